@@ -15,12 +15,24 @@ import (
 	// ACM
 	_ "github.com/clawscli/claws/custom/acm/certificates"
 
+	// Amplify
+	_ "github.com/clawscli/claws/custom/amplify/apps"
+	_ "github.com/clawscli/claws/custom/amplify/branches"
+
 	// API Gateway
+	_ "github.com/clawscli/claws/custom/apigateway/domain-names"
 	_ "github.com/clawscli/claws/custom/apigateway/http-apis"
 	_ "github.com/clawscli/claws/custom/apigateway/rest-apis"
 	_ "github.com/clawscli/claws/custom/apigateway/stages"
 	_ "github.com/clawscli/claws/custom/apigateway/stages-v2"
 
+	// AppConfig
+	_ "github.com/clawscli/claws/custom/appconfig/applications"
+	_ "github.com/clawscli/claws/custom/appconfig/configuration-profiles"
+	_ "github.com/clawscli/claws/custom/appconfig/deployments"
+	_ "github.com/clawscli/claws/custom/appconfig/environments"
+	_ "github.com/clawscli/claws/custom/appconfig/hosted-versions"
+
 	// App Runner
 	_ "github.com/clawscli/claws/custom/apprunner/operations"
 	_ "github.com/clawscli/claws/custom/apprunner/services"
@@ -62,6 +74,7 @@ import (
 	_ "github.com/clawscli/claws/custom/bedrock-agent/agents"
 	_ "github.com/clawscli/claws/custom/bedrock-agent/data-sources"
 	_ "github.com/clawscli/claws/custom/bedrock-agent/flows"
+	_ "github.com/clawscli/claws/custom/bedrock-agent/ingestion-jobs"
 	_ "github.com/clawscli/claws/custom/bedrock-agent/knowledge-bases"
 	_ "github.com/clawscli/claws/custom/bedrock-agent/prompts"
 
@@ -87,6 +100,13 @@ import (
 
 	// CloudFront
 	_ "github.com/clawscli/claws/custom/cloudfront/distributions"
+	_ "github.com/clawscli/claws/custom/cloudfront/functions"
+	_ "github.com/clawscli/claws/custom/cloudfront/key-value-stores"
+
+	// CloudHSM
+	_ "github.com/clawscli/claws/custom/cloudhsmv2/backups"
+	_ "github.com/clawscli/claws/custom/cloudhsmv2/clusters"
+	_ "github.com/clawscli/claws/custom/cloudhsmv2/hsms"
 
 	// CloudTrail
 	_ "github.com/clawscli/claws/custom/cloudtrail/events"
@@ -94,8 +114,14 @@ import (
 
 	// CloudWatch
 	_ "github.com/clawscli/claws/custom/cloudwatch/alarms"
+	_ "github.com/clawscli/claws/custom/cloudwatch/anomaly-detectors"
+	_ "github.com/clawscli/claws/custom/cloudwatch/canaries"
+	_ "github.com/clawscli/claws/custom/cloudwatch/canary-runs"
+	_ "github.com/clawscli/claws/custom/cloudwatch/insights-queries"
+	_ "github.com/clawscli/claws/custom/cloudwatch/insights-results"
 	_ "github.com/clawscli/claws/custom/cloudwatch/log-groups"
 	_ "github.com/clawscli/claws/custom/cloudwatch/log-streams"
+	_ "github.com/clawscli/claws/custom/cloudwatch/metric-streams"
 
 	// CodeBuild
 	_ "github.com/clawscli/claws/custom/codebuild/builds"
@@ -106,6 +132,7 @@ import (
 	_ "github.com/clawscli/claws/custom/codepipeline/pipelines"
 
 	// Cognito
+	_ "github.com/clawscli/claws/custom/cognito-idp/groups"
 	_ "github.com/clawscli/claws/custom/cognito-idp/user-pools"
 	_ "github.com/clawscli/claws/custom/cognito-idp/users"
 
@@ -116,6 +143,11 @@ import (
 	// Config
 	_ "github.com/clawscli/claws/custom/configservice/rules"
 
+	// Control Tower
+	_ "github.com/clawscli/claws/custom/controltower/controls"
+	_ "github.com/clawscli/claws/custom/controltower/enrollments"
+	_ "github.com/clawscli/claws/custom/controltower/landing-zones"
+
 	// DataSync
 	_ "github.com/clawscli/claws/custom/datasync/locations"
 	_ "github.com/clawscli/claws/custom/datasync/task-executions"
@@ -129,7 +161,24 @@ import (
 	_ "github.com/clawscli/claws/custom/directconnect/connections"
 	_ "github.com/clawscli/claws/custom/directconnect/virtual-interfaces"
 
+	// Data Lifecycle Manager
+	_ "github.com/clawscli/claws/custom/dlm/policies"
+
+	// Database Migration Service
+	_ "github.com/clawscli/claws/custom/dms/connections"
+	_ "github.com/clawscli/claws/custom/dms/endpoints"
+	_ "github.com/clawscli/claws/custom/dms/replication-instances"
+	_ "github.com/clawscli/claws/custom/dms/replication-tasks"
+	_ "github.com/clawscli/claws/custom/dms/table-statistics"
+
+	// Directory Service
+	_ "github.com/clawscli/claws/custom/ds/directories"
+	_ "github.com/clawscli/claws/custom/ds/domain-controllers"
+	_ "github.com/clawscli/claws/custom/ds/trusts"
+
 	// DynamoDB
+	_ "github.com/clawscli/claws/custom/dynamodb/backups"
+	_ "github.com/clawscli/claws/custom/dynamodb/exports"
 	_ "github.com/clawscli/claws/custom/dynamodb/tables"
 
 	// EC2
@@ -138,9 +187,13 @@ import (
 	_ "github.com/clawscli/claws/custom/ec2/images"
 	_ "github.com/clawscli/claws/custom/ec2/instances"
 	_ "github.com/clawscli/claws/custom/ec2/key-pairs"
+	_ "github.com/clawscli/claws/custom/ec2/launch-template-versions"
 	_ "github.com/clawscli/claws/custom/ec2/launch-templates"
+	_ "github.com/clawscli/claws/custom/ec2/network-interfaces"
 	_ "github.com/clawscli/claws/custom/ec2/security-groups"
 	_ "github.com/clawscli/claws/custom/ec2/snapshots"
+	_ "github.com/clawscli/claws/custom/ec2/spot-fleets"
+	_ "github.com/clawscli/claws/custom/ec2/spot-requests"
 	_ "github.com/clawscli/claws/custom/ec2/volumes"
 
 	// ECR
@@ -153,6 +206,11 @@ import (
 	_ "github.com/clawscli/claws/custom/ecs/task-definitions"
 	_ "github.com/clawscli/claws/custom/ecs/tasks"
 
+	// EFS
+	_ "github.com/clawscli/claws/custom/efs/access-points"
+	_ "github.com/clawscli/claws/custom/efs/file-systems"
+	_ "github.com/clawscli/claws/custom/efs/mount-targets"
+
 	// EKS
 	_ "github.com/clawscli/claws/custom/eks/access-entries"
 	_ "github.com/clawscli/claws/custom/eks/addons"
@@ -162,9 +220,20 @@ import (
 
 	// ElastiCache
 	_ "github.com/clawscli/claws/custom/elasticache/clusters"
+	_ "github.com/clawscli/claws/custom/elasticache/nodes"
+	_ "github.com/clawscli/claws/custom/elasticache/parameter-groups"
+	_ "github.com/clawscli/claws/custom/elasticache/parameters"
+	_ "github.com/clawscli/claws/custom/elasticache/shards"
+
+	// Elastic Beanstalk
+	_ "github.com/clawscli/claws/custom/elasticbeanstalk/applications"
+	_ "github.com/clawscli/claws/custom/elasticbeanstalk/environments"
+	_ "github.com/clawscli/claws/custom/elasticbeanstalk/events"
 
 	// Elastic Load Balancing
+	_ "github.com/clawscli/claws/custom/elbv2/listeners"
 	_ "github.com/clawscli/claws/custom/elbv2/load-balancers"
+	_ "github.com/clawscli/claws/custom/elbv2/rules"
 	_ "github.com/clawscli/claws/custom/elbv2/target-groups"
 	_ "github.com/clawscli/claws/custom/elbv2/targets"
 
@@ -176,8 +245,16 @@ import (
 	_ "github.com/clawscli/claws/custom/events/buses"
 	_ "github.com/clawscli/claws/custom/events/rules"
 
+	// Data Firehose
+	_ "github.com/clawscli/claws/custom/firehose/delivery-streams"
+
 	// Firewall Manager
 	_ "github.com/clawscli/claws/custom/fms/policies"
+	_ "github.com/clawscli/claws/custom/fms/policy-compliance"
+
+	// FSx
+	_ "github.com/clawscli/claws/custom/fsx/backups"
+	_ "github.com/clawscli/claws/custom/fsx/file-systems"
 
 	// GameLift
 	_ "github.com/clawscli/claws/custom/gamelift/builds"
@@ -187,11 +264,17 @@ import (
 	_ "github.com/clawscli/claws/custom/gamelift/matchmaking-configs"
 	_ "github.com/clawscli/claws/custom/gamelift/scripts"
 
+	// Global Accelerator
+	_ "github.com/clawscli/claws/custom/globalaccelerator/accelerators"
+	_ "github.com/clawscli/claws/custom/globalaccelerator/endpoint-groups"
+	_ "github.com/clawscli/claws/custom/globalaccelerator/listeners"
+
 	// Glue
 	_ "github.com/clawscli/claws/custom/glue/crawlers"
 	_ "github.com/clawscli/claws/custom/glue/databases"
 	_ "github.com/clawscli/claws/custom/glue/job-runs"
 	_ "github.com/clawscli/claws/custom/glue/jobs"
+	_ "github.com/clawscli/claws/custom/glue/table-preview"
 	_ "github.com/clawscli/claws/custom/glue/tables"
 
 	// GuardDuty
@@ -211,6 +294,12 @@ import (
 	// Inspector
 	_ "github.com/clawscli/claws/custom/inspector2/findings"
 
+	// IoT Core
+	_ "github.com/clawscli/claws/custom/iot/certificates"
+	_ "github.com/clawscli/claws/custom/iot/rules"
+	_ "github.com/clawscli/claws/custom/iot/thing-groups"
+	_ "github.com/clawscli/claws/custom/iot/things"
+
 	// Kinesis
 	_ "github.com/clawscli/claws/custom/kinesis/streams"
 
@@ -225,11 +314,25 @@ import (
 	_ "github.com/clawscli/claws/custom/license-manager/grants"
 	_ "github.com/clawscli/claws/custom/license-manager/licenses"
 
+	// Lightsail
+	_ "github.com/clawscli/claws/custom/lightsail/databases"
+	_ "github.com/clawscli/claws/custom/lightsail/instances"
+	_ "github.com/clawscli/claws/custom/lightsail/load-balancers"
+
 	// Macie
 	_ "github.com/clawscli/claws/custom/macie2/buckets"
 	_ "github.com/clawscli/claws/custom/macie2/classification-jobs"
+	_ "github.com/clawscli/claws/custom/macie2/finding-samples"
 	_ "github.com/clawscli/claws/custom/macie2/findings"
 
+	// Amazon MQ
+	_ "github.com/clawscli/claws/custom/mq/brokers"
+	_ "github.com/clawscli/claws/custom/mq/users"
+
+	// MSK
+	_ "github.com/clawscli/claws/custom/msk/clusters"
+	_ "github.com/clawscli/claws/custom/msk/topics"
+
 	// Network Firewall
 	_ "github.com/clawscli/claws/custom/network-firewall/firewall-policies"
 	_ "github.com/clawscli/claws/custom/network-firewall/firewalls"
@@ -243,6 +346,7 @@ import (
 	_ "github.com/clawscli/claws/custom/organizations/ous"
 	_ "github.com/clawscli/claws/custom/organizations/policies"
 	_ "github.com/clawscli/claws/custom/organizations/roots"
+	_ "github.com/clawscli/claws/custom/organizations/scp-denies"
 
 	// RDS
 	_ "github.com/clawscli/claws/custom/rds/instances"
@@ -250,6 +354,7 @@ import (
 
 	// Redshift
 	_ "github.com/clawscli/claws/custom/redshift/clusters"
+	_ "github.com/clawscli/claws/custom/redshift/queries"
 	_ "github.com/clawscli/claws/custom/redshift/snapshots"
 
 	// RI/SP
@@ -257,10 +362,17 @@ import (
 	_ "github.com/clawscli/claws/custom/risp/savings-plans"
 
 	// Route 53
+	_ "github.com/clawscli/claws/custom/route53/health-checks"
 	_ "github.com/clawscli/claws/custom/route53/hosted-zones"
 	_ "github.com/clawscli/claws/custom/route53/record-sets"
+	_ "github.com/clawscli/claws/custom/route53/traffic-policies"
+
+	// CloudWatch RUM
+	_ "github.com/clawscli/claws/custom/rum/app-monitors"
 
 	// S3
+	_ "github.com/clawscli/claws/custom/s3/archived-objects"
+	_ "github.com/clawscli/claws/custom/s3/batch-jobs"
 	_ "github.com/clawscli/claws/custom/s3/buckets"
 
 	// S3 Vectors
@@ -283,6 +395,15 @@ import (
 	_ "github.com/clawscli/claws/custom/service-quotas/quotas"
 	_ "github.com/clawscli/claws/custom/service-quotas/services"
 
+	// SES
+	_ "github.com/clawscli/claws/custom/ses/account"
+	_ "github.com/clawscli/claws/custom/ses/configuration-sets"
+	_ "github.com/clawscli/claws/custom/ses/identities"
+	_ "github.com/clawscli/claws/custom/ses/suppressed-destinations"
+
+	// Snow Family
+	_ "github.com/clawscli/claws/custom/snowball/jobs"
+
 	// SNS
 	_ "github.com/clawscli/claws/custom/sns/subscriptions"
 	_ "github.com/clawscli/claws/custom/sns/topics"
@@ -291,10 +412,21 @@ import (
 	_ "github.com/clawscli/claws/custom/sqs/queues"
 
 	// Systems Manager
+	_ "github.com/clawscli/claws/custom/ssm/associations"
+	_ "github.com/clawscli/claws/custom/ssm/automation-executions"
+	_ "github.com/clawscli/claws/custom/ssm/command-invocations"
+	_ "github.com/clawscli/claws/custom/ssm/documents"
+	_ "github.com/clawscli/claws/custom/ssm/maintenance-windows"
+	_ "github.com/clawscli/claws/custom/ssm/opsitems"
 	_ "github.com/clawscli/claws/custom/ssm/parameters"
+	_ "github.com/clawscli/claws/custom/ssm/patch-compliance"
+
+	// Incident Manager
+	_ "github.com/clawscli/claws/custom/ssm-incidents/incidents"
 
 	// Step Functions
 	_ "github.com/clawscli/claws/custom/stepfunctions/executions"
+	_ "github.com/clawscli/claws/custom/stepfunctions/express-executions"
 	_ "github.com/clawscli/claws/custom/stepfunctions/state-machines"
 
 	// Transcribe
@@ -309,6 +441,7 @@ import (
 
 	// VPC
 	_ "github.com/clawscli/claws/custom/vpc/endpoints"
+	_ "github.com/clawscli/claws/custom/vpc/flow-logs"
 	_ "github.com/clawscli/claws/custom/vpc/internet-gateways"
 	_ "github.com/clawscli/claws/custom/vpc/nat-gateways"
 	_ "github.com/clawscli/claws/custom/vpc/route-tables"
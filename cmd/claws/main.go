@@ -11,7 +11,9 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/clawscli/claws/internal/action"
 	"github.com/clawscli/claws/internal/app"
+	"github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/registry"
@@ -22,8 +24,80 @@ import (
 var version = "dev"
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "mcp" {
+		opts := parseFlagsFromArgs(os.Args[2:])
+		configure(opts)
+		if err := runMCP(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	opts := parseFlags()
+	configure(opts)
+
+	fileCfg := config.File()
+	cfg := config.Global()
+
+	var compactHeader bool
+	if opts.compactHeader != nil {
+		compactHeader = *opts.compactHeader
+	} else {
+		compactHeader = fileCfg.GetCompactHeader()
+	}
+	cfg.SetCompactHeader(compactHeader)
+
+	accessible := fileCfg.GetAccessible()
+	if v := os.Getenv("CLAWS_ACCESSIBLE"); v == "1" || v == "true" {
+		accessible = true
+	}
+	if opts.accessible != nil {
+		accessible = *opts.accessible
+	}
+	cfg.SetAccessible(accessible)
+
+	ui.ApplyConfigWithOverride(fileCfg.GetTheme(), opts.theme)
+
+	// Validate and resolve startup service/resource
+	var startupPath *app.StartupPath
+	if opts.service != "" {
+		service, resourceType, err := resolveStartupService(strings.TrimSpace(opts.service))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		startupPath = &app.StartupPath{
+			Service:      service,
+			ResourceType: resourceType,
+			ResourceID:   strings.TrimSpace(opts.resourceID),
+		}
+	} else if opts.resourceID != "" {
+		fmt.Fprintln(os.Stderr, "Error: --resource-id requires --service")
+		fmt.Fprintln(os.Stderr, "Example: claws -s ec2 -i i-1234567890abcdef0")
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
 
+	application := app.New(ctx, registry.Global, startupPath)
+
+	// Run the TUI
+	// Note: In v2, AltScreen and MouseMode are set via the View struct
+	// v2 has better ESC key handling via x/input package
+	p := tea.NewProgram(application)
+	aws.SetMFATokenFunc(app.MFATokenFunc(p.Send))
+
+	if _, err := p.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// configure applies the options shared by the TUI and `claws mcp`: config
+// file, credentials, regions, read-only mode, service subset and logging.
+// Invalid options exit with an error.
+func configure(opts cliOptions) {
 	propagateAllProxy()
 
 	// Set custom config path (CLI flag > env var > default)
@@ -53,14 +127,6 @@ func main() {
 	}
 	cfg.SetReadOnly(opts.readOnly)
 
-	var compactHeader bool
-	if opts.compactHeader != nil {
-		compactHeader = *opts.compactHeader
-	} else {
-		compactHeader = fileCfg.GetCompactHeader()
-	}
-	cfg.SetCompactHeader(compactHeader)
-
 	for _, p := range opts.profiles {
 		if !config.IsValidProfileName(p) {
 			fmt.Fprintf(os.Stderr, "Error: invalid profile name: %s\n", p)
@@ -78,25 +144,12 @@ func main() {
 
 	applyStartupConfig(opts, fileCfg, cfg)
 
-	ui.ApplyConfigWithOverride(fileCfg.GetTheme(), opts.theme)
-
-	// Validate and resolve startup service/resource
-	var startupPath *app.StartupPath
-	if opts.service != "" {
-		service, resourceType, err := resolveStartupService(strings.TrimSpace(opts.service))
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	if len(opts.services) > 0 {
+		if unknown := registry.Global.Restrict(opts.services); len(unknown) > 0 {
+			fmt.Fprintf(os.Stderr, "Error: unknown service(s): %s\n", strings.Join(unknown, ", "))
 			os.Exit(1)
 		}
-		startupPath = &app.StartupPath{
-			Service:      service,
-			ResourceType: resourceType,
-			ResourceID:   strings.TrimSpace(opts.resourceID),
-		}
-	} else if opts.resourceID != "" {
-		fmt.Fprintln(os.Stderr, "Error: --resource-id requires --service")
-		fmt.Fprintln(os.Stderr, "Example: claws -s ec2 -i i-1234567890abcdef0")
-		os.Exit(1)
+		action.Global.Restrict(registry.Global.ListServices())
 	}
 
 	// Enable logging if log file specified
@@ -107,20 +160,6 @@ func main() {
 			log.Info("claws started", "profiles", opts.profiles, "regions", opts.regions, "readOnly", opts.readOnly)
 		}
 	}
-
-	ctx := context.Background()
-
-	application := app.New(ctx, registry.Global, startupPath)
-
-	// Run the TUI
-	// Note: In v2, AltScreen and MouseMode are set via the View struct
-	// v2 has better ESC key handling via x/input package
-	p := tea.NewProgram(application)
-
-	if _, err := p.Run(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
-	}
 }
 
 type cliOptions struct {
@@ -132,9 +171,11 @@ type cliOptions struct {
 	logFile       string
 	configFile    string
 	service       string
+	services      []string
 	resourceID    string
 	theme         string
 	compactHeader *bool
+	accessible    *bool
 }
 
 // parseFlags parses command line flags and returns options
@@ -193,6 +234,15 @@ func parseFlagsFromArgs(args []string) cliOptions {
 				i++
 				opts.service = args[i]
 			}
+		case "--services":
+			if i+1 < len(args) {
+				i++
+				for _, s := range strings.Split(args[i], ",") {
+					if s = strings.TrimSpace(s); s != "" && !slices.Contains(opts.services, s) {
+						opts.services = append(opts.services, s)
+					}
+				}
+			}
 		case "-i", "--resource-id":
 			if i+1 < len(args) {
 				i++
@@ -209,6 +259,12 @@ func parseFlagsFromArgs(args []string) cliOptions {
 		case "--no-compact":
 			f := false
 			opts.compactHeader = &f
+		case "--accessible":
+			t := true
+			opts.accessible = &t
+		case "--no-accessible":
+			f := false
+			opts.accessible = &f
 		case "-h", "--help":
 			showHelp = true
 		case "-v", "--version":
@@ -233,6 +289,10 @@ func printUsage() {
 	fmt.Println("claws - A terminal UI for AWS resource management")
 	fmt.Println()
 	fmt.Println("Usage: claws [options]")
+	fmt.Println("       claws mcp [options]")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  mcp   Serve the AI tools over the Model Context Protocol on stdio")
 	fmt.Println()
 	fmt.Println("Options:")
 	fmt.Println("  -p, --profile <name>[,name2,...]")
@@ -243,6 +303,8 @@ func printUsage() {
 	fmt.Println("        Start directly on a service/resource (e.g., ec2, rds/snapshots, cfn)")
 	fmt.Println("        Special views: dashboard, services")
 	fmt.Println("        Supports aliases: cfn, sg, logs, ddb, etc.")
+	fmt.Println("  --services <service>[,service2,...]")
+	fmt.Println("        Load only these services (e.g., ec2,s3,lambda); others are not registered")
 	fmt.Println("  -i, --resource-id <id>")
 	fmt.Println("        Open detail view for a specific resource (requires --service)")
 	fmt.Println("  -e, --env")
@@ -264,6 +326,10 @@ func printUsage() {
 	fmt.Println("        Start with compact header mode (toggle with Ctrl+E)")
 	fmt.Println("  --no-compact")
 	fmt.Println("        Disable compact header (overrides config file)")
+	fmt.Println("  --accessible")
+	fmt.Println("        Screen-reader friendly mode: no alt screen, mouse capture, or animations")
+	fmt.Println("  --no-accessible")
+	fmt.Println("        Disable accessibility mode (overrides config file)")
 	fmt.Println("  -v, --version")
 	fmt.Println("        Show version")
 	fmt.Println("  -h, --help")
@@ -279,10 +345,13 @@ func printUsage() {
 	fmt.Println("  claws -s ec2 -i i-12345           Open detail view for instance i-12345")
 	fmt.Println("  claws -p dev,prod                 Query multiple profiles")
 	fmt.Println("  claws -r us-east-1,ap-northeast-1 Query multiple regions")
+	fmt.Println("  claws --services ec2,s3,lambda    Load only EC2, S3 and Lambda")
+	fmt.Println("  claws mcp -p dev -r us-east-1     Serve MCP tools for the dev profile")
 	fmt.Println()
 	fmt.Println("Environment Variables:")
 	fmt.Println("  CLAWS_CONFIG=<path>      Use custom config file")
 	fmt.Println("  CLAWS_READ_ONLY=1|true   Enable read-only mode")
+	fmt.Println("  CLAWS_ACCESSIBLE=1|true  Enable accessibility mode")
 	fmt.Println("  ALL_PROXY                Propagated to HTTP_PROXY/HTTPS_PROXY if not set")
 }
 
@@ -146,3 +146,34 @@ func TestParseFlags_ConfigFile(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFlags_Accessible(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected *bool
+	}{
+		{"enabled", []string{"--accessible"}, boolPtr(true)},
+		{"disabled", []string{"--no-accessible"}, boolPtr(false)},
+		{"unset", []string{"-p", "dev"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := parseFlagsFromArgs(tt.args)
+			if (opts.accessible == nil) != (tt.expected == nil) ||
+				(opts.accessible != nil && *opts.accessible != *tt.expected) {
+				t.Errorf("accessible = %v, want %v", opts.accessible, tt.expected)
+			}
+		})
+	}
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+func TestParseFlags_Services(t *testing.T) {
+	opts := parseFlagsFromArgs([]string{"--services", "ec2, s3", "--services", "lambda,ec2"})
+	if want := []string{"ec2", "s3", "lambda"}; !slices.Equal(opts.services, want) {
+		t.Errorf("services = %v, want %v", opts.services, want)
+	}
+}
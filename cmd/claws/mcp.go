@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/clawscli/claws/internal/ai"
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/mcp"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// runMCP serves the AI tools over MCP on stdin/stdout until the client
+// disconnects or the process is interrupted. stdout carries the protocol, so
+// nothing else may write to it.
+func runMCP() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Resolve the default region and account like the TUI does; tools that
+	// take an explicit region still work if this fails.
+	if err := aws.InitContext(ctx); err != nil {
+		log.Warn("mcp: could not resolve AWS context", "error", err)
+	}
+
+	tools, err := ai.NewToolExecutor(ctx, registry.Global)
+	if err != nil {
+		return err
+	}
+
+	err = mcp.NewServer(tools, version).Serve(ctx, os.Stdin, os.Stdout)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
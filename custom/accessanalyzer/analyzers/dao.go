@@ -20,13 +20,13 @@ type AnalyzerDAO struct {
 
 // NewAnalyzerDAO creates a new AnalyzerDAO.
 func NewAnalyzerDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, accessanalyzer.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &AnalyzerDAO{
 		BaseDAO: dao.NewBaseDAO("accessanalyzer", "analyzers"),
-		client:  accessanalyzer.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
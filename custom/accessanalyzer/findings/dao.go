@@ -21,13 +21,13 @@ type FindingDAO struct {
 
 // NewFindingDAO creates a new FindingDAO.
 func NewFindingDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, accessanalyzer.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &FindingDAO{
 		BaseDAO: dao.NewBaseDAO("accessanalyzer", "findings"),
-		client:  accessanalyzer.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
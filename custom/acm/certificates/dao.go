@@ -19,13 +19,13 @@ type CertificateDAO struct {
 
 // NewCertificateDAO creates a new CertificateDAO
 func NewCertificateDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, acm.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &CertificateDAO{
 		BaseDAO: dao.NewBaseDAO("acm", "certificates"),
-		client:  acm.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
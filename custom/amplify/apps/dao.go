@@ -0,0 +1,130 @@
+package apps
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+
+	amplifyClient "github.com/clawscli/claws/custom/amplify"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// AppDAO provides data access for Amplify apps
+type AppDAO struct {
+	dao.BaseDAO
+	client *amplify.Client
+}
+
+// NewAppDAO creates a new AppDAO
+func NewAppDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := amplifyClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &AppDAO{
+		BaseDAO: dao.NewBaseDAO("amplify", "apps"),
+		client:  client,
+	}, nil
+}
+
+// List returns all Amplify apps
+func (d *AppDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	apps, err := appaws.Paginate(ctx, func(token *string) ([]types.App, *string, error) {
+		output, err := d.client.ListApps(ctx, &amplify.ListAppsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list amplify apps")
+		}
+		return output.Apps, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		return appaws.Str(apps[i].Name) < appaws.Str(apps[j].Name)
+	})
+
+	resources := make([]dao.Resource, len(apps))
+	for i, app := range apps {
+		resources[i] = NewAppResource(app)
+	}
+	return resources, nil
+}
+
+// Get returns a specific app
+func (d *AppDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetApp(ctx, &amplify.GetAppInput{
+		AppId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get amplify app %s", id)
+	}
+	return NewAppResource(*output.App), nil
+}
+
+// Delete deletes an app and all its branches
+func (d *AppDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteApp(ctx, &amplify.DeleteAppInput{
+		AppId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete amplify app %s", id)
+	}
+	return nil
+}
+
+// AppResource wraps an Amplify app
+type AppResource struct {
+	dao.BaseResource
+	Item types.App
+}
+
+// NewAppResource creates a new AppResource
+func NewAppResource(app types.App) *AppResource {
+	return &AppResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(app.AppId),
+			Name: appaws.Str(app.Name),
+			ARN:  appaws.Str(app.AppArn),
+			Tags: app.Tags,
+			Data: app,
+		},
+		Item: app,
+	}
+}
+
+// Platform returns WEB, WEB_DYNAMIC or WEB_COMPUTE
+func (r *AppResource) Platform() string {
+	return string(r.Item.Platform)
+}
+
+// Repository returns the connected repository URL, or "" for manual deploys
+func (r *AppResource) Repository() string {
+	return appaws.Str(r.Item.Repository)
+}
+
+// ProductionBranch returns the production branch name and its last deploy status
+func (r *AppResource) ProductionBranch() (name, status string) {
+	pb := r.Item.ProductionBranch
+	if pb == nil {
+		return "", ""
+	}
+	return appaws.Str(pb.BranchName), appaws.Str(pb.Status)
+}
+
+// URL returns the production URL on the default domain. Amplify replaces
+// slashes in branch names with dashes in the subdomain.
+func (r *AppResource) URL() string {
+	name, _ := r.ProductionBranch()
+	domain := appaws.Str(r.Item.DefaultDomain)
+	if name == "" || domain == "" {
+		return ""
+	}
+	return "https://" + strings.ReplaceAll(name, "/", "-") + "." + domain
+}
@@ -0,0 +1,20 @@
+package apps
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("amplify", "apps", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewAppDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewAppRenderer()
+		},
+	})
+}
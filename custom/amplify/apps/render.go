@@ -0,0 +1,188 @@
+package apps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// AppRenderer renders Amplify apps
+type AppRenderer struct {
+	render.BaseRenderer
+}
+
+// NewAppRenderer creates a new AppRenderer
+func NewAppRenderer() render.Renderer {
+	return &AppRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "amplify",
+			Resource: "apps",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ID", Width: 16, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "PLATFORM", Width: 12, Getter: getPlatform},
+				{Name: "PRODUCTION", Width: 16, Getter: getProdBranch},
+				{Name: "STATUS", Width: 10, Getter: getProdStatus},
+				{Name: "DEPLOYED", Width: 12, Getter: getDeployed},
+				{Name: "REPOSITORY", Width: 40, Getter: getRepository},
+			},
+		},
+	}
+}
+
+func getPlatform(r dao.Resource) string {
+	app, ok := r.(*AppResource)
+	if !ok {
+		return ""
+	}
+	return app.Platform()
+}
+
+func getProdBranch(r dao.Resource) string {
+	app, ok := r.(*AppResource)
+	if !ok {
+		return ""
+	}
+	name, _ := app.ProductionBranch()
+	return name
+}
+
+func getProdStatus(r dao.Resource) string {
+	app, ok := r.(*AppResource)
+	if !ok {
+		return ""
+	}
+	_, status := app.ProductionBranch()
+	return status
+}
+
+func getDeployed(r dao.Resource) string {
+	app, ok := r.(*AppResource)
+	if !ok || app.Item.ProductionBranch == nil || app.Item.ProductionBranch.LastDeployTime == nil {
+		return ""
+	}
+	return render.FormatAge(*app.Item.ProductionBranch.LastDeployTime)
+}
+
+func getRepository(r dao.Resource) string {
+	app, ok := r.(*AppResource)
+	if !ok {
+		return ""
+	}
+	return app.Repository()
+}
+
+// deployStyle colors the production branch's last deploy status.
+func deployStyle(status string) render.Style {
+	switch status {
+	case "SUCCEED":
+		return ui.SuccessStyle()
+	case "FAILED":
+		return ui.DangerStyle()
+	case "PENDING", "PROVISIONING", "RUNNING", "CANCELLING":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an app
+func (r *AppRenderer) RenderDetail(resource dao.Resource) string {
+	app, ok := resource.(*AppResource)
+	if !ok {
+		return ""
+	}
+	item := app.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Amplify App", app.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", app.GetName())
+	d.Field("App ID", app.GetID())
+	d.FieldIf("Description", item.Description)
+	d.Field("Platform", app.Platform())
+	d.FieldIf("Default Domain", item.DefaultDomain)
+	d.Field("ARN", app.GetARN())
+
+	if prod, status := app.ProductionBranch(); prod != "" {
+		d.Section("Production")
+		d.Field("Branch", prod)
+		if status != "" {
+			d.FieldStyled("Last Deploy", status, deployStyle(status))
+		}
+		if t := item.ProductionBranch.LastDeployTime; t != nil {
+			d.Field("Deployed", t.Format("2006-01-02 15:04:05"))
+		}
+		if url := app.URL(); url != "" {
+			d.Field("URL", url)
+		}
+	}
+
+	d.Section("Build")
+	if repo := app.Repository(); repo != "" {
+		d.Field("Repository", repo)
+	} else {
+		d.Field("Repository", "manual deploys")
+	}
+	if item.EnableBranchAutoBuild != nil {
+		d.Field("Branch Auto Build", fmt.Sprintf("%v", *item.EnableBranchAutoBuild))
+	}
+	if item.EnableAutoBranchCreation != nil && *item.EnableAutoBranchCreation {
+		d.Field("Auto Branch Patterns", strings.Join(item.AutoBranchCreationPatterns, ", "))
+	}
+	d.FieldIf("Service Role", item.IamServiceRoleArn)
+	if jc := item.JobConfig; jc != nil {
+		d.Field("Build Compute", string(jc.BuildComputeType))
+	}
+	if len(item.CustomRules) > 0 {
+		d.Field("Rewrite Rules", fmt.Sprintf("%d", len(item.CustomRules)))
+	}
+	if len(item.EnvironmentVariables) > 0 {
+		d.Field("Environment Variables", fmt.Sprintf("%d", len(item.EnvironmentVariables)))
+	}
+
+	d.Section("Timestamps")
+	if item.CreateTime != nil {
+		d.Field("Created", item.CreateTime.Format("2006-01-02 15:04:05"))
+	}
+	if item.UpdateTime != nil {
+		d.Field("Updated", item.UpdateTime.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Tags(app.Tags)
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an app
+func (r *AppRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	app, ok := resource.(*AppResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	prod, status := app.ProductionBranch()
+	return []render.SummaryField{
+		{Label: "Name", Value: app.GetName()},
+		{Label: "ID", Value: app.GetID()},
+		{Label: "Production", Value: prod},
+		{Label: "Status", Value: status, Style: deployStyle(status)},
+		{Label: "URL", Value: app.URL()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *AppRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	app, ok := resource.(*AppResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "b", Label: "Branches", Service: "amplify", Resource: "branches", FilterField: "AppId", FilterValue: app.GetID()},
+	}
+}
@@ -0,0 +1,73 @@
+package branches
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+
+	amplifyClient "github.com/clawscli/claws/custom/amplify"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("amplify", "branches", []action.Action{
+		{
+			Name:      "Retry Build",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RetryJob",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				b, ok := r.(*BranchResource)
+				return ok && b.CanRetry()
+			},
+		},
+	})
+
+	action.RegisterExecutor("amplify", "branches", executeBranchAction)
+}
+
+func executeBranchAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RetryJob":
+		return executeRetry(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeRetry(ctx context.Context, resource dao.Resource) action.ActionResult {
+	b, ok := resource.(*BranchResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if !b.CanRetry() {
+		return action.FailResult(fmt.Errorf("latest job of %s is %s, only failed or cancelled jobs can be retried", b.GetName(), b.JobStatus()))
+	}
+
+	client, err := amplifyClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := b.GetName()
+	jobID := b.JobID()
+	output, err := client.StartJob(ctx, &amplify.StartJobInput{
+		AppId:      &b.AppID,
+		BranchName: &name,
+		JobType:    types.JobTypeRetry,
+		JobId:      &jobID,
+	})
+	if err != nil {
+		return action.FailResultf(err, "retry job %s of %s", jobID, name)
+	}
+	newID := jobID
+	if output.JobSummary != nil {
+		newID = appaws.Str(output.JobSummary.JobId)
+	}
+	return action.SuccessResult(fmt.Sprintf("Retrying build of %s as job %s", name, newID))
+}
@@ -0,0 +1,225 @@
+package branches
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+	"golang.org/x/sync/errgroup"
+
+	amplifyClient "github.com/clawscli/claws/custom/amplify"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// jobConcurrency bounds parallel ListJobs calls during List.
+const jobConcurrency = 8
+
+// BranchDAO provides data access for Amplify branches
+type BranchDAO struct {
+	dao.BaseDAO
+	client *amplify.Client
+}
+
+// NewBranchDAO creates a new BranchDAO
+func NewBranchDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := amplifyClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &BranchDAO{
+		BaseDAO: dao.NewBaseDAO("amplify", "branches"),
+		client:  client,
+	}, nil
+}
+
+// List returns the branches of an app (requires AppId filter), each with its
+// latest build job. Job lookups are best-effort.
+func (d *BranchDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	appID := dao.GetFilterFromContext(ctx, "AppId")
+	if appID == "" {
+		return nil, fmt.Errorf("AppId filter required - navigate from an Amplify app")
+	}
+
+	branches, err := appaws.Paginate(ctx, func(token *string) ([]types.Branch, *string, error) {
+		output, err := d.client.ListBranches(ctx, &amplify.ListBranchesInput{
+			AppId:     &appID,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list branches of amplify app %s", appID)
+		}
+		return output.Branches, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	resources := make([]dao.Resource, 0, len(branches))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(jobConcurrency)
+	for _, branch := range branches {
+		g.Go(func() error {
+			r := NewBranchResource(appID, branch)
+			job, err := d.latestJob(gctx, appID, r.GetName())
+			if err != nil {
+				log.Debug("failed to list amplify jobs", "app", appID, "branch", r.GetName(), "error", err)
+			} else {
+				r.LatestJob = job
+			}
+			mu.Lock()
+			resources = append(resources, r)
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetName() < resources[j].GetName()
+	})
+	return resources, nil
+}
+
+// Get returns a specific branch with its latest build job
+func (d *BranchDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	appID := dao.GetFilterFromContext(ctx, "AppId")
+	if appID == "" {
+		return nil, fmt.Errorf("AppId filter required - navigate from an Amplify app")
+	}
+
+	output, err := d.client.GetBranch(ctx, &amplify.GetBranchInput{
+		AppId:      &appID,
+		BranchName: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get amplify branch %s", name)
+	}
+	r := NewBranchResource(appID, *output.Branch)
+
+	job, err := d.latestJob(ctx, appID, name)
+	if err != nil {
+		log.Warn("failed to list amplify jobs", "app", appID, "branch", name, "error", err)
+	} else {
+		r.LatestJob = job
+	}
+	return r, nil
+}
+
+// Delete deletes a branch
+func (d *BranchDAO) Delete(ctx context.Context, name string) error {
+	appID := dao.GetFilterFromContext(ctx, "AppId")
+	if appID == "" {
+		return fmt.Errorf("AppId filter required - navigate from an Amplify app")
+	}
+
+	_, err := d.client.DeleteBranch(ctx, &amplify.DeleteBranchInput{
+		AppId:      &appID,
+		BranchName: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete amplify branch %s", name)
+	}
+	return nil
+}
+
+// latestJob returns the most recent job of a branch, or nil if it has none.
+// ListJobs returns jobs newest first.
+func (d *BranchDAO) latestJob(ctx context.Context, appID, branch string) (*types.JobSummary, error) {
+	output, err := d.client.ListJobs(ctx, &amplify.ListJobsInput{
+		AppId:      &appID,
+		BranchName: &branch,
+		MaxResults: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.JobSummaries) == 0 {
+		return nil, nil
+	}
+	return &output.JobSummaries[0], nil
+}
+
+// BranchResource wraps an Amplify branch and its latest job
+type BranchResource struct {
+	dao.BaseResource
+	AppID     string
+	Item      types.Branch
+	LatestJob *types.JobSummary
+}
+
+// NewBranchResource creates a new BranchResource
+func NewBranchResource(appID string, branch types.Branch) *BranchResource {
+	name := appaws.Str(branch.BranchName)
+	return &BranchResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(branch.BranchArn),
+			Tags: branch.Tags,
+			Data: branch,
+		},
+		AppID: appID,
+		Item:  branch,
+	}
+}
+
+// Stage returns the branch stage (PRODUCTION, BETA, DEVELOPMENT, ...)
+func (r *BranchResource) Stage() string {
+	return string(r.Item.Stage)
+}
+
+// JobStatus returns the latest job status, or "" if the branch never built
+func (r *BranchResource) JobStatus() string {
+	if r.LatestJob == nil {
+		return ""
+	}
+	return string(r.LatestJob.Status)
+}
+
+// JobID returns the latest job ID
+func (r *BranchResource) JobID() string {
+	if r.LatestJob == nil {
+		return ""
+	}
+	return appaws.Str(r.LatestJob.JobId)
+}
+
+// IsBuilding reports whether the latest job is still in progress
+func (r *BranchResource) IsBuilding() bool {
+	switch types.JobStatus(r.JobStatus()) {
+	case types.JobStatusCreated, types.JobStatusPending, types.JobStatusProvisioning,
+		types.JobStatusRunning, types.JobStatusCancelling:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanRetry reports whether the latest job ended without succeeding
+func (r *BranchResource) CanRetry() bool {
+	switch types.JobStatus(r.JobStatus()) {
+	case types.JobStatusFailed, types.JobStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Commit returns the short commit ID of the latest job
+func (r *BranchResource) Commit() string {
+	if r.LatestJob == nil {
+		return ""
+	}
+	id := appaws.Str(r.LatestJob.CommitId)
+	if len(id) > 7 {
+		id = id[:7]
+	}
+	return id
+}
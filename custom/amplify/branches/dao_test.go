@@ -0,0 +1,49 @@
+package branches
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+)
+
+func TestBranchResource_JobState(t *testing.T) {
+	b := NewBranchResource("d1abc", types.Branch{BranchName: aws.String("main")})
+	if b.CanRetry() || b.IsBuilding() || b.JobStatus() != "" {
+		t.Error("branch without jobs should be neither retryable nor building")
+	}
+
+	tests := []struct {
+		status   types.JobStatus
+		retry    bool
+		building bool
+	}{
+		{types.JobStatusFailed, true, false},
+		{types.JobStatusCancelled, true, false},
+		{types.JobStatusSucceed, false, false},
+		{types.JobStatusRunning, false, true},
+		{types.JobStatusPending, false, true},
+	}
+	for _, tt := range tests {
+		b.LatestJob = &types.JobSummary{JobId: aws.String("12"), Status: tt.status}
+		if got := b.CanRetry(); got != tt.retry {
+			t.Errorf("%s: CanRetry() = %v, want %v", tt.status, got, tt.retry)
+		}
+		if got := b.IsBuilding(); got != tt.building {
+			t.Errorf("%s: IsBuilding() = %v, want %v", tt.status, got, tt.building)
+		}
+	}
+}
+
+func TestBranchResource_Commit(t *testing.T) {
+	b := NewBranchResource("d1abc", types.Branch{BranchName: aws.String("main")})
+	b.LatestJob = &types.JobSummary{CommitId: aws.String("0123456789abcdef")}
+	if got := b.Commit(); got != "0123456" {
+		t.Errorf("Commit() = %q, want 0123456", got)
+	}
+
+	b.LatestJob.CommitId = aws.String("HEAD")
+	if got := b.Commit(); got != "HEAD" {
+		t.Errorf("Commit() = %q, want HEAD", got)
+	}
+}
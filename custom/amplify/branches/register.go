@@ -0,0 +1,20 @@
+package branches
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("amplify", "branches", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewBranchDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewBranchRenderer()
+		},
+	})
+}
@@ -0,0 +1,205 @@
+package branches
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// BranchRenderer renders Amplify branches
+type BranchRenderer struct {
+	render.BaseRenderer
+}
+
+// NewBranchRenderer creates a new BranchRenderer
+func NewBranchRenderer() render.Renderer {
+	return &BranchRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "amplify",
+			Resource: "branches",
+			Cols: []render.Column{
+				{Name: "BRANCH", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STAGE", Width: 13, Getter: getStage},
+				{Name: "AUTO", Width: 5, Getter: getAutoBuild},
+				{Name: "JOB", Width: 6, Getter: getJobID},
+				{Name: "BUILD", Width: 12, Getter: getJobStatus},
+				{Name: "COMMIT", Width: 8, Getter: getCommit},
+				{Name: "STARTED", Width: 12, Getter: getStarted},
+				{Name: "FRAMEWORK", Width: 16, Getter: getFramework},
+			},
+		},
+	}
+}
+
+func getStage(r dao.Resource) string {
+	b, ok := r.(*BranchResource)
+	if !ok {
+		return ""
+	}
+	return b.Stage()
+}
+
+func getAutoBuild(r dao.Resource) string {
+	b, ok := r.(*BranchResource)
+	if !ok {
+		return ""
+	}
+	if appaws.Bool(b.Item.EnableAutoBuild) {
+		return "yes"
+	}
+	return "no"
+}
+
+func getJobID(r dao.Resource) string {
+	b, ok := r.(*BranchResource)
+	if !ok {
+		return ""
+	}
+	return b.JobID()
+}
+
+func getJobStatus(r dao.Resource) string {
+	b, ok := r.(*BranchResource)
+	if !ok {
+		return ""
+	}
+	return b.JobStatus()
+}
+
+func getCommit(r dao.Resource) string {
+	b, ok := r.(*BranchResource)
+	if !ok {
+		return ""
+	}
+	return b.Commit()
+}
+
+func getStarted(r dao.Resource) string {
+	b, ok := r.(*BranchResource)
+	if !ok || b.LatestJob == nil || b.LatestJob.StartTime == nil {
+		return ""
+	}
+	return render.FormatAge(*b.LatestJob.StartTime)
+}
+
+func getFramework(r dao.Resource) string {
+	b, ok := r.(*BranchResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(b.Item.Framework)
+}
+
+func jobStyle(status string) render.Style {
+	switch status {
+	case "SUCCEED":
+		return ui.SuccessStyle()
+	case "FAILED":
+		return ui.DangerStyle()
+	case "CREATED", "PENDING", "PROVISIONING", "RUNNING", "CANCELLING":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a branch
+func (r *BranchRenderer) RenderDetail(resource dao.Resource) string {
+	b, ok := resource.(*BranchResource)
+	if !ok {
+		return ""
+	}
+	item := b.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Amplify Branch", b.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Branch", b.GetName())
+	d.FieldIf("Display Name", item.DisplayName)
+	d.Field("App ID", b.AppID)
+	d.Field("Stage", b.Stage())
+	d.FieldIf("Description", item.Description)
+	d.FieldIf("Framework", item.Framework)
+	if len(item.CustomDomains) > 0 {
+		d.Field("Custom Domains", strings.Join(item.CustomDomains, ", "))
+	}
+	if b.GetARN() != "" {
+		d.Field("ARN", b.GetARN())
+	}
+
+	d.Section("Build Settings")
+	d.Field("Auto Build", getAutoBuild(b))
+	d.Field("PR Previews", fmt.Sprintf("%v", appaws.Bool(item.EnablePullRequestPreview)))
+	d.Field("Performance Mode", fmt.Sprintf("%v", appaws.Bool(item.EnablePerformanceMode)))
+	d.FieldIf("Total Jobs", item.TotalNumberOfJobs)
+	d.FieldIf("Active Job", item.ActiveJobId)
+	if be := item.Backend; be != nil {
+		d.FieldIf("Backend Stack", be.StackArn)
+	}
+
+	if job := b.LatestJob; job != nil {
+		d.Section("Latest Build")
+		d.Field("Job", b.JobID())
+		d.FieldStyled("Status", b.JobStatus(), jobStyle(b.JobStatus()))
+		d.Field("Type", string(job.JobType))
+		d.FieldIf("Commit", job.CommitId)
+		if msg := appaws.Str(job.CommitMessage); msg != "" {
+			// Only the subject line; full messages can span many lines
+			subject, _, _ := strings.Cut(msg, "\n")
+			d.Field("Message", subject)
+		}
+		if t := job.StartTime; t != nil {
+			d.Field("Started", t.Format("2006-01-02 15:04:05"))
+		}
+		if t := job.EndTime; t != nil {
+			d.Field("Ended", t.Format("2006-01-02 15:04:05"))
+			if job.StartTime != nil {
+				d.Field("Duration", t.Sub(*job.StartTime).Round(time.Second).String())
+			}
+		}
+	}
+
+	d.Section("Timestamps")
+	if item.CreateTime != nil {
+		d.Field("Created", item.CreateTime.Format("2006-01-02 15:04:05"))
+	}
+	if item.UpdateTime != nil {
+		d.Field("Updated", item.UpdateTime.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Tags(b.Tags)
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a branch
+func (r *BranchRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	b, ok := resource.(*BranchResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Branch", Value: b.GetName()},
+		{Label: "Stage", Value: b.Stage()},
+		{Label: "Build", Value: b.JobStatus(), Style: jobStyle(b.JobStatus())},
+		{Label: "Job", Value: b.JobID()},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a build is in progress
+func (r *BranchRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if b, ok := dao.UnwrapResource(res).(*BranchResource); ok && b.IsBuilding() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+package amplify
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/amplify"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an Amplify client configured for the current context
+func GetClient(ctx context.Context) (*amplify.Client, error) {
+	return appaws.Client(ctx, amplify.NewFromConfig)
+}
@@ -0,0 +1,265 @@
+package domainnames
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// certConcurrency bounds parallel DescribeCertificate calls during List.
+const certConcurrency = 8
+
+// expiryWarning is how close to expiry a certificate is flagged.
+const expiryWarning = 30 * 24 * time.Hour
+
+// DomainNameDAO provides data access for API Gateway custom domain names
+type DomainNameDAO struct {
+	dao.BaseDAO
+	client    *apigatewayv2.Client
+	acmClient *acm.Client
+}
+
+// NewDomainNameDAO creates a new DomainNameDAO
+func NewDomainNameDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, apigatewayv2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	acmClient, err := appaws.Client(ctx, acm.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DomainNameDAO{
+		BaseDAO:   dao.NewBaseDAO("apigateway", "domain-names"),
+		client:    client,
+		acmClient: acmClient,
+	}, nil
+}
+
+// List returns all custom domain names, REST and HTTP alike
+func (d *DomainNameDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	domains, err := appaws.Paginate(ctx, func(token *string) ([]types.DomainName, *string, error) {
+		output, err := d.client.GetDomainNames(ctx, &apigatewayv2.GetDomainNamesInput{
+			NextToken:  token,
+			MaxResults: appaws.StringPtr("500"),
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list domain names")
+		}
+		return output.Items, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*DomainNameResource, len(domains))
+	resources := make([]dao.Resource, len(domains))
+	for i, dn := range domains {
+		items[i] = NewDomainNameResource(dn)
+		resources[i] = items[i]
+	}
+	d.loadCertExpiry(ctx, items)
+
+	return resources, nil
+}
+
+// Get returns a custom domain name with its API mappings
+func (d *DomainNameDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.client.GetDomainName(ctx, &apigatewayv2.GetDomainNameInput{
+		DomainName: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get domain name %s", name)
+	}
+
+	r := NewDomainNameResource(types.DomainName{
+		DomainName:                    output.DomainName,
+		DomainNameArn:                 output.DomainNameArn,
+		ApiMappingSelectionExpression: output.ApiMappingSelectionExpression,
+		DomainNameConfigurations:      output.DomainNameConfigurations,
+		MutualTlsAuthentication:       output.MutualTlsAuthentication,
+		RoutingMode:                   output.RoutingMode,
+		Tags:                          output.Tags,
+	})
+
+	mappings, err := appaws.Paginate(ctx, func(token *string) ([]types.ApiMapping, *string, error) {
+		out, err := d.client.GetApiMappings(ctx, &apigatewayv2.GetApiMappingsInput{
+			DomainName: &name,
+			NextToken:  token,
+			MaxResults: appaws.StringPtr("500"),
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "get api mappings for %s", name)
+		}
+		return out.Items, out.NextToken, nil
+	})
+	if err != nil {
+		log.Debug("failed to get api mappings", "domainName", name, "error", err)
+	} else {
+		r.Mappings = mappings
+	}
+
+	d.loadCertExpiry(ctx, []*DomainNameResource{r})
+	return r, nil
+}
+
+// Delete deletes a custom domain name
+func (d *DomainNameDAO) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteDomainName(ctx, &apigatewayv2.DeleteDomainNameInput{
+		DomainName: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete domain name %s", name)
+	}
+	return nil
+}
+
+// loadCertExpiry looks up the expiry of each domain's ACM certificate. Edge
+// domains use certificates from us-east-1, so each lookup targets the region
+// in the certificate ARN.
+func (d *DomainNameDAO) loadCertExpiry(ctx context.Context, resources []*DomainNameResource) {
+	var mu sync.Mutex
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(certConcurrency)
+	for _, r := range resources {
+		certArn := r.CertificateArn()
+		if certArn == "" {
+			continue
+		}
+		g.Go(func() error {
+			var optFns []func(*acm.Options)
+			if parsed := appaws.ParseARN(certArn); parsed != nil && parsed.Region != "" {
+				optFns = append(optFns, func(o *acm.Options) { o.Region = parsed.Region })
+			}
+			output, err := d.acmClient.DescribeCertificate(ctx, &acm.DescribeCertificateInput{
+				CertificateArn: &certArn,
+			}, optFns...)
+			if err != nil {
+				log.Debug("failed to describe certificate", "certificate", certArn, "error", err)
+				return nil
+			}
+			if output.Certificate == nil {
+				return nil
+			}
+			mu.Lock()
+			r.CertNotAfter = output.Certificate.NotAfter
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// DomainNameResource wraps an API Gateway custom domain name
+type DomainNameResource struct {
+	dao.BaseResource
+	Item         types.DomainName
+	Mappings     []types.ApiMapping
+	CertNotAfter *time.Time
+}
+
+// NewDomainNameResource creates a new DomainNameResource
+func NewDomainNameResource(dn types.DomainName) *DomainNameResource {
+	return &DomainNameResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(dn.DomainName),
+			Name: appaws.Str(dn.DomainName),
+			ARN:  appaws.Str(dn.DomainNameArn),
+			Tags: dn.Tags,
+			Data: dn,
+		},
+		Item: dn,
+	}
+}
+
+// config returns the domain's endpoint configuration. API Gateway allows
+// only one per domain name.
+func (r *DomainNameResource) config() *types.DomainNameConfiguration {
+	if len(r.Item.DomainNameConfigurations) == 0 {
+		return nil
+	}
+	return &r.Item.DomainNameConfigurations[0]
+}
+
+// EndpointType returns REGIONAL or EDGE.
+func (r *DomainNameResource) EndpointType() string {
+	if c := r.config(); c != nil {
+		return string(c.EndpointType)
+	}
+	return ""
+}
+
+// Status returns AVAILABLE, UPDATING, PENDING_CERTIFICATE_REIMPORT or
+// PENDING_OWNERSHIP_VERIFICATION.
+func (r *DomainNameResource) Status() string {
+	if c := r.config(); c != nil {
+		return string(c.DomainNameStatus)
+	}
+	return ""
+}
+
+// StatusMessage returns the reason for a non-available status.
+func (r *DomainNameResource) StatusMessage() string {
+	if c := r.config(); c != nil {
+		return appaws.Str(c.DomainNameStatusMessage)
+	}
+	return ""
+}
+
+// Target returns the API Gateway hostname DNS records should point at.
+func (r *DomainNameResource) Target() string {
+	if c := r.config(); c != nil {
+		return appaws.Str(c.ApiGatewayDomainName)
+	}
+	return ""
+}
+
+// HostedZoneId returns the hosted zone ID for Route 53 alias records.
+func (r *DomainNameResource) HostedZoneId() string {
+	if c := r.config(); c != nil {
+		return appaws.Str(c.HostedZoneId)
+	}
+	return ""
+}
+
+// CertificateArn returns the ACM certificate serving the domain.
+func (r *DomainNameResource) CertificateArn() string {
+	if c := r.config(); c != nil {
+		return appaws.Str(c.CertificateArn)
+	}
+	return ""
+}
+
+// SecurityPolicy returns the TLS security policy, e.g. TLS_1_2.
+func (r *DomainNameResource) SecurityPolicy() string {
+	if c := r.config(); c != nil {
+		return string(c.SecurityPolicy)
+	}
+	return ""
+}
+
+// CertExpiresIn returns the time left on the certificate relative to now,
+// and false when the expiry is unknown.
+func (r *DomainNameResource) CertExpiresIn(now time.Time) (time.Duration, bool) {
+	if r.CertNotAfter == nil {
+		return 0, false
+	}
+	return r.CertNotAfter.Sub(now), true
+}
+
+// CertExpiringSoon reports whether the certificate expires within 30 days
+// (or already has).
+func (r *DomainNameResource) CertExpiringSoon(now time.Time) bool {
+	left, ok := r.CertExpiresIn(now)
+	return ok && left < expiryWarning
+}
@@ -0,0 +1,20 @@
+package domainnames
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("apigateway", "domain-names", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewDomainNameDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewDomainNameRenderer()
+		},
+	})
+}
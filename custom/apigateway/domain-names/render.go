@@ -0,0 +1,192 @@
+package domainnames
+
+import (
+	"fmt"
+	"time"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// DomainNameRenderer renders API Gateway custom domain names
+type DomainNameRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDomainNameRenderer creates a new DomainNameRenderer
+func NewDomainNameRenderer() render.Renderer {
+	return &DomainNameRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "apigateway",
+			Resource: "domain-names",
+			Cols: []render.Column{
+				{Name: "DOMAIN", Width: 36, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ENDPOINT", Width: 10, Getter: getEndpointType},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "CERT EXPIRES", Width: 18, Getter: getCertExpiry},
+				{Name: "TLS", Width: 9, Getter: getSecurityPolicy},
+				{Name: "TARGET", Width: 50, Getter: getTarget},
+			},
+		},
+	}
+}
+
+func getEndpointType(r dao.Resource) string {
+	dn, ok := r.(*DomainNameResource)
+	if !ok {
+		return ""
+	}
+	return dn.EndpointType()
+}
+
+func getStatus(r dao.Resource) string {
+	dn, ok := r.(*DomainNameResource)
+	if !ok {
+		return ""
+	}
+	return dn.Status()
+}
+
+func getCertExpiry(r dao.Resource) string {
+	dn, ok := r.(*DomainNameResource)
+	if !ok {
+		return ""
+	}
+	return certExpiry(dn, time.Now())
+}
+
+func getSecurityPolicy(r dao.Resource) string {
+	dn, ok := r.(*DomainNameResource)
+	if !ok {
+		return ""
+	}
+	return dn.SecurityPolicy()
+}
+
+func getTarget(r dao.Resource) string {
+	dn, ok := r.(*DomainNameResource)
+	if !ok {
+		return ""
+	}
+	return dn.Target()
+}
+
+// certExpiry formats the certificate expiry as "2026-01-31 (45d)", or
+// "EXPIRED" once past.
+func certExpiry(dn *DomainNameResource, now time.Time) string {
+	left, ok := dn.CertExpiresIn(now)
+	if !ok {
+		return "-"
+	}
+	if left < 0 {
+		return "EXPIRED"
+	}
+	return fmt.Sprintf("%s (%dd)", dn.CertNotAfter.Format("2006-01-02"), int(left.Hours()/24))
+}
+
+func certExpiryStyle(dn *DomainNameResource, now time.Time) render.Style {
+	left, ok := dn.CertExpiresIn(now)
+	switch {
+	case !ok:
+		return ui.DimStyle()
+	case left < 0:
+		return ui.DangerStyle()
+	case dn.CertExpiringSoon(now):
+		return ui.WarningStyle()
+	default:
+		return ui.SuccessStyle()
+	}
+}
+
+func statusStyle(status string) render.Style {
+	switch status {
+	case "AVAILABLE":
+		return ui.SuccessStyle()
+	case "UPDATING":
+		return ui.WarningStyle()
+	case "PENDING_CERTIFICATE_REIMPORT", "PENDING_OWNERSHIP_VERIFICATION":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a custom domain name
+func (r *DomainNameRenderer) RenderDetail(resource dao.Resource) string {
+	dn, ok := resource.(*DomainNameResource)
+	if !ok {
+		return ""
+	}
+
+	now := time.Now()
+	d := render.NewDetailBuilder()
+
+	d.Title("API Gateway Domain Name", dn.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Domain Name", dn.GetName())
+	if arn := dn.GetARN(); arn != "" {
+		d.Field("ARN", arn)
+	}
+	d.Field("Endpoint Type", dn.EndpointType())
+	d.FieldStyled("Status", dn.Status(), statusStyle(dn.Status()))
+	if msg := dn.StatusMessage(); msg != "" {
+		d.Field("Status Message", msg)
+	}
+
+	d.Section("DNS")
+	d.Field("Target", dn.Target())
+	if zone := dn.HostedZoneId(); zone != "" {
+		d.Field("Hosted Zone ID", zone)
+	}
+
+	d.Section("Certificate")
+	d.Field("Certificate ARN", dn.CertificateArn())
+	if c := dn.config(); c != nil {
+		d.FieldIf("Certificate Name", c.CertificateName)
+		if c.CertificateUploadDate != nil {
+			d.Field("Uploaded", c.CertificateUploadDate.Format("2006-01-02 15:04:05"))
+		}
+	}
+	d.FieldStyled("Expires", certExpiry(dn, now), certExpiryStyle(dn, now))
+	d.Field("Security Policy", dn.SecurityPolicy())
+
+	if mtls := dn.Item.MutualTlsAuthentication; mtls != nil && mtls.TruststoreUri != nil {
+		d.Section("Mutual TLS")
+		d.Field("Truststore URI", appaws.Str(mtls.TruststoreUri))
+		d.FieldIf("Truststore Version", mtls.TruststoreVersion)
+		for _, w := range mtls.TruststoreWarnings {
+			d.FieldStyled("Warning", w, ui.WarningStyle())
+		}
+	}
+
+	if len(dn.Mappings) > 0 {
+		d.Section("API Mappings")
+		for _, m := range dn.Mappings {
+			path := "/" + appaws.Str(m.ApiMappingKey)
+			d.Field(path, fmt.Sprintf("%s (stage %s)", appaws.Str(m.ApiId), appaws.Str(m.Stage)))
+		}
+	}
+
+	d.Tags(dn.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a custom domain name
+func (r *DomainNameRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	dn, ok := resource.(*DomainNameResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	now := time.Now()
+	return []render.SummaryField{
+		{Label: "Domain", Value: dn.GetName()},
+		{Label: "Endpoint", Value: dn.EndpointType()},
+		{Label: "Status", Value: dn.Status(), Style: statusStyle(dn.Status())},
+		{Label: "Cert Expires", Value: certExpiry(dn, now), Style: certExpiryStyle(dn, now)},
+	}
+}
@@ -0,0 +1,43 @@
+package domainnames
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+)
+
+func TestCertExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	dn := NewDomainNameResource(types.DomainName{
+		DomainName: aws.String("api.example.com"),
+		DomainNameConfigurations: []types.DomainNameConfiguration{{
+			CertificateArn: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+			EndpointType:   types.EndpointTypeEdge,
+		}},
+	})
+
+	if got := certExpiry(dn, now); got != "-" {
+		t.Errorf("unknown expiry = %q", got)
+	}
+
+	tests := []struct {
+		notAfter time.Time
+		want     string
+		soon     bool
+	}{
+		{now.Add(90 * 24 * time.Hour), "2026-04-01 (90d)", false},
+		{now.Add(10 * 24 * time.Hour), "2026-01-11 (10d)", true},
+		{now.Add(-time.Hour), "EXPIRED", true},
+	}
+	for _, tt := range tests {
+		dn.CertNotAfter = &tt.notAfter
+		if got := certExpiry(dn, now); got != tt.want {
+			t.Errorf("certExpiry(%v) = %q, want %q", tt.notAfter, got, tt.want)
+		}
+		if got := dn.CertExpiringSoon(now); got != tt.soon {
+			t.Errorf("CertExpiringSoon(%v) = %v, want %v", tt.notAfter, got, tt.soon)
+		}
+	}
+}
@@ -20,13 +20,13 @@ type HttpAPIDAO struct {
 
 // NewHttpAPIDAO creates a new HttpAPIDAO
 func NewHttpAPIDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, apigatewayv2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &HttpAPIDAO{
 		BaseDAO: dao.NewBaseDAO("apigateway", "http-apis"),
-		client:  apigatewayv2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
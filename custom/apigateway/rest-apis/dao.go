@@ -20,13 +20,13 @@ type RestAPIDAO struct {
 
 // NewRestAPIDAO creates a new RestAPIDAO
 func NewRestAPIDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, apigateway.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RestAPIDAO{
 		BaseDAO: dao.NewBaseDAO("apigateway", "rest-apis"),
-		client:  apigateway.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type StageV2DAO struct {
 
 // NewStageV2DAO creates a new StageV2DAO
 func NewStageV2DAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, apigatewayv2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &StageV2DAO{
 		BaseDAO: dao.NewBaseDAO("apigateway", "stages-v2"),
-		client:  apigatewayv2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
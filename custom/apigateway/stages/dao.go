@@ -21,13 +21,13 @@ type StageDAO struct {
 
 // NewStageDAO creates a new StageDAO
 func NewStageDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, apigateway.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &StageDAO{
 		BaseDAO: dao.NewBaseDAO("apigateway", "stages"),
-		client:  apigateway.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,95 @@
+package applications
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+
+	acClient "github.com/clawscli/claws/custom/appconfig"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ApplicationDAO provides data access for AppConfig applications
+type ApplicationDAO struct {
+	dao.BaseDAO
+	client *appconfig.Client
+}
+
+// NewApplicationDAO creates a new ApplicationDAO
+func NewApplicationDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := acClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ApplicationDAO{
+		BaseDAO: dao.NewBaseDAO("appconfig", "applications"),
+		client:  client,
+	}, nil
+}
+
+// List returns all AppConfig applications
+func (d *ApplicationDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	apps, err := acClient.ListApplications(ctx, d.client)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(apps))
+	for i, app := range apps {
+		resources[i] = NewApplicationResource(app)
+	}
+	return resources, nil
+}
+
+// Get returns a specific application
+func (d *ApplicationDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetApplication(ctx, &appconfig.GetApplicationInput{
+		ApplicationId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get appconfig application %s", id)
+	}
+	return NewApplicationResource(types.Application{
+		Id:          output.Id,
+		Name:        output.Name,
+		Description: output.Description,
+	}), nil
+}
+
+// Delete deletes an application. AppConfig rejects this while the
+// application still has environments or configuration profiles.
+func (d *ApplicationDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteApplication(ctx, &appconfig.DeleteApplicationInput{
+		ApplicationId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete appconfig application %s", id)
+	}
+	return nil
+}
+
+// ApplicationResource wraps an AppConfig application
+type ApplicationResource struct {
+	dao.BaseResource
+	Item types.Application
+}
+
+// NewApplicationResource creates a new ApplicationResource
+func NewApplicationResource(app types.Application) *ApplicationResource {
+	return &ApplicationResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(app.Id),
+			Name: appaws.Str(app.Name),
+			Data: app,
+		},
+		Item: app,
+	}
+}
+
+// Description returns the application description
+func (r *ApplicationResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
@@ -0,0 +1,95 @@
+package applications
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ApplicationRenderer renders AppConfig applications
+type ApplicationRenderer struct {
+	render.BaseRenderer
+}
+
+// NewApplicationRenderer creates a new ApplicationRenderer
+func NewApplicationRenderer() render.Renderer {
+	return &ApplicationRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "appconfig",
+			Resource: "applications",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ID", Width: 10, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "DESCRIPTION", Width: 60, Getter: getDescription},
+			},
+		},
+	}
+}
+
+func getDescription(r dao.Resource) string {
+	app, ok := r.(*ApplicationResource)
+	if !ok {
+		return ""
+	}
+	return app.Description()
+}
+
+// RenderDetail renders the detail view for an application
+func (r *ApplicationRenderer) RenderDetail(resource dao.Resource) string {
+	app, ok := resource.(*ApplicationResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("AppConfig Application", app.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", app.GetName())
+	d.Field("ID", app.GetID())
+	if desc := app.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an application
+func (r *ApplicationRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	app, ok := resource.(*ApplicationResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: app.GetName()},
+		{Label: "ID", Value: app.GetID()},
+		{Label: "Description", Value: app.Description()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *ApplicationRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	app, ok := resource.(*ApplicationResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "e",
+			Label:       "Environments",
+			Service:     "appconfig",
+			Resource:    "environments",
+			FilterField: "ApplicationId",
+			FilterValue: app.GetID(),
+		},
+		{
+			Key:         "p",
+			Label:       "Profiles",
+			Service:     "appconfig",
+			Resource:    "configuration-profiles",
+			FilterField: "ApplicationId",
+			FilterValue: app.GetID(),
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package appconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// GetClient returns an AppConfig client configured for the current context
+func GetClient(ctx context.Context) (*appconfig.Client, error) {
+	return appaws.Client(ctx, appconfig.NewFromConfig)
+}
+
+// ListApplications returns all AppConfig applications in the region.
+func ListApplications(ctx context.Context, client *appconfig.Client) ([]types.Application, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.Application, *string, error) {
+		output, err := client.ListApplications(ctx, &appconfig.ListApplicationsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list appconfig applications")
+		}
+		return output.Items, output.NextToken, nil
+	})
+}
+
+// ListEnvironments returns the environments of an application.
+func ListEnvironments(ctx context.Context, client *appconfig.Client, appID string) ([]types.Environment, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.Environment, *string, error) {
+		output, err := client.ListEnvironments(ctx, &appconfig.ListEnvironmentsInput{
+			ApplicationId: &appID,
+			NextToken:     token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list environments of %s", appID)
+		}
+		return output.Items, output.NextToken, nil
+	})
+}
+
+// ListConfigurationProfiles returns the configuration profiles of an application.
+func ListConfigurationProfiles(ctx context.Context, client *appconfig.Client, appID string) ([]types.ConfigurationProfileSummary, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.ConfigurationProfileSummary, *string, error) {
+		output, err := client.ListConfigurationProfiles(ctx, &appconfig.ListConfigurationProfilesInput{
+			ApplicationId: &appID,
+			NextToken:     token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list configuration profiles of %s", appID)
+		}
+		return output.Items, output.NextToken, nil
+	})
+}
+
+// FindEnvironmentApplication returns the ID of the application owning envID.
+// Navigation carries only the environment ID, so callers use this when no
+// ApplicationId filter is present and cache the answer.
+func FindEnvironmentApplication(ctx context.Context, client *appconfig.Client, envID string) (string, error) {
+	return findApplication(ctx, client, "environment", envID, func(appID string) (bool, error) {
+		envs, err := ListEnvironments(ctx, client, appID)
+		if err != nil {
+			return false, err
+		}
+		for _, env := range envs {
+			if appaws.Str(env.Id) == envID {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+// FindProfileApplication returns the ID of the application owning the
+// configuration profile profileID.
+func FindProfileApplication(ctx context.Context, client *appconfig.Client, profileID string) (string, error) {
+	return findApplication(ctx, client, "configuration profile", profileID, func(appID string) (bool, error) {
+		profiles, err := ListConfigurationProfiles(ctx, client, appID)
+		if err != nil {
+			return false, err
+		}
+		for _, p := range profiles {
+			if appaws.Str(p.Id) == profileID {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+func findApplication(ctx context.Context, client *appconfig.Client, kind, id string, owns func(appID string) (bool, error)) (string, error) {
+	apps, err := ListApplications(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	for _, app := range apps {
+		appID := appaws.Str(app.Id)
+		ok, err := owns(appID)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return appID, nil
+		}
+	}
+	return "", fmt.Errorf("no application owns %s %s", kind, id)
+}
@@ -0,0 +1,168 @@
+package configurationprofiles
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+
+	acClient "github.com/clawscli/claws/custom/appconfig"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ConfigurationProfileDAO provides data access for AppConfig configuration profiles
+type ConfigurationProfileDAO struct {
+	dao.BaseDAO
+	client *appconfig.Client
+}
+
+// NewConfigurationProfileDAO creates a new ConfigurationProfileDAO
+func NewConfigurationProfileDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := acClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ConfigurationProfileDAO{
+		BaseDAO: dao.NewBaseDAO("appconfig", "configuration-profiles"),
+		client:  client,
+	}, nil
+}
+
+// List returns the configuration profiles of an application (requires ApplicationId filter)
+func (d *ConfigurationProfileDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	appID := dao.GetFilterFromContext(ctx, "ApplicationId")
+	if appID == "" {
+		return nil, fmt.Errorf("ApplicationId filter required - navigate from an application")
+	}
+
+	profiles, err := acClient.ListConfigurationProfiles(ctx, d.client, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(profiles))
+	for i, p := range profiles {
+		resources[i] = NewConfigurationProfileResource(p)
+	}
+	return resources, nil
+}
+
+// Get returns a configuration profile with its validators
+func (d *ConfigurationProfileDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	appID := dao.GetFilterFromContext(ctx, "ApplicationId")
+	if appID == "" {
+		return nil, fmt.Errorf("ApplicationId filter required - navigate from an application")
+	}
+
+	output, err := d.client.GetConfigurationProfile(ctx, &appconfig.GetConfigurationProfileInput{
+		ApplicationId:          &appID,
+		ConfigurationProfileId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get configuration profile %s", id)
+	}
+	return NewConfigurationProfileResourceFromDetail(output), nil
+}
+
+// Delete deletes a configuration profile. AppConfig rejects this while the
+// profile still has hosted configuration versions.
+func (d *ConfigurationProfileDAO) Delete(ctx context.Context, id string) error {
+	appID := dao.GetFilterFromContext(ctx, "ApplicationId")
+	if appID == "" {
+		return fmt.Errorf("ApplicationId filter required - navigate from an application")
+	}
+
+	_, err := d.client.DeleteConfigurationProfile(ctx, &appconfig.DeleteConfigurationProfileInput{
+		ApplicationId:          &appID,
+		ConfigurationProfileId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete configuration profile %s", id)
+	}
+	return nil
+}
+
+// ConfigurationProfileResource wraps an AppConfig configuration profile
+type ConfigurationProfileResource struct {
+	dao.BaseResource
+	Summary *types.ConfigurationProfileSummary
+	Detail  *appconfig.GetConfigurationProfileOutput
+}
+
+// NewConfigurationProfileResource creates a new ConfigurationProfileResource from summary
+func NewConfigurationProfileResource(p types.ConfigurationProfileSummary) *ConfigurationProfileResource {
+	return &ConfigurationProfileResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(p.Id),
+			Name: appaws.Str(p.Name),
+			Data: p,
+		},
+		Summary: &p,
+	}
+}
+
+// NewConfigurationProfileResourceFromDetail creates a new ConfigurationProfileResource from detail
+func NewConfigurationProfileResourceFromDetail(output *appconfig.GetConfigurationProfileOutput) *ConfigurationProfileResource {
+	return &ConfigurationProfileResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(output.Id),
+			Name: appaws.Str(output.Name),
+			Data: output,
+		},
+		Detail: output,
+	}
+}
+
+// ApplicationID returns the ID of the owning application
+func (r *ConfigurationProfileResource) ApplicationID() string {
+	if r.Detail != nil {
+		return appaws.Str(r.Detail.ApplicationId)
+	}
+	return appaws.Str(r.Summary.ApplicationId)
+}
+
+// Type returns the profile type, AWS.Freeform or AWS.AppConfig.FeatureFlags
+func (r *ConfigurationProfileResource) Type() string {
+	if r.Detail != nil {
+		return appaws.Str(r.Detail.Type)
+	}
+	return appaws.Str(r.Summary.Type)
+}
+
+// IsFeatureFlags reports whether the profile holds feature flags.
+func (r *ConfigurationProfileResource) IsFeatureFlags() bool {
+	return r.Type() == "AWS.AppConfig.FeatureFlags"
+}
+
+// LocationURI returns where the configuration is stored, "hosted" for the
+// AppConfig hosted store.
+func (r *ConfigurationProfileResource) LocationURI() string {
+	if r.Detail != nil {
+		return appaws.Str(r.Detail.LocationUri)
+	}
+	return appaws.Str(r.Summary.LocationUri)
+}
+
+// IsHosted reports whether versions live in the AppConfig hosted store.
+func (r *ConfigurationProfileResource) IsHosted() bool {
+	return r.LocationURI() == "hosted"
+}
+
+// ValidatorTypes returns the validator types, e.g. "JSON_SCHEMA, LAMBDA".
+func (r *ConfigurationProfileResource) ValidatorTypes() string {
+	var vts []string
+	if r.Detail != nil {
+		for _, v := range r.Detail.Validators {
+			vts = append(vts, string(v.Type))
+		}
+	} else {
+		for _, v := range r.Summary.ValidatorTypes {
+			vts = append(vts, string(v))
+		}
+	}
+	return strings.Join(vts, ", ")
+}
@@ -0,0 +1,148 @@
+package configurationprofiles
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ConfigurationProfileRenderer renders AppConfig configuration profiles
+type ConfigurationProfileRenderer struct {
+	render.BaseRenderer
+}
+
+// NewConfigurationProfileRenderer creates a new ConfigurationProfileRenderer
+func NewConfigurationProfileRenderer() render.Renderer {
+	return &ConfigurationProfileRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "appconfig",
+			Resource: "configuration-profiles",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ID", Width: 10, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "TYPE", Width: 14, Getter: getType},
+				{Name: "LOCATION", Width: 36, Getter: getLocation},
+				{Name: "VALIDATORS", Width: 20, Getter: getValidators},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	p, ok := r.(*ConfigurationProfileResource)
+	if !ok {
+		return ""
+	}
+	return shortType(p.Type())
+}
+
+func getLocation(r dao.Resource) string {
+	p, ok := r.(*ConfigurationProfileResource)
+	if !ok {
+		return ""
+	}
+	return p.LocationURI()
+}
+
+func getValidators(r dao.Resource) string {
+	p, ok := r.(*ConfigurationProfileResource)
+	if !ok {
+		return ""
+	}
+	return p.ValidatorTypes()
+}
+
+// shortType drops the AWS./AWS.AppConfig. prefixes: "Freeform", "FeatureFlags".
+func shortType(t string) string {
+	t = strings.TrimPrefix(t, "AWS.")
+	return strings.TrimPrefix(t, "AppConfig.")
+}
+
+// prettyJSON formats JSON string with indentation
+func prettyJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String()
+}
+
+// RenderDetail renders the detail view for a configuration profile
+func (r *ConfigurationProfileRenderer) RenderDetail(resource dao.Resource) string {
+	p, ok := resource.(*ConfigurationProfileResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("AppConfig Configuration Profile", p.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", p.GetName())
+	d.Field("ID", p.GetID())
+	d.Field("Application ID", p.ApplicationID())
+	d.Field("Type", p.Type())
+	d.Field("Location", p.LocationURI())
+	if p.Detail != nil {
+		d.FieldIf("Description", p.Detail.Description)
+		d.FieldIf("Retrieval Role", p.Detail.RetrievalRoleArn)
+		d.FieldIf("KMS Key", p.Detail.KmsKeyArn)
+	}
+
+	if p.Detail != nil && len(p.Detail.Validators) > 0 {
+		d.Section("Validators")
+		for _, v := range p.Detail.Validators {
+			content := appaws.Str(v.Content)
+			if v.Type == types.ValidatorTypeLambda {
+				d.Field("Lambda", content)
+				continue
+			}
+			d.Field("JSON Schema", "")
+			d.Line(prettyJSON(content))
+		}
+	} else if vts := p.ValidatorTypes(); vts != "" {
+		d.Section("Validators")
+		d.Field("Types", vts)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a configuration profile
+func (r *ConfigurationProfileRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	p, ok := resource.(*ConfigurationProfileResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: p.GetName()},
+		{Label: "Type", Value: shortType(p.Type())},
+		{Label: "Location", Value: p.LocationURI()},
+	}
+}
+
+// Navigations returns navigation shortcuts; only hosted profiles have versions
+func (r *ConfigurationProfileRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	p, ok := resource.(*ConfigurationProfileResource)
+	if !ok || !p.IsHosted() {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "v",
+			Label:       "Versions",
+			Service:     "appconfig",
+			Resource:    "hosted-versions",
+			FilterField: "ConfigurationProfileId",
+			FilterValue: p.GetID(),
+		},
+	}
+}
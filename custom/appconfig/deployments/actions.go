@@ -0,0 +1,64 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+
+	acClient "github.com/clawscli/claws/custom/appconfig"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("appconfig", "deployments", []action.Action{
+		{
+			Name:      "Stop Deployment",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopDeployment",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				dep, ok := r.(*DeploymentResource)
+				return ok && dep.IsInProgress()
+			},
+		},
+	})
+
+	action.RegisterExecutor("appconfig", "deployments", executeDeploymentAction)
+}
+
+func executeDeploymentAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "StopDeployment":
+		return executeStopDeployment(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// executeStopDeployment stops a deployment; AppConfig rolls the environment
+// back to the previously deployed configuration.
+func executeStopDeployment(ctx context.Context, resource dao.Resource) action.ActionResult {
+	dep, ok := resource.(*DeploymentResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := acClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	number := dep.Number()
+	_, err = client.StopDeployment(ctx, &appconfig.StopDeploymentInput{
+		ApplicationId:    &dep.ApplicationId,
+		EnvironmentId:    &dep.EnvironmentId,
+		DeploymentNumber: &number,
+	})
+	if err != nil {
+		return action.FailResultf(err, "stop deployment %d", number)
+	}
+	return action.SuccessResult(fmt.Sprintf("Stopping deployment %d; rolling back %s", number, dep.ConfigurationName()))
+}
@@ -0,0 +1,271 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+
+	acClient "github.com/clawscli/claws/custom/appconfig"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DeploymentDAO provides data access for AppConfig deployments
+type DeploymentDAO struct {
+	dao.BaseDAO
+	client *appconfig.Client
+
+	// applications caches environment ID -> application ID lookups so
+	// auto-reload does not rescan applications on every refresh.
+	mu           sync.Mutex
+	applications map[string]string
+}
+
+// NewDeploymentDAO creates a new DeploymentDAO
+func NewDeploymentDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := acClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DeploymentDAO{
+		BaseDAO:      dao.NewBaseDAO("appconfig", "deployments"),
+		client:       client,
+		applications: make(map[string]string),
+	}, nil
+}
+
+// List returns the deployments to an environment, newest first (requires EnvironmentId filter)
+func (d *DeploymentDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	appID, envID, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments, err := appaws.Paginate(ctx, func(token *string) ([]types.DeploymentSummary, *string, error) {
+		output, err := d.client.ListDeployments(ctx, &appconfig.ListDeploymentsInput{
+			ApplicationId: &appID,
+			EnvironmentId: &envID,
+			NextToken:     token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list deployments of environment %s", envID)
+		}
+		return output.Items, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(deployments))
+	for i, dep := range deployments {
+		resources[i] = NewDeploymentResource(dep, appID, envID)
+	}
+	return resources, nil
+}
+
+// Get returns a deployment with its event log
+func (d *DeploymentDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	appID, envID, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+	number, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deployment number %q", id)
+	}
+
+	output, err := d.client.GetDeployment(ctx, &appconfig.GetDeploymentInput{
+		ApplicationId:    &appID,
+		EnvironmentId:    &envID,
+		DeploymentNumber: appaws.Int32Ptr(int32(number)),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get deployment %s", id)
+	}
+	return NewDeploymentResourceFromDetail(output), nil
+}
+
+// Delete is not supported; use the stop action for deployments in progress.
+func (d *DeploymentDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for appconfig deployments - use stop action")
+}
+
+// Supports returns supported operations
+func (d *DeploymentDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// target returns the application and environment for the current filter.
+// Navigation carries only the environment ID, so the owning application is
+// taken from the ApplicationId filter when present and looked up otherwise.
+func (d *DeploymentDAO) target(ctx context.Context) (appID, envID string, err error) {
+	envID = dao.GetFilterFromContext(ctx, "EnvironmentId")
+	if envID == "" {
+		return "", "", fmt.Errorf("EnvironmentId filter required - navigate from an environment")
+	}
+	if appID = dao.GetFilterFromContext(ctx, "ApplicationId"); appID != "" {
+		return appID, envID, nil
+	}
+
+	d.mu.Lock()
+	appID = d.applications[envID]
+	d.mu.Unlock()
+	if appID != "" {
+		return appID, envID, nil
+	}
+
+	appID, err = acClient.FindEnvironmentApplication(ctx, d.client, envID)
+	if err != nil {
+		return "", "", err
+	}
+	d.mu.Lock()
+	d.applications[envID] = appID
+	d.mu.Unlock()
+	return appID, envID, nil
+}
+
+// DeploymentResource wraps an AppConfig deployment
+type DeploymentResource struct {
+	dao.BaseResource
+	Summary       *types.DeploymentSummary
+	Detail        *appconfig.GetDeploymentOutput
+	ApplicationId string
+	EnvironmentId string
+}
+
+// NewDeploymentResource creates a new DeploymentResource from summary
+func NewDeploymentResource(dep types.DeploymentSummary, appID, envID string) *DeploymentResource {
+	return &DeploymentResource{
+		BaseResource: dao.BaseResource{
+			ID:   strconv.Itoa(int(dep.DeploymentNumber)),
+			Name: fmt.Sprintf("#%d", dep.DeploymentNumber),
+			Data: dep,
+		},
+		Summary:       &dep,
+		ApplicationId: appID,
+		EnvironmentId: envID,
+	}
+}
+
+// NewDeploymentResourceFromDetail creates a new DeploymentResource from detail
+func NewDeploymentResourceFromDetail(output *appconfig.GetDeploymentOutput) *DeploymentResource {
+	return &DeploymentResource{
+		BaseResource: dao.BaseResource{
+			ID:   strconv.Itoa(int(output.DeploymentNumber)),
+			Name: fmt.Sprintf("#%d", output.DeploymentNumber),
+			Data: output,
+		},
+		Detail:        output,
+		ApplicationId: appaws.Str(output.ApplicationId),
+		EnvironmentId: appaws.Str(output.EnvironmentId),
+	}
+}
+
+// Number returns the deployment number
+func (r *DeploymentResource) Number() int32 {
+	if r.Detail != nil {
+		return r.Detail.DeploymentNumber
+	}
+	return r.Summary.DeploymentNumber
+}
+
+// State returns the deployment state
+func (r *DeploymentResource) State() types.DeploymentState {
+	if r.Detail != nil {
+		return r.Detail.State
+	}
+	return r.Summary.State
+}
+
+// IsInProgress reports whether the deployment can still be stopped.
+func (r *DeploymentResource) IsInProgress() bool {
+	switch r.State() {
+	case types.DeploymentStateBaking, types.DeploymentStateValidating, types.DeploymentStateDeploying:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTransitioning reports whether the deployment state will change on its own.
+func (r *DeploymentResource) IsTransitioning() bool {
+	return r.IsInProgress() || r.State() == types.DeploymentStateRollingBack
+}
+
+// ConfigurationName returns the name of the deployed configuration profile
+func (r *DeploymentResource) ConfigurationName() string {
+	if r.Detail != nil {
+		return appaws.Str(r.Detail.ConfigurationName)
+	}
+	return appaws.Str(r.Summary.ConfigurationName)
+}
+
+// ConfigurationVersion returns the deployed version, with its label if set,
+// e.g. "7 (release-42)".
+func (r *DeploymentResource) ConfigurationVersion() string {
+	var version, label *string
+	if r.Detail != nil {
+		version, label = r.Detail.ConfigurationVersion, r.Detail.VersionLabel
+	} else {
+		version, label = r.Summary.ConfigurationVersion, r.Summary.VersionLabel
+	}
+	if l := appaws.Str(label); l != "" {
+		return fmt.Sprintf("%s (%s)", appaws.Str(version), l)
+	}
+	return appaws.Str(version)
+}
+
+// PercentageComplete returns how much of the rollout is done
+func (r *DeploymentResource) PercentageComplete() float32 {
+	if r.Detail != nil && r.Detail.PercentageComplete != nil {
+		return *r.Detail.PercentageComplete
+	}
+	if r.Summary != nil && r.Summary.PercentageComplete != nil {
+		return *r.Summary.PercentageComplete
+	}
+	return 0
+}
+
+// Strategy describes the rollout, e.g. "LINEAR 20% over 10m, bake 10m".
+func (r *DeploymentResource) Strategy() string {
+	var growthType types.GrowthType
+	var factor *float32
+	var duration, bake int32
+	if r.Detail != nil {
+		growthType, factor, duration, bake = r.Detail.GrowthType, r.Detail.GrowthFactor, r.Detail.DeploymentDurationInMinutes, r.Detail.FinalBakeTimeInMinutes
+	} else {
+		growthType, factor, duration, bake = r.Summary.GrowthType, r.Summary.GrowthFactor, r.Summary.DeploymentDurationInMinutes, r.Summary.FinalBakeTimeInMinutes
+	}
+	s := string(growthType)
+	if factor != nil {
+		s += fmt.Sprintf(" %.0f%%", *factor)
+	}
+	return fmt.Sprintf("%s over %dm, bake %dm", s, duration, bake)
+}
+
+// StartedAt returns when the deployment started
+func (r *DeploymentResource) StartedAt() *time.Time {
+	if r.Detail != nil {
+		return r.Detail.StartedAt
+	}
+	return r.Summary.StartedAt
+}
+
+// CompletedAt returns when the deployment finished, or nil while in progress
+func (r *DeploymentResource) CompletedAt() *time.Time {
+	if r.Detail != nil {
+		return r.Detail.CompletedAt
+	}
+	return r.Summary.CompletedAt
+}
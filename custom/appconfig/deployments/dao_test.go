@@ -0,0 +1,63 @@
+package deployments
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+)
+
+func TestNewDeploymentResource(t *testing.T) {
+	dep := NewDeploymentResource(types.DeploymentSummary{
+		DeploymentNumber:            12,
+		ConfigurationName:           aws.String("feature-flags"),
+		ConfigurationVersion:        aws.String("7"),
+		VersionLabel:                aws.String("release-42"),
+		State:                       types.DeploymentStateDeploying,
+		GrowthType:                  types.GrowthTypeLinear,
+		GrowthFactor:                aws.Float32(20),
+		DeploymentDurationInMinutes: 10,
+		FinalBakeTimeInMinutes:      5,
+		PercentageComplete:          aws.Float32(40),
+	}, "app1234", "env5678")
+
+	if dep.GetID() != "12" || dep.GetName() != "#12" {
+		t.Errorf("ID/Name = %q/%q, want 12/#12", dep.GetID(), dep.GetName())
+	}
+	if dep.ApplicationId != "app1234" || dep.EnvironmentId != "env5678" {
+		t.Errorf("parent IDs = %q/%q", dep.ApplicationId, dep.EnvironmentId)
+	}
+	if got := dep.ConfigurationVersion(); got != "7 (release-42)" {
+		t.Errorf("ConfigurationVersion() = %q", got)
+	}
+	if got := dep.Strategy(); got != "LINEAR 20% over 10m, bake 5m" {
+		t.Errorf("Strategy() = %q", got)
+	}
+	if dep.PercentageComplete() != 40 {
+		t.Errorf("PercentageComplete() = %v, want 40", dep.PercentageComplete())
+	}
+}
+
+func TestDeploymentResource_States(t *testing.T) {
+	tests := []struct {
+		state         types.DeploymentState
+		inProgress    bool
+		transitioning bool
+	}{
+		{types.DeploymentStateDeploying, true, true},
+		{types.DeploymentStateBaking, true, true},
+		{types.DeploymentStateValidating, true, true},
+		{types.DeploymentStateRollingBack, false, true},
+		{types.DeploymentStateComplete, false, false},
+		{types.DeploymentStateRolledBack, false, false},
+	}
+	for _, tt := range tests {
+		dep := NewDeploymentResource(types.DeploymentSummary{State: tt.state}, "a", "e")
+		if got := dep.IsInProgress(); got != tt.inProgress {
+			t.Errorf("%s: IsInProgress() = %v, want %v", tt.state, got, tt.inProgress)
+		}
+		if got := dep.IsTransitioning(); got != tt.transitioning {
+			t.Errorf("%s: IsTransitioning() = %v, want %v", tt.state, got, tt.transitioning)
+		}
+	}
+}
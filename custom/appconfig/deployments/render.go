@@ -0,0 +1,164 @@
+package deployments
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// DeploymentRenderer renders AppConfig deployments
+type DeploymentRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDeploymentRenderer creates a new DeploymentRenderer
+func NewDeploymentRenderer() render.Renderer {
+	return &DeploymentRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "appconfig",
+			Resource: "deployments",
+			Cols: []render.Column{
+				{Name: "#", Width: 6, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "CONFIGURATION", Width: 28, Getter: getConfiguration},
+				{Name: "VERSION", Width: 20, Getter: getVersion},
+				{Name: "STATE", Width: 14, Getter: getState},
+				{Name: "PROGRESS", Width: 9, Getter: getProgress},
+				{Name: "STARTED", Width: 18, Getter: getStarted},
+			},
+		},
+	}
+}
+
+func getConfiguration(r dao.Resource) string {
+	dep, ok := r.(*DeploymentResource)
+	if !ok {
+		return ""
+	}
+	return dep.ConfigurationName()
+}
+
+func getVersion(r dao.Resource) string {
+	dep, ok := r.(*DeploymentResource)
+	if !ok {
+		return ""
+	}
+	return dep.ConfigurationVersion()
+}
+
+func getState(r dao.Resource) string {
+	dep, ok := r.(*DeploymentResource)
+	if !ok {
+		return ""
+	}
+	return string(dep.State())
+}
+
+func getProgress(r dao.Resource) string {
+	dep, ok := r.(*DeploymentResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%.0f%%", dep.PercentageComplete())
+}
+
+func getStarted(r dao.Resource) string {
+	dep, ok := r.(*DeploymentResource)
+	if !ok {
+		return ""
+	}
+	if t := dep.StartedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func stateStyle(state types.DeploymentState) render.Style {
+	switch state {
+	case types.DeploymentStateComplete:
+		return ui.SuccessStyle()
+	case types.DeploymentStateBaking, types.DeploymentStateValidating, types.DeploymentStateDeploying, types.DeploymentStateRollingBack:
+		return ui.WarningStyle()
+	case types.DeploymentStateRolledBack, types.DeploymentStateReverted:
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a deployment
+func (r *DeploymentRenderer) RenderDetail(resource dao.Resource) string {
+	dep, ok := resource.(*DeploymentResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("AppConfig Deployment", dep.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Deployment", dep.GetID())
+	d.FieldStyled("State", string(dep.State()), stateStyle(dep.State()))
+	d.Field("Progress", fmt.Sprintf("%.0f%%", dep.PercentageComplete()))
+	d.Field("Configuration", dep.ConfigurationName())
+	d.Field("Version", dep.ConfigurationVersion())
+	d.Field("Strategy", dep.Strategy())
+	d.Field("Application ID", dep.ApplicationId)
+	d.Field("Environment ID", dep.EnvironmentId)
+	if dep.Detail != nil {
+		d.FieldIf("Configuration Profile ID", dep.Detail.ConfigurationProfileId)
+		d.FieldIf("Strategy ID", dep.Detail.DeploymentStrategyId)
+		d.FieldIf("Description", dep.Detail.Description)
+	}
+
+	d.Section("Timestamps")
+	if t := dep.StartedAt(); t != nil {
+		d.Field("Started", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := dep.CompletedAt(); t != nil {
+		d.Field("Completed", t.Format("2006-01-02 15:04:05"))
+	}
+
+	if dep.Detail != nil && len(dep.Detail.EventLog) > 0 {
+		d.Section("Event Log")
+		for _, e := range dep.Detail.EventLog {
+			when := ""
+			if e.OccurredAt != nil {
+				when = e.OccurredAt.Format("2006-01-02 15:04:05")
+			}
+			d.Field(when, fmt.Sprintf("%s (%s) %s", e.EventType, e.TriggeredBy, appaws.Str(e.Description)))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a deployment
+func (r *DeploymentRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	dep, ok := resource.(*DeploymentResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Deployment", Value: dep.GetName()},
+		{Label: "State", Value: string(dep.State()), Style: stateStyle(dep.State())},
+		{Label: "Configuration", Value: dep.ConfigurationName() + " v" + dep.ConfigurationVersion()},
+		{Label: "Strategy", Value: dep.Strategy()},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a deployment rolls out or back
+func (r *DeploymentRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if dep, ok := dao.UnwrapResource(res).(*DeploymentResource); ok && dep.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+
+	acClient "github.com/clawscli/claws/custom/appconfig"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// EnvironmentDAO provides data access for AppConfig environments
+type EnvironmentDAO struct {
+	dao.BaseDAO
+	client *appconfig.Client
+}
+
+// NewEnvironmentDAO creates a new EnvironmentDAO
+func NewEnvironmentDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := acClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EnvironmentDAO{
+		BaseDAO: dao.NewBaseDAO("appconfig", "environments"),
+		client:  client,
+	}, nil
+}
+
+// List returns the environments of an application (requires ApplicationId filter)
+func (d *EnvironmentDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	appID := dao.GetFilterFromContext(ctx, "ApplicationId")
+	if appID == "" {
+		return nil, fmt.Errorf("ApplicationId filter required - navigate from an application")
+	}
+
+	envs, err := acClient.ListEnvironments(ctx, d.client, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(envs))
+	for i, env := range envs {
+		resources[i] = NewEnvironmentResource(env)
+	}
+	return resources, nil
+}
+
+// Get returns a specific environment
+func (d *EnvironmentDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	appID := dao.GetFilterFromContext(ctx, "ApplicationId")
+	if appID == "" {
+		return nil, fmt.Errorf("ApplicationId filter required - navigate from an application")
+	}
+
+	output, err := d.client.GetEnvironment(ctx, &appconfig.GetEnvironmentInput{
+		ApplicationId: &appID,
+		EnvironmentId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get appconfig environment %s", id)
+	}
+	return NewEnvironmentResource(types.Environment{
+		ApplicationId: output.ApplicationId,
+		Id:            output.Id,
+		Name:          output.Name,
+		Description:   output.Description,
+		State:         output.State,
+		Monitors:      output.Monitors,
+	}), nil
+}
+
+// Delete deletes an environment. AppConfig rejects this while a deployment
+// to the environment is in progress.
+func (d *EnvironmentDAO) Delete(ctx context.Context, id string) error {
+	appID := dao.GetFilterFromContext(ctx, "ApplicationId")
+	if appID == "" {
+		return fmt.Errorf("ApplicationId filter required - navigate from an application")
+	}
+
+	_, err := d.client.DeleteEnvironment(ctx, &appconfig.DeleteEnvironmentInput{
+		ApplicationId: &appID,
+		EnvironmentId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete appconfig environment %s", id)
+	}
+	return nil
+}
+
+// EnvironmentResource wraps an AppConfig environment
+type EnvironmentResource struct {
+	dao.BaseResource
+	Item types.Environment
+}
+
+// NewEnvironmentResource creates a new EnvironmentResource
+func NewEnvironmentResource(env types.Environment) *EnvironmentResource {
+	return &EnvironmentResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(env.Id),
+			Name: appaws.Str(env.Name),
+			Data: env,
+		},
+		Item: env,
+	}
+}
+
+// ApplicationID returns the ID of the owning application
+func (r *EnvironmentResource) ApplicationID() string {
+	return appaws.Str(r.Item.ApplicationId)
+}
+
+// State returns the environment state
+func (r *EnvironmentResource) State() string {
+	return string(r.Item.State)
+}
+
+// IsDeploying reports whether a deployment or rollback is under way.
+func (r *EnvironmentResource) IsDeploying() bool {
+	return r.Item.State == types.EnvironmentStateDeploying || r.Item.State == types.EnvironmentStateRollingBack
+}
+
+// Description returns the environment description
+func (r *EnvironmentResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+// AlarmNames returns the names of the CloudWatch alarms monitoring deployments.
+func (r *EnvironmentResource) AlarmNames() []string {
+	names := make([]string, 0, len(r.Item.Monitors))
+	for _, m := range r.Item.Monitors {
+		arn := appaws.Str(m.AlarmArn)
+		if _, name, ok := strings.Cut(arn, ":alarm:"); ok {
+			arn = name
+		}
+		names = append(names, arn)
+	}
+	return names
+}
@@ -0,0 +1,147 @@
+package environments
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// EnvironmentRenderer renders AppConfig environments
+type EnvironmentRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEnvironmentRenderer creates a new EnvironmentRenderer
+func NewEnvironmentRenderer() render.Renderer {
+	return &EnvironmentRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "appconfig",
+			Resource: "environments",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ID", Width: 10, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATE", Width: 22, Getter: getState},
+				{Name: "MONITORS", Width: 10, Getter: getMonitors},
+				{Name: "DESCRIPTION", Width: 40, Getter: getDescription},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	return env.State()
+}
+
+func getMonitors(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", len(env.Item.Monitors))
+}
+
+func getDescription(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	return env.Description()
+}
+
+func stateStyle(state string) render.Style {
+	switch state {
+	case "READY_FOR_DEPLOYMENT":
+		return ui.SuccessStyle()
+	case "DEPLOYING", "ROLLING_BACK":
+		return ui.WarningStyle()
+	case "ROLLED_BACK", "REVERTED":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an environment
+func (r *EnvironmentRenderer) RenderDetail(resource dao.Resource) string {
+	env, ok := resource.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("AppConfig Environment", env.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", env.GetName())
+	d.Field("ID", env.GetID())
+	d.Field("Application ID", env.ApplicationID())
+	d.FieldStyled("State", env.State(), stateStyle(env.State()))
+	if desc := env.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+
+	d.Section("Monitors")
+	if alarms := env.AlarmNames(); len(alarms) > 0 {
+		for _, alarm := range alarms {
+			d.Line("  " + alarm)
+		}
+	} else {
+		d.Dim("  No rollback alarms configured")
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an environment
+func (r *EnvironmentRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	env, ok := resource.(*EnvironmentResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: env.GetName()},
+		{Label: "ID", Value: env.GetID()},
+		{Label: "State", Value: env.State(), Style: stateStyle(env.State())},
+	}
+	if alarms := env.AlarmNames(); len(alarms) > 0 {
+		fields = append(fields, render.SummaryField{Label: "Alarms", Value: strings.Join(alarms, ", ")})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *EnvironmentRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	env, ok := resource.(*EnvironmentResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "o",
+			Label:       "Deployments",
+			Service:     "appconfig",
+			Resource:    "deployments",
+			FilterField: "EnvironmentId",
+			FilterValue: env.GetID(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a deployment is under way
+func (r *EnvironmentRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if env, ok := dao.UnwrapResource(res).(*EnvironmentResource); ok && env.IsDeploying() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package hostedversions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+
+	acClient "github.com/clawscli/claws/custom/appconfig"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// defaultStrategy is the predefined strategy used when none is given.
+const defaultStrategy = "AppConfig.AllAtOnce"
+
+func init() {
+	action.Global.Register("appconfig", "hosted-versions", []action.Action{
+		{
+			Name:      "Start Deployment",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartDeployment",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Environment name or ID, optional deployment strategy (default " + defaultStrategy + ")",
+				Placeholder: "production AppConfig.Linear50PercentEvery30Seconds",
+			},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteHostedConfigurationVersion",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("appconfig", "hosted-versions", executeHostedVersionAction)
+}
+
+func executeHostedVersionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "StartDeployment":
+		return executeStartDeployment(ctx, resource)
+	case "DeleteHostedConfigurationVersion":
+		return executeDeleteVersion(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// parseDeployInput splits "<environment> [strategy]" into its parts.
+func parseDeployInput(input string) (env, strategy string, err error) {
+	fields := strings.Fields(input)
+	switch len(fields) {
+	case 1:
+		return fields[0], defaultStrategy, nil
+	case 2:
+		return fields[0], fields[1], nil
+	default:
+		return "", "", fmt.Errorf("expected \"<environment> [strategy]\", got %q", input)
+	}
+}
+
+// findEnvironment returns the environment whose name or ID is ref.
+func findEnvironment(envs []types.Environment, ref string) *types.Environment {
+	for i, env := range envs {
+		if appaws.Str(env.Id) == ref || appaws.Str(env.Name) == ref {
+			return &envs[i]
+		}
+	}
+	return nil
+}
+
+func executeStartDeployment(ctx context.Context, resource dao.Resource) action.ActionResult {
+	v, ok := resource.(*HostedVersionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	envRef, strategy, err := parseDeployInput(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := acClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	appID := v.ApplicationID()
+	envs, err := acClient.ListEnvironments(ctx, client, appID)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	env := findEnvironment(envs, envRef)
+	if env == nil {
+		return action.FailResult(fmt.Errorf("environment %q not found in application %s", envRef, appID))
+	}
+
+	version := v.GetID()
+	output, err := client.StartDeployment(ctx, &appconfig.StartDeploymentInput{
+		ApplicationId:          &appID,
+		EnvironmentId:          env.Id,
+		ConfigurationProfileId: v.Item.ConfigurationProfileId,
+		ConfigurationVersion:   &version,
+		DeploymentStrategyId:   &strategy,
+	})
+	if err != nil {
+		return action.FailResultf(err, "deploy version %s to %s", version, envRef)
+	}
+	return action.SuccessResult(fmt.Sprintf("Started deployment %d of %s to %s (%s)",
+		output.DeploymentNumber, v.GetName(), appaws.Str(env.Name), strategy))
+}
+
+func executeDeleteVersion(ctx context.Context, resource dao.Resource) action.ActionResult {
+	v, ok := resource.(*HostedVersionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := acClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.DeleteHostedConfigurationVersion(ctx, &appconfig.DeleteHostedConfigurationVersionInput{
+		ApplicationId:          v.Item.ApplicationId,
+		ConfigurationProfileId: v.Item.ConfigurationProfileId,
+		VersionNumber:          appaws.Int32Ptr(v.Number()),
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete hosted configuration version %s", v.GetID())
+	}
+	return action.SuccessResult(fmt.Sprintf("Deleted hosted configuration version %s", v.GetName()))
+}
@@ -0,0 +1,270 @@
+package hostedversions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+	"golang.org/x/sync/errgroup"
+
+	acClient "github.com/clawscli/claws/custom/appconfig"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// contentVersions is how many of the newest versions List loads content
+	// for, so recent versions can be compared in the diff view.
+	contentVersions    = 10
+	contentConcurrency = 4
+)
+
+// HostedVersionDAO provides data access for AppConfig hosted configuration versions
+type HostedVersionDAO struct {
+	dao.BaseDAO
+	client *appconfig.Client
+
+	// applications caches profile ID -> application ID lookups so reloads
+	// do not rescan applications.
+	mu           sync.Mutex
+	applications map[string]string
+}
+
+// NewHostedVersionDAO creates a new HostedVersionDAO
+func NewHostedVersionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := acClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &HostedVersionDAO{
+		BaseDAO:      dao.NewBaseDAO("appconfig", "hosted-versions"),
+		client:       client,
+		applications: make(map[string]string),
+	}, nil
+}
+
+// List returns the versions of a hosted configuration profile, newest first
+// (requires ConfigurationProfileId filter). Content is loaded for the newest
+// versions only; older versions show it after Get.
+func (d *HostedVersionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	appID, profileID, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := appaws.Paginate(ctx, func(token *string) ([]types.HostedConfigurationVersionSummary, *string, error) {
+		output, err := d.client.ListHostedConfigurationVersions(ctx, &appconfig.ListHostedConfigurationVersionsInput{
+			ApplicationId:          &appID,
+			ConfigurationProfileId: &profileID,
+			NextToken:              token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list hosted configuration versions of %s", profileID)
+		}
+		return output.Items, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].VersionNumber > versions[j].VersionNumber
+	})
+
+	resources := make([]*HostedVersionResource, len(versions))
+	for i, v := range versions {
+		resources[i] = NewHostedVersionResource(v)
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(contentConcurrency)
+	for _, r := range resources[:min(len(resources), contentVersions)] {
+		g.Go(func() error {
+			output, err := d.get(gctx, appID, profileID, r.Number())
+			if err != nil {
+				log.Debug("failed to get hosted configuration content", "profile", profileID, "version", r.GetID(), "error", err)
+				return nil
+			}
+			r.Content = output.Content
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	result := make([]dao.Resource, len(resources))
+	for i, r := range resources {
+		result[i] = r
+	}
+	return result, nil
+}
+
+// Get returns a version with its content
+func (d *HostedVersionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	appID, profileID, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+	number, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version number %q", id)
+	}
+
+	output, err := d.get(ctx, appID, profileID, int32(number))
+	if err != nil {
+		return nil, err
+	}
+	return NewHostedVersionResourceFromDetail(output), nil
+}
+
+func (d *HostedVersionDAO) get(ctx context.Context, appID, profileID string, number int32) (*appconfig.GetHostedConfigurationVersionOutput, error) {
+	output, err := d.client.GetHostedConfigurationVersion(ctx, &appconfig.GetHostedConfigurationVersionInput{
+		ApplicationId:          &appID,
+		ConfigurationProfileId: &profileID,
+		VersionNumber:          &number,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get hosted configuration version %d", number)
+	}
+	return output, nil
+}
+
+// Delete deletes a hosted configuration version
+func (d *HostedVersionDAO) Delete(ctx context.Context, id string) error {
+	appID, profileID, err := d.target(ctx)
+	if err != nil {
+		return err
+	}
+	number, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid version number %q", id)
+	}
+
+	_, err = d.client.DeleteHostedConfigurationVersion(ctx, &appconfig.DeleteHostedConfigurationVersionInput{
+		ApplicationId:          &appID,
+		ConfigurationProfileId: &profileID,
+		VersionNumber:          appaws.Int32Ptr(int32(number)),
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete hosted configuration version %s", id)
+	}
+	return nil
+}
+
+// target returns the application and configuration profile for the current
+// filter. Navigation carries only the profile ID, so the owning application
+// is taken from the ApplicationId filter when present and looked up otherwise.
+func (d *HostedVersionDAO) target(ctx context.Context) (appID, profileID string, err error) {
+	profileID = dao.GetFilterFromContext(ctx, "ConfigurationProfileId")
+	if profileID == "" {
+		return "", "", fmt.Errorf("ConfigurationProfileId filter required - navigate from a configuration profile")
+	}
+	if appID = dao.GetFilterFromContext(ctx, "ApplicationId"); appID != "" {
+		return appID, profileID, nil
+	}
+
+	d.mu.Lock()
+	appID = d.applications[profileID]
+	d.mu.Unlock()
+	if appID != "" {
+		return appID, profileID, nil
+	}
+
+	appID, err = acClient.FindProfileApplication(ctx, d.client, profileID)
+	if err != nil {
+		return "", "", err
+	}
+	d.mu.Lock()
+	d.applications[profileID] = appID
+	d.mu.Unlock()
+	return appID, profileID, nil
+}
+
+// HostedVersionResource wraps a hosted configuration version
+type HostedVersionResource struct {
+	dao.BaseResource
+	Item types.HostedConfigurationVersionSummary
+	// Content is the raw configuration; nil when it was not loaded.
+	Content []byte
+}
+
+// NewHostedVersionResource creates a new HostedVersionResource from summary
+func NewHostedVersionResource(v types.HostedConfigurationVersionSummary) *HostedVersionResource {
+	return &HostedVersionResource{
+		BaseResource: dao.BaseResource{
+			ID:   strconv.Itoa(int(v.VersionNumber)),
+			Name: versionName(v.VersionNumber, appaws.Str(v.VersionLabel)),
+			Data: v,
+		},
+		Item: v,
+	}
+}
+
+// NewHostedVersionResourceFromDetail creates a new HostedVersionResource with content
+func NewHostedVersionResourceFromDetail(output *appconfig.GetHostedConfigurationVersionOutput) *HostedVersionResource {
+	r := NewHostedVersionResource(types.HostedConfigurationVersionSummary{
+		ApplicationId:          output.ApplicationId,
+		ConfigurationProfileId: output.ConfigurationProfileId,
+		ContentType:            output.ContentType,
+		Description:            output.Description,
+		KmsKeyArn:              output.KmsKeyArn,
+		VersionLabel:           output.VersionLabel,
+		VersionNumber:          output.VersionNumber,
+	})
+	r.Content = output.Content
+	return r
+}
+
+// versionName returns "v7", or "v7 (release-42)" when the version is labelled.
+func versionName(number int32, label string) string {
+	if label != "" {
+		return fmt.Sprintf("v%d (%s)", number, label)
+	}
+	return fmt.Sprintf("v%d", number)
+}
+
+// Number returns the version number
+func (r *HostedVersionResource) Number() int32 {
+	return r.Item.VersionNumber
+}
+
+// ApplicationID returns the ID of the owning application
+func (r *HostedVersionResource) ApplicationID() string {
+	return appaws.Str(r.Item.ApplicationId)
+}
+
+// ProfileID returns the ID of the owning configuration profile
+func (r *HostedVersionResource) ProfileID() string {
+	return appaws.Str(r.Item.ConfigurationProfileId)
+}
+
+// ContentType returns the MIME type of the content, e.g. application/json
+func (r *HostedVersionResource) ContentType() string {
+	return appaws.Str(r.Item.ContentType)
+}
+
+// HasContent reports whether the content was loaded.
+func (r *HostedVersionResource) HasContent() bool {
+	return r.Content != nil
+}
+
+// FormattedContent returns the content for display. JSON is re-indented so
+// that versions saved with different formatting still line up in the diff
+// view; other content types are returned as-is.
+func (r *HostedVersionResource) FormattedContent() string {
+	content := string(r.Content)
+	if json.Valid(r.Content) {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, r.Content, "", "  "); err == nil {
+			content = buf.String()
+		}
+	}
+	return strings.TrimRight(content, "\n")
+}
@@ -0,0 +1,120 @@
+package hostedversions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+)
+
+func newTestVersion(number int32, label, content string) *HostedVersionResource {
+	v := NewHostedVersionResource(types.HostedConfigurationVersionSummary{
+		ApplicationId:          aws.String("app1234"),
+		ConfigurationProfileId: aws.String("prof567"),
+		ContentType:            aws.String("application/json"),
+		VersionLabel:           aws.String(label),
+		VersionNumber:          number,
+	})
+	if content != "" {
+		v.Content = []byte(content)
+	}
+	return v
+}
+
+func TestNewHostedVersionResource(t *testing.T) {
+	v := newTestVersion(7, "release-42", "")
+	if v.GetID() != "7" {
+		t.Errorf("GetID() = %q, want 7", v.GetID())
+	}
+	if v.GetName() != "v7 (release-42)" {
+		t.Errorf("GetName() = %q, want v7 (release-42)", v.GetName())
+	}
+	if v.HasContent() {
+		t.Error("HasContent() = true before content is loaded")
+	}
+	if got := newTestVersion(3, "", "").GetName(); got != "v3" {
+		t.Errorf("GetName() without label = %q, want v3", got)
+	}
+}
+
+func TestFormattedContent(t *testing.T) {
+	compact := newTestVersion(1, "", `{"flags":{"beta":{"name":"beta"}},"version":"1"}`)
+	spaced := newTestVersion(2, "", "{\n    \"flags\": {\"beta\": {\"name\": \"beta\"}},\n \"version\": \"1\"\n}\n")
+	if compact.FormattedContent() != spaced.FormattedContent() {
+		t.Errorf("JSON formatting should be normalised:\n%s\nvs\n%s", compact.FormattedContent(), spaced.FormattedContent())
+	}
+	if !strings.Contains(compact.FormattedContent(), "\n  \"flags\": {") {
+		t.Errorf("expected indented JSON, got:\n%s", compact.FormattedContent())
+	}
+
+	yaml := newTestVersion(3, "", "timeout: 30\nretries: 2\n")
+	if got := yaml.FormattedContent(); got != "timeout: 30\nretries: 2" {
+		t.Errorf("non-JSON content = %q, want it unchanged minus trailing newline", got)
+	}
+}
+
+func TestRenderDetail_AlignsForDiff(t *testing.T) {
+	renderer := NewHostedVersionRenderer()
+	older := renderer.RenderDetail(newTestVersion(1, "release-1", `{"timeout":30,"retries":2}`))
+	newer := renderer.RenderDetail(newTestVersion(2, "release-2", `{"timeout":45,"retries":2}`))
+
+	oldLines := strings.Split(older, "\n")
+	newLines := strings.Split(newer, "\n")
+	if len(oldLines) != len(newLines) {
+		t.Fatalf("line counts differ: %d vs %d", len(oldLines), len(newLines))
+	}
+	var changed []string
+	for i := range oldLines {
+		if oldLines[i] != newLines[i] {
+			changed = append(changed, newLines[i])
+		}
+	}
+	joined := strings.Join(changed, "\n")
+	for _, want := range []string{"45", "release-2"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected a differing line containing %q, got:\n%s", want, joined)
+		}
+	}
+	if strings.Contains(joined, "retries") {
+		t.Error("unchanged keys should render identically")
+	}
+}
+
+func TestParseDeployInput(t *testing.T) {
+	tests := []struct {
+		input, env, strategy string
+		wantErr              bool
+	}{
+		{"production", "production", defaultStrategy, false},
+		{"  staging  AppConfig.Linear50PercentEvery30Seconds ", "staging", "AppConfig.Linear50PercentEvery30Seconds", false},
+		{"", "", "", true},
+		{"a b c", "", "", true},
+	}
+	for _, tt := range tests {
+		env, strategy, err := parseDeployInput(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseDeployInput(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if env != tt.env || strategy != tt.strategy {
+			t.Errorf("parseDeployInput(%q) = %q, %q, want %q, %q", tt.input, env, strategy, tt.env, tt.strategy)
+		}
+	}
+}
+
+func TestFindEnvironment(t *testing.T) {
+	envs := []types.Environment{
+		{Id: aws.String("env1111"), Name: aws.String("staging")},
+		{Id: aws.String("env2222"), Name: aws.String("production")},
+	}
+	if env := findEnvironment(envs, "production"); env == nil || *env.Id != "env2222" {
+		t.Errorf("findEnvironment by name = %v, want env2222", env)
+	}
+	if env := findEnvironment(envs, "env1111"); env == nil || *env.Name != "staging" {
+		t.Errorf("findEnvironment by ID = %v, want staging", env)
+	}
+	if env := findEnvironment(envs, "dev"); env != nil {
+		t.Errorf("findEnvironment(dev) = %v, want nil", env)
+	}
+}
@@ -0,0 +1,20 @@
+package hostedversions
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("appconfig", "hosted-versions", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewHostedVersionDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewHostedVersionRenderer()
+		},
+	})
+}
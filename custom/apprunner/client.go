@@ -0,0 +1,14 @@
+package apprunner
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an App Runner client configured for the current context
+func GetClient(ctx context.Context) (*apprunner.Client, error) {
+	return appaws.Client(ctx, apprunner.NewFromConfig)
+}
@@ -21,13 +21,13 @@ type OperationDAO struct {
 
 // NewOperationDAO creates a new OperationDAO.
 func NewOperationDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, apprunner.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &OperationDAO{
 		BaseDAO: dao.NewBaseDAO("apprunner", "operations"),
-		client:  apprunner.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -39,7 +39,8 @@ func (d *OperationDAO) List(ctx context.Context) ([]dao.Resource, error) {
 }
 
 // ListPage returns a page of App Runner operations.
-// Implements dao.PaginatedDAO interface.
+// Implements dao.PaginatedDAO interface. With the DeploymentsOnly toggle,
+// operations other than deployments are dropped from each page.
 func (d *OperationDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
 	serviceArn := dao.GetFilterFromContext(ctx, "ServiceArn")
 	if serviceArn == "" {
@@ -64,9 +65,14 @@ func (d *OperationDAO) ListPage(ctx context.Context, pageSize int, pageToken str
 		return nil, "", apperrors.Wrap(err, "list app runner operations")
 	}
 
-	resources := make([]dao.Resource, len(output.OperationSummaryList))
-	for i, op := range output.OperationSummaryList {
-		resources[i] = NewOperationResource(op)
+	deploymentsOnly := dao.GetFilterFromContext(ctx, "DeploymentsOnly") == "true"
+	resources := make([]dao.Resource, 0, len(output.OperationSummaryList))
+	for _, op := range output.OperationSummaryList {
+		res := NewOperationResource(op)
+		if deploymentsOnly && !res.IsDeployment() {
+			continue
+		}
+		resources = append(resources, res)
 	}
 
 	nextToken := ""
@@ -150,3 +156,33 @@ func (r *OperationResource) UpdatedAt() *time.Time {
 func (r *OperationResource) TargetArn() string {
 	return appaws.Str(r.Item.TargetArn)
 }
+
+// IsDeployment reports whether the operation rolled out a new version of the service.
+func (r *OperationResource) IsDeployment() bool {
+	switch r.Item.Type {
+	case types.OperationTypeStartDeployment, types.OperationTypeCreateService, types.OperationTypeUpdateService:
+		return true
+	}
+	return false
+}
+
+// IsInProgress reports whether the operation has not finished yet.
+func (r *OperationResource) IsInProgress() bool {
+	switch r.Item.Status {
+	case types.OperationStatusPending, types.OperationStatusInProgress, types.OperationStatusRollbackInProgress:
+		return true
+	}
+	return false
+}
+
+// Duration returns how long the operation ran, or has been running so far.
+func (r *OperationResource) Duration() time.Duration {
+	if r.Item.StartedAt == nil {
+		return 0
+	}
+	end := time.Now()
+	if r.Item.EndedAt != nil {
+		end = *r.Item.EndedAt
+	}
+	return end.Sub(*r.Item.StartedAt)
+}
@@ -0,0 +1,44 @@
+package operations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+)
+
+func TestOperationDeploymentStatus(t *testing.T) {
+	tests := []struct {
+		opType     types.OperationType
+		status     types.OperationStatus
+		deployment bool
+		inProgress bool
+	}{
+		{types.OperationTypeStartDeployment, types.OperationStatusInProgress, true, true},
+		{types.OperationTypeUpdateService, types.OperationStatusRollbackInProgress, true, true},
+		{types.OperationTypeCreateService, types.OperationStatusSucceeded, true, false},
+		{types.OperationTypePauseService, types.OperationStatusPending, false, true},
+		{types.OperationTypeResumeService, types.OperationStatusFailed, false, false},
+	}
+	for _, tt := range tests {
+		op := NewOperationResource(types.OperationSummary{Id: aws.String("op-1"), Type: tt.opType, Status: tt.status})
+		if op.IsDeployment() != tt.deployment || op.IsInProgress() != tt.inProgress {
+			t.Errorf("%s/%s: IsDeployment=%v IsInProgress=%v", tt.opType, tt.status, op.IsDeployment(), op.IsInProgress())
+		}
+	}
+}
+
+func TestOperationDuration(t *testing.T) {
+	start := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	end := start.Add(3*time.Minute + 20*time.Second)
+
+	op := NewOperationResource(types.OperationSummary{StartedAt: &start, EndedAt: &end})
+	if got := op.Duration(); got != 200*time.Second {
+		t.Errorf("Duration() = %v, want 3m20s", got)
+	}
+
+	if got := NewOperationResource(types.OperationSummary{}).Duration(); got != 0 {
+		t.Errorf("Duration() without start = %v, want 0", got)
+	}
+}
@@ -1,8 +1,11 @@
 package operations
 
 import (
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // OperationRenderer renders App Runner operations.
@@ -22,6 +25,7 @@ func NewOperationRenderer() render.Renderer {
 				{Name: "STATUS", Width: 14, Getter: getStatus},
 				{Name: "STARTED", Width: 18, Getter: getStarted},
 				{Name: "ENDED", Width: 18, Getter: getEnded},
+				{Name: "DURATION", Width: 10, Getter: getDuration},
 			},
 		},
 	}
@@ -65,6 +69,27 @@ func getEnded(r dao.Resource) string {
 	return ""
 }
 
+func getDuration(r dao.Resource) string {
+	op, ok := r.(*OperationResource)
+	if !ok || op.StartedAt() == nil {
+		return ""
+	}
+	return render.FormatDuration(op.Duration())
+}
+
+func statusStyle(status string) render.Style {
+	switch types.OperationStatus(status) {
+	case types.OperationStatusSucceeded:
+		return ui.SuccessStyle()
+	case types.OperationStatusFailed, types.OperationStatusRollbackFailed:
+		return ui.DangerStyle()
+	case types.OperationStatusRollbackInProgress, types.OperationStatusRollbackSucceeded:
+		return ui.WarningStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
+
 // RenderDetail renders the detail view for an App Runner operation.
 func (r *OperationRenderer) RenderDetail(resource dao.Resource) string {
 	op, ok := resource.(*OperationResource)
@@ -80,7 +105,7 @@ func (r *OperationRenderer) RenderDetail(resource dao.Resource) string {
 	d.Section("Basic Information")
 	d.Field("Operation ID", op.GetID())
 	d.Field("Type", op.OperationType())
-	d.Field("Status", op.Status())
+	d.FieldStyled("Status", op.Status(), statusStyle(op.Status()))
 
 	// Target
 	d.Section("Target")
@@ -97,6 +122,9 @@ func (r *OperationRenderer) RenderDetail(resource dao.Resource) string {
 	if t := op.UpdatedAt(); t != nil {
 		d.Field("Updated", t.Format("2006-01-02 15:04:05"))
 	}
+	if op.StartedAt() != nil {
+		d.Field("Duration", render.FormatDuration(op.Duration()))
+	}
 
 	return d.String()
 }
@@ -111,6 +139,23 @@ func (r *OperationRenderer) RenderSummary(resource dao.Resource) []render.Summar
 	return []render.SummaryField{
 		{Label: "Operation ID", Value: op.GetID()},
 		{Label: "Type", Value: op.OperationType()},
-		{Label: "Status", Value: op.Status()},
+		{Label: "Status", Value: op.Status(), Style: statusStyle(op.Status())},
+	}
+}
+
+// ListToggles returns the deployments-only toggle
+func (r *OperationRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "D", ContextKey: "DeploymentsOnly", LabelOn: "deployments", LabelOff: "all"},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while an operation is in progress
+func (r *OperationRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if op, ok := dao.UnwrapResource(res).(*OperationResource); ok && op.IsInProgress() {
+			return true
+		}
 	}
+	return false
 }
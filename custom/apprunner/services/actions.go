@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+
+	apprunnerClient "github.com/clawscli/claws/custom/apprunner"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for App Runner services
+	action.Global.Register("apprunner", "services", []action.Action{
+		{
+			Name:      "Start Deployment",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartDeployment",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				svc, ok := dao.UnwrapResource(r).(*ServiceResource)
+				return ok && svc.CanPause()
+			},
+		},
+		{
+			Name:      "Pause",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "PauseService",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				svc, ok := dao.UnwrapResource(r).(*ServiceResource)
+				return ok && svc.CanPause()
+			},
+		},
+		{
+			Name:      "Resume",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "ResumeService",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				svc, ok := dao.UnwrapResource(r).(*ServiceResource)
+				return ok && svc.CanResume()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("apprunner", "services", executeServiceAction)
+}
+
+// executeServiceAction executes an action on an App Runner service
+func executeServiceAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	svc, ok := dao.UnwrapResource(resource).(*ServiceResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := apprunnerClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	arn := svc.GetARN()
+	switch act.Operation {
+	case "StartDeployment":
+		output, err := client.StartDeployment(ctx, &apprunner.StartDeploymentInput{ServiceArn: &arn})
+		if err != nil {
+			return action.FailResult(fmt.Errorf("start deployment: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Started deployment of %s (operation %s)", svc.ServiceName(), appaws.Str(output.OperationId)))
+	case "PauseService":
+		if _, err := client.PauseService(ctx, &apprunner.PauseServiceInput{ServiceArn: &arn}); err != nil {
+			return action.FailResult(fmt.Errorf("pause service: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Pausing %s", svc.ServiceName()))
+	case "ResumeService":
+		if _, err := client.ResumeService(ctx, &apprunner.ResumeServiceInput{ServiceArn: &arn}); err != nil {
+			return action.FailResult(fmt.Errorf("resume service: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Resuming %s", svc.ServiceName()))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -20,13 +20,13 @@ type ServiceDAO struct {
 
 // NewServiceDAO creates a new ServiceDAO.
 func NewServiceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, apprunner.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ServiceDAO{
 		BaseDAO: dao.NewBaseDAO("apprunner", "services"),
-		client:  apprunner.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -156,12 +156,45 @@ func (r *ServiceResource) UpdatedAt() *time.Time {
 
 // ServiceId returns the service ID.
 func (r *ServiceResource) ServiceId() string {
+	if r.Summary != nil {
+		return appaws.Str(r.Summary.ServiceId)
+	}
 	if r.Detail != nil {
 		return appaws.Str(r.Detail.ServiceId)
 	}
 	return ""
 }
 
+// CanPause reports whether the service is running and can be paused.
+func (r *ServiceResource) CanPause() bool {
+	return r.Status() == string(types.ServiceStatusRunning)
+}
+
+// CanResume reports whether the service is paused and can be resumed.
+func (r *ServiceResource) CanResume() bool {
+	return r.Status() == string(types.ServiceStatusPaused)
+}
+
+// ApplicationLogGroup returns the CloudWatch log group App Runner writes
+// application output to.
+func (r *ServiceResource) ApplicationLogGroup() string {
+	return r.logGroup("application")
+}
+
+// SystemLogGroup returns the CloudWatch log group App Runner writes
+// deployment and system events to.
+func (r *ServiceResource) SystemLogGroup() string {
+	return r.logGroup("service")
+}
+
+func (r *ServiceResource) logGroup(kind string) string {
+	id := r.ServiceId()
+	if id == "" {
+		return ""
+	}
+	return "/aws/apprunner/" + r.ServiceName() + "/" + id + "/" + kind
+}
+
 // AutoDeploymentsEnabled returns whether auto deployments are enabled.
 func (r *ServiceResource) AutoDeploymentsEnabled() bool {
 	if r.Detail != nil && r.Detail.SourceConfiguration != nil {
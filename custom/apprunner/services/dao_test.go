@@ -0,0 +1,45 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+)
+
+func TestServiceLogGroups(t *testing.T) {
+	svc := NewServiceResource(types.ServiceSummary{
+		ServiceName: aws.String("api"),
+		ServiceId:   aws.String("8fe1e10304f84fd2b0df550fe98a71fa"),
+		Status:      types.ServiceStatusRunning,
+	})
+
+	if got := svc.ApplicationLogGroup(); got != "/aws/apprunner/api/8fe1e10304f84fd2b0df550fe98a71fa/application" {
+		t.Errorf("ApplicationLogGroup() = %q", got)
+	}
+	if got := svc.SystemLogGroup(); got != "/aws/apprunner/api/8fe1e10304f84fd2b0df550fe98a71fa/service" {
+		t.Errorf("SystemLogGroup() = %q", got)
+	}
+
+	noID := NewServiceResource(types.ServiceSummary{ServiceName: aws.String("api")})
+	if got := noID.ApplicationLogGroup(); got != "" {
+		t.Errorf("ApplicationLogGroup() without service ID = %q, want empty", got)
+	}
+}
+
+func TestServicePauseResume(t *testing.T) {
+	tests := []struct {
+		status              types.ServiceStatus
+		canPause, canResume bool
+	}{
+		{types.ServiceStatusRunning, true, false},
+		{types.ServiceStatusPaused, false, true},
+		{types.ServiceStatusOperationInProgress, false, false},
+	}
+	for _, tt := range tests {
+		svc := NewServiceResource(types.ServiceSummary{ServiceName: aws.String("api"), Status: tt.status})
+		if svc.CanPause() != tt.canPause || svc.CanResume() != tt.canResume {
+			t.Errorf("%s: CanPause=%v CanResume=%v", tt.status, svc.CanPause(), svc.CanResume())
+		}
+	}
+}
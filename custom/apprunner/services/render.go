@@ -205,7 +205,7 @@ func (r *ServiceRenderer) Navigations(resource dao.Resource) []render.Navigation
 	if !ok {
 		return nil
 	}
-	return []render.Navigation{
+	navs := []render.Navigation{
 		{
 			Key:         "o",
 			Label:       "Operations",
@@ -215,4 +215,27 @@ func (r *ServiceRenderer) Navigations(resource dao.Resource) []render.Navigation
 			FilterValue: svc.GetARN(),
 		},
 	}
+
+	if group := svc.ApplicationLogGroup(); group != "" {
+		navs = append(navs, render.Navigation{
+			Key:         "l",
+			Label:       "App Logs",
+			Service:     "cloudwatch",
+			Resource:    "log-streams",
+			FilterField: "LogGroupName",
+			FilterValue: group,
+		})
+	}
+	if group := svc.SystemLogGroup(); group != "" {
+		navs = append(navs, render.Navigation{
+			Key:         "L",
+			Label:       "System Logs",
+			Service:     "cloudwatch",
+			Resource:    "log-streams",
+			FilterField: "LogGroupName",
+			FilterValue: group,
+		})
+	}
+
+	return navs
 }
@@ -20,13 +20,13 @@ type DataSourceDAO struct {
 
 // NewDataSourceDAO creates a new DataSourceDAO.
 func NewDataSourceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, appsync.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &DataSourceDAO{
 		BaseDAO: dao.NewBaseDAO("appsync", "data-sources"),
-		client:  appsync.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
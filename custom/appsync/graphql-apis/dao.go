@@ -19,13 +19,13 @@ type GraphQLApiDAO struct {
 
 // NewGraphQLApiDAO creates a new GraphQLApiDAO.
 func NewGraphQLApiDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, appsync.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &GraphQLApiDAO{
 		BaseDAO: dao.NewBaseDAO("appsync", "graphql-apis"),
-		client:  appsync.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
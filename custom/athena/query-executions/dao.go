@@ -21,13 +21,13 @@ type QueryExecutionDAO struct {
 
 // NewQueryExecutionDAO creates a new QueryExecutionDAO.
 func NewQueryExecutionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, athena.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &QueryExecutionDAO{
 		BaseDAO: dao.NewBaseDAO("athena", "query-executions"),
-		client:  athena.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,130 @@
+package athena
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// queryPollInterval is how often a running query is polled.
+	queryPollInterval = time.Second
+	// queryTimeout bounds how long a query may run before it is cancelled.
+	queryTimeout = 60 * time.Second
+)
+
+// GetClient returns an Athena client configured for the current context
+func GetClient(ctx context.Context) (*athena.Client, error) {
+	return appaws.Client(ctx, athena.NewFromConfig)
+}
+
+// QueryResult is the first page of a finished query's results.
+type QueryResult struct {
+	QueryExecutionID string
+	Columns          []string
+	Rows             [][]string
+	DataScanned      int64
+	Elapsed          time.Duration
+}
+
+// RunQuery runs sql in workgroup against a Glue Data Catalog database, waits
+// for it to finish, and returns the first page of its results. Queries still
+// running after queryTimeout are cancelled.
+func RunQuery(ctx context.Context, client *athena.Client, workgroup, database, sql string) (*QueryResult, error) {
+	started, err := client.StartQueryExecution(ctx, &athena.StartQueryExecutionInput{
+		QueryString: &sql,
+		WorkGroup:   &workgroup,
+		QueryExecutionContext: &types.QueryExecutionContext{
+			Catalog:  appaws.StringPtr("AwsDataCatalog"),
+			Database: &database,
+		},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "start athena query in workgroup %s", workgroup)
+	}
+	id := appaws.Str(started.QueryExecutionId)
+
+	waitCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var exec *types.QueryExecution
+	for {
+		output, err := client.GetQueryExecution(waitCtx, &athena.GetQueryExecutionInput{QueryExecutionId: &id})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "get athena query %s", id)
+		}
+		exec = output.QueryExecution
+		if exec == nil || exec.Status == nil {
+			return nil, fmt.Errorf("athena query %s has no status", id)
+		}
+
+		state := exec.Status.State
+		if state == types.QueryExecutionStateSucceeded {
+			break
+		}
+		if state == types.QueryExecutionStateFailed || state == types.QueryExecutionStateCancelled {
+			return nil, fmt.Errorf("athena query %s %s: %s", id, strings.ToLower(string(state)), appaws.Str(exec.Status.StateChangeReason))
+		}
+
+		select {
+		case <-waitCtx.Done():
+			// Don't leave the query scanning data nobody will read
+			_, _ = client.StopQueryExecution(context.WithoutCancel(ctx), &athena.StopQueryExecutionInput{QueryExecutionId: &id})
+			return nil, apperrors.Wrapf(waitCtx.Err(), "wait for athena query %s", id)
+		case <-time.After(queryPollInterval):
+		}
+	}
+
+	output, err := client.GetQueryResults(ctx, &athena.GetQueryResultsInput{QueryExecutionId: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get results of athena query %s", id)
+	}
+
+	result := &QueryResult{QueryExecutionID: id}
+	result.Columns, result.Rows = parseResultSet(output.ResultSet)
+	if stats := exec.Statistics; stats != nil {
+		result.DataScanned = appaws.Int64(stats.DataScannedInBytes)
+		result.Elapsed = time.Duration(appaws.Int64(stats.TotalExecutionTimeInMillis)) * time.Millisecond
+	}
+	return result, nil
+}
+
+// parseResultSet returns the column names and rows of a result set. SELECT
+// results repeat the column names as their first row, which is dropped.
+func parseResultSet(rs *types.ResultSet) ([]string, [][]string) {
+	if rs == nil {
+		return nil, nil
+	}
+	var columns []string
+	if rs.ResultSetMetadata != nil {
+		for _, c := range rs.ResultSetMetadata.ColumnInfo {
+			columns = append(columns, appaws.Str(c.Name))
+		}
+	}
+
+	rows := make([][]string, 0, len(rs.Rows))
+	for _, row := range rs.Rows {
+		values := make([]string, len(row.Data))
+		for i, d := range row.Data {
+			values[i] = appaws.Str(d.VarCharValue)
+		}
+		rows = append(rows, values)
+	}
+	if len(rows) > 0 && len(columns) > 0 && slices.Equal(rows[0], columns) {
+		rows = rows[1:]
+	}
+	return columns, rows
+}
+
+// QuoteIdentifier quotes a database, table or column name for Athena SQL.
+func QuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
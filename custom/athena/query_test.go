@@ -0,0 +1,47 @@
+package athena
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+)
+
+func row(values ...string) types.Row {
+	data := make([]types.Datum, len(values))
+	for i, v := range values {
+		data[i] = types.Datum{VarCharValue: aws.String(v)}
+	}
+	return types.Row{Data: data}
+}
+
+func TestParseResultSet(t *testing.T) {
+	rs := &types.ResultSet{
+		ResultSetMetadata: &types.ResultSetMetadata{ColumnInfo: []types.ColumnInfo{
+			{Name: aws.String("id")}, {Name: aws.String("name")},
+		}},
+		Rows: []types.Row{row("id", "name"), row("1", "a"), {Data: []types.Datum{{VarCharValue: aws.String("2")}, {}}}},
+	}
+
+	columns, rows := parseResultSet(rs)
+	if !slices.Equal(columns, []string{"id", "name"}) {
+		t.Errorf("columns = %v", columns)
+	}
+	if len(rows) != 2 || !slices.Equal(rows[0], []string{"1", "a"}) || !slices.Equal(rows[1], []string{"2", ""}) {
+		t.Errorf("rows = %v, want header dropped and NULL as empty", rows)
+	}
+
+	if columns, rows := parseResultSet(nil); columns != nil || rows != nil {
+		t.Errorf("nil result set = %v, %v", columns, rows)
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	if got := QuoteIdentifier(`sales`); got != `"sales"` {
+		t.Errorf("QuoteIdentifier(sales) = %s", got)
+	}
+	if got := QuoteIdentifier(`a"b`); got != `"a""b"` {
+		t.Errorf(`QuoteIdentifier(a"b) = %s`, got)
+	}
+}
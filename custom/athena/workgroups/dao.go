@@ -20,13 +20,13 @@ type WorkgroupDAO struct {
 
 // NewWorkgroupDAO creates a new WorkgroupDAO.
 func NewWorkgroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, athena.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &WorkgroupDAO{
 		BaseDAO: dao.NewBaseDAO("athena", "workgroups"),
-		client:  athena.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
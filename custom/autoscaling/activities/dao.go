@@ -21,13 +21,13 @@ type ActivityDAO struct {
 
 // NewActivityDAO creates a new ActivityDAO
 func NewActivityDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, autoscaling.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ActivityDAO{
 		BaseDAO: dao.NewBaseDAO("autoscaling", "activities"),
-		client:  autoscaling.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
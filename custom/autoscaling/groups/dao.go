@@ -21,13 +21,13 @@ type AutoScalingGroupDAO struct {
 
 // NewAutoScalingGroupDAO creates a new AutoScalingGroupDAO
 func NewAutoScalingGroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, autoscaling.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &AutoScalingGroupDAO{
 		BaseDAO: dao.NewBaseDAO("autoscaling", "groups"),
-		client:  autoscaling.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -258,7 +258,7 @@ func (r *AutoScalingGroupRenderer) Navigations(resource dao.Resource) []render.N
 		return nil
 	}
 
-	return []render.Navigation{
+	navs := []render.Navigation{
 		{
 			Key: "g", Label: "Activities", Service: "autoscaling", Resource: "activities",
 			FilterField: "AutoScalingGroupName", FilterValue: rr.AutoScalingGroupName(),
@@ -268,4 +268,11 @@ func (r *AutoScalingGroupRenderer) Navigations(resource dao.Resource) []render.N
 			FilterField: "AutoScalingGroupName", FilterValue: rr.AutoScalingGroupName(),
 		},
 	}
+	if ltID := rr.LaunchTemplateId(); ltID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "t", Label: "Template Versions", Service: "ec2", Resource: "launch-template-versions",
+			FilterField: "LaunchTemplateId", FilterValue: ltID,
+		})
+	}
+	return navs
 }
@@ -21,13 +21,13 @@ type BackupJobDAO struct {
 
 // NewBackupJobDAO creates a new BackupJobDAO
 func NewBackupJobDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &BackupJobDAO{
 		BaseDAO: dao.NewBaseDAO("backup", "backup-jobs"),
-		client:  backup.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -22,13 +22,13 @@ type CopyJobDAO struct {
 
 // NewCopyJobDAO creates a new CopyJobDAO
 func NewCopyJobDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &CopyJobDAO{
 		BaseDAO: dao.NewBaseDAO("backup", "copy-jobs"),
-		client:  backup.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type BackupPlanDAO struct {
 
 // NewBackupPlanDAO creates a new BackupPlanDAO
 func NewBackupPlanDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &BackupPlanDAO{
 		BaseDAO: dao.NewBaseDAO("backup", "plans"),
-		client:  backup.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
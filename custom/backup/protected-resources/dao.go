@@ -21,13 +21,13 @@ type ProtectedResourceDAO struct {
 
 // NewProtectedResourceDAO creates a new ProtectedResourceDAO
 func NewProtectedResourceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ProtectedResourceDAO{
 		BaseDAO: dao.NewBaseDAO("backup", "protected-resources"),
-		client:  backup.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
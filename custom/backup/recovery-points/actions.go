@@ -0,0 +1,102 @@
+package recoverypoints
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// defaultRestoreRole is the service role AWS Backup creates for restores.
+const defaultRestoreRole = "service-role/AWSBackupDefaultServiceRole"
+
+func init() {
+	action.Global.Register("backup", "recovery-points", []action.Action{
+		{
+			Name:      "Restore",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartRestoreJob",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				rp, ok := dao.UnwrapResource(r).(*RecoveryPointResource)
+				return ok && rp.IsRestorable()
+			},
+			Input: &action.InputSpec{
+				Label:       "IAM role for the restore (name or ARN)",
+				Placeholder: defaultRestoreRole,
+				Optional:    true,
+			},
+		},
+	})
+
+	action.RegisterExecutor("backup", "recovery-points", executeRecoveryPointAction)
+}
+
+func executeRecoveryPointAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "StartRestoreJob":
+		return executeStartRestore(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// executeStartRestore restores the recovery point with the restore metadata
+// AWS Backup recorded for it, the same defaults the console pre-fills.
+func executeStartRestore(ctx context.Context, resource dao.Resource) action.ActionResult {
+	rp, ok := dao.UnwrapResource(resource).(*RecoveryPointResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	role := strings.TrimSpace(action.InputFromContext(ctx))
+	if role == "" {
+		role = defaultRestoreRole
+	}
+	if !strings.HasPrefix(role, "arn:") {
+		accountID := appaws.FetchAccountIDForContext(ctx)
+		if accountID == "" {
+			return action.FailResult(fmt.Errorf("could not determine AWS account ID: pass the role ARN instead"))
+		}
+		role = fmt.Sprintf("arn:%s:iam::%s:role/%s", appaws.PartitionForRegion(client.Options().Region), accountID, role)
+	}
+
+	arn := rp.RecoveryPointArn()
+	meta, err := client.GetRecoveryPointRestoreMetadata(ctx, &backup.GetRecoveryPointRestoreMetadataInput{
+		BackupVaultName:  &rp.VaultName,
+		RecoveryPointArn: &arn,
+	})
+	if err != nil {
+		return action.FailResultf(err, "get restore metadata for %s", arn)
+	}
+
+	input := &backup.StartRestoreJobInput{
+		RecoveryPointArn: &arn,
+		IamRoleArn:       &role,
+		Metadata:         meta.RestoreMetadata,
+	}
+	if resourceType := rp.ResourceType(); resourceType != "" {
+		input.ResourceType = &resourceType
+	}
+	output, err := client.StartRestoreJob(ctx, input)
+	if err != nil {
+		return action.FailResultf(err, "start restore of %s", arn)
+	}
+
+	msg := fmt.Sprintf("Started restore job %s", appaws.Str(output.RestoreJobId))
+	if rp.IsColdStorage() {
+		msg += " from cold storage (this can take hours)"
+	}
+	return action.SuccessResult(msg + "; track it with 'j' or in backup/restore-jobs")
+}
@@ -22,13 +22,13 @@ type RecoveryPointDAO struct {
 
 // NewRecoveryPointDAO creates a new RecoveryPointDAO
 func NewRecoveryPointDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RecoveryPointDAO{
 		BaseDAO: dao.NewBaseDAO("backup", "recovery-points"),
-		client:  backup.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -263,14 +263,32 @@ func (r *RecoveryPointResource) EncryptionKeyArn() string {
 	return ""
 }
 
-// StorageClass returns the storage class
+// StorageClass returns the storage class. List results do not carry it, so
+// it is derived from the calculated lifecycle's cold storage transition.
 func (r *RecoveryPointResource) StorageClass() string {
 	if r.Detail != nil {
 		return string(r.Detail.StorageClass)
 	}
+	if r.Summary != nil && r.Summary.Status == types.RecoveryPointStatusCompleted {
+		if lc := r.Summary.CalculatedLifecycle; lc != nil && lc.MoveToColdStorageAt != nil && lc.MoveToColdStorageAt.Before(time.Now()) {
+			return string(types.StorageClassCold)
+		}
+		return string(types.StorageClassWarm)
+	}
 	return ""
 }
 
+// IsColdStorage returns whether the recovery point is in cold storage,
+// where restores take hours instead of minutes
+func (r *RecoveryPointResource) IsColdStorage() bool {
+	return r.StorageClass() == string(types.StorageClassCold)
+}
+
+// IsRestorable returns whether the recovery point can be restored
+func (r *RecoveryPointResource) IsRestorable() bool {
+	return r.Status() == string(types.RecoveryPointStatusCompleted)
+}
+
 // IamRoleArn returns the IAM role ARN
 func (r *RecoveryPointResource) IamRoleArn() string {
 	if r.Summary != nil {
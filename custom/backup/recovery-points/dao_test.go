@@ -0,0 +1,55 @@
+package recoverypoints
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/backup/types"
+)
+
+func TestRecoveryPointResource_StorageClass(t *testing.T) {
+	past := time.Now().Add(-24 * time.Hour)
+	future := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		summary  types.RecoveryPointByBackupVault
+		want     string
+		wantCold bool
+	}{
+		{"no lifecycle", types.RecoveryPointByBackupVault{Status: types.RecoveryPointStatusCompleted}, "WARM", false},
+		{"moved to cold", types.RecoveryPointByBackupVault{
+			Status:              types.RecoveryPointStatusCompleted,
+			CalculatedLifecycle: &types.CalculatedLifecycle{MoveToColdStorageAt: &past},
+		}, "COLD", true},
+		{"moving later", types.RecoveryPointByBackupVault{
+			Status:              types.RecoveryPointStatusCompleted,
+			CalculatedLifecycle: &types.CalculatedLifecycle{MoveToColdStorageAt: &future},
+		}, "WARM", false},
+		{"still running", types.RecoveryPointByBackupVault{Status: types.RecoveryPointStatusPartial}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.summary.RecoveryPointArn = aws.String("arn:aws:backup:us-east-1:111122223333:recovery-point:abc")
+			rp := NewRecoveryPointResourceFromSummary(tt.summary, "vault")
+			if got := rp.StorageClass(); got != tt.want {
+				t.Errorf("StorageClass() = %q, want %q", got, tt.want)
+			}
+			if rp.IsColdStorage() != tt.wantCold {
+				t.Errorf("IsColdStorage() = %v, want %v", rp.IsColdStorage(), tt.wantCold)
+			}
+		})
+	}
+}
+
+func TestRecoveryPointResource_StorageClassFromDetail(t *testing.T) {
+	rp := NewRecoveryPointResourceFromDetail(&backup.DescribeRecoveryPointOutput{
+		Status:       types.RecoveryPointStatusCompleted,
+		StorageClass: types.StorageClassCold,
+	}, "vault")
+	if !rp.IsColdStorage() || !rp.IsRestorable() {
+		t.Errorf("IsColdStorage()/IsRestorable() = %v/%v, want true/true", rp.IsColdStorage(), rp.IsRestorable())
+	}
+}
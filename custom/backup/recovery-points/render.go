@@ -25,6 +25,7 @@ func NewRecoveryPointRenderer() *RecoveryPointRenderer {
 				{Name: "RESOURCE TYPE", Width: 15, Getter: getResourceType},
 				{Name: "STATUS", Width: 12, Getter: getStatus},
 				{Name: "SIZE", Width: 12, Getter: getSize},
+				{Name: "STORAGE", Width: 8, Getter: getStorageClass},
 				{Name: "CREATED", Width: 20, Getter: getCreated},
 				{Name: "RECOVERY POINT", Width: 50, Getter: getRecoveryPointId},
 			},
@@ -53,6 +54,13 @@ func getSize(r dao.Resource) string {
 	return "-"
 }
 
+func getStorageClass(r dao.Resource) string {
+	if rp, ok := r.(*RecoveryPointResource); ok {
+		return rp.StorageClass()
+	}
+	return ""
+}
+
 func getCreated(r dao.Resource) string {
 	if rp, ok := r.(*RecoveryPointResource); ok {
 		return rp.CreationDate()
@@ -234,6 +242,12 @@ func (r *RecoveryPointRenderer) Navigations(resource dao.Resource) []render.Navi
 		FilterField: "Name", FilterValue: rp.VaultName,
 	})
 
+	// Navigate to restores of this recovery point
+	navs = append(navs, render.Navigation{
+		Key: "j", Label: "Restore Jobs", Service: "backup", Resource: "restore-jobs",
+		FilterField: "RecoveryPointArn", FilterValue: rp.RecoveryPointArn(),
+	})
+
 	// Navigate to backup plan if available
 	if createdBy := rp.CreatedBy(); createdBy != nil && createdBy.BackupPlanId != nil {
 		navs = append(navs, render.Navigation{
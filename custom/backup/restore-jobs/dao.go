@@ -22,13 +22,13 @@ type RestoreJobDAO struct {
 
 // NewRestoreJobDAO creates a new RestoreJobDAO
 func NewRestoreJobDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RestoreJobDAO{
 		BaseDAO: dao.NewBaseDAO("backup", "restore-jobs"),
-		client:  backup.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -38,33 +38,59 @@ func (d *RestoreJobDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	return resources, err
 }
 
-// ListPage returns a page of restore jobs
+// ListPage returns a page of restore jobs. With the ActiveOnly toggle, all
+// pending and running jobs are returned in a single page; a RecoveryPointArn
+// filter (from recovery points) narrows the list to restores of that point.
 func (d *RestoreJobDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
-	maxResults := int32(pageSize)
-	if maxResults > 1000 {
-		maxResults = 1000
-	}
-
-	input := &backup.ListRestoreJobsInput{
-		MaxResults: &maxResults,
-	}
-	if pageToken != "" {
-		input.NextToken = &pageToken
-	}
-
-	output, err := d.client.ListRestoreJobs(ctx, input)
-	if err != nil {
-		return nil, "", apperrors.Wrap(err, "list restore jobs")
-	}
-
-	resources := make([]dao.Resource, len(output.RestoreJobs))
-	for i, job := range output.RestoreJobs {
-		resources[i] = NewRestoreJobResource(job)
-	}
-
+	var jobs []types.RestoreJobsListMember
 	nextToken := ""
-	if output.NextToken != nil {
-		nextToken = *output.NextToken
+	if dao.GetFilterFromContext(ctx, "ActiveOnly") == "true" {
+		for _, status := range []types.RestoreJobStatus{types.RestoreJobStatusPending, types.RestoreJobStatusRunning} {
+			active, err := appaws.Paginate(ctx, func(token *string) ([]types.RestoreJobsListMember, *string, error) {
+				output, err := d.client.ListRestoreJobs(ctx, &backup.ListRestoreJobsInput{
+					ByStatus:  status,
+					NextToken: token,
+				})
+				if err != nil {
+					return nil, nil, apperrors.Wrapf(err, "list %s restore jobs", status)
+				}
+				return output.RestoreJobs, output.NextToken, nil
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			jobs = append(jobs, active...)
+		}
+	} else {
+		maxResults := int32(pageSize)
+		if maxResults > 1000 {
+			maxResults = 1000
+		}
+
+		input := &backup.ListRestoreJobsInput{
+			MaxResults: &maxResults,
+		}
+		if pageToken != "" {
+			input.NextToken = &pageToken
+		}
+
+		output, err := d.client.ListRestoreJobs(ctx, input)
+		if err != nil {
+			return nil, "", apperrors.Wrap(err, "list restore jobs")
+		}
+		jobs = output.RestoreJobs
+		if output.NextToken != nil {
+			nextToken = *output.NextToken
+		}
+	}
+
+	recoveryPointArn := dao.GetFilterFromContext(ctx, "RecoveryPointArn")
+	resources := make([]dao.Resource, 0, len(jobs))
+	for _, job := range jobs {
+		if recoveryPointArn != "" && appaws.Str(job.RecoveryPointArn) != recoveryPointArn {
+			continue
+		}
+		resources = append(resources, NewRestoreJobResource(job))
 	}
 
 	return resources, nextToken, nil
@@ -180,6 +206,16 @@ func (r *RestoreJobResource) ResourceType() string {
 	return ""
 }
 
+// IsActive returns whether the job is still pending or running
+func (r *RestoreJobResource) IsActive() bool {
+	switch types.RestoreJobStatus(r.Status()) {
+	case types.RestoreJobStatusPending, types.RestoreJobStatusRunning:
+		return true
+	default:
+		return false
+	}
+}
+
 // RecoveryPointArn returns the recovery point ARN
 func (r *RestoreJobResource) RecoveryPointArn() string {
 	if r.Job != nil {
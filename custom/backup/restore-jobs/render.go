@@ -2,6 +2,7 @@ package restorejobs
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -27,6 +28,7 @@ func NewRestoreJobRenderer() *RestoreJobRenderer {
 				{Name: "RESOURCE TYPE", Width: 15, Getter: getResourceType},
 				{Name: "SIZE", Width: 12, Getter: getSize},
 				{Name: "PROGRESS", Width: 10, Getter: getProgress},
+				{Name: "ETA", Width: 8, Getter: getETA},
 				{Name: "CREATED", Width: 20, Getter: getCreated},
 			},
 		},
@@ -70,6 +72,15 @@ func getProgress(r dao.Resource) string {
 	return "-"
 }
 
+func getETA(r dao.Resource) string {
+	if j, ok := r.(*RestoreJobResource); ok && j.IsActive() {
+		if minutes := j.ExpectedCompletionTimeMinutes(); minutes > 0 {
+			return render.FormatDuration(time.Duration(minutes) * time.Minute)
+		}
+	}
+	return "-"
+}
+
 func getCreated(r dao.Resource) string {
 	if j, ok := r.(*RestoreJobResource); ok {
 		return j.CreationDate()
@@ -193,3 +204,20 @@ func (r *RestoreJobRenderer) Navigations(resource dao.Resource) []render.Navigat
 	// Restore jobs don't have direct navigation to vault (recovery point ARN contains vault info but parsing is complex)
 	return nil
 }
+
+// ListToggles returns the active-only toggle
+func (r *RestoreJobRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "a", ContextKey: "ActiveOnly", LabelOn: "active only", LabelOff: "all"},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while any restore is in progress
+func (r *RestoreJobRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if j, ok := dao.UnwrapResource(res).(*RestoreJobResource); ok && j.IsActive() {
+			return true
+		}
+	}
+	return false
+}
@@ -20,13 +20,13 @@ type SelectionDAO struct {
 
 // NewSelectionDAO creates a new SelectionDAO
 func NewSelectionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SelectionDAO{
 		BaseDAO: dao.NewBaseDAO("backup", "selections"),
-		client:  backup.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type VaultDAO struct {
 
 // NewVaultDAO creates a new VaultDAO
 func NewVaultDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, backup.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &VaultDAO{
 		BaseDAO: dao.NewBaseDAO("backup", "vaults"),
-		client:  backup.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,14 @@
+package batch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns a Batch client configured for the current context
+func GetClient(ctx context.Context) (*batch.Client, error) {
+	return appaws.Client(ctx, batch.NewFromConfig)
+}
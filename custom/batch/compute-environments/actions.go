@@ -0,0 +1,83 @@
+package computeenvironments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+
+	batchClient "github.com/clawscli/claws/custom/batch"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Batch compute environments
+	action.Global.Register("batch", "compute-environments", []action.Action{
+		{
+			Name:      "Enable",
+			Shortcut:  "E",
+			Type:      action.ActionTypeAPI,
+			Operation: "EnableComputeEnvironment",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				env, ok := r.(*ComputeEnvironmentResource)
+				return ok && !env.IsEnabled()
+			},
+		},
+		{
+			Name:      "Disable",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "DisableComputeEnvironment",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				env, ok := r.(*ComputeEnvironmentResource)
+				return ok && env.IsEnabled()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("batch", "compute-environments", executeComputeEnvironmentAction)
+}
+
+// executeComputeEnvironmentAction executes an action on a Batch compute environment.
+func executeComputeEnvironmentAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	env, ok := resource.(*ComputeEnvironmentResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "EnableComputeEnvironment":
+		return setState(ctx, env, types.CEStateEnabled)
+	case "DisableComputeEnvironment":
+		return setState(ctx, env, types.CEStateDisabled)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// setState enables or disables a compute environment. A disabled environment
+// takes no new jobs and scales in once its running jobs finish.
+func setState(ctx context.Context, env *ComputeEnvironmentResource, state types.CEState) action.ActionResult {
+	client, err := batchClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	name := env.GetID()
+	_, err = client.UpdateComputeEnvironment(ctx, &batch.UpdateComputeEnvironmentInput{
+		ComputeEnvironment: &name,
+		State:              state,
+	})
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("update compute environment: %w", err)}
+	}
+	return action.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Compute environment %s set to %s", name, state),
+	}
+}
@@ -1,32 +1,59 @@
 package computeenvironments
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/batch"
 	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// maxDescribeContainerInstances is the DescribeContainerInstances limit.
+	maxDescribeContainerInstances = 100
+	// maxDescribeAutoScalingInstances is the DescribeAutoScalingInstances limit.
+	maxDescribeAutoScalingInstances = 50
+	// cpuUnitsPerVCPU converts ECS CPU units to vCPUs.
+	cpuUnitsPerVCPU = 1024
 )
 
 // ComputeEnvironmentDAO provides data access for Batch compute environments.
 type ComputeEnvironmentDAO struct {
 	dao.BaseDAO
-	client *batch.Client
+	client      *batch.Client
+	ecs         *ecs.Client
+	autoscaling *autoscaling.Client
 }
 
 // NewComputeEnvironmentDAO creates a new ComputeEnvironmentDAO.
 func NewComputeEnvironmentDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, batch.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	ecsClient, err := appaws.Client(ctx, ecs.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	asClient, err := appaws.Client(ctx, autoscaling.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ComputeEnvironmentDAO{
-		BaseDAO: dao.NewBaseDAO("batch", "compute-environments"),
-		client:  batch.NewFromConfig(cfg),
+		BaseDAO:     dao.NewBaseDAO("batch", "compute-environments"),
+		client:      client,
+		ecs:         ecsClient,
+		autoscaling: asClient,
 	}, nil
 }
 
@@ -52,7 +79,8 @@ func (d *ComputeEnvironmentDAO) List(ctx context.Context) ([]dao.Resource, error
 	return resources, nil
 }
 
-// Get returns a specific compute environment.
+// Get returns a specific compute environment with the instances of its ECS
+// cluster.
 func (d *ComputeEnvironmentDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
 	output, err := d.client.DescribeComputeEnvironments(ctx, &batch.DescribeComputeEnvironmentsInput{
 		ComputeEnvironments: []string{name},
@@ -63,7 +91,117 @@ func (d *ComputeEnvironmentDAO) Get(ctx context.Context, name string) (dao.Resou
 	if len(output.ComputeEnvironments) == 0 {
 		return nil, fmt.Errorf("compute environment not found: %s", name)
 	}
-	return NewComputeEnvironmentResource(output.ComputeEnvironments[0]), nil
+	r := NewComputeEnvironmentResource(output.ComputeEnvironments[0])
+
+	if cluster := appaws.Str(r.Env.EcsClusterArn); cluster != "" && !r.IsFargate() {
+		instances, err := d.instances(ctx, cluster)
+		if err != nil {
+			// The instance breakdown only adds to the detail view
+			log.Warn("failed to list compute environment instances", "name", name, "error", err)
+		}
+		r.Instances = instances
+	}
+	return r, nil
+}
+
+// instances returns the container instances of an ECS cluster with the Auto
+// Scaling group each belongs to.
+func (d *ComputeEnvironmentDAO) instances(ctx context.Context, cluster string) ([]Instance, error) {
+	arns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := d.ecs.ListContainerInstances(ctx, &ecs.ListContainerInstancesInput{Cluster: &cluster, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list container instances")
+		}
+		return output.ContainerInstanceArns, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []Instance
+	for chunk := range slices.Chunk(arns, maxDescribeContainerInstances) {
+		output, err := d.ecs.DescribeContainerInstances(ctx, &ecs.DescribeContainerInstancesInput{
+			Cluster:            &cluster,
+			ContainerInstances: chunk,
+		})
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe container instances")
+		}
+		for _, ci := range output.ContainerInstances {
+			instances = append(instances, newInstance(ci))
+		}
+	}
+
+	ids := make([]string, 0, len(instances))
+	for _, inst := range instances {
+		if inst.ID != "" {
+			ids = append(ids, inst.ID)
+		}
+	}
+	groups := make(map[string]string, len(ids))
+	for chunk := range slices.Chunk(ids, maxDescribeAutoScalingInstances) {
+		output, err := d.autoscaling.DescribeAutoScalingInstances(ctx, &autoscaling.DescribeAutoScalingInstancesInput{InstanceIds: chunk})
+		if err != nil {
+			// Without the group names the breakdown is still accurate
+			log.Debug("failed to describe auto scaling instances", "error", err)
+			break
+		}
+		for _, asi := range output.AutoScalingInstances {
+			groups[appaws.Str(asi.InstanceId)] = appaws.Str(asi.AutoScalingGroupName)
+		}
+	}
+	for i := range instances {
+		instances[i].AutoScalingGroup = groups[instances[i].ID]
+	}
+
+	slices.SortFunc(instances, func(a, b Instance) int {
+		return cmp.Or(cmp.Compare(a.InstanceType, b.InstanceType), cmp.Compare(a.ID, b.ID))
+	})
+	return instances, nil
+}
+
+// Instance is an EC2 instance registered to a compute environment's ECS
+// cluster.
+type Instance struct {
+	ID               string
+	InstanceType     string
+	AvailabilityZone string
+	AutoScalingGroup string
+	Status           string
+	RunningTasks     int32
+	// RegisteredCPU and RemainingCPU are in ECS CPU units.
+	RegisteredCPU int32
+	RemainingCPU  int32
+}
+
+// newInstance converts an ECS container instance.
+func newInstance(ci ecstypes.ContainerInstance) Instance {
+	inst := Instance{
+		ID:            appaws.Str(ci.Ec2InstanceId),
+		Status:        appaws.Str(ci.Status),
+		RunningTasks:  ci.RunningTasksCount,
+		RegisteredCPU: cpuUnits(ci.RegisteredResources),
+		RemainingCPU:  cpuUnits(ci.RemainingResources),
+	}
+	for _, attr := range ci.Attributes {
+		switch appaws.Str(attr.Name) {
+		case "ecs.instance-type":
+			inst.InstanceType = appaws.Str(attr.Value)
+		case "ecs.availability-zone":
+			inst.AvailabilityZone = appaws.Str(attr.Value)
+		}
+	}
+	return inst
+}
+
+// cpuUnits returns the CPU of an ECS resource list.
+func cpuUnits(resources []ecstypes.Resource) int32 {
+	for _, r := range resources {
+		if appaws.Str(r.Name) == "CPU" {
+			return r.IntegerValue
+		}
+	}
+	return 0
 }
 
 // Delete deletes a Batch compute environment.
@@ -91,6 +229,9 @@ func (d *ComputeEnvironmentDAO) Delete(ctx context.Context, name string) error {
 type ComputeEnvironmentResource struct {
 	dao.BaseResource
 	Env *types.ComputeEnvironmentDetail
+
+	// Instances is populated by Get for EC2 and Spot environments.
+	Instances []Instance
 }
 
 // NewComputeEnvironmentResource creates a new ComputeEnvironmentResource.
@@ -136,3 +277,77 @@ func (r *ComputeEnvironmentResource) ServiceRole() string {
 	}
 	return ""
 }
+
+// IsEnabled reports whether the environment accepts jobs.
+func (r *ComputeEnvironmentResource) IsEnabled() bool {
+	return r.Env != nil && r.Env.State == types.CEStateEnabled
+}
+
+// ComputeType returns EC2, SPOT, FARGATE or FARGATE_SPOT, or "" for
+// unmanaged environments.
+func (r *ComputeEnvironmentResource) ComputeType() string {
+	if r.Env != nil && r.Env.ComputeResources != nil {
+		return string(r.Env.ComputeResources.Type)
+	}
+	return ""
+}
+
+// IsFargate reports whether the environment runs jobs on Fargate.
+func (r *ComputeEnvironmentResource) IsFargate() bool {
+	t := r.ComputeType()
+	return t == string(types.CRTypeFargate) || t == string(types.CRTypeFargateSpot)
+}
+
+// VCPUs returns the desired and maximum vCPUs of a managed environment.
+func (r *ComputeEnvironmentResource) VCPUs() (desired, maximum int32, ok bool) {
+	if r.Env == nil || r.Env.ComputeResources == nil {
+		return 0, 0, false
+	}
+	cr := r.Env.ComputeResources
+	return appaws.Int32(cr.DesiredvCpus), appaws.Int32(cr.MaxvCpus), true
+}
+
+// AtMaxCapacity reports whether the environment has scaled to its maximum
+// vCPUs, so queued jobs wait for running ones to finish.
+func (r *ComputeEnvironmentResource) AtMaxCapacity() bool {
+	desired, maximum, ok := r.VCPUs()
+	return ok && maximum > 0 && desired >= maximum
+}
+
+// UsedVCPUs returns the vCPUs reserved by running jobs and the vCPUs
+// registered across the environment's instances.
+func (r *ComputeEnvironmentResource) UsedVCPUs() (used, registered float64) {
+	for _, inst := range r.Instances {
+		used += float64(inst.RegisteredCPU-inst.RemainingCPU) / cpuUnitsPerVCPU
+		registered += float64(inst.RegisteredCPU) / cpuUnitsPerVCPU
+	}
+	return used, registered
+}
+
+// InstanceCount is the number of instances of one type in one Auto Scaling
+// group.
+type InstanceCount struct {
+	InstanceType     string
+	AutoScalingGroup string
+	Count            int
+}
+
+// InstanceBreakdown counts the environment's instances by type and Auto
+// Scaling group.
+func (r *ComputeEnvironmentResource) InstanceBreakdown() []InstanceCount {
+	var counts []InstanceCount
+	for _, inst := range r.Instances {
+		i := slices.IndexFunc(counts, func(c InstanceCount) bool {
+			return c.InstanceType == inst.InstanceType && c.AutoScalingGroup == inst.AutoScalingGroup
+		})
+		if i < 0 {
+			counts = append(counts, InstanceCount{InstanceType: inst.InstanceType, AutoScalingGroup: inst.AutoScalingGroup})
+			i = len(counts) - 1
+		}
+		counts[i].Count++
+	}
+	slices.SortFunc(counts, func(a, b InstanceCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.InstanceType, b.InstanceType), cmp.Compare(a.AutoScalingGroup, b.AutoScalingGroup))
+	})
+	return counts
+}
@@ -1,10 +1,21 @@
 package computeenvironments
 
 import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
+// Ensure ComputeEnvironmentRenderer implements render.Navigator
+var _ render.Navigator = (*ComputeEnvironmentRenderer)(nil)
+
 // ComputeEnvironmentRenderer renders Batch compute environments.
 type ComputeEnvironmentRenderer struct {
 	render.BaseRenderer
@@ -19,8 +30,11 @@ func NewComputeEnvironmentRenderer() render.Renderer {
 			Cols: []render.Column{
 				{Name: "NAME", Width: 40, Getter: func(r dao.Resource) string { return r.GetID() }},
 				{Name: "TYPE", Width: 12, Getter: getType},
+				{Name: "COMPUTE", Width: 12, Getter: getComputeType},
 				{Name: "STATE", Width: 12, Getter: getState},
 				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "VCPUS", Width: 11, Getter: getVCPUs},
+				{Name: "UTIL", Width: 6, Getter: getUtilization},
 			},
 		},
 	}
@@ -34,6 +48,47 @@ func getType(r dao.Resource) string {
 	return env.Type()
 }
 
+func getComputeType(r dao.Resource) string {
+	env, ok := r.(*ComputeEnvironmentResource)
+	if !ok || env.ComputeType() == "" {
+		return "-"
+	}
+	return env.ComputeType()
+}
+
+// getVCPUs shows desired of maximum vCPUs
+func getVCPUs(r dao.Resource) string {
+	env, ok := r.(*ComputeEnvironmentResource)
+	if !ok {
+		return ""
+	}
+	desired, maximum, ok := env.VCPUs()
+	if !ok {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d", desired, maximum)
+}
+
+func getUtilization(r dao.Resource) string {
+	env, ok := r.(*ComputeEnvironmentResource)
+	if !ok {
+		return ""
+	}
+	desired, maximum, ok := env.VCPUs()
+	if !ok || maximum == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", desired*100/maximum)
+}
+
+// capacityStyle warns when the environment cannot scale further
+func capacityStyle(env *ComputeEnvironmentResource) lipgloss.Style {
+	if env.AtMaxCapacity() {
+		return ui.WarningStyle()
+	}
+	return lipgloss.NewStyle()
+}
+
 func getState(r dao.Resource) string {
 	env, ok := r.(*ComputeEnvironmentResource)
 	if !ok {
@@ -69,6 +124,52 @@ func (r *ComputeEnvironmentRenderer) RenderDetail(resource dao.Resource) string
 	d.Field("State", env.State())
 	d.Field("Status", env.Status())
 
+	if reason := appaws.Str(env.Env.StatusReason); reason != "" {
+		d.Field("Status Reason", reason)
+	}
+
+	if cr := env.Env.ComputeResources; cr != nil {
+		desired, maximum, _ := env.VCPUs()
+		d.Section("Capacity")
+		d.Field("Compute Type", env.ComputeType())
+		d.Field("Min vCPUs", fmt.Sprintf("%d", appaws.Int32(cr.MinvCpus)))
+		d.FieldStyled("Desired vCPUs", fmt.Sprintf("%d of %d", desired, maximum), capacityStyle(env))
+		if env.AtMaxCapacity() {
+			d.DimIndent("At max vCPUs; queued jobs wait until running jobs finish")
+		}
+		if cr.AllocationStrategy != "" {
+			d.Field("Allocation Strategy", string(cr.AllocationStrategy))
+		}
+		if len(cr.InstanceTypes) > 0 {
+			d.Field("Instance Types", strings.Join(cr.InstanceTypes, ", "))
+		}
+		if cr.BidPercentage != nil {
+			d.Field("Spot Bid", fmt.Sprintf("%d%% of On-Demand", *cr.BidPercentage))
+		}
+	}
+
+	if cluster := appaws.Str(env.Env.EcsClusterArn); cluster != "" {
+		d.Section("Instances")
+		d.Field("ECS Cluster", appaws.ExtractResourceName(cluster))
+		switch {
+		case env.IsFargate():
+			d.Dim("Fargate jobs run without instances")
+		case len(env.Instances) == 0:
+			d.Dim("No instances running")
+		default:
+			used, registered := env.UsedVCPUs()
+			d.Field("vCPUs In Use", fmt.Sprintf("%.1f of %.1f registered", used, registered))
+			for _, c := range env.InstanceBreakdown() {
+				label := cmp.Or(c.InstanceType, "unknown type")
+				value := fmt.Sprintf("%d", c.Count)
+				if c.AutoScalingGroup != "" {
+					value += "  " + c.AutoScalingGroup
+				}
+				d.Field(label, value)
+			}
+		}
+	}
+
 	// IAM
 	if env.ServiceRole() != "" {
 		d.Section("IAM")
@@ -85,10 +186,39 @@ func (r *ComputeEnvironmentRenderer) RenderSummary(resource dao.Resource) []rend
 		return r.BaseRenderer.RenderSummary(resource)
 	}
 
-	return []render.SummaryField{
+	fields := []render.SummaryField{
 		{Label: "Name", Value: env.GetID()},
 		{Label: "Type", Value: env.Type()},
 		{Label: "State", Value: env.State()},
 		{Label: "Status", Value: env.Status()},
 	}
+	if desired, maximum, ok := env.VCPUs(); ok {
+		fields = append(fields, render.SummaryField{
+			Label: "vCPUs", Value: fmt.Sprintf("%d/%d", desired, maximum), Style: capacityStyle(env),
+		})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts for a compute environment.
+func (r *ComputeEnvironmentRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	env, ok := resource.(*ComputeEnvironmentResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if cluster := appaws.Str(env.Env.EcsClusterArn); cluster != "" {
+		navs = append(navs, render.Navigation{
+			Key: "c", Label: "ECS Cluster", Service: "ecs", Resource: "clusters",
+			FilterField: "ClusterName", FilterValue: appaws.ExtractResourceName(cluster),
+		})
+	}
+	if counts := env.InstanceBreakdown(); len(counts) > 0 && counts[0].AutoScalingGroup != "" {
+		navs = append(navs, render.Navigation{
+			Key: "g", Label: "Auto Scaling Group", Service: "autoscaling", Resource: "groups",
+			FilterField: "AutoScalingGroupName", FilterValue: counts[0].AutoScalingGroup,
+		})
+	}
+	return navs
 }
@@ -0,0 +1,75 @@
+package computeenvironments
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func TestNewInstance(t *testing.T) {
+	inst := newInstance(ecstypes.ContainerInstance{
+		Ec2InstanceId:       aws.String("i-1"),
+		RunningTasksCount:   2,
+		RegisteredResources: []ecstypes.Resource{{Name: aws.String("MEMORY"), IntegerValue: 8000}, {Name: aws.String("CPU"), IntegerValue: 4096}},
+		RemainingResources:  []ecstypes.Resource{{Name: aws.String("CPU"), IntegerValue: 1024}},
+		Attributes: []ecstypes.Attribute{
+			{Name: aws.String("ecs.instance-type"), Value: aws.String("c5.xlarge")},
+			{Name: aws.String("ecs.availability-zone"), Value: aws.String("us-east-1a")},
+		},
+	})
+	if inst.ID != "i-1" || inst.InstanceType != "c5.xlarge" || inst.AvailabilityZone != "us-east-1a" {
+		t.Errorf("instance = %+v", inst)
+	}
+	if inst.RegisteredCPU != 4096 || inst.RemainingCPU != 1024 {
+		t.Errorf("CPU = %d registered, %d remaining; want 4096, 1024", inst.RegisteredCPU, inst.RemainingCPU)
+	}
+}
+
+func TestCapacity(t *testing.T) {
+	env := NewComputeEnvironmentResource(types.ComputeEnvironmentDetail{
+		ComputeEnvironmentName: aws.String("ce"),
+		State:                  types.CEStateEnabled,
+		ComputeResources: &types.ComputeResource{
+			Type:         types.CRTypeSpot,
+			DesiredvCpus: aws.Int32(16),
+			MaxvCpus:     aws.Int32(16),
+		},
+	})
+	env.Instances = []Instance{
+		{ID: "i-1", InstanceType: "c5.xlarge", AutoScalingGroup: "asg-a", RegisteredCPU: 4096, RemainingCPU: 0},
+		{ID: "i-2", InstanceType: "c5.2xlarge", AutoScalingGroup: "asg-a", RegisteredCPU: 8192, RemainingCPU: 2048},
+		{ID: "i-3", InstanceType: "c5.xlarge", AutoScalingGroup: "asg-a", RegisteredCPU: 4096, RemainingCPU: 4096},
+	}
+
+	if !env.IsEnabled() || env.IsFargate() || !env.AtMaxCapacity() {
+		t.Errorf("enabled=%v fargate=%v atMax=%v", env.IsEnabled(), env.IsFargate(), env.AtMaxCapacity())
+	}
+	used, registered := env.UsedVCPUs()
+	if used != 10 || registered != 16 {
+		t.Errorf("UsedVCPUs = %v of %v, want 10 of 16", used, registered)
+	}
+
+	counts := env.InstanceBreakdown()
+	if len(counts) != 2 {
+		t.Fatalf("InstanceBreakdown = %+v, want 2 entries", counts)
+	}
+	if counts[0].InstanceType != "c5.xlarge" || counts[0].Count != 2 || counts[1].InstanceType != "c5.2xlarge" {
+		t.Errorf("InstanceBreakdown = %+v, want c5.xlarge×2 first", counts)
+	}
+}
+
+func TestUnmanagedCapacity(t *testing.T) {
+	env := NewComputeEnvironmentResource(types.ComputeEnvironmentDetail{
+		ComputeEnvironmentName: aws.String("byo"),
+		Type:                   types.CETypeUnmanaged,
+		State:                  types.CEStateDisabled,
+	})
+	if _, _, ok := env.VCPUs(); ok {
+		t.Error("VCPUs ok for an unmanaged environment")
+	}
+	if env.AtMaxCapacity() || env.IsEnabled() || env.ComputeType() != "" {
+		t.Errorf("atMax=%v enabled=%v type=%q", env.AtMaxCapacity(), env.IsEnabled(), env.ComputeType())
+	}
+}
@@ -21,13 +21,13 @@ type JobDefinitionDAO struct {
 
 // NewJobDefinitionDAO creates a new JobDefinitionDAO.
 func NewJobDefinitionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, batch.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &JobDefinitionDAO{
 		BaseDAO: dao.NewBaseDAO("batch", "job-definitions"),
-		client:  batch.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
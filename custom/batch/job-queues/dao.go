@@ -20,13 +20,13 @@ type JobQueueDAO struct {
 
 // NewJobQueueDAO creates a new JobQueueDAO.
 func NewJobQueueDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, batch.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &JobQueueDAO{
 		BaseDAO: dao.NewBaseDAO("batch", "job-queues"),
-		client:  batch.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
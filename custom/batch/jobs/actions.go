@@ -0,0 +1,144 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+
+	batchClient "github.com/clawscli/claws/custom/batch"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Batch jobs
+	action.Global.Register("batch", "jobs", []action.Action{
+		{
+			Name:      "Terminate",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "TerminateJob",
+			Confirm:   action.ConfirmDangerous,
+			Input:     &action.InputSpec{Label: "Reason", Placeholder: "optional", Optional: true},
+			Filter: func(r dao.Resource) bool {
+				j, ok := r.(*JobResource)
+				return ok && j.IsActive()
+			},
+		},
+		{
+			Name:      "Retry",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RetryJob",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				j, ok := r.(*JobResource)
+				return ok && !j.IsActive()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("batch", "jobs", executeJobAction)
+}
+
+// executeJobAction executes an action on a Batch job.
+func executeJobAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	job, ok := resource.(*JobResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := batchClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	jobID := job.GetID()
+
+	switch act.Operation {
+	case "TerminateJob":
+		reason := action.InputFromContext(ctx)
+		if reason == "" {
+			reason = "Terminated by claws"
+		}
+		_, err := client.TerminateJob(ctx, &batch.TerminateJobInput{
+			JobId:  &jobID,
+			Reason: &reason,
+		})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("terminate job: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Terminating job %s", jobID)}
+
+	case "RetryJob":
+		// List results lack the job definition details, so always describe.
+		output, err := client.DescribeJobs(ctx, &batch.DescribeJobsInput{Jobs: []string{jobID}})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("describe job: %w", err)}
+		}
+		if len(output.Jobs) == 0 {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("job not found: %s", jobID)}
+		}
+
+		submitted, err := client.SubmitJob(ctx, resubmitInput(output.Jobs[0]))
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("submit job: %w", err)}
+		}
+		return action.ActionResult{
+			Success: true,
+			Message: fmt.Sprintf("Submitted %s as %s", appaws.Str(submitted.JobName), appaws.Str(submitted.JobId)),
+		}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// resubmitInput builds a SubmitJob request that re-runs a job with the same
+// definition, parameters, and container overrides. Dependencies are dropped
+// since the original upstream jobs have already run.
+func resubmitInput(job types.JobDetail) *batch.SubmitJobInput {
+	input := &batch.SubmitJobInput{
+		JobName:         job.JobName,
+		JobQueue:        job.JobQueue,
+		JobDefinition:   job.JobDefinition,
+		Parameters:      job.Parameters,
+		RetryStrategy:   job.RetryStrategy,
+		Timeout:         job.Timeout,
+		PropagateTags:   job.PropagateTags,
+		ShareIdentifier: job.ShareIdentifier,
+		Tags:            job.Tags,
+	}
+	if job.SchedulingPriority != nil {
+		input.SchedulingPriorityOverride = job.SchedulingPriority
+	}
+	if job.ArrayProperties != nil && appaws.Int32(job.ArrayProperties.Size) > 0 {
+		input.ArrayProperties = &types.ArrayProperties{Size: job.ArrayProperties.Size}
+	}
+	if c := job.Container; c != nil {
+		input.ContainerOverrides = &types.ContainerOverrides{
+			Command:              c.Command,
+			Environment:          userEnvironment(c.Environment),
+			ResourceRequirements: c.ResourceRequirements,
+		}
+	}
+	return input
+}
+
+// userEnvironment drops the AWS_BATCH_* variables Batch injects into every
+// job; SubmitJob rejects overrides using that reserved prefix.
+func userEnvironment(env []types.KeyValuePair) []types.KeyValuePair {
+	var out []types.KeyValuePair
+	for _, kv := range env {
+		if strings.HasPrefix(appaws.Str(kv.Name), "AWS_BATCH") {
+			continue
+		}
+		out = append(out, kv)
+	}
+	return out
+}
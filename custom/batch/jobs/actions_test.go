@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+)
+
+func TestResubmitInput(t *testing.T) {
+	job := types.JobDetail{
+		JobName:         aws.String("nightly"),
+		JobQueue:        aws.String("arn:aws:batch:us-east-1:123456789012:job-queue/q"),
+		JobDefinition:   aws.String("arn:aws:batch:us-east-1:123456789012:job-definition/d:3"),
+		Parameters:      map[string]string{"date": "2026-01-01"},
+		DependsOn:       []types.JobDependency{{JobId: aws.String("upstream")}},
+		ArrayProperties: &types.ArrayPropertiesDetail{Size: aws.Int32(10), Index: aws.Int32(0)},
+		Container: &types.ContainerDetail{
+			Command: []string{"run", "--all"},
+			Environment: []types.KeyValuePair{
+				{Name: aws.String("AWS_BATCH_JOB_ID"), Value: aws.String("abc")},
+				{Name: aws.String("STAGE"), Value: aws.String("prod")},
+			},
+		},
+	}
+
+	input := resubmitInput(job)
+	if aws.ToString(input.JobName) != "nightly" || input.Parameters["date"] != "2026-01-01" {
+		t.Errorf("name/parameters not copied: %+v", input)
+	}
+	if input.DependsOn != nil {
+		t.Errorf("DependsOn = %v, want nil", input.DependsOn)
+	}
+	if input.ArrayProperties == nil || aws.ToInt32(input.ArrayProperties.Size) != 10 {
+		t.Errorf("ArrayProperties = %+v, want size 10", input.ArrayProperties)
+	}
+	env := input.ContainerOverrides.Environment
+	if len(env) != 1 || aws.ToString(env[0].Name) != "STAGE" {
+		t.Errorf("Environment = %+v, want only STAGE", env)
+	}
+}
+
+func TestJobResourceLogGroupName(t *testing.T) {
+	r := &JobResource{}
+	if got := r.LogGroupName(); got != defaultLogGroup {
+		t.Errorf("LogGroupName() = %q, want %q", got, defaultLogGroup)
+	}
+
+	r.Container = &types.ContainerDetail{
+		LogConfiguration: &types.LogConfiguration{
+			LogDriver: types.LogDriverAwslogs,
+			Options:   map[string]string{"awslogs-group": "/custom/group"},
+		},
+	}
+	if got := r.LogGroupName(); got != "/custom/group" {
+		t.Errorf("LogGroupName() = %q, want /custom/group", got)
+	}
+}
+
+func TestAttemptSummary(t *testing.T) {
+	a := types.AttemptDetail{
+		Container: &types.AttemptContainerDetail{ExitCode: aws.Int32(137), Reason: aws.String("OutOfMemoryError")},
+		StartedAt: aws.Int64(1_000),
+		StoppedAt: aws.Int64(61_000),
+	}
+	got := AttemptSummary(a)
+	for _, want := range []string{"exit 137", "OutOfMemoryError", "(1m0s)"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("AttemptSummary() = %q, missing %q", got, want)
+		}
+	}
+	if got := AttemptSummary(types.AttemptDetail{}); got != "-" {
+		t.Errorf("AttemptSummary(empty) = %q, want -", got)
+	}
+}
@@ -3,6 +3,7 @@ package jobs
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/batch"
@@ -21,13 +22,13 @@ type JobDAO struct {
 
 // NewJobDAO creates a new JobDAO.
 func NewJobDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, batch.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &JobDAO{
 		BaseDAO: dao.NewBaseDAO("batch", "jobs"),
-		client:  batch.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -250,3 +251,74 @@ func (r *JobResource) GetPlatformCapabilities() []string {
 func (r *JobResource) GetTags() map[string]string {
 	return r.Tags
 }
+
+// defaultLogGroup is where Batch sends container logs unless the job
+// definition configures another awslogs group.
+const defaultLogGroup = "/aws/batch/job"
+
+// IsActive reports whether the job has not yet reached a terminal state.
+func (r *JobResource) IsActive() bool {
+	switch r.Status() {
+	case string(types.JobStatusSucceeded), string(types.JobStatusFailed), "":
+		return false
+	default:
+		return true
+	}
+}
+
+// LogGroupName returns the CloudWatch log group of the job's container.
+func (r *JobResource) LogGroupName() string {
+	if r.Container != nil && r.Container.LogConfiguration != nil &&
+		r.Container.LogConfiguration.LogDriver == types.LogDriverAwslogs {
+		if group := r.Container.LogConfiguration.Options["awslogs-group"]; group != "" {
+			return group
+		}
+	}
+	return defaultLogGroup
+}
+
+// LogStreamName returns the CloudWatch log stream of the latest attempt.
+func (r *JobResource) LogStreamName() string {
+	if r.Container != nil {
+		return appaws.Str(r.Container.LogStreamName)
+	}
+	return ""
+}
+
+// LastEventTimestamp returns when the job stopped in milliseconds, so the
+// log view opens on a finished job's output.
+func (r *JobResource) LastEventTimestamp() int64 {
+	if r.Job != nil && r.Job.StoppedAt != nil {
+		return *r.Job.StoppedAt
+	}
+	return 0
+}
+
+// AttemptSummary returns a one-line description of an attempt: exit code,
+// reason, and run time.
+func AttemptSummary(a types.AttemptDetail) string {
+	var parts []string
+	if a.Container != nil {
+		if a.Container.ExitCode != nil {
+			parts = append(parts, fmt.Sprintf("exit %d", *a.Container.ExitCode))
+		}
+		if reason := appaws.Str(a.Container.Reason); reason != "" {
+			parts = append(parts, reason)
+		}
+	}
+	if reason := appaws.Str(a.StatusReason); reason != "" {
+		parts = append(parts, reason)
+	}
+	if a.StartedAt != nil {
+		started := time.UnixMilli(*a.StartedAt)
+		span := started.Format("2006-01-02 15:04:05")
+		if a.StoppedAt != nil {
+			span += " (" + time.UnixMilli(*a.StoppedAt).Sub(started).Round(time.Second).String() + ")"
+		}
+		parts = append(parts, span)
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, " · ")
+}
@@ -6,8 +6,12 @@ import (
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
+// Ensure JobRenderer implements render.Navigator
+var _ render.Navigator = (*JobRenderer)(nil)
+
 // JobRenderer renders Batch jobs.
 type JobRenderer struct {
 	render.BaseRenderer
@@ -148,17 +152,14 @@ func (r *JobRenderer) RenderDetail(resource dao.Resource) string {
 		d.Section("Attempts")
 		for i, attempt := range attempts {
 			label := fmt.Sprintf("Attempt %d", i+1)
-			if attempt.Container != nil && attempt.Container.ExitCode != nil {
-				d.Field(label+" Exit Code", fmt.Sprintf("%d", *attempt.Container.ExitCode))
-			}
-			if attempt.Container != nil && attempt.Container.Reason != nil {
-				d.Field(label+" Reason", *attempt.Container.Reason)
-			}
-			if attempt.StartedAt != nil {
-				d.Field(label+" Started", fmt.Sprintf("%d", *attempt.StartedAt))
+			summary := AttemptSummary(attempt)
+			if attempt.Container != nil && attempt.Container.ExitCode != nil && *attempt.Container.ExitCode != 0 {
+				d.FieldStyled(label, summary, ui.DangerStyle())
+			} else {
+				d.Field(label, summary)
 			}
-			if attempt.StoppedAt != nil {
-				d.Field(label+" Stopped", fmt.Sprintf("%d", *attempt.StoppedAt))
+			if attempt.Container != nil && attempt.Container.LogStreamName != nil {
+				d.Field("  Log Stream", *attempt.Container.LogStreamName)
 			}
 		}
 	}
@@ -199,3 +200,19 @@ func (r *JobRenderer) RenderSummary(resource dao.Resource) []render.SummaryField
 		{Label: "Status", Value: job.Status()},
 	}
 }
+
+// Navigations returns navigation shortcuts for a job.
+func (r *JobRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	job, ok := resource.(*JobResource)
+	if !ok || job.LogStreamName() == "" {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key:      "t",
+			Label:    "Tail Logs",
+			ViewType: render.ViewTypeLogView,
+		},
+	}
+}
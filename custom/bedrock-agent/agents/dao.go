@@ -20,13 +20,13 @@ type AgentDAO struct {
 
 // NewAgentDAO creates a new AgentDAO
 func NewAgentDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagent.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &AgentDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock-agent", "agents"),
-		client:  bedrockagent.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,14 @@
+package bedrockagent
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns a Bedrock Agent client configured for the current context
+func GetClient(ctx context.Context) (*bedrockagent.Client, error) {
+	return appaws.Client(ctx, bedrockagent.NewFromConfig)
+}
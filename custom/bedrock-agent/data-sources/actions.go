@@ -0,0 +1,71 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+
+	bedrockClient "github.com/clawscli/claws/custom/bedrock-agent"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Bedrock data sources
+	action.Global.Register("bedrock-agent", "data-sources", []action.Action{
+		{
+			Name:      "Start Ingestion Job",
+			Shortcut:  "I",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartIngestionJob",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Description", Placeholder: "optional", Optional: true},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("bedrock-agent", "data-sources", executeDataSourceAction)
+}
+
+// executeDataSourceAction executes an action on a Bedrock data source
+func executeDataSourceAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	ds, ok := resource.(*DataSourceResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := bedrockClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	switch act.Operation {
+	case "StartIngestionJob":
+		kbID := ds.KnowledgeBaseId()
+		dsID := ds.GetID()
+		input := &bedrockagent.StartIngestionJobInput{
+			KnowledgeBaseId: &kbID,
+			DataSourceId:    &dsID,
+		}
+		if desc := action.InputFromContext(ctx); desc != "" {
+			input.Description = &desc
+		}
+		output, err := client.StartIngestionJob(ctx, input)
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("start ingestion job: %w", err)}
+		}
+		jobID := ""
+		if output.IngestionJob != nil {
+			jobID = appaws.Str(output.IngestionJob.IngestionJobId)
+		}
+		return action.ActionResult{
+			Success: true,
+			Message: fmt.Sprintf("Started ingestion job %s - press i to follow progress", jobID),
+		}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
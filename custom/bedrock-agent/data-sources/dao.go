@@ -22,13 +22,13 @@ type DataSourceDAO struct {
 
 // NewDataSourceDAO creates a new DataSourceDAO
 func NewDataSourceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagent.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &DataSourceDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock-agent", "data-sources"),
-		client:  bedrockagent.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
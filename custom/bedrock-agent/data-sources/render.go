@@ -2,11 +2,15 @@ package datasources
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
 
+// ingestionReloadInterval is how often ingestion job status is polled.
+const ingestionReloadInterval = 5 * time.Second
+
 // DataSourceRenderer renders Bedrock Data Source resources
 // Ensure DataSourceRenderer implements render.Navigator
 var _ render.Navigator = (*DataSourceRenderer)(nil)
@@ -139,5 +143,20 @@ func (r *DataSourceRenderer) RenderSummary(resource dao.Resource) []render.Summa
 
 // Navigations returns navigation shortcuts
 func (r *DataSourceRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	return nil
+	ds, ok := resource.(*DataSourceResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:            "i",
+			Label:          "Ingestion Jobs",
+			Service:        "bedrock-agent",
+			Resource:       "ingestion-jobs",
+			FilterField:    "DataSourceId",
+			FilterValue:    ds.GetID(),
+			AutoReload:     true,
+			ReloadInterval: ingestionReloadInterval,
+		},
+	}
 }
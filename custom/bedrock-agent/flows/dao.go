@@ -20,13 +20,13 @@ type FlowDAO struct {
 
 // NewFlowDAO creates a new FlowDAO
 func NewFlowDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagent.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &FlowDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock-agent", "flows"),
-		client:  bedrockagent.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,62 @@
+package ingestionjobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+
+	bedrockClient "github.com/clawscli/claws/custom/bedrock-agent"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for ingestion jobs
+	action.Global.Register("bedrock-agent", "ingestion-jobs", []action.Action{
+		{
+			Name:      "Stop",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopIngestionJob",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				j, ok := r.(*IngestionJobResource)
+				return ok && j.IsRunning()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("bedrock-agent", "ingestion-jobs", executeIngestionJobAction)
+}
+
+// executeIngestionJobAction executes an action on an ingestion job
+func executeIngestionJobAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	job, ok := resource.(*IngestionJobResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := bedrockClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	switch act.Operation {
+	case "StopIngestionJob":
+		id := job.GetID()
+		_, err := client.StopIngestionJob(ctx, &bedrockagent.StopIngestionJobInput{
+			KnowledgeBaseId: &job.KnowledgeBaseId,
+			DataSourceId:    &job.DataSourceId,
+			IngestionJobId:  &id,
+		})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("stop ingestion job: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Stopping ingestion job %s", id)}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
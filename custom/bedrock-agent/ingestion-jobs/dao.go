@@ -0,0 +1,266 @@
+package ingestionjobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// IngestionJobDAO provides data access for Bedrock knowledge base ingestion jobs
+type IngestionJobDAO struct {
+	dao.BaseDAO
+	client *bedrockagent.Client
+
+	// knowledgeBases caches data source ID -> knowledge base ID lookups so
+	// auto-reload does not rescan knowledge bases on every refresh.
+	mu             sync.Mutex
+	knowledgeBases map[string]string
+}
+
+// NewIngestionJobDAO creates a new IngestionJobDAO
+func NewIngestionJobDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, bedrockagent.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &IngestionJobDAO{
+		BaseDAO:        dao.NewBaseDAO("bedrock-agent", "ingestion-jobs"),
+		client:         client,
+		knowledgeBases: make(map[string]string),
+	}, nil
+}
+
+// List returns ingestion jobs of a data source, newest first (requires DataSourceId filter)
+func (d *IngestionJobDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	kbID, dsID, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := appaws.Paginate(ctx, func(token *string) ([]types.IngestionJobSummary, *string, error) {
+		output, err := d.client.ListIngestionJobs(ctx, &bedrockagent.ListIngestionJobsInput{
+			KnowledgeBaseId: &kbID,
+			DataSourceId:    &dsID,
+			SortBy: &types.IngestionJobSortBy{
+				Attribute: types.IngestionJobSortByAttributeStartedAt,
+				Order:     types.SortOrderDescending,
+			},
+			MaxResults: appaws.Int32Ptr(100),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list ingestion jobs")
+		}
+		return output.IngestionJobSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(jobs))
+	for i, job := range jobs {
+		resources[i] = NewIngestionJobResource(job)
+	}
+	return resources, nil
+}
+
+// Get returns an ingestion job with its failure reasons
+func (d *IngestionJobDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	kbID, dsID, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := d.client.GetIngestionJob(ctx, &bedrockagent.GetIngestionJobInput{
+		KnowledgeBaseId: &kbID,
+		DataSourceId:    &dsID,
+		IngestionJobId:  &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get ingestion job %s", id)
+	}
+	if output.IngestionJob == nil {
+		return nil, fmt.Errorf("ingestion job not found: %s", id)
+	}
+	return NewIngestionJobResourceFromDetail(output.IngestionJob), nil
+}
+
+// Delete is not supported; use the stop action for running jobs.
+func (d *IngestionJobDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for ingestion jobs - use stop action")
+}
+
+func (d *IngestionJobDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// target returns the knowledge base and data source for the current filter.
+// Navigation carries only the data source ID, so the owning knowledge base is
+// taken from the KnowledgeBaseId filter when present and looked up otherwise.
+func (d *IngestionJobDAO) target(ctx context.Context) (kbID, dsID string, err error) {
+	dsID = dao.GetFilterFromContext(ctx, "DataSourceId")
+	if dsID == "" {
+		return "", "", fmt.Errorf("DataSourceId filter required - navigate from a data source")
+	}
+	if kbID = dao.GetFilterFromContext(ctx, "KnowledgeBaseId"); kbID != "" {
+		return kbID, dsID, nil
+	}
+
+	d.mu.Lock()
+	kbID = d.knowledgeBases[dsID]
+	d.mu.Unlock()
+	if kbID != "" {
+		return kbID, dsID, nil
+	}
+
+	kbID, err = d.findKnowledgeBase(ctx, dsID)
+	if err != nil {
+		return "", "", err
+	}
+	d.mu.Lock()
+	d.knowledgeBases[dsID] = kbID
+	d.mu.Unlock()
+	return kbID, dsID, nil
+}
+
+func (d *IngestionJobDAO) findKnowledgeBase(ctx context.Context, dsID string) (string, error) {
+	kbs, err := appaws.Paginate(ctx, func(token *string) ([]types.KnowledgeBaseSummary, *string, error) {
+		output, err := d.client.ListKnowledgeBases(ctx, &bedrockagent.ListKnowledgeBasesInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list knowledge bases")
+		}
+		return output.KnowledgeBaseSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, kb := range kbs {
+		kbID := appaws.Str(kb.KnowledgeBaseId)
+		sources, err := appaws.Paginate(ctx, func(token *string) ([]types.DataSourceSummary, *string, error) {
+			output, err := d.client.ListDataSources(ctx, &bedrockagent.ListDataSourcesInput{
+				KnowledgeBaseId: &kbID,
+				NextToken:       token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrapf(err, "list data sources of %s", kbID)
+			}
+			return output.DataSourceSummaries, output.NextToken, nil
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, ds := range sources {
+			if appaws.Str(ds.DataSourceId) == dsID {
+				return kbID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("knowledge base for data source %s not found", dsID)
+}
+
+// IngestionJobResource represents a Bedrock knowledge base ingestion job
+type IngestionJobResource struct {
+	dao.BaseResource
+	KnowledgeBaseId string
+	DataSourceId    string
+	JobStatus       types.IngestionJobStatus
+	Description     string
+	Statistics      *types.IngestionJobStatistics
+	StartedAt       *time.Time
+	UpdatedAt       *time.Time
+
+	// Populated by Get only
+	FailureReasons []string
+}
+
+// NewIngestionJobResource creates a new IngestionJobResource from list output
+func NewIngestionJobResource(job types.IngestionJobSummary) *IngestionJobResource {
+	id := appaws.Str(job.IngestionJobId)
+	return &IngestionJobResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: job,
+		},
+		KnowledgeBaseId: appaws.Str(job.KnowledgeBaseId),
+		DataSourceId:    appaws.Str(job.DataSourceId),
+		JobStatus:       job.Status,
+		Description:     appaws.Str(job.Description),
+		Statistics:      job.Statistics,
+		StartedAt:       job.StartedAt,
+		UpdatedAt:       job.UpdatedAt,
+	}
+}
+
+// NewIngestionJobResourceFromDetail creates an IngestionJobResource from detail output
+func NewIngestionJobResourceFromDetail(job *types.IngestionJob) *IngestionJobResource {
+	id := appaws.Str(job.IngestionJobId)
+	return &IngestionJobResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: job,
+		},
+		KnowledgeBaseId: appaws.Str(job.KnowledgeBaseId),
+		DataSourceId:    appaws.Str(job.DataSourceId),
+		JobStatus:       job.Status,
+		Description:     appaws.Str(job.Description),
+		Statistics:      job.Statistics,
+		StartedAt:       job.StartedAt,
+		UpdatedAt:       job.UpdatedAt,
+		FailureReasons:  job.FailureReasons,
+	}
+}
+
+// Status returns the ingestion job status
+func (r *IngestionJobResource) Status() string {
+	return string(r.JobStatus)
+}
+
+// IsRunning reports whether the job is starting or in progress
+func (r *IngestionJobResource) IsRunning() bool {
+	return r.JobStatus == types.IngestionJobStatusStarting || r.JobStatus == types.IngestionJobStatusInProgress
+}
+
+// Duration returns how long the job ran, or has been running so far
+func (r *IngestionJobResource) Duration() time.Duration {
+	if r.StartedAt == nil {
+		return 0
+	}
+	if r.IsRunning() || r.UpdatedAt == nil {
+		return time.Since(*r.StartedAt)
+	}
+	return r.UpdatedAt.Sub(*r.StartedAt)
+}
+
+// Indexed returns the number of new and modified documents indexed
+func (r *IngestionJobResource) Indexed() int64 {
+	if r.Statistics == nil {
+		return 0
+	}
+	return r.Statistics.NumberOfNewDocumentsIndexed + r.Statistics.NumberOfModifiedDocumentsIndexed
+}
+
+// Failed returns the number of documents that failed to index
+func (r *IngestionJobResource) Failed() int64 {
+	if r.Statistics == nil {
+		return 0
+	}
+	return r.Statistics.NumberOfDocumentsFailed
+}
@@ -0,0 +1,66 @@
+package ingestionjobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestIngestionJobResource(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := NewIngestionJobResource(types.IngestionJobSummary{
+		IngestionJobId:  aws.String("JOB1"),
+		KnowledgeBaseId: aws.String("KB1"),
+		DataSourceId:    aws.String("DS1"),
+		Status:          types.IngestionJobStatusComplete,
+		StartedAt:       aws.Time(start),
+		UpdatedAt:       aws.Time(start.Add(90 * time.Second)),
+		Statistics: &types.IngestionJobStatistics{
+			NumberOfNewDocumentsIndexed:      5,
+			NumberOfModifiedDocumentsIndexed: 2,
+			NumberOfDocumentsFailed:          1,
+		},
+	})
+
+	if r.IsRunning() {
+		t.Error("IsRunning() = true for completed job")
+	}
+	if got := r.Duration(); got != 90*time.Second {
+		t.Errorf("Duration() = %v, want 1m30s", got)
+	}
+	if got := r.Indexed(); got != 7 {
+		t.Errorf("Indexed() = %d, want 7", got)
+	}
+	if got := r.Failed(); got != 1 {
+		t.Errorf("Failed() = %d, want 1", got)
+	}
+
+	empty := NewIngestionJobResource(types.IngestionJobSummary{Status: types.IngestionJobStatusStarting})
+	if !empty.IsRunning() || empty.Indexed() != 0 || empty.Duration() != 0 {
+		t.Errorf("unexpected values for empty job: running=%v indexed=%d duration=%v", empty.IsRunning(), empty.Indexed(), empty.Duration())
+	}
+}
+
+func TestIngestionJobDAOTarget(t *testing.T) {
+	d := &IngestionJobDAO{knowledgeBases: map[string]string{"DS1": "KB1"}}
+
+	if _, _, err := d.target(context.Background()); err == nil {
+		t.Error("target() without filter should fail")
+	}
+
+	ctx := dao.WithFilter(context.Background(), "DataSourceId", "DS1")
+	kb, ds, err := d.target(ctx)
+	if err != nil || kb != "KB1" || ds != "DS1" {
+		t.Errorf("target() = %q, %q, %v; want KB1, DS1 from cache", kb, ds, err)
+	}
+
+	ctx = dao.WithFilter(ctx, "KnowledgeBaseId", "KB2")
+	if kb, _, _ := d.target(ctx); kb != "KB2" {
+		t.Errorf("target() kb = %q, want explicit KB2", kb)
+	}
+}
@@ -0,0 +1,20 @@
+package ingestionjobs
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("bedrock-agent", "ingestion-jobs", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewIngestionJobDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewIngestionJobRenderer()
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package ingestionjobs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// IngestionJobRenderer renders Bedrock ingestion jobs
+// Ensure IngestionJobRenderer implements render.Navigator
+var _ render.Navigator = (*IngestionJobRenderer)(nil)
+
+type IngestionJobRenderer struct {
+	render.BaseRenderer
+}
+
+// NewIngestionJobRenderer creates a new IngestionJobRenderer
+func NewIngestionJobRenderer() render.Renderer {
+	return &IngestionJobRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "bedrock-agent",
+			Resource: "ingestion-jobs",
+			Cols: []render.Column{
+				{Name: "JOB ID", Width: 12, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "SCANNED", Width: 9, Getter: getScanned},
+				{Name: "INDEXED", Width: 9, Getter: getIndexed},
+				{Name: "DELETED", Width: 9, Getter: getDeleted},
+				{Name: "FAILED", Width: 8, Getter: getFailed},
+				{Name: "DURATION", Width: 10, Getter: getDuration},
+				{Name: "STARTED", Width: 10, Getter: getStarted},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if j, ok := r.(*IngestionJobResource); ok {
+		return j.Status()
+	}
+	return ""
+}
+
+func getScanned(r dao.Resource) string {
+	if j, ok := r.(*IngestionJobResource); ok && j.Statistics != nil {
+		return fmt.Sprintf("%d", j.Statistics.NumberOfDocumentsScanned)
+	}
+	return "-"
+}
+
+func getIndexed(r dao.Resource) string {
+	if j, ok := r.(*IngestionJobResource); ok && j.Statistics != nil {
+		return fmt.Sprintf("%d", j.Indexed())
+	}
+	return "-"
+}
+
+func getDeleted(r dao.Resource) string {
+	if j, ok := r.(*IngestionJobResource); ok && j.Statistics != nil {
+		return fmt.Sprintf("%d", j.Statistics.NumberOfDocumentsDeleted)
+	}
+	return "-"
+}
+
+func getFailed(r dao.Resource) string {
+	if j, ok := r.(*IngestionJobResource); ok && j.Statistics != nil {
+		return fmt.Sprintf("%d", j.Failed())
+	}
+	return "-"
+}
+
+func getDuration(r dao.Resource) string {
+	if j, ok := r.(*IngestionJobResource); ok {
+		if d := j.Duration(); d > 0 {
+			return render.FormatDuration(d.Round(time.Second))
+		}
+	}
+	return "-"
+}
+
+func getStarted(r dao.Resource) string {
+	if j, ok := r.(*IngestionJobResource); ok && j.StartedAt != nil {
+		return render.FormatAge(*j.StartedAt)
+	}
+	return "-"
+}
+
+// RenderDetail renders detailed ingestion job information
+func (r *IngestionJobRenderer) RenderDetail(resource dao.Resource) string {
+	job, ok := resource.(*IngestionJobResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Bedrock Ingestion Job", job.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Job ID", job.GetID())
+	d.Field("Status", job.Status())
+	d.Field("Knowledge Base ID", job.KnowledgeBaseId)
+	d.Field("Data Source ID", job.DataSourceId)
+	if job.Description != "" {
+		d.Field("Description", job.Description)
+	}
+
+	if s := job.Statistics; s != nil {
+		d.Section("Document Statistics")
+		d.Field("Scanned", fmt.Sprintf("%d", s.NumberOfDocumentsScanned))
+		d.Field("New Indexed", fmt.Sprintf("%d", s.NumberOfNewDocumentsIndexed))
+		d.Field("Modified Indexed", fmt.Sprintf("%d", s.NumberOfModifiedDocumentsIndexed))
+		d.Field("Deleted", fmt.Sprintf("%d", s.NumberOfDocumentsDeleted))
+		if s.NumberOfDocumentsFailed > 0 {
+			d.FieldStyled("Failed", fmt.Sprintf("%d", s.NumberOfDocumentsFailed), ui.DangerStyle())
+		} else {
+			d.Field("Failed", "0")
+		}
+		if s.NumberOfMetadataDocumentsScanned > 0 || s.NumberOfMetadataDocumentsModified > 0 {
+			d.Field("Metadata Scanned", fmt.Sprintf("%d", s.NumberOfMetadataDocumentsScanned))
+			d.Field("Metadata Modified", fmt.Sprintf("%d", s.NumberOfMetadataDocumentsModified))
+		}
+	}
+
+	if len(job.FailureReasons) > 0 {
+		d.Section("Failure Reasons")
+		for _, reason := range job.FailureReasons {
+			d.FieldStyled("", reason, ui.DangerStyle())
+		}
+	}
+
+	d.Section("Timestamps")
+	if job.StartedAt != nil {
+		d.Field("Started", job.StartedAt.Format("2006-01-02 15:04:05"))
+	}
+	if job.UpdatedAt != nil {
+		d.Field("Updated", job.UpdatedAt.Format("2006-01-02 15:04:05"))
+	}
+	if dur := job.Duration(); dur > 0 {
+		d.Field("Duration", render.FormatDuration(dur.Round(time.Second)))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *IngestionJobRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	job, ok := resource.(*IngestionJobResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Job ID", Value: job.GetID()},
+		{Label: "Status", Value: job.Status()},
+		{Label: "Data Source ID", Value: job.DataSourceId},
+	}
+	if job.Statistics != nil {
+		fields = append(fields, render.SummaryField{
+			Label: "Documents",
+			Value: fmt.Sprintf("%d indexed, %d failed", job.Indexed(), job.Failed()),
+		})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *IngestionJobRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	job, ok := resource.(*IngestionJobResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "s",
+			Label:       "Data Sources",
+			Service:     "bedrock-agent",
+			Resource:    "data-sources",
+			FilterField: "KnowledgeBaseId",
+			FilterValue: job.KnowledgeBaseId,
+		},
+	}
+}
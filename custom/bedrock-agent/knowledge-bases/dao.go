@@ -20,13 +20,13 @@ type KnowledgeBaseDAO struct {
 
 // NewKnowledgeBaseDAO creates a new KnowledgeBaseDAO
 func NewKnowledgeBaseDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagent.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &KnowledgeBaseDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock-agent", "knowledge-bases"),
-		client:  bedrockagent.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type PromptDAO struct {
 
 // NewPromptDAO creates a new PromptDAO
 func NewPromptDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagent.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &PromptDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock-agent", "prompts"),
-		client:  bedrockagent.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
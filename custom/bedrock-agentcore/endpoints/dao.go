@@ -21,13 +21,13 @@ type EndpointDAO struct {
 
 // NewEndpointDAO creates a new EndpointDAO
 func NewEndpointDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagentcorecontrol.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &EndpointDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock-agentcore", "endpoints"),
-		client:  bedrockagentcorecontrol.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
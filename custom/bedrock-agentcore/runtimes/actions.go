@@ -35,11 +35,10 @@ func executeRuntimeAction(ctx context.Context, act action.Action, resource dao.R
 }
 
 func executeDeleteAgentRuntime(ctx context.Context, resource dao.Resource) action.ActionResult {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagentcorecontrol.NewFromConfig)
 	if err != nil {
 		return action.ActionResult{Success: false, Error: err}
 	}
-	client := bedrockagentcorecontrol.NewFromConfig(cfg)
 
 	runtimeID := resource.GetID()
 	input := &bedrockagentcorecontrol.DeleteAgentRuntimeInput{
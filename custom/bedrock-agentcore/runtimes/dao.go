@@ -20,13 +20,13 @@ type RuntimeDAO struct {
 
 // NewRuntimeDAO creates a new RuntimeDAO
 func NewRuntimeDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagentcorecontrol.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RuntimeDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock-agentcore", "runtimes"),
-		client:  bedrockagentcorecontrol.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type VersionDAO struct {
 
 // NewVersionDAO creates a new VersionDAO
 func NewVersionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockagentcorecontrol.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &VersionDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock-agentcore", "versions"),
-		client:  bedrockagentcorecontrol.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
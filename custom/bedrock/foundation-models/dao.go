@@ -21,13 +21,13 @@ type FoundationModelDAO struct {
 
 // NewFoundationModelDAO creates a new FoundationModelDAO
 func NewFoundationModelDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrock.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &FoundationModelDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock", "foundation-models"),
-		client:  bedrock.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
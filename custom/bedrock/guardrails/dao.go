@@ -20,13 +20,13 @@ type GuardrailDAO struct {
 
 // NewGuardrailDAO creates a new GuardrailDAO
 func NewGuardrailDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrock.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &GuardrailDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock", "guardrails"),
-		client:  bedrock.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
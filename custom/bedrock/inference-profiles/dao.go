@@ -21,13 +21,13 @@ type InferenceProfileDAO struct {
 
 // NewInferenceProfileDAO creates a new InferenceProfileDAO
 func NewInferenceProfileDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrock.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &InferenceProfileDAO{
 		BaseDAO: dao.NewBaseDAO("bedrock", "inference-profiles"),
-		client:  bedrock.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -22,14 +22,18 @@ type BudgetDAO struct {
 
 // NewBudgetDAO creates a new BudgetDAO.
 func NewBudgetDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, budgets.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	stsClient, err := appaws.Client(ctx, sts.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &BudgetDAO{
 		BaseDAO:   dao.NewBaseDAO("budgets", "budgets"),
-		client:    budgets.NewFromConfig(cfg),
-		stsClient: sts.NewFromConfig(cfg),
+		client:    client,
+		stsClient: stsClient,
 	}, nil
 }
 
@@ -41,6 +45,7 @@ func (d *BudgetDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		return nil, apperrors.Wrap(err, "get caller identity")
 	}
 	accountID := appaws.Str(identity.Account)
+	partition := appaws.PartitionForARN(appaws.Str(identity.Arn))
 
 	budgetList, err := appaws.Paginate(ctx, func(token *string) ([]types.Budget, *string, error) {
 		output, err := d.client.DescribeBudgets(ctx, &budgets.DescribeBudgetsInput{
@@ -58,7 +63,7 @@ func (d *BudgetDAO) List(ctx context.Context) ([]dao.Resource, error) {
 
 	resources := make([]dao.Resource, len(budgetList))
 	for i, budget := range budgetList {
-		resources[i] = NewBudgetResource(budget, accountID)
+		resources[i] = NewBudgetResource(budget, accountID, partition)
 	}
 	return resources, nil
 }
@@ -71,6 +76,7 @@ func (d *BudgetDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 		return nil, apperrors.Wrap(err, "get caller identity")
 	}
 	accountID := appaws.Str(identity.Account)
+	partition := appaws.PartitionForARN(appaws.Str(identity.Arn))
 
 	output, err := d.client.DescribeBudget(ctx, &budgets.DescribeBudgetInput{
 		AccountId:  &accountID,
@@ -79,7 +85,7 @@ func (d *BudgetDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	if err != nil {
 		return nil, apperrors.Wrapf(err, "describe budget %s", id)
 	}
-	return NewBudgetResource(*output.Budget, accountID), nil
+	return NewBudgetResource(*output.Budget, accountID, partition), nil
 }
 
 // Delete deletes a budget by name.
@@ -109,11 +115,11 @@ type BudgetResource struct {
 }
 
 // NewBudgetResource creates a new BudgetResource.
-func NewBudgetResource(budget types.Budget, accountID string) *BudgetResource {
+func NewBudgetResource(budget types.Budget, accountID, partition string) *BudgetResource {
 	return &BudgetResource{
 		BaseResource: dao.BaseResource{
 			ID:   appaws.Str(budget.BudgetName),
-			ARN:  fmt.Sprintf("arn:aws:budgets::%s:budget/%s", accountID, appaws.Str(budget.BudgetName)),
+			ARN:  fmt.Sprintf("arn:%s:budgets::%s:budget/%s", partition, accountID, appaws.Str(budget.BudgetName)),
 			Data: budget,
 		},
 		Item:      budget,
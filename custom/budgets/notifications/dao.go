@@ -22,14 +22,18 @@ type NotificationDAO struct {
 
 // NewNotificationDAO creates a new NotificationDAO.
 func NewNotificationDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, budgets.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	stsClient, err := appaws.Client(ctx, sts.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &NotificationDAO{
 		BaseDAO:   dao.NewBaseDAO("budgets", "notifications"),
-		client:    budgets.NewFromConfig(cfg),
-		stsClient: sts.NewFromConfig(cfg),
+		client:    client,
+		stsClient: stsClient,
 	}, nil
 }
 
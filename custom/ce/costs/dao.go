@@ -21,14 +21,14 @@ type CostDAO struct {
 
 // NewCostDAO creates a new CostDAO.
 func NewCostDAO(ctx context.Context) (dao.DAO, error) {
-	// Cost Explorer API is only available in us-east-1
-	cfg, err := appaws.NewConfigWithRegion(ctx, appaws.CostExplorerRegion)
+	// Cost Explorer API is served from a single region per partition
+	client, err := appaws.ClientWithRegion(ctx, appaws.CostExplorerRegionFor(appaws.CurrentRegion(ctx)), costexplorer.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &CostDAO{
 		BaseDAO: dao.NewBaseDAO("ce", "costs"),
-		client:  costexplorer.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,14 +20,14 @@ type MonitorDAO struct {
 
 // NewMonitorDAO creates a new MonitorDAO.
 func NewMonitorDAO(ctx context.Context) (dao.DAO, error) {
-	// Cost Explorer API is only available in us-east-1
-	cfg, err := appaws.NewConfigWithRegion(ctx, appaws.CostExplorerRegion)
+	// Cost Explorer API is served from a single region per partition
+	client, err := appaws.ClientWithRegion(ctx, appaws.CostExplorerRegionFor(appaws.CurrentRegion(ctx)), costexplorer.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &MonitorDAO{
 		BaseDAO: dao.NewBaseDAO("ce", "monitors"),
-		client:  costexplorer.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
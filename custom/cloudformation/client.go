@@ -10,9 +10,5 @@ import (
 
 // GetClient returns a CloudFormation client configured for the current context
 func GetClient(ctx context.Context) (*cloudformation.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return cloudformation.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, cloudformation.NewFromConfig)
 }
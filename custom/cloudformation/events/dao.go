@@ -20,13 +20,13 @@ type EventDAO struct {
 
 // NewEventDAO creates a new EventDAO
 func NewEventDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudformation.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &EventDAO{
 		BaseDAO: dao.NewBaseDAO("cloudformation", "events"),
-		client:  cloudformation.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type OutputDAO struct {
 
 // NewOutputDAO creates a new OutputDAO
 func NewOutputDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudformation.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &OutputDAO{
 		BaseDAO: dao.NewBaseDAO("cloudformation", "outputs"),
-		client:  cloudformation.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
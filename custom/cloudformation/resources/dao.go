@@ -20,13 +20,13 @@ type ResourceDAO struct {
 
 // NewResourceDAO creates a new ResourceDAO
 func NewResourceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudformation.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ResourceDAO{
 		BaseDAO: dao.NewBaseDAO("cloudformation", "resources"),
-		client:  cloudformation.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
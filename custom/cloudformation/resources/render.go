@@ -5,6 +5,7 @@ import (
 	"time"
 	"unicode"
 
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/render"
@@ -222,9 +223,9 @@ func (r *ResourceRenderer) Navigations(resource dao.Resource) []render.Navigatio
 // For ARN-based resources, this extracts the resource name from the ARN
 func extractFilterValue(physicalID, cfnType string) string {
 	// If it's an ARN, extract the resource name
-	if strings.HasPrefix(physicalID, "arn:aws:") {
-		// ARN format: arn:aws:service:region:account:resource-type/resource-name
-		// or: arn:aws:service:region:account:resource-type:resource-name
+	if appaws.IsARN(physicalID) {
+		// ARN format: arn:partition:service:region:account:resource-type/resource-name
+		// or: arn:partition:service:region:account:resource-type:resource-name
 		parts := strings.Split(physicalID, ":")
 		if len(parts) >= 6 {
 			resourcePart := strings.Join(parts[5:], ":")
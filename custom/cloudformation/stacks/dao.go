@@ -20,13 +20,13 @@ type StackDAO struct {
 
 // NewStackDAO creates a new StackDAO
 func NewStackDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudformation.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &StackDAO{
 		BaseDAO: dao.NewBaseDAO("cloudformation", "stacks"),
-		client:  cloudformation.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type DistributionDAO struct {
 
 // NewDistributionDAO creates a new DistributionDAO
 func NewDistributionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudfront.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &DistributionDAO{
 		BaseDAO: dao.NewBaseDAO("cloudfront", "distributions"),
-		client:  cloudfront.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
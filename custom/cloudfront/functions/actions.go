@@ -0,0 +1,184 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// sampleEvent is a minimal viewer-request event used when testing without input.
+const sampleEvent = `{"version":"1.0","context":{"eventType":"viewer-request"},` +
+	`"viewer":{"ip":"198.51.100.11"},` +
+	`"request":{"method":"GET","uri":"/index.html","querystring":{},"headers":{"host":{"value":"example.com"}},"cookies":{}}}`
+
+func init() {
+	action.Global.Register("cloudfront", "functions", []action.Action{
+		{
+			Name:      "Publish",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "PublishFunction",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				f, ok := r.(*FunctionResource)
+				return ok && f.HasUnpublishedChanges()
+			},
+		},
+		{
+			Name:      "Test",
+			Shortcut:  "T",
+			Type:      action.ActionTypeAPI,
+			Operation: "TestFunction",
+			Input: &action.InputSpec{
+				Label:       "Event object JSON for the DEVELOPMENT stage (blank for a sample viewer-request)",
+				Placeholder: `{"version":"1.0","context":{"eventType":"viewer-request"},...}`,
+				Optional:    true,
+			},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteFunction",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("cloudfront", "functions", executeFunctionAction)
+}
+
+func executeFunctionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "PublishFunction":
+		return executePublish(ctx, resource)
+	case "TestFunction":
+		return executeTest(ctx, resource)
+	case "DeleteFunction":
+		return executeDelete(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// developmentETag returns the ETag of the DEVELOPMENT stage, required by
+// CloudFront for every mutating function call.
+func developmentETag(ctx context.Context, client *cloudfront.Client, name string) (*string, error) {
+	output, err := client.DescribeFunction(ctx, &cloudfront.DescribeFunctionInput{
+		Name:  &name,
+		Stage: types.FunctionStageDevelopment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.ETag, nil
+}
+
+func newClient(ctx context.Context) (*cloudfront.Client, error) {
+	return appaws.Client(ctx, cloudfront.NewFromConfig)
+}
+
+func executePublish(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := newClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := resource.GetID()
+	etag, err := developmentETag(ctx, client, name)
+	if err != nil {
+		return action.FailResultf(err, "describe function %s", name)
+	}
+	_, err = client.PublishFunction(ctx, &cloudfront.PublishFunctionInput{
+		Name:    &name,
+		IfMatch: etag,
+	})
+	if err != nil {
+		return action.FailResultf(err, "publish function %s", name)
+	}
+	return action.SuccessResult(fmt.Sprintf("Published %s to LIVE", name))
+}
+
+// testEvent returns the event object to test with: the user's input, or the
+// sample viewer-request event. Input must be valid JSON.
+func testEvent(input string) ([]byte, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return []byte(sampleEvent), nil
+	}
+	if !json.Valid([]byte(input)) {
+		return nil, fmt.Errorf("event object is not valid JSON")
+	}
+	return []byte(input), nil
+}
+
+// summarizeTest condenses a test result into a single status line.
+func summarizeTest(name string, result *types.TestResult) action.ActionResult {
+	if result == nil {
+		return action.SuccessResult(fmt.Sprintf("Tested %s: no result returned", name))
+	}
+	if msg := appaws.Str(result.FunctionErrorMessage); msg != "" {
+		return action.FailResult(fmt.Errorf("test of %s failed: %s", name, msg))
+	}
+	summary := fmt.Sprintf("Tested %s: compute utilization %s", name, appaws.Str(result.ComputeUtilization))
+	if n := len(result.FunctionExecutionLogs); n > 0 {
+		summary += fmt.Sprintf(", %d log line(s), last: %s", n, result.FunctionExecutionLogs[n-1])
+	}
+	return action.SuccessResult(summary)
+}
+
+func executeTest(ctx context.Context, resource dao.Resource) action.ActionResult {
+	event, err := testEvent(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := resource.GetID()
+	etag, err := developmentETag(ctx, client, name)
+	if err != nil {
+		return action.FailResultf(err, "describe function %s", name)
+	}
+	output, err := client.TestFunction(ctx, &cloudfront.TestFunctionInput{
+		Name:        &name,
+		IfMatch:     etag,
+		Stage:       types.FunctionStageDevelopment,
+		EventObject: event,
+	})
+	if err != nil {
+		return action.FailResultf(err, "test function %s", name)
+	}
+	return summarizeTest(name, output.TestResult)
+}
+
+func executeDelete(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := newClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := resource.GetID()
+	etag, err := developmentETag(ctx, client, name)
+	if err != nil {
+		return action.FailResultf(err, "describe function %s", name)
+	}
+	_, err = client.DeleteFunction(ctx, &cloudfront.DeleteFunctionInput{
+		Name:    &name,
+		IfMatch: etag,
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete function %s", name)
+	}
+	return action.SuccessResult(fmt.Sprintf("Deleted function %s", name))
+}
@@ -0,0 +1,74 @@
+package functions
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+func TestTestEvent(t *testing.T) {
+	event, err := testEvent("")
+	if err != nil {
+		t.Fatalf("testEvent(\"\") error: %v", err)
+	}
+	if !json.Valid(event) {
+		t.Errorf("sample event is not valid JSON: %s", event)
+	}
+
+	if _, err := testEvent("{not json"); err == nil {
+		t.Error("testEvent should reject invalid JSON")
+	}
+
+	custom := `{"version":"1.0","context":{"eventType":"viewer-response"}}`
+	event, err = testEvent("  " + custom + "\n")
+	if err != nil || string(event) != custom {
+		t.Errorf("testEvent(custom) = %q, %v", event, err)
+	}
+}
+
+func TestSummarizeTest(t *testing.T) {
+	ok := summarizeTest("rewrite", &types.TestResult{
+		ComputeUtilization:    aws.String("12"),
+		FunctionExecutionLogs: []string{"start", "rewrote /about"},
+	})
+	if !ok.Success || ok.Message != "Tested rewrite: compute utilization 12, 2 log line(s), last: rewrote /about" {
+		t.Errorf("summarizeTest success = %+v", ok)
+	}
+
+	failed := summarizeTest("rewrite", &types.TestResult{
+		FunctionErrorMessage: aws.String("TypeError: cannot read property 'uri'"),
+	})
+	if failed.Success {
+		t.Error("summarizeTest should fail when the function errors")
+	}
+}
+
+func TestFunctionResource_PublishState(t *testing.T) {
+	now := time.Now()
+	summary := func(modified time.Time) types.FunctionSummary {
+		return types.FunctionSummary{
+			Name:             aws.String("rewrite"),
+			FunctionMetadata: &types.FunctionMetadata{LastModifiedTime: aws.Time(modified)},
+		}
+	}
+
+	f := NewFunctionResource(summary(now))
+	if f.PublishState() != "never published" || !f.HasUnpublishedChanges() {
+		t.Errorf("unpublished function state = %q", f.PublishState())
+	}
+
+	live := summary(now.Add(time.Minute))
+	f.Live = &live
+	if f.PublishState() != "up to date" || f.HasUnpublishedChanges() {
+		t.Errorf("published function state = %q", f.PublishState())
+	}
+
+	f = NewFunctionResource(summary(now.Add(time.Hour)))
+	f.Live = &live
+	if f.PublishState() != "changes pending" {
+		t.Errorf("edited function state = %q, want changes pending", f.PublishState())
+	}
+}
@@ -0,0 +1,228 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FunctionDAO provides data access for CloudFront Functions
+type FunctionDAO struct {
+	dao.BaseDAO
+	client *cloudfront.Client
+}
+
+// NewFunctionDAO creates a new FunctionDAO
+func NewFunctionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, cloudfront.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &FunctionDAO{
+		BaseDAO: dao.NewBaseDAO("cloudfront", "functions"),
+		client:  client,
+	}, nil
+}
+
+// List returns all functions. Each function is listed once, combining its
+// DEVELOPMENT and LIVE stages to show whether it has unpublished changes.
+func (d *FunctionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	dev, err := d.listStage(ctx, types.FunctionStageDevelopment)
+	if err != nil {
+		return nil, err
+	}
+	live, err := d.listStage(ctx, types.FunctionStageLive)
+	if err != nil {
+		return nil, err
+	}
+
+	liveByName := make(map[string]types.FunctionSummary, len(live))
+	for _, f := range live {
+		liveByName[appaws.Str(f.Name)] = f
+	}
+
+	resources := make([]dao.Resource, 0, len(dev))
+	for _, f := range dev {
+		r := NewFunctionResource(f)
+		if l, ok := liveByName[r.GetName()]; ok {
+			r.Live = &l
+		}
+		resources = append(resources, r)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetName() < resources[j].GetName()
+	})
+	return resources, nil
+}
+
+// Get returns a specific function with both stages
+func (d *FunctionDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	dev, err := d.describe(ctx, name, types.FunctionStageDevelopment)
+	if err != nil {
+		return nil, err
+	}
+	r := NewFunctionResource(*dev)
+	// A function that was never published has no LIVE stage
+	if live, err := d.describe(ctx, name, types.FunctionStageLive); err == nil {
+		r.Live = live
+	}
+	return r, nil
+}
+
+// Delete deletes a function
+func (d *FunctionDAO) Delete(ctx context.Context, name string) error {
+	output, err := d.client.DescribeFunction(ctx, &cloudfront.DescribeFunctionInput{
+		Name: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "describe cloudfront function %s", name)
+	}
+	_, err = d.client.DeleteFunction(ctx, &cloudfront.DeleteFunctionInput{
+		Name:    &name,
+		IfMatch: output.ETag,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete cloudfront function %s", name)
+	}
+	return nil
+}
+
+func (d *FunctionDAO) listStage(ctx context.Context, stage types.FunctionStage) ([]types.FunctionSummary, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.FunctionSummary, *string, error) {
+		output, err := d.client.ListFunctions(ctx, &cloudfront.ListFunctionsInput{
+			Marker: token,
+			Stage:  stage,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list cloudfront functions (%s)", stage)
+		}
+		if output.FunctionList == nil {
+			return nil, nil, nil
+		}
+		var next *string
+		if appaws.Str(output.FunctionList.NextMarker) != "" {
+			next = output.FunctionList.NextMarker
+		}
+		return output.FunctionList.Items, next, nil
+	})
+}
+
+func (d *FunctionDAO) describe(ctx context.Context, name string, stage types.FunctionStage) (*types.FunctionSummary, error) {
+	output, err := d.client.DescribeFunction(ctx, &cloudfront.DescribeFunctionInput{
+		Name:  &name,
+		Stage: stage,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe cloudfront function %s (%s)", name, stage)
+	}
+	if output.FunctionSummary == nil {
+		return nil, fmt.Errorf("cloudfront function not found: %s", name)
+	}
+	return output.FunctionSummary, nil
+}
+
+// FunctionResource wraps a CloudFront function; Item is the DEVELOPMENT stage
+// and Live the published stage, if any
+type FunctionResource struct {
+	dao.BaseResource
+	Item types.FunctionSummary
+	Live *types.FunctionSummary
+}
+
+// NewFunctionResource creates a new FunctionResource
+func NewFunctionResource(f types.FunctionSummary) *FunctionResource {
+	name := appaws.Str(f.Name)
+	r := &FunctionResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Data: f,
+		},
+		Item: f,
+	}
+	if f.FunctionMetadata != nil {
+		r.ARN = appaws.Str(f.FunctionMetadata.FunctionARN)
+	}
+	return r
+}
+
+// Status returns the function status (UNPUBLISHED, UNASSOCIATED, DEPLOYED)
+func (r *FunctionResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// Runtime returns the JavaScript runtime
+func (r *FunctionResource) Runtime() string {
+	if r.Item.FunctionConfig == nil {
+		return ""
+	}
+	return string(r.Item.FunctionConfig.Runtime)
+}
+
+// Comment returns the function comment
+func (r *FunctionResource) Comment() string {
+	if r.Item.FunctionConfig == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.FunctionConfig.Comment)
+}
+
+// LastModified returns when the DEVELOPMENT stage was last changed
+func (r *FunctionResource) LastModified() *time.Time {
+	return lastModified(&r.Item)
+}
+
+// IsPublished reports whether the function has a LIVE stage
+func (r *FunctionResource) IsPublished() bool {
+	return r.Live != nil
+}
+
+// HasUnpublishedChanges reports whether DEVELOPMENT was modified after the
+// LIVE stage was published
+func (r *FunctionResource) HasUnpublishedChanges() bool {
+	if r.Live == nil {
+		return true
+	}
+	dev, live := lastModified(&r.Item), lastModified(r.Live)
+	return dev != nil && live != nil && dev.After(*live)
+}
+
+// PublishState summarizes the LIVE stage relative to DEVELOPMENT
+func (r *FunctionResource) PublishState() string {
+	switch {
+	case !r.IsPublished():
+		return "never published"
+	case r.HasUnpublishedChanges():
+		return "changes pending"
+	default:
+		return "up to date"
+	}
+}
+
+// KeyValueStoreARNs returns the ARNs of associated key value stores
+func (r *FunctionResource) KeyValueStoreARNs() []string {
+	if r.Item.FunctionConfig == nil || r.Item.FunctionConfig.KeyValueStoreAssociations == nil {
+		return nil
+	}
+	items := r.Item.FunctionConfig.KeyValueStoreAssociations.Items
+	arns := make([]string, 0, len(items))
+	for _, a := range items {
+		arns = append(arns, appaws.Str(a.KeyValueStoreARN))
+	}
+	return arns
+}
+
+func lastModified(f *types.FunctionSummary) *time.Time {
+	if f.FunctionMetadata == nil {
+		return nil
+	}
+	return f.FunctionMetadata.LastModifiedTime
+}
@@ -0,0 +1,165 @@
+package functions
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// FunctionRenderer renders CloudFront Functions
+type FunctionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewFunctionRenderer creates a new FunctionRenderer
+func NewFunctionRenderer() render.Renderer {
+	return &FunctionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudfront",
+			Resource: "functions",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATUS", Width: 13, Getter: getStatus},
+				{Name: "LIVE", Width: 16, Getter: getPublishState},
+				{Name: "RUNTIME", Width: 18, Getter: getRuntime},
+				{Name: "KVS", Width: 4, Getter: getKVS},
+				{Name: "MODIFIED", Width: 12, Getter: getModified},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	f, ok := r.(*FunctionResource)
+	if !ok {
+		return ""
+	}
+	return f.Status()
+}
+
+func getPublishState(r dao.Resource) string {
+	f, ok := r.(*FunctionResource)
+	if !ok {
+		return ""
+	}
+	return f.PublishState()
+}
+
+func getRuntime(r dao.Resource) string {
+	f, ok := r.(*FunctionResource)
+	if !ok {
+		return ""
+	}
+	return f.Runtime()
+}
+
+func getKVS(r dao.Resource) string {
+	f, ok := r.(*FunctionResource)
+	if !ok || len(f.KeyValueStoreARNs()) == 0 {
+		return ""
+	}
+	return "yes"
+}
+
+func getModified(r dao.Resource) string {
+	f, ok := r.(*FunctionResource)
+	if !ok || f.LastModified() == nil {
+		return ""
+	}
+	return render.FormatAge(*f.LastModified())
+}
+
+func statusStyle(status string) render.Style {
+	switch status {
+	case "DEPLOYED":
+		return ui.SuccessStyle()
+	case "UNPUBLISHED":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a function
+func (r *FunctionRenderer) RenderDetail(resource dao.Resource) string {
+	f, ok := resource.(*FunctionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("CloudFront Function", f.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", f.GetName())
+	d.FieldStyled("Status", f.Status(), statusStyle(f.Status()))
+	d.Field("Runtime", f.Runtime())
+	if c := f.Comment(); c != "" {
+		d.Field("Comment", c)
+	}
+	if f.GetARN() != "" {
+		d.Field("ARN", f.GetARN())
+	}
+
+	d.Section("Stages")
+	if t := f.LastModified(); t != nil {
+		d.Field("Development Modified", t.Format("2006-01-02 15:04:05"))
+	}
+	if f.IsPublished() {
+		if t := lastModified(f.Live); t != nil {
+			d.Field("Live Published", t.Format("2006-01-02 15:04:05"))
+		}
+	}
+	if f.HasUnpublishedChanges() {
+		d.FieldStyled("Live", f.PublishState(), ui.WarningStyle())
+	} else {
+		d.FieldStyled("Live", f.PublishState(), ui.SuccessStyle())
+	}
+
+	if arns := f.KeyValueStoreARNs(); len(arns) > 0 {
+		d.Section("Key Value Stores")
+		for _, arn := range arns {
+			d.DimIndent(arn)
+		}
+	}
+
+	d.Section("Timestamps")
+	if m := f.Item.FunctionMetadata; m != nil && m.CreatedTime != nil {
+		d.Field("Created", m.CreatedTime.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a function
+func (r *FunctionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	f, ok := resource.(*FunctionResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: f.GetName()},
+		{Label: "Status", Value: f.Status(), Style: statusStyle(f.Status())},
+		{Label: "Live", Value: f.PublishState()},
+		{Label: "Runtime", Value: f.Runtime()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *FunctionRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	f, ok := resource.(*FunctionResource)
+	if !ok {
+		return nil
+	}
+	var navs []render.Navigation
+	// A function can be associated with at most one key value store
+	if arns := f.KeyValueStoreARNs(); len(arns) > 0 {
+		navs = append(navs, render.Navigation{
+			Key: "k", Label: "Key Value Store", Service: "cloudfront", Resource: "key-value-stores",
+			FilterField: "ARN", FilterValue: arns[0],
+		})
+	}
+	return navs
+}
@@ -0,0 +1,126 @@
+package keyvaluestores
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// KeyValueStoreDAO provides data access for CloudFront key value stores
+type KeyValueStoreDAO struct {
+	dao.BaseDAO
+	client *cloudfront.Client
+}
+
+// NewKeyValueStoreDAO creates a new KeyValueStoreDAO
+func NewKeyValueStoreDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, cloudfront.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &KeyValueStoreDAO{
+		BaseDAO: dao.NewBaseDAO("cloudfront", "key-value-stores"),
+		client:  client,
+	}, nil
+}
+
+// List returns all key value stores
+func (d *KeyValueStoreDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	stores, err := appaws.Paginate(ctx, func(token *string) ([]types.KeyValueStore, *string, error) {
+		output, err := d.client.ListKeyValueStores(ctx, &cloudfront.ListKeyValueStoresInput{
+			Marker: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list cloudfront key value stores")
+		}
+		if output.KeyValueStoreList == nil {
+			return nil, nil, nil
+		}
+		var next *string
+		if appaws.Str(output.KeyValueStoreList.NextMarker) != "" {
+			next = output.KeyValueStoreList.NextMarker
+		}
+		return output.KeyValueStoreList.Items, next, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return appaws.Str(stores[i].Name) < appaws.Str(stores[j].Name)
+	})
+
+	resources := make([]dao.Resource, len(stores))
+	for i, s := range stores {
+		resources[i] = NewKeyValueStoreResource(s)
+	}
+	return resources, nil
+}
+
+// Get returns a specific key value store by name
+func (d *KeyValueStoreDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.client.DescribeKeyValueStore(ctx, &cloudfront.DescribeKeyValueStoreInput{
+		Name: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe cloudfront key value store %s", name)
+	}
+	if output.KeyValueStore == nil {
+		return nil, fmt.Errorf("cloudfront key value store not found: %s", name)
+	}
+	return NewKeyValueStoreResource(*output.KeyValueStore), nil
+}
+
+// Delete deletes a key value store; it must not be associated with a function
+func (d *KeyValueStoreDAO) Delete(ctx context.Context, name string) error {
+	output, err := d.client.DescribeKeyValueStore(ctx, &cloudfront.DescribeKeyValueStoreInput{
+		Name: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "describe cloudfront key value store %s", name)
+	}
+	_, err = d.client.DeleteKeyValueStore(ctx, &cloudfront.DeleteKeyValueStoreInput{
+		Name:    &name,
+		IfMatch: output.ETag,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete cloudfront key value store %s", name)
+	}
+	return nil
+}
+
+// KeyValueStoreResource wraps a CloudFront key value store
+type KeyValueStoreResource struct {
+	dao.BaseResource
+	Item types.KeyValueStore
+}
+
+// NewKeyValueStoreResource creates a new KeyValueStoreResource
+func NewKeyValueStoreResource(s types.KeyValueStore) *KeyValueStoreResource {
+	name := appaws.Str(s.Name)
+	return &KeyValueStoreResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(s.ARN),
+			Data: s,
+		},
+		Item: s,
+	}
+}
+
+// Status returns the store status (READY, PROVISIONING, PROVISIONING_FAILED)
+func (r *KeyValueStoreResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// IsProvisioning reports whether the store is still being created
+func (r *KeyValueStoreResource) IsProvisioning() bool {
+	return r.Status() == "PROVISIONING"
+}
@@ -0,0 +1,20 @@
+package keyvaluestores
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudfront", "key-value-stores", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewKeyValueStoreDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewKeyValueStoreRenderer()
+		},
+	})
+}
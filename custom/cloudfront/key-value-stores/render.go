@@ -0,0 +1,125 @@
+package keyvaluestores
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// KeyValueStoreRenderer renders CloudFront key value stores
+type KeyValueStoreRenderer struct {
+	render.BaseRenderer
+}
+
+// NewKeyValueStoreRenderer creates a new KeyValueStoreRenderer
+func NewKeyValueStoreRenderer() render.Renderer {
+	return &KeyValueStoreRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudfront",
+			Resource: "key-value-stores",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ID", Width: 38, Getter: getID},
+				{Name: "STATUS", Width: 14, Getter: getStatus},
+				{Name: "COMMENT", Width: 30, Getter: getComment},
+				{Name: "MODIFIED", Width: 12, Getter: getModified},
+			},
+		},
+	}
+}
+
+func getID(r dao.Resource) string {
+	s, ok := r.(*KeyValueStoreResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(s.Item.Id)
+}
+
+func getStatus(r dao.Resource) string {
+	s, ok := r.(*KeyValueStoreResource)
+	if !ok {
+		return ""
+	}
+	return s.Status()
+}
+
+func getComment(r dao.Resource) string {
+	s, ok := r.(*KeyValueStoreResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(s.Item.Comment)
+}
+
+func getModified(r dao.Resource) string {
+	s, ok := r.(*KeyValueStoreResource)
+	if !ok || s.Item.LastModifiedTime == nil {
+		return ""
+	}
+	return render.FormatAge(*s.Item.LastModifiedTime)
+}
+
+func statusStyle(status string) render.Style {
+	switch status {
+	case "READY":
+		return ui.SuccessStyle()
+	case "PROVISIONING":
+		return ui.WarningStyle()
+	case "PROVISIONING_FAILED":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a key value store
+func (r *KeyValueStoreRenderer) RenderDetail(resource dao.Resource) string {
+	s, ok := resource.(*KeyValueStoreResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Key Value Store", s.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", s.GetName())
+	d.FieldIf("ID", s.Item.Id)
+	d.FieldStyled("Status", s.Status(), statusStyle(s.Status()))
+	d.FieldIf("Comment", s.Item.Comment)
+	d.Field("ARN", s.GetARN())
+
+	d.Section("Timestamps")
+	if t := s.Item.LastModifiedTime; t != nil {
+		d.Field("Last Modified", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a key value store
+func (r *KeyValueStoreRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	s, ok := resource.(*KeyValueStoreResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: s.GetName()},
+		{Label: "Status", Value: s.Status(), Style: statusStyle(s.Status())},
+		{Label: "Comment", Value: getComment(s)},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a store is provisioning
+func (r *KeyValueStoreRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if s, ok := dao.UnwrapResource(res).(*KeyValueStoreResource); ok && s.IsProvisioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// BackupDAO provides data access for CloudHSM cluster backups
+type BackupDAO struct {
+	dao.BaseDAO
+	client *cloudhsmv2.Client
+}
+
+// NewBackupDAO creates a new BackupDAO
+func NewBackupDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, cloudhsmv2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &BackupDAO{
+		BaseDAO: dao.NewBaseDAO("cloudhsmv2", "backups"),
+		client:  client,
+	}, nil
+}
+
+// List returns backups newest first, limited to one cluster when the
+// ClusterId filter is set
+func (d *BackupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var filters map[string][]string
+	if clusterID := dao.GetFilterFromContext(ctx, "ClusterId"); clusterID != "" {
+		filters = map[string][]string{"clusterIds": {clusterID}}
+	}
+
+	backups, err := d.describeBackups(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return appaws.Time(backups[i].CreateTimestamp).After(appaws.Time(backups[j].CreateTimestamp))
+	})
+
+	resources := make([]dao.Resource, len(backups))
+	for i, b := range backups {
+		resources[i] = NewBackupResource(b)
+	}
+	return resources, nil
+}
+
+// Get returns a specific backup
+func (d *BackupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	backups, err := d.describeBackups(ctx, map[string][]string{"backupIds": {id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(backups) == 0 {
+		return nil, fmt.Errorf("cloudhsm backup not found: %s", id)
+	}
+	return NewBackupResource(backups[0]), nil
+}
+
+// Delete marks a backup for deletion; it can be restored for 7 days.
+func (d *BackupDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteBackup(ctx, &cloudhsmv2.DeleteBackupInput{
+		BackupId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete cloudhsm backup %s", id)
+	}
+	return nil
+}
+
+func (d *BackupDAO) describeBackups(ctx context.Context, filters map[string][]string) ([]types.Backup, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.Backup, *string, error) {
+		output, err := d.client.DescribeBackups(ctx, &cloudhsmv2.DescribeBackupsInput{
+			Filters:   filters,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe cloudhsm backups")
+		}
+		return output.Backups, output.NextToken, nil
+	})
+}
+
+// BackupResource wraps a CloudHSM backup
+type BackupResource struct {
+	dao.BaseResource
+	Item types.Backup
+}
+
+// NewBackupResource creates a new BackupResource
+func NewBackupResource(b types.Backup) *BackupResource {
+	tags := make(map[string]string, len(b.TagList))
+	for _, t := range b.TagList {
+		tags[appaws.Str(t.Key)] = appaws.Str(t.Value)
+	}
+	return &BackupResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(b.BackupId),
+			Name: appaws.Str(b.BackupId),
+			ARN:  appaws.Str(b.BackupArn),
+			Tags: tags,
+			Data: b,
+		},
+		Item: b,
+	}
+}
+
+// State returns the backup state
+func (r *BackupResource) State() string {
+	return string(r.Item.BackupState)
+}
+
+// IsCopy reports whether the backup was copied from another region.
+func (r *BackupResource) IsCopy() bool {
+	return appaws.Str(r.Item.SourceRegion) != ""
+}
+
+// Retention describes when the backup expires, "never" for pinned backups.
+func (r *BackupResource) Retention() string {
+	if appaws.Bool(r.Item.NeverExpires) {
+		return "never expires"
+	}
+	return "policy"
+}
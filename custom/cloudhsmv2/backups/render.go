@@ -0,0 +1,152 @@
+package backups
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// BackupRenderer renders CloudHSM backups
+type BackupRenderer struct {
+	render.BaseRenderer
+}
+
+// NewBackupRenderer creates a new BackupRenderer
+func NewBackupRenderer() render.Renderer {
+	return &BackupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudhsmv2",
+			Resource: "backups",
+			Cols: []render.Column{
+				{Name: "BACKUP ID", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "CLUSTER", Width: 22, Getter: getCluster},
+				{Name: "STATE", Width: 18, Getter: getState},
+				{Name: "HSM TYPE", Width: 12, Getter: getHsmType},
+				{Name: "RETENTION", Width: 14, Getter: getRetention},
+				{Name: "AGE", Width: 10, Getter: getAge},
+			},
+		},
+	}
+}
+
+func getCluster(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(b.Item.ClusterId)
+}
+
+func getState(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok {
+		return ""
+	}
+	return b.State()
+}
+
+func getHsmType(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(b.Item.HsmType)
+}
+
+func getRetention(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok {
+		return ""
+	}
+	return b.Retention()
+}
+
+func getAge(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok || b.Item.CreateTimestamp == nil {
+		return ""
+	}
+	return render.FormatAge(*b.Item.CreateTimestamp)
+}
+
+func stateStyle(state string) render.Style {
+	switch state {
+	case "READY":
+		return ui.SuccessStyle()
+	case "CREATE_IN_PROGRESS":
+		return ui.WarningStyle()
+	case "PENDING_DELETION", "DELETED":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a backup
+func (r *BackupRenderer) RenderDetail(resource dao.Resource) string {
+	b, ok := resource.(*BackupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("CloudHSM Backup", b.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Backup ID", b.GetID())
+	d.Field("ARN", b.GetARN())
+	d.FieldStyled("State", b.State(), stateStyle(b.State()))
+	d.FieldIf("Cluster ID", b.Item.ClusterId)
+	d.FieldIf("HSM Type", b.Item.HsmType)
+	d.Field("Mode", string(b.Item.Mode))
+	d.Field("Retention", b.Retention())
+
+	if b.IsCopy() {
+		d.Section("Source")
+		d.FieldIf("Region", b.Item.SourceRegion)
+		d.FieldIf("Cluster", b.Item.SourceCluster)
+		d.FieldIf("Backup", b.Item.SourceBackup)
+	}
+
+	d.Section("Timestamps")
+	if t := b.Item.CreateTimestamp; t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := b.Item.CopyTimestamp; t != nil {
+		d.Field("Copied", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := b.Item.DeleteTimestamp; t != nil {
+		d.Field("Deleted", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Tags(b.Tags)
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a backup
+func (r *BackupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	b, ok := resource.(*BackupResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Backup", Value: b.GetID()},
+		{Label: "Cluster", Value: appaws.Str(b.Item.ClusterId)},
+		{Label: "State", Value: b.State(), Style: stateStyle(b.State())},
+		{Label: "Retention", Value: b.Retention()},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a backup is being created
+func (r *BackupRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if b, ok := dao.UnwrapResource(res).(*BackupResource); ok && b.State() == "CREATE_IN_PROGRESS" {
+			return true
+		}
+	}
+	return false
+}
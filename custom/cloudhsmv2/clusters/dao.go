@@ -0,0 +1,211 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ClusterDAO provides data access for CloudHSM clusters
+type ClusterDAO struct {
+	dao.BaseDAO
+	client *cloudhsmv2.Client
+}
+
+// NewClusterDAO creates a new ClusterDAO
+func NewClusterDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, cloudhsmv2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ClusterDAO{
+		BaseDAO: dao.NewBaseDAO("cloudhsmv2", "clusters"),
+		client:  client,
+	}, nil
+}
+
+// List returns all CloudHSM clusters with their latest backup
+func (d *ClusterDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	clusters, err := d.describeClusters(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := d.latestBackups(ctx, clusters)
+	resources := make([]dao.Resource, len(clusters))
+	for i, c := range clusters {
+		resources[i] = NewClusterResource(c, latest[appaws.Str(c.ClusterId)])
+	}
+	return resources, nil
+}
+
+// Get returns a specific cluster with its latest backup
+func (d *ClusterDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	clusters, err := d.describeClusters(ctx, map[string][]string{"clusterIds": {id}})
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) == 0 {
+		return nil, fmt.Errorf("cloudhsm cluster not found: %s", id)
+	}
+
+	latest := d.latestBackups(ctx, clusters)
+	return NewClusterResource(clusters[0], latest[id]), nil
+}
+
+// Delete deletes a cluster. All HSMs must be deleted first.
+func (d *ClusterDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteCluster(ctx, &cloudhsmv2.DeleteClusterInput{
+		ClusterId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete cloudhsm cluster %s", id)
+	}
+	return nil
+}
+
+func (d *ClusterDAO) describeClusters(ctx context.Context, filters map[string][]string) ([]types.Cluster, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.Cluster, *string, error) {
+		output, err := d.client.DescribeClusters(ctx, &cloudhsmv2.DescribeClustersInput{
+			Filters:   filters,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe cloudhsm clusters")
+		}
+		return output.Clusters, output.NextToken, nil
+	})
+}
+
+// latestBackups returns the newest backup of each cluster, keyed by cluster
+// ID. Backups are best-effort; failures are logged and leave them unset.
+func (d *ClusterDAO) latestBackups(ctx context.Context, clusters []types.Cluster) map[string]*types.Backup {
+	if len(clusters) == 0 {
+		return nil
+	}
+	ids := make([]string, len(clusters))
+	for i, c := range clusters {
+		ids[i] = appaws.Str(c.ClusterId)
+	}
+
+	backups, err := appaws.Paginate(ctx, func(token *string) ([]types.Backup, *string, error) {
+		output, err := d.client.DescribeBackups(ctx, &cloudhsmv2.DescribeBackupsInput{
+			Filters:   map[string][]string{"clusterIds": ids},
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe cloudhsm backups")
+		}
+		return output.Backups, output.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to describe cloudhsm backups", "error", err)
+		return nil
+	}
+	return latestByCluster(backups)
+}
+
+// latestByCluster picks the most recently created backup of each cluster.
+func latestByCluster(backups []types.Backup) map[string]*types.Backup {
+	latest := make(map[string]*types.Backup)
+	for i, b := range backups {
+		id := appaws.Str(b.ClusterId)
+		cur, ok := latest[id]
+		if !ok || appaws.Time(b.CreateTimestamp).After(appaws.Time(cur.CreateTimestamp)) {
+			latest[id] = &backups[i]
+		}
+	}
+	return latest
+}
+
+// ClusterResource wraps a CloudHSM cluster
+type ClusterResource struct {
+	dao.BaseResource
+	Item types.Cluster
+	// LatestBackup is the newest backup of the cluster, nil when there is
+	// none or backups could not be listed.
+	LatestBackup *types.Backup
+}
+
+// NewClusterResource creates a new ClusterResource
+func NewClusterResource(c types.Cluster, latest *types.Backup) *ClusterResource {
+	return &ClusterResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(c.ClusterId),
+			Name: appaws.Str(c.ClusterId),
+			Tags: tagMap(c.TagList),
+			Data: c,
+		},
+		Item:         c,
+		LatestBackup: latest,
+	}
+}
+
+func tagMap(tags []types.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[appaws.Str(t.Key)] = appaws.Str(t.Value)
+	}
+	return m
+}
+
+// State returns the cluster state
+func (r *ClusterResource) State() string {
+	return string(r.Item.State)
+}
+
+// IsTransitioning reports whether the cluster is in an *_IN_PROGRESS state.
+func (r *ClusterResource) IsTransitioning() bool {
+	switch r.Item.State {
+	case types.ClusterStateCreateInProgress, types.ClusterStateInitializeInProgress,
+		types.ClusterStateUpdateInProgress, types.ClusterStateModifyInProgress,
+		types.ClusterStateRollbackInProgress, types.ClusterStateDeleteInProgress:
+		return true
+	default:
+		return false
+	}
+}
+
+// ActiveHSMs returns how many of the cluster's HSMs are active
+func (r *ClusterResource) ActiveHSMs() int {
+	n := 0
+	for _, h := range r.Item.Hsms {
+		if h.State == types.HsmStateActive {
+			n++
+		}
+	}
+	return n
+}
+
+// BackupRetention returns the retention policy, e.g. "90 DAYS".
+func (r *ClusterResource) BackupRetention() string {
+	p := r.Item.BackupRetentionPolicy
+	if p == nil || p.Value == nil {
+		return ""
+	}
+	return appaws.Str(p.Value) + " " + string(p.Type)
+}
+
+// LatestBackupTime returns when the latest backup was created, or the zero time.
+func (r *ClusterResource) LatestBackupTime() time.Time {
+	if r.LatestBackup == nil {
+		return time.Time{}
+	}
+	return appaws.Time(r.LatestBackup.CreateTimestamp)
+}
+
+// LatestBackupState returns the latest backup's state, or "" when none.
+func (r *ClusterResource) LatestBackupState() string {
+	if r.LatestBackup == nil {
+		return ""
+	}
+	return string(r.LatestBackup.BackupState)
+}
@@ -0,0 +1,63 @@
+package clusters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+)
+
+func TestLatestByCluster(t *testing.T) {
+	now := time.Now()
+	backups := []types.Backup{
+		{BackupId: aws.String("backup-old"), ClusterId: aws.String("cluster-a"), CreateTimestamp: aws.Time(now.Add(-48 * time.Hour))},
+		{BackupId: aws.String("backup-new"), ClusterId: aws.String("cluster-a"), CreateTimestamp: aws.Time(now.Add(-time.Hour))},
+		{BackupId: aws.String("backup-b"), ClusterId: aws.String("cluster-b"), CreateTimestamp: aws.Time(now.Add(-24 * time.Hour))},
+	}
+
+	latest := latestByCluster(backups)
+	if got := aws.ToString(latest["cluster-a"].BackupId); got != "backup-new" {
+		t.Errorf("latest backup of cluster-a = %q, want backup-new", got)
+	}
+	if got := aws.ToString(latest["cluster-b"].BackupId); got != "backup-b" {
+		t.Errorf("latest backup of cluster-b = %q, want backup-b", got)
+	}
+	if _, ok := latest["cluster-c"]; ok {
+		t.Error("clusters without backups should have no entry")
+	}
+}
+
+func TestClusterResource(t *testing.T) {
+	c := NewClusterResource(types.Cluster{
+		ClusterId: aws.String("cluster-abc"),
+		State:     types.ClusterStateActive,
+		Hsms: []types.Hsm{
+			{HsmId: aws.String("hsm-1"), State: types.HsmStateActive},
+			{HsmId: aws.String("hsm-2"), State: types.HsmStateCreateInProgress},
+		},
+		BackupRetentionPolicy: &types.BackupRetentionPolicy{Type: types.BackupRetentionTypeDays, Value: aws.String("90")},
+		TagList:               []types.Tag{{Key: aws.String("team"), Value: aws.String("pki")}},
+	}, nil)
+
+	if c.ActiveHSMs() != 1 {
+		t.Errorf("ActiveHSMs() = %d, want 1", c.ActiveHSMs())
+	}
+	if got := c.BackupRetention(); got != "90 DAYS" {
+		t.Errorf("BackupRetention() = %q, want 90 DAYS", got)
+	}
+	if c.Tags["team"] != "pki" {
+		t.Errorf("Tags = %v", c.Tags)
+	}
+	if c.LatestBackupState() != "" || !c.LatestBackupTime().IsZero() {
+		t.Error("cluster without backups should report no latest backup")
+	}
+	if c.IsTransitioning() {
+		t.Error("ACTIVE cluster should not be transitioning")
+	}
+
+	c.Item.State = types.ClusterStateInitializeInProgress
+	if !c.IsTransitioning() {
+		t.Error("INITIALIZE_IN_PROGRESS cluster should be transitioning")
+	}
+}
@@ -0,0 +1,221 @@
+package clusters
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ClusterRenderer renders CloudHSM clusters
+type ClusterRenderer struct {
+	render.BaseRenderer
+}
+
+// NewClusterRenderer creates a new ClusterRenderer
+func NewClusterRenderer() render.Renderer {
+	return &ClusterRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudhsmv2",
+			Resource: "clusters",
+			Cols: []render.Column{
+				{Name: "CLUSTER ID", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATE", Width: 22, Getter: getState},
+				{Name: "HSM TYPE", Width: 12, Getter: getHsmType},
+				{Name: "HSMS", Width: 6, Getter: getHsms},
+				{Name: "MODE", Width: 9, Getter: getMode},
+				{Name: "VPC", Width: 22, Getter: getVpc},
+				{Name: "LAST BACKUP", Width: 18, Getter: getLastBackup},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	c, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	return c.State()
+}
+
+func getHsmType(r dao.Resource) string {
+	c, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(c.Item.HsmType)
+}
+
+func getHsms(r dao.Resource) string {
+	c, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", c.ActiveHSMs(), len(c.Item.Hsms))
+}
+
+func getMode(r dao.Resource) string {
+	c, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	return string(c.Item.Mode)
+}
+
+func getVpc(r dao.Resource) string {
+	c, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(c.Item.VpcId)
+}
+
+func getLastBackup(r dao.Resource) string {
+	c, ok := r.(*ClusterResource)
+	if !ok || c.LatestBackup == nil {
+		return ""
+	}
+	return render.FormatAge(c.LatestBackupTime())
+}
+
+func stateStyle(state string) render.Style {
+	switch {
+	case state == "ACTIVE" || state == "INITIALIZED":
+		return ui.SuccessStyle()
+	case state == "DEGRADED":
+		return ui.DangerStyle()
+	case strings.HasSuffix(state, "_IN_PROGRESS") || state == "UNINITIALIZED":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a cluster
+func (r *ClusterRenderer) RenderDetail(resource dao.Resource) string {
+	c, ok := resource.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("CloudHSM Cluster", c.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Cluster ID", c.GetID())
+	d.FieldStyled("State", c.State(), stateStyle(c.State()))
+	d.FieldIf("State Message", c.Item.StateMessage)
+	d.FieldIf("HSM Type", c.Item.HsmType)
+	d.Field("Mode", string(c.Item.Mode))
+	if t := c.Item.CreateTimestamp; t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	d.FieldIf("Source Backup", c.Item.SourceBackupId)
+
+	d.Section("Network")
+	d.FieldIf("VPC", c.Item.VpcId)
+	d.FieldIf("Security Group", c.Item.SecurityGroup)
+	d.Field("Network Type", string(c.Item.NetworkType))
+	azs := make([]string, 0, len(c.Item.SubnetMapping))
+	for az := range c.Item.SubnetMapping {
+		azs = append(azs, az)
+	}
+	sort.Strings(azs)
+	for _, az := range azs {
+		d.Field("Subnet ("+az+")", c.Item.SubnetMapping[az])
+	}
+
+	d.Section("HSMs")
+	if len(c.Item.Hsms) == 0 {
+		d.Dim("  No HSMs")
+	}
+	for _, h := range c.Item.Hsms {
+		d.FieldStyled(appaws.Str(h.HsmId), fmt.Sprintf("%s %s %s", h.State, appaws.Str(h.AvailabilityZone), appaws.Str(h.EniIp)), stateStyle(string(h.State)))
+	}
+
+	d.Section("Backups")
+	d.Field("Policy", string(c.Item.BackupPolicy))
+	if ret := c.BackupRetention(); ret != "" {
+		d.Field("Retention", ret)
+	}
+	if b := c.LatestBackup; b != nil {
+		d.Field("Latest Backup", appaws.Str(b.BackupId))
+		d.Field("Latest State", string(b.BackupState))
+		d.Field("Latest Created", c.LatestBackupTime().Format("2006-01-02 15:04:05"))
+	} else {
+		d.Field("Latest Backup", render.NoValue)
+	}
+
+	d.Tags(c.Tags)
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a cluster
+func (r *ClusterRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	c, ok := resource.(*ClusterResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Cluster", Value: c.GetID()},
+		{Label: "State", Value: c.State(), Style: stateStyle(c.State())},
+		{Label: "HSMs", Value: fmt.Sprintf("%d active of %d", c.ActiveHSMs(), len(c.Item.Hsms))},
+	}
+	if c.LatestBackup != nil {
+		fields = append(fields, render.SummaryField{
+			Label: "Last Backup",
+			Value: fmt.Sprintf("%s (%s)", render.FormatAge(c.LatestBackupTime()), c.LatestBackupState()),
+		})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	c, ok := resource.(*ClusterResource)
+	if !ok {
+		return nil
+	}
+	navs := []render.Navigation{
+		{
+			Key:         "h",
+			Label:       "HSMs",
+			Service:     "cloudhsmv2",
+			Resource:    "hsms",
+			FilterField: "ClusterId",
+			FilterValue: c.GetID(),
+		},
+		{
+			Key:         "b",
+			Label:       "Backups",
+			Service:     "cloudhsmv2",
+			Resource:    "backups",
+			FilterField: "ClusterId",
+			FilterValue: c.GetID(),
+		},
+	}
+	if vpcID := appaws.Str(c.Item.VpcId); vpcID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "v", Label: "VPC", Service: "vpc", Resource: "vpcs", FilterField: "VpcId", FilterValue: vpcID,
+		})
+	}
+	return navs
+}
+
+// NeedsAutoReload keeps the list refreshing while a cluster changes state
+func (r *ClusterRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if c, ok := dao.UnwrapResource(res).(*ClusterResource); ok && c.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package hsms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// HSMDAO provides data access for the HSMs of a CloudHSM cluster
+type HSMDAO struct {
+	dao.BaseDAO
+	client *cloudhsmv2.Client
+}
+
+// NewHSMDAO creates a new HSMDAO
+func NewHSMDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, cloudhsmv2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &HSMDAO{
+		BaseDAO: dao.NewBaseDAO("cloudhsmv2", "hsms"),
+		client:  client,
+	}, nil
+}
+
+// List returns the HSMs of a cluster (requires ClusterId filter)
+func (d *HSMDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	clusterID := dao.GetFilterFromContext(ctx, "ClusterId")
+	if clusterID == "" {
+		return nil, fmt.Errorf("ClusterId filter required - navigate from a cluster")
+	}
+
+	hsms, err := d.hsms(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(hsms))
+	for i, h := range hsms {
+		resources[i] = NewHSMResource(h)
+	}
+	return resources, nil
+}
+
+// Get returns a specific HSM
+func (d *HSMDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	clusterID := dao.GetFilterFromContext(ctx, "ClusterId")
+	if clusterID == "" {
+		return nil, fmt.Errorf("ClusterId filter required - navigate from a cluster")
+	}
+
+	hsms, err := d.hsms(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hsms {
+		if appaws.Str(h.HsmId) == id {
+			return NewHSMResource(h), nil
+		}
+	}
+	return nil, fmt.Errorf("hsm not found: %s", id)
+}
+
+// Delete deletes an HSM from its cluster
+func (d *HSMDAO) Delete(ctx context.Context, id string) error {
+	clusterID := dao.GetFilterFromContext(ctx, "ClusterId")
+	if clusterID == "" {
+		return fmt.Errorf("ClusterId filter required - navigate from a cluster")
+	}
+
+	_, err := d.client.DeleteHsm(ctx, &cloudhsmv2.DeleteHsmInput{
+		ClusterId: &clusterID,
+		HsmId:     &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete hsm %s", id)
+	}
+	return nil
+}
+
+// hsms returns the HSMs of a cluster; they are only exposed through
+// DescribeClusters.
+func (d *HSMDAO) hsms(ctx context.Context, clusterID string) ([]types.Hsm, error) {
+	output, err := d.client.DescribeClusters(ctx, &cloudhsmv2.DescribeClustersInput{
+		Filters: map[string][]string{"clusterIds": {clusterID}},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe cloudhsm cluster %s", clusterID)
+	}
+	if len(output.Clusters) == 0 {
+		return nil, fmt.Errorf("cloudhsm cluster not found: %s", clusterID)
+	}
+	return output.Clusters[0].Hsms, nil
+}
+
+// HSMResource wraps a CloudHSM hardware security module
+type HSMResource struct {
+	dao.BaseResource
+	Item types.Hsm
+}
+
+// NewHSMResource creates a new HSMResource
+func NewHSMResource(h types.Hsm) *HSMResource {
+	return &HSMResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(h.HsmId),
+			Name: appaws.Str(h.HsmId),
+			Data: h,
+		},
+		Item: h,
+	}
+}
+
+// State returns the HSM state
+func (r *HSMResource) State() string {
+	return string(r.Item.State)
+}
+
+// IsTransitioning reports whether the HSM is being created or deleted.
+func (r *HSMResource) IsTransitioning() bool {
+	return r.Item.State == types.HsmStateCreateInProgress || r.Item.State == types.HsmStateDeleteInProgress
+}
+
+// IPAddress returns the ENI IPv4 address, falling back to IPv6
+func (r *HSMResource) IPAddress() string {
+	if ip := appaws.Str(r.Item.EniIp); ip != "" {
+		return ip
+	}
+	return appaws.Str(r.Item.EniIpV6)
+}
@@ -0,0 +1,20 @@
+package hsms
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudhsmv2", "hsms", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewHSMDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewHSMRenderer()
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package hsms
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// HSMRenderer renders CloudHSM HSMs
+type HSMRenderer struct {
+	render.BaseRenderer
+}
+
+// NewHSMRenderer creates a new HSMRenderer
+func NewHSMRenderer() render.Renderer {
+	return &HSMRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudhsmv2",
+			Resource: "hsms",
+			Cols: []render.Column{
+				{Name: "HSM ID", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATE", Width: 20, Getter: getState},
+				{Name: "AZ", Width: 14, Getter: getAZ},
+				{Name: "IP", Width: 16, Getter: getIP},
+				{Name: "SUBNET", Width: 26, Getter: getSubnet},
+				{Name: "ENI", Width: 24, Getter: getEni},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	h, ok := r.(*HSMResource)
+	if !ok {
+		return ""
+	}
+	return h.State()
+}
+
+func getAZ(r dao.Resource) string {
+	h, ok := r.(*HSMResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(h.Item.AvailabilityZone)
+}
+
+func getIP(r dao.Resource) string {
+	h, ok := r.(*HSMResource)
+	if !ok {
+		return ""
+	}
+	return h.IPAddress()
+}
+
+func getSubnet(r dao.Resource) string {
+	h, ok := r.(*HSMResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(h.Item.SubnetId)
+}
+
+func getEni(r dao.Resource) string {
+	h, ok := r.(*HSMResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(h.Item.EniId)
+}
+
+func stateStyle(state string) render.Style {
+	switch state {
+	case "ACTIVE":
+		return ui.SuccessStyle()
+	case "DEGRADED":
+		return ui.DangerStyle()
+	case "CREATE_IN_PROGRESS", "DELETE_IN_PROGRESS":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an HSM
+func (r *HSMRenderer) RenderDetail(resource dao.Resource) string {
+	h, ok := resource.(*HSMResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("CloudHSM HSM", h.GetID())
+
+	d.Section("Basic Information")
+	d.Field("HSM ID", h.GetID())
+	d.FieldIf("Cluster ID", h.Item.ClusterId)
+	d.FieldStyled("State", h.State(), stateStyle(h.State()))
+	d.FieldIf("State Message", h.Item.StateMessage)
+	d.FieldIf("HSM Type", h.Item.HsmType)
+
+	d.Section("Network")
+	d.FieldIf("Availability Zone", h.Item.AvailabilityZone)
+	d.FieldIf("Subnet", h.Item.SubnetId)
+	d.FieldIf("ENI", h.Item.EniId)
+	d.FieldIf("IPv4 Address", h.Item.EniIp)
+	d.FieldIf("IPv6 Address", h.Item.EniIpV6)
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an HSM
+func (r *HSMRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	h, ok := resource.(*HSMResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "HSM", Value: h.GetID()},
+		{Label: "State", Value: h.State(), Style: stateStyle(h.State())},
+		{Label: "AZ", Value: appaws.Str(h.Item.AvailabilityZone)},
+		{Label: "IP", Value: h.IPAddress()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *HSMRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	h, ok := resource.(*HSMResource)
+	if !ok {
+		return nil
+	}
+	var navs []render.Navigation
+	if subnetID := appaws.Str(h.Item.SubnetId); subnetID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "u", Label: "Subnet", Service: "vpc", Resource: "subnets", FilterField: "SubnetId", FilterValue: subnetID,
+		})
+	}
+	return navs
+}
+
+// NeedsAutoReload keeps the list refreshing while an HSM is created or deleted
+func (r *HSMRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if h, ok := dao.UnwrapResource(res).(*HSMResource); ok && h.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -24,13 +24,13 @@ type EventDAO struct {
 
 // NewEventDAO creates a new EventDAO.
 func NewEventDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudtrail.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &EventDAO{
 		BaseDAO: dao.NewBaseDAO("cloudtrail", "events"),
-		client:  cloudtrail.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -64,6 +64,13 @@ func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string)
 		EndTime:    d.paginationEndTime,
 		MaxResults: &maxResults,
 	}
+	// A ReadOnly filter ("true" or "false") narrows to read or write events
+	if readOnly := dao.GetFilterFromContext(ctx, "ReadOnly"); readOnly != "" {
+		input.LookupAttributes = []types.LookupAttribute{{
+			AttributeKey:   types.LookupAttributeKeyReadOnly,
+			AttributeValue: &readOnly,
+		}}
+	}
 	if pageToken != "" {
 		input.NextToken = &pageToken
 	}
@@ -20,13 +20,13 @@ type TrailDAO struct {
 
 // NewTrailDAO creates a new TrailDAO.
 func NewTrailDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudtrail.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TrailDAO{
 		BaseDAO: dao.NewBaseDAO("cloudtrail", "trails"),
-		client:  cloudtrail.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
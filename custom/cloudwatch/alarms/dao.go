@@ -3,6 +3,7 @@ package alarms
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
@@ -21,18 +22,21 @@ type AlarmDAO struct {
 
 // NewAlarmDAO creates a new AlarmDAO
 func NewAlarmDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &AlarmDAO{
 		BaseDAO: dao.NewBaseDAO("cloudwatch", "alarms"),
-		client:  cloudwatch.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
 func (d *AlarmDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	stateFilter := dao.GetFilterFromContext(ctx, "StateValue")
+	if stateFilter == "" {
+		stateFilter = strings.ToUpper(dao.GetFilterFromContext(ctx, dao.FilterStatus))
+	}
 
 	input := &cloudwatch.DescribeAlarmsInput{}
 	if stateFilter != "" {
@@ -224,6 +228,11 @@ func NewCompositeAlarmResource(a types.CompositeAlarm) *AlarmResource {
 	return r
 }
 
+// State returns the alarm state: OK, ALARM or INSUFFICIENT_DATA.
+func (r *AlarmResource) State() string {
+	return r.StateValue
+}
+
 func (r *AlarmResource) IsMetricAlarm() bool {
 	return r.AlarmType == "Metric"
 }
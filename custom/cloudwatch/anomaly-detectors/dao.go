@@ -0,0 +1,196 @@
+package anomalydetectors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// AnomalyDetectorDAO provides data access for CloudWatch anomaly detectors
+type AnomalyDetectorDAO struct {
+	dao.BaseDAO
+	client *cloudwatch.Client
+}
+
+// NewAnomalyDetectorDAO creates a new AnomalyDetectorDAO
+func NewAnomalyDetectorDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &AnomalyDetectorDAO{
+		BaseDAO: dao.NewBaseDAO("cloudwatch", "anomaly-detectors"),
+		client:  client,
+	}, nil
+}
+
+func (d *AnomalyDetectorDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	detectors, err := d.describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(detectors))
+	for i, ad := range detectors {
+		resources[i] = NewAnomalyDetectorResource(ad)
+	}
+	return resources, nil
+}
+
+// Get returns an anomaly detector by its composite ID. Detectors have no
+// name or ARN, so the ID is derived from the metric they model.
+func (d *AnomalyDetectorDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	detectors, err := d.describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ad := range detectors {
+		if r := NewAnomalyDetectorResource(ad); r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("anomaly detector not found: %s", id)
+}
+
+func (d *AnomalyDetectorDAO) Delete(ctx context.Context, id string) error {
+	res, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	r := res.(*AnomalyDetectorResource)
+
+	_, err = d.client.DeleteAnomalyDetector(ctx, &cloudwatch.DeleteAnomalyDetectorInput{
+		SingleMetricAnomalyDetector: r.Item.SingleMetricAnomalyDetector,
+		MetricMathAnomalyDetector:   r.Item.MetricMathAnomalyDetector,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already deleted
+		}
+		return apperrors.Wrapf(err, "delete anomaly detector %s", id)
+	}
+	return nil
+}
+
+func (d *AnomalyDetectorDAO) describe(ctx context.Context) ([]types.AnomalyDetector, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.AnomalyDetector, *string, error) {
+		output, err := d.client.DescribeAnomalyDetectors(ctx, &cloudwatch.DescribeAnomalyDetectorsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe anomaly detectors")
+		}
+		return output.AnomalyDetectors, output.NextToken, nil
+	})
+}
+
+// AnomalyDetectorResource represents a CloudWatch anomaly detector
+type AnomalyDetectorResource struct {
+	dao.BaseResource
+	Item types.AnomalyDetector
+}
+
+// NewAnomalyDetectorResource creates a new AnomalyDetectorResource
+func NewAnomalyDetectorResource(ad types.AnomalyDetector) *AnomalyDetectorResource {
+	r := &AnomalyDetectorResource{
+		BaseResource: dao.BaseResource{Data: ad},
+		Item:         ad,
+	}
+	r.ID = r.key()
+	r.Name = r.key()
+	return r
+}
+
+// IsMetricMath reports whether the detector models a metric math expression
+func (r *AnomalyDetectorResource) IsMetricMath() bool {
+	return r.Item.MetricMathAnomalyDetector != nil
+}
+
+// Type returns "Single" or "Math"
+func (r *AnomalyDetectorResource) Type() string {
+	if r.IsMetricMath() {
+		return "Math"
+	}
+	return "Single"
+}
+
+// Namespace returns the metric namespace of a single-metric detector
+func (r *AnomalyDetectorResource) Namespace() string {
+	if s := r.Item.SingleMetricAnomalyDetector; s != nil {
+		return appaws.Str(s.Namespace)
+	}
+	return ""
+}
+
+// MetricName returns the metric name of a single-metric detector
+func (r *AnomalyDetectorResource) MetricName() string {
+	if s := r.Item.SingleMetricAnomalyDetector; s != nil {
+		return appaws.Str(s.MetricName)
+	}
+	return ""
+}
+
+// Stat returns the statistic of a single-metric detector
+func (r *AnomalyDetectorResource) Stat() string {
+	if s := r.Item.SingleMetricAnomalyDetector; s != nil {
+		return appaws.Str(s.Stat)
+	}
+	return ""
+}
+
+// Dimensions returns the dimensions of a single-metric detector
+func (r *AnomalyDetectorResource) Dimensions() []types.Dimension {
+	if s := r.Item.SingleMetricAnomalyDetector; s != nil {
+		return s.Dimensions
+	}
+	return nil
+}
+
+// DimensionsString renders dimensions as "Name=Value, ..."
+func (r *AnomalyDetectorResource) DimensionsString() string {
+	dims := r.Dimensions()
+	parts := make([]string, len(dims))
+	for i, dim := range dims {
+		parts[i] = appaws.Str(dim.Name) + "=" + appaws.Str(dim.Value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Expression returns the returned expression of a metric math detector
+func (r *AnomalyDetectorResource) Expression() string {
+	m := r.Item.MetricMathAnomalyDetector
+	if m == nil {
+		return ""
+	}
+	for _, q := range m.MetricDataQueries {
+		if q.Expression != nil && (q.ReturnData == nil || *q.ReturnData) {
+			return appaws.Str(q.Expression)
+		}
+	}
+	return ""
+}
+
+// State returns the detector state (PENDING_TRAINING, TRAINED_INSUFFICIENT_DATA, TRAINED)
+func (r *AnomalyDetectorResource) State() string {
+	return string(r.Item.StateValue)
+}
+
+// key builds a stable identifier: Namespace/MetricName/Stat[dims] for single
+// metric detectors and the expression for metric math detectors.
+func (r *AnomalyDetectorResource) key() string {
+	if r.IsMetricMath() {
+		return "math:" + r.Expression()
+	}
+	key := fmt.Sprintf("%s/%s/%s", r.Namespace(), r.MetricName(), r.Stat())
+	if dims := r.DimensionsString(); dims != "" {
+		key += "[" + dims + "]"
+	}
+	return key
+}
@@ -0,0 +1,20 @@
+package anomalydetectors
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudwatch", "anomaly-detectors", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewAnomalyDetectorDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewAnomalyDetectorRenderer()
+		},
+	})
+}
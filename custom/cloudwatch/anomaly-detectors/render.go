@@ -0,0 +1,139 @@
+package anomalydetectors
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// AnomalyDetectorRenderer renders CloudWatch anomaly detectors
+type AnomalyDetectorRenderer struct {
+	render.BaseRenderer
+}
+
+// NewAnomalyDetectorRenderer creates a new AnomalyDetectorRenderer
+func NewAnomalyDetectorRenderer() render.Renderer {
+	return &AnomalyDetectorRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudwatch",
+			Resource: "anomaly-detectors",
+			Cols: []render.Column{
+				{Name: "METRIC", Width: 40, Getter: getMetric},
+				{Name: "TYPE", Width: 7, Getter: getType},
+				{Name: "STAT", Width: 10, Getter: getStat},
+				{Name: "DIMENSIONS", Width: 40, Getter: getDimensions},
+				{Name: "STATE", Width: 26, Getter: getState},
+			},
+		},
+	}
+}
+
+func getMetric(r dao.Resource) string {
+	ad, ok := r.(*AnomalyDetectorResource)
+	if !ok {
+		return ""
+	}
+	if ad.IsMetricMath() {
+		return ad.Expression()
+	}
+	return ad.Namespace() + "/" + ad.MetricName()
+}
+
+func getType(r dao.Resource) string {
+	if ad, ok := r.(*AnomalyDetectorResource); ok {
+		return ad.Type()
+	}
+	return ""
+}
+
+func getStat(r dao.Resource) string {
+	if ad, ok := r.(*AnomalyDetectorResource); ok {
+		return ad.Stat()
+	}
+	return ""
+}
+
+func getDimensions(r dao.Resource) string {
+	if ad, ok := r.(*AnomalyDetectorResource); ok {
+		return ad.DimensionsString()
+	}
+	return ""
+}
+
+func getState(r dao.Resource) string {
+	if ad, ok := r.(*AnomalyDetectorResource); ok {
+		return ad.State()
+	}
+	return ""
+}
+
+// RenderDetail renders detailed anomaly detector information
+func (r *AnomalyDetectorRenderer) RenderDetail(resource dao.Resource) string {
+	ad, ok := resource.(*AnomalyDetectorResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Anomaly Detector", getMetric(ad))
+
+	d.Section("Basic Information")
+	d.Field("Type", ad.Type())
+	d.Field("State", ad.State())
+
+	if ad.IsMetricMath() {
+		d.Section("Metric Math")
+		for _, q := range ad.Item.MetricMathAnomalyDetector.MetricDataQueries {
+			id := appaws.Str(q.Id)
+			switch {
+			case q.Expression != nil:
+				d.Field(id, appaws.Str(q.Expression))
+			case q.MetricStat != nil && q.MetricStat.Metric != nil:
+				m := q.MetricStat.Metric
+				d.Field(id, fmt.Sprintf("%s/%s (%s)", appaws.Str(m.Namespace), appaws.Str(m.MetricName), appaws.Str(q.MetricStat.Stat)))
+			}
+		}
+	} else {
+		d.Section("Metric")
+		d.Field("Namespace", ad.Namespace())
+		d.Field("Metric Name", ad.MetricName())
+		d.Field("Stat", ad.Stat())
+		for _, dim := range ad.Dimensions() {
+			d.Field(appaws.Str(dim.Name), appaws.Str(dim.Value))
+		}
+	}
+
+	if c := ad.Item.Configuration; c != nil {
+		d.Section("Configuration")
+		if tz := appaws.Str(c.MetricTimezone); tz != "" {
+			d.Field("Timezone", tz)
+		}
+		for _, tr := range c.ExcludedTimeRanges {
+			if tr.StartTime != nil && tr.EndTime != nil {
+				d.Field("Excluded", fmt.Sprintf("%s - %s",
+					tr.StartTime.Format("2006-01-02 15:04"), tr.EndTime.Format("2006-01-02 15:04")))
+			}
+		}
+	}
+	if mc := ad.Item.MetricCharacteristics; mc != nil && mc.PeriodicSpikes != nil {
+		d.Field("Periodic Spikes", fmt.Sprintf("%v", *mc.PeriodicSpikes))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *AnomalyDetectorRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	ad, ok := resource.(*AnomalyDetectorResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Metric", Value: getMetric(ad)},
+		{Label: "Type", Value: ad.Type()},
+		{Label: "State", Value: ad.State()},
+	}
+}
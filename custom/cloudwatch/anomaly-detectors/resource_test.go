@@ -0,0 +1,53 @@
+package anomalydetectors
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+func TestNewAnomalyDetectorResource_SingleMetric(t *testing.T) {
+	r := NewAnomalyDetectorResource(types.AnomalyDetector{
+		SingleMetricAnomalyDetector: &types.SingleMetricAnomalyDetector{
+			Namespace:  aws.String("AWS/Lambda"),
+			MetricName: aws.String("Duration"),
+			Stat:       aws.String("Average"),
+			Dimensions: []types.Dimension{
+				{Name: aws.String("FunctionName"), Value: aws.String("api")},
+			},
+		},
+		StateValue: types.AnomalyDetectorStateValueTrained,
+	})
+
+	if got := r.GetID(); got != "AWS/Lambda/Duration/Average[FunctionName=api]" {
+		t.Errorf("GetID() = %q", got)
+	}
+	if r.IsMetricMath() || r.Type() != "Single" {
+		t.Errorf("Type() = %q, want Single", r.Type())
+	}
+	if r.State() != "TRAINED" {
+		t.Errorf("State() = %q, want TRAINED", r.State())
+	}
+}
+
+func TestNewAnomalyDetectorResource_MetricMath(t *testing.T) {
+	r := NewAnomalyDetectorResource(types.AnomalyDetector{
+		MetricMathAnomalyDetector: &types.MetricMathAnomalyDetector{
+			MetricDataQueries: []types.MetricDataQuery{
+				{Id: aws.String("errors"), ReturnData: aws.Bool(false)},
+				{Id: aws.String("rate"), Expression: aws.String("errors / invocations")},
+			},
+		},
+	})
+
+	if got := r.GetID(); got != "math:errors / invocations" {
+		t.Errorf("GetID() = %q", got)
+	}
+	if r.Type() != "Math" {
+		t.Errorf("Type() = %q, want Math", r.Type())
+	}
+	if r.DimensionsString() != "" || r.Namespace() != "" {
+		t.Error("metric math detector should have no single-metric fields")
+	}
+}
@@ -0,0 +1,71 @@
+package canaries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("cloudwatch", "canaries", []action.Action{
+		{
+			Name:      "Start",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartCanary",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				c, ok := r.(*CanaryResource)
+				return ok && c.CanStart()
+			},
+		},
+		{
+			Name:      "Stop",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopCanary",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				c, ok := r.(*CanaryResource)
+				return ok && c.IsRunning()
+			},
+		},
+	})
+
+	action.RegisterExecutor("cloudwatch", "canaries", executeCanaryAction)
+}
+
+func executeCanaryAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	c, ok := resource.(*CanaryResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := cwClient.GetSyntheticsClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	name := c.GetName()
+	switch act.Operation {
+	case "StartCanary":
+		if _, err := client.StartCanary(ctx, &synthetics.StartCanaryInput{Name: &name}); err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("start canary: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Started canary %s", name)}
+
+	case "StopCanary":
+		if _, err := client.StopCanary(ctx, &synthetics.StopCanaryInput{Name: &name}); err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("stop canary: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Stopped canary %s", name)}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -0,0 +1,237 @@
+package canaries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// CanaryDAO provides data access for CloudWatch Synthetics canaries
+type CanaryDAO struct {
+	dao.BaseDAO
+	client *synthetics.Client
+}
+
+// NewCanaryDAO creates a new CanaryDAO
+func NewCanaryDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, synthetics.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &CanaryDAO{
+		BaseDAO: dao.NewBaseDAO("cloudwatch", "canaries"),
+		client:  client,
+	}, nil
+}
+
+// List returns all canaries with their most recent run
+func (d *CanaryDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	canaries, err := appaws.Paginate(ctx, func(token *string) ([]types.Canary, *string, error) {
+		output, err := d.client.DescribeCanaries(ctx, &synthetics.DescribeCanariesInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe canaries")
+		}
+		return output.Canaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastRuns, err := d.lastRuns(ctx, nil)
+	if err != nil {
+		log.Warn("failed to describe canary last runs", "error", err)
+	}
+
+	resources := make([]dao.Resource, len(canaries))
+	for i, c := range canaries {
+		resources[i] = NewCanaryResource(c, lastRuns[appaws.Str(c.Name)])
+	}
+	return resources, nil
+}
+
+// Get returns a canary with its last run and the run's screenshot artifacts.
+// Last-run and screenshot lookups are best-effort.
+func (d *CanaryDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.client.GetCanary(ctx, &synthetics.GetCanaryInput{Name: &name})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get canary %s", name)
+	}
+	if output.Canary == nil {
+		return nil, fmt.Errorf("canary not found: %s", name)
+	}
+
+	lastRuns, err := d.lastRuns(ctx, []string{name})
+	if err != nil {
+		log.Warn("failed to describe canary last run", "canary", name, "error", err)
+	}
+	r := NewCanaryResource(*output.Canary, lastRuns[name])
+
+	if loc := r.LastRunArtifacts(); loc != "" {
+		shots, err := cwClient.ListCanaryScreenshots(ctx, loc)
+		if err != nil {
+			log.Warn("failed to list canary screenshots", "canary", name, "error", err)
+		} else {
+			r.Screenshots = shots
+		}
+	}
+	return r, nil
+}
+
+// Delete is not supported; deleting a canary leaves its Lambda function and
+// layers behind, which is better handled in the console.
+func (d *CanaryDAO) Delete(ctx context.Context, name string) error {
+	return fmt.Errorf("delete not supported for canaries")
+}
+
+func (d *CanaryDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// lastRuns returns the most recent run per canary name. A nil names slice
+// fetches all canaries.
+func (d *CanaryDAO) lastRuns(ctx context.Context, names []string) (map[string]*types.CanaryRun, error) {
+	runs := make(map[string]*types.CanaryRun)
+	results, err := appaws.Paginate(ctx, func(token *string) ([]types.CanaryLastRun, *string, error) {
+		output, err := d.client.DescribeCanariesLastRun(ctx, &synthetics.DescribeCanariesLastRunInput{
+			Names:     names,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe canaries last run")
+		}
+		return output.CanariesLastRun, output.NextToken, nil
+	})
+	if err != nil {
+		return runs, err
+	}
+	for _, lr := range results {
+		if lr.LastRun != nil {
+			runs[appaws.Str(lr.CanaryName)] = lr.LastRun
+		}
+	}
+	return runs, nil
+}
+
+// CanaryResource represents a CloudWatch Synthetics canary
+type CanaryResource struct {
+	dao.BaseResource
+	Item    types.Canary
+	LastRun *types.CanaryRun
+
+	// Populated by Get only
+	Screenshots []cwClient.Screenshot
+}
+
+// NewCanaryResource creates a new CanaryResource
+func NewCanaryResource(c types.Canary, lastRun *types.CanaryRun) *CanaryResource {
+	name := appaws.Str(c.Name)
+	return &CanaryResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Tags: c.Tags,
+			Data: c,
+		},
+		Item:    c,
+		LastRun: lastRun,
+	}
+}
+
+// State returns the canary state (RUNNING, STOPPED, ERROR, ...)
+func (r *CanaryResource) State() string {
+	if r.Item.Status != nil {
+		return string(r.Item.Status.State)
+	}
+	return ""
+}
+
+// StateReason returns why the canary is in its current state
+func (r *CanaryResource) StateReason() string {
+	if r.Item.Status != nil {
+		return appaws.Str(r.Item.Status.StateReason)
+	}
+	return ""
+}
+
+// IsRunning reports whether the canary is running on its schedule
+func (r *CanaryResource) IsRunning() bool {
+	return r.Item.Status != nil && r.Item.Status.State == types.CanaryStateRunning
+}
+
+// CanStart reports whether the canary is in a state StartCanary accepts
+func (r *CanaryResource) CanStart() bool {
+	if r.Item.Status == nil {
+		return false
+	}
+	switch r.Item.Status.State {
+	case types.CanaryStateReady, types.CanaryStateStopped, types.CanaryStateError:
+		return true
+	}
+	return false
+}
+
+// LastRunState returns the last run outcome (PASSED, FAILED, RUNNING)
+func (r *CanaryResource) LastRunState() string {
+	if r.LastRun != nil && r.LastRun.Status != nil {
+		return string(r.LastRun.Status.State)
+	}
+	return ""
+}
+
+// LastRunReason returns the failure reason of the last run, if any
+func (r *CanaryResource) LastRunReason() string {
+	if r.LastRun != nil && r.LastRun.Status != nil {
+		return appaws.Str(r.LastRun.Status.StateReason)
+	}
+	return ""
+}
+
+// LastRunTime returns when the last run started
+func (r *CanaryResource) LastRunTime() *time.Time {
+	if r.LastRun != nil && r.LastRun.Timeline != nil {
+		return r.LastRun.Timeline.Started
+	}
+	return nil
+}
+
+// LastRunArtifacts returns the S3 artifact location of the last run
+func (r *CanaryResource) LastRunArtifacts() string {
+	if r.LastRun != nil {
+		return appaws.Str(r.LastRun.ArtifactS3Location)
+	}
+	return ""
+}
+
+// Schedule returns the schedule expression
+func (r *CanaryResource) Schedule() string {
+	if r.Item.Schedule != nil {
+		return appaws.Str(r.Item.Schedule.Expression)
+	}
+	return ""
+}
+
+// RuntimeVersion returns the Synthetics runtime version
+func (r *CanaryResource) RuntimeVersion() string {
+	return appaws.Str(r.Item.RuntimeVersion)
+}
+
+// LogGroupName returns the Lambda log group the canary writes to
+func (r *CanaryResource) LogGroupName() string {
+	return fmt.Sprintf("/aws/lambda/cwsyn-%s-%s", appaws.Str(r.Item.Name), appaws.Str(r.Item.Id))
+}
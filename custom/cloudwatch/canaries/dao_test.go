@@ -0,0 +1,69 @@
+package canaries
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+)
+
+func TestCanaryResourceState(t *testing.T) {
+	tests := []struct {
+		state     types.CanaryState
+		canStart  bool
+		isRunning bool
+	}{
+		{types.CanaryStateRunning, false, true},
+		{types.CanaryStateStopped, true, false},
+		{types.CanaryStateReady, true, false},
+		{types.CanaryStateError, true, false},
+		{types.CanaryStateStarting, false, false},
+		{types.CanaryStateStopping, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			r := NewCanaryResource(types.Canary{
+				Name:   aws.String("home-page"),
+				Status: &types.CanaryStatus{State: tt.state},
+			}, nil)
+			if got := r.CanStart(); got != tt.canStart {
+				t.Errorf("CanStart() = %v, want %v", got, tt.canStart)
+			}
+			if got := r.IsRunning(); got != tt.isRunning {
+				t.Errorf("IsRunning() = %v, want %v", got, tt.isRunning)
+			}
+		})
+	}
+}
+
+func TestCanaryResourceLastRun(t *testing.T) {
+	r := NewCanaryResource(types.Canary{
+		Name: aws.String("home-page"),
+		Id:   aws.String("abc-123"),
+	}, &types.CanaryRun{
+		Status: &types.CanaryRunStatus{
+			State:       types.CanaryRunStateFailed,
+			StateReason: aws.String("Navigation timed out"),
+		},
+		ArtifactS3Location: aws.String("cw-syn-results/canary/home-page/2026/01/01"),
+	})
+
+	if got := r.LastRunState(); got != "FAILED" {
+		t.Errorf("LastRunState() = %q, want FAILED", got)
+	}
+	if got := r.LastRunReason(); got != "Navigation timed out" {
+		t.Errorf("LastRunReason() = %q", got)
+	}
+	if got := r.LastRunArtifacts(); got != "cw-syn-results/canary/home-page/2026/01/01" {
+		t.Errorf("LastRunArtifacts() = %q", got)
+	}
+	if got := r.LogGroupName(); got != "/aws/lambda/cwsyn-home-page-abc-123" {
+		t.Errorf("LogGroupName() = %q", got)
+	}
+
+	empty := NewCanaryResource(types.Canary{Name: aws.String("new")}, nil)
+	if empty.LastRunState() != "" || empty.LastRunTime() != nil {
+		t.Error("expected empty last run for canary without runs")
+	}
+}
@@ -0,0 +1,20 @@
+package canaries
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudwatch", "canaries", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewCanaryDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewCanaryRenderer()
+		},
+	})
+}
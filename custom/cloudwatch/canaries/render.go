@@ -0,0 +1,219 @@
+package canaries
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure CanaryRenderer implements render.Navigator
+var _ render.Navigator = (*CanaryRenderer)(nil)
+
+// CanaryRenderer renders CloudWatch Synthetics canaries
+type CanaryRenderer struct {
+	render.BaseRenderer
+}
+
+// NewCanaryRenderer creates a new CanaryRenderer
+func NewCanaryRenderer() *CanaryRenderer {
+	return &CanaryRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudwatch",
+			Resource: "canaries",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 30, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATE", Width: 10, Getter: getState},
+				{Name: "LAST RUN", Width: 10, Getter: getLastRun},
+				{Name: "RAN", Width: 8, Getter: getLastRunAge},
+				{Name: "SCHEDULE", Width: 22, Getter: getSchedule},
+				{Name: "RUNTIME", Width: 24, Getter: getRuntime},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	if c, ok := r.(*CanaryResource); ok {
+		return c.State()
+	}
+	return ""
+}
+
+func getLastRun(r dao.Resource) string {
+	if c, ok := r.(*CanaryResource); ok {
+		if s := c.LastRunState(); s != "" {
+			return s
+		}
+	}
+	return "-"
+}
+
+func getLastRunAge(r dao.Resource) string {
+	if c, ok := r.(*CanaryResource); ok {
+		if t := c.LastRunTime(); t != nil {
+			return render.FormatAge(*t)
+		}
+	}
+	return "-"
+}
+
+func getSchedule(r dao.Resource) string {
+	if c, ok := r.(*CanaryResource); ok {
+		return c.Schedule()
+	}
+	return ""
+}
+
+func getRuntime(r dao.Resource) string {
+	if c, ok := r.(*CanaryResource); ok {
+		return c.RuntimeVersion()
+	}
+	return ""
+}
+
+// RenderDetail renders detailed canary information
+func (r *CanaryRenderer) RenderDetail(resource dao.Resource) string {
+	c, ok := resource.(*CanaryResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Synthetics Canary", c.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", c.GetName())
+	d.Field("ID", appaws.Str(c.Item.Id))
+	d.Field("State", c.State())
+	if reason := c.StateReason(); reason != "" {
+		d.Field("State Reason", reason)
+	}
+	d.Field("Runtime", c.RuntimeVersion())
+	if s := c.Schedule(); s != "" {
+		d.Field("Schedule", s)
+	}
+	if role := appaws.Str(c.Item.ExecutionRoleArn); role != "" {
+		d.Field("Execution Role", role)
+	}
+
+	if c.LastRun != nil {
+		d.Section("Last Run")
+		state := c.LastRunState()
+		if c.LastRun.Status != nil && c.LastRun.Status.State == types.CanaryRunStateFailed {
+			d.FieldStyled("Result", state, ui.DangerStyle())
+		} else {
+			d.Field("Result", state)
+		}
+		if reason := c.LastRunReason(); reason != "" {
+			d.Field("Reason", reason)
+		}
+		if tl := c.LastRun.Timeline; tl != nil {
+			if tl.Started != nil {
+				d.Field("Started", tl.Started.Format("2006-01-02 15:04:05"))
+			}
+			if tl.Started != nil && tl.Completed != nil {
+				d.Field("Duration", render.FormatDuration(tl.Completed.Sub(*tl.Started)))
+			}
+		}
+		if loc := c.LastRunArtifacts(); loc != "" {
+			d.Field("Artifacts", loc)
+		}
+	}
+
+	if len(c.Screenshots) > 0 {
+		d.Section(fmt.Sprintf("Screenshots (%d)", len(c.Screenshots)))
+		for _, s := range c.Screenshots {
+			value := fmt.Sprintf("%.1f KB", float64(s.Size)/1024)
+			if s.LastModified != nil {
+				value += "  " + s.LastModified.Format("15:04:05")
+			}
+			d.Field(s.Name, value)
+		}
+	}
+
+	if rc := c.Item.RunConfig; rc != nil {
+		d.Section("Run Config")
+		if rc.TimeoutInSeconds != nil {
+			d.Field("Timeout", fmt.Sprintf("%ds", *rc.TimeoutInSeconds))
+		}
+		if rc.MemoryInMB != nil {
+			d.Field("Memory", fmt.Sprintf("%d MB", *rc.MemoryInMB))
+		}
+		if rc.ActiveTracing != nil {
+			d.Field("Active Tracing", fmt.Sprintf("%v", *rc.ActiveTracing))
+		}
+	}
+
+	d.Section("Retention")
+	if v := c.Item.SuccessRetentionPeriodInDays; v != nil {
+		d.Field("Success Runs", fmt.Sprintf("%d days", *v))
+	}
+	if v := c.Item.FailureRetentionPeriodInDays; v != nil {
+		d.Field("Failed Runs", fmt.Sprintf("%d days", *v))
+	}
+	if loc := appaws.Str(c.Item.ArtifactS3Location); loc != "" {
+		d.Field("Artifact Bucket", loc)
+	}
+
+	if tl := c.Item.Timeline; tl != nil {
+		d.Section("Timestamps")
+		if tl.Created != nil {
+			d.Field("Created", tl.Created.Format("2006-01-02 15:04:05"))
+		}
+		if tl.LastModified != nil {
+			d.Field("Last Modified", tl.LastModified.Format("2006-01-02 15:04:05"))
+		}
+		if tl.LastStarted != nil {
+			d.Field("Last Started", tl.LastStarted.Format("2006-01-02 15:04:05"))
+		}
+		if tl.LastStopped != nil {
+			d.Field("Last Stopped", tl.LastStopped.Format("2006-01-02 15:04:05"))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *CanaryRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	c, ok := resource.(*CanaryResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: c.GetName()},
+		{Label: "State", Value: c.State()},
+	}
+	if s := c.LastRunState(); s != "" {
+		fields = append(fields, render.SummaryField{Label: "Last Run", Value: s})
+	}
+	if s := c.Schedule(); s != "" {
+		fields = append(fields, render.SummaryField{Label: "Schedule", Value: s})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *CanaryRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	c, ok := resource.(*CanaryResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "r", Label: "Runs", Service: "cloudwatch", Resource: "canary-runs",
+			FilterField: "CanaryName", FilterValue: c.GetName(),
+		},
+		{
+			Key: "l", Label: "Logs", Service: "cloudwatch", Resource: "log-streams",
+			FilterField: "LogGroupName", FilterValue: c.LogGroupName(),
+		},
+	}
+}
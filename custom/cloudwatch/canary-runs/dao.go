@@ -0,0 +1,176 @@
+package canaryruns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// CanaryRunDAO provides data access for CloudWatch Synthetics canary runs
+type CanaryRunDAO struct {
+	dao.BaseDAO
+	client *synthetics.Client
+}
+
+// NewCanaryRunDAO creates a new CanaryRunDAO
+func NewCanaryRunDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, synthetics.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &CanaryRunDAO{
+		BaseDAO: dao.NewBaseDAO("cloudwatch", "canary-runs"),
+		client:  client,
+	}, nil
+}
+
+// List returns the run history of a canary (requires CanaryName filter)
+func (d *CanaryRunDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	name := dao.GetFilterFromContext(ctx, "CanaryName")
+	if name == "" {
+		return nil, fmt.Errorf("CanaryName filter required - navigate from a canary")
+	}
+
+	runs, err := d.listRuns(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(runs))
+	for i, run := range runs {
+		resources[i] = NewCanaryRunResource(name, run)
+	}
+	return resources, nil
+}
+
+// Get returns a canary run with its screenshot artifacts.
+// The screenshot lookup is best-effort.
+func (d *CanaryRunDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	name := dao.GetFilterFromContext(ctx, "CanaryName")
+	if name == "" {
+		return nil, fmt.Errorf("CanaryName filter required - navigate from a canary")
+	}
+
+	runs, err := d.listRuns(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		if appaws.Str(run.Id) != id {
+			continue
+		}
+		r := NewCanaryRunResource(name, run)
+		if loc := r.ArtifactLocation(); loc != "" {
+			shots, err := cwClient.ListCanaryScreenshots(ctx, loc)
+			if err != nil {
+				log.Warn("failed to list canary screenshots", "canary", name, "run", id, "error", err)
+			} else {
+				r.Screenshots = shots
+			}
+		}
+		return r, nil
+	}
+	return nil, fmt.Errorf("canary run not found: %s", id)
+}
+
+// Delete is not supported; run history expires per the canary's retention settings.
+func (d *CanaryRunDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for canary runs")
+}
+
+func (d *CanaryRunDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *CanaryRunDAO) listRuns(ctx context.Context, name string) ([]types.CanaryRun, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.CanaryRun, *string, error) {
+		output, err := d.client.GetCanaryRuns(ctx, &synthetics.GetCanaryRunsInput{
+			Name:      &name,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "get canary runs of %s", name)
+		}
+		return output.CanaryRuns, output.NextToken, nil
+	})
+}
+
+// CanaryRunResource represents a single run of a Synthetics canary
+type CanaryRunResource struct {
+	dao.BaseResource
+	CanaryName string
+	Item       types.CanaryRun
+
+	// Populated by Get only
+	Screenshots []cwClient.Screenshot
+}
+
+// NewCanaryRunResource creates a new CanaryRunResource
+func NewCanaryRunResource(canaryName string, run types.CanaryRun) *CanaryRunResource {
+	id := appaws.Str(run.Id)
+	return &CanaryRunResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: run,
+		},
+		CanaryName: canaryName,
+		Item:       run,
+	}
+}
+
+// State returns the run outcome (PASSED, FAILED, RUNNING)
+func (r *CanaryRunResource) State() string {
+	if r.Item.Status != nil {
+		return string(r.Item.Status.State)
+	}
+	return ""
+}
+
+// IsFailed reports whether the run failed
+func (r *CanaryRunResource) IsFailed() bool {
+	return r.Item.Status != nil && r.Item.Status.State == types.CanaryRunStateFailed
+}
+
+// Reason returns the run's state reason, if any
+func (r *CanaryRunResource) Reason() string {
+	if r.Item.Status != nil {
+		return appaws.Str(r.Item.Status.StateReason)
+	}
+	return ""
+}
+
+// StartedAt returns when the run started
+func (r *CanaryRunResource) StartedAt() *time.Time {
+	if r.Item.Timeline != nil {
+		return r.Item.Timeline.Started
+	}
+	return nil
+}
+
+// Duration returns how long the run took, or 0 while it is still running
+func (r *CanaryRunResource) Duration() time.Duration {
+	if tl := r.Item.Timeline; tl != nil && tl.Started != nil && tl.Completed != nil {
+		return tl.Completed.Sub(*tl.Started)
+	}
+	return 0
+}
+
+// ArtifactLocation returns the S3 location of the run's artifacts
+func (r *CanaryRunResource) ArtifactLocation() string {
+	return appaws.Str(r.Item.ArtifactS3Location)
+}
@@ -0,0 +1,20 @@
+package canaryruns
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudwatch", "canary-runs", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewCanaryRunDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewCanaryRunRenderer()
+		},
+	})
+}
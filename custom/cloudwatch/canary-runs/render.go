@@ -0,0 +1,130 @@
+package canaryruns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// CanaryRunRenderer renders Synthetics canary runs
+type CanaryRunRenderer struct {
+	render.BaseRenderer
+}
+
+// NewCanaryRunRenderer creates a new CanaryRunRenderer
+func NewCanaryRunRenderer() render.Renderer {
+	return &CanaryRunRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudwatch",
+			Resource: "canary-runs",
+			Cols: []render.Column{
+				{Name: "RUN ID", Width: 38, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 10, Getter: getStatus},
+				{Name: "STARTED", Width: 10, Getter: getStarted},
+				{Name: "DURATION", Width: 10, Getter: getDuration},
+				{Name: "REASON", Width: 50, Getter: getReason},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if run, ok := r.(*CanaryRunResource); ok {
+		return run.State()
+	}
+	return ""
+}
+
+func getStarted(r dao.Resource) string {
+	if run, ok := r.(*CanaryRunResource); ok {
+		if t := run.StartedAt(); t != nil {
+			return render.FormatAge(*t)
+		}
+	}
+	return "-"
+}
+
+func getDuration(r dao.Resource) string {
+	if run, ok := r.(*CanaryRunResource); ok {
+		if d := run.Duration(); d > 0 {
+			return render.FormatDuration(d.Round(time.Second))
+		}
+	}
+	return "-"
+}
+
+func getReason(r dao.Resource) string {
+	if run, ok := r.(*CanaryRunResource); ok {
+		return run.Reason()
+	}
+	return ""
+}
+
+// RenderDetail renders detailed canary run information
+func (r *CanaryRunRenderer) RenderDetail(resource dao.Resource) string {
+	run, ok := resource.(*CanaryRunResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Canary Run", run.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Run ID", run.GetID())
+	d.Field("Canary", run.CanaryName)
+	if run.IsFailed() {
+		d.FieldStyled("Status", run.State(), ui.DangerStyle())
+	} else {
+		d.Field("Status", run.State())
+	}
+	if reason := run.Reason(); reason != "" {
+		d.Field("Reason", reason)
+	}
+	if loc := run.ArtifactLocation(); loc != "" {
+		d.Field("Artifacts", loc)
+	}
+
+	if len(run.Screenshots) > 0 {
+		d.Section(fmt.Sprintf("Screenshots (%d)", len(run.Screenshots)))
+		for _, s := range run.Screenshots {
+			value := fmt.Sprintf("%.1f KB", float64(s.Size)/1024)
+			if s.LastModified != nil {
+				value += "  " + s.LastModified.Format("15:04:05")
+			}
+			d.Field(s.Name, value)
+		}
+	}
+
+	if tl := run.Item.Timeline; tl != nil {
+		d.Section("Timeline")
+		if tl.Started != nil {
+			d.Field("Started", tl.Started.Format("2006-01-02 15:04:05"))
+		}
+		if tl.Completed != nil {
+			d.Field("Completed", tl.Completed.Format("2006-01-02 15:04:05"))
+		}
+		if dur := run.Duration(); dur > 0 {
+			d.Field("Duration", render.FormatDuration(dur.Round(time.Second)))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *CanaryRunRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	run, ok := resource.(*CanaryRunResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Run ID", Value: run.GetID()},
+		{Label: "Canary", Value: run.CanaryName},
+		{Label: "Status", Value: run.State()},
+	}
+}
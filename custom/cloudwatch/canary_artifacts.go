@@ -0,0 +1,65 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// maxScreenshots bounds how many screenshots are listed for a canary run.
+const maxScreenshots = 50
+
+// Screenshot describes a screenshot artifact stored by a canary run
+type Screenshot struct {
+	Name         string
+	Key          string
+	Size         int64
+	LastModified *time.Time
+}
+
+// ListCanaryScreenshots lists the PNG screenshots under a canary run's
+// artifact location ("bucket/prefix", as reported by Synthetics).
+func ListCanaryScreenshots(ctx context.Context, location string) ([]Screenshot, error) {
+	bucket, prefix, ok := strings.Cut(strings.TrimPrefix(location, "s3://"), "/")
+	if !ok || bucket == "" {
+		return nil, fmt.Errorf("invalid artifact location %q", location)
+	}
+
+	client, err := appaws.Client(ctx, s3.NewFromConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket: &bucket,
+		Prefix: appaws.StringPtr(strings.TrimSuffix(prefix, "/") + "/"),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "list canary artifacts in %s", location)
+	}
+
+	var shots []Screenshot
+	for _, obj := range output.Contents {
+		key := appaws.Str(obj.Key)
+		if !strings.HasSuffix(strings.ToLower(key), ".png") {
+			continue
+		}
+		shots = append(shots, Screenshot{
+			Name:         path.Base(key),
+			Key:          key,
+			Size:         appaws.Int64(obj.Size),
+			LastModified: obj.LastModified,
+		})
+		if len(shots) == maxScreenshots {
+			break
+		}
+	}
+	return shots, nil
+}
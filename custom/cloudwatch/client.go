@@ -5,24 +5,22 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 
 	appaws "github.com/clawscli/claws/internal/aws"
 )
 
 // GetClient returns a CloudWatch client configured for the current context
 func GetClient(ctx context.Context) (*cloudwatch.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return cloudwatch.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, cloudwatch.NewFromConfig)
 }
 
 // GetLogsClient returns a CloudWatch Logs client configured for the current context
 func GetLogsClient(ctx context.Context) (*cloudwatchlogs.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return cloudwatchlogs.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, cloudwatchlogs.NewFromConfig)
+}
+
+// GetSyntheticsClient returns a CloudWatch Synthetics client configured for the current context
+func GetSyntheticsClient(ctx context.Context) (*synthetics.Client, error) {
+	return appaws.Client(ctx, synthetics.NewFromConfig)
 }
@@ -0,0 +1,207 @@
+package insightsqueries
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// defaultSince is the time range a query covers when none is given.
+const defaultSince = time.Hour
+
+func init() {
+	action.Global.Register("cloudwatch", "insights-queries", []action.Action{
+		{
+			Name:      "Run Query",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartQuery",
+			Confirm:   action.ConfirmNone,
+			Input: &action.InputSpec{
+				Label:       "Log groups and time range (blank: saved groups, last 1h)",
+				Placeholder: "/aws/lambda/app /aws/lambda/worker since=24h",
+				Optional:    true,
+			},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteQueryDefinition",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("cloudwatch", "insights-queries", executeQueryDefinitionAction)
+}
+
+func executeQueryDefinitionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "StartQuery":
+		return executeStartQuery(ctx, resource)
+	case "DeleteQueryDefinition":
+		return executeDeleteQueryDefinition(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeStartQuery(ctx context.Context, resource dao.Resource) action.ActionResult {
+	q, ok := resource.(*QueryDefinitionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	groups, since, err := parseRunInput(action.InputFromContext(ctx), q.LogGroupNames())
+	if err != nil {
+		return action.FailResult(err)
+	}
+	if len(groups) == 0 && !strings.Contains(strings.ToUpper(q.QueryString()), "SOURCE ") {
+		return action.FailResult(fmt.Errorf("query %q has no saved log groups; enter at least one", q.GetName()))
+	}
+
+	client, err := cwClient.GetLogsClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	end := time.Now()
+	req := &cloudwatchlogs.StartQueryInput{
+		QueryString:   q.Item.QueryString,
+		QueryLanguage: q.Item.QueryLanguage,
+		LogGroupNames: groups,
+		StartTime:     appaws.Int64Ptr(end.Add(-since).Unix()),
+		EndTime:       appaws.Int64Ptr(end.Unix()),
+	}
+	return action.Track(ctx, fmt.Sprintf("Running query %s", q.GetName()),
+		func(ctx context.Context, report func(string)) (string, error) {
+			return runQuery(ctx, client, req, report)
+		})
+}
+
+// parseRunInput splits the run prompt into log group names and an optional
+// since=<duration> token. Durations accept Go syntax plus a "d" day suffix.
+// With no groups given, the query's saved groups are used.
+func parseRunInput(input string, saved []string) ([]string, time.Duration, error) {
+	since := defaultSince
+	var groups []string
+	for field := range strings.FieldsFuncSeq(input, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' }) {
+		value, ok := strings.CutPrefix(field, "since=")
+		if !ok {
+			groups = append(groups, field)
+			continue
+		}
+		d, err := parseSince(value)
+		if err != nil {
+			return nil, 0, err
+		}
+		since = d
+	}
+	if len(groups) == 0 {
+		groups = saved
+	}
+	return groups, since, nil
+}
+
+func parseSince(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid since %q: want e.g. 7d", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid since %q: want e.g. 30m, 6h or 7d", value)
+	}
+	return d, nil
+}
+
+// queryRunAPI is the subset of the CloudWatch Logs client the run flow uses.
+type queryRunAPI interface {
+	StartQuery(ctx context.Context, params *cloudwatchlogs.StartQueryInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartQueryOutput, error)
+	GetQueryResults(ctx context.Context, params *cloudwatchlogs.GetQueryResultsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetQueryResultsOutput, error)
+}
+
+var (
+	// queryPollInterval is how often a running query is checked.
+	queryPollInterval = time.Second
+	// queryWait matches the service's own 60 minute query timeout.
+	queryWait = 60 * time.Minute
+)
+
+// runQuery starts an Insights query and polls until it finishes. The rows
+// stay retrievable for a week and are shown by cloudwatch/insights-results.
+func runQuery(ctx context.Context, client queryRunAPI, req *cloudwatchlogs.StartQueryInput, report func(string)) (string, error) {
+	report("Starting query")
+	started, err := client.StartQuery(ctx, req)
+	if err != nil {
+		return "", apperrors.Wrap(err, "start query")
+	}
+	queryID := appaws.Str(started.QueryId)
+
+	ctx, cancel := context.WithTimeout(ctx, queryWait)
+	defer cancel()
+
+	lastMatched := -1.0
+	for {
+		output, err := client.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{
+			QueryId: &queryID,
+		})
+		if err != nil {
+			return "", apperrors.Wrapf(err, "get results of query %s", queryID)
+		}
+
+		var matched, scanned float64
+		if s := output.Statistics; s != nil {
+			matched, scanned = s.RecordsMatched, s.RecordsScanned
+		}
+
+		switch output.Status {
+		case types.QueryStatusComplete:
+			return fmt.Sprintf("Query complete: %d rows, %.0f of %.0f records matched (r for results)",
+				len(output.Results), matched, scanned), nil
+		case types.QueryStatusFailed, types.QueryStatusCancelled, types.QueryStatusTimeout:
+			return "", fmt.Errorf("query %s ended with status %s", queryID, output.Status)
+		}
+
+		if matched != lastMatched {
+			report(fmt.Sprintf("%s: %.0f records matched, %.0f scanned", output.Status, matched, scanned))
+			lastMatched = matched
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", apperrors.Wrapf(ctx.Err(), "wait for query %s", queryID)
+		case <-time.After(queryPollInterval):
+		}
+	}
+}
+
+func executeDeleteQueryDefinition(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := cwClient.GetLogsClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := resource.GetID()
+	_, err = client.DeleteQueryDefinition(ctx, &cloudwatchlogs.DeleteQueryDefinitionInput{
+		QueryDefinitionId: &id,
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete query definition %s", id)
+	}
+	return action.SuccessResult(fmt.Sprintf("Deleted saved query %s", resource.GetName()))
+}
@@ -0,0 +1,88 @@
+package insightsqueries
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+func TestParseRunInput(t *testing.T) {
+	saved := []string{"/aws/lambda/saved"}
+
+	groups, since, err := parseRunInput("", saved)
+	if err != nil || !slices.Equal(groups, saved) || since != time.Hour {
+		t.Errorf("blank input = %v, %v, %v", groups, since, err)
+	}
+
+	groups, since, err = parseRunInput("/aws/lambda/a, /aws/lambda/b since=7d", saved)
+	if err != nil || !slices.Equal(groups, []string{"/aws/lambda/a", "/aws/lambda/b"}) || since != 7*24*time.Hour {
+		t.Errorf("groups and days = %v, %v, %v", groups, since, err)
+	}
+
+	groups, since, err = parseRunInput("since=30m", saved)
+	if err != nil || !slices.Equal(groups, saved) || since != 30*time.Minute {
+		t.Errorf("since only = %v, %v, %v", groups, since, err)
+	}
+
+	for _, bad := range []string{"since=", "since=abc", "since=-1h", "since=0d"} {
+		if _, _, err := parseRunInput(bad, saved); err == nil {
+			t.Errorf("parseRunInput(%q) expected error", bad)
+		}
+	}
+}
+
+// fakeQueryRunner returns one query status per GetQueryResults call.
+type fakeQueryRunner struct {
+	statuses []types.QueryStatus
+	calls    int
+}
+
+func (f *fakeQueryRunner) StartQuery(_ context.Context, _ *cloudwatchlogs.StartQueryInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartQueryOutput, error) {
+	return &cloudwatchlogs.StartQueryOutput{QueryId: aws.String("q-1")}, nil
+}
+
+func (f *fakeQueryRunner) GetQueryResults(_ context.Context, _ *cloudwatchlogs.GetQueryResultsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	status := f.statuses[min(f.calls, len(f.statuses)-1)]
+	f.calls++
+	return &cloudwatchlogs.GetQueryResultsOutput{
+		Status:     status,
+		Results:    make([][]types.ResultField, f.calls),
+		Statistics: &types.QueryStatistics{RecordsMatched: float64(f.calls), RecordsScanned: 100},
+	}, nil
+}
+
+func TestRunQuery(t *testing.T) {
+	orig := queryPollInterval
+	queryPollInterval = time.Millisecond
+	defer func() { queryPollInterval = orig }()
+
+	req := &cloudwatchlogs.StartQueryInput{QueryString: aws.String("fields @message")}
+
+	client := &fakeQueryRunner{statuses: []types.QueryStatus{
+		types.QueryStatusScheduled,
+		types.QueryStatusRunning,
+		types.QueryStatusComplete,
+	}}
+	var steps []string
+	msg, err := runQuery(context.Background(), client, req, func(s string) { steps = append(steps, s) })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(msg, "3 rows") || !strings.Contains(msg, "3 of 100") {
+		t.Errorf("message = %q", msg)
+	}
+	if len(steps) != 3 {
+		t.Errorf("steps = %v, want start + two progress updates", steps)
+	}
+
+	failing := &fakeQueryRunner{statuses: []types.QueryStatus{types.QueryStatusTimeout}}
+	if _, err := runQuery(context.Background(), failing, req, func(string) {}); err == nil || !strings.Contains(err.Error(), "Timeout") {
+		t.Errorf("err = %v, want timeout failure", err)
+	}
+}
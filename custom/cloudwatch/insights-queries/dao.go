@@ -0,0 +1,128 @@
+package insightsqueries
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// QueryDefinitionDAO provides data access for CloudWatch Logs Insights saved queries
+type QueryDefinitionDAO struct {
+	dao.BaseDAO
+	client *cloudwatchlogs.Client
+}
+
+// NewQueryDefinitionDAO creates a new QueryDefinitionDAO
+func NewQueryDefinitionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := cwClient.GetLogsClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &QueryDefinitionDAO{
+		BaseDAO: dao.NewBaseDAO("cloudwatch", "insights-queries"),
+		client:  client,
+	}, nil
+}
+
+// List returns all saved queries
+func (d *QueryDefinitionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	defs, err := cwClient.ListQueryDefinitions(ctx, d.client)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(defs))
+	for i, def := range defs {
+		resources[i] = NewQueryDefinitionResource(def)
+	}
+	return resources, nil
+}
+
+// Get returns a saved query by ID
+func (d *QueryDefinitionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	def, err := cwClient.GetQueryDefinition(ctx, d.client, id)
+	if err != nil {
+		return nil, err
+	}
+	return NewQueryDefinitionResource(*def), nil
+}
+
+// Delete deletes a saved query by ID
+func (d *QueryDefinitionDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteQueryDefinition(ctx, &cloudwatchlogs.DeleteQueryDefinitionInput{
+		QueryDefinitionId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete query definition %s", id)
+	}
+	return nil
+}
+
+// QueryDefinitionResource wraps a Logs Insights saved query
+type QueryDefinitionResource struct {
+	dao.BaseResource
+	Item types.QueryDefinition
+}
+
+// NewQueryDefinitionResource creates a new QueryDefinitionResource
+func NewQueryDefinitionResource(def types.QueryDefinition) *QueryDefinitionResource {
+	return &QueryDefinitionResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(def.QueryDefinitionId),
+			Name: appaws.Str(def.Name),
+			Data: def,
+		},
+		Item: def,
+	}
+}
+
+// Folder returns the console folder of the query, taken from the part of
+// the name before the last "/".
+func (r *QueryDefinitionResource) Folder() string {
+	if i := strings.LastIndex(r.GetName(), "/"); i > 0 {
+		return r.GetName()[:i]
+	}
+	return ""
+}
+
+// QueryString returns the saved query text.
+func (r *QueryDefinitionResource) QueryString() string {
+	return appaws.Str(r.Item.QueryString)
+}
+
+// QueryLanguage returns CWLI, SQL or PPL.
+func (r *QueryDefinitionResource) QueryLanguage() string {
+	return string(r.Item.QueryLanguage)
+}
+
+// LogGroupNames returns the log groups saved with the query.
+func (r *QueryDefinitionResource) LogGroupNames() []string {
+	return r.Item.LogGroupNames
+}
+
+// FirstLine returns the first non-empty line of the query for list display.
+func (r *QueryDefinitionResource) FirstLine() string {
+	for line := range strings.SplitSeq(r.QueryString(), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// ModifiedAt returns when the query was last saved.
+func (r *QueryDefinitionResource) ModifiedAt() *time.Time {
+	if r.Item.LastModified == nil {
+		return nil
+	}
+	t := time.UnixMilli(*r.Item.LastModified)
+	return &t
+}
@@ -0,0 +1,20 @@
+package insightsqueries
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudwatch", "insights-queries", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewQueryDefinitionDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewQueryDefinitionRenderer()
+		},
+	})
+}
@@ -0,0 +1,149 @@
+package insightsqueries
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure QueryDefinitionRenderer implements render.Navigator
+var _ render.Navigator = (*QueryDefinitionRenderer)(nil)
+
+// QueryDefinitionRenderer renders Logs Insights saved queries
+type QueryDefinitionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewQueryDefinitionRenderer creates a new QueryDefinitionRenderer
+func NewQueryDefinitionRenderer() render.Renderer {
+	return &QueryDefinitionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudwatch",
+			Resource: "insights-queries",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "LANG", Width: 5, Getter: getLanguage},
+				{Name: "LOG GROUPS", Width: 11, Getter: getLogGroups},
+				{Name: "QUERY", Width: 50, Getter: getQuery},
+				{Name: "MODIFIED", Width: 18, Getter: getModified},
+			},
+		},
+	}
+}
+
+func getLanguage(r dao.Resource) string {
+	q, ok := r.(*QueryDefinitionResource)
+	if !ok {
+		return ""
+	}
+	return q.QueryLanguage()
+}
+
+func getLogGroups(r dao.Resource) string {
+	q, ok := r.(*QueryDefinitionResource)
+	if !ok {
+		return ""
+	}
+	switch n := len(q.LogGroupNames()); n {
+	case 0:
+		return "-"
+	case 1:
+		return "1 group"
+	default:
+		return fmt.Sprintf("%d groups", n)
+	}
+}
+
+func getQuery(r dao.Resource) string {
+	q, ok := r.(*QueryDefinitionResource)
+	if !ok {
+		return ""
+	}
+	return q.FirstLine()
+}
+
+func getModified(r dao.Resource) string {
+	q, ok := r.(*QueryDefinitionResource)
+	if !ok {
+		return ""
+	}
+	if t := q.ModifiedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// RenderDetail renders the detail view for a saved query
+func (r *QueryDefinitionRenderer) RenderDetail(resource dao.Resource) string {
+	q, ok := resource.(*QueryDefinitionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Logs Insights Query", q.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", q.GetName())
+	d.Field("Query Definition ID", q.GetID())
+	if folder := q.Folder(); folder != "" {
+		d.Field("Folder", folder)
+	}
+	if lang := q.QueryLanguage(); lang != "" {
+		d.Field("Language", lang)
+	}
+	if t := q.ModifiedAt(); t != nil {
+		d.Field("Last Modified", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Log Groups")
+	if groups := q.LogGroupNames(); len(groups) > 0 {
+		for _, g := range groups {
+			d.Line("  " + g)
+		}
+	} else {
+		d.Dim("  None saved; enter log groups when running the query")
+	}
+
+	d.Section("Query")
+	for line := range strings.SplitSeq(q.QueryString(), "\n") {
+		d.Line("  " + line)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a saved query
+func (r *QueryDefinitionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	q, ok := resource.(*QueryDefinitionResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: q.GetName()},
+		{Label: "Log Groups", Value: strings.Join(q.LogGroupNames(), ", ")},
+		{Label: "Query", Value: q.FirstLine()},
+	}
+}
+
+// Navigations returns available navigations from a saved query
+func (r *QueryDefinitionRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	q, ok := resource.(*QueryDefinitionResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "r",
+			Label:       "Results",
+			Service:     "cloudwatch",
+			Resource:    "insights-results",
+			FilterField: "QueryDefinitionId",
+			FilterValue: q.GetID(),
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package insightsresults
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ResultDAO provides data access for the rows of a Logs Insights query run
+type ResultDAO struct {
+	dao.BaseDAO
+	client *cloudwatchlogs.Client
+}
+
+// NewResultDAO creates a new ResultDAO
+func NewResultDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := cwClient.GetLogsClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ResultDAO{
+		BaseDAO: dao.NewBaseDAO("cloudwatch", "insights-results"),
+		client:  client,
+	}, nil
+}
+
+// List returns the rows of the latest run of the saved query given by the
+// QueryDefinitionId filter. Runs are matched on query text, so runs started
+// from the console count too.
+func (d *ResultDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	defID := dao.GetFilterFromContext(ctx, "QueryDefinitionId")
+	if defID == "" {
+		return nil, fmt.Errorf("query definition ID filter required")
+	}
+
+	def, err := cwClient.GetQueryDefinition(ctx, d.client, defID)
+	if err != nil {
+		return nil, err
+	}
+	run, err := d.latestRun(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, fmt.Errorf("no recent runs of %q; run it from the saved query first", appaws.Str(def.Name))
+	}
+
+	queryID := appaws.Str(run.QueryId)
+	output, err := d.client.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: &queryID,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get results of query %s", queryID)
+	}
+
+	resources := make([]dao.Resource, len(output.Results))
+	for i, row := range output.Results {
+		resources[i] = NewResultResource(i, row, queryID, output.Status, output.Statistics)
+	}
+	return resources, nil
+}
+
+// latestRun returns the most recently started query with the saved query's text.
+func (d *ResultDAO) latestRun(ctx context.Context, def *types.QueryDefinition) (*types.QueryInfo, error) {
+	queries, err := appaws.Paginate(ctx, func(token *string) ([]types.QueryInfo, *string, error) {
+		output, err := d.client.DescribeQueries(ctx, &cloudwatchlogs.DescribeQueriesInput{
+			QueryLanguage: def.QueryLanguage,
+			NextToken:     token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe queries")
+		}
+		return output.Queries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return latestMatching(queries, appaws.Str(def.QueryString)), nil
+}
+
+// latestMatching picks the newest query whose text matches queryString.
+func latestMatching(queries []types.QueryInfo, queryString string) *types.QueryInfo {
+	var latest *types.QueryInfo
+	for i, q := range queries {
+		if strings.TrimSpace(appaws.Str(q.QueryString)) != strings.TrimSpace(queryString) {
+			continue
+		}
+		if latest == nil || appaws.Int64(q.CreateTime) > appaws.Int64(latest.CreateTime) {
+			latest = &queries[i]
+		}
+	}
+	return latest
+}
+
+// Get is not supported; rows only exist within a query's result set.
+func (d *ResultDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get not supported for insights results")
+}
+
+// Delete is not supported; results expire on their own after 7 days.
+func (d *ResultDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for insights results")
+}
+
+// Supports returns supported operations
+func (d *ResultDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// ResultResource is one row of a Logs Insights query result
+type ResultResource struct {
+	dao.BaseResource
+	Fields  []types.ResultField
+	QueryId string
+	Status  types.QueryStatus
+	Stats   *types.QueryStatistics
+}
+
+// NewResultResource creates a new ResultResource for row index i
+func NewResultResource(i int, row []types.ResultField, queryID string, status types.QueryStatus, stats *types.QueryStatistics) *ResultResource {
+	r := &ResultResource{
+		Fields:  row,
+		QueryId: queryID,
+		Status:  status,
+		Stats:   stats,
+	}
+	r.ID = fmt.Sprintf("%d", i+1)
+	if ptr := r.Field("@ptr"); ptr != "" {
+		r.ID = ptr
+	}
+	r.Name = r.Field("@timestamp")
+	r.Data = row
+	return r
+}
+
+// Field returns the value of a result field, or "" when absent.
+func (r *ResultResource) Field(name string) string {
+	for _, f := range r.Fields {
+		if appaws.Str(f.Field) == name {
+			return appaws.Str(f.Value)
+		}
+	}
+	return ""
+}
+
+// Message returns @message when the query returns it, otherwise the
+// row's own fields as key=value pairs (e.g. for stats queries).
+func (r *ResultResource) Message() string {
+	if msg := r.Field("@message"); msg != "" {
+		return strings.TrimSpace(msg)
+	}
+	var parts []string
+	for _, f := range r.Fields {
+		name := appaws.Str(f.Field)
+		if strings.HasPrefix(name, "@") {
+			continue
+		}
+		parts = append(parts, name+"="+appaws.Str(f.Value))
+	}
+	return strings.Join(parts, " ")
+}
+
+// IsRunning reports whether the query is still producing rows.
+func (r *ResultResource) IsRunning() bool {
+	return r.Status == types.QueryStatusRunning || r.Status == types.QueryStatusScheduled
+}
@@ -0,0 +1,49 @@
+package insightsresults
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+func TestLatestMatching(t *testing.T) {
+	queries := []types.QueryInfo{
+		{QueryId: aws.String("old"), QueryString: aws.String("fields @message"), CreateTime: aws.Int64(100)},
+		{QueryId: aws.String("other"), QueryString: aws.String("stats count()"), CreateTime: aws.Int64(300)},
+		{QueryId: aws.String("new"), QueryString: aws.String("fields @message\n"), CreateTime: aws.Int64(200)},
+	}
+
+	if got := latestMatching(queries, "fields @message"); got == nil || aws.ToString(got.QueryId) != "new" {
+		t.Errorf("latestMatching() = %v, want new", got)
+	}
+	if got := latestMatching(queries, "filter @message like /x/"); got != nil {
+		t.Errorf("latestMatching(no match) = %v", aws.ToString(got.QueryId))
+	}
+}
+
+func TestResultRow(t *testing.T) {
+	field := func(k, v string) types.ResultField {
+		return types.ResultField{Field: aws.String(k), Value: aws.String(v)}
+	}
+
+	logRow := NewResultResource(0, []types.ResultField{
+		field("@timestamp", "2026-01-01 00:00:00.000"),
+		field("@message", "ERROR boom\n"),
+		field("@ptr", "CmAKJgoi"),
+	}, "q-1", types.QueryStatusComplete, nil)
+	if logRow.GetID() != "CmAKJgoi" || logRow.GetName() != "2026-01-01 00:00:00.000" || logRow.Message() != "ERROR boom" {
+		t.Errorf("log row id=%q name=%q message=%q", logRow.GetID(), logRow.GetName(), logRow.Message())
+	}
+
+	statsRow := NewResultResource(1, []types.ResultField{
+		field("bin(5m)", "2026-01-01 00:05:00.000"),
+		field("count()", "42"),
+	}, "q-1", types.QueryStatusRunning, nil)
+	if statsRow.GetID() != "2" || statsRow.Message() != "bin(5m)=2026-01-01 00:05:00.000 count()=42" {
+		t.Errorf("stats row id=%q message=%q", statsRow.GetID(), statsRow.Message())
+	}
+	if !statsRow.IsRunning() {
+		t.Error("IsRunning() = false for Running")
+	}
+}
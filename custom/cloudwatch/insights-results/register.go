@@ -0,0 +1,20 @@
+package insightsresults
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudwatch", "insights-results", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewResultDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewResultRenderer()
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package insightsresults
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ResultRenderer renders Logs Insights query result rows
+type ResultRenderer struct {
+	render.BaseRenderer
+}
+
+// NewResultRenderer creates a new ResultRenderer
+func NewResultRenderer() render.Renderer {
+	return &ResultRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudwatch",
+			Resource: "insights-results",
+			Cols: []render.Column{
+				{Name: "TIMESTAMP", Width: 24, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "LOG STREAM", Width: 30, Getter: getLogStream},
+				{Name: "MESSAGE", Width: 100, Getter: getMessage},
+			},
+		},
+	}
+}
+
+func getLogStream(r dao.Resource) string {
+	row, ok := r.(*ResultResource)
+	if !ok {
+		return ""
+	}
+	return row.Field("@logStream")
+}
+
+func getMessage(r dao.Resource) string {
+	row, ok := r.(*ResultResource)
+	if !ok {
+		return ""
+	}
+	return row.Message()
+}
+
+// RenderDetail renders every field of a result row
+func (r *ResultRenderer) RenderDetail(resource dao.Resource) string {
+	row, ok := resource.(*ResultResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Logs Insights Result", row.GetName())
+
+	d.Section("Fields")
+	for _, f := range row.Fields {
+		d.Field(appaws.Str(f.Field), appaws.Str(f.Value))
+	}
+
+	d.Section("Query")
+	d.Field("Query ID", row.QueryId)
+	d.Field("Status", string(row.Status))
+	if s := row.Stats; s != nil {
+		d.Field("Records Matched", fmt.Sprintf("%.0f", s.RecordsMatched))
+		d.Field("Records Scanned", fmt.Sprintf("%.0f", s.RecordsScanned))
+		d.Field("Bytes Scanned", render.FormatSize(int64(s.BytesScanned)))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a result row
+func (r *ResultRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	row, ok := resource.(*ResultResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Query", Value: row.QueryId},
+		{Label: "Status", Value: string(row.Status)},
+	}
+	if s := row.Stats; s != nil {
+		fields = append(fields, render.SummaryField{
+			Label: "Matched",
+			Value: fmt.Sprintf("%.0f of %.0f records", s.RecordsMatched, s.RecordsScanned),
+		})
+	}
+	return fields
+}
+
+// NeedsAutoReload keeps partial results refreshing until the query finishes
+func (r *ResultRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if row, ok := dao.UnwrapResource(res).(*ResultResource); ok && row.IsRunning() {
+			return true
+		}
+	}
+	return false
+}
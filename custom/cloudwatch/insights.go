@@ -0,0 +1,40 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ListQueryDefinitions returns every Logs Insights saved query in the region.
+func ListQueryDefinitions(ctx context.Context, client *cloudwatchlogs.Client) ([]types.QueryDefinition, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.QueryDefinition, *string, error) {
+		output, err := client.DescribeQueryDefinitions(ctx, &cloudwatchlogs.DescribeQueryDefinitionsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe query definitions")
+		}
+		return output.QueryDefinitions, output.NextToken, nil
+	})
+}
+
+// GetQueryDefinition finds a saved query by ID. The API has no lookup by
+// ID, so this scans the full list.
+func GetQueryDefinition(ctx context.Context, client *cloudwatchlogs.Client, id string) (*types.QueryDefinition, error) {
+	defs, err := ListQueryDefinitions(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for i := range defs {
+		if appaws.Str(defs[i].QueryDefinitionId) == id {
+			return &defs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("query definition not found: %s", id)
+}
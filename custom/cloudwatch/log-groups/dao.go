@@ -21,13 +21,13 @@ type LogGroupDAO struct {
 
 // NewLogGroupDAO creates a new LogGroupDAO
 func NewLogGroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudwatchlogs.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &LogGroupDAO{
 		BaseDAO: dao.NewBaseDAO("cloudwatch", "log-groups"),
-		client:  cloudwatchlogs.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type LogStreamDAO struct {
 
 // NewLogStreamDAO creates a new LogStreamDAO
 func NewLogStreamDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudwatchlogs.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &LogStreamDAO{
 		BaseDAO: dao.NewBaseDAO("cloudwatch", "log-streams"),
-		client:  cloudwatchlogs.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,152 @@
+package metricstreams
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// MetricStreamDAO provides data access for CloudWatch metric streams
+type MetricStreamDAO struct {
+	dao.BaseDAO
+	client *cloudwatch.Client
+}
+
+// NewMetricStreamDAO creates a new MetricStreamDAO
+func NewMetricStreamDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &MetricStreamDAO{
+		BaseDAO: dao.NewBaseDAO("cloudwatch", "metric-streams"),
+		client:  client,
+	}, nil
+}
+
+func (d *MetricStreamDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	entries, err := appaws.Paginate(ctx, func(token *string) ([]types.MetricStreamEntry, *string, error) {
+		output, err := d.client.ListMetricStreams(ctx, &cloudwatch.ListMetricStreamsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list metric streams")
+		}
+		return output.Entries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(entries))
+	for i, e := range entries {
+		resources[i] = NewMetricStreamResource(e)
+	}
+	return resources, nil
+}
+
+// Get returns a metric stream with its filters and statistics configuration
+func (d *MetricStreamDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.client.GetMetricStream(ctx, &cloudwatch.GetMetricStreamInput{Name: &name})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get metric stream %s", name)
+	}
+	return NewMetricStreamResourceFromDetail(output), nil
+}
+
+func (d *MetricStreamDAO) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteMetricStream(ctx, &cloudwatch.DeleteMetricStreamInput{Name: &name})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already deleted
+		}
+		return apperrors.Wrapf(err, "delete metric stream %s", name)
+	}
+	return nil
+}
+
+// MetricStreamResource represents a CloudWatch metric stream
+type MetricStreamResource struct {
+	dao.BaseResource
+	State          string
+	OutputFormat   string
+	FirehoseArn    string
+	CreationDate   *time.Time
+	LastUpdateDate *time.Time
+
+	// Populated by Get only
+	RoleArn               string
+	IncludeLinkedAccounts bool
+	IncludeFilters        []types.MetricStreamFilter
+	ExcludeFilters        []types.MetricStreamFilter
+	StatisticsConfigs     []types.MetricStreamStatisticsConfiguration
+}
+
+// NewMetricStreamResource creates a new MetricStreamResource from list output
+func NewMetricStreamResource(e types.MetricStreamEntry) *MetricStreamResource {
+	name := appaws.Str(e.Name)
+	return &MetricStreamResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(e.Arn),
+			Data: e,
+		},
+		State:          appaws.Str(e.State),
+		OutputFormat:   string(e.OutputFormat),
+		FirehoseArn:    appaws.Str(e.FirehoseArn),
+		CreationDate:   e.CreationDate,
+		LastUpdateDate: e.LastUpdateDate,
+	}
+}
+
+// NewMetricStreamResourceFromDetail creates a MetricStreamResource from GetMetricStream output
+func NewMetricStreamResourceFromDetail(o *cloudwatch.GetMetricStreamOutput) *MetricStreamResource {
+	name := appaws.Str(o.Name)
+	return &MetricStreamResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(o.Arn),
+			Data: o,
+		},
+		State:                 appaws.Str(o.State),
+		OutputFormat:          string(o.OutputFormat),
+		FirehoseArn:           appaws.Str(o.FirehoseArn),
+		CreationDate:          o.CreationDate,
+		LastUpdateDate:        o.LastUpdateDate,
+		RoleArn:               appaws.Str(o.RoleArn),
+		IncludeLinkedAccounts: appaws.Bool(o.IncludeLinkedAccountsMetrics),
+		IncludeFilters:        o.IncludeFilters,
+		ExcludeFilters:        o.ExcludeFilters,
+		StatisticsConfigs:     o.StatisticsConfigurations,
+	}
+}
+
+// FirehoseName returns the delivery stream name from the Firehose ARN
+func (r *MetricStreamResource) FirehoseName() string {
+	if i := strings.LastIndex(r.FirehoseArn, "/"); i >= 0 {
+		return r.FirehoseArn[i+1:]
+	}
+	return r.FirehoseArn
+}
+
+// FormatFilter renders a stream filter as "Namespace" or "Namespace: m1, m2"
+func FormatFilter(f types.MetricStreamFilter) string {
+	ns := appaws.Str(f.Namespace)
+	if ns == "" {
+		ns = "*"
+	}
+	if len(f.MetricNames) == 0 {
+		return ns
+	}
+	return fmt.Sprintf("%s: %s", ns, strings.Join(f.MetricNames, ", "))
+}
@@ -0,0 +1,20 @@
+package metricstreams
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudwatch", "metric-streams", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewMetricStreamDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewMetricStreamRenderer()
+		},
+	})
+}
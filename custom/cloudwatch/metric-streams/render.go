@@ -0,0 +1,135 @@
+package metricstreams
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// MetricStreamRenderer renders CloudWatch metric streams
+type MetricStreamRenderer struct {
+	render.BaseRenderer
+}
+
+// NewMetricStreamRenderer creates a new MetricStreamRenderer
+func NewMetricStreamRenderer() render.Renderer {
+	return &MetricStreamRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudwatch",
+			Resource: "metric-streams",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 36, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATE", Width: 10, Getter: getState},
+				{Name: "FORMAT", Width: 16, Getter: getFormat},
+				{Name: "FIREHOSE", Width: 36, Getter: getFirehose},
+				{Name: "UPDATED", Width: 10, Getter: getUpdated},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	if s, ok := r.(*MetricStreamResource); ok {
+		return s.State
+	}
+	return ""
+}
+
+func getFormat(r dao.Resource) string {
+	if s, ok := r.(*MetricStreamResource); ok {
+		return s.OutputFormat
+	}
+	return ""
+}
+
+func getFirehose(r dao.Resource) string {
+	if s, ok := r.(*MetricStreamResource); ok {
+		return s.FirehoseName()
+	}
+	return ""
+}
+
+func getUpdated(r dao.Resource) string {
+	if s, ok := r.(*MetricStreamResource); ok && s.LastUpdateDate != nil {
+		return render.FormatAge(*s.LastUpdateDate)
+	}
+	return "-"
+}
+
+// RenderDetail renders detailed metric stream information
+func (r *MetricStreamRenderer) RenderDetail(resource dao.Resource) string {
+	s, ok := resource.(*MetricStreamResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Metric Stream", s.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", s.GetName())
+	d.Field("ARN", s.GetARN())
+	d.Field("State", s.State)
+	d.Field("Output Format", s.OutputFormat)
+	d.Field("Firehose", s.FirehoseArn)
+	if s.RoleArn != "" {
+		d.Field("Role", s.RoleArn)
+	}
+	d.Field("Linked Accounts", fmt.Sprintf("%v", s.IncludeLinkedAccounts))
+
+	if len(s.IncludeFilters) > 0 {
+		d.Section("Include Filters")
+		for _, f := range s.IncludeFilters {
+			d.Field("", FormatFilter(f))
+		}
+	}
+	if len(s.ExcludeFilters) > 0 {
+		d.Section("Exclude Filters")
+		for _, f := range s.ExcludeFilters {
+			d.Field("", FormatFilter(f))
+		}
+	}
+	if len(s.IncludeFilters) == 0 && len(s.ExcludeFilters) == 0 {
+		d.Section("Filters")
+		d.Field("Scope", "All namespaces")
+	}
+
+	if len(s.StatisticsConfigs) > 0 {
+		d.Section("Additional Statistics")
+		for _, sc := range s.StatisticsConfigs {
+			metrics := make([]string, len(sc.IncludeMetrics))
+			for i, m := range sc.IncludeMetrics {
+				metrics[i] = fmt.Sprintf("%s/%s", appaws.Str(m.Namespace), appaws.Str(m.MetricName))
+			}
+			d.Field(strings.Join(sc.AdditionalStatistics, ", "), strings.Join(metrics, ", "))
+		}
+	}
+
+	d.Section("Timestamps")
+	if s.CreationDate != nil {
+		d.Field("Created", s.CreationDate.Format("2006-01-02 15:04:05"))
+	}
+	if s.LastUpdateDate != nil {
+		d.Field("Last Updated", s.LastUpdateDate.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *MetricStreamRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	s, ok := resource.(*MetricStreamResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Name", Value: s.GetName()},
+		{Label: "State", Value: s.State},
+		{Label: "Format", Value: s.OutputFormat},
+		{Label: "Firehose", Value: s.FirehoseName()},
+	}
+}
@@ -0,0 +1,44 @@
+package metricstreams
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+func TestNewMetricStreamResource(t *testing.T) {
+	r := NewMetricStreamResource(types.MetricStreamEntry{
+		Name:         aws.String("to-datadog"),
+		Arn:          aws.String("arn:aws:cloudwatch:us-east-1:123456789012:metric-stream/to-datadog"),
+		State:        aws.String("running"),
+		OutputFormat: types.MetricStreamOutputFormatOpenTelemetry10,
+		FirehoseArn:  aws.String("arn:aws:firehose:us-east-1:123456789012:deliverystream/datadog-metrics"),
+	})
+
+	if r.GetID() != "to-datadog" || r.State != "running" {
+		t.Errorf("GetID()/State = %q/%q", r.GetID(), r.State)
+	}
+	if got := r.FirehoseName(); got != "datadog-metrics" {
+		t.Errorf("FirehoseName() = %q, want datadog-metrics", got)
+	}
+}
+
+func TestFormatFilter(t *testing.T) {
+	tests := []struct {
+		filter types.MetricStreamFilter
+		want   string
+	}{
+		{types.MetricStreamFilter{Namespace: aws.String("AWS/EC2")}, "AWS/EC2"},
+		{types.MetricStreamFilter{
+			Namespace:   aws.String("AWS/Lambda"),
+			MetricNames: []string{"Errors", "Invocations"},
+		}, "AWS/Lambda: Errors, Invocations"},
+		{types.MetricStreamFilter{}, "*"},
+	}
+	for _, tt := range tests {
+		if got := FormatFilter(tt.filter); got != tt.want {
+			t.Errorf("FormatFilter() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package builds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+
+	cbClient "github.com/clawscli/claws/custom/codebuild"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for CodeBuild builds
+	action.Global.Register("codebuild", "builds", []action.Action{
+		{
+			Name:      "Retry Build",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RetryBuild",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				b, ok := r.(*BuildResource)
+				return ok && !b.IsRunning()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("codebuild", "builds", executeBuildAction)
+}
+
+// executeBuildAction executes an action on a CodeBuild build
+func executeBuildAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	build, ok := resource.(*BuildResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := cbClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	switch act.Operation {
+	case "RetryBuild":
+		id := build.BuildId()
+		output, err := client.RetryBuild(ctx, &codebuild.RetryBuildInput{Id: &id})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("retry build: %w", err)}
+		}
+		msg := fmt.Sprintf("Retried build %s", id)
+		if output.Build != nil {
+			msg = fmt.Sprintf("Started build #%d (retry of %s)", appaws.Int64(output.Build.BuildNumber), id)
+		}
+		return action.ActionResult{Success: true, Message: msg}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
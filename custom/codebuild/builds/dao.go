@@ -21,13 +21,13 @@ type BuildDAO struct {
 
 // NewBuildDAO creates a new BuildDAO
 func NewBuildDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, codebuild.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &BuildDAO{
 		BaseDAO: dao.NewBaseDAO("codebuild", "builds"),
-		client:  codebuild.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -266,3 +266,71 @@ func (r *BuildResource) LogsStreamName() string {
 	}
 	return ""
 }
+
+// LogGroupName returns the CloudWatch log group, used by the log view
+func (r *BuildResource) LogGroupName() string {
+	return r.LogsGroupName()
+}
+
+// LogStreamName returns the CloudWatch log stream, used by the log view
+func (r *BuildResource) LogStreamName() string {
+	return r.LogsStreamName()
+}
+
+// LastEventTimestamp returns the build end time in milliseconds so the log
+// view opens on a finished build's output. Running builds return 0 and are
+// tailed from the last hour.
+func (r *BuildResource) LastEventTimestamp() int64 {
+	if r.Build.EndTime != nil {
+		return r.Build.EndTime.UnixMilli()
+	}
+	return 0
+}
+
+// IsRunning reports whether the build is still in progress
+func (r *BuildResource) IsRunning() bool {
+	return r.Build.BuildStatus == types.StatusTypeInProgress
+}
+
+// PhaseTiming is the elapsed time of a single build phase
+type PhaseTiming struct {
+	Phase    string
+	Status   string
+	Duration time.Duration
+	// Share is the fraction of the total phase time spent in this phase
+	Share float64
+}
+
+// PhaseTimings returns per-phase durations. In-progress phases are measured
+// up to now.
+func (r *BuildResource) PhaseTimings() []PhaseTiming {
+	return phaseTimings(r.Build.Phases, time.Now())
+}
+
+func phaseTimings(phases []types.BuildPhase, now time.Time) []PhaseTiming {
+	timings := make([]PhaseTiming, 0, len(phases))
+	var total time.Duration
+	for _, p := range phases {
+		var d time.Duration
+		switch {
+		case p.StartTime != nil && p.EndTime != nil:
+			d = p.EndTime.Sub(*p.StartTime)
+		case p.StartTime != nil:
+			d = now.Sub(*p.StartTime)
+		case p.DurationInSeconds != nil:
+			d = time.Duration(*p.DurationInSeconds) * time.Second
+		}
+		status := string(p.PhaseStatus)
+		if status == "" {
+			status = "IN_PROGRESS"
+		}
+		timings = append(timings, PhaseTiming{Phase: string(p.PhaseType), Status: status, Duration: d})
+		total += d
+	}
+	if total > 0 {
+		for i := range timings {
+			timings[i].Share = float64(timings[i].Duration) / float64(total)
+		}
+	}
+	return timings
+}
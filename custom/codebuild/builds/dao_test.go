@@ -0,0 +1,54 @@
+package builds
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+)
+
+func TestPhaseTimings(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start.Add(4 * time.Minute)
+	phases := []types.BuildPhase{
+		{
+			PhaseType:   types.BuildPhaseTypeProvisioning,
+			PhaseStatus: types.StatusTypeSucceeded,
+			StartTime:   aws.Time(start),
+			EndTime:     aws.Time(start.Add(time.Minute)),
+		},
+		{
+			PhaseType: types.BuildPhaseTypeBuild,
+			StartTime: aws.Time(start.Add(time.Minute)),
+		},
+	}
+
+	got := phaseTimings(phases, now)
+	if len(got) != 2 {
+		t.Fatalf("phaseTimings() returned %d timings, want 2", len(got))
+	}
+	if got[0].Duration != time.Minute || got[0].Share != 0.25 {
+		t.Errorf("provisioning = %v / %v, want 1m / 0.25", got[0].Duration, got[0].Share)
+	}
+	if got[1].Status != "IN_PROGRESS" || got[1].Duration != 3*time.Minute || got[1].Share != 0.75 {
+		t.Errorf("build = %+v, want IN_PROGRESS 3m 0.75", got[1])
+	}
+}
+
+func TestPhaseTimingsEmpty(t *testing.T) {
+	if got := phaseTimings(nil, time.Now()); len(got) != 0 {
+		t.Errorf("phaseTimings(nil) = %v, want empty", got)
+	}
+}
+
+func TestBuildResourceLastEventTimestamp(t *testing.T) {
+	end := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	r := NewBuildResource(types.Build{EndTime: aws.Time(end)}, "proj")
+	if got := r.LastEventTimestamp(); got != end.UnixMilli() {
+		t.Errorf("LastEventTimestamp() = %d, want %d", got, end.UnixMilli())
+	}
+	if got := NewBuildResource(types.Build{}, "proj").LastEventTimestamp(); got != 0 {
+		t.Errorf("LastEventTimestamp() = %d, want 0", got)
+	}
+}
@@ -2,9 +2,11 @@ package builds
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // BuildRenderer renders CodeBuild builds
@@ -113,18 +115,17 @@ func (r *BuildRenderer) RenderDetail(resource dao.Resource) string {
 	}
 
 	// Phases
-	if phases := build.Phases(); len(phases) > 0 {
+	if timings := build.PhaseTimings(); len(timings) > 0 {
 		d.Section("Build Phases")
-		for _, phase := range phases {
-			status := string(phase.PhaseStatus)
-			if status == "" {
-				status = "IN_PROGRESS"
+		for _, t := range timings {
+			value := fmt.Sprintf("%-12s %8s %4.0f%% %s",
+				t.Status, render.FormatDuration(t.Duration), t.Share*100, phaseBar(t.Share))
+			switch t.Status {
+			case "FAILED", "FAULT", "TIMED_OUT", "CLIENT_ERROR":
+				d.FieldStyled(t.Phase, value, ui.DangerStyle())
+			default:
+				d.Field(t.Phase, value)
 			}
-			duration := ""
-			if phase.DurationInSeconds != nil {
-				duration = fmt.Sprintf(" (%ds)", *phase.DurationInSeconds)
-			}
-			d.Field(string(phase.PhaseType), status+duration)
 		}
 	}
 
@@ -179,5 +180,25 @@ func (r *BuildRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 
 // Navigations returns navigation shortcuts
 func (r *BuildRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	return nil
+	build, ok := resource.(*BuildResource)
+	if !ok || build.LogGroupName() == "" {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key:      "t",
+			Label:    "Tail Logs",
+			ViewType: render.ViewTypeLogView,
+		},
+	}
+}
+
+// phaseBarWidth is the width of the phase timing bar at 100%.
+const phaseBarWidth = 20
+
+// phaseBar draws a bar proportional to a phase's share of total build time
+func phaseBar(share float64) string {
+	n := int(share*phaseBarWidth + 0.5)
+	return strings.Repeat("█", n)
 }
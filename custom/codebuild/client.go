@@ -0,0 +1,14 @@
+package codebuild
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns a CodeBuild client configured for the current context
+func GetClient(ctx context.Context) (*codebuild.Client, error) {
+	return appaws.Client(ctx, codebuild.NewFromConfig)
+}
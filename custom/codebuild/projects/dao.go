@@ -21,13 +21,13 @@ type ProjectDAO struct {
 
 // NewProjectDAO creates a new ProjectDAO
 func NewProjectDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, codebuild.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ProjectDAO{
 		BaseDAO: dao.NewBaseDAO("codebuild", "projects"),
-		client:  codebuild.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
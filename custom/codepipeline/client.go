@@ -0,0 +1,141 @@
+package codepipeline
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// GetClient returns a CodePipeline client configured for the current context
+func GetClient(ctx context.Context) (*codepipeline.Client, error) {
+	return appaws.Client(ctx, codepipeline.NewFromConfig)
+}
+
+// maxApprovalSummary is the API limit for an approval comment.
+const maxApprovalSummary = 512
+
+// PendingApproval identifies a manual approval action awaiting a response
+type PendingApproval struct {
+	StageName  string
+	ActionName string
+	Token      string
+}
+
+// FindPendingApproval returns the first manual approval awaiting a response.
+// Only approval actions carry a token while in progress. If executionID is
+// non-empty, only stages currently running that execution are considered.
+func FindPendingApproval(stages []types.StageState, executionID string) (PendingApproval, bool) {
+	for _, stage := range stages {
+		if !stageRunning(stage, executionID) {
+			continue
+		}
+		for _, as := range stage.ActionStates {
+			exec := as.LatestExecution
+			if exec == nil || exec.Status != types.ActionExecutionStatusInProgress || exec.Token == nil {
+				continue
+			}
+			return PendingApproval{
+				StageName:  appaws.Str(stage.StageName),
+				ActionName: appaws.Str(as.ActionName),
+				Token:      appaws.Str(exec.Token),
+			}, true
+		}
+	}
+	return PendingApproval{}, false
+}
+
+// FindFailedStage returns the first failed stage and the execution it failed
+// in. If executionID is non-empty, only stages of that execution are considered.
+func FindFailedStage(stages []types.StageState, executionID string) (stageName, failedExecutionID string, ok bool) {
+	for _, stage := range stages {
+		exec := stage.LatestExecution
+		if exec == nil || exec.Status != types.StageExecutionStatusFailed {
+			continue
+		}
+		if executionID != "" && appaws.Str(exec.PipelineExecutionId) != executionID {
+			continue
+		}
+		return appaws.Str(stage.StageName), appaws.Str(exec.PipelineExecutionId), true
+	}
+	return "", "", false
+}
+
+// FindInProgressExecution returns the ID of an execution currently running in any stage
+func FindInProgressExecution(stages []types.StageState) (string, bool) {
+	for _, stage := range stages {
+		if exec := stage.LatestExecution; exec != nil && exec.Status == types.StageExecutionStatusInProgress {
+			return appaws.Str(exec.PipelineExecutionId), true
+		}
+	}
+	return "", false
+}
+
+func stageRunning(stage types.StageState, executionID string) bool {
+	exec := stage.LatestExecution
+	if exec == nil || exec.Status != types.StageExecutionStatusInProgress {
+		return false
+	}
+	return executionID == "" || appaws.Str(exec.PipelineExecutionId) == executionID
+}
+
+// PutApproval responds to the pending manual approval of a pipeline,
+// optionally restricted to executionID, and returns a status message.
+func PutApproval(ctx context.Context, client *codepipeline.Client, pipelineName, executionID string, status types.ApprovalStatus, summary string) (string, error) {
+	state, err := client.GetPipelineState(ctx, &codepipeline.GetPipelineStateInput{Name: &pipelineName})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "get pipeline state %s", pipelineName)
+	}
+
+	approval, ok := FindPendingApproval(state.StageStates, executionID)
+	if !ok {
+		return "", fmt.Errorf("no pending approval for pipeline %s", pipelineName)
+	}
+
+	if len(summary) > maxApprovalSummary {
+		summary = summary[:maxApprovalSummary]
+	}
+	_, err = client.PutApprovalResult(ctx, &codepipeline.PutApprovalResultInput{
+		PipelineName: &pipelineName,
+		StageName:    &approval.StageName,
+		ActionName:   &approval.ActionName,
+		Token:        &approval.Token,
+		Result: &types.ApprovalResult{
+			Status:  status,
+			Summary: &summary,
+		},
+	})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "put approval result for %s/%s", approval.StageName, approval.ActionName)
+	}
+	return fmt.Sprintf("%s %s/%s", status, approval.StageName, approval.ActionName), nil
+}
+
+// RetryFailedStage retries the failed actions of the pipeline's failed stage,
+// optionally restricted to executionID, and returns a status message.
+func RetryFailedStage(ctx context.Context, client *codepipeline.Client, pipelineName, executionID string) (string, error) {
+	state, err := client.GetPipelineState(ctx, &codepipeline.GetPipelineStateInput{Name: &pipelineName})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "get pipeline state %s", pipelineName)
+	}
+
+	stageName, failedID, ok := FindFailedStage(state.StageStates, executionID)
+	if !ok {
+		return "", fmt.Errorf("no failed stage for pipeline %s", pipelineName)
+	}
+
+	_, err = client.RetryStageExecution(ctx, &codepipeline.RetryStageExecutionInput{
+		PipelineName:        &pipelineName,
+		StageName:           &stageName,
+		PipelineExecutionId: &failedID,
+		RetryMode:           types.StageRetryModeFailedActions,
+	})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "retry stage %s", stageName)
+	}
+	return fmt.Sprintf("Retrying failed actions in stage %s", stageName), nil
+}
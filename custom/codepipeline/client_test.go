@@ -0,0 +1,72 @@
+package codepipeline
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+)
+
+func testStages() []types.StageState {
+	return []types.StageState{
+		{
+			StageName:       aws.String("Build"),
+			LatestExecution: &types.StageExecution{PipelineExecutionId: aws.String("exec-1"), Status: types.StageExecutionStatusFailed},
+		},
+		{
+			StageName:       aws.String("Approve"),
+			LatestExecution: &types.StageExecution{PipelineExecutionId: aws.String("exec-2"), Status: types.StageExecutionStatusInProgress},
+			ActionStates: []types.ActionState{
+				{
+					ActionName:      aws.String("Notify"),
+					LatestExecution: &types.ActionExecution{Status: types.ActionExecutionStatusSucceeded},
+				},
+				{
+					ActionName:      aws.String("ManualApproval"),
+					LatestExecution: &types.ActionExecution{Status: types.ActionExecutionStatusInProgress, Token: aws.String("tok")},
+				},
+			},
+		},
+	}
+}
+
+func TestFindPendingApproval(t *testing.T) {
+	stages := testStages()
+
+	got, ok := FindPendingApproval(stages, "")
+	if !ok {
+		t.Fatal("FindPendingApproval() ok = false, want true")
+	}
+	want := PendingApproval{StageName: "Approve", ActionName: "ManualApproval", Token: "tok"}
+	if got != want {
+		t.Errorf("FindPendingApproval() = %+v, want %+v", got, want)
+	}
+
+	if _, ok := FindPendingApproval(stages, "exec-2"); !ok {
+		t.Error("FindPendingApproval(exec-2) ok = false, want true")
+	}
+	if _, ok := FindPendingApproval(stages, "exec-1"); ok {
+		t.Error("FindPendingApproval(exec-1) ok = true, want false")
+	}
+}
+
+func TestFindFailedStage(t *testing.T) {
+	stages := testStages()
+
+	stage, execID, ok := FindFailedStage(stages, "")
+	if !ok || stage != "Build" || execID != "exec-1" {
+		t.Errorf("FindFailedStage() = %q, %q, %v; want Build, exec-1, true", stage, execID, ok)
+	}
+	if _, _, ok := FindFailedStage(stages, "exec-2"); ok {
+		t.Error("FindFailedStage(exec-2) ok = true, want false")
+	}
+}
+
+func TestFindInProgressExecution(t *testing.T) {
+	if got, ok := FindInProgressExecution(testStages()); !ok || got != "exec-2" {
+		t.Errorf("FindInProgressExecution() = %q, %v; want exec-2, true", got, ok)
+	}
+	if _, ok := FindInProgressExecution(nil); ok {
+		t.Error("FindInProgressExecution(nil) ok = true, want false")
+	}
+}
@@ -0,0 +1,114 @@
+package executions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+
+	cpClient "github.com/clawscli/claws/custom/codepipeline"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for CodePipeline executions
+	action.Global.Register("codepipeline", "executions", []action.Action{
+		{
+			Name:      "Approve",
+			Shortcut:  "A",
+			Type:      action.ActionTypeAPI,
+			Operation: "ApproveExecution",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Comment", Placeholder: "optional", Optional: true},
+			Filter:    isInProgress,
+		},
+		{
+			Name:      "Reject",
+			Shortcut:  "J",
+			Type:      action.ActionTypeAPI,
+			Operation: "RejectExecution",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Reason", Placeholder: "why this change is rejected"},
+			Filter:    isInProgress,
+		},
+		{
+			Name:      "Retry Failed Stage",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RetryStageExecution",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				e, ok := r.(*ExecutionResource)
+				return ok && e.Status() == string(types.PipelineExecutionStatusFailed)
+			},
+		},
+		{
+			Name:      "Stop",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopPipelineExecution",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Reason", Placeholder: "optional", Optional: true},
+			Filter:    isInProgress,
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("codepipeline", "executions", executeExecutionAction)
+}
+
+func isInProgress(r dao.Resource) bool {
+	e, ok := r.(*ExecutionResource)
+	return ok && e.Status() == string(types.PipelineExecutionStatusInProgress)
+}
+
+// executeExecutionAction executes an action on a CodePipeline execution
+func executeExecutionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	exec, ok := resource.(*ExecutionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := cpClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	pipelineName := exec.PipelineName
+	executionID := exec.ExecutionId()
+
+	var msg string
+	switch act.Operation {
+	case "ApproveExecution":
+		msg, err = cpClient.PutApproval(ctx, client, pipelineName, executionID, types.ApprovalStatusApproved, action.InputFromContext(ctx))
+
+	case "RejectExecution":
+		msg, err = cpClient.PutApproval(ctx, client, pipelineName, executionID, types.ApprovalStatusRejected, action.InputFromContext(ctx))
+
+	case "RetryStageExecution":
+		msg, err = cpClient.RetryFailedStage(ctx, client, pipelineName, executionID)
+
+	case "StopPipelineExecution":
+		input := &codepipeline.StopPipelineExecutionInput{
+			PipelineName:        &pipelineName,
+			PipelineExecutionId: &executionID,
+		}
+		if reason := action.InputFromContext(ctx); reason != "" {
+			input.Reason = &reason
+		}
+		if _, err = client.StopPipelineExecution(ctx, input); err != nil {
+			err = fmt.Errorf("stop execution: %w", err)
+		}
+		msg = fmt.Sprintf("Stopping execution %s (in-progress actions finish first)", executionID)
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+	return action.ActionResult{Success: true, Message: msg}
+}
@@ -3,6 +3,7 @@ package executions
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
@@ -11,6 +12,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // ExecutionDAO provides data access for CodePipeline executions
@@ -21,13 +23,13 @@ type ExecutionDAO struct {
 
 // NewExecutionDAO creates a new ExecutionDAO
 func NewExecutionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, codepipeline.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ExecutionDAO{
 		BaseDAO: dao.NewBaseDAO("codepipeline", "executions"),
-		client:  codepipeline.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -95,7 +97,27 @@ func (d *ExecutionDAO) Get(ctx context.Context, executionId string) (dao.Resourc
 		return nil, apperrors.Wrapf(err, "get execution %s", executionId)
 	}
 
-	return NewExecutionResourceFromDetail(output.PipelineExecution, pipelineName), nil
+	r := NewExecutionResourceFromDetail(output.PipelineExecution, pipelineName)
+
+	// Action details are best-effort; the execution is still shown without them.
+	actions, err := appaws.Paginate(ctx, func(token *string) ([]types.ActionExecutionDetail, *string, error) {
+		out, err := d.client.ListActionExecutions(ctx, &codepipeline.ListActionExecutionsInput{
+			PipelineName: &pipelineName,
+			Filter:       &types.ActionExecutionFilter{PipelineExecutionId: &executionId},
+			NextToken:    token,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.ActionExecutionDetails, out.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to list pipeline action executions", "pipeline", pipelineName, "execution", executionId, "error", err)
+	} else {
+		r.Actions = sortActions(actions)
+	}
+
+	return r, nil
 }
 
 // Delete stops a pipeline execution
@@ -133,6 +155,9 @@ type ExecutionResource struct {
 	Summary      *types.PipelineExecutionSummary
 	Detail       *types.PipelineExecution
 	PipelineName string
+
+	// Populated by Get only, in start order
+	Actions []types.ActionExecutionDetail
 }
 
 // NewExecutionResource creates a new ExecutionResource from summary
@@ -268,3 +293,45 @@ func (r *ExecutionResource) PipelineVersion() int32 {
 	}
 	return 0
 }
+
+// sortActions orders action executions by start time; the API returns newest first.
+func sortActions(actions []types.ActionExecutionDetail) []types.ActionExecutionDetail {
+	sort.SliceStable(actions, func(i, j int) bool {
+		a, b := actions[i].StartTime, actions[j].StartTime
+		if a == nil || b == nil {
+			return b != nil
+		}
+		return a.Before(*b)
+	})
+	return actions
+}
+
+// ActionProvider returns the provider of an action execution (e.g. CodeBuild, Manual)
+func ActionProvider(a types.ActionExecutionDetail) string {
+	if a.Input != nil && a.Input.ActionTypeId != nil {
+		return appaws.Str(a.Input.ActionTypeId.Provider)
+	}
+	return ""
+}
+
+// ExternalExecution returns the external execution ID and URL of an action,
+// such as a CodeBuild build ID and its console link.
+func ExternalExecution(a types.ActionExecutionDetail) (id, url string) {
+	if a.Output == nil || a.Output.ExecutionResult == nil {
+		return "", ""
+	}
+	res := a.Output.ExecutionResult
+	return appaws.Str(res.ExternalExecutionId), appaws.Str(res.ExternalExecutionUrl)
+}
+
+// CodeBuildProject returns the CodeBuild project of the first CodeBuild action, if any
+func (r *ExecutionResource) CodeBuildProject() string {
+	for _, a := range r.Actions {
+		if ActionProvider(a) == "CodeBuild" && a.Input != nil {
+			if project := a.Input.Configuration["ProjectName"]; project != "" {
+				return project
+			}
+		}
+	}
+	return ""
+}
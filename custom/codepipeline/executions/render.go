@@ -2,9 +2,14 @@ package executions
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // ExecutionRenderer renders CodePipeline executions
@@ -113,6 +118,36 @@ func (r *ExecutionRenderer) RenderDetail(resource dao.Resource) string {
 		}
 	}
 
+	// Action Executions
+	if len(exec.Actions) > 0 {
+		d.Section("Actions")
+		for _, a := range exec.Actions {
+			label := appaws.Str(a.StageName) + "/" + appaws.Str(a.ActionName)
+			value := string(a.Status)
+			if provider := ActionProvider(a); provider != "" {
+				value += " (" + provider + ")"
+			}
+			if a.StartTime != nil && a.LastUpdateTime != nil {
+				value += " " + a.LastUpdateTime.Sub(*a.StartTime).Round(time.Second).String()
+			}
+			if a.Status == types.ActionExecutionStatusFailed {
+				d.FieldStyled(label, value, ui.DangerStyle())
+			} else {
+				d.Field(label, value)
+			}
+			if id, url := ExternalExecution(a); url != "" {
+				d.Field("  "+id, url)
+			} else if id != "" {
+				d.Field("  External ID", id)
+			}
+			if a.Output != nil && a.Output.ExecutionResult != nil {
+				if summary := appaws.Str(a.Output.ExecutionResult.ExternalExecutionSummary); summary != "" {
+					d.Field("  Summary", summary)
+				}
+			}
+		}
+	}
+
 	// Timestamps
 	d.Section("Timestamps")
 	if started := exec.StartTime(); started != "" {
@@ -152,5 +187,18 @@ func (r *ExecutionRenderer) RenderSummary(resource dao.Resource) []render.Summar
 
 // Navigations returns navigation shortcuts
 func (r *ExecutionRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	exec, ok := resource.(*ExecutionResource)
+	if !ok {
+		return nil
+	}
+
+	if project := exec.CodeBuildProject(); project != "" {
+		return []render.Navigation{
+			{
+				Key: "b", Label: "Builds", Service: "codebuild", Resource: "builds",
+				FilterField: "ProjectName", FilterValue: project,
+			},
+		}
+	}
 	return nil
 }
@@ -0,0 +1,117 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+
+	cpClient "github.com/clawscli/claws/custom/codepipeline"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+func init() {
+	// Register actions for CodePipeline pipelines. Pipeline state is not
+	// loaded in the list view, so the executor resolves the target stage.
+	action.Global.Register("codepipeline", "pipelines", []action.Action{
+		{
+			Name:      "Approve",
+			Shortcut:  "A",
+			Type:      action.ActionTypeAPI,
+			Operation: "ApprovePending",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Comment", Placeholder: "optional", Optional: true},
+		},
+		{
+			Name:      "Reject",
+			Shortcut:  "J",
+			Type:      action.ActionTypeAPI,
+			Operation: "RejectPending",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Reason", Placeholder: "why this change is rejected"},
+		},
+		{
+			Name:      "Retry Failed Stage",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RetryStageExecution",
+			Confirm:   action.ConfirmSimple,
+		},
+		{
+			Name:      "Stop Execution",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopPipelineExecution",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Reason", Placeholder: "optional", Optional: true},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("codepipeline", "pipelines", executePipelineAction)
+}
+
+// executePipelineAction executes an action on a CodePipeline pipeline
+func executePipelineAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	pipeline, ok := resource.(*PipelineResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := cpClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	name := pipeline.PipelineName()
+
+	var msg string
+	switch act.Operation {
+	case "ApprovePending":
+		msg, err = cpClient.PutApproval(ctx, client, name, "", types.ApprovalStatusApproved, action.InputFromContext(ctx))
+
+	case "RejectPending":
+		msg, err = cpClient.PutApproval(ctx, client, name, "", types.ApprovalStatusRejected, action.InputFromContext(ctx))
+
+	case "RetryStageExecution":
+		msg, err = cpClient.RetryFailedStage(ctx, client, name, "")
+
+	case "StopPipelineExecution":
+		msg, err = stopRunningExecution(ctx, client, name, action.InputFromContext(ctx))
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+	return action.ActionResult{Success: true, Message: msg}
+}
+
+func stopRunningExecution(ctx context.Context, client *codepipeline.Client, name, reason string) (string, error) {
+	state, err := client.GetPipelineState(ctx, &codepipeline.GetPipelineStateInput{Name: &name})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "get pipeline state %s", name)
+	}
+
+	executionID, ok := cpClient.FindInProgressExecution(state.StageStates)
+	if !ok {
+		return "", fmt.Errorf("no running execution for pipeline %s", name)
+	}
+
+	input := &codepipeline.StopPipelineExecutionInput{
+		PipelineName:        &name,
+		PipelineExecutionId: &executionID,
+	}
+	if reason != "" {
+		input.Reason = &reason
+	}
+	if _, err := client.StopPipelineExecution(ctx, input); err != nil {
+		return "", apperrors.Wrapf(err, "stop execution %s", executionID)
+	}
+	return fmt.Sprintf("Stopping execution %s (in-progress actions finish first)", executionID), nil
+}
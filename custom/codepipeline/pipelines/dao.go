@@ -21,13 +21,13 @@ type PipelineDAO struct {
 
 // NewPipelineDAO creates a new PipelineDAO
 func NewPipelineDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, codepipeline.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &PipelineDAO{
 		BaseDAO: dao.NewBaseDAO("codepipeline", "pipelines"),
-		client:  codepipeline.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
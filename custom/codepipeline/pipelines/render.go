@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	cpClient "github.com/clawscli/claws/custom/codepipeline"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // PipelineRenderer renders CodePipeline pipelines
@@ -112,6 +114,9 @@ func (r *PipelineRenderer) RenderDetail(resource dao.Resource) string {
 				d.Field(*ss.StageName, status)
 			}
 		}
+		if approval, ok := cpClient.FindPendingApproval(stageStates, ""); ok {
+			d.FieldStyled("Pending Approval", approval.StageName+"/"+approval.ActionName, ui.WarningStyle())
+		}
 	}
 
 	// Artifact Store
@@ -0,0 +1,14 @@
+package cognitoidp
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns a Cognito Identity Provider client configured for the current context
+func GetClient(ctx context.Context) (*cognitoidentityprovider.Client, error) {
+	return appaws.Client(ctx, cognitoidentityprovider.NewFromConfig)
+}
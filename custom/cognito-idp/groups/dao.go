@@ -0,0 +1,191 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// GroupDAO provides data access for Cognito user pool groups
+type GroupDAO struct {
+	dao.BaseDAO
+	client *cognitoidentityprovider.Client
+}
+
+// NewGroupDAO creates a new GroupDAO
+func NewGroupDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, cognitoidentityprovider.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &GroupDAO{
+		BaseDAO: dao.NewBaseDAO("cognito-idp", "groups"),
+		client:  client,
+	}, nil
+}
+
+// List returns the groups of a user pool (requires UserPoolId filter)
+func (d *GroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	userPoolId := dao.GetFilterFromContext(ctx, "UserPoolId")
+	if userPoolId == "" {
+		return nil, fmt.Errorf("user pool ID filter required")
+	}
+
+	groups, err := appaws.Paginate(ctx, func(token *string) ([]types.GroupType, *string, error) {
+		output, err := d.client.ListGroups(ctx, &cognitoidentityprovider.ListGroupsInput{
+			UserPoolId: &userPoolId,
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list cognito groups")
+		}
+		return output.Groups, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(groups))
+	for i, group := range groups {
+		resources[i] = NewGroupResource(group)
+	}
+	return resources, nil
+}
+
+// Get returns a group with its members.
+// Member lookup is best-effort; failures are logged and leave Members unset.
+func (d *GroupDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	userPoolId := dao.GetFilterFromContext(ctx, "UserPoolId")
+	if userPoolId == "" {
+		return nil, fmt.Errorf("user pool ID filter required")
+	}
+
+	output, err := d.client.GetGroup(ctx, &cognitoidentityprovider.GetGroupInput{
+		UserPoolId: &userPoolId,
+		GroupName:  &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get cognito group %s", name)
+	}
+	if output.Group == nil {
+		return nil, fmt.Errorf("group not found: %s", name)
+	}
+
+	r := NewGroupResource(*output.Group)
+	members, err := appaws.Paginate(ctx, func(token *string) ([]types.UserType, *string, error) {
+		out, err := d.client.ListUsersInGroup(ctx, &cognitoidentityprovider.ListUsersInGroupInput{
+			UserPoolId: &userPoolId,
+			GroupName:  &name,
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Users, out.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to list cognito group members", "group", name, "error", err)
+	} else {
+		r.Members = members
+	}
+	return r, nil
+}
+
+// Delete deletes a Cognito group. Members are not deleted.
+func (d *GroupDAO) Delete(ctx context.Context, name string) error {
+	userPoolId := dao.GetFilterFromContext(ctx, "UserPoolId")
+	if userPoolId == "" {
+		return fmt.Errorf("user pool ID filter required")
+	}
+
+	_, err := d.client.DeleteGroup(ctx, &cognitoidentityprovider.DeleteGroupInput{
+		UserPoolId: &userPoolId,
+		GroupName:  &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete cognito group %s", name)
+	}
+	return nil
+}
+
+func (d *GroupDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet, dao.OpDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// GroupResource represents a Cognito user pool group
+type GroupResource struct {
+	dao.BaseResource
+	Item       types.GroupType
+	UserPoolId string
+
+	// Populated by Get only
+	Members []types.UserType
+}
+
+// NewGroupResource creates a new GroupResource
+func NewGroupResource(group types.GroupType) *GroupResource {
+	name := appaws.Str(group.GroupName)
+	return &GroupResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Data: group,
+		},
+		Item:       group,
+		UserPoolId: appaws.Str(group.UserPoolId),
+	}
+}
+
+// Description returns the group description
+func (r *GroupResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+// Precedence returns the group precedence as a string, or "" if unset.
+// Lower values take priority when a user belongs to several groups with roles.
+func (r *GroupResource) Precedence() string {
+	if r.Item.Precedence == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d", *r.Item.Precedence)
+}
+
+// RoleArn returns the IAM role associated with the group
+func (r *GroupResource) RoleArn() string {
+	return appaws.Str(r.Item.RoleArn)
+}
+
+// MemberNames returns the usernames of the group's members
+func (r *GroupResource) MemberNames() []string {
+	names := make([]string, 0, len(r.Members))
+	for _, u := range r.Members {
+		names = append(names, appaws.Str(u.Username))
+	}
+	return names
+}
+
+// CreatedAtTime returns the creation time
+func (r *GroupResource) CreatedAtTime() *time.Time {
+	return r.Item.CreationDate
+}
+
+// LastModifiedDate returns the last modified time as a formatted string
+func (r *GroupResource) LastModifiedDate() string {
+	if r.Item.LastModifiedDate != nil {
+		return r.Item.LastModifiedDate.Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
@@ -0,0 +1,31 @@
+package groups
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+)
+
+func TestGroupResource(t *testing.T) {
+	r := NewGroupResource(types.GroupType{
+		GroupName:  aws.String("admins"),
+		UserPoolId: aws.String("us-east-1_abc"),
+		Precedence: aws.Int32(0),
+	})
+	if r.GetID() != "admins" || r.UserPoolId != "us-east-1_abc" {
+		t.Errorf("ID/UserPoolId = %q/%q", r.GetID(), r.UserPoolId)
+	}
+	if got := r.Precedence(); got != "0" {
+		t.Errorf("Precedence() = %q, want 0", got)
+	}
+	if got := NewGroupResource(types.GroupType{}).Precedence(); got != "" {
+		t.Errorf("Precedence() = %q, want empty", got)
+	}
+
+	r.Members = []types.UserType{{Username: aws.String("alice")}, {Username: aws.String("bob")}}
+	if got, want := r.MemberNames(), []string{"alice", "bob"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MemberNames() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,145 @@
+package groups
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure GroupRenderer implements render.Navigator
+var _ render.Navigator = (*GroupRenderer)(nil)
+
+// GroupRenderer renders Cognito user pool groups
+type GroupRenderer struct {
+	render.BaseRenderer
+}
+
+// NewGroupRenderer creates a new GroupRenderer
+func NewGroupRenderer() *GroupRenderer {
+	return &GroupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cognito-idp",
+			Resource: "groups",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 30, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "DESCRIPTION", Width: 40, Getter: getDescription},
+				{Name: "PRECEDENCE", Width: 11, Getter: getPrecedence},
+				{Name: "ROLE", Width: 10, Getter: getRole},
+				{Name: "AGE", Width: 12, Getter: getAge},
+			},
+		},
+	}
+}
+
+func getDescription(r dao.Resource) string {
+	if g, ok := r.(*GroupResource); ok {
+		return g.Description()
+	}
+	return ""
+}
+
+func getPrecedence(r dao.Resource) string {
+	if g, ok := r.(*GroupResource); ok {
+		if p := g.Precedence(); p != "" {
+			return p
+		}
+	}
+	return "-"
+}
+
+func getRole(r dao.Resource) string {
+	if g, ok := r.(*GroupResource); ok {
+		if g.RoleArn() != "" {
+			return "Yes"
+		}
+		return "No"
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if g, ok := r.(*GroupResource); ok {
+		if t := g.CreatedAtTime(); t != nil {
+			return render.FormatAge(*t)
+		}
+	}
+	return "-"
+}
+
+// RenderDetail renders detailed group information
+func (r *GroupRenderer) RenderDetail(resource dao.Resource) string {
+	group, ok := resource.(*GroupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Cognito Group", group.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Group Name", group.GetName())
+	d.Field("User Pool ID", group.UserPoolId)
+	if desc := group.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+	if p := group.Precedence(); p != "" {
+		d.Field("Precedence", p)
+	}
+	if role := group.RoleArn(); role != "" {
+		d.Field("IAM Role", role)
+	}
+
+	if len(group.Members) > 0 {
+		d.Section(fmt.Sprintf("Members (%d)", len(group.Members)))
+		for _, u := range group.Members {
+			d.Field(appaws.Str(u.Username), string(u.UserStatus))
+		}
+	}
+
+	d.Section("Timestamps")
+	if t := group.CreatedAtTime(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if modified := group.LastModifiedDate(); modified != "" {
+		d.Field("Last Modified", modified)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *GroupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	group, ok := resource.(*GroupResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Group", Value: group.GetName()},
+		{Label: "User Pool ID", Value: group.UserPoolId},
+	}
+	if p := group.Precedence(); p != "" {
+		fields = append(fields, render.SummaryField{Label: "Precedence", Value: p})
+	}
+	if role := group.RoleArn(); role != "" {
+		fields = append(fields, render.SummaryField{Label: "IAM Role", Value: role})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *GroupRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	group, ok := resource.(*GroupResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "u", Label: "Users", Service: "cognito-idp", Resource: "users",
+			FilterField: "UserPoolId", FilterValue: group.UserPoolId,
+		},
+	}
+}
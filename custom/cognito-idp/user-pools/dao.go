@@ -19,13 +19,13 @@ type UserPoolDAO struct {
 
 // NewUserPoolDAO creates a new UserPoolDAO
 func NewUserPoolDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cognitoidentityprovider.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &UserPoolDAO{
 		BaseDAO: dao.NewBaseDAO("cognito-idp", "user-pools"),
-		client:  cognitoidentityprovider.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -185,5 +185,9 @@ func (r *UserPoolRenderer) Navigations(resource dao.Resource) []render.Navigatio
 			Key: "u", Label: "Users", Service: "cognito-idp", Resource: "users",
 			FilterField: "UserPoolId", FilterValue: pool.PoolId(),
 		},
+		{
+			Key: "g", Label: "Groups", Service: "cognito-idp", Resource: "groups",
+			FilterField: "UserPoolId", FilterValue: pool.PoolId(),
+		},
 	}
 }
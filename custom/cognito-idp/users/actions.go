@@ -0,0 +1,158 @@
+package users
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+
+	cognitoClient "github.com/clawscli/claws/custom/cognito-idp"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Cognito users
+	action.Global.Register("cognito-idp", "users", []action.Action{
+		{
+			Name:      "Reset Password",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "AdminResetUserPassword",
+			Confirm:   action.ConfirmSimple,
+		},
+		{
+			Name:      "Enable",
+			Shortcut:  "E",
+			Type:      action.ActionTypeAPI,
+			Operation: "AdminEnableUser",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				u, ok := r.(*UserResource)
+				return ok && !u.Enabled()
+			},
+		},
+		{
+			Name:      "Disable",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "AdminDisableUser",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				u, ok := r.(*UserResource)
+				return ok && u.Enabled()
+			},
+		},
+		{
+			Name:      "Add to Group",
+			Shortcut:  "G",
+			Type:      action.ActionTypeAPI,
+			Operation: "AdminAddUserToGroup",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Group name", Placeholder: "group-name"},
+		},
+		{
+			Name:      "Remove from Group",
+			Shortcut:  "U",
+			Type:      action.ActionTypeAPI,
+			Operation: "AdminRemoveUserFromGroup",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Group name", Placeholder: "group-name"},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "AdminDeleteUser",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("cognito-idp", "users", executeUserAction)
+}
+
+// executeUserAction executes an action on a Cognito user
+func executeUserAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	user, ok := resource.(*UserResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := cognitoClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	poolID := user.UserPoolId
+	username := user.Username()
+
+	switch act.Operation {
+	case "AdminResetUserPassword":
+		_, err = client.AdminResetUserPassword(ctx, &cognitoidentityprovider.AdminResetUserPasswordInput{
+			UserPoolId: &poolID,
+			Username:   &username,
+		})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("reset password: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Password reset for %s; user must set a new password at next sign-in", username)}
+
+	case "AdminEnableUser":
+		_, err = client.AdminEnableUser(ctx, &cognitoidentityprovider.AdminEnableUserInput{
+			UserPoolId: &poolID,
+			Username:   &username,
+		})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("enable user: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Enabled user %s", username)}
+
+	case "AdminDisableUser":
+		_, err = client.AdminDisableUser(ctx, &cognitoidentityprovider.AdminDisableUserInput{
+			UserPoolId: &poolID,
+			Username:   &username,
+		})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("disable user: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Disabled user %s", username)}
+
+	case "AdminAddUserToGroup":
+		group := action.InputFromContext(ctx)
+		_, err = client.AdminAddUserToGroup(ctx, &cognitoidentityprovider.AdminAddUserToGroupInput{
+			UserPoolId: &poolID,
+			Username:   &username,
+			GroupName:  &group,
+		})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("add user to group: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Added %s to group %s", username, group)}
+
+	case "AdminRemoveUserFromGroup":
+		group := action.InputFromContext(ctx)
+		_, err = client.AdminRemoveUserFromGroup(ctx, &cognitoidentityprovider.AdminRemoveUserFromGroupInput{
+			UserPoolId: &poolID,
+			Username:   &username,
+			GroupName:  &group,
+		})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("remove user from group: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Removed %s from group %s", username, group)}
+
+	case "AdminDeleteUser":
+		_, err = client.AdminDeleteUser(ctx, &cognitoidentityprovider.AdminDeleteUserInput{
+			UserPoolId: &poolID,
+			Username:   &username,
+		})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("delete user: %w", err)}
+		}
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Deleted user %s", username)}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,8 +12,12 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
+// maxAuthEvents is the number of recent auth events fetched for the detail view.
+const maxAuthEvents = 20
+
 // UserDAO provides data access for Cognito users
 type UserDAO struct {
 	dao.BaseDAO
@@ -21,13 +26,13 @@ type UserDAO struct {
 
 // NewUserDAO creates a new UserDAO
 func NewUserDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cognitoidentityprovider.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &UserDAO{
 		BaseDAO: dao.NewBaseDAO("cognito-idp", "users"),
-		client:  cognitoidentityprovider.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -78,7 +83,8 @@ func (d *UserDAO) ListPage(ctx context.Context, pageSize int, pageToken string)
 	return resources, nextToken, nil
 }
 
-// Get returns a specific user
+// Get returns a specific user with group memberships and recent auth events.
+// Enrichment is best-effort; failures are logged and leave fields unset.
 func (d *UserDAO) Get(ctx context.Context, username string) (dao.Resource, error) {
 	userPoolId := dao.GetFilterFromContext(ctx, "UserPoolId")
 	if userPoolId == "" {
@@ -95,7 +101,41 @@ func (d *UserDAO) Get(ctx context.Context, username string) (dao.Resource, error
 		return nil, apperrors.Wrapf(err, "get user %s", username)
 	}
 
-	return NewUserResourceFromDetail(output, userPoolId), nil
+	r := NewUserResourceFromDetail(output, userPoolId)
+
+	groups, err := appaws.Paginate(ctx, func(token *string) ([]types.GroupType, *string, error) {
+		out, err := d.client.AdminListGroupsForUser(ctx, &cognitoidentityprovider.AdminListGroupsForUserInput{
+			UserPoolId: &userPoolId,
+			Username:   &username,
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return out.Groups, out.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to list groups for user", "user", username, "error", err)
+	} else {
+		r.Groups = groups
+	}
+
+	events, err := d.client.AdminListUserAuthEvents(ctx, &cognitoidentityprovider.AdminListUserAuthEventsInput{
+		UserPoolId: &userPoolId,
+		Username:   &username,
+		MaxResults: appaws.Int32Ptr(maxAuthEvents),
+	})
+	var addOnErr *types.UserPoolAddOnNotEnabledException
+	switch {
+	case errors.As(err, &addOnErr):
+		// Auth event history requires threat protection on the user pool
+	case err != nil:
+		log.Warn("failed to list auth events for user", "user", username, "error", err)
+	default:
+		r.AuthEvents = events.AuthEvents
+	}
+
+	return r, nil
 }
 
 // Delete deletes a Cognito user
@@ -131,6 +171,10 @@ type UserResource struct {
 	User       *types.UserType
 	Detail     *cognitoidentityprovider.AdminGetUserOutput
 	UserPoolId string
+
+	// Populated by Get only
+	Groups     []types.GroupType
+	AuthEvents []types.AuthEventType
 }
 
 // NewUserResource creates a new UserResource from list
@@ -200,6 +244,15 @@ func (r *UserResource) Enabled() bool {
 	return false
 }
 
+// GroupNames returns the names of the groups the user belongs to
+func (r *UserResource) GroupNames() []string {
+	names := make([]string, 0, len(r.Groups))
+	for _, g := range r.Groups {
+		names = append(names, appaws.Str(g.GroupName))
+	}
+	return names
+}
+
 // Email returns the user's email
 func (r *UserResource) Email() string {
 	return r.getAttribute("email")
@@ -3,9 +3,14 @@ package users
 import (
 	"fmt"
 	"sort"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // UserRenderer renders Cognito users
@@ -126,6 +131,40 @@ func (r *UserRenderer) RenderDetail(resource dao.Resource) string {
 		}
 	}
 
+	// Groups
+	if len(user.Groups) > 0 {
+		d.Section("Groups")
+		d.Field("Member Of", strings.Join(user.GroupNames(), ", "))
+	}
+
+	// Auth Events
+	if len(user.AuthEvents) > 0 {
+		d.Section("Recent Auth Events")
+		for _, ev := range user.AuthEvents {
+			when := ""
+			if ev.CreationDate != nil {
+				when = ev.CreationDate.Format("2006-01-02 15:04:05")
+			}
+			value := fmt.Sprintf("%s %s", ev.EventType, ev.EventResponse)
+			if ec := ev.EventContextData; ec != nil {
+				if ip := appaws.Str(ec.IpAddress); ip != "" {
+					value += " from " + ip
+				}
+				if country := appaws.Str(ec.Country); country != "" {
+					value += " (" + country + ")"
+				}
+			}
+			if ev.EventRisk != nil && ev.EventRisk.RiskLevel != "" {
+				value += fmt.Sprintf(" risk=%s", ev.EventRisk.RiskLevel)
+			}
+			if ev.EventResponse == types.EventResponseTypeFail {
+				d.FieldStyled(when, value, ui.DangerStyle())
+			} else {
+				d.Field(when, value)
+			}
+		}
+	}
+
 	// Timestamps
 	d.Section("Timestamps")
 	if created := user.CreatedAt(); created != "" {
@@ -166,5 +205,14 @@ func (r *UserRenderer) RenderSummary(resource dao.Resource) []render.SummaryFiel
 
 // Navigations returns navigation shortcuts
 func (r *UserRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	return nil
+	user, ok := resource.(*UserResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "g", Label: "Groups", Service: "cognito-idp", Resource: "groups",
+			FilterField: "UserPoolId", FilterValue: user.UserPoolId,
+		},
+	}
 }
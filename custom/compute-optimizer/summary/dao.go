@@ -20,13 +20,13 @@ type SummaryDAO struct {
 
 // NewSummaryDAO creates a new SummaryDAO.
 func NewSummaryDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, computeoptimizer.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SummaryDAO{
 		BaseDAO: dao.NewBaseDAO("compute-optimizer", "summary"),
-		client:  computeoptimizer.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
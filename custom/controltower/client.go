@@ -0,0 +1,61 @@
+package controltower
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	orgs "github.com/clawscli/claws/custom/organizations"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// GetClient returns a Control Tower client configured for the current context
+func GetClient(ctx context.Context) (*controltower.Client, error) {
+	return appaws.Client(ctx, controltower.NewFromConfig)
+}
+
+// TargetNames returns the names of the organization's root, OUs and accounts
+// keyed by ID. Names only label Control Tower targets, so a failure, e.g.
+// without organizations permissions, is logged and IDs are shown instead.
+func TargetNames(ctx context.Context) map[string]string {
+	client, err := appaws.Client(ctx, organizations.NewFromConfig)
+	if err != nil {
+		log.Warn("failed to create organizations client", "error", err)
+		return nil
+	}
+	root, err := orgs.LoadTree(ctx, client)
+	if err != nil {
+		log.Warn("failed to load organization tree", "error", err)
+		return nil
+	}
+	names := make(map[string]string)
+	root.Walk(func(n *orgs.TreeNode) { names[n.ID] = n.Name })
+	return names
+}
+
+// ARNResourceID returns the part of an ARN after its last slash, e.g. the OU
+// or account ID of a target, or a control's name
+func ARNResourceID(arn string) string {
+	if i := strings.LastIndex(arn, "/"); i >= 0 {
+		return arn[i+1:]
+	}
+	return arn
+}
+
+// IsAccountTarget reports whether a target ARN is an account rather than an OU
+func IsAccountTarget(arn string) bool {
+	return strings.Contains(arn, ":account/")
+}
+
+// Enablement returns the deployment status of an enabled control or
+// baseline: SUCCEEDED, UNDER_CHANGE or FAILED
+func Enablement(s *types.EnablementStatusSummary) types.EnablementStatus {
+	if s == nil {
+		return ""
+	}
+	return s.Status
+}
@@ -0,0 +1,61 @@
+package controls
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+
+	ctClient "github.com/clawscli/claws/custom/controltower"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for enabled controls
+	action.Global.Register("controltower", "controls", []action.Action{
+		{
+			Name:      "Reset",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "ResetEnabledControl",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				c, ok := r.(*ControlResource)
+				return ok && c.NeedsReset()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("controltower", "controls", executeControlAction)
+}
+
+// executeControlAction executes an action on an enabled control.
+func executeControlAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	c, ok := resource.(*ControlResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "ResetEnabledControl":
+		client, err := ctClient.GetClient(ctx)
+		if err != nil {
+			return action.ActionResult{Success: false, Error: err}
+		}
+		arn := c.GetARN()
+		output, err := client.ResetEnabledControl(ctx, &controltower.ResetEnabledControlInput{EnabledControlIdentifier: &arn})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("reset enabled control: %w", err)}
+		}
+		return action.ActionResult{
+			Success: true,
+			Message: fmt.Sprintf("Resetting %s on %s (operation %s)", c.GetName(), c.Target(), appaws.Str(output.OperationIdentifier)),
+		}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -0,0 +1,181 @@
+package controls
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+
+	ctClient "github.com/clawscli/claws/custom/controltower"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ControlDAO provides data access for controls (guardrails) enabled by
+// Control Tower
+type ControlDAO struct {
+	dao.BaseDAO
+	client *controltower.Client
+}
+
+// NewControlDAO creates a new ControlDAO
+func NewControlDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := ctClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ControlDAO{
+		BaseDAO: dao.NewBaseDAO("controltower", "controls"),
+		client:  client,
+	}, nil
+}
+
+// List returns the enabled controls, grouped by OU. The TargetIdentifier
+// filter (an OU ARN) limits them to one OU.
+func (d *ControlDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &controltower.ListEnabledControlsInput{}
+	if target := dao.GetFilterFromContext(ctx, "TargetIdentifier"); target != "" {
+		input.TargetIdentifier = &target
+	}
+	controls, err := appaws.Paginate(ctx, func(token *string) ([]types.EnabledControlSummary, *string, error) {
+		input.NextToken = token
+		output, err := d.client.ListEnabledControls(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list enabled controls")
+		}
+		return output.EnabledControls, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := ctClient.TargetNames(ctx)
+	resources := make([]*ControlResource, 0, len(controls))
+	for _, c := range controls {
+		r := NewControlResource(c)
+		r.TargetName = names[ctClient.ARNResourceID(r.TargetARN())]
+		resources = append(resources, r)
+	}
+	slices.SortFunc(resources, func(a, b *ControlResource) int {
+		return cmp.Or(cmp.Compare(a.Target(), b.Target()), cmp.Compare(a.GetName(), b.GetName()))
+	})
+
+	out := make([]dao.Resource, len(resources))
+	for i, r := range resources {
+		out[i] = r
+	}
+	return out, nil
+}
+
+// Get returns an enabled control with its regions and parameters
+func (d *ControlDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetEnabledControl(ctx, &controltower.GetEnabledControlInput{EnabledControlIdentifier: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get enabled control %s", id)
+	}
+	if output.EnabledControlDetails == nil {
+		return nil, fmt.Errorf("enabled control not found: %s", id)
+	}
+	c := output.EnabledControlDetails
+	r := NewControlResource(types.EnabledControlSummary{
+		Arn:                c.Arn,
+		ControlIdentifier:  c.ControlIdentifier,
+		DriftStatusSummary: c.DriftStatusSummary,
+		ParentIdentifier:   c.ParentIdentifier,
+		StatusSummary:      c.StatusSummary,
+		TargetIdentifier:   c.TargetIdentifier,
+	})
+	r.Detail = c
+	r.Data = c
+	r.TargetName = ctClient.TargetNames(ctx)[ctClient.ARNResourceID(r.TargetARN())]
+	return r, nil
+}
+
+// Delete is not supported for enabled controls
+func (d *ControlDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for enabled controls")
+}
+
+// ControlResource represents a control enabled on an OU
+type ControlResource struct {
+	dao.BaseResource
+	Item types.EnabledControlSummary
+
+	// TargetName is the name of the OU, when organizations can be read.
+	TargetName string
+
+	// Detail is populated by Get.
+	Detail *types.EnabledControlDetails
+}
+
+// NewControlResource creates a new ControlResource
+func NewControlResource(c types.EnabledControlSummary) *ControlResource {
+	arn := appaws.Str(c.Arn)
+	return &ControlResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn,
+			Name: ctClient.ARNResourceID(appaws.Str(c.ControlIdentifier)),
+			ARN:  arn,
+			Data: c,
+		},
+		Item: c,
+	}
+}
+
+// TargetARN returns the ARN of the OU the control is enabled on
+func (r *ControlResource) TargetARN() string {
+	return appaws.Str(r.Item.TargetIdentifier)
+}
+
+// Target names the OU the control is enabled on, or its ID
+func (r *ControlResource) Target() string {
+	return cmp.Or(r.TargetName, ctClient.ARNResourceID(r.TargetARN()))
+}
+
+// Status returns the deployment status: SUCCEEDED, UNDER_CHANGE or FAILED
+func (r *ControlResource) Status() string {
+	return string(ctClient.Enablement(r.Item.StatusSummary))
+}
+
+// DriftStatus returns DRIFTED, IN_SYNC, NOT_CHECKING or UNKNOWN
+func (r *ControlResource) DriftStatus() string {
+	if r.Item.DriftStatusSummary == nil {
+		return ""
+	}
+	return string(r.Item.DriftStatusSummary.DriftStatus)
+}
+
+// IsDrifted reports whether the control no longer matches its configuration
+func (r *ControlResource) IsDrifted() bool {
+	return r.DriftStatus() == string(types.DriftStatusDrifted)
+}
+
+// NeedsReset reports whether the control failed to deploy or has drifted,
+// so that resetting it would redeploy it
+func (r *ControlResource) NeedsReset() bool {
+	return r.IsDrifted() || r.Status() == string(types.EnablementStatusFailed)
+}
+
+// IsInherited reports whether the control's configuration comes from a
+// control enabled on a parent OU
+func (r *ControlResource) IsInherited() bool {
+	return appaws.Str(r.Item.ParentIdentifier) != ""
+}
+
+// DriftTypes returns the inheritance and resource drift statuses that are
+// reported for the control
+func (r *ControlResource) DriftTypes() (inheritance, resource string) {
+	if s := r.Item.DriftStatusSummary; s != nil && s.Types != nil {
+		if s.Types.Inheritance != nil {
+			inheritance = string(s.Types.Inheritance.Status)
+		}
+		if s.Types.Resource != nil {
+			resource = string(s.Types.Resource.Status)
+		}
+	}
+	return inheritance, resource
+}
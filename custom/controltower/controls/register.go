@@ -0,0 +1,20 @@
+package controls
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("controltower", "controls", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewControlDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewControlRenderer()
+		},
+	})
+}
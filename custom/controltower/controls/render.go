@@ -0,0 +1,184 @@
+package controls
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Navigator = (*ControlRenderer)(nil)
+
+// ControlRenderer renders controls enabled by Control Tower
+type ControlRenderer struct {
+	render.BaseRenderer
+}
+
+// NewControlRenderer creates a new ControlRenderer
+func NewControlRenderer() render.Renderer {
+	return &ControlRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "controltower",
+			Resource: "controls",
+			Cols: []render.Column{
+				{Name: "CONTROL", Width: 40, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "OU", Width: 24, Getter: getTarget, Priority: 0},
+				{Name: "STATUS", Width: 13, Getter: getStatus, Priority: 0},
+				{Name: "DRIFT", Width: 13, Getter: getDrift, Priority: 0},
+				{Name: "INHERITED", Width: 10, Getter: getInherited, Priority: 2},
+			},
+		},
+	}
+}
+
+func getTarget(r dao.Resource) string {
+	if c, ok := r.(*ControlResource); ok {
+		return c.Target()
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if c, ok := r.(*ControlResource); ok {
+		return c.Status()
+	}
+	return ""
+}
+
+func getDrift(r dao.Resource) string {
+	if c, ok := r.(*ControlResource); ok && c.DriftStatus() != "" {
+		return c.DriftStatus()
+	}
+	return "-"
+}
+
+func getInherited(r dao.Resource) string {
+	if c, ok := r.(*ControlResource); ok && c.IsInherited() {
+		return "yes"
+	}
+	return ""
+}
+
+// statusStyle colors a deployment status
+func statusStyle(status string) lipgloss.Style {
+	switch status {
+	case "SUCCEEDED":
+		return ui.SuccessStyle()
+	case "FAILED":
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+// driftStyle colors a drift status
+func driftStyle(drift string) lipgloss.Style {
+	switch drift {
+	case "DRIFTED":
+		return ui.DangerStyle()
+	case "IN_SYNC":
+		return ui.SuccessStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the enabled control with its drift and regions
+func (r *ControlRenderer) RenderDetail(resource dao.Resource) string {
+	c, ok := resource.(*ControlResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Enabled Control", c.GetName())
+
+	d.Section("Control")
+	d.Field("Control", appaws.Str(c.Item.ControlIdentifier))
+	d.Field("OU", c.Target())
+	d.Field("OU ARN", c.TargetARN())
+	d.FieldStyled("Status", c.Status(), statusStyle(c.Status()))
+	if c.IsInherited() {
+		d.Field("Inherited From", appaws.Str(c.Item.ParentIdentifier))
+	}
+	d.Field("ARN", c.GetARN())
+
+	if drift := c.DriftStatus(); drift != "" {
+		d.Section("Drift")
+		d.FieldStyled("Status", drift, driftStyle(drift))
+		inheritance, res := c.DriftTypes()
+		if inheritance != "" {
+			d.FieldStyled("Inheritance", inheritance, driftStyle(inheritance))
+		}
+		if res != "" {
+			d.FieldStyled("Resources", res, driftStyle(res))
+		}
+		if c.NeedsReset() {
+			d.Line(ui.WarningStyle().Render("⚠ Reset the control to redeploy it"))
+		}
+	}
+
+	if c.Detail != nil {
+		if len(c.Detail.TargetRegions) > 0 {
+			regions := make([]string, len(c.Detail.TargetRegions))
+			for i, region := range c.Detail.TargetRegions {
+				regions[i] = appaws.Str(region.Name)
+			}
+			d.Section("Regions")
+			d.Line(strings.Join(regions, ", "))
+		}
+		if len(c.Detail.Parameters) > 0 {
+			d.Section("Parameters")
+			for _, p := range c.Detail.Parameters {
+				var value string
+				if p.Value != nil {
+					if raw, err := p.Value.MarshalSmithyDocument(); err == nil {
+						value = string(raw)
+					}
+				}
+				d.Field(appaws.Str(p.Key), value)
+			}
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ControlRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	c, ok := resource.(*ControlResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "Control", Value: c.GetName()},
+		{Label: "OU", Value: c.Target()},
+		{Label: "Status", Value: c.Status(), Style: statusStyle(c.Status())},
+	}
+	if drift := c.DriftStatus(); drift != "" {
+		fields = append(fields, render.SummaryField{Label: "Drift", Value: drift, Style: driftStyle(drift)})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *ControlRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	c, ok := resource.(*ControlResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "o", Label: "OU Controls", Service: "controltower", Resource: "controls",
+			FilterField: "TargetIdentifier", FilterValue: c.TargetARN(),
+		},
+		{
+			Key: "e", Label: "Enrollments", Service: "controltower", Resource: "enrollments",
+			FilterField: "TargetIdentifier", FilterValue: c.TargetARN(),
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package controls
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+)
+
+func TestControlResource(t *testing.T) {
+	c := NewControlResource(types.EnabledControlSummary{
+		Arn:               aws.String("arn:aws:controltower:us-east-1:111111111111:enabledcontrol/ABC"),
+		ControlIdentifier: aws.String("arn:aws:controltower:us-east-1::control/AWS-GR_RESTRICT_ROOT_USER"),
+		TargetIdentifier:  aws.String("arn:aws:organizations::111111111111:ou/o-abc/ou-prod"),
+		StatusSummary:     &types.EnablementStatusSummary{Status: types.EnablementStatusSucceeded},
+		DriftStatusSummary: &types.DriftStatusSummary{
+			DriftStatus: types.DriftStatusDrifted,
+			Types: &types.EnabledControlDriftTypes{
+				Resource: &types.EnabledControlResourceDrift{Status: types.DriftStatusDrifted},
+			},
+		},
+	})
+
+	if c.GetName() != "AWS-GR_RESTRICT_ROOT_USER" || c.Target() != "ou-prod" {
+		t.Errorf("name=%q target=%q", c.GetName(), c.Target())
+	}
+	if !c.IsDrifted() || !c.NeedsReset() || c.IsInherited() {
+		t.Errorf("drifted=%v needsReset=%v inherited=%v", c.IsDrifted(), c.NeedsReset(), c.IsInherited())
+	}
+	if inheritance, resource := c.DriftTypes(); inheritance != "" || resource != "DRIFTED" {
+		t.Errorf("DriftTypes = %q, %q", inheritance, resource)
+	}
+	c.TargetName = "Prod"
+	if c.Target() != "Prod" {
+		t.Errorf("target = %q, want Prod", c.Target())
+	}
+}
@@ -0,0 +1,61 @@
+package enrollments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+
+	ctClient "github.com/clawscli/claws/custom/controltower"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Control Tower enrollments
+	action.Global.Register("controltower", "enrollments", []action.Action{
+		{
+			Name:      "Reset",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "ResetEnabledBaseline",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				e, ok := r.(*EnrollmentResource)
+				return ok && e.NeedsReset()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("controltower", "enrollments", executeEnrollmentAction)
+}
+
+// executeEnrollmentAction executes an action on a Control Tower enrollment.
+func executeEnrollmentAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	e, ok := resource.(*EnrollmentResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "ResetEnabledBaseline":
+		client, err := ctClient.GetClient(ctx)
+		if err != nil {
+			return action.ActionResult{Success: false, Error: err}
+		}
+		arn := e.GetARN()
+		output, err := client.ResetEnabledBaseline(ctx, &controltower.ResetEnabledBaselineInput{EnabledBaselineIdentifier: &arn})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("reset enabled baseline: %w", err)}
+		}
+		return action.ActionResult{
+			Success: true,
+			Message: fmt.Sprintf("Resetting %s on %s (operation %s)", e.Baseline(), e.Target(), appaws.Str(output.OperationIdentifier)),
+		}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -0,0 +1,251 @@
+package enrollments
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+
+	ctClient "github.com/clawscli/claws/custom/controltower"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// EnrollmentDAO provides data access for Control Tower enrollments: the
+// baselines enabled on registered OUs and on the accounts enrolled in them
+type EnrollmentDAO struct {
+	dao.BaseDAO
+	client *controltower.Client
+}
+
+// NewEnrollmentDAO creates a new EnrollmentDAO
+func NewEnrollmentDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := ctClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EnrollmentDAO{
+		BaseDAO: dao.NewBaseDAO("controltower", "enrollments"),
+		client:  client,
+	}, nil
+}
+
+// List returns the enabled baselines of OUs and their accounts, OUs first.
+// The TargetIdentifier filter (an OU ARN) limits them to one OU and its
+// accounts.
+func (d *EnrollmentDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &controltower.ListEnabledBaselinesInput{IncludeChildren: true}
+	target := dao.GetFilterFromContext(ctx, "TargetIdentifier")
+	baselines, err := appaws.Paginate(ctx, func(token *string) ([]types.EnabledBaselineSummary, *string, error) {
+		input.NextToken = token
+		output, err := d.client.ListEnabledBaselines(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list enabled baselines")
+		}
+		return output.EnabledBaselines, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	targetNames := ctClient.TargetNames(ctx)
+	baselineNames := d.baselineNames(ctx)
+	resources := make([]*EnrollmentResource, 0, len(baselines))
+	for _, b := range baselines {
+		r := NewEnrollmentResource(b)
+		if target != "" && r.TargetARN() != target && !r.InOU(target, baselines) {
+			continue
+		}
+		r.SetTargetName(targetNames[ctClient.ARNResourceID(r.TargetARN())])
+		r.BaselineName = baselineNames[appaws.Str(b.BaselineIdentifier)]
+		resources = append(resources, r)
+	}
+	slices.SortFunc(resources, func(a, b *EnrollmentResource) int {
+		return cmp.Or(cmp.Compare(a.Kind(), b.Kind()), cmp.Compare(a.Target(), b.Target()), cmp.Compare(a.Baseline(), b.Baseline()))
+	})
+
+	out := make([]dao.Resource, len(resources))
+	for i, r := range resources {
+		out[i] = r
+	}
+	return out, nil
+}
+
+// baselineNames returns baseline names keyed by ARN. Baseline ARNs are
+// opaque, so names make the list readable; a failure is logged.
+func (d *EnrollmentDAO) baselineNames(ctx context.Context) map[string]string {
+	baselines, err := appaws.Paginate(ctx, func(token *string) ([]types.BaselineSummary, *string, error) {
+		output, err := d.client.ListBaselines(ctx, &controltower.ListBaselinesInput{NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list baselines")
+		}
+		return output.Baselines, output.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to list baselines", "error", err)
+		return nil
+	}
+	names := make(map[string]string, len(baselines))
+	for _, b := range baselines {
+		names[appaws.Str(b.Arn)] = appaws.Str(b.Name)
+	}
+	return names
+}
+
+// Get returns an enabled baseline with its parameters
+func (d *EnrollmentDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetEnabledBaseline(ctx, &controltower.GetEnabledBaselineInput{EnabledBaselineIdentifier: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get enabled baseline %s", id)
+	}
+	if output.EnabledBaselineDetails == nil {
+		return nil, fmt.Errorf("enabled baseline not found: %s", id)
+	}
+	b := output.EnabledBaselineDetails
+	r := NewEnrollmentResource(types.EnabledBaselineSummary{
+		Arn:                b.Arn,
+		BaselineIdentifier: b.BaselineIdentifier,
+		BaselineVersion:    b.BaselineVersion,
+		DriftStatusSummary: b.DriftStatusSummary,
+		ParentIdentifier:   b.ParentIdentifier,
+		StatusSummary:      b.StatusSummary,
+		TargetIdentifier:   b.TargetIdentifier,
+	})
+	r.Detail = b
+	r.Data = b
+	r.SetTargetName(ctClient.TargetNames(ctx)[ctClient.ARNResourceID(r.TargetARN())])
+	r.BaselineName = d.baselineNames(ctx)[appaws.Str(b.BaselineIdentifier)]
+	return r, nil
+}
+
+// Delete is not supported for enrollments
+func (d *EnrollmentDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for enrollments")
+}
+
+// EnrollmentResource represents a baseline enabled on an OU (its
+// registration) or on an account (its enrollment)
+type EnrollmentResource struct {
+	dao.BaseResource
+	Item types.EnabledBaselineSummary
+
+	// TargetName and BaselineName label the target and baseline when they
+	// can be looked up.
+	TargetName   string
+	BaselineName string
+
+	// Detail is populated by Get.
+	Detail *types.EnabledBaselineDetails
+}
+
+// NewEnrollmentResource creates a new EnrollmentResource
+func NewEnrollmentResource(b types.EnabledBaselineSummary) *EnrollmentResource {
+	arn := appaws.Str(b.Arn)
+	return &EnrollmentResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn,
+			Name: ctClient.ARNResourceID(appaws.Str(b.TargetIdentifier)),
+			ARN:  arn,
+			Data: b,
+		},
+		Item: b,
+	}
+}
+
+// SetTargetName names the resource after its OU or account
+func (r *EnrollmentResource) SetTargetName(name string) {
+	r.TargetName = name
+	r.Name = r.Target()
+}
+
+// TargetARN returns the ARN of the OU or account
+func (r *EnrollmentResource) TargetARN() string {
+	return appaws.Str(r.Item.TargetIdentifier)
+}
+
+// Target names the OU or account, or gives its ID
+func (r *EnrollmentResource) Target() string {
+	return cmp.Or(r.TargetName, ctClient.ARNResourceID(r.TargetARN()))
+}
+
+// IsAccount reports whether the baseline is enabled on an account
+func (r *EnrollmentResource) IsAccount() bool {
+	return ctClient.IsAccountTarget(r.TargetARN())
+}
+
+// Kind returns "OU" or "Account"
+func (r *EnrollmentResource) Kind() string {
+	if r.IsAccount() {
+		return "Account"
+	}
+	return "OU"
+}
+
+// Baseline names the baseline, or gives its ID
+func (r *EnrollmentResource) Baseline() string {
+	return cmp.Or(r.BaselineName, ctClient.ARNResourceID(appaws.Str(r.Item.BaselineIdentifier)))
+}
+
+// InOU reports whether the baseline is a child of the baseline enabled on
+// the OU with the given ARN, i.e. an account enrolled through it
+func (r *EnrollmentResource) InOU(ouARN string, all []types.EnabledBaselineSummary) bool {
+	parent := appaws.Str(r.Item.ParentIdentifier)
+	if parent == "" {
+		return false
+	}
+	for _, b := range all {
+		if appaws.Str(b.Arn) == parent {
+			return appaws.Str(b.TargetIdentifier) == ouARN
+		}
+	}
+	return false
+}
+
+// Status returns the deployment status: SUCCEEDED, UNDER_CHANGE or FAILED
+func (r *EnrollmentResource) Status() string {
+	return string(ctClient.Enablement(r.Item.StatusSummary))
+}
+
+// State describes the status as a registration for OUs or an enrollment
+// for accounts, e.g. "Enrolled" or "Registration failed"
+func (r *EnrollmentResource) State() string {
+	done, doing, noun := "Registered", "Registering", "Registration"
+	if r.IsAccount() {
+		done, doing, noun = "Enrolled", "Enrolling", "Enrollment"
+	}
+	switch ctClient.Enablement(r.Item.StatusSummary) {
+	case types.EnablementStatusSucceeded:
+		return done
+	case types.EnablementStatusUnderChange:
+		return doing
+	case types.EnablementStatusFailed:
+		return noun + " failed"
+	default:
+		return r.Status()
+	}
+}
+
+// DriftStatus returns the inheritance drift status, DRIFTED or IN_SYNC
+func (r *EnrollmentResource) DriftStatus() string {
+	if s := r.Item.DriftStatusSummary; s != nil && s.Types != nil && s.Types.Inheritance != nil {
+		return string(s.Types.Inheritance.Status)
+	}
+	return ""
+}
+
+// IsDrifted reports whether the target no longer matches the baseline of
+// its OU, e.g. after an account was moved
+func (r *EnrollmentResource) IsDrifted() bool {
+	return r.DriftStatus() == string(types.EnabledBaselineDriftStatusDrifted)
+}
+
+// NeedsReset reports whether the baseline failed to deploy or has drifted,
+// so that resetting it would re-register the OU or repair the enrollment
+func (r *EnrollmentResource) NeedsReset() bool {
+	return r.IsDrifted() || ctClient.Enablement(r.Item.StatusSummary) == types.EnablementStatusFailed
+}
@@ -0,0 +1,20 @@
+package enrollments
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("controltower", "enrollments", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewEnrollmentDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewEnrollmentRenderer()
+		},
+	})
+}
@@ -0,0 +1,169 @@
+package enrollments
+
+import (
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Navigator = (*EnrollmentRenderer)(nil)
+
+// EnrollmentRenderer renders Control Tower OU registrations and account
+// enrollments
+type EnrollmentRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEnrollmentRenderer creates a new EnrollmentRenderer
+func NewEnrollmentRenderer() render.Renderer {
+	return &EnrollmentRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "controltower",
+			Resource: "enrollments",
+			Cols: []render.Column{
+				{Name: "TARGET", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "KIND", Width: 8, Getter: getKind, Priority: 0},
+				{Name: "STATE", Width: 20, Getter: getState, Priority: 0},
+				{Name: "DRIFT", Width: 9, Getter: getDrift, Priority: 0},
+				{Name: "BASELINE", Width: 28, Getter: getBaseline, Priority: 1},
+				{Name: "VERSION", Width: 8, Getter: getVersion, Priority: 2},
+			},
+		},
+	}
+}
+
+func getKind(r dao.Resource) string {
+	if e, ok := r.(*EnrollmentResource); ok {
+		return e.Kind()
+	}
+	return ""
+}
+
+func getState(r dao.Resource) string {
+	if e, ok := r.(*EnrollmentResource); ok {
+		return e.State()
+	}
+	return ""
+}
+
+func getDrift(r dao.Resource) string {
+	if e, ok := r.(*EnrollmentResource); ok && e.DriftStatus() != "" {
+		return e.DriftStatus()
+	}
+	return "-"
+}
+
+func getBaseline(r dao.Resource) string {
+	if e, ok := r.(*EnrollmentResource); ok {
+		return e.Baseline()
+	}
+	return ""
+}
+
+func getVersion(r dao.Resource) string {
+	if e, ok := r.(*EnrollmentResource); ok && e.Item.BaselineVersion != nil {
+		return *e.Item.BaselineVersion
+	}
+	return "-"
+}
+
+// statusStyle colors a deployment status
+func statusStyle(status string) lipgloss.Style {
+	switch status {
+	case "SUCCEEDED":
+		return ui.SuccessStyle()
+	case "FAILED":
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+// RenderDetail renders the enrollment with its drift and parameters
+func (r *EnrollmentRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*EnrollmentResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Enrollment", e.GetName())
+
+	d.Section(e.Kind())
+	d.Field("Name", e.Target())
+	d.Field("ARN", e.TargetARN())
+	d.FieldStyled("State", e.State(), statusStyle(e.Status()))
+	if drift := e.DriftStatus(); drift != "" {
+		if e.IsDrifted() {
+			d.FieldStyled("Drift", drift, ui.DangerStyle())
+		} else {
+			d.FieldStyled("Drift", drift, ui.SuccessStyle())
+		}
+	}
+	if e.NeedsReset() {
+		if e.IsAccount() {
+			d.Line(ui.WarningStyle().Render("⚠ Reset the baseline to repair the account's enrollment"))
+		} else {
+			d.Line(ui.WarningStyle().Render("⚠ Reset the baseline to re-register the OU"))
+		}
+	}
+
+	d.Section("Baseline")
+	d.Field("Baseline", e.Baseline())
+	d.FieldIf("Version", e.Item.BaselineVersion)
+	d.Field("Enabled Baseline ARN", e.GetARN())
+	d.FieldIf("Inherited From", e.Item.ParentIdentifier)
+
+	if e.Detail != nil && len(e.Detail.Parameters) > 0 {
+		d.Section("Parameters")
+		for _, p := range e.Detail.Parameters {
+			var value string
+			if p.Value != nil {
+				if raw, err := p.Value.MarshalSmithyDocument(); err == nil {
+					value = string(raw)
+				}
+			}
+			d.Field(appaws.Str(p.Key), value)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *EnrollmentRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*EnrollmentResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: e.Kind(), Value: e.Target()},
+		{Label: "State", Value: e.State(), Style: statusStyle(e.Status())},
+		{Label: "Baseline", Value: e.Baseline()},
+	}
+	if drift := e.DriftStatus(); drift != "" {
+		fields = append(fields, render.SummaryField{Label: "Drift", Value: drift})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *EnrollmentRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	e, ok := resource.(*EnrollmentResource)
+	if !ok || e.IsAccount() {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "c", Label: "Controls", Service: "controltower", Resource: "controls",
+			FilterField: "TargetIdentifier", FilterValue: e.TargetARN(),
+		},
+		{
+			Key: "e", Label: "Accounts", Service: "controltower", Resource: "enrollments",
+			FilterField: "TargetIdentifier", FilterValue: e.TargetARN(),
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package enrollments
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+)
+
+const (
+	ouARN      = "arn:aws:organizations::111111111111:ou/o-abc/ou-prod"
+	accountARN = "arn:aws:organizations::111111111111:account/o-abc/222222222222"
+)
+
+func TestEnrollmentState(t *testing.T) {
+	ou := NewEnrollmentResource(types.EnabledBaselineSummary{
+		Arn:              aws.String("arn:aws:controltower:us-east-1:111111111111:enabledbaseline/OU1"),
+		TargetIdentifier: aws.String(ouARN),
+		StatusSummary:    &types.EnablementStatusSummary{Status: types.EnablementStatusSucceeded},
+	})
+	account := NewEnrollmentResource(types.EnabledBaselineSummary{
+		Arn:              aws.String("arn:aws:controltower:us-east-1:111111111111:enabledbaseline/ACCT1"),
+		TargetIdentifier: aws.String(accountARN),
+		ParentIdentifier: ou.Item.Arn,
+		StatusSummary:    &types.EnablementStatusSummary{Status: types.EnablementStatusFailed},
+		DriftStatusSummary: &types.EnabledBaselineDriftStatusSummary{Types: &types.EnabledBaselineDriftTypes{
+			Inheritance: &types.EnabledBaselineInheritanceDrift{Status: types.EnabledBaselineDriftStatusDrifted},
+		}},
+	})
+
+	if ou.Kind() != "OU" || ou.State() != "Registered" || ou.NeedsReset() {
+		t.Errorf("OU kind=%s state=%s needsReset=%v", ou.Kind(), ou.State(), ou.NeedsReset())
+	}
+	if account.Kind() != "Account" || account.State() != "Enrollment failed" || !account.IsDrifted() || !account.NeedsReset() {
+		t.Errorf("account kind=%s state=%s drifted=%v", account.Kind(), account.State(), account.IsDrifted())
+	}
+
+	all := []types.EnabledBaselineSummary{ou.Item, account.Item}
+	if !account.InOU(ouARN, all) || account.InOU("arn:aws:organizations::111111111111:ou/o-abc/ou-dev", all) || ou.InOU(ouARN, all) {
+		t.Error("InOU should match only the account enrolled through the OU")
+	}
+
+	if account.GetName() != "222222222222" {
+		t.Errorf("name = %q, want the account ID", account.GetName())
+	}
+	account.SetTargetName("shop")
+	if account.GetName() != "shop" || account.Target() != "shop" {
+		t.Errorf("name = %q, want shop", account.GetName())
+	}
+}
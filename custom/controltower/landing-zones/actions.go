@@ -0,0 +1,61 @@
+package landingzones
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+
+	ctClient "github.com/clawscli/claws/custom/controltower"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Control Tower landing zones
+	action.Global.Register("controltower", "landing-zones", []action.Action{
+		{
+			Name:      "Reset",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "ResetLandingZone",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				lz, ok := r.(*LandingZoneResource)
+				return ok && lz.Status() != "PROCESSING"
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("controltower", "landing-zones", executeLandingZoneAction)
+}
+
+// executeLandingZoneAction executes an action on a Control Tower landing zone.
+func executeLandingZoneAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	lz, ok := resource.(*LandingZoneResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "ResetLandingZone":
+		client, err := ctClient.GetClient(ctx)
+		if err != nil {
+			return action.ActionResult{Success: false, Error: err}
+		}
+		arn := lz.GetARN()
+		output, err := client.ResetLandingZone(ctx, &controltower.ResetLandingZoneInput{LandingZoneIdentifier: &arn})
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("reset landing zone: %w", err)}
+		}
+		return action.ActionResult{
+			Success: true,
+			Message: fmt.Sprintf("Resetting landing zone %s (operation %s)", lz.GetName(), appaws.Str(output.OperationIdentifier)),
+		}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
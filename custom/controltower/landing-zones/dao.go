@@ -0,0 +1,157 @@
+package landingzones
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/controltower"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+
+	ctClient "github.com/clawscli/claws/custom/controltower"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// LandingZoneDAO provides data access for Control Tower landing zones
+type LandingZoneDAO struct {
+	dao.BaseDAO
+	client *controltower.Client
+}
+
+// NewLandingZoneDAO creates a new LandingZoneDAO
+func NewLandingZoneDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := ctClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &LandingZoneDAO{
+		BaseDAO: dao.NewBaseDAO("controltower", "landing-zones"),
+		client:  client,
+	}, nil
+}
+
+// List returns the landing zone with its version, status and drift. An
+// account has at most one landing zone, in Control Tower's home region.
+func (d *LandingZoneDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	summaries, err := appaws.Paginate(ctx, func(token *string) ([]types.LandingZoneSummary, *string, error) {
+		output, err := d.client.ListLandingZones(ctx, &controltower.ListLandingZonesInput{NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list landing zones")
+		}
+		return output.LandingZones, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(summaries))
+	for _, s := range summaries {
+		r, err := d.Get(ctx, appaws.Str(s.Arn))
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// Get returns a landing zone by ARN
+func (d *LandingZoneDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetLandingZone(ctx, &controltower.GetLandingZoneInput{LandingZoneIdentifier: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get landing zone %s", id)
+	}
+	if output.LandingZone == nil {
+		return nil, fmt.Errorf("landing zone not found: %s", id)
+	}
+	return NewLandingZoneResource(*output.LandingZone), nil
+}
+
+// Delete is not supported for landing zones
+func (d *LandingZoneDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for landing zones")
+}
+
+// Manifest is the part of a landing zone manifest claws shows
+type Manifest struct {
+	GovernedRegions       []string `json:"governedRegions"`
+	OrganizationStructure map[string]struct {
+		Name string `json:"name"`
+	} `json:"organizationStructure"`
+	CentralizedLogging struct {
+		AccountID string `json:"accountId"`
+		Enabled   bool   `json:"enabled"`
+	} `json:"centralizedLogging"`
+	SecurityRoles struct {
+		AccountID string `json:"accountId"`
+	} `json:"securityRoles"`
+	AccessManagement struct {
+		Enabled bool `json:"enabled"`
+	} `json:"accessManagement"`
+}
+
+// LandingZoneResource represents a Control Tower landing zone
+type LandingZoneResource struct {
+	dao.BaseResource
+	Item     types.LandingZoneDetail
+	Manifest Manifest
+}
+
+// NewLandingZoneResource creates a new LandingZoneResource
+func NewLandingZoneResource(lz types.LandingZoneDetail) *LandingZoneResource {
+	arn := appaws.Str(lz.Arn)
+	r := &LandingZoneResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn,
+			Name: ctClient.ARNResourceID(arn),
+			ARN:  arn,
+			Data: lz,
+		},
+		Item: lz,
+	}
+	if lz.Manifest != nil {
+		if raw, err := lz.Manifest.MarshalSmithyDocument(); err != nil {
+			log.Warn("failed to read landing zone manifest", "arn", arn, "error", err)
+		} else if err := json.Unmarshal(raw, &r.Manifest); err != nil {
+			log.Warn("failed to parse landing zone manifest", "arn", arn, "error", err)
+		}
+	}
+	return r
+}
+
+// Version returns the deployed landing zone version
+func (r *LandingZoneResource) Version() string {
+	return appaws.Str(r.Item.Version)
+}
+
+// LatestVersion returns the latest available landing zone version
+func (r *LandingZoneResource) LatestVersion() string {
+	return appaws.Str(r.Item.LatestAvailableVersion)
+}
+
+// UpdateAvailable reports whether a newer landing zone version is available
+func (r *LandingZoneResource) UpdateAvailable() bool {
+	return r.LatestVersion() != "" && r.LatestVersion() != r.Version()
+}
+
+// Status returns the deployment status: ACTIVE, PROCESSING or FAILED
+func (r *LandingZoneResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// DriftStatus returns DRIFTED or IN_SYNC
+func (r *LandingZoneResource) DriftStatus() string {
+	if r.Item.DriftStatus == nil {
+		return ""
+	}
+	return string(r.Item.DriftStatus.Status)
+}
+
+// IsDrifted reports whether the landing zone no longer matches the
+// configuration Control Tower deployed
+func (r *LandingZoneResource) IsDrifted() bool {
+	return r.Item.DriftStatus != nil && r.Item.DriftStatus.Status == types.LandingZoneDriftStatusDrifted
+}
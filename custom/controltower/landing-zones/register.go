@@ -0,0 +1,20 @@
+package landingzones
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("controltower", "landing-zones", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewLandingZoneDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewLandingZoneRenderer()
+		},
+	})
+}
@@ -0,0 +1,195 @@
+package landingzones
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Navigator = (*LandingZoneRenderer)(nil)
+
+// LandingZoneRenderer renders Control Tower landing zones
+type LandingZoneRenderer struct {
+	render.BaseRenderer
+}
+
+// NewLandingZoneRenderer creates a new LandingZoneRenderer
+func NewLandingZoneRenderer() render.Renderer {
+	return &LandingZoneRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "controltower",
+			Resource: "landing-zones",
+			Cols: []render.Column{
+				{Name: "ID", Width: 20, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "VERSION", Width: 9, Getter: getVersion, Priority: 0},
+				{Name: "LATEST", Width: 8, Getter: getLatest, Priority: 1},
+				{Name: "STATUS", Width: 11, Getter: getStatus, Priority: 0},
+				{Name: "DRIFT", Width: 9, Getter: getDrift, Priority: 0},
+				{Name: "REGIONS", Width: 8, Getter: getRegions, Priority: 2},
+			},
+		},
+	}
+}
+
+func getVersion(r dao.Resource) string {
+	if lz, ok := r.(*LandingZoneResource); ok {
+		return lz.Version()
+	}
+	return ""
+}
+
+func getLatest(r dao.Resource) string {
+	if lz, ok := r.(*LandingZoneResource); ok && lz.LatestVersion() != "" {
+		return lz.LatestVersion()
+	}
+	return "-"
+}
+
+func getStatus(r dao.Resource) string {
+	if lz, ok := r.(*LandingZoneResource); ok {
+		return lz.Status()
+	}
+	return ""
+}
+
+func getDrift(r dao.Resource) string {
+	if lz, ok := r.(*LandingZoneResource); ok && lz.DriftStatus() != "" {
+		return lz.DriftStatus()
+	}
+	return "-"
+}
+
+func getRegions(r dao.Resource) string {
+	if lz, ok := r.(*LandingZoneResource); ok {
+		return fmt.Sprintf("%d", len(lz.Manifest.GovernedRegions))
+	}
+	return ""
+}
+
+// statusStyle colors a landing zone deployment status
+func statusStyle(status string) lipgloss.Style {
+	switch status {
+	case "ACTIVE":
+		return ui.SuccessStyle()
+	case "FAILED":
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+// driftStyle colors a drift status
+func driftStyle(drifted bool) lipgloss.Style {
+	if drifted {
+		return ui.DangerStyle()
+	}
+	return ui.SuccessStyle()
+}
+
+// RenderDetail renders the landing zone with its manifest
+func (r *LandingZoneRenderer) RenderDetail(resource dao.Resource) string {
+	lz, ok := resource.(*LandingZoneResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Landing Zone", lz.GetName())
+
+	d.Section("Landing Zone")
+	d.Field("Version", lz.Version())
+	if lz.UpdateAvailable() {
+		d.FieldStyled("Latest Version", lz.LatestVersion()+" (update available)", ui.WarningStyle())
+	} else if lz.LatestVersion() != "" {
+		d.Field("Latest Version", lz.LatestVersion())
+	}
+	d.FieldStyled("Status", lz.Status(), statusStyle(lz.Status()))
+	if drift := lz.DriftStatus(); drift != "" {
+		d.FieldStyled("Drift", drift, driftStyle(lz.IsDrifted()))
+	}
+	if len(lz.Item.RemediationTypes) > 0 {
+		types := make([]string, len(lz.Item.RemediationTypes))
+		for i, t := range lz.Item.RemediationTypes {
+			types[i] = string(t)
+		}
+		d.Field("Auto Remediation", strings.Join(types, ", "))
+	}
+	d.Field("ARN", lz.GetARN())
+	if lz.IsDrifted() {
+		d.Line(ui.WarningStyle().Render("⚠ Resources deployed by Control Tower were changed outside it; reset the landing zone to repair them"))
+	}
+
+	m := lz.Manifest
+	if len(m.GovernedRegions) > 0 {
+		d.Section("Governed Regions")
+		d.Line(strings.Join(m.GovernedRegions, ", "))
+	}
+
+	if len(m.OrganizationStructure) > 0 {
+		d.Section("Organization Structure")
+		keys := make([]string, 0, len(m.OrganizationStructure))
+		for k := range m.OrganizationStructure {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			d.Field(k, m.OrganizationStructure[k].Name)
+		}
+	}
+
+	if m.CentralizedLogging.AccountID != "" || m.SecurityRoles.AccountID != "" {
+		d.Section("Shared Accounts")
+		if m.CentralizedLogging.AccountID != "" {
+			d.Field("Log Archive", m.CentralizedLogging.AccountID)
+		}
+		if m.SecurityRoles.AccountID != "" {
+			d.Field("Audit", m.SecurityRoles.AccountID)
+		}
+		if m.CentralizedLogging.AccountID != "" {
+			d.Field("Centralized Logging", enabled(m.CentralizedLogging.Enabled))
+		}
+		d.Field("IAM Identity Center", enabled(m.AccessManagement.Enabled))
+	}
+
+	return d.String()
+}
+
+func enabled(b bool) string {
+	if b {
+		return "Enabled"
+	}
+	return "Disabled"
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *LandingZoneRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	lz, ok := resource.(*LandingZoneResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "Version", Value: lz.Version()},
+		{Label: "Status", Value: lz.Status(), Style: statusStyle(lz.Status())},
+	}
+	if drift := lz.DriftStatus(); drift != "" {
+		fields = append(fields, render.SummaryField{Label: "Drift", Value: drift, Style: driftStyle(lz.IsDrifted())})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *LandingZoneRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	if _, ok := resource.(*LandingZoneResource); !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "c", Label: "Controls", Service: "controltower", Resource: "controls"},
+		{Key: "e", Label: "Enrollments", Service: "controltower", Resource: "enrollments"},
+	}
+}
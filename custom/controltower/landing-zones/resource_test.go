@@ -0,0 +1,41 @@
+package landingzones
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/document"
+	"github.com/aws/aws-sdk-go-v2/service/controltower/types"
+)
+
+func TestNewLandingZoneResource(t *testing.T) {
+	lz := NewLandingZoneResource(types.LandingZoneDetail{
+		Arn:                    aws.String("arn:aws:controltower:us-east-1:111111111111:landingzone/1A2B3C4D"),
+		Version:                aws.String("3.2"),
+		LatestAvailableVersion: aws.String("3.3"),
+		Status:                 types.LandingZoneStatusActive,
+		DriftStatus:            &types.LandingZoneDriftStatusSummary{Status: types.LandingZoneDriftStatusDrifted},
+		Manifest: document.NewLazyDocument(map[string]any{
+			"governedRegions": []string{"us-east-1", "eu-west-1"},
+			"organizationStructure": map[string]any{
+				"security": map[string]any{"name": "Security"},
+			},
+			"centralizedLogging": map[string]any{"accountId": "222222222222", "enabled": true},
+			"securityRoles":      map[string]any{"accountId": "333333333333"},
+		}),
+	})
+
+	if lz.GetName() != "1A2B3C4D" {
+		t.Errorf("name = %q", lz.GetName())
+	}
+	if !lz.UpdateAvailable() || !lz.IsDrifted() {
+		t.Errorf("updateAvailable=%v drifted=%v, want both", lz.UpdateAvailable(), lz.IsDrifted())
+	}
+	m := lz.Manifest
+	if len(m.GovernedRegions) != 2 || m.OrganizationStructure["security"].Name != "Security" {
+		t.Errorf("manifest = %+v", m)
+	}
+	if m.CentralizedLogging.AccountID != "222222222222" || !m.CentralizedLogging.Enabled || m.SecurityRoles.AccountID != "333333333333" {
+		t.Errorf("shared accounts = %+v %+v", m.CentralizedLogging, m.SecurityRoles)
+	}
+}
@@ -21,13 +21,13 @@ type LocationDAO struct {
 
 // NewLocationDAO creates a new LocationDAO.
 func NewLocationDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, datasync.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &LocationDAO{
 		BaseDAO: dao.NewBaseDAO("datasync", "locations"),
-		client:  datasync.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
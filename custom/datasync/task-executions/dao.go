@@ -22,13 +22,13 @@ type TaskExecutionDAO struct {
 
 // NewTaskExecutionDAO creates a new TaskExecutionDAO.
 func NewTaskExecutionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, datasync.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TaskExecutionDAO{
 		BaseDAO: dao.NewBaseDAO("datasync", "task-executions"),
-		client:  datasync.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -55,6 +55,14 @@ func (d *TaskExecutionDAO) List(ctx context.Context) ([]dao.Resource, error) {
 
 	resources := make([]dao.Resource, len(executions))
 	for i, exec := range executions {
+		// Only running executions are described, for their live progress;
+		// finished ones are described when opened
+		if isActive(exec.Status) {
+			if r, err := d.describe(ctx, appaws.Str(exec.TaskExecutionArn)); err == nil {
+				resources[i] = r
+				continue
+			}
+		}
 		resources[i] = NewTaskExecutionResource(exec)
 	}
 	return resources, nil
@@ -63,6 +71,10 @@ func (d *TaskExecutionDAO) List(ctx context.Context) ([]dao.Resource, error) {
 // Get returns a specific task execution.
 func (d *TaskExecutionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	// id is the execution ARN
+	return d.describe(ctx, id)
+}
+
+func (d *TaskExecutionDAO) describe(ctx context.Context, id string) (*TaskExecutionResource, error) {
 	output, err := d.client.DescribeTaskExecution(ctx, &datasync.DescribeTaskExecutionInput{
 		TaskExecutionArn: &id,
 	})
@@ -78,6 +90,7 @@ func (d *TaskExecutionDAO) Get(ctx context.Context, id string) (dao.Resource, er
 		Execution: &types.TaskExecutionListEntry{
 			TaskExecutionArn: output.TaskExecutionArn,
 			Status:           output.Status,
+			TaskMode:         output.TaskMode,
 		},
 		BytesWritten:     output.BytesWritten,
 		BytesTransferred: output.BytesTransferred,
@@ -134,6 +147,16 @@ func NewTaskExecutionResource(exec types.TaskExecutionListEntry) *TaskExecutionR
 	}
 }
 
+// isActive returns true for executions that have not finished.
+func isActive(status types.TaskExecutionStatus) bool {
+	switch status {
+	case types.TaskExecutionStatusSuccess, types.TaskExecutionStatusError, "":
+		return false
+	default:
+		return true
+	}
+}
+
 // extractExecutionID extracts the execution ID from an ARN.
 func extractExecutionID(arn string) string {
 	// Format: arn:aws:datasync:region:account:task/task-xxx/execution/exec-xxx
@@ -180,3 +203,37 @@ func (r *TaskExecutionResource) GetBytesCompressed() int64 {
 func (r *TaskExecutionResource) GetResult() *types.TaskExecutionResultDetail {
 	return r.Result
 }
+
+// IsActive returns true while the execution is queued, running or cancelling.
+func (r *TaskExecutionResource) IsActive() bool {
+	return r.Execution != nil && isActive(r.Execution.Status)
+}
+
+// Progress returns the fraction of the estimated transfer completed, by
+// bytes or, when no bytes were estimated, by files. ok is false until
+// DataSync has prepared an estimate.
+func (r *TaskExecutionResource) Progress() (fraction float64, ok bool) {
+	switch {
+	case r.EstimatedBytes > 0:
+		return min(float64(r.BytesTransferred)/float64(r.EstimatedBytes), 1), true
+	case r.EstimatedFiles > 0:
+		return min(float64(r.FilesTransferred)/float64(r.EstimatedFiles), 1), true
+	default:
+		return 0, false
+	}
+}
+
+// Throughput returns the average transfer rate in bytes per second: over
+// the transfer phase once finished, or since the start while running.
+func (r *TaskExecutionResource) Throughput() float64 {
+	var elapsed time.Duration
+	if d := r.Result; d != nil && d.TransferDuration != nil && !r.IsActive() {
+		elapsed = time.Duration(*d.TransferDuration) * time.Millisecond
+	} else if r.StartTime != nil {
+		elapsed = time.Since(*r.StartTime)
+	}
+	if elapsed <= 0 || r.BytesTransferred == 0 {
+		return 0
+	}
+	return float64(r.BytesTransferred) / elapsed.Seconds()
+}
@@ -0,0 +1,42 @@
+package taskexecutions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datasync/types"
+)
+
+func TestTaskExecutionProgress(t *testing.T) {
+	exec := NewTaskExecutionResource(types.TaskExecutionListEntry{
+		TaskExecutionArn: aws.String("arn:aws:datasync:us-east-1:111122223333:task/task-1/execution/exec-1"),
+		Status:           types.TaskExecutionStatusTransferring,
+	})
+	if exec.GetID() != "exec-1" || !exec.IsActive() {
+		t.Errorf("id = %q, active = %v", exec.GetID(), exec.IsActive())
+	}
+	if _, ok := exec.Progress(); ok {
+		t.Error("progress should be unknown before DataSync estimates the transfer")
+	}
+
+	exec.EstimatedFiles, exec.FilesTransferred = 200, 50
+	if f, ok := exec.Progress(); !ok || f != 0.25 {
+		t.Errorf("progress by files = %v, %v", f, ok)
+	}
+	exec.EstimatedBytes, exec.BytesTransferred = 1000, 750
+	if f, _ := exec.Progress(); f != 0.75 {
+		t.Errorf("progress by bytes = %v", f)
+	}
+	if got := progressBar(0.75); got != "███████████████░░░░░  75%" {
+		t.Errorf("progressBar(0.75) = %q", got)
+	}
+
+	// Finished executions report throughput over the transfer phase
+	exec.Execution.Status = types.TaskExecutionStatusSuccess
+	exec.StartTime = aws.Time(time.Now().Add(-time.Hour))
+	exec.Result = &types.TaskExecutionResultDetail{TransferDuration: aws.Int64(5000)}
+	if got := exec.Throughput(); got != 150 {
+		t.Errorf("Throughput() = %v, want 150 B/s", got)
+	}
+}
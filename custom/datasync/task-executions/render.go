@@ -2,6 +2,7 @@ package taskexecutions
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -21,6 +22,11 @@ func NewTaskExecutionRenderer() render.Renderer {
 			Cols: []render.Column{
 				{Name: "EXECUTION ID", Width: 35, Getter: func(r dao.Resource) string { return r.GetID() }},
 				{Name: "STATUS", Width: 15, Getter: getStatus},
+				{Name: "PROGRESS", Width: progressBarWidth + 6, Getter: getProgress},
+				{Name: "TRANSFERRED", Width: 22, Getter: getTransferred},
+				{Name: "FILES", Width: 15, Getter: getFiles},
+				{Name: "THROUGHPUT", Width: 12, Getter: getThroughput},
+				{Name: "STARTED", Width: 17, Getter: getStarted},
 			},
 		},
 	}
@@ -34,6 +40,76 @@ func getStatus(r dao.Resource) string {
 	return exec.Status()
 }
 
+// progressBarWidth is the width of the progress bar at 100%.
+const progressBarWidth = 20
+
+// progressBar draws a bar filled to fraction, followed by its percentage
+func progressBar(fraction float64) string {
+	n := int(fraction*progressBarWidth + 0.5)
+	return strings.Repeat("█", n) + strings.Repeat("░", progressBarWidth-n) + fmt.Sprintf(" %3.0f%%", fraction*100)
+}
+
+func getProgress(r dao.Resource) string {
+	exec, ok := r.(*TaskExecutionResource)
+	if !ok {
+		return ""
+	}
+	fraction, ok := exec.Progress()
+	if !ok {
+		return ""
+	}
+	return progressBar(fraction)
+}
+
+func getTransferred(r dao.Resource) string {
+	exec, ok := r.(*TaskExecutionResource)
+	if !ok || (exec.BytesTransferred == 0 && exec.EstimatedBytes == 0) {
+		return ""
+	}
+	if exec.EstimatedBytes > 0 {
+		return render.FormatSize(exec.BytesTransferred) + " / " + render.FormatSize(exec.EstimatedBytes)
+	}
+	return render.FormatSize(exec.BytesTransferred)
+}
+
+func getFiles(r dao.Resource) string {
+	exec, ok := r.(*TaskExecutionResource)
+	if !ok || (exec.FilesTransferred == 0 && exec.EstimatedFiles == 0) {
+		return ""
+	}
+	if exec.EstimatedFiles > 0 {
+		return fmt.Sprintf("%d / %d", exec.FilesTransferred, exec.EstimatedFiles)
+	}
+	return fmt.Sprintf("%d", exec.FilesTransferred)
+}
+
+func getThroughput(r dao.Resource) string {
+	exec, ok := r.(*TaskExecutionResource)
+	if !ok {
+		return ""
+	}
+	return formatThroughput(exec.Throughput())
+}
+
+func getStarted(r dao.Resource) string {
+	exec, ok := r.(*TaskExecutionResource)
+	if !ok {
+		return ""
+	}
+	if t := exec.GetStartTime(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// formatThroughput formats a rate in bytes per second, or "" when unknown
+func formatThroughput(bytesPerSecond float64) string {
+	if bytesPerSecond <= 0 {
+		return ""
+	}
+	return render.FormatSize(int64(bytesPerSecond)) + "/s"
+}
+
 // RenderDetail renders the detail view for a task execution.
 func (r *TaskExecutionRenderer) RenderDetail(resource dao.Resource) string {
 	exec, ok := resource.(*TaskExecutionResource)
@@ -57,6 +133,21 @@ func (r *TaskExecutionRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Started", t.Format("2006-01-02 15:04:05"))
 	}
 
+	// Progress
+	if fraction, ok := exec.Progress(); ok {
+		d.Section("Progress")
+		d.Field("Progress", progressBar(fraction))
+		if v := getTransferred(exec); v != "" {
+			d.Field("Transferred", v)
+		}
+		if v := getFiles(exec); v != "" {
+			d.Field("Files", v)
+		}
+		if v := formatThroughput(exec.Throughput()); v != "" {
+			d.Field("Throughput", v)
+		}
+	}
+
 	// File Statistics
 	d.Section("File Statistics")
 	if exec.FilesTransferred > 0 {
@@ -123,8 +214,26 @@ func (r *TaskExecutionRenderer) RenderSummary(resource dao.Resource) []render.Su
 		return r.BaseRenderer.RenderSummary(resource)
 	}
 
-	return []render.SummaryField{
+	fields := []render.SummaryField{
 		{Label: "Execution ID", Value: exec.GetID()},
 		{Label: "Status", Value: exec.Status()},
 	}
+	if fraction, ok := exec.Progress(); ok {
+		fields = append(fields, render.SummaryField{Label: "Progress", Value: progressBar(fraction)})
+	}
+	if v := formatThroughput(exec.Throughput()); v != "" {
+		fields = append(fields, render.SummaryField{Label: "Throughput", Value: v})
+	}
+	return fields
+}
+
+// NeedsAutoReload keeps the list refreshing while an execution is running,
+// so its progress stays live
+func (r *TaskExecutionRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if exec, ok := dao.UnwrapResource(res).(*TaskExecutionResource); ok && exec.IsActive() {
+			return true
+		}
+	}
+	return false
 }
@@ -22,13 +22,13 @@ type TaskDAO struct {
 
 // NewTaskDAO creates a new TaskDAO.
 func NewTaskDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, datasync.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TaskDAO{
 		BaseDAO: dao.NewBaseDAO("datasync", "tasks"),
-		client:  datasync.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type GraphDAO struct {
 
 // NewGraphDAO creates a new GraphDAO.
 func NewGraphDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, detective.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &GraphDAO{
 		BaseDAO: dao.NewBaseDAO("detective", "graphs"),
-		client:  detective.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
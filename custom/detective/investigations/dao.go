@@ -21,13 +21,13 @@ type InvestigationDAO struct {
 
 // NewInvestigationDAO creates a new InvestigationDAO.
 func NewInvestigationDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, detective.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &InvestigationDAO{
 		BaseDAO: dao.NewBaseDAO("detective", "investigations"),
-		client:  detective.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
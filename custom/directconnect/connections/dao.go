@@ -20,13 +20,13 @@ type ConnectionDAO struct {
 
 // NewConnectionDAO creates a new ConnectionDAO.
 func NewConnectionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, directconnect.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ConnectionDAO{
 		BaseDAO: dao.NewBaseDAO("directconnect", "connections"),
-		client:  directconnect.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type VirtualInterfaceDAO struct {
 
 // NewVirtualInterfaceDAO creates a new VirtualInterfaceDAO.
 func NewVirtualInterfaceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, directconnect.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &VirtualInterfaceDAO{
 		BaseDAO: dao.NewBaseDAO("directconnect", "virtual-interfaces"),
-		client:  directconnect.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
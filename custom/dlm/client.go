@@ -0,0 +1,14 @@
+package dlm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/dlm"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns a Data Lifecycle Manager client configured for the current context
+func GetClient(ctx context.Context) (*dlm.Client, error) {
+	return appaws.Client(ctx, dlm.NewFromConfig)
+}
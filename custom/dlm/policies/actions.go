@@ -0,0 +1,101 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dlm"
+	"github.com/aws/aws-sdk-go-v2/service/dlm/types"
+
+	appdlm "github.com/clawscli/claws/custom/dlm"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("dlm", "policies", []action.Action{
+		{
+			Name:      "Enable",
+			Shortcut:  "E",
+			Type:      action.ActionTypeAPI,
+			Operation: "EnablePolicy",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				p, ok := dao.UnwrapResource(r).(*PolicyResource)
+				return ok && p.State() == string(types.GettablePolicyStateValuesDisabled)
+			},
+		},
+		{
+			Name:      "Disable",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "DisablePolicy",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				p, ok := dao.UnwrapResource(r).(*PolicyResource)
+				return ok && p.State() == string(types.GettablePolicyStateValuesEnabled)
+			},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteLifecyclePolicy",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("dlm", "policies", executePolicyAction)
+}
+
+func executePolicyAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "EnablePolicy":
+		return executeSetPolicyState(ctx, resource, types.SettablePolicyStateValuesEnabled)
+	case "DisablePolicy":
+		return executeSetPolicyState(ctx, resource, types.SettablePolicyStateValuesDisabled)
+	case "DeleteLifecyclePolicy":
+		return executeDeletePolicy(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeSetPolicyState(ctx context.Context, resource dao.Resource, state types.SettablePolicyStateValues) action.ActionResult {
+	client, err := appdlm.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	policyID := resource.GetID()
+	_, err = client.UpdateLifecyclePolicy(ctx, &dlm.UpdateLifecyclePolicyInput{
+		PolicyId: &policyID,
+		State:    state,
+	})
+	if err != nil {
+		return action.FailResultf(err, "update lifecycle policy %s", policyID)
+	}
+
+	verb := "Enabled"
+	if state == types.SettablePolicyStateValuesDisabled {
+		verb = "Disabled"
+	}
+	return action.SuccessResult(fmt.Sprintf("%s lifecycle policy %s", verb, policyID))
+}
+
+func executeDeletePolicy(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := appdlm.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	policyID := resource.GetID()
+	_, err = client.DeleteLifecyclePolicy(ctx, &dlm.DeleteLifecyclePolicyInput{
+		PolicyId: &policyID,
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete lifecycle policy %s", policyID)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deleted lifecycle policy %s (existing snapshots are kept)", policyID))
+}
@@ -0,0 +1,247 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dlm"
+	"github.com/aws/aws-sdk-go-v2/service/dlm/types"
+
+	appdlm "github.com/clawscli/claws/custom/dlm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// PolicyDAO provides data access for Data Lifecycle Manager policies
+type PolicyDAO struct {
+	dao.BaseDAO
+	client *dlm.Client
+}
+
+// NewPolicyDAO creates a new PolicyDAO
+func NewPolicyDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, dlm.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PolicyDAO{
+		BaseDAO: dao.NewBaseDAO("dlm", "policies"),
+		client:  client,
+	}, nil
+}
+
+// List returns lifecycle policies. The summary from GetLifecyclePolicies has
+// no schedules, so each policy is fetched individually to show its retention.
+// Supported filters:
+//   - PolicyId: a single policy (for navigation from a snapshot)
+func (d *PolicyDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &dlm.GetLifecyclePoliciesInput{}
+	if policyID := dao.GetFilterFromContext(ctx, "PolicyId"); policyID != "" {
+		input.PolicyIds = []string{policyID}
+	}
+
+	output, err := d.client.GetLifecyclePolicies(ctx, input)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return []dao.Resource{}, nil
+		}
+		return nil, apperrors.Wrap(err, "get lifecycle policies")
+	}
+
+	resources := make([]dao.Resource, len(output.Policies))
+	for i, summary := range output.Policies {
+		res := NewPolicyResourceFromSummary(summary)
+		if policy, err := d.getPolicy(ctx, appaws.Str(summary.PolicyId)); err != nil {
+			log.Warn("failed to get lifecycle policy details", "policy", appaws.Str(summary.PolicyId), "error", err)
+		} else {
+			res.Policy = policy
+		}
+		resources[i] = res
+	}
+	return resources, nil
+}
+
+// Get returns a lifecycle policy by ID
+func (d *PolicyDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	policy, err := d.getPolicy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return NewPolicyResource(policy), nil
+}
+
+func (d *PolicyDAO) getPolicy(ctx context.Context, id string) (*types.LifecyclePolicy, error) {
+	output, err := d.client.GetLifecyclePolicy(ctx, &dlm.GetLifecyclePolicyInput{
+		PolicyId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get lifecycle policy %s", id)
+	}
+	if output.Policy == nil {
+		return nil, fmt.Errorf("lifecycle policy not found: %s", id)
+	}
+	return output.Policy, nil
+}
+
+// Delete deletes a lifecycle policy. Snapshots and AMIs it created are kept.
+func (d *PolicyDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteLifecyclePolicy(ctx, &dlm.DeleteLifecyclePolicyInput{
+		PolicyId: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return apperrors.Wrapf(err, "delete lifecycle policy %s", id)
+	}
+	return nil
+}
+
+// PolicyResource wraps a DLM lifecycle policy
+type PolicyResource struct {
+	dao.BaseResource
+	Summary *types.LifecyclePolicySummary
+	Policy  *types.LifecyclePolicy
+}
+
+// NewPolicyResourceFromSummary creates a PolicyResource from a list summary
+func NewPolicyResourceFromSummary(summary types.LifecyclePolicySummary) *PolicyResource {
+	return &PolicyResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(summary.PolicyId),
+			Name: appaws.Str(summary.Description),
+			Tags: summary.Tags,
+			Data: summary,
+		},
+		Summary: &summary,
+	}
+}
+
+// NewPolicyResource creates a PolicyResource from a full policy
+func NewPolicyResource(policy *types.LifecyclePolicy) *PolicyResource {
+	return &PolicyResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(policy.PolicyId),
+			Name: appaws.Str(policy.Description),
+			ARN:  appaws.Str(policy.PolicyArn),
+			Tags: policy.Tags,
+			Data: policy,
+		},
+		Policy: policy,
+	}
+}
+
+// State returns the policy state (ENABLED, DISABLED, ERROR)
+func (r *PolicyResource) State() string {
+	if r.Policy != nil {
+		return string(r.Policy.State)
+	}
+	if r.Summary != nil {
+		return string(r.Summary.State)
+	}
+	return ""
+}
+
+// PolicyType returns the policy type, e.g. EBS_SNAPSHOT_MANAGEMENT
+func (r *PolicyResource) PolicyType() string {
+	if r.Policy != nil && r.Policy.PolicyDetails != nil && r.Policy.PolicyDetails.PolicyType != "" {
+		return string(r.Policy.PolicyDetails.PolicyType)
+	}
+	if r.Summary != nil {
+		return string(r.Summary.PolicyType)
+	}
+	return ""
+}
+
+// IsDefault reports whether this is a default policy for the account
+func (r *PolicyResource) IsDefault() bool {
+	if r.Policy != nil {
+		return appaws.Bool(r.Policy.DefaultPolicy)
+	}
+	return r.Summary != nil && appaws.Bool(r.Summary.DefaultPolicy)
+}
+
+// ResourceTypes returns the targeted resource types, e.g. "VOLUME"
+func (r *PolicyResource) ResourceTypes() string {
+	details := r.details()
+	if details == nil {
+		return ""
+	}
+	names := make([]string, len(details.ResourceTypes))
+	for i, t := range details.ResourceTypes {
+		names[i] = string(t)
+	}
+	return strings.Join(names, ", ")
+}
+
+// TargetTags returns the tags that select resources, as "key=value" pairs
+func (r *PolicyResource) TargetTags() []string {
+	details := r.details()
+	if details == nil {
+		return nil
+	}
+	tags := make([]string, len(details.TargetTags))
+	for i, tag := range details.TargetTags {
+		tags[i] = appaws.Str(tag.Key) + "=" + appaws.Str(tag.Value)
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// Schedules returns the policy schedules, if the details were loaded
+func (r *PolicyResource) Schedules() []types.Schedule {
+	if details := r.details(); details != nil {
+		return details.Schedules
+	}
+	return nil
+}
+
+// ScheduleSummary describes when each schedule runs, joined by "; "
+func (r *PolicyResource) ScheduleSummary() string {
+	return joinSchedules(r.Schedules(), func(s types.Schedule) string {
+		return appdlm.CreateSummary(s.CreateRule)
+	})
+}
+
+// RetentionSummary describes how long each schedule keeps what it creates
+func (r *PolicyResource) RetentionSummary() string {
+	return joinSchedules(r.Schedules(), func(s types.Schedule) string {
+		return appdlm.RetainSummary(s.RetainRule)
+	})
+}
+
+// CopySummary lists the cross-region copy targets of all schedules
+func (r *PolicyResource) CopySummary() string {
+	return joinSchedules(r.Schedules(), func(s types.Schedule) string {
+		return appdlm.CopySummary(s.CrossRegionCopyRules)
+	})
+}
+
+// joinSchedules applies describe to each schedule and joins the distinct,
+// non-empty results.
+func joinSchedules(schedules []types.Schedule, describe func(types.Schedule) string) string {
+	var parts []string
+	for _, s := range schedules {
+		if part := describe(s); part != "" && !slices.Contains(parts, part) {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// ManagesSnapshots reports whether the policy creates EBS snapshots,
+// which are tagged with the policy ID and so can be listed.
+func (r *PolicyResource) ManagesSnapshots() bool {
+	return r.PolicyType() == string(types.PolicyTypeValuesEbsSnapshotManagement)
+}
+
+func (r *PolicyResource) details() *types.PolicyDetails {
+	if r.Policy != nil {
+		return r.Policy.PolicyDetails
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package policies
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dlm/types"
+)
+
+func TestPolicyResourceFromSummary(t *testing.T) {
+	r := NewPolicyResourceFromSummary(types.LifecyclePolicySummary{
+		PolicyId:    aws.String("policy-0123456789abcdef0"),
+		Description: aws.String("daily volume backups"),
+		State:       types.GettablePolicyStateValuesEnabled,
+		PolicyType:  types.PolicyTypeValuesEbsSnapshotManagement,
+	})
+
+	if r.GetID() != "policy-0123456789abcdef0" || r.GetName() != "daily volume backups" {
+		t.Errorf("got id=%q name=%q", r.GetID(), r.GetName())
+	}
+	if r.State() != "ENABLED" {
+		t.Errorf("State() = %q, want ENABLED", r.State())
+	}
+	if !r.ManagesSnapshots() {
+		t.Error("ManagesSnapshots() = false, want true")
+	}
+	if r.RetentionSummary() != "" || r.Schedules() != nil {
+		t.Error("summary-only policy should have no schedules")
+	}
+}
+
+func TestPolicyResourceSummaries(t *testing.T) {
+	r := NewPolicyResource(&types.LifecyclePolicy{
+		PolicyId: aws.String("policy-0123456789abcdef0"),
+		State:    types.GettablePolicyStateValuesDisabled,
+		PolicyDetails: &types.PolicyDetails{
+			PolicyType:    types.PolicyTypeValuesImageManagement,
+			ResourceTypes: []types.ResourceTypeValues{types.ResourceTypeValuesInstance},
+			TargetTags: []types.Tag{
+				{Key: aws.String("env"), Value: aws.String("prod")},
+				{Key: aws.String("backup"), Value: aws.String("true")},
+			},
+			Schedules: []types.Schedule{
+				{
+					CreateRule: &types.CreateRule{Interval: aws.Int32(24), IntervalUnit: types.IntervalUnitValuesHours, Times: []string{"03:00"}},
+					RetainRule: &types.RetainRule{Count: aws.Int32(7)},
+					CrossRegionCopyRules: []types.CrossRegionCopyRule{
+						{TargetRegion: aws.String("us-west-2")},
+					},
+				},
+				{
+					CreateRule: &types.CreateRule{CronExpression: aws.String("cron(0 3 1 * ? *)")},
+					RetainRule: &types.RetainRule{Count: aws.Int32(7)},
+				},
+			},
+		},
+	})
+
+	if got, want := r.ScheduleSummary(), "every 24 hours at 03:00; cron(0 3 1 * ? *)"; got != want {
+		t.Errorf("ScheduleSummary() = %q, want %q", got, want)
+	}
+	if got, want := r.RetentionSummary(), "last 7"; got != want {
+		t.Errorf("RetentionSummary() = %q, want %q (duplicates collapsed)", got, want)
+	}
+	if got, want := r.CopySummary(), "us-west-2"; got != want {
+		t.Errorf("CopySummary() = %q, want %q", got, want)
+	}
+	if got, want := r.TargetTags(), []string{"backup=true", "env=prod"}; !slices.Equal(got, want) {
+		t.Errorf("TargetTags() = %v, want %v", got, want)
+	}
+	if r.ResourceTypes() != "INSTANCE" {
+		t.Errorf("ResourceTypes() = %q, want INSTANCE", r.ResourceTypes())
+	}
+	if r.ManagesSnapshots() {
+		t.Error("ManagesSnapshots() = true for an AMI policy")
+	}
+	if navs := NewPolicyRenderer().(*PolicyRenderer).Navigations(r); len(navs) != 0 {
+		t.Errorf("Navigations() = %+v, want none for AMI policy", navs)
+	}
+}
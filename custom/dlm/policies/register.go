@@ -0,0 +1,20 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("dlm", "policies", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPolicyDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPolicyRenderer()
+		},
+	})
+}
@@ -0,0 +1,213 @@
+package policies
+
+import (
+	"strings"
+
+	appdlm "github.com/clawscli/claws/custom/dlm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// PolicyRenderer renders Data Lifecycle Manager policies
+type PolicyRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPolicyRenderer creates a new PolicyRenderer
+func NewPolicyRenderer() render.Renderer {
+	return &PolicyRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "dlm",
+			Resource: "policies",
+			Cols: []render.Column{
+				{
+					Name:  "POLICY ID",
+					Width: 26,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "DESCRIPTION",
+					Width: 30,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "STATE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*PolicyResource); ok {
+							return v.State()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "TYPE",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*PolicyResource); ok {
+							return v.PolicyType()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "TARGET TAGS",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*PolicyResource); ok {
+							return strings.Join(v.TargetTags(), ", ")
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "SCHEDULE",
+					Width: 22,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*PolicyResource); ok {
+							return v.ScheduleSummary()
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+				{
+					Name:  "RETENTION",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*PolicyResource); ok {
+							return v.RetentionSummary()
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+				{
+					Name:  "COPIES TO",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*PolicyResource); ok {
+							return v.CopySummary()
+						}
+						return ""
+					},
+					Priority: 7,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed policy information
+func (r *PolicyRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*PolicyResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DLM Lifecycle Policy", v.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Policy ID", v.GetID())
+	if v.GetName() != "" {
+		d.Field("Description", v.GetName())
+	}
+	d.FieldStyled("State", v.State(), render.StateColorer()(v.State()))
+	d.Field("Type", v.PolicyType())
+	if v.IsDefault() {
+		d.Field("Default Policy", "Yes")
+	}
+	if v.Policy != nil {
+		d.FieldIf("Status", v.Policy.StatusMessage)
+		d.FieldIf("Execution Role", v.Policy.ExecutionRoleArn)
+		d.FieldIf("ARN", v.Policy.PolicyArn)
+	}
+
+	if rt := v.ResourceTypes(); rt != "" || len(v.TargetTags()) > 0 {
+		d.Section("Targets")
+		if rt != "" {
+			d.Field("Resource Types", rt)
+		}
+		for _, tag := range v.TargetTags() {
+			d.DimIndent(tag)
+		}
+	}
+
+	for _, s := range v.Schedules() {
+		d.Section("Schedule: " + appaws.Str(s.Name))
+		if create := appdlm.CreateSummary(s.CreateRule); create != "" {
+			d.Field("Runs", create)
+		}
+		if retain := appdlm.RetainSummary(s.RetainRule); retain != "" {
+			d.Field("Retain", retain)
+		}
+		if copies := appdlm.CopySummary(s.CrossRegionCopyRules); copies != "" {
+			d.Field("Copies To", copies)
+		}
+		if appaws.Bool(s.CopyTags) {
+			d.Field("Copy Tags", "Yes")
+		}
+		for _, share := range s.ShareRules {
+			d.Field("Shared With", strings.Join(share.TargetAccounts, ", "))
+		}
+	}
+
+	if v.Policy != nil {
+		d.Section("Timestamps")
+		if v.Policy.DateCreated != nil {
+			d.Field("Created", v.Policy.DateCreated.Format("2006-01-02 15:04:05"))
+		}
+		if v.Policy.DateModified != nil {
+			d.Field("Modified", v.Policy.DateModified.Format("2006-01-02 15:04:05"))
+		}
+	}
+
+	d.Tags(v.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PolicyRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*PolicyResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Policy ID", Value: v.GetID()},
+		{Label: "Description", Value: v.GetName()},
+		{Label: "State", Value: v.State(), Style: render.StateColorer()(v.State())},
+		{Label: "Type", Value: v.PolicyType()},
+	}
+	if retain := v.RetentionSummary(); retain != "" {
+		fields = append(fields, render.SummaryField{Label: "Retention", Value: retain})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *PolicyRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*PolicyResource)
+	if !ok || !v.ManagesSnapshots() {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "s", Label: "Snapshots", Service: "ec2", Resource: "snapshots",
+			FilterField: "DLMPolicyId", FilterValue: v.GetID(),
+		},
+	}
+}
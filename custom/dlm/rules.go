@@ -0,0 +1,76 @@
+package dlm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dlm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// Tags DLM adds to every snapshot and AMI it creates.
+const (
+	PolicyIDTag     = "aws:dlm:lifecycle-policy-id"
+	ScheduleNameTag = "aws:dlm:lifecycle-schedule-name"
+)
+
+// CreateSummary describes when a schedule runs, e.g. "every 12 hours at 09:00".
+func CreateSummary(rule *types.CreateRule) string {
+	if rule == nil {
+		return ""
+	}
+	if rule.CronExpression != nil {
+		return *rule.CronExpression
+	}
+	if rule.Interval == nil {
+		return ""
+	}
+	s := "every " + plural(*rule.Interval, string(rule.IntervalUnit))
+	if len(rule.Times) > 0 {
+		s += " at " + strings.Join(rule.Times, ", ")
+	}
+	return s
+}
+
+// RetainSummary describes how long a schedule keeps what it creates,
+// e.g. "last 7" for count-based or "30 days" for age-based retention.
+func RetainSummary(rule *types.RetainRule) string {
+	if rule == nil {
+		return ""
+	}
+	if rule.Count != nil && *rule.Count > 0 {
+		return fmt.Sprintf("last %d", *rule.Count)
+	}
+	if rule.Interval != nil {
+		return plural(*rule.Interval, string(rule.IntervalUnit))
+	}
+	return ""
+}
+
+// CopySummary lists cross-region copy targets with their retention,
+// e.g. "us-west-2 (7 days), eu-west-1".
+func CopySummary(rules []types.CrossRegionCopyRule) string {
+	parts := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		target := appaws.Str(rule.TargetRegion)
+		if target == "" {
+			target = appaws.Str(rule.Target)
+		}
+		if rule.RetainRule != nil && rule.RetainRule.Interval != nil {
+			target += " (" + plural(*rule.RetainRule.Interval, string(rule.RetainRule.IntervalUnit)) + ")"
+		}
+		parts = append(parts, target)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// plural formats n with a lower-cased DLM unit such as "DAYS", dropping
+// the trailing "s" for a single unit.
+func plural(n int32, unit string) string {
+	unit = strings.ToLower(unit)
+	if n == 1 {
+		unit = strings.TrimSuffix(unit, "s")
+	}
+	return fmt.Sprintf("%d %s", n, unit)
+}
@@ -0,0 +1,61 @@
+package dlm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dlm/types"
+)
+
+func TestCreateSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *types.CreateRule
+		want string
+	}{
+		{"nil", nil, ""},
+		{"interval with times", &types.CreateRule{Interval: aws.Int32(12), IntervalUnit: types.IntervalUnitValuesHours, Times: []string{"09:00"}}, "every 12 hours at 09:00"},
+		{"single hour", &types.CreateRule{Interval: aws.Int32(1), IntervalUnit: types.IntervalUnitValuesHours}, "every 1 hour"},
+		{"cron", &types.CreateRule{CronExpression: aws.String("cron(0 9 ? * MON *)")}, "cron(0 9 ? * MON *)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CreateSummary(tt.rule); got != tt.want {
+				t.Errorf("CreateSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetainSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *types.RetainRule
+		want string
+	}{
+		{"nil", nil, ""},
+		{"count", &types.RetainRule{Count: aws.Int32(7)}, "last 7"},
+		{"age", &types.RetainRule{Interval: aws.Int32(30), IntervalUnit: types.RetentionIntervalUnitValuesDays}, "30 days"},
+		{"single week", &types.RetainRule{Interval: aws.Int32(1), IntervalUnit: types.RetentionIntervalUnitValuesWeeks}, "1 week"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RetainSummary(tt.rule); got != tt.want {
+				t.Errorf("RetainSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopySummary(t *testing.T) {
+	rules := []types.CrossRegionCopyRule{
+		{TargetRegion: aws.String("us-west-2"), RetainRule: &types.CrossRegionCopyRetainRule{Interval: aws.Int32(7), IntervalUnit: types.RetentionIntervalUnitValuesDays}},
+		{Target: aws.String("eu-west-1")},
+	}
+	if got, want := CopySummary(rules), "us-west-2 (7 days), eu-west-1"; got != want {
+		t.Errorf("CopySummary() = %q, want %q", got, want)
+	}
+	if got := CopySummary(nil); got != "" {
+		t.Errorf("CopySummary(nil) = %q, want empty", got)
+	}
+}
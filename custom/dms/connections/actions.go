@@ -0,0 +1,43 @@
+package connections
+
+import (
+	"context"
+
+	appdms "github.com/clawscli/claws/custom/dms"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("dms", "connections", []action.Action{
+		{
+			Name:      "Test Again",
+			Shortcut:  "T",
+			Type:      action.ActionTypeAPI,
+			Operation: "TestConnection",
+			Filter: func(r dao.Resource) bool {
+				conn, ok := dao.UnwrapResource(r).(*ConnectionResource)
+				return ok && conn.Status() != "testing" && conn.Status() != "deleting"
+			},
+		},
+	})
+
+	action.RegisterExecutor("dms", "connections", executeConnectionAction)
+}
+
+// executeConnectionAction executes an action on a DMS connection
+func executeConnectionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	conn, ok := dao.UnwrapResource(resource).(*ConnectionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "TestConnection":
+		return appdms.ExecuteTestConnection(ctx, appaws.Str(conn.Item.ReplicationInstanceArn),
+			appaws.Str(conn.Item.EndpointArn), conn.Endpoint())
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
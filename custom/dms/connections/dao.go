@@ -0,0 +1,124 @@
+package connections
+
+import (
+	"context"
+	"fmt"
+
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+
+	appdms "github.com/clawscli/claws/custom/dms"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ConnectionDAO lists the connection test results between DMS endpoints and
+// replication instances.
+type ConnectionDAO struct {
+	dao.BaseDAO
+	client *dms.Client
+}
+
+// NewConnectionDAO creates a new ConnectionDAO.
+func NewConnectionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, dms.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ConnectionDAO{
+		BaseDAO: dao.NewBaseDAO("dms", "connections"),
+		client:  client,
+	}, nil
+}
+
+// List returns the connections of an endpoint or of a replication instance.
+func (d *ConnectionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var filters []types.Filter
+	if arn := dao.GetFilterFromContext(ctx, "EndpointArn"); arn != "" {
+		filters = append(filters, appdms.Filter("endpoint-arn", arn))
+	}
+	if arn := dao.GetFilterFromContext(ctx, "ReplicationInstanceArn"); arn != "" {
+		filters = append(filters, appdms.Filter("replication-instance-arn", arn))
+	}
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("endpoint or replication instance filter required")
+	}
+
+	connections, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.Connection, *string, error) {
+		output, err := d.client.DescribeConnections(ctx, &dms.DescribeConnectionsInput{
+			Filters: filters,
+			Marker:  marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe dms connections")
+		}
+		return output.Connections, output.Marker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(connections))
+	for i, conn := range connections {
+		resources[i] = NewConnectionResource(conn)
+	}
+	return resources, nil
+}
+
+// Get is not supported; connections are identified by their endpoint and
+// instance pair.
+func (d *ConnectionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get not supported for dms connections")
+}
+
+// Delete is not supported.
+func (d *ConnectionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for dms connections")
+}
+
+// Supports returns true only for List operation.
+func (d *ConnectionDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// ConnectionResource is the last connection test between an endpoint and a
+// replication instance.
+type ConnectionResource struct {
+	dao.BaseResource
+	Item types.Connection
+}
+
+// NewConnectionResource creates a new ConnectionResource.
+func NewConnectionResource(conn types.Connection) *ConnectionResource {
+	endpoint := appaws.Str(conn.EndpointIdentifier)
+	instance := appaws.Str(conn.ReplicationInstanceIdentifier)
+	return &ConnectionResource{
+		BaseResource: dao.BaseResource{
+			ID:   endpoint + "/" + instance,
+			Name: endpoint,
+			Data: conn,
+		},
+		Item: conn,
+	}
+}
+
+// Endpoint returns the endpoint identifier.
+func (r *ConnectionResource) Endpoint() string {
+	return appaws.Str(r.Item.EndpointIdentifier)
+}
+
+// Instance returns the replication instance identifier.
+func (r *ConnectionResource) Instance() string {
+	return appaws.Str(r.Item.ReplicationInstanceIdentifier)
+}
+
+// Status returns the test status: successful, testing, failed or deleting.
+func (r *ConnectionResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// LastFailure returns the error of the last failed test.
+func (r *ConnectionResource) LastFailure() string {
+	return appaws.Str(r.Item.LastFailureMessage)
+}
@@ -0,0 +1,20 @@
+package connections
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("dms", "connections", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewConnectionDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewConnectionRenderer()
+		},
+	})
+}
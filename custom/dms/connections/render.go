@@ -0,0 +1,110 @@
+package connections
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ConnectionRenderer renders DMS connection test results.
+type ConnectionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewConnectionRenderer creates a new ConnectionRenderer.
+func NewConnectionRenderer() render.Renderer {
+	return &ConnectionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "dms",
+			Resource: "connections",
+			Cols: []render.Column{
+				{Name: "ENDPOINT", Width: 35, Getter: getEndpoint},
+				{Name: "INSTANCE", Width: 30, Getter: getInstance},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "LAST FAILURE", Width: 60, Getter: getLastFailure},
+			},
+		},
+	}
+}
+
+func getEndpoint(r dao.Resource) string {
+	conn, ok := r.(*ConnectionResource)
+	if !ok {
+		return ""
+	}
+	return conn.Endpoint()
+}
+
+func getInstance(r dao.Resource) string {
+	conn, ok := r.(*ConnectionResource)
+	if !ok {
+		return ""
+	}
+	return conn.Instance()
+}
+
+func getStatus(r dao.Resource) string {
+	conn, ok := r.(*ConnectionResource)
+	if !ok {
+		return ""
+	}
+	return conn.Status()
+}
+
+func getLastFailure(r dao.Resource) string {
+	conn, ok := r.(*ConnectionResource)
+	if !ok {
+		return ""
+	}
+	return conn.LastFailure()
+}
+
+// RenderDetail renders the detail view for a connection.
+func (r *ConnectionRenderer) RenderDetail(resource dao.Resource) string {
+	conn, ok := resource.(*ConnectionResource)
+	if !ok {
+		return ""
+	}
+	item := conn.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DMS Connection", conn.GetID())
+
+	d.Section("Connection")
+	d.Field("Status", conn.Status())
+	d.Field("Endpoint", conn.Endpoint())
+	d.FieldIf("Endpoint ARN", item.EndpointArn)
+	d.Field("Replication Instance", conn.Instance())
+	d.FieldIf("Replication Instance ARN", item.ReplicationInstanceArn)
+
+	if msg := conn.LastFailure(); msg != "" {
+		d.Section("Last Failure")
+		d.Field("Message", msg)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a connection.
+func (r *ConnectionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	conn, ok := resource.(*ConnectionResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Endpoint", Value: conn.Endpoint()},
+		{Label: "Instance", Value: conn.Instance()},
+		{Label: "Status", Value: conn.Status()},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a connection test runs
+func (r *ConnectionRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if conn, ok := dao.UnwrapResource(res).(*ConnectionResource); ok && conn.Status() == "testing" {
+			return true
+		}
+	}
+	return false
+}
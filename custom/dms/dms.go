@@ -0,0 +1,148 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// connectionPollInterval is how often a connection test is polled.
+	connectionPollInterval = 3 * time.Second
+	// connectionTestTimeout bounds how long a connection test is waited on.
+	// The test keeps running in DMS after this; its result shows up under
+	// connections.
+	connectionTestTimeout = 90 * time.Second
+)
+
+// GetClient returns a DMS client configured for the current context
+func GetClient(ctx context.Context) (*dms.Client, error) {
+	return appaws.Client(ctx, dms.NewFromConfig)
+}
+
+// Filter returns a DMS describe filter, e.g. Filter("endpoint-arn", arn).
+func Filter(name string, values ...string) types.Filter {
+	return types.Filter{Name: &name, Values: values}
+}
+
+// InstanceInput prompts for the replication instance a connection is tested
+// from.
+var InstanceInput = &action.InputSpec{
+	Label:   "Replication instance",
+	Choices: instanceChoices,
+}
+
+// instanceChoices offers the available replication instances by ARN.
+func instanceChoices(ctx context.Context, _ dao.Resource) ([]action.Choice, error) {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	instances, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.ReplicationInstance, *string, error) {
+		output, err := client.DescribeReplicationInstances(ctx, &dms.DescribeReplicationInstancesInput{Marker: marker})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe replication instances")
+		}
+		return output.ReplicationInstances, output.Marker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var choices []action.Choice
+	for _, inst := range instances {
+		if appaws.Str(inst.ReplicationInstanceStatus) != "available" {
+			continue
+		}
+		choices = append(choices, action.Choice{
+			Value: appaws.Str(inst.ReplicationInstanceArn),
+			Label: fmt.Sprintf("%s (%s, %s)", appaws.Str(inst.ReplicationInstanceIdentifier),
+				appaws.Str(inst.ReplicationInstanceClass), appaws.Str(inst.EngineVersion)),
+		})
+	}
+	if len(choices) == 0 {
+		return nil, fmt.Errorf("no available replication instances")
+	}
+	slices.SortFunc(choices, func(a, b action.Choice) int { return strings.Compare(a.Label, b.Label) })
+	return choices, nil
+}
+
+// ExecuteTestConnection tests the connection between a replication instance
+// and an endpoint, waiting up to connectionTestTimeout for the result.
+func ExecuteTestConnection(ctx context.Context, instanceArn, endpointArn, endpointName string) action.ActionResult {
+	if instanceArn == "" {
+		return action.FailResult(fmt.Errorf("replication instance is required"))
+	}
+	client, err := GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	if _, err := client.TestConnection(ctx, &dms.TestConnectionInput{
+		ReplicationInstanceArn: &instanceArn,
+		EndpointArn:            &endpointArn,
+	}); err != nil {
+		return action.FailResultf(err, "test connection to %s", endpointName)
+	}
+
+	conn, err := waitForConnectionTest(ctx, client, instanceArn, endpointArn)
+	if err != nil {
+		return action.FailResultf(err, "test connection to %s", endpointName)
+	}
+	switch status := appaws.Str(conn.Status); status {
+	case "successful":
+		return action.SuccessResult(fmt.Sprintf("Connection to %s from %s successful",
+			endpointName, appaws.Str(conn.ReplicationInstanceIdentifier)))
+	case "testing":
+		return action.SuccessResult(fmt.Sprintf("Connection test to %s still running; check its connections", endpointName))
+	default:
+		return action.FailResult(fmt.Errorf("connection to %s %s: %s", endpointName, status, appaws.Str(conn.LastFailureMessage)))
+	}
+}
+
+// waitForConnectionTest polls the connection until its test finishes or
+// connectionTestTimeout passes, returning the last connection seen.
+func waitForConnectionTest(ctx context.Context, client *dms.Client, instanceArn, endpointArn string) (*types.Connection, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, connectionTestTimeout)
+	defer cancel()
+
+	input := &dms.DescribeConnectionsInput{Filters: []types.Filter{
+		Filter("endpoint-arn", endpointArn),
+		Filter("replication-instance-arn", instanceArn),
+	}}
+	var last *types.Connection
+	for {
+		output, err := client.DescribeConnections(waitCtx, input)
+		if err != nil {
+			if last != nil && waitCtx.Err() != nil {
+				return last, nil
+			}
+			return nil, apperrors.Wrap(err, "describe connections")
+		}
+		if len(output.Connections) > 0 {
+			last = &output.Connections[0]
+			if appaws.Str(last.Status) != "testing" {
+				return last, nil
+			}
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if last == nil {
+				return nil, apperrors.Wrap(waitCtx.Err(), "wait for connection test")
+			}
+			return last, nil
+		case <-time.After(connectionPollInterval):
+		}
+	}
+}
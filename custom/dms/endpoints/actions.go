@@ -0,0 +1,38 @@
+package endpoints
+
+import (
+	"context"
+
+	appdms "github.com/clawscli/claws/custom/dms"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("dms", "endpoints", []action.Action{
+		{
+			Name:      "Test Connection",
+			Shortcut:  "T",
+			Type:      action.ActionTypeAPI,
+			Operation: "TestConnection",
+			Input:     appdms.InstanceInput,
+		},
+	})
+
+	action.RegisterExecutor("dms", "endpoints", executeEndpointAction)
+}
+
+// executeEndpointAction executes an action on a DMS endpoint
+func executeEndpointAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	ep, ok := dao.UnwrapResource(resource).(*EndpointResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "TestConnection":
+		return appdms.ExecuteTestConnection(ctx, action.InputFromContext(ctx), ep.GetARN(), ep.GetID())
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -0,0 +1,140 @@
+package endpoints
+
+import (
+	"context"
+	"fmt"
+
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+
+	appdms "github.com/clawscli/claws/custom/dms"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// EndpointDAO provides data access for DMS endpoints.
+type EndpointDAO struct {
+	dao.BaseDAO
+	client *dms.Client
+}
+
+// NewEndpointDAO creates a new EndpointDAO.
+func NewEndpointDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, dms.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EndpointDAO{
+		BaseDAO: dao.NewBaseDAO("dms", "endpoints"),
+		client:  client,
+	}, nil
+}
+
+// List returns all endpoints, or a single one when navigated to from a task.
+func (d *EndpointDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var filters []types.Filter
+	if arn := dao.GetFilterFromContext(ctx, "EndpointArn"); arn != "" {
+		filters = append(filters, appdms.Filter("endpoint-arn", arn))
+	}
+	endpoints, err := d.describe(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(endpoints))
+	for i, ep := range endpoints {
+		resources[i] = NewEndpointResource(ep)
+	}
+	return resources, nil
+}
+
+// Get returns an endpoint by identifier.
+func (d *EndpointDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	endpoints, err := d.describe(ctx, []types.Filter{appdms.Filter("endpoint-id", id)})
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("endpoint not found: %s", id)
+	}
+	return NewEndpointResource(endpoints[0]), nil
+}
+
+func (d *EndpointDAO) describe(ctx context.Context, filters []types.Filter) ([]types.Endpoint, error) {
+	return appaws.PaginateMarker(ctx, func(marker *string) ([]types.Endpoint, *string, error) {
+		output, err := d.client.DescribeEndpoints(ctx, &dms.DescribeEndpointsInput{
+			Filters: filters,
+			Marker:  marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe dms endpoints")
+		}
+		return output.Endpoints, output.Marker, nil
+	})
+}
+
+// Delete deletes an endpoint.
+func (d *EndpointDAO) Delete(ctx context.Context, id string) error {
+	resource, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	arn := resource.GetARN()
+	if _, err := d.client.DeleteEndpoint(ctx, &dms.DeleteEndpointInput{EndpointArn: &arn}); err != nil {
+		return apperrors.Wrapf(err, "delete dms endpoint %s", id)
+	}
+	return nil
+}
+
+// EndpointResource wraps a DMS endpoint.
+type EndpointResource struct {
+	dao.BaseResource
+	Item types.Endpoint
+}
+
+// NewEndpointResource creates a new EndpointResource.
+func NewEndpointResource(ep types.Endpoint) *EndpointResource {
+	id := appaws.Str(ep.EndpointIdentifier)
+	return &EndpointResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			ARN:  appaws.Str(ep.EndpointArn),
+			Data: ep,
+		},
+		Item: ep,
+	}
+}
+
+// EndpointType returns SOURCE or TARGET.
+func (r *EndpointResource) EndpointType() string {
+	return string(r.Item.EndpointType)
+}
+
+// Engine returns the engine's display name, e.g. "Amazon Aurora MySQL".
+func (r *EndpointResource) Engine() string {
+	if name := appaws.Str(r.Item.EngineDisplayName); name != "" {
+		return name
+	}
+	return appaws.Str(r.Item.EngineName)
+}
+
+// Status returns the endpoint status.
+func (r *EndpointResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// Server returns "host:port", or the host alone when no port is set.
+func (r *EndpointResource) Server() string {
+	host := appaws.Str(r.Item.ServerName)
+	if host != "" && r.Item.Port != nil {
+		return fmt.Sprintf("%s:%d", host, *r.Item.Port)
+	}
+	return host
+}
+
+// DatabaseName returns the endpoint's database name.
+func (r *EndpointResource) DatabaseName() string {
+	return appaws.Str(r.Item.DatabaseName)
+}
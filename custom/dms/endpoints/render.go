@@ -0,0 +1,168 @@
+package endpoints
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure EndpointRenderer implements render.Navigator
+var _ render.Navigator = (*EndpointRenderer)(nil)
+
+// EndpointRenderer renders DMS endpoints.
+type EndpointRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEndpointRenderer creates a new EndpointRenderer.
+func NewEndpointRenderer() render.Renderer {
+	return &EndpointRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "dms",
+			Resource: "endpoints",
+			Cols: []render.Column{
+				{Name: "IDENTIFIER", Width: 35, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "TYPE", Width: 8, Getter: getType},
+				{Name: "ENGINE", Width: 25, Getter: getEngine},
+				{Name: "STATUS", Width: 10, Getter: getStatus},
+				{Name: "SERVER", Width: 45, Getter: getServer},
+				{Name: "DATABASE", Width: 20, Getter: getDatabase},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	ep, ok := r.(*EndpointResource)
+	if !ok {
+		return ""
+	}
+	return ep.EndpointType()
+}
+
+func getEngine(r dao.Resource) string {
+	ep, ok := r.(*EndpointResource)
+	if !ok {
+		return ""
+	}
+	return ep.Engine()
+}
+
+func getStatus(r dao.Resource) string {
+	ep, ok := r.(*EndpointResource)
+	if !ok {
+		return ""
+	}
+	return ep.Status()
+}
+
+func getServer(r dao.Resource) string {
+	ep, ok := r.(*EndpointResource)
+	if !ok {
+		return ""
+	}
+	return ep.Server()
+}
+
+func getDatabase(r dao.Resource) string {
+	ep, ok := r.(*EndpointResource)
+	if !ok {
+		return ""
+	}
+	return ep.DatabaseName()
+}
+
+// RenderDetail renders the detail view for an endpoint.
+func (r *EndpointRenderer) RenderDetail(resource dao.Resource) string {
+	ep, ok := resource.(*EndpointResource)
+	if !ok {
+		return ""
+	}
+	item := ep.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DMS Endpoint", ep.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Identifier", ep.GetID())
+	d.Field("ARN", ep.GetARN())
+	d.Field("Type", ep.EndpointType())
+	d.Field("Engine", ep.Engine())
+	d.Field("Status", ep.Status())
+	d.FieldIf("KMS Key", item.KmsKeyId)
+	if item.IsReadOnly != nil {
+		d.Field("Read Only", fmt.Sprintf("%v", *item.IsReadOnly))
+	}
+
+	d.Section("Connection")
+	if server := ep.Server(); server != "" {
+		d.Field("Server", server)
+	}
+	d.FieldIf("Database", item.DatabaseName)
+	d.FieldIf("Username", item.Username)
+	if item.SslMode != "" {
+		d.Field("SSL Mode", string(item.SslMode))
+	}
+	d.FieldIf("Certificate", item.CertificateArn)
+	d.FieldIf("Service Access Role", item.ServiceAccessRoleArn)
+	d.FieldIf("Extra Connection Attributes", item.ExtraConnectionAttributes)
+
+	if s := item.S3Settings; s != nil {
+		d.Section("S3 Settings")
+		d.FieldIf("Bucket", s.BucketName)
+		d.FieldIf("Folder", s.BucketFolder)
+		if s.DataFormat != "" {
+			d.Field("Data Format", string(s.DataFormat))
+		}
+	}
+	if s := item.KinesisSettings; s != nil {
+		d.Section("Kinesis Settings")
+		d.FieldIf("Stream", s.StreamArn)
+	}
+	if s := item.KafkaSettings; s != nil {
+		d.Section("Kafka Settings")
+		d.FieldIf("Broker", s.Broker)
+		d.FieldIf("Topic", s.Topic)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an endpoint.
+func (r *EndpointRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	ep, ok := resource.(*EndpointResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Identifier", Value: ep.GetID()},
+		{Label: "Type", Value: ep.EndpointType()},
+		{Label: "Engine", Value: ep.Engine()},
+		{Label: "Status", Value: ep.Status()},
+	}
+	if server := ep.Server(); server != "" {
+		fields = append(fields, render.SummaryField{Label: "Server", Value: server})
+	}
+	return fields
+}
+
+// Navigations returns available navigations from an endpoint.
+func (r *EndpointRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	ep, ok := resource.(*EndpointResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "c",
+			Label:       "Connections",
+			Service:     "dms",
+			Resource:    "connections",
+			FilterField: "EndpointArn",
+			FilterValue: ep.GetARN(),
+		},
+	}
+}
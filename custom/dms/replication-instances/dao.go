@@ -0,0 +1,158 @@
+package replicationinstances
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+
+	appdms "github.com/clawscli/claws/custom/dms"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// InstanceDAO provides data access for DMS replication instances.
+type InstanceDAO struct {
+	dao.BaseDAO
+	client *dms.Client
+}
+
+// NewInstanceDAO creates a new InstanceDAO.
+func NewInstanceDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, dms.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &InstanceDAO{
+		BaseDAO: dao.NewBaseDAO("dms", "replication-instances"),
+		client:  client,
+	}, nil
+}
+
+// List returns all replication instances.
+func (d *InstanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	instances, err := d.describe(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(instances))
+	for i, inst := range instances {
+		resources[i] = NewInstanceResource(inst)
+	}
+	return resources, nil
+}
+
+// Get returns a replication instance by identifier.
+func (d *InstanceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	instances, err := d.describe(ctx, []types.Filter{appdms.Filter("replication-instance-id", id)})
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("replication instance not found: %s", id)
+	}
+	return NewInstanceResource(instances[0]), nil
+}
+
+func (d *InstanceDAO) describe(ctx context.Context, filters []types.Filter) ([]types.ReplicationInstance, error) {
+	return appaws.PaginateMarker(ctx, func(marker *string) ([]types.ReplicationInstance, *string, error) {
+		output, err := d.client.DescribeReplicationInstances(ctx, &dms.DescribeReplicationInstancesInput{
+			Filters: filters,
+			Marker:  marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe replication instances")
+		}
+		return output.ReplicationInstances, output.Marker, nil
+	})
+}
+
+// Delete deletes a replication instance.
+func (d *InstanceDAO) Delete(ctx context.Context, id string) error {
+	resource, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	arn := resource.GetARN()
+	if _, err := d.client.DeleteReplicationInstance(ctx, &dms.DeleteReplicationInstanceInput{
+		ReplicationInstanceArn: &arn,
+	}); err != nil {
+		return apperrors.Wrapf(err, "delete replication instance %s", id)
+	}
+	return nil
+}
+
+// InstanceResource wraps a DMS replication instance.
+type InstanceResource struct {
+	dao.BaseResource
+	Item types.ReplicationInstance
+}
+
+// NewInstanceResource creates a new InstanceResource.
+func NewInstanceResource(inst types.ReplicationInstance) *InstanceResource {
+	id := appaws.Str(inst.ReplicationInstanceIdentifier)
+	return &InstanceResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			ARN:  appaws.Str(inst.ReplicationInstanceArn),
+			Data: inst,
+		},
+		Item: inst,
+	}
+}
+
+// Status returns the instance status, e.g. available or modifying.
+func (r *InstanceResource) Status() string {
+	return appaws.Str(r.Item.ReplicationInstanceStatus)
+}
+
+// Class returns the instance class, e.g. dms.t3.medium.
+func (r *InstanceResource) Class() string {
+	return appaws.Str(r.Item.ReplicationInstanceClass)
+}
+
+// EngineVersion returns the DMS engine version.
+func (r *InstanceResource) EngineVersion() string {
+	return appaws.Str(r.Item.EngineVersion)
+}
+
+// AllocatedStorage returns the allocated storage in GiB.
+func (r *InstanceResource) AllocatedStorage() int32 {
+	return r.Item.AllocatedStorage
+}
+
+// MultiAZ returns whether the instance is Multi-AZ.
+func (r *InstanceResource) MultiAZ() bool {
+	return r.Item.MultiAZ
+}
+
+// AvailabilityZone returns the instance's availability zone.
+func (r *InstanceResource) AvailabilityZone() string {
+	return appaws.Str(r.Item.AvailabilityZone)
+}
+
+// PrivateIP returns the instance's first private IP address.
+func (r *InstanceResource) PrivateIP() string {
+	if len(r.Item.ReplicationInstancePrivateIpAddresses) > 0 {
+		return r.Item.ReplicationInstancePrivateIpAddresses[0]
+	}
+	return appaws.Str(r.Item.ReplicationInstancePrivateIpAddress)
+}
+
+// VpcID returns the VPC of the instance's subnet group.
+func (r *InstanceResource) VpcID() string {
+	if r.Item.ReplicationSubnetGroup != nil {
+		return appaws.Str(r.Item.ReplicationSubnetGroup.VpcId)
+	}
+	return ""
+}
+
+// CreateTime returns when the instance was created.
+func (r *InstanceResource) CreateTime() *time.Time {
+	return r.Item.InstanceCreateTime
+}
@@ -0,0 +1,204 @@
+package replicationinstances
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure InstanceRenderer implements render.Navigator
+var _ render.Navigator = (*InstanceRenderer)(nil)
+
+// InstanceRenderer renders DMS replication instances.
+type InstanceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewInstanceRenderer creates a new InstanceRenderer.
+func NewInstanceRenderer() render.Renderer {
+	return &InstanceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "dms",
+			Resource: "replication-instances",
+			Cols: []render.Column{
+				{Name: "IDENTIFIER", Width: 35, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 15, Getter: getStatus},
+				{Name: "CLASS", Width: 18, Getter: getClass},
+				{Name: "ENGINE", Width: 10, Getter: getEngineVersion},
+				{Name: "STORAGE", Width: 9, Getter: getStorage},
+				{Name: "MULTI-AZ", Width: 9, Getter: getMultiAZ},
+				{Name: "AZ", Width: 15, Getter: getAZ},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	inst, ok := r.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	return inst.Status()
+}
+
+func getClass(r dao.Resource) string {
+	inst, ok := r.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	return inst.Class()
+}
+
+func getEngineVersion(r dao.Resource) string {
+	inst, ok := r.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	return inst.EngineVersion()
+}
+
+func getStorage(r dao.Resource) string {
+	inst, ok := r.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d GiB", inst.AllocatedStorage())
+}
+
+func getMultiAZ(r dao.Resource) string {
+	inst, ok := r.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	if inst.MultiAZ() {
+		return "Yes"
+	}
+	return "No"
+}
+
+func getAZ(r dao.Resource) string {
+	inst, ok := r.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	return inst.AvailabilityZone()
+}
+
+// RenderDetail renders the detail view for a replication instance.
+func (r *InstanceRenderer) RenderDetail(resource dao.Resource) string {
+	inst, ok := resource.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	item := inst.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DMS Replication Instance", inst.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Identifier", inst.GetID())
+	d.Field("ARN", inst.GetARN())
+	d.Field("Status", inst.Status())
+	d.Field("Class", inst.Class())
+	d.Field("Engine Version", inst.EngineVersion())
+	d.Field("Allocated Storage", fmt.Sprintf("%d GiB", inst.AllocatedStorage()))
+	d.Field("Auto Minor Version Upgrade", fmt.Sprintf("%v", item.AutoMinorVersionUpgrade))
+	d.FieldIf("Maintenance Window", item.PreferredMaintenanceWindow)
+	d.FieldIf("KMS Key", item.KmsKeyId)
+
+	d.Section("Availability")
+	d.Field("Multi-AZ", fmt.Sprintf("%v", inst.MultiAZ()))
+	d.FieldIf("Availability Zone", item.AvailabilityZone)
+	d.FieldIf("Secondary AZ", item.SecondaryAvailabilityZone)
+
+	d.Section("Network")
+	d.FieldIf("Network Type", item.NetworkType)
+	if vpc := inst.VpcID(); vpc != "" {
+		d.Field("VPC", vpc)
+	}
+	if sg := item.ReplicationSubnetGroup; sg != nil {
+		d.FieldIf("Subnet Group", sg.ReplicationSubnetGroupIdentifier)
+	}
+	d.Field("Publicly Accessible", fmt.Sprintf("%v", item.PubliclyAccessible))
+	if ips := item.ReplicationInstancePrivateIpAddresses; len(ips) > 0 {
+		d.Field("Private IPs", strings.Join(ips, ", "))
+	}
+	if ips := item.ReplicationInstancePublicIpAddresses; len(ips) > 0 {
+		d.Field("Public IPs", strings.Join(ips, ", "))
+	}
+	if len(item.VpcSecurityGroups) > 0 {
+		groups := make([]string, len(item.VpcSecurityGroups))
+		for i, g := range item.VpcSecurityGroups {
+			groups[i] = appaws.Str(g.VpcSecurityGroupId)
+		}
+		d.Field("Security Groups", strings.Join(groups, ", "))
+	}
+
+	if p := item.PendingModifiedValues; p != nil {
+		d.Section("Pending Modifications")
+		d.FieldIf("Class", p.ReplicationInstanceClass)
+		d.FieldIf("Engine Version", p.EngineVersion)
+		if p.AllocatedStorage != nil {
+			d.Field("Allocated Storage", fmt.Sprintf("%d GiB", *p.AllocatedStorage))
+		}
+		if p.MultiAZ != nil {
+			d.Field("Multi-AZ", fmt.Sprintf("%v", *p.MultiAZ))
+		}
+	}
+
+	if t := inst.CreateTime(); t != nil {
+		d.Section("Timestamps")
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a replication instance.
+func (r *InstanceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	inst, ok := resource.(*InstanceResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Identifier", Value: inst.GetID()},
+		{Label: "Status", Value: inst.Status()},
+		{Label: "Class", Value: inst.Class()},
+		{Label: "Engine", Value: inst.EngineVersion()},
+	}
+	if ip := inst.PrivateIP(); ip != "" {
+		fields = append(fields, render.SummaryField{Label: "Private IP", Value: ip})
+	}
+	return fields
+}
+
+// Navigations returns available navigations from a replication instance.
+func (r *InstanceRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	inst, ok := resource.(*InstanceResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "t",
+			Label:       "Tasks",
+			Service:     "dms",
+			Resource:    "replication-tasks",
+			FilterField: "ReplicationInstanceArn",
+			FilterValue: inst.GetARN(),
+		},
+		{
+			Key:         "c",
+			Label:       "Connections",
+			Service:     "dms",
+			Resource:    "connections",
+			FilterField: "ReplicationInstanceArn",
+			FilterValue: inst.GetARN(),
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package replicationtasks
+
+import (
+	"context"
+	"fmt"
+
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+
+	appdms "github.com/clawscli/claws/custom/dms"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("dms", "replication-tasks", []action.Action{
+		{
+			Name:      "Start",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartReplicationTask",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				task, ok := dao.UnwrapResource(r).(*TaskResource)
+				return ok && task.CanStart()
+			},
+		},
+		{
+			Name:      "Stop",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopReplicationTask",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				task, ok := dao.UnwrapResource(r).(*TaskResource)
+				return ok && task.CanStop()
+			},
+		},
+		{
+			Name:      "Resume",
+			Shortcut:  "U",
+			Type:      action.ActionTypeAPI,
+			Operation: "ResumeReplicationTask",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				task, ok := dao.UnwrapResource(r).(*TaskResource)
+				return ok && task.CanResume()
+			},
+		},
+	})
+
+	action.RegisterExecutor("dms", "replication-tasks", executeTaskAction)
+}
+
+// executeTaskAction executes an action on a DMS replication task
+func executeTaskAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	task, ok := dao.UnwrapResource(resource).(*TaskResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := appdms.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	arn := task.GetARN()
+	switch act.Operation {
+	case "StartReplicationTask":
+		return startTask(ctx, client, task, types.StartReplicationTaskTypeValueStartReplication, "Starting")
+	case "ResumeReplicationTask":
+		return startTask(ctx, client, task, types.StartReplicationTaskTypeValueResumeProcessing, "Resuming")
+	case "StopReplicationTask":
+		if _, err := client.StopReplicationTask(ctx, &dms.StopReplicationTaskInput{ReplicationTaskArn: &arn}); err != nil {
+			return action.FailResultf(err, "stop replication task %s", task.GetID())
+		}
+		return action.SuccessResult(fmt.Sprintf("Stopping %s", task.GetID()))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func startTask(ctx context.Context, client *dms.Client, task *TaskResource, startType types.StartReplicationTaskTypeValue, verb string) action.ActionResult {
+	arn := task.GetARN()
+	if _, err := client.StartReplicationTask(ctx, &dms.StartReplicationTaskInput{
+		ReplicationTaskArn:       &arn,
+		StartReplicationTaskType: startType,
+	}); err != nil {
+		return action.FailResultf(err, "%s replication task %s", startType, task.GetID())
+	}
+	return action.SuccessResult(fmt.Sprintf("%s %s", verb, task.GetID()))
+}
@@ -0,0 +1,210 @@
+package replicationtasks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+
+	appdms "github.com/clawscli/claws/custom/dms"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// TaskDAO provides data access for DMS replication tasks.
+type TaskDAO struct {
+	dao.BaseDAO
+	client *dms.Client
+}
+
+// NewTaskDAO creates a new TaskDAO.
+func NewTaskDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, dms.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &TaskDAO{
+		BaseDAO: dao.NewBaseDAO("dms", "replication-tasks"),
+		client:  client,
+	}, nil
+}
+
+// List returns all replication tasks, or those of one replication instance
+// when navigated to from it.
+func (d *TaskDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var filters []types.Filter
+	if instanceArn := dao.GetFilterFromContext(ctx, "ReplicationInstanceArn"); instanceArn != "" {
+		filters = append(filters, appdms.Filter("replication-instance-arn", instanceArn))
+	}
+	// Settings and table mappings are large JSON documents; Get fetches them
+	tasks, err := d.describe(ctx, filters, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(tasks))
+	for i, task := range tasks {
+		resources[i] = NewTaskResource(task)
+	}
+	return resources, nil
+}
+
+// Get returns a replication task by identifier.
+func (d *TaskDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	tasks, err := d.describe(ctx, []types.Filter{appdms.Filter("replication-task-id", id)}, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("replication task not found: %s", id)
+	}
+	return NewTaskResource(tasks[0]), nil
+}
+
+func (d *TaskDAO) describe(ctx context.Context, filters []types.Filter, withoutSettings bool) ([]types.ReplicationTask, error) {
+	return appaws.PaginateMarker(ctx, func(marker *string) ([]types.ReplicationTask, *string, error) {
+		output, err := d.client.DescribeReplicationTasks(ctx, &dms.DescribeReplicationTasksInput{
+			Filters:         filters,
+			Marker:          marker,
+			WithoutSettings: &withoutSettings,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe replication tasks")
+		}
+		return output.ReplicationTasks, output.Marker, nil
+	})
+}
+
+// Delete deletes a replication task.
+func (d *TaskDAO) Delete(ctx context.Context, id string) error {
+	resource, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	arn := resource.GetARN()
+	if _, err := d.client.DeleteReplicationTask(ctx, &dms.DeleteReplicationTaskInput{
+		ReplicationTaskArn: &arn,
+	}); err != nil {
+		return apperrors.Wrapf(err, "delete replication task %s", id)
+	}
+	return nil
+}
+
+// transitionalStatuses are task statuses that change without user action.
+var transitionalStatuses = []string{"creating", "starting", "stopping", "deleting", "modifying", "moving", "testing"}
+
+// TaskResource wraps a DMS replication task.
+type TaskResource struct {
+	dao.BaseResource
+	Item types.ReplicationTask
+}
+
+// NewTaskResource creates a new TaskResource.
+func NewTaskResource(task types.ReplicationTask) *TaskResource {
+	id := appaws.Str(task.ReplicationTaskIdentifier)
+	return &TaskResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			ARN:  appaws.Str(task.ReplicationTaskArn),
+			Data: task,
+		},
+		Item: task,
+	}
+}
+
+// Status returns the task status, e.g. running or stopped.
+func (r *TaskResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// MigrationType returns full-load, cdc or full-load-and-cdc.
+func (r *TaskResource) MigrationType() string {
+	return string(r.Item.MigrationType)
+}
+
+// InstanceArn returns the ARN of the replication instance running the task.
+func (r *TaskResource) InstanceArn() string {
+	return appaws.Str(r.Item.ReplicationInstanceArn)
+}
+
+// SourceEndpointArn returns the ARN of the source endpoint.
+func (r *TaskResource) SourceEndpointArn() string {
+	return appaws.Str(r.Item.SourceEndpointArn)
+}
+
+// TargetEndpointArn returns the ARN of the target endpoint.
+func (r *TaskResource) TargetEndpointArn() string {
+	return appaws.Str(r.Item.TargetEndpointArn)
+}
+
+// Stats returns the task's run statistics, or nil before its first run.
+func (r *TaskResource) Stats() *types.ReplicationTaskStats {
+	return r.Item.ReplicationTaskStats
+}
+
+// Progress returns the full load progress percentage.
+func (r *TaskResource) Progress() int32 {
+	if s := r.Stats(); s != nil {
+		return s.FullLoadProgressPercent
+	}
+	return 0
+}
+
+// TableCount returns the number of tables in the task across all load states.
+func (r *TaskResource) TableCount() int32 {
+	if s := r.Stats(); s != nil {
+		return s.TablesLoaded + s.TablesLoading + s.TablesQueued + s.TablesErrored
+	}
+	return 0
+}
+
+// TablesErrored returns the number of tables that failed to load.
+func (r *TaskResource) TablesErrored() int32 {
+	if s := r.Stats(); s != nil {
+		return s.TablesErrored
+	}
+	return 0
+}
+
+// Elapsed returns how long the current run has taken.
+func (r *TaskResource) Elapsed() time.Duration {
+	if s := r.Stats(); s != nil {
+		return time.Duration(s.ElapsedTimeMillis) * time.Millisecond
+	}
+	return 0
+}
+
+// StartDate returns when the task was last started.
+func (r *TaskResource) StartDate() *time.Time {
+	return r.Item.ReplicationTaskStartDate
+}
+
+// LastFailure returns the last failure message of the task.
+func (r *TaskResource) LastFailure() string {
+	return appaws.Str(r.Item.LastFailureMessage)
+}
+
+// IsTransitioning returns true while the task's status is changing on its own.
+func (r *TaskResource) IsTransitioning() bool {
+	return slices.Contains(transitionalStatuses, r.Status())
+}
+
+// CanStart returns true for tasks that have never run.
+func (r *TaskResource) CanStart() bool {
+	return r.Status() == "ready"
+}
+
+// CanStop returns true for running tasks.
+func (r *TaskResource) CanStop() bool {
+	return r.Status() == "running" || r.Status() == "starting"
+}
+
+// CanResume returns true for stopped or failed tasks.
+func (r *TaskResource) CanResume() bool {
+	return r.Status() == "stopped" || r.Status() == "failed"
+}
@@ -0,0 +1,61 @@
+package replicationtasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+)
+
+func TestTaskResource(t *testing.T) {
+	task := NewTaskResource(types.ReplicationTask{
+		ReplicationTaskIdentifier: aws.String("orders-cdc"),
+		ReplicationTaskArn:        aws.String("arn:aws:dms:us-east-1:111122223333:task:ABC"),
+		Status:                    aws.String("running"),
+		MigrationType:             types.MigrationTypeValueFullLoadAndCdc,
+		ReplicationTaskStats: &types.ReplicationTaskStats{
+			FullLoadProgressPercent: 40,
+			TablesLoaded:            4,
+			TablesLoading:           2,
+			TablesQueued:            3,
+			TablesErrored:           1,
+			ElapsedTimeMillis:       90_000,
+		},
+	})
+
+	if task.GetID() != "orders-cdc" || task.MigrationType() != "full-load-and-cdc" {
+		t.Errorf("id = %q, type = %q", task.GetID(), task.MigrationType())
+	}
+	if task.TableCount() != 10 || getTables(task) != "4/10" || getProgress(task) != "40%" {
+		t.Errorf("tables = %s, progress = %s", getTables(task), getProgress(task))
+	}
+	if task.Elapsed() != 90*time.Second {
+		t.Errorf("Elapsed() = %v", task.Elapsed())
+	}
+
+	tests := []struct {
+		status                       string
+		start, stop, resume, reloads bool
+	}{
+		{"ready", true, false, false, false},
+		{"starting", false, true, false, true},
+		{"running", false, true, false, false},
+		{"stopped", false, false, true, false},
+		{"failed", false, false, true, false},
+		{"deleting", false, false, false, true},
+	}
+	for _, tt := range tests {
+		task.Item.Status = aws.String(tt.status)
+		if task.CanStart() != tt.start || task.CanStop() != tt.stop || task.CanResume() != tt.resume {
+			t.Errorf("%s: start=%v stop=%v resume=%v", tt.status, task.CanStart(), task.CanStop(), task.CanResume())
+		}
+		if task.IsTransitioning() != tt.reloads {
+			t.Errorf("%s: IsTransitioning() = %v", tt.status, task.IsTransitioning())
+		}
+	}
+
+	if getProgress(NewTaskResource(types.ReplicationTask{})) != "" {
+		t.Error("task that never ran should show no progress")
+	}
+}
@@ -0,0 +1,231 @@
+package replicationtasks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure TaskRenderer implements render.Navigator
+var _ render.Navigator = (*TaskRenderer)(nil)
+
+// TaskRenderer renders DMS replication tasks.
+type TaskRenderer struct {
+	render.BaseRenderer
+}
+
+// NewTaskRenderer creates a new TaskRenderer.
+func NewTaskRenderer() render.Renderer {
+	return &TaskRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "dms",
+			Resource: "replication-tasks",
+			Cols: []render.Column{
+				{Name: "IDENTIFIER", Width: 35, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "TYPE", Width: 18, Getter: getMigrationType},
+				{Name: "FULL LOAD", Width: 10, Getter: getProgress},
+				{Name: "TABLES", Width: 9, Getter: getTables},
+				{Name: "ERRORED", Width: 8, Getter: getErrored},
+				{Name: "STARTED", Width: 17, Getter: getStarted},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	task, ok := r.(*TaskResource)
+	if !ok {
+		return ""
+	}
+	return task.Status()
+}
+
+func getMigrationType(r dao.Resource) string {
+	task, ok := r.(*TaskResource)
+	if !ok {
+		return ""
+	}
+	return task.MigrationType()
+}
+
+func getProgress(r dao.Resource) string {
+	task, ok := r.(*TaskResource)
+	if !ok || task.Stats() == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d%%", task.Progress())
+}
+
+func getTables(r dao.Resource) string {
+	task, ok := r.(*TaskResource)
+	if !ok || task.Stats() == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", task.Stats().TablesLoaded, task.TableCount())
+}
+
+func getErrored(r dao.Resource) string {
+	task, ok := r.(*TaskResource)
+	if !ok || task.TablesErrored() == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", task.TablesErrored())
+}
+
+func getStarted(r dao.Resource) string {
+	task, ok := r.(*TaskResource)
+	if !ok {
+		return ""
+	}
+	if t := task.StartDate(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// RenderDetail renders the detail view for a replication task.
+func (r *TaskRenderer) RenderDetail(resource dao.Resource) string {
+	task, ok := resource.(*TaskResource)
+	if !ok {
+		return ""
+	}
+	item := task.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DMS Replication Task", task.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Identifier", task.GetID())
+	d.Field("ARN", task.GetARN())
+	d.Field("Status", task.Status())
+	d.Field("Migration Type", task.MigrationType())
+	d.FieldIf("Stop Reason", item.StopReason)
+
+	if s := task.Stats(); s != nil {
+		d.Section("Progress")
+		d.Field("Full Load", fmt.Sprintf("%d%%", s.FullLoadProgressPercent))
+		d.Field("Tables Loaded", fmt.Sprintf("%d", s.TablesLoaded))
+		d.Field("Tables Loading", fmt.Sprintf("%d", s.TablesLoading))
+		d.Field("Tables Queued", fmt.Sprintf("%d", s.TablesQueued))
+		d.Field("Tables Errored", fmt.Sprintf("%d", s.TablesErrored))
+		d.Field("Elapsed", task.Elapsed().Round(time.Second).String())
+		if s.FullLoadStartDate != nil {
+			d.Field("Full Load Started", s.FullLoadStartDate.Format("2006-01-02 15:04:05"))
+		}
+		if s.FullLoadFinishDate != nil {
+			d.Field("Full Load Finished", s.FullLoadFinishDate.Format("2006-01-02 15:04:05"))
+		}
+		if s.StopDate != nil {
+			d.Field("Stopped", s.StopDate.Format("2006-01-02 15:04:05"))
+		}
+	}
+
+	if msg := task.LastFailure(); msg != "" {
+		d.Section("Last Failure")
+		d.Field("Message", msg)
+	}
+
+	d.Section("Endpoints")
+	d.Field("Replication Instance", task.InstanceArn())
+	d.Field("Source Endpoint", task.SourceEndpointArn())
+	d.Field("Target Endpoint", task.TargetEndpointArn())
+
+	if item.CdcStartPosition != nil || item.CdcStopPosition != nil || item.RecoveryCheckpoint != nil {
+		d.Section("Change Data Capture")
+		d.FieldIf("Start Position", item.CdcStartPosition)
+		d.FieldIf("Stop Position", item.CdcStopPosition)
+		d.FieldIf("Recovery Checkpoint", item.RecoveryCheckpoint)
+	}
+
+	d.Section("Timestamps")
+	if t := item.ReplicationTaskCreationDate; t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := task.StartDate(); t != nil {
+		d.Field("Last Started", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a replication task.
+func (r *TaskRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	task, ok := resource.(*TaskResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Identifier", Value: task.GetID()},
+		{Label: "Status", Value: task.Status()},
+		{Label: "Type", Value: task.MigrationType()},
+	}
+	if task.Stats() != nil {
+		fields = append(fields,
+			render.SummaryField{Label: "Full Load", Value: getProgress(task)},
+			render.SummaryField{Label: "Tables", Value: getTables(task)},
+		)
+	}
+	if msg := task.LastFailure(); msg != "" {
+		fields = append(fields, render.SummaryField{Label: "Last Failure", Value: msg})
+	}
+	return fields
+}
+
+// Navigations returns available navigations from a replication task.
+func (r *TaskRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	task, ok := resource.(*TaskResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "t",
+			Label:       "Table Statistics",
+			Service:     "dms",
+			Resource:    "table-statistics",
+			FilterField: "ReplicationTaskArn",
+			FilterValue: task.GetARN(),
+			AutoReload:  true,
+		},
+		{
+			Key:         "i",
+			Label:       "Instance",
+			Service:     "dms",
+			Resource:    "replication-instances",
+			FilterField: "ReplicationInstanceArn",
+			FilterValue: task.InstanceArn(),
+		},
+		{
+			Key:         "e",
+			Label:       "Source Endpoint",
+			Service:     "dms",
+			Resource:    "endpoints",
+			FilterField: "EndpointArn",
+			FilterValue: task.SourceEndpointArn(),
+		},
+		{
+			Key:         "E",
+			Label:       "Target Endpoint",
+			Service:     "dms",
+			Resource:    "endpoints",
+			FilterField: "EndpointArn",
+			FilterValue: task.TargetEndpointArn(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a task is starting,
+// stopping or otherwise changing state
+func (r *TaskRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if task, ok := dao.UnwrapResource(res).(*TaskResource); ok && task.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
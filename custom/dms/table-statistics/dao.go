@@ -0,0 +1,132 @@
+package tablestatistics
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// TableStatisticDAO lists per-table statistics of a DMS replication task.
+type TableStatisticDAO struct {
+	dao.BaseDAO
+	client *dms.Client
+}
+
+// NewTableStatisticDAO creates a new TableStatisticDAO.
+func NewTableStatisticDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, dms.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &TableStatisticDAO{
+		BaseDAO: dao.NewBaseDAO("dms", "table-statistics"),
+		client:  client,
+	}, nil
+}
+
+// List returns the statistics of every table in the task, errored tables
+// first.
+func (d *TableStatisticDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	taskArn := dao.GetFilterFromContext(ctx, "ReplicationTaskArn")
+	if taskArn == "" {
+		return nil, fmt.Errorf("replication task ARN filter required")
+	}
+
+	stats, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.TableStatistics, *string, error) {
+		output, err := d.client.DescribeTableStatistics(ctx, &dms.DescribeTableStatisticsInput{
+			ReplicationTaskArn: &taskArn,
+			Marker:             marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe table statistics")
+		}
+		return output.TableStatistics, output.Marker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*TableStatisticResource, len(stats))
+	for i, s := range stats {
+		resources[i] = NewTableStatisticResource(s)
+	}
+	slices.SortFunc(resources, func(a, b *TableStatisticResource) int {
+		if a.Errored() != b.Errored() {
+			if a.Errored() {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.GetID(), b.GetID())
+	})
+
+	result := make([]dao.Resource, len(resources))
+	for i, r := range resources {
+		result[i] = r
+	}
+	return result, nil
+}
+
+// Get is not supported; statistics are only listed per task.
+func (d *TableStatisticDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get not supported for table statistics")
+}
+
+// Delete is not supported.
+func (d *TableStatisticDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for table statistics")
+}
+
+// Supports returns true only for List operation.
+func (d *TableStatisticDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// TableStatisticResource is the replication progress of one table.
+type TableStatisticResource struct {
+	dao.BaseResource
+	Item types.TableStatistics
+}
+
+// NewTableStatisticResource creates a new TableStatisticResource.
+func NewTableStatisticResource(s types.TableStatistics) *TableStatisticResource {
+	id := appaws.Str(s.SchemaName) + "." + appaws.Str(s.TableName)
+	return &TableStatisticResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: s,
+		},
+		Item: s,
+	}
+}
+
+// State returns the table state, e.g. "Table completed" or "Table error".
+func (r *TableStatisticResource) State() string {
+	return appaws.Str(r.Item.TableState)
+}
+
+// Errored returns true when the table failed to load or validate.
+func (r *TableStatisticResource) Errored() bool {
+	return strings.Contains(strings.ToLower(r.State()), "error") || r.Item.FullLoadErrorRows > 0
+}
+
+// ValidationState returns the validation state, e.g. "Validated".
+func (r *TableStatisticResource) ValidationState() string {
+	return appaws.Str(r.Item.ValidationState)
+}
+
+// LastUpdated returns when the statistics were last updated.
+func (r *TableStatisticResource) LastUpdated() *time.Time {
+	return r.Item.LastUpdateTime
+}
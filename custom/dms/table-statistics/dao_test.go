@@ -0,0 +1,30 @@
+package tablestatistics
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+)
+
+func TestTableStatisticResource(t *testing.T) {
+	s := NewTableStatisticResource(types.TableStatistics{
+		SchemaName:   aws.String("sales"),
+		TableName:    aws.String("orders"),
+		TableState:   aws.String("Table completed"),
+		FullLoadRows: 1200,
+	})
+	if s.GetID() != "sales.orders" || s.Errored() {
+		t.Errorf("id = %q, errored = %v", s.GetID(), s.Errored())
+	}
+
+	s.Item.FullLoadErrorRows = 3
+	if !s.Errored() {
+		t.Error("table with error rows should be errored")
+	}
+
+	failed := NewTableStatisticResource(types.TableStatistics{TableState: aws.String("Table error")})
+	if !failed.Errored() {
+		t.Error(`"Table error" state should be errored`)
+	}
+}
@@ -0,0 +1,20 @@
+package tablestatistics
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("dms", "table-statistics", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewTableStatisticDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewTableStatisticRenderer()
+		},
+	})
+}
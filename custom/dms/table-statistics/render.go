@@ -0,0 +1,159 @@
+package tablestatistics
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// TableStatisticRenderer renders DMS table statistics.
+type TableStatisticRenderer struct {
+	render.BaseRenderer
+}
+
+// NewTableStatisticRenderer creates a new TableStatisticRenderer.
+func NewTableStatisticRenderer() render.Renderer {
+	return &TableStatisticRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "dms",
+			Resource: "table-statistics",
+			Cols: []render.Column{
+				{Name: "TABLE", Width: 40, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATE", Width: 20, Getter: getState},
+				{Name: "FULL LOAD", Width: 12, Getter: count(func(r *TableStatisticResource) int64 { return r.Item.FullLoadRows })},
+				{Name: "INSERTS", Width: 10, Getter: count(func(r *TableStatisticResource) int64 { return r.Item.Inserts })},
+				{Name: "UPDATES", Width: 10, Getter: count(func(r *TableStatisticResource) int64 { return r.Item.Updates })},
+				{Name: "DELETES", Width: 10, Getter: count(func(r *TableStatisticResource) int64 { return r.Item.Deletes })},
+				{Name: "DDLS", Width: 6, Getter: count(func(r *TableStatisticResource) int64 { return r.Item.Ddls })},
+				{Name: "ERROR ROWS", Width: 10, Getter: count(func(r *TableStatisticResource) int64 { return r.Item.FullLoadErrorRows })},
+				{Name: "VALIDATION", Width: 20, Getter: getValidation},
+				{Name: "UPDATED", Width: 17, Getter: getUpdated},
+			},
+		},
+	}
+}
+
+// count returns a getter for a row counter.
+func count(get func(*TableStatisticResource) int64) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		s, ok := r.(*TableStatisticResource)
+		if !ok {
+			return ""
+		}
+		return fmt.Sprintf("%d", get(s))
+	}
+}
+
+func getState(r dao.Resource) string {
+	s, ok := r.(*TableStatisticResource)
+	if !ok {
+		return ""
+	}
+	return s.State()
+}
+
+func getValidation(r dao.Resource) string {
+	s, ok := r.(*TableStatisticResource)
+	if !ok {
+		return ""
+	}
+	return s.ValidationState()
+}
+
+func getUpdated(r dao.Resource) string {
+	s, ok := r.(*TableStatisticResource)
+	if !ok {
+		return ""
+	}
+	if t := s.LastUpdated(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// RenderDetail renders the detail view for a table's statistics.
+func (r *TableStatisticRenderer) RenderDetail(resource dao.Resource) string {
+	s, ok := resource.(*TableStatisticResource)
+	if !ok {
+		return ""
+	}
+	item := s.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DMS Table Statistics", s.GetID())
+
+	d.Section("Table")
+	d.FieldIf("Schema", item.SchemaName)
+	d.FieldIf("Table", item.TableName)
+	d.Field("State", s.State())
+	if t := s.LastUpdated(); t != nil {
+		d.Field("Last Updated", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Full Load")
+	d.Field("Rows", fmt.Sprintf("%d", item.FullLoadRows))
+	d.Field("Error Rows", fmt.Sprintf("%d", item.FullLoadErrorRows))
+	if item.FullLoadCondtnlChkFailedRows > 0 {
+		d.Field("Conditional Check Failed Rows", fmt.Sprintf("%d", item.FullLoadCondtnlChkFailedRows))
+	}
+	if item.FullLoadReloaded != nil && *item.FullLoadReloaded {
+		d.Field("Reloaded", "true")
+	}
+	if t := item.FullLoadStartTime; t != nil {
+		d.Field("Started", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := item.FullLoadEndTime; t != nil {
+		d.Field("Finished", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Change Data Capture")
+	d.Field("Inserts", fmt.Sprintf("%d", item.Inserts))
+	d.Field("Updates", fmt.Sprintf("%d", item.Updates))
+	d.Field("Deletes", fmt.Sprintf("%d", item.Deletes))
+	d.Field("DDLs", fmt.Sprintf("%d", item.Ddls))
+
+	if item.ValidationState != nil {
+		d.Section("Validation")
+		d.Field("State", s.ValidationState())
+		d.FieldIf("Details", item.ValidationStateDetails)
+		d.Field("Pending Records", fmt.Sprintf("%d", item.ValidationPendingRecords))
+		d.Field("Failed Records", fmt.Sprintf("%d", item.ValidationFailedRecords))
+		d.Field("Suspended Records", fmt.Sprintf("%d", item.ValidationSuspendedRecords))
+	}
+
+	if item.ResyncState != nil {
+		d.Section("Resync")
+		d.Field("State", *item.ResyncState)
+		if item.ResyncProgress != nil {
+			d.Field("Progress", fmt.Sprintf("%.1f%%", *item.ResyncProgress))
+		}
+		if item.ResyncRowsSucceeded != nil {
+			d.Field("Rows Succeeded", fmt.Sprintf("%d", *item.ResyncRowsSucceeded))
+		}
+		if item.ResyncRowsFailed != nil {
+			d.Field("Rows Failed", fmt.Sprintf("%d", *item.ResyncRowsFailed))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a table's statistics.
+func (r *TableStatisticRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	s, ok := resource.(*TableStatisticResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Table", Value: s.GetID()},
+		{Label: "State", Value: s.State()},
+		{Label: "Full Load Rows", Value: fmt.Sprintf("%d", s.Item.FullLoadRows)},
+	}
+	if v := s.ValidationState(); v != "" {
+		fields = append(fields, render.SummaryField{Label: "Validation", Value: v})
+	}
+	return fields
+}
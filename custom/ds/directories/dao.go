@@ -0,0 +1,156 @@
+package directories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DirectoryDAO provides data access for Directory Service directories
+type DirectoryDAO struct {
+	dao.BaseDAO
+	client *directoryservice.Client
+}
+
+// NewDirectoryDAO creates a new DirectoryDAO
+func NewDirectoryDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, directoryservice.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DirectoryDAO{
+		BaseDAO: dao.NewBaseDAO("ds", "directories"),
+		client:  client,
+	}, nil
+}
+
+// List returns all directories
+func (d *DirectoryDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	dirs, err := d.describe(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(dirs))
+	for i, dir := range dirs {
+		resources[i] = NewDirectoryResource(dir)
+	}
+	return resources, nil
+}
+
+// Get returns a specific directory
+func (d *DirectoryDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	dirs, err := d.describe(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(dirs) == 0 {
+		return nil, fmt.Errorf("directory not found: %s", id)
+	}
+	return NewDirectoryResource(dirs[0]), nil
+}
+
+// Delete deletes a directory
+func (d *DirectoryDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteDirectory(ctx, &directoryservice.DeleteDirectoryInput{
+		DirectoryId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete directory %s", id)
+	}
+	return nil
+}
+
+func (d *DirectoryDAO) describe(ctx context.Context, ids []string) ([]types.DirectoryDescription, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.DirectoryDescription, *string, error) {
+		output, err := d.client.DescribeDirectories(ctx, &directoryservice.DescribeDirectoriesInput{
+			DirectoryIds: ids,
+			NextToken:    token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe directories")
+		}
+		return output.DirectoryDescriptions, output.NextToken, nil
+	})
+}
+
+// DirectoryResource wraps a Directory Service directory
+type DirectoryResource struct {
+	dao.BaseResource
+	Item types.DirectoryDescription
+}
+
+// NewDirectoryResource creates a new DirectoryResource
+func NewDirectoryResource(dir types.DirectoryDescription) *DirectoryResource {
+	return &DirectoryResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(dir.DirectoryId),
+			Name: appaws.Str(dir.Name),
+			Data: dir,
+		},
+		Item: dir,
+	}
+}
+
+// Type returns the directory type, e.g. MicrosoftAD or ADConnector
+func (r *DirectoryResource) Type() types.DirectoryType {
+	return r.Item.Type
+}
+
+// IsManagedAD reports whether the directory is an AWS Managed Microsoft AD
+// owned by this account, the only kind with trusts and domain controllers.
+func (r *DirectoryResource) IsManagedAD() bool {
+	return r.Item.Type == types.DirectoryTypeMicrosoftAd
+}
+
+// Stage returns the directory lifecycle stage
+func (r *DirectoryResource) Stage() string {
+	return string(r.Item.Stage)
+}
+
+// IsTransitioning reports whether the stage will change on its own.
+func (r *DirectoryResource) IsTransitioning() bool {
+	switch r.Item.Stage {
+	case types.DirectoryStageRequested, types.DirectoryStageCreating, types.DirectoryStageCreated,
+		types.DirectoryStageRestoring, types.DirectoryStageDeleting, types.DirectoryStageUpdating:
+		return true
+	default:
+		return false
+	}
+}
+
+// SizeLabel returns the edition for Managed AD and the size otherwise,
+// e.g. "Enterprise" or "Small".
+func (r *DirectoryResource) SizeLabel() string {
+	if r.Item.Edition != "" {
+		return string(r.Item.Edition)
+	}
+	return string(r.Item.Size)
+}
+
+// DNSAddresses returns the DNS server addresses, comma separated
+func (r *DirectoryResource) DNSAddresses() string {
+	addrs := r.Item.DnsIpAddrs
+	if len(addrs) == 0 {
+		addrs = r.Item.DnsIpv6Addrs
+	}
+	return strings.Join(addrs, ", ")
+}
+
+// VpcID returns the directory's VPC, from the VPC or AD Connector settings
+func (r *DirectoryResource) VpcID() string {
+	if vs := r.Item.VpcSettings; vs != nil {
+		return appaws.Str(vs.VpcId)
+	}
+	if cs := r.Item.ConnectSettings; cs != nil {
+		return appaws.Str(cs.VpcId)
+	}
+	return ""
+}
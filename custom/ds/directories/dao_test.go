@@ -0,0 +1,72 @@
+package directories
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+)
+
+func TestDirectoryResource(t *testing.T) {
+	managed := NewDirectoryResource(types.DirectoryDescription{
+		DirectoryId: aws.String("d-1234567890"),
+		Name:        aws.String("corp.example.com"),
+		Type:        types.DirectoryTypeMicrosoftAd,
+		Edition:     types.DirectoryEditionEnterprise,
+		Stage:       types.DirectoryStageActive,
+		DnsIpAddrs:  []string{"10.0.1.10", "10.0.2.10"},
+		VpcSettings: &types.DirectoryVpcSettingsDescription{VpcId: aws.String("vpc-111")},
+	})
+	if !managed.IsManagedAD() {
+		t.Error("MicrosoftAD directory should be managed AD")
+	}
+	if got := managed.SizeLabel(); got != "Enterprise" {
+		t.Errorf("SizeLabel() = %q, want Enterprise", got)
+	}
+	if got := managed.DNSAddresses(); got != "10.0.1.10, 10.0.2.10" {
+		t.Errorf("DNSAddresses() = %q", got)
+	}
+	if got := managed.VpcID(); got != "vpc-111" {
+		t.Errorf("VpcID() = %q, want vpc-111", got)
+	}
+
+	connector := NewDirectoryResource(types.DirectoryDescription{
+		DirectoryId:     aws.String("d-0987654321"),
+		Type:            types.DirectoryTypeAdConnector,
+		Size:            types.DirectorySizeSmall,
+		Stage:           types.DirectoryStageCreating,
+		ConnectSettings: &types.DirectoryConnectSettingsDescription{VpcId: aws.String("vpc-222")},
+	})
+	if connector.IsManagedAD() {
+		t.Error("AD Connector should not be managed AD")
+	}
+	if got := connector.SizeLabel(); got != "Small" {
+		t.Errorf("SizeLabel() = %q, want Small", got)
+	}
+	if got := connector.VpcID(); got != "vpc-222" {
+		t.Errorf("VpcID() = %q, want vpc-222", got)
+	}
+	if !connector.IsTransitioning() {
+		t.Error("Creating directory should be transitioning")
+	}
+}
+
+func TestNavigations_ManagedADOnly(t *testing.T) {
+	r := NewDirectoryRenderer().(*DirectoryRenderer)
+
+	managed := NewDirectoryResource(types.DirectoryDescription{
+		DirectoryId: aws.String("d-1"),
+		Type:        types.DirectoryTypeMicrosoftAd,
+	})
+	if navs := r.Navigations(managed); len(navs) != 2 {
+		t.Errorf("managed AD navigations = %d, want trusts and domain controllers", len(navs))
+	}
+
+	simple := NewDirectoryResource(types.DirectoryDescription{
+		DirectoryId: aws.String("d-2"),
+		Type:        types.DirectoryTypeSimpleAd,
+	})
+	if navs := r.Navigations(simple); len(navs) != 0 {
+		t.Errorf("Simple AD navigations = %v, want none", navs)
+	}
+}
@@ -0,0 +1,20 @@
+package directories
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ds", "directories", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewDirectoryDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewDirectoryRenderer()
+		},
+	})
+}
@@ -0,0 +1,224 @@
+package directories
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// DirectoryRenderer renders Directory Service directories
+type DirectoryRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDirectoryRenderer creates a new DirectoryRenderer
+func NewDirectoryRenderer() render.Renderer {
+	return &DirectoryRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ds",
+			Resource: "directories",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ID", Width: 14, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "TYPE", Width: 18, Getter: getType},
+				{Name: "SIZE", Width: 11, Getter: getSize},
+				{Name: "STAGE", Width: 12, Getter: getStage},
+				{Name: "DNS", Width: 30, Getter: getDNS},
+				{Name: "VPC", Width: 22, Getter: getVpc},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	dir, ok := r.(*DirectoryResource)
+	if !ok {
+		return ""
+	}
+	return string(dir.Type())
+}
+
+func getSize(r dao.Resource) string {
+	dir, ok := r.(*DirectoryResource)
+	if !ok {
+		return ""
+	}
+	return dir.SizeLabel()
+}
+
+func getStage(r dao.Resource) string {
+	dir, ok := r.(*DirectoryResource)
+	if !ok {
+		return ""
+	}
+	return dir.Stage()
+}
+
+func getDNS(r dao.Resource) string {
+	dir, ok := r.(*DirectoryResource)
+	if !ok {
+		return ""
+	}
+	return dir.DNSAddresses()
+}
+
+func getVpc(r dao.Resource) string {
+	dir, ok := r.(*DirectoryResource)
+	if !ok {
+		return ""
+	}
+	return dir.VpcID()
+}
+
+func stageStyle(stage string) render.Style {
+	switch stage {
+	case "Active":
+		return ui.SuccessStyle()
+	case "Impaired", "Inoperable", "Failed", "RestoreFailed":
+		return ui.DangerStyle()
+	case "Requested", "Creating", "Created", "Restoring", "Deleting", "Updating":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a directory
+func (r *DirectoryRenderer) RenderDetail(resource dao.Resource) string {
+	dir, ok := resource.(*DirectoryResource)
+	if !ok {
+		return ""
+	}
+	item := dir.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Directory", dir.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", dir.GetName())
+	d.FieldIf("Short Name", item.ShortName)
+	d.Field("Directory ID", dir.GetID())
+	d.Field("Type", string(dir.Type()))
+	if item.Edition != "" {
+		d.Field("Edition", string(item.Edition))
+	}
+	if item.Size != "" {
+		d.Field("Size", string(item.Size))
+	}
+	d.FieldStyled("Stage", dir.Stage(), stageStyle(dir.Stage()))
+	d.FieldIf("Stage Reason", item.StageReason)
+	d.FieldIf("Description", item.Description)
+	d.FieldIf("Alias", item.Alias)
+	d.FieldIf("Access URL", item.AccessUrl)
+	if item.OsVersion != "" {
+		d.Field("OS Version", string(item.OsVersion))
+	}
+	d.Field("SSO Enabled", fmt.Sprintf("%v", item.SsoEnabled))
+
+	d.Section("Network")
+	d.Field("DNS Addresses", dir.DNSAddresses())
+	if vs := item.VpcSettings; vs != nil {
+		d.FieldIf("VPC", vs.VpcId)
+		d.Field("Subnets", strings.Join(vs.SubnetIds, ", "))
+		d.FieldIf("Security Group", vs.SecurityGroupId)
+		d.Field("Availability Zones", strings.Join(vs.AvailabilityZones, ", "))
+	}
+	if cs := item.ConnectSettings; cs != nil {
+		d.FieldIf("VPC", cs.VpcId)
+		d.Field("Subnets", strings.Join(cs.SubnetIds, ", "))
+		d.Field("Connect IPs", strings.Join(cs.ConnectIps, ", "))
+		d.FieldIf("Service Account", cs.CustomerUserName)
+	}
+	if n := item.DesiredNumberOfDomainControllers; n != nil {
+		d.Field("Desired Domain Controllers", fmt.Sprintf("%d", *n))
+	}
+
+	if o := item.OwnerDirectoryDescription; o != nil {
+		d.Section("Owner Directory")
+		d.FieldIf("Account", o.AccountId)
+		d.FieldIf("Directory ID", o.DirectoryId)
+		d.Field("Share Status", string(item.ShareStatus))
+		d.Field("Share Method", string(item.ShareMethod))
+	}
+
+	if item.RadiusStatus != "" {
+		d.Section("RADIUS")
+		d.Field("Status", string(item.RadiusStatus))
+	}
+
+	d.Section("Timestamps")
+	if t := item.LaunchTime; t != nil {
+		d.Field("Launched", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := item.StageLastUpdatedDateTime; t != nil {
+		d.Field("Stage Updated", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a directory
+func (r *DirectoryRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	dir, ok := resource.(*DirectoryResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: dir.GetName()},
+		{Label: "ID", Value: dir.GetID()},
+		{Label: "Type", Value: fmt.Sprintf("%s (%s)", dir.Type(), dir.SizeLabel())},
+		{Label: "Stage", Value: dir.Stage(), Style: stageStyle(dir.Stage())},
+		{Label: "DNS", Value: dir.DNSAddresses()},
+	}
+}
+
+// Navigations returns navigation shortcuts; trusts and domain controllers
+// only exist for AWS Managed Microsoft AD
+func (r *DirectoryRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	dir, ok := resource.(*DirectoryResource)
+	if !ok {
+		return nil
+	}
+	var navs []render.Navigation
+	if dir.IsManagedAD() {
+		navs = append(navs,
+			render.Navigation{
+				Key:         "t",
+				Label:       "Trusts",
+				Service:     "ds",
+				Resource:    "trusts",
+				FilterField: "DirectoryId",
+				FilterValue: dir.GetID(),
+			},
+			render.Navigation{
+				Key:         "c",
+				Label:       "Domain Controllers",
+				Service:     "ds",
+				Resource:    "domain-controllers",
+				FilterField: "DirectoryId",
+				FilterValue: dir.GetID(),
+			},
+		)
+	}
+	if vpcID := dir.VpcID(); vpcID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "v", Label: "VPC", Service: "vpc", Resource: "vpcs", FilterField: "VpcId", FilterValue: vpcID,
+		})
+	}
+	return navs
+}
+
+// NeedsAutoReload keeps the list refreshing while a directory changes stage
+func (r *DirectoryRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if dir, ok := dao.UnwrapResource(res).(*DirectoryResource); ok && dir.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package domaincontrollers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DomainControllerDAO provides data access for Managed Microsoft AD domain controllers
+type DomainControllerDAO struct {
+	dao.BaseDAO
+	client *directoryservice.Client
+}
+
+// NewDomainControllerDAO creates a new DomainControllerDAO
+func NewDomainControllerDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, directoryservice.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DomainControllerDAO{
+		BaseDAO: dao.NewBaseDAO("ds", "domain-controllers"),
+		client:  client,
+	}, nil
+}
+
+// List returns the domain controllers of a directory (requires DirectoryId filter)
+func (d *DomainControllerDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	dirID := dao.GetFilterFromContext(ctx, "DirectoryId")
+	if dirID == "" {
+		return nil, fmt.Errorf("DirectoryId filter required - navigate from a directory")
+	}
+
+	dcs, err := d.describe(ctx, dirID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(dcs))
+	for i, dc := range dcs {
+		resources[i] = NewDomainControllerResource(dc)
+	}
+	return resources, nil
+}
+
+// Get returns a specific domain controller
+func (d *DomainControllerDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	dirID := dao.GetFilterFromContext(ctx, "DirectoryId")
+	if dirID == "" {
+		return nil, fmt.Errorf("DirectoryId filter required - navigate from a directory")
+	}
+
+	dcs, err := d.describe(ctx, dirID, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(dcs) == 0 {
+		return nil, fmt.Errorf("domain controller not found: %s", id)
+	}
+	return NewDomainControllerResource(dcs[0]), nil
+}
+
+// Delete is not supported; domain controllers are removed by lowering the
+// directory's desired count.
+func (d *DomainControllerDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for domain controllers - lower the directory's domain controller count instead")
+}
+
+// Supports returns supported operations
+func (d *DomainControllerDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *DomainControllerDAO) describe(ctx context.Context, dirID string, ids []string) ([]types.DomainController, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.DomainController, *string, error) {
+		output, err := d.client.DescribeDomainControllers(ctx, &directoryservice.DescribeDomainControllersInput{
+			DirectoryId:         &dirID,
+			DomainControllerIds: ids,
+			NextToken:           token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe domain controllers of %s", dirID)
+		}
+		return output.DomainControllers, output.NextToken, nil
+	})
+}
+
+// DomainControllerResource wraps a Managed Microsoft AD domain controller
+type DomainControllerResource struct {
+	dao.BaseResource
+	Item types.DomainController
+}
+
+// NewDomainControllerResource creates a new DomainControllerResource
+func NewDomainControllerResource(dc types.DomainController) *DomainControllerResource {
+	return &DomainControllerResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(dc.DomainControllerId),
+			Name: appaws.Str(dc.DomainControllerId),
+			Data: dc,
+		},
+		Item: dc,
+	}
+}
+
+// Status returns the domain controller status
+func (r *DomainControllerResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// IsTransitioning reports whether the status will change on its own.
+func (r *DomainControllerResource) IsTransitioning() bool {
+	switch r.Item.Status {
+	case types.DomainControllerStatusCreating, types.DomainControllerStatusRestoring,
+		types.DomainControllerStatusDeleting, types.DomainControllerStatusUpdating:
+		return true
+	default:
+		return false
+	}
+}
+
+// DNSAddress returns the IPv4 DNS address, falling back to IPv6
+func (r *DomainControllerResource) DNSAddress() string {
+	if ip := appaws.Str(r.Item.DnsIpAddr); ip != "" {
+		return ip
+	}
+	return appaws.Str(r.Item.DnsIpv6Addr)
+}
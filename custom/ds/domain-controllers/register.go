@@ -0,0 +1,20 @@
+package domaincontrollers
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ds", "domain-controllers", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewDomainControllerDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewDomainControllerRenderer()
+		},
+	})
+}
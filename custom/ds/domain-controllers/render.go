@@ -0,0 +1,159 @@
+package domaincontrollers
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// DomainControllerRenderer renders domain controllers
+type DomainControllerRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDomainControllerRenderer creates a new DomainControllerRenderer
+func NewDomainControllerRenderer() render.Renderer {
+	return &DomainControllerRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ds",
+			Resource: "domain-controllers",
+			Cols: []render.Column{
+				{Name: "ID", Width: 16, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "DNS IP", Width: 16, Getter: getDNS},
+				{Name: "AZ", Width: 14, Getter: getAZ},
+				{Name: "SUBNET", Width: 26, Getter: getSubnet},
+				{Name: "LAUNCHED", Width: 18, Getter: getLaunched},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	dc, ok := r.(*DomainControllerResource)
+	if !ok {
+		return ""
+	}
+	return dc.Status()
+}
+
+func getDNS(r dao.Resource) string {
+	dc, ok := r.(*DomainControllerResource)
+	if !ok {
+		return ""
+	}
+	return dc.DNSAddress()
+}
+
+func getAZ(r dao.Resource) string {
+	dc, ok := r.(*DomainControllerResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(dc.Item.AvailabilityZone)
+}
+
+func getSubnet(r dao.Resource) string {
+	dc, ok := r.(*DomainControllerResource)
+	if !ok {
+		return ""
+	}
+	return appaws.Str(dc.Item.SubnetId)
+}
+
+func getLaunched(r dao.Resource) string {
+	dc, ok := r.(*DomainControllerResource)
+	if !ok || dc.Item.LaunchTime == nil {
+		return ""
+	}
+	return dc.Item.LaunchTime.Format("2006-01-02 15:04")
+}
+
+func statusStyle(status string) render.Style {
+	switch status {
+	case "Active":
+		return ui.SuccessStyle()
+	case "Impaired", "Failed":
+		return ui.DangerStyle()
+	case "Creating", "Restoring", "Deleting", "Updating":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a domain controller
+func (r *DomainControllerRenderer) RenderDetail(resource dao.Resource) string {
+	dc, ok := resource.(*DomainControllerResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Domain Controller", dc.GetID())
+
+	d.Section("Basic Information")
+	d.Field("ID", dc.GetID())
+	d.FieldIf("Directory ID", dc.Item.DirectoryId)
+	d.FieldStyled("Status", dc.Status(), statusStyle(dc.Status()))
+	d.FieldIf("Status Reason", dc.Item.StatusReason)
+
+	d.Section("Network")
+	d.FieldIf("DNS IPv4", dc.Item.DnsIpAddr)
+	d.FieldIf("DNS IPv6", dc.Item.DnsIpv6Addr)
+	d.FieldIf("Availability Zone", dc.Item.AvailabilityZone)
+	d.FieldIf("Subnet", dc.Item.SubnetId)
+	d.FieldIf("VPC", dc.Item.VpcId)
+
+	d.Section("Timestamps")
+	if t := dc.Item.LaunchTime; t != nil {
+		d.Field("Launched", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := dc.Item.StatusLastUpdatedDateTime; t != nil {
+		d.Field("Status Updated", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a domain controller
+func (r *DomainControllerRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	dc, ok := resource.(*DomainControllerResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "ID", Value: dc.GetID()},
+		{Label: "Status", Value: dc.Status(), Style: statusStyle(dc.Status())},
+		{Label: "DNS IP", Value: dc.DNSAddress()},
+		{Label: "AZ", Value: appaws.Str(dc.Item.AvailabilityZone)},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *DomainControllerRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	dc, ok := resource.(*DomainControllerResource)
+	if !ok {
+		return nil
+	}
+	var navs []render.Navigation
+	if subnetID := appaws.Str(dc.Item.SubnetId); subnetID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "u", Label: "Subnet", Service: "vpc", Resource: "subnets", FilterField: "SubnetId", FilterValue: subnetID,
+		})
+	}
+	return navs
+}
+
+// NeedsAutoReload keeps the list refreshing while a domain controller changes status
+func (r *DomainControllerRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if dc, ok := dao.UnwrapResource(res).(*DomainControllerResource); ok && dc.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package trusts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
+	"github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// TrustDAO provides data access for Managed Microsoft AD trust relationships
+type TrustDAO struct {
+	dao.BaseDAO
+	client *directoryservice.Client
+}
+
+// NewTrustDAO creates a new TrustDAO
+func NewTrustDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, directoryservice.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &TrustDAO{
+		BaseDAO: dao.NewBaseDAO("ds", "trusts"),
+		client:  client,
+	}, nil
+}
+
+// List returns the trusts of a directory (requires DirectoryId filter)
+func (d *TrustDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	dirID := dao.GetFilterFromContext(ctx, "DirectoryId")
+	if dirID == "" {
+		return nil, fmt.Errorf("DirectoryId filter required - navigate from a directory")
+	}
+
+	trusts, err := d.describe(ctx, dirID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(trusts))
+	for i, t := range trusts {
+		resources[i] = NewTrustResource(t)
+	}
+	return resources, nil
+}
+
+// Get returns a specific trust
+func (d *TrustDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	dirID := dao.GetFilterFromContext(ctx, "DirectoryId")
+	if dirID == "" {
+		return nil, fmt.Errorf("DirectoryId filter required - navigate from a directory")
+	}
+
+	trusts, err := d.describe(ctx, dirID, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(trusts) == 0 {
+		return nil, fmt.Errorf("trust not found: %s", id)
+	}
+	return NewTrustResource(trusts[0]), nil
+}
+
+// Delete deletes a trust, leaving any conditional forwarder in place
+func (d *TrustDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteTrust(ctx, &directoryservice.DeleteTrustInput{
+		TrustId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete trust %s", id)
+	}
+	return nil
+}
+
+func (d *TrustDAO) describe(ctx context.Context, dirID string, ids []string) ([]types.Trust, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.Trust, *string, error) {
+		output, err := d.client.DescribeTrusts(ctx, &directoryservice.DescribeTrustsInput{
+			DirectoryId: &dirID,
+			TrustIds:    ids,
+			NextToken:   token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe trusts of %s", dirID)
+		}
+		return output.Trusts, output.NextToken, nil
+	})
+}
+
+// TrustResource wraps a directory trust relationship
+type TrustResource struct {
+	dao.BaseResource
+	Item types.Trust
+}
+
+// NewTrustResource creates a new TrustResource
+func NewTrustResource(t types.Trust) *TrustResource {
+	return &TrustResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(t.TrustId),
+			Name: appaws.Str(t.RemoteDomainName),
+			Data: t,
+		},
+		Item: t,
+	}
+}
+
+// State returns the trust state
+func (r *TrustResource) State() string {
+	return string(r.Item.TrustState)
+}
+
+// IsFailed reports whether creating, verifying or updating the trust failed.
+func (r *TrustResource) IsFailed() bool {
+	switch r.Item.TrustState {
+	case types.TrustStateFailed, types.TrustStateVerifyFailed, types.TrustStateUpdateFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTransitioning reports whether the trust state will change on its own.
+func (r *TrustResource) IsTransitioning() bool {
+	switch r.Item.TrustState {
+	case types.TrustStateCreating, types.TrustStateCreated, types.TrustStateVerifying,
+		types.TrustStateUpdating, types.TrustStateUpdated, types.TrustStateDeleting:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,20 @@
+package trusts
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ds", "trusts", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewTrustDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewTrustRenderer()
+		},
+	})
+}
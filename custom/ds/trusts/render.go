@@ -0,0 +1,140 @@
+package trusts
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// TrustRenderer renders directory trusts
+type TrustRenderer struct {
+	render.BaseRenderer
+}
+
+// NewTrustRenderer creates a new TrustRenderer
+func NewTrustRenderer() render.Renderer {
+	return &TrustRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ds",
+			Resource: "trusts",
+			Cols: []render.Column{
+				{Name: "REMOTE DOMAIN", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "TRUST ID", Width: 14, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "DIRECTION", Width: 18, Getter: getDirection},
+				{Name: "TYPE", Width: 10, Getter: getType},
+				{Name: "STATE", Width: 14, Getter: getState},
+				{Name: "SELECTIVE AUTH", Width: 14, Getter: getSelectiveAuth},
+			},
+		},
+	}
+}
+
+func getDirection(r dao.Resource) string {
+	t, ok := r.(*TrustResource)
+	if !ok {
+		return ""
+	}
+	return string(t.Item.TrustDirection)
+}
+
+func getType(r dao.Resource) string {
+	t, ok := r.(*TrustResource)
+	if !ok {
+		return ""
+	}
+	return string(t.Item.TrustType)
+}
+
+func getState(r dao.Resource) string {
+	t, ok := r.(*TrustResource)
+	if !ok {
+		return ""
+	}
+	return t.State()
+}
+
+func getSelectiveAuth(r dao.Resource) string {
+	t, ok := r.(*TrustResource)
+	if !ok {
+		return ""
+	}
+	return string(t.Item.SelectiveAuth)
+}
+
+func stateStyle(t *TrustResource) render.Style {
+	switch {
+	case t.State() == "Verified":
+		return ui.SuccessStyle()
+	case t.IsFailed():
+		return ui.DangerStyle()
+	case t.IsTransitioning():
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a trust
+func (r *TrustRenderer) RenderDetail(resource dao.Resource) string {
+	t, ok := resource.(*TrustResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Directory Trust", t.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Remote Domain", t.GetName())
+	d.Field("Trust ID", t.GetID())
+	d.FieldIf("Directory ID", t.Item.DirectoryId)
+	d.Field("Direction", string(t.Item.TrustDirection))
+	d.Field("Type", string(t.Item.TrustType))
+	d.Field("Selective Auth", string(t.Item.SelectiveAuth))
+	d.FieldStyled("State", t.State(), stateStyle(t))
+	if t.IsFailed() {
+		if reason := t.Item.TrustStateReason; reason != nil {
+			d.FieldStyled("State Reason", *reason, ui.DangerStyle())
+		}
+	} else {
+		d.FieldIf("State Reason", t.Item.TrustStateReason)
+	}
+
+	d.Section("Timestamps")
+	if ts := t.Item.CreatedDateTime; ts != nil {
+		d.Field("Created", ts.Format("2006-01-02 15:04:05"))
+	}
+	if ts := t.Item.LastUpdatedDateTime; ts != nil {
+		d.Field("Last Updated", ts.Format("2006-01-02 15:04:05"))
+	}
+	if ts := t.Item.StateLastUpdatedDateTime; ts != nil {
+		d.Field("State Updated", ts.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a trust
+func (r *TrustRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	t, ok := resource.(*TrustResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Remote Domain", Value: t.GetName()},
+		{Label: "Direction", Value: string(t.Item.TrustDirection)},
+		{Label: "State", Value: t.State(), Style: stateStyle(t)},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a trust is created or verified
+func (r *TrustRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if t, ok := dao.UnwrapResource(res).(*TrustResource); ok && t.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
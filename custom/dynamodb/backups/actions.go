@@ -0,0 +1,108 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+
+	ddbClient "github.com/clawscli/claws/custom/dynamodb"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("dynamodb", "backups", []action.Action{
+		{
+			Name:      "Restore to New Table",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RestoreTableFromBackup",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				backup, ok := dao.UnwrapResource(r).(*BackupResource)
+				return ok && backup.IsAvailable()
+			},
+			Input: &action.InputSpec{
+				Label:       "New table name",
+				Placeholder: "my-table-restored",
+			},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteBackup",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				backup, ok := dao.UnwrapResource(r).(*BackupResource)
+				return ok && backup.IsUserBackup()
+			},
+		},
+	})
+
+	action.RegisterExecutor("dynamodb", "backups", executeBackupAction)
+}
+
+func executeBackupAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RestoreTableFromBackup":
+		return executeRestore(ctx, resource)
+	case "DeleteBackup":
+		return executeDelete(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeRestore(ctx context.Context, resource dao.Resource) action.ActionResult {
+	backup, ok := dao.UnwrapResource(resource).(*BackupResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	target := strings.TrimSpace(action.InputFromContext(ctx))
+	if target == "" {
+		return action.FailResult(fmt.Errorf("new table name is required"))
+	}
+	if target == backup.TableName() {
+		return action.FailResult(fmt.Errorf("restore target must differ from source table %s", target))
+	}
+
+	client, err := ddbClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	backupArn := backup.GetARN()
+	_, err = client.RestoreTableFromBackup(ctx, &dynamodb.RestoreTableFromBackupInput{
+		BackupArn:       &backupArn,
+		TargetTableName: &target,
+	})
+	if err != nil {
+		return action.FailResultf(err, "restore backup %s", backup.GetName())
+	}
+	return action.SuccessResult(fmt.Sprintf("Restoring %s to new table %s", backup.GetName(), target))
+}
+
+func executeDelete(ctx context.Context, resource dao.Resource) action.ActionResult {
+	backup, ok := dao.UnwrapResource(resource).(*BackupResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := ddbClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	backupArn := backup.GetARN()
+	_, err = client.DeleteBackup(ctx, &dynamodb.DeleteBackupInput{
+		BackupArn: &backupArn,
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete backup %s", backup.GetName())
+	}
+	return action.SuccessResult(fmt.Sprintf("Deleted backup %s", backup.GetName()))
+}
@@ -0,0 +1,171 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// BackupDAO provides data access for DynamoDB on-demand backups
+type BackupDAO struct {
+	dao.BaseDAO
+	client *dynamodb.Client
+}
+
+// NewBackupDAO creates a new BackupDAO
+func NewBackupDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, dynamodb.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &BackupDAO{
+		BaseDAO: dao.NewBaseDAO("dynamodb", "backups"),
+		client:  client,
+	}, nil
+}
+
+// List returns all backups of a table (requires TableName filter)
+func (d *BackupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	tableName := dao.GetFilterFromContext(ctx, "TableName")
+	if tableName == "" {
+		return nil, fmt.Errorf("TableName filter required - navigate from a table")
+	}
+
+	backups, err := appaws.Paginate(ctx, func(token *string) ([]types.BackupSummary, *string, error) {
+		output, err := d.client.ListBackups(ctx, &dynamodb.ListBackupsInput{
+			TableName:               &tableName,
+			BackupType:              types.BackupTypeFilterAll,
+			ExclusiveStartBackupArn: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list backups")
+		}
+		return output.BackupSummaries, output.LastEvaluatedBackupArn, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(backups))
+	for i, b := range backups {
+		resources[i] = NewBackupResource(b)
+	}
+	return resources, nil
+}
+
+// Get returns a backup with its source table details
+func (d *BackupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeBackup(ctx, &dynamodb.DescribeBackupInput{
+		BackupArn: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe backup %s", id)
+	}
+	desc := output.BackupDescription
+	if desc == nil || desc.BackupDetails == nil {
+		return nil, fmt.Errorf("backup not found: %s", id)
+	}
+
+	details := desc.BackupDetails
+	summary := types.BackupSummary{
+		BackupArn:              details.BackupArn,
+		BackupName:             details.BackupName,
+		BackupStatus:           details.BackupStatus,
+		BackupType:             details.BackupType,
+		BackupSizeBytes:        details.BackupSizeBytes,
+		BackupCreationDateTime: details.BackupCreationDateTime,
+		BackupExpiryDateTime:   details.BackupExpiryDateTime,
+	}
+	if src := desc.SourceTableDetails; src != nil {
+		summary.TableName = src.TableName
+		summary.TableArn = src.TableArn
+		summary.TableId = src.TableId
+	}
+
+	r := NewBackupResource(summary)
+	r.SourceTable = desc.SourceTableDetails
+	return r, nil
+}
+
+// Delete deletes a backup
+func (d *BackupDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteBackup(ctx, &dynamodb.DeleteBackupInput{
+		BackupArn: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return apperrors.Wrapf(err, "delete backup %s", id)
+	}
+	return nil
+}
+
+// BackupResource wraps a DynamoDB backup
+type BackupResource struct {
+	dao.BaseResource
+	Item        types.BackupSummary
+	SourceTable *types.SourceTableDetails // Set by Get
+}
+
+// NewBackupResource creates a new BackupResource
+func NewBackupResource(b types.BackupSummary) *BackupResource {
+	return &BackupResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(b.BackupArn),
+			Name: appaws.Str(b.BackupName),
+			ARN:  appaws.Str(b.BackupArn),
+			Tags: make(map[string]string),
+			Data: b,
+		},
+		Item: b,
+	}
+}
+
+// Status returns the backup status (CREATING, AVAILABLE, DELETED)
+func (r *BackupResource) Status() string {
+	return string(r.Item.BackupStatus)
+}
+
+// BackupType returns USER, SYSTEM, or AWS_BACKUP
+func (r *BackupResource) BackupType() string {
+	return string(r.Item.BackupType)
+}
+
+// SizeBytes returns the backup size in bytes
+func (r *BackupResource) SizeBytes() int64 {
+	return appaws.Int64(r.Item.BackupSizeBytes)
+}
+
+// CreatedAt returns when the backup was requested
+func (r *BackupResource) CreatedAt() time.Time {
+	return appaws.Time(r.Item.BackupCreationDateTime)
+}
+
+// ExpiresAt returns when a SYSTEM backup expires (zero for user backups)
+func (r *BackupResource) ExpiresAt() time.Time {
+	return appaws.Time(r.Item.BackupExpiryDateTime)
+}
+
+// TableName returns the name of the source table
+func (r *BackupResource) TableName() string {
+	return appaws.Str(r.Item.TableName)
+}
+
+// IsAvailable reports whether the backup can be restored
+func (r *BackupResource) IsAvailable() bool {
+	return r.Item.BackupStatus == types.BackupStatusAvailable
+}
+
+// IsUserBackup reports whether the backup was created on demand via DynamoDB;
+// only these can be deleted here.
+func (r *BackupResource) IsUserBackup() bool {
+	return r.Item.BackupType == types.BackupTypeUser
+}
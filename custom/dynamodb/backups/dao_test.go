@@ -0,0 +1,71 @@
+package backups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+func TestNewBackupResource(t *testing.T) {
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	arn := "arn:aws:dynamodb:us-east-1:123456789012:table/orders/backup/01700000000000-abcdef12"
+	r := NewBackupResource(types.BackupSummary{
+		BackupArn:              aws.String(arn),
+		BackupName:             aws.String("orders-nightly"),
+		BackupStatus:           types.BackupStatusAvailable,
+		BackupType:             types.BackupTypeUser,
+		BackupSizeBytes:        aws.Int64(2048),
+		BackupCreationDateTime: &created,
+		TableName:              aws.String("orders"),
+	})
+
+	if r.GetID() != arn || r.GetARN() != arn {
+		t.Errorf("ID/ARN = %q/%q, want %q", r.GetID(), r.GetARN(), arn)
+	}
+	if r.GetName() != "orders-nightly" {
+		t.Errorf("GetName() = %q", r.GetName())
+	}
+	if r.TableName() != "orders" {
+		t.Errorf("TableName() = %q", r.TableName())
+	}
+	if r.SizeBytes() != 2048 {
+		t.Errorf("SizeBytes() = %d", r.SizeBytes())
+	}
+	if !r.CreatedAt().Equal(created) {
+		t.Errorf("CreatedAt() = %v", r.CreatedAt())
+	}
+	if !r.ExpiresAt().IsZero() {
+		t.Errorf("ExpiresAt() = %v, want zero", r.ExpiresAt())
+	}
+}
+
+func TestBackupActionAvailability(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     types.BackupStatus
+		backupType types.BackupType
+		restorable bool
+		deletable  bool
+	}{
+		{"available user backup", types.BackupStatusAvailable, types.BackupTypeUser, true, true},
+		{"creating user backup", types.BackupStatusCreating, types.BackupTypeUser, false, true},
+		{"system backup", types.BackupStatusAvailable, types.BackupTypeSystem, true, false},
+		{"aws backup", types.BackupStatusAvailable, types.BackupTypeAwsBackup, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewBackupResource(types.BackupSummary{
+				BackupStatus: tt.status,
+				BackupType:   tt.backupType,
+			})
+			if got := r.IsAvailable(); got != tt.restorable {
+				t.Errorf("IsAvailable() = %v, want %v", got, tt.restorable)
+			}
+			if got := r.IsUserBackup(); got != tt.deletable {
+				t.Errorf("IsUserBackup() = %v, want %v", got, tt.deletable)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package backups
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// BackupRenderer renders DynamoDB backups
+type BackupRenderer struct {
+	render.BaseRenderer
+}
+
+// NewBackupRenderer creates a new BackupRenderer
+func NewBackupRenderer() render.Renderer {
+	return &BackupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "dynamodb",
+			Resource: "backups",
+			Cols: []render.Column{
+				{
+					Name:  "NAME",
+					Width: 36,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "STATUS",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*BackupResource); ok {
+							return v.Status()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "TYPE",
+					Width: 11,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*BackupResource); ok {
+							return v.BackupType()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "SIZE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*BackupResource); ok {
+							return render.FormatSize(v.SizeBytes())
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "AGE",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*BackupResource); ok {
+							return render.FormatAge(v.CreatedAt())
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed backup information
+func (r *BackupRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*BackupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DynamoDB Backup", v.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", v.GetName())
+	d.Field("ARN", v.GetARN())
+	d.Field("Status", v.Status())
+	d.Field("Type", v.BackupType())
+	d.Field("Size", render.FormatSize(v.SizeBytes()))
+	if t := v.CreatedAt(); !t.IsZero() {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := v.ExpiresAt(); !t.IsZero() {
+		d.Field("Expires", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Source Table")
+	d.Field("Table", v.TableName())
+	if src := v.SourceTable; src != nil {
+		d.Field("Billing Mode", string(src.BillingMode))
+		d.Field("Item Count", fmt.Sprintf("%d", appaws.Int64(src.ItemCount)))
+		d.Field("Table Size", render.FormatSize(appaws.Int64(src.TableSizeBytes)))
+		for _, k := range src.KeySchema {
+			d.Field(appaws.Str(k.AttributeName), string(k.KeyType))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *BackupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*BackupResource)
+	if !ok {
+		return nil
+	}
+	return []render.SummaryField{
+		{Label: "Backup", Value: v.GetName()},
+		{Label: "Table", Value: v.TableName()},
+		{Label: "Status", Value: v.Status()},
+		{Label: "Size", Value: render.FormatSize(v.SizeBytes())},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *BackupRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*BackupResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{{
+		Key: "t", Label: "Table", Service: "dynamodb", Resource: "tables",
+		FilterField: "TableName", FilterValue: v.TableName(),
+	}}
+}
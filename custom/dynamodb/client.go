@@ -10,9 +10,5 @@ import (
 
 // GetClient returns a DynamoDB client configured for the current context
 func GetClient(ctx context.Context) (*dynamodb.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return dynamodb.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, dynamodb.NewFromConfig)
 }
@@ -0,0 +1,184 @@
+package exports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// describeConcurrency bounds parallel DescribeExport calls during List.
+const describeConcurrency = 8
+
+// ExportDAO provides data access for DynamoDB export-to-S3 jobs
+type ExportDAO struct {
+	dao.BaseDAO
+	client *dynamodb.Client
+}
+
+// NewExportDAO creates a new ExportDAO
+func NewExportDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, dynamodb.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ExportDAO{
+		BaseDAO: dao.NewBaseDAO("dynamodb", "exports"),
+		client:  client,
+	}, nil
+}
+
+// List returns the export jobs of a table (requires TableArn filter)
+func (d *ExportDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	tableArn := dao.GetFilterFromContext(ctx, "TableArn")
+	if tableArn == "" {
+		return nil, fmt.Errorf("TableArn filter required - navigate from a table")
+	}
+
+	summaries, err := appaws.Paginate(ctx, func(token *string) ([]types.ExportSummary, *string, error) {
+		output, err := d.client.ListExports(ctx, &dynamodb.ListExportsInput{
+			TableArn:  &tableArn,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list exports")
+		}
+		return output.ExportSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The summary only carries ARN, status, and type; describe each export
+	// for destination, progress, and timing. Failures fall back to the summary.
+	descriptions := make([]*types.ExportDescription, len(summaries))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeConcurrency)
+	for i, s := range summaries {
+		g.Go(func() error {
+			output, err := d.client.DescribeExport(gctx, &dynamodb.DescribeExportInput{
+				ExportArn: s.ExportArn,
+			})
+			if err != nil {
+				log.Debug("failed to describe export", "export", appaws.Str(s.ExportArn), "error", err)
+				return nil
+			}
+			descriptions[i] = output.ExportDescription
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	resources := make([]dao.Resource, len(summaries))
+	for i, s := range summaries {
+		desc := descriptions[i]
+		if desc == nil {
+			desc = &types.ExportDescription{
+				ExportArn:    s.ExportArn,
+				ExportStatus: s.ExportStatus,
+				ExportType:   s.ExportType,
+				TableArn:     &tableArn,
+			}
+		}
+		resources[i] = NewExportResource(*desc)
+	}
+	return resources, nil
+}
+
+// Get returns a single export job
+func (d *ExportDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeExport(ctx, &dynamodb.DescribeExportInput{
+		ExportArn: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe export %s", id)
+	}
+	if output.ExportDescription == nil {
+		return nil, fmt.Errorf("export not found: %s", id)
+	}
+	return NewExportResource(*output.ExportDescription), nil
+}
+
+// Delete is not supported; completed exports live on in S3
+func (d *ExportDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for DynamoDB exports")
+}
+
+// ExportResource wraps a DynamoDB export-to-S3 job
+type ExportResource struct {
+	dao.BaseResource
+	Item types.ExportDescription
+}
+
+// NewExportResource creates a new ExportResource
+func NewExportResource(e types.ExportDescription) *ExportResource {
+	arn := appaws.Str(e.ExportArn)
+	return &ExportResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn,
+			Name: appaws.ExtractResourceName(arn),
+			ARN:  arn,
+			Tags: make(map[string]string),
+			Data: e,
+		},
+		Item: e,
+	}
+}
+
+// Status returns IN_PROGRESS, COMPLETED, or FAILED
+func (r *ExportResource) Status() string {
+	return string(r.Item.ExportStatus)
+}
+
+// IsInProgress reports whether the export is still running
+func (r *ExportResource) IsInProgress() bool {
+	return r.Item.ExportStatus == types.ExportStatusInProgress
+}
+
+// ExportType returns FULL_EXPORT or INCREMENTAL_EXPORT
+func (r *ExportResource) ExportType() string {
+	return string(r.Item.ExportType)
+}
+
+// Destination returns the S3 location as s3://bucket/prefix
+func (r *ExportResource) Destination() string {
+	bucket := appaws.Str(r.Item.S3Bucket)
+	if bucket == "" {
+		return ""
+	}
+	if prefix := appaws.Str(r.Item.S3Prefix); prefix != "" {
+		return "s3://" + bucket + "/" + prefix
+	}
+	return "s3://" + bucket
+}
+
+// ItemCount returns the number of exported items (0 until completed)
+func (r *ExportResource) ItemCount() int64 {
+	return appaws.Int64(r.Item.ItemCount)
+}
+
+// StartedAt returns when the export job started
+func (r *ExportResource) StartedAt() time.Time {
+	return appaws.Time(r.Item.StartTime)
+}
+
+// Failure returns the failure code and message of a failed export
+func (r *ExportResource) Failure() string {
+	code, msg := appaws.Str(r.Item.FailureCode), appaws.Str(r.Item.FailureMessage)
+	switch {
+	case code != "" && msg != "":
+		return code + ": " + msg
+	case code != "":
+		return code
+	default:
+		return msg
+	}
+}
@@ -0,0 +1,84 @@
+package exports
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestNewExportResource(t *testing.T) {
+	arn := "arn:aws:dynamodb:us-east-1:123456789012:table/orders/export/01700000000000-abcdef12"
+	r := NewExportResource(types.ExportDescription{
+		ExportArn:    aws.String(arn),
+		ExportStatus: types.ExportStatusCompleted,
+		ExportType:   types.ExportTypeFullExport,
+		S3Bucket:     aws.String("exports"),
+		S3Prefix:     aws.String("orders"),
+		ItemCount:    aws.Int64(42),
+	})
+
+	if r.GetID() != arn {
+		t.Errorf("GetID() = %q, want %q", r.GetID(), arn)
+	}
+	if r.GetName() != "01700000000000-abcdef12" {
+		t.Errorf("GetName() = %q", r.GetName())
+	}
+	if r.Destination() != "s3://exports/orders" {
+		t.Errorf("Destination() = %q", r.Destination())
+	}
+	if r.ItemCount() != 42 {
+		t.Errorf("ItemCount() = %d", r.ItemCount())
+	}
+}
+
+func TestExportDestination(t *testing.T) {
+	tests := []struct {
+		bucket, prefix *string
+		want           string
+	}{
+		{nil, nil, ""},
+		{aws.String("exports"), nil, "s3://exports"},
+		{aws.String("exports"), aws.String(""), "s3://exports"},
+		{aws.String("exports"), aws.String("a/b"), "s3://exports/a/b"},
+	}
+	for _, tt := range tests {
+		r := NewExportResource(types.ExportDescription{S3Bucket: tt.bucket, S3Prefix: tt.prefix})
+		if got := r.Destination(); got != tt.want {
+			t.Errorf("Destination() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestExportFailure(t *testing.T) {
+	tests := []struct {
+		code, msg *string
+		want      string
+	}{
+		{nil, nil, ""},
+		{aws.String("S3AccessDenied"), nil, "S3AccessDenied"},
+		{nil, aws.String("bucket missing"), "bucket missing"},
+		{aws.String("S3AccessDenied"), aws.String("bucket missing"), "S3AccessDenied: bucket missing"},
+	}
+	for _, tt := range tests {
+		r := NewExportResource(types.ExportDescription{FailureCode: tt.code, FailureMessage: tt.msg})
+		if got := r.Failure(); got != tt.want {
+			t.Errorf("Failure() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestNeedsAutoReload(t *testing.T) {
+	renderer := NewExportRenderer().(*ExportRenderer)
+	done := NewExportResource(types.ExportDescription{ExportStatus: types.ExportStatusCompleted})
+	running := NewExportResource(types.ExportDescription{ExportStatus: types.ExportStatusInProgress})
+
+	if renderer.NeedsAutoReload([]dao.Resource{done}) {
+		t.Error("NeedsAutoReload() = true with only completed exports")
+	}
+	if !renderer.NeedsAutoReload([]dao.Resource{done, running}) {
+		t.Error("NeedsAutoReload() = false with an export in progress")
+	}
+}
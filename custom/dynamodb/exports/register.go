@@ -0,0 +1,20 @@
+package exports
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("dynamodb", "exports", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewExportDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewExportRenderer()
+		},
+	})
+}
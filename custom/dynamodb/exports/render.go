@@ -0,0 +1,165 @@
+package exports
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ExportRenderer renders DynamoDB export jobs
+type ExportRenderer struct {
+	render.BaseRenderer
+}
+
+// NewExportRenderer creates a new ExportRenderer
+func NewExportRenderer() render.Renderer {
+	return &ExportRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "dynamodb",
+			Resource: "exports",
+			Cols: []render.Column{
+				{
+					Name:  "EXPORT ID",
+					Width: 34,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "STATUS",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ExportResource); ok {
+							return v.Status()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "TYPE",
+					Width: 18,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ExportResource); ok {
+							return v.ExportType()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "DESTINATION",
+					Width: 40,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ExportResource); ok {
+							return v.Destination()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "ITEMS",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ExportResource); ok && !v.IsInProgress() {
+							return fmt.Sprintf("%d", v.ItemCount())
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "STARTED",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ExportResource); ok {
+							if t := v.StartedAt(); !t.IsZero() {
+								return render.FormatAge(t)
+							}
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed export information
+func (r *ExportRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*ExportResource)
+	if !ok {
+		return ""
+	}
+	e := v.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DynamoDB Export", v.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Export ID", v.GetName())
+	d.Field("ARN", v.GetARN())
+	d.Field("Table ARN", appaws.Str(e.TableArn))
+	d.Field("Status", v.Status())
+	if failure := v.Failure(); failure != "" {
+		d.Field("Failure", failure)
+	}
+	d.Field("Type", v.ExportType())
+	if e.ExportFormat != "" {
+		d.Field("Format", string(e.ExportFormat))
+	}
+
+	d.Section("Destination")
+	if dest := v.Destination(); dest != "" {
+		d.Field("S3 Location", dest)
+	}
+	d.FieldIf("Bucket Owner", e.S3BucketOwner)
+	d.FieldIf("Manifest", e.ExportManifest)
+
+	d.Section("Progress")
+	if t := appaws.Time(e.ExportTime); !t.IsZero() {
+		d.Field("Point in Time", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := v.StartedAt(); !t.IsZero() {
+		d.Field("Started", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := appaws.Time(e.EndTime); !t.IsZero() {
+		d.Field("Ended", t.Format("2006-01-02 15:04:05"))
+	}
+	if !v.IsInProgress() {
+		d.Field("Items", fmt.Sprintf("%d", v.ItemCount()))
+		d.Field("Billed Size", render.FormatSize(appaws.Int64(e.BilledSizeBytes)))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ExportRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*ExportResource)
+	if !ok {
+		return nil
+	}
+	return []render.SummaryField{
+		{Label: "Export", Value: v.GetName()},
+		{Label: "Status", Value: v.Status()},
+		{Label: "Destination", Value: v.Destination()},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while an export is running so
+// its completion shows up without a manual reload.
+func (r *ExportRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if e, ok := dao.UnwrapResource(res).(*ExportResource); ok && e.IsInProgress() {
+			return true
+		}
+	}
+	return false
+}
@@ -3,12 +3,18 @@ package tables
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
 	ddbClient "github.com/clawscli/claws/custom/dynamodb"
+	apps3 "github.com/clawscli/claws/custom/s3"
 	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 )
 
@@ -35,6 +41,10 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "SwitchToOnDemand",
 			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				table, ok := dao.UnwrapResource(r).(*TableResource)
+				return ok && !table.IsOnDemand()
+			},
 		},
 		{
 			Name:      "Switch to Provisioned",
@@ -42,6 +52,76 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "SwitchToProvisioned",
 			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				table, ok := dao.UnwrapResource(r).(*TableResource)
+				return ok && table.IsOnDemand()
+			},
+		},
+		{
+			Name:      "Update Auto Scaling",
+			Shortcut:  "a",
+			Type:      action.ActionTypeAPI,
+			Operation: "UpdateAutoScaling",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				table, ok := dao.UnwrapResource(r).(*TableResource)
+				return ok && !table.IsOnDemand()
+			},
+			Input: &action.InputSpec{
+				Label:       "Reads and writes of table and GSIs: min,max,target%",
+				Placeholder: "5,100,70",
+			},
+		},
+		{
+			Name:      "Enable Point-in-Time Recovery",
+			Shortcut:  "t",
+			Type:      action.ActionTypeAPI,
+			Operation: "EnablePITR",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				table, ok := dao.UnwrapResource(r).(*TableResource)
+				return ok && !table.PITREnabled()
+			},
+		},
+		{
+			Name:      "Disable Point-in-Time Recovery",
+			Shortcut:  "t",
+			Type:      action.ActionTypeAPI,
+			Operation: "DisablePITR",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				table, ok := dao.UnwrapResource(r).(*TableResource)
+				return ok && table.PITREnabled()
+			},
+		},
+		{
+			Name:      "Create Backup",
+			Shortcut:  "b",
+			Type:      action.ActionTypeAPI,
+			Operation: "CreateBackup",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Backup name (default: <table>-<timestamp>)",
+				Placeholder: "my-table-before-migration",
+				Optional:    true,
+			},
+		},
+		{
+			Name:      "Export to S3",
+			Shortcut:  "x",
+			Type:      action.ActionTypeAPI,
+			Operation: "ExportToS3",
+			Confirm:   action.ConfirmSimple,
+			// Exports read from the continuous backup, so PITR must be on.
+			// The list view has not loaded PITR yet; the API rejects it then.
+			Filter: func(r dao.Resource) bool {
+				table, ok := dao.UnwrapResource(r).(*TableResource)
+				return ok && (!table.PITRLoaded || table.PITREnabled())
+			},
+			Input: &action.InputSpec{
+				Label:   "Destination bucket",
+				Choices: exportBuckets,
+			},
 		},
 		{
 			Name:      "Delete",
@@ -67,6 +147,16 @@ func executeTableAction(ctx context.Context, act action.Action, resource dao.Res
 		return executeSwitchToOnDemand(ctx, resource)
 	case "SwitchToProvisioned":
 		return executeSwitchToProvisioned(ctx, resource)
+	case "UpdateAutoScaling":
+		return executeUpdateAutoScaling(ctx, resource)
+	case "EnablePITR":
+		return executeSetPITR(ctx, resource, true)
+	case "DisablePITR":
+		return executeSetPITR(ctx, resource, false)
+	case "CreateBackup":
+		return executeCreateBackup(ctx, resource)
+	case "ExportToS3":
+		return executeExportToS3(ctx, resource)
 	case "DeleteTable":
 		return executeDeleteTable(ctx, resource)
 	default:
@@ -268,3 +358,181 @@ func executeDeleteTable(ctx context.Context, resource dao.Resource) action.Actio
 		Message: fmt.Sprintf("Deleted table %s", tableName),
 	}
 }
+
+func executeUpdateAutoScaling(ctx context.Context, resource dao.Resource) action.ActionResult {
+	table, ok := dao.UnwrapResource(resource).(*TableResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if table.IsOnDemand() {
+		return action.FailResult(fmt.Errorf("table %s is in on-demand mode, switch to provisioned first", table.GetName()))
+	}
+
+	input, err := ParseAutoScalingInput(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := appaws.Client(ctx, applicationautoscaling.NewFromConfig)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	indexes := []string{""}
+	for _, gsi := range table.GlobalSecondaryIndexes() {
+		indexes = append(indexes, appaws.Str(gsi.IndexName))
+	}
+
+	for _, index := range indexes {
+		resourceID := scalingResourceID(table.GetName(), index)
+		for _, write := range []bool{false, true} {
+			dim := scalableDimension(index, write)
+			_, err := client.RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+				ServiceNamespace:  aastypes.ServiceNamespaceDynamodb,
+				ResourceId:        &resourceID,
+				ScalableDimension: dim,
+				MinCapacity:       &input.Min,
+				MaxCapacity:       &input.Max,
+			})
+			if err != nil {
+				return action.FailResultf(err, "register scalable target %s %s", resourceID, dim)
+			}
+
+			policyName := defaultPolicyName(resourceID, write)
+			if existing, ok := table.ScalingFor(index, write); ok && existing.PolicyName != "" {
+				policyName = existing.PolicyName
+			}
+			_, err = client.PutScalingPolicy(ctx, &applicationautoscaling.PutScalingPolicyInput{
+				ServiceNamespace:  aastypes.ServiceNamespaceDynamodb,
+				ResourceId:        &resourceID,
+				ScalableDimension: dim,
+				PolicyName:        &policyName,
+				PolicyType:        aastypes.PolicyTypeTargetTrackingScaling,
+				TargetTrackingScalingPolicyConfiguration: &aastypes.TargetTrackingScalingPolicyConfiguration{
+					TargetValue: &input.Target,
+					PredefinedMetricSpecification: &aastypes.PredefinedMetricSpecification{
+						PredefinedMetricType: utilizationMetric(write),
+					},
+				},
+			})
+			if err != nil {
+				return action.FailResultf(err, "put scaling policy %s", policyName)
+			}
+		}
+	}
+
+	setting := ScalingSetting{Min: input.Min, Max: input.Max, Target: input.Target}
+	msg := fmt.Sprintf("Auto scaling for %s set to %s", table.GetName(), setting.Label())
+	if gsis := len(indexes) - 1; gsis > 0 {
+		msg += fmt.Sprintf(" (table and %d GSI(s))", gsis)
+	}
+	return action.SuccessResult(msg)
+}
+
+func executeSetPITR(ctx context.Context, resource dao.Resource, enabled bool) action.ActionResult {
+	table, ok := dao.UnwrapResource(resource).(*TableResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := getDynamoDBClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	tableName := table.GetName()
+	_, err = client.UpdateContinuousBackups(ctx, &dynamodb.UpdateContinuousBackupsInput{
+		TableName: &tableName,
+		PointInTimeRecoverySpecification: &types.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: &enabled,
+		},
+	})
+	if err != nil {
+		return action.FailResultf(err, "update continuous backups %s", tableName)
+	}
+
+	if enabled {
+		return action.SuccessResult(fmt.Sprintf("Enabled point-in-time recovery for %s", tableName))
+	}
+	return action.SuccessResult(fmt.Sprintf("Disabled point-in-time recovery for %s", tableName))
+}
+
+// defaultBackupName names an on-demand backup after its table and the time
+// it was taken, e.g. orders-20260102-150405.
+func defaultBackupName(table string, now time.Time) string {
+	return table + "-" + now.UTC().Format("20060102-150405")
+}
+
+func executeCreateBackup(ctx context.Context, resource dao.Resource) action.ActionResult {
+	table, ok := dao.UnwrapResource(resource).(*TableResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := getDynamoDBClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	tableName := table.GetName()
+	backupName := strings.TrimSpace(action.InputFromContext(ctx))
+	if backupName == "" {
+		backupName = defaultBackupName(tableName, time.Now())
+	}
+
+	_, err = client.CreateBackup(ctx, &dynamodb.CreateBackupInput{
+		TableName:  &tableName,
+		BackupName: &backupName,
+	})
+	if err != nil {
+		return action.FailResultf(err, "create backup of %s", tableName)
+	}
+	return action.SuccessResult(fmt.Sprintf("Creating backup %s of %s", backupName, tableName))
+}
+
+// exportBuckets offers same-region buckets as export destinations.
+func exportBuckets(ctx context.Context, _ dao.Resource) ([]action.Choice, error) {
+	buckets, err := apps3.RegionBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	choices := make([]action.Choice, 0, len(buckets))
+	for _, b := range buckets {
+		name := appaws.Str(b.Name)
+		choices = append(choices, action.Choice{Value: name, Label: name})
+	}
+	return choices, nil
+}
+
+func executeExportToS3(ctx context.Context, resource dao.Resource) action.ActionResult {
+	table, ok := dao.UnwrapResource(resource).(*TableResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	bucket := strings.TrimSpace(action.InputFromContext(ctx))
+	if bucket == "" {
+		return action.FailResult(fmt.Errorf("destination bucket is required"))
+	}
+
+	client, err := getDynamoDBClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	tableArn := table.GetARN()
+	output, err := client.ExportTableToPointInTime(ctx, &dynamodb.ExportTableToPointInTimeInput{
+		TableArn:     &tableArn,
+		S3Bucket:     &bucket,
+		ExportFormat: types.ExportFormatDynamodbJson,
+	})
+	if err != nil {
+		return action.FailResultf(err, "export %s to s3://%s", table.GetName(), bucket)
+	}
+
+	msg := fmt.Sprintf("Started export of %s to s3://%s", table.GetName(), bucket)
+	if output.ExportDescription != nil {
+		msg += fmt.Sprintf(" (export %s)", appaws.ExtractResourceName(appaws.Str(output.ExportDescription.ExportArn)))
+	}
+	return action.SuccessResult(msg)
+}
@@ -0,0 +1,127 @@
+package tables
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+)
+
+// capacityPeriod is the CloudWatch period used for consumed capacity; Sum
+// over the period divided by its length gives units per second.
+const capacityPeriod = 300
+
+// ConsumedCapacity is the consumed capacity of a table or GSI over the
+// metrics window, in capacity units per second.
+type ConsumedCapacity struct {
+	ReadAvg, ReadPeak   float64
+	WriteAvg, WritePeak float64
+}
+
+// ScalingSetting is the Application Auto Scaling configuration of one
+// capacity dimension of a table or GSI.
+type ScalingSetting struct {
+	Index      string // "" for the table itself
+	Write      bool
+	Min, Max   int32
+	Target     float64 // Target utilization percent; 0 without a target tracking policy
+	PolicyName string
+}
+
+// Label describes the setting, e.g. "5-100, target 70%".
+func (s ScalingSetting) Label() string {
+	label := fmt.Sprintf("%d-%d", s.Min, s.Max)
+	if s.Target > 0 {
+		label += fmt.Sprintf(", target %s%%", strconv.FormatFloat(s.Target, 'f', -1, 64))
+	}
+	return label
+}
+
+// scalingResourceID returns the Application Auto Scaling resource ID of a
+// table ("table/orders") or GSI ("table/orders/index/by-customer").
+func scalingResourceID(table, index string) string {
+	if index == "" {
+		return "table/" + table
+	}
+	return "table/" + table + "/index/" + index
+}
+
+// parseScalingResourceID returns the index name of a resource ID, "" for the table.
+func parseScalingResourceID(id string) string {
+	_, index, _ := strings.Cut(id, "/index/")
+	return index
+}
+
+// scalableDimension returns the dimension for reads or writes of a table or GSI.
+func scalableDimension(index string, write bool) aastypes.ScalableDimension {
+	switch {
+	case index == "" && !write:
+		return aastypes.ScalableDimensionDynamoDBTableReadCapacityUnits
+	case index == "" && write:
+		return aastypes.ScalableDimensionDynamoDBTableWriteCapacityUnits
+	case !write:
+		return aastypes.ScalableDimensionDynamoDBIndexReadCapacityUnits
+	default:
+		return aastypes.ScalableDimensionDynamoDBIndexWriteCapacityUnits
+	}
+}
+
+// isWriteDimension reports whether a dimension scales write capacity.
+func isWriteDimension(dim aastypes.ScalableDimension) bool {
+	return strings.HasSuffix(string(dim), "WriteCapacityUnits")
+}
+
+// utilizationMetric returns the predefined target tracking metric.
+func utilizationMetric(write bool) aastypes.MetricType {
+	if write {
+		return aastypes.MetricTypeDynamoDBWriteCapacityUtilization
+	}
+	return aastypes.MetricTypeDynamoDBReadCapacityUtilization
+}
+
+// defaultPolicyName matches the name the DynamoDB console gives scaling policies.
+func defaultPolicyName(resourceID string, write bool) string {
+	return string(utilizationMetric(write)) + ":" + resourceID
+}
+
+// AutoScalingInput is a parsed "min,max,target" autoscaling update.
+type AutoScalingInput struct {
+	Min, Max int32
+	Target   float64
+}
+
+// ParseAutoScalingInput parses "min,max,target", e.g. "5,100,70".
+func ParseAutoScalingInput(s string) (AutoScalingInput, error) {
+	fields := strings.Split(s, ",")
+	if len(fields) != 3 {
+		return AutoScalingInput{}, fmt.Errorf("expected min,max,target (e.g. 5,100,70), got %q", s)
+	}
+	minCap, err := strconv.ParseInt(strings.TrimSpace(fields[0]), 10, 32)
+	if err != nil || minCap < 1 {
+		return AutoScalingInput{}, fmt.Errorf("invalid minimum capacity %q", fields[0])
+	}
+	maxCap, err := strconv.ParseInt(strings.TrimSpace(fields[1]), 10, 32)
+	if err != nil || maxCap < minCap {
+		return AutoScalingInput{}, fmt.Errorf("invalid maximum capacity %q: must be at least the minimum", fields[1])
+	}
+	target, err := strconv.ParseFloat(strings.TrimSpace(fields[2]), 64)
+	if err != nil || target < 20 || target > 90 {
+		return AutoScalingInput{}, fmt.Errorf("invalid target utilization %q: must be 20-90", fields[2])
+	}
+	return AutoScalingInput{Min: int32(minCap), Max: int32(maxCap), Target: target}, nil
+}
+
+// usage describes consumed against provisioned capacity, e.g.
+// "avg 12.5 / peak 40.0 RCU/s (40% of 100)"; provisioned 0 means on-demand.
+func usage(avg, peak float64, provisioned int64, unit string) string {
+	s := fmt.Sprintf("avg %s / peak %s %s/s", formatUnits(avg), formatUnits(peak), unit)
+	if provisioned > 0 {
+		s += fmt.Sprintf(" (%.0f%% of %d)", peak*100/float64(provisioned), provisioned)
+	}
+	return s
+}
+
+func formatUnits(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64)
+}
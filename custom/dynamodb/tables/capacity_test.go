@@ -0,0 +1,108 @@
+package tables
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+func TestParseAutoScalingInput(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    AutoScalingInput
+		wantErr bool
+	}{
+		{"5,100,70", AutoScalingInput{Min: 5, Max: 100, Target: 70}, false},
+		{" 1 , 1 , 20 ", AutoScalingInput{Min: 1, Max: 1, Target: 20}, false},
+		{"5,100", AutoScalingInput{}, true},
+		{"0,100,70", AutoScalingInput{}, true},
+		{"50,10,70", AutoScalingInput{}, true},
+		{"5,100,95", AutoScalingInput{}, true},
+		{"a,b,c", AutoScalingInput{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseAutoScalingInput(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAutoScalingInput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAutoScalingInput() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScalingResourceID(t *testing.T) {
+	if got := scalingResourceID("orders", ""); got != "table/orders" {
+		t.Errorf("scalingResourceID(table) = %q", got)
+	}
+	id := scalingResourceID("orders", "by-customer")
+	if id != "table/orders/index/by-customer" {
+		t.Errorf("scalingResourceID(index) = %q", id)
+	}
+	if got := parseScalingResourceID(id); got != "by-customer" {
+		t.Errorf("parseScalingResourceID() = %q", got)
+	}
+	if got := scalableDimension("by-customer", true); got != aastypes.ScalableDimensionDynamoDBIndexWriteCapacityUnits {
+		t.Errorf("scalableDimension() = %q", got)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	if got := usage(12.5, 40, 100, "RCU"); got != "avg 12.5 / peak 40.0 RCU/s (40% of 100)" {
+		t.Errorf("usage(provisioned) = %q", got)
+	}
+	if got := usage(1, 2, 0, "WCU"); got != "avg 1.0 / peak 2.0 WCU/s" {
+		t.Errorf("usage(on-demand) = %q", got)
+	}
+}
+
+func TestConsumedCapacity(t *testing.T) {
+	results := []cwtypes.MetricDataResult{
+		{Id: aws.String("r0"), Values: []float64{3000, 6000}},
+		{Id: aws.String("w0"), Values: []float64{300}},
+		{Id: aws.String("r1"), Values: []float64{1500}},
+		{Id: aws.String("bogus"), Values: []float64{1}},
+	}
+	got := consumedCapacity(results, []string{"", "by-customer"})
+
+	table := got[""]
+	if table.ReadAvg != 15 || table.ReadPeak != 20 || table.WriteAvg != 1 {
+		t.Errorf("table consumed = %+v", table)
+	}
+	if gsi := got["by-customer"]; gsi.ReadPeak != 5 || gsi.WritePeak != 0 {
+		t.Errorf("gsi consumed = %+v", gsi)
+	}
+}
+
+func TestScalingSettings(t *testing.T) {
+	targets := []aastypes.ScalableTarget{
+		{ResourceId: aws.String("table/orders"), ScalableDimension: aastypes.ScalableDimensionDynamoDBTableReadCapacityUnits, MinCapacity: aws.Int32(5), MaxCapacity: aws.Int32(100)},
+		{ResourceId: aws.String("table/orders/index/gsi1"), ScalableDimension: aastypes.ScalableDimensionDynamoDBIndexWriteCapacityUnits, MinCapacity: aws.Int32(1), MaxCapacity: aws.Int32(10)},
+	}
+	policies := []aastypes.ScalingPolicy{{
+		ResourceId:        aws.String("table/orders"),
+		ScalableDimension: aastypes.ScalableDimensionDynamoDBTableReadCapacityUnits,
+		PolicyName:        aws.String("reads"),
+		TargetTrackingScalingPolicyConfiguration: &aastypes.TargetTrackingScalingPolicyConfiguration{
+			TargetValue: aws.Float64(70),
+		},
+	}}
+
+	table := &TableResource{Scaling: scalingSettings(targets, policies)}
+
+	reads, ok := table.ScalingFor("", false)
+	if !ok || reads.Label() != "5-100, target 70%" || reads.PolicyName != "reads" {
+		t.Errorf("table reads = %+v, %v", reads, ok)
+	}
+	writes, ok := table.ScalingFor("gsi1", true)
+	if !ok || writes.Label() != "1-10" {
+		t.Errorf("gsi writes = %+v, %v", writes, ok)
+	}
+	if _, ok := table.ScalingFor("", true); ok {
+		t.Error("table writes should have no scaling setting")
+	}
+}
@@ -3,7 +3,12 @@ package tables
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 
@@ -13,21 +18,36 @@ import (
 	"github.com/clawscli/claws/internal/log"
 )
 
+// capacityWindow is how far back consumed capacity is shown in the detail view.
+const capacityWindow = time.Hour
+
 // TableDAO provides data access for DynamoDB tables
 type TableDAO struct {
 	dao.BaseDAO
-	client *dynamodb.Client
+	client    *dynamodb.Client
+	cwClient  *cloudwatch.Client
+	aasClient *applicationautoscaling.Client
 }
 
 // NewTableDAO creates a new TableDAO
 func NewTableDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, dynamodb.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	cwClient, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	aasClient, err := appaws.Client(ctx, applicationautoscaling.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TableDAO{
-		BaseDAO: dao.NewBaseDAO("dynamodb", "tables"),
-		client:  dynamodb.NewFromConfig(cfg),
+		BaseDAO:   dao.NewBaseDAO("dynamodb", "tables"),
+		client:    client,
+		cwClient:  cwClient,
+		aasClient: aasClient,
 	}, nil
 }
 
@@ -76,7 +96,172 @@ func (d *TableDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 		return nil, fmt.Errorf("table not found: %s", id)
 	}
 
-	return NewTableResource(*output.Table), nil
+	table := NewTableResource(*output.Table)
+	d.loadCapacity(ctx, table)
+	d.loadAutoScaling(ctx, table)
+	d.loadPITR(ctx, table)
+	return table, nil
+}
+
+// loadCapacity fetches consumed capacity of the table and its GSIs. Best-effort.
+func (d *TableDAO) loadCapacity(ctx context.Context, table *TableResource) {
+	indexes := []string{""}
+	for _, gsi := range table.GlobalSecondaryIndexes() {
+		indexes = append(indexes, appaws.Str(gsi.IndexName))
+	}
+
+	var queries []cwtypes.MetricDataQuery
+	for i, index := range indexes {
+		dims := []cwtypes.Dimension{{Name: appaws.StringPtr("TableName"), Value: appaws.StringPtr(table.GetName())}}
+		if index != "" {
+			dims = append(dims, cwtypes.Dimension{Name: appaws.StringPtr("GlobalSecondaryIndexName"), Value: appaws.StringPtr(index)})
+		}
+		for _, m := range []struct{ prefix, name string }{
+			{"r", "ConsumedReadCapacityUnits"},
+			{"w", "ConsumedWriteCapacityUnits"},
+		} {
+			queries = append(queries, cwtypes.MetricDataQuery{
+				Id: appaws.StringPtr(fmt.Sprintf("%s%d", m.prefix, i)),
+				MetricStat: &cwtypes.MetricStat{
+					Metric: &cwtypes.Metric{
+						Namespace:  appaws.StringPtr("AWS/DynamoDB"),
+						MetricName: appaws.StringPtr(m.name),
+						Dimensions: dims,
+					},
+					Period: appaws.Int32Ptr(capacityPeriod),
+					Stat:   appaws.StringPtr("Sum"),
+				},
+			})
+		}
+	}
+
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-capacityWindow)
+	output, err := d.cwClient.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime:         &start,
+		EndTime:           &end,
+		MetricDataQueries: queries,
+	})
+	if err != nil {
+		log.Debug("failed to get consumed capacity", "table", table.GetName(), "error", err)
+		return
+	}
+	table.Consumed = consumedCapacity(output.MetricDataResults, indexes)
+}
+
+// consumedCapacity maps "r<i>"/"w<i>" query results back to table ("") and
+// index names, converting per-period sums to units per second.
+func consumedCapacity(results []cwtypes.MetricDataResult, indexes []string) map[string]ConsumedCapacity {
+	consumed := make(map[string]ConsumedCapacity, len(indexes))
+	for _, res := range results {
+		var kind byte
+		var i int
+		if _, err := fmt.Sscanf(appaws.Str(res.Id), "%c%d", &kind, &i); err != nil || i >= len(indexes) {
+			continue
+		}
+		avg, peak := perSecond(res.Values)
+		c := consumed[indexes[i]]
+		if kind == 'w' {
+			c.WriteAvg, c.WritePeak = avg, peak
+		} else {
+			c.ReadAvg, c.ReadPeak = avg, peak
+		}
+		consumed[indexes[i]] = c
+	}
+	return consumed
+}
+
+// perSecond returns the average and peak of per-period sums as units per second.
+func perSecond(sums []float64) (avg, peak float64) {
+	if len(sums) == 0 {
+		return 0, 0
+	}
+	var total float64
+	for _, v := range sums {
+		total += v
+		peak = max(peak, v)
+	}
+	return total / float64(len(sums)) / capacityPeriod, peak / capacityPeriod
+}
+
+// loadAutoScaling fetches scalable targets and target tracking policies
+// of the table and its GSIs. Best-effort.
+func (d *TableDAO) loadAutoScaling(ctx context.Context, table *TableResource) {
+	ids := []string{scalingResourceID(table.GetName(), "")}
+	for _, gsi := range table.GlobalSecondaryIndexes() {
+		ids = append(ids, scalingResourceID(table.GetName(), appaws.Str(gsi.IndexName)))
+	}
+
+	targets, err := d.aasClient.DescribeScalableTargets(ctx, &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace: aastypes.ServiceNamespaceDynamodb,
+		ResourceIds:      ids,
+	})
+	if err != nil {
+		log.Debug("failed to describe scalable targets", "table", table.GetName(), "error", err)
+		return
+	}
+	if len(targets.ScalableTargets) == 0 {
+		return
+	}
+
+	var policies []aastypes.ScalingPolicy
+	seen := make(map[string]bool)
+	for _, t := range targets.ScalableTargets {
+		id := appaws.Str(t.ResourceId)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		output, err := d.aasClient.DescribeScalingPolicies(ctx, &applicationautoscaling.DescribeScalingPoliciesInput{
+			ServiceNamespace: aastypes.ServiceNamespaceDynamodb,
+			ResourceId:       &id,
+		})
+		if err != nil {
+			log.Debug("failed to describe scaling policies", "resource", id, "error", err)
+			continue
+		}
+		policies = append(policies, output.ScalingPolicies...)
+	}
+	table.Scaling = scalingSettings(targets.ScalableTargets, policies)
+}
+
+// scalingSettings joins scalable targets with their target tracking policies.
+func scalingSettings(targets []aastypes.ScalableTarget, policies []aastypes.ScalingPolicy) []ScalingSetting {
+	settings := make([]ScalingSetting, 0, len(targets))
+	for _, t := range targets {
+		s := ScalingSetting{
+			Index: parseScalingResourceID(appaws.Str(t.ResourceId)),
+			Write: isWriteDimension(t.ScalableDimension),
+			Min:   appaws.Int32(t.MinCapacity),
+			Max:   appaws.Int32(t.MaxCapacity),
+		}
+		for _, p := range policies {
+			if appaws.Str(p.ResourceId) != appaws.Str(t.ResourceId) || p.ScalableDimension != t.ScalableDimension ||
+				p.TargetTrackingScalingPolicyConfiguration == nil {
+				continue
+			}
+			s.Target = appaws.Float64(p.TargetTrackingScalingPolicyConfiguration.TargetValue)
+			s.PolicyName = appaws.Str(p.PolicyName)
+		}
+		settings = append(settings, s)
+	}
+	return settings
+}
+
+// loadPITR fetches the point-in-time recovery status. Best-effort.
+func (d *TableDAO) loadPITR(ctx context.Context, table *TableResource) {
+	name := table.GetName()
+	output, err := d.client.DescribeContinuousBackups(ctx, &dynamodb.DescribeContinuousBackupsInput{
+		TableName: &name,
+	})
+	if err != nil {
+		log.Debug("failed to describe continuous backups", "table", name, "error", err)
+		return
+	}
+	if output.ContinuousBackupsDescription != nil {
+		table.PITR = output.ContinuousBackupsDescription.PointInTimeRecoveryDescription
+		table.PITRLoaded = true
+	}
 }
 
 func (d *TableDAO) Delete(ctx context.Context, id string) error {
@@ -102,6 +287,12 @@ func (d *TableDAO) Delete(ctx context.Context, id string) error {
 type TableResource struct {
 	dao.BaseResource
 	Item types.TableDescription
+
+	// Loaded by Get only
+	Consumed   map[string]ConsumedCapacity // By GSI name; "" is the table
+	Scaling    []ScalingSetting
+	PITR       *types.PointInTimeRecoveryDescription
+	PITRLoaded bool
 }
 
 // NewTableResource creates a new TableResource
@@ -227,3 +418,23 @@ func (r *TableResource) TableId() string {
 func (r *TableResource) StreamArn() string {
 	return appaws.Str(r.Item.LatestStreamArn)
 }
+
+// IsOnDemand reports whether the table uses on-demand (pay per request) billing
+func (r *TableResource) IsOnDemand() bool {
+	return r.BillingMode() == string(types.BillingModePayPerRequest)
+}
+
+// PITREnabled reports whether point-in-time recovery is enabled (false if not loaded)
+func (r *TableResource) PITREnabled() bool {
+	return r.PITR != nil && r.PITR.PointInTimeRecoveryStatus == types.PointInTimeRecoveryStatusEnabled
+}
+
+// ScalingFor returns the autoscaling setting of a table ("") or GSI dimension
+func (r *TableResource) ScalingFor(index string, write bool) (ScalingSetting, bool) {
+	for _, s := range r.Scaling {
+		if s.Index == index && s.Write == write {
+			return s, true
+		}
+	}
+	return ScalingSetting{}, false
+}
@@ -165,25 +165,42 @@ func (r *TableRenderer) RenderDetail(resource dao.Resource) string {
 	}
 
 	// Capacity
-	if billing == "PROVISIONED" {
-		d.Section("Provisioned Capacity")
-		d.Field("Read Capacity", fmt.Sprintf("%d RCU", table.ReadCapacity()))
-		d.Field("Write Capacity", fmt.Sprintf("%d WCU", table.WriteCapacity()))
-	}
+	renderCapacity(d, table)
 
 	// Global Secondary Indexes
-	gsis := table.GlobalSecondaryIndexes()
-	if len(gsis) > 0 {
-		d.Section("Global Secondary Indexes")
-		for _, gsi := range gsis {
-			if gsi.IndexName == nil {
-				continue
+	for _, gsi := range table.GlobalSecondaryIndexes() {
+		if gsi.IndexName == nil {
+			continue
+		}
+		d.Section("GSI: " + *gsi.IndexName)
+		status := "UNKNOWN"
+		if gsi.IndexStatus != "" {
+			status = string(gsi.IndexStatus)
+		}
+		d.Field("Status", status)
+		var rcu, wcu int64
+		if pt := gsi.ProvisionedThroughput; pt != nil && !table.IsOnDemand() {
+			rcu, wcu = appaws.Int64(pt.ReadCapacityUnits), appaws.Int64(pt.WriteCapacityUnits)
+		}
+		renderCapacityFields(d, table, *gsi.IndexName, rcu, wcu)
+	}
+
+	// Point-in-Time Recovery
+	if table.PITRLoaded {
+		d.Section("Point-in-Time Recovery")
+		if table.PITREnabled() {
+			d.Field("Status", "Enabled")
+			if t := table.PITR.EarliestRestorableDateTime; t != nil {
+				d.Field("Earliest Restorable", t.Format("2006-01-02 15:04:05"))
+			}
+			if t := table.PITR.LatestRestorableDateTime; t != nil {
+				d.Field("Latest Restorable", t.Format("2006-01-02 15:04:05"))
 			}
-			status := "UNKNOWN"
-			if gsi.IndexStatus != "" {
-				status = string(gsi.IndexStatus)
+			if days := table.PITR.RecoveryPeriodInDays; days != nil {
+				d.Field("Recovery Period", fmt.Sprintf("%d days", *days))
 			}
-			d.Field(*gsi.IndexName, status)
+		} else {
+			d.Field("Status", "Disabled")
 		}
 	}
 
@@ -246,6 +263,46 @@ func (r *TableRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// renderCapacity writes the table's own capacity section
+func renderCapacity(d *render.DetailBuilder, table *TableResource) {
+	if table.IsOnDemand() {
+		if _, ok := table.Consumed[""]; !ok {
+			return
+		}
+		d.Section("Capacity (On-Demand)")
+		renderCapacityFields(d, table, "", 0, 0)
+		return
+	}
+	d.Section("Provisioned Capacity")
+	renderCapacityFields(d, table, "", table.ReadCapacity(), table.WriteCapacity())
+}
+
+// renderCapacityFields writes read/write capacity of the table ("") or a GSI:
+// consumed over the last hour against provisioned units, plus autoscaling.
+func renderCapacityFields(d *render.DetailBuilder, table *TableResource, index string, rcu, wcu int64) {
+	consumed, hasConsumed := table.Consumed[index]
+	for _, dim := range []struct {
+		label       string
+		unit        string
+		write       bool
+		provisioned int64
+		avg, peak   float64
+	}{
+		{"Read", "RCU", false, rcu, consumed.ReadAvg, consumed.ReadPeak},
+		{"Write", "WCU", true, wcu, consumed.WriteAvg, consumed.WritePeak},
+	} {
+		if dim.provisioned > 0 {
+			d.Field(dim.label+" Capacity", fmt.Sprintf("%d %s", dim.provisioned, dim.unit))
+		}
+		if hasConsumed {
+			d.Field(dim.label+" Consumed (1h)", usage(dim.avg, dim.peak, dim.provisioned, dim.unit))
+		}
+		if s, ok := table.ScalingFor(index, dim.write); ok {
+			d.Field(dim.label+" Auto Scaling", s.Label())
+		}
+	}
+}
+
 // RenderSummary returns summary fields for the header panel
 func (r *TableRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	table, ok := resource.(*TableResource)
@@ -315,3 +372,21 @@ func (r *TableRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 
 	return fields
 }
+
+// Navigations returns navigation shortcuts
+func (r *TableRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	table, ok := resource.(*TableResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "b", Label: "Backups", Service: "dynamodb", Resource: "backups",
+			FilterField: "TableName", FilterValue: table.GetName(),
+		},
+		{
+			Key: "x", Label: "Exports", Service: "dynamodb", Resource: "exports",
+			FilterField: "TableArn", FilterValue: table.GetARN(),
+		},
+	}
+}
@@ -145,3 +145,10 @@ func TestTableResource_TableClass(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultBackupName(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	if got := defaultBackupName("orders", now); got != "orders-20260102-150405" {
+		t.Errorf("defaultBackupName() = %q", got)
+	}
+}
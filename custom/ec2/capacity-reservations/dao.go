@@ -21,13 +21,13 @@ type CapacityReservationDAO struct {
 
 // NewCapacityReservationDAO creates a new CapacityReservationDAO
 func NewCapacityReservationDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &CapacityReservationDAO{
 		BaseDAO: dao.NewBaseDAO("ec2", "capacity-reservations"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -10,9 +10,5 @@ import (
 
 // GetClient returns an EC2 client configured for the current context
 func GetClient(ctx context.Context) (*ec2.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return ec2.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, ec2.NewFromConfig)
 }
@@ -20,13 +20,13 @@ type ElasticIPDAO struct {
 
 // NewElasticIPDAO creates a new ElasticIPDAO
 func NewElasticIPDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ElasticIPDAO{
 		BaseDAO: dao.NewBaseDAO("ec2", "elastic-ips"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,32 @@
+package ec2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// ListFilters translates the generic dao list filters in ctx into Describe*
+// filters. stateFilter names the resource's state filter, e.g.
+// "instance-state-name"; EC2 states are lower case. Name filters are left
+// to the caller, as EC2 cannot match a substring case-insensitively.
+func ListFilters(ctx context.Context, stateFilter string) []types.Filter {
+	var filters []types.Filter
+	if key, value, ok := dao.TagFilterFromContext(ctx); ok {
+		filters = append(filters, types.Filter{
+			Name:   appaws.StringPtr("tag:" + key),
+			Values: []string{value},
+		})
+	}
+	if status := dao.GetFilterFromContext(ctx, dao.FilterStatus); status != "" && stateFilter != "" {
+		filters = append(filters, types.Filter{
+			Name:   appaws.StringPtr(stateFilter),
+			Values: []string{strings.ToLower(status)},
+		})
+	}
+	return filters
+}
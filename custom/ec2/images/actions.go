@@ -3,11 +3,14 @@ package images
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	appec2 "github.com/clawscli/claws/custom/ec2"
 	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 )
 
@@ -20,6 +23,39 @@ func init() {
 			Operation: "DeregisterImage",
 			Confirm:   action.ConfirmDangerous,
 		},
+		{
+			Name:      "Deregister + Delete Snapshots",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeregisterImageAndSnapshots",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				img, ok := dao.UnwrapResource(r).(*ImageResource)
+				return ok && len(img.SnapshotIDs()) > 0
+			},
+		},
+		{
+			Name:      "Copy to Region",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CopyImage",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Destination region",
+				Placeholder: "e.g. us-west-2",
+			},
+		},
+		{
+			Name:      "Share with Accounts",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "ModifyLaunchPermissions",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Account IDs, comma-separated (prefix with - to unshare)",
+				Placeholder: "111122223333, -444455556666",
+			},
+		},
 	})
 
 	action.RegisterExecutor("ec2", "images", executeImageAction)
@@ -29,6 +65,12 @@ func executeImageAction(ctx context.Context, act action.Action, resource dao.Res
 	switch act.Operation {
 	case "DeregisterImage":
 		return executeDeregisterImage(ctx, resource)
+	case "DeregisterImageAndSnapshots":
+		return executeDeregisterImageAndSnapshots(ctx, resource)
+	case "CopyImage":
+		return executeCopyImage(ctx, resource)
+	case "ModifyLaunchPermissions":
+		return executeModifyLaunchPermissions(ctx, resource)
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -53,3 +95,112 @@ func executeDeregisterImage(ctx context.Context, resource dao.Resource) action.A
 		Message: fmt.Sprintf("Deregistered image %s", imageID),
 	}
 }
+
+func executeDeregisterImageAndSnapshots(ctx context.Context, resource dao.Resource) action.ActionResult {
+	img, ok := dao.UnwrapResource(resource).(*ImageResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	imageID := img.GetID()
+	output, err := client.DeregisterImage(ctx, &ec2.DeregisterImageInput{
+		ImageId:                   &imageID,
+		DeleteAssociatedSnapshots: appaws.BoolPtr(true),
+	})
+	if err != nil {
+		return action.FailResultf(err, "deregister image %s", imageID)
+	}
+
+	var failed []string
+	for _, res := range output.DeleteSnapshotResults {
+		if res.ReturnCode != types.SnapshotReturnCodesSuccess {
+			failed = append(failed, fmt.Sprintf("%s (%s)", appaws.Str(res.SnapshotId), res.ReturnCode))
+		}
+	}
+	if len(failed) > 0 {
+		return action.FailResult(fmt.Errorf("deregistered image %s but could not delete snapshots: %s", imageID, strings.Join(failed, ", ")))
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deregistered image %s and deleted %d snapshot(s)", imageID, len(output.DeleteSnapshotResults)))
+}
+
+func executeCopyImage(ctx context.Context, resource dao.Resource) action.ActionResult {
+	img, ok := dao.UnwrapResource(resource).(*ImageResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	sourceRegion := dao.GetResourceRegion(resource)
+	if sourceRegion == "" {
+		sourceRegion = appaws.CurrentRegion(ctx)
+	}
+	dest := strings.TrimSpace(action.InputFromContext(ctx))
+	if err := appec2.ValidateCopyRegion(sourceRegion, dest); err != nil {
+		return action.FailResult(err)
+	}
+
+	// CopyImage is called in the destination region and pulls from the source.
+	client, err := appec2.GetClient(appaws.WithRegionOverride(ctx, dest))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	imageID := img.GetID()
+	name := img.GetName()
+	if name == "" {
+		name = imageID
+	}
+	output, err := client.CopyImage(ctx, &ec2.CopyImageInput{
+		SourceImageId: &imageID,
+		SourceRegion:  &sourceRegion,
+		Name:          &name,
+		Description:   appaws.StringPtr(fmt.Sprintf("Copied from %s in %s", imageID, sourceRegion)),
+		CopyImageTags: appaws.BoolPtr(true),
+	})
+	if err != nil {
+		return action.FailResultf(err, "copy image %s to %s", imageID, dest)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Copying %s to %s as %s", imageID, dest, appaws.Str(output.ImageId)))
+}
+
+func executeModifyLaunchPermissions(ctx context.Context, resource dao.Resource) action.ActionResult {
+	img, ok := dao.UnwrapResource(resource).(*ImageResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	add, remove, err := appec2.ParseAccountChanges(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	mods := &types.LaunchPermissionModifications{}
+	for _, id := range add {
+		mods.Add = append(mods.Add, types.LaunchPermission{UserId: appaws.StringPtr(id)})
+	}
+	for _, id := range remove {
+		mods.Remove = append(mods.Remove, types.LaunchPermission{UserId: appaws.StringPtr(id)})
+	}
+
+	imageID := img.GetID()
+	_, err = client.ModifyImageAttribute(ctx, &ec2.ModifyImageAttributeInput{
+		ImageId:          &imageID,
+		LaunchPermission: mods,
+	})
+	if err != nil {
+		return action.FailResultf(err, "modify launch permissions of %s", imageID)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Image %s %s", imageID, appec2.DescribeAccountChanges(add, remove)))
+}
@@ -0,0 +1,57 @@
+package images
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestCountTemplatesByImage(t *testing.T) {
+	version := func(templateID, imageID string) types.LaunchTemplateVersion {
+		return types.LaunchTemplateVersion{
+			LaunchTemplateId:   aws.String(templateID),
+			LaunchTemplateData: &types.ResponseLaunchTemplateData{ImageId: aws.String(imageID)},
+		}
+	}
+	counts := countTemplatesByImage([]types.LaunchTemplateVersion{
+		version("lt-1", "ami-a"), // default
+		version("lt-1", "ami-a"), // latest, same AMI: counted once
+		version("lt-2", "ami-a"),
+		version("lt-3", "ami-b"),
+		version("lt-4", "resolve:ssm:/aws/service/ami"),
+		{LaunchTemplateId: aws.String("lt-5")},
+	})
+
+	want := map[string]int{"ami-a": 2, "ami-b": 1}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("countTemplatesByImage() = %v, want %v", counts, want)
+	}
+}
+
+func TestImageResource_UsageAndSnapshots(t *testing.T) {
+	img := NewImageResource(types.Image{
+		ImageId: aws.String("ami-a"),
+		BlockDeviceMappings: []types.BlockDeviceMapping{
+			{DeviceName: aws.String("/dev/xvda"), Ebs: &types.EbsBlockDevice{SnapshotId: aws.String("snap-1")}},
+			{DeviceName: aws.String("/dev/sdb"), VirtualName: aws.String("ephemeral0")},
+		},
+	})
+
+	if got := img.SnapshotIDs(); !reflect.DeepEqual(got, []string{"snap-1"}) {
+		t.Errorf("SnapshotIDs() = %v", got)
+	}
+	if got := img.UsageSummary(); got != "" {
+		t.Errorf("UsageSummary() before lookup = %q, want empty", got)
+	}
+
+	img.UsageKnown = true
+	if got := img.UsageSummary(); got != "unused" {
+		t.Errorf("UsageSummary() = %q, want unused", got)
+	}
+	img.InstanceCount, img.TemplateCount = 3, 1
+	if got := img.UsageSummary(); got != "3 inst, 1 LT" {
+		t.Errorf("UsageSummary() = %q", got)
+	}
+}
@@ -3,6 +3,7 @@ package images
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
@@ -10,6 +11,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // ImageDAO provides data access for EC2 AMIs
@@ -20,13 +22,13 @@ type ImageDAO struct {
 
 // NewImageDAO creates a new ImageDAO
 func NewImageDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ImageDAO{
 		BaseDAO: dao.NewBaseDAO("ec2", "images"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -43,10 +45,13 @@ func (d *ImageDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	}
 
 	var resources []dao.Resource
+	var ids []string
 	for _, img := range output.Images {
 		resources = append(resources, NewImageResource(img))
+		ids = append(ids, appaws.Str(img.ImageId))
 	}
 
+	d.applyUsage(ctx, ids, resources)
 	return resources, nil
 }
 
@@ -64,7 +69,117 @@ func (d *ImageDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 		return nil, fmt.Errorf("image not found: %s", id)
 	}
 
-	return NewImageResource(output.Images[0]), nil
+	res := NewImageResource(output.Images[0])
+	d.applyUsage(ctx, []string{id}, []dao.Resource{res})
+
+	attr, err := d.client.DescribeImageAttribute(ctx, &ec2.DescribeImageAttributeInput{
+		ImageId:   &id,
+		Attribute: types.ImageAttributeNameLaunchPermission,
+	})
+	if err != nil {
+		log.Warn("failed to describe image launch permissions", "image", id, "error", err)
+	} else {
+		res.LaunchPermissions = attr.LaunchPermissions
+		res.PermissionsLoaded = true
+	}
+	return res, nil
+}
+
+// maxFilterValues is the most values EC2 accepts in a single filter.
+const maxFilterValues = 200
+
+// applyUsage sets how many instances and launch templates reference each
+// image. Usage is best-effort: on failure the counts are left unknown.
+func (d *ImageDAO) applyUsage(ctx context.Context, imageIDs []string, resources []dao.Resource) {
+	if len(imageIDs) == 0 {
+		return
+	}
+	instances, err := d.countInstances(ctx, imageIDs)
+	if err != nil {
+		log.Warn("failed to count instances using images", "error", err)
+		return
+	}
+	templates, err := d.countLaunchTemplates(ctx)
+	if err != nil {
+		log.Warn("failed to count launch templates using images", "error", err)
+		return
+	}
+	for _, r := range resources {
+		if img, ok := r.(*ImageResource); ok {
+			img.InstanceCount = instances[img.GetID()]
+			img.TemplateCount = templates[img.GetID()]
+			img.UsageKnown = true
+		}
+	}
+}
+
+// countInstances counts non-terminated instances launched from each image.
+func (d *ImageDAO) countInstances(ctx context.Context, imageIDs []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	for start := 0; start < len(imageIDs); start += maxFilterValues {
+		chunk := imageIDs[start:min(start+maxFilterValues, len(imageIDs))]
+		paginator := ec2.NewDescribeInstancesPaginator(d.client, &ec2.DescribeInstancesInput{
+			Filters: []types.Filter{
+				{Name: appaws.StringPtr("image-id"), Values: chunk},
+				{Name: appaws.StringPtr("instance-state-name"), Values: []string{"pending", "running", "stopping", "stopped", "shutting-down"}},
+			},
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, apperrors.Wrap(err, "describe instances")
+			}
+			for _, reservation := range output.Reservations {
+				for _, instance := range reservation.Instances {
+					counts[appaws.Str(instance.ImageId)]++
+				}
+			}
+		}
+	}
+	return counts, nil
+}
+
+// countLaunchTemplates counts launch templates whose default or latest
+// version references each image.
+func (d *ImageDAO) countLaunchTemplates(ctx context.Context) (map[string]int, error) {
+	versions, err := appaws.Paginate(ctx, func(token *string) ([]types.LaunchTemplateVersion, *string, error) {
+		output, err := d.client.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+			Versions:  []string{"$Default", "$Latest"},
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe launch template versions")
+		}
+		return output.LaunchTemplateVersions, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return countTemplatesByImage(versions), nil
+}
+
+// countTemplatesByImage counts distinct templates per image, so a template
+// whose default and latest versions share an AMI is counted once.
+func countTemplatesByImage(versions []types.LaunchTemplateVersion) map[string]int {
+	seen := make(map[string]map[string]bool)
+	for _, v := range versions {
+		if v.LaunchTemplateData == nil {
+			continue
+		}
+		imageID := appaws.Str(v.LaunchTemplateData.ImageId)
+		if !strings.HasPrefix(imageID, "ami-") {
+			continue // empty or resolve:ssm: parameter reference
+		}
+		if seen[imageID] == nil {
+			seen[imageID] = make(map[string]bool)
+		}
+		seen[imageID][appaws.Str(v.LaunchTemplateId)] = true
+	}
+	counts := make(map[string]int, len(seen))
+	for imageID, templates := range seen {
+		counts[imageID] = len(templates)
+	}
+	return counts
 }
 
 func (d *ImageDAO) Delete(ctx context.Context, id string) error {
@@ -84,6 +199,15 @@ func (d *ImageDAO) Delete(ctx context.Context, id string) error {
 type ImageResource struct {
 	dao.BaseResource
 	Item types.Image
+
+	// Usage, populated by List and Get when the lookups succeed.
+	InstanceCount int
+	TemplateCount int
+	UsageKnown    bool
+
+	// LaunchPermissions is populated by Get.
+	LaunchPermissions []types.LaunchPermission
+	PermissionsLoaded bool
 }
 
 // NewImageResource creates a new ImageResource
@@ -166,3 +290,25 @@ func (r *ImageResource) RootDeviceName() string {
 func (r *ImageResource) VirtualizationType() string {
 	return string(r.Item.VirtualizationType)
 }
+
+// SnapshotIDs returns the EBS snapshots backing the image.
+func (r *ImageResource) SnapshotIDs() []string {
+	var ids []string
+	for _, bdm := range r.Item.BlockDeviceMappings {
+		if bdm.Ebs != nil && bdm.Ebs.SnapshotId != nil {
+			ids = append(ids, *bdm.Ebs.SnapshotId)
+		}
+	}
+	return ids
+}
+
+// UsageSummary describes how many instances and launch templates use the image.
+func (r *ImageResource) UsageSummary() string {
+	if !r.UsageKnown {
+		return ""
+	}
+	if r.InstanceCount == 0 && r.TemplateCount == 0 {
+		return "unused"
+	}
+	return fmt.Sprintf("%d inst, %d LT", r.InstanceCount, r.TemplateCount)
+}
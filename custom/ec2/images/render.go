@@ -48,6 +48,19 @@ func NewImageRenderer() render.Renderer {
 					},
 					Priority: 2,
 				},
+				{
+					Name:  "USED BY",
+					Width: 14,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ImageResource); ok {
+							if usage := v.UsageSummary(); usage != "" {
+								return usage
+							}
+						}
+						return render.NoValue
+					},
+					Priority: 3,
+				},
 				{
 					Name:  "TYPE",
 					Width: 10,
@@ -152,6 +165,31 @@ func (r *ImageRenderer) RenderDetail(resource dao.Resource) string {
 	}
 	d.Field("Public", publicStr)
 
+	if v.UsageKnown {
+		d.Section("Usage")
+		d.Field("Instances", fmt.Sprintf("%d", v.InstanceCount))
+		d.Field("Launch Templates", fmt.Sprintf("%d", v.TemplateCount))
+	}
+
+	if v.PermissionsLoaded {
+		d.Section("Launch Permissions")
+		if len(v.LaunchPermissions) == 0 {
+			d.DimIndent("(owner only)")
+		}
+		for _, perm := range v.LaunchPermissions {
+			switch {
+			case perm.UserId != nil:
+				d.Field("Account", *perm.UserId)
+			case perm.Group != "":
+				d.Field("Group", string(perm.Group))
+			case perm.OrganizationArn != nil:
+				d.Field("Organization", *perm.OrganizationArn)
+			case perm.OrganizationalUnitArn != nil:
+				d.Field("OU", *perm.OrganizationalUnitArn)
+			}
+		}
+	}
+
 	// Description
 	if desc := v.Description(); desc != "" {
 		d.Section("Description")
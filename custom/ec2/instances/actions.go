@@ -3,12 +3,17 @@ package instances
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	appec2 "github.com/clawscli/claws/custom/ec2"
+	appssm "github.com/clawscli/claws/custom/ssm"
 	"github.com/clawscli/claws/internal/action"
 	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
 func init() {
@@ -41,12 +46,60 @@ func init() {
 			Operation: "TerminateInstances",
 			Confirm:   action.ConfirmDangerous,
 		},
+		{
+			Name:      "Change Instance Type",
+			Shortcut:  "T",
+			Type:      action.ActionTypeAPI,
+			Operation: "ChangeInstanceType",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "New instance type (empty uses the Compute Optimizer recommendation)",
+				Placeholder: "e.g. t3.large",
+				Optional:    true,
+			},
+			Filter: func(r dao.Resource) bool {
+				ir, ok := dao.UnwrapResource(r).(*InstanceResource)
+				return ok && ir.CanChangeInstanceType()
+			},
+		},
 		{
 			Name:     "SSM Session",
 			Shortcut: "x",
 			Type:     action.ActionTypeExec,
 			Command:  "aws ssm start-session --target ${ID}",
 		},
+		{
+			Name:      "Patch Now",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "PatchNow",
+			Confirm:   action.ConfirmSimple,
+			Input:     appssm.PatchNowInput,
+			Filter: func(r dao.Resource) bool {
+				ir, ok := dao.UnwrapResource(r).(*InstanceResource)
+				return ok && ir.State() == "running"
+			},
+		},
+		{
+			Name:      "Run Command",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "RunCommand",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label: "SSM document",
+				Choices: func(ctx context.Context, r dao.Resource) ([]action.Choice, error) {
+					return appssm.CommandDocumentChoices(ctx, ssmPlatform(r))
+				},
+				Next: appssm.ParameterInputs(func(_ dao.Resource, values []string) string {
+					return values[0]
+				}, 1),
+			},
+			Filter: func(r dao.Resource) bool {
+				ir, ok := dao.UnwrapResource(r).(*InstanceResource)
+				return ok && ir.State() == "running"
+			},
+		},
 	})
 
 	action.RegisterExecutor("ec2", "instances", executeInstanceAction)
@@ -62,6 +115,12 @@ func executeInstanceAction(ctx context.Context, act action.Action, resource dao.
 		return executeRebootInstance(ctx, resource)
 	case "TerminateInstances":
 		return executeTerminateInstance(ctx, resource)
+	case "ChangeInstanceType":
+		return executeChangeInstanceType(ctx, resource)
+	case "PatchNow":
+		return executePatchNow(ctx, resource)
+	case "RunCommand":
+		return executeRunCommand(ctx, resource)
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -134,3 +193,156 @@ func executeTerminateInstance(ctx context.Context, resource dao.Resource) action
 
 	return action.SuccessResult(fmt.Sprintf("Terminated instance %s", instanceID))
 }
+
+// instanceStateWait bounds each wait for the instance to stop or start.
+const instanceStateWait = 10 * time.Minute
+
+// instanceTypeAPI is the subset of the EC2 client the change-type flow uses.
+type instanceTypeAPI interface {
+	ec2.DescribeInstancesAPIClient
+	StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+	StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	ModifyInstanceAttribute(ctx context.Context, params *ec2.ModifyInstanceAttributeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
+}
+
+func executeChangeInstanceType(ctx context.Context, resource dao.Resource) action.ActionResult {
+	ir, ok := dao.UnwrapResource(resource).(*InstanceResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	target := strings.TrimSpace(action.InputFromContext(ctx))
+	if target == "" {
+		target = ir.RecommendedInstanceType()
+	}
+	if target == "" {
+		return action.FailResult(fmt.Errorf("no instance type given and no Compute Optimizer recommendation for %s", ir.GetID()))
+	}
+	if target == ir.InstanceType() {
+		return action.FailResult(fmt.Errorf("instance %s is already %s", ir.GetID(), target))
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	instanceID := ir.GetID()
+	return action.Track(ctx, fmt.Sprintf("Changing %s to %s", instanceID, target),
+		func(ctx context.Context, report func(string)) (string, error) {
+			return changeInstanceType(ctx, client, instanceID, target, report)
+		})
+}
+
+// changeInstanceType stops a running instance, modifies its type, and starts
+// it again. If the modify call fails, the instance is restarted with its
+// original type so a failed resize does not leave it stopped.
+func changeInstanceType(ctx context.Context, client instanceTypeAPI, instanceID, target string, report func(string)) (string, error) {
+	output, err := client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "describe instance %s", instanceID)
+	}
+	if len(output.Reservations) == 0 || len(output.Reservations[0].Instances) == 0 {
+		return "", fmt.Errorf("instance not found: %s", instanceID)
+	}
+	instance := output.Reservations[0].Instances[0]
+	original := string(instance.InstanceType)
+
+	var state types.InstanceStateName
+	if instance.State != nil {
+		state = instance.State.Name
+	}
+	wasRunning := state == types.InstanceStateNameRunning
+	if !wasRunning && state != types.InstanceStateNameStopped {
+		return "", fmt.Errorf("instance %s is %s; it must be running or stopped", instanceID, state)
+	}
+
+	if wasRunning {
+		report("Stopping instance")
+		if _, err := client.StopInstances(ctx, &ec2.StopInstancesInput{InstanceIds: []string{instanceID}}); err != nil {
+			return "", apperrors.Wrapf(err, "stop instance %s", instanceID)
+		}
+		report("Waiting for instance to stop")
+		if err := ec2.NewInstanceStoppedWaiter(client).Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}}, instanceStateWait); err != nil {
+			return "", apperrors.Wrapf(err, "wait for instance %s to stop", instanceID)
+		}
+	}
+
+	report(fmt.Sprintf("Changing instance type %s → %s", original, target))
+	_, modifyErr := client.ModifyInstanceAttribute(ctx, &ec2.ModifyInstanceAttributeInput{
+		InstanceId:   &instanceID,
+		InstanceType: &types.AttributeValue{Value: &target},
+	})
+	if modifyErr != nil {
+		modifyErr = apperrors.Wrapf(modifyErr, "modify instance type of %s", instanceID)
+		if !wasRunning {
+			return "", modifyErr
+		}
+		report(fmt.Sprintf("Restarting instance as %s", original))
+	}
+
+	if wasRunning {
+		if modifyErr == nil {
+			report("Starting instance")
+		}
+		if _, err := client.StartInstances(ctx, &ec2.StartInstancesInput{InstanceIds: []string{instanceID}}); err != nil {
+			return "", apperrors.Wrapf(err, "start instance %s", instanceID)
+		}
+		report("Waiting for instance to run")
+		if err := ec2.NewInstanceRunningWaiter(client).Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}}, instanceStateWait); err != nil {
+			return "", apperrors.Wrapf(err, "wait for instance %s to start", instanceID)
+		}
+	}
+
+	if modifyErr != nil {
+		return "", modifyErr
+	}
+	return fmt.Sprintf("Changed %s from %s to %s", instanceID, original, target), nil
+}
+
+func executePatchNow(ctx context.Context, resource dao.Resource) action.ActionResult {
+	instanceID := resource.GetID()
+	commandID, err := appssm.PatchNow(ctx, instanceID, action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResult(fmt.Sprintf("Started patching %s (command %s)", instanceID, commandID))
+}
+
+// ssmPlatform returns the SSM platform type of an instance, which narrows
+// the documents offered by Run Command
+func ssmPlatform(r dao.Resource) string {
+	ir, ok := dao.UnwrapResource(r).(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	if ir.Item.Platform == types.PlatformValuesWindows {
+		return "Windows"
+	}
+	return "Linux"
+}
+
+func executeRunCommand(ctx context.Context, resource dao.Resource) action.ActionResult {
+	values := action.InputsFromContext(ctx)
+	if len(values) == 0 || values[0] == "" {
+		return action.FailResult(fmt.Errorf("no document selected"))
+	}
+	document := values[0]
+	params, err := appssm.DocumentParameters(ctx, document)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	instanceID := resource.GetID()
+	commandID, err := appssm.RunCommand(ctx, appssm.RunCommandInput{
+		Document:    document,
+		InstanceIDs: []string{instanceID},
+		Parameters:  appssm.CommandParameters(params, values[1:]),
+	})
+	if err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResultWithFollowUp(
+		fmt.Sprintf("Sent %s to %s (command %s)", document, instanceID, commandID),
+		appssm.ShowInvocations(commandID),
+	)
+}
@@ -0,0 +1,128 @@
+package instances
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// fakeInstanceClient tracks one instance's state and type across calls.
+type fakeInstanceClient struct {
+	state        types.InstanceStateName
+	instanceType string
+	modifyErr    error
+	calls        []string
+}
+
+func (f *fakeInstanceClient) DescribeInstances(_ context.Context, _ *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	return &ec2.DescribeInstancesOutput{Reservations: []types.Reservation{{
+		Instances: []types.Instance{{
+			InstanceId:   aws.String("i-123"),
+			InstanceType: types.InstanceType(f.instanceType),
+			State:        &types.InstanceState{Name: f.state},
+		}},
+	}}}, nil
+}
+
+func (f *fakeInstanceClient) StopInstances(_ context.Context, _ *ec2.StopInstancesInput, _ ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	f.calls = append(f.calls, "stop")
+	f.state = types.InstanceStateNameStopped
+	return &ec2.StopInstancesOutput{}, nil
+}
+
+func (f *fakeInstanceClient) StartInstances(_ context.Context, _ *ec2.StartInstancesInput, _ ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	f.calls = append(f.calls, "start")
+	f.state = types.InstanceStateNameRunning
+	return &ec2.StartInstancesOutput{}, nil
+}
+
+func (f *fakeInstanceClient) ModifyInstanceAttribute(_ context.Context, params *ec2.ModifyInstanceAttributeInput, _ ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error) {
+	f.calls = append(f.calls, "modify")
+	if f.modifyErr != nil {
+		return nil, f.modifyErr
+	}
+	f.instanceType = aws.ToString(params.InstanceType.Value)
+	return &ec2.ModifyInstanceAttributeOutput{}, nil
+}
+
+func TestChangeInstanceType(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     types.InstanceStateName
+		modifyErr error
+		wantCalls []string
+		wantType  string
+		wantState types.InstanceStateName
+		wantErr   string
+	}{
+		{
+			name:      "running instance is stopped, resized, and restarted",
+			state:     types.InstanceStateNameRunning,
+			wantCalls: []string{"stop", "modify", "start"},
+			wantType:  "t3.small",
+			wantState: types.InstanceStateNameRunning,
+		},
+		{
+			name:      "stopped instance is resized and left stopped",
+			state:     types.InstanceStateNameStopped,
+			wantCalls: []string{"modify"},
+			wantType:  "t3.small",
+			wantState: types.InstanceStateNameStopped,
+		},
+		{
+			name:      "failed modify restarts with the original type",
+			state:     types.InstanceStateNameRunning,
+			modifyErr: errors.New("unsupported"),
+			wantCalls: []string{"stop", "modify", "start"},
+			wantType:  "m5.large",
+			wantState: types.InstanceStateNameRunning,
+			wantErr:   "modify instance type",
+		},
+		{
+			name:      "pending instance is rejected",
+			state:     types.InstanceStateNamePending,
+			wantType:  "m5.large",
+			wantState: types.InstanceStateNamePending,
+			wantErr:   "must be running or stopped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeInstanceClient{state: tt.state, instanceType: "m5.large", modifyErr: tt.modifyErr}
+			var steps []string
+			msg, err := changeInstanceType(context.Background(), client, "i-123", "t3.small", func(step string) {
+				steps = append(steps, step)
+			})
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want containing %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if msg != "Changed i-123 from m5.large to t3.small" {
+				t.Errorf("message = %q", msg)
+			}
+
+			if !reflect.DeepEqual(client.calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", client.calls, tt.wantCalls)
+			}
+			if client.instanceType != tt.wantType {
+				t.Errorf("instance type = %q, want %q", client.instanceType, tt.wantType)
+			}
+			if client.state != tt.wantState {
+				t.Errorf("state = %q, want %q", client.state, tt.wantState)
+			}
+			if len(tt.wantCalls) > 0 && len(steps) == 0 {
+				t.Error("expected progress steps to be reported")
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package instances
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// CountRunning returns the number of running instances in the context's
+// region. Unlike List it skips role and recommendation lookups.
+func CountRunning(ctx context.Context) (int, error) {
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+	if err != nil {
+		return 0, apperrors.Wrap(err, "count running instances")
+	}
+	paginator := ec2.NewDescribeInstancesPaginator(client, &ec2.DescribeInstancesInput{
+		Filters: []types.Filter{{
+			Name:   appaws.StringPtr("instance-state-name"),
+			Values: []string{"running"},
+		}},
+	})
+
+	count := 0
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, apperrors.Wrap(err, "describe instances")
+		}
+		for _, reservation := range output.Reservations {
+			count += len(reservation.Instances)
+		}
+	}
+	return count, nil
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	cotypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 
+	appec2 "github.com/clawscli/claws/custom/ec2"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // InstanceDAO provides data access for EC2 instances
@@ -18,23 +22,44 @@ type InstanceDAO struct {
 	dao.BaseDAO
 	client    *ec2.Client
 	iamClient *iam.Client
+	coClient  *computeoptimizer.Client
 }
 
 // NewInstanceDAO creates a new InstanceDAO
 func NewInstanceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	iamClient, err := appaws.Client(ctx, iam.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	coClient, err := appaws.Client(ctx, computeoptimizer.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &InstanceDAO{
 		BaseDAO:   dao.NewBaseDAO("ec2", "instances"),
-		client:    ec2.NewFromConfig(cfg),
-		iamClient: iam.NewFromConfig(cfg),
+		client:    client,
+		iamClient: iamClient,
+		coClient:  coClient,
 	}, nil
 }
 
+// List returns EC2 instances. A SpotFleetRequestId filter in context narrows
+// the result to instances launched by that spot fleet; tag and status list
+// filters are applied server-side.
 func (d *InstanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
-	input := &ec2.DescribeInstancesInput{}
+	input := &ec2.DescribeInstancesInput{
+		Filters: appec2.ListFilters(ctx, "instance-state-name"),
+	}
+	if fleetID := dao.GetFilterFromContext(ctx, "SpotFleetRequestId"); fleetID != "" {
+		input.Filters = append(input.Filters, types.Filter{
+			Name:   appaws.StringPtr("tag:aws:ec2spot:fleet-request-id"),
+			Values: []string{fleetID},
+		})
+	}
 	paginator := ec2.NewDescribeInstancesPaginator(d.client, input)
 
 	// Cache for instance profile -> role name mapping
@@ -74,7 +99,9 @@ func (d *InstanceDAO) Get(ctx context.Context, id string) (dao.Resource, error)
 
 	instance := output.Reservations[0].Instances[0]
 	roleName := d.getRoleNameFromInstance(ctx, instance, nil)
-	return NewInstanceResourceWithRole(instance, roleName), nil
+	res := NewInstanceResourceWithRole(instance, roleName)
+	res.Recommendation = d.getRecommendation(ctx, appaws.Str(output.Reservations[0].OwnerId), id)
+	return res, nil
 }
 
 func (d *InstanceDAO) Delete(ctx context.Context, id string) error {
@@ -93,6 +120,30 @@ func (d *InstanceDAO) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// getRecommendation returns the Compute Optimizer recommendation for an instance,
+// or nil when Compute Optimizer is not enabled or has nothing for it.
+func (d *InstanceDAO) getRecommendation(ctx context.Context, accountID, instanceID string) *cotypes.InstanceRecommendation {
+	if accountID == "" {
+		return nil
+	}
+	output, err := d.coClient.GetEC2InstanceRecommendations(ctx, &computeoptimizer.GetEC2InstanceRecommendationsInput{
+		InstanceArns: []string{instanceARN(appaws.CurrentRegion(ctx), accountID, instanceID)},
+	})
+	if err != nil {
+		log.Debug("compute optimizer recommendation unavailable", "instance", instanceID, "error", err)
+		return nil
+	}
+	if len(output.InstanceRecommendations) == 0 {
+		return nil
+	}
+	return &output.InstanceRecommendations[0]
+}
+
+// instanceARN builds the ARN Compute Optimizer uses to identify an instance.
+func instanceARN(region, accountID, instanceID string) string {
+	return fmt.Sprintf("arn:%s:ec2:%s:%s:instance/%s", appaws.PartitionForRegion(region), region, accountID, instanceID)
+}
+
 // getRoleNameFromInstance extracts the IAM role name from an instance's instance profile
 func (d *InstanceDAO) getRoleNameFromInstance(ctx context.Context, instance types.Instance, cache map[string]string) string {
 	if instance.IamInstanceProfile == nil || instance.IamInstanceProfile.Arn == nil {
@@ -142,6 +193,9 @@ type InstanceResource struct {
 	dao.BaseResource
 	Item     types.Instance
 	RoleName string
+
+	// Recommendation is the Compute Optimizer finding, populated by Get.
+	Recommendation *cotypes.InstanceRecommendation
 }
 
 // NewInstanceResourceWithRole creates a new InstanceResource with IAM role name
@@ -178,6 +232,26 @@ func (r *InstanceResource) InstanceType() string {
 	return string(r.Item.InstanceType)
 }
 
+// RecommendedInstanceType returns Compute Optimizer's top instance type
+// when it finds the instance over- or under-provisioned, or "".
+func (r *InstanceResource) RecommendedInstanceType() string {
+	rec := r.Recommendation
+	if rec == nil || rec.Finding == cotypes.FindingOptimized || len(rec.RecommendationOptions) == 0 {
+		return ""
+	}
+	return appaws.Str(rec.RecommendationOptions[0].InstanceType)
+}
+
+// CanChangeInstanceType reports whether the instance can be stopped and
+// resized: EBS-backed, not spot, and running or stopped.
+func (r *InstanceResource) CanChangeInstanceType() bool {
+	if r.RootDeviceType() != string(types.DeviceTypeEbs) || r.InstanceLifecycle() == string(types.InstanceLifecycleTypeSpot) {
+		return false
+	}
+	state := r.State()
+	return state == string(types.InstanceStateNameRunning) || state == string(types.InstanceStateNameStopped)
+}
+
 // PrivateIP returns the private IP address
 func (r *InstanceResource) PrivateIP() string {
 	if r.Item.PrivateIpAddress != nil {
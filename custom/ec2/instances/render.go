@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"time"
 
+	cotypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 var (
@@ -186,6 +189,10 @@ func (r *InstanceRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Monitoring", monitoring)
 	}
 
+	if ir.Recommendation != nil {
+		renderRecommendation(d, ir)
+	}
+
 	// Network
 	d.Section("Network")
 	d.Field("Private IP", ir.PrivateIP())
@@ -274,6 +281,40 @@ func (r *InstanceRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// renderRecommendation adds the Compute Optimizer finding and its top option.
+func renderRecommendation(d *render.DetailBuilder, ir *InstanceResource) {
+	rec := ir.Recommendation
+	d.Section("Compute Optimizer")
+	finding := string(rec.Finding)
+	findingStyle := ui.WarningStyle()
+	if rec.Finding == cotypes.FindingOptimized {
+		findingStyle = ui.SuccessStyle()
+	}
+	d.FieldStyled("Finding", finding, findingStyle)
+	if rec.CurrentPerformanceRisk != "" {
+		d.Field("Performance Risk", string(rec.CurrentPerformanceRisk))
+	}
+
+	recommended := ir.RecommendedInstanceType()
+	if recommended == "" {
+		return
+	}
+	opt := rec.RecommendationOptions[0]
+	d.Field("Recommended Type", recommended)
+	if opt.SavingsOpportunity != nil && opt.SavingsOpportunity.EstimatedMonthlySavings != nil {
+		savings := opt.SavingsOpportunity.EstimatedMonthlySavings
+		d.Field("Est. Savings", fmt.Sprintf("%s/mo (%.0f%%)",
+			appaws.FormatMoney(savings.Value, string(savings.Currency)),
+			opt.SavingsOpportunity.SavingsOpportunityPercentage))
+	}
+	if opt.MigrationEffort != "" {
+		d.Field("Migration Effort", string(opt.MigrationEffort))
+	}
+	if ir.CanChangeInstanceType() {
+		d.DimIndent("Apply with the Change Instance Type action (a → T)")
+	}
+}
+
 // RenderSummary returns summary fields for the header panel
 func (r *InstanceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	ir, ok := resource.(*InstanceResource)
@@ -359,6 +400,12 @@ func (r *InstanceRenderer) Navigations(resource dao.Resource) []render.Navigatio
 		})
 	}
 
+	// Network interfaces attached to this instance
+	navs = append(navs, render.Navigation{
+		Key: "e", Label: "ENIs", Service: "ec2", Resource: "network-interfaces",
+		FilterField: "InstanceId", FilterValue: ir.GetID(),
+	})
+
 	// IAM Role navigation
 	if ir.RoleName != "" {
 		navs = append(navs, render.Navigation{
@@ -367,6 +414,12 @@ func (r *InstanceRenderer) Navigations(resource dao.Resource) []render.Navigatio
 		})
 	}
 
+	// Patch compliance, reported once SSM manages the instance
+	navs = append(navs, render.Navigation{
+		Key: "p", Label: "Patches", Service: "ssm", Resource: "patch-compliance",
+		FilterField: "InstanceId", FilterValue: ir.GetID(),
+	})
+
 	return navs
 }
 
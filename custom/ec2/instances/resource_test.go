@@ -1,9 +1,11 @@
 package instances
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	cotypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
 
@@ -119,3 +121,79 @@ func TestInstanceResource_StateVariations(t *testing.T) {
 		})
 	}
 }
+
+func TestInstanceResource_Recommendation(t *testing.T) {
+	instance := types.Instance{
+		InstanceId:     aws.String("i-123"),
+		InstanceType:   types.InstanceTypeM5Large,
+		RootDeviceType: types.DeviceTypeEbs,
+		State:          &types.InstanceState{Name: types.InstanceStateNameRunning},
+	}
+	r := NewInstanceResourceWithRole(instance, "")
+
+	if got := r.RecommendedInstanceType(); got != "" {
+		t.Errorf("RecommendedInstanceType() without recommendation = %q, want empty", got)
+	}
+	if !r.CanChangeInstanceType() {
+		t.Error("CanChangeInstanceType() = false for running EBS instance")
+	}
+
+	r.Recommendation = &cotypes.InstanceRecommendation{
+		Finding: cotypes.FindingOverProvisioned,
+		RecommendationOptions: []cotypes.InstanceRecommendationOption{{
+			InstanceType: aws.String("t3.large"),
+			SavingsOpportunity: &cotypes.SavingsOpportunity{
+				SavingsOpportunityPercentage: 40,
+				EstimatedMonthlySavings:      &cotypes.EstimatedMonthlySavings{Value: 28.5, Currency: cotypes.CurrencyUsd},
+			},
+		}},
+	}
+	if got := r.RecommendedInstanceType(); got != "t3.large" {
+		t.Errorf("RecommendedInstanceType() = %q, want t3.large", got)
+	}
+	detail := NewInstanceRenderer().RenderDetail(r)
+	for _, want := range []string{"Compute Optimizer", "Overprovisioned", "t3.large", "Change Instance Type"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("RenderDetail() missing %q", want)
+		}
+	}
+
+	r.Recommendation.Finding = cotypes.FindingOptimized
+	if got := r.RecommendedInstanceType(); got != "" {
+		t.Errorf("RecommendedInstanceType() for optimized = %q, want empty", got)
+	}
+}
+
+func TestInstanceResource_CanChangeInstanceType(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     types.InstanceStateName
+		device    types.DeviceType
+		lifecycle types.InstanceLifecycleType
+		want      bool
+	}{
+		{"stopped ebs", types.InstanceStateNameStopped, types.DeviceTypeEbs, "", true},
+		{"pending", types.InstanceStateNamePending, types.DeviceTypeEbs, "", false},
+		{"instance store", types.InstanceStateNameRunning, types.DeviceTypeInstanceStore, "", false},
+		{"spot", types.InstanceStateNameRunning, types.DeviceTypeEbs, types.InstanceLifecycleTypeSpot, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewInstanceResourceWithRole(types.Instance{
+				InstanceId:        aws.String("i-123"),
+				RootDeviceType:    tt.device,
+				InstanceLifecycle: tt.lifecycle,
+				State:             &types.InstanceState{Name: tt.state},
+			}, "")
+			if got := r.CanChangeInstanceType(); got != tt.want {
+				t.Errorf("CanChangeInstanceType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstanceARN(t *testing.T) {
+	if got := instanceARN("cn-north-1", "123456789012", "i-1"); got != "arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-1" {
+		t.Errorf("instanceARN() = %q", got)
+	}
+}
@@ -20,13 +20,13 @@ type KeyPairDAO struct {
 
 // NewKeyPairDAO creates a new KeyPairDAO
 func NewKeyPairDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &KeyPairDAO{
 		BaseDAO: dao.NewBaseDAO("ec2", "key-pairs"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
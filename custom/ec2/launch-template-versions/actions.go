@@ -0,0 +1,63 @@
+package launchtemplateversions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ec2", "launch-template-versions", []action.Action{
+		{
+			Name:      "Set Default Version",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "SetDefaultVersion",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				v, ok := dao.UnwrapResource(r).(*LaunchTemplateVersionResource)
+				return ok && !v.IsDefault()
+			},
+		},
+	})
+
+	action.RegisterExecutor("ec2", "launch-template-versions", executeVersionAction)
+}
+
+func executeVersionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "SetDefaultVersion":
+		return executeSetDefaultVersion(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeSetDefaultVersion(ctx context.Context, resource dao.Resource) action.ActionResult {
+	v, ok := dao.UnwrapResource(resource).(*LaunchTemplateVersionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	templateID := v.TemplateID()
+	version := v.GetID()
+	_, err = client.ModifyLaunchTemplate(ctx, &ec2.ModifyLaunchTemplateInput{
+		LaunchTemplateId: &templateID,
+		DefaultVersion:   &version,
+	})
+	if err != nil {
+		return action.FailResultf(err, "set default version of %s to %s", templateID, version)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Set %s default version to %s", v.TemplateName(), v.GetName()))
+}
@@ -0,0 +1,185 @@
+package launchtemplateversions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// LaunchTemplateVersionDAO provides data access for EC2 launch template versions
+type LaunchTemplateVersionDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewLaunchTemplateVersionDAO creates a new LaunchTemplateVersionDAO
+func NewLaunchTemplateVersionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &LaunchTemplateVersionDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "launch-template-versions"),
+		client:  client,
+	}, nil
+}
+
+// List returns all versions of a launch template (requires LaunchTemplateId filter)
+func (d *LaunchTemplateVersionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	templateID := dao.GetFilterFromContext(ctx, "LaunchTemplateId")
+	if templateID == "" {
+		return nil, fmt.Errorf("LaunchTemplateId filter required - navigate from a launch template")
+	}
+
+	versions, err := d.describeVersions(ctx, templateID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(versions))
+	for i, v := range versions {
+		resources[i] = NewLaunchTemplateVersionResource(v)
+	}
+	return resources, nil
+}
+
+// Get returns a single launch template version by version number
+func (d *LaunchTemplateVersionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	templateID := dao.GetFilterFromContext(ctx, "LaunchTemplateId")
+	if templateID == "" {
+		return nil, fmt.Errorf("LaunchTemplateId filter required - navigate from a launch template")
+	}
+
+	versions, err := d.describeVersions(ctx, templateID, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("launch template version not found: %s", id)
+	}
+	return NewLaunchTemplateVersionResource(versions[0]), nil
+}
+
+// Delete deletes a non-default launch template version
+func (d *LaunchTemplateVersionDAO) Delete(ctx context.Context, id string) error {
+	templateID := dao.GetFilterFromContext(ctx, "LaunchTemplateId")
+	if templateID == "" {
+		return fmt.Errorf("LaunchTemplateId filter required - navigate from a launch template")
+	}
+
+	output, err := d.client.DeleteLaunchTemplateVersions(ctx, &ec2.DeleteLaunchTemplateVersionsInput{
+		LaunchTemplateId: &templateID,
+		Versions:         []string{id},
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete launch template %s version %s", templateID, id)
+	}
+	if len(output.UnsuccessfullyDeletedLaunchTemplateVersions) > 0 {
+		failed := output.UnsuccessfullyDeletedLaunchTemplateVersions[0]
+		if failed.ResponseError != nil {
+			return fmt.Errorf("delete launch template %s version %s: %s", templateID, id, appaws.Str(failed.ResponseError.Message))
+		}
+		return fmt.Errorf("delete launch template %s version %s failed", templateID, id)
+	}
+	return nil
+}
+
+func (d *LaunchTemplateVersionDAO) describeVersions(ctx context.Context, templateID string, versions []string) ([]types.LaunchTemplateVersion, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.LaunchTemplateVersion, *string, error) {
+		output, err := d.client.DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: &templateID,
+			Versions:         versions,
+			NextToken:        token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe launch template %s versions", templateID)
+		}
+		return output.LaunchTemplateVersions, output.NextToken, nil
+	})
+}
+
+// LaunchTemplateVersionResource wraps a launch template version
+type LaunchTemplateVersionResource struct {
+	dao.BaseResource
+	Item types.LaunchTemplateVersion
+}
+
+// NewLaunchTemplateVersionResource creates a new LaunchTemplateVersionResource
+func NewLaunchTemplateVersionResource(v types.LaunchTemplateVersion) *LaunchTemplateVersionResource {
+	number := appaws.Int64(v.VersionNumber)
+	return &LaunchTemplateVersionResource{
+		BaseResource: dao.BaseResource{
+			ID:   strconv.FormatInt(number, 10),
+			Name: fmt.Sprintf("v%d", number),
+			Data: v,
+		},
+		Item: v,
+	}
+}
+
+// TemplateID returns the ID of the launch template this version belongs to
+func (r *LaunchTemplateVersionResource) TemplateID() string {
+	return appaws.Str(r.Item.LaunchTemplateId)
+}
+
+// TemplateName returns the name of the launch template this version belongs to
+func (r *LaunchTemplateVersionResource) TemplateName() string {
+	return appaws.Str(r.Item.LaunchTemplateName)
+}
+
+// VersionNumber returns the version number
+func (r *LaunchTemplateVersionResource) VersionNumber() int64 {
+	return appaws.Int64(r.Item.VersionNumber)
+}
+
+// IsDefault returns whether this is the template's default version
+func (r *LaunchTemplateVersionResource) IsDefault() bool {
+	return appaws.Bool(r.Item.DefaultVersion)
+}
+
+// Description returns the version description
+func (r *LaunchTemplateVersionResource) Description() string {
+	return appaws.Str(r.Item.VersionDescription)
+}
+
+// CreatedBy returns who created the version
+func (r *LaunchTemplateVersionResource) CreatedBy() string {
+	return appaws.Str(r.Item.CreatedBy)
+}
+
+// CreateTime returns the creation time
+func (r *LaunchTemplateVersionResource) CreateTime() time.Time {
+	if r.Item.CreateTime != nil {
+		return *r.Item.CreateTime
+	}
+	return time.Time{}
+}
+
+// Data returns the launch template data, or nil if absent
+func (r *LaunchTemplateVersionResource) Data() *types.ResponseLaunchTemplateData {
+	return r.Item.LaunchTemplateData
+}
+
+// ImageID returns the AMI ID
+func (r *LaunchTemplateVersionResource) ImageID() string {
+	if data := r.Data(); data != nil {
+		return appaws.Str(data.ImageId)
+	}
+	return ""
+}
+
+// InstanceType returns the instance type
+func (r *LaunchTemplateVersionResource) InstanceType() string {
+	if data := r.Data(); data != nil {
+		return string(data.InstanceType)
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+package launchtemplateversions
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func newTestVersion(number int64, isDefault bool, data *types.ResponseLaunchTemplateData) *LaunchTemplateVersionResource {
+	return NewLaunchTemplateVersionResource(types.LaunchTemplateVersion{
+		LaunchTemplateId:   aws.String("lt-0abc"),
+		LaunchTemplateName: aws.String("web"),
+		VersionNumber:      aws.Int64(number),
+		DefaultVersion:     aws.Bool(isDefault),
+		VersionDescription: aws.String("bump AMI"),
+		LaunchTemplateData: data,
+	})
+}
+
+func TestNewLaunchTemplateVersionResource(t *testing.T) {
+	v := newTestVersion(3, true, &types.ResponseLaunchTemplateData{
+		ImageId:      aws.String("ami-123"),
+		InstanceType: types.InstanceTypeT3Micro,
+	})
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"GetID", v.GetID(), "3"},
+		{"GetName", v.GetName(), "v3"},
+		{"TemplateID", v.TemplateID(), "lt-0abc"},
+		{"TemplateName", v.TemplateName(), "web"},
+		{"ImageID", v.ImageID(), "ami-123"},
+		{"InstanceType", v.InstanceType(), "t3.micro"},
+		{"Description", v.Description(), "bump AMI"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+	if !v.IsDefault() {
+		t.Error("IsDefault() = false, want true")
+	}
+}
+
+func TestLaunchTemplateVersionResource_NilData(t *testing.T) {
+	v := newTestVersion(1, false, nil)
+	if v.ImageID() != "" || v.InstanceType() != "" {
+		t.Errorf("expected empty fields without launch template data, got %q/%q", v.ImageID(), v.InstanceType())
+	}
+	if detail := NewLaunchTemplateVersionRenderer().RenderDetail(v); !strings.Contains(detail, "lt-0abc") {
+		t.Error("RenderDetail() should still render basic information")
+	}
+}
+
+func TestRenderDetail_AlignsForDiff(t *testing.T) {
+	renderer := NewLaunchTemplateVersionRenderer()
+	older := renderer.RenderDetail(newTestVersion(1, true, &types.ResponseLaunchTemplateData{
+		ImageId:      aws.String("ami-old"),
+		InstanceType: types.InstanceTypeT3Micro,
+		UserData:     aws.String(base64.StdEncoding.EncodeToString([]byte("#!/bin/sh\necho v1\n"))),
+	}))
+	newer := renderer.RenderDetail(newTestVersion(2, false, &types.ResponseLaunchTemplateData{
+		ImageId:      aws.String("ami-new"),
+		InstanceType: types.InstanceTypeT3Micro,
+		UserData:     aws.String(base64.StdEncoding.EncodeToString([]byte("#!/bin/sh\necho v2\n"))),
+	}))
+
+	oldLines := strings.Split(older, "\n")
+	newLines := strings.Split(newer, "\n")
+	if len(oldLines) != len(newLines) {
+		t.Fatalf("line counts differ: %d vs %d", len(oldLines), len(newLines))
+	}
+	var changed []string
+	for i := range oldLines {
+		if oldLines[i] != newLines[i] {
+			changed = append(changed, newLines[i])
+		}
+	}
+	joined := strings.Join(changed, "\n")
+	for _, want := range []string{"v2", "ami-new", "User Data"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("expected a differing line containing %q, got:\n%s", want, joined)
+		}
+	}
+	if strings.Contains(joined, "Instance Type") {
+		t.Error("unchanged instance type should render identically")
+	}
+}
+
+func TestUserDataSummary(t *testing.T) {
+	if got := userDataSummary(""); got != "none" {
+		t.Errorf("userDataSummary(\"\") = %q, want none", got)
+	}
+	got := userDataSummary(base64.StdEncoding.EncodeToString([]byte("hello")))
+	if !strings.HasPrefix(got, "5 bytes (sha256 ") {
+		t.Errorf("userDataSummary() = %q", got)
+	}
+}
@@ -0,0 +1,243 @@
+package launchtemplateversions
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// LaunchTemplateVersionRenderer renders launch template versions
+type LaunchTemplateVersionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewLaunchTemplateVersionRenderer creates a new LaunchTemplateVersionRenderer
+func NewLaunchTemplateVersionRenderer() render.Renderer {
+	return &LaunchTemplateVersionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "launch-template-versions",
+			Cols: []render.Column{
+				{Name: "VERSION", Width: 8, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "DEFAULT", Width: 8, Getter: getDefault},
+				{Name: "DESCRIPTION", Width: 30, Getter: getDescription},
+				{Name: "AMI", Width: 22, Getter: getImage},
+				{Name: "TYPE", Width: 13, Getter: getInstanceType},
+				{Name: "CREATED BY", Width: 30, Getter: getCreatedBy},
+				{Name: "AGE", Width: 8, Getter: getAge},
+			},
+		},
+	}
+}
+
+func getDefault(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok && v.IsDefault() {
+		return "✓"
+	}
+	return ""
+}
+
+func getDescription(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		return v.Description()
+	}
+	return ""
+}
+
+func getImage(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		return v.ImageID()
+	}
+	return ""
+}
+
+func getInstanceType(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		return v.InstanceType()
+	}
+	return ""
+}
+
+func getCreatedBy(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		return appaws.ExtractResourceName(v.CreatedBy())
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if v, ok := r.(*LaunchTemplateVersionResource); ok {
+		if t := v.CreateTime(); !t.IsZero() {
+			return render.FormatAge(t)
+		}
+	}
+	return "-"
+}
+
+// RenderDetail renders a version's launch template data. Fields are emitted
+// in a fixed order so two versions line up side by side in the diff view.
+func (r *LaunchTemplateVersionRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*LaunchTemplateVersionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Launch Template Version", fmt.Sprintf("%s %s", v.TemplateName(), v.GetName()))
+
+	d.Section("Basic Information")
+	d.Field("Template", v.TemplateName())
+	d.Field("Template ID", v.TemplateID())
+	d.Field("Version", v.GetID())
+	if v.IsDefault() {
+		d.FieldStyled("Default", "Yes", ui.SuccessStyle())
+	} else {
+		d.Field("Default", "No")
+	}
+	if desc := v.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+	d.Field("Created By", v.CreatedBy())
+	if t := v.CreateTime(); !t.IsZero() {
+		d.Field("Created", t.Format("2006-01-02 15:04:05 MST"))
+	}
+
+	data := v.Data()
+	if data == nil {
+		return d.String()
+	}
+
+	d.Section("Instance")
+	d.Field("AMI", appaws.Str(data.ImageId))
+	d.Field("Instance Type", string(data.InstanceType))
+	d.Field("Key Pair", appaws.Str(data.KeyName))
+	if data.IamInstanceProfile != nil {
+		profile := appaws.Str(data.IamInstanceProfile.Name)
+		if profile == "" {
+			profile = appaws.ExtractResourceName(appaws.Str(data.IamInstanceProfile.Arn))
+		}
+		d.Field("IAM Profile", profile)
+	}
+	if data.EbsOptimized != nil {
+		d.Field("EBS Optimized", fmt.Sprintf("%t", *data.EbsOptimized))
+	}
+	if data.Monitoring != nil {
+		d.Field("Detailed Monitoring", fmt.Sprintf("%t", appaws.Bool(data.Monitoring.Enabled)))
+	}
+	if data.MetadataOptions != nil {
+		d.Field("IMDS Tokens", string(data.MetadataOptions.HttpTokens))
+	}
+	if data.InstanceMarketOptions != nil {
+		d.Field("Market", string(data.InstanceMarketOptions.MarketType))
+	}
+	d.Field("User Data", userDataSummary(appaws.Str(data.UserData)))
+
+	if len(data.SecurityGroupIds) > 0 || len(data.SecurityGroups) > 0 {
+		d.Section("Security Groups")
+		for _, id := range data.SecurityGroupIds {
+			d.Line("  " + id)
+		}
+		for _, name := range data.SecurityGroups {
+			d.Line("  " + name)
+		}
+	}
+
+	if len(data.NetworkInterfaces) > 0 {
+		d.Section("Network Interfaces")
+		for _, ni := range data.NetworkInterfaces {
+			label := fmt.Sprintf("eth%d", appaws.Int32(ni.DeviceIndex))
+			parts := []string{}
+			if subnet := appaws.Str(ni.SubnetId); subnet != "" {
+				parts = append(parts, subnet)
+			}
+			if len(ni.Groups) > 0 {
+				parts = append(parts, strings.Join(ni.Groups, ","))
+			}
+			if appaws.Bool(ni.AssociatePublicIpAddress) {
+				parts = append(parts, "public IP")
+			}
+			d.Field(label, strings.Join(parts, " "))
+		}
+	}
+
+	if len(data.BlockDeviceMappings) > 0 {
+		d.Section("Block Devices")
+		for _, bd := range data.BlockDeviceMappings {
+			d.Field(appaws.Str(bd.DeviceName), blockDeviceSummary(bd))
+		}
+	}
+
+	for _, spec := range data.TagSpecifications {
+		if len(spec.Tags) == 0 {
+			continue
+		}
+		d.Section(fmt.Sprintf("Tags (%s)", spec.ResourceType))
+		tags := appaws.TagsToMap(spec.Tags)
+		for _, key := range slices.Sorted(maps.Keys(tags)) {
+			d.Tag(key, tags[key])
+		}
+	}
+
+	return d.String()
+}
+
+// userDataSummary describes base64 user data by size and a short hash, so a
+// changed script shows up in a diff without printing the script itself.
+func userDataSummary(encoded string) string {
+	if encoded == "" {
+		return "none"
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		decoded = []byte(encoded)
+	}
+	sum := sha256.Sum256(decoded)
+	return fmt.Sprintf("%d bytes (sha256 %x)", len(decoded), sum[:6])
+}
+
+func blockDeviceSummary(bd types.LaunchTemplateBlockDeviceMapping) string {
+	if bd.Ebs == nil {
+		if name := appaws.Str(bd.VirtualName); name != "" {
+			return name
+		}
+		return "-"
+	}
+	summary := fmt.Sprintf("%d GiB %s", appaws.Int32(bd.Ebs.VolumeSize), bd.Ebs.VolumeType)
+	if appaws.Bool(bd.Ebs.Encrypted) {
+		summary += " encrypted"
+	}
+	if snap := appaws.Str(bd.Ebs.SnapshotId); snap != "" {
+		summary += " from " + snap
+	}
+	return summary
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *LaunchTemplateVersionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*LaunchTemplateVersionResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Template", Value: v.TemplateName()},
+		{Label: "Version", Value: v.GetName()},
+		{Label: "AMI", Value: v.ImageID()},
+		{Label: "Type", Value: v.InstanceType()},
+	}
+	if v.IsDefault() {
+		fields = append(fields, render.SummaryField{Label: "Default", Value: "yes"})
+	}
+	return fields
+}
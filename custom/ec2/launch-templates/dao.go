@@ -21,13 +21,13 @@ type LaunchTemplateDAO struct {
 
 // NewLaunchTemplateDAO creates a new LaunchTemplateDAO
 func NewLaunchTemplateDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &LaunchTemplateDAO{
 		BaseDAO: dao.NewBaseDAO("ec2", "launch-templates"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -7,6 +7,8 @@ import (
 	"github.com/clawscli/claws/internal/render"
 )
 
+var _ render.Navigator = (*LaunchTemplateRenderer)(nil)
+
 // LaunchTemplateRenderer renders EC2 Launch Templates
 type LaunchTemplateRenderer struct {
 	render.BaseRenderer
@@ -124,3 +126,18 @@ func (r *LaunchTemplateRenderer) RenderSummary(resource dao.Resource) []render.S
 		{Label: "Latest", Value: fmt.Sprintf("v%d", rr.LatestVersionNumber())},
 	}
 }
+
+// Navigations returns navigation shortcuts
+func (r *LaunchTemplateRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	rr, ok := resource.(*LaunchTemplateResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key: "v", Label: "Versions", Service: "ec2", Resource: "launch-template-versions",
+			FilterField: "LaunchTemplateId", FilterValue: rr.LaunchTemplateId(),
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package networkinterfaces
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appvpc "github.com/clawscli/claws/custom/vpc"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ec2", "network-interfaces", []action.Action{
+		appvpc.EnableFlowLogsAction(),
+	})
+
+	action.RegisterExecutor("ec2", "network-interfaces", executeNetworkInterfaceAction)
+}
+
+func executeNetworkInterfaceAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case appvpc.EnableFlowLogsOperation:
+		return appvpc.EnableFlowLogs(ctx, resource, types.FlowLogsResourceTypeNetworkInterface)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
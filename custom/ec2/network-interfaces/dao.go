@@ -0,0 +1,273 @@
+package networkinterfaces
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// NetworkInterfaceDAO provides data access for EC2 network interfaces (ENIs)
+type NetworkInterfaceDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewNetworkInterfaceDAO creates a new NetworkInterfaceDAO
+func NewNetworkInterfaceDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &NetworkInterfaceDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "network-interfaces"),
+		client:  client,
+	}, nil
+}
+
+// List returns network interfaces, narrowed by any navigation filter in context.
+// Supported filters:
+//   - NetworkInterfaceIds: comma-separated ENI IDs
+//   - InstanceId: ENIs attached to an EC2 instance
+//   - FunctionName: Lambda-managed ENIs for a function
+//   - LoadBalancerArn: ELB-managed ENIs for a load balancer
+//   - VpcId: ENIs in a VPC
+func (d *NetworkInterfaceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{}
+
+	if ids := dao.GetFilterFromContext(ctx, "NetworkInterfaceIds"); ids != "" {
+		input.NetworkInterfaceIds = strings.Split(ids, ",")
+	}
+	if instanceID := dao.GetFilterFromContext(ctx, "InstanceId"); instanceID != "" {
+		input.Filters = append(input.Filters, ec2Filter("attachment.instance-id", instanceID))
+	}
+	if fnName := dao.GetFilterFromContext(ctx, "FunctionName"); fnName != "" {
+		input.Filters = append(input.Filters, ec2Filter("description", "AWS Lambda VPC ENI-"+fnName+"-*"))
+	}
+	if lbArn := dao.GetFilterFromContext(ctx, "LoadBalancerArn"); lbArn != "" {
+		input.Filters = append(input.Filters, ec2Filter("description", "ELB "+loadBalancerDescription(lbArn)))
+	}
+	if vpcID := dao.GetFilterFromContext(ctx, "VpcId"); vpcID != "" {
+		input.Filters = append(input.Filters, ec2Filter("vpc-id", vpcID))
+	}
+
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(d.client, input)
+
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe network interfaces")
+		}
+
+		for _, eni := range output.NetworkInterfaces {
+			resources = append(resources, NewNetworkInterfaceResource(eni))
+		}
+	}
+
+	return resources, nil
+}
+
+func (d *NetworkInterfaceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe network interface %s", id)
+	}
+
+	if len(output.NetworkInterfaces) == 0 {
+		return nil, fmt.Errorf("network interface not found: %s", id)
+	}
+
+	return NewNetworkInterfaceResource(output.NetworkInterfaces[0]), nil
+}
+
+func (d *NetworkInterfaceDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{
+		NetworkInterfaceId: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already deleted
+		}
+		if apperrors.IsResourceInUse(err) {
+			return apperrors.Wrapf(err, "network interface %s is attached", id)
+		}
+		return apperrors.Wrapf(err, "delete network interface %s", id)
+	}
+
+	return nil
+}
+
+func ec2Filter(name, value string) types.Filter {
+	return types.Filter{Name: &name, Values: []string{value}}
+}
+
+// loadBalancerDescription converts a load balancer ARN into the suffix ELB uses
+// in its ENI descriptions, e.g. "arn:...:loadbalancer/app/my-alb/abc" -> "app/my-alb/abc".
+func loadBalancerDescription(arn string) string {
+	if _, suffix, ok := strings.Cut(arn, ":loadbalancer/"); ok {
+		return suffix
+	}
+	return arn
+}
+
+// NetworkInterfaceResource wraps an EC2 network interface
+type NetworkInterfaceResource struct {
+	dao.BaseResource
+	Item types.NetworkInterface
+}
+
+// NewNetworkInterfaceResource creates a new NetworkInterfaceResource
+func NewNetworkInterfaceResource(eni types.NetworkInterface) *NetworkInterfaceResource {
+	return &NetworkInterfaceResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(eni.NetworkInterfaceId),
+			Name: appaws.EC2NameTag(eni.TagSet),
+			Tags: appaws.TagsToMap(eni.TagSet),
+			Data: eni,
+		},
+		Item: eni,
+	}
+}
+
+func (r *NetworkInterfaceResource) Status() string {
+	return string(r.Item.Status)
+}
+
+func (r *NetworkInterfaceResource) InterfaceType() string {
+	return string(r.Item.InterfaceType)
+}
+
+func (r *NetworkInterfaceResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+func (r *NetworkInterfaceResource) VpcId() string {
+	return appaws.Str(r.Item.VpcId)
+}
+
+func (r *NetworkInterfaceResource) SubnetId() string {
+	return appaws.Str(r.Item.SubnetId)
+}
+
+func (r *NetworkInterfaceResource) AZ() string {
+	return appaws.Str(r.Item.AvailabilityZone)
+}
+
+func (r *NetworkInterfaceResource) PrivateIP() string {
+	return appaws.Str(r.Item.PrivateIpAddress)
+}
+
+func (r *NetworkInterfaceResource) PublicIP() string {
+	if r.Item.Association != nil {
+		return appaws.Str(r.Item.Association.PublicIp)
+	}
+	return ""
+}
+
+// InstanceId returns the attached EC2 instance ID, if any.
+func (r *NetworkInterfaceResource) InstanceId() string {
+	if r.Item.Attachment != nil {
+		return appaws.Str(r.Item.Attachment.InstanceId)
+	}
+	return ""
+}
+
+// AttachmentStatus returns the attachment status, or empty if not attached.
+func (r *NetworkInterfaceResource) AttachmentStatus() string {
+	if r.Item.Attachment != nil {
+		return string(r.Item.Attachment.Status)
+	}
+	return ""
+}
+
+// SecurityGroupIds returns the IDs of the security groups on this ENI.
+func (r *NetworkInterfaceResource) SecurityGroupIds() []string {
+	ids := make([]string, 0, len(r.Item.Groups))
+	for _, g := range r.Item.Groups {
+		if g.GroupId != nil {
+			ids = append(ids, *g.GroupId)
+		}
+	}
+	return ids
+}
+
+// LambdaFunctionName returns the function name for Lambda-managed ENIs.
+// Lambda describes its ENIs as "AWS Lambda VPC ENI-<function>-<uuid>".
+func (r *NetworkInterfaceResource) LambdaFunctionName() string {
+	rest, ok := strings.CutPrefix(r.Description(), "AWS Lambda VPC ENI-")
+	if !ok {
+		return ""
+	}
+	// Strip the trailing UUID (5 hyphen-separated groups)
+	parts := strings.Split(rest, "-")
+	if len(parts) <= 5 {
+		return rest
+	}
+	return strings.Join(parts[:len(parts)-5], "-")
+}
+
+// NatGatewayId returns the NAT gateway ID for NAT-managed ENIs.
+func (r *NetworkInterfaceResource) NatGatewayId() string {
+	if id, ok := strings.CutPrefix(r.Description(), "Interface for NAT Gateway "); ok {
+		return id
+	}
+	return ""
+}
+
+// LoadBalancerName returns the load balancer name for ELB-managed ENIs.
+// ELB describes its ENIs as "ELB app/<name>/<id>" or "ELB net/<name>/<id>".
+func (r *NetworkInterfaceResource) LoadBalancerName() string {
+	rest, ok := strings.CutPrefix(r.Description(), "ELB ")
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) == 3 {
+		return parts[1]
+	}
+	return rest
+}
+
+// VpcEndpointId returns the VPC endpoint ID for endpoint-managed ENIs.
+func (r *NetworkInterfaceResource) VpcEndpointId() string {
+	if id, ok := strings.CutPrefix(r.Description(), "VPC Endpoint Interface "); ok {
+		return id
+	}
+	return ""
+}
+
+// AttachedTo returns a short description of what the ENI is attached to.
+// EC2 attachments are reported by instance ID; AWS-managed ENIs are
+// identified from their description or requester.
+func (r *NetworkInterfaceResource) AttachedTo() string {
+	if id := r.InstanceId(); id != "" {
+		return id
+	}
+	if fn := r.LambdaFunctionName(); fn != "" {
+		return "lambda:" + fn
+	}
+	if id := r.NatGatewayId(); id != "" {
+		return id
+	}
+	if lb := r.LoadBalancerName(); lb != "" {
+		return "elb:" + lb
+	}
+	if id := r.VpcEndpointId(); id != "" {
+		return id
+	}
+	if r.Item.RequesterManaged != nil && *r.Item.RequesterManaged {
+		if req := appaws.Str(r.Item.RequesterId); req != "" {
+			return req
+		}
+	}
+	return ""
+}
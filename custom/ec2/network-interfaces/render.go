@@ -0,0 +1,341 @@
+package networkinterfaces
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+var _ render.Navigator = (*NetworkInterfaceRenderer)(nil)
+
+// NetworkInterfaceRenderer renders EC2 network interfaces
+type NetworkInterfaceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewNetworkInterfaceRenderer creates a new NetworkInterfaceRenderer
+func NewNetworkInterfaceRenderer() render.Renderer {
+	return &NetworkInterfaceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "network-interfaces",
+			Cols: []render.Column{
+				{
+					Name:  "ID",
+					Width: 22,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "STATUS",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*NetworkInterfaceResource); ok {
+							return v.Status()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "TYPE",
+					Width: 14,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*NetworkInterfaceResource); ok {
+							return v.InterfaceType()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "ATTACHED TO",
+					Width: 28,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*NetworkInterfaceResource); ok {
+							return v.AttachedTo()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "PRIVATE IP",
+					Width: 16,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*NetworkInterfaceResource); ok {
+							return v.PrivateIP()
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "PUBLIC IP",
+					Width: 16,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*NetworkInterfaceResource); ok {
+							return v.PublicIP()
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+				{
+					Name:  "SUBNET",
+					Width: 25,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*NetworkInterfaceResource); ok {
+							return v.SubnetId()
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+				{
+					Name:  "AZ",
+					Width: 15,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*NetworkInterfaceResource); ok {
+							return v.AZ()
+						}
+						return ""
+					},
+					Priority: 7,
+				},
+				{
+					Name:  "DESCRIPTION",
+					Width: 40,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*NetworkInterfaceResource); ok {
+							return v.Description()
+						}
+						return ""
+					},
+					Priority: 8,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed network interface information
+func (r *NetworkInterfaceRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*NetworkInterfaceResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Network Interface", v.GetID())
+
+	// Basic Info
+	d.Section("Basic Information")
+	d.Field("Network Interface ID", v.GetID())
+	if name := v.GetName(); name != "" {
+		d.Field("Name", name)
+	}
+	d.FieldStyled("Status", v.Status(), render.StateColorer()(v.Status()))
+	d.Field("Interface Type", v.InterfaceType())
+	if desc := v.Description(); desc != "" {
+		d.Field("Description", desc)
+	} else {
+		d.Field("Description", render.NoValue)
+	}
+	d.FieldIf("MAC Address", v.Item.MacAddress)
+	d.FieldIf("Owner ID", v.Item.OwnerId)
+	if v.Item.RequesterManaged != nil && *v.Item.RequesterManaged {
+		d.Field("Requester Managed", "Yes")
+		d.FieldIf("Requester ID", v.Item.RequesterId)
+	}
+	if v.Item.SourceDestCheck != nil {
+		d.Field("Source/Dest Check", boolYesNo(*v.Item.SourceDestCheck))
+	}
+
+	// Attachment
+	d.Section("Attachment")
+	if attachedTo := v.AttachedTo(); attachedTo != "" {
+		d.Field("Attached To", attachedTo)
+	}
+	if att := v.Item.Attachment; att != nil {
+		d.FieldIf("Attachment ID", att.AttachmentId)
+		d.FieldIf("Instance ID", att.InstanceId)
+		d.FieldIf("Instance Owner", att.InstanceOwnerId)
+		d.Field("Status", string(att.Status))
+		if att.DeviceIndex != nil {
+			d.Field("Device Index", fmt.Sprintf("%d", *att.DeviceIndex))
+		}
+		if att.NetworkCardIndex != nil {
+			d.Field("Network Card Index", fmt.Sprintf("%d", *att.NetworkCardIndex))
+		}
+		if att.DeleteOnTermination != nil {
+			d.Field("Delete on Term", boolYesNo(*att.DeleteOnTermination))
+		}
+		if att.AttachTime != nil {
+			d.Field("Attach Time", att.AttachTime.Format("2006-01-02 15:04:05"))
+		}
+	} else if v.AttachedTo() == "" {
+		d.DimIndent("(not attached)")
+	}
+
+	// Network
+	d.Section("Network")
+	d.Field("VPC ID", v.VpcId())
+	d.Field("Subnet ID", v.SubnetId())
+	d.Field("Availability Zone", v.AZ())
+	d.FieldIf("Private DNS", v.Item.PrivateDnsName)
+	if assoc := v.Item.Association; assoc != nil {
+		d.FieldIf("Public IP", assoc.PublicIp)
+		d.FieldIf("Public DNS", assoc.PublicDnsName)
+		d.FieldIf("EIP Allocation", assoc.AllocationId)
+		d.FieldIf("IP Owner", assoc.IpOwnerId)
+	}
+
+	// Private IPs
+	if len(v.Item.PrivateIpAddresses) > 0 {
+		d.Section("Private IP Addresses")
+		for _, ip := range v.Item.PrivateIpAddresses {
+			addr := appaws.Str(ip.PrivateIpAddress)
+			label := "Secondary"
+			if ip.Primary != nil && *ip.Primary {
+				label = "Primary"
+			}
+			if ip.Association != nil && ip.Association.PublicIp != nil {
+				addr += " → " + *ip.Association.PublicIp
+			}
+			d.Field(label, addr)
+		}
+	}
+
+	if len(v.Item.Ipv6Addresses) > 0 {
+		d.Section("IPv6 Addresses")
+		for _, ip := range v.Item.Ipv6Addresses {
+			d.Field("Address", appaws.Str(ip.Ipv6Address))
+		}
+	}
+
+	// Security Groups
+	d.Section("Security Groups")
+	if len(v.Item.Groups) > 0 {
+		for _, g := range v.Item.Groups {
+			d.Field(appaws.Str(g.GroupId), appaws.Str(g.GroupName))
+		}
+	} else {
+		d.DimIndent("(none)")
+	}
+
+	// Tags
+	d.Tags(appaws.TagsToMap(v.Item.TagSet))
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *NetworkInterfaceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*NetworkInterfaceResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "ID", Value: v.GetID()},
+		{Label: "Status", Value: v.Status(), Style: render.StateColorer()(v.Status())},
+		{Label: "Type", Value: v.InterfaceType()},
+	}
+
+	if attachedTo := v.AttachedTo(); attachedTo != "" {
+		fields = append(fields, render.SummaryField{Label: "Attached To", Value: attachedTo})
+	}
+
+	fields = append(fields, render.SummaryField{Label: "Private IP", Value: v.PrivateIP()})
+	if pub := v.PublicIP(); pub != "" {
+		fields = append(fields, render.SummaryField{Label: "Public IP", Value: pub})
+	}
+	fields = append(fields, render.SummaryField{Label: "VPC", Value: v.VpcId()})
+	fields = append(fields, render.SummaryField{Label: "Subnet", Value: v.SubnetId()})
+
+	if sgs := v.SecurityGroupIds(); len(sgs) > 0 {
+		fields = append(fields, render.SummaryField{Label: "SGs", Value: strings.Join(sgs, ", ")})
+	}
+
+	return fields
+}
+
+// Navigations returns navigation shortcuts for network interfaces
+func (r *NetworkInterfaceRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*NetworkInterfaceResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+
+	if instanceID := v.InstanceId(); instanceID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "i", Label: "Instance", Service: "ec2", Resource: "instances",
+			FilterField: "InstanceId", FilterValue: instanceID,
+		})
+	}
+
+	if fn := v.LambdaFunctionName(); fn != "" {
+		navs = append(navs, render.Navigation{
+			Key: "f", Label: "Function", Service: "lambda", Resource: "functions",
+			FilterField: "FunctionName", FilterValue: fn,
+		})
+	}
+
+	if natID := v.NatGatewayId(); natID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "n", Label: "NAT Gateway", Service: "vpc", Resource: "nat-gateways",
+			FilterField: "NatGatewayId", FilterValue: natID,
+		})
+	}
+
+	if lb := v.LoadBalancerName(); lb != "" {
+		navs = append(navs, render.Navigation{
+			Key: "b", Label: "Load Balancer", Service: "elbv2", Resource: "load-balancers",
+			FilterField: "LoadBalancerName", FilterValue: lb,
+		})
+	}
+
+	if vpcID := v.VpcId(); vpcID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "v", Label: "VPC", Service: "vpc", Resource: "vpcs",
+			FilterField: "VpcId", FilterValue: vpcID,
+		})
+	}
+
+	if subnetID := v.SubnetId(); subnetID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "u", Label: "Subnet", Service: "vpc", Resource: "subnets",
+			FilterField: "SubnetId", FilterValue: subnetID,
+		})
+	}
+
+	if sgs := v.SecurityGroupIds(); len(sgs) > 0 {
+		navs = append(navs, render.Navigation{
+			Key: "g", Label: "Security Groups", Service: "ec2", Resource: "security-groups",
+			FilterField: "GroupId", FilterValue: sgs[0],
+		})
+	}
+
+	navs = append(navs, render.Navigation{
+		Key: "F", Label: "Flow Logs", Service: "vpc", Resource: "flow-logs",
+		FilterField: "ResourceId", FilterValue: v.GetID(),
+	})
+
+	return navs
+}
+
+func boolYesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
@@ -0,0 +1,87 @@
+package networkinterfaces
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestNetworkInterfaceResource_AttachedTo(t *testing.T) {
+	tests := []struct {
+		name        string
+		eni         types.NetworkInterface
+		attachedTo  string
+		lambdaFn    string
+		natGateway  string
+		loadBalance string
+	}{
+		{
+			name: "instance attachment",
+			eni: types.NetworkInterface{
+				NetworkInterfaceId: aws.String("eni-1"),
+				Attachment:         &types.NetworkInterfaceAttachment{InstanceId: aws.String("i-0abc")},
+			},
+			attachedTo: "i-0abc",
+		},
+		{
+			name: "lambda hyperplane ENI",
+			eni: types.NetworkInterface{
+				NetworkInterfaceId: aws.String("eni-2"),
+				Description:        aws.String("AWS Lambda VPC ENI-my-func-1a2b3c4d-1111-2222-3333-444455556666"),
+			},
+			attachedTo: "lambda:my-func",
+			lambdaFn:   "my-func",
+		},
+		{
+			name: "NAT gateway ENI",
+			eni: types.NetworkInterface{
+				NetworkInterfaceId: aws.String("eni-3"),
+				Description:        aws.String("Interface for NAT Gateway nat-0123456789abcdef0"),
+			},
+			attachedTo: "nat-0123456789abcdef0",
+			natGateway: "nat-0123456789abcdef0",
+		},
+		{
+			name: "ALB ENI",
+			eni: types.NetworkInterface{
+				NetworkInterfaceId: aws.String("eni-4"),
+				Description:        aws.String("ELB app/my-alb/50dc6c495c0c9188"),
+			},
+			attachedTo:  "elb:my-alb",
+			loadBalance: "my-alb",
+		},
+		{
+			name: "unattached",
+			eni: types.NetworkInterface{
+				NetworkInterfaceId: aws.String("eni-5"),
+				Description:        aws.String("manual"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewNetworkInterfaceResource(tt.eni)
+			if got := r.AttachedTo(); got != tt.attachedTo {
+				t.Errorf("AttachedTo() = %q, want %q", got, tt.attachedTo)
+			}
+			if got := r.LambdaFunctionName(); got != tt.lambdaFn {
+				t.Errorf("LambdaFunctionName() = %q, want %q", got, tt.lambdaFn)
+			}
+			if got := r.NatGatewayId(); got != tt.natGateway {
+				t.Errorf("NatGatewayId() = %q, want %q", got, tt.natGateway)
+			}
+			if got := r.LoadBalancerName(); got != tt.loadBalance {
+				t.Errorf("LoadBalancerName() = %q, want %q", got, tt.loadBalance)
+			}
+		})
+	}
+}
+
+func TestLoadBalancerDescription(t *testing.T) {
+	arn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188"
+	if got := loadBalancerDescription(arn); got != "app/my-alb/50dc6c495c0c9188" {
+		t.Errorf("loadBalancerDescription() = %q", got)
+	}
+}
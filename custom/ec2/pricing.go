@@ -0,0 +1,125 @@
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricingtypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// CurrentSpotPrice returns the most recent spot price (USD/hour) for an
+// instance type in an availability zone. productDescription defaults to Linux/UNIX.
+func CurrentSpotPrice(ctx context.Context, client *ec2.Client, instanceType, az, productDescription string) (float64, error) {
+	if productDescription == "" {
+		productDescription = string(ec2types.RIProductDescriptionLinuxUnix)
+	}
+	now := time.Now()
+	output, err := client.DescribeSpotPriceHistory(ctx, &ec2.DescribeSpotPriceHistoryInput{
+		InstanceTypes:       []ec2types.InstanceType{ec2types.InstanceType(instanceType)},
+		AvailabilityZone:    &az,
+		ProductDescriptions: []string{productDescription},
+		StartTime:           &now,
+		MaxResults:          appaws.Int32Ptr(1),
+	})
+	if err != nil {
+		return 0, apperrors.Wrapf(err, "describe spot price history for %s in %s", instanceType, az)
+	}
+	if len(output.SpotPriceHistory) == 0 {
+		return 0, fmt.Errorf("no spot price for %s in %s", instanceType, az)
+	}
+	return strconv.ParseFloat(appaws.Str(output.SpotPriceHistory[0].SpotPrice), 64)
+}
+
+// OnDemandPrice returns the hourly on-demand price (USD) for an instance type
+// in a region using the Price List Query API. Shared tenancy without
+// pre-installed software is assumed.
+func OnDemandPrice(ctx context.Context, instanceType, region, productDescription string) (float64, error) {
+	client, err := appaws.ClientWithRegion(ctx, appaws.PricingRegionFor(region), pricing.NewFromConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	filters := map[string]string{
+		"instanceType":    instanceType,
+		"regionCode":      region,
+		"operatingSystem": pricingOperatingSystem(productDescription),
+		"tenancy":         "Shared",
+		"preInstalledSw":  "NA",
+		"capacitystatus":  "Used",
+	}
+	input := &pricing.GetProductsInput{
+		ServiceCode: appaws.StringPtr("AmazonEC2"),
+		MaxResults:  appaws.Int32Ptr(1),
+	}
+	for field, value := range filters {
+		input.Filters = append(input.Filters, pricingtypes.Filter{
+			Type:  pricingtypes.FilterTypeTermMatch,
+			Field: appaws.StringPtr(field),
+			Value: appaws.StringPtr(value),
+		})
+	}
+
+	output, err := client.GetProducts(ctx, input)
+	if err != nil {
+		return 0, apperrors.Wrapf(err, "get on-demand price for %s in %s", instanceType, region)
+	}
+	if len(output.PriceList) == 0 {
+		return 0, fmt.Errorf("no on-demand price for %s in %s", instanceType, region)
+	}
+	return parseOnDemandPrice(output.PriceList[0])
+}
+
+// SpotSavings returns the percentage saved by paying spot instead of on-demand.
+func SpotSavings(spot, onDemand float64) float64 {
+	if onDemand <= 0 {
+		return 0
+	}
+	return (1 - spot/onDemand) * 100
+}
+
+func pricingOperatingSystem(productDescription string) string {
+	switch {
+	case strings.Contains(productDescription, "Windows"):
+		return "Windows"
+	case strings.Contains(productDescription, "Red Hat"):
+		return "RHEL"
+	case strings.Contains(productDescription, "SUSE"):
+		return "SUSE"
+	default:
+		return "Linux"
+	}
+}
+
+// parseOnDemandPrice extracts the USD hourly rate from a price list product document.
+func parseOnDemandPrice(doc string) (float64, error) {
+	var product struct {
+		Terms struct {
+			OnDemand map[string]struct {
+				PriceDimensions map[string]struct {
+					PricePerUnit map[string]string `json:"pricePerUnit"`
+				} `json:"priceDimensions"`
+			} `json:"OnDemand"`
+		} `json:"terms"`
+	}
+	if err := json.Unmarshal([]byte(doc), &product); err != nil {
+		return 0, fmt.Errorf("parse price list: %w", err)
+	}
+	for _, term := range product.Terms.OnDemand {
+		for _, dim := range term.PriceDimensions {
+			if usd, ok := dim.PricePerUnit["USD"]; ok {
+				return strconv.ParseFloat(usd, 64)
+			}
+		}
+	}
+	return 0, fmt.Errorf("no USD on-demand price in price list")
+}
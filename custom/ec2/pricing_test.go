@@ -0,0 +1,50 @@
+package ec2
+
+import "testing"
+
+func TestParseOnDemandPrice(t *testing.T) {
+	doc := `{"product":{"sku":"ABC"},"terms":{"OnDemand":{"ABC.JRTCKXETXF":{"priceDimensions":{"ABC.JRTCKXETXF.6YS6EN2CT7":{"unit":"Hrs","pricePerUnit":{"USD":"0.0960000000"}}}}}}}`
+	got, err := parseOnDemandPrice(doc)
+	if err != nil {
+		t.Fatalf("parseOnDemandPrice() error = %v", err)
+	}
+	if got != 0.096 {
+		t.Errorf("parseOnDemandPrice() = %v, want 0.096", got)
+	}
+
+	if _, err := parseOnDemandPrice(`{"terms":{"OnDemand":{}}}`); err == nil {
+		t.Error("parseOnDemandPrice() expected error for missing price")
+	}
+}
+
+func TestSpotSavings(t *testing.T) {
+	tests := []struct {
+		spot, onDemand, want float64
+	}{
+		{0.03, 0.1, 70},
+		{0.1, 0.1, 0},
+		{0.05, 0, 0},
+	}
+	for _, tt := range tests {
+		got := SpotSavings(tt.spot, tt.onDemand)
+		if diff := got - tt.want; diff > 1e-9 || diff < -1e-9 {
+			t.Errorf("SpotSavings(%v, %v) = %v, want %v", tt.spot, tt.onDemand, got, tt.want)
+		}
+	}
+}
+
+func TestPricingOperatingSystem(t *testing.T) {
+	tests := map[string]string{
+		"":                         "Linux",
+		"Linux/UNIX":               "Linux",
+		"Linux/UNIX (Amazon VPC)":  "Linux",
+		"Windows (Amazon VPC)":     "Windows",
+		"Red Hat Enterprise Linux": "RHEL",
+		"SUSE Linux":               "SUSE",
+	}
+	for in, want := range tests {
+		if got := pricingOperatingSystem(in); got != want {
+			t.Errorf("pricingOperatingSystem(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -20,13 +20,13 @@ type SecurityGroupDAO struct {
 
 // NewSecurityGroupDAO creates a new SecurityGroupDAO
 func NewSecurityGroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SecurityGroupDAO{
 		BaseDAO: dao.NewBaseDAO("ec2", "security-groups"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
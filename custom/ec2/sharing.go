@@ -0,0 +1,62 @@
+package ec2
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// ValidateCopyRegion checks that dest is a different region in the same
+// partition as the source, since images and snapshots cannot be copied
+// across partitions.
+func ValidateCopyRegion(source, dest string) error {
+	if dest == "" {
+		return fmt.Errorf("destination region required")
+	}
+	if dest == source {
+		return fmt.Errorf("already in %s", dest)
+	}
+	partition := appaws.PartitionForRegion(source)
+	if !slices.Contains(appaws.PartitionRegions(partition), dest) {
+		return fmt.Errorf("unknown region %q in partition %s", dest, partition)
+	}
+	return nil
+}
+
+var accountIDPattern = regexp.MustCompile(`^\d{12}$`)
+
+// ParseAccountChanges splits "111122223333, -444455556666" into accounts to
+// add and accounts to remove, as used by the share-with-accounts actions.
+func ParseAccountChanges(input string) (add, remove []string, err error) {
+	for _, field := range strings.FieldsFunc(input, func(r rune) bool { return r == ',' || r == ' ' }) {
+		id, removing := strings.CutPrefix(field, "-")
+		if !accountIDPattern.MatchString(id) {
+			return nil, nil, fmt.Errorf("invalid account ID %q: must be 12 digits", id)
+		}
+		if removing {
+			remove = append(remove, id)
+		} else {
+			add = append(add, id)
+		}
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, nil, fmt.Errorf("no account IDs given")
+	}
+	return add, remove, nil
+}
+
+// DescribeAccountChanges formats the result of a share action, e.g.
+// "shared with 111122223333; unshared from 444455556666".
+func DescribeAccountChanges(add, remove []string) string {
+	var parts []string
+	if len(add) > 0 {
+		parts = append(parts, fmt.Sprintf("shared with %s", strings.Join(add, ", ")))
+	}
+	if len(remove) > 0 {
+		parts = append(parts, fmt.Sprintf("unshared from %s", strings.Join(remove, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,68 @@
+package ec2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAccountChanges(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantAdd    []string
+		wantRemove []string
+		wantErr    bool
+	}{
+		{input: "111122223333", wantAdd: []string{"111122223333"}},
+		{input: "111122223333, -444455556666", wantAdd: []string{"111122223333"}, wantRemove: []string{"444455556666"}},
+		{input: "111122223333 222233334444", wantAdd: []string{"111122223333", "222233334444"}},
+		{input: "12345", wantErr: true},
+		{input: "-abc", wantErr: true},
+		{input: " , ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			add, remove, err := ParseAccountChanges(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(add, tt.wantAdd) || !reflect.DeepEqual(remove, tt.wantRemove) {
+				t.Errorf("got add=%v remove=%v, want add=%v remove=%v", add, remove, tt.wantAdd, tt.wantRemove)
+			}
+		})
+	}
+}
+
+func TestValidateCopyRegion(t *testing.T) {
+	tests := []struct {
+		source, dest string
+		wantErr      bool
+	}{
+		{"us-east-1", "us-west-2", false},
+		{"us-east-1", "", true},
+		{"us-east-1", "us-east-1", true},
+		{"us-east-1", "cn-north-1", true},
+		{"us-east-1", "moon-1", true},
+	}
+	for _, tt := range tests {
+		if err := ValidateCopyRegion(tt.source, tt.dest); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateCopyRegion(%q, %q) err = %v, wantErr %v", tt.source, tt.dest, err, tt.wantErr)
+		}
+	}
+}
+
+func TestDescribeAccountChanges(t *testing.T) {
+	tests := []struct {
+		add, remove []string
+		want        string
+	}{
+		{add: []string{"111122223333"}, want: "shared with 111122223333"},
+		{remove: []string{"444455556666"}, want: "unshared from 444455556666"},
+		{add: []string{"111122223333", "222233334444"}, remove: []string{"444455556666"}, want: "shared with 111122223333, 222233334444; unshared from 444455556666"},
+	}
+	for _, tt := range tests {
+		if got := DescribeAccountChanges(tt.add, tt.remove); got != tt.want {
+			t.Errorf("DescribeAccountChanges(%v, %v) = %q, want %q", tt.add, tt.remove, got, tt.want)
+		}
+	}
+}
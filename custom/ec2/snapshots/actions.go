@@ -3,11 +3,16 @@ package snapshots
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	appec2 "github.com/clawscli/claws/custom/ec2"
 	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 )
 
@@ -20,6 +25,32 @@ func init() {
 			Operation: "DeleteSnapshot",
 			Confirm:   action.ConfirmDangerous,
 		},
+		{
+			Name:      "Copy to Region",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CopySnapshot",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Destination region",
+				Placeholder: "e.g. us-west-2",
+			},
+			Filter: func(r dao.Resource) bool {
+				snap, ok := dao.UnwrapResource(r).(*SnapshotResource)
+				return ok && snap.State() == string(types.SnapshotStateCompleted)
+			},
+		},
+		{
+			Name:      "Share with Accounts",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "ModifyCreateVolumePermissions",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Account IDs, comma-separated (prefix with - to unshare)",
+				Placeholder: "111122223333, -444455556666",
+			},
+		},
 	})
 
 	action.RegisterExecutor("ec2", "snapshots", executeSnapshotAction)
@@ -29,6 +60,10 @@ func executeSnapshotAction(ctx context.Context, act action.Action, resource dao.
 	switch act.Operation {
 	case "DeleteSnapshot":
 		return executeDeleteSnapshot(ctx, resource)
+	case "CopySnapshot":
+		return executeCopySnapshot(ctx, resource)
+	case "ModifyCreateVolumePermissions":
+		return executeModifyCreateVolumePermissions(ctx, resource)
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -53,3 +88,94 @@ func executeDeleteSnapshot(ctx context.Context, resource dao.Resource) action.Ac
 		Message: fmt.Sprintf("Deleted snapshot %s", snapshotID),
 	}
 }
+
+func executeCopySnapshot(ctx context.Context, resource dao.Resource) action.ActionResult {
+	snap, ok := dao.UnwrapResource(resource).(*SnapshotResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	sourceRegion := dao.GetResourceRegion(resource)
+	if sourceRegion == "" {
+		sourceRegion = appaws.CurrentRegion(ctx)
+	}
+	dest := strings.TrimSpace(action.InputFromContext(ctx))
+	if err := appec2.ValidateCopyRegion(sourceRegion, dest); err != nil {
+		return action.FailResult(err)
+	}
+
+	// CopySnapshot is called in the destination region and pulls from the source.
+	client, err := appec2.GetClient(appaws.WithRegionOverride(ctx, dest))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	snapshotID := snap.GetID()
+	input := &ec2.CopySnapshotInput{
+		SourceSnapshotId: &snapshotID,
+		SourceRegion:     &sourceRegion,
+		Description:      appaws.StringPtr(fmt.Sprintf("Copied from %s in %s", snapshotID, sourceRegion)),
+	}
+	if tags := copyableTags(snap.GetTags()); len(tags) > 0 {
+		input.TagSpecifications = []types.TagSpecification{
+			{ResourceType: types.ResourceTypeSnapshot, Tags: tags},
+		}
+	}
+
+	output, err := client.CopySnapshot(ctx, input)
+	if err != nil {
+		return action.FailResultf(err, "copy snapshot %s to %s", snapshotID, dest)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Copying %s to %s as %s", snapshotID, dest, appaws.Str(output.SnapshotId)))
+}
+
+// copyableTags returns the snapshot's user tags in key order. Tags with the
+// reserved "aws:" prefix, such as those added by DLM, cannot be set on the copy.
+func copyableTags(tags map[string]string) []types.Tag {
+	var out []types.Tag
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		if strings.HasPrefix(key, "aws:") {
+			continue
+		}
+		out = append(out, types.Tag{Key: appaws.StringPtr(key), Value: appaws.StringPtr(tags[key])})
+	}
+	return out
+}
+
+func executeModifyCreateVolumePermissions(ctx context.Context, resource dao.Resource) action.ActionResult {
+	snap, ok := dao.UnwrapResource(resource).(*SnapshotResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	add, remove, err := appec2.ParseAccountChanges(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	mods := &types.CreateVolumePermissionModifications{}
+	for _, id := range add {
+		mods.Add = append(mods.Add, types.CreateVolumePermission{UserId: appaws.StringPtr(id)})
+	}
+	for _, id := range remove {
+		mods.Remove = append(mods.Remove, types.CreateVolumePermission{UserId: appaws.StringPtr(id)})
+	}
+
+	snapshotID := snap.GetID()
+	_, err = client.ModifySnapshotAttribute(ctx, &ec2.ModifySnapshotAttributeInput{
+		SnapshotId:             &snapshotID,
+		Attribute:              types.SnapshotAttributeNameCreateVolumePermission,
+		CreateVolumePermission: mods,
+	})
+	if err != nil {
+		return action.FailResultf(err, "modify create volume permissions of %s", snapshotID)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Snapshot %s %s", snapshotID, appec2.DescribeAccountChanges(add, remove)))
+}
@@ -0,0 +1,28 @@
+package snapshots
+
+import (
+	"testing"
+
+	"github.com/clawscli/claws/internal/aws"
+)
+
+func TestCopyableTags(t *testing.T) {
+	tags := map[string]string{
+		"Name":                            "db-backup",
+		"env":                             "prod",
+		"aws:dlm:lifecycle-policy-id":     "policy-0123456789abcdef0",
+		"aws:dlm:lifecycle-schedule-name": "daily",
+	}
+
+	got := copyableTags(tags)
+	if len(got) != 2 {
+		t.Fatalf("copyableTags() returned %d tags, want 2", len(got))
+	}
+	if aws.Str(got[0].Key) != "Name" || aws.Str(got[1].Key) != "env" {
+		t.Errorf("copyableTags() keys = %s, %s; want Name, env", aws.Str(got[0].Key), aws.Str(got[1].Key))
+	}
+
+	if got := copyableTags(nil); got != nil {
+		t.Errorf("copyableTags(nil) = %v, want nil", got)
+	}
+}
@@ -4,29 +4,40 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/service/dlm"
+	dlmtypes "github.com/aws/aws-sdk-go-v2/service/dlm/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
+	appdlm "github.com/clawscli/claws/custom/dlm"
+	appec2 "github.com/clawscli/claws/custom/ec2"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // SnapshotDAO provides data access for EBS snapshots
 type SnapshotDAO struct {
 	dao.BaseDAO
-	client *ec2.Client
+	client    *ec2.Client
+	dlmClient *dlm.Client
 }
 
 // NewSnapshotDAO creates a new SnapshotDAO
 func NewSnapshotDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	dlmClient, err := appaws.Client(ctx, dlm.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SnapshotDAO{
-		BaseDAO: dao.NewBaseDAO("ec2", "snapshots"),
-		client:  ec2.NewFromConfig(cfg),
+		BaseDAO:   dao.NewBaseDAO("ec2", "snapshots"),
+		client:    client,
+		dlmClient: dlmClient,
 	}, nil
 }
 
@@ -39,6 +50,9 @@ func (d *SnapshotDAO) List(ctx context.Context) ([]dao.Resource, error) {
 
 // ListPage returns a page of EBS snapshots.
 // Implements dao.PaginatedDAO interface.
+// Supported filters:
+//   - DLMPolicyId: snapshots created by a Data Lifecycle Manager policy
+//   - dao.FilterTag, dao.FilterStatus: applied server-side
 func (d *SnapshotDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
 	// By default, only show owned snapshots
 	self := "self"
@@ -50,10 +64,17 @@ func (d *SnapshotDAO) ListPage(ctx context.Context, pageSize int, pageToken stri
 	input := &ec2.DescribeSnapshotsInput{
 		OwnerIds:   []string{self},
 		MaxResults: &maxResults,
+		Filters:    appec2.ListFilters(ctx, "status"),
 	}
 	if pageToken != "" {
 		input.NextToken = &pageToken
 	}
+	if policyID := dao.GetFilterFromContext(ctx, "DLMPolicyId"); policyID != "" {
+		input.Filters = append(input.Filters, types.Filter{
+			Name:   appaws.StringPtr("tag:" + appdlm.PolicyIDTag),
+			Values: []string{policyID},
+		})
+	}
 
 	output, err := d.client.DescribeSnapshots(ctx, input)
 	if err != nil {
@@ -64,6 +85,7 @@ func (d *SnapshotDAO) ListPage(ctx context.Context, pageSize int, pageToken stri
 	for i, snap := range output.Snapshots {
 		resources[i] = NewSnapshotResource(snap)
 	}
+	d.applyPolicies(ctx, resources)
 
 	nextToken := ""
 	if output.NextToken != nil {
@@ -87,7 +109,50 @@ func (d *SnapshotDAO) Get(ctx context.Context, id string) (dao.Resource, error)
 		return nil, fmt.Errorf("snapshot not found: %s", id)
 	}
 
-	return NewSnapshotResource(output.Snapshots[0]), nil
+	res := NewSnapshotResource(output.Snapshots[0])
+	d.applyPolicies(ctx, []dao.Resource{res})
+
+	attr, err := d.client.DescribeSnapshotAttribute(ctx, &ec2.DescribeSnapshotAttributeInput{
+		SnapshotId: &id,
+		Attribute:  types.SnapshotAttributeNameCreateVolumePermission,
+	})
+	if err != nil {
+		log.Warn("failed to describe snapshot create volume permissions", "snapshot", id, "error", err)
+	} else {
+		res.VolumePermissions = attr.CreateVolumePermissions
+		res.PermissionsLoaded = true
+	}
+	return res, nil
+}
+
+// applyPolicies attaches the DLM policy that created each snapshot, fetching
+// each distinct policy once. Lookups are best-effort: on failure the snapshot
+// still shows the policy ID from its tag.
+func (d *SnapshotDAO) applyPolicies(ctx context.Context, resources []dao.Resource) {
+	policies := make(map[string]*dlmtypes.LifecyclePolicy)
+	for _, r := range resources {
+		snap, ok := r.(*SnapshotResource)
+		if !ok {
+			continue
+		}
+		policyID := snap.LifecyclePolicyID()
+		if policyID == "" {
+			continue
+		}
+		policy, seen := policies[policyID]
+		if !seen {
+			output, err := d.dlmClient.GetLifecyclePolicy(ctx, &dlm.GetLifecyclePolicyInput{
+				PolicyId: &policyID,
+			})
+			if err != nil {
+				log.Debug("failed to get lifecycle policy", "policy", policyID, "error", err)
+			} else {
+				policy = output.Policy
+			}
+			policies[policyID] = policy
+		}
+		snap.LifecyclePolicy = policy
+	}
 }
 
 func (d *SnapshotDAO) Delete(ctx context.Context, id string) error {
@@ -113,6 +178,12 @@ func (d *SnapshotDAO) Delete(ctx context.Context, id string) error {
 type SnapshotResource struct {
 	dao.BaseResource
 	Item types.Snapshot
+
+	// LifecyclePolicy is the DLM policy that created the snapshot, if any
+	LifecyclePolicy *dlmtypes.LifecyclePolicy
+
+	VolumePermissions []types.CreateVolumePermission
+	PermissionsLoaded bool
 }
 
 // NewSnapshotResource creates a new SnapshotResource
@@ -173,3 +244,35 @@ func (r *SnapshotResource) OwnerId() string {
 	}
 	return ""
 }
+
+// LifecyclePolicyID returns the ID of the DLM policy that created the snapshot
+func (r *SnapshotResource) LifecyclePolicyID() string {
+	return r.Tags[appdlm.PolicyIDTag]
+}
+
+// LifecycleSchedule returns the DLM schedule that created the snapshot,
+// falling back to the policy's first schedule when the tag is missing.
+func (r *SnapshotResource) LifecycleSchedule() *dlmtypes.Schedule {
+	if r.LifecyclePolicy == nil || r.LifecyclePolicy.PolicyDetails == nil {
+		return nil
+	}
+	schedules := r.LifecyclePolicy.PolicyDetails.Schedules
+	name := r.Tags[appdlm.ScheduleNameTag]
+	for i := range schedules {
+		if appaws.Str(schedules[i].Name) == name {
+			return &schedules[i]
+		}
+	}
+	if len(schedules) > 0 {
+		return &schedules[0]
+	}
+	return nil
+}
+
+// Retention describes how long DLM keeps the snapshot, e.g. "last 7"
+func (r *SnapshotResource) Retention() string {
+	if s := r.LifecycleSchedule(); s != nil {
+		return appdlm.RetainSummary(s.RetainRule)
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package snapshots
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	dlmtypes "github.com/aws/aws-sdk-go-v2/service/dlm/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func dlmSnapshot(schedule string) *SnapshotResource {
+	tags := []types.Tag{
+		{Key: aws.String("aws:dlm:lifecycle-policy-id"), Value: aws.String("policy-0123456789abcdef0")},
+	}
+	if schedule != "" {
+		tags = append(tags, types.Tag{Key: aws.String("aws:dlm:lifecycle-schedule-name"), Value: aws.String(schedule)})
+	}
+	return NewSnapshotResource(types.Snapshot{SnapshotId: aws.String("snap-1"), Tags: tags})
+}
+
+func TestSnapshotRetention(t *testing.T) {
+	policy := &dlmtypes.LifecyclePolicy{
+		PolicyDetails: &dlmtypes.PolicyDetails{
+			Schedules: []dlmtypes.Schedule{
+				{Name: aws.String("daily"), RetainRule: &dlmtypes.RetainRule{Count: aws.Int32(7)}},
+				{Name: aws.String("monthly"), RetainRule: &dlmtypes.RetainRule{Interval: aws.Int32(1), IntervalUnit: dlmtypes.RetentionIntervalUnitValuesYears}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		schedule string
+		policy   *dlmtypes.LifecyclePolicy
+		want     string
+	}{
+		{"matching schedule", "monthly", policy, "1 year"},
+		{"missing schedule tag falls back to first", "", policy, "last 7"},
+		{"policy not loaded", "daily", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snap := dlmSnapshot(tt.schedule)
+			snap.LifecyclePolicy = tt.policy
+			if got := snap.Retention(); got != tt.want {
+				t.Errorf("Retention() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnapshotNavigations(t *testing.T) {
+	r := NewSnapshotRenderer().(*SnapshotRenderer)
+
+	navs := r.Navigations(dlmSnapshot("daily"))
+	if len(navs) != 1 || navs[0].Service != "dlm" || navs[0].FilterValue != "policy-0123456789abcdef0" {
+		t.Errorf("Navigations() = %+v, want lifecycle policy navigation", navs)
+	}
+
+	plain := NewSnapshotResource(types.Snapshot{SnapshotId: aws.String("snap-2")})
+	if navs := r.Navigations(plain); len(navs) != 0 {
+		t.Errorf("Navigations() for unmanaged snapshot = %+v, want none", navs)
+	}
+}
@@ -3,6 +3,7 @@ package snapshots
 import (
 	"fmt"
 
+	appdlm "github.com/clawscli/claws/custom/dlm"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -107,7 +108,18 @@ func NewSnapshotRenderer() render.Renderer {
 					},
 					Priority: 7,
 				},
-				render.TagsColumn(25, 8),
+				{
+					Name:  "RETENTION",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SnapshotResource); ok {
+							return v.Retention()
+						}
+						return ""
+					},
+					Priority: 8,
+				},
+				render.TagsColumn(25, 9),
 			},
 		},
 	}
@@ -148,6 +160,42 @@ func (r *SnapshotRenderer) RenderDetail(resource dao.Resource) string {
 	d.Section("Ownership")
 	d.Field("Owner ID", v.OwnerId())
 
+	if v.PermissionsLoaded {
+		d.Section("Create Volume Permissions")
+		if len(v.VolumePermissions) == 0 {
+			d.DimIndent("(owner only)")
+		}
+		for _, perm := range v.VolumePermissions {
+			switch {
+			case perm.UserId != nil:
+				d.Field("Account", *perm.UserId)
+			case perm.Group != "":
+				d.Field("Group", string(perm.Group))
+			}
+		}
+	}
+
+	if policyID := v.LifecyclePolicyID(); policyID != "" {
+		d.Section("Lifecycle Policy")
+		d.Field("Policy ID", policyID)
+		if p := v.LifecyclePolicy; p != nil {
+			d.FieldIf("Description", p.Description)
+			d.Field("State", string(p.State))
+		}
+		if schedule := v.LifecycleSchedule(); schedule != nil {
+			d.FieldIf("Schedule", schedule.Name)
+			if create := appdlm.CreateSummary(schedule.CreateRule); create != "" {
+				d.Field("Runs", create)
+			}
+			if retain := appdlm.RetainSummary(schedule.RetainRule); retain != "" {
+				d.Field("Retain", retain)
+			}
+			if copies := appdlm.CopySummary(schedule.CrossRegionCopyRules); copies != "" {
+				d.Field("Copies To", copies)
+			}
+		}
+	}
+
 	// Description
 	if desc := v.Description(); desc != "" {
 		d.Section("Description")
@@ -193,6 +241,10 @@ func (r *SnapshotRenderer) RenderSummary(resource dao.Resource) []render.Summary
 
 	fields = append(fields, render.SummaryField{Label: "Owner", Value: v.OwnerId()})
 
+	if retain := v.Retention(); retain != "" {
+		fields = append(fields, render.SummaryField{Label: "Retention", Value: retain})
+	}
+
 	if v.Item.StartTime != nil {
 		fields = append(fields, render.SummaryField{
 			Label: "Started",
@@ -202,3 +254,20 @@ func (r *SnapshotRenderer) RenderSummary(resource dao.Resource) []render.Summary
 
 	return fields
 }
+
+// Navigations returns navigation shortcuts
+func (r *SnapshotRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*SnapshotResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if policyID := v.LifecyclePolicyID(); policyID != "" {
+		navs = append(navs, render.Navigation{
+			Key: "l", Label: "Lifecycle Policy", Service: "dlm", Resource: "policies",
+			FilterField: "PolicyId", FilterValue: policyID,
+		})
+	}
+	return navs
+}
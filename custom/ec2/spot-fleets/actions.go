@@ -0,0 +1,74 @@
+package spotfleets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ec2", "spot-fleets", []action.Action{
+		{
+			Name:      "Cancel Fleet",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelSpotFleetRequests",
+			Confirm:   action.ConfirmDangerous,
+		},
+		{
+			Name:      "Cancel Fleet + Terminate",
+			Shortcut:  "T",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelSpotFleetRequestsTerminate",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("ec2", "spot-fleets", executeSpotFleetAction)
+}
+
+func executeSpotFleetAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CancelSpotFleetRequests":
+		return executeCancelSpotFleet(ctx, resource, false)
+	case "CancelSpotFleetRequestsTerminate":
+		return executeCancelSpotFleet(ctx, resource, true)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeCancelSpotFleet(ctx context.Context, resource dao.Resource, terminate bool) action.ActionResult {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	fleetID := resource.GetID()
+	output, err := client.CancelSpotFleetRequests(ctx, &ec2.CancelSpotFleetRequestsInput{
+		SpotFleetRequestIds: []string{fleetID},
+		TerminateInstances:  appaws.BoolPtr(terminate),
+	})
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("cancel spot fleet: %w", err)}
+	}
+	if len(output.UnsuccessfulFleetRequests) > 0 {
+		item := output.UnsuccessfulFleetRequests[0]
+		msg := "unknown error"
+		if item.Error != nil {
+			msg = appaws.Str(item.Error.Message)
+		}
+		return action.ActionResult{Success: false, Error: fmt.Errorf("cancel spot fleet %s: %s", fleetID, msg)}
+	}
+
+	if terminate {
+		return action.ActionResult{Success: true, Message: fmt.Sprintf("Cancelled spot fleet %s and terminated its instances", fleetID)}
+	}
+	return action.ActionResult{Success: true, Message: fmt.Sprintf("Cancelled spot fleet %s", fleetID)}
+}
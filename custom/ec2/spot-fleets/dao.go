@@ -0,0 +1,226 @@
+package spotfleets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// historyWindow is how far back Get looks for interruption events.
+const historyWindow = 24 * time.Hour
+
+// SpotFleetDAO provides data access for EC2 spot fleet requests
+type SpotFleetDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewSpotFleetDAO creates a new SpotFleetDAO
+func NewSpotFleetDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &SpotFleetDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "spot-fleets"),
+		client:  client,
+	}, nil
+}
+
+func (d *SpotFleetDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	paginator := ec2.NewDescribeSpotFleetRequestsPaginator(d.client, &ec2.DescribeSpotFleetRequestsInput{})
+
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe spot fleet requests")
+		}
+
+		for _, fleet := range output.SpotFleetRequestConfigs {
+			resources = append(resources, NewSpotFleetResource(fleet))
+		}
+	}
+
+	return resources, nil
+}
+
+// Get returns a spot fleet enriched with its active instances and recent
+// interruption events. Enrichment is best-effort; failures are logged.
+func (d *SpotFleetDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeSpotFleetRequests(ctx, &ec2.DescribeSpotFleetRequestsInput{
+		SpotFleetRequestIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe spot fleet request %s", id)
+	}
+
+	if len(output.SpotFleetRequestConfigs) == 0 {
+		return nil, fmt.Errorf("spot fleet request not found: %s", id)
+	}
+
+	r := NewSpotFleetResource(output.SpotFleetRequestConfigs[0])
+
+	instances, err := d.activeInstances(ctx, id)
+	if err != nil {
+		log.Warn("failed to describe spot fleet instances", "fleet", id, "error", err)
+	} else {
+		r.ActiveInstances = instances
+	}
+
+	events, err := d.interruptions(ctx, id)
+	if err != nil {
+		log.Warn("failed to describe spot fleet history", "fleet", id, "error", err)
+	} else {
+		r.Interruptions = events
+	}
+
+	return r, nil
+}
+
+func (d *SpotFleetDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.CancelSpotFleetRequests(ctx, &ec2.CancelSpotFleetRequestsInput{
+		SpotFleetRequestIds: []string{id},
+		TerminateInstances:  appaws.BoolPtr(false),
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already cancelled
+		}
+		return apperrors.Wrapf(err, "cancel spot fleet request %s", id)
+	}
+	return nil
+}
+
+func (d *SpotFleetDAO) activeInstances(ctx context.Context, id string) ([]types.ActiveInstance, error) {
+	var instances []types.ActiveInstance
+	input := &ec2.DescribeSpotFleetInstancesInput{SpotFleetRequestId: &id}
+	for {
+		output, err := d.client.DescribeSpotFleetInstances(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, output.ActiveInstances...)
+		if output.NextToken == nil {
+			return instances, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+func (d *SpotFleetDAO) interruptions(ctx context.Context, id string) ([]types.HistoryRecord, error) {
+	start := time.Now().Add(-historyWindow)
+	var records []types.HistoryRecord
+	input := &ec2.DescribeSpotFleetRequestHistoryInput{
+		SpotFleetRequestId: &id,
+		StartTime:          &start,
+		EventType:          types.EventTypeInstanceChange,
+	}
+	for {
+		output, err := d.client.DescribeSpotFleetRequestHistory(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, rec := range output.HistoryRecords {
+			if isInterruption(rec) {
+				records = append(records, rec)
+			}
+		}
+		if output.NextToken == nil {
+			return records, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// isInterruption reports whether a fleet history record is an interruption
+// notice or an EC2-initiated termination.
+func isInterruption(rec types.HistoryRecord) bool {
+	if rec.EventInformation == nil {
+		return false
+	}
+	switch appaws.Str(rec.EventInformation.EventSubType) {
+	case "termination_notified", "terminated":
+		return true
+	}
+	return false
+}
+
+// SpotFleetResource wraps an EC2 spot fleet request
+type SpotFleetResource struct {
+	dao.BaseResource
+	Item types.SpotFleetRequestConfig
+
+	// Populated by Get only
+	ActiveInstances []types.ActiveInstance
+	Interruptions   []types.HistoryRecord
+}
+
+// NewSpotFleetResource creates a new SpotFleetResource
+func NewSpotFleetResource(fleet types.SpotFleetRequestConfig) *SpotFleetResource {
+	return &SpotFleetResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(fleet.SpotFleetRequestId),
+			Name: appaws.EC2NameTag(fleet.Tags),
+			Tags: appaws.TagsToMap(fleet.Tags),
+			Data: fleet,
+		},
+		Item: fleet,
+	}
+}
+
+func (r *SpotFleetResource) State() string {
+	return string(r.Item.SpotFleetRequestState)
+}
+
+func (r *SpotFleetResource) ActivityStatus() string {
+	return string(r.Item.ActivityStatus)
+}
+
+func (r *SpotFleetResource) config() *types.SpotFleetRequestConfigData {
+	if r.Item.SpotFleetRequestConfig != nil {
+		return r.Item.SpotFleetRequestConfig
+	}
+	return &types.SpotFleetRequestConfigData{}
+}
+
+func (r *SpotFleetResource) FleetType() string {
+	return string(r.config().Type)
+}
+
+func (r *SpotFleetResource) AllocationStrategy() string {
+	return string(r.config().AllocationStrategy)
+}
+
+func (r *SpotFleetResource) TargetCapacity() int32 {
+	return appaws.Int32(r.config().TargetCapacity)
+}
+
+func (r *SpotFleetResource) FulfilledCapacity() float64 {
+	if c := r.config().FulfilledCapacity; c != nil {
+		return *c
+	}
+	return 0
+}
+
+func (r *SpotFleetResource) OnDemandTargetCapacity() int32 {
+	return appaws.Int32(r.config().OnDemandTargetCapacity)
+}
+
+// MaxPrice returns the fleet-wide maximum spot price (empty means on-demand cap).
+func (r *SpotFleetResource) MaxPrice() string {
+	return appaws.Str(r.config().SpotPrice)
+}
+
+// Capacity returns "fulfilled/target" capacity.
+func (r *SpotFleetResource) Capacity() string {
+	return fmt.Sprintf("%g/%d", r.FulfilledCapacity(), r.TargetCapacity())
+}
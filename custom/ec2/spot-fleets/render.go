@@ -0,0 +1,229 @@
+package spotfleets
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Navigator = (*SpotFleetRenderer)(nil)
+
+// SpotFleetRenderer renders EC2 spot fleet requests
+type SpotFleetRenderer struct {
+	render.BaseRenderer
+}
+
+// NewSpotFleetRenderer creates a new SpotFleetRenderer
+func NewSpotFleetRenderer() render.Renderer {
+	return &SpotFleetRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "spot-fleets",
+			Cols: []render.Column{
+				{
+					Name:  "FLEET ID",
+					Width: 46,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "STATE",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotFleetResource); ok {
+							return v.State()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "ACTIVITY",
+					Width: 22,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotFleetResource); ok {
+							return v.ActivityStatus()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "CAPACITY",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotFleetResource); ok {
+							return v.Capacity()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "TYPE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotFleetResource); ok {
+							return v.FleetType()
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "ALLOCATION",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotFleetResource); ok {
+							return v.AllocationStrategy()
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+				{
+					Name:  "AGE",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotFleetResource); ok && v.Item.CreateTime != nil {
+							return render.FormatAge(*v.Item.CreateTime)
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed spot fleet information
+func (r *SpotFleetRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*SpotFleetResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Spot Fleet", v.GetID())
+
+	// Basic Info
+	d.Section("Basic Information")
+	d.Field("Fleet ID", v.GetID())
+	if name := v.GetName(); name != "" {
+		d.Field("Name", name)
+	}
+	d.FieldStyled("State", v.State(), render.StateColorer()(v.State()))
+	d.Field("Activity", v.ActivityStatus())
+	d.Field("Type", v.FleetType())
+	d.Field("Allocation Strategy", v.AllocationStrategy())
+	if v.Item.CreateTime != nil {
+		d.Field("Created", v.Item.CreateTime.Format("2006-01-02 15:04:05"))
+	}
+	cfg := v.Item.SpotFleetRequestConfig
+	if cfg != nil {
+		if cfg.ValidUntil != nil {
+			d.Field("Valid Until", cfg.ValidUntil.Format("2006-01-02 15:04:05"))
+		}
+		d.FieldIf("IAM Fleet Role", cfg.IamFleetRole)
+		if cfg.InstanceInterruptionBehavior != "" {
+			d.Field("Interruption Behavior", string(cfg.InstanceInterruptionBehavior))
+		}
+	}
+
+	// Capacity
+	d.Section("Capacity")
+	d.Field("Target", fmt.Sprintf("%d", v.TargetCapacity()))
+	d.Field("Fulfilled", fmt.Sprintf("%g", v.FulfilledCapacity()))
+	if od := v.OnDemandTargetCapacity(); od > 0 {
+		d.Field("On-Demand Target", fmt.Sprintf("%d", od))
+	}
+	if p := v.MaxPrice(); p != "" {
+		d.Field("Max Price", "$"+p+"/hr")
+	} else {
+		d.Field("Max Price", "on-demand price")
+	}
+
+	// Active Instances
+	d.Section("Active Instances")
+	if len(v.ActiveInstances) > 0 {
+		for _, inst := range v.ActiveInstances {
+			value := appaws.Str(inst.InstanceType)
+			if inst.InstanceHealth != "" {
+				value += " (" + string(inst.InstanceHealth) + ")"
+			}
+			d.Field(appaws.Str(inst.InstanceId), value)
+		}
+	} else {
+		d.Field("Instances", render.Empty)
+	}
+
+	// Interruptions
+	d.Section("Interruptions (last 24h)")
+	if len(v.Interruptions) > 0 {
+		for _, rec := range v.Interruptions {
+			ts := ""
+			if rec.Timestamp != nil {
+				ts = rec.Timestamp.Format("2006-01-02 15:04:05")
+			}
+			info := rec.EventInformation
+			msg := appaws.Str(info.EventSubType)
+			if id := appaws.Str(info.InstanceId); id != "" {
+				msg = id + ": " + msg
+			}
+			d.FieldStyled(ts, msg, ui.DangerStyle())
+		}
+	} else {
+		d.Field("Notices", render.Empty)
+	}
+
+	// Tags
+	d.Tags(v.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *SpotFleetRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*SpotFleetResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Fleet ID", Value: v.GetID()},
+		{Label: "State", Value: v.State(), Style: render.StateColorer()(v.State())},
+		{Label: "Activity", Value: v.ActivityStatus()},
+		{Label: "Capacity", Value: v.Capacity()},
+		{Label: "Type", Value: v.FleetType()},
+	}
+
+	if n := len(v.Interruptions); n > 0 {
+		fields = append(fields, render.SummaryField{
+			Label: "Interruptions", Value: fmt.Sprintf("%d in 24h", n), Style: ui.DangerStyle(),
+		})
+	}
+
+	return fields
+}
+
+// Navigations returns navigation shortcuts for spot fleets
+func (r *SpotFleetRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*SpotFleetResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key: "i", Label: "Instances", Service: "ec2", Resource: "instances",
+			FilterField: "SpotFleetRequestId", FilterValue: v.GetID(),
+		},
+	}
+}
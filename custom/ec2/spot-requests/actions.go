@@ -0,0 +1,55 @@
+package spotrequests
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ec2", "spot-requests", []action.Action{
+		{
+			Name:      "Cancel Request",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelSpotInstanceRequests",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("ec2", "spot-requests", executeSpotRequestAction)
+}
+
+func executeSpotRequestAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CancelSpotInstanceRequests":
+		return executeCancelSpotRequest(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeCancelSpotRequest(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	requestID := resource.GetID()
+	_, err = client.CancelSpotInstanceRequests(ctx, &ec2.CancelSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []string{requestID},
+	})
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("cancel spot request: %w", err)}
+	}
+
+	return action.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Cancelled spot request %s (instance is not terminated)", requestID),
+	}
+}
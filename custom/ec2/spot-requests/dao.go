@@ -0,0 +1,212 @@
+package spotrequests
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// SpotRequestDAO provides data access for EC2 spot instance requests
+type SpotRequestDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+	region string
+}
+
+// NewSpotRequestDAO creates a new SpotRequestDAO
+func NewSpotRequestDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &SpotRequestDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "spot-requests"),
+		client:  client,
+		region:  client.Options().Region,
+	}, nil
+}
+
+func (d *SpotRequestDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	paginator := ec2.NewDescribeSpotInstanceRequestsPaginator(d.client, &ec2.DescribeSpotInstanceRequestsInput{})
+
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe spot instance requests")
+		}
+
+		for _, req := range output.SpotInstanceRequests {
+			resources = append(resources, NewSpotRequestResource(req))
+		}
+	}
+
+	return resources, nil
+}
+
+// Get returns a spot request enriched with current spot and on-demand pricing.
+// Pricing lookups are best-effort; failures are logged and leave prices unset.
+func (d *SpotRequestDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeSpotInstanceRequests(ctx, &ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe spot instance request %s", id)
+	}
+
+	if len(output.SpotInstanceRequests) == 0 {
+		return nil, fmt.Errorf("spot instance request not found: %s", id)
+	}
+
+	r := NewSpotRequestResource(output.SpotInstanceRequests[0])
+
+	instanceType, az := r.InstanceType(), r.AZ()
+	if instanceType == "" || az == "" {
+		return r, nil
+	}
+
+	if price, err := appec2.CurrentSpotPrice(ctx, d.client, instanceType, az, r.ProductDescription()); err != nil {
+		log.Warn("failed to fetch spot price", "request", id, "error", err)
+	} else {
+		r.CurrentSpotPrice = &price
+	}
+
+	if price, err := appec2.OnDemandPrice(ctx, instanceType, d.region, r.ProductDescription()); err != nil {
+		log.Warn("failed to fetch on-demand price", "request", id, "error", err)
+	} else {
+		r.OnDemandPrice = &price
+	}
+
+	return r, nil
+}
+
+func (d *SpotRequestDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.CancelSpotInstanceRequests(ctx, &ec2.CancelSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []string{id},
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already cancelled
+		}
+		return apperrors.Wrapf(err, "cancel spot instance request %s", id)
+	}
+	return nil
+}
+
+// interruptionCodes are spot request status codes that indicate the instance
+// has been (or is about to be) interrupted by EC2.
+var interruptionCodes = map[string]string{
+	"marked-for-stop":                             "Stop notice issued",
+	"marked-for-termination":                      "Termination notice issued",
+	"marked-for-hibernation":                      "Hibernation notice issued",
+	"instance-stopped-by-price":                   "Stopped: price exceeded max",
+	"instance-stopped-no-capacity":                "Stopped: no capacity",
+	"instance-terminated-by-price":                "Terminated: price exceeded max",
+	"instance-terminated-no-capacity":             "Terminated: no capacity",
+	"instance-terminated-capacity-oversubscribed": "Terminated: capacity oversubscribed",
+	"instance-hibernated-by-price":                "Hibernated: price exceeded max",
+	"instance-hibernated-no-capacity":             "Hibernated: no capacity",
+}
+
+// SpotRequestResource wraps an EC2 spot instance request
+type SpotRequestResource struct {
+	dao.BaseResource
+	Item types.SpotInstanceRequest
+
+	// Populated by Get only
+	CurrentSpotPrice *float64
+	OnDemandPrice    *float64
+}
+
+// NewSpotRequestResource creates a new SpotRequestResource
+func NewSpotRequestResource(req types.SpotInstanceRequest) *SpotRequestResource {
+	return &SpotRequestResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(req.SpotInstanceRequestId),
+			Name: appaws.EC2NameTag(req.Tags),
+			Tags: appaws.TagsToMap(req.Tags),
+			Data: req,
+		},
+		Item: req,
+	}
+}
+
+func (r *SpotRequestResource) State() string {
+	return string(r.Item.State)
+}
+
+func (r *SpotRequestResource) StatusCode() string {
+	if r.Item.Status != nil {
+		return appaws.Str(r.Item.Status.Code)
+	}
+	return ""
+}
+
+func (r *SpotRequestResource) StatusMessage() string {
+	if r.Item.Status != nil {
+		return appaws.Str(r.Item.Status.Message)
+	}
+	return ""
+}
+
+func (r *SpotRequestResource) RequestType() string {
+	return string(r.Item.Type)
+}
+
+func (r *SpotRequestResource) InstanceId() string {
+	return appaws.Str(r.Item.InstanceId)
+}
+
+func (r *SpotRequestResource) InstanceType() string {
+	if r.Item.LaunchSpecification != nil {
+		return string(r.Item.LaunchSpecification.InstanceType)
+	}
+	return ""
+}
+
+func (r *SpotRequestResource) ProductDescription() string {
+	return string(r.Item.ProductDescription)
+}
+
+// AZ returns the launched AZ, falling back to the requested placement.
+func (r *SpotRequestResource) AZ() string {
+	if az := appaws.Str(r.Item.LaunchedAvailabilityZone); az != "" {
+		return az
+	}
+	if r.Item.LaunchSpecification != nil && r.Item.LaunchSpecification.Placement != nil {
+		return appaws.Str(r.Item.LaunchSpecification.Placement.AvailabilityZone)
+	}
+	return ""
+}
+
+// MaxPrice returns the maximum price the request will pay (empty means on-demand cap).
+func (r *SpotRequestResource) MaxPrice() string {
+	return appaws.Str(r.Item.SpotPrice)
+}
+
+// InterruptionNotice returns a human-readable interruption notice, or empty if none.
+func (r *SpotRequestResource) InterruptionNotice() string {
+	return interruptionCodes[r.StatusCode()]
+}
+
+// Savings returns the spot discount vs on-demand as a percentage, and whether it is known.
+func (r *SpotRequestResource) Savings() (float64, bool) {
+	if r.CurrentSpotPrice == nil || r.OnDemandPrice == nil || *r.OnDemandPrice <= 0 {
+		return 0, false
+	}
+	return appec2.SpotSavings(*r.CurrentSpotPrice, *r.OnDemandPrice), true
+}
+
+// formatPrice formats an hourly USD price.
+func formatPrice(p float64) string {
+	return "$" + strconv.FormatFloat(p, 'f', 4, 64) + "/hr"
+}
@@ -0,0 +1,20 @@
+package spotrequests
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ec2", "spot-requests", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewSpotRequestDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewSpotRequestRenderer()
+		},
+	})
+}
@@ -0,0 +1,279 @@
+package spotrequests
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Navigator = (*SpotRequestRenderer)(nil)
+
+// SpotRequestRenderer renders EC2 spot instance requests
+type SpotRequestRenderer struct {
+	render.BaseRenderer
+}
+
+// NewSpotRequestRenderer creates a new SpotRequestRenderer
+func NewSpotRequestRenderer() render.Renderer {
+	return &SpotRequestRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "spot-requests",
+			Cols: []render.Column{
+				{
+					Name:  "REQUEST ID",
+					Width: 22,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "STATE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotRequestResource); ok {
+							return v.State()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "STATUS",
+					Width: 30,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotRequestResource); ok {
+							return v.StatusCode()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "INSTANCE",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotRequestResource); ok {
+							return v.InstanceId()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "TYPE",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotRequestResource); ok {
+							return v.InstanceType()
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "AZ",
+					Width: 14,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotRequestResource); ok {
+							return v.AZ()
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+				{
+					Name:  "REQUEST TYPE",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotRequestResource); ok {
+							return v.RequestType()
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+				{
+					Name:  "MAX PRICE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotRequestResource); ok {
+							if p := v.MaxPrice(); p != "" {
+								return p
+							}
+							return "on-demand"
+						}
+						return ""
+					},
+					Priority: 7,
+				},
+				{
+					Name:  "AGE",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*SpotRequestResource); ok && v.Item.CreateTime != nil {
+							return render.FormatAge(*v.Item.CreateTime)
+						}
+						return ""
+					},
+					Priority: 8,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed spot request information
+func (r *SpotRequestRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*SpotRequestResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Spot Request", v.GetID())
+
+	// Basic Info
+	d.Section("Basic Information")
+	d.Field("Request ID", v.GetID())
+	if name := v.GetName(); name != "" {
+		d.Field("Name", name)
+	}
+	d.FieldStyled("State", v.State(), spotStateStyle(v.State()))
+	d.Field("Request Type", v.RequestType())
+	if v.Item.InstanceInterruptionBehavior != "" {
+		d.Field("Interruption Behavior", string(v.Item.InstanceInterruptionBehavior))
+	}
+	if v.Item.CreateTime != nil {
+		d.Field("Created", v.Item.CreateTime.Format("2006-01-02 15:04:05"))
+	}
+	if v.Item.ValidUntil != nil {
+		d.Field("Valid Until", v.Item.ValidUntil.Format("2006-01-02 15:04:05"))
+	}
+
+	// Status
+	d.Section("Status")
+	d.Field("Code", v.StatusCode())
+	if msg := v.StatusMessage(); msg != "" {
+		d.Field("Message", msg)
+	}
+	if v.Item.Status != nil && v.Item.Status.UpdateTime != nil {
+		d.Field("Updated", v.Item.Status.UpdateTime.Format("2006-01-02 15:04:05"))
+	}
+	if notice := v.InterruptionNotice(); notice != "" {
+		d.FieldStyled("Interruption", notice, ui.DangerStyle())
+	}
+	if v.Item.Fault != nil {
+		d.FieldStyled("Fault", appaws.Str(v.Item.Fault.Code)+": "+appaws.Str(v.Item.Fault.Message), ui.DangerStyle())
+	}
+
+	// Instance
+	d.Section("Instance")
+	if id := v.InstanceId(); id != "" {
+		d.Field("Instance ID", id)
+	} else {
+		d.Field("Instance ID", render.NoValue)
+	}
+	d.Field("Instance Type", v.InstanceType())
+	d.Field("Availability Zone", v.AZ())
+	d.Field("Product", v.ProductDescription())
+	if spec := v.Item.LaunchSpecification; spec != nil {
+		d.FieldIf("AMI", spec.ImageId)
+		d.FieldIf("Key Pair", spec.KeyName)
+		d.FieldIf("Subnet", spec.SubnetId)
+	}
+
+	// Pricing
+	d.Section("Pricing")
+	if p := v.MaxPrice(); p != "" {
+		d.Field("Max Price", "$"+p+"/hr")
+	} else {
+		d.Field("Max Price", "on-demand price")
+	}
+	if v.CurrentSpotPrice != nil {
+		d.Field("Current Spot Price", formatPrice(*v.CurrentSpotPrice))
+	} else {
+		d.Field("Current Spot Price", render.NoValue)
+	}
+	if v.OnDemandPrice != nil {
+		d.Field("On-Demand Price", formatPrice(*v.OnDemandPrice))
+	} else {
+		d.Field("On-Demand Price", render.NoValue)
+	}
+	if savings, ok := v.Savings(); ok {
+		d.FieldStyled("Savings vs On-Demand", fmt.Sprintf("%.0f%%", savings), ui.SuccessStyle())
+	} else {
+		d.Field("Savings vs On-Demand", render.NoValue)
+	}
+
+	// Tags
+	d.Tags(v.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *SpotRequestRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*SpotRequestResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Request ID", Value: v.GetID()},
+		{Label: "State", Value: v.State(), Style: spotStateStyle(v.State())},
+		{Label: "Status", Value: v.StatusCode()},
+	}
+
+	if id := v.InstanceId(); id != "" {
+		fields = append(fields, render.SummaryField{Label: "Instance", Value: id})
+	}
+	fields = append(fields, render.SummaryField{Label: "Type", Value: v.InstanceType()})
+	fields = append(fields, render.SummaryField{Label: "AZ", Value: v.AZ()})
+
+	if notice := v.InterruptionNotice(); notice != "" {
+		fields = append(fields, render.SummaryField{Label: "Interruption", Value: notice, Style: ui.DangerStyle()})
+	}
+	if savings, ok := v.Savings(); ok {
+		fields = append(fields, render.SummaryField{Label: "Savings", Value: fmt.Sprintf("%.0f%%", savings), Style: ui.SuccessStyle()})
+	}
+
+	return fields
+}
+
+// Navigations returns navigation shortcuts for spot requests
+func (r *SpotRequestRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*SpotRequestResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if id := v.InstanceId(); id != "" {
+		navs = append(navs, render.Navigation{
+			Key: "i", Label: "Instance", Service: "ec2", Resource: "instances",
+			FilterField: "InstanceId", FilterValue: id,
+		})
+	}
+	return navs
+}
+
+func spotStateStyle(state string) render.Style {
+	switch state {
+	case "active":
+		return ui.SuccessStyle()
+	case "open":
+		return ui.PendingStyle()
+	case "failed":
+		return ui.DangerStyle()
+	case "cancelled", "closed":
+		return ui.DimStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
@@ -0,0 +1,70 @@
+package spotrequests
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestSpotRequestResource_AZ(t *testing.T) {
+	launched := NewSpotRequestResource(types.SpotInstanceRequest{
+		LaunchedAvailabilityZone: aws.String("us-east-1a"),
+		LaunchSpecification: &types.LaunchSpecification{
+			Placement: &types.SpotPlacement{AvailabilityZone: aws.String("us-east-1b")},
+		},
+	})
+	if got := launched.AZ(); got != "us-east-1a" {
+		t.Errorf("AZ() = %q, want us-east-1a", got)
+	}
+
+	requested := NewSpotRequestResource(types.SpotInstanceRequest{
+		LaunchSpecification: &types.LaunchSpecification{
+			Placement: &types.SpotPlacement{AvailabilityZone: aws.String("us-east-1b")},
+		},
+	})
+	if got := requested.AZ(); got != "us-east-1b" {
+		t.Errorf("AZ() = %q, want us-east-1b", got)
+	}
+
+	if got := NewSpotRequestResource(types.SpotInstanceRequest{}).AZ(); got != "" {
+		t.Errorf("AZ() = %q, want empty", got)
+	}
+}
+
+func TestSpotRequestResource_InterruptionNotice(t *testing.T) {
+	tests := []struct {
+		code string
+		want string
+	}{
+		{"fulfilled", ""},
+		{"marked-for-termination", "Termination notice issued"},
+		{"instance-terminated-no-capacity", "Terminated: no capacity"},
+	}
+	for _, tt := range tests {
+		r := NewSpotRequestResource(types.SpotInstanceRequest{
+			Status: &types.SpotInstanceStatus{Code: aws.String(tt.code)},
+		})
+		if got := r.InterruptionNotice(); got != tt.want {
+			t.Errorf("InterruptionNotice() for %q = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestSpotRequestResource_Savings(t *testing.T) {
+	r := NewSpotRequestResource(types.SpotInstanceRequest{})
+	if _, ok := r.Savings(); ok {
+		t.Error("Savings() ok = true without prices")
+	}
+
+	spot, onDemand := 0.025, 0.1
+	r.CurrentSpotPrice = &spot
+	r.OnDemandPrice = &onDemand
+	got, ok := r.Savings()
+	if !ok {
+		t.Fatal("Savings() ok = false with prices")
+	}
+	if got != 75 {
+		t.Errorf("Savings() = %v, want 75", got)
+	}
+}
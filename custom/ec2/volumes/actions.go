@@ -3,13 +3,19 @@ package volumes
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	appec2 "github.com/clawscli/claws/custom/ec2"
 	"github.com/clawscli/claws/internal/action"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
 func init() {
@@ -34,6 +40,30 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "DetachVolume",
 			Confirm:   action.ConfirmDangerous,
+			Filter:    volumeInState(types.VolumeStateInUse),
+		},
+		{
+			Name:      "Attach",
+			Shortcut:  "A",
+			Type:      action.ActionTypeAPI,
+			Operation: "AttachVolume",
+			Confirm:   action.ConfirmSimple,
+			Filter:    volumeInState(types.VolumeStateAvailable),
+			Input: &action.InputSpec{
+				Label:   "Instance in the volume's availability zone",
+				Choices: attachableInstances,
+			},
+		},
+		{
+			Name:      "Modify",
+			Shortcut:  "M",
+			Type:      action.ActionTypeAPI,
+			Operation: "ModifyVolume",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Changes (size=GiB type=gp3 iops=N throughput=MiB/s)",
+				Placeholder: "size=200 type=gp3",
+			},
 		},
 	})
 
@@ -48,6 +78,10 @@ func executeVolumeAction(ctx context.Context, act action.Action, resource dao.Re
 		return executeCreateSnapshot(ctx, resource)
 	case "DetachVolume":
 		return executeDetachVolume(ctx, resource)
+	case "AttachVolume":
+		return executeAttachVolume(ctx, resource)
+	case "ModifyVolume":
+		return executeModifyVolume(ctx, resource)
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -112,3 +146,238 @@ func executeDetachVolume(ctx context.Context, resource dao.Resource) action.Acti
 		Message: fmt.Sprintf("Detached volume %s", volumeID),
 	}
 }
+
+func volumeInState(state types.VolumeState) func(dao.Resource) bool {
+	return func(r dao.Resource) bool {
+		v, ok := dao.UnwrapResource(r).(*VolumeResource)
+		return ok && v.State() == string(state)
+	}
+}
+
+// attachableInstances lists running and stopped instances in the volume's AZ.
+func attachableInstances(ctx context.Context, resource dao.Resource) ([]action.Choice, error) {
+	v, ok := dao.UnwrapResource(resource).(*VolumeResource)
+	if !ok {
+		return nil, action.ErrInvalidResourceType
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := ec2.NewDescribeInstancesPaginator(client, &ec2.DescribeInstancesInput{
+		Filters: []types.Filter{
+			{Name: appaws.StringPtr("availability-zone"), Values: []string{v.AZ()}},
+			{Name: appaws.StringPtr("instance-state-name"), Values: []string{"running", "stopped"}},
+		},
+	})
+	var choices []action.Choice
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe instances")
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				id := appaws.Str(instance.InstanceId)
+				label := id
+				if name := appaws.EC2NameTag(instance.Tags); name != "" {
+					label += "  " + name
+				}
+				if instance.State != nil {
+					label += fmt.Sprintf("  (%s)", instance.State.Name)
+				}
+				choices = append(choices, action.Choice{Value: id, Label: label})
+			}
+		}
+	}
+	return choices, nil
+}
+
+// nextDeviceName returns the first /dev/sd[f-p] name not already in use;
+// AWS recommends this range for attached EBS data volumes.
+func nextDeviceName(used []string) (string, error) {
+	for c := 'f'; c <= 'p'; c++ {
+		sd := fmt.Sprintf("/dev/sd%c", c)
+		xvd := fmt.Sprintf("/dev/xvd%c", c)
+		if !slices.Contains(used, sd) && !slices.Contains(used, xvd) {
+			return sd, nil
+		}
+	}
+	return "", fmt.Errorf("no free device name between /dev/sdf and /dev/sdp")
+}
+
+func executeAttachVolume(ctx context.Context, resource dao.Resource) action.ActionResult {
+	v, ok := dao.UnwrapResource(resource).(*VolumeResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	instanceID := action.InputFromContext(ctx)
+	if instanceID == "" {
+		return action.FailResult(fmt.Errorf("no instance selected"))
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	output, err := client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{instanceID}})
+	if err != nil {
+		return action.FailResultf(err, "describe instance %s", instanceID)
+	}
+	var used []string
+	for _, reservation := range output.Reservations {
+		for _, instance := range reservation.Instances {
+			for _, bdm := range instance.BlockDeviceMappings {
+				used = append(used, appaws.Str(bdm.DeviceName))
+			}
+		}
+	}
+	device, err := nextDeviceName(used)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	volumeID := v.GetID()
+	_, err = client.AttachVolume(ctx, &ec2.AttachVolumeInput{
+		VolumeId:   &volumeID,
+		InstanceId: &instanceID,
+		Device:     &device,
+	})
+	if err != nil {
+		return action.FailResultf(err, "attach volume %s to %s", volumeID, instanceID)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Attaching volume %s to %s as %s", volumeID, instanceID, device))
+}
+
+// parseVolumeChanges parses "size=200 type=gp3 iops=4000 throughput=250"
+// into a ModifyVolume request.
+func parseVolumeChanges(volumeID, input string) (*ec2.ModifyVolumeInput, error) {
+	req := &ec2.ModifyVolumeInput{VolumeId: &volumeID}
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no changes given")
+	}
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid change %q: expected key=value", field)
+		}
+		switch strings.ToLower(key) {
+		case "type":
+			vt := types.VolumeType(value)
+			if !slices.Contains(vt.Values(), vt) {
+				return nil, fmt.Errorf("unknown volume type %q", value)
+			}
+			req.VolumeType = vt
+		case "size", "iops", "throughput":
+			n, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid %s %q: must be a positive integer", key, value)
+			}
+			num := int32(n)
+			switch strings.ToLower(key) {
+			case "size":
+				req.Size = &num
+			case "iops":
+				req.Iops = &num
+			default:
+				req.Throughput = &num
+			}
+		default:
+			return nil, fmt.Errorf("unknown setting %q (use size, type, iops, throughput)", key)
+		}
+	}
+	return req, nil
+}
+
+func executeModifyVolume(ctx context.Context, resource dao.Resource) action.ActionResult {
+	v, ok := dao.UnwrapResource(resource).(*VolumeResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	volumeID := v.GetID()
+	req, err := parseVolumeChanges(volumeID, action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+	if req.Size != nil && *req.Size < v.Size() {
+		return action.FailResult(fmt.Errorf("volume size can only grow: %d GiB is smaller than %d GiB", *req.Size, v.Size()))
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	return action.Track(ctx, fmt.Sprintf("Modifying volume %s", volumeID),
+		func(ctx context.Context, report func(string)) (string, error) {
+			return modifyVolume(ctx, client, req, report)
+		})
+}
+
+// volumeModifyAPI is the subset of the EC2 client the modify flow uses.
+type volumeModifyAPI interface {
+	ModifyVolume(ctx context.Context, params *ec2.ModifyVolumeInput, optFns ...func(*ec2.Options)) (*ec2.ModifyVolumeOutput, error)
+	DescribeVolumesModifications(ctx context.Context, params *ec2.DescribeVolumesModificationsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeVolumesModificationsOutput, error)
+}
+
+var (
+	// volumeModifyPollInterval is how often modification progress is checked.
+	volumeModifyPollInterval = 5 * time.Second
+	// volumeModifyWait bounds the wait for a modification to leave "modifying".
+	volumeModifyWait = 30 * time.Minute
+)
+
+// modifyVolume requests a volume modification and polls until the new
+// settings are in effect. The volume keeps optimizing in the background
+// after that, which can take hours but needs no further action.
+func modifyVolume(ctx context.Context, client volumeModifyAPI, req *ec2.ModifyVolumeInput, report func(string)) (string, error) {
+	volumeID := appaws.Str(req.VolumeId)
+	report("Requesting modification")
+	if _, err := client.ModifyVolume(ctx, req); err != nil {
+		return "", apperrors.Wrapf(err, "modify volume %s", volumeID)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, volumeModifyWait)
+	defer cancel()
+
+	lastProgress := int64(-1)
+	for {
+		output, err := client.DescribeVolumesModifications(ctx, &ec2.DescribeVolumesModificationsInput{
+			VolumeIds: []string{volumeID},
+		})
+		if err != nil {
+			return "", apperrors.Wrapf(err, "describe modification of volume %s", volumeID)
+		}
+		if len(output.VolumesModifications) == 0 {
+			return "", fmt.Errorf("no modification found for volume %s", volumeID)
+		}
+		mod := output.VolumesModifications[0]
+		progress := appaws.Int64(mod.Progress)
+
+		switch mod.ModificationState {
+		case types.VolumeModificationStateOptimizing:
+			return fmt.Sprintf("Modified volume %s (optimizing in background, %d%%)", volumeID, progress), nil
+		case types.VolumeModificationStateCompleted:
+			return fmt.Sprintf("Modified volume %s", volumeID), nil
+		case types.VolumeModificationStateFailed:
+			return "", fmt.Errorf("modify volume %s failed: %s", volumeID, appaws.Str(mod.StatusMessage))
+		}
+
+		if progress != lastProgress {
+			report(fmt.Sprintf("Modifying (%d%%)", progress))
+			lastProgress = progress
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", apperrors.Wrapf(ctx.Err(), "wait for volume %s modification", volumeID)
+		case <-time.After(volumeModifyPollInterval):
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package volumes
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestParseVolumeChanges(t *testing.T) {
+	req, err := parseVolumeChanges("vol-1", "size=200 type=gp3 iops=4000 throughput=250")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if aws.ToInt32(req.Size) != 200 || req.VolumeType != types.VolumeTypeGp3 ||
+		aws.ToInt32(req.Iops) != 4000 || aws.ToInt32(req.Throughput) != 250 {
+		t.Errorf("parsed request = %+v", req)
+	}
+
+	for _, bad := range []string{"", "size", "size=-1", "type=gp9", "speed=fast", "iops=abc"} {
+		if _, err := parseVolumeChanges("vol-1", bad); err == nil {
+			t.Errorf("parseVolumeChanges(%q) expected error", bad)
+		}
+	}
+}
+
+func TestNextDeviceName(t *testing.T) {
+	got, err := nextDeviceName([]string{"/dev/xvda", "/dev/sdf", "/dev/xvdg"})
+	if err != nil || got != "/dev/sdh" {
+		t.Errorf("nextDeviceName() = %q, %v; want /dev/sdh", got, err)
+	}
+
+	var all []string
+	for c := 'f'; c <= 'p'; c++ {
+		all = append(all, "/dev/sd"+string(c))
+	}
+	if _, err := nextDeviceName(all); err == nil {
+		t.Error("expected error when all device names are used")
+	}
+}
+
+// fakeVolumeModifier returns one modification state per describe call.
+type fakeVolumeModifier struct {
+	states []types.VolumeModificationState
+	calls  int
+}
+
+func (f *fakeVolumeModifier) ModifyVolume(_ context.Context, _ *ec2.ModifyVolumeInput, _ ...func(*ec2.Options)) (*ec2.ModifyVolumeOutput, error) {
+	return &ec2.ModifyVolumeOutput{}, nil
+}
+
+func (f *fakeVolumeModifier) DescribeVolumesModifications(_ context.Context, _ *ec2.DescribeVolumesModificationsInput, _ ...func(*ec2.Options)) (*ec2.DescribeVolumesModificationsOutput, error) {
+	state := f.states[min(f.calls, len(f.states)-1)]
+	f.calls++
+	return &ec2.DescribeVolumesModificationsOutput{VolumesModifications: []types.VolumeModification{{
+		ModificationState: state,
+		Progress:          aws.Int64(int64(f.calls * 10)),
+		StatusMessage:     aws.String("bad iops"),
+	}}}, nil
+}
+
+func TestModifyVolume(t *testing.T) {
+	orig := volumeModifyPollInterval
+	volumeModifyPollInterval = time.Millisecond
+	defer func() { volumeModifyPollInterval = orig }()
+
+	req := &ec2.ModifyVolumeInput{VolumeId: aws.String("vol-1")}
+
+	client := &fakeVolumeModifier{states: []types.VolumeModificationState{
+		types.VolumeModificationStateModifying,
+		types.VolumeModificationStateModifying,
+		types.VolumeModificationStateOptimizing,
+	}}
+	var steps []string
+	msg, err := modifyVolume(context.Background(), client, req, func(s string) { steps = append(steps, s) })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(msg, "optimizing") {
+		t.Errorf("message = %q", msg)
+	}
+	if len(steps) != 3 {
+		t.Errorf("steps = %v, want request + two progress updates", steps)
+	}
+
+	failing := &fakeVolumeModifier{states: []types.VolumeModificationState{types.VolumeModificationStateFailed}}
+	if _, err := modifyVolume(context.Background(), failing, req, func(string) {}); err == nil || !strings.Contains(err.Error(), "bad iops") {
+		t.Errorf("err = %v, want failure with status message", err)
+	}
+}
+
+func TestSortOrphans(t *testing.T) {
+	now := time.Now()
+	vol := func(id string, size int32, age time.Duration) dao.Resource {
+		return NewVolumeResource(types.Volume{
+			VolumeId:   aws.String(id),
+			Size:       aws.Int32(size),
+			CreateTime: aws.Time(now.Add(-age)),
+		})
+	}
+	resources := []dao.Resource{
+		vol("small", 10, time.Hour),
+		vol("big-new", 500, time.Hour),
+		vol("big-old", 500, 100*time.Hour),
+	}
+	sortOrphans(resources)
+
+	var got []string
+	for _, r := range resources {
+		got = append(got, r.GetID())
+	}
+	if strings.Join(got, ",") != "big-old,big-new,small" {
+		t.Errorf("order = %v", got)
+	}
+}
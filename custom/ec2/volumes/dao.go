@@ -1,12 +1,16 @@
 package volumes
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
+	appec2 "github.com/clawscli/claws/custom/ec2"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
@@ -20,18 +24,30 @@ type VolumeDAO struct {
 
 // NewVolumeDAO creates a new VolumeDAO
 func NewVolumeDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &VolumeDAO{
 		BaseDAO: dao.NewBaseDAO("ec2", "volumes"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
+// List returns EBS volumes. With the OrphanedOnly toggle on, only unattached
+// volumes are returned, largest and then oldest first. Tag and status list
+// filters are applied server-side.
 func (d *VolumeDAO) List(ctx context.Context) ([]dao.Resource, error) {
-	input := &ec2.DescribeVolumesInput{}
+	input := &ec2.DescribeVolumesInput{
+		Filters: appec2.ListFilters(ctx, "status"),
+	}
+	orphanedOnly := dao.GetFilterFromContext(ctx, "OrphanedOnly") == "true"
+	if orphanedOnly {
+		input.Filters = append(input.Filters, types.Filter{
+			Name:   appaws.StringPtr("status"),
+			Values: []string{string(types.VolumeStateAvailable)},
+		})
+	}
 	paginator := ec2.NewDescribeVolumesPaginator(d.client, input)
 
 	var resources []dao.Resource
@@ -46,9 +62,28 @@ func (d *VolumeDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		}
 	}
 
+	if orphanedOnly {
+		sortOrphans(resources)
+	}
 	return resources, nil
 }
 
+// sortOrphans orders volumes by size descending, then creation time
+// ascending, so the costliest and longest-forgotten volumes come first.
+func sortOrphans(resources []dao.Resource) {
+	slices.SortStableFunc(resources, func(a, b dao.Resource) int {
+		va, _ := a.(*VolumeResource)
+		vb, _ := b.(*VolumeResource)
+		if va == nil || vb == nil {
+			return 0
+		}
+		if c := cmp.Compare(vb.Size(), va.Size()); c != 0 {
+			return c
+		}
+		return va.CreateTime().Compare(vb.CreateTime())
+	})
+}
+
 func (d *VolumeDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	input := &ec2.DescribeVolumesInput{
 		VolumeIds: []string{id},
@@ -146,3 +181,19 @@ func (r *VolumeResource) IOPS() int32 {
 	}
 	return 0
 }
+
+// Throughput returns the provisioned throughput in MiB/s, or 0
+func (r *VolumeResource) Throughput() int32 {
+	if r.Item.Throughput != nil {
+		return *r.Item.Throughput
+	}
+	return 0
+}
+
+// CreateTime returns when the volume was created
+func (r *VolumeResource) CreateTime() time.Time {
+	if r.Item.CreateTime != nil {
+		return *r.Item.CreateTime
+	}
+	return time.Time{}
+}
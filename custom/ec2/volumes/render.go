@@ -8,6 +8,8 @@ import (
 	"github.com/clawscli/claws/internal/render"
 )
 
+var _ render.Toggler = (*VolumeRenderer)(nil)
+
 // VolumeRenderer renders EBS volumes
 type VolumeRenderer struct {
 	render.BaseRenderer
@@ -119,7 +121,20 @@ func NewVolumeRenderer() render.Renderer {
 					},
 					Priority: 8,
 				},
-				render.TagsColumn(25, 9),
+				{
+					Name:  "AGE",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*VolumeResource); ok {
+							if t := v.CreateTime(); !t.IsZero() {
+								return render.FormatAge(t)
+							}
+						}
+						return "-"
+					},
+					Priority: 9,
+				},
+				render.TagsColumn(25, 10),
 			},
 		},
 	}
@@ -257,3 +272,10 @@ func (r *VolumeRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 
 	return fields
 }
+
+// ListToggles returns the orphaned-volumes toggle
+func (r *VolumeRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "o", ContextKey: "OrphanedOnly", LabelOn: "orphaned", LabelOff: "all"},
+	}
+}
@@ -9,9 +9,5 @@ import (
 )
 
 func GetClient(ctx context.Context) (*ecr.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return ecr.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, ecr.NewFromConfig)
 }
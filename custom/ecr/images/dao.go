@@ -21,13 +21,13 @@ type ImageDAO struct {
 
 // NewImageDAO creates a new ImageDAO
 func NewImageDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ecr.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ImageDAO{
 		BaseDAO: dao.NewBaseDAO("ecr", "images"),
-		client:  ecr.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type RepositoryDAO struct {
 
 // NewRepositoryDAO creates a new RepositoryDAO
 func NewRepositoryDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ecr.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RepositoryDAO{
 		BaseDAO: dao.NewBaseDAO("ecr", "repositories"),
-		client:  ecr.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -10,9 +10,5 @@ import (
 
 // GetClient returns an ECS client configured for the current context
 func GetClient(ctx context.Context) (*ecs.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return ecs.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, ecs.NewFromConfig)
 }
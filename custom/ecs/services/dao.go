@@ -21,13 +21,13 @@ type ServiceDAO struct {
 
 // NewServiceDAO creates a new ServiceDAO
 func NewServiceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ecs.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ServiceDAO{
 		BaseDAO: dao.NewBaseDAO("ecs", "services"),
-		client:  ecs.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,195 @@
+package taskdefinitions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	ecsClient "github.com/clawscli/claws/custom/ecs"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for ECS task definitions
+	action.Global.Register("ecs", "task-definitions", []action.Action{
+		{
+			Name:      "Clone & Edit",
+			Shortcut:  "E",
+			Type:      action.ActionTypeAPI,
+			Operation: "RegisterRevision",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Changes for the new revision (image[.container]= cpu= memory= env[.container]=KEY=VALUE)",
+				Placeholder: "image=v1.2.3 memory=1024 env=LOG_LEVEL=debug",
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("ecs", "task-definitions", executeTaskDefinitionAction)
+}
+
+// executeTaskDefinitionAction executes an action on an ECS task definition
+func executeTaskDefinitionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RegisterRevision":
+		return executeRegisterRevision(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeRegisterRevision(ctx context.Context, resource dao.Resource) action.ActionResult {
+	td, ok := dao.UnwrapResource(resource).(*TaskDefinitionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	input, err := buildRevision(td.Item, action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := ecsClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	output, err := client.RegisterTaskDefinition(ctx, input)
+	if err != nil {
+		return action.FailResult(fmt.Errorf("register task definition: %w", err))
+	}
+
+	rev := int32(0)
+	if output.TaskDefinition != nil {
+		rev = output.TaskDefinition.Revision
+	}
+	return action.SuccessResult(fmt.Sprintf("Registered %s:%d from revision %d", td.Family(), rev, td.Revision()))
+}
+
+// buildRevision copies td into a RegisterTaskDefinition request and applies
+// the space-separated key=value changes. image and env target the first
+// container unless suffixed with .<container>; an image value without ':',
+// '/' or '@' replaces only the tag.
+func buildRevision(td types.TaskDefinition, changes string) (*ecs.RegisterTaskDefinitionInput, error) {
+	fields := strings.Fields(changes)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no changes given")
+	}
+	if len(td.ContainerDefinitions) == 0 {
+		return nil, fmt.Errorf("task definition has no containers")
+	}
+
+	input := &ecs.RegisterTaskDefinitionInput{
+		Family:                  td.Family,
+		ContainerDefinitions:    append([]types.ContainerDefinition(nil), td.ContainerDefinitions...),
+		Cpu:                     td.Cpu,
+		Memory:                  td.Memory,
+		EnableFaultInjection:    td.EnableFaultInjection,
+		EphemeralStorage:        td.EphemeralStorage,
+		ExecutionRoleArn:        td.ExecutionRoleArn,
+		InferenceAccelerators:   td.InferenceAccelerators,
+		IpcMode:                 td.IpcMode,
+		NetworkMode:             td.NetworkMode,
+		PidMode:                 td.PidMode,
+		PlacementConstraints:    td.PlacementConstraints,
+		ProxyConfiguration:      td.ProxyConfiguration,
+		RequiresCompatibilities: td.RequiresCompatibilities,
+		RuntimePlatform:         td.RuntimePlatform,
+		TaskRoleArn:             td.TaskRoleArn,
+		Volumes:                 td.Volumes,
+	}
+
+	copiedEnv := make(map[int]bool)
+	for _, field := range fields {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid change %q: expected key=value", field)
+		}
+		key, container, _ := strings.Cut(key, ".")
+
+		switch strings.ToLower(key) {
+		case "cpu", "memory":
+			if container != "" {
+				return nil, fmt.Errorf("%s is a task-level setting and takes no container", key)
+			}
+			if n, err := strconv.Atoi(value); err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid %s %q: must be a positive integer", key, value)
+			}
+			if strings.ToLower(key) == "cpu" {
+				input.Cpu = &value
+			} else {
+				input.Memory = &value
+			}
+		case "image":
+			idx, err := containerIndex(input.ContainerDefinitions, container)
+			if err != nil {
+				return nil, err
+			}
+			c := &input.ContainerDefinitions[idx]
+			image := value
+			if !strings.ContainsAny(value, ":/@") {
+				image = withImageTag(appaws.Str(c.Image), value)
+			}
+			c.Image = &image
+		case "env":
+			name, val, ok := strings.Cut(value, "=")
+			if !ok || name == "" {
+				return nil, fmt.Errorf("invalid env %q: expected KEY=VALUE", value)
+			}
+			idx, err := containerIndex(input.ContainerDefinitions, container)
+			if err != nil {
+				return nil, err
+			}
+			c := &input.ContainerDefinitions[idx]
+			if !copiedEnv[idx] {
+				c.Environment = append([]types.KeyValuePair(nil), c.Environment...)
+				copiedEnv[idx] = true
+			}
+			c.Environment = setEnv(c.Environment, name, val)
+		default:
+			return nil, fmt.Errorf("unknown setting %q (use image, cpu, memory, env)", key)
+		}
+	}
+	return input, nil
+}
+
+// containerIndex finds a container by name, or the first one when name is empty.
+func containerIndex(containers []types.ContainerDefinition, name string) (int, error) {
+	if name == "" {
+		return 0, nil
+	}
+	for i, c := range containers {
+		if appaws.Str(c.Name) == name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no container named %q", name)
+}
+
+// withImageTag replaces the tag or digest of an image reference.
+func withImageTag(image, tag string) string {
+	if at := strings.Index(image, "@"); at != -1 {
+		image = image[:at]
+	}
+	if colon := strings.LastIndex(image, ":"); colon > strings.LastIndex(image, "/") {
+		image = image[:colon]
+	}
+	return image + ":" + tag
+}
+
+func setEnv(env []types.KeyValuePair, name, value string) []types.KeyValuePair {
+	for i := range env {
+		if appaws.Str(env[i].Name) == name {
+			env[i].Value = &value
+			return env
+		}
+	}
+	return append(env, types.KeyValuePair{Name: &name, Value: &value})
+}
@@ -0,0 +1,95 @@
+package taskdefinitions
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func testTaskDefinition() types.TaskDefinition {
+	return types.TaskDefinition{
+		Family:   aws.String("web"),
+		Revision: 7,
+		Cpu:      aws.String("256"),
+		Memory:   aws.String("512"),
+		ContainerDefinitions: []types.ContainerDefinition{
+			{
+				Name:  aws.String("app"),
+				Image: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/app:v1"),
+				Environment: []types.KeyValuePair{
+					{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+				},
+			},
+			{
+				Name:  aws.String("sidecar"),
+				Image: aws.String("localhost:5000/proxy@sha256:abc"),
+			},
+		},
+	}
+}
+
+func TestBuildRevision(t *testing.T) {
+	td := testTaskDefinition()
+	input, err := buildRevision(td, "image=v2 cpu=512 memory=1024 env=LOG_LEVEL=debug env=FEATURE=a=b image.sidecar=v3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if aws.ToString(input.Family) != "web" || aws.ToString(input.Cpu) != "512" || aws.ToString(input.Memory) != "1024" {
+		t.Errorf("task settings = %s %s %s", aws.ToString(input.Family), aws.ToString(input.Cpu), aws.ToString(input.Memory))
+	}
+
+	app := input.ContainerDefinitions[0]
+	if got := aws.ToString(app.Image); got != "123456789012.dkr.ecr.us-east-1.amazonaws.com/app:v2" {
+		t.Errorf("app image = %q", got)
+	}
+	env := map[string]string{}
+	for _, kv := range app.Environment {
+		env[aws.ToString(kv.Name)] = aws.ToString(kv.Value)
+	}
+	if len(env) != 2 || env["LOG_LEVEL"] != "debug" || env["FEATURE"] != "a=b" {
+		t.Errorf("app env = %v", env)
+	}
+	if got := aws.ToString(input.ContainerDefinitions[1].Image); got != "localhost:5000/proxy:v3" {
+		t.Errorf("sidecar image = %q", got)
+	}
+
+	// The source revision must be left untouched.
+	if aws.ToString(td.ContainerDefinitions[0].Image) != "123456789012.dkr.ecr.us-east-1.amazonaws.com/app:v1" ||
+		aws.ToString(td.ContainerDefinitions[0].Environment[0].Value) != "info" {
+		t.Error("buildRevision modified the source task definition")
+	}
+}
+
+func TestBuildRevisionFullImage(t *testing.T) {
+	input, err := buildRevision(testTaskDefinition(), "image.app=nginx:1.27")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := aws.ToString(input.ContainerDefinitions[0].Image); got != "nginx:1.27" {
+		t.Errorf("image = %q, want nginx:1.27", got)
+	}
+}
+
+func TestBuildRevisionErrors(t *testing.T) {
+	for _, bad := range []string{"", "image", "cpu=-1", "memory=lots", "cpu.app=256", "image.db=v2", "env=NOVALUE", "env==x", "tag=v2"} {
+		if _, err := buildRevision(testTaskDefinition(), bad); err == nil {
+			t.Errorf("buildRevision(%q) expected error", bad)
+		}
+	}
+}
+
+func TestWithImageTag(t *testing.T) {
+	tests := []struct{ image, want string }{
+		{"nginx", "nginx:v9"},
+		{"nginx:1.27", "nginx:v9"},
+		{"localhost:5000/app", "localhost:5000/app:v9"},
+		{"repo/app@sha256:abc", "repo/app:v9"},
+	}
+	for _, tt := range tests {
+		if got := withImageTag(tt.image, "v9"); got != tt.want {
+			t.Errorf("withImageTag(%q) = %q, want %q", tt.image, got, tt.want)
+		}
+	}
+}
@@ -20,17 +20,25 @@ type TaskDefinitionDAO struct {
 }
 
 func NewTaskDefinitionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ecs.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new ecs/task-definitions dao")
 	}
 	return &TaskDefinitionDAO{
 		BaseDAO: dao.NewBaseDAO("ecs", "task-definitions"),
-		client:  ecs.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
+// maxRevisions caps how many revisions of one family are described when
+// listing with the Family filter.
+const maxRevisions = 50
+
 func (d *TaskDefinitionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if family := dao.GetFilterFromContext(ctx, "Family"); family != "" {
+		return d.listRevisions(ctx, family)
+	}
+
 	taskDefArns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
 		output, err := d.client.ListTaskDefinitions(ctx, &ecs.ListTaskDefinitionsInput{
 			Status:    types.TaskDefinitionStatusActive,
@@ -56,8 +64,44 @@ func (d *TaskDefinitionDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		}
 	}
 
-	resources := make([]dao.Resource, 0, len(latestArns))
-	for _, arn := range latestArns {
+	return d.describeAll(ctx, latestArns), nil
+}
+
+// listRevisions returns the active revisions of a family, newest first.
+func (d *TaskDefinitionDAO) listRevisions(ctx context.Context, family string) ([]dao.Resource, error) {
+	taskDefArns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := d.client.ListTaskDefinitions(ctx, &ecs.ListTaskDefinitionsInput{
+			FamilyPrefix: &family,
+			Status:       types.TaskDefinitionStatusActive,
+			Sort:         types.SortOrderDesc,
+			NextToken:    token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list revisions of %s", family)
+		}
+		return output.TaskDefinitionArns, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// FamilyPrefix also matches longer family names, so keep exact matches only.
+	var arns []string
+	for _, arn := range taskDefArns {
+		if extractFamilyFromArn(arn) == family {
+			arns = append(arns, arn)
+		}
+		if len(arns) == maxRevisions {
+			break
+		}
+	}
+
+	return d.describeAll(ctx, arns), nil
+}
+
+func (d *TaskDefinitionDAO) describeAll(ctx context.Context, arns []string) []dao.Resource {
+	resources := make([]dao.Resource, 0, len(arns))
+	for _, arn := range arns {
 		output, err := d.client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
 			TaskDefinition: &arn,
 		})
@@ -69,8 +113,7 @@ func (d *TaskDefinitionDAO) List(ctx context.Context) ([]dao.Resource, error) {
 			resources = append(resources, NewTaskDefinitionResource(*output.TaskDefinition))
 		}
 	}
-
-	return resources, nil
+	return resources
 }
 
 func (d *TaskDefinitionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
@@ -261,7 +261,16 @@ func (r *TaskDefinitionRenderer) Navigations(resource dao.Resource) []render.Nav
 		return nil
 	}
 
-	var navs []render.Navigation
+	navs := []render.Navigation{
+		{
+			Key:         "v",
+			Label:       "Revisions",
+			Service:     "ecs",
+			Resource:    "task-definitions",
+			FilterField: "Family",
+			FilterValue: td.Family(),
+		},
+	}
 
 	if groups := td.GetAllCloudWatchLogGroups(); len(groups) > 0 {
 		navs = append(navs, render.Navigation{
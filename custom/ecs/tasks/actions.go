@@ -3,11 +3,13 @@ package tasks
 import (
 	"context"
 	"fmt"
+	"os/exec"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 
 	ecsClient "github.com/clawscli/claws/custom/ecs"
 	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 )
 
@@ -15,11 +17,19 @@ func init() {
 	// Register actions for ECS tasks
 	action.Global.Register("ecs", "tasks", []action.Action{
 		{
-			Name:     "Exec",
+			Name:     "Exec shell",
 			Shortcut: "x",
 			Type:     action.ActionTypeExec,
-			Command:  `aws ecs execute-command --cluster "${CLUSTER}" --task "${ARN}" --container "${CONTAINER}" --interactive --command "/bin/sh"`,
+			Command:  `aws ecs execute-command --cluster "${CLUSTER}" --task "${ARN}" --container "${INPUT}" --interactive --command "/bin/sh"`,
 			Confirm:  action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				task, ok := dao.UnwrapResource(r).(*TaskResource)
+				return ok && task.EnableExecuteCommand() && task.LastStatus() == "RUNNING"
+			},
+			Input: &action.InputSpec{
+				Label:   "Container",
+				Choices: execContainerChoices,
+			},
 		},
 		{
 			Name:      "Stop",
@@ -34,6 +44,43 @@ func init() {
 	action.RegisterExecutor("ecs", "tasks", executeTaskAction)
 }
 
+// execTools are the local commands `aws ecs execute-command` needs.
+var execTools = []struct{ name, hint string }{
+	{"aws", "install the AWS CLI v2"},
+	{"session-manager-plugin", "install the Session Manager plugin for the AWS CLI"},
+}
+
+// lookPath is exec.LookPath, replaced in tests.
+var lookPath = exec.LookPath
+
+// execContainerChoices offers the containers whose execute-command agent is
+// running, after checking the local tools the session needs.
+func execContainerChoices(_ context.Context, resource dao.Resource) ([]action.Choice, error) {
+	task, ok := dao.UnwrapResource(resource).(*TaskResource)
+	if !ok {
+		return nil, fmt.Errorf("not an ECS task")
+	}
+	for _, tool := range execTools {
+		if _, err := lookPath(tool.name); err != nil {
+			return nil, fmt.Errorf("%s not found on PATH: %s", tool.name, tool.hint)
+		}
+	}
+
+	var choices []action.Choice
+	for _, c := range task.ExecContainers() {
+		label := appaws.Str(c.Name)
+		if image := appaws.Str(c.Image); image != "" {
+			label += " (" + image + ")"
+		}
+		choices = append(choices, action.Choice{Value: appaws.Str(c.Name), Label: label})
+	}
+	if len(choices) == 0 {
+		return nil, fmt.Errorf("no container has a running execute-command agent; " +
+			"check the task role allows ssmmessages and the task was started with execute command enabled")
+	}
+	return choices, nil
+}
+
 // executeTaskAction executes an action on an ECS task
 func executeTaskAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
 	switch act.Operation {
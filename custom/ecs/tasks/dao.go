@@ -6,13 +6,18 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"golang.org/x/sync/errgroup"
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
 	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
 )
 
+// taskDefConcurrency bounds parallel DescribeTaskDefinition calls.
+const taskDefConcurrency = 4
+
 // TaskDAO provides data access for ECS tasks
 type TaskDAO struct {
 	dao.BaseDAO
@@ -21,24 +26,31 @@ type TaskDAO struct {
 
 // NewTaskDAO creates a new TaskDAO
 func NewTaskDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ecs.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TaskDAO{
 		BaseDAO: dao.NewBaseDAO("ecs", "tasks"),
-		client:  ecs.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
 func (d *TaskDAO) List(ctx context.Context) ([]dao.Resource, error) {
-	clusterName := dao.GetFilterFromContext(ctx, "ClusterName")
-	if clusterName == "" {
+	var resources []dao.Resource
+	var err error
+	if clusterName := dao.GetFilterFromContext(ctx, "ClusterName"); clusterName == "" {
 		// List tasks from all clusters
-		return d.listAllTasks(ctx)
+		resources, err = d.listAllTasks(ctx)
+	} else {
+		resources, err = d.listTasksInCluster(ctx, clusterName)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return d.listTasksInCluster(ctx, clusterName)
+	d.loadLogConfigs(ctx, resources)
+	return resources, nil
 }
 
 func (d *TaskDAO) listAllTasks(ctx context.Context) ([]dao.Resource, error) {
@@ -139,7 +151,45 @@ func (d *TaskDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 		return nil, fmt.Errorf("task not found: %s", id)
 	}
 
-	return NewTaskResource(output.Tasks[0]), nil
+	task := NewTaskResource(output.Tasks[0])
+	d.loadLogConfigs(ctx, []dao.Resource{task})
+	return task, nil
+}
+
+// loadLogConfigs fills each task's container log configurations from its
+// task definition, which DescribeTasks does not return. Best-effort: tasks
+// whose definition cannot be read are left without log streams.
+func (d *TaskDAO) loadLogConfigs(ctx context.Context, resources []dao.Resource) {
+	byTaskDef := make(map[string][]*TaskResource)
+	for _, res := range resources {
+		if task, ok := res.(*TaskResource); ok && task.TaskDefinitionArn() != "" {
+			byTaskDef[task.TaskDefinitionArn()] = append(byTaskDef[task.TaskDefinitionArn()], task)
+		}
+	}
+
+	// Each task definition writes only its own tasks, so no locking is needed.
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(taskDefConcurrency)
+	for arn, tasks := range byTaskDef {
+		g.Go(func() error {
+			output, err := d.client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{TaskDefinition: &arn})
+			if err != nil || output.TaskDefinition == nil {
+				log.Debug("failed to describe task definition for logs", "taskDefinition", arn, "error", err)
+				return nil
+			}
+			configs := make(map[string]types.LogConfiguration)
+			for _, c := range output.TaskDefinition.ContainerDefinitions {
+				if c.Name != nil && c.LogConfiguration != nil {
+					configs[*c.Name] = *c.LogConfiguration
+				}
+			}
+			for _, task := range tasks {
+				task.LogConfigs = configs
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
 }
 
 func (d *TaskDAO) Delete(ctx context.Context, id string) error {
@@ -166,6 +216,9 @@ func (d *TaskDAO) Delete(ctx context.Context, id string) error {
 type TaskResource struct {
 	dao.BaseResource
 	Item types.Task
+
+	// LogConfigs maps container name to its task definition log configuration
+	LogConfigs map[string]types.LogConfiguration
 }
 
 // NewTaskResource creates a new TaskResource
@@ -282,3 +335,48 @@ func (r *TaskResource) FirstContainerName() string {
 func (r *TaskResource) EnableExecuteCommand() bool {
 	return r.Item.EnableExecuteCommand
 }
+
+// execAgentName is the managed agent that serves ECS Exec sessions.
+const execAgentName = types.ManagedAgentNameExecuteCommandAgent
+
+// ExecContainers returns the containers ECS Exec can open a session in:
+// those whose execute-command agent is running.
+func (r *TaskResource) ExecContainers() []types.Container {
+	var containers []types.Container
+	for _, c := range r.Item.Containers {
+		for _, agent := range c.ManagedAgents {
+			if agent.Name == execAgentName && appaws.Str(agent.LastStatus) == "RUNNING" {
+				containers = append(containers, c)
+				break
+			}
+		}
+	}
+	return containers
+}
+
+// ContainerLogs returns the CloudWatch log stream of each container that logs
+// with the awslogs driver. Streams are named prefix/container/task-id, or the
+// container's runtime ID when no awslogs-stream-prefix is set (EC2 only).
+func (r *TaskResource) ContainerLogs() []render.ContainerLog {
+	var logs []render.ContainerLog
+	for _, c := range r.Item.Containers {
+		name := appaws.Str(c.Name)
+		cfg, ok := r.LogConfigs[name]
+		if !ok || cfg.LogDriver != types.LogDriverAwslogs {
+			continue
+		}
+		group := cfg.Options["awslogs-group"]
+		if group == "" {
+			continue
+		}
+		stream := appaws.Str(c.RuntimeId)
+		if prefix := cfg.Options["awslogs-stream-prefix"]; prefix != "" {
+			stream = prefix + "/" + name + "/" + r.GetID()
+		}
+		if stream == "" {
+			continue
+		}
+		logs = append(logs, render.ContainerLog{Container: name, LogGroup: group, LogStream: stream})
+	}
+	return logs
+}
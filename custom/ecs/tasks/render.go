@@ -207,6 +207,14 @@ func (r *TaskRenderer) RenderDetail(resource dao.Resource) string {
 		}
 	}
 
+	// Container log streams (press L to tail them together)
+	if logs := task.ContainerLogs(); len(logs) > 0 {
+		d.Section("Container Logs")
+		for _, l := range logs {
+			d.Field(l.Container, l.LogGroup+" / "+l.LogStream)
+		}
+	}
+
 	// Health
 	if health := task.HealthStatus(); health != "" && health != "UNKNOWN" {
 		d.Section("Health")
@@ -355,15 +363,21 @@ func (r *TaskRenderer) Navigations(resource dao.Resource) []render.Navigation {
 		})
 	}
 
+	if task.ContainerLogs() != nil {
+		navs = append(navs, render.Navigation{
+			Key: "L", Label: "Container Logs", ViewType: render.ViewTypeContainerLogView,
+		})
+	}
+
 	// Add ECR navigation if container uses ECR image
 	if len(task.Item.Containers) > 0 {
 		for _, container := range task.Item.Containers {
 			if container.Image != nil && strings.Contains(*container.Image, ".dkr.ecr.") {
 				// Extract repository name from ECR URL
-				// Format: <account>.dkr.ecr.<region>.amazonaws.com/<repository>:<tag>
+				// Format: <account>.dkr.ecr.<region>.amazonaws.com[.cn]/<repository>:<tag>
 				image := *container.Image
-				if idx := strings.Index(image, ".amazonaws.com/"); idx > 0 {
-					repoWithTag := image[idx+len(".amazonaws.com/"):]
+				if idx := strings.Index(image, "/"); idx > 0 && strings.Contains(image[:idx], ".amazonaws.com") {
+					repoWithTag := image[idx+1:]
 					// Remove tag if present
 					if tagIdx := strings.Index(repoWithTag, ":"); tagIdx > 0 {
 						repoWithTag = repoWithTag[:tagIdx]
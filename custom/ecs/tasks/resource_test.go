@@ -0,0 +1,130 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/clawscli/claws/internal/render"
+)
+
+func TestTaskResource_ContainerLogs(t *testing.T) {
+	task := NewTaskResource(types.Task{
+		TaskArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task/prod/abc123"),
+		Containers: []types.Container{
+			{Name: aws.String("web")},
+			{Name: aws.String("sidecar"), RuntimeId: aws.String("docker-id")},
+			{Name: aws.String("firelens")},
+			{Name: aws.String("unconfigured")},
+		},
+	})
+	task.LogConfigs = map[string]types.LogConfiguration{
+		"web": {LogDriver: types.LogDriverAwslogs, Options: map[string]string{
+			"awslogs-group": "/ecs/app", "awslogs-stream-prefix": "ecs",
+		}},
+		"sidecar": {LogDriver: types.LogDriverAwslogs, Options: map[string]string{
+			"awslogs-group": "/ecs/sidecar",
+		}},
+		"firelens": {LogDriver: types.LogDriverAwsfirelens},
+	}
+
+	got := task.ContainerLogs()
+	want := []render.ContainerLog{
+		{Container: "web", LogGroup: "/ecs/app", LogStream: "ecs/web/abc123"},
+		{Container: "sidecar", LogGroup: "/ecs/sidecar", LogStream: "docker-id"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ContainerLogs() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ContainerLogs()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestTaskRenderer_ContainerLogsNavigation(t *testing.T) {
+	task := NewTaskResource(types.Task{
+		TaskArn:    aws.String("arn:aws:ecs:us-east-1:123456789012:task/prod/abc123"),
+		ClusterArn: aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/prod"),
+		Containers: []types.Container{{Name: aws.String("web")}},
+	})
+
+	hasContainerLogs := func() bool {
+		for _, nav := range NewTaskRenderer().(render.Navigator).Navigations(task) {
+			if nav.ViewType == render.ViewTypeContainerLogView {
+				return true
+			}
+		}
+		return false
+	}
+	if hasContainerLogs() {
+		t.Error("Navigations() offered container logs without log configuration")
+	}
+
+	task.LogConfigs = map[string]types.LogConfiguration{
+		"web": {LogDriver: types.LogDriverAwslogs, Options: map[string]string{
+			"awslogs-group": "/ecs/app", "awslogs-stream-prefix": "ecs",
+		}},
+	}
+	if !hasContainerLogs() {
+		t.Error("Navigations() missing container logs")
+	}
+}
+
+func execTask() *TaskResource {
+	agent := func(status string) []types.ManagedAgent {
+		return []types.ManagedAgent{{Name: types.ManagedAgentNameExecuteCommandAgent, LastStatus: aws.String(status)}}
+	}
+	return NewTaskResource(types.Task{
+		TaskArn:              aws.String("arn:aws:ecs:us-east-1:123456789012:task/prod/abc123"),
+		EnableExecuteCommand: true,
+		LastStatus:           aws.String("RUNNING"),
+		Containers: []types.Container{
+			{Name: aws.String("web"), Image: aws.String("nginx:1.25"), ManagedAgents: agent("RUNNING")},
+			{Name: aws.String("init"), ManagedAgents: agent("STOPPED")},
+			{Name: aws.String("sidecar"), ManagedAgents: agent("RUNNING")},
+			{Name: aws.String("no-agent")},
+		},
+	})
+}
+
+func TestTaskResource_ExecContainers(t *testing.T) {
+	got := execTask().ExecContainers()
+	if len(got) != 2 || aws.ToString(got[0].Name) != "web" || aws.ToString(got[1].Name) != "sidecar" {
+		t.Errorf("ExecContainers() = %d containers", len(got))
+	}
+}
+
+func TestExecContainerChoices(t *testing.T) {
+	orig := lookPath
+	t.Cleanup(func() { lookPath = orig })
+	lookPath = func(file string) (string, error) { return "/usr/bin/" + file, nil }
+
+	choices, err := execContainerChoices(context.Background(), execTask())
+	if err != nil {
+		t.Fatalf("execContainerChoices() error = %v", err)
+	}
+	if len(choices) != 2 || choices[0].Value != "web" || choices[0].Label != "web (nginx:1.25)" || choices[1].Value != "sidecar" {
+		t.Errorf("execContainerChoices() = %+v", choices)
+	}
+
+	noAgents := NewTaskResource(types.Task{Containers: []types.Container{{Name: aws.String("web")}}})
+	if _, err := execContainerChoices(context.Background(), noAgents); err == nil {
+		t.Error("execContainerChoices() expected error without running agents")
+	}
+
+	lookPath = func(file string) (string, error) {
+		if file == "session-manager-plugin" {
+			return "", errors.New("not found")
+		}
+		return "/usr/bin/" + file, nil
+	}
+	if _, err := execContainerChoices(context.Background(), execTask()); err == nil || !strings.Contains(err.Error(), "session-manager-plugin") {
+		t.Errorf("execContainerChoices() error = %v, want missing plugin", err)
+	}
+}
@@ -0,0 +1,57 @@
+package accesspoints
+
+import (
+	"context"
+	"strings"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// defaultMountPoint is used when no mount point is entered.
+const defaultMountPoint = "/mnt/efs"
+
+func init() {
+	// Register actions for EFS access points
+	action.Global.Register("efs", "access-points", []action.Action{
+		{
+			Name:      "Copy Mount Command",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CopyMountCommand",
+			Filter: func(r dao.Resource) bool {
+				ap, ok := dao.UnwrapResource(r).(*AccessPointResource)
+				return ok && ap.IsAvailable()
+			},
+			Input: &action.InputSpec{
+				Label:       "Mount point",
+				Placeholder: defaultMountPoint,
+				Optional:    true,
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("efs", "access-points", executeAccessPointAction)
+}
+
+// executeAccessPointAction executes an action on an EFS access point
+func executeAccessPointAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	ap, ok := dao.UnwrapResource(resource).(*AccessPointResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "CopyMountCommand":
+		mountPoint := strings.TrimSpace(action.InputFromContext(ctx))
+		if mountPoint == "" {
+			mountPoint = defaultMountPoint
+		}
+		cmd := ap.MountCommand(mountPoint)
+		return action.SuccessResultWithFollowUp("Copied mount command", clipboard.Copy("Mount command", cmd)())
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
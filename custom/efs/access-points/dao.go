@@ -0,0 +1,155 @@
+package accesspoints
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// AccessPointDAO provides data access for EFS access points.
+type AccessPointDAO struct {
+	dao.BaseDAO
+	client *efs.Client
+}
+
+// NewAccessPointDAO creates a new AccessPointDAO.
+func NewAccessPointDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, efs.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &AccessPointDAO{
+		BaseDAO: dao.NewBaseDAO("efs", "access-points"),
+		client:  client,
+	}, nil
+}
+
+// List returns access points, narrowed to one file system by the
+// FileSystemId filter.
+func (d *AccessPointDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var fileSystemId *string
+	if id := dao.GetFilterFromContext(ctx, "FileSystemId"); id != "" {
+		fileSystemId = &id
+	}
+
+	accessPoints, err := appaws.Paginate(ctx, func(token *string) ([]types.AccessPointDescription, *string, error) {
+		output, err := d.client.DescribeAccessPoints(ctx, &efs.DescribeAccessPointsInput{
+			FileSystemId: fileSystemId,
+			NextToken:    token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe efs access points")
+		}
+		return output.AccessPoints, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(accessPoints))
+	for i, ap := range accessPoints {
+		resources[i] = NewAccessPointResource(ap)
+	}
+	return resources, nil
+}
+
+// Get returns an access point by ID.
+func (d *AccessPointDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeAccessPoints(ctx, &efs.DescribeAccessPointsInput{
+		AccessPointId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe efs access point %s", id)
+	}
+	if len(output.AccessPoints) == 0 {
+		return nil, fmt.Errorf("access point not found: %s", id)
+	}
+	return NewAccessPointResource(output.AccessPoints[0]), nil
+}
+
+// Delete deletes an access point by ID.
+func (d *AccessPointDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteAccessPoint(ctx, &efs.DeleteAccessPointInput{
+		AccessPointId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete efs access point %s", id)
+	}
+	return nil
+}
+
+// AccessPointResource wraps an EFS access point.
+type AccessPointResource struct {
+	dao.BaseResource
+	Item types.AccessPointDescription
+}
+
+// NewAccessPointResource creates a new AccessPointResource.
+func NewAccessPointResource(ap types.AccessPointDescription) *AccessPointResource {
+	return &AccessPointResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(ap.AccessPointId),
+			Name: appaws.Str(ap.Name),
+			ARN:  appaws.Str(ap.AccessPointArn),
+			Tags: appaws.TagsToMap(ap.Tags),
+			Data: ap,
+		},
+		Item: ap,
+	}
+}
+
+// FileSystemId returns the file system the access point belongs to.
+func (r *AccessPointResource) FileSystemId() string {
+	return appaws.Str(r.Item.FileSystemId)
+}
+
+// State returns the lifecycle state.
+func (r *AccessPointResource) State() string {
+	return string(r.Item.LifeCycleState)
+}
+
+// IsAvailable reports whether the access point is available.
+func (r *AccessPointResource) IsAvailable() bool {
+	return r.Item.LifeCycleState == types.LifeCycleStateAvailable
+}
+
+// RootPath returns the directory the access point exposes as the root.
+func (r *AccessPointResource) RootPath() string {
+	if r.Item.RootDirectory != nil {
+		if path := appaws.Str(r.Item.RootDirectory.Path); path != "" {
+			return path
+		}
+	}
+	return "/"
+}
+
+// PosixUser returns the enforced identity as "uid:gid", or "" when clients
+// keep their own identity.
+func (r *AccessPointResource) PosixUser() string {
+	u := r.Item.PosixUser
+	if u == nil || u.Uid == nil || u.Gid == nil {
+		return ""
+	}
+	user := fmt.Sprintf("%d:%d", *u.Uid, *u.Gid)
+	if len(u.SecondaryGids) > 0 {
+		gids := make([]string, len(u.SecondaryGids))
+		for i, g := range u.SecondaryGids {
+			gids[i] = fmt.Sprintf("%d", g)
+		}
+		user += " (" + strings.Join(gids, ",") + ")"
+	}
+	return user
+}
+
+// MountCommand returns the amazon-efs-utils command that mounts the file
+// system through this access point.
+func (r *AccessPointResource) MountCommand(mountPoint string) string {
+	return fmt.Sprintf("sudo mount -t efs -o tls,accesspoint=%s %s:/ %s", r.GetID(), r.FileSystemId(), mountPoint)
+}
@@ -0,0 +1,36 @@
+package accesspoints
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+)
+
+func TestAccessPointResource(t *testing.T) {
+	ap := NewAccessPointResource(types.AccessPointDescription{
+		AccessPointId: aws.String("fsap-0abc"),
+		FileSystemId:  aws.String("fs-0123"),
+		RootDirectory: &types.RootDirectory{Path: aws.String("/app")},
+		PosixUser: &types.PosixUser{
+			Uid:           aws.Int64(1000),
+			Gid:           aws.Int64(1000),
+			SecondaryGids: []int64{27, 100},
+		},
+	})
+
+	if got := ap.MountCommand("/mnt/app"); got != "sudo mount -t efs -o tls,accesspoint=fsap-0abc fs-0123:/ /mnt/app" {
+		t.Errorf("MountCommand() = %q", got)
+	}
+	if got := ap.RootPath(); got != "/app" {
+		t.Errorf("RootPath() = %q", got)
+	}
+	if got := ap.PosixUser(); got != "1000:1000 (27,100)" {
+		t.Errorf("PosixUser() = %q", got)
+	}
+
+	bare := NewAccessPointResource(types.AccessPointDescription{AccessPointId: aws.String("fsap-1")})
+	if bare.RootPath() != "/" || bare.PosixUser() != "" {
+		t.Errorf("bare access point: path=%q user=%q", bare.RootPath(), bare.PosixUser())
+	}
+}
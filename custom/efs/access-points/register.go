@@ -0,0 +1,20 @@
+package accesspoints
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("efs", "access-points", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewAccessPointDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewAccessPointRenderer()
+		},
+	})
+}
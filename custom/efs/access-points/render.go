@@ -0,0 +1,147 @@
+package accesspoints
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure AccessPointRenderer implements render.Navigator
+var _ render.Navigator = (*AccessPointRenderer)(nil)
+
+// AccessPointRenderer renders EFS access points.
+type AccessPointRenderer struct {
+	render.BaseRenderer
+}
+
+// NewAccessPointRenderer creates a new AccessPointRenderer.
+func NewAccessPointRenderer() render.Renderer {
+	return &AccessPointRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "efs",
+			Resource: "access-points",
+			Cols: []render.Column{
+				{Name: "ACCESS POINT ID", Width: 24, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "NAME", Width: 22, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "FILE SYSTEM", Width: 22, Getter: getFileSystem},
+				{Name: "PATH", Width: 24, Getter: getPath},
+				{Name: "POSIX USER", Width: 14, Getter: getPosixUser},
+				{Name: "STATE", Width: 11, Getter: getState},
+			},
+		},
+	}
+}
+
+func getFileSystem(r dao.Resource) string {
+	ap, ok := r.(*AccessPointResource)
+	if !ok {
+		return ""
+	}
+	return ap.FileSystemId()
+}
+
+func getPath(r dao.Resource) string {
+	ap, ok := r.(*AccessPointResource)
+	if !ok {
+		return ""
+	}
+	return ap.RootPath()
+}
+
+func getPosixUser(r dao.Resource) string {
+	ap, ok := r.(*AccessPointResource)
+	if !ok {
+		return ""
+	}
+	if u := ap.PosixUser(); u != "" {
+		return u
+	}
+	return "-"
+}
+
+func getState(r dao.Resource) string {
+	ap, ok := r.(*AccessPointResource)
+	if !ok {
+		return ""
+	}
+	return ap.State()
+}
+
+// RenderDetail renders the detail view for an EFS access point.
+func (r *AccessPointRenderer) RenderDetail(resource dao.Resource) string {
+	ap, ok := resource.(*AccessPointResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("EFS Access Point", ap.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Access Point ID", ap.GetID())
+	if name := ap.GetName(); name != "" {
+		d.Field("Name", name)
+	}
+	d.Field("ARN", ap.GetARN())
+	d.Field("File System", ap.FileSystemId())
+	d.FieldStyled("State", ap.State(), render.StateColorer()(ap.State()))
+
+	d.Section("Root Directory")
+	d.Field("Path", ap.RootPath())
+	if rd := ap.Item.RootDirectory; rd != nil && rd.CreationInfo != nil {
+		ci := rd.CreationInfo
+		if ci.OwnerUid != nil && ci.OwnerGid != nil {
+			d.Field("Created As", fmt.Sprintf("%d:%d mode %s", *ci.OwnerUid, *ci.OwnerGid, appaws.Str(ci.Permissions)))
+		}
+	}
+
+	d.Section("POSIX User")
+	if u := ap.PosixUser(); u != "" {
+		d.Field("UID:GID", u)
+	} else {
+		d.Field("UID:GID", "Not enforced")
+	}
+
+	d.Section("Mount")
+	d.Line("  " + ap.MountCommand(defaultMountPoint))
+
+	d.Tags(ap.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an EFS access point.
+func (r *AccessPointRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	ap, ok := resource.(*AccessPointResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Access Point", Value: ap.GetID()},
+		{Label: "File System", Value: ap.FileSystemId()},
+		{Label: "Path", Value: ap.RootPath()},
+		{Label: "State", Value: ap.State(), Style: render.StateColorer()(ap.State())},
+	}
+}
+
+// Navigations returns available navigations from an EFS access point.
+func (r *AccessPointRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	ap, ok := resource.(*AccessPointResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "f",
+			Label:       "File System",
+			Service:     "efs",
+			Resource:    "file-systems",
+			FilterField: "FileSystemId",
+			FilterValue: ap.FileSystemId(),
+		},
+	}
+}
@@ -0,0 +1,14 @@
+package efs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an EFS client configured for the current context
+func GetClient(ctx context.Context) (*efs.Client, error) {
+	return appaws.Client(ctx, efs.NewFromConfig)
+}
@@ -0,0 +1,74 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+
+	efsClient "github.com/clawscli/claws/custom/efs"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for EFS file systems
+	action.Global.Register("efs", "file-systems", []action.Action{
+		{
+			Name:      "Change Throughput Mode",
+			Shortcut:  "T",
+			Type:      action.ActionTypeAPI,
+			Operation: "UpdateThroughputMode",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				fs, ok := dao.UnwrapResource(r).(*FileSystemResource)
+				return ok && fs.IsAvailable()
+			},
+			Input: &action.InputSpec{
+				Label:       "Throughput mode",
+				Placeholder: "elastic | bursting | provisioned=128",
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("efs", "file-systems", executeFileSystemAction)
+}
+
+// executeFileSystemAction executes an action on an EFS file system
+func executeFileSystemAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "UpdateThroughputMode":
+		return executeUpdateThroughputMode(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeUpdateThroughputMode(ctx context.Context, resource dao.Resource) action.ActionResult {
+	fs, ok := dao.UnwrapResource(resource).(*FileSystemResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	mode, mibps, err := parseThroughput(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := efsClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := fs.GetID()
+	_, err = client.UpdateFileSystem(ctx, &efs.UpdateFileSystemInput{
+		FileSystemId:                 &id,
+		ThroughputMode:               mode,
+		ProvisionedThroughputInMibps: mibps,
+	})
+	if err != nil {
+		return action.FailResult(fmt.Errorf("update file system: %w", err))
+	}
+	return action.SuccessResult(fmt.Sprintf("Changing throughput mode of %s to %s", id, mode))
+}
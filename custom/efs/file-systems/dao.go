@@ -0,0 +1,186 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FileSystemDAO provides data access for EFS file systems.
+type FileSystemDAO struct {
+	dao.BaseDAO
+	client *efs.Client
+}
+
+// NewFileSystemDAO creates a new FileSystemDAO.
+func NewFileSystemDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, efs.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &FileSystemDAO{
+		BaseDAO: dao.NewBaseDAO("efs", "file-systems"),
+		client:  client,
+	}, nil
+}
+
+// List returns all EFS file systems in the region.
+func (d *FileSystemDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	fileSystems, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.FileSystemDescription, *string, error) {
+		output, err := d.client.DescribeFileSystems(ctx, &efs.DescribeFileSystemsInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe efs file systems")
+		}
+		return output.FileSystems, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(fileSystems))
+	for i, fs := range fileSystems {
+		resources[i] = NewFileSystemResource(fs)
+	}
+	return resources, nil
+}
+
+// Get returns a file system by ID.
+func (d *FileSystemDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeFileSystems(ctx, &efs.DescribeFileSystemsInput{
+		FileSystemId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe efs file system %s", id)
+	}
+	if len(output.FileSystems) == 0 {
+		return nil, fmt.Errorf("file system not found: %s", id)
+	}
+	return NewFileSystemResource(output.FileSystems[0]), nil
+}
+
+// Delete deletes a file system by ID. Its mount targets must be deleted first.
+func (d *FileSystemDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteFileSystem(ctx, &efs.DeleteFileSystemInput{
+		FileSystemId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete efs file system %s", id)
+	}
+	return nil
+}
+
+// FileSystemResource wraps an EFS file system.
+type FileSystemResource struct {
+	dao.BaseResource
+	Item types.FileSystemDescription
+}
+
+// NewFileSystemResource creates a new FileSystemResource.
+func NewFileSystemResource(fs types.FileSystemDescription) *FileSystemResource {
+	return &FileSystemResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(fs.FileSystemId),
+			Name: appaws.Str(fs.Name),
+			ARN:  appaws.Str(fs.FileSystemArn),
+			Tags: appaws.TagsToMap(fs.Tags),
+			Data: fs,
+		},
+		Item: fs,
+	}
+}
+
+// State returns the lifecycle state (available, creating, ...).
+func (r *FileSystemResource) State() string {
+	return string(r.Item.LifeCycleState)
+}
+
+// IsAvailable reports whether the file system is available.
+func (r *FileSystemResource) IsAvailable() bool {
+	return r.Item.LifeCycleState == types.LifeCycleStateAvailable
+}
+
+// IsTransitioning reports whether the file system is being created, updated or deleted.
+func (r *FileSystemResource) IsTransitioning() bool {
+	switch r.Item.LifeCycleState {
+	case types.LifeCycleStateCreating, types.LifeCycleStateUpdating, types.LifeCycleStateDeleting:
+		return true
+	}
+	return false
+}
+
+// SizeBytes returns the last metered size in bytes.
+func (r *FileSystemResource) SizeBytes() int64 {
+	if r.Item.SizeInBytes != nil {
+		return r.Item.SizeInBytes.Value
+	}
+	return 0
+}
+
+// PerformanceMode returns generalPurpose or maxIO.
+func (r *FileSystemResource) PerformanceMode() string {
+	return string(r.Item.PerformanceMode)
+}
+
+// ThroughputMode returns bursting, elastic or provisioned.
+func (r *FileSystemResource) ThroughputMode() string {
+	return string(r.Item.ThroughputMode)
+}
+
+// Throughput returns the throughput mode, with the provisioned MiB/s when set.
+func (r *FileSystemResource) Throughput() string {
+	if r.Item.ThroughputMode == types.ThroughputModeProvisioned && r.Item.ProvisionedThroughputInMibps != nil {
+		return fmt.Sprintf("provisioned (%g MiB/s)", *r.Item.ProvisionedThroughputInMibps)
+	}
+	return r.ThroughputMode()
+}
+
+// MountTargetCount returns the number of mount targets.
+func (r *FileSystemResource) MountTargetCount() int32 {
+	return r.Item.NumberOfMountTargets
+}
+
+// Encrypted reports whether the file system is encrypted at rest.
+func (r *FileSystemResource) Encrypted() bool {
+	return appaws.Bool(r.Item.Encrypted)
+}
+
+// AvailabilityZone returns the zone of a One Zone file system, or "" for Regional.
+func (r *FileSystemResource) AvailabilityZone() string {
+	return appaws.Str(r.Item.AvailabilityZoneName)
+}
+
+// CreatedAt returns when the file system was created.
+func (r *FileSystemResource) CreatedAt() *time.Time {
+	return r.Item.CreationTime
+}
+
+// parseThroughput parses "elastic", "bursting" or "provisioned=<MiB/s>".
+func parseThroughput(input string) (types.ThroughputMode, *float64, error) {
+	mode, value, hasValue := strings.Cut(strings.ToLower(strings.TrimSpace(input)), "=")
+	switch types.ThroughputMode(mode) {
+	case types.ThroughputModeElastic, types.ThroughputModeBursting:
+		if hasValue {
+			return "", nil, fmt.Errorf("%s throughput takes no value", mode)
+		}
+		return types.ThroughputMode(mode), nil, nil
+	case types.ThroughputModeProvisioned:
+		mibps, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if !hasValue || err != nil || mibps <= 0 {
+			return "", nil, fmt.Errorf("provisioned throughput needs a positive MiB/s value, e.g. provisioned=128")
+		}
+		return types.ThroughputModeProvisioned, &mibps, nil
+	default:
+		return "", nil, fmt.Errorf("unknown throughput mode %q (use elastic, bursting or provisioned=<MiB/s>)", mode)
+	}
+}
@@ -0,0 +1,67 @@
+package filesystems
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+)
+
+func TestParseThroughput(t *testing.T) {
+	tests := []struct {
+		input   string
+		mode    types.ThroughputMode
+		mibps   float64
+		wantErr bool
+	}{
+		{input: "elastic", mode: types.ThroughputModeElastic},
+		{input: " Bursting ", mode: types.ThroughputModeBursting},
+		{input: "provisioned=128", mode: types.ThroughputModeProvisioned, mibps: 128},
+		{input: "provisioned= 2.5", mode: types.ThroughputModeProvisioned, mibps: 2.5},
+		{input: "provisioned", wantErr: true},
+		{input: "provisioned=0", wantErr: true},
+		{input: "elastic=10", wantErr: true},
+		{input: "turbo", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			mode, mibps, err := parseThroughput(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseThroughput(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if mode != tt.mode {
+				t.Errorf("mode = %q, want %q", mode, tt.mode)
+			}
+			if (mibps == nil) != (tt.mibps == 0) || (mibps != nil && *mibps != tt.mibps) {
+				t.Errorf("mibps = %v, want %v", mibps, tt.mibps)
+			}
+		})
+	}
+}
+
+func TestFileSystemResource(t *testing.T) {
+	fs := NewFileSystemResource(types.FileSystemDescription{
+		FileSystemId:                 aws.String("fs-0123"),
+		Name:                         aws.String("shared"),
+		LifeCycleState:               types.LifeCycleStateAvailable,
+		ThroughputMode:               types.ThroughputModeProvisioned,
+		ProvisionedThroughputInMibps: aws.Float64(64),
+		SizeInBytes:                  &types.FileSystemSize{Value: 2048},
+		Tags:                         []types.Tag{{Key: aws.String("Name"), Value: aws.String("shared")}},
+	})
+
+	if fs.GetID() != "fs-0123" || fs.GetName() != "shared" || fs.SizeBytes() != 2048 {
+		t.Errorf("id=%q name=%q size=%d", fs.GetID(), fs.GetName(), fs.SizeBytes())
+	}
+	if got := fs.Throughput(); got != "provisioned (64 MiB/s)" {
+		t.Errorf("Throughput() = %q", got)
+	}
+	if !fs.IsAvailable() || fs.IsTransitioning() {
+		t.Errorf("available=%v transitioning=%v", fs.IsAvailable(), fs.IsTransitioning())
+	}
+}
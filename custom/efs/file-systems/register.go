@@ -0,0 +1,20 @@
+package filesystems
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("efs", "file-systems", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewFileSystemDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewFileSystemRenderer()
+		},
+	})
+}
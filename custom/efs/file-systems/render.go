@@ -0,0 +1,197 @@
+package filesystems
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure FileSystemRenderer implements render.Navigator
+var _ render.Navigator = (*FileSystemRenderer)(nil)
+
+// FileSystemRenderer renders EFS file systems.
+type FileSystemRenderer struct {
+	render.BaseRenderer
+}
+
+// NewFileSystemRenderer creates a new FileSystemRenderer.
+func NewFileSystemRenderer() render.Renderer {
+	return &FileSystemRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "efs",
+			Resource: "file-systems",
+			Cols: []render.Column{
+				{Name: "ID", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "NAME", Width: 26, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATE", Width: 11, Getter: getState},
+				{Name: "SIZE", Width: 10, Getter: getSize},
+				{Name: "PERFORMANCE", Width: 15, Getter: getPerformance},
+				{Name: "THROUGHPUT", Width: 12, Getter: getThroughput},
+				{Name: "MOUNTS", Width: 7, Getter: getMountTargets},
+				{Name: "ENCRYPTED", Width: 10, Getter: getEncrypted},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return fs.State()
+}
+
+func getSize(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return render.FormatSize(fs.SizeBytes())
+}
+
+func getPerformance(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return fs.PerformanceMode()
+}
+
+func getThroughput(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return fs.ThroughputMode()
+}
+
+func getMountTargets(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", fs.MountTargetCount())
+}
+
+func getEncrypted(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return yesNo(fs.Encrypted())
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// RenderDetail renders the detail view for an EFS file system.
+func (r *FileSystemRenderer) RenderDetail(resource dao.Resource) string {
+	fs, ok := resource.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("EFS File System", fs.GetID())
+
+	d.Section("Basic Information")
+	d.Field("File System ID", fs.GetID())
+	if name := fs.GetName(); name != "" {
+		d.Field("Name", name)
+	}
+	d.Field("ARN", fs.GetARN())
+	d.FieldStyled("State", fs.State(), render.StateColorer()(fs.State()))
+	if az := fs.AvailabilityZone(); az != "" {
+		d.Field("Storage Class", "One Zone ("+az+")")
+	} else {
+		d.Field("Storage Class", "Regional")
+	}
+
+	d.Section("Performance")
+	d.Field("Performance Mode", fs.PerformanceMode())
+	d.Field("Throughput Mode", fs.Throughput())
+
+	d.Section("Storage")
+	d.Field("Size", render.FormatSize(fs.SizeBytes()))
+	if s := fs.Item.SizeInBytes; s != nil {
+		if s.ValueInStandard != nil {
+			d.Field("Standard", render.FormatSize(*s.ValueInStandard))
+		}
+		if s.ValueInIA != nil {
+			d.Field("Infrequent Access", render.FormatSize(*s.ValueInIA))
+		}
+		if s.ValueInArchive != nil {
+			d.Field("Archive", render.FormatSize(*s.ValueInArchive))
+		}
+	}
+	d.Field("Encrypted", yesNo(fs.Encrypted()))
+	d.Field("Mount Targets", fmt.Sprintf("%d", fs.MountTargetCount()))
+
+	d.Tags(fs.GetTags())
+
+	d.Section("Timestamps")
+	if t := fs.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an EFS file system.
+func (r *FileSystemRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	fs, ok := resource.(*FileSystemResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "ID", Value: fs.GetID()},
+		{Label: "Name", Value: fs.GetName()},
+		{Label: "State", Value: fs.State(), Style: render.StateColorer()(fs.State())},
+		{Label: "Size", Value: render.FormatSize(fs.SizeBytes())},
+		{Label: "Throughput", Value: fs.Throughput()},
+	}
+}
+
+// Navigations returns available navigations from an EFS file system.
+func (r *FileSystemRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	fs, ok := resource.(*FileSystemResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "t",
+			Label:       "Mount Targets",
+			Service:     "efs",
+			Resource:    "mount-targets",
+			FilterField: "FileSystemId",
+			FilterValue: fs.GetID(),
+		},
+		{
+			Key:         "p",
+			Label:       "Access Points",
+			Service:     "efs",
+			Resource:    "access-points",
+			FilterField: "FileSystemId",
+			FilterValue: fs.GetID(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a file system is changing state
+func (r *FileSystemRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if fs, ok := dao.UnwrapResource(res).(*FileSystemResource); ok && fs.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package mounttargets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/aws/aws-sdk-go-v2/service/efs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// MountTargetDAO provides data access for EFS mount targets.
+type MountTargetDAO struct {
+	dao.BaseDAO
+	client *efs.Client
+}
+
+// NewMountTargetDAO creates a new MountTargetDAO.
+func NewMountTargetDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, efs.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &MountTargetDAO{
+		BaseDAO: dao.NewBaseDAO("efs", "mount-targets"),
+		client:  client,
+	}, nil
+}
+
+// List returns the mount targets of a file system.
+func (d *MountTargetDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	fileSystemId := dao.GetFilterFromContext(ctx, "FileSystemId")
+	if fileSystemId == "" {
+		return nil, fmt.Errorf("file system ID filter required")
+	}
+
+	targets, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.MountTargetDescription, *string, error) {
+		output, err := d.client.DescribeMountTargets(ctx, &efs.DescribeMountTargetsInput{
+			FileSystemId: &fileSystemId,
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe efs mount targets")
+		}
+		return output.MountTargets, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(targets))
+	for i, mt := range targets {
+		resources[i] = NewMountTargetResource(mt)
+	}
+	return resources, nil
+}
+
+// Get returns a mount target by ID.
+func (d *MountTargetDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeMountTargets(ctx, &efs.DescribeMountTargetsInput{
+		MountTargetId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe efs mount target %s", id)
+	}
+	if len(output.MountTargets) == 0 {
+		return nil, fmt.Errorf("mount target not found: %s", id)
+	}
+	return NewMountTargetResource(output.MountTargets[0]), nil
+}
+
+// Delete deletes a mount target by ID.
+func (d *MountTargetDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteMountTarget(ctx, &efs.DeleteMountTargetInput{
+		MountTargetId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete efs mount target %s", id)
+	}
+	return nil
+}
+
+// MountTargetResource wraps an EFS mount target.
+type MountTargetResource struct {
+	dao.BaseResource
+	Item types.MountTargetDescription
+}
+
+// NewMountTargetResource creates a new MountTargetResource.
+func NewMountTargetResource(mt types.MountTargetDescription) *MountTargetResource {
+	return &MountTargetResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(mt.MountTargetId),
+			Name: appaws.Str(mt.MountTargetId),
+			Data: mt,
+		},
+		Item: mt,
+	}
+}
+
+// FileSystemId returns the file system the mount target belongs to.
+func (r *MountTargetResource) FileSystemId() string {
+	return appaws.Str(r.Item.FileSystemId)
+}
+
+// State returns the lifecycle state.
+func (r *MountTargetResource) State() string {
+	return string(r.Item.LifeCycleState)
+}
+
+// AvailabilityZone returns the mount target's availability zone.
+func (r *MountTargetResource) AvailabilityZone() string {
+	return appaws.Str(r.Item.AvailabilityZoneName)
+}
+
+// SubnetId returns the mount target's subnet.
+func (r *MountTargetResource) SubnetId() string {
+	return appaws.Str(r.Item.SubnetId)
+}
+
+// VpcId returns the mount target's VPC.
+func (r *MountTargetResource) VpcId() string {
+	return appaws.Str(r.Item.VpcId)
+}
+
+// IPAddress returns the mount target's IPv4 address.
+func (r *MountTargetResource) IPAddress() string {
+	return appaws.Str(r.Item.IpAddress)
+}
+
+// NetworkInterfaceId returns the mount target's network interface.
+func (r *MountTargetResource) NetworkInterfaceId() string {
+	return appaws.Str(r.Item.NetworkInterfaceId)
+}
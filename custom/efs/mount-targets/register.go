@@ -0,0 +1,20 @@
+package mounttargets
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("efs", "mount-targets", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewMountTargetDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewMountTargetRenderer()
+		},
+	})
+}
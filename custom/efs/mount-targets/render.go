@@ -0,0 +1,119 @@
+package mounttargets
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure MountTargetRenderer implements render.Navigator
+var _ render.Navigator = (*MountTargetRenderer)(nil)
+
+// MountTargetRenderer renders EFS mount targets.
+type MountTargetRenderer struct {
+	render.BaseRenderer
+}
+
+// NewMountTargetRenderer creates a new MountTargetRenderer.
+func NewMountTargetRenderer() render.Renderer {
+	return &MountTargetRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "efs",
+			Resource: "mount-targets",
+			Cols: []render.Column{
+				{Name: "MOUNT TARGET ID", Width: 24, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "AZ", Width: 14, Getter: getAZ},
+				{Name: "SUBNET", Width: 26, Getter: getSubnet},
+				{Name: "IP ADDRESS", Width: 16, Getter: getIP},
+				{Name: "STATE", Width: 11, Getter: getState},
+			},
+		},
+	}
+}
+
+func getAZ(r dao.Resource) string {
+	mt, ok := r.(*MountTargetResource)
+	if !ok {
+		return ""
+	}
+	return mt.AvailabilityZone()
+}
+
+func getSubnet(r dao.Resource) string {
+	mt, ok := r.(*MountTargetResource)
+	if !ok {
+		return ""
+	}
+	return mt.SubnetId()
+}
+
+func getIP(r dao.Resource) string {
+	mt, ok := r.(*MountTargetResource)
+	if !ok {
+		return ""
+	}
+	return mt.IPAddress()
+}
+
+func getState(r dao.Resource) string {
+	mt, ok := r.(*MountTargetResource)
+	if !ok {
+		return ""
+	}
+	return mt.State()
+}
+
+// RenderDetail renders the detail view for an EFS mount target.
+func (r *MountTargetRenderer) RenderDetail(resource dao.Resource) string {
+	mt, ok := resource.(*MountTargetResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("EFS Mount Target", mt.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Mount Target ID", mt.GetID())
+	d.Field("File System", mt.FileSystemId())
+	d.FieldStyled("State", mt.State(), render.StateColorer()(mt.State()))
+
+	d.Section("Network")
+	d.Field("Availability Zone", mt.AvailabilityZone())
+	d.Field("VPC", mt.VpcId())
+	d.Field("Subnet", mt.SubnetId())
+	d.Field("IP Address", mt.IPAddress())
+	if ipv6 := mt.Item.Ipv6Address; ipv6 != nil {
+		d.Field("IPv6 Address", *ipv6)
+	}
+	d.Field("Network Interface", mt.NetworkInterfaceId())
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an EFS mount target.
+func (r *MountTargetRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	mt, ok := resource.(*MountTargetResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Mount Target", Value: mt.GetID()},
+		{Label: "AZ", Value: mt.AvailabilityZone()},
+		{Label: "IP", Value: mt.IPAddress()},
+		{Label: "State", Value: mt.State(), Style: render.StateColorer()(mt.State())},
+	}
+}
+
+// Navigations returns available navigations from an EFS mount target.
+func (r *MountTargetRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	mt, ok := resource.(*MountTargetResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "u", Label: "Subnet", Service: "vpc", Resource: "subnets", FilterField: "SubnetId", FilterValue: mt.SubnetId()},
+		{Key: "v", Label: "VPC", Service: "vpc", Resource: "vpcs", FilterField: "VpcId", FilterValue: mt.VpcId()},
+	}
+}
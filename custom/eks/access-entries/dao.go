@@ -21,13 +21,13 @@ type AccessEntryDAO struct {
 
 // NewAccessEntryDAO creates a new AccessEntryDAO
 func NewAccessEntryDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, eks.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new eks/access-entries dao")
 	}
 	return &AccessEntryDAO{
 		BaseDAO: dao.NewBaseDAO("eks", "access-entries"),
-		client:  eks.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
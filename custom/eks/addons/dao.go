@@ -21,13 +21,13 @@ type AddonDAO struct {
 
 // NewAddonDAO creates a new AddonDAO
 func NewAddonDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, eks.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new eks/addons dao")
 	}
 	return &AddonDAO{
 		BaseDAO: dao.NewBaseDAO("eks", "addons"),
-		client:  eks.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type ClusterDAO struct {
 
 // NewClusterDAO creates a new ClusterDAO
 func NewClusterDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, eks.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new eks/clusters dao")
 	}
 	return &ClusterDAO{
 		BaseDAO: dao.NewBaseDAO("eks", "clusters"),
-		client:  eks.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
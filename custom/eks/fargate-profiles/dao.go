@@ -21,13 +21,13 @@ type FargateProfileDAO struct {
 
 // NewFargateProfileDAO creates a new FargateProfileDAO
 func NewFargateProfileDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, eks.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new eks/fargate-profiles dao")
 	}
 	return &FargateProfileDAO{
 		BaseDAO: dao.NewBaseDAO("eks", "fargate-profiles"),
-		client:  eks.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -22,13 +22,13 @@ type NodeGroupDAO struct {
 
 // NewNodeGroupDAO creates a new NodeGroupDAO
 func NewNodeGroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, eks.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new eks/node-groups dao")
 	}
 	return &NodeGroupDAO{
 		BaseDAO: dao.NewBaseDAO("eks", "node-groups"),
-		client:  eks.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,14 @@
+package elasticache
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an ElastiCache client configured for the current context
+func GetClient(ctx context.Context) (*elasticache.Client, error) {
+	return appaws.Client(ctx, elasticache.NewFromConfig)
+}
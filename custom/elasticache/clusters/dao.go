@@ -20,18 +20,20 @@ type ClusterDAO struct {
 
 // NewClusterDAO creates a new ClusterDAO
 func NewClusterDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, elasticache.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ClusterDAO{
 		BaseDAO: dao.NewBaseDAO("elasticache", "clusters"),
-		client:  elasticache.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
-// List returns all ElastiCache clusters
+// List returns all ElastiCache clusters. A ReplicationGroupId filter in context
+// narrows the result to members of that replication group.
 func (d *ClusterDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	groupID := dao.GetFilterFromContext(ctx, "ReplicationGroupId")
 	clusters, err := appaws.Paginate(ctx, func(token *string) ([]types.CacheCluster, *string, error) {
 		output, err := d.client.DescribeCacheClusters(ctx, &elasticache.DescribeCacheClustersInput{
 			Marker:            token,
@@ -47,9 +49,12 @@ func (d *ClusterDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		return nil, err
 	}
 
-	resources := make([]dao.Resource, len(clusters))
-	for i, cluster := range clusters {
-		resources[i] = NewClusterResource(cluster)
+	resources := make([]dao.Resource, 0, len(clusters))
+	for _, cluster := range clusters {
+		if groupID != "" && appaws.Str(cluster.ReplicationGroupId) != groupID {
+			continue
+		}
+		resources = append(resources, NewClusterResource(cluster))
 	}
 
 	return resources, nil
@@ -10,8 +10,11 @@ import (
 )
 
 // ClusterRenderer renders ElastiCache clusters
-// Ensure ClusterRenderer implements render.Navigator
-var _ render.Navigator = (*ClusterRenderer)(nil)
+// Ensure ClusterRenderer implements render.Navigator and render.MetricSpecProvider
+var (
+	_ render.Navigator          = (*ClusterRenderer)(nil)
+	_ render.MetricSpecProvider = (*ClusterRenderer)(nil)
+)
 
 type ClusterRenderer struct {
 	render.BaseRenderer
@@ -230,6 +233,42 @@ func (r *ClusterRenderer) RenderSummary(resource dao.Resource) []render.SummaryF
 
 // Navigations returns navigation shortcuts
 func (r *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	// No navigations for now
-	return nil
+	cluster, ok := resource.(*ClusterResource)
+	if !ok {
+		return nil
+	}
+
+	navs := []render.Navigation{
+		{
+			Key: "n", Label: "Nodes", Service: "elasticache", Resource: "nodes",
+			FilterField: "CacheClusterId", FilterValue: cluster.ClusterId(),
+		},
+	}
+
+	if replGroup := cluster.ReplicationGroupId(); replGroup != "" {
+		navs = append(navs, render.Navigation{
+			Key: "s", Label: "Shards", Service: "elasticache", Resource: "shards",
+			FilterField: "ReplicationGroupId", FilterValue: replGroup,
+		})
+	}
+
+	if paramGroup := cluster.ParameterGroupName(); paramGroup != "" {
+		navs = append(navs, render.Navigation{
+			Key: "p", Label: "Parameters", Service: "elasticache", Resource: "parameters",
+			FilterField: "CacheParameterGroupName", FilterValue: paramGroup,
+		})
+	}
+
+	return navs
+}
+
+func (r *ClusterRenderer) MetricSpec() *render.MetricSpec {
+	return &render.MetricSpec{
+		Namespace:     "AWS/ElastiCache",
+		MetricName:    "CPUUtilization",
+		DimensionName: "CacheClusterId",
+		Stat:          "Average",
+		ColumnHeader:  "CPU(15m)",
+		Unit:          "%",
+	}
 }
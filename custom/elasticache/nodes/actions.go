@@ -0,0 +1,67 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	elasticacheClient "github.com/clawscli/claws/custom/elasticache"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for ElastiCache nodes
+	action.Global.Register("elasticache", "nodes", []action.Action{
+		{
+			Name:      "Reboot Node",
+			Shortcut:  "B",
+			Type:      action.ActionTypeAPI,
+			Operation: "RebootCacheNode",
+			Confirm:   action.ConfirmSimple,
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("elasticache", "nodes", executeNodeAction)
+}
+
+// executeNodeAction executes an action on an ElastiCache node
+func executeNodeAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RebootCacheNode":
+		return executeRebootNode(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeRebootNode(ctx context.Context, resource dao.Resource) action.ActionResult {
+	node, ok := resource.(*NodeResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	if node.Status() != "available" {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("node is %s, can only reboot available nodes", node.Status())}
+	}
+
+	client, err := elasticacheClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	_, err = client.RebootCacheCluster(ctx, &elasticache.RebootCacheClusterInput{
+		CacheClusterId:       &node.ClusterId,
+		CacheNodeIdsToReboot: []string{node.GetID()},
+	})
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("reboot cache node: %w", err)}
+	}
+
+	return action.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Rebooting node %s in cluster %s", node.GetID(), node.ClusterId),
+	}
+}
@@ -0,0 +1,277 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// metricsWindow is how far back Get looks for the latest engine metric datapoint.
+const metricsWindow = 15 * time.Minute
+
+// engineMetric describes a per-node CloudWatch metric shown in the detail view.
+type engineMetric struct {
+	Name  string
+	Label string
+	Stat  string
+	Unit  string
+}
+
+// engineMetrics are fetched for a node on Get. Metrics that do not apply to the
+// node's engine (e.g. ReplicationLag on memcached or a primary) return no data
+// and are omitted.
+var engineMetrics = []engineMetric{
+	{Name: "CPUUtilization", Label: "CPU", Stat: "Average", Unit: "%"},
+	{Name: "EngineCPUUtilization", Label: "Engine CPU", Stat: "Average", Unit: "%"},
+	{Name: "DatabaseMemoryUsagePercentage", Label: "Memory Used", Stat: "Average", Unit: "%"},
+	{Name: "CurrConnections", Label: "Connections", Stat: "Maximum"},
+	{Name: "Evictions", Label: "Evictions", Stat: "Sum"},
+	{Name: "ReplicationLag", Label: "Replication Lag", Stat: "Maximum", Unit: "s"},
+}
+
+// NodeDAO provides data access for ElastiCache cache nodes
+type NodeDAO struct {
+	dao.BaseDAO
+	client   *elasticache.Client
+	cwClient *cloudwatch.Client
+}
+
+// NewNodeDAO creates a new NodeDAO
+func NewNodeDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticache.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	cwClient, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &NodeDAO{
+		BaseDAO:  dao.NewBaseDAO("elasticache", "nodes"),
+		client:   client,
+		cwClient: cwClient,
+	}, nil
+}
+
+// List returns the cache nodes of a cluster (requires CacheClusterId filter)
+func (d *NodeDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	cluster, err := d.describeCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(cluster.CacheNodes))
+	for _, node := range cluster.CacheNodes {
+		resources = append(resources, NewNodeResource(node, cluster))
+	}
+	return resources, nil
+}
+
+// Get returns a cache node enriched with its latest engine metrics.
+// Metric lookups are best-effort; failures are logged and leave metrics unset.
+func (d *NodeDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	cluster, err := d.describeCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range cluster.CacheNodes {
+		if appaws.Str(node.CacheNodeId) != id {
+			continue
+		}
+		r := NewNodeResource(node, cluster)
+		metrics, err := d.fetchMetrics(ctx, r.ClusterId, id)
+		if err != nil {
+			log.Warn("failed to fetch cache node metrics", "cluster", r.ClusterId, "node", id, "error", err)
+		} else {
+			r.Metrics = metrics
+		}
+		return r, nil
+	}
+
+	return nil, fmt.Errorf("cache node %s not found", id)
+}
+
+// Delete is not supported; nodes are removed by modifying the cluster.
+func (d *NodeDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for cache nodes - modify the cluster node count instead")
+}
+
+func (d *NodeDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *NodeDAO) describeCluster(ctx context.Context) (types.CacheCluster, error) {
+	clusterID := dao.GetFilterFromContext(ctx, "CacheClusterId")
+	if clusterID == "" {
+		return types.CacheCluster{}, fmt.Errorf("CacheClusterId filter required - navigate from a cluster")
+	}
+
+	output, err := d.client.DescribeCacheClusters(ctx, &elasticache.DescribeCacheClustersInput{
+		CacheClusterId:    &clusterID,
+		ShowCacheNodeInfo: appaws.BoolPtr(true),
+	})
+	if err != nil {
+		return types.CacheCluster{}, apperrors.Wrapf(err, "describe cache cluster %s", clusterID)
+	}
+	if len(output.CacheClusters) == 0 {
+		return types.CacheCluster{}, fmt.Errorf("cache cluster %s not found", clusterID)
+	}
+	return output.CacheClusters[0], nil
+}
+
+func (d *NodeDAO) fetchMetrics(ctx context.Context, clusterID, nodeID string) ([]NodeMetric, error) {
+	queries := make([]cwtypes.MetricDataQuery, len(engineMetrics))
+	for i, m := range engineMetrics {
+		queries[i] = cwtypes.MetricDataQuery{
+			Id: appaws.StringPtr(fmt.Sprintf("m%d", i)),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  appaws.StringPtr("AWS/ElastiCache"),
+					MetricName: appaws.StringPtr(m.Name),
+					Dimensions: []cwtypes.Dimension{
+						{Name: appaws.StringPtr("CacheClusterId"), Value: appaws.StringPtr(clusterID)},
+						{Name: appaws.StringPtr("CacheNodeId"), Value: appaws.StringPtr(nodeID)},
+					},
+				},
+				Period: appaws.Int32Ptr(60),
+				Stat:   appaws.StringPtr(m.Stat),
+			},
+		}
+	}
+
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-metricsWindow)
+	output, err := d.cwClient.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime:         &start,
+		EndTime:           &end,
+		MetricDataQueries: queries,
+		ScanBy:            cwtypes.ScanByTimestampDescending,
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get cache node metrics")
+	}
+
+	return latestMetrics(output.MetricDataResults), nil
+}
+
+// latestMetrics maps query results back to engineMetrics, keeping only
+// metrics with data. Results are expected newest-first.
+func latestMetrics(results []cwtypes.MetricDataResult) []NodeMetric {
+	latest := make(map[string]float64, len(results))
+	for _, res := range results {
+		if len(res.Values) > 0 {
+			latest[appaws.Str(res.Id)] = res.Values[0]
+		}
+	}
+
+	var metrics []NodeMetric
+	for i, m := range engineMetrics {
+		if v, ok := latest[fmt.Sprintf("m%d", i)]; ok {
+			metrics = append(metrics, NodeMetric{Name: m.Name, Label: m.Label, Unit: m.Unit, Value: v})
+		}
+	}
+	return metrics
+}
+
+// NodeMetric is the latest value of an engine metric for a node
+type NodeMetric struct {
+	Name  string
+	Label string
+	Unit  string
+	Value float64
+}
+
+// Format returns the metric value with its unit
+func (m NodeMetric) Format() string {
+	switch m.Unit {
+	case "%":
+		return fmt.Sprintf("%.1f%%", m.Value)
+	case "s":
+		return fmt.Sprintf("%.2fs", m.Value)
+	default:
+		return fmt.Sprintf("%.0f", m.Value)
+	}
+}
+
+// NodeResource represents an ElastiCache cache node
+type NodeResource struct {
+	dao.BaseResource
+	Item               types.CacheNode
+	ClusterId          string
+	ReplicationGroupId string
+	Engine             string
+	NodeType           string
+
+	// Populated by Get only
+	Metrics []NodeMetric
+}
+
+// NewNodeResource creates a new NodeResource
+func NewNodeResource(node types.CacheNode, cluster types.CacheCluster) *NodeResource {
+	nodeID := appaws.Str(node.CacheNodeId)
+	return &NodeResource{
+		BaseResource: dao.BaseResource{
+			ID:   nodeID,
+			Name: nodeID,
+			Tags: make(map[string]string),
+			Data: node,
+		},
+		Item:               node,
+		ClusterId:          appaws.Str(cluster.CacheClusterId),
+		ReplicationGroupId: appaws.Str(cluster.ReplicationGroupId),
+		Engine:             appaws.Str(cluster.Engine),
+		NodeType:           appaws.Str(cluster.CacheNodeType),
+	}
+}
+
+// Status returns the node status
+func (r *NodeResource) Status() string {
+	return appaws.Str(r.Item.CacheNodeStatus)
+}
+
+// AvailabilityZone returns the node's availability zone
+func (r *NodeResource) AvailabilityZone() string {
+	return appaws.Str(r.Item.CustomerAvailabilityZone)
+}
+
+// Endpoint returns the node endpoint as host:port
+func (r *NodeResource) Endpoint() string {
+	if r.Item.Endpoint == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", appaws.Str(r.Item.Endpoint.Address), appaws.Int32(r.Item.Endpoint.Port))
+}
+
+// ParameterGroupStatus returns the status of the node's parameter group
+func (r *NodeResource) ParameterGroupStatus() string {
+	return appaws.Str(r.Item.ParameterGroupStatus)
+}
+
+// SourceNodeId returns the primary node this node replicates from, if any
+func (r *NodeResource) SourceNodeId() string {
+	return appaws.Str(r.Item.SourceCacheNodeId)
+}
+
+// CreatedAt returns the creation time as a formatted string
+func (r *NodeResource) CreatedAt() string {
+	if r.Item.CacheNodeCreateTime != nil {
+		return r.Item.CacheNodeCreateTime.Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
@@ -0,0 +1,43 @@
+package nodes
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+func TestLatestMetrics(t *testing.T) {
+	results := []cwtypes.MetricDataResult{
+		{Id: aws.String("m0"), Values: []float64{12.5, 10}},
+		{Id: aws.String("m1"), Values: nil},
+		{Id: aws.String("m4"), Values: []float64{3}},
+	}
+
+	got := latestMetrics(results)
+	if len(got) != 2 {
+		t.Fatalf("latestMetrics() returned %d metrics, want 2", len(got))
+	}
+	if got[0].Name != "CPUUtilization" || got[0].Value != 12.5 {
+		t.Errorf("got[0] = %+v, want CPUUtilization=12.5", got[0])
+	}
+	if got[1].Name != "Evictions" || got[1].Value != 3 {
+		t.Errorf("got[1] = %+v, want Evictions=3", got[1])
+	}
+}
+
+func TestNodeMetric_Format(t *testing.T) {
+	tests := []struct {
+		metric NodeMetric
+		want   string
+	}{
+		{NodeMetric{Unit: "%", Value: 42.345}, "42.3%"},
+		{NodeMetric{Unit: "s", Value: 0.5}, "0.50s"},
+		{NodeMetric{Value: 17}, "17"},
+	}
+	for _, tt := range tests {
+		if got := tt.metric.Format(); got != tt.want {
+			t.Errorf("Format() = %q, want %q", got, tt.want)
+		}
+	}
+}
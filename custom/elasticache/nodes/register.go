@@ -0,0 +1,20 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("elasticache", "nodes", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewNodeDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewNodeRenderer()
+		},
+	})
+}
@@ -0,0 +1,170 @@
+package nodes
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// NodeRenderer renders ElastiCache cache nodes
+// Ensure NodeRenderer implements render.Navigator
+var _ render.Navigator = (*NodeRenderer)(nil)
+
+type NodeRenderer struct {
+	render.BaseRenderer
+}
+
+// NewNodeRenderer creates a new NodeRenderer
+func NewNodeRenderer() *NodeRenderer {
+	return &NodeRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elasticache",
+			Resource: "nodes",
+			Cols: []render.Column{
+				{Name: "NODE ID", Width: 10, Getter: getNodeId},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "AZ", Width: 14, Getter: getAZ},
+				{Name: "ENDPOINT", Width: 60, Getter: getEndpoint},
+				{Name: "PARAMS", Width: 14, Getter: getParameterGroupStatus},
+				{Name: "CREATED", Width: 20, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getNodeId(r dao.Resource) string {
+	return r.GetID()
+}
+
+func getStatus(r dao.Resource) string {
+	if node, ok := r.(*NodeResource); ok {
+		return node.Status()
+	}
+	return ""
+}
+
+func getAZ(r dao.Resource) string {
+	if node, ok := r.(*NodeResource); ok {
+		return node.AvailabilityZone()
+	}
+	return ""
+}
+
+func getEndpoint(r dao.Resource) string {
+	if node, ok := r.(*NodeResource); ok {
+		return node.Endpoint()
+	}
+	return ""
+}
+
+func getParameterGroupStatus(r dao.Resource) string {
+	if node, ok := r.(*NodeResource); ok {
+		return node.ParameterGroupStatus()
+	}
+	return ""
+}
+
+func getCreated(r dao.Resource) string {
+	if node, ok := r.(*NodeResource); ok {
+		return node.CreatedAt()
+	}
+	return ""
+}
+
+// RenderDetail renders detailed cache node information
+func (r *NodeRenderer) RenderDetail(resource dao.Resource) string {
+	node, ok := resource.(*NodeResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ElastiCache Node", node.ClusterId+"/"+node.GetID())
+
+	// Basic Info
+	d.Section("Basic Information")
+	d.Field("Node ID", node.GetID())
+	d.Field("Cluster ID", node.ClusterId)
+	if node.ReplicationGroupId != "" {
+		d.Field("Replication Group", node.ReplicationGroupId)
+	}
+	if node.Status() == "available" {
+		d.FieldStyled("Status", node.Status(), ui.SuccessStyle())
+	} else {
+		d.Field("Status", node.Status())
+	}
+	d.Field("Engine", node.Engine)
+	d.Field("Node Type", node.NodeType)
+	if params := node.ParameterGroupStatus(); params != "" {
+		d.Field("Parameter Group Status", params)
+	}
+
+	// Networking
+	d.Section("Networking")
+	if endpoint := node.Endpoint(); endpoint != "" {
+		d.Field("Endpoint", endpoint)
+	}
+	d.Field("Availability Zone", node.AvailabilityZone())
+	if source := node.SourceNodeId(); source != "" {
+		d.Field("Source Node", source)
+	}
+
+	// Engine Metrics
+	if len(node.Metrics) > 0 {
+		d.Section("Engine Metrics (latest)")
+		for _, m := range node.Metrics {
+			if m.Name == "Evictions" && m.Value > 0 {
+				d.FieldStyled(m.Label, m.Format(), ui.WarningStyle())
+			} else {
+				d.Field(m.Label, m.Format())
+			}
+		}
+	}
+
+	// Timestamps
+	d.Section("Timestamps")
+	if created := node.CreatedAt(); created != "" {
+		d.Field("Created", created)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *NodeRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	node, ok := resource.(*NodeResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Node ID", Value: node.GetID()},
+		{Label: "Cluster ID", Value: node.ClusterId},
+		{Label: "Status", Value: node.Status()},
+		{Label: "AZ", Value: node.AvailabilityZone()},
+	}
+
+	if endpoint := node.Endpoint(); endpoint != "" {
+		fields = append(fields, render.SummaryField{Label: "Endpoint", Value: endpoint})
+	}
+
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *NodeRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	node, ok := resource.(*NodeResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if node.ReplicationGroupId != "" {
+		navs = append(navs, render.Navigation{
+			Key: "s", Label: "Shards", Service: "elasticache", Resource: "shards",
+			FilterField: "ReplicationGroupId", FilterValue: node.ReplicationGroupId,
+		})
+	}
+	return navs
+}
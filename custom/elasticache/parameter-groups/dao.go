@@ -0,0 +1,122 @@
+package parametergroups
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ParameterGroupDAO provides data access for ElastiCache cache parameter groups
+type ParameterGroupDAO struct {
+	dao.BaseDAO
+	client *elasticache.Client
+}
+
+// NewParameterGroupDAO creates a new ParameterGroupDAO
+func NewParameterGroupDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticache.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ParameterGroupDAO{
+		BaseDAO: dao.NewBaseDAO("elasticache", "parameter-groups"),
+		client:  client,
+	}, nil
+}
+
+// List returns all cache parameter groups, default ones included
+func (d *ParameterGroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	groups, err := appaws.Paginate(ctx, func(token *string) ([]types.CacheParameterGroup, *string, error) {
+		output, err := d.client.DescribeCacheParameterGroups(ctx, &elasticache.DescribeCacheParameterGroupsInput{
+			Marker:     token,
+			MaxRecords: appaws.Int32Ptr(100),
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe cache parameter groups")
+		}
+		return output.CacheParameterGroups, output.Marker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(groups))
+	for _, group := range groups {
+		resources = append(resources, NewParameterGroupResource(group))
+	}
+	return resources, nil
+}
+
+// Get returns a cache parameter group by name
+func (d *ParameterGroupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeCacheParameterGroups(ctx, &elasticache.DescribeCacheParameterGroupsInput{
+		CacheParameterGroupName: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe cache parameter group %s", id)
+	}
+	if len(output.CacheParameterGroups) == 0 {
+		return nil, fmt.Errorf("cache parameter group %s not found", id)
+	}
+	return NewParameterGroupResource(output.CacheParameterGroups[0]), nil
+}
+
+// Delete deletes a cache parameter group. Default groups cannot be deleted.
+func (d *ParameterGroupDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteCacheParameterGroup(ctx, &elasticache.DeleteCacheParameterGroupInput{
+		CacheParameterGroupName: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete cache parameter group %s", id)
+	}
+	return nil
+}
+
+// ParameterGroupResource represents an ElastiCache cache parameter group
+type ParameterGroupResource struct {
+	dao.BaseResource
+	Item types.CacheParameterGroup
+}
+
+// NewParameterGroupResource creates a new ParameterGroupResource
+func NewParameterGroupResource(item types.CacheParameterGroup) *ParameterGroupResource {
+	name := appaws.Str(item.CacheParameterGroupName)
+	return &ParameterGroupResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(item.ARN),
+			Tags: make(map[string]string),
+			Data: item,
+		},
+		Item: item,
+	}
+}
+
+// Family returns the parameter group family, e.g. redis7
+func (r *ParameterGroupResource) Family() string {
+	return appaws.Str(r.Item.CacheParameterGroupFamily)
+}
+
+// Description returns the parameter group description
+func (r *ParameterGroupResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+// IsGlobal reports whether the group belongs to a global datastore
+func (r *ParameterGroupResource) IsGlobal() bool {
+	return appaws.Bool(r.Item.IsGlobal)
+}
+
+// IsDefault reports whether this is an AWS-managed default group, which
+// cannot be modified or deleted
+func (r *ParameterGroupResource) IsDefault() bool {
+	return strings.HasPrefix(r.GetID(), "default.")
+}
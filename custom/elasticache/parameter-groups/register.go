@@ -0,0 +1,20 @@
+package parametergroups
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("elasticache", "parameter-groups", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewParameterGroupDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewParameterGroupRenderer()
+		},
+	})
+}
@@ -0,0 +1,107 @@
+package parametergroups
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure ParameterGroupRenderer implements render.Navigator
+var _ render.Navigator = (*ParameterGroupRenderer)(nil)
+
+// ParameterGroupRenderer renders ElastiCache cache parameter groups
+type ParameterGroupRenderer struct {
+	render.BaseRenderer
+}
+
+// NewParameterGroupRenderer creates a new ParameterGroupRenderer
+func NewParameterGroupRenderer() *ParameterGroupRenderer {
+	return &ParameterGroupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elasticache",
+			Resource: "parameter-groups",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 40, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "FAMILY", Width: 14, Getter: getFamily},
+				{Name: "GLOBAL", Width: 8, Getter: getGlobal},
+				{Name: "DESCRIPTION", Width: 50, Getter: getDescription},
+			},
+		},
+	}
+}
+
+func getFamily(r dao.Resource) string {
+	if group, ok := r.(*ParameterGroupResource); ok {
+		return group.Family()
+	}
+	return ""
+}
+
+func getGlobal(r dao.Resource) string {
+	if group, ok := r.(*ParameterGroupResource); ok && group.IsGlobal() {
+		return "Yes"
+	}
+	return ""
+}
+
+func getDescription(r dao.Resource) string {
+	if group, ok := r.(*ParameterGroupResource); ok {
+		return group.Description()
+	}
+	return ""
+}
+
+// RenderDetail renders detailed parameter group information
+func (r *ParameterGroupRenderer) RenderDetail(resource dao.Resource) string {
+	group, ok := resource.(*ParameterGroupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ElastiCache Parameter Group", group.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", group.GetName())
+	d.Field("ARN", group.GetARN())
+	d.Field("Family", group.Family())
+	if desc := group.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+	if group.IsDefault() {
+		d.Field("Type", "Default (read-only)")
+	} else {
+		d.Field("Type", "Custom")
+	}
+	if group.IsGlobal() {
+		d.Field("Global Datastore", "Yes")
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ParameterGroupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	group, ok := resource.(*ParameterGroupResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: group.GetName()},
+		{Label: "Family", Value: group.Family()},
+		{Label: "Description", Value: group.Description()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *ParameterGroupRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	group, ok := resource.(*ParameterGroupResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{{
+		Key: "p", Label: "Parameters", Service: "elasticache", Resource: "parameters",
+		FilterField: "CacheParameterGroupName", FilterValue: group.GetName(),
+	}}
+}
@@ -0,0 +1,94 @@
+package parameters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	elasticacheClient "github.com/clawscli/claws/custom/elasticache"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for ElastiCache parameters
+	action.Global.Register("elasticache", "parameters", []action.Action{
+		{
+			Name:      "Modify Parameter",
+			Shortcut:  "M",
+			Type:      action.ActionTypeAPI,
+			Operation: "ModifyCacheParameterGroup",
+			Confirm:   action.ConfirmDangerous,
+			Input:     &action.InputSpec{Next: valueInput},
+			Filter: func(r dao.Resource) bool {
+				p, ok := r.(*ParameterResource)
+				return ok && p.IsModifiable() && !p.InDefaultGroup()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("elasticache", "parameters", executeParameterAction)
+}
+
+// valueInput prompts for the new value, showing the current one and what
+// the parameter accepts.
+func valueInput(_ context.Context, resource dao.Resource, values []string) (*action.InputSpec, error) {
+	p, ok := resource.(*ParameterResource)
+	if !ok || len(values) > 0 {
+		return nil, nil
+	}
+	placeholder := "current: " + p.Value()
+	if allowed := p.AllowedValues(); allowed != "" {
+		placeholder += " (allowed: " + allowed + ")"
+	}
+	return &action.InputSpec{Label: "New value for " + p.GetName(), Placeholder: placeholder}, nil
+}
+
+// executeParameterAction executes an action on an ElastiCache parameter
+func executeParameterAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "ModifyCacheParameterGroup":
+		return executeModifyParameter(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeModifyParameter(ctx context.Context, resource dao.Resource) action.ActionResult {
+	p, ok := resource.(*ParameterResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if !p.IsModifiable() || p.InDefaultGroup() {
+		return action.FailResult(fmt.Errorf("parameter %s cannot be modified in %s", p.GetName(), p.GroupName))
+	}
+
+	value := strings.TrimSpace(action.InputFromContext(ctx))
+	if value == "" {
+		return action.FailResult(fmt.Errorf("a new value is required"))
+	}
+
+	client, err := elasticacheClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := p.GetName()
+	_, err = client.ModifyCacheParameterGroup(ctx, &elasticache.ModifyCacheParameterGroupInput{
+		CacheParameterGroupName: &p.GroupName,
+		ParameterNameValues:     []types.ParameterNameValue{{ParameterName: &name, ParameterValue: &value}},
+	})
+	if err != nil {
+		return action.FailResult(fmt.Errorf("modify cache parameter group: %w", err))
+	}
+
+	msg := fmt.Sprintf("Set %s to %s in %s", name, value, p.GroupName)
+	if p.RequiresReboot() {
+		msg += "; reboot the clusters using this group to apply it"
+	}
+	return action.SuccessResult(msg)
+}
@@ -0,0 +1,166 @@
+package parameters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ParameterDAO provides data access for the parameters of a cache parameter group
+type ParameterDAO struct {
+	dao.BaseDAO
+	client *elasticache.Client
+}
+
+// NewParameterDAO creates a new ParameterDAO
+func NewParameterDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticache.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ParameterDAO{
+		BaseDAO: dao.NewBaseDAO("elasticache", "parameters"),
+		client:  client,
+	}, nil
+}
+
+// List returns the parameters of a group (requires CacheParameterGroupName filter)
+func (d *ParameterDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	group := dao.GetFilterFromContext(ctx, "CacheParameterGroupName")
+	if group == "" {
+		return nil, fmt.Errorf("CacheParameterGroupName filter required - navigate from a parameter group")
+	}
+
+	params, err := d.describeParameters(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(params))
+	for _, p := range params {
+		resources = append(resources, NewParameterResource(p, group))
+	}
+	return resources, nil
+}
+
+// Get returns a parameter of the group in the CacheParameterGroupName filter
+func (d *ParameterDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	group := dao.GetFilterFromContext(ctx, "CacheParameterGroupName")
+	if group == "" {
+		return nil, fmt.Errorf("CacheParameterGroupName filter required - navigate from a parameter group")
+	}
+
+	params, err := d.describeParameters(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range params {
+		if appaws.Str(p.ParameterName) == id {
+			return NewParameterResource(p, group), nil
+		}
+	}
+	return nil, fmt.Errorf("parameter %s not found in %s", id, group)
+}
+
+// Delete is not supported; parameters are reset by modifying the group.
+func (d *ParameterDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for cache parameters - modify the parameter group instead")
+}
+
+func (d *ParameterDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *ParameterDAO) describeParameters(ctx context.Context, group string) ([]types.Parameter, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.Parameter, *string, error) {
+		output, err := d.client.DescribeCacheParameters(ctx, &elasticache.DescribeCacheParametersInput{
+			CacheParameterGroupName: &group,
+			Marker:                  token,
+			MaxRecords:              appaws.Int32Ptr(100),
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe cache parameters %s", group)
+		}
+		return output.Parameters, output.Marker, nil
+	})
+}
+
+// ParameterResource represents one parameter of a cache parameter group
+type ParameterResource struct {
+	dao.BaseResource
+	Item      types.Parameter
+	GroupName string
+}
+
+// NewParameterResource creates a new ParameterResource
+func NewParameterResource(p types.Parameter, group string) *ParameterResource {
+	name := appaws.Str(p.ParameterName)
+	return &ParameterResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Tags: make(map[string]string),
+			Data: p,
+		},
+		Item:      p,
+		GroupName: group,
+	}
+}
+
+// Value returns the parameter's current value
+func (r *ParameterResource) Value() string {
+	return appaws.Str(r.Item.ParameterValue)
+}
+
+// Source returns where the value comes from: system or user
+func (r *ParameterResource) Source() string {
+	return appaws.Str(r.Item.Source)
+}
+
+// DataType returns the parameter's data type, e.g. integer or string
+func (r *ParameterResource) DataType() string {
+	return appaws.Str(r.Item.DataType)
+}
+
+// AllowedValues returns the valid range or values for the parameter
+func (r *ParameterResource) AllowedValues() string {
+	return appaws.Str(r.Item.AllowedValues)
+}
+
+// Description returns the parameter description
+func (r *ParameterResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+// MinimumEngineVersion returns the earliest engine version supporting the parameter
+func (r *ParameterResource) MinimumEngineVersion() string {
+	return appaws.Str(r.Item.MinimumEngineVersion)
+}
+
+// IsModifiable reports whether the parameter can be changed
+func (r *ParameterResource) IsModifiable() bool {
+	return appaws.Bool(r.Item.IsModifiable)
+}
+
+// RequiresReboot reports whether a change only applies after the nodes reboot
+func (r *ParameterResource) RequiresReboot() bool {
+	return r.Item.ChangeType == types.ChangeTypeRequiresReboot
+}
+
+// InDefaultGroup reports whether the parameter belongs to an AWS-managed
+// default group, which cannot be modified
+func (r *ParameterResource) InDefaultGroup() bool {
+	return strings.HasPrefix(r.GroupName, "default.")
+}
@@ -0,0 +1,138 @@
+package parameters
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ParameterRenderer renders the parameters of a cache parameter group
+type ParameterRenderer struct {
+	render.BaseRenderer
+}
+
+// NewParameterRenderer creates a new ParameterRenderer
+func NewParameterRenderer() *ParameterRenderer {
+	return &ParameterRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elasticache",
+			Resource: "parameters",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 36, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "VALUE", Width: 24, Getter: getValue},
+				{Name: "SOURCE", Width: 8, Getter: getSource},
+				{Name: "TYPE", Width: 10, Getter: getDataType},
+				{Name: "MODIFIABLE", Width: 10, Getter: getModifiable},
+				{Name: "APPLY", Width: 8, Getter: getApply},
+				{Name: "ALLOWED VALUES", Width: 30, Getter: getAllowedValues, Priority: 3},
+			},
+		},
+	}
+}
+
+func getValue(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return p.Value()
+	}
+	return ""
+}
+
+func getSource(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return p.Source()
+	}
+	return ""
+}
+
+func getDataType(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return p.DataType()
+	}
+	return ""
+}
+
+func getModifiable(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok && p.IsModifiable() {
+		return "Yes"
+	}
+	return "No"
+}
+
+func getApply(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return applyType(p)
+	}
+	return ""
+}
+
+func getAllowedValues(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return p.AllowedValues()
+	}
+	return ""
+}
+
+// applyType says when a change to the parameter takes effect
+func applyType(p *ParameterResource) string {
+	if p.RequiresReboot() {
+		return "reboot"
+	}
+	return "dynamic"
+}
+
+// RenderDetail renders detailed parameter information
+func (r *ParameterRenderer) RenderDetail(resource dao.Resource) string {
+	p, ok := resource.(*ParameterResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ElastiCache Parameter", p.GroupName+"/"+p.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", p.GetName())
+	d.Field("Parameter Group", p.GroupName)
+	d.Field("Value", p.Value())
+	d.Field("Source", p.Source())
+	if desc := p.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+
+	d.Section("Constraints")
+	d.Field("Data Type", p.DataType())
+	if allowed := p.AllowedValues(); allowed != "" {
+		d.Field("Allowed Values", allowed)
+	}
+	if p.IsModifiable() && !p.InDefaultGroup() {
+		d.FieldStyled("Modifiable", "Yes", ui.SuccessStyle())
+	} else {
+		d.Field("Modifiable", "No")
+	}
+	if p.RequiresReboot() {
+		d.FieldStyled("Apply", "Requires reboot", ui.WarningStyle())
+	} else {
+		d.Field("Apply", "Immediately")
+	}
+	if v := p.MinimumEngineVersion(); v != "" {
+		d.Field("Minimum Engine Version", v)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ParameterRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	p, ok := resource.(*ParameterResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: p.GetName()},
+		{Label: "Group", Value: p.GroupName},
+		{Label: "Value", Value: p.Value()},
+		{Label: "Apply", Value: applyType(p)},
+	}
+}
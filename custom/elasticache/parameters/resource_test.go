@@ -0,0 +1,61 @@
+package parameters
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/clawscli/claws/internal/action"
+)
+
+func TestParameterResource(t *testing.T) {
+	p := NewParameterResource(types.Parameter{
+		ParameterName:  aws.String("maxmemory-policy"),
+		ParameterValue: aws.String("volatile-lru"),
+		AllowedValues:  aws.String("volatile-lru,allkeys-lru,noeviction"),
+		IsModifiable:   aws.Bool(true),
+		ChangeType:     types.ChangeTypeImmediate,
+	}, "app-redis7")
+
+	if p.GetID() != "maxmemory-policy" || p.Value() != "volatile-lru" || p.GroupName != "app-redis7" {
+		t.Errorf("id=%q value=%q group=%q", p.GetID(), p.Value(), p.GroupName)
+	}
+	if p.RequiresReboot() || p.InDefaultGroup() || applyType(p) != "dynamic" {
+		t.Errorf("reboot=%v default=%v apply=%q", p.RequiresReboot(), p.InDefaultGroup(), applyType(p))
+	}
+
+	spec, err := valueInput(context.Background(), p, nil)
+	if err != nil || spec == nil || !strings.Contains(spec.Placeholder, "current: volatile-lru") || !strings.Contains(spec.Placeholder, "noeviction") {
+		t.Errorf("valueInput() = %+v, %v", spec, err)
+	}
+	if spec, _ := valueInput(context.Background(), p, []string{"allkeys-lru"}); spec != nil {
+		t.Error("valueInput() should end the chain after one value")
+	}
+}
+
+func TestModifyParameterFilter(t *testing.T) {
+	var modify action.Action
+	for _, act := range action.Global.Get("elasticache", "parameters") {
+		if act.Operation == "ModifyCacheParameterGroup" {
+			modify = act
+		}
+	}
+	if modify.Confirm != action.ConfirmDangerous {
+		t.Fatalf("Modify Parameter confirm = %v, want dangerous", modify.Confirm)
+	}
+
+	param := types.Parameter{ParameterName: aws.String("timeout"), IsModifiable: aws.Bool(true)}
+	if !modify.Filter(NewParameterResource(param, "app-redis7")) {
+		t.Error("modifiable parameter of a custom group should offer Modify Parameter")
+	}
+	if modify.Filter(NewParameterResource(param, "default.redis7")) {
+		t.Error("default groups are read-only")
+	}
+	param.IsModifiable = aws.Bool(false)
+	if modify.Filter(NewParameterResource(param, "app-redis7")) {
+		t.Error("non-modifiable parameter should not offer Modify Parameter")
+	}
+}
@@ -0,0 +1,158 @@
+package shards
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ShardDAO provides data access for ElastiCache replication group shards (node groups)
+type ShardDAO struct {
+	dao.BaseDAO
+	client *elasticache.Client
+}
+
+// NewShardDAO creates a new ShardDAO
+func NewShardDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticache.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ShardDAO{
+		BaseDAO: dao.NewBaseDAO("elasticache", "shards"),
+		client:  client,
+	}, nil
+}
+
+// List returns the shards of a replication group (requires ReplicationGroupId filter)
+func (d *ShardDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	group, err := d.describeReplicationGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(group.NodeGroups))
+	for _, ng := range group.NodeGroups {
+		resources = append(resources, NewShardResource(ng, group))
+	}
+	return resources, nil
+}
+
+// Get returns a specific shard by node group ID
+func (d *ShardDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	group, err := d.describeReplicationGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ng := range group.NodeGroups {
+		if appaws.Str(ng.NodeGroupId) == id {
+			return NewShardResource(ng, group), nil
+		}
+	}
+	return nil, fmt.Errorf("shard %s not found", id)
+}
+
+// Delete is not supported; shards are removed by resharding the replication group.
+func (d *ShardDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for shards - reshard the replication group instead")
+}
+
+func (d *ShardDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *ShardDAO) describeReplicationGroup(ctx context.Context) (types.ReplicationGroup, error) {
+	groupID := dao.GetFilterFromContext(ctx, "ReplicationGroupId")
+	if groupID == "" {
+		return types.ReplicationGroup{}, fmt.Errorf("ReplicationGroupId filter required - navigate from a cluster")
+	}
+
+	output, err := d.client.DescribeReplicationGroups(ctx, &elasticache.DescribeReplicationGroupsInput{
+		ReplicationGroupId: &groupID,
+	})
+	if err != nil {
+		return types.ReplicationGroup{}, apperrors.Wrapf(err, "describe replication group %s", groupID)
+	}
+	if len(output.ReplicationGroups) == 0 {
+		return types.ReplicationGroup{}, fmt.Errorf("replication group %s not found", groupID)
+	}
+	return output.ReplicationGroups[0], nil
+}
+
+// ShardResource represents a shard (node group) of an ElastiCache replication group
+type ShardResource struct {
+	dao.BaseResource
+	Item               types.NodeGroup
+	ReplicationGroupId string
+	ClusterMode        string
+}
+
+// NewShardResource creates a new ShardResource
+func NewShardResource(ng types.NodeGroup, group types.ReplicationGroup) *ShardResource {
+	shardID := appaws.Str(ng.NodeGroupId)
+	return &ShardResource{
+		BaseResource: dao.BaseResource{
+			ID:   shardID,
+			Name: shardID,
+			Tags: make(map[string]string),
+			Data: ng,
+		},
+		Item:               ng,
+		ReplicationGroupId: appaws.Str(group.ReplicationGroupId),
+		ClusterMode:        string(group.ClusterMode),
+	}
+}
+
+// Status returns the shard status
+func (r *ShardResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// Slots returns the keyspace slot range served by the shard
+func (r *ShardResource) Slots() string {
+	return appaws.Str(r.Item.Slots)
+}
+
+// NumMembers returns the number of nodes in the shard
+func (r *ShardResource) NumMembers() int {
+	return len(r.Item.NodeGroupMembers)
+}
+
+// Primary returns the cluster ID of the shard's primary node
+func (r *ShardResource) Primary() string {
+	for _, m := range r.Item.NodeGroupMembers {
+		if appaws.Str(m.CurrentRole) == "primary" {
+			return appaws.Str(m.CacheClusterId)
+		}
+	}
+	return ""
+}
+
+// PrimaryEndpoint returns the primary endpoint as host:port
+func (r *ShardResource) PrimaryEndpoint() string {
+	return formatEndpoint(r.Item.PrimaryEndpoint)
+}
+
+// ReaderEndpoint returns the reader endpoint as host:port
+func (r *ShardResource) ReaderEndpoint() string {
+	return formatEndpoint(r.Item.ReaderEndpoint)
+}
+
+func formatEndpoint(ep *types.Endpoint) string {
+	if ep == nil || ep.Address == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", appaws.Str(ep.Address), appaws.Int32(ep.Port))
+}
@@ -0,0 +1,20 @@
+package shards
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("elasticache", "shards", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewShardDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewShardRenderer()
+		},
+	})
+}
@@ -0,0 +1,161 @@
+package shards
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ShardRenderer renders ElastiCache replication group shards
+// Ensure ShardRenderer implements render.Navigator
+var _ render.Navigator = (*ShardRenderer)(nil)
+
+type ShardRenderer struct {
+	render.BaseRenderer
+}
+
+// NewShardRenderer creates a new ShardRenderer
+func NewShardRenderer() *ShardRenderer {
+	return &ShardRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elasticache",
+			Resource: "shards",
+			Cols: []render.Column{
+				{Name: "SHARD ID", Width: 10, Getter: getShardId},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "SLOTS", Width: 14, Getter: getSlots},
+				{Name: "NODES", Width: 6, Getter: getNumMembers},
+				{Name: "PRIMARY", Width: 32, Getter: getPrimary},
+			},
+		},
+	}
+}
+
+func getShardId(r dao.Resource) string {
+	return r.GetID()
+}
+
+func getStatus(r dao.Resource) string {
+	if shard, ok := r.(*ShardResource); ok {
+		return shard.Status()
+	}
+	return ""
+}
+
+func getSlots(r dao.Resource) string {
+	if shard, ok := r.(*ShardResource); ok {
+		return shard.Slots()
+	}
+	return ""
+}
+
+func getNumMembers(r dao.Resource) string {
+	if shard, ok := r.(*ShardResource); ok {
+		return fmt.Sprintf("%d", shard.NumMembers())
+	}
+	return ""
+}
+
+func getPrimary(r dao.Resource) string {
+	if shard, ok := r.(*ShardResource); ok {
+		return shard.Primary()
+	}
+	return ""
+}
+
+// RenderDetail renders detailed shard information
+func (r *ShardRenderer) RenderDetail(resource dao.Resource) string {
+	shard, ok := resource.(*ShardResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ElastiCache Shard", shard.ReplicationGroupId+"/"+shard.GetID())
+
+	// Basic Info
+	d.Section("Basic Information")
+	d.Field("Shard ID", shard.GetID())
+	d.Field("Replication Group", shard.ReplicationGroupId)
+	d.Field("Status", shard.Status())
+	if shard.ClusterMode != "" {
+		d.Field("Cluster Mode", shard.ClusterMode)
+	}
+	if slots := shard.Slots(); slots != "" {
+		d.Field("Slots", slots)
+	}
+
+	// Endpoints
+	if primary, reader := shard.PrimaryEndpoint(), shard.ReaderEndpoint(); primary != "" || reader != "" {
+		d.Section("Endpoints")
+		if primary != "" {
+			d.Field("Primary", primary)
+		}
+		if reader != "" {
+			d.Field("Reader", reader)
+		}
+	}
+
+	// Members
+	if len(shard.Item.NodeGroupMembers) > 0 {
+		d.Section("Members")
+		for _, m := range shard.Item.NodeGroupMembers {
+			label := fmt.Sprintf("%s/%s", appaws.Str(m.CacheClusterId), appaws.Str(m.CacheNodeId))
+			value := fmt.Sprintf("%s (%s)", appaws.Str(m.CurrentRole), appaws.Str(m.PreferredAvailabilityZone))
+			if appaws.Str(m.CurrentRole) == "primary" {
+				d.FieldStyled(label, value, ui.SuccessStyle())
+			} else {
+				d.Field(label, value)
+			}
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ShardRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	shard, ok := resource.(*ShardResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Shard ID", Value: shard.GetID()},
+		{Label: "Replication Group", Value: shard.ReplicationGroupId},
+		{Label: "Status", Value: shard.Status()},
+		{Label: "Nodes", Value: fmt.Sprintf("%d", shard.NumMembers())},
+	}
+
+	if primary := shard.Primary(); primary != "" {
+		fields = append(fields, render.SummaryField{Label: "Primary", Value: primary})
+	}
+
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *ShardRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	shard, ok := resource.(*ShardResource)
+	if !ok {
+		return nil
+	}
+
+	navs := []render.Navigation{
+		{
+			Key: "c", Label: "Clusters", Service: "elasticache", Resource: "clusters",
+			FilterField: "ReplicationGroupId", FilterValue: shard.ReplicationGroupId,
+		},
+	}
+	if primary := shard.Primary(); primary != "" {
+		navs = append(navs, render.Navigation{
+			Key: "n", Label: "Primary Nodes", Service: "elasticache", Resource: "nodes",
+			FilterField: "CacheClusterId", FilterValue: primary,
+		})
+	}
+	return navs
+}
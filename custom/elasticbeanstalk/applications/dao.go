@@ -0,0 +1,115 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ApplicationDAO provides data access for Elastic Beanstalk applications.
+type ApplicationDAO struct {
+	dao.BaseDAO
+	client *elasticbeanstalk.Client
+}
+
+// NewApplicationDAO creates a new ApplicationDAO.
+func NewApplicationDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticbeanstalk.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ApplicationDAO{
+		BaseDAO: dao.NewBaseDAO("elasticbeanstalk", "applications"),
+		client:  client,
+	}, nil
+}
+
+// List returns all Elastic Beanstalk applications.
+func (d *ApplicationDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	output, err := d.client.DescribeApplications(ctx, &elasticbeanstalk.DescribeApplicationsInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "describe elastic beanstalk applications")
+	}
+
+	resources := make([]dao.Resource, len(output.Applications))
+	for i, app := range output.Applications {
+		resources[i] = NewApplicationResource(app)
+	}
+	return resources, nil
+}
+
+// Get returns a specific application by name.
+func (d *ApplicationDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeApplications(ctx, &elasticbeanstalk.DescribeApplicationsInput{
+		ApplicationNames: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe elastic beanstalk application %s", id)
+	}
+	if len(output.Applications) == 0 {
+		return nil, fmt.Errorf("application not found: %s", id)
+	}
+	return NewApplicationResource(output.Applications[0]), nil
+}
+
+// Delete deletes an application by name.
+func (d *ApplicationDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteApplication(ctx, &elasticbeanstalk.DeleteApplicationInput{
+		ApplicationName: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete elastic beanstalk application %s", id)
+	}
+	return nil
+}
+
+// ApplicationResource wraps an Elastic Beanstalk application.
+type ApplicationResource struct {
+	dao.BaseResource
+	Item types.ApplicationDescription
+}
+
+// NewApplicationResource creates a new ApplicationResource.
+func NewApplicationResource(app types.ApplicationDescription) *ApplicationResource {
+	return &ApplicationResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(app.ApplicationName),
+			Name: appaws.Str(app.ApplicationName),
+			ARN:  appaws.Str(app.ApplicationArn),
+			Data: app,
+		},
+		Item: app,
+	}
+}
+
+// Description returns the application description.
+func (r *ApplicationResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+// Versions returns the application's version labels.
+func (r *ApplicationResource) Versions() []string {
+	return r.Item.Versions
+}
+
+// ConfigurationTemplates returns the saved configuration template names.
+func (r *ApplicationResource) ConfigurationTemplates() []string {
+	return r.Item.ConfigurationTemplates
+}
+
+// CreatedAt returns when the application was created.
+func (r *ApplicationResource) CreatedAt() *time.Time {
+	return r.Item.DateCreated
+}
+
+// UpdatedAt returns when the application was last updated.
+func (r *ApplicationResource) UpdatedAt() *time.Time {
+	return r.Item.DateUpdated
+}
@@ -0,0 +1,20 @@
+package applications
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("elasticbeanstalk", "applications", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewApplicationDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewApplicationRenderer()
+		},
+	})
+}
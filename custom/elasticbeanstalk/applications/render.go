@@ -0,0 +1,153 @@
+package applications
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure ApplicationRenderer implements render.Navigator
+var _ render.Navigator = (*ApplicationRenderer)(nil)
+
+// ApplicationRenderer renders Elastic Beanstalk applications.
+type ApplicationRenderer struct {
+	render.BaseRenderer
+}
+
+// NewApplicationRenderer creates a new ApplicationRenderer.
+func NewApplicationRenderer() render.Renderer {
+	return &ApplicationRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elasticbeanstalk",
+			Resource: "applications",
+			Cols: []render.Column{
+				{Name: "APPLICATION", Width: 30, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "VERSIONS", Width: 9, Getter: getVersionCount},
+				{Name: "DESCRIPTION", Width: 40, Getter: getDescription},
+				{Name: "UPDATED", Width: 18, Getter: getUpdated},
+			},
+		},
+	}
+}
+
+func getVersionCount(r dao.Resource) string {
+	app, ok := r.(*ApplicationResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", len(app.Versions()))
+}
+
+func getDescription(r dao.Resource) string {
+	app, ok := r.(*ApplicationResource)
+	if !ok {
+		return ""
+	}
+	return app.Description()
+}
+
+func getUpdated(r dao.Resource) string {
+	app, ok := r.(*ApplicationResource)
+	if !ok {
+		return ""
+	}
+	if t := app.UpdatedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// RenderDetail renders the detail view for an Elastic Beanstalk application.
+func (r *ApplicationRenderer) RenderDetail(resource dao.Resource) string {
+	app, ok := resource.(*ApplicationResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Elastic Beanstalk Application", app.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", app.GetName())
+	d.Field("ARN", app.GetARN())
+	if desc := app.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+
+	if versions := app.Versions(); len(versions) > 0 {
+		d.Section(fmt.Sprintf("Versions (%d)", len(versions)))
+		shown := versions
+		if len(shown) > 10 {
+			shown = shown[:10]
+		}
+		for _, v := range shown {
+			d.Line("  " + v)
+		}
+		if len(versions) > len(shown) {
+			d.Line(fmt.Sprintf("  ... and %d more", len(versions)-len(shown)))
+		}
+	}
+
+	if templates := app.ConfigurationTemplates(); len(templates) > 0 {
+		d.Section("Configuration Templates")
+		d.Field("Templates", strings.Join(templates, ", "))
+	}
+
+	d.Section("Timestamps")
+	if t := app.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := app.UpdatedAt(); t != nil {
+		d.Field("Updated", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an Elastic Beanstalk application.
+func (r *ApplicationRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	app, ok := resource.(*ApplicationResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Application", Value: app.GetName()},
+		{Label: "ARN", Value: app.GetARN()},
+		{Label: "Versions", Value: fmt.Sprintf("%d", len(app.Versions()))},
+	}
+	if desc := app.Description(); desc != "" {
+		fields = append(fields, render.SummaryField{Label: "Description", Value: desc})
+	}
+	return fields
+}
+
+// Navigations returns available navigations from an Elastic Beanstalk application.
+func (r *ApplicationRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	app, ok := resource.(*ApplicationResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "e",
+			Label:       "Environments",
+			Service:     "elasticbeanstalk",
+			Resource:    "environments",
+			FilterField: "ApplicationName",
+			FilterValue: app.GetName(),
+		},
+		{
+			Key:         "v",
+			Label:       "Events",
+			Service:     "elasticbeanstalk",
+			Resource:    "events",
+			FilterField: "ApplicationName",
+			FilterValue: app.GetName(),
+			AutoReload:  true,
+		},
+	}
+}
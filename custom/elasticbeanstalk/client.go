@@ -0,0 +1,14 @@
+package elasticbeanstalk
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an Elastic Beanstalk client configured for the current context
+func GetClient(ctx context.Context) (*elasticbeanstalk.Client, error) {
+	return appaws.Client(ctx, elasticbeanstalk.NewFromConfig)
+}
@@ -0,0 +1,153 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+
+	ebClient "github.com/clawscli/claws/custom/elasticbeanstalk"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Elastic Beanstalk environments
+	action.Global.Register("elasticbeanstalk", "environments", []action.Action{
+		{
+			Name:      "Restart App Servers",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RestartAppServer",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				env, ok := dao.UnwrapResource(r).(*EnvironmentResource)
+				return ok && env.IsReady()
+			},
+		},
+		{
+			Name:      "Swap CNAMEs",
+			Shortcut:  "W",
+			Type:      action.ActionTypeAPI,
+			Operation: "SwapEnvironmentCNAMEs",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				env, ok := dao.UnwrapResource(r).(*EnvironmentResource)
+				return ok && env.CanSwapCNAME()
+			},
+			Input: &action.InputSpec{
+				Label:   "Swap CNAME with environment",
+				Choices: swapTargetChoices,
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("elasticbeanstalk", "environments", executeEnvironmentAction)
+}
+
+// executeEnvironmentAction executes an action on an Elastic Beanstalk environment
+func executeEnvironmentAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RestartAppServer":
+		return executeRestartAppServer(ctx, resource)
+	case "SwapEnvironmentCNAMEs":
+		return executeSwapCNAMEs(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeRestartAppServer(ctx context.Context, resource dao.Resource) action.ActionResult {
+	env, ok := dao.UnwrapResource(resource).(*EnvironmentResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := ebClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := env.GetName()
+	if _, err := client.RestartAppServer(ctx, &elasticbeanstalk.RestartAppServerInput{EnvironmentName: &name}); err != nil {
+		return action.FailResult(fmt.Errorf("restart app server: %w", err))
+	}
+	return action.SuccessResult(fmt.Sprintf("Restarting app servers in %s", name))
+}
+
+func executeSwapCNAMEs(ctx context.Context, resource dao.Resource) action.ActionResult {
+	env, ok := dao.UnwrapResource(resource).(*EnvironmentResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	target := action.InputFromContext(ctx)
+	if target == "" {
+		return action.FailResult(fmt.Errorf("no target environment given"))
+	}
+	source := env.GetName()
+	if target == source {
+		return action.FailResult(fmt.Errorf("cannot swap %s with itself", source))
+	}
+
+	client, err := ebClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.SwapEnvironmentCNAMEs(ctx, &elasticbeanstalk.SwapEnvironmentCNAMEsInput{
+		SourceEnvironmentName:      &source,
+		DestinationEnvironmentName: &target,
+	})
+	if err != nil {
+		return action.FailResult(fmt.Errorf("swap environment CNAMEs: %w", err))
+	}
+	return action.SuccessResult(fmt.Sprintf("Swapping CNAMEs of %s and %s", source, target))
+}
+
+// swapTargetChoices offers the other ready web environments of the same
+// application, since CNAMEs can only be swapped within one application.
+func swapTargetChoices(ctx context.Context, resource dao.Resource) ([]action.Choice, error) {
+	env, ok := dao.UnwrapResource(resource).(*EnvironmentResource)
+	if !ok {
+		return nil, fmt.Errorf("not an Elastic Beanstalk environment")
+	}
+
+	client, err := ebClient.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appName := env.ApplicationName()
+	output, err := client.DescribeEnvironments(ctx, &elasticbeanstalk.DescribeEnvironmentsInput{
+		ApplicationName: &appName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe environments: %w", err)
+	}
+
+	var candidates []*EnvironmentResource
+	for _, e := range output.Environments {
+		candidates = append(candidates, NewEnvironmentResource(e))
+	}
+	choices := swapTargets(env, candidates)
+	if len(choices) == 0 {
+		return nil, fmt.Errorf("no other ready environment in %s to swap with", appName)
+	}
+	return choices, nil
+}
+
+func swapTargets(source *EnvironmentResource, candidates []*EnvironmentResource) []action.Choice {
+	var choices []action.Choice
+	for _, c := range candidates {
+		if c.GetName() == source.GetName() || !c.CanSwapCNAME() {
+			continue
+		}
+		choices = append(choices, action.Choice{
+			Value: c.GetName(),
+			Label: fmt.Sprintf("%s (%s, %s)", c.GetName(), c.CNAME(), c.VersionLabel()),
+		})
+	}
+	return choices
+}
@@ -0,0 +1,228 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// EnvironmentDAO provides data access for Elastic Beanstalk environments.
+type EnvironmentDAO struct {
+	dao.BaseDAO
+	client *elasticbeanstalk.Client
+}
+
+// NewEnvironmentDAO creates a new EnvironmentDAO.
+func NewEnvironmentDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticbeanstalk.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EnvironmentDAO{
+		BaseDAO: dao.NewBaseDAO("elasticbeanstalk", "environments"),
+		client:  client,
+	}, nil
+}
+
+// List returns environments, narrowed to one application by the
+// ApplicationName filter.
+func (d *EnvironmentDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var appName *string
+	if name := dao.GetFilterFromContext(ctx, "ApplicationName"); name != "" {
+		appName = &name
+	}
+
+	envs, err := appaws.Paginate(ctx, func(token *string) ([]types.EnvironmentDescription, *string, error) {
+		output, err := d.client.DescribeEnvironments(ctx, &elasticbeanstalk.DescribeEnvironmentsInput{
+			ApplicationName: appName,
+			NextToken:       token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe elastic beanstalk environments")
+		}
+		return output.Environments, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(envs))
+	for i, env := range envs {
+		resources[i] = NewEnvironmentResource(env)
+	}
+	return resources, nil
+}
+
+// Get returns an environment by name, with enhanced health details when the
+// environment reports them.
+func (d *EnvironmentDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeEnvironments(ctx, &elasticbeanstalk.DescribeEnvironmentsInput{
+		EnvironmentNames: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe elastic beanstalk environment %s", id)
+	}
+	if len(output.Environments) == 0 {
+		return nil, fmt.Errorf("environment not found: %s", id)
+	}
+
+	env := NewEnvironmentResource(output.Environments[0])
+	if env.EnhancedHealth() {
+		health, err := d.client.DescribeEnvironmentHealth(ctx, &elasticbeanstalk.DescribeEnvironmentHealthInput{
+			EnvironmentName: &id,
+			AttributeNames:  []types.EnvironmentHealthAttribute{types.EnvironmentHealthAttributeAll},
+		})
+		if err != nil {
+			log.Warn("failed to describe environment health", "environment", id, "error", err)
+		} else {
+			env.Health = health
+		}
+	}
+	return env, nil
+}
+
+// Delete terminates an environment by name.
+func (d *EnvironmentDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.TerminateEnvironment(ctx, &elasticbeanstalk.TerminateEnvironmentInput{
+		EnvironmentName: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "terminate elastic beanstalk environment %s", id)
+	}
+	return nil
+}
+
+// EnvironmentResource wraps an Elastic Beanstalk environment.
+type EnvironmentResource struct {
+	dao.BaseResource
+	Item types.EnvironmentDescription
+
+	// Health holds enhanced health details; only set by Get.
+	Health *elasticbeanstalk.DescribeEnvironmentHealthOutput
+}
+
+// NewEnvironmentResource creates a new EnvironmentResource.
+func NewEnvironmentResource(env types.EnvironmentDescription) *EnvironmentResource {
+	return &EnvironmentResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(env.EnvironmentName),
+			Name: appaws.Str(env.EnvironmentName),
+			ARN:  appaws.Str(env.EnvironmentArn),
+			Data: env,
+		},
+		Item: env,
+	}
+}
+
+// EnvironmentId returns the environment ID (e-xxxxxxxx).
+func (r *EnvironmentResource) EnvironmentId() string {
+	return appaws.Str(r.Item.EnvironmentId)
+}
+
+// ApplicationName returns the application the environment belongs to.
+func (r *EnvironmentResource) ApplicationName() string {
+	return appaws.Str(r.Item.ApplicationName)
+}
+
+// Status returns the environment's lifecycle status.
+func (r *EnvironmentResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// HealthColor returns the basic health color (Green, Yellow, Red, Grey).
+func (r *EnvironmentResource) HealthColor() string {
+	return string(r.Item.Health)
+}
+
+// HealthStatus returns the enhanced health status, or the health color when
+// enhanced health reporting is off.
+func (r *EnvironmentResource) HealthStatus() string {
+	if r.Item.HealthStatus != "" {
+		return string(r.Item.HealthStatus)
+	}
+	return r.HealthColor()
+}
+
+// EnhancedHealth reports whether the environment uses enhanced health reporting.
+func (r *EnvironmentResource) EnhancedHealth() bool {
+	return r.Item.HealthStatus != ""
+}
+
+// HealthCauses returns the reasons behind a degraded health status.
+func (r *EnvironmentResource) HealthCauses() []string {
+	if r.Health == nil {
+		return nil
+	}
+	return r.Health.Causes
+}
+
+// VersionLabel returns the deployed application version.
+func (r *EnvironmentResource) VersionLabel() string {
+	return appaws.Str(r.Item.VersionLabel)
+}
+
+// Platform returns the solution stack name, or the platform ARN's name.
+func (r *EnvironmentResource) Platform() string {
+	if stack := appaws.Str(r.Item.SolutionStackName); stack != "" {
+		return stack
+	}
+	return appaws.ExtractResourceName(appaws.Str(r.Item.PlatformArn))
+}
+
+// Tier returns the environment tier (WebServer or Worker).
+func (r *EnvironmentResource) Tier() string {
+	if r.Item.Tier != nil {
+		return appaws.Str(r.Item.Tier.Name)
+	}
+	return ""
+}
+
+// CNAME returns the environment's CNAME.
+func (r *EnvironmentResource) CNAME() string {
+	return appaws.Str(r.Item.CNAME)
+}
+
+// EndpointURL returns the load balancer or instance endpoint.
+func (r *EnvironmentResource) EndpointURL() string {
+	return appaws.Str(r.Item.EndpointURL)
+}
+
+// UpdatedAt returns when the environment was last updated.
+func (r *EnvironmentResource) UpdatedAt() *time.Time {
+	return r.Item.DateUpdated
+}
+
+// CreatedAt returns when the environment was created.
+func (r *EnvironmentResource) CreatedAt() *time.Time {
+	return r.Item.DateCreated
+}
+
+// IsReady reports whether the environment accepts new operations.
+func (r *EnvironmentResource) IsReady() bool {
+	return r.Item.Status == types.EnvironmentStatusReady
+}
+
+// IsTransitioning reports whether an operation is running on the environment.
+func (r *EnvironmentResource) IsTransitioning() bool {
+	switch r.Item.Status {
+	case types.EnvironmentStatusLaunching, types.EnvironmentStatusUpdating,
+		types.EnvironmentStatusTerminating, types.EnvironmentStatusAborting,
+		types.EnvironmentStatusLinkingFrom, types.EnvironmentStatusLinkingTo:
+		return true
+	}
+	return false
+}
+
+// CanSwapCNAME reports whether the environment is a ready web server whose
+// CNAME can be swapped.
+func (r *EnvironmentResource) CanSwapCNAME() bool {
+	return r.IsReady() && r.Tier() != "Worker" && r.CNAME() != ""
+}
@@ -0,0 +1,74 @@
+package environments
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+)
+
+func newEnv(name string, status types.EnvironmentStatus, tier string) *EnvironmentResource {
+	return NewEnvironmentResource(types.EnvironmentDescription{
+		EnvironmentName: aws.String(name),
+		ApplicationName: aws.String("shop"),
+		Status:          status,
+		CNAME:           aws.String(name + ".eu-west-1.elasticbeanstalk.com"),
+		VersionLabel:    aws.String("v42"),
+		Tier:            &types.EnvironmentTier{Name: aws.String(tier)},
+	})
+}
+
+func TestEnvironmentHealthStatus(t *testing.T) {
+	basic := NewEnvironmentResource(types.EnvironmentDescription{Health: types.EnvironmentHealthYellow})
+	if basic.EnhancedHealth() || basic.HealthStatus() != "Yellow" {
+		t.Errorf("basic health: enhanced=%v status=%q", basic.EnhancedHealth(), basic.HealthStatus())
+	}
+
+	enhanced := NewEnvironmentResource(types.EnvironmentDescription{
+		Health:       types.EnvironmentHealthRed,
+		HealthStatus: types.EnvironmentHealthStatusSevere,
+	})
+	if !enhanced.EnhancedHealth() || enhanced.HealthStatus() != "Severe" || enhanced.HealthColor() != "Red" {
+		t.Errorf("enhanced health: enhanced=%v status=%q color=%q", enhanced.EnhancedHealth(), enhanced.HealthStatus(), enhanced.HealthColor())
+	}
+}
+
+func TestEnvironmentState(t *testing.T) {
+	tests := []struct {
+		status        types.EnvironmentStatus
+		tier          string
+		ready         bool
+		transitioning bool
+		canSwap       bool
+	}{
+		{types.EnvironmentStatusReady, "WebServer", true, false, true},
+		{types.EnvironmentStatusReady, "Worker", true, false, false},
+		{types.EnvironmentStatusUpdating, "WebServer", false, true, false},
+		{types.EnvironmentStatusLaunching, "WebServer", false, true, false},
+		{types.EnvironmentStatusTerminated, "WebServer", false, false, false},
+	}
+	for _, tt := range tests {
+		env := newEnv("prod", tt.status, tt.tier)
+		if env.IsReady() != tt.ready || env.IsTransitioning() != tt.transitioning || env.CanSwapCNAME() != tt.canSwap {
+			t.Errorf("%s/%s: ready=%v transitioning=%v canSwap=%v", tt.status, tt.tier, env.IsReady(), env.IsTransitioning(), env.CanSwapCNAME())
+		}
+	}
+}
+
+func TestSwapTargets(t *testing.T) {
+	source := newEnv("prod-blue", types.EnvironmentStatusReady, "WebServer")
+	candidates := []*EnvironmentResource{
+		source,
+		newEnv("prod-green", types.EnvironmentStatusReady, "WebServer"),
+		newEnv("prod-staging", types.EnvironmentStatusUpdating, "WebServer"),
+		newEnv("prod-worker", types.EnvironmentStatusReady, "Worker"),
+	}
+
+	choices := swapTargets(source, candidates)
+	if len(choices) != 1 || choices[0].Value != "prod-green" {
+		t.Fatalf("swapTargets() = %+v, want only prod-green", choices)
+	}
+	if want := "prod-green (prod-green.eu-west-1.elasticbeanstalk.com, v42)"; choices[0].Label != want {
+		t.Errorf("label = %q, want %q", choices[0].Label, want)
+	}
+}
@@ -0,0 +1,20 @@
+package environments
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("elasticbeanstalk", "environments", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewEnvironmentDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewEnvironmentRenderer()
+		},
+	})
+}
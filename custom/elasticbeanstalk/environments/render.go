@@ -0,0 +1,241 @@
+package environments
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure EnvironmentRenderer implements render.Navigator
+var _ render.Navigator = (*EnvironmentRenderer)(nil)
+
+// EnvironmentRenderer renders Elastic Beanstalk environments.
+type EnvironmentRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEnvironmentRenderer creates a new EnvironmentRenderer.
+func NewEnvironmentRenderer() render.Renderer {
+	return &EnvironmentRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elasticbeanstalk",
+			Resource: "environments",
+			Cols: []render.Column{
+				{Name: "ENVIRONMENT", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "APPLICATION", Width: 22, Getter: getApplication},
+				{Name: "HEALTH", Width: 10, Getter: getHealth},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "VERSION", Width: 22, Getter: getVersion},
+				{Name: "TIER", Width: 10, Getter: getTier},
+				{Name: "UPDATED", Width: 18, Getter: getUpdated},
+			},
+		},
+	}
+}
+
+func getApplication(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	return env.ApplicationName()
+}
+
+func getHealth(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	return env.HealthStatus()
+}
+
+func getStatus(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	return env.Status()
+}
+
+func getVersion(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	return env.VersionLabel()
+}
+
+func getTier(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	return env.Tier()
+}
+
+func getUpdated(r dao.Resource) string {
+	env, ok := r.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+	if t := env.UpdatedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// healthStyle colors both enhanced health statuses and basic health colors.
+func healthStyle(health string) render.Style {
+	switch health {
+	case "Ok", "Green":
+		return ui.SuccessStyle()
+	case "Info":
+		return ui.InfoStyle()
+	case "Warning", "Yellow":
+		return ui.WarningStyle()
+	case "Degraded", "Severe", "Red":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an Elastic Beanstalk environment.
+func (r *EnvironmentRenderer) RenderDetail(resource dao.Resource) string {
+	env, ok := resource.(*EnvironmentResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Elastic Beanstalk Environment", env.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", env.GetName())
+	d.Field("Environment ID", env.EnvironmentId())
+	d.Field("ARN", env.GetARN())
+	d.Field("Application", env.ApplicationName())
+	d.Field("Status", env.Status())
+
+	d.Section("Health")
+	d.FieldStyled("Health", env.HealthStatus(), healthStyle(env.HealthStatus()))
+	if env.EnhancedHealth() {
+		d.FieldStyled("Color", env.HealthColor(), healthStyle(env.HealthColor()))
+	} else {
+		d.Field("Reporting", "Basic")
+	}
+	if h := env.Health; h != nil {
+		if ih := h.InstancesHealth; ih != nil {
+			d.Field("Instances", fmt.Sprintf("%d ok, %d warning, %d degraded, %d severe, %d pending",
+				deref(ih.Ok), deref(ih.Warning), deref(ih.Degraded), deref(ih.Severe), deref(ih.Pending)))
+		}
+		if m := h.ApplicationMetrics; m != nil && m.RequestCount > 0 {
+			d.Field("Requests", fmt.Sprintf("%d in last %ds", m.RequestCount, deref(m.Duration)))
+			if sc := m.StatusCodes; sc != nil {
+				d.Field("Status Codes", fmt.Sprintf("2xx %d, 3xx %d, 4xx %d, 5xx %d",
+					deref(sc.Status2xx), deref(sc.Status3xx), deref(sc.Status4xx), deref(sc.Status5xx)))
+			}
+		}
+	}
+	if causes := env.HealthCauses(); len(causes) > 0 {
+		d.Section("Health Causes")
+		for _, c := range causes {
+			d.Line("  " + c)
+		}
+	}
+
+	d.Section("Configuration")
+	d.Field("Platform", env.Platform())
+	d.Field("Tier", env.Tier())
+	if v := env.VersionLabel(); v != "" {
+		d.Field("Version", v)
+	}
+
+	d.Section("Endpoints")
+	if cname := env.CNAME(); cname != "" {
+		d.Field("CNAME", cname)
+	}
+	if url := env.EndpointURL(); url != "" {
+		d.Field("Endpoint", url)
+	}
+
+	d.Section("Timestamps")
+	if t := env.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := env.UpdatedAt(); t != nil {
+		d.Field("Updated", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+func deref(n *int32) int32 {
+	if n == nil {
+		return 0
+	}
+	return *n
+}
+
+// RenderSummary renders summary fields for an Elastic Beanstalk environment.
+func (r *EnvironmentRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	env, ok := resource.(*EnvironmentResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Environment", Value: env.GetName()},
+		{Label: "Application", Value: env.ApplicationName()},
+		{Label: "Health", Value: env.HealthStatus(), Style: healthStyle(env.HealthStatus())},
+		{Label: "Status", Value: env.Status()},
+	}
+	if v := env.VersionLabel(); v != "" {
+		fields = append(fields, render.SummaryField{Label: "Version", Value: v})
+	}
+	if cname := env.CNAME(); cname != "" {
+		fields = append(fields, render.SummaryField{Label: "CNAME", Value: cname})
+	}
+	return fields
+}
+
+// Navigations returns available navigations from an Elastic Beanstalk environment.
+func (r *EnvironmentRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	env, ok := resource.(*EnvironmentResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "e",
+			Label:       "Events",
+			Service:     "elasticbeanstalk",
+			Resource:    "events",
+			FilterField: "EnvironmentName",
+			FilterValue: env.GetName(),
+			AutoReload:  true,
+		},
+		{
+			Key:         "A",
+			Label:       "Application",
+			Service:     "elasticbeanstalk",
+			Resource:    "applications",
+			FilterField: "ApplicationName",
+			FilterValue: env.ApplicationName(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while an environment is launching,
+// updating or terminating
+func (r *EnvironmentRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if env, ok := dao.UnwrapResource(res).(*EnvironmentResource); ok && env.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// EventDAO provides data access for Elastic Beanstalk events.
+type EventDAO struct {
+	dao.BaseDAO
+	client *elasticbeanstalk.Client
+}
+
+// NewEventDAO creates a new EventDAO.
+func NewEventDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticbeanstalk.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EventDAO{
+		BaseDAO: dao.NewBaseDAO("elasticbeanstalk", "events"),
+		client:  client,
+	}, nil
+}
+
+// List returns the most recent events (first page only).
+// For paginated access, use ListPage instead.
+func (d *EventDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 100, "")
+	return resources, err
+}
+
+// ListPage returns a page of events, newest first. The ApplicationName and
+// EnvironmentName filters narrow the list; the WarningsOnly toggle drops
+// events below WARN severity.
+// Implements dao.PaginatedDAO interface.
+func (d *EventDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	maxRecords := int32(pageSize)
+	if maxRecords <= 0 || maxRecords > 1000 {
+		maxRecords = 1000 // AWS API max
+	}
+
+	input := &elasticbeanstalk.DescribeEventsInput{
+		MaxRecords: &maxRecords,
+	}
+	if app := dao.GetFilterFromContext(ctx, "ApplicationName"); app != "" {
+		input.ApplicationName = &app
+	}
+	if env := dao.GetFilterFromContext(ctx, "EnvironmentName"); env != "" {
+		input.EnvironmentName = &env
+	}
+	if dao.GetFilterFromContext(ctx, "WarningsOnly") == "true" {
+		input.Severity = types.EventSeverityWarn
+	}
+	if pageToken != "" {
+		input.NextToken = &pageToken
+	}
+
+	output, err := d.client.DescribeEvents(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, "describe elastic beanstalk events")
+	}
+
+	resources := make([]dao.Resource, len(output.Events))
+	for i, event := range output.Events {
+		resources[i] = NewEventResource(event)
+	}
+
+	nextToken := ""
+	if output.NextToken != nil {
+		nextToken = *output.NextToken
+	}
+	return resources, nextToken, nil
+}
+
+func (d *EventDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for elastic beanstalk events")
+}
+
+func (d *EventDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for elastic beanstalk events")
+}
+
+func (d *EventDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// EventResource wraps an Elastic Beanstalk event.
+type EventResource struct {
+	dao.BaseResource
+	Item types.EventDescription
+}
+
+// NewEventResource creates a new EventResource. Events have no ID of their
+// own, so one is derived from the timestamp and message.
+func NewEventResource(event types.EventDescription) *EventResource {
+	var ts int64
+	if event.EventDate != nil {
+		ts = event.EventDate.UnixNano()
+	}
+	h := fnv.New32a()
+	h.Write([]byte(appaws.Str(event.EnvironmentName) + appaws.Str(event.Message)))
+
+	return &EventResource{
+		BaseResource: dao.BaseResource{
+			ID:   fmt.Sprintf("%d-%08x", ts, h.Sum32()),
+			Name: appaws.Str(event.EnvironmentName),
+			Data: event,
+		},
+		Item: event,
+	}
+}
+
+// Severity returns the event severity.
+func (r *EventResource) Severity() string {
+	return string(r.Item.Severity)
+}
+
+// Message returns the event message.
+func (r *EventResource) Message() string {
+	return appaws.Str(r.Item.Message)
+}
+
+// ApplicationName returns the application the event belongs to.
+func (r *EventResource) ApplicationName() string {
+	return appaws.Str(r.Item.ApplicationName)
+}
+
+// EnvironmentName returns the environment the event belongs to, if any.
+func (r *EventResource) EnvironmentName() string {
+	return appaws.Str(r.Item.EnvironmentName)
+}
+
+// VersionLabel returns the application version the event refers to, if any.
+func (r *EventResource) VersionLabel() string {
+	return appaws.Str(r.Item.VersionLabel)
+}
+
+// EventDate returns when the event occurred.
+func (r *EventResource) EventDate() *time.Time {
+	return r.Item.EventDate
+}
@@ -0,0 +1,31 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+)
+
+func TestEventResourceID(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	event := func(env, msg string) types.EventDescription {
+		return types.EventDescription{EventDate: &at, EnvironmentName: aws.String(env), Message: aws.String(msg)}
+	}
+
+	a := NewEventResource(event("prod", "Environment update is starting."))
+	again := NewEventResource(event("prod", "Environment update is starting."))
+	other := NewEventResource(event("prod", "Deploying new version to instance(s)."))
+	otherEnv := NewEventResource(event("staging", "Environment update is starting."))
+
+	if a.GetID() != again.GetID() {
+		t.Errorf("IDs differ for the same event: %q vs %q", a.GetID(), again.GetID())
+	}
+	if a.GetID() == other.GetID() || a.GetID() == otherEnv.GetID() {
+		t.Errorf("events at the same time share an ID: %q", a.GetID())
+	}
+	if a.GetName() != "prod" {
+		t.Errorf("GetName() = %q, want prod", a.GetName())
+	}
+}
@@ -0,0 +1,20 @@
+package events
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("elasticbeanstalk", "events", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewEventDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewEventRenderer()
+		},
+	})
+}
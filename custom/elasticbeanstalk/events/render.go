@@ -0,0 +1,122 @@
+package events
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// EventRenderer renders Elastic Beanstalk events.
+type EventRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEventRenderer creates a new EventRenderer.
+func NewEventRenderer() render.Renderer {
+	return &EventRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elasticbeanstalk",
+			Resource: "events",
+			Cols: []render.Column{
+				{Name: "TIME", Width: 16, Getter: getTime, Priority: 0},
+				{Name: "SEVERITY", Width: 8, Getter: getSeverity, Priority: 1},
+				{Name: "ENVIRONMENT", Width: 24, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 3},
+				{Name: "MESSAGE", Width: 80, Getter: getMessage, Priority: 2},
+			},
+		},
+	}
+}
+
+func getTime(r dao.Resource) string {
+	e, ok := r.(*EventResource)
+	if !ok || e.EventDate() == nil {
+		return ""
+	}
+	return e.EventDate().Format("01-02 15:04:05")
+}
+
+func getSeverity(r dao.Resource) string {
+	e, ok := r.(*EventResource)
+	if !ok {
+		return ""
+	}
+	return e.Severity()
+}
+
+func getMessage(r dao.Resource) string {
+	e, ok := r.(*EventResource)
+	if !ok {
+		return ""
+	}
+	return e.Message()
+}
+
+func severityStyle(severity string) render.Style {
+	switch severity {
+	case "ERROR", "FATAL":
+		return ui.DangerStyle()
+	case "WARN":
+		return ui.WarningStyle()
+	case "TRACE", "DEBUG":
+		return ui.DimStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an Elastic Beanstalk event.
+func (r *EventRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*EventResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Elastic Beanstalk Event", e.Severity())
+
+	d.Section("Event Information")
+	if t := e.EventDate(); t != nil {
+		d.Field("Time", t.Format(time.RFC3339))
+	}
+	d.FieldStyled("Severity", e.Severity(), severityStyle(e.Severity()))
+	d.Field("Application", e.ApplicationName())
+	d.FieldIf("Environment", e.Item.EnvironmentName)
+	d.FieldIf("Version", e.Item.VersionLabel)
+	d.FieldIf("Template", e.Item.TemplateName)
+	d.FieldIf("Request ID", e.Item.RequestId)
+
+	d.Section("Message")
+	d.Line("  " + e.Message())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel.
+func (r *EventRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*EventResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Severity", Value: e.Severity(), Style: severityStyle(e.Severity())},
+		{Label: "Application", Value: e.ApplicationName()},
+	}
+	if env := e.EnvironmentName(); env != "" {
+		fields = append(fields, render.SummaryField{Label: "Environment", Value: env})
+	}
+	if t := e.EventDate(); t != nil {
+		fields = append(fields, render.SummaryField{Label: "Time", Value: t.Format("2006-01-02 15:04:05")})
+	}
+	return fields
+}
+
+// ListToggles returns the warnings-only toggle
+func (r *EventRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "w", ContextKey: "WarningsOnly", LabelOn: "warn+", LabelOff: "all"},
+	}
+}
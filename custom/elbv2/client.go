@@ -10,9 +10,5 @@ import (
 
 // GetClient returns an ELBv2 client configured for the current context
 func GetClient(ctx context.Context) (*elasticloadbalancingv2.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return elasticloadbalancingv2.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, elasticloadbalancingv2.NewFromConfig)
 }
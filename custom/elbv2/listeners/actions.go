@@ -0,0 +1,82 @@
+package listeners
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	appelbv2 "github.com/clawscli/claws/custom/elbv2"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("elbv2", "listeners", []action.Action{
+		appelbv2.ShiftTrafficAction(),
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteListener",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("elbv2", "listeners", executeListenerAction)
+}
+
+func executeListenerAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case appelbv2.ShiftTrafficOperation:
+		return executeShiftTraffic(ctx, resource)
+	case "DeleteListener":
+		return executeDeleteListener(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeShiftTraffic(ctx context.Context, resource dao.Resource) action.ActionResult {
+	l, ok := dao.UnwrapResource(resource).(*ListenerResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	actions, split, err := appelbv2.ShiftedActions(ctx, l)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := appelbv2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.ModifyListener(ctx, &elasticloadbalancingv2.ModifyListenerInput{
+		ListenerArn:    l.Item.ListenerArn,
+		DefaultActions: actions,
+	})
+	if err != nil {
+		return action.FailResultf(err, "modify listener %s", l.GetName())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Listener %s now forwards %s", l.GetName(), split))
+}
+
+func executeDeleteListener(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := appelbv2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	arn := resource.GetID()
+	_, err = client.DeleteListener(ctx, &elasticloadbalancingv2.DeleteListenerInput{
+		ListenerArn: &arn,
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete listener %s", resource.GetName())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deleted listener %s", resource.GetName()))
+}
@@ -0,0 +1,143 @@
+package listeners
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ListenerDAO provides data access for ELBv2 listeners
+type ListenerDAO struct {
+	dao.BaseDAO
+	client *elasticloadbalancingv2.Client
+}
+
+// NewListenerDAO creates a new ListenerDAO
+func NewListenerDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticloadbalancingv2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ListenerDAO{
+		BaseDAO: dao.NewBaseDAO("elbv2", "listeners"),
+		client:  client,
+	}, nil
+}
+
+// List returns the listeners of a load balancer (requires LoadBalancerArn filter)
+func (d *ListenerDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	lbArn := dao.GetFilterFromContext(ctx, "LoadBalancerArn")
+	if lbArn == "" {
+		return nil, fmt.Errorf("LoadBalancerArn filter required - navigate from a load balancer")
+	}
+
+	listeners, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.Listener, *string, error) {
+		output, err := d.client.DescribeListeners(ctx, &elasticloadbalancingv2.DescribeListenersInput{
+			LoadBalancerArn: &lbArn,
+			Marker:          marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe listeners")
+		}
+		return output.Listeners, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(listeners))
+	for i, l := range listeners {
+		resources[i] = NewListenerResource(l)
+	}
+	return resources, nil
+}
+
+// Get returns a listener by ARN
+func (d *ListenerDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeListeners(ctx, &elasticloadbalancingv2.DescribeListenersInput{
+		ListenerArns: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe listener %s", id)
+	}
+	if len(output.Listeners) == 0 {
+		return nil, fmt.Errorf("listener not found: %s", id)
+	}
+	return NewListenerResource(output.Listeners[0]), nil
+}
+
+// Delete deletes a listener and its rules
+func (d *ListenerDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteListener(ctx, &elasticloadbalancingv2.DeleteListenerInput{
+		ListenerArn: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return apperrors.Wrapf(err, "delete listener %s", id)
+	}
+	return nil
+}
+
+// ListenerResource wraps an ELBv2 listener
+type ListenerResource struct {
+	dao.BaseResource
+	Item types.Listener
+}
+
+// NewListenerResource creates a new ListenerResource
+func NewListenerResource(l types.Listener) *ListenerResource {
+	return &ListenerResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(l.ListenerArn),
+			Name: fmt.Sprintf("%s:%d", l.Protocol, appaws.Int32(l.Port)),
+			ARN:  appaws.Str(l.ListenerArn),
+			Tags: make(map[string]string),
+			Data: l,
+		},
+		Item: l,
+	}
+}
+
+// ListenerArn returns the listener ARN
+func (r *ListenerResource) ListenerArn() string {
+	return appaws.Str(r.Item.ListenerArn)
+}
+
+// LoadBalancerArn returns the ARN of the listener's load balancer
+func (r *ListenerResource) LoadBalancerArn() string {
+	return appaws.Str(r.Item.LoadBalancerArn)
+}
+
+// Protocol returns the listener protocol (HTTP, HTTPS, TCP, ...)
+func (r *ListenerResource) Protocol() string {
+	return string(r.Item.Protocol)
+}
+
+// Port returns the listener port
+func (r *ListenerResource) Port() int32 {
+	return appaws.Int32(r.Item.Port)
+}
+
+// SslPolicy returns the TLS policy for HTTPS/TLS listeners
+func (r *ListenerResource) SslPolicy() string {
+	return appaws.Str(r.Item.SslPolicy)
+}
+
+// RuleActions returns the default actions, implementing appelbv2.ActionHolder
+func (r *ListenerResource) RuleActions() []types.Action {
+	return r.Item.DefaultActions
+}
+
+// HasRules reports whether the listener supports rules beyond its default
+// action; only Application Load Balancer listeners do.
+func (r *ListenerResource) HasRules() bool {
+	return r.Item.Protocol == types.ProtocolEnumHttp || r.Item.Protocol == types.ProtocolEnumHttps
+}
@@ -0,0 +1,139 @@
+package listeners
+
+import (
+	"fmt"
+
+	appelbv2 "github.com/clawscli/claws/custom/elbv2"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ListenerRenderer renders ELBv2 listeners
+type ListenerRenderer struct {
+	render.BaseRenderer
+}
+
+// NewListenerRenderer creates a new ListenerRenderer
+func NewListenerRenderer() render.Renderer {
+	return &ListenerRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elbv2",
+			Resource: "listeners",
+			Cols: []render.Column{
+				{
+					Name:  "LISTENER",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "DEFAULT ACTION",
+					Width: 50,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ListenerResource); ok {
+							return appelbv2.ActionsSummary(v.RuleActions())
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "SSL POLICY",
+					Width: 30,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ListenerResource); ok {
+							return v.SslPolicy()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "CERTS",
+					Width: 6,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*ListenerResource); ok && len(v.Item.Certificates) > 0 {
+							return fmt.Sprintf("%d", len(v.Item.Certificates))
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed listener information
+func (r *ListenerRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*ListenerResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Listener", v.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Protocol", v.Protocol())
+	d.Field("Port", fmt.Sprintf("%d", v.Port()))
+	d.Field("ARN", v.ListenerArn())
+	d.Field("Load Balancer", v.LoadBalancerArn())
+	if policy := v.SslPolicy(); policy != "" {
+		d.Field("SSL Policy", policy)
+	}
+
+	if len(v.Item.Certificates) > 0 {
+		d.Section("Certificates")
+		for _, cert := range v.Item.Certificates {
+			label := "Certificate"
+			if appaws.Bool(cert.IsDefault) {
+				label = "Default"
+			}
+			d.Field(label, appaws.Str(cert.CertificateArn))
+		}
+	}
+
+	appelbv2.RenderActions(d, "Default Actions", v.RuleActions())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ListenerRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*ListenerResource)
+	if !ok {
+		return nil
+	}
+	return []render.SummaryField{
+		{Label: "Listener", Value: v.GetName()},
+		{Label: "Default Action", Value: appelbv2.ActionsSummary(v.RuleActions())},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *ListenerRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*ListenerResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if v.HasRules() {
+		navs = append(navs, render.Navigation{
+			Key: "r", Label: "Rules", Service: "elbv2", Resource: "rules",
+			FilterField: "ListenerArn", FilterValue: v.ListenerArn(),
+		})
+	}
+	if tgs := appelbv2.ForwardTargetGroups(v.RuleActions()); len(tgs) > 0 {
+		navs = append(navs, render.Navigation{
+			Key: "t", Label: "Target Group", Service: "elbv2", Resource: "target-groups",
+			FilterField: "TargetGroupArn", FilterValue: appaws.Str(tgs[0].TargetGroupArn),
+		})
+	}
+	return navs
+}
@@ -21,13 +21,13 @@ type LoadBalancerDAO struct {
 
 // NewLoadBalancerDAO creates a new LoadBalancerDAO
 func NewLoadBalancerDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, elasticloadbalancingv2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &LoadBalancerDAO{
 		BaseDAO: dao.NewBaseDAO("elbv2", "load-balancers"),
-		client:  elasticloadbalancingv2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
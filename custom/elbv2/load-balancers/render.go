@@ -189,6 +189,16 @@ func (r *LoadBalancerRenderer) Navigations(resource dao.Resource) []render.Navig
 		},
 	}
 
+	// Listeners (and, for ALBs, their routing rules)
+	navs = append(navs, render.Navigation{
+		Key:         "l",
+		Label:       "Listeners",
+		Service:     "elbv2",
+		Resource:    "listeners",
+		FilterField: "LoadBalancerArn",
+		FilterValue: rr.LoadBalancerArn(),
+	})
+
 	// VPC navigation
 	if vpcId := rr.VpcId(); vpcId != "" {
 		navs = append(navs, render.Navigation{
@@ -201,5 +211,17 @@ func (r *LoadBalancerRenderer) Navigations(resource dao.Resource) []render.Navig
 		})
 	}
 
+	// ENIs created by ELB for this load balancer (ALB/NLB only)
+	if lbType := rr.Type(); lbType == "application" || lbType == "network" {
+		navs = append(navs, render.Navigation{
+			Key:         "e",
+			Label:       "ENIs",
+			Service:     "ec2",
+			Resource:    "network-interfaces",
+			FilterField: "LoadBalancerArn",
+			FilterValue: rr.LoadBalancerArn(),
+		})
+	}
+
 	return navs
 }
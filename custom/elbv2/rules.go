@@ -0,0 +1,287 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ActionHolder is implemented by listeners (default actions) and rules, so
+// both can share the traffic-shifting action.
+type ActionHolder interface {
+	RuleActions() []types.Action
+}
+
+// SortedActions returns actions in evaluation order.
+func SortedActions(actions []types.Action) []types.Action {
+	sorted := slices.Clone(actions)
+	slices.SortStableFunc(sorted, func(a, b types.Action) int {
+		return int(appaws.Int32(a.Order)) - int(appaws.Int32(b.Order))
+	})
+	return sorted
+}
+
+// TargetGroupName extracts "my-tg" from
+// "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/abc".
+func TargetGroupName(arn string) string {
+	_, resource, ok := strings.Cut(arn, ":targetgroup/")
+	if !ok {
+		return arn
+	}
+	name, _, _ := strings.Cut(resource, "/")
+	return name
+}
+
+// ForwardTargetGroups returns the target groups of the forward action, if
+// any. A single-target forward without ForwardConfig is reported with weight 1.
+func ForwardTargetGroups(actions []types.Action) []types.TargetGroupTuple {
+	for _, a := range actions {
+		if a.Type != types.ActionTypeEnumForward {
+			continue
+		}
+		if a.ForwardConfig != nil && len(a.ForwardConfig.TargetGroups) > 0 {
+			return a.ForwardConfig.TargetGroups
+		}
+		if a.TargetGroupArn != nil {
+			return []types.TargetGroupTuple{{TargetGroupArn: a.TargetGroupArn, Weight: appaws.Int32Ptr(1)}}
+		}
+	}
+	return nil
+}
+
+// CanShiftTraffic reports whether actions forward to exactly two target
+// groups, the blue/green setup the weight picker supports.
+func CanShiftTraffic(actions []types.Action) bool {
+	return len(ForwardTargetGroups(actions)) == 2
+}
+
+// ActionSummary describes one action, e.g. "forward blue 90% / green 10%".
+func ActionSummary(a types.Action) string {
+	switch a.Type {
+	case types.ActionTypeEnumForward:
+		tgs := ForwardTargetGroups([]types.Action{a})
+		if len(tgs) == 1 {
+			return "forward " + TargetGroupName(appaws.Str(tgs[0].TargetGroupArn))
+		}
+		return "forward " + weightSummary(tgs)
+	case types.ActionTypeEnumRedirect:
+		if c := a.RedirectConfig; c != nil {
+			return fmt.Sprintf("redirect %s://%s:%s%s (%s)",
+				appaws.Str(c.Protocol), appaws.Str(c.Host), appaws.Str(c.Port), appaws.Str(c.Path),
+				strings.TrimPrefix(string(c.StatusCode), "HTTP_"))
+		}
+	case types.ActionTypeEnumFixedResponse:
+		if c := a.FixedResponseConfig; c != nil {
+			return strings.TrimSpace(fmt.Sprintf("fixed-response %s %s", appaws.Str(c.StatusCode), appaws.Str(c.ContentType)))
+		}
+	}
+	return string(a.Type)
+}
+
+// ActionsSummary describes actions in evaluation order, joined by " → ".
+func ActionsSummary(actions []types.Action) string {
+	parts := make([]string, 0, len(actions))
+	for _, a := range SortedActions(actions) {
+		parts = append(parts, ActionSummary(a))
+	}
+	return strings.Join(parts, " → ")
+}
+
+// weightSummary renders target group weights as percentages of their total.
+func weightSummary(tgs []types.TargetGroupTuple) string {
+	var total int32
+	for _, tg := range tgs {
+		total += appaws.Int32(tg.Weight)
+	}
+	parts := make([]string, len(tgs))
+	for i, tg := range tgs {
+		pct := int32(0)
+		if total > 0 {
+			pct = appaws.Int32(tg.Weight) * 100 / total
+		}
+		parts[i] = fmt.Sprintf("%s %d%%", TargetGroupName(appaws.Str(tg.TargetGroupArn)), pct)
+	}
+	return strings.Join(parts, " / ")
+}
+
+// ConditionSummary describes a rule condition, e.g. "path=/api/*,/v2/*".
+func ConditionSummary(c types.RuleCondition) string {
+	field := appaws.Str(c.Field)
+	values := c.Values
+	switch {
+	case c.HostHeaderConfig != nil:
+		field, values = "host", c.HostHeaderConfig.Values
+	case c.PathPatternConfig != nil:
+		field, values = "path", c.PathPatternConfig.Values
+	case c.HttpHeaderConfig != nil:
+		field, values = "header:"+appaws.Str(c.HttpHeaderConfig.HttpHeaderName), c.HttpHeaderConfig.Values
+	case c.HttpRequestMethodConfig != nil:
+		field, values = "method", c.HttpRequestMethodConfig.Values
+	case c.SourceIpConfig != nil:
+		field, values = "source-ip", c.SourceIpConfig.Values
+	case c.QueryStringConfig != nil:
+		field, values = "query", nil
+		for _, kv := range c.QueryStringConfig.Values {
+			values = append(values, appaws.Str(kv.Key)+"="+appaws.Str(kv.Value))
+		}
+	}
+	if len(values) == 0 && len(c.RegexValues) > 0 {
+		values = c.RegexValues
+	}
+	return field + "=" + strings.Join(values, ",")
+}
+
+// ConditionsSummary describes all conditions of a rule, joined by " & ".
+func ConditionsSummary(conditions []types.RuleCondition) string {
+	parts := make([]string, len(conditions))
+	for i, c := range conditions {
+		parts[i] = ConditionSummary(c)
+	}
+	return strings.Join(parts, " & ")
+}
+
+// RenderActions writes one section per action with its full configuration.
+func RenderActions(d *render.DetailBuilder, title string, actions []types.Action) {
+	d.Section(title)
+	for _, a := range SortedActions(actions) {
+		d.Field(fmt.Sprintf("%d. %s", appaws.Int32(a.Order), a.Type), ActionSummary(a))
+		if a.Type == types.ActionTypeEnumForward {
+			for _, tg := range ForwardTargetGroups([]types.Action{a}) {
+				d.Tag(TargetGroupName(appaws.Str(tg.TargetGroupArn)), fmt.Sprintf("weight %d", appaws.Int32(tg.Weight)))
+			}
+			if a.ForwardConfig != nil && a.ForwardConfig.TargetGroupStickinessConfig != nil &&
+				appaws.Bool(a.ForwardConfig.TargetGroupStickinessConfig.Enabled) {
+				d.Tag("stickiness", fmt.Sprintf("%ds", appaws.Int32(a.ForwardConfig.TargetGroupStickinessConfig.DurationSeconds)))
+			}
+		}
+	}
+}
+
+// ShiftTrafficOperation is the operation of the action returned by ShiftTrafficAction.
+const ShiftTrafficOperation = "ShiftTraffic"
+
+// ShiftTrafficAction returns the action that moves traffic between the two
+// target groups of a weighted forward, picked from 10% steps.
+func ShiftTrafficAction() action.Action {
+	return action.Action{
+		Name:      "Shift Traffic",
+		Shortcut:  "W",
+		Type:      action.ActionTypeAPI,
+		Operation: ShiftTrafficOperation,
+		Confirm:   action.ConfirmSimple,
+		Filter: func(r dao.Resource) bool {
+			h, ok := dao.UnwrapResource(r).(ActionHolder)
+			return ok && CanShiftTraffic(h.RuleActions())
+		},
+		Input: &action.InputSpec{
+			Label: "Weights (↑/↓ to slide)",
+			Choices: func(_ context.Context, r dao.Resource) ([]action.Choice, error) {
+				h, ok := dao.UnwrapResource(r).(ActionHolder)
+				if !ok || !CanShiftTraffic(h.RuleActions()) {
+					return nil, fmt.Errorf("not a forward to two target groups")
+				}
+				return WeightChoices(ForwardTargetGroups(h.RuleActions())), nil
+			},
+		},
+	}
+}
+
+// weightStep is the increment between weight presets.
+const weightStep = 10
+
+// WeightChoices offers splits of 100 between two target groups in 10%
+// steps, drawn as a bar so moving the cursor works like a slider.
+func WeightChoices(tgs []types.TargetGroupTuple) []action.Choice {
+	blue := TargetGroupName(appaws.Str(tgs[0].TargetGroupArn))
+	green := TargetGroupName(appaws.Str(tgs[1].TargetGroupArn))
+	current := weightSummary(tgs)
+
+	var choices []action.Choice
+	for w := 100; w >= 0; w -= weightStep {
+		bar := strings.Repeat("█", w/weightStep) + strings.Repeat("░", (100-w)/weightStep)
+		label := fmt.Sprintf("%s %3d%% %s %3d%% %s", blue, w, bar, 100-w, green)
+		if current == fmt.Sprintf("%s %d%% / %s %d%%", blue, w, green, 100-w) {
+			label += "  (current)"
+		}
+		choices = append(choices, action.Choice{Value: fmt.Sprintf("%d,%d", w, 100-w), Label: label})
+	}
+	return choices
+}
+
+// ParseWeights parses "90,10" into one weight per target group.
+func ParseWeights(value string, n int) ([]int32, error) {
+	fields := strings.Split(value, ",")
+	if len(fields) != n {
+		return nil, fmt.Errorf("expected %d weights, got %q", n, value)
+	}
+	weights := make([]int32, n)
+	var total int32
+	for i, f := range fields {
+		w, err := strconv.ParseInt(strings.TrimSpace(f), 10, 32)
+		if err != nil || w < 0 || w > 999 {
+			return nil, fmt.Errorf("invalid weight %q: must be 0-999", f)
+		}
+		weights[i] = int32(w)
+		total += int32(w)
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("at least one weight must be positive")
+	}
+	return weights, nil
+}
+
+// WithForwardWeights returns a copy of actions with the forward action's
+// target group weights replaced, ready for ModifyListener or ModifyRule.
+func WithForwardWeights(actions []types.Action, weights []int32) ([]types.Action, error) {
+	out := slices.Clone(actions)
+	for i, a := range out {
+		if a.Type != types.ActionTypeEnumForward {
+			continue
+		}
+		tgs := slices.Clone(ForwardTargetGroups([]types.Action{a}))
+		if len(tgs) != len(weights) {
+			return nil, fmt.Errorf("forward has %d target groups, got %d weights", len(tgs), len(weights))
+		}
+		for j := range tgs {
+			tgs[j].Weight = appaws.Int32Ptr(weights[j])
+		}
+		forward := &types.ForwardActionConfig{TargetGroups: tgs}
+		if a.ForwardConfig != nil {
+			forward.TargetGroupStickinessConfig = a.ForwardConfig.TargetGroupStickinessConfig
+		}
+		// TargetGroupArn and a multi-group ForwardConfig are mutually exclusive.
+		a.TargetGroupArn = nil
+		a.ForwardConfig = forward
+		out[i] = a
+		return out, nil
+	}
+	return nil, fmt.Errorf("no forward action")
+}
+
+// ShiftedActions applies the weights picked via ShiftTrafficAction to the
+// resource's actions and returns the updated actions with a description of
+// the new split.
+func ShiftedActions(ctx context.Context, resource dao.Resource) ([]types.Action, string, error) {
+	h, ok := dao.UnwrapResource(resource).(ActionHolder)
+	if !ok {
+		return nil, "", fmt.Errorf("resource has no actions")
+	}
+	weights, err := ParseWeights(action.InputFromContext(ctx), 2)
+	if err != nil {
+		return nil, "", err
+	}
+	actions, err := WithForwardWeights(h.RuleActions(), weights)
+	if err != nil {
+		return nil, "", err
+	}
+	return actions, weightSummary(ForwardTargetGroups(actions)), nil
+}
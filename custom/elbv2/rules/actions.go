@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	appelbv2 "github.com/clawscli/claws/custom/elbv2"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("elbv2", "rules", []action.Action{
+		shiftTrafficAction(),
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteRule",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				rule, ok := dao.UnwrapResource(r).(*RuleResource)
+				return ok && !rule.IsDefault()
+			},
+		},
+	})
+
+	action.RegisterExecutor("elbv2", "rules", executeRuleAction)
+}
+
+// shiftTrafficAction is offered on priority rules only. ModifyRule rejects
+// the default rule; its traffic is shifted from the listener instead.
+func shiftTrafficAction() action.Action {
+	act := appelbv2.ShiftTrafficAction()
+	canShift := act.Filter
+	act.Filter = func(r dao.Resource) bool {
+		rule, ok := dao.UnwrapResource(r).(*RuleResource)
+		return ok && !rule.IsDefault() && canShift(r)
+	}
+	return act
+}
+
+func executeRuleAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case appelbv2.ShiftTrafficOperation:
+		return executeShiftTraffic(ctx, resource)
+	case "DeleteRule":
+		return executeDeleteRule(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeShiftTraffic(ctx context.Context, resource dao.Resource) action.ActionResult {
+	rule, ok := dao.UnwrapResource(resource).(*RuleResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if rule.IsDefault() {
+		return action.FailResult(fmt.Errorf("shift the default rule's traffic from its listener"))
+	}
+
+	actions, split, err := appelbv2.ShiftedActions(ctx, rule)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := appelbv2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.ModifyRule(ctx, &elasticloadbalancingv2.ModifyRuleInput{
+		RuleArn: rule.Item.RuleArn,
+		Actions: actions,
+	})
+	if err != nil {
+		return action.FailResultf(err, "modify rule %s", rule.Priority())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Rule %s now forwards %s", rule.Priority(), split))
+}
+
+func executeDeleteRule(ctx context.Context, resource dao.Resource) action.ActionResult {
+	rule, ok := dao.UnwrapResource(resource).(*RuleResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if rule.IsDefault() {
+		return action.FailResult(fmt.Errorf("the default rule cannot be deleted"))
+	}
+
+	client, err := appelbv2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.DeleteRule(ctx, &elasticloadbalancingv2.DeleteRuleInput{
+		RuleArn: rule.Item.RuleArn,
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete rule %s", rule.Priority())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deleted rule %s", rule.Priority()))
+}
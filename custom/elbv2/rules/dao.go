@@ -0,0 +1,158 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// RuleDAO provides data access for ELBv2 listener rules
+type RuleDAO struct {
+	dao.BaseDAO
+	client *elasticloadbalancingv2.Client
+}
+
+// NewRuleDAO creates a new RuleDAO
+func NewRuleDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, elasticloadbalancingv2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &RuleDAO{
+		BaseDAO: dao.NewBaseDAO("elbv2", "rules"),
+		client:  client,
+	}, nil
+}
+
+// List returns the rules of a listener in evaluation order (requires ListenerArn filter)
+func (d *RuleDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	listenerArn := dao.GetFilterFromContext(ctx, "ListenerArn")
+	if listenerArn == "" {
+		return nil, fmt.Errorf("ListenerArn filter required - navigate from a listener")
+	}
+
+	rules, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.Rule, *string, error) {
+		output, err := d.client.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
+			ListenerArn: &listenerArn,
+			Marker:      marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe rules")
+		}
+		return output.Rules, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortRules(rules)
+
+	resources := make([]dao.Resource, len(rules))
+	for i, rule := range rules {
+		resources[i] = NewRuleResource(rule, listenerArn)
+	}
+	return resources, nil
+}
+
+// Get returns a rule by ARN
+func (d *RuleDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
+		RuleArns: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe rule %s", id)
+	}
+	if len(output.Rules) == 0 {
+		return nil, fmt.Errorf("rule not found: %s", id)
+	}
+	return NewRuleResource(output.Rules[0], dao.GetFilterFromContext(ctx, "ListenerArn")), nil
+}
+
+// Delete deletes a rule; the listener's default rule cannot be deleted
+func (d *RuleDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteRule(ctx, &elasticloadbalancingv2.DeleteRuleInput{
+		RuleArn: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return apperrors.Wrapf(err, "delete rule %s", id)
+	}
+	return nil
+}
+
+// sortRules orders rules by numeric priority with the default rule last,
+// matching how the load balancer evaluates them.
+func sortRules(rules []types.Rule) {
+	slices.SortStableFunc(rules, func(a, b types.Rule) int {
+		return rulePriority(a) - rulePriority(b)
+	})
+}
+
+// rulePriority returns the numeric priority; "default" sorts after 1-50000.
+func rulePriority(r types.Rule) int {
+	if appaws.Bool(r.IsDefault) {
+		return 1 << 30
+	}
+	p, err := strconv.Atoi(appaws.Str(r.Priority))
+	if err != nil {
+		return 1<<30 - 1
+	}
+	return p
+}
+
+// RuleResource wraps an ELBv2 listener rule
+type RuleResource struct {
+	dao.BaseResource
+	Item        types.Rule
+	ListenerArn string
+}
+
+// NewRuleResource creates a new RuleResource
+func NewRuleResource(rule types.Rule, listenerArn string) *RuleResource {
+	return &RuleResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(rule.RuleArn),
+			Name: appaws.Str(rule.Priority),
+			ARN:  appaws.Str(rule.RuleArn),
+			Tags: make(map[string]string),
+			Data: rule,
+		},
+		Item:        rule,
+		ListenerArn: listenerArn,
+	}
+}
+
+// RuleArn returns the rule ARN
+func (r *RuleResource) RuleArn() string {
+	return appaws.Str(r.Item.RuleArn)
+}
+
+// Priority returns the rule priority ("default" for the default rule)
+func (r *RuleResource) Priority() string {
+	return appaws.Str(r.Item.Priority)
+}
+
+// IsDefault reports whether this is the listener's default rule
+func (r *RuleResource) IsDefault() bool {
+	return appaws.Bool(r.Item.IsDefault)
+}
+
+// Conditions returns the rule conditions
+func (r *RuleResource) Conditions() []types.RuleCondition {
+	return r.Item.Conditions
+}
+
+// RuleActions returns the rule actions, implementing appelbv2.ActionHolder
+func (r *RuleResource) RuleActions() []types.Action {
+	return r.Item.Actions
+}
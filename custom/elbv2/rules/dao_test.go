@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+func TestSortRules(t *testing.T) {
+	rules := []types.Rule{
+		{Priority: aws.String("default"), IsDefault: aws.Bool(true)},
+		{Priority: aws.String("100")},
+		{Priority: aws.String("5")},
+		{Priority: aws.String("20")},
+	}
+	sortRules(rules)
+
+	want := []string{"5", "20", "100", "default"}
+	for i, r := range rules {
+		if got := aws.ToString(r.Priority); got != want[i] {
+			t.Errorf("rules[%d].Priority = %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestRuleResource(t *testing.T) {
+	r := NewRuleResource(types.Rule{
+		RuleArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:listener-rule/app/lb/1/2/3"),
+		Priority: aws.String("10"),
+		Actions:  []types.Action{{Type: types.ActionTypeEnumForward}},
+	}, "arn:listener")
+
+	if r.GetName() != "10" || r.Priority() != "10" {
+		t.Errorf("Priority() = %q, GetName() = %q", r.Priority(), r.GetName())
+	}
+	if r.IsDefault() {
+		t.Error("IsDefault() = true for a priority rule")
+	}
+	if len(r.RuleActions()) != 1 {
+		t.Errorf("RuleActions() len = %d, want 1", len(r.RuleActions()))
+	}
+}
+
+func TestShiftTrafficActionSkipsDefaultRule(t *testing.T) {
+	weighted := []types.Action{{
+		Type: types.ActionTypeEnumForward,
+		ForwardConfig: &types.ForwardActionConfig{TargetGroups: []types.TargetGroupTuple{
+			{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/blue/1"), Weight: aws.Int32(90)},
+			{TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/green/2"), Weight: aws.Int32(10)},
+		}},
+	}}
+	act := shiftTrafficAction()
+
+	rule := NewRuleResource(types.Rule{Priority: aws.String("10"), Actions: weighted}, "arn:listener")
+	if !act.Filter(rule) {
+		t.Error("Shift Traffic should be offered on a weighted priority rule")
+	}
+	def := NewRuleResource(types.Rule{Priority: aws.String("default"), IsDefault: aws.Bool(true), Actions: weighted}, "arn:listener")
+	if act.Filter(def) {
+		t.Error("Shift Traffic should not be offered on the default rule")
+	}
+}
@@ -0,0 +1,119 @@
+package rules
+
+import (
+	appelbv2 "github.com/clawscli/claws/custom/elbv2"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// RuleRenderer renders ELBv2 listener rules
+type RuleRenderer struct {
+	render.BaseRenderer
+}
+
+// NewRuleRenderer creates a new RuleRenderer
+func NewRuleRenderer() render.Renderer {
+	return &RuleRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "elbv2",
+			Resource: "rules",
+			Cols: []render.Column{
+				{
+					Name:  "PRIORITY",
+					Width: 9,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*RuleResource); ok {
+							return v.Priority()
+						}
+						return ""
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "CONDITIONS",
+					Width: 45,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*RuleResource); ok {
+							if v.IsDefault() {
+								return "(all other requests)"
+							}
+							return appelbv2.ConditionsSummary(v.Conditions())
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "ACTIONS",
+					Width: 50,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*RuleResource); ok {
+							return appelbv2.ActionsSummary(v.RuleActions())
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed rule information
+func (r *RuleRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*RuleResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Listener Rule", v.Priority())
+
+	d.Section("Basic Information")
+	d.Field("Priority", v.Priority())
+	d.Field("ARN", v.RuleArn())
+	if v.ListenerArn != "" {
+		d.Field("Listener", v.ListenerArn)
+	}
+
+	d.Section("Conditions")
+	if v.IsDefault() {
+		d.Dim("Default rule: matches requests no other rule matches")
+	}
+	for _, c := range v.Conditions() {
+		d.Line(appelbv2.ConditionSummary(c))
+	}
+
+	appelbv2.RenderActions(d, "Actions", v.RuleActions())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *RuleRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*RuleResource)
+	if !ok {
+		return nil
+	}
+	return []render.SummaryField{
+		{Label: "Priority", Value: v.Priority()},
+		{Label: "Actions", Value: appelbv2.ActionsSummary(v.RuleActions())},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *RuleRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*RuleResource)
+	if !ok {
+		return nil
+	}
+	if tgs := appelbv2.ForwardTargetGroups(v.RuleActions()); len(tgs) > 0 {
+		return []render.Navigation{{
+			Key: "t", Label: "Target Group", Service: "elbv2", Resource: "target-groups",
+			FilterField: "TargetGroupArn", FilterValue: appaws.Str(tgs[0].TargetGroupArn),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package elbv2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+const (
+	blueArn  = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/blue/abc"
+	greenArn = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/green/def"
+)
+
+func weightedForward(blue, green int32) types.Action {
+	return types.Action{
+		Type:  types.ActionTypeEnumForward,
+		Order: aws.Int32(1),
+		ForwardConfig: &types.ForwardActionConfig{
+			TargetGroups: []types.TargetGroupTuple{
+				{TargetGroupArn: aws.String(blueArn), Weight: aws.Int32(blue)},
+				{TargetGroupArn: aws.String(greenArn), Weight: aws.Int32(green)},
+			},
+			TargetGroupStickinessConfig: &types.TargetGroupStickinessConfig{Enabled: aws.Bool(true), DurationSeconds: aws.Int32(60)},
+		},
+	}
+}
+
+type holder struct {
+	dao.BaseResource
+	actions []types.Action
+}
+
+func (h *holder) RuleActions() []types.Action { return h.actions }
+
+func TestTargetGroupName(t *testing.T) {
+	if got := TargetGroupName(blueArn); got != "blue" {
+		t.Errorf("TargetGroupName() = %q, want %q", got, "blue")
+	}
+	if got := TargetGroupName("not-an-arn"); got != "not-an-arn" {
+		t.Errorf("TargetGroupName() = %q, want input unchanged", got)
+	}
+}
+
+func TestActionsSummary(t *testing.T) {
+	tests := []struct {
+		name    string
+		actions []types.Action
+		want    string
+	}{
+		{"single forward", []types.Action{{Type: types.ActionTypeEnumForward, TargetGroupArn: aws.String(blueArn)}}, "forward blue"},
+		{"weighted forward", []types.Action{weightedForward(3, 1)}, "forward blue 75% / green 25%"},
+		{"redirect", []types.Action{{
+			Type: types.ActionTypeEnumRedirect,
+			RedirectConfig: &types.RedirectActionConfig{
+				Protocol: aws.String("HTTPS"), Host: aws.String("#{host}"), Port: aws.String("443"),
+				Path: aws.String("/#{path}"), StatusCode: types.RedirectActionStatusCodeEnumHttp301,
+			},
+		}}, "redirect HTTPS://#{host}:443/#{path} (301)"},
+		{"ordered", []types.Action{
+			{Type: types.ActionTypeEnumForward, Order: aws.Int32(2), TargetGroupArn: aws.String(blueArn)},
+			{Type: types.ActionTypeEnumAuthenticateCognito, Order: aws.Int32(1)},
+		}, "authenticate-cognito → forward blue"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ActionsSummary(tt.actions); got != tt.want {
+				t.Errorf("ActionsSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConditionsSummary(t *testing.T) {
+	conditions := []types.RuleCondition{
+		{Field: aws.String("host-header"), HostHeaderConfig: &types.HostHeaderConditionConfig{Values: []string{"api.example.com"}}},
+		{Field: aws.String("path-pattern"), PathPatternConfig: &types.PathPatternConditionConfig{Values: []string{"/v1/*", "/v2/*"}}},
+		{Field: aws.String("query-string"), QueryStringConfig: &types.QueryStringConditionConfig{
+			Values: []types.QueryStringKeyValuePair{{Key: aws.String("canary"), Value: aws.String("true")}},
+		}},
+	}
+	want := "host=api.example.com & path=/v1/*,/v2/* & query=canary=true"
+	if got := ConditionsSummary(conditions); got != want {
+		t.Errorf("ConditionsSummary() = %q, want %q", got, want)
+	}
+}
+
+func TestCanShiftTraffic(t *testing.T) {
+	if !CanShiftTraffic([]types.Action{weightedForward(1, 1)}) {
+		t.Error("CanShiftTraffic() = false for two weighted target groups")
+	}
+	if CanShiftTraffic([]types.Action{{Type: types.ActionTypeEnumForward, TargetGroupArn: aws.String(blueArn)}}) {
+		t.Error("CanShiftTraffic() = true for a single target group")
+	}
+	if CanShiftTraffic([]types.Action{{Type: types.ActionTypeEnumFixedResponse}}) {
+		t.Error("CanShiftTraffic() = true for a fixed response")
+	}
+}
+
+func TestWeightChoices(t *testing.T) {
+	choices := WeightChoices(weightedForward(90, 10).ForwardConfig.TargetGroups)
+	if len(choices) != 11 {
+		t.Fatalf("len(choices) = %d, want 11", len(choices))
+	}
+	if choices[0].Value != "100,0" || choices[10].Value != "0,100" {
+		t.Errorf("choices range = %q..%q, want 100,0..0,100", choices[0].Value, choices[10].Value)
+	}
+	for i, c := range choices {
+		current := strings.HasSuffix(c.Label, "(current)")
+		if current != (i == 1) {
+			t.Errorf("choice %q current = %v", c.Label, current)
+		}
+	}
+}
+
+func TestParseWeights(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []int32
+		wantErr bool
+	}{
+		{"90,10", []int32{90, 10}, false},
+		{" 0 , 100 ", []int32{0, 100}, false},
+		{"100", nil, true},
+		{"0,0", nil, true},
+		{"-1,101", nil, true},
+		{"a,b", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseWeights(tt.value, 2)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseWeights() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (got[0] != tt.want[0] || got[1] != tt.want[1]) {
+				t.Errorf("ParseWeights() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithForwardWeights(t *testing.T) {
+	auth := types.Action{Type: types.ActionTypeEnumAuthenticateOidc, Order: aws.Int32(0)}
+	original := []types.Action{auth, weightedForward(90, 10)}
+
+	got, err := WithForwardWeights(original, []int32{40, 60})
+	if err != nil {
+		t.Fatalf("WithForwardWeights() error = %v", err)
+	}
+	if got[0].Type != types.ActionTypeEnumAuthenticateOidc {
+		t.Errorf("non-forward action changed: %v", got[0].Type)
+	}
+	if s := ActionsSummary(got); s != "authenticate-oidc → forward blue 40% / green 60%" {
+		t.Errorf("ActionsSummary() = %q", s)
+	}
+	if got[1].ForwardConfig.TargetGroupStickinessConfig == nil {
+		t.Error("stickiness config dropped")
+	}
+	if w := aws.ToInt32(original[1].ForwardConfig.TargetGroups[0].Weight); w != 90 {
+		t.Errorf("original weight mutated to %d", w)
+	}
+
+	if _, err := WithForwardWeights([]types.Action{auth}, []int32{1, 1}); err == nil {
+		t.Error("WithForwardWeights() without forward: expected error")
+	}
+}
+
+func TestShiftedActions(t *testing.T) {
+	ctx := action.WithInput(context.Background(), "20,80")
+	actions, split, err := ShiftedActions(ctx, &holder{actions: []types.Action{weightedForward(100, 0)}})
+	if err != nil {
+		t.Fatalf("ShiftedActions() error = %v", err)
+	}
+	if split != "blue 20% / green 80%" {
+		t.Errorf("split = %q", split)
+	}
+	if len(actions) != 1 || actions[0].TargetGroupArn != nil {
+		t.Errorf("unexpected actions: %+v", actions)
+	}
+}
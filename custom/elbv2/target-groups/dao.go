@@ -20,13 +20,13 @@ type TargetGroupDAO struct {
 
 // NewTargetGroupDAO creates a new TargetGroupDAO
 func NewTargetGroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, elasticloadbalancingv2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TargetGroupDAO{
 		BaseDAO: dao.NewBaseDAO("elbv2", "target-groups"),
-		client:  elasticloadbalancingv2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
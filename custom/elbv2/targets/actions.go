@@ -0,0 +1,62 @@
+package targets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	appelbv2 "github.com/clawscli/claws/custom/elbv2"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("elbv2", "targets", []action.Action{
+		{
+			Name:      "Drain (Deregister)",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeregisterTarget",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				t, ok := dao.UnwrapResource(r).(*TargetResource)
+				return ok && !t.IsDraining()
+			},
+		},
+	})
+
+	action.RegisterExecutor("elbv2", "targets", executeTargetAction)
+}
+
+func executeTargetAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "DeregisterTarget":
+		return executeDeregisterTarget(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeDeregisterTarget(ctx context.Context, resource dao.Resource) action.ActionResult {
+	t, ok := dao.UnwrapResource(resource).(*TargetResource)
+	if !ok || t.Item.Target == nil {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := appelbv2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.DeregisterTargets(ctx, &elasticloadbalancingv2.DeregisterTargetsInput{
+		TargetGroupArn: &t.TargetGroupArn,
+		Targets:        []types.TargetDescription{*t.Item.Target},
+	})
+	if err != nil {
+		return action.FailResultf(err, "deregister target %s", t.GetID())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Draining %s; the list refreshes until deregistration completes", t.GetID()))
+}
@@ -4,29 +4,39 @@ import (
 	"context"
 	"fmt"
 
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // TargetDAO provides data access for ELBv2 Targets (Target Health)
 type TargetDAO struct {
 	dao.BaseDAO
-	client *elasticloadbalancingv2.Client
+	client    *elasticloadbalancingv2.Client
+	ec2Client *ec2.Client
 }
 
 // NewTargetDAO creates a new TargetDAO
 func NewTargetDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, elasticloadbalancingv2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	ec2Client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TargetDAO{
-		BaseDAO: dao.NewBaseDAO("elbv2", "targets"),
-		client:  elasticloadbalancingv2.NewFromConfig(cfg),
+		BaseDAO:   dao.NewBaseDAO("elbv2", "targets"),
+		client:    client,
+		ec2Client: ec2Client,
 	}, nil
 }
 
@@ -44,14 +54,55 @@ func (d *TargetDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		return nil, apperrors.Wrap(err, "describe target health")
 	}
 
-	var resources []dao.Resource
-	for _, th := range output.TargetHealthDescriptions {
-		resources = append(resources, NewTargetResource(th, tgArn))
+	targets := make([]*TargetResource, len(output.TargetHealthDescriptions))
+	for i, th := range output.TargetHealthDescriptions {
+		targets[i] = NewTargetResource(th, tgArn)
+	}
+	d.resolveInstanceZones(ctx, targets)
+
+	zones := zoneHealth(targets)
+	resources := make([]dao.Resource, len(targets))
+	for i, t := range targets {
+		t.Zones = zones
+		resources[i] = t
 	}
 
 	return resources, nil
 }
 
+// resolveInstanceZones fills in the availability zone of instance targets,
+// which DescribeTargetHealth only reports for IP targets. Best-effort.
+func (d *TargetDAO) resolveInstanceZones(ctx context.Context, targets []*TargetResource) {
+	var ids []string
+	for _, t := range targets {
+		if strings.HasPrefix(t.TargetId(), "i-") && t.AvailabilityZone() == "" {
+			ids = append(ids, t.TargetId())
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	output, err := d.ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{InstanceIds: ids})
+	if err != nil {
+		log.Debug("failed to resolve target instance zones", "error", err)
+		return
+	}
+	zones := make(map[string]string)
+	for _, res := range output.Reservations {
+		for _, inst := range res.Instances {
+			if inst.Placement != nil {
+				zones[appaws.Str(inst.InstanceId)] = appaws.Str(inst.Placement.AvailabilityZone)
+			}
+		}
+	}
+	for _, t := range targets {
+		if zone, ok := zones[t.TargetId()]; ok && t.AvailabilityZone() == "" {
+			t.Zone = zone
+		}
+	}
+}
+
 // Get returns a specific target - not supported for targets
 func (d *TargetDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	return nil, fmt.Errorf("get not supported for targets - use list from target group")
@@ -59,7 +110,7 @@ func (d *TargetDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 
 // Delete deregisters a target from the target group
 func (d *TargetDAO) Delete(ctx context.Context, id string) error {
-	return fmt.Errorf("delete not supported for targets - use deregister action")
+	return fmt.Errorf("delete not supported for targets - use the drain action")
 }
 
 // TargetResource wraps an ELBv2 Target Health Description
@@ -67,6 +118,8 @@ type TargetResource struct {
 	dao.BaseResource
 	Item           types.TargetHealthDescription
 	TargetGroupArn string
+	Zone           string       // Resolved AZ for instance targets
+	Zones          []ZoneHealth // Healthy counts per AZ across the target group
 }
 
 // NewTargetResource creates a new TargetResource
@@ -118,7 +171,21 @@ func (r *TargetResource) AvailabilityZone() string {
 	if r.Item.Target != nil && r.Item.Target.AvailabilityZone != nil {
 		return *r.Item.Target.AvailabilityZone
 	}
-	return ""
+	return r.Zone
+}
+
+// ZoneHealth returns the healthy count for the target's own AZ
+func (r *TargetResource) ZoneHealth() (ZoneHealth, bool) {
+	zone := r.AvailabilityZone()
+	if zone == "" {
+		zone = "unknown"
+	}
+	for _, z := range r.Zones {
+		if z.Zone == zone {
+			return z, true
+		}
+	}
+	return ZoneHealth{}, false
 }
 
 // HealthCheckPort returns the port used for health checks
@@ -152,3 +219,22 @@ func (r *TargetResource) HealthDescription() string {
 	}
 	return ""
 }
+
+// ReasonExplanation returns a plain-language explanation of the health reason
+func (r *TargetResource) ReasonExplanation() string {
+	if r.Item.TargetHealth != nil {
+		return ReasonExplanation(r.Item.TargetHealth.Reason)
+	}
+	return ""
+}
+
+// IsTransitioning reports whether the target is registering or draining
+func (r *TargetResource) IsTransitioning() bool {
+	return r.Item.TargetHealth != nil && IsTransitioning(r.Item.TargetHealth.State)
+}
+
+// IsDraining reports whether the target is already being deregistered
+func (r *TargetResource) IsDraining() bool {
+	state := types.TargetHealthStateEnum(r.HealthState())
+	return state == types.TargetHealthStateEnumDraining || state == types.TargetHealthStateEnumUnhealthyDraining
+}
@@ -0,0 +1,83 @@
+package targets
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// reasonExplanations describes target health reason codes in plain terms,
+// with the usual next step where there is one.
+var reasonExplanations = map[types.TargetHealthReasonEnum]string{
+	types.TargetHealthReasonEnumRegistrationInProgress:   "The target is being registered with the load balancer.",
+	types.TargetHealthReasonEnumInitialHealthChecking:    "Registered; waiting to pass the minimum number of health checks.",
+	types.TargetHealthReasonEnumResponseCodeMismatch:     "Health checks returned an unexpected HTTP status. Check the health check path and success codes.",
+	types.TargetHealthReasonEnumTimeout:                  "Health check requests timed out. Check security groups, NACLs, and that the app listens on the health check port.",
+	types.TargetHealthReasonEnumFailedHealthChecks:       "The target failed health checks (connection refused or reset).",
+	types.TargetHealthReasonEnumNotRegistered:            "The target is not registered with the target group.",
+	types.TargetHealthReasonEnumNotInUse:                 "No load balancer uses this target group, or the target's AZ is not enabled on the load balancer.",
+	types.TargetHealthReasonEnumDeregistrationInProgress: "Draining: existing connections finish before the deregistration delay expires.",
+	types.TargetHealthReasonEnumInvalidState:             "The instance is stopped or terminated.",
+	types.TargetHealthReasonEnumIpUnusable:               "The IP address cannot be used as a target, e.g. it belongs to the load balancer itself.",
+	types.TargetHealthReasonEnumHealthCheckDisabled:      "Health checks are disabled on the target group.",
+	types.TargetHealthReasonEnumInternalError:            "Health checks failed due to an internal load balancer error.",
+}
+
+// ReasonExplanation returns a plain-language explanation of a reason code.
+func ReasonExplanation(reason types.TargetHealthReasonEnum) string {
+	return reasonExplanations[reason]
+}
+
+// IsTransitioning reports whether a health state is expected to change on
+// its own soon (registration or draining in progress).
+func IsTransitioning(state types.TargetHealthStateEnum) bool {
+	switch state {
+	case types.TargetHealthStateEnumInitial,
+		types.TargetHealthStateEnumDraining,
+		types.TargetHealthStateEnumUnhealthyDraining:
+		return true
+	}
+	return false
+}
+
+// ZoneHealth counts the healthy targets in one availability zone.
+type ZoneHealth struct {
+	Zone    string
+	Healthy int
+	Total   int
+}
+
+// String renders the count as "healthy/total".
+func (z ZoneHealth) String() string {
+	return fmt.Sprintf("%d/%d", z.Healthy, z.Total)
+}
+
+// zoneHealth groups targets by availability zone, sorted by zone name.
+func zoneHealth(targets []*TargetResource) []ZoneHealth {
+	byZone := make(map[string]*ZoneHealth)
+	var zones []string
+	for _, t := range targets {
+		zone := t.AvailabilityZone()
+		if zone == "" {
+			zone = "unknown"
+		}
+		z, ok := byZone[zone]
+		if !ok {
+			z = &ZoneHealth{Zone: zone}
+			byZone[zone] = z
+			zones = append(zones, zone)
+		}
+		z.Total++
+		if t.HealthState() == string(types.TargetHealthStateEnumHealthy) {
+			z.Healthy++
+		}
+	}
+	slices.Sort(zones)
+
+	result := make([]ZoneHealth, len(zones))
+	for i, zone := range zones {
+		result[i] = *byZone[zone]
+	}
+	return result
+}
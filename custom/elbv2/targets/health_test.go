@@ -0,0 +1,93 @@
+package targets
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func target(id, zone string, state types.TargetHealthStateEnum) *TargetResource {
+	th := types.TargetHealthDescription{
+		Target:       &types.TargetDescription{Id: aws.String(id), Port: aws.Int32(80)},
+		TargetHealth: &types.TargetHealth{State: state},
+	}
+	if zone != "" {
+		th.Target.AvailabilityZone = aws.String(zone)
+	}
+	return NewTargetResource(th, "arn:tg")
+}
+
+func TestZoneHealth(t *testing.T) {
+	targets := []*TargetResource{
+		target("10.0.1.1", "us-east-1b", types.TargetHealthStateEnumHealthy),
+		target("10.0.0.1", "us-east-1a", types.TargetHealthStateEnumHealthy),
+		target("10.0.0.2", "us-east-1a", types.TargetHealthStateEnumUnhealthy),
+		target("i-123", "", types.TargetHealthStateEnumInitial),
+	}
+	// Resolved instance zones count toward their AZ
+	targets[3].Zone = "us-east-1b"
+
+	got := zoneHealth(targets)
+	want := []ZoneHealth{
+		{Zone: "us-east-1a", Healthy: 1, Total: 2},
+		{Zone: "us-east-1b", Healthy: 1, Total: 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("zoneHealth() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("zoneHealth()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	targets[0].Zones = got
+	if z, ok := targets[0].ZoneHealth(); !ok || z.String() != "1/2" {
+		t.Errorf("ZoneHealth() = %v, %v; want 1/2", z, ok)
+	}
+}
+
+func TestZoneHealthUnknownZone(t *testing.T) {
+	got := zoneHealth([]*TargetResource{target("i-123", "", types.TargetHealthStateEnumHealthy)})
+	if len(got) != 1 || got[0].Zone != "unknown" || got[0].Healthy != 1 {
+		t.Errorf("zoneHealth() = %v, want one unknown zone", got)
+	}
+}
+
+func TestReasonExplanation(t *testing.T) {
+	if ReasonExplanation(types.TargetHealthReasonEnumTimeout) == "" {
+		t.Error("ReasonExplanation(Target.Timeout) is empty")
+	}
+	if got := ReasonExplanation("Unknown.Reason"); got != "" {
+		t.Errorf("ReasonExplanation(unknown) = %q, want empty", got)
+	}
+}
+
+func TestNeedsAutoReload(t *testing.T) {
+	renderer := NewTargetRenderer().(*TargetRenderer)
+	tests := []struct {
+		name  string
+		state types.TargetHealthStateEnum
+		want  bool
+	}{
+		{"initial", types.TargetHealthStateEnumInitial, true},
+		{"draining", types.TargetHealthStateEnumDraining, true},
+		{"unhealthy draining", types.TargetHealthStateEnumUnhealthyDraining, true},
+		{"healthy", types.TargetHealthStateEnumHealthy, false},
+		{"unhealthy", types.TargetHealthStateEnumUnhealthy, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := []dao.Resource{
+				target("10.0.0.1", "us-east-1a", types.TargetHealthStateEnumHealthy),
+				target("10.0.0.2", "us-east-1a", tt.state),
+			}
+			if got := renderer.NeedsAutoReload(resources); got != tt.want {
+				t.Errorf("NeedsAutoReload() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
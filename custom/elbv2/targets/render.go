@@ -10,8 +10,11 @@ import (
 )
 
 // TargetRenderer renders ELBv2 Targets
-// Ensure TargetRenderer implements render.Navigator
-var _ render.Navigator = (*TargetRenderer)(nil)
+// Ensure TargetRenderer implements render.Navigator and render.AutoReloader
+var (
+	_ render.Navigator    = (*TargetRenderer)(nil)
+	_ render.AutoReloader = (*TargetRenderer)(nil)
+)
 
 type TargetRenderer struct {
 	render.BaseRenderer
@@ -58,6 +61,19 @@ func NewTargetRenderer() render.Renderer {
 					},
 					Priority: 2,
 				},
+				{
+					Name:  "AZ HEALTHY",
+					Width: 11,
+					Getter: func(r dao.Resource) string {
+						if rr, ok := r.(*TargetResource); ok {
+							if z, ok := rr.ZoneHealth(); ok {
+								return z.String()
+							}
+						}
+						return ""
+					},
+					Priority: 6,
+				},
 				{
 					Name:  "HEALTH",
 					Width: 12,
@@ -125,6 +141,16 @@ func (r *TargetRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Description", rr.HealthDescription())
 	}
 	d.Field("Health Check Port", rr.HealthCheckPort())
+	if explanation := rr.ReasonExplanation(); explanation != "" {
+		d.Field("Explanation", explanation)
+	}
+
+	if len(rr.Zones) > 0 {
+		d.Section("Healthy Targets by AZ")
+		for _, z := range rr.Zones {
+			d.Field(z.Zone, z.String())
+		}
+	}
 
 	// Parent Target Group
 	d.Section("Target Group")
@@ -148,6 +174,17 @@ func (r *TargetRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 	}
 }
 
+// NeedsAutoReload keeps the list refreshing while any target is
+// registering or draining, so deployments can be watched live.
+func (r *TargetRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if t, ok := dao.UnwrapResource(res).(*TargetResource); ok && t.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
+
 // Navigations returns available navigation options based on target type
 func (r *TargetRenderer) Navigations(resource dao.Resource) []render.Navigation {
 	rr, ok := resource.(*TargetResource)
@@ -180,8 +217,8 @@ func (r *TargetRenderer) Navigations(resource dao.Resource) []render.Navigation
 		})
 	}
 
-	// Lambda function target (arn:aws:lambda:...)
-	if strings.HasPrefix(targetId, "arn:aws:lambda:") {
+	// Lambda function target (arn:<partition>:lambda:...)
+	if arn := appaws.ParseARN(targetId); arn != nil && arn.Service == "lambda" {
 		functionName := appaws.ExtractResourceName(targetId)
 		navs = append(navs, render.Navigation{
 			Key:         "l",
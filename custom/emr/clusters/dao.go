@@ -19,13 +19,13 @@ type ClusterDAO struct {
 
 // NewClusterDAO creates a new ClusterDAO.
 func NewClusterDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, emr.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ClusterDAO{
 		BaseDAO: dao.NewBaseDAO("emr", "clusters"),
-		client:  emr.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
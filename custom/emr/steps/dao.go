@@ -20,13 +20,13 @@ type StepDAO struct {
 
 // NewStepDAO creates a new StepDAO.
 func NewStepDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, emr.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &StepDAO{
 		BaseDAO: dao.NewBaseDAO("emr", "steps"),
-		client:  emr.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
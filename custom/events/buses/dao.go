@@ -19,13 +19,13 @@ type BusDAO struct {
 
 // NewBusDAO creates a new BusDAO
 func NewBusDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, eventbridge.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &BusDAO{
 		BaseDAO: dao.NewBaseDAO("events", "buses"),
-		client:  eventbridge.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
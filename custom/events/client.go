@@ -10,9 +10,5 @@ import (
 
 // GetClient returns an EventBridge client configured for the current context
 func GetClient(ctx context.Context) (*eventbridge.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return eventbridge.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, eventbridge.NewFromConfig)
 }
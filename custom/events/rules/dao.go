@@ -20,13 +20,13 @@ type RuleDAO struct {
 
 // NewRuleDAO creates a new RuleDAO
 func NewRuleDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, eventbridge.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RuleDAO{
 		BaseDAO: dao.NewBaseDAO("events", "rules"),
-		client:  eventbridge.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
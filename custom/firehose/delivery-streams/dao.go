@@ -0,0 +1,449 @@
+package deliverystreams
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// describeConcurrency bounds parallel DescribeDeliveryStream calls during List.
+const describeConcurrency = 8
+
+// metricsWindow is how far back delivery metrics are aggregated on Get.
+const metricsWindow = time.Hour
+
+// DeliveryStreamDAO provides data access for Firehose delivery streams
+type DeliveryStreamDAO struct {
+	dao.BaseDAO
+	client   *firehose.Client
+	cwClient *cloudwatch.Client
+}
+
+// NewDeliveryStreamDAO creates a new DeliveryStreamDAO
+func NewDeliveryStreamDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, firehose.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	cwClient, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DeliveryStreamDAO{
+		BaseDAO:  dao.NewBaseDAO("firehose", "delivery-streams"),
+		client:   client,
+		cwClient: cwClient,
+	}, nil
+}
+
+// List returns all delivery streams. ListDeliveryStreams only returns names,
+// so each stream is described to show its destination and status.
+func (d *DeliveryStreamDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var names []string
+	var start *string
+	for {
+		output, err := d.client.ListDeliveryStreams(ctx, &firehose.ListDeliveryStreamsInput{
+			ExclusiveStartDeliveryStreamName: start,
+		})
+		if err != nil {
+			return nil, apperrors.Wrap(err, "list delivery streams")
+		}
+		names = append(names, output.DeliveryStreamNames...)
+		if !appaws.Bool(output.HasMoreDeliveryStreams) || len(output.DeliveryStreamNames) == 0 {
+			break
+		}
+		start = &output.DeliveryStreamNames[len(output.DeliveryStreamNames)-1]
+	}
+
+	var mu sync.Mutex
+	resources := make([]dao.Resource, 0, len(names))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeConcurrency)
+	for _, name := range names {
+		g.Go(func() error {
+			desc, err := d.describe(gctx, name)
+			if err != nil {
+				// Streams deleted between list and describe are skipped
+				log.Debug("failed to describe delivery stream", "name", name, "error", err)
+				return nil
+			}
+			mu.Lock()
+			resources = append(resources, NewDeliveryStreamResource(*desc))
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetName() < resources[j].GetName()
+	})
+	return resources, nil
+}
+
+// Get returns a delivery stream enriched with its recent delivery metrics.
+// Metric lookups are best-effort; failures are logged and leave metrics unset.
+func (d *DeliveryStreamDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	desc, err := d.describe(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	r := NewDeliveryStreamResource(*desc)
+
+	metrics, err := d.fetchMetrics(ctx, name, r.Destination().MetricPrefix)
+	if err != nil {
+		log.Warn("failed to fetch delivery stream metrics", "name", name, "error", err)
+	} else {
+		r.Metrics = metrics
+	}
+	return r, nil
+}
+
+// Delete deletes a delivery stream by name
+func (d *DeliveryStreamDAO) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteDeliveryStream(ctx, &firehose.DeleteDeliveryStreamInput{
+		DeliveryStreamName: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete delivery stream %s", name)
+	}
+	return nil
+}
+
+func (d *DeliveryStreamDAO) describe(ctx context.Context, name string) (*types.DeliveryStreamDescription, error) {
+	output, err := d.client.DescribeDeliveryStream(ctx, &firehose.DescribeDeliveryStreamInput{
+		DeliveryStreamName: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe delivery stream %s", name)
+	}
+	return output.DeliveryStreamDescription, nil
+}
+
+// deliveryMetric describes a stream metric shown in the detail view.
+// Names starting with "." are suffixed to the destination's metric prefix.
+type deliveryMetric struct {
+	Name  string
+	Label string
+	Stat  string
+	Unit  string
+}
+
+// deliveryMetrics are fetched for a stream on Get. Metrics the stream does
+// not publish (e.g. transformation metrics without a Lambda) return no data
+// and are omitted.
+var deliveryMetrics = []deliveryMetric{
+	{Name: "IncomingRecords", Label: "Incoming Records", Stat: "Sum"},
+	{Name: ".Records", Label: "Delivered Records", Stat: "Sum"},
+	{Name: ".Success", Label: "Delivery Success", Stat: "Average", Unit: "%"},
+	{Name: ".DataFreshness", Label: "Max Data Age", Stat: "Maximum", Unit: "s"},
+	{Name: "ExecuteProcessing.Success", Label: "Transform Success", Stat: "Average", Unit: "%"},
+	{Name: "ThrottledRecords", Label: "Throttled Records", Stat: "Sum"},
+}
+
+func (d *DeliveryStreamDAO) fetchMetrics(ctx context.Context, name, prefix string) ([]DeliveryMetric, error) {
+	specs := metricsFor(prefix)
+	period := int32(metricsWindow.Seconds())
+	queries := make([]cwtypes.MetricDataQuery, len(specs))
+	for i, m := range specs {
+		queries[i] = cwtypes.MetricDataQuery{
+			Id: appaws.StringPtr(fmt.Sprintf("m%d", i)),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  appaws.StringPtr("AWS/Firehose"),
+					MetricName: appaws.StringPtr(m.Name),
+					Dimensions: []cwtypes.Dimension{
+						{Name: appaws.StringPtr("DeliveryStreamName"), Value: appaws.StringPtr(name)},
+					},
+				},
+				Period: &period,
+				Stat:   appaws.StringPtr(m.Stat),
+			},
+		}
+	}
+
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-metricsWindow)
+	output, err := d.cwClient.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime:         &start,
+		EndTime:           &end,
+		MetricDataQueries: queries,
+		ScanBy:            cwtypes.ScanByTimestampDescending,
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get delivery stream metrics")
+	}
+
+	return latestMetrics(specs, output.MetricDataResults), nil
+}
+
+// metricsFor resolves destination-relative metric names against prefix,
+// dropping them when the destination publishes none.
+func metricsFor(prefix string) []deliveryMetric {
+	specs := make([]deliveryMetric, 0, len(deliveryMetrics))
+	for _, m := range deliveryMetrics {
+		if m.Name[0] == '.' {
+			if prefix == "" {
+				continue
+			}
+			m.Name = prefix + m.Name
+		}
+		specs = append(specs, m)
+	}
+	return specs
+}
+
+// latestMetrics maps query results back to specs, keeping only metrics with
+// data. Success metrics are ratios and are scaled to percentages.
+func latestMetrics(specs []deliveryMetric, results []cwtypes.MetricDataResult) []DeliveryMetric {
+	latest := make(map[string]float64, len(results))
+	for _, res := range results {
+		if len(res.Values) > 0 {
+			latest[appaws.Str(res.Id)] = res.Values[0]
+		}
+	}
+
+	var metrics []DeliveryMetric
+	for i, m := range specs {
+		v, ok := latest[fmt.Sprintf("m%d", i)]
+		if !ok {
+			continue
+		}
+		if m.Unit == "%" {
+			v *= 100
+		}
+		metrics = append(metrics, DeliveryMetric{Name: m.Name, Label: m.Label, Unit: m.Unit, Value: v})
+	}
+	return metrics
+}
+
+// DeliveryMetric is a delivery stream metric aggregated over metricsWindow
+type DeliveryMetric struct {
+	Name  string
+	Label string
+	Unit  string
+	Value float64
+}
+
+// Format returns the metric value with its unit
+func (m DeliveryMetric) Format() string {
+	switch m.Unit {
+	case "%":
+		return fmt.Sprintf("%.1f%%", m.Value)
+	case "s":
+		return fmt.Sprintf("%.0fs", m.Value)
+	default:
+		return fmt.Sprintf("%.0f", m.Value)
+	}
+}
+
+// IsFailing reports whether a success-rate metric shows failed deliveries.
+func (m DeliveryMetric) IsFailing() bool {
+	return m.Unit == "%" && m.Value < 100
+}
+
+// DeliveryStreamResource wraps a Firehose delivery stream
+type DeliveryStreamResource struct {
+	dao.BaseResource
+	Item types.DeliveryStreamDescription
+
+	// Populated by Get only
+	Metrics []DeliveryMetric
+}
+
+// NewDeliveryStreamResource creates a new DeliveryStreamResource
+func NewDeliveryStreamResource(desc types.DeliveryStreamDescription) *DeliveryStreamResource {
+	return &DeliveryStreamResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(desc.DeliveryStreamName),
+			Name: appaws.Str(desc.DeliveryStreamName),
+			ARN:  appaws.Str(desc.DeliveryStreamARN),
+			Data: desc,
+		},
+		Item: desc,
+	}
+}
+
+// Status returns ACTIVE, CREATING, DELETING or a *_FAILED status.
+func (r *DeliveryStreamResource) Status() string {
+	return string(r.Item.DeliveryStreamStatus)
+}
+
+// IsTransitioning reports whether the stream is being created or deleted.
+func (r *DeliveryStreamResource) IsTransitioning() bool {
+	return r.Item.DeliveryStreamStatus == types.DeliveryStreamStatusCreating ||
+		r.Item.DeliveryStreamStatus == types.DeliveryStreamStatusDeleting
+}
+
+// SourceType returns DirectPut, KinesisStreamAsSource, MSKAsSource or DatabaseAsSource.
+func (r *DeliveryStreamResource) SourceType() string {
+	return string(r.Item.DeliveryStreamType)
+}
+
+// IsDirectPut reports whether producers write to the stream with PutRecord.
+func (r *DeliveryStreamResource) IsDirectPut() bool {
+	return r.Item.DeliveryStreamType == types.DeliveryStreamTypeDirectPut
+}
+
+// Source returns the ARN of the stream's Kinesis or MSK source, if any.
+func (r *DeliveryStreamResource) Source() string {
+	src := r.Item.Source
+	switch {
+	case src == nil:
+		return ""
+	case src.KinesisStreamSourceDescription != nil:
+		return appaws.Str(src.KinesisStreamSourceDescription.KinesisStreamARN)
+	case src.MSKSourceDescription != nil:
+		return appaws.Str(src.MSKSourceDescription.MSKClusterARN) + " (" + appaws.Str(src.MSKSourceDescription.TopicName) + ")"
+	}
+	return ""
+}
+
+// Destination summarises the stream's (first) destination.
+func (r *DeliveryStreamResource) Destination() Destination {
+	if len(r.Item.Destinations) == 0 {
+		return Destination{}
+	}
+	return describeDestination(r.Item.Destinations[0])
+}
+
+// CreatedAt returns when the stream was created.
+func (r *DeliveryStreamResource) CreatedAt() *time.Time {
+	return r.Item.CreateTimestamp
+}
+
+// Destination holds the settings every destination type shares, read from
+// whichever type-specific description is set.
+type Destination struct {
+	Type            string
+	Target          string
+	IntervalSeconds *int32
+	SizeMB          *int32
+	MetricPrefix    string
+	BackupBucketARN string
+}
+
+// Buffering returns the buffering hints as "5 MB / 300s".
+func (d Destination) Buffering() string {
+	if d.IntervalSeconds == nil && d.SizeMB == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d MB / %ds", appaws.Int32(d.SizeMB), appaws.Int32(d.IntervalSeconds))
+}
+
+func describeDestination(dest types.DestinationDescription) Destination {
+	backup := func(s3 *types.S3DestinationDescription) string {
+		if s3 == nil {
+			return ""
+		}
+		return appaws.Str(s3.BucketARN)
+	}
+
+	switch {
+	case dest.ExtendedS3DestinationDescription != nil:
+		c := dest.ExtendedS3DestinationDescription
+		out := Destination{Type: "S3", Target: bucketName(appaws.Str(c.BucketARN)), MetricPrefix: "DeliveryToS3"}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.S3DestinationDescription != nil && dest.RedshiftDestinationDescription == nil:
+		c := dest.S3DestinationDescription
+		out := Destination{Type: "S3", Target: bucketName(appaws.Str(c.BucketARN)), MetricPrefix: "DeliveryToS3"}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.RedshiftDestinationDescription != nil:
+		c := dest.RedshiftDestinationDescription
+		out := Destination{Type: "Redshift", Target: appaws.Str(c.ClusterJDBCURL), MetricPrefix: "DeliveryToRedshift", BackupBucketARN: backup(c.S3DestinationDescription)}
+		if s3 := c.S3DestinationDescription; s3 != nil && s3.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = s3.BufferingHints.IntervalInSeconds, s3.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.AmazonopensearchserviceDestinationDescription != nil:
+		c := dest.AmazonopensearchserviceDestinationDescription
+		target := appaws.Str(c.DomainARN)
+		if target == "" {
+			target = appaws.Str(c.ClusterEndpoint)
+		}
+		out := Destination{Type: "OpenSearch", Target: target + "/" + appaws.Str(c.IndexName), MetricPrefix: "DeliveryToAmazonOpenSearchService", BackupBucketARN: backup(c.S3DestinationDescription)}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.AmazonOpenSearchServerlessDestinationDescription != nil:
+		c := dest.AmazonOpenSearchServerlessDestinationDescription
+		out := Destination{Type: "OpenSearch Serverless", Target: appaws.Str(c.CollectionEndpoint) + "/" + appaws.Str(c.IndexName), MetricPrefix: "DeliveryToAmazonOpenSearchServerless", BackupBucketARN: backup(c.S3DestinationDescription)}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.ElasticsearchDestinationDescription != nil:
+		c := dest.ElasticsearchDestinationDescription
+		out := Destination{Type: "Elasticsearch", Target: appaws.Str(c.DomainARN) + "/" + appaws.Str(c.IndexName), MetricPrefix: "DeliveryToElasticsearch", BackupBucketARN: backup(c.S3DestinationDescription)}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.SplunkDestinationDescription != nil:
+		c := dest.SplunkDestinationDescription
+		out := Destination{Type: "Splunk", Target: appaws.Str(c.HECEndpoint), MetricPrefix: "DeliveryToSplunk", BackupBucketARN: backup(c.S3DestinationDescription)}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.HttpEndpointDestinationDescription != nil:
+		c := dest.HttpEndpointDestinationDescription
+		out := Destination{Type: "HTTP Endpoint", MetricPrefix: "DeliveryToHttpEndpoint", BackupBucketARN: backup(c.S3DestinationDescription)}
+		if e := c.EndpointConfiguration; e != nil {
+			out.Target = appaws.Str(e.Url)
+			if name := appaws.Str(e.Name); name != "" {
+				out.Target = name + " (" + out.Target + ")"
+			}
+		}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.SnowflakeDestinationDescription != nil:
+		c := dest.SnowflakeDestinationDescription
+		out := Destination{Type: "Snowflake", Target: fmt.Sprintf("%s.%s.%s", appaws.Str(c.Database), appaws.Str(c.Schema), appaws.Str(c.Table)), MetricPrefix: "DeliveryToSnowflake", BackupBucketARN: backup(c.S3DestinationDescription)}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	case dest.IcebergDestinationDescription != nil:
+		c := dest.IcebergDestinationDescription
+		out := Destination{Type: "Iceberg", MetricPrefix: "DeliveryToIceberg", BackupBucketARN: backup(c.S3DestinationDescription)}
+		if cat := c.CatalogConfiguration; cat != nil {
+			out.Target = appaws.Str(cat.CatalogARN)
+		}
+		if c.BufferingHints != nil {
+			out.IntervalSeconds, out.SizeMB = c.BufferingHints.IntervalInSeconds, c.BufferingHints.SizeInMBs
+		}
+		return out
+	}
+	return Destination{}
+}
+
+// bucketName extracts the bucket from an S3 bucket ARN (arn:aws:s3:::bucket).
+func bucketName(arn string) string {
+	if parsed := appaws.ParseARN(arn); parsed != nil && parsed.ResourceID != "" {
+		return parsed.ResourceID
+	}
+	return arn
+}
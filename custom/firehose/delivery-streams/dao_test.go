@@ -0,0 +1,80 @@
+package deliverystreams
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+)
+
+func TestDescribeDestination(t *testing.T) {
+	s3 := describeDestination(types.DestinationDescription{
+		ExtendedS3DestinationDescription: &types.ExtendedS3DestinationDescription{
+			BucketARN:      aws.String("arn:aws:s3:::logs-bucket"),
+			BufferingHints: &types.BufferingHints{IntervalInSeconds: aws.Int32(300), SizeInMBs: aws.Int32(5)},
+		},
+		S3DestinationDescription: &types.S3DestinationDescription{BucketARN: aws.String("arn:aws:s3:::logs-bucket")},
+	})
+	if s3.Type != "S3" || s3.Target != "logs-bucket" || s3.Buffering() != "5 MB / 300s" || s3.MetricPrefix != "DeliveryToS3" {
+		t.Errorf("s3 destination = %+v", s3)
+	}
+
+	redshift := describeDestination(types.DestinationDescription{
+		RedshiftDestinationDescription: &types.RedshiftDestinationDescription{
+			ClusterJDBCURL: aws.String("jdbc:redshift://cluster:5439/dev"),
+			S3DestinationDescription: &types.S3DestinationDescription{
+				BucketARN:      aws.String("arn:aws:s3:::staging"),
+				BufferingHints: &types.BufferingHints{IntervalInSeconds: aws.Int32(60), SizeInMBs: aws.Int32(1)},
+			},
+		},
+		S3DestinationDescription: &types.S3DestinationDescription{BucketARN: aws.String("arn:aws:s3:::staging")},
+	})
+	if redshift.Type != "Redshift" || redshift.BackupBucketARN != "arn:aws:s3:::staging" || redshift.Buffering() != "1 MB / 60s" {
+		t.Errorf("redshift destination = %+v", redshift)
+	}
+
+	if empty := describeDestination(types.DestinationDescription{}); empty.Type != "" || empty.Buffering() != "" {
+		t.Errorf("empty destination = %+v", empty)
+	}
+}
+
+func TestLatestMetrics(t *testing.T) {
+	specs := metricsFor("DeliveryToS3")
+	if specs[2].Name != "DeliveryToS3.Success" {
+		t.Fatalf("specs[2] = %q", specs[2].Name)
+	}
+	if len(metricsFor("")) != len(deliveryMetrics)-3 {
+		t.Errorf("metricsFor(\"\") kept destination metrics")
+	}
+
+	metrics := latestMetrics(specs, []cwtypes.MetricDataResult{
+		{Id: aws.String("m0"), Values: []float64{1200}},
+		{Id: aws.String("m2"), Values: []float64{0.95}},
+		{Id: aws.String("m5"), Values: nil},
+	})
+	if len(metrics) != 2 {
+		t.Fatalf("metrics = %+v", metrics)
+	}
+	if metrics[0].Format() != "1200" || metrics[0].IsFailing() {
+		t.Errorf("incoming = %s failing=%v", metrics[0].Format(), metrics[0].IsFailing())
+	}
+	if metrics[1].Format() != "95.0%" || !metrics[1].IsFailing() {
+		t.Errorf("success = %s failing=%v", metrics[1].Format(), metrics[1].IsFailing())
+	}
+}
+
+func TestTestRecord(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	if got := string(testRecord("", now)); !strings.Contains(got, `"timestamp":"2026-01-02T03:04:05Z"`) || !strings.HasSuffix(got, "}\n") {
+		t.Errorf("sample record = %q", got)
+	}
+	if got := string(testRecord("hello", now)); got != "hello\n" {
+		t.Errorf("custom record = %q", got)
+	}
+	if got := string(testRecord("line\n", now)); got != "line\n" {
+		t.Errorf("newline record = %q", got)
+	}
+}
@@ -0,0 +1,20 @@
+package deliverystreams
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("firehose", "delivery-streams", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewDeliveryStreamDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewDeliveryStreamRenderer()
+		},
+	})
+}
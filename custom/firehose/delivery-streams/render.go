@@ -0,0 +1,201 @@
+package deliverystreams
+
+import (
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// DeliveryStreamRenderer renders Firehose delivery streams
+type DeliveryStreamRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDeliveryStreamRenderer creates a new DeliveryStreamRenderer
+func NewDeliveryStreamRenderer() render.Renderer {
+	return &DeliveryStreamRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "firehose",
+			Resource: "delivery-streams",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATUS", Width: 10, Getter: getStatus},
+				{Name: "SOURCE", Width: 12, Getter: getSource},
+				{Name: "DESTINATION", Width: 14, Getter: getDestinationType},
+				{Name: "TARGET", Width: 36, Getter: getTarget},
+				{Name: "BUFFER", Width: 14, Getter: getBuffering},
+				{Name: "CREATED", Width: 18, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	s, ok := r.(*DeliveryStreamResource)
+	if !ok {
+		return ""
+	}
+	return s.Status()
+}
+
+func getSource(r dao.Resource) string {
+	s, ok := r.(*DeliveryStreamResource)
+	if !ok {
+		return ""
+	}
+	return strings.TrimSuffix(s.SourceType(), "AsSource")
+}
+
+func getDestinationType(r dao.Resource) string {
+	s, ok := r.(*DeliveryStreamResource)
+	if !ok {
+		return ""
+	}
+	return s.Destination().Type
+}
+
+func getTarget(r dao.Resource) string {
+	s, ok := r.(*DeliveryStreamResource)
+	if !ok {
+		return ""
+	}
+	return s.Destination().Target
+}
+
+func getBuffering(r dao.Resource) string {
+	s, ok := r.(*DeliveryStreamResource)
+	if !ok {
+		return ""
+	}
+	return s.Destination().Buffering()
+}
+
+func getCreated(r dao.Resource) string {
+	s, ok := r.(*DeliveryStreamResource)
+	if !ok {
+		return ""
+	}
+	if t := s.CreatedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func statusStyle(status string) render.Style {
+	switch {
+	case status == "ACTIVE":
+		return ui.SuccessStyle()
+	case strings.HasSuffix(status, "_FAILED"):
+		return ui.DangerStyle()
+	case status == "CREATING", status == "DELETING":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a delivery stream
+func (r *DeliveryStreamRenderer) RenderDetail(resource dao.Resource) string {
+	s, ok := resource.(*DeliveryStreamResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Firehose Delivery Stream", s.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", s.GetName())
+	d.Field("ARN", s.GetARN())
+	d.FieldStyled("Status", s.Status(), statusStyle(s.Status()))
+	d.Field("Source Type", s.SourceType())
+	if src := s.Source(); src != "" {
+		d.Field("Source", src)
+	}
+	if enc := s.Item.DeliveryStreamEncryptionConfiguration; enc != nil && enc.Status != "" {
+		d.Field("Encryption", string(enc.Status))
+	}
+
+	if fd := s.Item.FailureDescription; fd != nil {
+		d.Section("Failure")
+		d.FieldStyled("Type", string(fd.Type), ui.DangerStyle())
+		d.FieldIf("Details", fd.Details)
+	}
+
+	dest := s.Destination()
+	d.Section("Destination")
+	d.Field("Type", dest.Type)
+	d.Field("Target", dest.Target)
+	if buf := dest.Buffering(); buf != "" {
+		d.Field("Buffering", buf)
+	}
+	if dest.BackupBucketARN != "" {
+		d.Field("Backup Bucket", bucketName(dest.BackupBucketARN))
+	}
+	if appaws.Bool(s.Item.HasMoreDestinations) || len(s.Item.Destinations) > 1 {
+		d.Dim("  Additional destinations not shown")
+	}
+
+	if len(s.Metrics) > 0 {
+		d.Section("Delivery (last hour)")
+		for _, m := range s.Metrics {
+			if m.IsFailing() {
+				d.FieldStyled(m.Label, m.Format(), ui.DangerStyle())
+			} else {
+				d.Field(m.Label, m.Format())
+			}
+		}
+	}
+
+	d.Section("Timestamps")
+	if t := s.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := s.Item.LastUpdateTimestamp; t != nil {
+		d.Field("Last Updated", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a delivery stream
+func (r *DeliveryStreamRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	s, ok := resource.(*DeliveryStreamResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	dest := s.Destination()
+	return []render.SummaryField{
+		{Label: "Name", Value: s.GetName()},
+		{Label: "Status", Value: s.Status(), Style: statusStyle(s.Status())},
+		{Label: "Destination", Value: dest.Type + " " + dest.Target},
+		{Label: "Buffering", Value: dest.Buffering()},
+	}
+}
+
+// MetricSpec shows incoming records in the inline metrics column
+func (r *DeliveryStreamRenderer) MetricSpec() *render.MetricSpec {
+	return &render.MetricSpec{
+		Namespace:     "AWS/Firehose",
+		MetricName:    "IncomingRecords",
+		DimensionName: "DeliveryStreamName",
+		Stat:          "Sum",
+		ColumnHeader:  "RECORDS(15m)",
+		Unit:          "",
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a stream is created or deleted
+func (r *DeliveryStreamRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if s, ok := dao.UnwrapResource(res).(*DeliveryStreamResource); ok && s.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -154,3 +154,17 @@ func (r *PolicyRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 
 	return fields
 }
+
+// Navigations returns available navigations from an FMS policy.
+func (r *PolicyRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	return []render.Navigation{
+		{
+			Key:         "c",
+			Label:       "Compliance",
+			Service:     "fms",
+			Resource:    "policy-compliance",
+			FilterField: "PolicyId",
+			FilterValue: resource.GetID(),
+		},
+	}
+}
@@ -0,0 +1,223 @@
+package policycompliance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fms"
+	"github.com/aws/aws-sdk-go-v2/service/fms/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ComplianceDAO provides data access for the per-account compliance of a
+// Firewall Manager policy.
+type ComplianceDAO struct {
+	dao.BaseDAO
+	client *fms.Client
+}
+
+// NewComplianceDAO creates a new ComplianceDAO.
+func NewComplianceDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, fms.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ComplianceDAO{
+		BaseDAO: dao.NewBaseDAO("fms", "policy-compliance"),
+		client:  client,
+	}, nil
+}
+
+// List returns the compliance of every member account in the policy's
+// scope, non-compliant accounts first.
+func (d *ComplianceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	policyID := dao.GetFilterFromContext(ctx, "PolicyId")
+	if policyID == "" {
+		return nil, fmt.Errorf("policy ID filter required")
+	}
+
+	statuses, err := appaws.Paginate(ctx, func(token *string) ([]types.PolicyComplianceStatus, *string, error) {
+		output, err := d.client.ListComplianceStatus(ctx, &fms.ListComplianceStatusInput{
+			PolicyId:  &policyID,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list compliance status for fms policy %s", policyID)
+		}
+		return output.PolicyComplianceStatusList, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*ComplianceResource, len(statuses))
+	for i, status := range statuses {
+		resources[i] = NewComplianceResource(status)
+	}
+	slices.SortStableFunc(resources, func(a, b *ComplianceResource) int {
+		if a.Compliant() != b.Compliant() {
+			if a.Compliant() {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(a.Account(), b.Account())
+	})
+
+	result := make([]dao.Resource, len(resources))
+	for i, r := range resources {
+		result[i] = r
+	}
+	return result, nil
+}
+
+// Get returns the compliance of one account, including its violators.
+// IDs are "<policy-id>:<account-id>".
+func (d *ComplianceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	policyID, account, ok := strings.Cut(id, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid policy compliance ID format: %s", id)
+	}
+
+	output, err := d.client.GetComplianceDetail(ctx, &fms.GetComplianceDetailInput{
+		PolicyId:      &policyID,
+		MemberAccount: &account,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get compliance detail for account %s", account)
+	}
+
+	r := NewComplianceResource(types.PolicyComplianceStatus{
+		PolicyId:      &policyID,
+		MemberAccount: &account,
+	})
+	r.Detail = output.PolicyComplianceDetail
+	if r.Detail != nil {
+		r.Status.IssueInfoMap = r.Detail.IssueInfoMap
+		r.Status.PolicyOwner = r.Detail.PolicyOwner
+	}
+	return r, nil
+}
+
+// Delete is not supported.
+func (d *ComplianceDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for fms policy compliance")
+}
+
+// ComplianceResource is the compliance of one member account with an FMS
+// policy.
+type ComplianceResource struct {
+	dao.BaseResource
+	Status types.PolicyComplianceStatus
+	Detail *types.PolicyComplianceDetail // only set by Get
+}
+
+// NewComplianceResource creates a new ComplianceResource.
+func NewComplianceResource(status types.PolicyComplianceStatus) *ComplianceResource {
+	account := appaws.Str(status.MemberAccount)
+	return &ComplianceResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(status.PolicyId) + ":" + account,
+			Name: account,
+			Data: status,
+		},
+		Status: status,
+	}
+}
+
+// MergeFrom keeps the evaluation results from the list, which
+// GetComplianceDetail does not return.
+func (r *ComplianceResource) MergeFrom(original dao.Resource) {
+	orig, ok := original.(*ComplianceResource)
+	if !ok {
+		return
+	}
+	r.Status.EvaluationResults = orig.Status.EvaluationResults
+	r.Status.LastUpdated = orig.Status.LastUpdated
+	r.Status.PolicyName = orig.Status.PolicyName
+}
+
+// Account returns the member account ID.
+func (r *ComplianceResource) Account() string {
+	return appaws.Str(r.Status.MemberAccount)
+}
+
+// PolicyName returns the name of the policy.
+func (r *ComplianceResource) PolicyName() string {
+	return appaws.Str(r.Status.PolicyName)
+}
+
+// Compliant reports whether no evaluation found the account non-compliant.
+func (r *ComplianceResource) Compliant() bool {
+	for _, e := range r.Status.EvaluationResults {
+		if e.ComplianceStatus == types.PolicyComplianceStatusTypeNonCompliant {
+			return false
+		}
+	}
+	return true
+}
+
+// ComplianceStatus returns COMPLIANT or NON_COMPLIANT, or "" before the
+// account has been evaluated.
+func (r *ComplianceResource) ComplianceStatus() string {
+	switch {
+	case len(r.Status.EvaluationResults) == 0:
+		return ""
+	case r.Compliant():
+		return string(types.PolicyComplianceStatusTypeCompliant)
+	default:
+		return string(types.PolicyComplianceStatusTypeNonCompliant)
+	}
+}
+
+// ViolatorCount returns the number of non-compliant resources across
+// evaluations.
+func (r *ComplianceResource) ViolatorCount() int64 {
+	var n int64
+	for _, e := range r.Status.EvaluationResults {
+		n += e.ViolatorCount
+	}
+	return n
+}
+
+// EvaluationLimitExceeded reports whether FMS stopped counting violators
+// because the account has too many.
+func (r *ComplianceResource) EvaluationLimitExceeded() bool {
+	if r.Detail != nil && r.Detail.EvaluationLimitExceeded {
+		return true
+	}
+	return slices.ContainsFunc(r.Status.EvaluationResults, func(e types.EvaluationResult) bool {
+		return e.EvaluationLimitExceeded
+	})
+}
+
+// Issues returns the dependent services blocking evaluation, e.g. AWSCONFIG,
+// sorted.
+func (r *ComplianceResource) Issues() []string {
+	issues := make([]string, 0, len(r.Status.IssueInfoMap))
+	for service := range r.Status.IssueInfoMap {
+		issues = append(issues, service)
+	}
+	slices.Sort(issues)
+	return issues
+}
+
+// LastUpdated returns when the account was last evaluated.
+func (r *ComplianceResource) LastUpdated() *time.Time {
+	return r.Status.LastUpdated
+}
+
+// Violators returns the non-compliant resources of the account. Only
+// available after Get.
+func (r *ComplianceResource) Violators() []types.ComplianceViolator {
+	if r.Detail == nil {
+		return nil
+	}
+	return r.Detail.Violators
+}
@@ -0,0 +1,63 @@
+package policycompliance
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fms/types"
+)
+
+func TestComplianceResource(t *testing.T) {
+	r := NewComplianceResource(types.PolicyComplianceStatus{
+		PolicyId:      aws.String("p-1"),
+		MemberAccount: aws.String("111122223333"),
+		EvaluationResults: []types.EvaluationResult{
+			{ComplianceStatus: types.PolicyComplianceStatusTypeCompliant, ViolatorCount: 0},
+			{ComplianceStatus: types.PolicyComplianceStatusTypeNonCompliant, ViolatorCount: 3, EvaluationLimitExceeded: true},
+		},
+		IssueInfoMap: map[string]string{"AWSVPC": "x", "AWSCONFIG": "Config is not enabled"},
+	})
+
+	if r.GetID() != "p-1:111122223333" || r.GetName() != "111122223333" {
+		t.Errorf("id = %q, name = %q", r.GetID(), r.GetName())
+	}
+	if r.Compliant() || r.ComplianceStatus() != "NON_COMPLIANT" {
+		t.Errorf("status = %q, want NON_COMPLIANT", r.ComplianceStatus())
+	}
+	if r.ViolatorCount() != 3 || formatViolators(r) != "3+" {
+		t.Errorf("violators = %d (%s)", r.ViolatorCount(), formatViolators(r))
+	}
+	if !slices.Equal(r.Issues(), []string{"AWSCONFIG", "AWSVPC"}) {
+		t.Errorf("issues = %v", r.Issues())
+	}
+
+	pending := NewComplianceResource(types.PolicyComplianceStatus{PolicyId: aws.String("p-1"), MemberAccount: aws.String("444455556666")})
+	if pending.ComplianceStatus() != "" || getStatus(pending) != "PENDING" {
+		t.Errorf("unevaluated account status = %q", getStatus(pending))
+	}
+}
+
+func TestComplianceResource_MergeFrom(t *testing.T) {
+	evaluated := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	listed := NewComplianceResource(types.PolicyComplianceStatus{
+		PolicyId:          aws.String("p-1"),
+		PolicyName:        aws.String("waf-baseline"),
+		MemberAccount:     aws.String("111122223333"),
+		LastUpdated:       &evaluated,
+		EvaluationResults: []types.EvaluationResult{{ComplianceStatus: types.PolicyComplianceStatusTypeNonCompliant, ViolatorCount: 1}},
+	})
+	refreshed := NewComplianceResource(types.PolicyComplianceStatus{PolicyId: aws.String("p-1"), MemberAccount: aws.String("111122223333")})
+	refreshed.Detail = &types.PolicyComplianceDetail{
+		Violators: []types.ComplianceViolator{{ResourceId: aws.String("alb-1"), ViolationReason: types.ViolationReasonWebAclMissingRuleGroup}},
+	}
+
+	refreshed.MergeFrom(listed)
+	if refreshed.ComplianceStatus() != "NON_COMPLIANT" || refreshed.PolicyName() != "waf-baseline" || refreshed.LastUpdated() != &evaluated {
+		t.Errorf("merged = %+v", refreshed.Status)
+	}
+	if len(refreshed.Violators()) != 1 {
+		t.Errorf("violators lost in merge: %+v", refreshed.Violators())
+	}
+}
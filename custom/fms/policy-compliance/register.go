@@ -0,0 +1,20 @@
+package policycompliance
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("fms", "policy-compliance", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewComplianceDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewComplianceRenderer()
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package policycompliance
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ComplianceRenderer renders the per-account compliance of an FMS policy.
+type ComplianceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewComplianceRenderer creates a new ComplianceRenderer.
+func NewComplianceRenderer() render.Renderer {
+	return &ComplianceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "fms",
+			Resource: "policy-compliance",
+			Cols: []render.Column{
+				{Name: "ACCOUNT", Width: 14, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATUS", Width: 15, Getter: getStatus},
+				{Name: "VIOLATORS", Width: 10, Getter: getViolators},
+				{Name: "ISSUES", Width: 30, Getter: getIssues},
+				{Name: "EVALUATED", Width: 12, Getter: getEvaluated},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	c, ok := r.(*ComplianceResource)
+	if !ok {
+		return ""
+	}
+	if status := c.ComplianceStatus(); status != "" {
+		return status
+	}
+	return "PENDING"
+}
+
+func getViolators(r dao.Resource) string {
+	c, ok := r.(*ComplianceResource)
+	if !ok {
+		return ""
+	}
+	return formatViolators(c)
+}
+
+func formatViolators(c *ComplianceResource) string {
+	count := fmt.Sprintf("%d", c.ViolatorCount())
+	if c.EvaluationLimitExceeded() {
+		count += "+"
+	}
+	return count
+}
+
+func getIssues(r dao.Resource) string {
+	c, ok := r.(*ComplianceResource)
+	if !ok {
+		return ""
+	}
+	return strings.Join(c.Issues(), ", ")
+}
+
+func getEvaluated(r dao.Resource) string {
+	c, ok := r.(*ComplianceResource)
+	if !ok {
+		return ""
+	}
+	if t := c.LastUpdated(); t != nil {
+		return render.FormatAge(*t)
+	}
+	return ""
+}
+
+// RenderDetail renders the compliance of an account and its violators.
+func (r *ComplianceRenderer) RenderDetail(resource dao.Resource) string {
+	c, ok := resource.(*ComplianceResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("FMS Policy Compliance", c.Account())
+
+	d.Section("Compliance")
+	d.Field("Account", c.Account())
+	if name := c.PolicyName(); name != "" {
+		d.Field("Policy", fmt.Sprintf("%s (%s)", name, appaws.Str(c.Status.PolicyId)))
+	} else {
+		d.Field("Policy ID", appaws.Str(c.Status.PolicyId))
+	}
+	d.Field("Status", getStatus(c))
+	d.Field("Violators", formatViolators(c))
+	if c.EvaluationLimitExceeded() {
+		d.Dim("Too many violators; FMS stopped evaluating at its limit")
+	}
+	d.FieldIf("Policy Owner", c.Status.PolicyOwner)
+	if t := c.LastUpdated(); t != nil {
+		d.Field("Last Evaluated", t.Format("2006-01-02 15:04:05"))
+	}
+	if c.Detail != nil && c.Detail.ExpiredAt != nil {
+		d.Field("Details Expire", c.Detail.ExpiredAt.Format("2006-01-02 15:04:05"))
+	}
+
+	// Dependent services FMS needs in the account, e.g. AWS Config
+	if issues := c.Issues(); len(issues) > 0 {
+		d.Section("Issues")
+		for _, service := range issues {
+			d.Field(service, c.Status.IssueInfoMap[service])
+		}
+	}
+
+	if violators := c.Violators(); len(violators) > 0 {
+		d.Section(fmt.Sprintf("Violators (%d)", len(violators)))
+		for _, v := range violators {
+			d.Field(appaws.Str(v.ResourceId), fmt.Sprintf("%s • %s", appaws.Str(v.ResourceType), v.ViolationReason))
+			keys := make([]string, 0, len(v.Metadata))
+			for k := range v.Metadata {
+				keys = append(keys, k)
+			}
+			slices.Sort(keys)
+			for _, k := range keys {
+				d.DimIndent(fmt.Sprintf("%s: %s", k, v.Metadata[k]))
+			}
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an account's compliance.
+func (r *ComplianceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	c, ok := resource.(*ComplianceResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Account", Value: c.Account()},
+		{Label: "Status", Value: getStatus(c)},
+		{Label: "Violators", Value: formatViolators(c)},
+	}
+}
@@ -0,0 +1,171 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// BackupDAO provides data access for FSx backups.
+type BackupDAO struct {
+	dao.BaseDAO
+	client *fsx.Client
+}
+
+// NewBackupDAO creates a new BackupDAO.
+func NewBackupDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, fsx.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &BackupDAO{
+		BaseDAO: dao.NewBaseDAO("fsx", "backups"),
+		client:  client,
+	}, nil
+}
+
+// List returns backups, narrowed to one file system by the FileSystemId filter.
+func (d *BackupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var filters []types.Filter
+	if id := dao.GetFilterFromContext(ctx, "FileSystemId"); id != "" {
+		filters = append(filters, types.Filter{Name: types.FilterNameFileSystemId, Values: []string{id}})
+	}
+
+	backups, err := appaws.Paginate(ctx, func(token *string) ([]types.Backup, *string, error) {
+		output, err := d.client.DescribeBackups(ctx, &fsx.DescribeBackupsInput{
+			Filters:   filters,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe fsx backups")
+		}
+		return output.Backups, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(backups))
+	for i, b := range backups {
+		resources[i] = NewBackupResource(b)
+	}
+	return resources, nil
+}
+
+// Get returns a backup by ID.
+func (d *BackupDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeBackups(ctx, &fsx.DescribeBackupsInput{
+		BackupIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe fsx backup %s", id)
+	}
+	if len(output.Backups) == 0 {
+		return nil, fmt.Errorf("backup not found: %s", id)
+	}
+	return NewBackupResource(output.Backups[0]), nil
+}
+
+// Delete deletes a backup by ID.
+func (d *BackupDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteBackup(ctx, &fsx.DeleteBackupInput{
+		BackupId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete fsx backup %s", id)
+	}
+	return nil
+}
+
+// BackupResource wraps an FSx backup.
+type BackupResource struct {
+	dao.BaseResource
+	Item types.Backup
+}
+
+// NewBackupResource creates a new BackupResource.
+func NewBackupResource(b types.Backup) *BackupResource {
+	tags := appaws.TagsToMap(b.Tags)
+	return &BackupResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(b.BackupId),
+			Name: tags["Name"],
+			ARN:  appaws.Str(b.ResourceARN),
+			Tags: tags,
+			Data: b,
+		},
+		Item: b,
+	}
+}
+
+// FileSystemId returns the ID of the backed-up file system.
+func (r *BackupResource) FileSystemId() string {
+	if r.Item.FileSystem != nil {
+		return appaws.Str(r.Item.FileSystem.FileSystemId)
+	}
+	return ""
+}
+
+// FileSystemType returns the type of the backed-up file system.
+func (r *BackupResource) FileSystemType() string {
+	if r.Item.FileSystem != nil {
+		return string(r.Item.FileSystem.FileSystemType)
+	}
+	return ""
+}
+
+// VolumeId returns the backed-up volume for ONTAP and OpenZFS volume backups.
+func (r *BackupResource) VolumeId() string {
+	if r.Item.Volume != nil {
+		return appaws.Str(r.Item.Volume.VolumeId)
+	}
+	return ""
+}
+
+// BackupType returns AUTOMATIC, USER_INITIATED or AWS_BACKUP.
+func (r *BackupResource) BackupType() string {
+	return string(r.Item.Type)
+}
+
+// State returns the lifecycle state (AVAILABLE, CREATING, ...).
+func (r *BackupResource) State() string {
+	return string(r.Item.Lifecycle)
+}
+
+// IsInProgress reports whether the backup is still being taken or copied.
+func (r *BackupResource) IsInProgress() bool {
+	switch r.Item.Lifecycle {
+	case types.BackupLifecycleCreating, types.BackupLifecyclePending,
+		types.BackupLifecycleTransferring, types.BackupLifecycleCopying:
+		return true
+	}
+	return false
+}
+
+// Progress returns the completion percentage of an in-progress backup.
+func (r *BackupResource) Progress() int32 {
+	if r.Item.ProgressPercent != nil {
+		return *r.Item.ProgressPercent
+	}
+	return 0
+}
+
+// SizeBytes returns the backup size, when reported.
+func (r *BackupResource) SizeBytes() int64 {
+	if r.Item.SizeInBytes != nil {
+		return *r.Item.SizeInBytes
+	}
+	return 0
+}
+
+// CreatedAt returns when the backup was created.
+func (r *BackupResource) CreatedAt() *time.Time {
+	return r.Item.CreationTime
+}
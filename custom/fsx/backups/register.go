@@ -0,0 +1,20 @@
+package backups
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("fsx", "backups", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewBackupDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewBackupRenderer()
+		},
+	})
+}
@@ -0,0 +1,187 @@
+package backups
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure BackupRenderer implements render.Navigator
+var _ render.Navigator = (*BackupRenderer)(nil)
+
+// BackupRenderer renders FSx backups.
+type BackupRenderer struct {
+	render.BaseRenderer
+}
+
+// NewBackupRenderer creates a new BackupRenderer.
+func NewBackupRenderer() render.Renderer {
+	return &BackupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "fsx",
+			Resource: "backups",
+			Cols: []render.Column{
+				{Name: "BACKUP ID", Width: 26, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "FILE SYSTEM", Width: 22, Getter: getFileSystem},
+				{Name: "TYPE", Width: 15, Getter: getType},
+				{Name: "STATE", Width: 14, Getter: getState},
+				{Name: "SIZE", Width: 10, Getter: getSize},
+				{Name: "CREATED", Width: 18, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getFileSystem(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok {
+		return ""
+	}
+	return b.FileSystemId()
+}
+
+func getType(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok {
+		return ""
+	}
+	return b.BackupType()
+}
+
+func getState(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok {
+		return ""
+	}
+	if b.IsInProgress() && b.Progress() > 0 {
+		return fmt.Sprintf("%s %d%%", b.State(), b.Progress())
+	}
+	return b.State()
+}
+
+func getSize(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok || b.SizeBytes() == 0 {
+		return "-"
+	}
+	return render.FormatSize(b.SizeBytes())
+}
+
+func getCreated(r dao.Resource) string {
+	b, ok := r.(*BackupResource)
+	if !ok {
+		return ""
+	}
+	if t := b.CreatedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func stateStyle(state string) render.Style {
+	switch state {
+	case "AVAILABLE":
+		return ui.SuccessStyle()
+	case "CREATING", "PENDING", "TRANSFERRING", "COPYING":
+		return ui.WarningStyle()
+	case "FAILED":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an FSx backup.
+func (r *BackupRenderer) RenderDetail(resource dao.Resource) string {
+	b, ok := resource.(*BackupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("FSx Backup", b.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Backup ID", b.GetID())
+	if name := b.GetName(); name != "" {
+		d.Field("Name", name)
+	}
+	d.Field("ARN", b.GetARN())
+	d.Field("Type", b.BackupType())
+	d.FieldStyled("State", b.State(), stateStyle(b.State()))
+	if b.IsInProgress() {
+		d.Field("Progress", fmt.Sprintf("%d%%", b.Progress()))
+	}
+	if fd := b.Item.FailureDetails; fd != nil && fd.Message != nil {
+		d.FieldStyled("Failure", *fd.Message, ui.DangerStyle())
+	}
+	if size := b.SizeBytes(); size > 0 {
+		d.Field("Size", render.FormatSize(size))
+	}
+
+	d.Section("Source")
+	d.Field("File System", b.FileSystemId())
+	d.Field("File System Type", b.FileSystemType())
+	if v := b.VolumeId(); v != "" {
+		d.Field("Volume", v)
+	}
+	if src := b.Item.SourceBackupId; src != nil {
+		d.Field("Copied From", fmt.Sprintf("%s (%s)", *src, appaws.Str(b.Item.SourceBackupRegion)))
+	}
+
+	d.Tags(b.GetTags())
+
+	d.Section("Timestamps")
+	if t := b.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an FSx backup.
+func (r *BackupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	b, ok := resource.(*BackupResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Backup", Value: b.GetID()},
+		{Label: "File System", Value: b.FileSystemId()},
+		{Label: "Type", Value: b.BackupType()},
+		{Label: "State", Value: b.State(), Style: stateStyle(b.State())},
+	}
+}
+
+// Navigations returns available navigations from an FSx backup.
+func (r *BackupRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	b, ok := resource.(*BackupResource)
+	if !ok || b.FileSystemId() == "" {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "f",
+			Label:       "File System",
+			Service:     "fsx",
+			Resource:    "file-systems",
+			FilterField: "FileSystemId",
+			FilterValue: b.FileSystemId(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a backup is in progress
+func (r *BackupRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if b, ok := dao.UnwrapResource(res).(*BackupResource); ok && b.IsInProgress() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+package fsx
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an FSX client configured for the current context
+func GetClient(ctx context.Context) (*fsx.Client, error) {
+	return appaws.Client(ctx, fsx.NewFromConfig)
+}
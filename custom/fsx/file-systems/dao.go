@@ -0,0 +1,203 @@
+package filesystems
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FileSystemDAO provides data access for FSx file systems.
+type FileSystemDAO struct {
+	dao.BaseDAO
+	client *fsx.Client
+}
+
+// NewFileSystemDAO creates a new FileSystemDAO.
+func NewFileSystemDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, fsx.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &FileSystemDAO{
+		BaseDAO: dao.NewBaseDAO("fsx", "file-systems"),
+		client:  client,
+	}, nil
+}
+
+// List returns all FSx file systems in the region.
+func (d *FileSystemDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	fileSystems, err := appaws.Paginate(ctx, func(token *string) ([]types.FileSystem, *string, error) {
+		output, err := d.client.DescribeFileSystems(ctx, &fsx.DescribeFileSystemsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe fsx file systems")
+		}
+		return output.FileSystems, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(fileSystems))
+	for i, fs := range fileSystems {
+		resources[i] = NewFileSystemResource(fs)
+	}
+	return resources, nil
+}
+
+// Get returns a file system by ID.
+func (d *FileSystemDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeFileSystems(ctx, &fsx.DescribeFileSystemsInput{
+		FileSystemIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe fsx file system %s", id)
+	}
+	if len(output.FileSystems) == 0 {
+		return nil, fmt.Errorf("file system not found: %s", id)
+	}
+	return NewFileSystemResource(output.FileSystems[0]), nil
+}
+
+// Delete deletes a file system by ID.
+func (d *FileSystemDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteFileSystem(ctx, &fsx.DeleteFileSystemInput{
+		FileSystemId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete fsx file system %s", id)
+	}
+	return nil
+}
+
+// FileSystemResource wraps an FSx file system.
+type FileSystemResource struct {
+	dao.BaseResource
+	Item types.FileSystem
+}
+
+// NewFileSystemResource creates a new FileSystemResource.
+func NewFileSystemResource(fs types.FileSystem) *FileSystemResource {
+	tags := appaws.TagsToMap(fs.Tags)
+	return &FileSystemResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(fs.FileSystemId),
+			Name: tags["Name"],
+			ARN:  appaws.Str(fs.ResourceARN),
+			Tags: tags,
+			Data: fs,
+		},
+		Item: fs,
+	}
+}
+
+// FileSystemType returns WINDOWS, LUSTRE, ONTAP or OPENZFS.
+func (r *FileSystemResource) FileSystemType() string {
+	return string(r.Item.FileSystemType)
+}
+
+// State returns the lifecycle state (AVAILABLE, CREATING, ...).
+func (r *FileSystemResource) State() string {
+	return string(r.Item.Lifecycle)
+}
+
+// IsTransitioning reports whether the file system is being created, updated or deleted.
+func (r *FileSystemResource) IsTransitioning() bool {
+	switch r.Item.Lifecycle {
+	case types.FileSystemLifecycleCreating, types.FileSystemLifecycleUpdating, types.FileSystemLifecycleDeleting:
+		return true
+	}
+	return false
+}
+
+// StorageCapacityGiB returns the provisioned storage capacity in GiB.
+func (r *FileSystemResource) StorageCapacityGiB() int32 {
+	if r.Item.StorageCapacity != nil {
+		return *r.Item.StorageCapacity
+	}
+	return 0
+}
+
+// StorageType returns SSD, HDD or INTELLIGENT_TIERING.
+func (r *FileSystemResource) StorageType() string {
+	return string(r.Item.StorageType)
+}
+
+// DNSName returns the DNS name clients mount.
+func (r *FileSystemResource) DNSName() string {
+	return appaws.Str(r.Item.DNSName)
+}
+
+// typeConfig holds the settings every file system type shares, read from
+// whichever type-specific configuration is set.
+type typeConfig struct {
+	DeploymentType    string
+	ThroughputMBps    *int32
+	BackupRetention   *int32
+	BackupStartTime   *string
+	MaintenanceWindow *string
+}
+
+func (r *FileSystemResource) config() typeConfig {
+	switch {
+	case r.Item.WindowsConfiguration != nil:
+		c := r.Item.WindowsConfiguration
+		return typeConfig{string(c.DeploymentType), c.ThroughputCapacity, c.AutomaticBackupRetentionDays, c.DailyAutomaticBackupStartTime, c.WeeklyMaintenanceStartTime}
+	case r.Item.LustreConfiguration != nil:
+		c := r.Item.LustreConfiguration
+		return typeConfig{string(c.DeploymentType), c.ThroughputCapacity, c.AutomaticBackupRetentionDays, c.DailyAutomaticBackupStartTime, c.WeeklyMaintenanceStartTime}
+	case r.Item.OntapConfiguration != nil:
+		c := r.Item.OntapConfiguration
+		return typeConfig{string(c.DeploymentType), c.ThroughputCapacity, c.AutomaticBackupRetentionDays, c.DailyAutomaticBackupStartTime, c.WeeklyMaintenanceStartTime}
+	case r.Item.OpenZFSConfiguration != nil:
+		c := r.Item.OpenZFSConfiguration
+		return typeConfig{string(c.DeploymentType), c.ThroughputCapacity, c.AutomaticBackupRetentionDays, c.DailyAutomaticBackupStartTime, c.WeeklyMaintenanceStartTime}
+	}
+	return typeConfig{}
+}
+
+// DeploymentType returns the type-specific deployment type (MULTI_AZ_1, SCRATCH_2, ...).
+func (r *FileSystemResource) DeploymentType() string {
+	return r.config().DeploymentType
+}
+
+// ThroughputMBps returns the provisioned throughput capacity, or 0 when the
+// type sizes throughput by storage.
+func (r *FileSystemResource) ThroughputMBps() int32 {
+	if t := r.config().ThroughputMBps; t != nil {
+		return *t
+	}
+	return 0
+}
+
+// BackupRetentionDays returns how long automatic backups are kept; 0 means
+// automatic backups are off.
+func (r *FileSystemResource) BackupRetentionDays() int32 {
+	if d := r.config().BackupRetention; d != nil {
+		return *d
+	}
+	return 0
+}
+
+// BackupStartTime returns the daily automatic backup start time (HH:MM UTC).
+func (r *FileSystemResource) BackupStartTime() string {
+	return appaws.Str(r.config().BackupStartTime)
+}
+
+// MaintenanceWindow returns the weekly maintenance start (d:HH:MM UTC).
+func (r *FileSystemResource) MaintenanceWindow() string {
+	return appaws.Str(r.config().MaintenanceWindow)
+}
+
+// CreatedAt returns when the file system was created.
+func (r *FileSystemResource) CreatedAt() *time.Time {
+	return r.Item.CreationTime
+}
@@ -0,0 +1,41 @@
+package filesystems
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+)
+
+func TestFileSystemTypeConfig(t *testing.T) {
+	fs := NewFileSystemResource(types.FileSystem{
+		FileSystemId:    aws.String("fs-0abc"),
+		FileSystemType:  types.FileSystemTypeOntap,
+		Lifecycle:       types.FileSystemLifecycleUpdating,
+		StorageCapacity: aws.Int32(1024),
+		OntapConfiguration: &types.OntapFileSystemConfiguration{
+			DeploymentType:                types.OntapDeploymentTypeMultiAz1,
+			ThroughputCapacity:            aws.Int32(512),
+			AutomaticBackupRetentionDays:  aws.Int32(7),
+			DailyAutomaticBackupStartTime: aws.String("03:00"),
+		},
+		Tags: []types.Tag{{Key: aws.String("Name"), Value: aws.String("netapp")}},
+	})
+
+	if fs.GetName() != "netapp" || fs.DeploymentType() != "MULTI_AZ_1" {
+		t.Errorf("name=%q deployment=%q", fs.GetName(), fs.DeploymentType())
+	}
+	if fs.ThroughputMBps() != 512 || fs.BackupRetentionDays() != 7 || fs.BackupStartTime() != "03:00" {
+		t.Errorf("throughput=%d retention=%d start=%q", fs.ThroughputMBps(), fs.BackupRetentionDays(), fs.BackupStartTime())
+	}
+	if !fs.IsTransitioning() {
+		t.Error("IsTransitioning() = false for UPDATING")
+	}
+
+	lustre := NewFileSystemResource(types.FileSystem{
+		LustreConfiguration: &types.LustreFileSystemConfiguration{DeploymentType: types.LustreDeploymentTypeScratch2},
+	})
+	if lustre.DeploymentType() != "SCRATCH_2" || lustre.ThroughputMBps() != 0 || lustre.BackupRetentionDays() != 0 {
+		t.Errorf("lustre: deployment=%q throughput=%d", lustre.DeploymentType(), lustre.ThroughputMBps())
+	}
+}
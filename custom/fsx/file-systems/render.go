@@ -0,0 +1,213 @@
+package filesystems
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure FileSystemRenderer implements render.Navigator
+var _ render.Navigator = (*FileSystemRenderer)(nil)
+
+// FileSystemRenderer renders FSx file systems.
+type FileSystemRenderer struct {
+	render.BaseRenderer
+}
+
+// NewFileSystemRenderer creates a new FileSystemRenderer.
+func NewFileSystemRenderer() render.Renderer {
+	return &FileSystemRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "fsx",
+			Resource: "file-systems",
+			Cols: []render.Column{
+				{Name: "ID", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "NAME", Width: 24, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "TYPE", Width: 9, Getter: getType},
+				{Name: "STATE", Width: 12, Getter: getState},
+				{Name: "DEPLOYMENT", Width: 16, Getter: getDeployment},
+				{Name: "STORAGE", Width: 12, Getter: getStorage},
+				{Name: "THROUGHPUT", Width: 11, Getter: getThroughput},
+				{Name: "CREATED", Width: 18, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return fs.FileSystemType()
+}
+
+func getState(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return fs.State()
+}
+
+func getDeployment(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return fs.DeploymentType()
+}
+
+func getStorage(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d GiB %s", fs.StorageCapacityGiB(), fs.StorageType())
+}
+
+func getThroughput(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok || fs.ThroughputMBps() == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d MB/s", fs.ThroughputMBps())
+}
+
+func getCreated(r dao.Resource) string {
+	fs, ok := r.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+	if t := fs.CreatedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// lifecycleStyle colors FSx file system and backup lifecycle states.
+func lifecycleStyle(state string) render.Style {
+	switch state {
+	case "AVAILABLE":
+		return ui.SuccessStyle()
+	case "CREATING", "UPDATING", "DELETING":
+		return ui.WarningStyle()
+	case "FAILED", "MISCONFIGURED", "MISCONFIGURED_UNAVAILABLE":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an FSx file system.
+func (r *FileSystemRenderer) RenderDetail(resource dao.Resource) string {
+	fs, ok := resource.(*FileSystemResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("FSx File System", fs.GetID())
+
+	d.Section("Basic Information")
+	d.Field("File System ID", fs.GetID())
+	if name := fs.GetName(); name != "" {
+		d.Field("Name", name)
+	}
+	d.Field("ARN", fs.GetARN())
+	d.Field("Type", fs.FileSystemType())
+	if v := fs.Item.FileSystemTypeVersion; v != nil {
+		d.Field("Version", *v)
+	}
+	d.FieldStyled("State", fs.State(), lifecycleStyle(fs.State()))
+	if fd := fs.Item.FailureDetails; fd != nil && fd.Message != nil {
+		d.FieldStyled("Failure", *fd.Message, ui.DangerStyle())
+	}
+
+	d.Section("Capacity")
+	d.Field("Deployment Type", fs.DeploymentType())
+	d.Field("Storage", fmt.Sprintf("%d GiB", fs.StorageCapacityGiB()))
+	d.Field("Storage Type", fs.StorageType())
+	if t := fs.ThroughputMBps(); t > 0 {
+		d.Field("Throughput", fmt.Sprintf("%d MB/s", t))
+	}
+
+	d.Section("Network")
+	if dns := fs.DNSName(); dns != "" {
+		d.Field("DNS Name", dns)
+	}
+	if c := fs.Item.LustreConfiguration; c != nil && c.MountName != nil {
+		d.Field("Mount Name", *c.MountName)
+	}
+	d.FieldIf("VPC", fs.Item.VpcId)
+	if len(fs.Item.SubnetIds) > 0 {
+		d.Field("Subnets", strings.Join(fs.Item.SubnetIds, ", "))
+	}
+
+	d.Section("Backups & Maintenance")
+	if days := fs.BackupRetentionDays(); days > 0 {
+		d.Field("Automatic Backups", fmt.Sprintf("%d days, daily at %s UTC", days, fs.BackupStartTime()))
+	} else {
+		d.Field("Automatic Backups", "Disabled")
+	}
+	if w := fs.MaintenanceWindow(); w != "" {
+		d.Field("Maintenance Window", w+" UTC")
+	}
+
+	d.Tags(fs.GetTags())
+
+	d.Section("Timestamps")
+	if t := fs.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an FSx file system.
+func (r *FileSystemRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	fs, ok := resource.(*FileSystemResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "ID", Value: fs.GetID()},
+		{Label: "Name", Value: fs.GetName()},
+		{Label: "Type", Value: fs.FileSystemType()},
+		{Label: "State", Value: fs.State(), Style: lifecycleStyle(fs.State())},
+		{Label: "Storage", Value: fmt.Sprintf("%d GiB", fs.StorageCapacityGiB())},
+	}
+}
+
+// Navigations returns available navigations from an FSx file system.
+func (r *FileSystemRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	fs, ok := resource.(*FileSystemResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "b",
+			Label:       "Backups",
+			Service:     "fsx",
+			Resource:    "backups",
+			FilterField: "FileSystemId",
+			FilterValue: fs.GetID(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a file system is changing state
+func (r *FileSystemRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if fs, ok := dao.UnwrapResource(res).(*FileSystemResource); ok && fs.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
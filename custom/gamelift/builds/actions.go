@@ -41,11 +41,10 @@ func executeDeleteBuild(ctx context.Context, resource dao.Resource) action.Actio
 		return action.InvalidResourceResult()
 	}
 
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return action.ActionResult{Success: false, Error: apperrors.Wrap(err, "create gamelift client")}
 	}
-	client := gamelift.NewFromConfig(cfg)
 
 	buildId := build.GetID()
 	_, err = client.DeleteBuild(ctx, &gamelift.DeleteBuildInput{
@@ -21,13 +21,13 @@ type BuildDAO struct {
 
 // NewBuildDAO creates a new BuildDAO.
 func NewBuildDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &BuildDAO{
 		BaseDAO: dao.NewBaseDAO("gamelift", "builds"),
-		client:  gamelift.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
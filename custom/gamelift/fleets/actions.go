@@ -41,11 +41,10 @@ func executeDeleteFleet(ctx context.Context, resource dao.Resource) action.Actio
 		return action.InvalidResourceResult()
 	}
 
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return action.ActionResult{Success: false, Error: apperrors.Wrap(err, "create gamelift client")}
 	}
-	client := gamelift.NewFromConfig(cfg)
 
 	fleetId := fleet.GetID()
 	_, err = client.DeleteFleet(ctx, &gamelift.DeleteFleetInput{
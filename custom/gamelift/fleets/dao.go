@@ -21,13 +21,13 @@ type FleetDAO struct {
 
 // NewFleetDAO creates a new FleetDAO.
 func NewFleetDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &FleetDAO{
 		BaseDAO: dao.NewBaseDAO("gamelift", "fleets"),
-		client:  gamelift.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type QueueDAO struct {
 
 // NewQueueDAO creates a new QueueDAO.
 func NewQueueDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &QueueDAO{
 		BaseDAO: dao.NewBaseDAO("gamelift", "game-session-queues"),
-		client:  gamelift.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
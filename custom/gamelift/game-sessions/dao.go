@@ -21,13 +21,13 @@ type GameSessionDAO struct {
 
 // NewGameSessionDAO creates a new GameSessionDAO.
 func NewGameSessionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &GameSessionDAO{
 		BaseDAO: dao.NewBaseDAO("gamelift", "game-sessions"),
-		client:  gamelift.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -41,11 +41,10 @@ func executeDeleteMatchmakingConfig(ctx context.Context, resource dao.Resource)
 		return action.InvalidResourceResult()
 	}
 
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return action.ActionResult{Success: false, Error: apperrors.Wrap(err, "create gamelift client")}
 	}
-	client := gamelift.NewFromConfig(cfg)
 
 	name := config.GetName()
 	_, err = client.DeleteMatchmakingConfiguration(ctx, &gamelift.DeleteMatchmakingConfigurationInput{
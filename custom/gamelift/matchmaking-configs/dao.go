@@ -21,13 +21,13 @@ type MatchmakingConfigDAO struct {
 
 // NewMatchmakingConfigDAO creates a new MatchmakingConfigDAO.
 func NewMatchmakingConfigDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &MatchmakingConfigDAO{
 		BaseDAO: dao.NewBaseDAO("gamelift", "matchmaking-configs"),
-		client:  gamelift.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
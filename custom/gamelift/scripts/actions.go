@@ -41,11 +41,10 @@ func executeDeleteScript(ctx context.Context, resource dao.Resource) action.Acti
 		return action.InvalidResourceResult()
 	}
 
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return action.ActionResult{Success: false, Error: apperrors.Wrap(err, "create gamelift client")}
 	}
-	client := gamelift.NewFromConfig(cfg)
 
 	scriptId := script.GetID()
 	_, err = client.DeleteScript(ctx, &gamelift.DeleteScriptInput{
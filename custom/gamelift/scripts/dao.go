@@ -21,13 +21,13 @@ type ScriptDAO struct {
 
 // NewScriptDAO creates a new ScriptDAO.
 func NewScriptDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, gamelift.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ScriptDAO{
 		BaseDAO: dao.NewBaseDAO("gamelift", "scripts"),
-		client:  gamelift.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,150 @@
+package accelerators
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	gaClient "github.com/clawscli/claws/custom/globalaccelerator"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// AcceleratorDAO provides data access for Global Accelerator accelerators.
+type AcceleratorDAO struct {
+	dao.BaseDAO
+	client *globalaccelerator.Client
+}
+
+// NewAcceleratorDAO creates a new AcceleratorDAO.
+func NewAcceleratorDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := gaClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &AcceleratorDAO{
+		BaseDAO: dao.NewBaseDAO("globalaccelerator", "accelerators"),
+		client:  client,
+	}, nil
+}
+
+// List returns all standard accelerators in the account.
+func (d *AcceleratorDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	accelerators, err := appaws.Paginate(ctx, func(token *string) ([]types.Accelerator, *string, error) {
+		output, err := d.client.ListAccelerators(ctx, &globalaccelerator.ListAcceleratorsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list accelerators")
+		}
+		return output.Accelerators, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(accelerators))
+	for i, acc := range accelerators {
+		resources[i] = NewAcceleratorResource(acc)
+	}
+	return resources, nil
+}
+
+// Get returns an accelerator by ARN.
+func (d *AcceleratorDAO) Get(ctx context.Context, arn string) (dao.Resource, error) {
+	output, err := d.client.DescribeAccelerator(ctx, &globalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: &arn,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe accelerator %s", arn)
+	}
+	return NewAcceleratorResource(*output.Accelerator), nil
+}
+
+// Delete deletes an accelerator by ARN. It must be disabled first.
+func (d *AcceleratorDAO) Delete(ctx context.Context, arn string) error {
+	_, err := d.client.DeleteAccelerator(ctx, &globalaccelerator.DeleteAcceleratorInput{
+		AcceleratorArn: &arn,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete accelerator %s", arn)
+	}
+	return nil
+}
+
+// AcceleratorResource wraps a Global Accelerator accelerator.
+type AcceleratorResource struct {
+	dao.BaseResource
+	Item types.Accelerator
+}
+
+// NewAcceleratorResource creates a new AcceleratorResource.
+func NewAcceleratorResource(acc types.Accelerator) *AcceleratorResource {
+	return &AcceleratorResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(acc.AcceleratorArn),
+			Name: appaws.Str(acc.Name),
+			ARN:  appaws.Str(acc.AcceleratorArn),
+			Data: acc,
+		},
+		Item: acc,
+	}
+}
+
+// Status returns DEPLOYED or IN_PROGRESS.
+func (r *AcceleratorResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// IsInProgress reports whether a change is still propagating.
+func (r *AcceleratorResource) IsInProgress() bool {
+	return r.Item.Status == types.AcceleratorStatusInProgress
+}
+
+// Enabled reports whether the accelerator accepts traffic.
+func (r *AcceleratorResource) Enabled() bool {
+	return appaws.Bool(r.Item.Enabled)
+}
+
+// DNSName returns the accelerator's IPv4 DNS name.
+func (r *AcceleratorResource) DNSName() string {
+	return appaws.Str(r.Item.DnsName)
+}
+
+// DualStackDNSName returns the dual-stack DNS name, when the accelerator has IPv6.
+func (r *AcceleratorResource) DualStackDNSName() string {
+	return appaws.Str(r.Item.DualStackDnsName)
+}
+
+// IPAddressType returns IPV4 or DUAL_STACK.
+func (r *AcceleratorResource) IPAddressType() string {
+	return string(r.Item.IpAddressType)
+}
+
+// StaticIPs returns the accelerator's anycast addresses.
+func (r *AcceleratorResource) StaticIPs() []string {
+	var ips []string
+	for _, set := range r.Item.IpSets {
+		ips = append(ips, set.IpAddresses...)
+	}
+	return ips
+}
+
+// StaticIPList returns the anycast addresses joined for display.
+func (r *AcceleratorResource) StaticIPList() string {
+	return strings.Join(r.StaticIPs(), ", ")
+}
+
+// CreatedAt returns when the accelerator was created.
+func (r *AcceleratorResource) CreatedAt() *time.Time {
+	return r.Item.CreatedTime
+}
+
+// ModifiedAt returns when the accelerator was last modified.
+func (r *AcceleratorResource) ModifiedAt() *time.Time {
+	return r.Item.LastModifiedTime
+}
@@ -0,0 +1,20 @@
+package accelerators
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("globalaccelerator", "accelerators", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewAcceleratorDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewAcceleratorRenderer()
+		},
+	})
+}
@@ -0,0 +1,190 @@
+package accelerators
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure AcceleratorRenderer implements render.Navigator
+var _ render.Navigator = (*AcceleratorRenderer)(nil)
+
+// AcceleratorRenderer renders Global Accelerator accelerators.
+type AcceleratorRenderer struct {
+	render.BaseRenderer
+}
+
+// NewAcceleratorRenderer creates a new AcceleratorRenderer.
+func NewAcceleratorRenderer() render.Renderer {
+	return &AcceleratorRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "globalaccelerator",
+			Resource: "accelerators",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 26, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "ENABLED", Width: 8, Getter: getEnabled},
+				{Name: "IP TYPE", Width: 11, Getter: getIPType},
+				{Name: "STATIC IPS", Width: 32, Getter: getStaticIPs},
+				{Name: "DNS NAME", Width: 40, Getter: getDNSName},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	acc, ok := r.(*AcceleratorResource)
+	if !ok {
+		return ""
+	}
+	return acc.Status()
+}
+
+func getEnabled(r dao.Resource) string {
+	acc, ok := r.(*AcceleratorResource)
+	if !ok {
+		return ""
+	}
+	return yesNo(acc.Enabled())
+}
+
+func getIPType(r dao.Resource) string {
+	acc, ok := r.(*AcceleratorResource)
+	if !ok {
+		return ""
+	}
+	return acc.IPAddressType()
+}
+
+func getStaticIPs(r dao.Resource) string {
+	acc, ok := r.(*AcceleratorResource)
+	if !ok {
+		return ""
+	}
+	return acc.StaticIPList()
+}
+
+func getDNSName(r dao.Resource) string {
+	acc, ok := r.(*AcceleratorResource)
+	if !ok {
+		return ""
+	}
+	return acc.DNSName()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+func statusStyle(status string) render.Style {
+	switch status {
+	case "DEPLOYED":
+		return ui.SuccessStyle()
+	case "IN_PROGRESS":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an accelerator.
+func (r *AcceleratorRenderer) RenderDetail(resource dao.Resource) string {
+	acc, ok := resource.(*AcceleratorResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Global Accelerator", acc.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", acc.GetName())
+	d.Field("ARN", acc.GetARN())
+	d.FieldStyled("Status", acc.Status(), statusStyle(acc.Status()))
+	d.Field("Enabled", yesNo(acc.Enabled()))
+
+	d.Section("Addressing")
+	d.Field("IP Address Type", acc.IPAddressType())
+	for _, set := range acc.Item.IpSets {
+		family := string(set.IpAddressFamily)
+		if family == "" {
+			family = "Static IPs"
+		}
+		for _, ip := range set.IpAddresses {
+			d.Field(family, ip)
+		}
+	}
+	d.Field("DNS Name", acc.DNSName())
+	if dual := acc.DualStackDNSName(); dual != "" {
+		d.Field("Dual-Stack DNS Name", dual)
+	}
+
+	if len(acc.Item.Events) > 0 {
+		d.Section("Events")
+		for _, e := range acc.Item.Events {
+			line := appaws.Str(e.Message)
+			if e.Timestamp != nil {
+				line = e.Timestamp.Format("2006-01-02 15:04") + "  " + line
+			}
+			d.Line("  " + line)
+		}
+	}
+
+	d.Section("Timestamps")
+	if t := acc.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if t := acc.ModifiedAt(); t != nil {
+		d.Field("Modified", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an accelerator.
+func (r *AcceleratorRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	acc, ok := resource.(*AcceleratorResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: acc.GetName()},
+		{Label: "Status", Value: acc.Status(), Style: statusStyle(acc.Status())},
+		{Label: "Static IPs", Value: acc.StaticIPList()},
+		{Label: "DNS Name", Value: acc.DNSName()},
+	}
+}
+
+// Navigations returns available navigations from an accelerator.
+func (r *AcceleratorRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	acc, ok := resource.(*AcceleratorResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "l",
+			Label:       "Listeners",
+			Service:     "globalaccelerator",
+			Resource:    "listeners",
+			FilterField: "AcceleratorArn",
+			FilterValue: acc.GetARN(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a change propagates
+func (r *AcceleratorRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if acc, ok := dao.UnwrapResource(res).(*AcceleratorResource); ok && acc.IsInProgress() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,19 @@
+package globalaccelerator
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// apiRegion is the only region serving the Global Accelerator API; the
+// accelerators themselves are global.
+const apiRegion = "us-west-2"
+
+// GetClient returns a Global Accelerator client for the current context's
+// credentials, pinned to the API region
+func GetClient(ctx context.Context) (*globalaccelerator.Client, error) {
+	return appaws.ClientWithRegion(ctx, apiRegion, globalaccelerator.NewFromConfig)
+}
@@ -0,0 +1,136 @@
+package endpointgroups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	gaClient "github.com/clawscli/claws/custom/globalaccelerator"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// EndpointGroupDAO provides data access for Global Accelerator endpoint groups.
+type EndpointGroupDAO struct {
+	dao.BaseDAO
+	client *globalaccelerator.Client
+}
+
+// NewEndpointGroupDAO creates a new EndpointGroupDAO.
+func NewEndpointGroupDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := gaClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &EndpointGroupDAO{
+		BaseDAO: dao.NewBaseDAO("globalaccelerator", "endpoint-groups"),
+		client:  client,
+	}, nil
+}
+
+// List returns the endpoint groups of the listener given by the ListenerArn filter.
+func (d *EndpointGroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	listenerArn := dao.GetFilterFromContext(ctx, "ListenerArn")
+	if listenerArn == "" {
+		return nil, fmt.Errorf("listener ARN filter required")
+	}
+
+	groups, err := appaws.Paginate(ctx, func(token *string) ([]types.EndpointGroup, *string, error) {
+		output, err := d.client.ListEndpointGroups(ctx, &globalaccelerator.ListEndpointGroupsInput{
+			ListenerArn: &listenerArn,
+			NextToken:   token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list endpoint groups for %s", listenerArn)
+		}
+		return output.EndpointGroups, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(groups))
+	for i, g := range groups {
+		resources[i] = NewEndpointGroupResource(g)
+	}
+	return resources, nil
+}
+
+// Get returns an endpoint group by ARN.
+func (d *EndpointGroupDAO) Get(ctx context.Context, arn string) (dao.Resource, error) {
+	output, err := d.client.DescribeEndpointGroup(ctx, &globalaccelerator.DescribeEndpointGroupInput{
+		EndpointGroupArn: &arn,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe endpoint group %s", arn)
+	}
+	return NewEndpointGroupResource(*output.EndpointGroup), nil
+}
+
+// Delete deletes an endpoint group by ARN.
+func (d *EndpointGroupDAO) Delete(ctx context.Context, arn string) error {
+	_, err := d.client.DeleteEndpointGroup(ctx, &globalaccelerator.DeleteEndpointGroupInput{
+		EndpointGroupArn: &arn,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete endpoint group %s", arn)
+	}
+	return nil
+}
+
+// EndpointGroupResource wraps a Global Accelerator endpoint group.
+type EndpointGroupResource struct {
+	dao.BaseResource
+	Item types.EndpointGroup
+}
+
+// NewEndpointGroupResource creates a new EndpointGroupResource.
+func NewEndpointGroupResource(g types.EndpointGroup) *EndpointGroupResource {
+	return &EndpointGroupResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(g.EndpointGroupArn),
+			Name: appaws.Str(g.EndpointGroupRegion),
+			ARN:  appaws.Str(g.EndpointGroupArn),
+			Data: g,
+		},
+		Item: g,
+	}
+}
+
+// Region returns the region the group's endpoints live in.
+func (r *EndpointGroupResource) Region() string {
+	return appaws.Str(r.Item.EndpointGroupRegion)
+}
+
+// TrafficDial returns the percentage of traffic sent to this group.
+func (r *EndpointGroupResource) TrafficDial() float32 {
+	if r.Item.TrafficDialPercentage != nil {
+		return *r.Item.TrafficDialPercentage
+	}
+	return 100
+}
+
+// HealthCounts returns how many endpoints are healthy, out of how many.
+func (r *EndpointGroupResource) HealthCounts() (healthy, total int) {
+	for _, e := range r.Item.EndpointDescriptions {
+		if e.HealthState == types.HealthStateHealthy {
+			healthy++
+		}
+	}
+	return healthy, len(r.Item.EndpointDescriptions)
+}
+
+// HealthCheck describes the group's health check, e.g. "HTTP:80/health".
+func (r *EndpointGroupResource) HealthCheck() string {
+	hc := string(r.Item.HealthCheckProtocol)
+	if r.Item.HealthCheckPort != nil {
+		hc += fmt.Sprintf(":%d", *r.Item.HealthCheckPort)
+	}
+	if r.Item.HealthCheckProtocol != types.HealthCheckProtocolTcp {
+		hc += appaws.Str(r.Item.HealthCheckPath)
+	}
+	return hc
+}
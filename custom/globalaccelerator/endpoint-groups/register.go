@@ -0,0 +1,20 @@
+package endpointgroups
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("globalaccelerator", "endpoint-groups", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewEndpointGroupDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewEndpointGroupRenderer()
+		},
+	})
+}
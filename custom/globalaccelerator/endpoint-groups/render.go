@@ -0,0 +1,147 @@
+package endpointgroups
+
+import (
+	"fmt"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// EndpointGroupRenderer renders Global Accelerator endpoint groups.
+type EndpointGroupRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEndpointGroupRenderer creates a new EndpointGroupRenderer.
+func NewEndpointGroupRenderer() render.Renderer {
+	return &EndpointGroupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "globalaccelerator",
+			Resource: "endpoint-groups",
+			Cols: []render.Column{
+				{Name: "REGION", Width: 16, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "HEALTHY", Width: 9, Getter: getHealthy},
+				{Name: "TRAFFIC DIAL", Width: 13, Getter: getTrafficDial},
+				{Name: "HEALTH CHECK", Width: 30, Getter: getHealthCheck},
+				{Name: "THRESHOLD", Width: 10, Getter: getThreshold},
+			},
+		},
+	}
+}
+
+func getHealthy(r dao.Resource) string {
+	g, ok := r.(*EndpointGroupResource)
+	if !ok {
+		return ""
+	}
+	healthy, total := g.HealthCounts()
+	return fmt.Sprintf("%d/%d", healthy, total)
+}
+
+func getTrafficDial(r dao.Resource) string {
+	g, ok := r.(*EndpointGroupResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%.0f%%", g.TrafficDial())
+}
+
+func getHealthCheck(r dao.Resource) string {
+	g, ok := r.(*EndpointGroupResource)
+	if !ok {
+		return ""
+	}
+	return g.HealthCheck()
+}
+
+func getThreshold(r dao.Resource) string {
+	g, ok := r.(*EndpointGroupResource)
+	if !ok || g.Item.ThresholdCount == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d", *g.Item.ThresholdCount)
+}
+
+func healthStyle(state string) render.Style {
+	switch state {
+	case "HEALTHY":
+		return ui.SuccessStyle()
+	case "UNHEALTHY":
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an endpoint group.
+func (r *EndpointGroupRenderer) RenderDetail(resource dao.Resource) string {
+	g, ok := resource.(*EndpointGroupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Global Accelerator Endpoint Group", g.Region())
+
+	d.Section("Basic Information")
+	d.Field("ARN", g.GetARN())
+	d.Field("Region", g.Region())
+	d.Field("Traffic Dial", fmt.Sprintf("%.0f%%", g.TrafficDial()))
+
+	d.Section("Health Check")
+	d.Field("Check", g.HealthCheck())
+	if g.Item.HealthCheckIntervalSeconds != nil {
+		d.Field("Interval", fmt.Sprintf("%ds", *g.Item.HealthCheckIntervalSeconds))
+	}
+	if g.Item.ThresholdCount != nil {
+		d.Field("Threshold", fmt.Sprintf("%d", *g.Item.ThresholdCount))
+	}
+
+	if len(g.Item.PortOverrides) > 0 {
+		d.Section("Port Overrides")
+		for _, po := range g.Item.PortOverrides {
+			d.Field(fmt.Sprintf("Listener %d", appaws.Int32(po.ListenerPort)), fmt.Sprintf("→ %d", appaws.Int32(po.EndpointPort)))
+		}
+	}
+
+	if len(g.Item.EndpointDescriptions) > 0 {
+		healthy, total := g.HealthCounts()
+		d.Section(fmt.Sprintf("Endpoints (%d/%d healthy)", healthy, total))
+		for _, e := range g.Item.EndpointDescriptions {
+			state := string(e.HealthState)
+			value := fmt.Sprintf("%s  weight %d", state, appaws.Int32(e.Weight))
+			if appaws.Bool(e.ClientIPPreservationEnabled) {
+				value += "  client IP preserved"
+			}
+			d.FieldStyled(appaws.Str(e.EndpointId), value, healthStyle(state))
+			if reason := appaws.Str(e.HealthReason); reason != "" && e.HealthState != "HEALTHY" {
+				d.DimIndent(reason)
+			}
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an endpoint group.
+func (r *EndpointGroupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	g, ok := resource.(*EndpointGroupResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	healthy, total := g.HealthCounts()
+	style := ui.SuccessStyle()
+	if healthy < total {
+		style = ui.WarningStyle()
+	}
+	return []render.SummaryField{
+		{Label: "Region", Value: g.Region()},
+		{Label: "Healthy", Value: fmt.Sprintf("%d/%d", healthy, total), Style: style},
+		{Label: "Traffic Dial", Value: fmt.Sprintf("%.0f%%", g.TrafficDial())},
+		{Label: "Health Check", Value: g.HealthCheck()},
+	}
+}
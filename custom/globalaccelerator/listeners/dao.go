@@ -0,0 +1,138 @@
+package listeners
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	gaClient "github.com/clawscli/claws/custom/globalaccelerator"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ListenerDAO provides data access for Global Accelerator listeners.
+type ListenerDAO struct {
+	dao.BaseDAO
+	client *globalaccelerator.Client
+}
+
+// NewListenerDAO creates a new ListenerDAO.
+func NewListenerDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := gaClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ListenerDAO{
+		BaseDAO: dao.NewBaseDAO("globalaccelerator", "listeners"),
+		client:  client,
+	}, nil
+}
+
+// List returns the listeners of the accelerator given by the AcceleratorArn filter.
+func (d *ListenerDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	acceleratorArn := dao.GetFilterFromContext(ctx, "AcceleratorArn")
+	if acceleratorArn == "" {
+		return nil, fmt.Errorf("accelerator ARN filter required")
+	}
+
+	listeners, err := appaws.Paginate(ctx, func(token *string) ([]types.Listener, *string, error) {
+		output, err := d.client.ListListeners(ctx, &globalaccelerator.ListListenersInput{
+			AcceleratorArn: &acceleratorArn,
+			NextToken:      token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list listeners for %s", acceleratorArn)
+		}
+		return output.Listeners, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(listeners))
+	for i, l := range listeners {
+		resources[i] = NewListenerResource(l, acceleratorArn)
+	}
+	return resources, nil
+}
+
+// Get returns a listener by ARN.
+func (d *ListenerDAO) Get(ctx context.Context, arn string) (dao.Resource, error) {
+	output, err := d.client.DescribeListener(ctx, &globalaccelerator.DescribeListenerInput{
+		ListenerArn: &arn,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe listener %s", arn)
+	}
+	return NewListenerResource(*output.Listener, acceleratorARN(arn)), nil
+}
+
+// Delete deletes a listener by ARN. Its endpoint groups must be deleted first.
+func (d *ListenerDAO) Delete(ctx context.Context, arn string) error {
+	_, err := d.client.DeleteListener(ctx, &globalaccelerator.DeleteListenerInput{
+		ListenerArn: &arn,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete listener %s", arn)
+	}
+	return nil
+}
+
+// acceleratorARN derives the parent accelerator ARN from a listener ARN
+// (arn:aws:globalaccelerator::<account>:accelerator/<id>/listener/<id>).
+func acceleratorARN(listenerArn string) string {
+	if i := strings.Index(listenerArn, "/listener/"); i > 0 {
+		return listenerArn[:i]
+	}
+	return ""
+}
+
+// ListenerResource wraps a Global Accelerator listener.
+type ListenerResource struct {
+	dao.BaseResource
+	Item           types.Listener
+	AcceleratorArn string
+}
+
+// NewListenerResource creates a new ListenerResource.
+func NewListenerResource(l types.Listener, acceleratorArn string) *ListenerResource {
+	r := &ListenerResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(l.ListenerArn),
+			ARN:  appaws.Str(l.ListenerArn),
+			Data: l,
+		},
+		Item:           l,
+		AcceleratorArn: acceleratorArn,
+	}
+	r.Name = strings.TrimSpace(r.Protocol() + " " + r.PortRanges())
+	return r
+}
+
+// Protocol returns TCP or UDP.
+func (r *ListenerResource) Protocol() string {
+	return string(r.Item.Protocol)
+}
+
+// ClientAffinity returns NONE or SOURCE_IP.
+func (r *ListenerResource) ClientAffinity() string {
+	return string(r.Item.ClientAffinity)
+}
+
+// PortRanges returns the listener's port ranges, e.g. "80, 8000-8080".
+func (r *ListenerResource) PortRanges() string {
+	ranges := make([]string, 0, len(r.Item.PortRanges))
+	for _, pr := range r.Item.PortRanges {
+		from, to := appaws.Int32(pr.FromPort), appaws.Int32(pr.ToPort)
+		if from == to {
+			ranges = append(ranges, fmt.Sprintf("%d", from))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", from, to))
+		}
+	}
+	return strings.Join(ranges, ", ")
+}
@@ -0,0 +1,30 @@
+package listeners
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+)
+
+func TestListenerName(t *testing.T) {
+	arn := "arn:aws:globalaccelerator::123456789012:accelerator/abcd/listener/0123"
+	l := NewListenerResource(types.Listener{
+		ListenerArn: aws.String(arn),
+		Protocol:    types.ProtocolTcp,
+		PortRanges: []types.PortRange{
+			{FromPort: aws.Int32(80), ToPort: aws.Int32(80)},
+			{FromPort: aws.Int32(8000), ToPort: aws.Int32(8080)},
+		},
+	}, "")
+
+	if got := l.GetName(); got != "TCP 80, 8000-8080" {
+		t.Errorf("GetName() = %q", got)
+	}
+	if got := acceleratorARN(arn); got != "arn:aws:globalaccelerator::123456789012:accelerator/abcd" {
+		t.Errorf("acceleratorARN() = %q", got)
+	}
+	if got := acceleratorARN("not-an-arn"); got != "" {
+		t.Errorf("acceleratorARN(invalid) = %q", got)
+	}
+}
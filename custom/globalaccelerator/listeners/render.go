@@ -0,0 +1,109 @@
+package listeners
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure ListenerRenderer implements render.Navigator
+var _ render.Navigator = (*ListenerRenderer)(nil)
+
+// ListenerRenderer renders Global Accelerator listeners.
+type ListenerRenderer struct {
+	render.BaseRenderer
+}
+
+// NewListenerRenderer creates a new ListenerRenderer.
+func NewListenerRenderer() render.Renderer {
+	return &ListenerRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "globalaccelerator",
+			Resource: "listeners",
+			Cols: []render.Column{
+				{Name: "PROTOCOL", Width: 9, Getter: getProtocol},
+				{Name: "PORTS", Width: 30, Getter: getPorts},
+				{Name: "AFFINITY", Width: 10, Getter: getAffinity},
+				{Name: "LISTENER ARN", Width: 90, Getter: func(r dao.Resource) string { return r.GetARN() }},
+			},
+		},
+	}
+}
+
+func getProtocol(r dao.Resource) string {
+	l, ok := r.(*ListenerResource)
+	if !ok {
+		return ""
+	}
+	return l.Protocol()
+}
+
+func getPorts(r dao.Resource) string {
+	l, ok := r.(*ListenerResource)
+	if !ok {
+		return ""
+	}
+	return l.PortRanges()
+}
+
+func getAffinity(r dao.Resource) string {
+	l, ok := r.(*ListenerResource)
+	if !ok {
+		return ""
+	}
+	return l.ClientAffinity()
+}
+
+// RenderDetail renders the detail view for a listener.
+func (r *ListenerRenderer) RenderDetail(resource dao.Resource) string {
+	l, ok := resource.(*ListenerResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Global Accelerator Listener", l.GetName())
+
+	d.Section("Basic Information")
+	d.Field("ARN", l.GetARN())
+	if l.AcceleratorArn != "" {
+		d.Field("Accelerator", l.AcceleratorArn)
+	}
+	d.Field("Protocol", l.Protocol())
+	d.Field("Port Ranges", l.PortRanges())
+	d.Field("Client Affinity", l.ClientAffinity())
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a listener.
+func (r *ListenerRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	l, ok := resource.(*ListenerResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Protocol", Value: l.Protocol()},
+		{Label: "Ports", Value: l.PortRanges()},
+		{Label: "Client Affinity", Value: l.ClientAffinity()},
+	}
+}
+
+// Navigations returns available navigations from a listener.
+func (r *ListenerRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	l, ok := resource.(*ListenerResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "e",
+			Label:       "Endpoint Groups",
+			Service:     "globalaccelerator",
+			Resource:    "endpoint-groups",
+			FilterField: "ListenerArn",
+			FilterValue: l.GetARN(),
+		},
+	}
+}
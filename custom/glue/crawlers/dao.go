@@ -20,13 +20,13 @@ type CrawlerDAO struct {
 
 // NewCrawlerDAO creates a new CrawlerDAO.
 func NewCrawlerDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, glue.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &CrawlerDAO{
 		BaseDAO: dao.NewBaseDAO("glue", "crawlers"),
-		client:  glue.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
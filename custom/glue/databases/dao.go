@@ -20,13 +20,13 @@ type DatabaseDAO struct {
 
 // NewDatabaseDAO creates a new DatabaseDAO.
 func NewDatabaseDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, glue.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &DatabaseDAO{
 		BaseDAO: dao.NewBaseDAO("glue", "databases"),
-		client:  glue.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type JobRunDAO struct {
 
 // NewJobRunDAO creates a new JobRunDAO.
 func NewJobRunDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, glue.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &JobRunDAO{
 		BaseDAO: dao.NewBaseDAO("glue", "job-runs"),
-		client:  glue.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type JobDAO struct {
 
 // NewJobDAO creates a new JobDAO.
 func NewJobDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, glue.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &JobDAO{
 		BaseDAO: dao.NewBaseDAO("glue", "jobs"),
-		client:  glue.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,135 @@
+package tablepreview
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+
+	appathena "github.com/clawscli/claws/custom/athena"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// previewRows bounds the SELECT so a preview never scans a whole table
+	// unless Athena has to.
+	previewRows = 10
+	// previewWorkGroup is the Athena workgroup previews run in. It needs a
+	// query result location.
+	previewWorkGroup = "primary"
+)
+
+// PreviewDAO runs a bounded SELECT on a Glue table through Athena and lists
+// the returned rows.
+type PreviewDAO struct {
+	dao.BaseDAO
+	client *athena.Client
+}
+
+// NewPreviewDAO creates a new PreviewDAO.
+func NewPreviewDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, athena.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PreviewDAO{
+		BaseDAO: dao.NewBaseDAO("glue", "table-preview"),
+		client:  client,
+	}, nil
+}
+
+// PreviewSQL returns the query used to preview table in database.
+func PreviewSQL(database, table string) string {
+	return fmt.Sprintf("SELECT * FROM %s.%s LIMIT %d",
+		appathena.QuoteIdentifier(database), appathena.QuoteIdentifier(table), previewRows)
+}
+
+// List runs the preview query for the table given as "<database>/<table>".
+func (d *PreviewDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	ref := dao.GetFilterFromContext(ctx, "Table")
+	database, table, ok := strings.Cut(ref, "/")
+	if !ok || database == "" || table == "" {
+		return nil, fmt.Errorf("table filter required - navigate from a Glue table")
+	}
+
+	result, err := appathena.RunQuery(ctx, d.client, previewWorkGroup, database, PreviewSQL(database, table))
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(result.Rows))
+	for i, row := range result.Rows {
+		resources[i] = NewPreviewRowResource(i+1, result.Columns, row, result)
+	}
+	return resources, nil
+}
+
+// Get is not supported; rows only exist in the preview that listed them.
+func (d *PreviewDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get not supported for table preview rows")
+}
+
+// Delete is not supported.
+func (d *PreviewDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for table preview rows")
+}
+
+// Supports returns true only for List operation.
+func (d *PreviewDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// PreviewRowResource is one row returned by a table preview.
+type PreviewRowResource struct {
+	dao.BaseResource
+	Columns     []string
+	Values      []string
+	QueryID     string
+	DataScanned int64
+	Elapsed     time.Duration
+}
+
+// NewPreviewRowResource creates a new PreviewRowResource.
+func NewPreviewRowResource(index int, columns, values []string, result *appathena.QueryResult) *PreviewRowResource {
+	data := make(map[string]string, len(columns))
+	for i, c := range columns {
+		if i < len(values) {
+			data[c] = values[i]
+		}
+	}
+	id := strconv.Itoa(index)
+	return &PreviewRowResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: data,
+		},
+		Columns:     columns,
+		Values:      values,
+		QueryID:     result.QueryExecutionID,
+		DataScanned: result.DataScanned,
+		Elapsed:     result.Elapsed,
+	}
+}
+
+// Value returns the value of column i, or "" past the end of the row.
+func (r *PreviewRowResource) Value(i int) string {
+	if i < len(r.Values) {
+		return r.Values[i]
+	}
+	return ""
+}
+
+// Summary joins the row as "col=value" pairs for the list view.
+func (r *PreviewRowResource) Summary() string {
+	parts := make([]string, len(r.Columns))
+	for i, c := range r.Columns {
+		parts[i] = c + "=" + r.Value(i)
+	}
+	return strings.Join(parts, "  ")
+}
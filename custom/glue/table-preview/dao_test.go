@@ -0,0 +1,29 @@
+package tablepreview
+
+import (
+	"testing"
+
+	appathena "github.com/clawscli/claws/custom/athena"
+)
+
+func TestPreviewSQL(t *testing.T) {
+	want := `SELECT * FROM "sales"."orders" LIMIT 10`
+	if got := PreviewSQL("sales", "orders"); got != want {
+		t.Errorf("PreviewSQL() = %s, want %s", got, want)
+	}
+}
+
+func TestPreviewRowResource(t *testing.T) {
+	result := &appathena.QueryResult{QueryExecutionID: "q-1", DataScanned: 2048}
+	r := NewPreviewRowResource(3, []string{"id", "name", "region"}, []string{"7", "widget"}, result)
+
+	if r.GetID() != "3" || r.QueryID != "q-1" {
+		t.Errorf("id = %q, query = %q", r.GetID(), r.QueryID)
+	}
+	if got := r.Summary(); got != "id=7  name=widget  region=" {
+		t.Errorf("Summary() = %q", got)
+	}
+	if data := r.Data.(map[string]string); data["name"] != "widget" || len(data) != 2 {
+		t.Errorf("data = %v", data)
+	}
+}
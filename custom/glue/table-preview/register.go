@@ -0,0 +1,20 @@
+package tablepreview
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("glue", "table-preview", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPreviewDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPreviewRenderer()
+		},
+	})
+}
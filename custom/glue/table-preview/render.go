@@ -0,0 +1,71 @@
+package tablepreview
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// PreviewRenderer renders rows of a Glue table preview.
+type PreviewRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPreviewRenderer creates a new PreviewRenderer.
+func NewPreviewRenderer() render.Renderer {
+	return &PreviewRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "glue",
+			Resource: "table-preview",
+			Cols: []render.Column{
+				{Name: "#", Width: 4, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "ROW", Width: 120, Getter: getRow},
+			},
+		},
+	}
+}
+
+func getRow(r dao.Resource) string {
+	row, ok := r.(*PreviewRowResource)
+	if !ok {
+		return ""
+	}
+	return row.Summary()
+}
+
+// RenderDetail renders every column of a preview row.
+func (r *PreviewRenderer) RenderDetail(resource dao.Resource) string {
+	row, ok := resource.(*PreviewRowResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Table Preview Row", row.GetID())
+
+	d.Section("Columns")
+	for i, c := range row.Columns {
+		d.Field(c, row.Value(i))
+	}
+
+	d.Section("Query")
+	d.Field("Query Execution ID", row.QueryID)
+	d.Field("Data Scanned", render.FormatSize(row.DataScanned))
+	d.Field("Elapsed", row.Elapsed.String())
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a preview row.
+func (r *PreviewRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	row, ok := resource.(*PreviewRowResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Row", Value: row.GetID()},
+		{Label: "Data Scanned", Value: render.FormatSize(row.DataScanned)},
+		{Label: "Query", Value: row.QueryID},
+	}
+}
@@ -21,13 +21,13 @@ type TableDAO struct {
 
 // NewTableDAO creates a new TableDAO.
 func NewTableDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, glue.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TableDAO{
 		BaseDAO: dao.NewBaseDAO("glue", "tables"),
-		client:  glue.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -170,3 +170,38 @@ func (r *TableResource) CreateTime() *time.Time {
 func (r *TableResource) UpdateTime() *time.Time {
 	return r.Item.UpdateTime
 }
+
+// Columns returns the table's data columns.
+func (r *TableResource) Columns() []types.Column {
+	if r.Item.StorageDescriptor != nil {
+		return r.Item.StorageDescriptor.Columns
+	}
+	return nil
+}
+
+// PartitionKeys returns the columns the table is partitioned by.
+func (r *TableResource) PartitionKeys() []types.Column {
+	return r.Item.PartitionKeys
+}
+
+// PartitionKeyNames returns the partition key names, in partition order.
+func (r *TableResource) PartitionKeyNames() []string {
+	names := make([]string, len(r.Item.PartitionKeys))
+	for i, c := range r.Item.PartitionKeys {
+		names[i] = appaws.Str(c.Name)
+	}
+	return names
+}
+
+// SerDe returns the serialization library and its parameters.
+func (r *TableResource) SerDe() *types.SerDeInfo {
+	if r.Item.StorageDescriptor != nil {
+		return r.Item.StorageDescriptor.SerdeInfo
+	}
+	return nil
+}
+
+// PreviewRef returns the table as "<database>/<table>" for glue/table-preview.
+func (r *TableResource) PreviewRef() string {
+	return r.DatabaseName + "/" + r.Name()
+}
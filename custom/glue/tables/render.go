@@ -2,6 +2,13 @@ package tables
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -22,6 +29,7 @@ func NewTableRenderer() render.Renderer {
 				{Name: "TABLE NAME", Width: 35, Getter: func(r dao.Resource) string { return r.GetID() }},
 				{Name: "TYPE", Width: 15, Getter: getTableType},
 				{Name: "COLUMNS", Width: 10, Getter: getColumns},
+				{Name: "PARTITION KEYS", Width: 25, Getter: getPartitionKeys},
 				{Name: "LOCATION", Width: 50, Getter: getLocation},
 				{Name: "UPDATED", Width: 20, Getter: getUpdated},
 			},
@@ -45,6 +53,14 @@ func getColumns(r dao.Resource) string {
 	return fmt.Sprintf("%d", table.ColumnCount())
 }
 
+func getPartitionKeys(r dao.Resource) string {
+	table, ok := r.(*TableResource)
+	if !ok {
+		return ""
+	}
+	return strings.Join(table.PartitionKeyNames(), ", ")
+}
+
 func getLocation(r dao.Resource) string {
 	table, ok := r.(*TableResource)
 	if !ok {
@@ -101,10 +117,26 @@ func (r *TableRenderer) RenderDetail(resource dao.Resource) string {
 	if output := table.OutputFormat(); output != "" {
 		d.Field("Output Format", output)
 	}
+	if serde := table.SerDe(); serde != nil {
+		if lib := appaws.Str(serde.SerializationLibrary); lib != "" {
+			d.Field("SerDe Library", lib)
+		}
+		renderParameters(d, serde.Parameters)
+	}
 
 	// Schema
-	d.Section("Schema")
-	d.Field("Column Count", fmt.Sprintf("%d", table.ColumnCount()))
+	d.Section(fmt.Sprintf("Columns (%d)", table.ColumnCount()))
+	renderColumns(d, table.Columns())
+
+	if keys := table.PartitionKeys(); len(keys) > 0 {
+		d.Section(fmt.Sprintf("Partition Keys (%d)", len(keys)))
+		renderColumns(d, keys)
+	}
+
+	if params := table.Item.Parameters; len(params) > 0 {
+		d.Section("Table Properties")
+		renderParameters(d, params)
+	}
 
 	// Timestamps
 	d.Section("Timestamps")
@@ -118,6 +150,42 @@ func (r *TableRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// renderColumns shows each column as its type, followed by its comment.
+func renderColumns(d *render.DetailBuilder, columns []types.Column) {
+	for _, c := range columns {
+		value := appaws.Str(c.Type)
+		if comment := appaws.Str(c.Comment); comment != "" {
+			value += "  # " + comment
+		}
+		d.Field(appaws.Str(c.Name), value)
+	}
+}
+
+// renderParameters shows key/value parameters sorted by key.
+func renderParameters(d *render.DetailBuilder, params map[string]string) {
+	for _, k := range slices.Sorted(maps.Keys(params)) {
+		d.Field(k, params[k])
+	}
+}
+
+// Navigations returns available navigations from a table.
+func (r *TableRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	table, ok := resource.(*TableResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "p",
+			Label:       "Preview (Athena)",
+			Service:     "glue",
+			Resource:    "table-preview",
+			FilterField: "Table",
+			FilterValue: table.PreviewRef(),
+		},
+	}
+}
+
 // RenderSummary renders summary fields for a Glue table.
 func (r *TableRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	table, ok := resource.(*TableResource)
@@ -20,13 +20,13 @@ type DetectorDAO struct {
 
 // NewDetectorDAO creates a new DetectorDAO
 func NewDetectorDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, guardduty.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &DetectorDAO{
 		BaseDAO: dao.NewBaseDAO("guardduty", "detectors"),
-		client:  guardduty.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -22,14 +22,14 @@ type EventDAO struct {
 
 // NewEventDAO creates a new EventDAO.
 func NewEventDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	// The Health API is served from one global region per partition
+	client, err := appaws.ClientWithRegion(ctx, appaws.HealthRegionFor(appaws.CurrentRegion(ctx)), health.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
-	// Health API requires us-east-1 region
 	return &EventDAO{
 		BaseDAO: dao.NewBaseDAO("health", "events"),
-		client:  health.NewFromConfig(cfg, func(o *health.Options) { o.Region = "us-east-1" }),
+		client:  client,
 	}, nil
 }
 
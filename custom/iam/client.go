@@ -10,9 +10,5 @@ import (
 
 // GetClient returns an IAM client configured for the current context
 func GetClient(ctx context.Context) (*iam.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return iam.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, iam.NewFromConfig)
 }
@@ -19,13 +19,13 @@ type GroupDAO struct {
 
 // NewGroupDAO creates a new GroupDAO
 func NewGroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, iam.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &GroupDAO{
 		BaseDAO: dao.NewBaseDAO("iam", "groups"),
-		client:  iam.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
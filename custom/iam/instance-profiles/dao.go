@@ -20,13 +20,13 @@ type InstanceProfileDAO struct {
 
 // NewInstanceProfileDAO creates a new InstanceProfileDAO
 func NewInstanceProfileDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, iam.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &InstanceProfileDAO{
 		BaseDAO: dao.NewBaseDAO("iam", "instance-profiles"),
-		client:  iam.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
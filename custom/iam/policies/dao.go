@@ -19,13 +19,13 @@ type PolicyDAO struct {
 
 // NewPolicyDAO creates a new PolicyDAO
 func NewPolicyDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, iam.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &PolicyDAO{
 		BaseDAO: dao.NewBaseDAO("iam", "policies"),
-		client:  iam.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
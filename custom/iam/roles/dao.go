@@ -19,13 +19,13 @@ type RoleDAO struct {
 
 // NewRoleDAO creates a new RoleDAO
 func NewRoleDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, iam.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RoleDAO{
 		BaseDAO: dao.NewBaseDAO("iam", "roles"),
-		client:  iam.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -29,13 +29,13 @@ type UserDAO struct {
 
 // NewUserDAO creates a new UserDAO
 func NewUserDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, iam.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &UserDAO{
 		BaseDAO: dao.NewBaseDAO("iam", "users"),
-		client:  iam.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
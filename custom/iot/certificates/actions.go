@@ -0,0 +1,76 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+
+	iotClient "github.com/clawscli/claws/custom/iot"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("iot", "certificates", []action.Action{
+		{
+			Name:      "Activate",
+			Shortcut:  "E",
+			Type:      action.ActionTypeAPI,
+			Operation: "ActivateCertificate",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				c, ok := r.(*CertificateResource)
+				return ok && c.CanActivate()
+			},
+		},
+		{
+			// Devices using the certificate are disconnected and cannot reconnect
+			Name:      "Deactivate",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeactivateCertificate",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				c, ok := r.(*CertificateResource)
+				return ok && c.CanDeactivate()
+			},
+		},
+	})
+
+	action.RegisterExecutor("iot", "certificates", executeCertificateAction)
+}
+
+func executeCertificateAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "ActivateCertificate":
+		return executeUpdateStatus(ctx, resource, types.CertificateStatusActive)
+	case "DeactivateCertificate":
+		return executeUpdateStatus(ctx, resource, types.CertificateStatusInactive)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeUpdateStatus(ctx context.Context, resource dao.Resource, status types.CertificateStatus) action.ActionResult {
+	c, ok := resource.(*CertificateResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := iotClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := c.GetID()
+	_, err = client.UpdateCertificate(ctx, &iot.UpdateCertificateInput{
+		CertificateId: &id,
+		NewStatus:     status,
+	})
+	if err != nil {
+		return action.FailResultf(err, "set certificate %s to %s", id, status)
+	}
+	return action.SuccessResult(fmt.Sprintf("Certificate %s is now %s", id, status))
+}
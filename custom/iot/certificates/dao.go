@@ -0,0 +1,172 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+
+	iotClient "github.com/clawscli/claws/custom/iot"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// CertificateDAO provides data access for IoT device certificates
+type CertificateDAO struct {
+	dao.BaseDAO
+	client *iot.Client
+}
+
+// NewCertificateDAO creates a new CertificateDAO
+func NewCertificateDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := iotClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &CertificateDAO{
+		BaseDAO: dao.NewBaseDAO("iot", "certificates"),
+		client:  client,
+	}, nil
+}
+
+// List returns all certificates, newest first. With the ThingName filter
+// only certificates attached to that thing are returned.
+func (d *CertificateDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var attached map[string]bool
+	if thing := dao.GetFilterFromContext(ctx, "ThingName"); thing != "" {
+		principals, err := d.thingPrincipals(ctx, thing)
+		if err != nil {
+			return nil, err
+		}
+		if len(principals) == 0 {
+			return nil, nil
+		}
+		attached = principals
+	}
+
+	certs, err := appaws.Paginate(ctx, func(token *string) ([]types.Certificate, *string, error) {
+		output, err := d.client.ListCertificates(ctx, &iot.ListCertificatesInput{
+			Marker: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list iot certificates")
+		}
+		return output.Certificates, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(certs))
+	for _, cert := range certs {
+		if attached != nil && !attached[appaws.Str(cert.CertificateArn)] {
+			continue
+		}
+		resources = append(resources, NewCertificateResource(cert))
+	}
+	return resources, nil
+}
+
+// thingPrincipals returns the ARNs of the principals attached to a thing
+func (d *CertificateDAO) thingPrincipals(ctx context.Context, thing string) (map[string]bool, error) {
+	arns, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := d.client.ListThingPrincipals(ctx, &iot.ListThingPrincipalsInput{
+			ThingName: &thing,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list principals of iot thing %s", thing)
+		}
+		return output.Principals, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]bool, len(arns))
+	for _, arn := range arns {
+		set[arn] = true
+	}
+	return set, nil
+}
+
+// Get returns a specific certificate with its validity and owner
+func (d *CertificateDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeCertificate(ctx, &iot.DescribeCertificateInput{
+		CertificateId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe iot certificate %s", id)
+	}
+	desc := output.CertificateDescription
+	if desc == nil {
+		return nil, fmt.Errorf("certificate not found: %s", id)
+	}
+	r := NewCertificateResource(types.Certificate{
+		CertificateArn:  desc.CertificateArn,
+		CertificateId:   desc.CertificateId,
+		CertificateMode: desc.CertificateMode,
+		CreationDate:    desc.CreationDate,
+		Status:          desc.Status,
+	})
+	r.Description = desc
+	return r, nil
+}
+
+// Delete deletes a certificate. IoT only deletes inactive certificates
+// without attached policies or things.
+func (d *CertificateDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteCertificate(ctx, &iot.DeleteCertificateInput{
+		CertificateId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete iot certificate %s", id)
+	}
+	return nil
+}
+
+// CertificateResource wraps an IoT device certificate
+type CertificateResource struct {
+	dao.BaseResource
+	Item        types.Certificate
+	Description *types.CertificateDescription
+}
+
+// NewCertificateResource creates a new CertificateResource
+func NewCertificateResource(cert types.Certificate) *CertificateResource {
+	id := appaws.Str(cert.CertificateId)
+	return &CertificateResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			ARN:  appaws.Str(cert.CertificateArn),
+			Data: cert,
+		},
+		Item: cert,
+	}
+}
+
+// Status returns ACTIVE, INACTIVE, REVOKED, PENDING_TRANSFER, ...
+func (r *CertificateResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// CanActivate reports whether the certificate can be set to ACTIVE
+func (r *CertificateResource) CanActivate() bool {
+	return r.Item.Status == types.CertificateStatusInactive
+}
+
+// CanDeactivate reports whether the certificate can be set to INACTIVE
+func (r *CertificateResource) CanDeactivate() bool {
+	return r.Item.Status == types.CertificateStatusActive
+}
+
+// NotAfter returns the expiry time, or nil if the certificate was not described
+func (r *CertificateResource) NotAfter() *time.Time {
+	if r.Description == nil || r.Description.Validity == nil {
+		return nil
+	}
+	return r.Description.Validity.NotAfter
+}
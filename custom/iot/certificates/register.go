@@ -0,0 +1,20 @@
+package certificates
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("iot", "certificates", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewCertificateDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewCertificateRenderer()
+		},
+	})
+}
@@ -0,0 +1,146 @@
+package certificates
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// CertificateRenderer renders IoT device certificates
+type CertificateRenderer struct {
+	render.BaseRenderer
+}
+
+// NewCertificateRenderer creates a new CertificateRenderer
+func NewCertificateRenderer() render.Renderer {
+	return &CertificateRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "iot",
+			Resource: "certificates",
+			Cols: []render.Column{
+				{Name: "CERTIFICATE ID", Width: 66, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 18, Getter: getStatus},
+				{Name: "MODE", Width: 10, Getter: getMode},
+				{Name: "CREATED", Width: 12, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	c, ok := r.(*CertificateResource)
+	if !ok {
+		return ""
+	}
+	return c.Status()
+}
+
+func getMode(r dao.Resource) string {
+	c, ok := r.(*CertificateResource)
+	if !ok {
+		return ""
+	}
+	return string(c.Item.CertificateMode)
+}
+
+func getCreated(r dao.Resource) string {
+	c, ok := r.(*CertificateResource)
+	if !ok || c.Item.CreationDate == nil {
+		return ""
+	}
+	return render.FormatAge(*c.Item.CreationDate)
+}
+
+func statusStyle(status string) render.Style {
+	switch status {
+	case "ACTIVE":
+		return ui.SuccessStyle()
+	case "REVOKED":
+		return ui.DangerStyle()
+	case "PENDING_TRANSFER", "PENDING_ACTIVATION", "REGISTER_INACTIVE":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a certificate
+func (r *CertificateRenderer) RenderDetail(resource dao.Resource) string {
+	c, ok := resource.(*CertificateResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("IoT Certificate", c.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Certificate ID", c.GetID())
+	d.FieldStyled("Status", c.Status(), statusStyle(c.Status()))
+	if mode := getMode(c); mode != "" {
+		d.Field("Mode", mode)
+	}
+	if c.GetARN() != "" {
+		d.Field("ARN", c.GetARN())
+	}
+	if c.Item.CreationDate != nil {
+		d.Field("Created", c.Item.CreationDate.Format("2006-01-02 15:04:05"))
+	}
+
+	desc := c.Description
+	if desc == nil {
+		return d.String()
+	}
+
+	d.FieldIf("CA Certificate", desc.CaCertificateId)
+	d.FieldIf("Owner", desc.OwnedBy)
+	d.FieldIf("Previous Owner", desc.PreviousOwnedBy)
+	if desc.LastModifiedDate != nil {
+		d.Field("Last Modified", desc.LastModifiedDate.Format("2006-01-02 15:04:05"))
+	}
+
+	if v := desc.Validity; v != nil {
+		d.Section("Validity")
+		if v.NotBefore != nil {
+			d.Field("Not Before", v.NotBefore.Format("2006-01-02 15:04:05"))
+		}
+		if v.NotAfter != nil {
+			if time.Now().After(*v.NotAfter) {
+				d.FieldStyled("Not After", v.NotAfter.Format("2006-01-02 15:04:05")+" (expired)", ui.DangerStyle())
+			} else {
+				d.Field("Not After", v.NotAfter.Format("2006-01-02 15:04:05"))
+			}
+		}
+	}
+
+	if t := desc.TransferData; t != nil {
+		d.Section("Transfer")
+		d.FieldIf("Message", t.TransferMessage)
+		d.FieldIf("Reject Reason", t.RejectReason)
+		if t.TransferDate != nil {
+			d.Field("Transferred", t.TransferDate.Format("2006-01-02 15:04:05"))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a certificate
+func (r *CertificateRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	c, ok := resource.(*CertificateResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Certificate ID", Value: c.GetID()},
+		{Label: "Status", Value: c.Status(), Style: statusStyle(c.Status())},
+	}
+	if t := c.NotAfter(); t != nil {
+		fields = append(fields, render.SummaryField{Label: "Expires", Value: t.Format("2006-01-02")})
+	}
+	return fields
+}
@@ -0,0 +1,14 @@
+package iot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an IoT Core client configured for the current context
+func GetClient(ctx context.Context) (*iot.Client, error) {
+	return appaws.Client(ctx, iot.NewFromConfig)
+}
@@ -0,0 +1,243 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+	"golang.org/x/sync/errgroup"
+
+	iotClient "github.com/clawscli/claws/custom/iot"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ruleConcurrency bounds parallel GetTopicRule calls during List.
+const ruleConcurrency = 8
+
+// RuleDAO provides data access for IoT topic rules
+type RuleDAO struct {
+	dao.BaseDAO
+	client *iot.Client
+}
+
+// NewRuleDAO creates a new RuleDAO
+func NewRuleDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := iotClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &RuleDAO{
+		BaseDAO: dao.NewBaseDAO("iot", "rules"),
+		client:  client,
+	}, nil
+}
+
+// List returns all topic rules. ListTopicRules omits the SQL and actions, so
+// each rule is fetched; failures keep the summary.
+func (d *RuleDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	items, err := appaws.Paginate(ctx, func(token *string) ([]types.TopicRuleListItem, *string, error) {
+		output, err := d.client.ListTopicRules(ctx, &iot.ListTopicRulesInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list iot topic rules")
+		}
+		return output.Rules, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	resources := make([]dao.Resource, 0, len(items))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(ruleConcurrency)
+	for _, item := range items {
+		g.Go(func() error {
+			r := NewRuleResource(item)
+			rule, err := d.getRule(gctx, r.GetName())
+			if err != nil {
+				log.Debug("failed to get iot topic rule", "rule", r.GetName(), "error", err)
+			} else {
+				r.Rule = rule
+			}
+			mu.Lock()
+			resources = append(resources, r)
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetName() < resources[j].GetName()
+	})
+	return resources, nil
+}
+
+// Get returns a specific topic rule
+func (d *RuleDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.client.GetTopicRule(ctx, &iot.GetTopicRuleInput{
+		RuleName: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get iot topic rule %s", name)
+	}
+	if output.Rule == nil {
+		return nil, fmt.Errorf("topic rule not found: %s", name)
+	}
+	rule := output.Rule
+	r := NewRuleResource(types.TopicRuleListItem{
+		RuleName:     rule.RuleName,
+		RuleArn:      output.RuleArn,
+		RuleDisabled: rule.RuleDisabled,
+		CreatedAt:    rule.CreatedAt,
+	})
+	r.Rule = rule
+	return r, nil
+}
+
+// Delete deletes a topic rule
+func (d *RuleDAO) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteTopicRule(ctx, &iot.DeleteTopicRuleInput{
+		RuleName: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete iot topic rule %s", name)
+	}
+	return nil
+}
+
+func (d *RuleDAO) getRule(ctx context.Context, name string) (*types.TopicRule, error) {
+	output, err := d.client.GetTopicRule(ctx, &iot.GetTopicRuleInput{
+		RuleName: &name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Rule, nil
+}
+
+// RuleResource wraps an IoT topic rule
+type RuleResource struct {
+	dao.BaseResource
+	Item types.TopicRuleListItem
+	Rule *types.TopicRule
+}
+
+// NewRuleResource creates a new RuleResource
+func NewRuleResource(item types.TopicRuleListItem) *RuleResource {
+	name := appaws.Str(item.RuleName)
+	return &RuleResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(item.RuleArn),
+			Data: item,
+		},
+		Item: item,
+	}
+}
+
+// IsEnabled reports whether the rule is enabled
+func (r *RuleResource) IsEnabled() bool {
+	return !appaws.Bool(r.Item.RuleDisabled)
+}
+
+// SQL returns the rule's SQL statement as written
+func (r *RuleResource) SQL() string {
+	if r.Rule == nil {
+		return ""
+	}
+	return appaws.Str(r.Rule.Sql)
+}
+
+// SQLPreview returns the SQL statement on a single line
+func (r *RuleResource) SQLPreview() string {
+	return strings.Join(strings.Fields(r.SQL()), " ")
+}
+
+// Actions returns the rule's actions, or nil if the rule was not fetched
+func (r *RuleResource) Actions() []types.Action {
+	if r.Rule == nil {
+		return nil
+	}
+	return r.Rule.Actions
+}
+
+// clauseRe matches the whitespace before the clauses of an IoT SQL statement.
+var clauseRe = regexp.MustCompile(`(?i)\s+(FROM|WHERE)\s+`)
+
+// formatSQL puts each clause of an IoT SQL statement on its own line.
+func formatSQL(sql string) []string {
+	oneLine := strings.Join(strings.Fields(sql), " ")
+	if oneLine == "" {
+		return nil
+	}
+	return strings.Split(clauseRe.ReplaceAllString(oneLine, "\n$1 "), "\n")
+}
+
+// actionTarget returns the kind of a rule action and the resource it sends to.
+func actionTarget(a types.Action) (kind, target string) {
+	switch {
+	case a.Lambda != nil:
+		return "Lambda", appaws.Str(a.Lambda.FunctionArn)
+	case a.S3 != nil:
+		return "S3", appaws.Str(a.S3.BucketName) + "/" + appaws.Str(a.S3.Key)
+	case a.DynamoDB != nil:
+		return "DynamoDB", appaws.Str(a.DynamoDB.TableName)
+	case a.DynamoDBv2 != nil:
+		if a.DynamoDBv2.PutItem != nil {
+			return "DynamoDBv2", appaws.Str(a.DynamoDBv2.PutItem.TableName)
+		}
+		return "DynamoDBv2", ""
+	case a.Kinesis != nil:
+		return "Kinesis", appaws.Str(a.Kinesis.StreamName)
+	case a.Firehose != nil:
+		return "Firehose", appaws.Str(a.Firehose.DeliveryStreamName)
+	case a.Sqs != nil:
+		return "SQS", appaws.Str(a.Sqs.QueueUrl)
+	case a.Sns != nil:
+		return "SNS", appaws.Str(a.Sns.TargetArn)
+	case a.Republish != nil:
+		return "Republish", appaws.Str(a.Republish.Topic)
+	case a.StepFunctions != nil:
+		return "Step Functions", appaws.Str(a.StepFunctions.StateMachineName)
+	case a.CloudwatchLogs != nil:
+		return "CloudWatch Logs", appaws.Str(a.CloudwatchLogs.LogGroupName)
+	case a.CloudwatchMetric != nil:
+		return "CloudWatch Metric", appaws.Str(a.CloudwatchMetric.MetricNamespace) + "/" + appaws.Str(a.CloudwatchMetric.MetricName)
+	case a.CloudwatchAlarm != nil:
+		return "CloudWatch Alarm", appaws.Str(a.CloudwatchAlarm.AlarmName)
+	case a.Http != nil:
+		return "HTTP", appaws.Str(a.Http.Url)
+	case a.Timestream != nil:
+		return "Timestream", appaws.Str(a.Timestream.DatabaseName) + "/" + appaws.Str(a.Timestream.TableName)
+	case a.Kafka != nil:
+		return "Kafka", appaws.Str(a.Kafka.Topic)
+	case a.OpenSearch != nil:
+		return "OpenSearch", appaws.Str(a.OpenSearch.Endpoint)
+	case a.Elasticsearch != nil:
+		return "Elasticsearch", appaws.Str(a.Elasticsearch.Endpoint)
+	case a.IotAnalytics != nil:
+		return "IoT Analytics", appaws.Str(a.IotAnalytics.ChannelName)
+	case a.IotEvents != nil:
+		return "IoT Events", appaws.Str(a.IotEvents.InputName)
+	case a.IotSiteWise != nil:
+		return "IoT SiteWise", ""
+	case a.Location != nil:
+		return "Location", appaws.Str(a.Location.TrackerName)
+	case a.Salesforce != nil:
+		return "Salesforce", appaws.Str(a.Salesforce.Url)
+	default:
+		return "Unknown", ""
+	}
+}
@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+)
+
+func TestFormatSQL(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want []string
+	}{
+		{"", nil},
+		{
+			"SELECT temperature, deviceId\n   FROM 'sensors/+/telemetry'   where temperature > 40",
+			[]string{"SELECT temperature, deviceId", "FROM 'sensors/+/telemetry'", "where temperature > 40"},
+		},
+		{"SELECT * FROM 'a/b'", []string{"SELECT *", "FROM 'a/b'"}},
+	}
+	for _, tt := range tests {
+		if got := formatSQL(tt.sql); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("formatSQL(%q) = %q, want %q", tt.sql, got, tt.want)
+		}
+	}
+}
+
+func TestRuleResource_SQLPreview(t *testing.T) {
+	r := NewRuleResource(types.TopicRuleListItem{RuleName: aws.String("alerts")})
+	if r.SQLPreview() != "" || !r.IsEnabled() {
+		t.Errorf("unfetched rule: preview %q, enabled %v", r.SQLPreview(), r.IsEnabled())
+	}
+
+	r.Rule = &types.TopicRule{Sql: aws.String("SELECT *\n\tFROM 'a/#'")}
+	if got := r.SQLPreview(); got != "SELECT * FROM 'a/#'" {
+		t.Errorf("SQLPreview() = %q", got)
+	}
+}
+
+func TestActionTarget(t *testing.T) {
+	tests := []struct {
+		action       types.Action
+		kind, target string
+	}{
+		{types.Action{Lambda: &types.LambdaAction{FunctionArn: aws.String("arn:fn")}}, "Lambda", "arn:fn"},
+		{types.Action{S3: &types.S3Action{BucketName: aws.String("raw"), Key: aws.String("${topic()}")}}, "S3", "raw/${topic()}"},
+		{types.Action{Republish: &types.RepublishAction{Topic: aws.String("alerts/high")}}, "Republish", "alerts/high"},
+		{types.Action{DynamoDBv2: &types.DynamoDBv2Action{}}, "DynamoDBv2", ""},
+		{types.Action{}, "Unknown", ""},
+	}
+	for _, tt := range tests {
+		kind, target := actionTarget(tt.action)
+		if kind != tt.kind || target != tt.target {
+			t.Errorf("actionTarget() = %q, %q, want %q, %q", kind, target, tt.kind, tt.target)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// RuleRenderer renders IoT topic rules
+type RuleRenderer struct {
+	render.BaseRenderer
+}
+
+// NewRuleRenderer creates a new RuleRenderer
+func NewRuleRenderer() render.Renderer {
+	return &RuleRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "iot",
+			Resource: "rules",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATE", Width: 9, Getter: getState},
+				{Name: "TOPIC", Width: 28, Getter: getTopic},
+				{Name: "ACTIONS", Width: 24, Getter: getActions},
+				{Name: "CREATED", Width: 12, Getter: getCreated},
+				{Name: "SQL", Width: 60, Getter: getSQL},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	rule, ok := r.(*RuleResource)
+	if !ok {
+		return ""
+	}
+	if rule.IsEnabled() {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
+
+func getTopic(r dao.Resource) string {
+	rule, ok := r.(*RuleResource)
+	if !ok || rule.Item.TopicPattern == nil {
+		return ""
+	}
+	return *rule.Item.TopicPattern
+}
+
+func getActions(r dao.Resource) string {
+	rule, ok := r.(*RuleResource)
+	if !ok {
+		return ""
+	}
+	kinds := make([]string, 0, len(rule.Actions()))
+	for _, a := range rule.Actions() {
+		kind, _ := actionTarget(a)
+		kinds = append(kinds, kind)
+	}
+	return strings.Join(kinds, ", ")
+}
+
+func getCreated(r dao.Resource) string {
+	rule, ok := r.(*RuleResource)
+	if !ok || rule.Item.CreatedAt == nil {
+		return ""
+	}
+	return render.FormatAge(*rule.Item.CreatedAt)
+}
+
+func getSQL(r dao.Resource) string {
+	rule, ok := r.(*RuleResource)
+	if !ok {
+		return ""
+	}
+	return rule.SQLPreview()
+}
+
+func stateStyle(enabled bool) render.Style {
+	if enabled {
+		return ui.SuccessStyle()
+	}
+	return ui.DimStyle()
+}
+
+// RenderDetail renders the detail view for a topic rule
+func (r *RuleRenderer) RenderDetail(resource dao.Resource) string {
+	rule, ok := resource.(*RuleResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("IoT Topic Rule", rule.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", rule.GetName())
+	d.FieldStyled("State", getState(rule), stateStyle(rule.IsEnabled()))
+	if topic := getTopic(rule); topic != "" {
+		d.Field("Topic Pattern", topic)
+	}
+	if rule.GetARN() != "" {
+		d.Field("ARN", rule.GetARN())
+	}
+	if rule.Item.CreatedAt != nil {
+		d.Field("Created", rule.Item.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+
+	item := rule.Rule
+	if item == nil {
+		return d.String()
+	}
+	d.FieldIf("Description", item.Description)
+
+	d.Section("SQL")
+	d.FieldIf("SQL Version", item.AwsIotSqlVersion)
+	for _, line := range formatSQL(rule.SQL()) {
+		d.Line("  " + line)
+	}
+
+	if len(item.Actions) > 0 {
+		d.Section(fmt.Sprintf("Actions (%d)", len(item.Actions)))
+		for _, a := range item.Actions {
+			kind, target := actionTarget(a)
+			d.Field(kind, target)
+		}
+	}
+
+	if item.ErrorAction != nil {
+		d.Section("Error Action")
+		kind, target := actionTarget(*item.ErrorAction)
+		d.Field(kind, target)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a topic rule
+func (r *RuleRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	rule, ok := resource.(*RuleResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: rule.GetName()},
+		{Label: "State", Value: getState(rule), Style: stateStyle(rule.IsEnabled())},
+		{Label: "SQL", Value: rule.SQLPreview()},
+	}
+}
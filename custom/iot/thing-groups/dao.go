@@ -0,0 +1,177 @@
+package thinggroups
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+	"golang.org/x/sync/errgroup"
+
+	iotClient "github.com/clawscli/claws/custom/iot"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// describeConcurrency bounds parallel DescribeThingGroup calls during List.
+const describeConcurrency = 8
+
+// ThingGroupDAO provides data access for IoT thing groups
+type ThingGroupDAO struct {
+	dao.BaseDAO
+	client *iot.Client
+}
+
+// NewThingGroupDAO creates a new ThingGroupDAO
+func NewThingGroupDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := iotClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ThingGroupDAO{
+		BaseDAO: dao.NewBaseDAO("iot", "thing-groups"),
+		client:  client,
+	}, nil
+}
+
+// List returns all thing groups. ListThingGroups only returns names, so each
+// group is described; failures keep the name and ARN.
+func (d *ThingGroupDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	groups, err := appaws.Paginate(ctx, func(token *string) ([]types.GroupNameAndArn, *string, error) {
+		output, err := d.client.ListThingGroups(ctx, &iot.ListThingGroupsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list iot thing groups")
+		}
+		return output.ThingGroups, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	resources := make([]dao.Resource, 0, len(groups))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeConcurrency)
+	for _, group := range groups {
+		g.Go(func() error {
+			r := NewThingGroupResource(group)
+			detail, err := d.describe(gctx, r.GetName())
+			if err != nil {
+				log.Debug("failed to describe iot thing group", "group", r.GetName(), "error", err)
+			} else {
+				r.Detail = detail
+			}
+			mu.Lock()
+			resources = append(resources, r)
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetName() < resources[j].GetName()
+	})
+	return resources, nil
+}
+
+// Get returns a specific thing group
+func (d *ThingGroupDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	detail, err := d.describe(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	r := NewThingGroupResource(types.GroupNameAndArn{
+		GroupName: detail.ThingGroupName,
+		GroupArn:  detail.ThingGroupArn,
+	})
+	r.Detail = detail
+	return r, nil
+}
+
+// Delete deletes a thing group
+func (d *ThingGroupDAO) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteThingGroup(ctx, &iot.DeleteThingGroupInput{
+		ThingGroupName: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete iot thing group %s", name)
+	}
+	return nil
+}
+
+func (d *ThingGroupDAO) describe(ctx context.Context, name string) (*iot.DescribeThingGroupOutput, error) {
+	output, err := d.client.DescribeThingGroup(ctx, &iot.DescribeThingGroupInput{
+		ThingGroupName: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe iot thing group %s", name)
+	}
+	return output, nil
+}
+
+// ThingGroupResource wraps an IoT thing group
+type ThingGroupResource struct {
+	dao.BaseResource
+	Item   types.GroupNameAndArn
+	Detail *iot.DescribeThingGroupOutput
+}
+
+// NewThingGroupResource creates a new ThingGroupResource
+func NewThingGroupResource(group types.GroupNameAndArn) *ThingGroupResource {
+	name := appaws.Str(group.GroupName)
+	return &ThingGroupResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(group.GroupArn),
+			Data: group,
+		},
+		Item: group,
+	}
+}
+
+// IsDynamic reports whether membership is driven by a fleet indexing query
+func (r *ThingGroupResource) IsDynamic() bool {
+	return r.Query() != ""
+}
+
+// Kind returns "dynamic" or "static"
+func (r *ThingGroupResource) Kind() string {
+	if r.Detail == nil {
+		return ""
+	}
+	if r.IsDynamic() {
+		return "dynamic"
+	}
+	return "static"
+}
+
+// Query returns the fleet indexing query of a dynamic group
+func (r *ThingGroupResource) Query() string {
+	if r.Detail == nil {
+		return ""
+	}
+	return appaws.Str(r.Detail.QueryString)
+}
+
+// ParentGroup returns the parent group name, or "" for root groups
+func (r *ThingGroupResource) ParentGroup() string {
+	if r.Detail == nil || r.Detail.ThingGroupMetadata == nil {
+		return ""
+	}
+	return appaws.Str(r.Detail.ThingGroupMetadata.ParentGroupName)
+}
+
+// Description returns the group description
+func (r *ThingGroupResource) Description() string {
+	if r.Detail == nil || r.Detail.ThingGroupProperties == nil {
+		return ""
+	}
+	return appaws.Str(r.Detail.ThingGroupProperties.ThingGroupDescription)
+}
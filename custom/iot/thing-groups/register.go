@@ -0,0 +1,20 @@
+package thinggroups
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("iot", "thing-groups", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewThingGroupDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewThingGroupRenderer()
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package thinggroups
+
+import (
+	"sort"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ThingGroupRenderer renders IoT thing groups
+type ThingGroupRenderer struct {
+	render.BaseRenderer
+}
+
+// NewThingGroupRenderer creates a new ThingGroupRenderer
+func NewThingGroupRenderer() render.Renderer {
+	return &ThingGroupRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "iot",
+			Resource: "thing-groups",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "KIND", Width: 8, Getter: getKind},
+				{Name: "PARENT", Width: 24, Getter: getParent},
+				{Name: "CREATED", Width: 12, Getter: getCreated},
+				{Name: "DESCRIPTION", Width: 40, Getter: getDescription},
+			},
+		},
+	}
+}
+
+func getKind(r dao.Resource) string {
+	g, ok := r.(*ThingGroupResource)
+	if !ok {
+		return ""
+	}
+	return g.Kind()
+}
+
+func getParent(r dao.Resource) string {
+	g, ok := r.(*ThingGroupResource)
+	if !ok {
+		return ""
+	}
+	return g.ParentGroup()
+}
+
+func getCreated(r dao.Resource) string {
+	g, ok := r.(*ThingGroupResource)
+	if !ok || g.Detail == nil || g.Detail.ThingGroupMetadata == nil || g.Detail.ThingGroupMetadata.CreationDate == nil {
+		return ""
+	}
+	return render.FormatAge(*g.Detail.ThingGroupMetadata.CreationDate)
+}
+
+func getDescription(r dao.Resource) string {
+	g, ok := r.(*ThingGroupResource)
+	if !ok {
+		return ""
+	}
+	return g.Description()
+}
+
+// RenderDetail renders the detail view for a thing group
+func (r *ThingGroupRenderer) RenderDetail(resource dao.Resource) string {
+	g, ok := resource.(*ThingGroupResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("IoT Thing Group", g.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", g.GetName())
+	if g.GetARN() != "" {
+		d.Field("ARN", g.GetARN())
+	}
+	if desc := g.Description(); desc != "" {
+		d.Field("Description", desc)
+	}
+	if kind := g.Kind(); kind != "" {
+		d.Field("Kind", kind)
+	}
+
+	detail := g.Detail
+	if detail == nil {
+		return d.String()
+	}
+
+	if meta := detail.ThingGroupMetadata; meta != nil {
+		if len(meta.RootToParentThingGroups) > 0 {
+			path := make([]string, 0, len(meta.RootToParentThingGroups)+1)
+			for _, p := range meta.RootToParentThingGroups {
+				path = append(path, appaws.Str(p.GroupName))
+			}
+			path = append(path, g.GetName())
+			d.Field("Hierarchy", strings.Join(path, " / "))
+		}
+		if meta.CreationDate != nil {
+			d.Field("Created", meta.CreationDate.Format("2006-01-02 15:04:05"))
+		}
+	}
+
+	if g.IsDynamic() {
+		d.Section("Dynamic Membership")
+		d.Field("Query", g.Query())
+		d.FieldIf("Query Version", detail.QueryVersion)
+		d.FieldIf("Index", detail.IndexName)
+		if detail.Status != "" {
+			d.Field("Status", string(detail.Status))
+		}
+	}
+
+	if props := detail.ThingGroupProperties; props != nil && props.AttributePayload != nil && len(props.AttributePayload.Attributes) > 0 {
+		attrs := props.AttributePayload.Attributes
+		d.Section("Attributes")
+		keys := make([]string, 0, len(attrs))
+		for k := range attrs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			d.Field(k, attrs[k])
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a thing group
+func (r *ThingGroupRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	g, ok := resource.(*ThingGroupResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: g.GetName()},
+		{Label: "Kind", Value: g.Kind()},
+		{Label: "Parent", Value: g.ParentGroup()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *ThingGroupRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	g, ok := resource.(*ThingGroupResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "t", Label: "Things", Service: "iot", Resource: "things", FilterField: "ThingGroupName", FilterValue: g.GetName()},
+	}
+}
@@ -0,0 +1,171 @@
+package things
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/iot"
+	"github.com/aws/aws-sdk-go-v2/service/iot/types"
+	"golang.org/x/sync/errgroup"
+
+	iotClient "github.com/clawscli/claws/custom/iot"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// describeConcurrency bounds parallel DescribeThing calls for group members.
+const describeConcurrency = 8
+
+// ThingDAO provides data access for IoT things
+type ThingDAO struct {
+	dao.BaseDAO
+	client *iot.Client
+}
+
+// NewThingDAO creates a new ThingDAO
+func NewThingDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := iotClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ThingDAO{
+		BaseDAO: dao.NewBaseDAO("iot", "things"),
+		client:  client,
+	}, nil
+}
+
+// List returns all things, or the members of a thing group when the
+// ThingGroupName filter is set.
+func (d *ThingDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var (
+		things []types.ThingAttribute
+		err    error
+	)
+	if group := dao.GetFilterFromContext(ctx, "ThingGroupName"); group != "" {
+		things, err = d.listGroupMembers(ctx, group)
+	} else {
+		things, err = appaws.Paginate(ctx, func(token *string) ([]types.ThingAttribute, *string, error) {
+			output, err := d.client.ListThings(ctx, &iot.ListThingsInput{
+				NextToken: token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrap(err, "list iot things")
+			}
+			return output.Things, output.NextToken, nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(things, func(i, j int) bool {
+		return appaws.Str(things[i].ThingName) < appaws.Str(things[j].ThingName)
+	})
+
+	resources := make([]dao.Resource, len(things))
+	for i, thing := range things {
+		resources[i] = NewThingResource(thing)
+	}
+	return resources, nil
+}
+
+// listGroupMembers lists the things in a group. ListThingsInThingGroup only
+// returns names, so each thing is described; failures keep the bare name.
+func (d *ThingDAO) listGroupMembers(ctx context.Context, group string) ([]types.ThingAttribute, error) {
+	names, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := d.client.ListThingsInThingGroup(ctx, &iot.ListThingsInThingGroupInput{
+			ThingGroupName: &group,
+			NextToken:      token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list things in iot thing group %s", group)
+		}
+		return output.Things, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	things := make([]types.ThingAttribute, 0, len(names))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeConcurrency)
+	for _, name := range names {
+		g.Go(func() error {
+			thing, err := d.describe(gctx, name)
+			if err != nil {
+				log.Debug("failed to describe iot thing", "thing", name, "error", err)
+				thing = types.ThingAttribute{ThingName: &name}
+			}
+			mu.Lock()
+			things = append(things, thing)
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return things, nil
+}
+
+// Get returns a specific thing
+func (d *ThingDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	thing, err := d.describe(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewThingResource(thing), nil
+}
+
+// Delete deletes a thing
+func (d *ThingDAO) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteThing(ctx, &iot.DeleteThingInput{
+		ThingName: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete iot thing %s", name)
+	}
+	return nil
+}
+
+func (d *ThingDAO) describe(ctx context.Context, name string) (types.ThingAttribute, error) {
+	output, err := d.client.DescribeThing(ctx, &iot.DescribeThingInput{
+		ThingName: &name,
+	})
+	if err != nil {
+		return types.ThingAttribute{}, apperrors.Wrapf(err, "describe iot thing %s", name)
+	}
+	return types.ThingAttribute{
+		ThingName:     output.ThingName,
+		ThingArn:      output.ThingArn,
+		ThingTypeName: output.ThingTypeName,
+		Attributes:    output.Attributes,
+		Version:       output.Version,
+	}, nil
+}
+
+// ThingResource wraps an IoT thing
+type ThingResource struct {
+	dao.BaseResource
+	Item types.ThingAttribute
+}
+
+// NewThingResource creates a new ThingResource
+func NewThingResource(thing types.ThingAttribute) *ThingResource {
+	name := appaws.Str(thing.ThingName)
+	return &ThingResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			ARN:  appaws.Str(thing.ThingArn),
+			Data: thing,
+		},
+		Item: thing,
+	}
+}
+
+// ThingType returns the thing type name, or "" for untyped things
+func (r *ThingResource) ThingType() string {
+	return appaws.Str(r.Item.ThingTypeName)
+}
@@ -0,0 +1,20 @@
+package things
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("iot", "things", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewThingDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewThingRenderer()
+		},
+	})
+}
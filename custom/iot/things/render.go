@@ -0,0 +1,115 @@
+package things
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ThingRenderer renders IoT things
+type ThingRenderer struct {
+	render.BaseRenderer
+}
+
+// NewThingRenderer creates a new ThingRenderer
+func NewThingRenderer() render.Renderer {
+	return &ThingRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "iot",
+			Resource: "things",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 36, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "TYPE", Width: 24, Getter: getThingType},
+				{Name: "ATTRIBUTES", Width: 10, Getter: getAttributeCount},
+				{Name: "VERSION", Width: 8, Getter: getVersion},
+			},
+		},
+	}
+}
+
+func getThingType(r dao.Resource) string {
+	t, ok := r.(*ThingResource)
+	if !ok {
+		return ""
+	}
+	return t.ThingType()
+}
+
+func getAttributeCount(r dao.Resource) string {
+	t, ok := r.(*ThingResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", len(t.Item.Attributes))
+}
+
+func getVersion(r dao.Resource) string {
+	t, ok := r.(*ThingResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", t.Item.Version)
+}
+
+// RenderDetail renders the detail view for a thing
+func (r *ThingRenderer) RenderDetail(resource dao.Resource) string {
+	t, ok := resource.(*ThingResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("IoT Thing", t.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", t.GetName())
+	if typ := t.ThingType(); typ != "" {
+		d.Field("Thing Type", typ)
+	}
+	d.Field("Version", getVersion(t))
+	if t.GetARN() != "" {
+		d.Field("ARN", t.GetARN())
+	}
+
+	if len(t.Item.Attributes) > 0 {
+		d.Section("Attributes")
+		keys := make([]string, 0, len(t.Item.Attributes))
+		for k := range t.Item.Attributes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			d.Field(k, t.Item.Attributes[k])
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a thing
+func (r *ThingRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	t, ok := resource.(*ThingResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: t.GetName()},
+		{Label: "Type", Value: t.ThingType()},
+		{Label: "Attributes", Value: getAttributeCount(t)},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *ThingRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	t, ok := resource.(*ThingResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{Key: "c", Label: "Certificates", Service: "iot", Resource: "certificates", FilterField: "ThingName", FilterValue: t.GetName()},
+	}
+}
@@ -19,13 +19,13 @@ type StreamDAO struct {
 
 // NewStreamDAO creates a new StreamDAO
 func NewStreamDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, kinesis.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &StreamDAO{
 		BaseDAO: dao.NewBaseDAO("kinesis", "streams"),
-		client:  kinesis.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
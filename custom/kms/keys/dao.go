@@ -20,13 +20,13 @@ type KeyDAO struct {
 
 // NewKeyDAO creates a new KeyDAO
 func NewKeyDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, kms.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &KeyDAO{
 		BaseDAO: dao.NewBaseDAO("kms", "keys"),
-		client:  kms.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -10,9 +10,5 @@ import (
 
 // GetClient returns a Lambda client configured for the current context
 func GetClient(ctx context.Context) (*lambda.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return lambda.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, lambda.NewFromConfig)
 }
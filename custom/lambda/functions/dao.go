@@ -20,13 +20,13 @@ type FunctionDAO struct {
 
 // NewFunctionDAO creates a new FunctionDAO
 func NewFunctionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, lambda.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &FunctionDAO{
 		BaseDAO: dao.NewBaseDAO("lambda", "functions"),
-		client:  lambda.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
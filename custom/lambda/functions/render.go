@@ -367,6 +367,16 @@ func (r *FunctionRenderer) Navigations(resource dao.Resource) []render.Navigatio
 				FilterValue: fn.Item.VpcConfig.SecurityGroupIds[0],
 			})
 		}
+
+		// Hyperplane ENIs created by Lambda for this function
+		navs = append(navs, render.Navigation{
+			Key:         "e",
+			Label:       "ENIs",
+			Service:     "ec2",
+			Resource:    "network-interfaces",
+			FilterField: "FunctionName",
+			FilterValue: fn.GetName(),
+		})
 	}
 
 	return navs
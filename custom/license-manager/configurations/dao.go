@@ -19,13 +19,13 @@ type ConfigurationDAO struct {
 
 // NewConfigurationDAO creates a new ConfigurationDAO.
 func NewConfigurationDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, licensemanager.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ConfigurationDAO{
 		BaseDAO: dao.NewBaseDAO("license-manager", "configurations"),
-		client:  licensemanager.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type GrantDAO struct {
 
 // NewGrantDAO creates a new GrantDAO.
 func NewGrantDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, licensemanager.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &GrantDAO{
 		BaseDAO: dao.NewBaseDAO("license-manager", "grants"),
-		client:  licensemanager.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type LicenseDAO struct {
 
 // NewLicenseDAO creates a new LicenseDAO.
 func NewLicenseDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, licensemanager.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &LicenseDAO{
 		BaseDAO: dao.NewBaseDAO("license-manager", "licenses"),
-		client:  licensemanager.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
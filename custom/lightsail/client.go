@@ -0,0 +1,14 @@
+package lightsail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns a Lightsail client configured for the current context
+func GetClient(ctx context.Context) (*lightsail.Client, error) {
+	return appaws.Client(ctx, lightsail.NewFromConfig)
+}
@@ -0,0 +1,88 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+
+	lightsailClient "github.com/clawscli/claws/custom/lightsail"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Lightsail databases
+	action.Global.Register("lightsail", "databases", []action.Action{
+		{
+			Name:      "Start",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartRelationalDatabase",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				db, ok := dao.UnwrapResource(r).(*DatabaseResource)
+				return ok && db.IsStopped()
+			},
+		},
+		{
+			Name:      "Stop",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopRelationalDatabase",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				db, ok := dao.UnwrapResource(r).(*DatabaseResource)
+				return ok && db.IsAvailable()
+			},
+		},
+		{
+			Name:      "Reboot",
+			Shortcut:  "B",
+			Type:      action.ActionTypeAPI,
+			Operation: "RebootRelationalDatabase",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				db, ok := dao.UnwrapResource(r).(*DatabaseResource)
+				return ok && db.IsAvailable()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("lightsail", "databases", executeDatabaseAction)
+}
+
+// executeDatabaseAction executes an action on a Lightsail database
+func executeDatabaseAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	db, ok := dao.UnwrapResource(resource).(*DatabaseResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := lightsailClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := db.GetName()
+	switch act.Operation {
+	case "StartRelationalDatabase":
+		if _, err := client.StartRelationalDatabase(ctx, &lightsail.StartRelationalDatabaseInput{RelationalDatabaseName: &name}); err != nil {
+			return action.FailResult(fmt.Errorf("start database: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Starting database %s", name))
+	case "StopRelationalDatabase":
+		if _, err := client.StopRelationalDatabase(ctx, &lightsail.StopRelationalDatabaseInput{RelationalDatabaseName: &name}); err != nil {
+			return action.FailResult(fmt.Errorf("stop database: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Stopping database %s", name))
+	case "RebootRelationalDatabase":
+		if _, err := client.RebootRelationalDatabase(ctx, &lightsail.RebootRelationalDatabaseInput{RelationalDatabaseName: &name}); err != nil {
+			return action.FailResult(fmt.Errorf("reboot database: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Rebooting database %s", name))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
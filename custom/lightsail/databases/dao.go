@@ -0,0 +1,188 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DatabaseDAO provides data access for Lightsail managed databases.
+type DatabaseDAO struct {
+	dao.BaseDAO
+	client *lightsail.Client
+}
+
+// NewDatabaseDAO creates a new DatabaseDAO.
+func NewDatabaseDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, lightsail.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DatabaseDAO{
+		BaseDAO: dao.NewBaseDAO("lightsail", "databases"),
+		client:  client,
+	}, nil
+}
+
+// List returns all Lightsail databases in the region.
+func (d *DatabaseDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	dbs, err := appaws.Paginate(ctx, func(token *string) ([]types.RelationalDatabase, *string, error) {
+		output, err := d.client.GetRelationalDatabases(ctx, &lightsail.GetRelationalDatabasesInput{
+			PageToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "get lightsail databases")
+		}
+		return output.RelationalDatabases, output.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(dbs))
+	for i, db := range dbs {
+		resources[i] = NewDatabaseResource(db)
+	}
+	return resources, nil
+}
+
+// Get returns a specific database by name.
+func (d *DatabaseDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetRelationalDatabase(ctx, &lightsail.GetRelationalDatabaseInput{
+		RelationalDatabaseName: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get lightsail database %s", id)
+	}
+	if output.RelationalDatabase == nil {
+		return nil, fmt.Errorf("database not found: %s", id)
+	}
+	return NewDatabaseResource(*output.RelationalDatabase), nil
+}
+
+// Delete deletes a database by name without a final snapshot.
+func (d *DatabaseDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteRelationalDatabase(ctx, &lightsail.DeleteRelationalDatabaseInput{
+		RelationalDatabaseName: &id,
+		SkipFinalSnapshot:      appaws.BoolPtr(true),
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete lightsail database %s", id)
+	}
+	return nil
+}
+
+// DatabaseResource wraps a Lightsail managed database.
+type DatabaseResource struct {
+	dao.BaseResource
+	Item types.RelationalDatabase
+}
+
+// NewDatabaseResource creates a new DatabaseResource.
+func NewDatabaseResource(db types.RelationalDatabase) *DatabaseResource {
+	return &DatabaseResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(db.Name),
+			Name: appaws.Str(db.Name),
+			ARN:  appaws.Str(db.Arn),
+			Tags: appaws.TagsToMap(db.Tags),
+			Data: db,
+		},
+		Item: db,
+	}
+}
+
+// State returns the database state (available, stopped, ...).
+func (r *DatabaseResource) State() string {
+	return appaws.Str(r.Item.State)
+}
+
+// IsAvailable reports whether the database is up.
+func (r *DatabaseResource) IsAvailable() bool {
+	return r.State() == "available"
+}
+
+// IsStopped reports whether the database is stopped.
+func (r *DatabaseResource) IsStopped() bool {
+	return r.State() == "stopped"
+}
+
+// IsTransitioning reports whether the database is between states.
+func (r *DatabaseResource) IsTransitioning() bool {
+	switch r.State() {
+	case "creating", "starting", "stopping", "rebooting", "modifying", "backing-up", "deleting", "updating":
+		return true
+	}
+	return false
+}
+
+// Engine returns the engine and version, e.g. "mysql 8.0.35".
+func (r *DatabaseResource) Engine() string {
+	engine := appaws.Str(r.Item.Engine)
+	if v := appaws.Str(r.Item.EngineVersion); v != "" {
+		engine += " " + v
+	}
+	return engine
+}
+
+// BundleId returns the database plan.
+func (r *DatabaseResource) BundleId() string {
+	return appaws.Str(r.Item.RelationalDatabaseBundleId)
+}
+
+// Endpoint returns the master endpoint as host:port.
+func (r *DatabaseResource) Endpoint() string {
+	ep := r.Item.MasterEndpoint
+	if ep == nil || ep.Address == nil {
+		return ""
+	}
+	if ep.Port != nil {
+		return fmt.Sprintf("%s:%d", *ep.Address, *ep.Port)
+	}
+	return *ep.Address
+}
+
+// PubliclyAccessible reports whether the database accepts connections from outside Lightsail.
+func (r *DatabaseResource) PubliclyAccessible() bool {
+	return appaws.Bool(r.Item.PubliclyAccessible)
+}
+
+// MasterUsername returns the master user name.
+func (r *DatabaseResource) MasterUsername() string {
+	return appaws.Str(r.Item.MasterUsername)
+}
+
+// MasterDatabaseName returns the name of the database created with the instance.
+func (r *DatabaseResource) MasterDatabaseName() string {
+	return appaws.Str(r.Item.MasterDatabaseName)
+}
+
+// AvailabilityZone returns the primary availability zone.
+func (r *DatabaseResource) AvailabilityZone() string {
+	if r.Item.Location != nil {
+		return appaws.Str(r.Item.Location.AvailabilityZone)
+	}
+	return ""
+}
+
+// HighAvailability reports whether the database has a standby in a second zone.
+func (r *DatabaseResource) HighAvailability() bool {
+	return appaws.Str(r.Item.SecondaryAvailabilityZone) != ""
+}
+
+// BackupsEnabled reports whether automatic daily backups are on.
+func (r *DatabaseResource) BackupsEnabled() bool {
+	return appaws.Bool(r.Item.BackupRetentionEnabled)
+}
+
+// CreatedAt returns when the database was created.
+func (r *DatabaseResource) CreatedAt() *time.Time {
+	return r.Item.CreatedAt
+}
@@ -0,0 +1,37 @@
+package databases
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+)
+
+func TestDatabaseResource(t *testing.T) {
+	db := NewDatabaseResource(types.RelationalDatabase{
+		Name:          aws.String("shop-db"),
+		Engine:        aws.String("mysql"),
+		EngineVersion: aws.String("8.0.35"),
+		State:         aws.String("available"),
+		MasterEndpoint: &types.RelationalDatabaseEndpoint{
+			Address: aws.String("ls-abc.cluster.eu-west-1.rds.amazonaws.com"),
+			Port:    aws.Int32(3306),
+		},
+		SecondaryAvailabilityZone: aws.String("eu-west-1b"),
+	})
+
+	if got := db.Engine(); got != "mysql 8.0.35" {
+		t.Errorf("Engine() = %q", got)
+	}
+	if got := db.Endpoint(); got != "ls-abc.cluster.eu-west-1.rds.amazonaws.com:3306" {
+		t.Errorf("Endpoint() = %q", got)
+	}
+	if !db.IsAvailable() || db.IsStopped() || db.IsTransitioning() || !db.HighAvailability() {
+		t.Errorf("available=%v stopped=%v transitioning=%v ha=%v", db.IsAvailable(), db.IsStopped(), db.IsTransitioning(), db.HighAvailability())
+	}
+
+	stopping := NewDatabaseResource(types.RelationalDatabase{State: aws.String("stopping")})
+	if !stopping.IsTransitioning() || stopping.Endpoint() != "" {
+		t.Errorf("stopping database: transitioning=%v endpoint=%q", stopping.IsTransitioning(), stopping.Endpoint())
+	}
+}
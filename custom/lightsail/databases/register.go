@@ -0,0 +1,20 @@
+package databases
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("lightsail", "databases", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewDatabaseDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewDatabaseRenderer()
+		},
+	})
+}
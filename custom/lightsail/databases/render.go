@@ -0,0 +1,152 @@
+package databases
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// DatabaseRenderer renders Lightsail managed databases.
+type DatabaseRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDatabaseRenderer creates a new DatabaseRenderer.
+func NewDatabaseRenderer() render.Renderer {
+	return &DatabaseRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "lightsail",
+			Resource: "databases",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATE", Width: 12, Getter: getState},
+				{Name: "ENGINE", Width: 18, Getter: getEngine},
+				{Name: "PLAN", Width: 18, Getter: getPlan},
+				{Name: "ENDPOINT", Width: 50, Getter: getEndpoint},
+				{Name: "PUBLIC", Width: 7, Getter: getPublic},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	if db, ok := r.(*DatabaseResource); ok {
+		return db.State()
+	}
+	return ""
+}
+
+func getEngine(r dao.Resource) string {
+	if db, ok := r.(*DatabaseResource); ok {
+		return db.Engine()
+	}
+	return ""
+}
+
+func getPlan(r dao.Resource) string {
+	if db, ok := r.(*DatabaseResource); ok {
+		return db.BundleId()
+	}
+	return ""
+}
+
+func getEndpoint(r dao.Resource) string {
+	if db, ok := r.(*DatabaseResource); ok {
+		return db.Endpoint()
+	}
+	return ""
+}
+
+func getPublic(r dao.Resource) string {
+	if db, ok := r.(*DatabaseResource); ok {
+		return yesNo(db.PubliclyAccessible())
+	}
+	return ""
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// RenderDetail renders the detail view for a Lightsail database.
+func (r *DatabaseRenderer) RenderDetail(resource dao.Resource) string {
+	db, ok := resource.(*DatabaseResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Lightsail Database", db.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", db.GetName())
+	d.Field("ARN", db.GetARN())
+	d.FieldStyled("State", db.State(), render.StateColorer()(db.State()))
+	d.Field("Engine", db.Engine())
+	d.Field("Plan", db.BundleId())
+	if hw := db.Item.Hardware; hw != nil && hw.CpuCount != nil && hw.RamSizeInGb != nil {
+		d.Field("Size", fmt.Sprintf("%d vCPU / %g GB", *hw.CpuCount, *hw.RamSizeInGb))
+	}
+	d.Field("Availability Zone", db.AvailabilityZone())
+	d.Field("High Availability", yesNo(db.HighAvailability()))
+
+	d.Section("Connection")
+	if ep := db.Endpoint(); ep != "" {
+		d.Field("Endpoint", ep)
+	}
+	d.Field("Publicly Accessible", yesNo(db.PubliclyAccessible()))
+	d.Field("Master Username", db.MasterUsername())
+	if name := db.MasterDatabaseName(); name != "" {
+		d.Field("Database Name", name)
+	}
+
+	d.Section("Maintenance")
+	d.Field("Automatic Backups", yesNo(db.BackupsEnabled()))
+	d.FieldIf("Backup Window", db.Item.PreferredBackupWindow)
+	d.FieldIf("Maintenance Window", db.Item.PreferredMaintenanceWindow)
+	if t := db.Item.LatestRestorableTime; t != nil {
+		d.Field("Latest Restorable", t.Format("2006-01-02 15:04:05"))
+	}
+
+	if t := db.CreatedAt(); t != nil {
+		d.Section("Timestamps")
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Tags(db.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a Lightsail database.
+func (r *DatabaseRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	db, ok := resource.(*DatabaseResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: db.GetName()},
+		{Label: "State", Value: db.State(), Style: render.StateColorer()(db.State())},
+		{Label: "Engine", Value: db.Engine()},
+	}
+	if ep := db.Endpoint(); ep != "" {
+		fields = append(fields, render.SummaryField{Label: "Endpoint", Value: ep})
+	}
+	return fields
+}
+
+// NeedsAutoReload keeps the list refreshing while a database is changing state
+func (r *DatabaseRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if db, ok := dao.UnwrapResource(res).(*DatabaseResource); ok && db.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
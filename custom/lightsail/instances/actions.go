@@ -0,0 +1,88 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+
+	lightsailClient "github.com/clawscli/claws/custom/lightsail"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Lightsail instances
+	action.Global.Register("lightsail", "instances", []action.Action{
+		{
+			Name:      "Start",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartInstance",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				inst, ok := dao.UnwrapResource(r).(*InstanceResource)
+				return ok && inst.IsStopped()
+			},
+		},
+		{
+			Name:      "Stop",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StopInstance",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				inst, ok := dao.UnwrapResource(r).(*InstanceResource)
+				return ok && inst.IsRunning()
+			},
+		},
+		{
+			Name:      "Reboot",
+			Shortcut:  "B",
+			Type:      action.ActionTypeAPI,
+			Operation: "RebootInstance",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				inst, ok := dao.UnwrapResource(r).(*InstanceResource)
+				return ok && inst.IsRunning()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("lightsail", "instances", executeInstanceAction)
+}
+
+// executeInstanceAction executes an action on a Lightsail instance
+func executeInstanceAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	inst, ok := dao.UnwrapResource(resource).(*InstanceResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := lightsailClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	name := inst.GetName()
+	switch act.Operation {
+	case "StartInstance":
+		if _, err := client.StartInstance(ctx, &lightsail.StartInstanceInput{InstanceName: &name}); err != nil {
+			return action.FailResult(fmt.Errorf("start instance: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Starting instance %s", name))
+	case "StopInstance":
+		if _, err := client.StopInstance(ctx, &lightsail.StopInstanceInput{InstanceName: &name}); err != nil {
+			return action.FailResult(fmt.Errorf("stop instance: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Stopping instance %s", name))
+	case "RebootInstance":
+		if _, err := client.RebootInstance(ctx, &lightsail.RebootInstanceInput{InstanceName: &name}); err != nil {
+			return action.FailResult(fmt.Errorf("reboot instance: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Rebooting instance %s", name))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
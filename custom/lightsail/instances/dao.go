@@ -0,0 +1,215 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// InstanceDAO provides data access for Lightsail instances.
+type InstanceDAO struct {
+	dao.BaseDAO
+	client *lightsail.Client
+}
+
+// NewInstanceDAO creates a new InstanceDAO.
+func NewInstanceDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, lightsail.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &InstanceDAO{
+		BaseDAO: dao.NewBaseDAO("lightsail", "instances"),
+		client:  client,
+	}, nil
+}
+
+// List returns all Lightsail instances in the region.
+func (d *InstanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	instances, err := appaws.Paginate(ctx, func(token *string) ([]types.Instance, *string, error) {
+		output, err := d.client.GetInstances(ctx, &lightsail.GetInstancesInput{
+			PageToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "get lightsail instances")
+		}
+		return output.Instances, output.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(instances))
+	for i, inst := range instances {
+		resources[i] = NewInstanceResource(inst)
+	}
+	return resources, nil
+}
+
+// Get returns a specific instance by name.
+func (d *InstanceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetInstance(ctx, &lightsail.GetInstanceInput{
+		InstanceName: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get lightsail instance %s", id)
+	}
+	if output.Instance == nil {
+		return nil, fmt.Errorf("instance not found: %s", id)
+	}
+	return NewInstanceResource(*output.Instance), nil
+}
+
+// Delete deletes an instance by name.
+func (d *InstanceDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteInstance(ctx, &lightsail.DeleteInstanceInput{
+		InstanceName: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete lightsail instance %s", id)
+	}
+	return nil
+}
+
+// InstanceResource wraps a Lightsail instance.
+type InstanceResource struct {
+	dao.BaseResource
+	Item types.Instance
+}
+
+// NewInstanceResource creates a new InstanceResource.
+func NewInstanceResource(inst types.Instance) *InstanceResource {
+	return &InstanceResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(inst.Name),
+			Name: appaws.Str(inst.Name),
+			ARN:  appaws.Str(inst.Arn),
+			Tags: appaws.TagsToMap(inst.Tags),
+			Data: inst,
+		},
+		Item: inst,
+	}
+}
+
+// State returns the instance state name (running, stopped, pending, ...).
+func (r *InstanceResource) State() string {
+	if r.Item.State != nil {
+		return appaws.Str(r.Item.State.Name)
+	}
+	return ""
+}
+
+// IsRunning reports whether the instance is running.
+func (r *InstanceResource) IsRunning() bool {
+	return r.State() == "running"
+}
+
+// IsStopped reports whether the instance is stopped.
+func (r *InstanceResource) IsStopped() bool {
+	return r.State() == "stopped"
+}
+
+// IsTransitioning reports whether the instance is between states.
+func (r *InstanceResource) IsTransitioning() bool {
+	switch r.State() {
+	case "pending", "starting", "stopping", "rebooting", "shutting-down":
+		return true
+	}
+	return false
+}
+
+// Blueprint returns the blueprint (OS or app image) name.
+func (r *InstanceResource) Blueprint() string {
+	if name := appaws.Str(r.Item.BlueprintName); name != "" {
+		return name
+	}
+	return appaws.Str(r.Item.BlueprintId)
+}
+
+// BundleId returns the instance plan.
+func (r *InstanceResource) BundleId() string {
+	return appaws.Str(r.Item.BundleId)
+}
+
+// PublicIP returns the public IPv4 address.
+func (r *InstanceResource) PublicIP() string {
+	return appaws.Str(r.Item.PublicIpAddress)
+}
+
+// PrivateIP returns the private IPv4 address.
+func (r *InstanceResource) PrivateIP() string {
+	return appaws.Str(r.Item.PrivateIpAddress)
+}
+
+// IPv6Addresses returns the instance's IPv6 addresses.
+func (r *InstanceResource) IPv6Addresses() []string {
+	return r.Item.Ipv6Addresses
+}
+
+// IsStaticIP reports whether the public IP is an attached static IP.
+func (r *InstanceResource) IsStaticIP() bool {
+	return appaws.Bool(r.Item.IsStaticIp)
+}
+
+// Username returns the default SSH user name.
+func (r *InstanceResource) Username() string {
+	return appaws.Str(r.Item.Username)
+}
+
+// SSHKeyName returns the name of the SSH key pair.
+func (r *InstanceResource) SSHKeyName() string {
+	return appaws.Str(r.Item.SshKeyName)
+}
+
+// AvailabilityZone returns the instance's availability zone.
+func (r *InstanceResource) AvailabilityZone() string {
+	if r.Item.Location != nil {
+		return appaws.Str(r.Item.Location.AvailabilityZone)
+	}
+	return ""
+}
+
+// Size returns the vCPU count and RAM as "2 vCPU / 4 GB".
+func (r *InstanceResource) Size() string {
+	hw := r.Item.Hardware
+	if hw == nil || hw.CpuCount == nil {
+		return ""
+	}
+	size := fmt.Sprintf("%d vCPU", *hw.CpuCount)
+	if hw.RamSizeInGb != nil {
+		size += fmt.Sprintf(" / %g GB", *hw.RamSizeInGb)
+	}
+	return size
+}
+
+// OpenPorts returns the firewall's public ports as "22/tcp", "80-81/tcp".
+func (r *InstanceResource) OpenPorts() []string {
+	if r.Item.Networking == nil {
+		return nil
+	}
+	var ports []string
+	for _, p := range r.Item.Networking.Ports {
+		port := fmt.Sprintf("%d", p.FromPort)
+		if p.ToPort != p.FromPort {
+			port = fmt.Sprintf("%d-%d", p.FromPort, p.ToPort)
+		}
+		if p.Protocol != "" && p.Protocol != types.NetworkProtocolAll {
+			port += "/" + strings.ToLower(string(p.Protocol))
+		}
+		ports = append(ports, port)
+	}
+	return ports
+}
+
+// CreatedAt returns when the instance was created.
+func (r *InstanceResource) CreatedAt() *time.Time {
+	return r.Item.CreatedAt
+}
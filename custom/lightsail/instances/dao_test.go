@@ -0,0 +1,51 @@
+package instances
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+)
+
+func TestInstanceResource(t *testing.T) {
+	inst := NewInstanceResource(types.Instance{
+		Name:            aws.String("blog"),
+		BlueprintId:     aws.String("wordpress"),
+		BlueprintName:   aws.String("WordPress"),
+		PublicIpAddress: aws.String("203.0.113.10"),
+		State:           &types.InstanceState{Name: aws.String("running")},
+		Hardware:        &types.InstanceHardware{CpuCount: aws.Int32(2), RamSizeInGb: aws.Float32(0.5)},
+		Networking: &types.InstanceNetworking{Ports: []types.InstancePortInfo{
+			{FromPort: 22, ToPort: 22, Protocol: types.NetworkProtocolTcp},
+			{FromPort: 8000, ToPort: 8010, Protocol: types.NetworkProtocolUdp},
+			{FromPort: 0, ToPort: 65535, Protocol: types.NetworkProtocolAll},
+		}},
+		Tags: []types.Tag{{Key: aws.String("Project"), Value: aws.String("blog")}},
+	})
+
+	if inst.Blueprint() != "WordPress" || inst.PublicIP() != "203.0.113.10" {
+		t.Errorf("Blueprint() = %q, PublicIP() = %q", inst.Blueprint(), inst.PublicIP())
+	}
+	if got := inst.Size(); got != "2 vCPU / 0.5 GB" {
+		t.Errorf("Size() = %q", got)
+	}
+	if got, want := inst.OpenPorts(), []string{"22/tcp", "8000-8010/udp", "0-65535"}; !slices.Equal(got, want) {
+		t.Errorf("OpenPorts() = %v, want %v", got, want)
+	}
+	if inst.GetTags()["Project"] != "blog" {
+		t.Errorf("tags = %v", inst.GetTags())
+	}
+	if !inst.IsRunning() || inst.IsStopped() || inst.IsTransitioning() {
+		t.Errorf("running instance: running=%v stopped=%v transitioning=%v", inst.IsRunning(), inst.IsStopped(), inst.IsTransitioning())
+	}
+}
+
+func TestInstanceTransitioning(t *testing.T) {
+	for state, want := range map[string]bool{"pending": true, "stopping": true, "stopped": false, "running": false} {
+		inst := NewInstanceResource(types.Instance{State: &types.InstanceState{Name: aws.String(state)}})
+		if inst.IsTransitioning() != want {
+			t.Errorf("%s: IsTransitioning() = %v, want %v", state, inst.IsTransitioning(), want)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package instances
+
+import (
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// InstanceRenderer renders Lightsail instances.
+type InstanceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewInstanceRenderer creates a new InstanceRenderer.
+func NewInstanceRenderer() render.Renderer {
+	return &InstanceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "lightsail",
+			Resource: "instances",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATE", Width: 12, Getter: getState},
+				{Name: "PUBLIC IP", Width: 16, Getter: getPublicIP},
+				{Name: "BLUEPRINT", Width: 20, Getter: getBlueprint},
+				{Name: "PLAN", Width: 14, Getter: getPlan},
+				{Name: "SIZE", Width: 16, Getter: getSize},
+				{Name: "ZONE", Width: 14, Getter: getZone},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	if inst, ok := r.(*InstanceResource); ok {
+		return inst.State()
+	}
+	return ""
+}
+
+func getPublicIP(r dao.Resource) string {
+	inst, ok := r.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+	if ip := inst.PublicIP(); ip != "" {
+		return ip
+	}
+	return "-"
+}
+
+func getBlueprint(r dao.Resource) string {
+	if inst, ok := r.(*InstanceResource); ok {
+		return inst.Blueprint()
+	}
+	return ""
+}
+
+func getPlan(r dao.Resource) string {
+	if inst, ok := r.(*InstanceResource); ok {
+		return inst.BundleId()
+	}
+	return ""
+}
+
+func getSize(r dao.Resource) string {
+	if inst, ok := r.(*InstanceResource); ok {
+		return inst.Size()
+	}
+	return ""
+}
+
+func getZone(r dao.Resource) string {
+	if inst, ok := r.(*InstanceResource); ok {
+		return inst.AvailabilityZone()
+	}
+	return ""
+}
+
+// RenderDetail renders the detail view for a Lightsail instance.
+func (r *InstanceRenderer) RenderDetail(resource dao.Resource) string {
+	inst, ok := resource.(*InstanceResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Lightsail Instance", inst.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", inst.GetName())
+	d.Field("ARN", inst.GetARN())
+	d.FieldStyled("State", inst.State(), render.StateColorer()(inst.State()))
+	d.Field("Blueprint", inst.Blueprint())
+	d.Field("Plan", inst.BundleId())
+	if size := inst.Size(); size != "" {
+		d.Field("Size", size)
+	}
+	d.Field("Availability Zone", inst.AvailabilityZone())
+
+	d.Section("Network")
+	if ip := inst.PublicIP(); ip != "" {
+		if inst.IsStaticIP() {
+			ip += " (static)"
+		}
+		d.Field("Public IP", ip)
+	}
+	if ip := inst.PrivateIP(); ip != "" {
+		d.Field("Private IP", ip)
+	}
+	if v6 := inst.IPv6Addresses(); len(v6) > 0 {
+		d.Field("IPv6", strings.Join(v6, ", "))
+	}
+	if ports := inst.OpenPorts(); len(ports) > 0 {
+		d.Field("Open Ports", strings.Join(ports, ", "))
+	}
+
+	if user := inst.Username(); user != "" {
+		d.Section("Access")
+		d.Field("Username", user)
+		if key := inst.SSHKeyName(); key != "" {
+			d.Field("SSH Key", key)
+		}
+		if ip := inst.PublicIP(); ip != "" {
+			d.Field("SSH", "ssh "+user+"@"+ip)
+		}
+	}
+
+	if t := inst.CreatedAt(); t != nil {
+		d.Section("Timestamps")
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Tags(inst.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a Lightsail instance.
+func (r *InstanceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	inst, ok := resource.(*InstanceResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: inst.GetName()},
+		{Label: "State", Value: inst.State(), Style: render.StateColorer()(inst.State())},
+		{Label: "Blueprint", Value: inst.Blueprint()},
+	}
+	if ip := inst.PublicIP(); ip != "" {
+		fields = append(fields, render.SummaryField{Label: "Public IP", Value: ip})
+	}
+	if ip := inst.PrivateIP(); ip != "" {
+		fields = append(fields, render.SummaryField{Label: "Private IP", Value: ip})
+	}
+	return fields
+}
+
+// NeedsAutoReload keeps the list refreshing while an instance is starting,
+// stopping or rebooting
+func (r *InstanceRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if inst, ok := dao.UnwrapResource(res).(*InstanceResource); ok && inst.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
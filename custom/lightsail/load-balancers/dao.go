@@ -0,0 +1,159 @@
+package loadbalancers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// LoadBalancerDAO provides data access for Lightsail load balancers.
+type LoadBalancerDAO struct {
+	dao.BaseDAO
+	client *lightsail.Client
+}
+
+// NewLoadBalancerDAO creates a new LoadBalancerDAO.
+func NewLoadBalancerDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, lightsail.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &LoadBalancerDAO{
+		BaseDAO: dao.NewBaseDAO("lightsail", "load-balancers"),
+		client:  client,
+	}, nil
+}
+
+// List returns all Lightsail load balancers in the region.
+func (d *LoadBalancerDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	lbs, err := appaws.Paginate(ctx, func(token *string) ([]types.LoadBalancer, *string, error) {
+		output, err := d.client.GetLoadBalancers(ctx, &lightsail.GetLoadBalancersInput{
+			PageToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "get lightsail load balancers")
+		}
+		return output.LoadBalancers, output.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(lbs))
+	for i, lb := range lbs {
+		resources[i] = NewLoadBalancerResource(lb)
+	}
+	return resources, nil
+}
+
+// Get returns a specific load balancer by name.
+func (d *LoadBalancerDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetLoadBalancer(ctx, &lightsail.GetLoadBalancerInput{
+		LoadBalancerName: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get lightsail load balancer %s", id)
+	}
+	if output.LoadBalancer == nil {
+		return nil, fmt.Errorf("load balancer not found: %s", id)
+	}
+	return NewLoadBalancerResource(*output.LoadBalancer), nil
+}
+
+// Delete deletes a load balancer by name.
+func (d *LoadBalancerDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteLoadBalancer(ctx, &lightsail.DeleteLoadBalancerInput{
+		LoadBalancerName: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete lightsail load balancer %s", id)
+	}
+	return nil
+}
+
+// LoadBalancerResource wraps a Lightsail load balancer.
+type LoadBalancerResource struct {
+	dao.BaseResource
+	Item types.LoadBalancer
+}
+
+// NewLoadBalancerResource creates a new LoadBalancerResource.
+func NewLoadBalancerResource(lb types.LoadBalancer) *LoadBalancerResource {
+	return &LoadBalancerResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(lb.Name),
+			Name: appaws.Str(lb.Name),
+			ARN:  appaws.Str(lb.Arn),
+			Tags: appaws.TagsToMap(lb.Tags),
+			Data: lb,
+		},
+		Item: lb,
+	}
+}
+
+// State returns the load balancer state.
+func (r *LoadBalancerResource) State() string {
+	return string(r.Item.State)
+}
+
+// DNSName returns the public DNS name.
+func (r *LoadBalancerResource) DNSName() string {
+	return appaws.Str(r.Item.DnsName)
+}
+
+// Protocol returns the listener protocol (HTTP or HTTP_HTTPS).
+func (r *LoadBalancerResource) Protocol() string {
+	return string(r.Item.Protocol)
+}
+
+// PublicPorts returns the ports the load balancer listens on.
+func (r *LoadBalancerResource) PublicPorts() []int32 {
+	return r.Item.PublicPorts
+}
+
+// InstancePort returns the port traffic is forwarded to on instances.
+func (r *LoadBalancerResource) InstancePort() int32 {
+	return appaws.Int32(r.Item.InstancePort)
+}
+
+// HealthCheckPath returns the health check path.
+func (r *LoadBalancerResource) HealthCheckPath() string {
+	return appaws.Str(r.Item.HealthCheckPath)
+}
+
+// HTTPSRedirection reports whether HTTP requests are redirected to HTTPS.
+func (r *LoadBalancerResource) HTTPSRedirection() bool {
+	return appaws.Bool(r.Item.HttpsRedirectionEnabled)
+}
+
+// InstanceHealth returns the health of each attached instance.
+func (r *LoadBalancerResource) InstanceHealth() []types.InstanceHealthSummary {
+	return r.Item.InstanceHealthSummary
+}
+
+// HealthyCount returns the healthy and total attached instance counts.
+func (r *LoadBalancerResource) HealthyCount() (healthy, total int) {
+	for _, h := range r.Item.InstanceHealthSummary {
+		if h.InstanceHealth == types.InstanceHealthStateHealthy {
+			healthy++
+		}
+	}
+	return healthy, len(r.Item.InstanceHealthSummary)
+}
+
+// IsTransitioning reports whether the load balancer is still provisioning.
+func (r *LoadBalancerResource) IsTransitioning() bool {
+	return r.Item.State == types.LoadBalancerStateProvisioning
+}
+
+// CreatedAt returns when the load balancer was created.
+func (r *LoadBalancerResource) CreatedAt() *time.Time {
+	return r.Item.CreatedAt
+}
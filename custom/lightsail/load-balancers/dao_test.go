@@ -0,0 +1,30 @@
+package loadbalancers
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+)
+
+func TestLoadBalancerHealthyCount(t *testing.T) {
+	lb := NewLoadBalancerResource(types.LoadBalancer{
+		Name:  aws.String("web-lb"),
+		State: types.LoadBalancerStateActiveImpaired,
+		InstanceHealthSummary: []types.InstanceHealthSummary{
+			{InstanceName: aws.String("web-1"), InstanceHealth: types.InstanceHealthStateHealthy},
+			{InstanceName: aws.String("web-2"), InstanceHealth: types.InstanceHealthStateUnhealthy},
+			{InstanceName: aws.String("web-3"), InstanceHealth: types.InstanceHealthStateHealthy},
+		},
+	})
+
+	if healthy, total := lb.HealthyCount(); healthy != 2 || total != 3 {
+		t.Errorf("HealthyCount() = %d/%d, want 2/3", healthy, total)
+	}
+	if lb.IsTransitioning() {
+		t.Error("active_impaired load balancer reported as transitioning")
+	}
+	if !NewLoadBalancerResource(types.LoadBalancer{State: types.LoadBalancerStateProvisioning}).IsTransitioning() {
+		t.Error("provisioning load balancer not reported as transitioning")
+	}
+}
@@ -0,0 +1,20 @@
+package loadbalancers
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("lightsail", "load-balancers", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewLoadBalancerDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewLoadBalancerRenderer()
+		},
+	})
+}
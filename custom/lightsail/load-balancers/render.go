@@ -0,0 +1,184 @@
+package loadbalancers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// LoadBalancerRenderer renders Lightsail load balancers.
+type LoadBalancerRenderer struct {
+	render.BaseRenderer
+}
+
+// NewLoadBalancerRenderer creates a new LoadBalancerRenderer.
+func NewLoadBalancerRenderer() render.Renderer {
+	return &LoadBalancerRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "lightsail",
+			Resource: "load-balancers",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATE", Width: 16, Getter: getState},
+				{Name: "PROTOCOL", Width: 11, Getter: getProtocol},
+				{Name: "HEALTHY", Width: 8, Getter: getHealthy},
+				{Name: "DNS NAME", Width: 60, Getter: getDNSName},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	if lb, ok := r.(*LoadBalancerResource); ok {
+		return lb.State()
+	}
+	return ""
+}
+
+func getProtocol(r dao.Resource) string {
+	if lb, ok := r.(*LoadBalancerResource); ok {
+		return lb.Protocol()
+	}
+	return ""
+}
+
+func getHealthy(r dao.Resource) string {
+	lb, ok := r.(*LoadBalancerResource)
+	if !ok {
+		return ""
+	}
+	healthy, total := lb.HealthyCount()
+	return fmt.Sprintf("%d/%d", healthy, total)
+}
+
+func getDNSName(r dao.Resource) string {
+	if lb, ok := r.(*LoadBalancerResource); ok {
+		return lb.DNSName()
+	}
+	return ""
+}
+
+func stateStyle(state string) render.Style {
+	switch types.LoadBalancerState(state) {
+	case types.LoadBalancerStateActive:
+		return ui.SuccessStyle()
+	case types.LoadBalancerStateActiveImpaired:
+		return ui.WarningStyle()
+	case types.LoadBalancerStateFailed:
+		return ui.DangerStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
+
+func instanceHealthStyle(state types.InstanceHealthState) render.Style {
+	switch state {
+	case types.InstanceHealthStateHealthy:
+		return ui.SuccessStyle()
+	case types.InstanceHealthStateUnhealthy:
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a Lightsail load balancer.
+func (r *LoadBalancerRenderer) RenderDetail(resource dao.Resource) string {
+	lb, ok := resource.(*LoadBalancerResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Lightsail Load Balancer", lb.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", lb.GetName())
+	d.Field("ARN", lb.GetARN())
+	d.FieldStyled("State", lb.State(), stateStyle(lb.State()))
+
+	d.Section("Listener")
+	d.Field("DNS Name", lb.DNSName())
+	d.Field("Protocol", lb.Protocol())
+	if ports := lb.PublicPorts(); len(ports) > 0 {
+		strs := make([]string, len(ports))
+		for i, p := range ports {
+			strs[i] = fmt.Sprintf("%d", p)
+		}
+		d.Field("Public Ports", strings.Join(strs, ", "))
+	}
+	if port := lb.InstancePort(); port != 0 {
+		d.Field("Instance Port", fmt.Sprintf("%d", port))
+	}
+	if path := lb.HealthCheckPath(); path != "" {
+		d.Field("Health Check Path", path)
+	}
+	if lb.HTTPSRedirection() {
+		d.Field("HTTPS Redirection", "Enabled")
+	}
+
+	if health := lb.InstanceHealth(); len(health) > 0 {
+		healthy, total := lb.HealthyCount()
+		d.Section(fmt.Sprintf("Instances (%d/%d healthy)", healthy, total))
+		for _, h := range health {
+			value := string(h.InstanceHealth)
+			if h.InstanceHealthReason != "" {
+				value += " (" + string(h.InstanceHealthReason) + ")"
+			}
+			d.FieldStyled(appaws.Str(h.InstanceName), value, instanceHealthStyle(h.InstanceHealth))
+		}
+	}
+
+	if certs := lb.Item.TlsCertificateSummaries; len(certs) > 0 {
+		d.Section("TLS Certificates")
+		for _, c := range certs {
+			status := "not attached"
+			if appaws.Bool(c.IsAttached) {
+				status = "attached"
+			}
+			d.Field(appaws.Str(c.Name), status)
+		}
+	}
+
+	if t := lb.CreatedAt(); t != nil {
+		d.Section("Timestamps")
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Tags(lb.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a Lightsail load balancer.
+func (r *LoadBalancerRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	lb, ok := resource.(*LoadBalancerResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	healthy, total := lb.HealthyCount()
+	return []render.SummaryField{
+		{Label: "Name", Value: lb.GetName()},
+		{Label: "State", Value: lb.State(), Style: stateStyle(lb.State())},
+		{Label: "DNS Name", Value: lb.DNSName()},
+		{Label: "Healthy", Value: fmt.Sprintf("%d/%d", healthy, total)},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a load balancer is provisioning
+func (r *LoadBalancerRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if lb, ok := dao.UnwrapResource(res).(*LoadBalancerResource); ok && lb.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
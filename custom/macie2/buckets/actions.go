@@ -0,0 +1,38 @@
+package buckets
+
+import (
+	"context"
+
+	appmacie "github.com/clawscli/claws/custom/macie2"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("macie2", "buckets", []action.Action{
+		{
+			Name:      "Create Classification Job",
+			Shortcut:  "n",
+			Type:      action.ActionTypeAPI,
+			Operation: "CreateClassificationJob",
+			Confirm:   action.ConfirmSimple,
+			Input:     appmacie.IdentifierInput,
+		},
+	})
+
+	action.RegisterExecutor("macie2", "buckets", executeBucketAction)
+}
+
+func executeBucketAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CreateClassificationJob":
+		b, ok := dao.UnwrapResource(resource).(*BucketResource)
+		if !ok || b.Bucket == nil {
+			return action.InvalidResourceResult()
+		}
+		return appmacie.ExecuteCreateJob(ctx, appaws.Str(b.Bucket.AccountId)+"/"+b.GetID())
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
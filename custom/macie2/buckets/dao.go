@@ -20,13 +20,13 @@ type BucketDAO struct {
 
 // NewBucketDAO creates a new BucketDAO.
 func NewBucketDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, macie2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &BucketDAO{
 		BaseDAO: dao.NewBaseDAO("macie2", "buckets"),
-		client:  macie2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
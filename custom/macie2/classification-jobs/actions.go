@@ -0,0 +1,87 @@
+package classificationjobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+
+	appmacie "github.com/clawscli/claws/custom/macie2"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("macie2", "classification-jobs", []action.Action{
+		{
+			Name:      "Create Job",
+			Shortcut:  "n",
+			Type:      action.ActionTypeAPI,
+			Operation: "CreateClassificationJob",
+			Confirm:   action.ConfirmSimple,
+			Input:     appmacie.BucketInput,
+		},
+		{
+			Name:      "Pause",
+			Shortcut:  "p",
+			Type:      action.ActionTypeAPI,
+			Operation: "PauseClassificationJob",
+			Confirm:   action.ConfirmSimple,
+			// Idle jobs are scheduled jobs waiting for their next run
+			Filter: func(r dao.Resource) bool {
+				status := jobStatus(r)
+				return status == types.JobStatusRunning || status == types.JobStatusIdle
+			},
+		},
+		{
+			Name:      "Resume",
+			Shortcut:  "r",
+			Type:      action.ActionTypeAPI,
+			Operation: "ResumeClassificationJob",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				return jobStatus(r) == types.JobStatusUserPaused
+			},
+		},
+	})
+
+	action.RegisterExecutor("macie2", "classification-jobs", executeJobAction)
+}
+
+func jobStatus(r dao.Resource) types.JobStatus {
+	job, ok := dao.UnwrapResource(r).(*ClassificationJobResource)
+	if !ok || job.Job == nil {
+		return ""
+	}
+	return job.Job.JobStatus
+}
+
+func executeJobAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CreateClassificationJob":
+		return appmacie.ExecuteCreateJob(ctx, "")
+	case "PauseClassificationJob":
+		return executeSetJobStatus(ctx, resource, types.JobStatusUserPaused, "Paused")
+	case "ResumeClassificationJob":
+		return executeSetJobStatus(ctx, resource, types.JobStatusRunning, "Resumed")
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeSetJobStatus(ctx context.Context, resource dao.Resource, status types.JobStatus, verb string) action.ActionResult {
+	client, err := appmacie.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	jobID := resource.GetID()
+	_, err = client.UpdateClassificationJob(ctx, &macie2.UpdateClassificationJobInput{
+		JobId:     &jobID,
+		JobStatus: status,
+	})
+	if err != nil {
+		return action.FailResultf(err, "update classification job %s", jobID)
+	}
+	return action.SuccessResult(fmt.Sprintf("%s classification job %s", verb, resource.GetName()))
+}
@@ -20,13 +20,13 @@ type ClassificationJobDAO struct {
 
 // NewClassificationJobDAO creates a new ClassificationJobDAO.
 func NewClassificationJobDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, macie2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ClassificationJobDAO{
 		BaseDAO: dao.NewBaseDAO("macie2", "classification-jobs"),
-		client:  macie2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -73,6 +73,7 @@ func (d *ClassificationJobDAO) Get(ctx context.Context, id string) (dao.Resource
 			JobType:   output.JobType,
 			CreatedAt: output.CreatedAt,
 		},
+		Detail: output,
 	}, nil
 }
 
@@ -92,7 +93,8 @@ func (d *ClassificationJobDAO) Delete(ctx context.Context, id string) error {
 // ClassificationJobResource wraps a Macie classification job.
 type ClassificationJobResource struct {
 	dao.BaseResource
-	Job *types.JobSummary
+	Job    *types.JobSummary
+	Detail *macie2.DescribeClassificationJobOutput // only set by Get
 }
 
 // NewClassificationJobResource creates a new ClassificationJobResource.
@@ -1,6 +1,11 @@
 package classificationjobs
 
 import (
+	"fmt"
+	"strings"
+
+	appmacie "github.com/clawscli/claws/custom/macie2"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -80,11 +85,41 @@ func (r *ClassificationJobRenderer) RenderDetail(resource dao.Resource) string {
 	d.Field("Status", job.Status())
 	d.Field("Type", job.JobType())
 
+	if detail := job.Detail; detail != nil {
+		d.Field("Schedule", appmacie.DescribeSchedule(detail.JobType, detail.ScheduleFrequency))
+		identifiers := string(detail.ManagedDataIdentifierSelector)
+		if len(detail.ManagedDataIdentifierIds) > 0 {
+			identifiers += ": " + strings.Join(detail.ManagedDataIdentifierIds, ", ")
+		}
+		if identifiers != "" {
+			d.Field("Managed Identifiers", identifiers)
+		}
+		if detail.SamplingPercentage != nil {
+			d.Field("Sampling", fmt.Sprintf("%d%%", *detail.SamplingPercentage))
+		}
+
+		if def := detail.S3JobDefinition; def != nil && len(def.BucketDefinitions) > 0 {
+			d.Section("Buckets")
+			for _, b := range def.BucketDefinitions {
+				d.Field(appaws.Str(b.AccountId), strings.Join(b.Buckets, ", "))
+			}
+		}
+
+		if stats := detail.Statistics; stats != nil {
+			d.Section("Statistics")
+			d.Field("Approximate Objects Left", fmt.Sprintf("%.0f", appaws.Float64(stats.ApproximateNumberOfObjectsToProcess)))
+			d.Field("Runs", fmt.Sprintf("%.0f", appaws.Float64(stats.NumberOfRuns)))
+		}
+	}
+
 	// Timestamps
 	d.Section("Timestamps")
 	if t := job.CreatedAt(); t != nil {
 		d.Field("Created", t.Format("2006-01-02 15:04:05"))
 	}
+	if job.Detail != nil && job.Detail.LastRunTime != nil {
+		d.Field("Last Run", job.Detail.LastRunTime.Format("2006-01-02 15:04:05"))
+	}
 
 	return d.String()
 }
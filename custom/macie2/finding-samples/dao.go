@@ -0,0 +1,177 @@
+package findingsamples
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// revealPollInterval is how often a pending reveal request is polled.
+	revealPollInterval = 2 * time.Second
+	// revealTimeout bounds how long Macie may take to retrieve the samples.
+	revealTimeout = 60 * time.Second
+)
+
+// SampleDAO retrieves the sensitive data occurrences Macie reported for a
+// finding. Macie reads them from the affected S3 object on request, which
+// requires the reveal configuration to be enabled in the account.
+type SampleDAO struct {
+	dao.BaseDAO
+	client *macie2.Client
+}
+
+// NewSampleDAO creates a new SampleDAO.
+func NewSampleDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, macie2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &SampleDAO{
+		BaseDAO: dao.NewBaseDAO("macie2", "finding-samples"),
+		client:  client,
+	}, nil
+}
+
+// List returns one resource per sensitive data occurrence of the finding.
+func (d *SampleDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	findingID := dao.GetFilterFromContext(ctx, "FindingId")
+	if findingID == "" {
+		return nil, fmt.Errorf("finding ID filter required")
+	}
+
+	availability, err := d.client.GetSensitiveDataOccurrencesAvailability(ctx, &macie2.GetSensitiveDataOccurrencesAvailabilityInput{
+		FindingId: &findingID,
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "check sensitive data availability")
+	}
+	if availability.Code != types.AvailabilityCodeAvailable {
+		return nil, unavailableError(availability.Reasons)
+	}
+
+	occurrences, err := d.reveal(ctx, findingID)
+	if err != nil {
+		return nil, err
+	}
+	return sampleResources(findingID, occurrences), nil
+}
+
+// reveal waits for Macie to retrieve the occurrences from the S3 object.
+func (d *SampleDAO) reveal(ctx context.Context, findingID string) (map[string][]types.DetectedDataDetails, error) {
+	ctx, cancel := context.WithTimeout(ctx, revealTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(revealPollInterval)
+	defer ticker.Stop()
+
+	for {
+		output, err := d.client.GetSensitiveDataOccurrences(ctx, &macie2.GetSensitiveDataOccurrencesInput{
+			FindingId: &findingID,
+		})
+		if err != nil {
+			return nil, apperrors.Wrap(err, "get sensitive data occurrences")
+		}
+
+		switch output.Status {
+		case types.RevealRequestStatusSuccess:
+			return output.SensitiveDataOccurrences, nil
+		case types.RevealRequestStatusError:
+			return nil, fmt.Errorf("retrieve sensitive data occurrences: %s", appaws.Str(output.Error))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("retrieve sensitive data occurrences: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// unavailableError explains why Macie won't retrieve samples for a finding.
+func unavailableError(reasons []types.UnavailabilityReasonCode) error {
+	if len(reasons) == 0 {
+		return fmt.Errorf("sensitive data samples are not available for this finding")
+	}
+	codes := make([]string, len(reasons))
+	for i, r := range reasons {
+		codes[i] = string(r)
+	}
+	return fmt.Errorf("sensitive data samples are not available for this finding: %s", strings.Join(codes, ", "))
+}
+
+// sampleResources flattens occurrences keyed by data type into one resource
+// per value, ordered by type.
+func sampleResources(findingID string, occurrences map[string][]types.DetectedDataDetails) []dao.Resource {
+	dataTypes := make([]string, 0, len(occurrences))
+	for t := range occurrences {
+		dataTypes = append(dataTypes, t)
+	}
+	slices.Sort(dataTypes)
+
+	var resources []dao.Resource
+	for _, t := range dataTypes {
+		for i, detail := range occurrences[t] {
+			resources = append(resources, NewSampleResource(findingID, t, i+1, appaws.Str(detail.Value)))
+		}
+	}
+	return resources
+}
+
+// Get returns one sample.
+func (d *SampleDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("sample not found: %s", id)
+}
+
+// Delete is not supported.
+func (d *SampleDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for macie finding samples")
+}
+
+// Supports returns true only for List operation.
+// Get() is implemented via List() scan, so we disable auto-refresh in DetailView.
+func (d *SampleDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// SampleResource is one occurrence of sensitive data in a finding's object.
+type SampleResource struct {
+	dao.BaseResource
+	FindingID string
+	DataType  string // managed or custom data identifier, e.g. CREDIT_CARD_NUMBER
+	Index     int    // 1-based position among the occurrences of DataType
+	Value     string
+}
+
+// NewSampleResource creates a new SampleResource.
+func NewSampleResource(findingID, dataType string, index int, value string) *SampleResource {
+	return &SampleResource{
+		BaseResource: dao.BaseResource{
+			ID:   fmt.Sprintf("%s-%d", dataType, index),
+			Name: dataType,
+			Data: map[string]string{"Type": dataType, "Value": value},
+		},
+		FindingID: findingID,
+		DataType:  dataType,
+		Index:     index,
+		Value:     value,
+	}
+}
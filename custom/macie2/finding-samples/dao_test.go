@@ -0,0 +1,43 @@
+package findingsamples
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+)
+
+func TestSampleResources(t *testing.T) {
+	resources := sampleResources("f-1", map[string][]types.DetectedDataDetails{
+		"USA_SOCIAL_SECURITY_NUMBER": {{Value: aws.String("123-45-6789")}},
+		"CREDIT_CARD_NUMBER":         {{Value: aws.String("4111111111111111")}, {Value: aws.String("5500000000000004")}},
+	})
+
+	var ids []string
+	for _, r := range resources {
+		ids = append(ids, r.GetID())
+	}
+	want := "CREDIT_CARD_NUMBER-1,CREDIT_CARD_NUMBER-2,USA_SOCIAL_SECURITY_NUMBER-1"
+	if got := strings.Join(ids, ","); got != want {
+		t.Errorf("ids = %s, want %s", got, want)
+	}
+
+	s := resources[1].(*SampleResource)
+	if s.FindingID != "f-1" || s.DataType != "CREDIT_CARD_NUMBER" || s.Index != 2 || s.Value != "5500000000000004" {
+		t.Errorf("sample = %+v", s)
+	}
+}
+
+func TestUnavailableError(t *testing.T) {
+	err := unavailableError([]types.UnavailabilityReasonCode{
+		types.UnavailabilityReasonCodeObjectExceedsSizeQuota,
+		types.UnavailabilityReasonCodeUnsupportedFindingType,
+	})
+	if !strings.HasSuffix(err.Error(), ": OBJECT_EXCEEDS_SIZE_QUOTA, UNSUPPORTED_FINDING_TYPE") {
+		t.Errorf("error = %v", err)
+	}
+	if err := unavailableError(nil); err == nil {
+		t.Error("expected an error without reasons")
+	}
+}
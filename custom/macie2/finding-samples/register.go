@@ -0,0 +1,20 @@
+package findingsamples
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("macie2", "finding-samples", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewSampleDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewSampleRenderer()
+		},
+	})
+}
@@ -0,0 +1,84 @@
+package findingsamples
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// SampleRenderer renders Macie sensitive data samples.
+type SampleRenderer struct {
+	render.BaseRenderer
+}
+
+// NewSampleRenderer creates a new SampleRenderer.
+func NewSampleRenderer() render.Renderer {
+	return &SampleRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "macie2",
+			Resource: "finding-samples",
+			Cols: []render.Column{
+				{Name: "TYPE", Width: 30, Getter: getType},
+				{Name: "#", Width: 4, Getter: getIndex},
+				{Name: "VALUE", Width: 60, Getter: getValue},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	s, ok := r.(*SampleResource)
+	if !ok {
+		return ""
+	}
+	return s.DataType
+}
+
+func getIndex(r dao.Resource) string {
+	s, ok := r.(*SampleResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", s.Index)
+}
+
+func getValue(r dao.Resource) string {
+	s, ok := r.(*SampleResource)
+	if !ok {
+		return ""
+	}
+	return s.Value
+}
+
+// RenderDetail renders the detail view for a sample.
+func (r *SampleRenderer) RenderDetail(resource dao.Resource) string {
+	s, ok := resource.(*SampleResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Macie Sensitive Data Sample", s.GetID())
+
+	d.Section("Occurrence")
+	d.Field("Type", s.DataType)
+	d.Field("Value", s.Value)
+	d.Field("Finding ID", s.FindingID)
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a sample.
+func (r *SampleRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	s, ok := resource.(*SampleResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Type", Value: s.DataType},
+		{Label: "Finding ID", Value: s.FindingID},
+	}
+}
@@ -21,13 +21,13 @@ type FindingDAO struct {
 
 // NewFindingDAO creates a new FindingDAO.
 func NewFindingDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, macie2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &FindingDAO{
 		BaseDAO: dao.NewBaseDAO("macie2", "findings"),
-		client:  macie2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
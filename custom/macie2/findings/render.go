@@ -3,6 +3,8 @@ package findings
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -150,3 +152,25 @@ func (r *FindingRenderer) RenderSummary(resource dao.Resource) []render.SummaryF
 		{Label: "Object", Value: finding.ObjectKey()},
 	}
 }
+
+// Navigations returns available navigations from a finding.
+func (r *FindingRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	finding, ok := resource.(*FindingResource)
+	if !ok {
+		return nil
+	}
+	// Only sensitive data findings point at occurrences in an S3 object
+	if finding.Category() != string(types.FindingCategoryClassification) {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "s",
+			Label:       "Samples",
+			Service:     "macie2",
+			Resource:    "finding-samples",
+			FilterField: "FindingId",
+			FilterValue: finding.GetID(),
+		},
+	}
+}
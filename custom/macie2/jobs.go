@@ -0,0 +1,241 @@
+package macie2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// GetClient returns a Macie client configured for the current context
+func GetClient(ctx context.Context) (*macie2.Client, error) {
+	return appaws.Client(ctx, macie2.NewFromConfig)
+}
+
+// Schedules offered when creating a job. Weekly jobs run on Mondays and
+// monthly jobs on the 1st.
+const (
+	ScheduleOnce    = "ONE_TIME"
+	ScheduleDaily   = "DAILY"
+	ScheduleWeekly  = "WEEKLY"
+	ScheduleMonthly = "MONTHLY"
+)
+
+// includePrefix marks an identifier choice that narrows a job to one
+// managed data identifier, e.g. "INCLUDE:CREDIT_CARD_NUMBER".
+const includePrefix = "INCLUDE:"
+
+// IdentifierInput prompts for the managed data identifiers a job uses, then
+// for its schedule.
+var IdentifierInput = &action.InputSpec{
+	Label:   "Managed data identifiers",
+	Choices: identifierChoices,
+	Then: &action.InputSpec{
+		Label:   "Schedule",
+		Choices: scheduleChoices,
+	},
+}
+
+// BucketInput prompts for the bucket a job scans, then as IdentifierInput.
+var BucketInput = &action.InputSpec{
+	Label:   "Bucket",
+	Choices: bucketChoices,
+	Then:    IdentifierInput,
+}
+
+// bucketChoices offers the buckets Macie monitors. Values are
+// "<account>/<bucket>" since jobs name buckets per owning account.
+func bucketChoices(ctx context.Context, _ dao.Resource) ([]action.Choice, error) {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := appaws.Paginate(ctx, func(token *string) ([]types.BucketMetadata, *string, error) {
+		output, err := client.DescribeBuckets(ctx, &macie2.DescribeBucketsInput{NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe macie buckets")
+		}
+		return output.Buckets, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	choices := make([]action.Choice, 0, len(buckets))
+	for _, b := range buckets {
+		name := appaws.Str(b.BucketName)
+		label := name
+		if b.ClassifiableObjectCount != nil {
+			label = fmt.Sprintf("%s (%d classifiable objects, %s)", name, *b.ClassifiableObjectCount,
+				render.FormatSize(appaws.Int64(b.ClassifiableSizeInBytes)))
+		}
+		choices = append(choices, action.Choice{Value: appaws.Str(b.AccountId) + "/" + name, Label: label})
+	}
+	slices.SortFunc(choices, func(a, b action.Choice) int { return strings.Compare(a.Label, b.Label) })
+	return choices, nil
+}
+
+// identifierChoices offers the recommended and full sets of managed data
+// identifiers, followed by each identifier on its own.
+func identifierChoices(ctx context.Context, _ dao.Resource) ([]action.Choice, error) {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identifiers, err := appaws.Paginate(ctx, func(token *string) ([]types.ManagedDataIdentifierSummary, *string, error) {
+		output, err := client.ListManagedDataIdentifiers(ctx, &macie2.ListManagedDataIdentifiersInput{NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list managed data identifiers")
+		}
+		return output.Items, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	choices := []action.Choice{
+		{Value: string(types.ManagedDataIdentifierSelectorRecommended), Label: "Recommended set (default)"},
+		{Value: string(types.ManagedDataIdentifierSelectorAll), Label: "All managed identifiers"},
+	}
+	for _, id := range identifiers {
+		choices = append(choices, action.Choice{
+			Value: includePrefix + appaws.Str(id.Id),
+			Label: fmt.Sprintf("Only %s (%s)", appaws.Str(id.Id), strings.ToLower(string(id.Category))),
+		})
+	}
+	return choices, nil
+}
+
+func scheduleChoices(context.Context, dao.Resource) ([]action.Choice, error) {
+	return []action.Choice{
+		{Value: ScheduleOnce, Label: "Run once now"},
+		{Value: ScheduleDaily, Label: "Daily"},
+		{Value: ScheduleWeekly, Label: "Weekly on Mondays"},
+		{Value: ScheduleMonthly, Label: "Monthly on the 1st"},
+	}, nil
+}
+
+// ParseIdentifierSelection turns an identifier choice into the selector and
+// identifier IDs of a job.
+func ParseIdentifierSelection(value string) (types.ManagedDataIdentifierSelector, []string, error) {
+	if id, ok := strings.CutPrefix(value, includePrefix); ok && id != "" {
+		return types.ManagedDataIdentifierSelectorInclude, []string{id}, nil
+	}
+	switch selector := types.ManagedDataIdentifierSelector(value); selector {
+	case "", types.ManagedDataIdentifierSelectorRecommended:
+		return types.ManagedDataIdentifierSelectorRecommended, nil, nil
+	case types.ManagedDataIdentifierSelectorAll:
+		return selector, nil, nil
+	default:
+		return "", nil, fmt.Errorf("unknown identifier selection %q", value)
+	}
+}
+
+// ParseSchedule turns a schedule choice into a job type and frequency.
+func ParseSchedule(value string) (types.JobType, *types.JobScheduleFrequency, error) {
+	switch value {
+	case "", ScheduleOnce:
+		return types.JobTypeOneTime, nil, nil
+	case ScheduleDaily:
+		return types.JobTypeScheduled, &types.JobScheduleFrequency{DailySchedule: &types.DailySchedule{}}, nil
+	case ScheduleWeekly:
+		return types.JobTypeScheduled, &types.JobScheduleFrequency{
+			WeeklySchedule: &types.WeeklySchedule{DayOfWeek: types.DayOfWeekMonday},
+		}, nil
+	case ScheduleMonthly:
+		return types.JobTypeScheduled, &types.JobScheduleFrequency{
+			MonthlySchedule: &types.MonthlySchedule{DayOfMonth: appaws.Int32Ptr(1)},
+		}, nil
+	default:
+		return "", nil, fmt.Errorf("unknown schedule %q", value)
+	}
+}
+
+// DescribeSchedule describes a job's recurrence, e.g. "Weekly (MONDAY)".
+func DescribeSchedule(jobType types.JobType, freq *types.JobScheduleFrequency) string {
+	switch {
+	case jobType == types.JobTypeOneTime:
+		return "One time"
+	case freq == nil:
+		return string(jobType)
+	case freq.DailySchedule != nil:
+		return "Daily"
+	case freq.WeeklySchedule != nil:
+		return fmt.Sprintf("Weekly (%s)", freq.WeeklySchedule.DayOfWeek)
+	case freq.MonthlySchedule != nil:
+		return fmt.Sprintf("Monthly (day %d)", appaws.Int32(freq.MonthlySchedule.DayOfMonth))
+	default:
+		return string(jobType)
+	}
+}
+
+// ExecuteCreateJob creates a job from the answers to BucketInput, or to
+// IdentifierInput when bucket is given as "<account>/<bucket>".
+func ExecuteCreateJob(ctx context.Context, bucket string) action.ActionResult {
+	inputs := action.InputsFromContext(ctx)
+	if bucket == "" {
+		if len(inputs) == 0 {
+			return action.FailResult(fmt.Errorf("bucket is required"))
+		}
+		bucket, inputs = inputs[0], inputs[1:]
+	}
+	accountID, bucketName, ok := strings.Cut(bucket, "/")
+	if !ok || accountID == "" || bucketName == "" {
+		return action.FailResult(fmt.Errorf("invalid bucket %q", bucket))
+	}
+	var selection, schedule string
+	if len(inputs) > 0 {
+		selection = inputs[0]
+	}
+	if len(inputs) > 1 {
+		schedule = inputs[1]
+	}
+
+	selector, identifierIDs, err := ParseIdentifierSelection(selection)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	jobType, freq, err := ParseSchedule(schedule)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	name := fmt.Sprintf("%s-%s", bucketName, time.Now().UTC().Format("20060102-150405"))
+	input := &macie2.CreateClassificationJobInput{
+		Name:    &name,
+		JobType: jobType,
+		S3JobDefinition: &types.S3JobDefinition{
+			BucketDefinitions: []types.S3BucketDefinitionForJob{{
+				AccountId: &accountID,
+				Buckets:   []string{bucketName},
+			}},
+		},
+		ManagedDataIdentifierSelector: selector,
+		ManagedDataIdentifierIds:      identifierIDs,
+		ScheduleFrequency:             freq,
+	}
+	if jobType == types.JobTypeScheduled {
+		// Scan existing objects now rather than waiting for the first run
+		input.InitialRun = appaws.BoolPtr(true)
+	}
+	output, err := client.CreateClassificationJob(ctx, input)
+	if err != nil {
+		return action.FailResultf(err, "create classification job for %s", bucketName)
+	}
+	return action.SuccessResult(fmt.Sprintf("Created %s job %s (%s)",
+		strings.ToLower(DescribeSchedule(jobType, freq)), name, appaws.Str(output.JobId)))
+}
@@ -0,0 +1,64 @@
+package macie2
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+)
+
+func TestParseIdentifierSelection(t *testing.T) {
+	tests := []struct {
+		value    string
+		selector types.ManagedDataIdentifierSelector
+		ids      []string
+	}{
+		{"", types.ManagedDataIdentifierSelectorRecommended, nil},
+		{"RECOMMENDED", types.ManagedDataIdentifierSelectorRecommended, nil},
+		{"ALL", types.ManagedDataIdentifierSelectorAll, nil},
+		{"INCLUDE:CREDIT_CARD_NUMBER", types.ManagedDataIdentifierSelectorInclude, []string{"CREDIT_CARD_NUMBER"}},
+	}
+	for _, tt := range tests {
+		selector, ids, err := ParseIdentifierSelection(tt.value)
+		if err != nil {
+			t.Errorf("ParseIdentifierSelection(%q) error: %v", tt.value, err)
+			continue
+		}
+		if selector != tt.selector || !slices.Equal(ids, tt.ids) {
+			t.Errorf("ParseIdentifierSelection(%q) = %s %v, want %s %v", tt.value, selector, ids, tt.selector, tt.ids)
+		}
+	}
+
+	for _, bad := range []string{"INCLUDE:", "NONE", "bogus"} {
+		if _, _, err := ParseIdentifierSelection(bad); err == nil {
+			t.Errorf("ParseIdentifierSelection(%q) expected error", bad)
+		}
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	jobType, freq, err := ParseSchedule(ScheduleOnce)
+	if err != nil || jobType != types.JobTypeOneTime || freq != nil {
+		t.Errorf("once = %s %+v %v", jobType, freq, err)
+	}
+
+	tests := map[string]string{
+		ScheduleDaily:   "Daily",
+		ScheduleWeekly:  "Weekly (MONDAY)",
+		ScheduleMonthly: "Monthly (day 1)",
+	}
+	for value, want := range tests {
+		jobType, freq, err := ParseSchedule(value)
+		if err != nil || jobType != types.JobTypeScheduled {
+			t.Errorf("ParseSchedule(%q) = %s, %v", value, jobType, err)
+			continue
+		}
+		if got := DescribeSchedule(jobType, freq); got != want {
+			t.Errorf("DescribeSchedule(%q) = %q, want %q", value, got, want)
+		}
+	}
+
+	if _, _, err := ParseSchedule("HOURLY"); err == nil {
+		t.Error("expected error for unknown schedule")
+	}
+}
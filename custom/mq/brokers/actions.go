@@ -0,0 +1,84 @@
+package brokers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+
+	mqClient "github.com/clawscli/claws/custom/mq"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/console"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Amazon MQ brokers
+	action.Global.Register("mq", "brokers", []action.Action{
+		{
+			Name:      "Reboot",
+			Shortcut:  "B",
+			Type:      action.ActionTypeAPI,
+			Operation: "RebootBroker",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				broker, ok := dao.UnwrapResource(r).(*BrokerResource)
+				return ok && broker.IsRunning()
+			},
+		},
+		{
+			Name:      "Open Web Console",
+			Shortcut:  "W",
+			Type:      action.ActionTypeAPI,
+			Operation: "OpenWebConsole",
+			Filter: func(r dao.Resource) bool {
+				broker, ok := dao.UnwrapResource(r).(*BrokerResource)
+				return ok && broker.IsRunning()
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("mq", "brokers", executeBrokerAction)
+}
+
+// executeBrokerAction executes an action on an Amazon MQ broker
+func executeBrokerAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	broker, ok := dao.UnwrapResource(resource).(*BrokerResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := mqClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := broker.GetID()
+	switch act.Operation {
+	case "RebootBroker":
+		if _, err := client.RebootBroker(ctx, &mq.RebootBrokerInput{BrokerId: &id}); err != nil {
+			return action.FailResult(fmt.Errorf("reboot broker: %w", err))
+		}
+		return action.SuccessResult(fmt.Sprintf("Rebooting %s", broker.GetName()))
+	case "OpenWebConsole":
+		// List rows only carry the summary; the console URL comes from DescribeBroker.
+		if broker.Detail == nil {
+			output, err := client.DescribeBroker(ctx, &mq.DescribeBrokerInput{BrokerId: &id})
+			if err != nil {
+				return action.FailResult(fmt.Errorf("describe broker: %w", err))
+			}
+			broker = NewBrokerResourceFromDetail(output)
+		}
+		u := broker.ConsoleURL()
+		if u == "" {
+			return action.FailResult(fmt.Errorf("broker %s has no web console URL", broker.GetName()))
+		}
+		if err := console.Open(u); err != nil {
+			return action.FailResult(err)
+		}
+		return action.SuccessResult("Opened web console in browser")
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -0,0 +1,284 @@
+package brokers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// BrokerDAO provides data access for Amazon MQ brokers.
+type BrokerDAO struct {
+	dao.BaseDAO
+	client *mq.Client
+}
+
+// NewBrokerDAO creates a new BrokerDAO.
+func NewBrokerDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, mq.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &BrokerDAO{
+		BaseDAO: dao.NewBaseDAO("mq", "brokers"),
+		client:  client,
+	}, nil
+}
+
+// List returns all brokers in the region.
+func (d *BrokerDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	brokers, err := appaws.Paginate(ctx, func(token *string) ([]types.BrokerSummary, *string, error) {
+		output, err := d.client.ListBrokers(ctx, &mq.ListBrokersInput{
+			MaxResults: aws.Int32(100),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list mq brokers")
+		}
+		return output.BrokerSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(brokers))
+	for i, broker := range brokers {
+		resources[i] = NewBrokerResource(broker)
+	}
+	return resources, nil
+}
+
+// Get returns a broker by ID, including endpoints, maintenance window and users.
+func (d *BrokerDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeBroker(ctx, &mq.DescribeBrokerInput{
+		BrokerId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe mq broker %s", id)
+	}
+	return NewBrokerResourceFromDetail(output), nil
+}
+
+// Delete deletes a broker by ID.
+func (d *BrokerDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteBroker(ctx, &mq.DeleteBrokerInput{
+		BrokerId: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete mq broker %s", id)
+	}
+	return nil
+}
+
+// BrokerResource wraps an Amazon MQ broker.
+type BrokerResource struct {
+	dao.BaseResource
+	Summary *types.BrokerSummary
+	Detail  *mq.DescribeBrokerOutput
+}
+
+// NewBrokerResource creates a new BrokerResource from a list summary.
+func NewBrokerResource(broker types.BrokerSummary) *BrokerResource {
+	return &BrokerResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(broker.BrokerId),
+			Name: appaws.Str(broker.BrokerName),
+			ARN:  appaws.Str(broker.BrokerArn),
+			Data: broker,
+		},
+		Summary: &broker,
+	}
+}
+
+// NewBrokerResourceFromDetail creates a new BrokerResource from DescribeBroker.
+func NewBrokerResourceFromDetail(output *mq.DescribeBrokerOutput) *BrokerResource {
+	return &BrokerResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(output.BrokerId),
+			Name: appaws.Str(output.BrokerName),
+			ARN:  appaws.Str(output.BrokerArn),
+			Tags: output.Tags,
+			Data: output,
+		},
+		Detail: output,
+	}
+}
+
+// State returns the broker state (RUNNING, REBOOT_IN_PROGRESS, ...).
+func (r *BrokerResource) State() string {
+	if r.Detail != nil {
+		return string(r.Detail.BrokerState)
+	}
+	if r.Summary != nil {
+		return string(r.Summary.BrokerState)
+	}
+	return ""
+}
+
+// IsRunning reports whether the broker is running.
+func (r *BrokerResource) IsRunning() bool {
+	return r.State() == string(types.BrokerStateRunning)
+}
+
+// IsTransitioning reports whether the broker is being created, rebooted or deleted.
+func (r *BrokerResource) IsTransitioning() bool {
+	switch types.BrokerState(r.State()) {
+	case types.BrokerStateCreationInProgress, types.BrokerStateRebootInProgress,
+		types.BrokerStateDeletionInProgress:
+		return true
+	}
+	return false
+}
+
+// EngineType returns the broker engine (ACTIVEMQ or RABBITMQ).
+func (r *BrokerResource) EngineType() string {
+	if r.Detail != nil {
+		return string(r.Detail.EngineType)
+	}
+	if r.Summary != nil {
+		return string(r.Summary.EngineType)
+	}
+	return ""
+}
+
+// IsActiveMQ reports whether the broker runs ActiveMQ, whose users are
+// managed through the Amazon MQ API.
+func (r *BrokerResource) IsActiveMQ() bool {
+	return r.EngineType() == string(types.EngineTypeActivemq)
+}
+
+// EngineVersion returns the running engine version; only set by Get.
+func (r *BrokerResource) EngineVersion() string {
+	if r.Detail != nil {
+		return appaws.Str(r.Detail.EngineVersion)
+	}
+	return ""
+}
+
+// PendingEngineVersion returns the engine version applied at the next reboot.
+func (r *BrokerResource) PendingEngineVersion() string {
+	if r.Detail != nil {
+		return appaws.Str(r.Detail.PendingEngineVersion)
+	}
+	return ""
+}
+
+// DeploymentMode returns the deployment mode (SINGLE_INSTANCE, ...).
+func (r *BrokerResource) DeploymentMode() string {
+	if r.Detail != nil {
+		return string(r.Detail.DeploymentMode)
+	}
+	if r.Summary != nil {
+		return string(r.Summary.DeploymentMode)
+	}
+	return ""
+}
+
+// HostInstanceType returns the broker instance type.
+func (r *BrokerResource) HostInstanceType() string {
+	if r.Detail != nil {
+		return appaws.Str(r.Detail.HostInstanceType)
+	}
+	if r.Summary != nil {
+		return appaws.Str(r.Summary.HostInstanceType)
+	}
+	return ""
+}
+
+// CreatedAt returns when the broker was created.
+func (r *BrokerResource) CreatedAt() *time.Time {
+	if r.Detail != nil {
+		return r.Detail.Created
+	}
+	if r.Summary != nil {
+		return r.Summary.Created
+	}
+	return nil
+}
+
+// Endpoints returns the wire-level endpoints of all broker instances.
+func (r *BrokerResource) Endpoints() []string {
+	if r.Detail == nil {
+		return nil
+	}
+	var endpoints []string
+	for _, inst := range r.Detail.BrokerInstances {
+		endpoints = append(endpoints, inst.Endpoints...)
+	}
+	return endpoints
+}
+
+// ConsoleURL returns the web console URL of the first broker instance.
+func (r *BrokerResource) ConsoleURL() string {
+	if r.Detail == nil {
+		return ""
+	}
+	for _, inst := range r.Detail.BrokerInstances {
+		if u := appaws.Str(inst.ConsoleURL); u != "" {
+			return u
+		}
+	}
+	return ""
+}
+
+// MaintenanceWindow returns the weekly maintenance window as "SUNDAY 02:00 UTC".
+func (r *BrokerResource) MaintenanceWindow() string {
+	if r.Detail == nil || r.Detail.MaintenanceWindowStartTime == nil {
+		return ""
+	}
+	w := r.Detail.MaintenanceWindowStartTime
+	parts := []string{string(w.DayOfWeek), appaws.Str(w.TimeOfDay)}
+	if tz := appaws.Str(w.TimeZone); tz != "" {
+		parts = append(parts, tz)
+	}
+	return strings.Join(parts, " ")
+}
+
+// AutoMinorVersionUpgrade reports whether minor engine upgrades are applied
+// during the maintenance window.
+func (r *BrokerResource) AutoMinorVersionUpgrade() bool {
+	return r.Detail != nil && appaws.Bool(r.Detail.AutoMinorVersionUpgrade)
+}
+
+// PubliclyAccessible reports whether the broker has public endpoints.
+func (r *BrokerResource) PubliclyAccessible() bool {
+	return r.Detail != nil && appaws.Bool(r.Detail.PubliclyAccessible)
+}
+
+// Users returns the broker's users as "name" or "name (CREATE)" when a
+// change is pending the next reboot.
+func (r *BrokerResource) Users() []string {
+	if r.Detail == nil {
+		return nil
+	}
+	users := make([]string, 0, len(r.Detail.Users))
+	for _, u := range r.Detail.Users {
+		name := appaws.Str(u.Username)
+		if u.PendingChange != "" {
+			name = fmt.Sprintf("%s (%s)", name, u.PendingChange)
+		}
+		users = append(users, name)
+	}
+	return users
+}
+
+// ActionsRequired returns the codes of actions needed to recover a broker in
+// CRITICAL_ACTION_REQUIRED state.
+func (r *BrokerResource) ActionsRequired() []string {
+	if r.Detail == nil {
+		return nil
+	}
+	var codes []string
+	for _, a := range r.Detail.ActionsRequired {
+		codes = append(codes, appaws.Str(a.ActionRequiredCode))
+	}
+	return codes
+}
@@ -0,0 +1,87 @@
+package brokers
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+)
+
+func TestBrokerResourceFromSummary(t *testing.T) {
+	broker := NewBrokerResource(types.BrokerSummary{
+		BrokerId:         aws.String("b-1234"),
+		BrokerName:       aws.String("orders"),
+		BrokerState:      types.BrokerStateRebootInProgress,
+		EngineType:       types.EngineTypeRabbitmq,
+		DeploymentMode:   types.DeploymentModeClusterMultiAz,
+		HostInstanceType: aws.String("mq.m5.large"),
+	})
+
+	if broker.GetID() != "b-1234" || broker.GetName() != "orders" {
+		t.Errorf("id=%q name=%q", broker.GetID(), broker.GetName())
+	}
+	if broker.IsRunning() || !broker.IsTransitioning() || broker.IsActiveMQ() {
+		t.Errorf("running=%v transitioning=%v activemq=%v", broker.IsRunning(), broker.IsTransitioning(), broker.IsActiveMQ())
+	}
+	if broker.ConsoleURL() != "" || broker.MaintenanceWindow() != "" || broker.Endpoints() != nil {
+		t.Errorf("summary should carry no detail fields")
+	}
+	if got := (&BrokerRenderer{}).Navigations(broker); got != nil {
+		t.Errorf("RabbitMQ broker should have no user navigation, got %v", got)
+	}
+}
+
+func TestBrokerResourceFromDetail(t *testing.T) {
+	broker := NewBrokerResourceFromDetail(&mq.DescribeBrokerOutput{
+		BrokerId:    aws.String("b-5678"),
+		BrokerName:  aws.String("events"),
+		BrokerState: types.BrokerStateRunning,
+		EngineType:  types.EngineTypeActivemq,
+		BrokerInstances: []types.BrokerInstance{
+			{
+				ConsoleURL: aws.String("https://b-5678-1.mq.eu-west-1.amazonaws.com:8162"),
+				Endpoints:  []string{"ssl://b-5678-1:61617", "amqp+ssl://b-5678-1:5671"},
+			},
+			{
+				ConsoleURL: aws.String("https://b-5678-2.mq.eu-west-1.amazonaws.com:8162"),
+				Endpoints:  []string{"ssl://b-5678-2:61617"},
+			},
+		},
+		MaintenanceWindowStartTime: &types.WeeklyStartTime{
+			DayOfWeek: types.DayOfWeekSunday,
+			TimeOfDay: aws.String("02:00"),
+			TimeZone:  aws.String("UTC"),
+		},
+		Users: []types.UserSummary{
+			{Username: aws.String("admin")},
+			{Username: aws.String("app"), PendingChange: types.ChangeTypeCreate},
+		},
+		Tags: map[string]string{"team": "payments"},
+	})
+
+	if !broker.IsRunning() || broker.IsTransitioning() || !broker.IsActiveMQ() {
+		t.Errorf("running=%v transitioning=%v activemq=%v", broker.IsRunning(), broker.IsTransitioning(), broker.IsActiveMQ())
+	}
+	if got := broker.ConsoleURL(); got != "https://b-5678-1.mq.eu-west-1.amazonaws.com:8162" {
+		t.Errorf("ConsoleURL() = %q", got)
+	}
+	if got := broker.Endpoints(); len(got) != 3 {
+		t.Errorf("Endpoints() = %v", got)
+	}
+	if got := broker.MaintenanceWindow(); got != "SUNDAY 02:00 UTC" {
+		t.Errorf("MaintenanceWindow() = %q", got)
+	}
+	if got := broker.Users(); !slices.Equal(got, []string{"admin", "app (CREATE)"}) {
+		t.Errorf("Users() = %v", got)
+	}
+	if broker.GetTags()["team"] != "payments" {
+		t.Errorf("tags = %v", broker.GetTags())
+	}
+
+	navs := (&BrokerRenderer{}).Navigations(broker)
+	if len(navs) != 1 || navs[0].Resource != "users" || navs[0].FilterValue != "b-5678" {
+		t.Errorf("Navigations() = %+v", navs)
+	}
+}
@@ -0,0 +1,20 @@
+package brokers
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("mq", "brokers", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewBrokerDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewBrokerRenderer()
+		},
+	})
+}
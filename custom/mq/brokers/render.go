@@ -0,0 +1,213 @@
+package brokers
+
+import (
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure BrokerRenderer implements render.Navigator
+var _ render.Navigator = (*BrokerRenderer)(nil)
+
+// BrokerRenderer renders Amazon MQ brokers.
+type BrokerRenderer struct {
+	render.BaseRenderer
+}
+
+// NewBrokerRenderer creates a new BrokerRenderer.
+func NewBrokerRenderer() render.Renderer {
+	return &BrokerRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "mq",
+			Resource: "brokers",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "ENGINE", Width: 10, Getter: getEngine},
+				{Name: "STATE", Width: 26, Getter: getState},
+				{Name: "MODE", Width: 24, Getter: getMode},
+				{Name: "INSTANCE TYPE", Width: 16, Getter: getInstanceType},
+				{Name: "CREATED", Width: 18, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getEngine(r dao.Resource) string {
+	broker, ok := r.(*BrokerResource)
+	if !ok {
+		return ""
+	}
+	return broker.EngineType()
+}
+
+func getState(r dao.Resource) string {
+	broker, ok := r.(*BrokerResource)
+	if !ok {
+		return ""
+	}
+	return broker.State()
+}
+
+func getMode(r dao.Resource) string {
+	broker, ok := r.(*BrokerResource)
+	if !ok {
+		return ""
+	}
+	return broker.DeploymentMode()
+}
+
+func getInstanceType(r dao.Resource) string {
+	broker, ok := r.(*BrokerResource)
+	if !ok {
+		return ""
+	}
+	return broker.HostInstanceType()
+}
+
+func getCreated(r dao.Resource) string {
+	broker, ok := r.(*BrokerResource)
+	if !ok {
+		return ""
+	}
+	if t := broker.CreatedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func stateStyle(state string) render.Style {
+	switch state {
+	case "RUNNING":
+		return ui.SuccessStyle()
+	case "CREATION_IN_PROGRESS", "REBOOT_IN_PROGRESS", "DELETION_IN_PROGRESS":
+		return ui.WarningStyle()
+	case "CREATION_FAILED", "CRITICAL_ACTION_REQUIRED":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an Amazon MQ broker.
+func (r *BrokerRenderer) RenderDetail(resource dao.Resource) string {
+	broker, ok := resource.(*BrokerResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Amazon MQ Broker", broker.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", broker.GetName())
+	d.Field("Broker ID", broker.GetID())
+	d.Field("ARN", broker.GetARN())
+	d.FieldStyled("State", broker.State(), stateStyle(broker.State()))
+	if codes := broker.ActionsRequired(); len(codes) > 0 {
+		d.FieldStyled("Actions Required", strings.Join(codes, ", "), ui.DangerStyle())
+	}
+
+	d.Section("Engine")
+	d.Field("Engine", broker.EngineType())
+	if v := broker.EngineVersion(); v != "" {
+		d.Field("Version", v)
+	}
+	if v := broker.PendingEngineVersion(); v != "" {
+		d.Field("Pending Version", v)
+	}
+	d.Field("Deployment Mode", broker.DeploymentMode())
+	d.Field("Instance Type", broker.HostInstanceType())
+
+	if endpoints := broker.Endpoints(); len(endpoints) > 0 || broker.ConsoleURL() != "" {
+		d.Section("Endpoints")
+		if u := broker.ConsoleURL(); u != "" {
+			d.Field("Web Console", u)
+		}
+		for _, e := range endpoints {
+			d.Line("  " + e)
+		}
+		d.Field("Public", yesNo(broker.PubliclyAccessible()))
+	}
+
+	if w := broker.MaintenanceWindow(); w != "" {
+		d.Section("Maintenance Window")
+		d.Field("Start", w)
+		d.Field("Auto Minor Upgrade", yesNo(broker.AutoMinorVersionUpgrade()))
+	}
+
+	if broker.IsActiveMQ() {
+		if users := broker.Users(); len(users) > 0 {
+			d.Section("Users")
+			for _, u := range users {
+				d.Line("  " + u)
+			}
+		}
+	}
+
+	d.Tags(broker.GetTags())
+
+	d.Section("Timestamps")
+	if t := broker.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// RenderSummary renders summary fields for an Amazon MQ broker.
+func (r *BrokerRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	broker, ok := resource.(*BrokerResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: broker.GetName()},
+		{Label: "Engine", Value: broker.EngineType()},
+		{Label: "State", Value: broker.State(), Style: stateStyle(broker.State())},
+		{Label: "Mode", Value: broker.DeploymentMode()},
+	}
+	if w := broker.MaintenanceWindow(); w != "" {
+		fields = append(fields, render.SummaryField{Label: "Maintenance", Value: w})
+	}
+	return fields
+}
+
+// Navigations returns available navigations from an Amazon MQ broker.
+func (r *BrokerRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	broker, ok := resource.(*BrokerResource)
+	if !ok || !broker.IsActiveMQ() {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "u",
+			Label:       "Users",
+			Service:     "mq",
+			Resource:    "users",
+			FilterField: "BrokerId",
+			FilterValue: broker.GetID(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a broker is being created,
+// rebooted or deleted
+func (r *BrokerRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if broker, ok := dao.UnwrapResource(res).(*BrokerResource); ok && broker.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
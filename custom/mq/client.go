@@ -0,0 +1,14 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an Amazon MQ client configured for the current context
+func GetClient(ctx context.Context) (*mq.Client, error) {
+	return appaws.Client(ctx, mq.NewFromConfig)
+}
@@ -0,0 +1,160 @@
+package users
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mq"
+	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// UserDAO provides data access for Amazon MQ broker users.
+type UserDAO struct {
+	dao.BaseDAO
+	client *mq.Client
+}
+
+// NewUserDAO creates a new UserDAO.
+func NewUserDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, mq.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &UserDAO{
+		BaseDAO: dao.NewBaseDAO("mq", "users"),
+		client:  client,
+	}, nil
+}
+
+// List returns all users of an ActiveMQ broker.
+func (d *UserDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	brokerId := dao.GetFilterFromContext(ctx, "BrokerId")
+	if brokerId == "" {
+		return nil, fmt.Errorf("broker ID filter required")
+	}
+
+	users, err := appaws.Paginate(ctx, func(token *string) ([]types.UserSummary, *string, error) {
+		output, err := d.client.ListUsers(ctx, &mq.ListUsersInput{
+			BrokerId:   &brokerId,
+			MaxResults: aws.Int32(100),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list mq users")
+		}
+		return output.Users, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(users))
+	for i, user := range users {
+		resources[i] = NewUserResource(user, brokerId)
+	}
+	return resources, nil
+}
+
+// Get returns a specific user by username.
+func (d *UserDAO) Get(ctx context.Context, username string) (dao.Resource, error) {
+	brokerId := dao.GetFilterFromContext(ctx, "BrokerId")
+	if brokerId == "" {
+		return nil, fmt.Errorf("broker ID filter required")
+	}
+
+	output, err := d.client.DescribeUser(ctx, &mq.DescribeUserInput{
+		BrokerId: &brokerId,
+		Username: &username,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe mq user %s", username)
+	}
+	return NewUserResourceFromDetail(output, brokerId), nil
+}
+
+// Delete deletes a broker user. The change takes effect at the next reboot.
+func (d *UserDAO) Delete(ctx context.Context, username string) error {
+	brokerId := dao.GetFilterFromContext(ctx, "BrokerId")
+	if brokerId == "" {
+		return fmt.Errorf("broker ID filter required")
+	}
+
+	_, err := d.client.DeleteUser(ctx, &mq.DeleteUserInput{
+		BrokerId: &brokerId,
+		Username: &username,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete mq user %s", username)
+	}
+	return nil
+}
+
+// UserResource wraps an Amazon MQ broker user.
+type UserResource struct {
+	dao.BaseResource
+	Summary  *types.UserSummary
+	Detail   *mq.DescribeUserOutput
+	BrokerId string
+}
+
+// NewUserResource creates a new UserResource from summary.
+func NewUserResource(user types.UserSummary, brokerId string) *UserResource {
+	return &UserResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(user.Username),
+			Name: appaws.Str(user.Username),
+			Data: user,
+		},
+		Summary:  &user,
+		BrokerId: brokerId,
+	}
+}
+
+// NewUserResourceFromDetail creates a new UserResource from detail.
+func NewUserResourceFromDetail(output *mq.DescribeUserOutput, brokerId string) *UserResource {
+	return &UserResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(output.Username),
+			Name: appaws.Str(output.Username),
+			Data: output,
+		},
+		Detail:   output,
+		BrokerId: brokerId,
+	}
+}
+
+// PendingChange returns the change (CREATE, UPDATE, DELETE) waiting for the
+// next broker reboot.
+func (r *UserResource) PendingChange() string {
+	if r.Summary != nil {
+		return string(r.Summary.PendingChange)
+	}
+	if r.Detail != nil && r.Detail.Pending != nil {
+		return string(r.Detail.Pending.PendingChange)
+	}
+	return ""
+}
+
+// ConsoleAccess reports whether the user may log in to the ActiveMQ web
+// console; only known after Get.
+func (r *UserResource) ConsoleAccess() bool {
+	return r.Detail != nil && appaws.Bool(r.Detail.ConsoleAccess)
+}
+
+// Groups returns the user's ActiveMQ groups; only known after Get.
+func (r *UserResource) Groups() []string {
+	if r.Detail != nil {
+		return r.Detail.Groups
+	}
+	return nil
+}
+
+// ReplicationUser reports whether the user is used for cross-region replication.
+func (r *UserResource) ReplicationUser() bool {
+	return r.Detail != nil && appaws.Bool(r.Detail.ReplicationUser)
+}
@@ -0,0 +1,94 @@
+package users
+
+import (
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// UserRenderer renders Amazon MQ broker users.
+type UserRenderer struct {
+	render.BaseRenderer
+}
+
+// NewUserRenderer creates a new UserRenderer.
+func NewUserRenderer() render.Renderer {
+	return &UserRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "mq",
+			Resource: "users",
+			Cols: []render.Column{
+				{Name: "USERNAME", Width: 30, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "PENDING CHANGE", Width: 16, Getter: getPendingChange},
+			},
+		},
+	}
+}
+
+func getPendingChange(r dao.Resource) string {
+	user, ok := r.(*UserResource)
+	if !ok {
+		return ""
+	}
+	if change := user.PendingChange(); change != "" {
+		return change
+	}
+	return "-"
+}
+
+// RenderDetail renders the detail view for an Amazon MQ broker user.
+func (r *UserRenderer) RenderDetail(resource dao.Resource) string {
+	user, ok := resource.(*UserResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Amazon MQ User", user.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Username", user.GetID())
+	d.Field("Broker ID", user.BrokerId)
+	if change := user.PendingChange(); change != "" {
+		d.Field("Pending Change", change+" (applied at next reboot)")
+	}
+
+	if user.Detail != nil {
+		d.Section("Access")
+		d.Field("Console Access", yesNo(user.ConsoleAccess()))
+		if groups := user.Groups(); len(groups) > 0 {
+			d.Field("Groups", strings.Join(groups, ", "))
+		}
+		if user.ReplicationUser() {
+			d.Field("Replication User", "Yes")
+		}
+	}
+
+	return d.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// RenderSummary renders summary fields for an Amazon MQ broker user.
+func (r *UserRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	user, ok := resource.(*UserResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Username", Value: user.GetID()},
+		{Label: "Broker ID", Value: user.BrokerId},
+	}
+	if change := user.PendingChange(); change != "" {
+		fields = append(fields, render.SummaryField{Label: "Pending", Value: change})
+	}
+	return fields
+}
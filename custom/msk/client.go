@@ -0,0 +1,14 @@
+package msk
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an MSK client configured for the current context
+func GetClient(ctx context.Context) (*kafka.Client, error) {
+	return appaws.Client(ctx, kafka.NewFromConfig)
+}
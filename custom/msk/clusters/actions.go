@@ -0,0 +1,82 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+
+	mskClient "github.com/clawscli/claws/custom/msk"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for MSK clusters
+	action.Global.Register("msk", "clusters", []action.Action{
+		{
+			Name:      "Copy Bootstrap Brokers",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CopyBootstrapBrokers",
+			Filter: func(r dao.Resource) bool {
+				cluster, ok := dao.UnwrapResource(r).(*ClusterResource)
+				return ok && cluster.IsActive()
+			},
+			Input: &action.InputSpec{
+				Label:   "Bootstrap brokers for",
+				Choices: bootstrapChoices,
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("msk", "clusters", executeClusterAction)
+}
+
+// executeClusterAction executes an action on an MSK cluster
+func executeClusterAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CopyBootstrapBrokers":
+		brokers := action.InputFromContext(ctx)
+		if brokers == "" {
+			return action.FailResult(fmt.Errorf("no bootstrap brokers selected"))
+		}
+		return action.SuccessResultWithFollowUp("Copied bootstrap brokers", clipboard.Copy("Bootstrap brokers", brokers)())
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// bootstrapChoices offers one bootstrap broker string per enabled
+// authentication method, fetching them when the row came from List.
+func bootstrapChoices(ctx context.Context, resource dao.Resource) ([]action.Choice, error) {
+	cluster, ok := dao.UnwrapResource(resource).(*ClusterResource)
+	if !ok {
+		return nil, fmt.Errorf("not an MSK cluster")
+	}
+
+	if cluster.Bootstrap == nil {
+		client, err := mskClient.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		arn := cluster.GetARN()
+		output, err := client.GetBootstrapBrokers(ctx, &kafka.GetBootstrapBrokersInput{ClusterArn: &arn})
+		if err != nil {
+			return nil, fmt.Errorf("get bootstrap brokers: %w", err)
+		}
+		cluster = &ClusterResource{BaseResource: cluster.BaseResource, Item: cluster.Item, Bootstrap: output}
+	}
+
+	endpoints := cluster.BootstrapBrokers()
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("cluster %s has no bootstrap brokers", cluster.GetName())
+	}
+	choices := make([]action.Choice, len(endpoints))
+	for i, e := range endpoints {
+		choices[i] = action.Choice{Value: e.Brokers, Label: fmt.Sprintf("%s: %s", e.Label, e.Brokers)}
+	}
+	return choices, nil
+}
@@ -0,0 +1,283 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ClusterDAO provides data access for MSK clusters.
+type ClusterDAO struct {
+	dao.BaseDAO
+	client *kafka.Client
+}
+
+// NewClusterDAO creates a new ClusterDAO.
+func NewClusterDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, kafka.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ClusterDAO{
+		BaseDAO: dao.NewBaseDAO("msk", "clusters"),
+		client:  client,
+	}, nil
+}
+
+// List returns all provisioned and serverless clusters.
+func (d *ClusterDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	clusters, err := d.listClusters(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(clusters))
+	for i, c := range clusters {
+		resources[i] = NewClusterResource(c)
+	}
+	return resources, nil
+}
+
+// Get returns a cluster by name, including its bootstrap broker strings.
+func (d *ClusterDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	// ClusterNameFilter is a prefix match, so keep the exact name only.
+	clusters, err := d.listClusters(ctx, &id)
+	if err != nil {
+		return nil, err
+	}
+	var cluster *ClusterResource
+	for _, c := range clusters {
+		if appaws.Str(c.ClusterName) == id {
+			cluster = NewClusterResource(c)
+			break
+		}
+	}
+	if cluster == nil {
+		return nil, fmt.Errorf("cluster not found: %s", id)
+	}
+
+	if cluster.IsActive() {
+		arn := cluster.GetARN()
+		brokers, err := d.client.GetBootstrapBrokers(ctx, &kafka.GetBootstrapBrokersInput{ClusterArn: &arn})
+		if err != nil {
+			log.Warn("failed to get bootstrap brokers", "cluster", id, "error", err)
+		} else {
+			cluster.Bootstrap = brokers
+		}
+	}
+	return cluster, nil
+}
+
+// Delete deletes a cluster by name.
+func (d *ClusterDAO) Delete(ctx context.Context, id string) error {
+	res, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	arn := res.GetARN()
+	if _, err := d.client.DeleteCluster(ctx, &kafka.DeleteClusterInput{ClusterArn: &arn}); err != nil {
+		return apperrors.Wrapf(err, "delete msk cluster %s", id)
+	}
+	return nil
+}
+
+func (d *ClusterDAO) listClusters(ctx context.Context, nameFilter *string) ([]types.Cluster, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.Cluster, *string, error) {
+		output, err := d.client.ListClustersV2(ctx, &kafka.ListClustersV2Input{
+			ClusterNameFilter: nameFilter,
+			NextToken:         token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list msk clusters")
+		}
+		return output.ClusterInfoList, output.NextToken, nil
+	})
+}
+
+// ClusterResource wraps an MSK cluster.
+type ClusterResource struct {
+	dao.BaseResource
+	Item types.Cluster
+
+	// Bootstrap holds the bootstrap broker strings; only set by Get.
+	Bootstrap *kafka.GetBootstrapBrokersOutput
+}
+
+// NewClusterResource creates a new ClusterResource.
+func NewClusterResource(c types.Cluster) *ClusterResource {
+	return &ClusterResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(c.ClusterName),
+			Name: appaws.Str(c.ClusterName),
+			ARN:  appaws.Str(c.ClusterArn),
+			Tags: c.Tags,
+			Data: c,
+		},
+		Item: c,
+	}
+}
+
+// State returns the cluster state (ACTIVE, CREATING, UPDATING, ...).
+func (r *ClusterResource) State() string {
+	return string(r.Item.State)
+}
+
+// IsActive reports whether the cluster is active.
+func (r *ClusterResource) IsActive() bool {
+	return r.Item.State == types.ClusterStateActive
+}
+
+// IsTransitioning reports whether the cluster is being created, changed or deleted.
+func (r *ClusterResource) IsTransitioning() bool {
+	switch r.Item.State {
+	case types.ClusterStateCreating, types.ClusterStateUpdating, types.ClusterStateDeleting,
+		types.ClusterStateHealing, types.ClusterStateMaintenance, types.ClusterStateRebootingBroker:
+		return true
+	}
+	return false
+}
+
+// ClusterType returns PROVISIONED or SERVERLESS.
+func (r *ClusterResource) ClusterType() string {
+	return string(r.Item.ClusterType)
+}
+
+// IsServerless reports whether the cluster is serverless.
+func (r *ClusterResource) IsServerless() bool {
+	return r.Item.ClusterType == types.ClusterTypeServerless
+}
+
+// KafkaVersion returns the Apache Kafka version of a provisioned cluster.
+func (r *ClusterResource) KafkaVersion() string {
+	if p := r.Item.Provisioned; p != nil && p.CurrentBrokerSoftwareInfo != nil {
+		return appaws.Str(p.CurrentBrokerSoftwareInfo.KafkaVersion)
+	}
+	return ""
+}
+
+// BrokerCount returns the number of broker nodes of a provisioned cluster.
+func (r *ClusterResource) BrokerCount() int32 {
+	if p := r.Item.Provisioned; p != nil && p.NumberOfBrokerNodes != nil {
+		return *p.NumberOfBrokerNodes
+	}
+	return 0
+}
+
+// InstanceType returns the broker instance type.
+func (r *ClusterResource) InstanceType() string {
+	if p := r.Item.Provisioned; p != nil && p.BrokerNodeGroupInfo != nil {
+		return appaws.Str(p.BrokerNodeGroupInfo.InstanceType)
+	}
+	return ""
+}
+
+// StorageGiB returns the EBS volume size per broker in GiB.
+func (r *ClusterResource) StorageGiB() int32 {
+	p := r.Item.Provisioned
+	if p == nil || p.BrokerNodeGroupInfo == nil || p.BrokerNodeGroupInfo.StorageInfo == nil {
+		return 0
+	}
+	if ebs := p.BrokerNodeGroupInfo.StorageInfo.EbsStorageInfo; ebs != nil && ebs.VolumeSize != nil {
+		return *ebs.VolumeSize
+	}
+	return 0
+}
+
+// StorageMode returns the storage mode (LOCAL or TIERED).
+func (r *ClusterResource) StorageMode() string {
+	if p := r.Item.Provisioned; p != nil {
+		return string(p.StorageMode)
+	}
+	return ""
+}
+
+// IAMAuthEnabled reports whether clients can authenticate with IAM, which
+// the MSK topic APIs require.
+func (r *ClusterResource) IAMAuthEnabled() bool {
+	if s := r.Item.Serverless; s != nil {
+		return s.ClientAuthentication != nil && s.ClientAuthentication.Sasl != nil &&
+			s.ClientAuthentication.Sasl.Iam != nil && appaws.Bool(s.ClientAuthentication.Sasl.Iam.Enabled)
+	}
+	if p := r.Item.Provisioned; p != nil {
+		return p.ClientAuthentication != nil && p.ClientAuthentication.Sasl != nil &&
+			p.ClientAuthentication.Sasl.Iam != nil && appaws.Bool(p.ClientAuthentication.Sasl.Iam.Enabled)
+	}
+	return false
+}
+
+// AuthMethods returns the enabled client authentication methods.
+func (r *ClusterResource) AuthMethods() []string {
+	if r.IsServerless() {
+		if r.IAMAuthEnabled() {
+			return []string{"IAM"}
+		}
+		return nil
+	}
+	p := r.Item.Provisioned
+	if p == nil || p.ClientAuthentication == nil {
+		return []string{"Unauthenticated"}
+	}
+	auth := p.ClientAuthentication
+	var methods []string
+	if r.IAMAuthEnabled() {
+		methods = append(methods, "IAM")
+	}
+	if auth.Sasl != nil && auth.Sasl.Scram != nil && appaws.Bool(auth.Sasl.Scram.Enabled) {
+		methods = append(methods, "SCRAM")
+	}
+	if auth.Tls != nil && appaws.Bool(auth.Tls.Enabled) {
+		methods = append(methods, "TLS")
+	}
+	if auth.Unauthenticated != nil && appaws.Bool(auth.Unauthenticated.Enabled) {
+		methods = append(methods, "Unauthenticated")
+	}
+	return methods
+}
+
+// CreatedAt returns when the cluster was created.
+func (r *ClusterResource) CreatedAt() *time.Time {
+	return r.Item.CreationTime
+}
+
+// BootstrapEndpoint is one bootstrap broker string and the client
+// authentication it is meant for.
+type BootstrapEndpoint struct {
+	Label   string
+	Brokers string
+}
+
+// BootstrapBrokers returns the non-empty bootstrap broker strings, IAM first.
+func (r *ClusterResource) BootstrapBrokers() []BootstrapEndpoint {
+	b := r.Bootstrap
+	if b == nil {
+		return nil
+	}
+	candidates := []BootstrapEndpoint{
+		{"IAM", appaws.Str(b.BootstrapBrokerStringSaslIam)},
+		{"IAM (public)", appaws.Str(b.BootstrapBrokerStringPublicSaslIam)},
+		{"IAM (VPC connectivity)", appaws.Str(b.BootstrapBrokerStringVpcConnectivitySaslIam)},
+		{"SCRAM", appaws.Str(b.BootstrapBrokerStringSaslScram)},
+		{"SCRAM (public)", appaws.Str(b.BootstrapBrokerStringPublicSaslScram)},
+		{"SCRAM (VPC connectivity)", appaws.Str(b.BootstrapBrokerStringVpcConnectivitySaslScram)},
+		{"TLS", appaws.Str(b.BootstrapBrokerStringTls)},
+		{"TLS (public)", appaws.Str(b.BootstrapBrokerStringPublicTls)},
+		{"TLS (VPC connectivity)", appaws.Str(b.BootstrapBrokerStringVpcConnectivityTls)},
+		{"Plaintext", appaws.Str(b.BootstrapBrokerString)},
+	}
+	var endpoints []BootstrapEndpoint
+	for _, c := range candidates {
+		if strings.TrimSpace(c.Brokers) != "" {
+			endpoints = append(endpoints, c)
+		}
+	}
+	return endpoints
+}
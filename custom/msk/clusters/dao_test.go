@@ -0,0 +1,99 @@
+package clusters
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+)
+
+func TestProvisionedCluster(t *testing.T) {
+	cluster := NewClusterResource(types.Cluster{
+		ClusterName: aws.String("events"),
+		ClusterArn:  aws.String("arn:aws:kafka:eu-west-1:123456789012:cluster/events/abc"),
+		ClusterType: types.ClusterTypeProvisioned,
+		State:       types.ClusterStateActive,
+		Provisioned: &types.Provisioned{
+			NumberOfBrokerNodes: aws.Int32(3),
+			BrokerNodeGroupInfo: &types.BrokerNodeGroupInfo{
+				InstanceType: aws.String("kafka.m5.large"),
+				StorageInfo: &types.StorageInfo{
+					EbsStorageInfo: &types.EBSStorageInfo{VolumeSize: aws.Int32(1000)},
+				},
+			},
+			CurrentBrokerSoftwareInfo: &types.BrokerSoftwareInfo{KafkaVersion: aws.String("3.6.0")},
+			ClientAuthentication: &types.ClientAuthentication{
+				Sasl: &types.Sasl{Iam: &types.Iam{Enabled: aws.Bool(true)}},
+				Tls:  &types.Tls{Enabled: aws.Bool(true)},
+			},
+		},
+	})
+
+	if cluster.BrokerCount() != 3 || cluster.StorageGiB() != 1000 || cluster.KafkaVersion() != "3.6.0" {
+		t.Errorf("brokers=%d storage=%d version=%q", cluster.BrokerCount(), cluster.StorageGiB(), cluster.KafkaVersion())
+	}
+	if !cluster.IAMAuthEnabled() {
+		t.Error("IAMAuthEnabled() = false")
+	}
+	if got := cluster.AuthMethods(); !slices.Equal(got, []string{"IAM", "TLS"}) {
+		t.Errorf("AuthMethods() = %v", got)
+	}
+
+	navs := (&ClusterRenderer{}).Navigations(cluster)
+	if len(navs) != 1 || navs[0].Resource != "topics" || navs[0].FilterValue != cluster.GetARN() {
+		t.Errorf("Navigations() = %+v", navs)
+	}
+}
+
+func TestClusterWithoutIAM(t *testing.T) {
+	cluster := NewClusterResource(types.Cluster{
+		ClusterName: aws.String("legacy"),
+		ClusterType: types.ClusterTypeProvisioned,
+		State:       types.ClusterStateUpdating,
+		Provisioned: &types.Provisioned{
+			ClientAuthentication: &types.ClientAuthentication{
+				Unauthenticated: &types.Unauthenticated{Enabled: aws.Bool(true)},
+			},
+		},
+	})
+
+	if cluster.IAMAuthEnabled() || !cluster.IsTransitioning() {
+		t.Errorf("iam=%v transitioning=%v", cluster.IAMAuthEnabled(), cluster.IsTransitioning())
+	}
+	if navs := (&ClusterRenderer{}).Navigations(cluster); navs != nil {
+		t.Errorf("expected no topic navigation, got %+v", navs)
+	}
+}
+
+func TestServerlessClusterIAM(t *testing.T) {
+	cluster := NewClusterResource(types.Cluster{
+		ClusterType: types.ClusterTypeServerless,
+		Serverless: &types.Serverless{
+			ClientAuthentication: &types.ServerlessClientAuthentication{
+				Sasl: &types.ServerlessSasl{Iam: &types.Iam{Enabled: aws.Bool(true)}},
+			},
+		},
+	})
+
+	if !cluster.IAMAuthEnabled() || cluster.BrokerCount() != 0 {
+		t.Errorf("iam=%v brokers=%d", cluster.IAMAuthEnabled(), cluster.BrokerCount())
+	}
+}
+
+func TestBootstrapBrokers(t *testing.T) {
+	cluster := &ClusterResource{Bootstrap: &kafka.GetBootstrapBrokersOutput{
+		BootstrapBrokerStringTls:     aws.String("b-1:9094,b-2:9094"),
+		BootstrapBrokerStringSaslIam: aws.String("b-1:9098,b-2:9098"),
+		BootstrapBrokerString:        aws.String(""),
+	}}
+
+	got := cluster.BootstrapBrokers()
+	if len(got) != 2 || got[0].Label != "IAM" || got[1].Brokers != "b-1:9094,b-2:9094" {
+		t.Errorf("BootstrapBrokers() = %+v", got)
+	}
+	if (&ClusterResource{}).BootstrapBrokers() != nil {
+		t.Error("expected no bootstrap brokers before Get")
+	}
+}
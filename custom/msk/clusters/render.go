@@ -0,0 +1,220 @@
+package clusters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Ensure ClusterRenderer implements render.Navigator
+var _ render.Navigator = (*ClusterRenderer)(nil)
+
+// ClusterRenderer renders MSK clusters.
+type ClusterRenderer struct {
+	render.BaseRenderer
+}
+
+// NewClusterRenderer creates a new ClusterRenderer.
+func NewClusterRenderer() render.Renderer {
+	return &ClusterRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "msk",
+			Resource: "clusters",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "TYPE", Width: 12, Getter: getType},
+				{Name: "STATE", Width: 16, Getter: getState},
+				{Name: "KAFKA", Width: 12, Getter: getKafkaVersion},
+				{Name: "BROKERS", Width: 8, Getter: getBrokers},
+				{Name: "INSTANCE TYPE", Width: 18, Getter: getInstanceType},
+				{Name: "STORAGE", Width: 10, Getter: getStorage},
+				{Name: "CREATED", Width: 18, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	cluster, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	return cluster.ClusterType()
+}
+
+func getState(r dao.Resource) string {
+	cluster, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	return cluster.State()
+}
+
+func getKafkaVersion(r dao.Resource) string {
+	cluster, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	if v := cluster.KafkaVersion(); v != "" {
+		return v
+	}
+	return "-"
+}
+
+func getBrokers(r dao.Resource) string {
+	cluster, ok := r.(*ClusterResource)
+	if !ok || cluster.BrokerCount() == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", cluster.BrokerCount())
+}
+
+func getInstanceType(r dao.Resource) string {
+	cluster, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	if t := cluster.InstanceType(); t != "" {
+		return t
+	}
+	return "-"
+}
+
+func getStorage(r dao.Resource) string {
+	cluster, ok := r.(*ClusterResource)
+	if !ok || cluster.StorageGiB() == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d GiB", cluster.StorageGiB())
+}
+
+func getCreated(r dao.Resource) string {
+	cluster, ok := r.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+	if t := cluster.CreatedAt(); t != nil {
+		return t.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func stateStyle(state string) render.Style {
+	switch state {
+	case "ACTIVE":
+		return ui.SuccessStyle()
+	case "CREATING", "UPDATING", "DELETING", "HEALING", "MAINTENANCE", "REBOOTING_BROKER":
+		return ui.WarningStyle()
+	case "FAILED":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an MSK cluster.
+func (r *ClusterRenderer) RenderDetail(resource dao.Resource) string {
+	cluster, ok := resource.(*ClusterResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("MSK Cluster", cluster.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", cluster.GetName())
+	d.Field("ARN", cluster.GetARN())
+	d.Field("Type", cluster.ClusterType())
+	d.FieldStyled("State", cluster.State(), stateStyle(cluster.State()))
+	if si := cluster.Item.StateInfo; si != nil && si.Message != nil {
+		d.Field("State Message", *si.Message)
+	}
+
+	if !cluster.IsServerless() {
+		d.Section("Brokers")
+		d.Field("Kafka Version", cluster.KafkaVersion())
+		d.Field("Broker Nodes", fmt.Sprintf("%d", cluster.BrokerCount()))
+		d.Field("Instance Type", cluster.InstanceType())
+		if size := cluster.StorageGiB(); size > 0 {
+			d.Field("Storage per Broker", fmt.Sprintf("%d GiB", size))
+		}
+		if mode := cluster.StorageMode(); mode != "" {
+			d.Field("Storage Mode", mode)
+		}
+	}
+
+	d.Section("Authentication")
+	if methods := cluster.AuthMethods(); len(methods) > 0 {
+		d.Field("Methods", strings.Join(methods, ", "))
+	} else {
+		d.Field("Methods", "-")
+	}
+
+	if endpoints := cluster.BootstrapBrokers(); len(endpoints) > 0 {
+		d.Section("Bootstrap Brokers")
+		for _, e := range endpoints {
+			d.Field(e.Label, e.Brokers)
+		}
+	}
+
+	d.Tags(cluster.GetTags())
+
+	d.Section("Timestamps")
+	if t := cluster.CreatedAt(); t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an MSK cluster.
+func (r *ClusterRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	cluster, ok := resource.(*ClusterResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: cluster.GetName()},
+		{Label: "Type", Value: cluster.ClusterType()},
+		{Label: "State", Value: cluster.State(), Style: stateStyle(cluster.State())},
+	}
+	if v := cluster.KafkaVersion(); v != "" {
+		fields = append(fields, render.SummaryField{Label: "Kafka", Value: v})
+	}
+	return fields
+}
+
+// Navigations returns available navigations from an MSK cluster. Topics are
+// only listed for clusters that accept IAM authentication.
+func (r *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	cluster, ok := resource.(*ClusterResource)
+	if !ok || !cluster.IAMAuthEnabled() {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "t",
+			Label:       "Topics",
+			Service:     "msk",
+			Resource:    "topics",
+			FilterField: "ClusterArn",
+			FilterValue: cluster.GetARN(),
+		},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while a cluster is changing state
+func (r *ClusterRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if cluster, ok := dao.UnwrapResource(res).(*ClusterResource); ok && cluster.IsTransitioning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package topics
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// TopicDAO provides data access for the topics of an MSK cluster.
+type TopicDAO struct {
+	dao.BaseDAO
+	client *kafka.Client
+}
+
+// NewTopicDAO creates a new TopicDAO.
+func NewTopicDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, kafka.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &TopicDAO{
+		BaseDAO: dao.NewBaseDAO("msk", "topics"),
+		client:  client,
+	}, nil
+}
+
+// List returns all topics of a cluster. MSK reads them from the brokers with
+// the caller's IAM identity, so the cluster must allow IAM authentication.
+func (d *TopicDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	clusterArn := dao.GetFilterFromContext(ctx, "ClusterArn")
+	if clusterArn == "" {
+		return nil, fmt.Errorf("cluster ARN filter required")
+	}
+
+	topics, err := appaws.Paginate(ctx, func(token *string) ([]types.TopicInfo, *string, error) {
+		output, err := d.client.ListTopics(ctx, &kafka.ListTopicsInput{
+			ClusterArn: &clusterArn,
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list msk topics")
+		}
+		return output.Topics, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(topics))
+	for i, topic := range topics {
+		resources[i] = NewTopicResource(topic, clusterArn)
+	}
+	return resources, nil
+}
+
+// Get returns a topic by name, including its configuration.
+func (d *TopicDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	clusterArn := dao.GetFilterFromContext(ctx, "ClusterArn")
+	if clusterArn == "" {
+		return nil, fmt.Errorf("cluster ARN filter required")
+	}
+
+	output, err := d.client.DescribeTopic(ctx, &kafka.DescribeTopicInput{
+		ClusterArn: &clusterArn,
+		TopicName:  &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe msk topic %s", name)
+	}
+	return NewTopicResourceFromDetail(output, clusterArn), nil
+}
+
+// Delete is not supported; topics are managed with Kafka tooling.
+func (d *TopicDAO) Delete(ctx context.Context, name string) error {
+	return fmt.Errorf("delete not supported for msk topics")
+}
+
+func (d *TopicDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// TopicResource wraps a Kafka topic of an MSK cluster.
+type TopicResource struct {
+	dao.BaseResource
+	Summary    *types.TopicInfo
+	Detail     *kafka.DescribeTopicOutput
+	ClusterArn string
+}
+
+// NewTopicResource creates a new TopicResource from summary.
+func NewTopicResource(topic types.TopicInfo, clusterArn string) *TopicResource {
+	return &TopicResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(topic.TopicName),
+			Name: appaws.Str(topic.TopicName),
+			ARN:  appaws.Str(topic.TopicArn),
+			Data: topic,
+		},
+		Summary:    &topic,
+		ClusterArn: clusterArn,
+	}
+}
+
+// NewTopicResourceFromDetail creates a new TopicResource from detail.
+func NewTopicResourceFromDetail(output *kafka.DescribeTopicOutput, clusterArn string) *TopicResource {
+	return &TopicResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(output.TopicName),
+			Name: appaws.Str(output.TopicName),
+			ARN:  appaws.Str(output.TopicArn),
+			Data: output,
+		},
+		Detail:     output,
+		ClusterArn: clusterArn,
+	}
+}
+
+// PartitionCount returns the number of partitions.
+func (r *TopicResource) PartitionCount() int32 {
+	if r.Summary != nil && r.Summary.PartitionCount != nil {
+		return *r.Summary.PartitionCount
+	}
+	if r.Detail != nil && r.Detail.PartitionCount != nil {
+		return *r.Detail.PartitionCount
+	}
+	return 0
+}
+
+// ReplicationFactor returns the replication factor.
+func (r *TopicResource) ReplicationFactor() int32 {
+	if r.Summary != nil && r.Summary.ReplicationFactor != nil {
+		return *r.Summary.ReplicationFactor
+	}
+	if r.Detail != nil && r.Detail.ReplicationFactor != nil {
+		return *r.Detail.ReplicationFactor
+	}
+	return 0
+}
+
+// OutOfSyncReplicas returns the number of replicas not in sync; only known
+// from List.
+func (r *TopicResource) OutOfSyncReplicas() int32 {
+	if r.Summary != nil && r.Summary.OutOfSyncReplicaCount != nil {
+		return *r.Summary.OutOfSyncReplicaCount
+	}
+	return 0
+}
+
+// Status returns the topic status; only known after Get.
+func (r *TopicResource) Status() string {
+	if r.Detail != nil {
+		return string(r.Detail.Status)
+	}
+	return ""
+}
+
+// Configs returns the decoded topic configuration; only known after Get.
+func (r *TopicResource) Configs() string {
+	if r.Detail == nil || r.Detail.Configs == nil {
+		return ""
+	}
+	decoded, err := base64.StdEncoding.DecodeString(*r.Detail.Configs)
+	if err != nil {
+		return *r.Detail.Configs
+	}
+	return string(decoded)
+}
@@ -0,0 +1,105 @@
+package topics
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// TopicRenderer renders the topics of an MSK cluster.
+type TopicRenderer struct {
+	render.BaseRenderer
+}
+
+// NewTopicRenderer creates a new TopicRenderer.
+func NewTopicRenderer() render.Renderer {
+	return &TopicRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "msk",
+			Resource: "topics",
+			Cols: []render.Column{
+				{Name: "TOPIC", Width: 40, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "PARTITIONS", Width: 11, Getter: getPartitions},
+				{Name: "REPLICATION", Width: 12, Getter: getReplication},
+				{Name: "OUT OF SYNC", Width: 12, Getter: getOutOfSync},
+			},
+		},
+	}
+}
+
+func getPartitions(r dao.Resource) string {
+	topic, ok := r.(*TopicResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", topic.PartitionCount())
+}
+
+func getReplication(r dao.Resource) string {
+	topic, ok := r.(*TopicResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", topic.ReplicationFactor())
+}
+
+func getOutOfSync(r dao.Resource) string {
+	topic, ok := r.(*TopicResource)
+	if !ok || topic.OutOfSyncReplicas() == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", topic.OutOfSyncReplicas())
+}
+
+// RenderDetail renders the detail view for an MSK topic.
+func (r *TopicRenderer) RenderDetail(resource dao.Resource) string {
+	topic, ok := resource.(*TopicResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("MSK Topic", topic.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Topic", topic.GetName())
+	d.Field("ARN", topic.GetARN())
+	d.Field("Cluster", topic.ClusterArn)
+	if status := topic.Status(); status != "" {
+		d.Field("Status", status)
+	}
+
+	d.Section("Partitions")
+	d.Field("Partitions", fmt.Sprintf("%d", topic.PartitionCount()))
+	d.Field("Replication Factor", fmt.Sprintf("%d", topic.ReplicationFactor()))
+	if n := topic.OutOfSyncReplicas(); n > 0 {
+		d.FieldStyled("Out-of-Sync Replicas", fmt.Sprintf("%d", n), ui.WarningStyle())
+	}
+
+	if configs := strings.TrimSpace(topic.Configs()); configs != "" {
+		d.Section("Configuration")
+		for _, line := range strings.Split(configs, "\n") {
+			d.Line("  " + line)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an MSK topic.
+func (r *TopicRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	topic, ok := resource.(*TopicResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Topic", Value: topic.GetName()},
+		{Label: "Partitions", Value: fmt.Sprintf("%d", topic.PartitionCount())},
+		{Label: "Replication", Value: fmt.Sprintf("%d", topic.ReplicationFactor())},
+	}
+}
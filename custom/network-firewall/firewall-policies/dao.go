@@ -19,13 +19,13 @@ type FirewallPolicyDAO struct {
 
 // NewFirewallPolicyDAO creates a new FirewallPolicyDAO.
 func NewFirewallPolicyDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, networkfirewall.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &FirewallPolicyDAO{
 		BaseDAO: dao.NewBaseDAO("network-firewall", "firewall-policies"),
-		client:  networkfirewall.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -19,13 +19,13 @@ type FirewallDAO struct {
 
 // NewFirewallDAO creates a new FirewallDAO.
 func NewFirewallDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, networkfirewall.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &FirewallDAO{
 		BaseDAO: dao.NewBaseDAO("network-firewall", "firewalls"),
-		client:  networkfirewall.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -19,13 +19,13 @@ type RuleGroupDAO struct {
 
 // NewRuleGroupDAO creates a new RuleGroupDAO.
 func NewRuleGroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, networkfirewall.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RuleGroupDAO{
 		BaseDAO: dao.NewBaseDAO("network-firewall", "rule-groups"),
-		client:  networkfirewall.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
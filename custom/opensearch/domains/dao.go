@@ -20,13 +20,13 @@ type DomainDAO struct {
 
 // NewDomainDAO creates a new DomainDAO
 func NewDomainDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, opensearch.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &DomainDAO{
 		BaseDAO: dao.NewBaseDAO("opensearch", "domains"),
-		client:  opensearch.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,81 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	orgs "github.com/clawscli/claws/custom/organizations"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Organizations accounts
+	action.Global.Register("organizations", "accounts", []action.Action{
+		{
+			Name:      "Move",
+			Shortcut:  "M",
+			Type:      action.ActionTypeAPI,
+			Operation: "MoveAccount",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:   "Destination",
+				Choices: destinationChoices,
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("organizations", "accounts", executeAccountAction)
+}
+
+// destinationChoices offers the root and every OU, by path
+func destinationChoices(ctx context.Context, _ dao.Resource) ([]action.Choice, error) {
+	client, err := appaws.Client(ctx, organizations.NewFromConfig)
+	if err != nil {
+		return nil, err
+	}
+	root, err := orgs.LoadTree(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	var choices []action.Choice
+	for _, n := range root.Containers() {
+		choices = append(choices, action.Choice{Value: n.ID, Label: n.Path()})
+	}
+	return choices, nil
+}
+
+// executeAccountAction executes an action on an Organizations account.
+func executeAccountAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	account, ok := resource.(*AccountResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "MoveAccount":
+		destination := action.InputFromContext(ctx)
+		if destination == "" {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("destination OU required")}
+		}
+		client, err := appaws.Client(ctx, organizations.NewFromConfig)
+		if err != nil {
+			return action.ActionResult{Success: false, Error: err}
+		}
+		source, err := orgs.MoveAccount(ctx, client, account.GetID(), destination)
+		if err != nil {
+			return action.FailResult(err)
+		}
+		return action.ActionResult{
+			Success: true,
+			Message: fmt.Sprintf("Moved %s from %s to %s", account.Name(), source, destination),
+		}
+
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -20,13 +20,13 @@ type AccountDAO struct {
 
 // NewAccountDAO creates a new AccountDAO.
 func NewAccountDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, organizations.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &AccountDAO{
 		BaseDAO: dao.NewBaseDAO("organizations", "accounts"),
-		client:  organizations.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
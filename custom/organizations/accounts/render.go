@@ -79,6 +79,32 @@ func (r *AccountRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// Navigations returns available navigations from an account.
+func (r *AccountRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	account, ok := resource.(*AccountResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "p",
+			Label:       "Effective SCPs",
+			Service:     "organizations",
+			Resource:    "policies",
+			FilterField: "AccountId",
+			FilterValue: account.GetID(),
+		},
+		{
+			Key:         "e",
+			Label:       "SCP Denies",
+			Service:     "organizations",
+			Resource:    "scp-denies",
+			FilterField: "AccountId",
+			FilterValue: account.GetID(),
+		},
+	}
+}
+
 // RenderSummary renders summary fields for an account.
 func (r *AccountRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	account, ok := resource.(*AccountResource)
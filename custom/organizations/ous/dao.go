@@ -20,13 +20,13 @@ type OUDAO struct {
 
 // NewOUDAO creates a new OUDAO.
 func NewOUDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, organizations.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &OUDAO{
 		BaseDAO: dao.NewBaseDAO("organizations", "ous"),
-		client:  organizations.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
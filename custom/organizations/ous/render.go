@@ -83,5 +83,13 @@ func (r *OURenderer) Navigations(resource dao.Resource) []render.Navigation {
 			FilterField: "ParentId",
 			FilterValue: ou.GetID(),
 		},
+		{
+			Key:         "c",
+			Label:       "Controls",
+			Service:     "controltower",
+			Resource:    "controls",
+			FilterField: "TargetIdentifier",
+			FilterValue: ou.GetARN(),
+		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
 
+	apporgs "github.com/clawscli/claws/custom/organizations"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
@@ -19,18 +20,24 @@ type PolicyDAO struct {
 
 // NewPolicyDAO creates a new PolicyDAO.
 func NewPolicyDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, organizations.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &PolicyDAO{
 		BaseDAO: dao.NewBaseDAO("organizations", "policies"),
-		client:  organizations.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
-// List returns all policies (of all types).
+// List returns all policies (of all types). With an AccountId filter it
+// returns the SCPs in effect for that account instead, including those
+// inherited from the root and OUs above it.
 func (d *PolicyDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if accountID := dao.GetFilterFromContext(ctx, "AccountId"); accountID != "" {
+		return d.listEffective(ctx, accountID)
+	}
+
 	// List all policy types
 	policyTypes := []types.PolicyType{
 		types.PolicyTypeServiceControlPolicy,
@@ -65,6 +72,21 @@ func (d *PolicyDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	return resources, nil
 }
 
+func (d *PolicyDAO) listEffective(ctx context.Context, accountID string) ([]dao.Resource, error) {
+	scps, err := apporgs.EffectiveSCPs(ctx, d.client, accountID)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]dao.Resource, len(scps))
+	for i, scp := range scps {
+		r := NewPolicyResource(scp.Policy)
+		r.Content = scp.Content
+		r.AttachedTo = scp.AttachedTo
+		resources[i] = r
+	}
+	return resources, nil
+}
+
 // Get returns a specific policy.
 func (d *PolicyDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	output, err := d.client.DescribePolicy(ctx, &organizations.DescribePolicyInput{
@@ -100,6 +122,10 @@ type PolicyResource struct {
 	dao.BaseResource
 	Policy  *types.PolicySummary
 	Content string
+
+	// AttachedTo is set when listing the SCPs in effect for an account:
+	// the root, OUs or account the policy applies through.
+	AttachedTo []apporgs.SCPTarget
 }
 
 // NewPolicyResource creates a new PolicyResource.
@@ -138,6 +164,25 @@ func (r *PolicyResource) Type() string {
 	return ""
 }
 
+// MergeFrom keeps the attachments of an effective SCP, which Get does not
+// return.
+func (r *PolicyResource) MergeFrom(original dao.Resource) {
+	if orig, ok := original.(*PolicyResource); ok && len(r.AttachedTo) == 0 {
+		r.AttachedTo = orig.AttachedTo
+	}
+}
+
+// Inherited reports whether an effective SCP applies only through the root
+// or an OU rather than being attached to the account itself.
+func (r *PolicyResource) Inherited() bool {
+	for _, t := range r.AttachedTo {
+		if t.Type == types.TargetTypeAccount {
+			return false
+		}
+	}
+	return len(r.AttachedTo) > 0
+}
+
 // AwsManaged returns whether this is an AWS managed policy.
 func (r *PolicyResource) AwsManaged() bool {
 	if r.Policy != nil {
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	apporgs "github.com/clawscli/claws/custom/organizations"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -24,6 +27,7 @@ func NewPolicyRenderer() render.Renderer {
 				{Name: "NAME", Width: 35, Getter: getName},
 				{Name: "TYPE", Width: 25, Getter: getType},
 				{Name: "AWS MANAGED", Width: 12, Getter: getAwsManaged},
+				{Name: "ATTACHED TO", Width: 40, Getter: getAttachedTo},
 			},
 		},
 	}
@@ -56,6 +60,15 @@ func getAwsManaged(r dao.Resource) string {
 	return "No"
 }
 
+// getAttachedTo is only set for the SCPs in effect for an account
+func getAttachedTo(r dao.Resource) string {
+	policy, ok := r.(*PolicyResource)
+	if !ok {
+		return ""
+	}
+	return apporgs.JoinTargets(policy.AttachedTo)
+}
+
 // RenderDetail renders the detail view for a policy.
 func (r *PolicyRenderer) RenderDetail(resource dao.Resource) string {
 	policy, ok := resource.(*PolicyResource)
@@ -81,6 +94,20 @@ func (r *PolicyRenderer) RenderDetail(resource dao.Resource) string {
 		d.Field("Description", policy.Description())
 	}
 
+	if len(policy.AttachedTo) > 0 {
+		d.Section("Applies Through")
+		for _, t := range policy.AttachedTo {
+			d.Line("  " + t.String())
+		}
+		if policy.Inherited() {
+			d.Dim("  Inherited; not attached to the account directly")
+		}
+	}
+
+	if policy.Type() == string(types.PolicyTypeServiceControlPolicy) && policy.Content != "" {
+		renderDenies(d, policy.Content)
+	}
+
 	// Policy Content (at bottom for readability)
 	if policy.Content != "" {
 		d.Section("Policy Document")
@@ -90,6 +117,20 @@ func (r *PolicyRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// renderDenies lists the policy's deny statements grouped by service
+func renderDenies(d *render.DetailBuilder, content string) {
+	groups := apporgs.GroupDeniesByService([]apporgs.EffectiveSCP{{Content: content}})
+	if len(groups) == 0 {
+		return
+	}
+	for _, g := range groups {
+		d.Section("Denies: " + g.Service)
+		for _, st := range g.Statements {
+			apporgs.RenderDenyStatement(d, st)
+		}
+	}
+}
+
 // prettyJSON formats JSON string with indentation
 func prettyJSON(s string) string {
 	var buf bytes.Buffer
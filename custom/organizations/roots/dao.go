@@ -20,13 +20,13 @@ type RootDAO struct {
 
 // NewRootDAO creates a new RootDAO.
 func NewRootDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, organizations.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RootDAO{
 		BaseDAO: dao.NewBaseDAO("organizations", "roots"),
-		client:  organizations.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
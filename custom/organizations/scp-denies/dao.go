@@ -0,0 +1,115 @@
+package scpdenies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+
+	apporgs "github.com/clawscli/claws/custom/organizations"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DenyDAO lists the deny statements of the SCPs in effect for an account,
+// grouped by service.
+type DenyDAO struct {
+	dao.BaseDAO
+	client *organizations.Client
+}
+
+// NewDenyDAO creates a new DenyDAO.
+func NewDenyDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, organizations.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DenyDAO{
+		BaseDAO: dao.NewBaseDAO("organizations", "scp-denies"),
+		client:  client,
+	}, nil
+}
+
+// List returns one resource per service denied by the account's SCPs.
+func (d *DenyDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	accountID := dao.GetFilterFromContext(ctx, "AccountId")
+	if accountID == "" {
+		return nil, fmt.Errorf("account ID filter required")
+	}
+
+	scps, err := apporgs.EffectiveSCPs(ctx, d.client, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := apporgs.GroupDeniesByService(scps)
+	resources := make([]dao.Resource, len(groups))
+	for i, g := range groups {
+		resources[i] = NewDenyResource(g, accountID)
+	}
+	return resources, nil
+}
+
+// Get returns the denies of one service.
+func (d *DenyDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("SCP denies not found for service: %s", id)
+}
+
+// Delete is not supported.
+func (d *DenyDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for SCP denies")
+}
+
+// Supports returns true only for List operation.
+// Get() is implemented via List() scan, so we disable auto-refresh in DetailView.
+func (d *DenyDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// DenyResource is the set of SCP deny statements affecting one service.
+type DenyResource struct {
+	dao.BaseResource
+	Denies    apporgs.ServiceDenies
+	AccountID string
+}
+
+// NewDenyResource creates a new DenyResource.
+func NewDenyResource(denies apporgs.ServiceDenies, accountID string) *DenyResource {
+	return &DenyResource{
+		BaseResource: dao.BaseResource{
+			ID:   denies.Service,
+			Name: denies.Service,
+			Data: denies,
+		},
+		Denies:    denies,
+		AccountID: accountID,
+	}
+}
+
+// ActionsSummary lists the denied actions, or describes NotAction denies
+// when the service has no explicit actions.
+func (r *DenyResource) ActionsSummary() string {
+	if len(r.Denies.Actions) > 0 {
+		return joinLimited(r.Denies.Actions, 4)
+	}
+	return "all actions except exempted ones"
+}
+
+// joinLimited joins up to limit values and counts the rest
+func joinLimited(values []string, limit int) string {
+	if len(values) <= limit {
+		return strings.Join(values, ", ")
+	}
+	return fmt.Sprintf("%s (+%d more)", strings.Join(values[:limit], ", "), len(values)-limit)
+}
@@ -0,0 +1,20 @@
+package scpdenies
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("organizations", "scp-denies", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewDenyDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewDenyRenderer()
+		},
+	})
+}
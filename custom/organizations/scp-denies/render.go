@@ -0,0 +1,109 @@
+package scpdenies
+
+import (
+	"fmt"
+	"strings"
+
+	apporgs "github.com/clawscli/claws/custom/organizations"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// DenyRenderer renders SCP denies grouped by service.
+type DenyRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDenyRenderer creates a new DenyRenderer.
+func NewDenyRenderer() render.Renderer {
+	return &DenyRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "organizations",
+			Resource: "scp-denies",
+			Cols: []render.Column{
+				{Name: "SERVICE", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "DENIED ACTIONS", Width: 60, Getter: getActions},
+				{Name: "STATEMENTS", Width: 11, Getter: getStatements},
+				{Name: "CONDITIONAL", Width: 12, Getter: getConditional},
+				{Name: "POLICIES", Width: 35, Getter: getPolicies},
+			},
+		},
+	}
+}
+
+func getActions(r dao.Resource) string {
+	d, ok := r.(*DenyResource)
+	if !ok {
+		return ""
+	}
+	return d.ActionsSummary()
+}
+
+func getStatements(r dao.Resource) string {
+	d, ok := r.(*DenyResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", len(d.Denies.Statements))
+}
+
+func getConditional(r dao.Resource) string {
+	d, ok := r.(*DenyResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d", d.Denies.Conditional())
+}
+
+func getPolicies(r dao.Resource) string {
+	d, ok := r.(*DenyResource)
+	if !ok {
+		return ""
+	}
+	return strings.Join(d.Denies.Policies(), ", ")
+}
+
+// RenderDetail renders every deny statement affecting the service.
+func (r *DenyRenderer) RenderDetail(resource dao.Resource) string {
+	deny, ok := resource.(*DenyResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("SCP Denies", deny.GetID())
+
+	d.Section("Summary")
+	d.Field("Account", deny.AccountID)
+	d.Field("Service", deny.Denies.Service)
+	d.Field("Statements", fmt.Sprintf("%d (%d conditional)", len(deny.Denies.Statements), deny.Denies.Conditional()))
+	if len(deny.Denies.Actions) > 0 {
+		d.Field("Denied Actions", strings.Join(deny.Denies.Actions, ", "))
+	}
+	if deny.Denies.Service == "*" {
+		d.FieldStyled("Note", "These statements deny across every service", ui.WarningStyle())
+	}
+
+	for i, st := range deny.Denies.Statements {
+		d.Section(fmt.Sprintf("Statement %d", i+1))
+		apporgs.RenderDenyStatement(d, st)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a service's denies.
+func (r *DenyRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	deny, ok := resource.(*DenyResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Service", Value: deny.Denies.Service},
+		{Label: "Statements", Value: fmt.Sprintf("%d", len(deny.Denies.Statements))},
+		{Label: "Policies", Value: strings.Join(deny.Denies.Policies(), ", ")},
+	}
+}
@@ -0,0 +1,378 @@
+package organizations
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// SCPTarget is a root, OU or account an SCP is attached to.
+type SCPTarget struct {
+	ID   string
+	Name string
+	Type types.TargetType
+}
+
+// String describes the target, e.g. "OU Workloads (ou-ab12-cdef3456)".
+func (t SCPTarget) String() string {
+	switch t.Type {
+	case types.TargetTypeRoot:
+		return "Root (" + t.ID + ")"
+	case types.TargetTypeOrganizationalUnit:
+		if t.Name != "" {
+			return "OU " + t.Name + " (" + t.ID + ")"
+		}
+		return "OU " + t.ID
+	default:
+		return "Account " + t.ID
+	}
+}
+
+// JoinTargets describes targets as "Root (r-ab12), OU Prod (ou-ab12-1)".
+func JoinTargets(targets []SCPTarget) string {
+	parts := make([]string, len(targets))
+	for i, t := range targets {
+		parts[i] = t.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// EffectiveSCP is an SCP that applies to an account, directly or inherited
+// through the OUs above it.
+type EffectiveSCP struct {
+	Policy     types.PolicySummary
+	Content    string
+	AttachedTo []SCPTarget // in root-to-account order
+}
+
+// EffectiveSCPs returns every SCP that applies to accountID: those attached
+// to the root, to each OU on the path down to the account, and to the account
+// itself. Policies are ordered by where they are first attached, root first.
+func EffectiveSCPs(ctx context.Context, client *organizations.Client, accountID string) ([]EffectiveSCP, error) {
+	path, err := targetPath(ctx, client, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var scps []EffectiveSCP
+	index := make(map[string]int)
+	for _, target := range path {
+		policies, err := appaws.Paginate(ctx, func(token *string) ([]types.PolicySummary, *string, error) {
+			output, err := client.ListPoliciesForTarget(ctx, &organizations.ListPoliciesForTargetInput{
+				TargetId:  &target.ID,
+				Filter:    types.PolicyTypeServiceControlPolicy,
+				NextToken: token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrapf(err, "list SCPs for %s", target.ID)
+			}
+			return output.Policies, output.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range policies {
+			id := appaws.Str(p.Id)
+			if i, ok := index[id]; ok {
+				scps[i].AttachedTo = append(scps[i].AttachedTo, target)
+				continue
+			}
+			index[id] = len(scps)
+			scps = append(scps, EffectiveSCP{Policy: p, AttachedTo: []SCPTarget{target}})
+		}
+	}
+
+	// ListPoliciesForTarget omits the document, so describe each policy
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(8)
+	for i := range scps {
+		g.Go(func() error {
+			output, err := client.DescribePolicy(gctx, &organizations.DescribePolicyInput{PolicyId: scps[i].Policy.Id})
+			if err != nil {
+				return apperrors.Wrapf(err, "describe SCP %s", appaws.Str(scps[i].Policy.Id))
+			}
+			if output.Policy != nil {
+				scps[i].Content = appaws.Str(output.Policy.Content)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return scps, nil
+}
+
+// targetPath walks up from accountID and returns the root, each OU and the
+// account, in root-to-account order.
+func targetPath(ctx context.Context, client *organizations.Client, accountID string) ([]SCPTarget, error) {
+	path := []SCPTarget{{ID: accountID, Type: types.TargetTypeAccount}}
+	child := accountID
+	for {
+		output, err := client.ListParents(ctx, &organizations.ListParentsInput{ChildId: &child})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "list parents of %s", child)
+		}
+		if len(output.Parents) == 0 {
+			return nil, fmt.Errorf("no parent found for %s", child)
+		}
+		parent := output.Parents[0]
+		child = appaws.Str(parent.Id)
+		if parent.Type == types.ParentTypeRoot {
+			path = append(path, SCPTarget{ID: child, Type: types.TargetTypeRoot})
+			break
+		}
+		path = append(path, SCPTarget{ID: child, Type: types.TargetTypeOrganizationalUnit})
+	}
+	slices.Reverse(path)
+
+	// OU names are only for display; IDs are enough if a lookup fails
+	var wg sync.WaitGroup
+	for i := range path {
+		if path[i].Type != types.TargetTypeOrganizationalUnit {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			output, err := client.DescribeOrganizationalUnit(ctx, &organizations.DescribeOrganizationalUnitInput{
+				OrganizationalUnitId: &path[i].ID,
+			})
+			if err != nil {
+				log.Debug("failed to describe OU", "id", path[i].ID, "error", err)
+				return
+			}
+			if output.OrganizationalUnit != nil {
+				path[i].Name = appaws.Str(output.OrganizationalUnit.Name)
+			}
+		}()
+	}
+	wg.Wait()
+	return path, nil
+}
+
+// DenyStatement is one Deny statement of an SCP.
+type DenyStatement struct {
+	PolicyID     string
+	PolicyName   string
+	AttachedTo   []SCPTarget
+	Sid          string
+	Actions      []string
+	NotActions   []string
+	Resources    []string
+	NotResources []string
+	Condition    string // compact JSON; empty when unconditional
+}
+
+// ServiceDenies collects the deny statements that affect one service.
+type ServiceDenies struct {
+	Service    string   // IAM service prefix, or "*" for statements covering every service
+	Actions    []string // denied actions of this service, sorted
+	Statements []DenyStatement
+}
+
+// Conditional counts the statements that only deny under a condition.
+func (s ServiceDenies) Conditional() int {
+	n := 0
+	for _, st := range s.Statements {
+		if st.Condition != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Policies returns the names of the SCPs contributing statements, in order.
+func (s ServiceDenies) Policies() []string {
+	var names []string
+	for _, st := range s.Statements {
+		if !slices.Contains(names, st.PolicyName) {
+			names = append(names, st.PolicyName)
+		}
+	}
+	return names
+}
+
+// DenyStatements extracts the Deny statements of an SCP document.
+func DenyStatements(content string) ([]DenyStatement, error) {
+	var doc struct {
+		Statement statementList `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("parse SCP: %w", err)
+	}
+
+	var denies []DenyStatement
+	for _, s := range doc.Statement {
+		if !strings.EqualFold(s.Effect, "Deny") {
+			continue
+		}
+		d := DenyStatement{
+			Sid:          s.Sid,
+			Actions:      s.Action,
+			NotActions:   s.NotAction,
+			Resources:    s.Resource,
+			NotResources: s.NotResource,
+		}
+		if len(s.Condition) > 0 && string(s.Condition) != "null" {
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, s.Condition); err == nil {
+				d.Condition = buf.String()
+			}
+		}
+		denies = append(denies, d)
+	}
+	return denies, nil
+}
+
+// GroupDeniesByService groups the deny statements of scps by the service
+// their actions belong to. A statement naming actions of several services
+// appears under each; NotAction statements and "*" actions deny across
+// services and are grouped under "*", which sorts first.
+func GroupDeniesByService(scps []EffectiveSCP) []ServiceDenies {
+	groups := make(map[string]*ServiceDenies)
+	group := func(service string) *ServiceDenies {
+		g, ok := groups[service]
+		if !ok {
+			g = &ServiceDenies{Service: service}
+			groups[service] = g
+		}
+		return g
+	}
+
+	for _, scp := range scps {
+		denies, err := DenyStatements(scp.Content)
+		if err != nil {
+			log.Debug("skipping unparseable SCP", "id", appaws.Str(scp.Policy.Id), "error", err)
+			continue
+		}
+		for _, d := range denies {
+			d.PolicyID = appaws.Str(scp.Policy.Id)
+			d.PolicyName = appaws.Str(scp.Policy.Name)
+			d.AttachedTo = scp.AttachedTo
+
+			if len(d.NotActions) > 0 {
+				group("*").Statements = append(group("*").Statements, d)
+				continue
+			}
+			var services []string
+			for _, action := range d.Actions {
+				service := actionService(action)
+				g := group(service)
+				if !slices.Contains(g.Actions, action) {
+					g.Actions = append(g.Actions, action)
+				}
+				if !slices.Contains(services, service) {
+					services = append(services, service)
+					g.Statements = append(g.Statements, d)
+				}
+			}
+		}
+	}
+
+	result := make([]ServiceDenies, 0, len(groups))
+	for _, g := range groups {
+		slices.Sort(g.Actions)
+		result = append(result, *g)
+	}
+	// "*" sorts ahead of every service prefix
+	slices.SortFunc(result, func(a, b ServiceDenies) int {
+		return strings.Compare(a.Service, b.Service)
+	})
+	return result
+}
+
+// RenderDenyStatement writes the fields of one deny statement. The policy is
+// only shown when st carries it, i.e. when statements of several SCPs are
+// listed together.
+func RenderDenyStatement(d *render.DetailBuilder, st DenyStatement) {
+	if st.PolicyName != "" {
+		d.Field("Policy", fmt.Sprintf("%s (%s)", st.PolicyName, st.PolicyID))
+	}
+	if len(st.AttachedTo) > 0 {
+		d.Field("Attached To", JoinTargets(st.AttachedTo))
+	}
+	if st.Sid != "" {
+		d.Field("Sid", st.Sid)
+	}
+	if len(st.Actions) > 0 {
+		d.Field("Action", strings.Join(st.Actions, ", "))
+	}
+	if len(st.NotActions) > 0 {
+		d.Field("NotAction", "all except "+strings.Join(st.NotActions, ", "))
+	}
+	if len(st.Resources) > 0 {
+		d.Field("Resource", strings.Join(st.Resources, ", "))
+	}
+	if len(st.NotResources) > 0 {
+		d.Field("NotResource", strings.Join(st.NotResources, ", "))
+	}
+	if st.Condition != "" {
+		d.Field("Condition", st.Condition)
+	} else {
+		d.Field("Condition", "none (always denied)")
+	}
+}
+
+// actionService returns the service prefix of an IAM action, e.g. "ec2" for
+// "ec2:RunInstances", and "*" for the bare wildcard.
+func actionService(action string) string {
+	service, _, ok := strings.Cut(action, ":")
+	if !ok {
+		return "*"
+	}
+	return strings.ToLower(service)
+}
+
+type policyStatement struct {
+	Sid         string          `json:"Sid"`
+	Effect      string          `json:"Effect"`
+	Action      stringList      `json:"Action"`
+	NotAction   stringList      `json:"NotAction"`
+	Resource    stringList      `json:"Resource"`
+	NotResource stringList      `json:"NotResource"`
+	Condition   json.RawMessage `json:"Condition"`
+}
+
+// statementList accepts a single statement object or an array of them
+type statementList []policyStatement
+
+func (l *statementList) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '{' {
+		var s policyStatement
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*l = statementList{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]policyStatement)(l))
+}
+
+// stringList accepts a single string or an array of strings
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*l = stringList{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(l))
+}
@@ -0,0 +1,114 @@
+package organizations
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+const regionGuardrail = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "DenyOutsideRegions",
+    "Effect": "Deny",
+    "NotAction": ["iam:*", "sts:*"],
+    "Resource": "*",
+    "Condition": {"StringNotEquals": {"aws:RequestedRegion": ["eu-west-1"]}}
+  }
+}`
+
+const protectLogging = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "*", "Resource": "*"},
+    {
+      "Sid": "ProtectLogging",
+      "Effect": "Deny",
+      "Action": ["cloudtrail:StopLogging", "cloudtrail:DeleteTrail", "ec2:DeleteFlowLogs"],
+      "Resource": "*"
+    },
+    {"Effect": "Deny", "Action": "ec2:DeleteFlowLogs", "Resource": "*"}
+  ]
+}`
+
+func TestDenyStatements(t *testing.T) {
+	denies, err := DenyStatements(regionGuardrail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(denies) != 1 {
+		t.Fatalf("got %d deny statements, want 1", len(denies))
+	}
+	d := denies[0]
+	if d.Sid != "DenyOutsideRegions" || !reflect.DeepEqual(d.NotActions, []string{"iam:*", "sts:*"}) ||
+		!reflect.DeepEqual(d.Resources, []string{"*"}) {
+		t.Errorf("statement = %+v", d)
+	}
+	if d.Condition != `{"StringNotEquals":{"aws:RequestedRegion":["eu-west-1"]}}` {
+		t.Errorf("condition = %s", d.Condition)
+	}
+
+	if denies, _ := DenyStatements(protectLogging); len(denies) != 2 {
+		t.Errorf("Allow statements should be skipped: got %d", len(denies))
+	}
+	if _, err := DenyStatements("not json"); err == nil {
+		t.Error("expected a parse error")
+	}
+}
+
+func TestGroupDeniesByService(t *testing.T) {
+	root := SCPTarget{ID: "r-ab12", Type: types.TargetTypeRoot}
+	ou := SCPTarget{ID: "ou-ab12-1", Name: "Prod", Type: types.TargetTypeOrganizationalUnit}
+	scps := []EffectiveSCP{
+		{Policy: types.PolicySummary{Id: aws.String("p-1"), Name: aws.String("RegionGuardrail")}, Content: regionGuardrail, AttachedTo: []SCPTarget{root}},
+		{Policy: types.PolicySummary{Id: aws.String("p-2"), Name: aws.String("ProtectLogging")}, Content: protectLogging, AttachedTo: []SCPTarget{ou}},
+		{Policy: types.PolicySummary{Id: aws.String("p-3"), Name: aws.String("Broken")}, Content: "{"},
+	}
+
+	groups := GroupDeniesByService(scps)
+	var services []string
+	for _, g := range groups {
+		services = append(services, g.Service)
+	}
+	if !reflect.DeepEqual(services, []string{"*", "cloudtrail", "ec2"}) {
+		t.Fatalf("services = %v", services)
+	}
+
+	all := groups[0]
+	if len(all.Statements) != 1 || all.Conditional() != 1 || all.Statements[0].AttachedTo[0] != root {
+		t.Errorf("* group = %+v", all)
+	}
+
+	trail := groups[1]
+	if !reflect.DeepEqual(trail.Actions, []string{"cloudtrail:DeleteTrail", "cloudtrail:StopLogging"}) || len(trail.Statements) != 1 {
+		t.Errorf("cloudtrail group = %+v", trail)
+	}
+
+	// Two statements deny ec2:DeleteFlowLogs; the action is listed once
+	ec2 := groups[2]
+	if !reflect.DeepEqual(ec2.Actions, []string{"ec2:DeleteFlowLogs"}) || len(ec2.Statements) != 2 {
+		t.Errorf("ec2 group = %+v", ec2)
+	}
+	if !reflect.DeepEqual(ec2.Policies(), []string{"ProtectLogging"}) {
+		t.Errorf("ec2 policies = %v", ec2.Policies())
+	}
+}
+
+func TestSCPTarget_String(t *testing.T) {
+	tests := []struct {
+		target SCPTarget
+		want   string
+	}{
+		{SCPTarget{ID: "r-ab12", Type: types.TargetTypeRoot}, "Root (r-ab12)"},
+		{SCPTarget{ID: "ou-1", Name: "Prod", Type: types.TargetTypeOrganizationalUnit}, "OU Prod (ou-1)"},
+		{SCPTarget{ID: "ou-1", Type: types.TargetTypeOrganizationalUnit}, "OU ou-1"},
+		{SCPTarget{ID: "123456789012", Type: types.TargetTypeAccount}, "Account 123456789012"},
+	}
+	for _, tt := range tests {
+		if got := tt.target.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
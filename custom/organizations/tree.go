@@ -0,0 +1,200 @@
+package organizations
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// TreeNode is the root, an OU or an account in the organization's hierarchy.
+type TreeNode struct {
+	ID      string
+	Name    string
+	Type    types.TargetType
+	Account *types.Account // set for accounts
+
+	// SCPs are the policies attached directly to the node, set by LoadSCPs.
+	SCPs []types.PolicySummary
+
+	Parent   *TreeNode
+	Children []*TreeNode // OUs first, then accounts, each by name
+}
+
+// IsAccount reports whether the node is an account.
+func (n *TreeNode) IsAccount() bool {
+	return n.Type == types.TargetTypeAccount
+}
+
+// Path names the node and its ancestors, e.g. "Root / Workloads / Prod".
+func (n *TreeNode) Path() string {
+	var names []string
+	for p := n; p != nil; p = p.Parent {
+		names = append(names, p.Name)
+	}
+	slices.Reverse(names)
+	return strings.Join(names, " / ")
+}
+
+// Walk calls fn for n and every node below it, parents first.
+func (n *TreeNode) Walk(fn func(*TreeNode)) {
+	fn(n)
+	for _, c := range n.Children {
+		c.Walk(fn)
+	}
+}
+
+// Find returns the node with the given ID, or nil.
+func (n *TreeNode) Find(id string) *TreeNode {
+	var found *TreeNode
+	n.Walk(func(c *TreeNode) {
+		if found == nil && c.ID == id {
+			found = c
+		}
+	})
+	return found
+}
+
+// Containers returns the root and every OU, parents first.
+func (n *TreeNode) Containers() []*TreeNode {
+	var nodes []*TreeNode
+	n.Walk(func(c *TreeNode) {
+		if !c.IsAccount() {
+			nodes = append(nodes, c)
+		}
+	})
+	return nodes
+}
+
+// LoadTree reads the organization's root with every OU and account below it.
+func LoadTree(ctx context.Context, client *organizations.Client) (*TreeNode, error) {
+	output, err := client.ListRoots(ctx, &organizations.ListRootsInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "list organization roots")
+	}
+	if len(output.Roots) == 0 {
+		return nil, fmt.Errorf("organization has no root")
+	}
+	r := output.Roots[0]
+	root := &TreeNode{ID: appaws.Str(r.Id), Name: cmp.Or(appaws.Str(r.Name), "Root"), Type: types.TargetTypeRoot}
+	if err := loadChildren(ctx, client, root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// loadChildren adds the OUs and accounts under parent, recursively.
+func loadChildren(ctx context.Context, client *organizations.Client, parent *TreeNode) error {
+	ous, err := appaws.Paginate(ctx, func(token *string) ([]types.OrganizationalUnit, *string, error) {
+		output, err := client.ListOrganizationalUnitsForParent(ctx, &organizations.ListOrganizationalUnitsForParentInput{
+			ParentId:  &parent.ID,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list OUs of %s", parent.ID)
+		}
+		return output.OrganizationalUnits, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	accounts, err := appaws.Paginate(ctx, func(token *string) ([]types.Account, *string, error) {
+		output, err := client.ListAccountsForParent(ctx, &organizations.ListAccountsForParentInput{
+			ParentId:  &parent.ID,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list accounts of %s", parent.ID)
+		}
+		return output.Accounts, output.NextToken, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(ous, func(a, b types.OrganizationalUnit) int { return cmp.Compare(appaws.Str(a.Name), appaws.Str(b.Name)) })
+	slices.SortFunc(accounts, func(a, b types.Account) int { return cmp.Compare(appaws.Str(a.Name), appaws.Str(b.Name)) })
+	for _, ou := range ous {
+		child := &TreeNode{ID: appaws.Str(ou.Id), Name: appaws.Str(ou.Name), Type: types.TargetTypeOrganizationalUnit, Parent: parent}
+		if err := loadChildren(ctx, client, child); err != nil {
+			return err
+		}
+		parent.Children = append(parent.Children, child)
+	}
+	for _, acct := range accounts {
+		parent.Children = append(parent.Children, &TreeNode{
+			ID:      appaws.Str(acct.Id),
+			Name:    appaws.Str(acct.Name),
+			Type:    types.TargetTypeAccount,
+			Account: &acct,
+			Parent:  parent,
+		})
+	}
+	return nil
+}
+
+// LoadSCPs sets the SCPs attached to every node of the tree. Nodes whose
+// policies cannot be listed keep none.
+func LoadSCPs(ctx context.Context, client *organizations.Client, root *TreeNode) {
+	var nodes []*TreeNode
+	root.Walk(func(n *TreeNode) { nodes = append(nodes, n) })
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(8)
+	for _, n := range nodes {
+		g.Go(func() error {
+			policies, err := appaws.Paginate(gctx, func(token *string) ([]types.PolicySummary, *string, error) {
+				output, err := client.ListPoliciesForTarget(gctx, &organizations.ListPoliciesForTargetInput{
+					TargetId:  &n.ID,
+					Filter:    types.PolicyTypeServiceControlPolicy,
+					NextToken: token,
+				})
+				if err != nil {
+					return nil, nil, apperrors.Wrapf(err, "list SCPs for %s", n.ID)
+				}
+				return output.Policies, output.NextToken, nil
+			})
+			if err != nil {
+				log.Debug("failed to list SCPs", "target", n.ID, "error", err)
+				return nil
+			}
+			n.SCPs = policies
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// MoveAccount moves an account from its current parent to destinationID and
+// returns the parent it was moved from.
+func MoveAccount(ctx context.Context, client *organizations.Client, accountID, destinationID string) (string, error) {
+	output, err := client.ListParents(ctx, &organizations.ListParentsInput{ChildId: &accountID})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "list parents of %s", accountID)
+	}
+	if len(output.Parents) == 0 {
+		return "", fmt.Errorf("no parent found for %s", accountID)
+	}
+	sourceID := appaws.Str(output.Parents[0].Id)
+	if sourceID == destinationID {
+		return "", fmt.Errorf("account %s is already in %s", accountID, destinationID)
+	}
+	_, err = client.MoveAccount(ctx, &organizations.MoveAccountInput{
+		AccountId:           &accountID,
+		SourceParentId:      &sourceID,
+		DestinationParentId: &destinationID,
+	})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "move account %s", accountID)
+	}
+	return sourceID, nil
+}
@@ -0,0 +1,28 @@
+package organizations
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+func TestTreeNode(t *testing.T) {
+	root := &TreeNode{ID: "r-1", Name: "Root", Type: types.TargetTypeRoot}
+	workloads := &TreeNode{ID: "ou-1", Name: "Workloads", Type: types.TargetTypeOrganizationalUnit, Parent: root}
+	prod := &TreeNode{ID: "ou-2", Name: "Prod", Type: types.TargetTypeOrganizationalUnit, Parent: workloads}
+	acct := &TreeNode{ID: "111111111111", Name: "shop", Type: types.TargetTypeAccount, Parent: prod}
+	root.Children = []*TreeNode{workloads}
+	workloads.Children = []*TreeNode{prod}
+	prod.Children = []*TreeNode{acct}
+
+	if got := acct.Path(); got != "Root / Workloads / Prod / shop" {
+		t.Errorf("Path() = %q", got)
+	}
+	if root.Find("111111111111") != acct || root.Find("ou-9") != nil {
+		t.Error("Find() did not return the account, or found a missing ID")
+	}
+	containers := root.Containers()
+	if len(containers) != 3 || containers[0] != root || containers[2] != prod {
+		t.Errorf("Containers() = %v, want root, Workloads, Prod", containers)
+	}
+}
@@ -10,9 +10,5 @@ import (
 
 // GetClient returns an RDS client configured for the current context
 func GetClient(ctx context.Context) (*rds.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return rds.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, rds.NewFromConfig)
 }
@@ -20,13 +20,13 @@ type InstanceDAO struct {
 
 // NewInstanceDAO creates a new InstanceDAO
 func NewInstanceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, rds.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &InstanceDAO{
 		BaseDAO: dao.NewBaseDAO("rds", "instances"),
-		client:  rds.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,14 @@
+package redshift
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetDataClient returns a Redshift Data API client configured for the current context
+func GetDataClient(ctx context.Context) (*redshiftdata.Client, error) {
+	return appaws.Client(ctx, redshiftdata.NewFromConfig)
+}
@@ -21,13 +21,13 @@ type ClusterDAO struct {
 
 // NewClusterDAO creates a new ClusterDAO.
 func NewClusterDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, redshift.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ClusterDAO{
 		BaseDAO: dao.NewBaseDAO("redshift", "clusters"),
-		client:  redshift.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -2,6 +2,7 @@ package clusters
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -192,5 +193,15 @@ func (r *ClusterRenderer) Navigations(resource dao.Resource) []render.Navigation
 			FilterField: "ClusterIdentifier",
 			FilterValue: cluster.GetID(),
 		},
+		{
+			Key:            "q",
+			Label:          "Queries",
+			Service:        "redshift",
+			Resource:       "queries",
+			FilterField:    "ClusterIdentifier",
+			FilterValue:    cluster.GetID(),
+			AutoReload:     true,
+			ReloadInterval: 10 * time.Second,
+		},
 	}
 }
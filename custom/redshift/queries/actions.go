@@ -0,0 +1,63 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	redshiftClient "github.com/clawscli/claws/custom/redshift"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Redshift queries
+	action.Global.Register("redshift", "queries", []action.Action{
+		{
+			Name:      "Cancel Query",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelQuery",
+			Confirm:   action.ConfirmSimple,
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("redshift", "queries", executeQueryAction)
+}
+
+// executeQueryAction executes an action on a Redshift query
+func executeQueryAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CancelQuery":
+		return executeCancelQuery(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// executeCancelQuery cancels the query by issuing CANCEL against its session.
+func executeCancelQuery(ctx context.Context, resource dao.Resource) action.ActionResult {
+	query, ok := resource.(*QueryResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	if !query.IsActive() {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("query is %s, can only cancel queries that have not finished", query.Status())}
+	}
+
+	client, err := redshiftClient.GetDataClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	sql := fmt.Sprintf("CANCEL %d", query.Item.SessionID)
+	if _, err := RunStatement(ctx, client, query.Target, sql, nil); err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("cancel query: %w", err)}
+	}
+
+	return action.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Cancelled query %s (session %d)", query.GetID(), query.Item.SessionID),
+	}
+}
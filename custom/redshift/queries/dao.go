@@ -0,0 +1,333 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// statementPollInterval is how often a submitted Data API statement is polled.
+	statementPollInterval = 500 * time.Millisecond
+	// statementTimeout bounds how long a monitoring query may take.
+	statementTimeout = 30 * time.Second
+)
+
+// queryColumns is the SYS_QUERY_HISTORY projection shared by List and Get.
+// Column order must match parseQueryRecord.
+const queryColumns = `query_id, session_id, user_id, database_name, query_type, status,
+	start_time, end_time, elapsed_time, queue_time, execution_time, returned_rows,
+	TRIM(error_message), TRIM(query_text)`
+
+// listQuerySQL returns active queries plus those started in the last hour.
+const listQuerySQL = `SELECT ` + queryColumns + `
+FROM sys_query_history
+WHERE status IN ('planning', 'queued', 'running', 'returning')
+   OR start_time > DATEADD(hour, -1, GETDATE())
+ORDER BY start_time DESC
+LIMIT 200`
+
+const getQuerySQL = `SELECT ` + queryColumns + `
+FROM sys_query_history
+WHERE query_id = :query_id`
+
+// QueryDAO provides data access for Redshift queries via the Data API
+type QueryDAO struct {
+	dao.BaseDAO
+	client     *redshift.Client
+	dataClient *redshiftdata.Client
+}
+
+// NewQueryDAO creates a new QueryDAO
+func NewQueryDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, redshift.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	dataClient, err := appaws.Client(ctx, redshiftdata.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &QueryDAO{
+		BaseDAO:    dao.NewBaseDAO("redshift", "queries"),
+		client:     client,
+		dataClient: dataClient,
+	}, nil
+}
+
+// List returns active and recent queries for a cluster (requires ClusterIdentifier filter)
+func (d *QueryDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	target, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := RunStatement(ctx, d.dataClient, target, listQuerySQL, nil)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "list redshift queries")
+	}
+
+	resources := make([]dao.Resource, 0, len(records))
+	for _, rec := range records {
+		resources = append(resources, NewQueryResource(parseQueryRecord(rec), target))
+	}
+	return resources, nil
+}
+
+// Get returns a specific query by query ID
+func (d *QueryDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	target, err := d.target(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := []types.SqlParameter{{Name: appaws.StringPtr("query_id"), Value: &id}}
+	records, err := RunStatement(ctx, d.dataClient, target, getQuerySQL, params)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get redshift query %s", id)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("query not found: %s", id)
+	}
+	return NewQueryResource(parseQueryRecord(records[0]), target), nil
+}
+
+// Delete is not supported; use the cancel action instead.
+func (d *QueryDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for queries - use cancel action")
+}
+
+func (d *QueryDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// target resolves the cluster's database and master user for Data API calls.
+func (d *QueryDAO) target(ctx context.Context) (Target, error) {
+	clusterID := dao.GetFilterFromContext(ctx, "ClusterIdentifier")
+	if clusterID == "" {
+		return Target{}, fmt.Errorf("ClusterIdentifier filter required - navigate from a cluster")
+	}
+
+	output, err := d.client.DescribeClusters(ctx, &redshift.DescribeClustersInput{
+		ClusterIdentifier: &clusterID,
+	})
+	if err != nil {
+		return Target{}, apperrors.Wrapf(err, "describe redshift cluster %s", clusterID)
+	}
+	if len(output.Clusters) == 0 {
+		return Target{}, fmt.Errorf("cluster not found: %s", clusterID)
+	}
+
+	cluster := output.Clusters[0]
+	return Target{
+		ClusterIdentifier: clusterID,
+		Database:          appaws.Str(cluster.DBName),
+		DbUser:            appaws.Str(cluster.MasterUsername),
+	}, nil
+}
+
+// Target identifies where Data API statements run. Credentials are obtained
+// as temporary database credentials for DbUser.
+type Target struct {
+	ClusterIdentifier string
+	Database          string
+	DbUser            string
+}
+
+// RunStatement executes sql via the Data API, waits for it to finish, and
+// returns its result records (nil for statements without a result set).
+func RunStatement(ctx context.Context, client *redshiftdata.Client, target Target, sql string, params []types.SqlParameter) ([][]types.Field, error) {
+	ctx, cancel := context.WithTimeout(ctx, statementTimeout)
+	defer cancel()
+
+	exec, err := client.ExecuteStatement(ctx, &redshiftdata.ExecuteStatementInput{
+		ClusterIdentifier: &target.ClusterIdentifier,
+		Database:          &target.Database,
+		DbUser:            &target.DbUser,
+		Sql:               &sql,
+		Parameters:        params,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("execute statement: %w", err)
+	}
+
+	ticker := time.NewTicker(statementPollInterval)
+	defer ticker.Stop()
+
+	for {
+		desc, err := client.DescribeStatement(ctx, &redshiftdata.DescribeStatementInput{Id: exec.Id})
+		if err != nil {
+			return nil, fmt.Errorf("describe statement: %w", err)
+		}
+
+		switch desc.Status {
+		case types.StatusStringFinished:
+			if !appaws.Bool(desc.HasResultSet) {
+				return nil, nil
+			}
+			return statementRecords(ctx, client, exec.Id)
+		case types.StatusStringFailed, types.StatusStringAborted:
+			return nil, fmt.Errorf("statement %s: %s", strings.ToLower(string(desc.Status)), appaws.Str(desc.Error))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func statementRecords(ctx context.Context, client *redshiftdata.Client, id *string) ([][]types.Field, error) {
+	var records [][]types.Field
+	input := &redshiftdata.GetStatementResultInput{Id: id}
+	for {
+		output, err := client.GetStatementResult(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("get statement result: %w", err)
+		}
+		records = append(records, output.Records...)
+		if output.NextToken == nil {
+			return records, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
+
+// Query is a row of SYS_QUERY_HISTORY. Durations are reported by Redshift in microseconds.
+type Query struct {
+	QueryID       int64
+	SessionID     int64
+	UserID        int64
+	Database      string
+	QueryType     string
+	Status        string
+	StartTime     time.Time
+	EndTime       time.Time
+	ElapsedTime   time.Duration
+	QueueTime     time.Duration
+	ExecutionTime time.Duration
+	ReturnedRows  int64
+	ErrorMessage  string
+	QueryText     string
+}
+
+// sysTimeLayout is the text format of SYS_QUERY_HISTORY timestamps (UTC).
+const sysTimeLayout = "2006-01-02 15:04:05.999999"
+
+func parseQueryRecord(rec []types.Field) Query {
+	str := func(i int) string {
+		if i >= len(rec) {
+			return ""
+		}
+		switch v := rec[i].(type) {
+		case *types.FieldMemberStringValue:
+			return v.Value
+		case *types.FieldMemberLongValue:
+			return strconv.FormatInt(v.Value, 10)
+		}
+		return ""
+	}
+	long := func(i int) int64 {
+		if i < len(rec) {
+			if v, ok := rec[i].(*types.FieldMemberLongValue); ok {
+				return v.Value
+			}
+		}
+		n, _ := strconv.ParseInt(str(i), 10, 64)
+		return n
+	}
+	ts := func(i int) time.Time {
+		t, _ := time.Parse(sysTimeLayout, str(i))
+		return t
+	}
+	micros := func(i int) time.Duration {
+		return time.Duration(long(i)) * time.Microsecond
+	}
+
+	return Query{
+		QueryID:       long(0),
+		SessionID:     long(1),
+		UserID:        long(2),
+		Database:      str(3),
+		QueryType:     str(4),
+		Status:        str(5),
+		StartTime:     ts(6),
+		EndTime:       ts(7),
+		ElapsedTime:   micros(8),
+		QueueTime:     micros(9),
+		ExecutionTime: micros(10),
+		ReturnedRows:  long(11),
+		ErrorMessage:  str(12),
+		QueryText:     str(13),
+	}
+}
+
+// QueryResource represents a Redshift query
+type QueryResource struct {
+	dao.BaseResource
+	Item   Query
+	Target Target
+}
+
+// NewQueryResource creates a new QueryResource
+func NewQueryResource(q Query, target Target) *QueryResource {
+	id := strconv.FormatInt(q.QueryID, 10)
+	return &QueryResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: q,
+		},
+		Item:   q,
+		Target: target,
+	}
+}
+
+// Status returns the query status (planning, queued, running, returning,
+// success, failed, canceled)
+func (r *QueryResource) Status() string {
+	return r.Item.Status
+}
+
+// IsActive reports whether the query has not yet finished: it is planning,
+// queued, running, or returning results
+func (r *QueryResource) IsActive() bool {
+	switch r.Item.Status {
+	case "planning", "queued", "running", "returning":
+		return true
+	}
+	return false
+}
+
+// Duration returns the query's elapsed time. Redshift reports elapsed time for
+// running queries lazily, so it falls back to time since start.
+func (r *QueryResource) Duration() time.Duration {
+	if r.Item.ElapsedTime > 0 {
+		return r.Item.ElapsedTime
+	}
+	if r.IsActive() && !r.Item.StartTime.IsZero() {
+		return time.Since(r.Item.StartTime)
+	}
+	return 0
+}
+
+// ShortText returns the query text collapsed to a single line
+func (r *QueryResource) ShortText() string {
+	return strings.Join(strings.Fields(r.Item.QueryText), " ")
+}
@@ -0,0 +1,100 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata/types"
+)
+
+func TestParseQueryRecord(t *testing.T) {
+	rec := []types.Field{
+		&types.FieldMemberLongValue{Value: 1234},
+		&types.FieldMemberLongValue{Value: 1073815778},
+		&types.FieldMemberLongValue{Value: 100},
+		&types.FieldMemberStringValue{Value: "dev"},
+		&types.FieldMemberStringValue{Value: "SELECT"},
+		&types.FieldMemberStringValue{Value: "running"},
+		&types.FieldMemberStringValue{Value: "2026-01-02 03:04:05.123456"},
+		&types.FieldMemberIsNull{Value: true},
+		&types.FieldMemberLongValue{Value: 2500000},
+		&types.FieldMemberLongValue{Value: 1500},
+		&types.FieldMemberLongValue{Value: 2498500},
+		&types.FieldMemberLongValue{Value: 0},
+		&types.FieldMemberStringValue{Value: ""},
+		&types.FieldMemberStringValue{Value: "select *\n  from sales"},
+	}
+
+	q := parseQueryRecord(rec)
+	if q.QueryID != 1234 || q.SessionID != 1073815778 {
+		t.Errorf("ids = %d/%d, want 1234/1073815778", q.QueryID, q.SessionID)
+	}
+	if q.Status != "running" || q.Database != "dev" {
+		t.Errorf("status/db = %q/%q, want running/dev", q.Status, q.Database)
+	}
+	want := time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	if !q.StartTime.Equal(want) {
+		t.Errorf("StartTime = %v, want %v", q.StartTime, want)
+	}
+	if !q.EndTime.IsZero() {
+		t.Errorf("EndTime = %v, want zero", q.EndTime)
+	}
+	if q.ElapsedTime != 2500*time.Millisecond || q.QueueTime != 1500*time.Microsecond {
+		t.Errorf("elapsed/queue = %v/%v, want 2.5s/1.5ms", q.ElapsedTime, q.QueueTime)
+	}
+
+	r := NewQueryResource(q, Target{ClusterIdentifier: "c1"})
+	if r.GetID() != "1234" {
+		t.Errorf("GetID() = %q, want 1234", r.GetID())
+	}
+	if !r.IsActive() {
+		t.Error("IsActive() = false for running query")
+	}
+	if got := r.ShortText(); got != "select * from sales" {
+		t.Errorf("ShortText() = %q", got)
+	}
+}
+
+func TestQueryResource_IsActive(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{"planning", true},
+		{"queued", true},
+		{"running", true},
+		{"returning", true},
+		{"success", false},
+		{"failed", false},
+		{"canceled", false},
+	}
+	for _, tt := range tests {
+		r := NewQueryResource(Query{Status: tt.status}, Target{})
+		if got := r.IsActive(); got != tt.want {
+			t.Errorf("IsActive() for %q = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestParseQueryRecord_Short(t *testing.T) {
+	q := parseQueryRecord([]types.Field{&types.FieldMemberLongValue{Value: 7}})
+	if q.QueryID != 7 || q.Status != "" {
+		t.Errorf("parseQueryRecord() = %+v", q)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "-"},
+		{250 * time.Millisecond, "250ms"},
+		{90*time.Second + 400*time.Millisecond, "1m30s"},
+	}
+	for _, tt := range tests {
+		if got := formatDuration(tt.d); got != tt.want {
+			t.Errorf("formatDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
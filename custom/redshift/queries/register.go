@@ -0,0 +1,20 @@
+package queries
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("redshift", "queries", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewQueryDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewQueryRenderer()
+		},
+	})
+}
@@ -0,0 +1,168 @@
+package queries
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// QueryRenderer renders Redshift queries.
+type QueryRenderer struct {
+	render.BaseRenderer
+}
+
+// NewQueryRenderer creates a new QueryRenderer.
+func NewQueryRenderer() render.Renderer {
+	return &QueryRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "redshift",
+			Resource: "queries",
+			Cols: []render.Column{
+				{Name: "QUERY ID", Width: 12, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 10, Getter: getStatus},
+				{Name: "TYPE", Width: 10, Getter: getType},
+				{Name: "DURATION", Width: 10, Getter: getDuration},
+				{Name: "QUEUED", Width: 10, Getter: getQueueTime},
+				{Name: "STARTED", Width: 20, Getter: getStarted},
+				{Name: "QUERY", Width: 60, Getter: getText},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	query, ok := r.(*QueryResource)
+	if !ok {
+		return ""
+	}
+	return query.Status()
+}
+
+func getType(r dao.Resource) string {
+	query, ok := r.(*QueryResource)
+	if !ok {
+		return ""
+	}
+	return query.Item.QueryType
+}
+
+func getDuration(r dao.Resource) string {
+	query, ok := r.(*QueryResource)
+	if !ok {
+		return ""
+	}
+	return formatDuration(query.Duration())
+}
+
+func getQueueTime(r dao.Resource) string {
+	query, ok := r.(*QueryResource)
+	if !ok {
+		return ""
+	}
+	return formatDuration(query.Item.QueueTime)
+}
+
+func getStarted(r dao.Resource) string {
+	query, ok := r.(*QueryResource)
+	if !ok || query.Item.StartTime.IsZero() {
+		return ""
+	}
+	return query.Item.StartTime.Format("2006-01-02 15:04:05")
+}
+
+func getText(r dao.Resource) string {
+	query, ok := r.(*QueryResource)
+	if !ok {
+		return ""
+	}
+	return query.ShortText()
+}
+
+// formatDuration renders a query duration with sub-second precision for short queries.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "-"
+	case d < time.Second:
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	default:
+		return d.Round(time.Second).String()
+	}
+}
+
+// RenderDetail renders detailed query information.
+func (r *QueryRenderer) RenderDetail(resource dao.Resource) string {
+	query, ok := resource.(*QueryResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Redshift Query", query.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Query ID", query.GetID())
+	d.Field("Cluster", query.Target.ClusterIdentifier)
+	d.Field("Database", query.Item.Database)
+	d.Field("Session ID", fmt.Sprintf("%d", query.Item.SessionID))
+	d.Field("User ID", fmt.Sprintf("%d", query.Item.UserID))
+	d.Field("Type", query.Item.QueryType)
+	d.FieldStyled("Status", query.Status(), statusStyle(query.Status()))
+
+	d.Section("Timing")
+	if !query.Item.StartTime.IsZero() {
+		d.Field("Started", query.Item.StartTime.Format("2006-01-02 15:04:05"))
+	}
+	if !query.Item.EndTime.IsZero() {
+		d.Field("Ended", query.Item.EndTime.Format("2006-01-02 15:04:05"))
+	}
+	d.Field("Duration", formatDuration(query.Duration()))
+	d.Field("Queue Time", formatDuration(query.Item.QueueTime))
+	d.Field("Execution Time", formatDuration(query.Item.ExecutionTime))
+	if !query.IsActive() {
+		d.Field("Returned Rows", fmt.Sprintf("%d", query.Item.ReturnedRows))
+	}
+
+	if query.Item.ErrorMessage != "" {
+		d.Section("Error")
+		d.Line(query.Item.ErrorMessage)
+	}
+
+	if query.Item.QueryText != "" {
+		d.Section("Query Text")
+		d.Line(query.Item.QueryText)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel.
+func (r *QueryRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	query, ok := resource.(*QueryResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Query ID", Value: query.GetID()},
+		{Label: "Status", Value: query.Status(), Style: statusStyle(query.Status())},
+		{Label: "Duration", Value: formatDuration(query.Duration())},
+		{Label: "Queue Time", Value: formatDuration(query.Item.QueueTime)},
+	}
+}
+
+func statusStyle(status string) render.Style {
+	switch status {
+	case "success":
+		return ui.SuccessStyle()
+	case "planning", "queued", "running", "returning":
+		return ui.WarningStyle()
+	case "failed":
+		return ui.DangerStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
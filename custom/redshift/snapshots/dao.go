@@ -21,13 +21,13 @@ type SnapshotDAO struct {
 
 // NewSnapshotDAO creates a new SnapshotDAO.
 func NewSnapshotDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, redshift.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SnapshotDAO{
 		BaseDAO: dao.NewBaseDAO("redshift", "snapshots"),
-		client:  redshift.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type ReservedInstanceDAO struct {
 
 // NewReservedInstanceDAO creates a new ReservedInstanceDAO
 func NewReservedInstanceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ReservedInstanceDAO{
 		BaseDAO: dao.NewBaseDAO("risp", "reserved-instances"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -22,13 +22,13 @@ type SavingsPlanDAO struct {
 
 // NewSavingsPlanDAO creates a new SavingsPlanDAO
 func NewSavingsPlanDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, savingsplans.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SavingsPlanDAO{
 		BaseDAO: dao.NewBaseDAO("risp", "savings-plans"),
-		client:  savingsplans.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
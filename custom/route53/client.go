@@ -0,0 +1,14 @@
+package route53
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns a Route 53 client configured for the current context
+func GetClient(ctx context.Context) (*route53.Client, error) {
+	return appaws.Client(ctx, route53.NewFromConfig)
+}
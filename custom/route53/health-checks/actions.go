@@ -0,0 +1,126 @@
+package healthchecks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	approute53 "github.com/clawscli/claws/custom/route53"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("route53", "health-checks", []action.Action{
+		{
+			Name:      "Invert",
+			Shortcut:  "I",
+			Type:      action.ActionTypeAPI,
+			Operation: "InvertHealthCheck",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				hc, ok := dao.UnwrapResource(r).(*HealthCheckResource)
+				return ok && !hc.IsInverted()
+			},
+		},
+		{
+			Name:      "Clear Inversion",
+			Shortcut:  "I",
+			Type:      action.ActionTypeAPI,
+			Operation: "UninvertHealthCheck",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				hc, ok := dao.UnwrapResource(r).(*HealthCheckResource)
+				return ok && hc.IsInverted()
+			},
+		},
+		{
+			Name:      "Disable",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "DisableHealthCheck",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				hc, ok := dao.UnwrapResource(r).(*HealthCheckResource)
+				return ok && !hc.IsDisabled()
+			},
+		},
+		{
+			Name:      "Enable",
+			Shortcut:  "E",
+			Type:      action.ActionTypeAPI,
+			Operation: "EnableHealthCheck",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				hc, ok := dao.UnwrapResource(r).(*HealthCheckResource)
+				return ok && hc.IsDisabled()
+			},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteHealthCheck",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("route53", "health-checks", executeHealthCheckAction)
+}
+
+func executeHealthCheckAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "InvertHealthCheck":
+		return executeUpdateHealthCheck(ctx, resource, &route53.UpdateHealthCheckInput{Inverted: appaws.BoolPtr(true)}, "Inverted")
+	case "UninvertHealthCheck":
+		return executeUpdateHealthCheck(ctx, resource, &route53.UpdateHealthCheckInput{Inverted: appaws.BoolPtr(false)}, "Cleared inversion of")
+	case "DisableHealthCheck":
+		return executeUpdateHealthCheck(ctx, resource, &route53.UpdateHealthCheckInput{Disabled: appaws.BoolPtr(true)}, "Disabled")
+	case "EnableHealthCheck":
+		return executeUpdateHealthCheck(ctx, resource, &route53.UpdateHealthCheckInput{Disabled: appaws.BoolPtr(false)}, "Enabled")
+	case "DeleteHealthCheck":
+		return executeDeleteHealthCheck(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeUpdateHealthCheck(ctx context.Context, resource dao.Resource, input *route53.UpdateHealthCheckInput, verb string) action.ActionResult {
+	hc, ok := dao.UnwrapResource(resource).(*HealthCheckResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := approute53.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	// The version guards against overwriting a concurrent change
+	input.HealthCheckId = hc.Item.Id
+	input.HealthCheckVersion = hc.Item.HealthCheckVersion
+	if _, err := client.UpdateHealthCheck(ctx, input); err != nil {
+		return action.FailResultf(err, "update health check %s", hc.GetID())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("%s health check %s", verb, hc.GetName()))
+}
+
+func executeDeleteHealthCheck(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := approute53.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := resource.GetID()
+	_, err = client.DeleteHealthCheck(ctx, &route53.DeleteHealthCheckInput{
+		HealthCheckId: &id,
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete health check %s", id)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deleted health check %s", resource.GetName()))
+}
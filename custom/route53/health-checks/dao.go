@@ -0,0 +1,330 @@
+package healthchecks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// statusConcurrency bounds parallel GetHealthCheckStatus calls during List.
+const statusConcurrency = 8
+
+// tagBatchSize is the ListTagsForResources limit per call.
+const tagBatchSize = 10
+
+// HealthCheckDAO provides data access for Route 53 health checks
+type HealthCheckDAO struct {
+	dao.BaseDAO
+	client *route53.Client
+}
+
+// NewHealthCheckDAO creates a new HealthCheckDAO
+func NewHealthCheckDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, route53.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &HealthCheckDAO{
+		BaseDAO: dao.NewBaseDAO("route53", "health-checks"),
+		client:  client,
+	}, nil
+}
+
+// List returns all health checks with their current status (optionally filtered by HealthCheckId)
+func (d *HealthCheckDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if id := dao.GetFilterFromContext(ctx, "HealthCheckId"); id != "" {
+		r, err := d.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return []dao.Resource{r}, nil
+	}
+
+	checks, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.HealthCheck, *string, error) {
+		output, err := d.client.ListHealthChecks(ctx, &route53.ListHealthChecksInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list health checks")
+		}
+		return output.HealthChecks, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*HealthCheckResource, len(checks))
+	for i, hc := range checks {
+		resources[i] = NewHealthCheckResource(hc)
+	}
+	d.loadNames(ctx, resources)
+	d.loadStatuses(ctx, resources)
+
+	result := make([]dao.Resource, len(resources))
+	for i, r := range resources {
+		result[i] = r
+	}
+	return result, nil
+}
+
+// Get returns a health check with its status and last failure reason
+func (d *HealthCheckDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetHealthCheck(ctx, &route53.GetHealthCheckInput{
+		HealthCheckId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get health check %s", id)
+	}
+	if output.HealthCheck == nil {
+		return nil, fmt.Errorf("health check not found: %s", id)
+	}
+
+	r := NewHealthCheckResource(*output.HealthCheck)
+	d.loadNames(ctx, []*HealthCheckResource{r})
+	d.loadStatuses(ctx, []*HealthCheckResource{r})
+
+	if r.HasCheckers() {
+		failure, err := d.client.GetHealthCheckLastFailureReason(ctx, &route53.GetHealthCheckLastFailureReasonInput{
+			HealthCheckId: &id,
+		})
+		if err != nil {
+			log.Debug("failed to get health check last failure reason", "healthCheck", id, "error", err)
+		} else {
+			r.LastFailure = latestObservation(failure.HealthCheckObservations)
+		}
+	}
+	return r, nil
+}
+
+// Delete deletes a health check
+func (d *HealthCheckDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteHealthCheck(ctx, &route53.DeleteHealthCheckInput{
+		HealthCheckId: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return apperrors.Wrapf(err, "delete health check %s", id)
+	}
+	return nil
+}
+
+// loadNames sets each resource's name from its Name tag. Best-effort.
+func (d *HealthCheckDAO) loadNames(ctx context.Context, resources []*HealthCheckResource) {
+	byID := make(map[string]*HealthCheckResource, len(resources))
+	ids := make([]string, len(resources))
+	for i, r := range resources {
+		byID[r.GetID()] = r
+		ids[i] = r.GetID()
+	}
+
+	for batch := range slices.Chunk(ids, tagBatchSize) {
+		output, err := d.client.ListTagsForResources(ctx, &route53.ListTagsForResourcesInput{
+			ResourceType: types.TagResourceTypeHealthcheck,
+			ResourceIds:  batch,
+		})
+		if err != nil {
+			log.Debug("failed to list health check tags", "error", err)
+			return
+		}
+		for _, set := range output.ResourceTagSets {
+			r, ok := byID[appaws.Str(set.ResourceId)]
+			if !ok {
+				continue
+			}
+			for _, tag := range set.Tags {
+				r.Tags[appaws.Str(tag.Key)] = appaws.Str(tag.Value)
+			}
+			if name := r.Tags["Name"]; name != "" {
+				r.Name = name
+			}
+		}
+	}
+}
+
+// loadStatuses fetches the checker observations of each health check.
+// Calculated, CloudWatch, and recovery control checks have no checkers.
+func (d *HealthCheckDAO) loadStatuses(ctx context.Context, resources []*HealthCheckResource) {
+	var mu sync.Mutex
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(statusConcurrency)
+	for _, r := range resources {
+		if !r.HasCheckers() {
+			continue
+		}
+		g.Go(func() error {
+			id := r.GetID()
+			output, err := d.client.GetHealthCheckStatus(ctx, &route53.GetHealthCheckStatusInput{
+				HealthCheckId: &id,
+			})
+			if err != nil {
+				log.Debug("failed to get health check status", "healthCheck", id, "error", err)
+				return nil
+			}
+			mu.Lock()
+			r.Observations = output.HealthCheckObservations
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// latestObservation returns the most recently checked observation, if any.
+func latestObservation(observations []types.HealthCheckObservation) *types.HealthCheckObservation {
+	var latest *types.HealthCheckObservation
+	var latestTime time.Time
+	for i, o := range observations {
+		if o.StatusReport == nil || o.StatusReport.CheckedTime == nil {
+			continue
+		}
+		if t := *o.StatusReport.CheckedTime; latest == nil || t.After(latestTime) {
+			latest, latestTime = &observations[i], t
+		}
+	}
+	return latest
+}
+
+// healthyThreshold is the share of checkers that must report success for
+// Route 53 to consider an endpoint healthy.
+const healthyThreshold = 0.18
+
+// HealthCheckResource wraps a Route 53 health check
+type HealthCheckResource struct {
+	dao.BaseResource
+	Item         types.HealthCheck
+	Observations []types.HealthCheckObservation
+	LastFailure  *types.HealthCheckObservation
+}
+
+// NewHealthCheckResource creates a new HealthCheckResource
+func NewHealthCheckResource(hc types.HealthCheck) *HealthCheckResource {
+	r := &HealthCheckResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(hc.Id),
+			Tags: make(map[string]string),
+			Data: hc,
+		},
+		Item: hc,
+	}
+	r.Name = r.Endpoint()
+	return r
+}
+
+func (r *HealthCheckResource) config() types.HealthCheckConfig {
+	if r.Item.HealthCheckConfig != nil {
+		return *r.Item.HealthCheckConfig
+	}
+	return types.HealthCheckConfig{}
+}
+
+// Type returns the health check type (HTTP, TCP, CALCULATED, ...)
+func (r *HealthCheckResource) Type() string {
+	return string(r.config().Type)
+}
+
+// IsInverted reports whether the health check status is inverted
+func (r *HealthCheckResource) IsInverted() bool {
+	return appaws.Bool(r.config().Inverted)
+}
+
+// IsDisabled reports whether the health check is disabled (always healthy)
+func (r *HealthCheckResource) IsDisabled() bool {
+	return appaws.Bool(r.config().Disabled)
+}
+
+// HasCheckers reports whether Route 53 health checkers probe an endpoint
+func (r *HealthCheckResource) HasCheckers() bool {
+	switch r.config().Type {
+	case types.HealthCheckTypeCalculated, types.HealthCheckTypeCloudwatchMetric, types.HealthCheckTypeRecoveryControl:
+		return false
+	}
+	return true
+}
+
+// Endpoint describes what is checked, e.g. "https://example.com:443/health"
+func (r *HealthCheckResource) Endpoint() string {
+	c := r.config()
+	switch c.Type {
+	case types.HealthCheckTypeCalculated:
+		return fmt.Sprintf("%d of %d children", appaws.Int32(c.HealthThreshold), len(c.ChildHealthChecks))
+	case types.HealthCheckTypeCloudwatchMetric:
+		if c.AlarmIdentifier != nil {
+			return "alarm " + appaws.Str(c.AlarmIdentifier.Name)
+		}
+		return "alarm"
+	case types.HealthCheckTypeRecoveryControl:
+		return appaws.ExtractResourceName(appaws.Str(c.RoutingControlArn))
+	}
+
+	host := appaws.Str(c.FullyQualifiedDomainName)
+	if host == "" {
+		host = appaws.Str(c.IPAddress)
+	}
+	if c.Port != nil {
+		host = fmt.Sprintf("%s:%d", host, *c.Port)
+	}
+	if c.Type == types.HealthCheckTypeTcp {
+		return "tcp://" + host
+	}
+	scheme := "http"
+	if strings.HasPrefix(string(c.Type), "HTTPS") {
+		scheme = "https"
+	}
+	return scheme + "://" + host + appaws.Str(c.ResourcePath)
+}
+
+// HealthyCheckers returns how many checkers last reported success, and how many reported
+func (r *HealthCheckResource) HealthyCheckers() (healthy, total int) {
+	for _, o := range r.Observations {
+		if o.StatusReport == nil {
+			continue
+		}
+		total++
+		if strings.HasPrefix(appaws.Str(o.StatusReport.Status), "Success") {
+			healthy++
+		}
+	}
+	return healthy, total
+}
+
+// Status returns "Healthy" or "Unhealthy" as Route 53 evaluates the check,
+// or "" when no checker status is available.
+func (r *HealthCheckResource) Status() string {
+	if r.IsDisabled() {
+		return "Healthy"
+	}
+	healthy, total := r.HealthyCheckers()
+	if total == 0 {
+		return ""
+	}
+	ok := float64(healthy)/float64(total) > healthyThreshold
+	if r.IsInverted() {
+		ok = !ok
+	}
+	if ok {
+		return "Healthy"
+	}
+	return "Unhealthy"
+}
+
+// LastFailureReason returns the last failure reported by any checker
+func (r *HealthCheckResource) LastFailureReason() string {
+	if r.LastFailure == nil || r.LastFailure.StatusReport == nil {
+		return ""
+	}
+	return appaws.Str(r.LastFailure.StatusReport.Status)
+}
@@ -0,0 +1,94 @@
+package healthchecks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func observations(statuses ...string) []types.HealthCheckObservation {
+	obs := make([]types.HealthCheckObservation, len(statuses))
+	for i, s := range statuses {
+		obs[i] = types.HealthCheckObservation{
+			StatusReport: &types.StatusReport{Status: aws.String(s), CheckedTime: aws.Time(time.Unix(int64(i), 0))},
+		}
+	}
+	return obs
+}
+
+func TestEndpoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		config types.HealthCheckConfig
+		want   string
+	}{
+		{"https", types.HealthCheckConfig{Type: types.HealthCheckTypeHttps, FullyQualifiedDomainName: aws.String("example.com"), Port: aws.Int32(443), ResourcePath: aws.String("/health")}, "https://example.com:443/health"},
+		{"http ip", types.HealthCheckConfig{Type: types.HealthCheckTypeHttpStrMatch, IPAddress: aws.String("192.0.2.1"), Port: aws.Int32(80)}, "http://192.0.2.1:80"},
+		{"tcp", types.HealthCheckConfig{Type: types.HealthCheckTypeTcp, IPAddress: aws.String("192.0.2.1"), Port: aws.Int32(22)}, "tcp://192.0.2.1:22"},
+		{"calculated", types.HealthCheckConfig{Type: types.HealthCheckTypeCalculated, HealthThreshold: aws.Int32(2), ChildHealthChecks: []string{"a", "b", "c"}}, "2 of 3 children"},
+		{"alarm", types.HealthCheckConfig{Type: types.HealthCheckTypeCloudwatchMetric, AlarmIdentifier: &types.AlarmIdentifier{Name: aws.String("api-5xx")}}, "alarm api-5xx"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewHealthCheckResource(types.HealthCheck{Id: aws.String("hc-1"), HealthCheckConfig: &tt.config})
+			if got := r.Endpoint(); got != tt.want {
+				t.Errorf("Endpoint() = %q, want %q", got, tt.want)
+			}
+			if r.GetName() != tt.want {
+				t.Errorf("GetName() = %q, want endpoint when untagged", r.GetName())
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	// 2 of 10 checkers (20%) passing is above the 18% threshold
+	mostlyFailing := append(observations("Success: HTTP Status Code 200", "Success: HTTP Status Code 200"),
+		observations("Failure: timeout", "Failure: timeout", "Failure: timeout", "Failure: timeout",
+			"Failure: timeout", "Failure: timeout", "Failure: timeout", "Failure: timeout")...)
+
+	tests := []struct {
+		name     string
+		inverted bool
+		disabled bool
+		obs      []types.HealthCheckObservation
+		want     string
+	}{
+		{"no observations", false, false, nil, ""},
+		{"all failing", false, false, observations("Failure: timeout", "Failure: timeout"), "Unhealthy"},
+		{"above threshold", false, false, mostlyFailing, "Healthy"},
+		{"below threshold", false, false, mostlyFailing[1:], "Unhealthy"},
+		{"inverted", true, false, observations("Success: ok"), "Unhealthy"},
+		{"disabled", false, true, observations("Failure: timeout"), "Healthy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewHealthCheckResource(types.HealthCheck{
+				Id: aws.String("hc-1"),
+				HealthCheckConfig: &types.HealthCheckConfig{
+					Type:     types.HealthCheckTypeHttp,
+					Inverted: aws.Bool(tt.inverted),
+					Disabled: aws.Bool(tt.disabled),
+				},
+			})
+			r.Observations = tt.obs
+			if got := r.Status(); got != tt.want {
+				t.Errorf("Status() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLatestObservation(t *testing.T) {
+	obs := observations("Failure: old", "Failure: newest", "Failure: middle")
+	obs[2].StatusReport.CheckedTime = aws.Time(time.Unix(0, 500))
+	got := latestObservation(obs)
+	if got == nil || aws.ToString(got.StatusReport.Status) != "Failure: newest" {
+		t.Errorf("latestObservation() = %v, want newest", got)
+	}
+	if latestObservation(nil) != nil {
+		t.Error("latestObservation(nil) should be nil")
+	}
+}
@@ -0,0 +1,20 @@
+package healthchecks
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("route53", "health-checks", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewHealthCheckDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewHealthCheckRenderer()
+		},
+	})
+}
@@ -0,0 +1,206 @@
+package healthchecks
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// HealthCheckRenderer renders Route 53 health checks
+type HealthCheckRenderer struct {
+	render.BaseRenderer
+}
+
+// NewHealthCheckRenderer creates a new HealthCheckRenderer
+func NewHealthCheckRenderer() render.Renderer {
+	return &HealthCheckRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "route53",
+			Resource: "health-checks",
+			Cols: []render.Column{
+				{
+					Name:  "NAME",
+					Width: 30,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "STATUS",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*HealthCheckResource); ok {
+							return v.Status()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "CHECKERS",
+					Width: 9,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*HealthCheckResource); ok {
+							if healthy, total := v.HealthyCheckers(); total > 0 {
+								return fmt.Sprintf("%d/%d", healthy, total)
+							}
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "TYPE",
+					Width: 17,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*HealthCheckResource); ok {
+							return v.Type()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "FLAGS",
+					Width: 16,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*HealthCheckResource); ok {
+							return flags(v)
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "ENDPOINT",
+					Width: 40,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*HealthCheckResource); ok {
+							return v.Endpoint()
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+			},
+		},
+	}
+}
+
+// flags lists the non-default switches of a health check.
+func flags(v *HealthCheckResource) string {
+	var f []string
+	if v.IsInverted() {
+		f = append(f, "inverted")
+	}
+	if v.IsDisabled() {
+		f = append(f, "disabled")
+	}
+	return strings.Join(f, ",")
+}
+
+// RenderDetail renders detailed health check information
+func (r *HealthCheckRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*HealthCheckResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	c := v.config()
+
+	d.Title("Health Check", v.GetName())
+
+	d.Section("Basic Information")
+	d.Field("ID", v.GetID())
+	d.Field("Type", v.Type())
+	d.Field("Endpoint", v.Endpoint())
+	if status := v.Status(); status != "" {
+		d.Field("Status", status)
+	}
+	if healthy, total := v.HealthyCheckers(); total > 0 {
+		d.Field("Healthy Checkers", fmt.Sprintf("%d of %d", healthy, total))
+	}
+	d.Field("Inverted", fmt.Sprintf("%t", v.IsInverted()))
+	d.Field("Disabled", fmt.Sprintf("%t", v.IsDisabled()))
+
+	if reason := v.LastFailureReason(); reason != "" {
+		d.Section("Last Failure")
+		d.Field("Reason", reason)
+		if v.LastFailure.Region != "" {
+			d.Field("Checker Region", string(v.LastFailure.Region))
+		}
+		if t := v.LastFailure.StatusReport.CheckedTime; t != nil {
+			d.Field("Checked", render.FormatAge(*t)+" ago")
+		}
+	}
+
+	if v.HasCheckers() {
+		d.Section("Configuration")
+		if c.SearchString != nil {
+			d.Field("Search String", *c.SearchString)
+		}
+		d.Field("Request Interval", fmt.Sprintf("%ds", appaws.Int32(c.RequestInterval)))
+		d.Field("Failure Threshold", fmt.Sprintf("%d", appaws.Int32(c.FailureThreshold)))
+		if len(c.Regions) > 0 {
+			regions := make([]string, len(c.Regions))
+			for i, region := range c.Regions {
+				regions[i] = string(region)
+			}
+			d.Field("Regions", strings.Join(regions, ", "))
+		}
+		d.Field("Measure Latency", fmt.Sprintf("%t", appaws.Bool(c.MeasureLatency)))
+	}
+
+	if len(c.ChildHealthChecks) > 0 {
+		d.Section("Child Health Checks")
+		for _, child := range c.ChildHealthChecks {
+			d.Line(child)
+		}
+	}
+
+	if v.Item.LinkedService != nil {
+		d.Section("Linked Service")
+		d.Field("Service", appaws.Str(v.Item.LinkedService.ServicePrincipal))
+		d.FieldIf("Description", v.Item.LinkedService.Description)
+	}
+
+	d.Tags(v.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *HealthCheckRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*HealthCheckResource)
+	if !ok {
+		return nil
+	}
+	fields := []render.SummaryField{
+		{Label: "Name", Value: v.GetName()},
+		{Label: "Endpoint", Value: v.Endpoint()},
+	}
+	if status := v.Status(); status != "" {
+		fields = append(fields, render.SummaryField{Label: "Status", Value: status})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *HealthCheckRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*HealthCheckResource)
+	if !ok {
+		return nil
+	}
+	if alarm := v.config().AlarmIdentifier; alarm != nil {
+		return []render.Navigation{{
+			Key: "a", Label: "Alarm", Service: "cloudwatch", Resource: "alarms",
+			FilterField: "AlarmName", FilterValue: appaws.Str(alarm.Name),
+		}}
+	}
+	return nil
+}
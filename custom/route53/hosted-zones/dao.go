@@ -21,13 +21,13 @@ type HostedZoneDAO struct {
 
 // NewHostedZoneDAO creates a new HostedZoneDAO
 func NewHostedZoneDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, route53.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &HostedZoneDAO{
 		BaseDAO: dao.NewBaseDAO("route53", "hosted-zones"),
-		client:  route53.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type RecordSetDAO struct {
 
 // NewRecordSetDAO creates a new RecordSetDAO
 func NewRecordSetDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, route53.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RecordSetDAO{
 		BaseDAO: dao.NewBaseDAO("route53", "record-sets"),
-		client:  route53.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
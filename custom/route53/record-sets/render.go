@@ -193,3 +193,18 @@ func (r *RecordSetRenderer) RenderSummary(resource dao.Resource) []render.Summar
 
 	return fields
 }
+
+// Navigations returns navigation shortcuts
+func (r *RecordSetRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	rr, ok := resource.(*RecordSetResource)
+	if !ok {
+		return nil
+	}
+	if id := rr.HealthCheckID(); id != "" {
+		return []render.Navigation{{
+			Key: "h", Label: "Health Check", Service: "route53", Resource: "health-checks",
+			FilterField: "HealthCheckId", FilterValue: id,
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package trafficpolicies
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// TrafficPolicyDAO provides data access for Route 53 traffic policies
+type TrafficPolicyDAO struct {
+	dao.BaseDAO
+	client *route53.Client
+}
+
+// NewTrafficPolicyDAO creates a new TrafficPolicyDAO
+func NewTrafficPolicyDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, route53.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &TrafficPolicyDAO{
+		BaseDAO: dao.NewBaseDAO("route53", "traffic-policies"),
+		client:  client,
+	}, nil
+}
+
+// List returns all traffic policies (latest version summaries)
+func (d *TrafficPolicyDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	summaries, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.TrafficPolicySummary, *string, error) {
+		output, err := d.client.ListTrafficPolicies(ctx, &route53.ListTrafficPoliciesInput{
+			TrafficPolicyIdMarker: marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list traffic policies")
+		}
+		if !output.IsTruncated {
+			return output.TrafficPolicySummaries, nil, nil
+		}
+		return output.TrafficPolicySummaries, output.TrafficPolicyIdMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(summaries))
+	for i, s := range summaries {
+		resources[i] = NewTrafficPolicyResource(s)
+	}
+	return resources, nil
+}
+
+// Get returns the latest version of a traffic policy with its document
+func (d *TrafficPolicyDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	versions, err := appaws.PaginateMarker(ctx, func(marker *string) ([]types.TrafficPolicy, *string, error) {
+		output, err := d.client.ListTrafficPolicyVersions(ctx, &route53.ListTrafficPolicyVersionsInput{
+			Id:                         &id,
+			TrafficPolicyVersionMarker: marker,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list traffic policy versions %s", id)
+		}
+		if !output.IsTruncated {
+			return output.TrafficPolicies, nil, nil
+		}
+		return output.TrafficPolicies, output.TrafficPolicyVersionMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("traffic policy not found: %s", id)
+	}
+
+	latest := slices.MaxFunc(versions, func(a, b types.TrafficPolicy) int {
+		return int(appaws.Int32(a.Version)) - int(appaws.Int32(b.Version))
+	})
+	r := NewTrafficPolicyResource(types.TrafficPolicySummary{
+		Id:                 latest.Id,
+		Name:               latest.Name,
+		Type:               latest.Type,
+		LatestVersion:      latest.Version,
+		TrafficPolicyCount: appaws.Int32Ptr(int32(len(versions))),
+	})
+	r.Policy = &latest
+	return r, nil
+}
+
+// Delete is not supported: every version must be deleted individually
+func (d *TrafficPolicyDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for traffic policies")
+}
+
+// TrafficPolicyResource wraps a Route 53 traffic policy
+type TrafficPolicyResource struct {
+	dao.BaseResource
+	Summary types.TrafficPolicySummary
+	Policy  *types.TrafficPolicy // Latest version with document; set by Get
+}
+
+// NewTrafficPolicyResource creates a new TrafficPolicyResource
+func NewTrafficPolicyResource(s types.TrafficPolicySummary) *TrafficPolicyResource {
+	return &TrafficPolicyResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(s.Id),
+			Name: appaws.Str(s.Name),
+			Tags: make(map[string]string),
+			Data: s,
+		},
+		Summary: s,
+	}
+}
+
+// RecordType returns the DNS type of records created by the policy
+func (r *TrafficPolicyResource) RecordType() string {
+	return string(r.Summary.Type)
+}
+
+// LatestVersion returns the latest version number
+func (r *TrafficPolicyResource) LatestVersion() int32 {
+	return appaws.Int32(r.Summary.LatestVersion)
+}
+
+// VersionCount returns the number of versions of the policy
+func (r *TrafficPolicyResource) VersionCount() int32 {
+	return appaws.Int32(r.Summary.TrafficPolicyCount)
+}
+
+// Document returns the policy document JSON of the latest version
+func (r *TrafficPolicyResource) Document() string {
+	if r.Policy == nil {
+		return ""
+	}
+	return appaws.Str(r.Policy.Document)
+}
+
+// Comment returns the comment of the latest version
+func (r *TrafficPolicyResource) Comment() string {
+	if r.Policy == nil {
+		return ""
+	}
+	return appaws.Str(r.Policy.Comment)
+}
+
+// policyDocument is the subset of the traffic policy document format
+// needed to summarize routing.
+type policyDocument struct {
+	StartRule     string `json:"StartRule"`
+	StartEndpoint string `json:"StartEndpoint"`
+	Rules         map[string]struct {
+		RuleType string `json:"RuleType"`
+	} `json:"Rules"`
+	Endpoints map[string]struct {
+		Type  string `json:"Type"`
+		Value string `json:"Value"`
+	} `json:"Endpoints"`
+}
+
+// DocumentSummary describes the routing of the policy document
+type DocumentSummary struct {
+	Start     string
+	RuleTypes map[string]int // Rule type (failover, latency, ...) to count
+	Endpoints []string       // "type value", sorted by endpoint name
+}
+
+// ParseDocument summarizes a traffic policy document.
+func ParseDocument(document string) (*DocumentSummary, error) {
+	var doc policyDocument
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil, fmt.Errorf("parse traffic policy document: %w", err)
+	}
+
+	s := &DocumentSummary{RuleTypes: make(map[string]int)}
+	switch {
+	case doc.StartRule != "":
+		s.Start = "rule " + doc.StartRule
+	case doc.StartEndpoint != "":
+		s.Start = "endpoint " + doc.StartEndpoint
+	}
+	for _, rule := range doc.Rules {
+		s.RuleTypes[rule.RuleType]++
+	}
+	for _, name := range slices.Sorted(maps.Keys(doc.Endpoints)) {
+		ep := doc.Endpoints[name]
+		s.Endpoints = append(s.Endpoints, fmt.Sprintf("%s: %s %s", name, ep.Type, ep.Value))
+	}
+	return s, nil
+}
@@ -0,0 +1,39 @@
+package trafficpolicies
+
+import "testing"
+
+func TestParseDocument(t *testing.T) {
+	doc := `{
+		"AWSPolicyFormatVersion": "2015-10-01",
+		"RecordType": "A",
+		"StartRule": "primary-failover",
+		"Endpoints": {
+			"secondary": {"Type": "value", "Value": "192.0.2.2"},
+			"primary": {"Type": "elastic-load-balancer", "Value": "lb.example.com"}
+		},
+		"Rules": {
+			"primary-failover": {"RuleType": "failover"},
+			"by-region": {"RuleType": "latency"},
+			"by-region-2": {"RuleType": "latency"}
+		}
+	}`
+
+	s, err := ParseDocument(doc)
+	if err != nil {
+		t.Fatalf("ParseDocument() error = %v", err)
+	}
+	if s.Start != "rule primary-failover" {
+		t.Errorf("Start = %q", s.Start)
+	}
+	if s.RuleTypes["latency"] != 2 || s.RuleTypes["failover"] != 1 {
+		t.Errorf("RuleTypes = %v", s.RuleTypes)
+	}
+	want := []string{"primary: elastic-load-balancer lb.example.com", "secondary: value 192.0.2.2"}
+	if len(s.Endpoints) != 2 || s.Endpoints[0] != want[0] || s.Endpoints[1] != want[1] {
+		t.Errorf("Endpoints = %v, want %v", s.Endpoints, want)
+	}
+
+	if _, err := ParseDocument("not json"); err == nil {
+		t.Error("ParseDocument(invalid) expected error")
+	}
+}
@@ -0,0 +1,20 @@
+package trafficpolicies
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("route53", "traffic-policies", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewTrafficPolicyDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewTrafficPolicyRenderer()
+		},
+	})
+}
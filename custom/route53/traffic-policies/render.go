@@ -0,0 +1,148 @@
+package trafficpolicies
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// TrafficPolicyRenderer renders Route 53 traffic policies
+type TrafficPolicyRenderer struct {
+	render.BaseRenderer
+}
+
+// NewTrafficPolicyRenderer creates a new TrafficPolicyRenderer
+func NewTrafficPolicyRenderer() render.Renderer {
+	return &TrafficPolicyRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "route53",
+			Resource: "traffic-policies",
+			Cols: []render.Column{
+				{
+					Name:  "NAME",
+					Width: 30,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "TYPE",
+					Width: 6,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*TrafficPolicyResource); ok {
+							return v.RecordType()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "LATEST",
+					Width: 7,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*TrafficPolicyResource); ok {
+							return fmt.Sprintf("v%d", v.LatestVersion())
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "VERSIONS",
+					Width: 9,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*TrafficPolicyResource); ok {
+							return fmt.Sprintf("%d", v.VersionCount())
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "ID",
+					Width: 38,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 4,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed traffic policy information
+func (r *TrafficPolicyRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*TrafficPolicyResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Traffic Policy", v.GetName())
+
+	d.Section("Basic Information")
+	d.Field("ID", v.GetID())
+	d.Field("Record Type", v.RecordType())
+	d.Field("Latest Version", fmt.Sprintf("%d", v.LatestVersion()))
+	d.Field("Versions", fmt.Sprintf("%d", v.VersionCount()))
+	if comment := v.Comment(); comment != "" {
+		d.Field("Comment", comment)
+	}
+
+	document := v.Document()
+	if document == "" {
+		return d.String()
+	}
+
+	if summary, err := ParseDocument(document); err == nil {
+		d.Section("Routing")
+		if summary.Start != "" {
+			d.Field("Starts At", summary.Start)
+		}
+		for _, ruleType := range slices.Sorted(maps.Keys(summary.RuleTypes)) {
+			d.Field(ruleType+" rules", fmt.Sprintf("%d", summary.RuleTypes[ruleType]))
+		}
+		if len(summary.Endpoints) > 0 {
+			d.Section("Endpoints")
+			for _, ep := range summary.Endpoints {
+				d.Line(ep)
+			}
+		}
+	}
+
+	d.Section("Document")
+	d.Line(prettyJSON(document))
+
+	return d.String()
+}
+
+// prettyJSON formats JSON string with indentation
+func prettyJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *TrafficPolicyRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*TrafficPolicyResource)
+	if !ok {
+		return nil
+	}
+	return []render.SummaryField{
+		{Label: "Name", Value: v.GetName()},
+		{Label: "Type", Value: v.RecordType()},
+		{Label: "Version", Value: fmt.Sprintf("%d", v.LatestVersion())},
+	}
+}
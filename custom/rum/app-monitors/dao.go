@@ -0,0 +1,293 @@
+package appmonitors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/rum"
+	"github.com/aws/aws-sdk-go-v2/service/rum/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// metricsWindow is how far back session and error metrics are summed on Get.
+const metricsWindow = 24 * time.Hour
+
+// rumMetric describes an app monitor metric shown in the detail view.
+type rumMetric struct {
+	Name  string
+	Label string
+}
+
+// rumMetrics are summed over metricsWindow for an app monitor on Get. RUM
+// publishes them in AWS/RUM by application_name; metrics without data in the
+// window are omitted.
+var rumMetrics = []rumMetric{
+	{Name: "SessionCount", Label: "Sessions"},
+	{Name: "PageViewCount", Label: "Page Views"},
+	{Name: "JsErrorCount", Label: "JS Errors"},
+	{Name: "HttpErrorCount", Label: "HTTP Errors"},
+}
+
+// AppMonitorDAO provides data access for CloudWatch RUM app monitors
+type AppMonitorDAO struct {
+	dao.BaseDAO
+	client   *rum.Client
+	cwClient *cloudwatch.Client
+}
+
+// NewAppMonitorDAO creates a new AppMonitorDAO
+func NewAppMonitorDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, rum.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	cwClient, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &AppMonitorDAO{
+		BaseDAO:  dao.NewBaseDAO("rum", "app-monitors"),
+		client:   client,
+		cwClient: cwClient,
+	}, nil
+}
+
+// List returns all app monitors
+func (d *AppMonitorDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	monitors, err := appaws.Paginate(ctx, func(token *string) ([]types.AppMonitorSummary, *string, error) {
+		output, err := d.client.ListAppMonitors(ctx, &rum.ListAppMonitorsInput{
+			MaxResults: appaws.Int32Ptr(100),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list app monitors")
+		}
+		return output.AppMonitorSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(monitors))
+	for _, m := range monitors {
+		resources = append(resources, NewAppMonitorResource(m))
+	}
+	return resources, nil
+}
+
+// Get returns an app monitor with its configuration and its session and
+// error counts. Metric lookups are best-effort; failures are logged and
+// leave metrics unset.
+func (d *AppMonitorDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.client.GetAppMonitor(ctx, &rum.GetAppMonitorInput{Name: &name})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get app monitor %s", name)
+	}
+	if output.AppMonitor == nil {
+		return nil, fmt.Errorf("app monitor %s not found", name)
+	}
+	r := NewAppMonitorResourceFromDetail(*output.AppMonitor)
+
+	metrics, err := d.fetchMetrics(ctx, name)
+	if err != nil {
+		log.Warn("failed to fetch app monitor metrics", "name", name, "error", err)
+	} else {
+		r.Metrics = metrics
+	}
+	return r, nil
+}
+
+// Delete deletes an app monitor by name
+func (d *AppMonitorDAO) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteAppMonitor(ctx, &rum.DeleteAppMonitorInput{Name: &name})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete app monitor %s", name)
+	}
+	return nil
+}
+
+func (d *AppMonitorDAO) fetchMetrics(ctx context.Context, name string) ([]AppMonitorMetric, error) {
+	period := int32(metricsWindow.Seconds())
+	queries := make([]cwtypes.MetricDataQuery, len(rumMetrics))
+	for i, m := range rumMetrics {
+		queries[i] = cwtypes.MetricDataQuery{
+			Id: appaws.StringPtr(fmt.Sprintf("m%d", i)),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  appaws.StringPtr("AWS/RUM"),
+					MetricName: appaws.StringPtr(m.Name),
+					Dimensions: []cwtypes.Dimension{
+						{Name: appaws.StringPtr("application_name"), Value: appaws.StringPtr(name)},
+					},
+				},
+				Period: &period,
+				Stat:   appaws.StringPtr("Sum"),
+			},
+		}
+	}
+
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-metricsWindow)
+	output, err := d.cwClient.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime:         &start,
+		EndTime:           &end,
+		MetricDataQueries: queries,
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get app monitor metrics")
+	}
+
+	return sumMetrics(output.MetricDataResults), nil
+}
+
+// sumMetrics maps query results back to rumMetrics, adding up the
+// datapoints of each and keeping only metrics with data.
+func sumMetrics(results []cwtypes.MetricDataResult) []AppMonitorMetric {
+	sums := make(map[string]float64, len(results))
+	for _, res := range results {
+		if len(res.Values) == 0 {
+			continue
+		}
+		id := appaws.Str(res.Id)
+		for _, v := range res.Values {
+			sums[id] += v
+		}
+	}
+
+	var metrics []AppMonitorMetric
+	for i, m := range rumMetrics {
+		if v, ok := sums[fmt.Sprintf("m%d", i)]; ok {
+			metrics = append(metrics, AppMonitorMetric{Name: m.Name, Label: m.Label, Value: v})
+		}
+	}
+	return metrics
+}
+
+// AppMonitorMetric is an app monitor metric summed over metricsWindow
+type AppMonitorMetric struct {
+	Name  string
+	Label string
+	Value float64
+}
+
+// IsError reports whether the metric counts errors
+func (m AppMonitorMetric) IsError() bool {
+	return m.Name == "JsErrorCount" || m.Name == "HttpErrorCount"
+}
+
+// AppMonitorResource represents a CloudWatch RUM app monitor
+type AppMonitorResource struct {
+	dao.BaseResource
+	MonitorID    string
+	State        types.StateEnum
+	Platform     types.AppMonitorPlatform
+	Created      string
+	LastModified string
+
+	// Populated by Get only
+	Detail  *types.AppMonitor
+	Metrics []AppMonitorMetric
+}
+
+// NewAppMonitorResource creates an AppMonitorResource from a list summary
+func NewAppMonitorResource(m types.AppMonitorSummary) *AppMonitorResource {
+	name := appaws.Str(m.Name)
+	return &AppMonitorResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Tags: make(map[string]string),
+			Data: m,
+		},
+		MonitorID:    appaws.Str(m.Id),
+		State:        m.State,
+		Platform:     m.Platform,
+		Created:      appaws.Str(m.Created),
+		LastModified: appaws.Str(m.LastModified),
+	}
+}
+
+// NewAppMonitorResourceFromDetail creates an AppMonitorResource from GetAppMonitor
+func NewAppMonitorResourceFromDetail(m types.AppMonitor) *AppMonitorResource {
+	name := appaws.Str(m.Name)
+	tags := m.Tags
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+	return &AppMonitorResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Tags: tags,
+			Data: m,
+		},
+		MonitorID:    appaws.Str(m.Id),
+		State:        m.State,
+		Platform:     m.Platform,
+		Created:      appaws.Str(m.Created),
+		LastModified: appaws.Str(m.LastModified),
+		Detail:       &m,
+	}
+}
+
+// Domains returns the domains the monitor collects data from
+func (r *AppMonitorResource) Domains() []string {
+	if r.Detail == nil {
+		return nil
+	}
+	if len(r.Detail.DomainList) > 0 {
+		return r.Detail.DomainList
+	}
+	if d := appaws.Str(r.Detail.Domain); d != "" {
+		return []string{d}
+	}
+	return nil
+}
+
+// Config returns the monitor's collection settings, if loaded
+func (r *AppMonitorResource) Config() *types.AppMonitorConfiguration {
+	if r.Detail == nil {
+		return nil
+	}
+	return r.Detail.AppMonitorConfiguration
+}
+
+// LogGroup returns the CloudWatch Logs group RUM events are copied to, if enabled
+func (r *AppMonitorResource) LogGroup() string {
+	if r.Detail == nil || r.Detail.DataStorage == nil || r.Detail.DataStorage.CwLog == nil {
+		return ""
+	}
+	if !appaws.Bool(r.Detail.DataStorage.CwLog.CwLogEnabled) {
+		return ""
+	}
+	return appaws.Str(r.Detail.DataStorage.CwLog.CwLogGroup)
+}
+
+// Metric returns the summed value of a metric and whether it had data
+func (r *AppMonitorResource) Metric(name string) (float64, bool) {
+	for _, m := range r.Metrics {
+		if m.Name == name {
+			return m.Value, true
+		}
+	}
+	return 0, false
+}
+
+// ErrorsPerSession returns JS and HTTP errors per session, or false when
+// there were no sessions in the window
+func (r *AppMonitorResource) ErrorsPerSession() (float64, bool) {
+	sessions, ok := r.Metric("SessionCount")
+	if !ok || sessions == 0 {
+		return 0, false
+	}
+	js, _ := r.Metric("JsErrorCount")
+	http, _ := r.Metric("HttpErrorCount")
+	return (js + http) / sessions, true
+}
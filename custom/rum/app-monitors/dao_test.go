@@ -0,0 +1,74 @@
+package appmonitors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/rum/types"
+)
+
+func TestSumMetrics(t *testing.T) {
+	metrics := sumMetrics([]cwtypes.MetricDataResult{
+		{Id: aws.String("m0"), Values: []float64{40, 60}},
+		{Id: aws.String("m2"), Values: []float64{5}},
+		{Id: aws.String("m3"), Values: nil},
+	})
+	if len(metrics) != 2 {
+		t.Fatalf("metrics = %+v", metrics)
+	}
+	if metrics[0].Name != "SessionCount" || metrics[0].Value != 100 {
+		t.Errorf("metrics[0] = %+v", metrics[0])
+	}
+	if metrics[1].Name != "JsErrorCount" || !metrics[1].IsError() {
+		t.Errorf("metrics[1] = %+v", metrics[1])
+	}
+}
+
+func TestAppMonitorResource(t *testing.T) {
+	list := NewAppMonitorResource(types.AppMonitorSummary{
+		Name:     aws.String("web"),
+		Id:       aws.String("abc-123"),
+		State:    types.StateEnumActive,
+		Platform: types.AppMonitorPlatformWeb,
+	})
+	if list.GetID() != "web" || list.MonitorID != "abc-123" || list.Config() != nil || list.Domains() != nil {
+		t.Errorf("list resource = %+v", list)
+	}
+	if _, ok := list.ErrorsPerSession(); ok {
+		t.Error("ErrorsPerSession() without metrics should report no data")
+	}
+
+	detail := NewAppMonitorResourceFromDetail(types.AppMonitor{
+		Name:   aws.String("web"),
+		Domain: aws.String("example.com"),
+		DataStorage: &types.DataStorage{CwLog: &types.CwLog{
+			CwLogEnabled: aws.Bool(true),
+			CwLogGroup:   aws.String("/aws/vendedlogs/RUMService_web"),
+		}},
+		AppMonitorConfiguration: &types.AppMonitorConfiguration{SessionSampleRate: 0.5},
+	})
+	if got := detail.Domains(); len(got) != 1 || got[0] != "example.com" {
+		t.Errorf("Domains() = %v", got)
+	}
+	if detail.LogGroup() != "/aws/vendedlogs/RUMService_web" {
+		t.Errorf("LogGroup() = %q", detail.LogGroup())
+	}
+
+	detail.Metrics = []AppMonitorMetric{
+		{Name: "SessionCount", Value: 200},
+		{Name: "JsErrorCount", Value: 30},
+		{Name: "HttpErrorCount", Value: 10},
+	}
+	if rate, ok := detail.ErrorsPerSession(); !ok || rate != 0.2 {
+		t.Errorf("ErrorsPerSession() = %v, %v", rate, ok)
+	}
+
+	out := NewAppMonitorRenderer().RenderDetail(detail)
+	for _, want := range []string{"example.com", "50%", "Errors per Session", "0.20"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("detail missing %q", want)
+		}
+	}
+}
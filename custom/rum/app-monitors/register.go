@@ -0,0 +1,20 @@
+package appmonitors
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("rum", "app-monitors", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewAppMonitorDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewAppMonitorRenderer()
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package appmonitors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/rum/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// AppMonitorRenderer renders CloudWatch RUM app monitors
+type AppMonitorRenderer struct {
+	render.BaseRenderer
+}
+
+// NewAppMonitorRenderer creates a new AppMonitorRenderer
+func NewAppMonitorRenderer() render.Renderer {
+	return &AppMonitorRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "rum",
+			Resource: "app-monitors",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "STATE", Width: 10, Getter: getState},
+				{Name: "PLATFORM", Width: 10, Getter: getPlatform},
+				{Name: "ID", Width: 38, Getter: getMonitorID},
+				{Name: "CREATED", Width: 22, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getState(r dao.Resource) string {
+	m, ok := r.(*AppMonitorResource)
+	if !ok {
+		return ""
+	}
+	return string(m.State)
+}
+
+func getPlatform(r dao.Resource) string {
+	m, ok := r.(*AppMonitorResource)
+	if !ok {
+		return ""
+	}
+	return string(m.Platform)
+}
+
+func getMonitorID(r dao.Resource) string {
+	m, ok := r.(*AppMonitorResource)
+	if !ok {
+		return ""
+	}
+	return m.MonitorID
+}
+
+func getCreated(r dao.Resource) string {
+	m, ok := r.(*AppMonitorResource)
+	if !ok {
+		return ""
+	}
+	return m.Created
+}
+
+func stateStyle(state types.StateEnum) render.Style {
+	switch state {
+	case types.StateEnumActive:
+		return ui.SuccessStyle()
+	case types.StateEnumCreated:
+		return ui.WarningStyle()
+	case types.StateEnumDeleting:
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an app monitor
+func (r *AppMonitorRenderer) RenderDetail(resource dao.Resource) string {
+	m, ok := resource.(*AppMonitorResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("CloudWatch RUM App Monitor", m.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", m.GetName())
+	d.Field("ID", m.MonitorID)
+	d.FieldStyled("State", string(m.State), stateStyle(m.State))
+	if m.Platform != "" {
+		d.Field("Platform", string(m.Platform))
+	}
+	if domains := m.Domains(); len(domains) > 0 {
+		d.Field("Domains", strings.Join(domains, ", "))
+	}
+
+	if cfg := m.Config(); cfg != nil {
+		d.Section("Collection")
+		d.Field("Session Sample Rate", fmt.Sprintf("%.0f%%", cfg.SessionSampleRate*100))
+		d.Field("Telemetries", joinTelemetries(cfg.Telemetries))
+		d.Field("Cookies", enabled(appaws.Bool(cfg.AllowCookies)))
+		d.Field("X-Ray Tracing", enabled(appaws.Bool(cfg.EnableXRay)))
+		if m.Detail.CustomEvents != nil && m.Detail.CustomEvents.Status != "" {
+			d.Field("Custom Events", string(m.Detail.CustomEvents.Status))
+		}
+		d.FieldIf("Identity Pool", cfg.IdentityPoolId)
+		d.FieldIf("Guest Role", cfg.GuestRoleArn)
+	}
+
+	if lg := m.LogGroup(); lg != "" {
+		d.Section("Data Storage")
+		d.Field("Log Group", lg)
+	}
+
+	if len(m.Metrics) > 0 {
+		d.Section("Activity (last 24h)")
+		for _, metric := range m.Metrics {
+			value := fmt.Sprintf("%.0f", metric.Value)
+			if metric.IsError() && metric.Value > 0 {
+				d.FieldStyled(metric.Label, value, ui.WarningStyle())
+			} else {
+				d.Field(metric.Label, value)
+			}
+		}
+		if rate, ok := m.ErrorsPerSession(); ok {
+			d.Field("Errors per Session", fmt.Sprintf("%.2f", rate))
+		}
+	}
+
+	d.Section("Timestamps")
+	if m.Created != "" {
+		d.Field("Created", m.Created)
+	}
+	if m.LastModified != "" {
+		d.Field("Last Modified", m.LastModified)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an app monitor
+func (r *AppMonitorRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	m, ok := resource.(*AppMonitorResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: m.GetName()},
+		{Label: "State", Value: string(m.State), Style: stateStyle(m.State)},
+		{Label: "Platform", Value: string(m.Platform)},
+	}
+	if domains := m.Domains(); len(domains) > 0 {
+		fields = append(fields, render.SummaryField{Label: "Domains", Value: strings.Join(domains, ", ")})
+	}
+	return fields
+}
+
+// MetricSpec shows sessions in the inline metrics column
+func (r *AppMonitorRenderer) MetricSpec() *render.MetricSpec {
+	return &render.MetricSpec{
+		Namespace:     "AWS/RUM",
+		MetricName:    "SessionCount",
+		DimensionName: "application_name",
+		Stat:          "Sum",
+		ColumnHeader:  "SESSIONS(15m)",
+		Unit:          "",
+	}
+}
+
+func joinTelemetries(ts []types.Telemetry) string {
+	if len(ts) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(ts))
+	for i, t := range ts {
+		parts[i] = string(t)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func enabled(b bool) string {
+	if b {
+		return "Enabled"
+	}
+	return "Disabled"
+}
@@ -0,0 +1,117 @@
+package archivedobjects
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// defaultRestoreDays is how long a restored copy is kept when no days are given.
+const defaultRestoreDays = 7
+
+func init() {
+	action.Global.Register("s3", "archived-objects", []action.Action{
+		{
+			Name:      "Restore",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RestoreObject",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				obj, ok := dao.UnwrapResource(r).(*ArchivedObjectResource)
+				return ok && !obj.Restoring
+			},
+			Input: &action.InputSpec{
+				Label:       "Days to keep the copy [tier: Standard|Bulk|Expedited]",
+				Placeholder: "7 Standard",
+				Optional:    true,
+			},
+		},
+	})
+
+	action.RegisterExecutor("s3", "archived-objects", executeArchivedObjectAction)
+}
+
+func executeArchivedObjectAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RestoreObject":
+		return executeRestore(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeRestore(ctx context.Context, resource dao.Resource) action.ActionResult {
+	obj, ok := dao.UnwrapResource(resource).(*ArchivedObjectResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	days, tier, err := ParseRestoreRequest(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+	if tier == types.TierExpedited && obj.StorageClass == string(types.ObjectStorageClassDeepArchive) {
+		return action.FailResult(fmt.Errorf("expedited retrieval is not available for DEEP_ARCHIVE objects"))
+	}
+
+	client, _, err := apps3.GetClientForBucket(ctx, obj.Bucket)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	// Restoring an already restored object extends its expiry instead.
+	if _, err := client.RestoreObject(ctx, &s3.RestoreObjectInput{
+		Bucket: &obj.Bucket,
+		Key:    &obj.Key,
+		RestoreRequest: &types.RestoreRequest{
+			Days:                 &days,
+			GlacierJobParameters: &types.GlacierJobParameters{Tier: tier},
+		},
+	}); err != nil {
+		return action.FailResultf(err, "restore s3://%s/%s", obj.Bucket, obj.Key)
+	}
+
+	if obj.Restored() {
+		return action.SuccessResult(fmt.Sprintf("Extended restored copy of %s to %d days", obj.Key, days))
+	}
+	return action.SuccessResult(fmt.Sprintf("Started %s restore of %s for %d days", tier, obj.Key, days))
+}
+
+// ParseRestoreRequest parses "[DAYS] [TIER]", defaulting to 7 days at the
+// Standard tier.
+func ParseRestoreRequest(input string) (int32, types.Tier, error) {
+	days := int32(defaultRestoreDays)
+	tier := types.TierStandard
+
+	fields := strings.Fields(input)
+	if len(fields) > 2 {
+		return 0, "", fmt.Errorf("expected DAYS [TIER], got %q", input)
+	}
+	for _, field := range fields {
+		if n, err := strconv.ParseInt(field, 10, 32); err == nil {
+			if n < 1 {
+				return 0, "", fmt.Errorf("invalid days %d: must be at least 1", n)
+			}
+			days = int32(n)
+			continue
+		}
+		idx := slices.IndexFunc(tier.Values(), func(t types.Tier) bool {
+			return strings.EqualFold(string(t), field)
+		})
+		if idx < 0 {
+			return 0, "", fmt.Errorf("unknown tier %q (use Standard, Bulk, or Expedited)", field)
+		}
+		tier = tier.Values()[idx]
+	}
+	return days, tier, nil
+}
@@ -0,0 +1,224 @@
+package archivedobjects
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// listPageMax is the ListObjectsV2 page size limit.
+	listPageMax = 1000
+
+	// scanPages bounds how many object pages one ListPage call scans looking
+	// for archived objects, so mostly-hot buckets still return promptly.
+	scanPages = 5
+)
+
+// ArchivedObjectDAO provides data access for archived objects in a bucket
+type ArchivedObjectDAO struct {
+	dao.BaseDAO
+}
+
+// NewArchivedObjectDAO creates a new ArchivedObjectDAO
+func NewArchivedObjectDAO(ctx context.Context) (dao.DAO, error) {
+	return &ArchivedObjectDAO{
+		BaseDAO: dao.NewBaseDAO("s3", "archived-objects"),
+	}, nil
+}
+
+// List returns all archived objects in the bucket
+func (d *ArchivedObjectDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var resources []dao.Resource
+	token := ""
+	for {
+		page, next, err := d.ListPage(ctx, listPageMax, token)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, page...)
+		if next == "" {
+			return resources, nil
+		}
+		token = next
+	}
+}
+
+// ListPage scans the bucket's objects and returns those in archive storage
+// classes or with a restore, along with their restore status. With the
+// RestoresOnly toggle, only objects being or already restored are returned.
+func (d *ArchivedObjectDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	bucket := dao.GetFilterFromContext(ctx, "BucketName")
+	if bucket == "" {
+		return nil, "", fmt.Errorf("navigate from s3/buckets using 'a' key")
+	}
+	restoresOnly := dao.GetFilterFromContext(ctx, "RestoresOnly") == "true"
+
+	client, _, err := apps3.GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, "", err
+	}
+
+	maxKeys := int32(min(max(pageSize, 1), listPageMax))
+	var resources []dao.Resource
+	token := pageToken
+	for range scanPages {
+		input := &s3.ListObjectsV2Input{
+			Bucket:                   &bucket,
+			MaxKeys:                  &maxKeys,
+			OptionalObjectAttributes: []types.OptionalObjectAttributes{types.OptionalObjectAttributesRestoreStatus},
+		}
+		if token != "" {
+			input.ContinuationToken = &token
+		}
+		output, err := client.ListObjectsV2(ctx, input)
+		if err != nil {
+			return nil, "", apperrors.Wrapf(err, "list objects in %s", bucket)
+		}
+
+		for _, obj := range output.Contents {
+			if !IsArchived(obj) || (restoresOnly && obj.RestoreStatus == nil) {
+				continue
+			}
+			resources = append(resources, NewArchivedObjectResource(bucket, obj))
+		}
+
+		token = appaws.Str(output.NextContinuationToken)
+		if token == "" || len(resources) >= pageSize {
+			break
+		}
+	}
+	return resources, token, nil
+}
+
+// Get returns an object with its current restore status
+func (d *ArchivedObjectDAO) Get(ctx context.Context, key string) (dao.Resource, error) {
+	bucket := dao.GetFilterFromContext(ctx, "BucketName")
+	if bucket == "" {
+		return nil, fmt.Errorf("navigate from s3/buckets using 'a' key")
+	}
+
+	client, _, err := apps3.GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	output, err := client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: &bucket, Key: &key})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "head object s3://%s/%s", bucket, key)
+	}
+	return NewArchivedObjectResourceFromHead(bucket, key, output), nil
+}
+
+// Delete is not supported; archived objects are managed from the bucket
+func (d *ArchivedObjectDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for archived objects")
+}
+
+// IsArchived reports whether an object must be restored before it can be
+// read: it is in Glacier Flexible Retrieval or Deep Archive, or it carries
+// a restore status (e.g. Intelligent-Tiering archive access tiers).
+func IsArchived(obj types.Object) bool {
+	switch obj.StorageClass {
+	case types.ObjectStorageClassGlacier, types.ObjectStorageClassDeepArchive:
+		return true
+	}
+	return obj.RestoreStatus != nil
+}
+
+// ArchivedObjectResource represents an archived S3 object and its restore status
+type ArchivedObjectResource struct {
+	dao.BaseResource
+	Bucket        string
+	Key           string
+	StorageClass  string
+	ArchiveStatus string // Intelligent-Tiering archive tier, from HeadObject
+	Size          int64
+	LastModified  time.Time
+	Restoring     bool
+	RestoreExpiry time.Time // Set once a restored copy is available
+}
+
+// NewArchivedObjectResource creates an ArchivedObjectResource from a listing
+func NewArchivedObjectResource(bucket string, obj types.Object) *ArchivedObjectResource {
+	r := newResource(bucket, appaws.Str(obj.Key), obj)
+	r.StorageClass = string(obj.StorageClass)
+	r.Size = appaws.Int64(obj.Size)
+	r.LastModified = appaws.Time(obj.LastModified)
+	if rs := obj.RestoreStatus; rs != nil {
+		r.Restoring = appaws.Bool(rs.IsRestoreInProgress)
+		r.RestoreExpiry = appaws.Time(rs.RestoreExpiryDate)
+	}
+	return r
+}
+
+// NewArchivedObjectResourceFromHead creates an ArchivedObjectResource from HeadObject
+func NewArchivedObjectResourceFromHead(bucket, key string, output *s3.HeadObjectOutput) *ArchivedObjectResource {
+	r := newResource(bucket, key, output)
+	r.StorageClass = string(output.StorageClass)
+	if r.StorageClass == "" {
+		r.StorageClass = string(types.ObjectStorageClassStandard)
+	}
+	r.ArchiveStatus = string(output.ArchiveStatus)
+	r.Size = appaws.Int64(output.ContentLength)
+	r.LastModified = appaws.Time(output.LastModified)
+	r.Restoring, r.RestoreExpiry = ParseRestoreHeader(appaws.Str(output.Restore))
+	return r
+}
+
+func newResource(bucket, key string, data any) *ArchivedObjectResource {
+	return &ArchivedObjectResource{
+		BaseResource: dao.BaseResource{
+			ID:   key,
+			Name: key,
+			Tags: make(map[string]string),
+			Data: data,
+		},
+		Bucket: bucket,
+		Key:    key,
+	}
+}
+
+var restoreHeaderPattern = regexp.MustCompile(`(ongoing-request|expiry-date)="([^"]*)"`)
+
+// ParseRestoreHeader parses the x-amz-restore header, e.g.
+// `ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`.
+func ParseRestoreHeader(header string) (restoring bool, expiry time.Time) {
+	for _, m := range restoreHeaderPattern.FindAllStringSubmatch(header, -1) {
+		switch m[1] {
+		case "ongoing-request":
+			restoring = strings.EqualFold(m[2], "true")
+		case "expiry-date":
+			if t, err := time.Parse(time.RFC1123, m[2]); err == nil {
+				expiry = t
+			}
+		}
+	}
+	return restoring, expiry
+}
+
+// Restored reports whether a temporary restored copy is available
+func (r *ArchivedObjectResource) Restored() bool {
+	return !r.Restoring && !r.RestoreExpiry.IsZero()
+}
+
+// RestoreState returns Restoring, Restored, or Archived
+func (r *ArchivedObjectResource) RestoreState() string {
+	switch {
+	case r.Restoring:
+		return "Restoring"
+	case r.Restored():
+		return "Restored"
+	default:
+		return "Archived"
+	}
+}
@@ -0,0 +1,106 @@
+package archivedobjects
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestIsArchived(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  types.Object
+		want bool
+	}{
+		{"glacier", types.Object{StorageClass: types.ObjectStorageClassGlacier}, true},
+		{"deep archive", types.Object{StorageClass: types.ObjectStorageClassDeepArchive}, true},
+		{"glacier instant retrieval", types.Object{StorageClass: types.ObjectStorageClassGlacierIr}, false},
+		{"standard", types.Object{StorageClass: types.ObjectStorageClassStandard}, false},
+		{"intelligent tiering with restore", types.Object{
+			StorageClass:  types.ObjectStorageClassIntelligentTiering,
+			RestoreStatus: &types.RestoreStatus{IsRestoreInProgress: aws.Bool(true)},
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsArchived(tt.obj); got != tt.want {
+				t.Errorf("IsArchived() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewArchivedObjectResource_RestoreState(t *testing.T) {
+	expiry := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		status *types.RestoreStatus
+		want   string
+	}{
+		{"not restored", nil, "Archived"},
+		{"in progress", &types.RestoreStatus{IsRestoreInProgress: aws.Bool(true)}, "Restoring"},
+		{"restored", &types.RestoreStatus{IsRestoreInProgress: aws.Bool(false), RestoreExpiryDate: &expiry}, "Restored"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewArchivedObjectResource("bucket", types.Object{
+				Key:           aws.String("logs/2020.gz"),
+				StorageClass:  types.ObjectStorageClassGlacier,
+				RestoreStatus: tt.status,
+			})
+			if got := r.RestoreState(); got != tt.want {
+				t.Errorf("RestoreState() = %q, want %q", got, tt.want)
+			}
+			if r.GetID() != "logs/2020.gz" || r.Bucket != "bucket" {
+				t.Errorf("resource = %s in %s", r.GetID(), r.Bucket)
+			}
+		})
+	}
+}
+
+func TestParseRestoreHeader(t *testing.T) {
+	restoring, expiry := ParseRestoreHeader(`ongoing-request="true"`)
+	if !restoring || !expiry.IsZero() {
+		t.Errorf("ParseRestoreHeader(ongoing) = %v, %v", restoring, expiry)
+	}
+
+	restoring, expiry = ParseRestoreHeader(`ongoing-request="false", expiry-date="Fri, 21 Dec 2012 00:00:00 GMT"`)
+	if restoring || expiry.Year() != 2012 || expiry.Month() != time.December || expiry.Day() != 21 {
+		t.Errorf("ParseRestoreHeader(done) = %v, %v", restoring, expiry)
+	}
+
+	if restoring, expiry = ParseRestoreHeader(""); restoring || !expiry.IsZero() {
+		t.Errorf("ParseRestoreHeader(empty) = %v, %v", restoring, expiry)
+	}
+}
+
+func TestParseRestoreRequest(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantDays int32
+		wantTier types.Tier
+		wantErr  bool
+	}{
+		{"", 7, types.TierStandard, false},
+		{"3", 3, types.TierStandard, false},
+		{"bulk", 7, types.TierBulk, false},
+		{"14 expedited", 14, types.TierExpedited, false},
+		{"Bulk 2", 2, types.TierBulk, false},
+		{"0", 0, "", true},
+		{"7 fast", 0, "", true},
+		{"1 2 3", 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			days, tier, err := ParseRestoreRequest(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRestoreRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (days != tt.wantDays || tier != tt.wantTier) {
+				t.Errorf("ParseRestoreRequest() = %d, %s, want %d, %s", days, tier, tt.wantDays, tt.wantTier)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package archivedobjects
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ArchivedObjectRenderer renders archived S3 objects and their restores
+type ArchivedObjectRenderer struct {
+	render.BaseRenderer
+}
+
+// NewArchivedObjectRenderer creates a new ArchivedObjectRenderer
+func NewArchivedObjectRenderer() render.Renderer {
+	return &ArchivedObjectRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "s3",
+			Resource: "archived-objects",
+			Cols: []render.Column{
+				{
+					Name:  "KEY",
+					Width: 50,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "CLASS",
+					Width: 19,
+					Getter: func(r dao.Resource) string {
+						if o, ok := r.(*ArchivedObjectResource); ok {
+							return o.StorageClass
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "SIZE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if o, ok := r.(*ArchivedObjectResource); ok {
+							return render.FormatSize(o.Size)
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "RESTORE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if o, ok := r.(*ArchivedObjectResource); ok {
+							return o.RestoreState()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "EXPIRES",
+					Width: 17,
+					Getter: func(r dao.Resource) string {
+						if o, ok := r.(*ArchivedObjectResource); ok && o.Restored() {
+							return o.RestoreExpiry.Local().Format("2006-01-02 15:04")
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "MODIFIED",
+					Width: 9,
+					Getter: func(r dao.Resource) string {
+						if o, ok := r.(*ArchivedObjectResource); ok {
+							return render.FormatAge(o.LastModified)
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed object and restore information
+func (r *ArchivedObjectRenderer) RenderDetail(resource dao.Resource) string {
+	o, ok := resource.(*ArchivedObjectResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Archived Object", o.Key)
+
+	d.Section("Object")
+	d.Field("Bucket", o.Bucket)
+	d.Field("Key", o.Key)
+	d.Field("Storage Class", o.StorageClass)
+	if o.ArchiveStatus != "" {
+		d.Field("Archive Tier", o.ArchiveStatus)
+	}
+	d.Field("Size", render.FormatSize(o.Size))
+	if !o.LastModified.IsZero() {
+		d.Field("Last Modified", o.LastModified.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Restore")
+	switch {
+	case o.Restoring:
+		d.FieldStyled("Status", "Restoring", ui.WarningStyle())
+		d.Dim("Glacier restores take minutes to hours depending on tier; Deep Archive up to 48h.")
+	case o.Restored():
+		d.FieldStyled("Status", "Restored", ui.SuccessStyle())
+		d.Field("Copy Expires", o.RestoreExpiry.Local().Format("2006-01-02 15:04:05"))
+		d.Field("Time Left", render.FormatDuration(time.Until(o.RestoreExpiry)))
+	default:
+		d.Field("Status", "Archived (not restored)")
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ArchivedObjectRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	o, ok := resource.(*ArchivedObjectResource)
+	if !ok {
+		return nil
+	}
+	fields := []render.SummaryField{
+		{Label: "Object", Value: "s3://" + o.Bucket + "/" + o.Key},
+		{Label: "Class", Value: o.StorageClass},
+		{Label: "Restore", Value: o.RestoreState()},
+	}
+	if o.Restored() {
+		fields = append(fields, render.SummaryField{Label: "Expires", Value: o.RestoreExpiry.Local().Format("2006-01-02 15:04")})
+	}
+	return fields
+}
+
+// ListToggles returns the restores-only toggle
+func (r *ArchivedObjectRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "r", ContextKey: "RestoresOnly", LabelOn: "restores only", LabelOff: "all archived"},
+	}
+}
+
+// NeedsAutoReload keeps the list refreshing while any restore is in progress
+func (r *ArchivedObjectRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if o, ok := dao.UnwrapResource(res).(*ArchivedObjectResource); ok && o.Restoring {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package batchjobs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("s3", "batch-jobs", []action.Action{
+		{
+			Name:      "Run",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RunJob",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				job, ok := dao.UnwrapResource(r).(*BatchJobResource)
+				return ok && job.AwaitingConfirmation()
+			},
+		},
+		{
+			Name:      "Cancel",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelJob",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				job, ok := dao.UnwrapResource(r).(*BatchJobResource)
+				return ok && job.CanCancel()
+			},
+		},
+		{
+			Name:      "Set Priority",
+			Shortcut:  "p",
+			Type:      action.ActionTypeAPI,
+			Operation: "UpdateJobPriority",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				job, ok := dao.UnwrapResource(r).(*BatchJobResource)
+				return ok && job.CanCancel()
+			},
+			Input: &action.InputSpec{
+				Label:       "Priority (higher runs first)",
+				Placeholder: "10",
+			},
+		},
+	})
+
+	action.RegisterExecutor("s3", "batch-jobs", executeBatchJobAction)
+}
+
+func executeBatchJobAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RunJob":
+		return executeUpdateStatus(ctx, resource, types.RequestedJobStatusReady, "Confirmed")
+	case "CancelJob":
+		return executeUpdateStatus(ctx, resource, types.RequestedJobStatusCancelled, "Cancelling")
+	case "UpdateJobPriority":
+		return executeUpdatePriority(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeUpdateStatus(ctx context.Context, resource dao.Resource, status types.RequestedJobStatus, verb string) action.ActionResult {
+	job, ok := dao.UnwrapResource(resource).(*BatchJobResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, accountID, err := apps3.GetControlClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	jobID := job.GetID()
+	if _, err := client.UpdateJobStatus(ctx, &s3control.UpdateJobStatusInput{
+		AccountId:          &accountID,
+		JobId:              &jobID,
+		RequestedJobStatus: status,
+	}); err != nil {
+		return action.FailResultf(err, "update batch job %s status", jobID)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("%s batch job %s", verb, jobID))
+}
+
+func executeUpdatePriority(ctx context.Context, resource dao.Resource) action.ActionResult {
+	job, ok := dao.UnwrapResource(resource).(*BatchJobResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	priority, err := parsePriority(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, accountID, err := apps3.GetControlClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	jobID := job.GetID()
+	if _, err := client.UpdateJobPriority(ctx, &s3control.UpdateJobPriorityInput{
+		AccountId: &accountID,
+		JobId:     &jobID,
+		Priority:  priority,
+	}); err != nil {
+		return action.FailResultf(err, "update batch job %s priority", jobID)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Set batch job %s priority to %d", jobID, priority))
+}
+
+func parsePriority(input string) (int32, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(input), 10, 32)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid priority %q: must be a non-negative integer", input)
+	}
+	return int32(n), nil
+}
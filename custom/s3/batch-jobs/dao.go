@@ -0,0 +1,210 @@
+package batchjobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// BatchJobDAO provides data access for S3 Batch Operations jobs
+type BatchJobDAO struct {
+	dao.BaseDAO
+	client    *s3control.Client
+	accountID string
+}
+
+// NewBatchJobDAO creates a new BatchJobDAO
+func NewBatchJobDAO(ctx context.Context) (dao.DAO, error) {
+	client, accountID, err := apps3.GetControlClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &BatchJobDAO{
+		BaseDAO:   dao.NewBaseDAO("s3", "batch-jobs"),
+		client:    client,
+		accountID: accountID,
+	}, nil
+}
+
+// List returns the Batch Operations jobs of the account in the current region
+func (d *BatchJobDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	jobs, err := appaws.Paginate(ctx, func(token *string) ([]types.JobListDescriptor, *string, error) {
+		output, err := d.client.ListJobs(ctx, &s3control.ListJobsInput{
+			AccountId: &d.accountID,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list batch jobs")
+		}
+		return output.Jobs, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(jobs))
+	for i, job := range jobs {
+		resources[i] = NewBatchJobResource(job)
+	}
+	return resources, nil
+}
+
+// Get returns a job with its manifest, operation, and report settings
+func (d *BatchJobDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeJob(ctx, &s3control.DescribeJobInput{
+		AccountId: &d.accountID,
+		JobId:     &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe batch job %s", id)
+	}
+	if output.Job == nil {
+		return nil, fmt.Errorf("batch job not found: %s", id)
+	}
+	return NewBatchJobResourceFromDescriptor(*output.Job), nil
+}
+
+// Delete is not supported; jobs are cancelled and expire after 90 days
+func (d *BatchJobDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for batch jobs, cancel the job instead")
+}
+
+// BatchJobResource wraps an S3 Batch Operations job
+type BatchJobResource struct {
+	dao.BaseResource
+	Status       types.JobStatus
+	Operation    string // e.g. S3PutObjectCopy
+	Priority     int32
+	Description  string
+	Progress     *types.JobProgressSummary
+	CreatedAt    time.Time
+	TerminatedAt time.Time
+
+	// Detail is set by Get
+	Detail *types.JobDescriptor
+}
+
+// NewBatchJobResource creates a BatchJobResource from a ListJobs entry
+func NewBatchJobResource(job types.JobListDescriptor) *BatchJobResource {
+	id := appaws.Str(job.JobId)
+	return &BatchJobResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Tags: make(map[string]string),
+			Data: job,
+		},
+		Status:       job.Status,
+		Operation:    string(job.Operation),
+		Priority:     job.Priority,
+		Description:  appaws.Str(job.Description),
+		Progress:     job.ProgressSummary,
+		CreatedAt:    appaws.Time(job.CreationTime),
+		TerminatedAt: appaws.Time(job.TerminationDate),
+	}
+}
+
+// NewBatchJobResourceFromDescriptor creates a BatchJobResource from DescribeJob
+func NewBatchJobResourceFromDescriptor(job types.JobDescriptor) *BatchJobResource {
+	id := appaws.Str(job.JobId)
+	return &BatchJobResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			ARN:  appaws.Str(job.JobArn),
+			Tags: make(map[string]string),
+			Data: job,
+		},
+		Status:       job.Status,
+		Operation:    OperationName(job.Operation),
+		Priority:     job.Priority,
+		Description:  appaws.Str(job.Description),
+		Progress:     job.ProgressSummary,
+		CreatedAt:    appaws.Time(job.CreationTime),
+		TerminatedAt: appaws.Time(job.TerminationDate),
+		Detail:       &job,
+	}
+}
+
+// OperationName returns the ListJobs-style name of a job operation.
+func OperationName(op *types.JobOperation) string {
+	if op == nil {
+		return ""
+	}
+	switch {
+	case op.S3PutObjectCopy != nil:
+		return string(types.OperationNameS3PutObjectCopy)
+	case op.S3PutObjectTagging != nil:
+		return string(types.OperationNameS3PutObjectTagging)
+	case op.S3InitiateRestoreObject != nil:
+		return string(types.OperationNameS3InitiateRestoreObject)
+	case op.S3DeleteObjectTagging != nil:
+		return string(types.OperationNameS3DeleteObjectTagging)
+	case op.S3PutObjectAcl != nil:
+		return string(types.OperationNameS3PutObjectAcl)
+	case op.S3PutObjectLegalHold != nil:
+		return string(types.OperationNameS3PutObjectLegalHold)
+	case op.S3PutObjectRetention != nil:
+		return string(types.OperationNameS3PutObjectRetention)
+	case op.S3ReplicateObject != nil:
+		return string(types.OperationNameS3ReplicateObject)
+	case op.LambdaInvoke != nil:
+		return string(types.OperationNameLambdaInvoke)
+	default:
+		return ""
+	}
+}
+
+// IsInProgress reports whether the job is moving between states on its own,
+// so the list should keep refreshing.
+func (r *BatchJobResource) IsInProgress() bool {
+	switch r.Status {
+	case types.JobStatusNew, types.JobStatusPreparing, types.JobStatusReady, types.JobStatusActive,
+		types.JobStatusPausing, types.JobStatusCancelling, types.JobStatusCompleting, types.JobStatusFailing:
+		return true
+	}
+	return false
+}
+
+// CanCancel reports whether the job has not finished yet
+func (r *BatchJobResource) CanCancel() bool {
+	switch r.Status {
+	case types.JobStatusNew, types.JobStatusPreparing, types.JobStatusSuspended,
+		types.JobStatusReady, types.JobStatusActive, types.JobStatusPaused:
+		return true
+	}
+	return false
+}
+
+// AwaitingConfirmation reports whether the job waits to be confirmed to run
+func (r *BatchJobResource) AwaitingConfirmation() bool {
+	return r.Status == types.JobStatusSuspended
+}
+
+// Tasks returns succeeded, failed, and total task counts
+func (r *BatchJobResource) Tasks() (succeeded, failed, total int64) {
+	if r.Progress == nil {
+		return 0, 0, 0
+	}
+	return appaws.Int64(r.Progress.NumberOfTasksSucceeded),
+		appaws.Int64(r.Progress.NumberOfTasksFailed),
+		appaws.Int64(r.Progress.TotalNumberOfTasks)
+}
+
+// ProgressText returns "done/total (pct%)", or "" before tasks are known
+func (r *BatchJobResource) ProgressText() string {
+	succeeded, failed, total := r.Tasks()
+	if total == 0 {
+		return ""
+	}
+	done := succeeded + failed
+	return fmt.Sprintf("%d/%d (%d%%)", done, total, done*100/total)
+}
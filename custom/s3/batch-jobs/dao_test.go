@@ -0,0 +1,72 @@
+package batchjobs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+)
+
+func TestBatchJobResource_ProgressText(t *testing.T) {
+	job := &BatchJobResource{Progress: &types.JobProgressSummary{
+		NumberOfTasksSucceeded: aws.Int64(40),
+		NumberOfTasksFailed:    aws.Int64(10),
+		TotalNumberOfTasks:     aws.Int64(200),
+	}}
+	if got := job.ProgressText(); got != "50/200 (25%)" {
+		t.Errorf("ProgressText() = %q, want %q", got, "50/200 (25%)")
+	}
+	if got := (&BatchJobResource{}).ProgressText(); got != "" {
+		t.Errorf("ProgressText() without progress = %q, want empty", got)
+	}
+}
+
+func TestBatchJobResource_StatusPredicates(t *testing.T) {
+	tests := []struct {
+		status     types.JobStatus
+		inProgress bool
+		cancel     bool
+		confirm    bool
+	}{
+		{types.JobStatusActive, true, true, false},
+		{types.JobStatusSuspended, false, true, true},
+		{types.JobStatusComplete, false, false, false},
+		{types.JobStatusCancelling, true, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			job := &BatchJobResource{Status: tt.status}
+			if job.IsInProgress() != tt.inProgress || job.CanCancel() != tt.cancel || job.AwaitingConfirmation() != tt.confirm {
+				t.Errorf("IsInProgress/CanCancel/AwaitingConfirmation = %v/%v/%v",
+					job.IsInProgress(), job.CanCancel(), job.AwaitingConfirmation())
+			}
+		})
+	}
+}
+
+func TestNewBatchJobResourceFromDescriptor(t *testing.T) {
+	job := NewBatchJobResourceFromDescriptor(types.JobDescriptor{
+		JobId:     aws.String("job-1"),
+		JobArn:    aws.String("arn:aws:s3:us-east-1:111122223333:job/job-1"),
+		Status:    types.JobStatusActive,
+		Priority:  5,
+		Operation: &types.JobOperation{S3PutObjectTagging: &types.S3SetObjectTaggingOperation{}},
+	})
+	if job.GetID() != "job-1" || job.Operation != "S3PutObjectTagging" || job.Priority != 5 || job.Detail == nil {
+		t.Errorf("NewBatchJobResourceFromDescriptor() = %+v", job)
+	}
+	if OperationName(nil) != "" {
+		t.Error("OperationName(nil) should be empty")
+	}
+}
+
+func TestParsePriority(t *testing.T) {
+	if n, err := parsePriority(" 42 "); err != nil || n != 42 {
+		t.Errorf("parsePriority() = %d, %v", n, err)
+	}
+	for _, in := range []string{"", "-1", "high"} {
+		if _, err := parsePriority(in); err == nil {
+			t.Errorf("parsePriority(%q) expected error", in)
+		}
+	}
+}
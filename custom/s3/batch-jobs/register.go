@@ -0,0 +1,20 @@
+package batchjobs
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("s3", "batch-jobs", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewBatchJobDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewBatchJobRenderer()
+		},
+	})
+}
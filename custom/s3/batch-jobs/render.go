@@ -0,0 +1,254 @@
+package batchjobs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// BatchJobRenderer renders S3 Batch Operations jobs
+type BatchJobRenderer struct {
+	render.BaseRenderer
+}
+
+// NewBatchJobRenderer creates a new BatchJobRenderer
+func NewBatchJobRenderer() render.Renderer {
+	return &BatchJobRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "s3",
+			Resource: "batch-jobs",
+			Cols: []render.Column{
+				{
+					Name:  "JOB ID",
+					Width: 38,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "OPERATION",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						if j, ok := r.(*BatchJobResource); ok {
+							return j.Operation
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "STATUS",
+					Width: 11,
+					Getter: func(r dao.Resource) string {
+						if j, ok := r.(*BatchJobResource); ok {
+							return string(j.Status)
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "PROGRESS",
+					Width: 22,
+					Getter: func(r dao.Resource) string {
+						if j, ok := r.(*BatchJobResource); ok {
+							return j.ProgressText()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "FAILED",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if j, ok := r.(*BatchJobResource); ok {
+							if _, failed, total := j.Tasks(); total > 0 {
+								return fmt.Sprintf("%d", failed)
+							}
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "PRIORITY",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if j, ok := r.(*BatchJobResource); ok {
+							return fmt.Sprintf("%d", j.Priority)
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+				{
+					Name:  "AGE",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if j, ok := r.(*BatchJobResource); ok {
+							return render.FormatAge(j.CreatedAt)
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed job information
+func (r *BatchJobRenderer) RenderDetail(resource dao.Resource) string {
+	j, ok := resource.(*BatchJobResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("S3 Batch Job", j.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Job ID", j.GetID())
+	if arn := j.GetARN(); arn != "" {
+		d.Field("ARN", arn)
+	}
+	d.FieldStyled("Status", string(j.Status), statusStyle(j.Status))
+	d.Field("Operation", j.Operation)
+	d.Field("Priority", fmt.Sprintf("%d", j.Priority))
+	if j.Description != "" {
+		d.Field("Description", j.Description)
+	}
+
+	d.Section("Progress")
+	succeeded, failed, total := j.Tasks()
+	if total > 0 {
+		d.Field("Tasks", j.ProgressText())
+		d.Field("Succeeded", fmt.Sprintf("%d", succeeded))
+		if failed > 0 {
+			d.FieldStyled("Failed", fmt.Sprintf("%d", failed), ui.DangerStyle())
+		} else {
+			d.Field("Failed", "0")
+		}
+	} else {
+		d.Field("Tasks", render.NoValue)
+	}
+	if j.Progress != nil && j.Progress.Timers != nil {
+		if secs := appaws.Int64(j.Progress.Timers.ElapsedTimeInActiveSeconds); secs > 0 {
+			d.Field("Active Time", (time.Duration(secs) * time.Second).String())
+		}
+	}
+	if !j.CreatedAt.IsZero() {
+		d.Field("Created", j.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+	if !j.TerminatedAt.IsZero() {
+		d.Field("Terminated", j.TerminatedAt.Format("2006-01-02 15:04:05"))
+	}
+
+	if job := j.Detail; job != nil {
+		if reason := appaws.Str(job.StatusUpdateReason); reason != "" {
+			d.Field("Status Reason", reason)
+		}
+		if cause := appaws.Str(job.SuspendedCause); cause != "" {
+			d.Field("Suspended Cause", cause)
+		}
+		for _, f := range job.FailureReasons {
+			d.FieldStyled(appaws.Str(f.FailureCode), appaws.Str(f.FailureReason), ui.DangerStyle())
+		}
+
+		renderOperation(d, job.Operation)
+
+		d.Section("Manifest & Report")
+		if m := job.Manifest; m != nil && m.Location != nil {
+			d.Field("Manifest", appaws.Str(m.Location.ObjectArn))
+			d.FieldIf("Manifest ETag", m.Location.ETag)
+		}
+		d.FieldIf("Role", job.RoleArn)
+		if rep := job.Report; rep != nil && rep.Enabled {
+			d.Field("Report", fmt.Sprintf("%s/%s (%s)", appaws.Str(rep.Bucket), appaws.Str(rep.Prefix), rep.ReportScope))
+		} else {
+			d.Field("Report", "Disabled")
+		}
+	}
+
+	return d.String()
+}
+
+// renderOperation renders the settings of the job's operation.
+func renderOperation(d *render.DetailBuilder, op *types.JobOperation) {
+	if op == nil {
+		return
+	}
+	d.Section("Operation")
+	switch {
+	case op.S3PutObjectCopy != nil:
+		c := op.S3PutObjectCopy
+		d.Field("Copy To", appaws.Str(c.TargetResource))
+		d.FieldIf("Key Prefix", c.TargetKeyPrefix)
+		if c.StorageClass != "" {
+			d.Field("Storage Class", string(c.StorageClass))
+		}
+	case op.S3PutObjectTagging != nil:
+		tags := make([]string, 0, len(op.S3PutObjectTagging.TagSet))
+		for _, t := range op.S3PutObjectTagging.TagSet {
+			tags = append(tags, appaws.Str(t.Key)+"="+appaws.Str(t.Value))
+		}
+		d.Field("Replace Tags With", strings.Join(tags, ", "))
+	case op.S3InitiateRestoreObject != nil:
+		rs := op.S3InitiateRestoreObject
+		d.Field("Restore For", fmt.Sprintf("%d days", appaws.Int32(rs.ExpirationInDays)))
+		d.Field("Tier", string(rs.GlacierJobTier))
+	default:
+		d.Field("Type", OperationName(op))
+	}
+}
+
+func statusStyle(status types.JobStatus) render.Style {
+	switch status {
+	case types.JobStatusComplete:
+		return ui.SuccessStyle()
+	case types.JobStatusFailed, types.JobStatusFailing:
+		return ui.DangerStyle()
+	case types.JobStatusSuspended, types.JobStatusPaused, types.JobStatusCancelled:
+		return ui.WarningStyle()
+	default:
+		return ui.NoStyle()
+	}
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *BatchJobRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	j, ok := resource.(*BatchJobResource)
+	if !ok {
+		return nil
+	}
+	fields := []render.SummaryField{
+		{Label: "Job", Value: j.GetID()},
+		{Label: "Operation", Value: j.Operation},
+		{Label: "Status", Value: string(j.Status), Style: statusStyle(j.Status)},
+	}
+	if progress := j.ProgressText(); progress != "" {
+		fields = append(fields, render.SummaryField{Label: "Progress", Value: progress})
+	}
+	return fields
+}
+
+// NeedsAutoReload keeps the list refreshing while any job is running so
+// task counts advance live.
+func (r *BatchJobRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if j, ok := dao.UnwrapResource(res).(*BatchJobResource); ok && j.IsInProgress() {
+			return true
+		}
+	}
+	return false
+}
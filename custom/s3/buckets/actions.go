@@ -0,0 +1,112 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	s3ctypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("s3", "buckets", []action.Action{
+		{
+			Name:      "Create Batch Job",
+			Shortcut:  "B",
+			Type:      action.ActionTypeAPI,
+			Operation: "CreateBatchJob",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label: "copy|tag|restore manifest=KEY role=ROLE " +
+					"[to=BUCKET/PREFIX] [tags=K=V,...] [days=N tier=STANDARD|BULK] [report=PREFIX|off] [priority=N]",
+				Placeholder: "copy manifest=lists/keys.csv role=BatchOpsRole to=archive-bucket/2024/",
+			},
+		},
+	})
+
+	action.RegisterExecutor("s3", "buckets", executeBucketAction)
+}
+
+func executeBucketAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CreateBatchJob":
+		return executeCreateBatchJob(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeCreateBatchJob(ctx context.Context, resource dao.Resource) action.ActionResult {
+	b, ok := dao.UnwrapResource(resource).(*BucketResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	spec, err := ParseBatchJobSpec(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	// Jobs run in the region they are created in and must read the manifest
+	// from a bucket in that region.
+	if region := appaws.CurrentRegion(ctx); b.Region != "" && b.Region != region {
+		return action.FailResult(fmt.Errorf("bucket %s is in %s: switch to that region to run batch jobs on its manifests", b.BucketName, b.Region))
+	}
+
+	s3Client, err := apps3.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	head, err := s3Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: &b.BucketName,
+		Key:    &spec.ManifestKey,
+	})
+	if err != nil {
+		return action.FailResultf(err, "read manifest s3://%s/%s", b.BucketName, spec.ManifestKey)
+	}
+
+	client, accountID, err := apps3.GetControlClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	partition := appaws.PartitionForRegion(appaws.CurrentRegion(ctx))
+	manifestARN := fmt.Sprintf("%s/%s", bucketARN(partition, b.BucketName), spec.ManifestKey)
+	roleARN := spec.RoleARN(partition, accountID)
+	operation := spec.JobOperation(partition)
+	description := fmt.Sprintf("%s from s3://%s/%s", spec.Operation, b.BucketName, spec.ManifestKey)
+
+	output, err := client.CreateJob(ctx, &s3control.CreateJobInput{
+		AccountId: &accountID,
+		Operation: &operation,
+		Manifest: &s3ctypes.JobManifest{
+			Spec: &s3ctypes.JobManifestSpec{
+				Format: s3ctypes.JobManifestFormatS3BatchOperationsCsv20180820,
+				Fields: []s3ctypes.JobManifestFieldName{
+					s3ctypes.JobManifestFieldNameBucket,
+					s3ctypes.JobManifestFieldNameKey,
+				},
+			},
+			Location: &s3ctypes.JobManifestLocation{
+				ObjectArn: &manifestARN,
+				ETag:      head.ETag,
+			},
+		},
+		Report:               spec.Report(partition, b.BucketName),
+		RoleArn:              &roleARN,
+		Priority:             &spec.Priority,
+		Description:          &description,
+		ConfirmationRequired: appaws.BoolPtr(false),
+	})
+	if err != nil {
+		return action.FailResultf(err, "create batch job")
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Created batch job %s (%s); track it in s3/batch-jobs", appaws.Str(output.JobId), description))
+}
@@ -0,0 +1,396 @@
+package buckets
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// Grantee URIs of the predefined S3 groups that make an ACL grant public.
+const (
+	allUsersURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsersURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+// restrictingConditionKeys are condition keys that pin a statement to a
+// known network, account, or organization. A wildcard-principal statement
+// carrying any of them is not considered public, mirroring how S3 itself
+// evaluates policy status, unless the condition matches any value (see
+// restrictsCaller).
+var restrictingConditionKeys = []string{
+	"aws:principalaccount",
+	"aws:principalarn",
+	"aws:principalorgid",
+	"aws:principalorgpaths",
+	"aws:sourcearn",
+	"aws:sourceaccount",
+	"aws:sourceip",
+	"aws:sourceorgid",
+	"aws:sourceowner",
+	"aws:sourcevpc",
+	"aws:sourcevpce",
+	"aws:userid",
+	"s3:dataaccesspointaccount",
+	"s3:dataaccesspointarn",
+}
+
+// PolicyStatement is one bucket policy statement flattened for display.
+type PolicyStatement struct {
+	Sid             string
+	Effect          string
+	Principals      []string // "*" or "AWS:arn", "Service:name", ...
+	Actions         []string
+	Resources       []string
+	Conditions      []string            // "Operator key", sorted
+	ConditionValues map[string][]string // Values of each Conditions entry
+	Not             []string            // Names of NotPrincipal/NotAction/NotResource elements used
+}
+
+// stringList decodes IAM policy values that may be a string or a list.
+type stringList []string
+
+func (s *stringList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = []string{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// ParsePolicy parses a bucket policy document into its statements.
+func ParsePolicy(doc string) ([]PolicyStatement, error) {
+	var policy struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(doc), &policy); err != nil {
+		return nil, fmt.Errorf("parse bucket policy: %w", err)
+	}
+
+	type rawStatement struct {
+		Sid          string                                `json:"Sid"`
+		Effect       string                                `json:"Effect"`
+		Principal    json.RawMessage                       `json:"Principal"`
+		NotPrincipal json.RawMessage                       `json:"NotPrincipal"`
+		Action       stringList                            `json:"Action"`
+		NotAction    stringList                            `json:"NotAction"`
+		Resource     stringList                            `json:"Resource"`
+		NotResource  stringList                            `json:"NotResource"`
+		Condition    map[string]map[string]json.RawMessage `json:"Condition"`
+	}
+
+	// Statement may be a single object or a list.
+	var raws []rawStatement
+	if err := json.Unmarshal(policy.Statement, &raws); err != nil {
+		var single rawStatement
+		if err := json.Unmarshal(policy.Statement, &single); err != nil {
+			return nil, fmt.Errorf("parse bucket policy statements: %w", err)
+		}
+		raws = []rawStatement{single}
+	}
+
+	statements := make([]PolicyStatement, 0, len(raws))
+	for _, raw := range raws {
+		stmt := PolicyStatement{
+			Sid:       raw.Sid,
+			Effect:    raw.Effect,
+			Actions:   raw.Action,
+			Resources: raw.Resource,
+		}
+
+		principals, err := parsePrincipal(raw.Principal)
+		if err != nil {
+			return nil, err
+		}
+		stmt.Principals = principals
+
+		if len(raw.NotPrincipal) > 0 {
+			stmt.Not = append(stmt.Not, "NotPrincipal")
+		}
+		if len(raw.NotAction) > 0 {
+			stmt.Not = append(stmt.Not, "NotAction")
+			stmt.Actions = raw.NotAction
+		}
+		if len(raw.NotResource) > 0 {
+			stmt.Not = append(stmt.Not, "NotResource")
+			stmt.Resources = raw.NotResource
+		}
+
+		for op, keys := range raw.Condition {
+			for key, value := range keys {
+				cond := op + " " + key
+				stmt.Conditions = append(stmt.Conditions, cond)
+				var values stringList
+				if json.Unmarshal(value, &values) == nil {
+					if stmt.ConditionValues == nil {
+						stmt.ConditionValues = make(map[string][]string)
+					}
+					stmt.ConditionValues[cond] = values
+				}
+			}
+		}
+		sort.Strings(stmt.Conditions)
+
+		statements = append(statements, stmt)
+	}
+	return statements, nil
+}
+
+// parsePrincipal flattens a Principal element: "*" or {"AWS": ..., "Service": ...}.
+func parsePrincipal(data json.RawMessage) ([]string, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var wildcard string
+	if err := json.Unmarshal(data, &wildcard); err == nil {
+		return []string{wildcard}, nil
+	}
+	var byType map[string]stringList
+	if err := json.Unmarshal(data, &byType); err != nil {
+		return nil, fmt.Errorf("parse policy principal: %w", err)
+	}
+	var principals []string
+	for typ, values := range byType {
+		for _, v := range values {
+			if v == "*" && typ == "AWS" {
+				principals = append(principals, "*")
+				continue
+			}
+			principals = append(principals, typ+":"+v)
+		}
+	}
+	sort.Strings(principals)
+	return principals, nil
+}
+
+// IsPublic reports whether the statement allows anyone: an Allow with a
+// wildcard principal (or NotPrincipal) and no condition that restricts the
+// caller to a known source or account.
+func (s PolicyStatement) IsPublic() bool {
+	if s.Effect != "Allow" {
+		return false
+	}
+	if !slices.Contains(s.Principals, "*") && !slices.Contains(s.Not, "NotPrincipal") {
+		return false
+	}
+	for _, cond := range s.Conditions {
+		values, known := s.ConditionValues[cond]
+		if restrictsCaller(cond, values, known) {
+			return false
+		}
+	}
+	return true
+}
+
+// restrictsCaller reports whether cond ("Operator key") pins the caller to a
+// known source or account. Negated and IfExists operators let unknown callers
+// through, and so does any wildcard value, e.g. aws:SourceIp 0.0.0.0/0 or
+// aws:PrincipalOrgID "*". Conditions whose values weren't decoded are taken
+// as restricting.
+func restrictsCaller(cond string, values []string, known bool) bool {
+	op, key, _ := strings.Cut(cond, " ")
+	if !slices.Contains(restrictingConditionKeys, strings.ToLower(key)) {
+		return false
+	}
+	op = strings.ToLower(op)
+	if strings.Contains(op, "not") || strings.HasSuffix(op, "ifexists") {
+		return false
+	}
+	if !known {
+		return true
+	}
+	for _, v := range values {
+		if isWildcardConditionValue(v) {
+			return false
+		}
+	}
+	return len(values) > 0
+}
+
+// isWildcardConditionValue reports whether v matches any caller.
+func isWildcardConditionValue(v string) bool {
+	switch strings.TrimSpace(v) {
+	case "*", "0.0.0.0/0", "::/0":
+		return true
+	}
+	return false
+}
+
+// Label returns the Sid, or a positional fallback for statements without one.
+func (s PolicyStatement) Label(index int) string {
+	if s.Sid != "" {
+		return s.Sid
+	}
+	return fmt.Sprintf("Statement %d", index+1)
+}
+
+// ACLGrant is one bucket ACL grant.
+type ACLGrant struct {
+	Grantee    string
+	Permission string
+	Public     bool
+}
+
+// NewACLGrant describes a grant, flagging grants to the AllUsers and
+// AuthenticatedUsers groups (any AWS account) as public.
+func NewACLGrant(g types.Grant) ACLGrant {
+	grant := ACLGrant{Permission: string(g.Permission)}
+	if g.Grantee == nil {
+		return grant
+	}
+	switch uri := appaws.Str(g.Grantee.URI); uri {
+	case allUsersURI:
+		grant.Grantee = "Everyone (AllUsers)"
+		grant.Public = true
+	case authenticatedUsersURI:
+		grant.Grantee = "Any AWS account (AuthenticatedUsers)"
+		grant.Public = true
+	case "":
+		switch {
+		case g.Grantee.DisplayName != nil:
+			grant.Grantee = *g.Grantee.DisplayName
+		case g.Grantee.EmailAddress != nil:
+			grant.Grantee = *g.Grantee.EmailAddress
+		default:
+			grant.Grantee = appaws.Str(g.Grantee.ID)
+		}
+	default:
+		grant.Grantee = appaws.ExtractResourceName(uri)
+	}
+	return grant
+}
+
+// AllBlocked reports whether all four Block Public Access settings are on.
+func (p *PublicAccessBlockInfo) AllBlocked() bool {
+	return p.BlockPublicAcls && p.IgnorePublicAcls && p.BlockPublicPolicy && p.RestrictPublicBuckets
+}
+
+// EnabledCount returns how many of the four settings are on.
+func (p *PublicAccessBlockInfo) EnabledCount() int {
+	n := 0
+	for _, on := range []bool{p.BlockPublicAcls, p.IgnorePublicAcls, p.BlockPublicPolicy, p.RestrictPublicBuckets} {
+		if on {
+			n++
+		}
+	}
+	return n
+}
+
+// PublicExposure returns the reasons the bucket is potentially public, after
+// discounting what its bucket-level Block Public Access settings neutralize.
+// Account-level Block Public Access is not considered. Empty means no public
+// access was found in what could be read.
+func (r *BucketResource) PublicExposure() []string {
+	pab := r.PublicAccessBlock
+	var reasons []string
+
+	// RestrictPublicBuckets ignores public policies; BlockPublicPolicy only
+	// stops new ones from being put.
+	if pab == nil || !pab.RestrictPublicBuckets {
+		for i, stmt := range r.PolicyStatements {
+			if stmt.IsPublic() {
+				reasons = append(reasons, fmt.Sprintf("Bucket policy %q allows any principal", stmt.Label(i)))
+			}
+		}
+		if len(reasons) == 0 && r.PolicyPublic {
+			reasons = append(reasons, "S3 reports the bucket policy as public")
+		}
+	}
+
+	if pab == nil || !pab.IgnorePublicAcls {
+		for _, g := range r.ACLGrants {
+			if g.Public {
+				reasons = append(reasons, fmt.Sprintf("ACL grants %s to %s", g.Permission, g.Grantee))
+			}
+		}
+	}
+
+	return reasons
+}
+
+// PotentiallyPublic reports whether PublicExposure found anything.
+func (r *BucketResource) PotentiallyPublic() bool {
+	return len(r.PublicExposure()) > 0
+}
+
+// LifecycleRuleSummary describes a lifecycle rule in one line, e.g.
+// "logs/: STANDARD_IA after 30d, expire after 365d".
+func LifecycleRuleSummary(rule types.LifecycleRule) string {
+	var parts []string
+	for _, t := range rule.Transitions {
+		parts = append(parts, fmt.Sprintf("%s %s", t.StorageClass, whenText(t.Days, t.Date != nil)))
+	}
+	if e := rule.Expiration; e != nil {
+		switch {
+		case e.Days != nil || e.Date != nil:
+			parts = append(parts, "expire "+whenText(e.Days, e.Date != nil))
+		case appaws.Bool(e.ExpiredObjectDeleteMarker):
+			parts = append(parts, "remove expired delete markers")
+		}
+	}
+	for _, t := range rule.NoncurrentVersionTransitions {
+		parts = append(parts, fmt.Sprintf("noncurrent %s after %dd", t.StorageClass, appaws.Int32(t.NoncurrentDays)))
+	}
+	if e := rule.NoncurrentVersionExpiration; e != nil && e.NoncurrentDays != nil {
+		parts = append(parts, fmt.Sprintf("expire noncurrent after %dd", *e.NoncurrentDays))
+	}
+	if a := rule.AbortIncompleteMultipartUpload; a != nil && a.DaysAfterInitiation != nil {
+		parts = append(parts, fmt.Sprintf("abort uploads after %dd", *a.DaysAfterInitiation))
+	}
+
+	actions := "no actions"
+	if len(parts) > 0 {
+		actions = strings.Join(parts, ", ")
+	}
+	return lifecycleScope(rule) + ": " + actions
+}
+
+func whenText(days *int32, hasDate bool) string {
+	if days != nil {
+		return fmt.Sprintf("after %dd", *days)
+	}
+	if hasDate {
+		return "on date"
+	}
+	return ""
+}
+
+// lifecycleScope describes which objects a rule applies to.
+func lifecycleScope(rule types.LifecycleRule) string {
+	var scope []string
+	prefix := appaws.Str(rule.Prefix) // legacy rules predate Filter
+	if f := rule.Filter; f != nil {
+		if f.Prefix != nil {
+			prefix = *f.Prefix
+		}
+		if f.Tag != nil {
+			scope = append(scope, "tag "+appaws.Str(f.Tag.Key)+"="+appaws.Str(f.Tag.Value))
+		}
+		if and := f.And; and != nil {
+			if and.Prefix != nil {
+				prefix = *and.Prefix
+			}
+			for _, tag := range and.Tags {
+				scope = append(scope, "tag "+appaws.Str(tag.Key)+"="+appaws.Str(tag.Value))
+			}
+		}
+	}
+	if prefix != "" {
+		scope = append([]string{prefix}, scope...)
+	}
+	if len(scope) == 0 {
+		return "all objects"
+	}
+	return strings.Join(scope, " ")
+}
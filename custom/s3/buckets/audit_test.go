@@ -0,0 +1,283 @@
+package buckets
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestParsePolicy(t *testing.T) {
+	doc := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Sid": "PublicRead",
+				"Effect": "Allow",
+				"Principal": "*",
+				"Action": "s3:GetObject",
+				"Resource": "arn:aws:s3:::site/*"
+			},
+			{
+				"Effect": "Deny",
+				"Principal": {"AWS": ["arn:aws:iam::111122223333:root", "*"], "Service": "logging.s3.amazonaws.com"},
+				"NotAction": ["s3:GetObject", "s3:ListBucket"],
+				"Resource": ["arn:aws:s3:::site", "arn:aws:s3:::site/*"],
+				"Condition": {"Bool": {"aws:SecureTransport": "false"}}
+			}
+		]
+	}`
+
+	stmts, err := ParsePolicy(doc)
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	if len(stmts) != 2 {
+		t.Fatalf("ParsePolicy() returned %d statements, want 2", len(stmts))
+	}
+
+	first := stmts[0]
+	if first.Label(0) != "PublicRead" || first.Effect != "Allow" {
+		t.Errorf("first statement = %+v", first)
+	}
+	if !slices.Equal(first.Principals, []string{"*"}) || !slices.Equal(first.Actions, []string{"s3:GetObject"}) {
+		t.Errorf("first statement principals/actions = %v/%v", first.Principals, first.Actions)
+	}
+
+	second := stmts[1]
+	if second.Label(1) != "Statement 2" {
+		t.Errorf("Label() = %q, want %q", second.Label(1), "Statement 2")
+	}
+	wantPrincipals := []string{"*", "AWS:arn:aws:iam::111122223333:root", "Service:logging.s3.amazonaws.com"}
+	if !slices.Equal(second.Principals, wantPrincipals) {
+		t.Errorf("Principals = %v, want %v", second.Principals, wantPrincipals)
+	}
+	if !slices.Equal(second.Not, []string{"NotAction"}) || len(second.Actions) != 2 {
+		t.Errorf("Not/Actions = %v/%v", second.Not, second.Actions)
+	}
+	if !slices.Equal(second.Conditions, []string{"Bool aws:SecureTransport"}) {
+		t.Errorf("Conditions = %v", second.Conditions)
+	}
+}
+
+func TestParsePolicy_SingleStatement(t *testing.T) {
+	stmts, err := ParsePolicy(`{"Statement": {"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "s3:*", "Resource": "*"}}`)
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	if len(stmts) != 1 || !stmts[0].IsPublic() {
+		t.Errorf("ParsePolicy() = %+v, want one public statement", stmts)
+	}
+}
+
+func TestParsePolicy_WildcardCondition(t *testing.T) {
+	doc := `{"Statement": [
+		{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "*",
+		 "Condition": {"IpAddress": {"aws:SourceIp": ["0.0.0.0/0"]}}},
+		{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "*",
+		 "Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-abc123"}}}
+	]}`
+	stmts, err := ParsePolicy(doc)
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+	if !stmts[0].IsPublic() {
+		t.Error("aws:SourceIp 0.0.0.0/0 should not restrict the statement")
+	}
+	if stmts[1].IsPublic() {
+		t.Error("aws:PrincipalOrgID o-abc123 should restrict the statement")
+	}
+	if got := stmts[1].ConditionValues["StringEquals aws:PrincipalOrgID"]; !slices.Equal(got, []string{"o-abc123"}) {
+		t.Errorf("ConditionValues = %v", got)
+	}
+}
+
+func TestParsePolicy_Invalid(t *testing.T) {
+	if _, err := ParsePolicy("not json"); err == nil {
+		t.Error("ParsePolicy() expected error for invalid document")
+	}
+}
+
+func TestPolicyStatement_IsPublic(t *testing.T) {
+	tests := []struct {
+		name string
+		stmt PolicyStatement
+		want bool
+	}{
+		{"wildcard allow", PolicyStatement{Effect: "Allow", Principals: []string{"*"}}, true},
+		{"wildcard deny", PolicyStatement{Effect: "Deny", Principals: []string{"*"}}, false},
+		{"account principal", PolicyStatement{Effect: "Allow", Principals: []string{"AWS:arn:aws:iam::111122223333:root"}}, false},
+		{"not principal", PolicyStatement{Effect: "Allow", Not: []string{"NotPrincipal"}}, true},
+		{"restricted by source vpce", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"StringEquals aws:SourceVpce"},
+		}, false},
+		{"restricted by org", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"StringEquals aws:PrincipalOrgID"},
+		}, false},
+		{"non-restricting condition", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"Bool aws:SecureTransport"},
+		}, true},
+		{"restricted by source ip", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"IpAddress aws:SourceIp"},
+			ConditionValues: map[string][]string{"IpAddress aws:SourceIp": {"203.0.113.0/24"}},
+		}, false},
+		{"any source ip", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"IpAddress aws:SourceIp"},
+			ConditionValues: map[string][]string{"IpAddress aws:SourceIp": {"203.0.113.0/24", "0.0.0.0/0"}},
+		}, true},
+		{"any ipv6 source", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"IpAddress aws:SourceIp"},
+			ConditionValues: map[string][]string{"IpAddress aws:SourceIp": {"::/0"}},
+		}, true},
+		{"any org", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"StringLike aws:PrincipalOrgID"},
+			ConditionValues: map[string][]string{"StringLike aws:PrincipalOrgID": {"*"}},
+		}, true},
+		{"outside an org", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"StringNotEquals aws:PrincipalOrgID"},
+			ConditionValues: map[string][]string{"StringNotEquals aws:PrincipalOrgID": {"o-abc123"}},
+		}, true},
+		{"vpce if present", PolicyStatement{
+			Effect: "Allow", Principals: []string{"*"}, Conditions: []string{"StringEqualsIfExists aws:SourceVpce"},
+			ConditionValues: map[string][]string{"StringEqualsIfExists aws:SourceVpce": {"vpce-1a2b3c4d"}},
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stmt.IsPublic(); got != tt.want {
+				t.Errorf("IsPublic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewACLGrant(t *testing.T) {
+	tests := []struct {
+		name       string
+		grantee    *types.Grantee
+		wantName   string
+		wantPublic bool
+	}{
+		{"all users", &types.Grantee{URI: aws.String(allUsersURI)}, "Everyone (AllUsers)", true},
+		{"authenticated users", &types.Grantee{URI: aws.String(authenticatedUsersURI)}, "Any AWS account (AuthenticatedUsers)", true},
+		{"log delivery", &types.Grantee{URI: aws.String("http://acs.amazonaws.com/groups/s3/LogDelivery")}, "LogDelivery", false},
+		{"canonical user", &types.Grantee{ID: aws.String("abc123"), DisplayName: aws.String("owner")}, "owner", false},
+		{"canonical user without name", &types.Grantee{ID: aws.String("abc123")}, "abc123", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewACLGrant(types.Grant{Grantee: tt.grantee, Permission: types.PermissionRead})
+			if g.Grantee != tt.wantName || g.Public != tt.wantPublic || g.Permission != "READ" {
+				t.Errorf("NewACLGrant() = %+v, want grantee %q public %v", g, tt.wantName, tt.wantPublic)
+			}
+		})
+	}
+}
+
+func TestBucketResource_PublicExposure(t *testing.T) {
+	publicStmt := PolicyStatement{Sid: "Open", Effect: "Allow", Principals: []string{"*"}}
+	publicGrant := ACLGrant{Grantee: "Everyone (AllUsers)", Permission: "READ", Public: true}
+
+	tests := []struct {
+		name string
+		r    *BucketResource
+		want int
+	}{
+		{"nothing public", &BucketResource{
+			PolicyStatements: []PolicyStatement{{Effect: "Allow", Principals: []string{"AWS:arn:aws:iam::1:root"}}},
+			ACLGrants:        []ACLGrant{{Grantee: "owner", Permission: "FULL_CONTROL"}},
+		}, 0},
+		{"public policy and acl", &BucketResource{
+			PolicyStatements: []PolicyStatement{publicStmt},
+			ACLGrants:        []ACLGrant{publicGrant},
+		}, 2},
+		{"restrict public buckets neutralizes policy", &BucketResource{
+			PolicyStatements:  []PolicyStatement{publicStmt},
+			ACLGrants:         []ACLGrant{publicGrant},
+			PublicAccessBlock: &PublicAccessBlockInfo{RestrictPublicBuckets: true},
+		}, 1},
+		{"block public policy alone does not", &BucketResource{
+			PolicyStatements:  []PolicyStatement{publicStmt},
+			PublicAccessBlock: &PublicAccessBlockInfo{BlockPublicPolicy: true},
+		}, 1},
+		{"ignore public acls neutralizes acl", &BucketResource{
+			ACLGrants:         []ACLGrant{publicGrant},
+			PublicAccessBlock: &PublicAccessBlockInfo{IgnorePublicAcls: true},
+		}, 0},
+		{"policy status fallback", &BucketResource{PolicyPublic: true}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.r.PublicExposure()
+			if len(got) != tt.want {
+				t.Errorf("PublicExposure() = %v, want %d reasons", got, tt.want)
+			}
+			if tt.r.PotentiallyPublic() != (tt.want > 0) {
+				t.Errorf("PotentiallyPublic() = %v", tt.r.PotentiallyPublic())
+			}
+		})
+	}
+}
+
+func TestPublicAccessBlockInfo_Counts(t *testing.T) {
+	pab := &PublicAccessBlockInfo{BlockPublicAcls: true, IgnorePublicAcls: true}
+	if pab.AllBlocked() || pab.EnabledCount() != 2 {
+		t.Errorf("AllBlocked()/EnabledCount() = %v/%d", pab.AllBlocked(), pab.EnabledCount())
+	}
+	all := &PublicAccessBlockInfo{true, true, true, true}
+	if !all.AllBlocked() || all.EnabledCount() != 4 {
+		t.Errorf("AllBlocked()/EnabledCount() = %v/%d", all.AllBlocked(), all.EnabledCount())
+	}
+}
+
+func TestLifecycleRuleSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		rule types.LifecycleRule
+		want string
+	}{
+		{
+			name: "transition and expiration with prefix",
+			rule: types.LifecycleRule{
+				Filter: &types.LifecycleRuleFilter{Prefix: aws.String("logs/")},
+				Transitions: []types.Transition{
+					{Days: aws.Int32(30), StorageClass: types.TransitionStorageClassStandardIa},
+				},
+				Expiration: &types.LifecycleExpiration{Days: aws.Int32(365)},
+			},
+			want: "logs/: STANDARD_IA after 30d, expire after 365d",
+		},
+		{
+			name: "noncurrent and multipart for all objects",
+			rule: types.LifecycleRule{
+				NoncurrentVersionExpiration:    &types.NoncurrentVersionExpiration{NoncurrentDays: aws.Int32(30)},
+				AbortIncompleteMultipartUpload: &types.AbortIncompleteMultipartUpload{DaysAfterInitiation: aws.Int32(7)},
+			},
+			want: "all objects: expire noncurrent after 30d, abort uploads after 7d",
+		},
+		{
+			name: "and filter with tags",
+			rule: types.LifecycleRule{
+				Filter: &types.LifecycleRuleFilter{And: &types.LifecycleRuleAndOperator{
+					Prefix: aws.String("tmp/"),
+					Tags:   []types.Tag{{Key: aws.String("ttl"), Value: aws.String("short")}},
+				}},
+				Expiration: &types.LifecycleExpiration{ExpiredObjectDeleteMarker: aws.Bool(true)},
+			},
+			want: "tmp/ tag ttl=short: remove expired delete markers",
+		},
+		{
+			name: "no actions",
+			rule: types.LifecycleRule{},
+			want: "all objects: no actions",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LifecycleRuleSummary(tt.rule); got != tt.want {
+				t.Errorf("LifecycleRuleSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package buckets
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	s3ctypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+)
+
+// Batch job operations offered by the Create Batch Job action.
+const (
+	batchCopy    = "copy"
+	batchTag     = "tag"
+	batchRestore = "restore"
+)
+
+// defaultBatchReportPrefix is where completion reports go unless report= is given.
+const defaultBatchReportPrefix = "batch-reports"
+
+// BatchJobSpec is a parsed Create Batch Job input such as
+// "copy manifest=lists/keys.csv role=BatchOps to=archive/2024/".
+type BatchJobSpec struct {
+	Operation    string
+	ManifestKey  string
+	Role         string // Role name or ARN
+	TargetBucket string // copy
+	TargetPrefix string // copy
+	Tags         []s3ctypes.S3Tag
+	Days         int32                     // restore
+	Tier         s3ctypes.S3GlacierJobTier // restore
+	ReportPrefix string                    // Empty when reports are off
+	Priority     int32
+}
+
+// batchOptions lists the key=value settings each operation accepts besides
+// manifest, role, report, and priority.
+var batchOptions = map[string][]string{
+	batchCopy:    {"to"},
+	batchTag:     {"tags"},
+	batchRestore: {"days", "tier"},
+}
+
+// ParseBatchJobSpec parses "<copy|tag|restore> manifest=KEY role=ROLE ...".
+func ParseBatchJobSpec(input string) (*BatchJobSpec, error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no job given: start with copy, tag, or restore")
+	}
+
+	spec := &BatchJobSpec{
+		Operation:    strings.ToLower(fields[0]),
+		ReportPrefix: defaultBatchReportPrefix,
+		Priority:     10,
+		Days:         7,
+		Tier:         s3ctypes.S3GlacierJobTierStandard,
+	}
+	allowed, ok := batchOptions[spec.Operation]
+	if !ok {
+		return nil, fmt.Errorf("unknown operation %q (use copy, tag, or restore)", fields[0])
+	}
+
+	for _, field := range fields[1:] {
+		key, value, ok := strings.Cut(field, "=")
+		key = strings.ToLower(key)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid setting %q: expected key=value", field)
+		}
+		switch key {
+		case "manifest":
+			spec.ManifestKey = strings.TrimPrefix(value, "/")
+		case "role":
+			spec.Role = value
+		case "report":
+			spec.ReportPrefix = strings.Trim(value, "/")
+			if strings.EqualFold(value, "off") {
+				spec.ReportPrefix = ""
+			}
+		case "priority":
+			n, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid priority %q: must be a non-negative integer", value)
+			}
+			spec.Priority = int32(n)
+		default:
+			if !slices.Contains(allowed, key) {
+				return nil, fmt.Errorf("setting %q does not apply to %s", key, spec.Operation)
+			}
+			if err := spec.setOption(key, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if spec.ManifestKey == "" {
+		return nil, fmt.Errorf("manifest=KEY is required (a CSV of bucket,key in this bucket)")
+	}
+	if spec.Role == "" {
+		return nil, fmt.Errorf("role=ROLE is required (the role Batch Operations assumes)")
+	}
+	switch spec.Operation {
+	case batchCopy:
+		if spec.TargetBucket == "" {
+			return nil, fmt.Errorf("copy needs to=BUCKET[/PREFIX]")
+		}
+	case batchTag:
+		if len(spec.Tags) == 0 {
+			return nil, fmt.Errorf("tag needs tags=KEY=VALUE[,KEY=VALUE]")
+		}
+	}
+	return spec, nil
+}
+
+func (s *BatchJobSpec) setOption(key, value string) error {
+	switch key {
+	case "to":
+		bucket, prefix, _ := strings.Cut(strings.TrimPrefix(value, "s3://"), "/")
+		s.TargetBucket, s.TargetPrefix = bucket, prefix
+	case "tags":
+		for _, pair := range strings.Split(value, ",") {
+			k, v, ok := strings.Cut(pair, "=")
+			if !ok || k == "" {
+				return fmt.Errorf("invalid tag %q: expected KEY=VALUE", pair)
+			}
+			s.Tags = append(s.Tags, s3ctypes.S3Tag{Key: &k, Value: &v})
+		}
+	case "days":
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || n < 1 {
+			return fmt.Errorf("invalid days %q: must be a positive integer", value)
+		}
+		s.Days = int32(n)
+	case "tier":
+		tier := s3ctypes.S3GlacierJobTier(strings.ToUpper(value))
+		if !slices.Contains(tier.Values(), tier) {
+			return fmt.Errorf("unknown tier %q (use STANDARD or BULK)", value)
+		}
+		s.Tier = tier
+	}
+	return nil
+}
+
+// JobOperation returns the Batch Operations operation for the spec.
+// partition is used to build the copy target bucket ARN.
+func (s *BatchJobSpec) JobOperation(partition string) s3ctypes.JobOperation {
+	switch s.Operation {
+	case batchCopy:
+		target := bucketARN(partition, s.TargetBucket)
+		op := &s3ctypes.S3CopyObjectOperation{TargetResource: &target}
+		if s.TargetPrefix != "" {
+			op.TargetKeyPrefix = &s.TargetPrefix
+		}
+		return s3ctypes.JobOperation{S3PutObjectCopy: op}
+	case batchTag:
+		return s3ctypes.JobOperation{S3PutObjectTagging: &s3ctypes.S3SetObjectTaggingOperation{TagSet: s.Tags}}
+	default:
+		return s3ctypes.JobOperation{S3InitiateRestoreObject: &s3ctypes.S3InitiateRestoreObjectOperation{
+			ExpirationInDays: &s.Days,
+			GlacierJobTier:   s.Tier,
+		}}
+	}
+}
+
+// RoleARN returns the role as an ARN, expanding a bare role name.
+func (s *BatchJobSpec) RoleARN(partition, accountID string) string {
+	if strings.HasPrefix(s.Role, "arn:") {
+		return s.Role
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, accountID, s.Role)
+}
+
+// Report returns the completion report settings, written to the manifest
+// bucket so failures can be inspected next to the input.
+func (s *BatchJobSpec) Report(partition, bucket string) *s3ctypes.JobReport {
+	if s.ReportPrefix == "" {
+		return &s3ctypes.JobReport{Enabled: false}
+	}
+	arn := bucketARN(partition, bucket)
+	return &s3ctypes.JobReport{
+		Enabled:     true,
+		Bucket:      &arn,
+		Format:      s3ctypes.JobReportFormatReportCsv20180820,
+		Prefix:      &s.ReportPrefix,
+		ReportScope: s3ctypes.JobReportScopeAllTasks,
+	}
+}
+
+func bucketARN(partition, bucket string) string {
+	return fmt.Sprintf("arn:%s:s3:::%s", partition, bucket)
+}
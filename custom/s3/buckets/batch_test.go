@@ -0,0 +1,84 @@
+package buckets
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3ctypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+)
+
+func TestParseBatchJobSpec(t *testing.T) {
+	spec, err := ParseBatchJobSpec("copy manifest=/lists/keys.csv role=BatchOps to=s3://archive/2024/ priority=20")
+	if err != nil {
+		t.Fatalf("ParseBatchJobSpec() error = %v", err)
+	}
+	if spec.Operation != batchCopy || spec.ManifestKey != "lists/keys.csv" || spec.TargetBucket != "archive" ||
+		spec.TargetPrefix != "2024/" || spec.Priority != 20 || spec.ReportPrefix != defaultBatchReportPrefix {
+		t.Errorf("ParseBatchJobSpec() = %+v", spec)
+	}
+
+	spec, err = ParseBatchJobSpec("tag manifest=m.csv role=r tags=env=prod,team=data report=off")
+	if err != nil {
+		t.Fatalf("ParseBatchJobSpec() error = %v", err)
+	}
+	if len(spec.Tags) != 2 || aws.ToString(spec.Tags[1].Value) != "data" || spec.ReportPrefix != "" {
+		t.Errorf("ParseBatchJobSpec() tags/report = %+v/%q", spec.Tags, spec.ReportPrefix)
+	}
+
+	spec, err = ParseBatchJobSpec("RESTORE manifest=m.csv role=r days=3 tier=bulk")
+	if err != nil {
+		t.Fatalf("ParseBatchJobSpec() error = %v", err)
+	}
+	if spec.Days != 3 || spec.Tier != s3ctypes.S3GlacierJobTierBulk {
+		t.Errorf("ParseBatchJobSpec() restore = %d/%s", spec.Days, spec.Tier)
+	}
+}
+
+func TestParseBatchJobSpec_Errors(t *testing.T) {
+	tests := []string{
+		"",
+		"delete manifest=m.csv role=r",
+		"copy role=r to=b",
+		"copy manifest=m.csv to=b",
+		"copy manifest=m.csv role=r",
+		"tag manifest=m.csv role=r",
+		"tag manifest=m.csv role=r tags=novalue",
+		"restore manifest=m.csv role=r to=b",
+		"restore manifest=m.csv role=r tier=fast",
+		"restore manifest=m.csv role=r days=0",
+		"copy manifest=m.csv role=r to=b priority=-1",
+		"copy manifest",
+	}
+	for _, input := range tests {
+		if _, err := ParseBatchJobSpec(input); err == nil {
+			t.Errorf("ParseBatchJobSpec(%q) expected error", input)
+		}
+	}
+}
+
+func TestBatchJobSpec_ARNs(t *testing.T) {
+	spec := &BatchJobSpec{Operation: batchCopy, Role: "BatchOps", TargetBucket: "archive", ReportPrefix: "reports"}
+
+	if got := spec.RoleARN("aws", "111122223333"); got != "arn:aws:iam::111122223333:role/BatchOps" {
+		t.Errorf("RoleARN() = %q", got)
+	}
+	spec.Role = "arn:aws-cn:iam::111122223333:role/Other"
+	if got := spec.RoleARN("aws", "111122223333"); got != spec.Role {
+		t.Errorf("RoleARN() with ARN = %q", got)
+	}
+
+	op := spec.JobOperation("aws")
+	if op.S3PutObjectCopy == nil || aws.ToString(op.S3PutObjectCopy.TargetResource) != "arn:aws:s3:::archive" ||
+		op.S3PutObjectCopy.TargetKeyPrefix != nil {
+		t.Errorf("JobOperation() = %+v", op.S3PutObjectCopy)
+	}
+
+	report := spec.Report("aws", "source")
+	if !report.Enabled || aws.ToString(report.Bucket) != "arn:aws:s3:::source" || aws.ToString(report.Prefix) != "reports" {
+		t.Errorf("Report() = %+v", report)
+	}
+	spec.ReportPrefix = ""
+	if spec.Report("aws", "source").Enabled {
+		t.Error("Report() should be disabled without a prefix")
+	}
+}
@@ -1,8 +1,10 @@
 package buckets
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -11,8 +13,12 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
+// listPageMax is the largest page requested from ListBuckets.
+const listPageMax = 1000
+
 // BucketDAO provides data access for S3 buckets
 type BucketDAO struct {
 	dao.BaseDAO
@@ -21,44 +27,95 @@ type BucketDAO struct {
 
 // NewBucketDAO creates a new BucketDAO
 func NewBucketDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, s3.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &BucketDAO{
 		BaseDAO: dao.NewBaseDAO("s3", "buckets"),
-		client:  s3.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
+// List returns all buckets with their storage metrics.
+// Supported filters:
+//   - SortBySize: order buckets largest first
 func (d *BucketDAO) List(ctx context.Context) ([]dao.Resource, error) {
-	// Use MaxBuckets parameter to get BucketRegion in the response
-	// This avoids N+1 GetBucketLocation calls
-	buckets, err := appaws.Paginate(ctx, func(token *string) ([]types.Bucket, *string, error) {
-		output, err := d.client.ListBuckets(ctx, &s3.ListBucketsInput{
-			ContinuationToken: token,
-			MaxBuckets:        appaws.Int32Ptr(1000),
-		})
+	var all []dao.Resource
+	token := ""
+	for {
+		page, next, err := d.listPage(ctx, listPageMax, token)
 		if err != nil {
-			return nil, nil, apperrors.Wrap(err, "list buckets")
+			return nil, err
 		}
-		return output.Buckets, output.ContinuationToken, nil
-	})
+		all = append(all, page...)
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	if dao.GetFilterFromContext(ctx, "SortBySize") == "true" {
+		SortBySize(all)
+	}
+	return all, nil
+}
+
+// ListPage returns a page of buckets with their storage metrics, so sizes
+// are only fetched for pages actually viewed. Ordering by size needs every
+// bucket, so with SortBySize on the first page holds them all.
+// Implements dao.PaginatedDAO interface.
+func (d *BucketDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	if dao.GetFilterFromContext(ctx, "SortBySize") == "true" {
+		if pageToken != "" {
+			return nil, "", nil
+		}
+		resources, err := d.List(ctx)
+		return resources, "", err
+	}
+	return d.listPage(ctx, pageSize, pageToken)
+}
+
+// listPage fetches one ListBuckets page and loads its storage metrics.
+func (d *BucketDAO) listPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	// Setting MaxBuckets makes ListBuckets include BucketRegion, which
+	// avoids N+1 GetBucketLocation calls
+	input := &s3.ListBucketsInput{
+		MaxBuckets: appaws.Int32Ptr(int32(min(pageSize, listPageMax))),
+	}
+	if pageToken != "" {
+		input.ContinuationToken = &pageToken
+	}
+	output, err := d.client.ListBuckets(ctx, input)
 	if err != nil {
-		return nil, err
+		return nil, "", apperrors.Wrap(err, "list buckets")
 	}
 
-	resources := make([]dao.Resource, 0, len(buckets))
-	for _, bucket := range buckets {
+	buckets := make([]*BucketResource, len(output.Buckets))
+	resources := make([]dao.Resource, len(output.Buckets))
+	for i, bucket := range output.Buckets {
 		r := NewBucketResource(bucket)
-		// BucketRegion is included when any parameter is set in ListBucketsInput
-		if bucket.BucketRegion != nil {
-			r.Region = *bucket.BucketRegion
-		}
-		resources = append(resources, r)
+		r.Region = appaws.Str(bucket.BucketRegion)
+		buckets[i] = r
+		resources[i] = r
 	}
+	d.loadStorageMetrics(ctx, buckets)
+
+	return resources, appaws.Str(output.ContinuationToken), nil
+}
 
-	return resources, nil
+// SortBySize orders buckets by size descending, then by name.
+func SortBySize(resources []dao.Resource) {
+	slices.SortStableFunc(resources, func(a, b dao.Resource) int {
+		ba, _ := a.(*BucketResource)
+		bb, _ := b.(*BucketResource)
+		if ba == nil || bb == nil {
+			return 0
+		}
+		if c := cmp.Compare(bb.SizeBytes, ba.SizeBytes); c != 0 {
+			return c
+		}
+		return cmp.Compare(ba.BucketName, bb.BucketName)
+	})
 }
 
 func (d *BucketDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
@@ -95,20 +152,19 @@ func (d *BucketDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	d.fetchVersioning(ctx, regionClient, id, resource)
 	d.fetchEncryption(ctx, regionClient, id, resource)
 	d.fetchPublicAccessBlock(ctx, regionClient, id, resource)
+	d.fetchPolicy(ctx, regionClient, id, resource)
+	d.fetchACL(ctx, regionClient, id, resource)
 	d.fetchLifecycle(ctx, regionClient, id, resource)
 	d.fetchObjectLock(ctx, regionClient, id, resource)
 	d.fetchTags(ctx, regionClient, id, resource)
+	resource.Audited = true
 
 	return resource, nil
 }
 
 // getRegionClient creates an S3 client for the specified region
 func (d *BucketDAO) getRegionClient(ctx context.Context, region string) (*s3.Client, error) {
-	cfg, err := appaws.NewConfigWithRegion(ctx, region)
-	if err != nil {
-		return nil, err
-	}
-	return s3.NewFromConfig(cfg), nil
+	return appaws.ClientWithRegion(ctx, region, s3.NewFromConfig)
 }
 
 // fetchVersioning fetches bucket versioning configuration
@@ -179,9 +235,58 @@ func (d *BucketDAO) fetchLifecycle(ctx context.Context, client *s3.Client, bucke
 	if err != nil {
 		return
 	}
+	r.LifecycleRules = output.Rules
 	r.LifecycleRulesCount = len(output.Rules)
 }
 
+// fetchPolicy fetches and parses the bucket policy along with S3's own
+// public/not-public verdict for it
+func (d *BucketDAO) fetchPolicy(ctx context.Context, client *s3.Client, bucket string, r *BucketResource) {
+	output, err := client.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		if apperrors.GetErrorCode(err) != "NoSuchBucketPolicy" {
+			log.Debug("failed to get bucket policy", "bucket", bucket, "error", err)
+			r.PolicyUnreadable = true
+		}
+		return
+	}
+	r.Policy = appaws.Str(output.Policy)
+	statements, err := ParsePolicy(r.Policy)
+	if err != nil {
+		log.Debug("failed to parse bucket policy", "bucket", bucket, "error", err)
+	}
+	r.PolicyStatements = statements
+
+	status, err := client.GetBucketPolicyStatus(ctx, &s3.GetBucketPolicyStatusInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		log.Debug("failed to get bucket policy status", "bucket", bucket, "error", err)
+		return
+	}
+	if status.PolicyStatus != nil {
+		r.PolicyPublic = appaws.Bool(status.PolicyStatus.IsPublic)
+	}
+}
+
+// fetchACL fetches the bucket ACL grants
+func (d *BucketDAO) fetchACL(ctx context.Context, client *s3.Client, bucket string, r *BucketResource) {
+	output, err := client.GetBucketAcl(ctx, &s3.GetBucketAclInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		log.Debug("failed to get bucket acl", "bucket", bucket, "error", err)
+		r.ACLUnreadable = true
+		return
+	}
+	r.ACLGrants = make([]ACLGrant, 0, len(output.Grants))
+	for _, g := range output.Grants {
+		r.ACLGrants = append(r.ACLGrants, NewACLGrant(g))
+	}
+}
+
 // fetchObjectLock fetches object lock configuration
 func (d *BucketDAO) fetchObjectLock(ctx context.Context, client *s3.Client, bucket string, r *BucketResource) {
 	output, err := client.GetObjectLockConfiguration(ctx, &s3.GetObjectLockConfigurationInput{
@@ -244,6 +349,11 @@ type BucketResource struct {
 	Region       string
 	CreationDate time.Time
 
+	// Storage metrics (fetched per List page from CloudWatch, daily)
+	SizeBytes     int64
+	ObjectCount   int64
+	StorageLoaded bool
+
 	// Extended info (fetched in Get() only)
 	Versioning          string
 	MFADelete           string
@@ -252,10 +362,18 @@ type BucketResource struct {
 	EncryptionKMSKeyID  string
 	BucketKeyEnabled    bool
 	PublicAccessBlock   *PublicAccessBlockInfo
+	Policy              string
+	PolicyStatements    []PolicyStatement
+	PolicyPublic        bool // S3's GetBucketPolicyStatus verdict
+	PolicyUnreadable    bool
+	ACLGrants           []ACLGrant
+	ACLUnreadable       bool
+	LifecycleRules      []types.LifecycleRule
 	LifecycleRulesCount int
 	ObjectLockEnabled   bool
 	ObjectLockMode      string
 	ObjectLockRetention string
+	Audited             bool // Set once the fields above have been fetched
 }
 
 // PublicAccessBlockInfo holds public access block settings
@@ -289,11 +407,17 @@ func (r *BucketResource) Age() time.Duration {
 }
 
 // MergeFrom implements dao.Mergeable to preserve List-only fields after Get() refresh.
-// CreationDate is only available from ListBuckets, not from any Get API.
+// CreationDate is only available from ListBuckets, not from any Get API, and
+// storage metrics are only loaded while listing.
 func (r *BucketResource) MergeFrom(original dao.Resource) {
 	if orig, ok := original.(*BucketResource); ok {
 		if r.CreationDate.IsZero() && !orig.CreationDate.IsZero() {
 			r.CreationDate = orig.CreationDate
 		}
+		if !r.StorageLoaded && orig.StorageLoaded {
+			r.SizeBytes = orig.SizeBytes
+			r.ObjectCount = orig.ObjectCount
+			r.StorageLoaded = true
+		}
 	}
 }
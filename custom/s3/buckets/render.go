@@ -2,9 +2,15 @@ package buckets
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 // BucketRenderer renders S3 buckets
@@ -38,6 +44,28 @@ func NewBucketRenderer() render.Renderer {
 					},
 					Priority: 1,
 				},
+				{
+					Name:  "SIZE",
+					Width: 11,
+					Getter: func(r dao.Resource) string {
+						if b, ok := r.(*BucketResource); ok && b.StorageLoaded {
+							return render.FormatSize(b.SizeBytes)
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "OBJECTS",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if b, ok := r.(*BucketResource); ok && b.StorageLoaded {
+							return fmt.Sprintf("%d", b.ObjectCount)
+						}
+						return ""
+					},
+					Priority: 3,
+				},
 				{
 					Name:  "CREATED",
 					Width: 20,
@@ -49,7 +77,7 @@ func NewBucketRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 2,
+					Priority: 4,
 				},
 				{
 					Name:  "AGE",
@@ -60,9 +88,9 @@ func NewBucketRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 3,
+					Priority: 5,
 				},
-				render.TagsColumn(35, 4),
+				render.TagsColumn(35, 6),
 			},
 		},
 	}
@@ -84,14 +112,25 @@ func (r *BucketRenderer) RenderDetail(resource dao.Resource) string {
 	d.Field("Bucket Name", b.BucketName)
 	d.Field("Region", b.Region)
 
+	renderAudit(d, b)
+
+	// Storage (from daily CloudWatch storage metrics, loaded while listing)
+	if b.StorageLoaded {
+		d.Section("Storage")
+		d.Field("Size", render.FormatSize(b.SizeBytes))
+		d.Field("Objects", fmt.Sprintf("%d", b.ObjectCount))
+		d.Dim("Daily CloudWatch storage metrics; up to a day behind")
+	}
+
 	// URIs
 	d.Section("Access")
-	d.Field("ARN", fmt.Sprintf("arn:aws:s3:::%s", b.BucketName))
+	partition := appaws.PartitionForRegion(b.Region)
+	d.Field("ARN", fmt.Sprintf("arn:%s:s3:::%s", partition, b.BucketName))
 	d.Field("S3 URI", fmt.Sprintf("s3://%s", b.BucketName))
 
 	// Website endpoint (if standard naming)
 	if b.Region != "" {
-		websiteEndpoint := fmt.Sprintf("%s.s3.%s.amazonaws.com", b.BucketName, b.Region)
+		websiteEndpoint := fmt.Sprintf("%s.s3.%s.%s", b.BucketName, b.Region, appaws.DNSSuffix(partition))
 		d.Field("Endpoint", websiteEndpoint)
 	}
 
@@ -125,35 +164,21 @@ func (r *BucketRenderer) RenderDetail(resource dao.Resource) string {
 	d.Section("Block Public Access")
 	if b.PublicAccessBlock != nil {
 		pab := b.PublicAccessBlock
-		allBlocked := pab.BlockPublicAcls && pab.IgnorePublicAcls && pab.BlockPublicPolicy && pab.RestrictPublicBuckets
-		if allBlocked {
+		if pab.AllBlocked() {
 			d.Field("Status", "All public access blocked")
 		} else {
-			if pab.BlockPublicAcls {
-				d.Field("Block Public ACLs", "On")
-			} else {
-				d.Field("Block Public ACLs", "Off")
-			}
-			if pab.IgnorePublicAcls {
-				d.Field("Ignore Public ACLs", "On")
-			} else {
-				d.Field("Ignore Public ACLs", "Off")
-			}
-			if pab.BlockPublicPolicy {
-				d.Field("Block Public Policy", "On")
-			} else {
-				d.Field("Block Public Policy", "Off")
-			}
-			if pab.RestrictPublicBuckets {
-				d.Field("Restrict Public Buckets", "On")
-			} else {
-				d.Field("Restrict Public Buckets", "Off")
-			}
+			d.Field("Block Public ACLs", onOff(pab.BlockPublicAcls))
+			d.Field("Ignore Public ACLs", onOff(pab.IgnorePublicAcls))
+			d.Field("Block Public Policy", onOff(pab.BlockPublicPolicy))
+			d.Field("Restrict Public Buckets", onOff(pab.RestrictPublicBuckets))
 		}
 	} else {
 		d.Field("Status", render.NotConfigured)
 	}
 
+	renderPolicy(d, b)
+	renderACL(d, b)
+
 	// Object Lock
 	if b.ObjectLockEnabled {
 		d.Section("Object Lock")
@@ -170,6 +195,16 @@ func (r *BucketRenderer) RenderDetail(resource dao.Resource) string {
 	if b.LifecycleRulesCount > 0 {
 		d.Section("Lifecycle")
 		d.Field("Rules", fmt.Sprintf("%d lifecycle rules configured", b.LifecycleRulesCount))
+		for i, rule := range b.LifecycleRules {
+			id := appaws.Str(rule.ID)
+			if id == "" {
+				id = fmt.Sprintf("Rule %d", i+1)
+			}
+			if rule.Status != types.ExpirationStatusEnabled {
+				id += " (" + string(rule.Status) + ")"
+			}
+			d.Field(id, LifecycleRuleSummary(rule))
+		}
 	}
 
 	// Timestamps (only shown if creation date is available)
@@ -198,6 +233,12 @@ func (r *BucketRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 		{Label: "Region", Value: b.Region},
 	}
 
+	// Storage (if loaded)
+	if b.StorageLoaded {
+		fields = append(fields, render.SummaryField{Label: "Size", Value: render.FormatSize(b.SizeBytes)})
+		fields = append(fields, render.SummaryField{Label: "Objects", Value: fmt.Sprintf("%d", b.ObjectCount)})
+	}
+
 	// Versioning (if fetched)
 	if b.Versioning != "" {
 		fields = append(fields, render.SummaryField{Label: "Versioning", Value: b.Versioning})
@@ -208,11 +249,12 @@ func (r *BucketRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 		fields = append(fields, render.SummaryField{Label: "Encryption", Value: b.EncryptionAlgorithm})
 	}
 
-	// Public Access Block (if fetched)
+	// Public exposure and Public Access Block (if fetched)
+	if b.Audited && b.PotentiallyPublic() {
+		fields = append(fields, render.SummaryField{Label: "Exposure", Value: "POTENTIALLY PUBLIC", Style: ui.BoldDangerStyle()})
+	}
 	if b.PublicAccessBlock != nil {
-		pab := b.PublicAccessBlock
-		allBlocked := pab.BlockPublicAcls && pab.IgnorePublicAcls && pab.BlockPublicPolicy && pab.RestrictPublicBuckets
-		if allBlocked {
+		if b.PublicAccessBlock.AllBlocked() {
 			fields = append(fields, render.SummaryField{Label: "Public Access", Value: "Blocked"})
 		} else {
 			fields = append(fields, render.SummaryField{Label: "Public Access", Value: "Partial"})
@@ -246,3 +288,133 @@ func (r *BucketRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 
 	return fields
 }
+
+// renderAudit renders the at-a-glance security posture: the public exposure
+// badge with its reasons and the protection flags.
+func renderAudit(d *render.DetailBuilder, b *BucketResource) {
+	d.Section("Security Audit")
+	if !b.Audited {
+		d.Field("Exposure", render.NoValue)
+		return
+	}
+
+	reasons := b.PublicExposure()
+	switch {
+	case len(reasons) > 0:
+		d.FieldStyled("Exposure", "POTENTIALLY PUBLIC", ui.BoldDangerStyle())
+		for _, reason := range reasons {
+			d.DimIndent(reason)
+		}
+	case b.PolicyUnreadable || b.ACLUnreadable:
+		d.FieldStyled("Exposure", "Unknown (policy or ACL not readable)", ui.WarningStyle())
+	default:
+		d.FieldStyled("Exposure", "Not public", d.Styles().Success)
+	}
+
+	bpa := "Off"
+	if pab := b.PublicAccessBlock; pab != nil {
+		bpa = fmt.Sprintf("%d/4 on", pab.EnabledCount())
+	}
+	d.Field("Block Public Access", bpa)
+	d.Field("Versioning", valueOr(b.Versioning, "Unknown"))
+	if b.EncryptionEnabled {
+		d.Field("Default Encryption", b.EncryptionAlgorithm)
+	} else {
+		d.Field("Default Encryption", "Off")
+	}
+	d.Field("Object Lock", enabledDisabled(b.ObjectLockEnabled))
+}
+
+// renderPolicy renders the parsed bucket policy statements.
+func renderPolicy(d *render.DetailBuilder, b *BucketResource) {
+	if b.Policy == "" {
+		return
+	}
+	d.Section("Bucket Policy")
+	if len(b.PolicyStatements) == 0 {
+		d.Dim("Policy could not be parsed")
+		return
+	}
+	for i, stmt := range b.PolicyStatements {
+		label := stmt.Label(i)
+		if stmt.IsPublic() {
+			d.FieldStyled(label, stmt.Effect+" (public)", ui.DangerStyle())
+		} else {
+			d.Field(label, stmt.Effect)
+		}
+		principals := strings.Join(stmt.Principals, ", ")
+		if slices.Contains(stmt.Not, "NotPrincipal") {
+			principals = "all except " + principals
+		}
+		d.DimIndent("Principal: " + valueOr(principals, "-"))
+		d.DimIndent(notPrefix(stmt, "NotAction", "Action: ") + strings.Join(stmt.Actions, ", "))
+		d.DimIndent(notPrefix(stmt, "NotResource", "Resource: ") + strings.Join(stmt.Resources, ", "))
+		if len(stmt.Conditions) > 0 {
+			d.DimIndent("Condition: " + strings.Join(stmt.Conditions, ", "))
+		}
+	}
+}
+
+// renderACL renders the bucket ACL grants.
+func renderACL(d *render.DetailBuilder, b *BucketResource) {
+	if len(b.ACLGrants) == 0 {
+		return
+	}
+	d.Section("Access Control List")
+	for _, g := range b.ACLGrants {
+		if g.Public {
+			d.FieldStyled(g.Grantee, g.Permission, ui.DangerStyle())
+		} else {
+			d.Field(g.Grantee, g.Permission)
+		}
+	}
+}
+
+func notPrefix(stmt PolicyStatement, element, label string) string {
+	if slices.Contains(stmt.Not, element) {
+		return label + "all except "
+	}
+	return label
+}
+
+func onOff(on bool) string {
+	if on {
+		return "On"
+	}
+	return "Off"
+}
+
+func enabledDisabled(on bool) string {
+	if on {
+		return "Enabled"
+	}
+	return "Disabled"
+}
+
+func valueOr(v, fallback string) string {
+	if v == "" {
+		return fallback
+	}
+	return v
+}
+
+// Navigations returns navigation shortcuts
+func (r *BucketRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	b, ok := resource.(*BucketResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "a", Label: "Archived Objects", Service: "s3", Resource: "archived-objects",
+			FilterField: "BucketName", FilterValue: b.BucketName,
+		},
+	}
+}
+
+// ListToggles returns the sort-by-size toggle
+func (r *BucketRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "z", ContextKey: "SortBySize", LabelOn: "largest first", LabelOff: "by name"},
+	}
+}
@@ -0,0 +1,199 @@
+package buckets
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// S3 publishes storage metrics once a day, in the bucket's own region.
+	// Look back a few days so a late datapoint is still found.
+	storageMetricsWindow = 3 * 24 * time.Hour
+	storageMetricsPeriod = int32(24 * 60 * 60)
+
+	// maxMetricQueries is the GetMetricData limit per request.
+	maxMetricQueries = 500
+
+	// storageRegionConcurrency bounds parallel per-region metric loads.
+	storageRegionConcurrency = 4
+)
+
+// storageRef maps a metric query ID back to the bucket it belongs to.
+type storageRef struct {
+	bucket int  // index into the buckets slice
+	size   bool // BucketSizeBytes (summed over storage types) vs NumberOfObjects
+}
+
+// loadStorageMetrics fills SizeBytes and ObjectCount from CloudWatch storage
+// metrics, one region at a time. Best-effort: failures leave buckets unloaded.
+func (d *BucketDAO) loadStorageMetrics(ctx context.Context, buckets []*BucketResource) {
+	byRegion := make(map[string][]*BucketResource)
+	for _, b := range buckets {
+		if b.Region != "" {
+			byRegion[b.Region] = append(byRegion[b.Region], b)
+		}
+	}
+
+	// Each region writes only its own buckets, so no locking is needed.
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(storageRegionConcurrency)
+	for region, regionBuckets := range byRegion {
+		g.Go(func() error {
+			metrics, err := fetchRegionStorage(ctx, region, regionBuckets)
+			if err != nil {
+				log.Debug("failed to load s3 storage metrics", "region", region, "error", err)
+				return nil
+			}
+			for i, m := range metrics {
+				if m.loaded {
+					regionBuckets[i].SizeBytes = m.size
+					regionBuckets[i].ObjectCount = m.objects
+					regionBuckets[i].StorageLoaded = true
+				}
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+type bucketStorage struct {
+	size    int64
+	objects int64
+	loaded  bool
+}
+
+// fetchRegionStorage loads storage metrics for buckets that share a region.
+func fetchRegionStorage(ctx context.Context, region string, buckets []*BucketResource) ([]bucketStorage, error) {
+	client, err := appaws.ClientWithRegion(ctx, region, cloudwatch.NewFromConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Size is reported per storage class; list which classes exist so each
+	// one can be queried and summed.
+	sizeMetrics, err := appaws.Paginate(ctx, func(token *string) ([]cwtypes.Metric, *string, error) {
+		output, err := client.ListMetrics(ctx, &cloudwatch.ListMetricsInput{
+			Namespace:  appaws.StringPtr("AWS/S3"),
+			MetricName: appaws.StringPtr("BucketSizeBytes"),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("list s3 storage metrics: %w", err)
+		}
+		return output.Metrics, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(buckets))
+	for i, b := range buckets {
+		names[i] = b.BucketName
+	}
+	queries, refs := storageQueries(names, sizeMetrics)
+
+	end := time.Now()
+	start := end.Add(-storageMetricsWindow)
+	results := make([]bucketStorage, len(buckets))
+	for chunk := range slices.Chunk(queries, maxMetricQueries) {
+		var token *string
+		for {
+			output, err := client.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+				MetricDataQueries: chunk,
+				StartTime:         &start,
+				EndTime:           &end,
+				ScanBy:            cwtypes.ScanByTimestampDescending,
+				NextToken:         token,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("get s3 storage metrics: %w", err)
+			}
+			applyStorageResults(results, refs, output.MetricDataResults)
+			if output.NextToken == nil {
+				break
+			}
+			token = output.NextToken
+		}
+	}
+	return results, nil
+}
+
+// storageQueries builds one query per (bucket, storage type) size metric and
+// one object-count query per bucket.
+func storageQueries(names []string, sizeMetrics []cwtypes.Metric) ([]cwtypes.MetricDataQuery, map[string]storageRef) {
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+
+	var queries []cwtypes.MetricDataQuery
+	refs := make(map[string]storageRef)
+	add := func(bucket int, size bool, metric cwtypes.Metric) {
+		id := fmt.Sprintf("q%d", len(queries))
+		refs[id] = storageRef{bucket: bucket, size: size}
+		queries = append(queries, cwtypes.MetricDataQuery{
+			Id: &id,
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &metric,
+				Period: appaws.Int32Ptr(storageMetricsPeriod),
+				Stat:   appaws.StringPtr("Average"),
+			},
+		})
+	}
+
+	for _, m := range sizeMetrics {
+		if i, ok := index[metricDimension(m, "BucketName")]; ok {
+			add(i, true, m)
+		}
+	}
+	for i, name := range names {
+		add(i, false, cwtypes.Metric{
+			Namespace:  appaws.StringPtr("AWS/S3"),
+			MetricName: appaws.StringPtr("NumberOfObjects"),
+			Dimensions: []cwtypes.Dimension{
+				{Name: appaws.StringPtr("BucketName"), Value: appaws.StringPtr(name)},
+				{Name: appaws.StringPtr("StorageType"), Value: appaws.StringPtr("AllStorageTypes")},
+			},
+		})
+	}
+	return queries, refs
+}
+
+// applyStorageResults adds the latest datapoint of each query to its bucket.
+// Results are scanned newest first, so Values[0] is the latest; a query is
+// consumed once applied so older datapoints on later pages are skipped.
+func applyStorageResults(results []bucketStorage, refs map[string]storageRef, data []cwtypes.MetricDataResult) {
+	for _, res := range data {
+		ref, ok := refs[appaws.Str(res.Id)]
+		if !ok || len(res.Values) == 0 {
+			continue
+		}
+		delete(refs, appaws.Str(res.Id))
+		value := int64(res.Values[0])
+		if ref.size {
+			results[ref.bucket].size += value
+		} else {
+			results[ref.bucket].objects = value
+		}
+		results[ref.bucket].loaded = true
+	}
+}
+
+func metricDimension(m cwtypes.Metric, name string) string {
+	for _, d := range m.Dimensions {
+		if appaws.Str(d.Name) == name {
+			return appaws.Str(d.Value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,116 @@
+package buckets
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func sizeMetric(bucket, storageType string) cwtypes.Metric {
+	return cwtypes.Metric{
+		Namespace:  aws.String("AWS/S3"),
+		MetricName: aws.String("BucketSizeBytes"),
+		Dimensions: []cwtypes.Dimension{
+			{Name: aws.String("StorageType"), Value: aws.String(storageType)},
+			{Name: aws.String("BucketName"), Value: aws.String(bucket)},
+		},
+	}
+}
+
+func TestStorageQueries(t *testing.T) {
+	names := []string{"logs", "assets"}
+	metrics := []cwtypes.Metric{
+		sizeMetric("logs", "StandardStorage"),
+		sizeMetric("logs", "GlacierStorage"),
+		sizeMetric("other-page", "StandardStorage"),
+		sizeMetric("assets", "StandardStorage"),
+	}
+
+	queries, refs := storageQueries(names, metrics)
+
+	// 3 size queries (other-page skipped) + 2 object-count queries
+	if len(queries) != 5 || len(refs) != 5 {
+		t.Fatalf("storageQueries() = %d queries, %d refs, want 5 each", len(queries), len(refs))
+	}
+	sizes, counts := 0, 0
+	for _, q := range queries {
+		ref := refs[aws.ToString(q.Id)]
+		if ref.size {
+			sizes++
+		} else {
+			counts++
+			if metricDimension(*q.MetricStat.Metric, "StorageType") != "AllStorageTypes" {
+				t.Errorf("object count query %s has wrong storage type", aws.ToString(q.Id))
+			}
+		}
+		if aws.ToInt32(q.MetricStat.Period) != storageMetricsPeriod {
+			t.Errorf("query %s period = %d", aws.ToString(q.Id), aws.ToInt32(q.MetricStat.Period))
+		}
+	}
+	if sizes != 3 || counts != 2 {
+		t.Errorf("sizes/counts = %d/%d, want 3/2", sizes, counts)
+	}
+}
+
+func TestApplyStorageResults(t *testing.T) {
+	refs := map[string]storageRef{
+		"q0": {bucket: 0, size: true},
+		"q1": {bucket: 0, size: true},
+		"q2": {bucket: 0, size: false},
+		"q3": {bucket: 1, size: false},
+	}
+	results := make([]bucketStorage, 2)
+
+	applyStorageResults(results, refs, []cwtypes.MetricDataResult{
+		{Id: aws.String("q0"), Values: []float64{1000, 900}},
+		{Id: aws.String("q1"), Values: []float64{24}},
+		{Id: aws.String("q2"), Values: []float64{7}},
+		{Id: aws.String("q3")}, // no datapoints
+	})
+	// A later page with older datapoints for an already applied query
+	applyStorageResults(results, refs, []cwtypes.MetricDataResult{
+		{Id: aws.String("q0"), Values: []float64{800}},
+	})
+
+	if got := results[0]; got.size != 1024 || got.objects != 7 || !got.loaded {
+		t.Errorf("results[0] = %+v, want size 1024, objects 7, loaded", got)
+	}
+	if results[1].loaded {
+		t.Errorf("results[1] = %+v, want not loaded", results[1])
+	}
+}
+
+func TestSortBySize(t *testing.T) {
+	bucket := func(name string, size int64) *BucketResource {
+		return &BucketResource{BucketName: name, SizeBytes: size}
+	}
+	resources := []dao.Resource{
+		bucket("small", 10),
+		bucket("unknown", 0),
+		bucket("huge", 1<<40),
+		bucket("also-small", 10),
+	}
+
+	SortBySize(resources)
+
+	want := []string{"huge", "also-small", "small", "unknown"}
+	for i, name := range want {
+		if got := resources[i].(*BucketResource).BucketName; got != name {
+			t.Errorf("resources[%d] = %q, want %q", i, got, name)
+		}
+	}
+}
+
+func TestBucketResource_MergeFrom_Storage(t *testing.T) {
+	original := &BucketResource{SizeBytes: 2048, ObjectCount: 3, StorageLoaded: true}
+	refreshed := &BucketResource{}
+
+	refreshed.MergeFrom(original)
+
+	if !refreshed.StorageLoaded || refreshed.SizeBytes != 2048 || refreshed.ObjectCount != 3 {
+		t.Errorf("MergeFrom() storage = %d/%d/%v", refreshed.SizeBytes, refreshed.ObjectCount, refreshed.StorageLoaded)
+	}
+}
@@ -2,26 +2,82 @@ package s3
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
 
 	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
-// GetClient returns an S3 client configured for the current context
-func GetClient(ctx context.Context) (*s3.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
+// GetControlClient returns an S3 Control client and the account ID that its
+// account-scoped APIs (e.g. Batch Operations) are called with.
+func GetControlClient(ctx context.Context) (*s3control.Client, string, error) {
+	client, err := appaws.Client(ctx, s3control.NewFromConfig)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	return s3.NewFromConfig(cfg), nil
+	accountID := appaws.FetchAccountIDForContext(ctx)
+	if accountID == "" {
+		return nil, "", fmt.Errorf("could not determine AWS account ID for S3 Control")
+	}
+	return client, accountID, nil
+}
+
+// GetClient returns an S3 client configured for the current context
+func GetClient(ctx context.Context) (*s3.Client, error) {
+	return appaws.Client(ctx, s3.NewFromConfig)
 }
 
 // GetClientForRegion returns an S3 client configured for a specific region
 func GetClientForRegion(ctx context.Context, region string) (*s3.Client, error) {
-	cfg, err := appaws.NewConfigWithRegion(ctx, region)
+	return appaws.ClientWithRegion(ctx, region, s3.NewFromConfig)
+}
+
+// RegionBuckets lists the buckets in the current region, for pickers of
+// services that can only write to same-region buckets.
+func RegionBuckets(ctx context.Context) ([]types.Bucket, error) {
+	client, err := GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return s3.NewFromConfig(cfg), nil
+	region := appaws.CurrentRegion(ctx)
+	return appaws.Paginate(ctx, func(token *string) ([]types.Bucket, *string, error) {
+		output, err := client.ListBuckets(ctx, &s3.ListBucketsInput{
+			BucketRegion:      &region,
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list buckets")
+		}
+		return output.Buckets, output.ContinuationToken, nil
+	})
+}
+
+// GetClientForBucket returns an S3 client for the region a bucket lives in,
+// along with that region. Object-level calls must go to the bucket's region.
+func GetClientForBucket(ctx context.Context, bucket string) (*s3.Client, string, error) {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	output, err := client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{Bucket: &bucket})
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "get bucket location for %s", bucket)
+	}
+	// An empty location constraint means us-east-1
+	region := "us-east-1"
+	if output.LocationConstraint != "" {
+		region = string(output.LocationConstraint)
+	}
+	if region == appaws.CurrentRegion(ctx) {
+		return client, region, nil
+	}
+	regionClient, err := GetClientForRegion(ctx, region)
+	if err != nil {
+		return nil, "", err
+	}
+	return regionClient, region, nil
 }
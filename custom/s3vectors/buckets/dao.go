@@ -19,13 +19,13 @@ type VectorBucketDAO struct {
 
 // NewVectorBucketDAO creates a new VectorBucketDAO
 func NewVectorBucketDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, s3vectors.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &VectorBucketDAO{
 		BaseDAO: dao.NewBaseDAO("s3vectors", "buckets"),
-		client:  s3vectors.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
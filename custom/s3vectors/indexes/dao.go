@@ -19,13 +19,13 @@ type VectorIndexDAO struct {
 
 // NewVectorIndexDAO creates a new VectorIndexDAO
 func NewVectorIndexDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, s3vectors.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &VectorIndexDAO{
 		BaseDAO: dao.NewBaseDAO("s3vectors", "indexes"),
-		client:  s3vectors.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
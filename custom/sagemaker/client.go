@@ -0,0 +1,20 @@
+package sagemaker
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemakerruntime"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns a SageMaker client configured for the current context
+func GetClient(ctx context.Context) (*sagemaker.Client, error) {
+	return appaws.Client(ctx, sagemaker.NewFromConfig)
+}
+
+// GetRuntimeClient returns a SageMaker Runtime client for invoking endpoints
+func GetRuntimeClient(ctx context.Context) (*sagemakerruntime.Client, error) {
+	return appaws.Client(ctx, sagemakerruntime.NewFromConfig)
+}
@@ -0,0 +1,260 @@
+package endpoints
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/aws/aws-sdk-go-v2/service/sagemakerruntime"
+
+	smClient "github.com/clawscli/claws/custom/sagemaker"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+const (
+	// maxResponseLines bounds how much of an invocation response is shown.
+	maxResponseLines = 15
+	// maxResponseBytes bounds the response body size shown before truncation.
+	maxResponseBytes = 2000
+)
+
+func init() {
+	// Register actions for SageMaker endpoints
+	action.Global.Register("sagemaker", "endpoints", []action.Action{
+		{
+			Name:      "Invoke Test",
+			Shortcut:  "I",
+			Type:      action.ActionTypeAPI,
+			Operation: "InvokeEndpoint",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Payload (JSON or CSV)", Placeholder: `{"inputs": "..."}`},
+			Filter:    isInService,
+		},
+		{
+			Name:      "Set Instance Count",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "UpdateInstanceCount",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Instance count (N or variant=N,...)", Placeholder: "2"},
+			Filter:    isInService,
+		},
+		{
+			Name:      "Set Variant Weight",
+			Shortcut:  "W",
+			Type:      action.ActionTypeAPI,
+			Operation: "UpdateVariantWeight",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Label: "Weights (variant=W,...)", Placeholder: "AllTraffic=1"},
+			Filter:    isInService,
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("sagemaker", "endpoints", executeEndpointAction)
+}
+
+func isInService(r dao.Resource) bool {
+	e, ok := r.(*EndpointResource)
+	return ok && e.IsInService()
+}
+
+// executeEndpointAction executes an action on a SageMaker endpoint
+func executeEndpointAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	endpoint, ok := resource.(*EndpointResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case "InvokeEndpoint":
+		return invokeEndpoint(ctx, endpoint.GetID(), action.InputFromContext(ctx))
+	case "UpdateInstanceCount":
+		return updateVariants(ctx, endpoint.GetID(), action.InputFromContext(ctx), true)
+	case "UpdateVariantWeight":
+		return updateVariants(ctx, endpoint.GetID(), action.InputFromContext(ctx), false)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func invokeEndpoint(ctx context.Context, name, payload string) action.ActionResult {
+	client, err := smClient.GetRuntimeClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	contentType := payloadContentType(payload)
+	start := time.Now()
+	output, err := client.InvokeEndpoint(ctx, &sagemakerruntime.InvokeEndpointInput{
+		EndpointName: &name,
+		ContentType:  &contentType,
+		Body:         []byte(payload),
+	})
+	latency := time.Since(start)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("invoke endpoint (%s): %w", latency.Round(time.Millisecond), err)}
+	}
+
+	header := fmt.Sprintf("%s response in %s", appaws.Str(output.ContentType), latency.Round(time.Millisecond))
+	if variant := appaws.Str(output.InvokedProductionVariant); variant != "" {
+		header += " from " + variant
+	}
+	return action.ActionResult{Success: true, Message: header + "\n" + formatResponse(output.Body)}
+}
+
+// payloadContentType treats valid JSON as application/json and anything else as CSV
+func payloadContentType(payload string) string {
+	if json.Valid([]byte(payload)) {
+		return "application/json"
+	}
+	return "text/csv"
+}
+
+// formatResponse pretty-prints JSON bodies and truncates long responses
+func formatResponse(body []byte) string {
+	if len(body) == 0 {
+		return "(empty response)"
+	}
+
+	var buf bytes.Buffer
+	if json.Indent(&buf, body, "", "  ") == nil {
+		body = buf.Bytes()
+	}
+
+	truncated := false
+	if len(body) > maxResponseBytes {
+		// Back off to a rune boundary so a multi-byte character isn't split
+		cut := maxResponseBytes
+		for cut > 0 && !utf8.RuneStart(body[cut]) {
+			cut--
+		}
+		body = body[:cut]
+		truncated = true
+	}
+	lines := strings.Split(string(body), "\n")
+	if len(lines) > maxResponseLines {
+		lines = lines[:maxResponseLines]
+		truncated = true
+	}
+
+	out := strings.Join(lines, "\n")
+	if truncated {
+		out += "\n… (truncated)"
+	}
+	return out
+}
+
+func updateVariants(ctx context.Context, name, input string, capacity bool) action.ActionResult {
+	client, err := smClient.GetClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	output, err := client.DescribeEndpoint(ctx, &sagemaker.DescribeEndpointInput{EndpointName: &name})
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("describe endpoint: %w", err)}
+	}
+	variants := make([]string, 0, len(output.ProductionVariants))
+	for _, v := range output.ProductionVariants {
+		variants = append(variants, appaws.Str(v.VariantName))
+	}
+
+	values, err := parseVariantValues(input, variants)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	desired := make([]types.DesiredWeightAndCapacity, 0, len(values))
+	var changes []string
+	for _, variant := range sortedKeys(values) {
+		v := values[variant]
+		d := types.DesiredWeightAndCapacity{VariantName: appaws.StringPtr(variant)}
+		if capacity {
+			if v < 1 || v != float64(int32(v)) {
+				return action.ActionResult{Success: false, Error: fmt.Errorf("instance count for %s must be a positive integer", variant)}
+			}
+			d.DesiredInstanceCount = appaws.Int32Ptr(int32(v))
+			changes = append(changes, fmt.Sprintf("%s=%d instances", variant, int32(v)))
+		} else {
+			if v < 0 {
+				return action.ActionResult{Success: false, Error: fmt.Errorf("weight for %s must not be negative", variant)}
+			}
+			w := float32(v)
+			d.DesiredWeight = &w
+			changes = append(changes, fmt.Sprintf("%s=%g", variant, v))
+		}
+		desired = append(desired, d)
+	}
+
+	_, err = client.UpdateEndpointWeightsAndCapacities(ctx, &sagemaker.UpdateEndpointWeightsAndCapacitiesInput{
+		EndpointName:                &name,
+		DesiredWeightsAndCapacities: desired,
+	})
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("update endpoint: %w", err)}
+	}
+	return action.ActionResult{Success: true, Message: fmt.Sprintf("Updating %s: %s", name, strings.Join(changes, ", "))}
+}
+
+// parseVariantValues parses "N" (only for single-variant endpoints) or
+// "variant=N,variant=N" into per-variant values.
+func parseVariantValues(input string, variants []string) (map[string]float64, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, fmt.Errorf("a value is required")
+	}
+
+	if !strings.Contains(input, "=") {
+		if len(variants) != 1 {
+			return nil, fmt.Errorf("endpoint has %d variants - use variant=value", len(variants))
+		}
+		v, err := strconv.ParseFloat(input, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", input)
+		}
+		return map[string]float64{variants[0]: v}, nil
+	}
+
+	known := make(map[string]bool, len(variants))
+	for _, v := range variants {
+		known[v] = true
+	}
+
+	values := make(map[string]float64)
+	for _, pair := range strings.Split(input, ",") {
+		name, raw, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid entry %q - use variant=value", pair)
+		}
+		if !known[name] {
+			return nil, fmt.Errorf("unknown variant %q", name)
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %q", name, raw)
+		}
+		values[name] = v
+	}
+	return values, nil
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,72 @@
+package endpoints
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestParseVariantValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		variants []string
+		want     map[string]float64
+		wantErr  bool
+	}{
+		{"bare value single variant", "3", []string{"AllTraffic"}, map[string]float64{"AllTraffic": 3}, false},
+		{"bare value multiple variants", "3", []string{"A", "B"}, nil, true},
+		{"pairs", "A=0.9, B=0.1", []string{"A", "B"}, map[string]float64{"A": 0.9, "B": 0.1}, false},
+		{"unknown variant", "C=1", []string{"A", "B"}, nil, true},
+		{"bad number", "A=x", []string{"A"}, nil, true},
+		{"empty", " ", []string{"A"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseVariantValues(tt.input, tt.variants)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseVariantValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseVariantValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayloadContentType(t *testing.T) {
+	if got := payloadContentType(`{"inputs": [1, 2]}`); got != "application/json" {
+		t.Errorf("JSON payload content type = %q", got)
+	}
+	if got := payloadContentType("1.0,2.5,3"); got != "text/csv" {
+		t.Errorf("CSV payload content type = %q", got)
+	}
+}
+
+func TestFormatResponse(t *testing.T) {
+	if got := formatResponse(nil); got != "(empty response)" {
+		t.Errorf("formatResponse(nil) = %q", got)
+	}
+	if got := formatResponse([]byte(`{"score":0.9}`)); got != "{\n  \"score\": 0.9\n}" {
+		t.Errorf("formatResponse(json) = %q", got)
+	}
+
+	long := []byte(strings.Repeat("x\n", maxResponseLines*2))
+	got := formatResponse(long)
+	if !strings.HasSuffix(got, "(truncated)") {
+		t.Errorf("formatResponse(long) not truncated: %q", got)
+	}
+}
+
+func TestFormatResponse_MultiByte(t *testing.T) {
+	// "é" is two bytes; an odd prefix puts maxResponseBytes mid-rune
+	body := []byte("x" + strings.Repeat("é", maxResponseBytes))
+	got := formatResponse(body)
+	if !utf8.ValidString(got) {
+		t.Fatalf("formatResponse(multi-byte) produced invalid UTF-8: %q", got[len(got)-20:])
+	}
+	if !strings.HasSuffix(got, "… (truncated)") {
+		t.Errorf("formatResponse(multi-byte) not truncated")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // EndpointDAO provides data access for SageMaker endpoints.
@@ -20,13 +21,13 @@ type EndpointDAO struct {
 
 // NewEndpointDAO creates a new EndpointDAO.
 func NewEndpointDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sagemaker.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &EndpointDAO{
 		BaseDAO: dao.NewBaseDAO("sagemaker", "endpoints"),
-		client:  sagemaker.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -73,6 +74,18 @@ func (d *EndpointDAO) Get(ctx context.Context, id string) (dao.Resource, error)
 	r.FailureReason = appaws.Str(output.FailureReason)
 	r.ProductionVariants = output.ProductionVariants
 	r.DataCaptureConfig = output.DataCaptureConfig
+
+	// Capture modes and content types only live on the endpoint config.
+	if output.DataCaptureConfig != nil && output.EndpointConfigName != nil {
+		cfg, err := d.client.DescribeEndpointConfig(ctx, &sagemaker.DescribeEndpointConfigInput{
+			EndpointConfigName: output.EndpointConfigName,
+		})
+		if err != nil {
+			log.Warn("failed to describe endpoint config", "endpoint", id, "config", appaws.Str(output.EndpointConfigName), "error", err)
+		} else {
+			r.DataCaptureDetail = cfg.DataCaptureConfig
+		}
+	}
 	return r, nil
 }
 
@@ -95,6 +108,9 @@ type EndpointResource struct {
 	FailureReason      string
 	ProductionVariants []types.ProductionVariantSummary
 	DataCaptureConfig  *types.DataCaptureConfigSummary
+
+	// DataCaptureDetail is the endpoint config's capture settings, populated by Get only
+	DataCaptureDetail *types.DataCaptureConfig
 }
 
 // NewEndpointResource creates a new EndpointResource.
@@ -143,3 +159,20 @@ func (r *EndpointResource) GetProductionVariants() []types.ProductionVariantSumm
 func (r *EndpointResource) GetDataCaptureConfig() *types.DataCaptureConfigSummary {
 	return r.DataCaptureConfig
 }
+
+// IsInService reports whether the endpoint can serve and be updated.
+func (r *EndpointResource) IsInService() bool {
+	return r.Endpoint.EndpointStatus == types.EndpointStatusInService
+}
+
+// CaptureModes returns the captured traffic directions (Input, Output).
+func (r *EndpointResource) CaptureModes() []string {
+	if r.DataCaptureDetail == nil {
+		return nil
+	}
+	modes := make([]string, 0, len(r.DataCaptureDetail.CaptureOptions))
+	for _, opt := range r.DataCaptureDetail.CaptureOptions {
+		modes = append(modes, string(opt.CaptureMode))
+	}
+	return modes
+}
@@ -2,7 +2,9 @@ package endpoints
 
 import (
 	"fmt"
+	"strings"
 
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -104,6 +106,25 @@ func (r *EndpointRenderer) RenderDetail(resource dao.Resource) string {
 			d.Field("Destination", *dc.DestinationS3Uri)
 		}
 		d.Field("Capture Status", string(dc.CaptureStatus))
+		if modes := endpoint.CaptureModes(); len(modes) > 0 {
+			d.Field("Capture Modes", strings.Join(modes, ", "))
+		}
+		if detail := endpoint.DataCaptureDetail; detail != nil {
+			if h := detail.CaptureContentTypeHeader; h != nil {
+				if len(h.JsonContentTypes) > 0 {
+					d.Field("JSON Content Types", strings.Join(h.JsonContentTypes, ", "))
+				}
+				if len(h.CsvContentTypes) > 0 {
+					d.Field("CSV Content Types", strings.Join(h.CsvContentTypes, ", "))
+				}
+			}
+			if key := appaws.Str(detail.KmsKeyId); key != "" {
+				d.Field("KMS Key", key)
+			}
+		}
+	} else if endpoint.GetEndpointConfigName() != "" {
+		d.Section("Data Capture")
+		d.Field("Enabled", "false")
 	}
 
 	// Timestamps
@@ -20,13 +20,13 @@ type ModelDAO struct {
 
 // NewModelDAO creates a new ModelDAO.
 func NewModelDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sagemaker.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ModelDAO{
 		BaseDAO: dao.NewBaseDAO("sagemaker", "models"),
-		client:  sagemaker.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type NotebookDAO struct {
 
 // NewNotebookDAO creates a new NotebookDAO.
 func NewNotebookDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sagemaker.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &NotebookDAO{
 		BaseDAO: dao.NewBaseDAO("sagemaker", "notebooks"),
-		client:  sagemaker.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type TrainingJobDAO struct {
 
 // NewTrainingJobDAO creates a new TrainingJobDAO.
 func NewTrainingJobDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sagemaker.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TrainingJobDAO{
 		BaseDAO: dao.NewBaseDAO("sagemaker", "training-jobs"),
-		client:  sagemaker.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -9,9 +9,5 @@ import (
 )
 
 func GetClient(ctx context.Context) (*secretsmanager.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return secretsmanager.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, secretsmanager.NewFromConfig)
 }
@@ -19,13 +19,13 @@ type SecretDAO struct {
 
 // NewSecretDAO creates a new SecretDAO
 func NewSecretDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, secretsmanager.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SecretDAO{
 		BaseDAO: dao.NewBaseDAO("secretsmanager", "secrets"),
-		client:  secretsmanager.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -129,13 +129,13 @@ type QuotaDAO struct {
 
 // NewQuotaDAO creates a new QuotaDAO
 func NewQuotaDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, servicequotas.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &QuotaDAO{
 		BaseDAO: dao.NewBaseDAO("service-quotas", "quotas"),
-		client:  servicequotas.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
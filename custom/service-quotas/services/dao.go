@@ -75,13 +75,13 @@ type ServiceDAO struct {
 
 // NewServiceDAO creates a new ServiceDAO
 func NewServiceDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, servicequotas.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ServiceDAO{
 		BaseDAO: dao.NewBaseDAO("service-quotas", "services"),
-		client:  servicequotas.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,248 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// accountID is the ID of the single per-region account resource.
+const accountID = "account"
+
+// statsWindow matches the rolling 24 hour window of the sending quota.
+const statsWindow = 24 * time.Hour
+
+// AccountDAO provides data access for the SES account's sending quota and
+// statistics in the current region
+type AccountDAO struct {
+	dao.BaseDAO
+	client   *sesv2.Client
+	cwClient *cloudwatch.Client
+}
+
+// NewAccountDAO creates a new AccountDAO
+func NewAccountDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, sesv2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	cwClient, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &AccountDAO{
+		BaseDAO:  dao.NewBaseDAO("ses", "account"),
+		client:   client,
+		cwClient: cwClient,
+	}, nil
+}
+
+// List returns the account as a single resource
+func (d *AccountDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	r, err := d.Get(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return []dao.Resource{r}, nil
+}
+
+// Get returns the account enriched with sending statistics from the last
+// 24 hours. Statistics are best-effort; failures are logged and leave them unset.
+func (d *AccountDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetAccount(ctx, &sesv2.GetAccountInput{})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get ses account")
+	}
+	r := NewAccountResource(output)
+
+	stats, err := d.fetchStats(ctx)
+	if err != nil {
+		log.Warn("failed to fetch ses sending statistics", "error", err)
+	} else {
+		r.Stats = stats
+	}
+	return r, nil
+}
+
+// Delete is not supported
+func (d *AccountDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for ses account")
+}
+
+// Supports returns supported operations
+func (d *AccountDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// sendingMetric describes an account-level AWS/SES metric.
+type sendingMetric struct {
+	Name  string
+	Label string
+	Stat  string
+	Rate  bool
+}
+
+// sendingMetrics are the account-level statistics shown for the account.
+// Reputation rates are published as ratios and reported as percentages.
+var sendingMetrics = []sendingMetric{
+	{Name: "Send", Label: "Sent", Stat: "Sum"},
+	{Name: "Delivery", Label: "Delivered", Stat: "Sum"},
+	{Name: "Bounce", Label: "Bounces", Stat: "Sum"},
+	{Name: "Complaint", Label: "Complaints", Stat: "Sum"},
+	{Name: "Reject", Label: "Rejects", Stat: "Sum"},
+	{Name: "Reputation.BounceRate", Label: "Bounce Rate", Stat: "Maximum", Rate: true},
+	{Name: "Reputation.ComplaintRate", Label: "Complaint Rate", Stat: "Maximum", Rate: true},
+}
+
+func (d *AccountDAO) fetchStats(ctx context.Context) ([]SendingStat, error) {
+	period := int32(statsWindow.Seconds())
+	queries := make([]cwtypes.MetricDataQuery, len(sendingMetrics))
+	for i, m := range sendingMetrics {
+		queries[i] = cwtypes.MetricDataQuery{
+			Id: appaws.StringPtr(fmt.Sprintf("m%d", i)),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  appaws.StringPtr("AWS/SES"),
+					MetricName: appaws.StringPtr(m.Name),
+				},
+				Period: &period,
+				Stat:   appaws.StringPtr(m.Stat),
+			},
+		}
+	}
+
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-statsWindow)
+	output, err := d.cwClient.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+		StartTime:         &start,
+		EndTime:           &end,
+		MetricDataQueries: queries,
+		ScanBy:            cwtypes.ScanByTimestampDescending,
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "get ses sending statistics")
+	}
+
+	return statsFrom(output.MetricDataResults), nil
+}
+
+// statsFrom maps query results back to sendingMetrics. Counters without data
+// mean nothing was sent and report zero; rates without data are omitted.
+func statsFrom(results []cwtypes.MetricDataResult) []SendingStat {
+	values := make(map[string][]float64, len(results))
+	for _, res := range results {
+		values[appaws.Str(res.Id)] = res.Values
+	}
+
+	stats := make([]SendingStat, 0, len(sendingMetrics))
+	for i, m := range sendingMetrics {
+		var total float64
+		vs := values[fmt.Sprintf("m%d", i)]
+		if m.Rate {
+			if len(vs) == 0 {
+				continue
+			}
+			total = vs[0] * 100
+		} else {
+			for _, v := range vs {
+				total += v
+			}
+		}
+		stats = append(stats, SendingStat{Name: m.Name, Label: m.Label, Rate: m.Rate, Value: total})
+	}
+	return stats
+}
+
+// SendingStat is an account sending statistic aggregated over statsWindow
+type SendingStat struct {
+	Name  string
+	Label string
+	Rate  bool
+	Value float64
+}
+
+// Format returns the value as a count or percentage
+func (s SendingStat) Format() string {
+	if s.Rate {
+		return fmt.Sprintf("%.2f%%", s.Value)
+	}
+	return fmt.Sprintf("%.0f", s.Value)
+}
+
+// IsConcerning reports whether a reputation rate is at or above the level
+// where SES places accounts under review (5% bounces, 0.1% complaints)
+func (s SendingStat) IsConcerning() bool {
+	switch s.Name {
+	case "Reputation.BounceRate":
+		return s.Value >= 5
+	case "Reputation.ComplaintRate":
+		return s.Value >= 0.1
+	default:
+		return false
+	}
+}
+
+// AccountResource wraps the SES account of the current region
+type AccountResource struct {
+	dao.BaseResource
+	Item  *sesv2.GetAccountOutput
+	Stats []SendingStat
+}
+
+// NewAccountResource creates a new AccountResource
+func NewAccountResource(output *sesv2.GetAccountOutput) *AccountResource {
+	return &AccountResource{
+		BaseResource: dao.BaseResource{
+			ID:   accountID,
+			Name: accountID,
+			Data: output,
+		},
+		Item: output,
+	}
+}
+
+// EnforcementStatus returns HEALTHY, PROBATION or SHUTDOWN
+func (r *AccountResource) EnforcementStatus() string {
+	return appaws.Str(r.Item.EnforcementStatus)
+}
+
+// Quota returns the sending quota, or a zero quota when unavailable
+func (r *AccountResource) Quota() (sent, limit, rate float64) {
+	if q := r.Item.SendQuota; q != nil {
+		return q.SentLast24Hours, q.Max24HourSend, q.MaxSendRate
+	}
+	return 0, 0, 0
+}
+
+// QuotaUsage returns the percentage of the 24 hour quota used
+func (r *AccountResource) QuotaUsage() float64 {
+	sent, limit, _ := r.Quota()
+	if limit <= 0 {
+		return 0
+	}
+	return sent / limit * 100
+}
+
+// Stat returns the statistic with the given metric name
+func (r *AccountResource) Stat(name string) (SendingStat, bool) {
+	for _, s := range r.Stats {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return SendingStat{}, false
+}
@@ -0,0 +1,67 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+)
+
+func TestStatsFrom(t *testing.T) {
+	stats := statsFrom([]cwtypes.MetricDataResult{
+		{Id: aws.String("m0"), Values: []float64{1200, 300}},
+		{Id: aws.String("m2"), Values: []float64{12}},
+		{Id: aws.String("m5"), Values: []float64{0.061, 0.02}},
+	})
+
+	want := map[string]string{
+		"Send":                  "1500",
+		"Delivery":              "0",
+		"Bounce":                "12",
+		"Complaint":             "0",
+		"Reject":                "0",
+		"Reputation.BounceRate": "6.10%",
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("got %d stats, want %d: %+v", len(stats), len(want), stats)
+	}
+	for _, s := range stats {
+		if got := s.Format(); got != want[s.Name] {
+			t.Errorf("%s = %q, want %q", s.Name, got, want[s.Name])
+		}
+	}
+}
+
+func TestSendingStat_IsConcerning(t *testing.T) {
+	tests := []struct {
+		stat SendingStat
+		want bool
+	}{
+		{SendingStat{Name: "Reputation.BounceRate", Rate: true, Value: 4.9}, false},
+		{SendingStat{Name: "Reputation.BounceRate", Rate: true, Value: 5}, true},
+		{SendingStat{Name: "Reputation.ComplaintRate", Rate: true, Value: 0.05}, false},
+		{SendingStat{Name: "Reputation.ComplaintRate", Rate: true, Value: 0.1}, true},
+		{SendingStat{Name: "Bounce", Value: 1000}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.stat.IsConcerning(); got != tt.want {
+			t.Errorf("%s=%v IsConcerning() = %v, want %v", tt.stat.Name, tt.stat.Value, got, tt.want)
+		}
+	}
+}
+
+func TestAccountResource_QuotaUsage(t *testing.T) {
+	a := NewAccountResource(&sesv2.GetAccountOutput{
+		SendQuota: &types.SendQuota{Max24HourSend: 50000, MaxSendRate: 14, SentLast24Hours: 12500},
+	})
+	if got := a.QuotaUsage(); got != 25 {
+		t.Errorf("QuotaUsage() = %v, want 25", got)
+	}
+
+	sandbox := NewAccountResource(&sesv2.GetAccountOutput{})
+	if got := sandbox.QuotaUsage(); got != 0 {
+		t.Errorf("QuotaUsage() without quota = %v, want 0", got)
+	}
+}
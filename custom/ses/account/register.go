@@ -0,0 +1,20 @@
+package account
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ses", "account", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewAccountDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewAccountRenderer()
+		},
+	})
+}
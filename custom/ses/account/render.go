@@ -0,0 +1,218 @@
+package account
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// AccountRenderer renders the SES account sending quota and statistics
+type AccountRenderer struct {
+	render.BaseRenderer
+}
+
+// NewAccountRenderer creates a new AccountRenderer
+func NewAccountRenderer() render.Renderer {
+	return &AccountRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ses",
+			Resource: "account",
+			Cols: []render.Column{
+				{Name: "STATUS", Width: 10, Getter: getStatus},
+				{Name: "ACCESS", Width: 11, Getter: getAccess},
+				{Name: "SENDING", Width: 9, Getter: getSending},
+				{Name: "SENT/24H", Width: 20, Getter: getQuota},
+				{Name: "USED", Width: 7, Getter: getUsage},
+				{Name: "RATE/S", Width: 8, Getter: getRate},
+				{Name: "BOUNCE", Width: 8, Getter: statGetter("Reputation.BounceRate")},
+				{Name: "COMPLAINT", Width: 10, Getter: statGetter("Reputation.ComplaintRate")},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	a, ok := r.(*AccountResource)
+	if !ok {
+		return ""
+	}
+	return a.EnforcementStatus()
+}
+
+func getAccess(r dao.Resource) string {
+	a, ok := r.(*AccountResource)
+	if !ok {
+		return ""
+	}
+	return accessLabel(a.Item.ProductionAccessEnabled)
+}
+
+func getSending(r dao.Resource) string {
+	a, ok := r.(*AccountResource)
+	if !ok {
+		return ""
+	}
+	if a.Item.SendingEnabled {
+		return "Enabled"
+	}
+	return "Paused"
+}
+
+func getQuota(r dao.Resource) string {
+	a, ok := r.(*AccountResource)
+	if !ok {
+		return ""
+	}
+	sent, limit, _ := a.Quota()
+	return fmt.Sprintf("%.0f / %.0f", sent, limit)
+}
+
+func getUsage(r dao.Resource) string {
+	a, ok := r.(*AccountResource)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%.0f%%", a.QuotaUsage())
+}
+
+func getRate(r dao.Resource) string {
+	a, ok := r.(*AccountResource)
+	if !ok {
+		return ""
+	}
+	_, _, rate := a.Quota()
+	return fmt.Sprintf("%.0f", rate)
+}
+
+func statGetter(name string) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		a, ok := r.(*AccountResource)
+		if !ok {
+			return ""
+		}
+		if s, ok := a.Stat(name); ok {
+			return s.Format()
+		}
+		return ""
+	}
+}
+
+func accessLabel(production bool) string {
+	if production {
+		return "Production"
+	}
+	return "Sandbox"
+}
+
+func enforcementStyle(status string) render.Style {
+	switch status {
+	case "HEALTHY":
+		return ui.SuccessStyle()
+	case "PROBATION":
+		return ui.WarningStyle()
+	case "SHUTDOWN":
+		return ui.DangerStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for the account
+func (r *AccountRenderer) RenderDetail(resource dao.Resource) string {
+	a, ok := resource.(*AccountResource)
+	if !ok {
+		return ""
+	}
+	item := a.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("SES Account", "Sending")
+
+	d.Section("Status")
+	d.FieldStyled("Enforcement", a.EnforcementStatus(), enforcementStyle(a.EnforcementStatus()))
+	d.Field("Access", accessLabel(item.ProductionAccessEnabled))
+	if item.SendingEnabled {
+		d.FieldStyled("Sending", "Enabled", ui.SuccessStyle())
+	} else {
+		d.FieldStyled("Sending", "Paused", ui.DangerStyle())
+	}
+	d.Field("Dedicated IP Auto Warmup", fmt.Sprintf("%v", item.DedicatedIpAutoWarmupEnabled))
+
+	sent, limit, rate := a.Quota()
+	d.Section("Sending Quota")
+	d.Field("Sent (last 24h)", fmt.Sprintf("%.0f", sent))
+	d.Field("Max per 24h", fmt.Sprintf("%.0f", limit))
+	usage := fmt.Sprintf("%.1f%%", a.QuotaUsage())
+	if a.QuotaUsage() >= 90 {
+		d.FieldStyled("Used", usage, ui.WarningStyle())
+	} else {
+		d.Field("Used", usage)
+	}
+	d.Field("Max Send Rate", fmt.Sprintf("%.0f/s", rate))
+
+	d.Section("Statistics (last 24h)")
+	if len(a.Stats) == 0 {
+		d.DimIndent("No statistics available")
+	}
+	for _, s := range a.Stats {
+		if s.IsConcerning() {
+			d.FieldStyled(s.Label, s.Format(), ui.DangerStyle())
+		} else {
+			d.Field(s.Label, s.Format())
+		}
+	}
+
+	if sa := item.SuppressionAttributes; sa != nil {
+		d.Section("Suppression List")
+		reasons := make([]string, len(sa.SuppressedReasons))
+		for i, reason := range sa.SuppressedReasons {
+			reasons[i] = string(reason)
+		}
+		if len(reasons) > 0 {
+			d.Field("Reasons", strings.Join(reasons, ", "))
+		} else {
+			d.Field("Reasons", "none")
+		}
+	}
+
+	if det := item.Details; det != nil {
+		d.Section("Account Details")
+		if det.MailType != "" {
+			d.Field("Mail Type", string(det.MailType))
+		}
+		d.FieldIf("Website", det.WebsiteURL)
+		if rev := det.ReviewDetails; rev != nil {
+			d.Field("Review Status", string(rev.Status))
+			d.FieldIf("Review Case", rev.CaseId)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for the account
+func (r *AccountRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	a, ok := resource.(*AccountResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Status", Value: a.EnforcementStatus(), Style: enforcementStyle(a.EnforcementStatus())},
+		{Label: "Access", Value: accessLabel(a.Item.ProductionAccessEnabled)},
+		{Label: "Sent/24h", Value: getQuota(a)},
+		{Label: "Used", Value: getUsage(a)},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *AccountRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	return []render.Navigation{
+		{Key: "i", Label: "Identities", Service: "ses", Resource: "identities"},
+		{Key: "s", Label: "Suppression List", Service: "ses", Resource: "suppressed-destinations"},
+	}
+}
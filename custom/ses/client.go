@@ -0,0 +1,14 @@
+package ses
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// GetClient returns an SES v2 client configured for the current context
+func GetClient(ctx context.Context) (*sesv2.Client, error) {
+	return appaws.Client(ctx, sesv2.NewFromConfig)
+}
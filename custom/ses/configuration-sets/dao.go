@@ -0,0 +1,188 @@
+package configurationsets
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"golang.org/x/sync/errgroup"
+
+	sesClient "github.com/clawscli/claws/custom/ses"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// describeConcurrency bounds parallel GetConfigurationSet calls during List.
+const describeConcurrency = 8
+
+// ConfigurationSetDAO provides data access for SES configuration sets
+type ConfigurationSetDAO struct {
+	dao.BaseDAO
+	client *sesv2.Client
+}
+
+// NewConfigurationSetDAO creates a new ConfigurationSetDAO
+func NewConfigurationSetDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := sesClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ConfigurationSetDAO{
+		BaseDAO: dao.NewBaseDAO("ses", "configuration-sets"),
+		client:  client,
+	}, nil
+}
+
+// List returns all configuration sets, or only the one named by the
+// ConfigurationSetName filter. ListConfigurationSets only returns names, so
+// each set is fetched to show its options.
+func (d *ConfigurationSetDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if name := dao.GetFilterFromContext(ctx, "ConfigurationSetName"); name != "" {
+		r, err := d.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return []dao.Resource{r}, nil
+	}
+
+	names, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := d.client.ListConfigurationSets(ctx, &sesv2.ListConfigurationSetsInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list ses configuration sets")
+		}
+		return output.ConfigurationSets, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	resources := make([]dao.Resource, 0, len(names))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeConcurrency)
+	for _, name := range names {
+		g.Go(func() error {
+			output, err := d.get(gctx, name)
+			if err != nil {
+				// Sets deleted between list and get are skipped
+				log.Debug("failed to get ses configuration set", "name", name, "error", err)
+				return nil
+			}
+			mu.Lock()
+			resources = append(resources, NewConfigurationSetResource(output))
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetName() < resources[j].GetName()
+	})
+	return resources, nil
+}
+
+// Get returns a specific configuration set
+func (d *ConfigurationSetDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return NewConfigurationSetResource(output), nil
+}
+
+// Delete deletes a configuration set
+func (d *ConfigurationSetDAO) Delete(ctx context.Context, name string) error {
+	_, err := d.client.DeleteConfigurationSet(ctx, &sesv2.DeleteConfigurationSetInput{
+		ConfigurationSetName: &name,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete ses configuration set %s", name)
+	}
+	return nil
+}
+
+func (d *ConfigurationSetDAO) get(ctx context.Context, name string) (*sesv2.GetConfigurationSetOutput, error) {
+	output, err := d.client.GetConfigurationSet(ctx, &sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: &name,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get ses configuration set %s", name)
+	}
+	return output, nil
+}
+
+// ConfigurationSetResource wraps an SES configuration set
+type ConfigurationSetResource struct {
+	dao.BaseResource
+	Item *sesv2.GetConfigurationSetOutput
+}
+
+// NewConfigurationSetResource creates a new ConfigurationSetResource
+func NewConfigurationSetResource(cs *sesv2.GetConfigurationSetOutput) *ConfigurationSetResource {
+	name := appaws.Str(cs.ConfigurationSetName)
+	r := &ConfigurationSetResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Data: cs,
+		},
+		Item: cs,
+	}
+	if len(cs.Tags) > 0 {
+		r.Tags = make(map[string]string, len(cs.Tags))
+		for _, t := range cs.Tags {
+			r.Tags[appaws.Str(t.Key)] = appaws.Str(t.Value)
+		}
+	}
+	return r
+}
+
+// SendingEnabled reports whether email can be sent with this set
+func (r *ConfigurationSetResource) SendingEnabled() bool {
+	// Sending is enabled unless explicitly turned off
+	return r.Item.SendingOptions == nil || r.Item.SendingOptions.SendingEnabled
+}
+
+// ReputationMetrics reports whether reputation metrics are published
+func (r *ConfigurationSetResource) ReputationMetrics() bool {
+	return r.Item.ReputationOptions != nil && r.Item.ReputationOptions.ReputationMetricsEnabled
+}
+
+// TLSPolicy returns the TLS policy (REQUIRE or OPTIONAL)
+func (r *ConfigurationSetResource) TLSPolicy() string {
+	if r.Item.DeliveryOptions == nil || r.Item.DeliveryOptions.TlsPolicy == "" {
+		return "OPTIONAL"
+	}
+	return string(r.Item.DeliveryOptions.TlsPolicy)
+}
+
+// SendingPool returns the dedicated IP pool, or "" for the shared pool
+func (r *ConfigurationSetResource) SendingPool() string {
+	if r.Item.DeliveryOptions == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.DeliveryOptions.SendingPoolName)
+}
+
+// SuppressedReasons returns the set-level suppression reasons joined by
+// ", ", or "" when the set inherits the account-level suppression list
+func (r *ConfigurationSetResource) SuppressedReasons() string {
+	if r.Item.SuppressionOptions == nil {
+		return ""
+	}
+	reasons := make([]string, len(r.Item.SuppressionOptions.SuppressedReasons))
+	for i, reason := range r.Item.SuppressionOptions.SuppressedReasons {
+		reasons[i] = string(reason)
+	}
+	if len(reasons) == 0 {
+		return "none"
+	}
+	return strings.Join(reasons, ", ")
+}
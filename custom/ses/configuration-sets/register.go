@@ -0,0 +1,20 @@
+package configurationsets
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ses", "configuration-sets", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewConfigurationSetDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewConfigurationSetRenderer()
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package configurationsets
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ConfigurationSetRenderer renders SES configuration sets
+type ConfigurationSetRenderer struct {
+	render.BaseRenderer
+}
+
+// NewConfigurationSetRenderer creates a new ConfigurationSetRenderer
+func NewConfigurationSetRenderer() render.Renderer {
+	return &ConfigurationSetRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ses",
+			Resource: "configuration-sets",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "SENDING", Width: 9, Getter: getSending},
+				{Name: "REPUTATION", Width: 11, Getter: getReputation},
+				{Name: "TLS", Width: 9, Getter: getTLS},
+				{Name: "IP POOL", Width: 20, Getter: getPool},
+				{Name: "SUPPRESSION", Width: 22, Getter: getSuppression},
+			},
+		},
+	}
+}
+
+func getSending(r dao.Resource) string {
+	cs, ok := r.(*ConfigurationSetResource)
+	if !ok {
+		return ""
+	}
+	return yesNo(cs.SendingEnabled())
+}
+
+func getReputation(r dao.Resource) string {
+	cs, ok := r.(*ConfigurationSetResource)
+	if !ok {
+		return ""
+	}
+	return yesNo(cs.ReputationMetrics())
+}
+
+func getTLS(r dao.Resource) string {
+	cs, ok := r.(*ConfigurationSetResource)
+	if !ok {
+		return ""
+	}
+	return cs.TLSPolicy()
+}
+
+func getPool(r dao.Resource) string {
+	cs, ok := r.(*ConfigurationSetResource)
+	if !ok {
+		return ""
+	}
+	return cs.SendingPool()
+}
+
+func getSuppression(r dao.Resource) string {
+	cs, ok := r.(*ConfigurationSetResource)
+	if !ok {
+		return ""
+	}
+	if reasons := cs.SuppressedReasons(); reasons != "" {
+		return reasons
+	}
+	return "account default"
+}
+
+// RenderDetail renders the detail view for a configuration set
+func (r *ConfigurationSetRenderer) RenderDetail(resource dao.Resource) string {
+	cs, ok := resource.(*ConfigurationSetResource)
+	if !ok {
+		return ""
+	}
+	item := cs.Item
+
+	d := render.NewDetailBuilder()
+
+	d.Title("SES Configuration Set", cs.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", cs.GetName())
+	if cs.SendingEnabled() {
+		d.FieldStyled("Sending", "Enabled", ui.SuccessStyle())
+	} else {
+		d.FieldStyled("Sending", "Paused", ui.DangerStyle())
+	}
+
+	d.Section("Delivery")
+	d.Field("TLS Policy", cs.TLSPolicy())
+	if pool := cs.SendingPool(); pool != "" {
+		d.Field("Dedicated IP Pool", pool)
+	} else {
+		d.Field("Dedicated IP Pool", "shared")
+	}
+	if opts := item.DeliveryOptions; opts != nil && opts.MaxDeliverySeconds != nil {
+		d.Field("Max Delivery Time", fmt.Sprintf("%ds", *opts.MaxDeliverySeconds))
+	}
+
+	d.Section("Reputation")
+	d.Field("Metrics", yesNo(cs.ReputationMetrics()))
+	if opts := item.ReputationOptions; opts != nil && opts.LastFreshStart != nil {
+		d.Field("Last Fresh Start", opts.LastFreshStart.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Suppression")
+	if reasons := cs.SuppressedReasons(); reasons != "" {
+		d.Field("Reasons", reasons)
+	} else {
+		d.Field("Reasons", "account default")
+	}
+
+	if opts := item.TrackingOptions; opts != nil {
+		d.Section("Tracking")
+		d.FieldIf("Redirect Domain", opts.CustomRedirectDomain)
+		if opts.HttpsPolicy != "" {
+			d.Field("HTTPS Policy", string(opts.HttpsPolicy))
+		}
+	}
+
+	if opts := item.ArchivingOptions; opts != nil {
+		d.Section("Archiving")
+		d.FieldIf("Archive", opts.ArchiveArn)
+	}
+
+	d.Tags(cs.Tags)
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a configuration set
+func (r *ConfigurationSetRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	cs, ok := resource.(*ConfigurationSetResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Name", Value: cs.GetName()},
+		{Label: "Sending", Value: yesNo(cs.SendingEnabled())},
+		{Label: "TLS", Value: cs.TLSPolicy()},
+		{Label: "Suppression", Value: getSuppression(cs)},
+	}
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
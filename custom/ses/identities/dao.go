@@ -0,0 +1,208 @@
+package identities
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"golang.org/x/sync/errgroup"
+
+	sesClient "github.com/clawscli/claws/custom/ses"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// detailConcurrency bounds parallel GetEmailIdentity calls during List.
+const detailConcurrency = 8
+
+// IdentityDAO provides data access for SES email identities
+type IdentityDAO struct {
+	dao.BaseDAO
+	client *sesv2.Client
+}
+
+// NewIdentityDAO creates a new IdentityDAO
+func NewIdentityDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := sesClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &IdentityDAO{
+		BaseDAO: dao.NewBaseDAO("ses", "identities"),
+		client:  client,
+	}, nil
+}
+
+// List returns all email identities. ListEmailIdentities omits DKIM and
+// MAIL FROM state, so each identity is fetched; failures keep the summary.
+func (d *IdentityDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	infos, err := appaws.Paginate(ctx, func(token *string) ([]types.IdentityInfo, *string, error) {
+		output, err := d.client.ListEmailIdentities(ctx, &sesv2.ListEmailIdentitiesInput{
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list ses identities")
+		}
+		return output.EmailIdentities, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	resources := make([]dao.Resource, 0, len(infos))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(detailConcurrency)
+	for _, info := range infos {
+		g.Go(func() error {
+			r := NewIdentityResource(info)
+			detail, err := d.get(gctx, appaws.Str(info.IdentityName))
+			if err != nil {
+				log.Debug("failed to get ses identity", "identity", r.GetID(), "error", err)
+			} else {
+				r.SetDetail(detail)
+			}
+			mu.Lock()
+			resources = append(resources, r)
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetName() < resources[j].GetName()
+	})
+	return resources, nil
+}
+
+// Get returns a specific identity
+func (d *IdentityDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	detail, err := d.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r := NewIdentityResource(types.IdentityInfo{
+		IdentityName:       &id,
+		IdentityType:       detail.IdentityType,
+		SendingEnabled:     detail.VerifiedForSendingStatus,
+		VerificationStatus: detail.VerificationStatus,
+	})
+	r.SetDetail(detail)
+	return r, nil
+}
+
+// Delete deletes an email identity
+func (d *IdentityDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteEmailIdentity(ctx, &sesv2.DeleteEmailIdentityInput{
+		EmailIdentity: &id,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete ses identity %s", id)
+	}
+	return nil
+}
+
+func (d *IdentityDAO) get(ctx context.Context, id string) (*sesv2.GetEmailIdentityOutput, error) {
+	output, err := d.client.GetEmailIdentity(ctx, &sesv2.GetEmailIdentityInput{
+		EmailIdentity: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get ses identity %s", id)
+	}
+	return output, nil
+}
+
+// IdentityResource wraps an SES email identity
+type IdentityResource struct {
+	dao.BaseResource
+	Item   types.IdentityInfo
+	Detail *sesv2.GetEmailIdentityOutput
+}
+
+// NewIdentityResource creates a new IdentityResource
+func NewIdentityResource(info types.IdentityInfo) *IdentityResource {
+	name := appaws.Str(info.IdentityName)
+	return &IdentityResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Data: info,
+		},
+		Item: info,
+	}
+}
+
+// SetDetail attaches the GetEmailIdentity output and its tags
+func (r *IdentityResource) SetDetail(detail *sesv2.GetEmailIdentityOutput) {
+	r.Detail = detail
+	if len(detail.Tags) > 0 {
+		r.Tags = make(map[string]string, len(detail.Tags))
+		for _, t := range detail.Tags {
+			r.Tags[appaws.Str(t.Key)] = appaws.Str(t.Value)
+		}
+	}
+}
+
+// Type returns the identity type (DOMAIN, EMAIL_ADDRESS, MANAGED_DOMAIN)
+func (r *IdentityResource) Type() string {
+	return string(r.Item.IdentityType)
+}
+
+// IsDomain reports whether the identity is a domain
+func (r *IdentityResource) IsDomain() bool {
+	return r.Item.IdentityType != types.IdentityTypeEmailAddress
+}
+
+// VerificationStatus returns the verification status
+func (r *IdentityResource) VerificationStatus() string {
+	return string(r.Item.VerificationStatus)
+}
+
+// IsPending reports whether verification is still in progress
+func (r *IdentityResource) IsPending() bool {
+	return r.Item.VerificationStatus == types.VerificationStatusPending
+}
+
+// DKIMStatus returns the DKIM status, or "" when unknown
+func (r *IdentityResource) DKIMStatus() string {
+	if r.Detail == nil || r.Detail.DkimAttributes == nil {
+		return ""
+	}
+	return string(r.Detail.DkimAttributes.Status)
+}
+
+// MailFromDomain returns the custom MAIL FROM domain and its status
+func (r *IdentityResource) MailFromDomain() (domain, status string) {
+	if r.Detail == nil || r.Detail.MailFromAttributes == nil {
+		return "", ""
+	}
+	m := r.Detail.MailFromAttributes
+	return appaws.Str(m.MailFromDomain), string(m.MailFromDomainStatus)
+}
+
+// ConfigurationSet returns the default configuration set name
+func (r *IdentityResource) ConfigurationSet() string {
+	if r.Detail == nil {
+		return ""
+	}
+	return appaws.Str(r.Detail.ConfigurationSetName)
+}
+
+// DKIMRecords returns the CNAME records to publish for Easy DKIM tokens;
+// BYODKIM keys are published by the owner and have no records here
+func (r *IdentityResource) DKIMRecords() []string {
+	if r.Detail == nil || r.Detail.DkimAttributes == nil || !r.IsDomain() ||
+		r.Detail.DkimAttributes.SigningAttributesOrigin == types.DkimSigningAttributesOriginExternal {
+		return nil
+	}
+	records := make([]string, len(r.Detail.DkimAttributes.Tokens))
+	for i, token := range r.Detail.DkimAttributes.Tokens {
+		records[i] = token + "._domainkey." + r.GetName() + " CNAME " + token + ".dkim.amazonses.com"
+	}
+	return records
+}
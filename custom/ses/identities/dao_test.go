@@ -0,0 +1,63 @@
+package identities
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+)
+
+func TestIdentityResource_DKIM(t *testing.T) {
+	r := NewIdentityResource(types.IdentityInfo{
+		IdentityName:       aws.String("example.com"),
+		IdentityType:       types.IdentityTypeDomain,
+		VerificationStatus: types.VerificationStatusSuccess,
+	})
+	if r.DKIMStatus() != "" {
+		t.Errorf("DKIMStatus() without detail = %q, want empty", r.DKIMStatus())
+	}
+
+	r.SetDetail(&sesv2.GetEmailIdentityOutput{
+		ConfigurationSetName: aws.String("transactional"),
+		DkimAttributes: &types.DkimAttributes{
+			Status:                  types.DkimStatusSuccess,
+			SigningAttributesOrigin: types.DkimSigningAttributesOriginAwsSes,
+			Tokens:                  []string{"abc123"},
+		},
+		Tags: []types.Tag{{Key: aws.String("team"), Value: aws.String("mail")}},
+	})
+	if got := r.DKIMStatus(); got != "SUCCESS" {
+		t.Errorf("DKIMStatus() = %q, want SUCCESS", got)
+	}
+	if got := r.ConfigurationSet(); got != "transactional" {
+		t.Errorf("ConfigurationSet() = %q, want transactional", got)
+	}
+	if r.Tags["team"] != "mail" {
+		t.Errorf("Tags = %v", r.Tags)
+	}
+	records := r.DKIMRecords()
+	want := "abc123._domainkey.example.com CNAME abc123.dkim.amazonses.com"
+	if len(records) != 1 || records[0] != want {
+		t.Errorf("DKIMRecords() = %v, want [%s]", records, want)
+	}
+
+	r.Detail.DkimAttributes.SigningAttributesOrigin = types.DkimSigningAttributesOriginExternal
+	if records := r.DKIMRecords(); records != nil {
+		t.Errorf("BYODKIM DKIMRecords() = %v, want nil", records)
+	}
+}
+
+func TestIdentityResource_EmailAddress(t *testing.T) {
+	r := NewIdentityResource(types.IdentityInfo{
+		IdentityName:       aws.String("ops@example.com"),
+		IdentityType:       types.IdentityTypeEmailAddress,
+		VerificationStatus: types.VerificationStatusPending,
+	})
+	if r.IsDomain() {
+		t.Error("email address identity should not be a domain")
+	}
+	if !r.IsPending() {
+		t.Error("PENDING identity should be pending")
+	}
+}
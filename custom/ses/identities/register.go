@@ -0,0 +1,20 @@
+package identities
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ses", "identities", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewIdentityDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewIdentityRenderer()
+		},
+	})
+}
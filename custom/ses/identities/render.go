@@ -0,0 +1,209 @@
+package identities
+
+import (
+	"sort"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// IdentityRenderer renders SES email identities
+type IdentityRenderer struct {
+	render.BaseRenderer
+}
+
+// NewIdentityRenderer creates a new IdentityRenderer
+func NewIdentityRenderer() render.Renderer {
+	return &IdentityRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ses",
+			Resource: "identities",
+			Cols: []render.Column{
+				{Name: "IDENTITY", Width: 36, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "TYPE", Width: 14, Getter: getType},
+				{Name: "VERIFICATION", Width: 14, Getter: getVerification},
+				{Name: "DKIM", Width: 18, Getter: getDKIM},
+				{Name: "SENDING", Width: 9, Getter: getSending},
+				{Name: "CONFIG SET", Width: 24, Getter: getConfigSet},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	id, ok := r.(*IdentityResource)
+	if !ok {
+		return ""
+	}
+	return id.Type()
+}
+
+func getVerification(r dao.Resource) string {
+	id, ok := r.(*IdentityResource)
+	if !ok {
+		return ""
+	}
+	return id.VerificationStatus()
+}
+
+func getDKIM(r dao.Resource) string {
+	id, ok := r.(*IdentityResource)
+	if !ok {
+		return ""
+	}
+	return id.DKIMStatus()
+}
+
+func getSending(r dao.Resource) string {
+	id, ok := r.(*IdentityResource)
+	if !ok {
+		return ""
+	}
+	if id.Item.SendingEnabled {
+		return "Yes"
+	}
+	return "No"
+}
+
+func getConfigSet(r dao.Resource) string {
+	id, ok := r.(*IdentityResource)
+	if !ok {
+		return ""
+	}
+	return id.ConfigurationSet()
+}
+
+// statusStyle colors verification, DKIM and MAIL FROM statuses, which share
+// the SUCCESS/PENDING/FAILED/TEMPORARY_FAILURE/NOT_STARTED vocabulary.
+func statusStyle(status string) render.Style {
+	switch status {
+	case "SUCCESS":
+		return ui.SuccessStyle()
+	case "FAILED":
+		return ui.DangerStyle()
+	case "PENDING", "TEMPORARY_FAILURE":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the detail view for an identity
+func (r *IdentityRenderer) RenderDetail(resource dao.Resource) string {
+	id, ok := resource.(*IdentityResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("SES Identity", id.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Identity", id.GetName())
+	d.Field("Type", id.Type())
+	d.FieldStyled("Verification", id.VerificationStatus(), statusStyle(id.VerificationStatus()))
+	d.Field("Sending Enabled", getSending(id))
+	if cs := id.ConfigurationSet(); cs != "" {
+		d.Field("Configuration Set", cs)
+	}
+
+	if id.Detail == nil {
+		d.DimIndent("Identity details unavailable")
+		return d.String()
+	}
+
+	if dkim := id.Detail.DkimAttributes; dkim != nil {
+		d.Section("DKIM")
+		d.FieldStyled("Status", string(dkim.Status), statusStyle(string(dkim.Status)))
+		d.Field("Signing", enabledLabel(dkim.SigningEnabled))
+		d.Field("Origin", string(dkim.SigningAttributesOrigin))
+		if dkim.CurrentSigningKeyLength != "" {
+			d.Field("Key Length", string(dkim.CurrentSigningKeyLength))
+		}
+		if t := dkim.LastKeyGenerationTimestamp; t != nil {
+			d.Field("Key Generated", t.Format("2006-01-02 15:04:05"))
+		}
+		if records := id.DKIMRecords(); len(records) > 0 {
+			d.Field("Records", "")
+			for _, rec := range records {
+				d.DimIndent(rec)
+			}
+		}
+	}
+
+	if domain, status := id.MailFromDomain(); domain != "" {
+		d.Section("MAIL FROM")
+		d.Field("Domain", domain)
+		d.FieldStyled("Status", status, statusStyle(status))
+		d.Field("On MX Failure", string(id.Detail.MailFromAttributes.BehaviorOnMxFailure))
+	}
+
+	d.Section("Feedback")
+	d.Field("Email Forwarding", enabledLabel(id.Detail.FeedbackForwardingStatus))
+
+	if len(id.Detail.Policies) > 0 {
+		d.Section("Sending Authorization Policies")
+		names := make([]string, 0, len(id.Detail.Policies))
+		for name := range id.Detail.Policies {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			d.DimIndent(name)
+		}
+	}
+
+	d.Tags(id.Tags)
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for an identity
+func (r *IdentityRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	id, ok := resource.(*IdentityResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Identity", Value: id.GetName()},
+		{Label: "Type", Value: id.Type()},
+		{Label: "Verification", Value: id.VerificationStatus(), Style: statusStyle(id.VerificationStatus())},
+		{Label: "DKIM", Value: id.DKIMStatus(), Style: statusStyle(id.DKIMStatus())},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *IdentityRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	id, ok := resource.(*IdentityResource)
+	if !ok {
+		return nil
+	}
+	var navs []render.Navigation
+	if cs := id.ConfigurationSet(); cs != "" {
+		navs = append(navs, render.Navigation{
+			Key: "c", Label: "Config Set", Service: "ses", Resource: "configuration-sets",
+			FilterField: "ConfigurationSetName", FilterValue: cs,
+		})
+	}
+	return navs
+}
+
+// NeedsAutoReload keeps the list refreshing while verification is pending
+func (r *IdentityRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if id, ok := dao.UnwrapResource(res).(*IdentityResource); ok && id.IsPending() {
+			return true
+		}
+	}
+	return false
+}
+
+func enabledLabel(enabled bool) string {
+	if enabled {
+		return "Enabled"
+	}
+	return "Disabled"
+}
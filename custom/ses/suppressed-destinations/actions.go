@@ -0,0 +1,56 @@
+package suppresseddestinations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+
+	sesClient "github.com/clawscli/claws/custom/ses"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ses", "suppressed-destinations", []action.Action{
+		{
+			Name:      "Remove from Suppression List",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteSuppressedDestination",
+			Confirm:   action.ConfirmSimple,
+		},
+	})
+
+	action.RegisterExecutor("ses", "suppressed-destinations", executeSuppressedDestinationAction)
+}
+
+func executeSuppressedDestinationAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "DeleteSuppressedDestination":
+		return executeRemove(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeRemove(ctx context.Context, resource dao.Resource) action.ActionResult {
+	s, ok := resource.(*SuppressedDestinationResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := sesClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	email := s.GetID()
+	_, err = client.DeleteSuppressedDestination(ctx, &sesv2.DeleteSuppressedDestinationInput{
+		EmailAddress: &email,
+	})
+	if err != nil {
+		return action.FailResultf(err, "remove %s from suppression list", email)
+	}
+	return action.SuccessResult(fmt.Sprintf("Removed %s from the suppression list", email))
+}
@@ -0,0 +1,120 @@
+package suppresseddestinations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+
+	sesClient "github.com/clawscli/claws/custom/ses"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// SuppressedDestinationDAO provides data access for the SES account-level
+// suppression list
+type SuppressedDestinationDAO struct {
+	dao.BaseDAO
+	client *sesv2.Client
+}
+
+// NewSuppressedDestinationDAO creates a new SuppressedDestinationDAO
+func NewSuppressedDestinationDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := sesClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &SuppressedDestinationDAO{
+		BaseDAO: dao.NewBaseDAO("ses", "suppressed-destinations"),
+		client:  client,
+	}, nil
+}
+
+// List returns suppressed addresses, most recently suppressed first
+func (d *SuppressedDestinationDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	summaries, err := appaws.Paginate(ctx, func(token *string) ([]types.SuppressedDestinationSummary, *string, error) {
+		output, err := d.client.ListSuppressedDestinations(ctx, &sesv2.ListSuppressedDestinationsInput{
+			NextToken: token,
+			PageSize:  appaws.Int32Ptr(1000),
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list ses suppressed destinations")
+		}
+		return output.SuppressedDestinationSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return appaws.Time(summaries[i].LastUpdateTime).After(appaws.Time(summaries[j].LastUpdateTime))
+	})
+
+	resources := make([]dao.Resource, len(summaries))
+	for i, s := range summaries {
+		resources[i] = NewSuppressedDestinationResource(types.SuppressedDestination{
+			EmailAddress:   s.EmailAddress,
+			LastUpdateTime: s.LastUpdateTime,
+			Reason:         s.Reason,
+		})
+	}
+	return resources, nil
+}
+
+// Get returns a suppressed address with the message that caused it
+func (d *SuppressedDestinationDAO) Get(ctx context.Context, email string) (dao.Resource, error) {
+	output, err := d.client.GetSuppressedDestination(ctx, &sesv2.GetSuppressedDestinationInput{
+		EmailAddress: &email,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get ses suppressed destination %s", email)
+	}
+	if output.SuppressedDestination == nil {
+		return nil, fmt.Errorf("ses suppressed destination not found: %s", email)
+	}
+	return NewSuppressedDestinationResource(*output.SuppressedDestination), nil
+}
+
+// Delete removes an address from the suppression list
+func (d *SuppressedDestinationDAO) Delete(ctx context.Context, email string) error {
+	_, err := d.client.DeleteSuppressedDestination(ctx, &sesv2.DeleteSuppressedDestinationInput{
+		EmailAddress: &email,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "remove %s from ses suppression list", email)
+	}
+	return nil
+}
+
+// SuppressedDestinationResource wraps an address on the suppression list
+type SuppressedDestinationResource struct {
+	dao.BaseResource
+	Item types.SuppressedDestination
+}
+
+// NewSuppressedDestinationResource creates a new SuppressedDestinationResource
+func NewSuppressedDestinationResource(dest types.SuppressedDestination) *SuppressedDestinationResource {
+	email := appaws.Str(dest.EmailAddress)
+	return &SuppressedDestinationResource{
+		BaseResource: dao.BaseResource{
+			ID:   email,
+			Name: email,
+			Data: dest,
+		},
+		Item: dest,
+	}
+}
+
+// Reason returns BOUNCE or COMPLAINT
+func (r *SuppressedDestinationResource) Reason() string {
+	return string(r.Item.Reason)
+}
+
+// Domain returns the domain part of the address
+func (r *SuppressedDestinationResource) Domain() string {
+	_, domain, _ := strings.Cut(r.GetName(), "@")
+	return domain
+}
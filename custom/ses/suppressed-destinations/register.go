@@ -0,0 +1,20 @@
+package suppresseddestinations
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ses", "suppressed-destinations", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewSuppressedDestinationDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewSuppressedDestinationRenderer()
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package suppresseddestinations
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// SuppressedDestinationRenderer renders the SES suppression list
+type SuppressedDestinationRenderer struct {
+	render.BaseRenderer
+}
+
+// NewSuppressedDestinationRenderer creates a new SuppressedDestinationRenderer
+func NewSuppressedDestinationRenderer() render.Renderer {
+	return &SuppressedDestinationRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ses",
+			Resource: "suppressed-destinations",
+			Cols: []render.Column{
+				{Name: "EMAIL", Width: 40, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "REASON", Width: 10, Getter: getReason},
+				{Name: "DOMAIN", Width: 24, Getter: getDomain},
+				{Name: "SUPPRESSED", Width: 12, Getter: getAge},
+			},
+		},
+	}
+}
+
+func getReason(r dao.Resource) string {
+	s, ok := r.(*SuppressedDestinationResource)
+	if !ok {
+		return ""
+	}
+	return s.Reason()
+}
+
+func getDomain(r dao.Resource) string {
+	s, ok := r.(*SuppressedDestinationResource)
+	if !ok {
+		return ""
+	}
+	return s.Domain()
+}
+
+func getAge(r dao.Resource) string {
+	s, ok := r.(*SuppressedDestinationResource)
+	if !ok || s.Item.LastUpdateTime == nil {
+		return ""
+	}
+	return render.FormatAge(*s.Item.LastUpdateTime)
+}
+
+func reasonStyle(reason string) render.Style {
+	if reason == "COMPLAINT" {
+		return ui.DangerStyle()
+	}
+	return ui.WarningStyle()
+}
+
+// RenderDetail renders the detail view for a suppressed address
+func (r *SuppressedDestinationRenderer) RenderDetail(resource dao.Resource) string {
+	s, ok := resource.(*SuppressedDestinationResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Suppressed Destination", s.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Email", s.GetName())
+	d.FieldStyled("Reason", s.Reason(), reasonStyle(s.Reason()))
+	if t := s.Item.LastUpdateTime; t != nil {
+		d.Field("Suppressed", t.Format("2006-01-02 15:04:05"))
+	}
+
+	if attrs := s.Item.Attributes; attrs != nil {
+		d.Section("Cause")
+		d.FieldIf("Message ID", attrs.MessageId)
+		d.FieldIf("Feedback ID", attrs.FeedbackId)
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a suppressed address
+func (r *SuppressedDestinationRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	s, ok := resource.(*SuppressedDestinationResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Email", Value: s.GetName()},
+		{Label: "Reason", Value: s.Reason(), Style: reasonStyle(s.Reason())},
+		{Label: "Suppressed", Value: getAge(s)},
+	}
+}
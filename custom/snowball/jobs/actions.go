@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/snowball"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("snowball", "jobs", []action.Action{
+		{
+			Name:      "Cancel Job",
+			Shortcut:  "C",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelJob",
+			Confirm:   action.ConfirmDangerous,
+			Filter: func(r dao.Resource) bool {
+				job, ok := r.(*JobResource)
+				return ok && job.IsCancellable()
+			},
+		},
+	})
+
+	action.RegisterExecutor("snowball", "jobs", executeJobAction)
+}
+
+func executeJobAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CancelJob":
+		return executeCancelJob(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeCancelJob(ctx context.Context, resource dao.Resource) action.ActionResult {
+	job, ok := resource.(*JobResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+	if !job.IsCancellable() {
+		return action.FailResult(fmt.Errorf("job is %s, can only cancel jobs that are still New", job.State))
+	}
+
+	client, err := appaws.Client(ctx, snowball.NewFromConfig)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := job.GetID()
+	if _, err := client.CancelJob(ctx, &snowball.CancelJobInput{JobId: &id}); err != nil {
+		return action.FailResult(fmt.Errorf("cancel snowball job: %w", err))
+	}
+	return action.SuccessResult(fmt.Sprintf("Cancelled Snow Family job %s", id))
+}
@@ -0,0 +1,230 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/snowball"
+	"github.com/aws/aws-sdk-go-v2/service/snowball/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// JobDAO provides data access for Snow Family jobs.
+type JobDAO struct {
+	dao.BaseDAO
+	client *snowball.Client
+}
+
+// NewJobDAO creates a new JobDAO.
+func NewJobDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, snowball.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &JobDAO{
+		BaseDAO: dao.NewBaseDAO("snowball", "jobs"),
+		client:  client,
+	}, nil
+}
+
+// List returns all Snow Family jobs.
+func (d *JobDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	jobs, err := appaws.Paginate(ctx, func(token *string) ([]types.JobListEntry, *string, error) {
+		output, err := d.client.ListJobs(ctx, &snowball.ListJobsInput{
+			MaxResults: appaws.Int32Ptr(100),
+			NextToken:  token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list snowball jobs")
+		}
+		return output.JobListEntries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(jobs))
+	for i, job := range jobs {
+		// Only open jobs are described, for their shipment and transfer
+		// progress; finished ones are described when opened
+		if isActive(job.JobState) {
+			r, err := d.describe(ctx, appaws.Str(job.JobId))
+			if err == nil {
+				resources[i] = r
+				continue
+			}
+			log.Debug("failed to describe snowball job", "id", appaws.Str(job.JobId), "error", err)
+		}
+		resources[i] = NewJobResource(job)
+	}
+	return resources, nil
+}
+
+// Get returns a specific job.
+func (d *JobDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return d.describe(ctx, id)
+}
+
+func (d *JobDAO) describe(ctx context.Context, id string) (*JobResource, error) {
+	output, err := d.client.DescribeJob(ctx, &snowball.DescribeJobInput{JobId: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe snowball job %s", id)
+	}
+	if output.JobMetadata == nil {
+		return nil, fmt.Errorf("snowball job %s not found", id)
+	}
+	return NewJobResourceFromMetadata(*output.JobMetadata), nil
+}
+
+// Delete cancels a job. Snowball only allows this while the job is New.
+func (d *JobDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.CancelJob(ctx, &snowball.CancelJobInput{JobId: &id})
+	if err != nil {
+		return apperrors.Wrapf(err, "cancel snowball job %s", id)
+	}
+	return nil
+}
+
+// isActive returns true for jobs that have not completed or been cancelled.
+func isActive(state types.JobState) bool {
+	switch state {
+	case types.JobStateComplete, types.JobStateCancelled, "":
+		return false
+	default:
+		return true
+	}
+}
+
+// JobResource represents a Snow Family job.
+type JobResource struct {
+	dao.BaseResource
+	State        types.JobState
+	JobType      types.JobType
+	SnowballType types.SnowballType
+	Description  string
+	IsMaster     bool
+	CreationDate *time.Time
+
+	// Populated by DescribeJob only
+	Metadata *types.JobMetadata
+}
+
+// NewJobResource creates a JobResource from a ListJobs entry.
+func NewJobResource(job types.JobListEntry) *JobResource {
+	id := appaws.Str(job.JobId)
+	return &JobResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Tags: make(map[string]string),
+			Data: job,
+		},
+		State:        job.JobState,
+		JobType:      job.JobType,
+		SnowballType: job.SnowballType,
+		Description:  appaws.Str(job.Description),
+		IsMaster:     job.IsMaster,
+		CreationDate: job.CreationDate,
+	}
+}
+
+// NewJobResourceFromMetadata creates a JobResource from DescribeJob.
+func NewJobResourceFromMetadata(job types.JobMetadata) *JobResource {
+	id := appaws.Str(job.JobId)
+	return &JobResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Tags: make(map[string]string),
+			Data: job,
+		},
+		State:        job.JobState,
+		JobType:      job.JobType,
+		SnowballType: job.SnowballType,
+		Description:  appaws.Str(job.Description),
+		CreationDate: job.CreationDate,
+		Metadata:     &job,
+	}
+}
+
+// IsActive returns true until the job is complete or cancelled.
+func (r *JobResource) IsActive() bool {
+	return isActive(r.State)
+}
+
+// IsCancellable returns true while Snowball still accepts CancelJob, which
+// is until the job moves to PreparingAppliance.
+func (r *JobResource) IsCancellable() bool {
+	return r.State == types.JobStateNew
+}
+
+// IsTransferring returns true while AWS is importing or exporting data.
+func (r *JobResource) IsTransferring() bool {
+	return r.State == types.JobStateInProgress
+}
+
+// Transfer returns the job's data transfer counters, once AWS reports them.
+func (r *JobResource) Transfer() *types.DataTransfer {
+	if r.Metadata == nil {
+		return nil
+	}
+	return r.Metadata.DataTransferProgress
+}
+
+// Progress returns the fraction of the data transferred, by bytes or, when
+// no total bytes are known, by objects. ok is false until Snowball reports
+// a total.
+func (r *JobResource) Progress() (fraction float64, ok bool) {
+	t := r.Transfer()
+	switch {
+	case t == nil:
+		return 0, false
+	case t.TotalBytes > 0:
+		return min(float64(t.BytesTransferred)/float64(t.TotalBytes), 1), true
+	case t.TotalObjects > 0:
+		return min(float64(t.ObjectsTransferred)/float64(t.TotalObjects), 1), true
+	default:
+		return 0, false
+	}
+}
+
+// Buckets returns the S3 bucket ARNs the job imports into or exports from.
+func (r *JobResource) Buckets() []string {
+	if r.Metadata == nil || r.Metadata.Resources == nil {
+		return nil
+	}
+	var buckets []string
+	for _, s3 := range r.Metadata.Resources.S3Resources {
+		if arn := appaws.Str(s3.BucketArn); arn != "" {
+			buckets = append(buckets, arn)
+		}
+	}
+	return buckets
+}
+
+// Shipment returns the status and tracking number of the current leg:
+// outbound to the customer until they have the device, then inbound back
+// to AWS.
+func (r *JobResource) Shipment() (status, tracking string) {
+	if r.Metadata == nil || r.Metadata.ShippingDetails == nil {
+		return "", ""
+	}
+	sd := r.Metadata.ShippingDetails
+	s := sd.OutboundShipment
+	switch r.State {
+	case types.JobStateWithCustomer, types.JobStateInTransitToAws, types.JobStateWithAwsSortingFacility,
+		types.JobStateWithAws, types.JobStateInProgress, types.JobStateComplete:
+		if sd.InboundShipment != nil {
+			s = sd.InboundShipment
+		}
+	}
+	if s == nil {
+		return "", ""
+	}
+	return appaws.Str(s.Status), appaws.Str(s.TrackingNumber)
+}
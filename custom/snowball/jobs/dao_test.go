@@ -0,0 +1,110 @@
+package jobs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/snowball/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestIsActive(t *testing.T) {
+	tests := []struct {
+		state types.JobState
+		want  bool
+	}{
+		{types.JobStateNew, true},
+		{types.JobStateWithCustomer, true},
+		{types.JobStateInProgress, true},
+		{types.JobStateComplete, false},
+		{types.JobStateCancelled, false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := isActive(tt.state); got != tt.want {
+			t.Errorf("isActive(%q) = %v, want %v", tt.state, got, tt.want)
+		}
+	}
+}
+
+func TestJobResource_Progress(t *testing.T) {
+	list := NewJobResource(types.JobListEntry{JobId: aws.String("JID1"), JobState: types.JobStateInProgress})
+	if _, ok := list.Progress(); ok {
+		t.Error("Progress() without DescribeJob should report no data")
+	}
+
+	tests := []struct {
+		name     string
+		transfer *types.DataTransfer
+		want     float64
+		wantOK   bool
+	}{
+		{"bytes", &types.DataTransfer{BytesTransferred: 250, TotalBytes: 1000, ObjectsTransferred: 9, TotalObjects: 10}, 0.25, true},
+		{"objects only", &types.DataTransfer{ObjectsTransferred: 3, TotalObjects: 4}, 0.75, true},
+		{"capped", &types.DataTransfer{BytesTransferred: 1200, TotalBytes: 1000}, 1, true},
+		{"no totals", &types.DataTransfer{BytesTransferred: 10}, 0, false},
+		{"none", nil, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := NewJobResourceFromMetadata(types.JobMetadata{JobId: aws.String("JID1"), DataTransferProgress: tt.transfer})
+			got, ok := job.Progress()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Progress() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestJobResource_Shipment(t *testing.T) {
+	shipping := &types.ShippingDetails{
+		OutboundShipment: &types.Shipment{Status: aws.String("Delivered"), TrackingNumber: aws.String("OUT1")},
+		InboundShipment:  &types.Shipment{Status: aws.String("InTransit"), TrackingNumber: aws.String("IN1")},
+	}
+
+	toCustomer := NewJobResourceFromMetadata(types.JobMetadata{JobState: types.JobStateInTransitToCustomer, ShippingDetails: shipping})
+	if status, tracking := toCustomer.Shipment(); status != "Delivered" || tracking != "OUT1" {
+		t.Errorf("to customer Shipment() = %q, %q", status, tracking)
+	}
+
+	toAWS := NewJobResourceFromMetadata(types.JobMetadata{JobState: types.JobStateInTransitToAws, ShippingDetails: shipping})
+	if status, tracking := toAWS.Shipment(); status != "InTransit" || tracking != "IN1" {
+		t.Errorf("to AWS Shipment() = %q, %q", status, tracking)
+	}
+
+	if status, _ := NewJobResource(types.JobListEntry{}).Shipment(); status != "" {
+		t.Errorf("list Shipment() = %q, want empty", status)
+	}
+}
+
+func TestRenderDetail(t *testing.T) {
+	job := NewJobResourceFromMetadata(types.JobMetadata{
+		JobId:                aws.String("JID1"),
+		JobState:             types.JobStateInProgress,
+		JobType:              types.JobTypeImport,
+		SnowballType:         types.SnowballTypeEdge,
+		DataTransferProgress: &types.DataTransfer{BytesTransferred: 512, TotalBytes: 1024, ObjectsTransferred: 5, TotalObjects: 10},
+		Resources:            &types.JobResource{S3Resources: []types.S3Resource{{BucketArn: aws.String("arn:aws:s3:::import-bucket")}}},
+	})
+
+	out := NewJobRenderer().RenderDetail(job)
+	for _, want := range []string{"Data Transfer", "50%", "5 / 10", "import-bucket"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("detail missing %q", want)
+		}
+	}
+	if !NewJobRenderer().(*JobRenderer).NeedsAutoReload([]dao.Resource{job}) {
+		t.Error("NeedsAutoReload() = false for a transferring job")
+	}
+}
+
+func TestJobResource_IsCancellable(t *testing.T) {
+	if !NewJobResource(types.JobListEntry{JobState: types.JobStateNew}).IsCancellable() {
+		t.Error("New job should be cancellable")
+	}
+	if NewJobResource(types.JobListEntry{JobState: types.JobStatePreparingAppliance}).IsCancellable() {
+		t.Error("job preparing its appliance should not be cancellable")
+	}
+}
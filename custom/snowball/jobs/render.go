@@ -0,0 +1,250 @@
+package jobs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/snowball/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// JobRenderer renders Snow Family jobs.
+type JobRenderer struct {
+	render.BaseRenderer
+}
+
+// NewJobRenderer creates a new JobRenderer.
+func NewJobRenderer() render.Renderer {
+	return &JobRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "snowball",
+			Resource: "jobs",
+			Cols: []render.Column{
+				{Name: "JOB ID", Width: 40, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "TYPE", Width: 10, Getter: getJobType},
+				{Name: "DEVICE", Width: 10, Getter: getDevice},
+				{Name: "STATE", Width: 22, Getter: getState},
+				{Name: "PROGRESS", Width: progressBarWidth + 6, Getter: getProgress},
+				{Name: "SHIPMENT", Width: 20, Getter: getShipment},
+				{Name: "CREATED", Width: 17, Getter: getCreated},
+			},
+		},
+	}
+}
+
+func getJobType(r dao.Resource) string {
+	job, ok := r.(*JobResource)
+	if !ok {
+		return ""
+	}
+	return string(job.JobType)
+}
+
+func getDevice(r dao.Resource) string {
+	job, ok := r.(*JobResource)
+	if !ok {
+		return ""
+	}
+	return string(job.SnowballType)
+}
+
+func getState(r dao.Resource) string {
+	job, ok := r.(*JobResource)
+	if !ok {
+		return ""
+	}
+	return string(job.State)
+}
+
+func getProgress(r dao.Resource) string {
+	job, ok := r.(*JobResource)
+	if !ok {
+		return ""
+	}
+	fraction, ok := job.Progress()
+	if !ok {
+		return ""
+	}
+	return progressBar(fraction)
+}
+
+func getShipment(r dao.Resource) string {
+	job, ok := r.(*JobResource)
+	if !ok {
+		return ""
+	}
+	status, _ := job.Shipment()
+	return status
+}
+
+func getCreated(r dao.Resource) string {
+	job, ok := r.(*JobResource)
+	if !ok || job.CreationDate == nil {
+		return ""
+	}
+	return job.CreationDate.Format("2006-01-02 15:04")
+}
+
+// progressBarWidth is the width of the progress bar at 100%.
+const progressBarWidth = 20
+
+// progressBar draws a bar filled to fraction, followed by its percentage
+func progressBar(fraction float64) string {
+	n := int(fraction*progressBarWidth + 0.5)
+	return strings.Repeat("█", n) + strings.Repeat("░", progressBarWidth-n) + fmt.Sprintf(" %3.0f%%", fraction*100)
+}
+
+func stateStyle(state types.JobState) render.Style {
+	switch state {
+	case types.JobStateComplete:
+		return ui.SuccessStyle()
+	case types.JobStateCancelled:
+		return ui.DimStyle()
+	case types.JobStateInProgress, types.JobStateWithCustomer:
+		return ui.WarningStyle()
+	default:
+		return ui.InfoStyle()
+	}
+}
+
+// RenderDetail renders the detail view for a job.
+func (r *JobRenderer) RenderDetail(resource dao.Resource) string {
+	job, ok := resource.(*JobResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Snow Family Job", job.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Job ID", job.GetID())
+	d.FieldStyled("State", string(job.State), stateStyle(job.State))
+	d.Field("Job Type", string(job.JobType))
+	d.Field("Device Type", string(job.SnowballType))
+	if job.Description != "" {
+		d.Field("Description", job.Description)
+	}
+	if t := job.CreationDate; t != nil {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	if m := job.Metadata; m != nil {
+		if m.SnowballCapacityPreference != "" {
+			d.Field("Capacity", string(m.SnowballCapacityPreference))
+		}
+		d.FieldIf("Cluster ID", m.ClusterId)
+		d.FieldIf("Device ID", m.SnowballId)
+	}
+
+	if t := job.Transfer(); t != nil {
+		d.Section("Data Transfer")
+		if fraction, ok := job.Progress(); ok {
+			d.Field("Progress", progressBar(fraction))
+		}
+		d.Field("Transferred", formatTransferred(t.BytesTransferred, t.TotalBytes))
+		d.Field("Objects", formatCount(t.ObjectsTransferred, t.TotalObjects))
+	}
+
+	if buckets := job.Buckets(); len(buckets) > 0 {
+		d.Section("Resources")
+		for _, arn := range buckets {
+			d.Field("S3 Bucket", appaws.ExtractResourceName(arn))
+		}
+	}
+
+	if m := job.Metadata; m != nil && m.ShippingDetails != nil {
+		sd := m.ShippingDetails
+		d.Section("Shipping")
+		if sd.ShippingOption != "" {
+			d.Field("Speed", string(sd.ShippingOption))
+		}
+		if s := sd.OutboundShipment; s != nil {
+			d.Field("To You", shipmentLine(s))
+		}
+		if s := sd.InboundShipment; s != nil {
+			d.Field("To AWS", shipmentLine(s))
+		}
+	}
+
+	if m := job.Metadata; m != nil {
+		if logs := m.JobLogInfo; logs != nil {
+			d.Section("Job Reports")
+			d.FieldIf("Completion Report", logs.JobCompletionReportURI)
+			d.FieldIf("Success Log", logs.JobSuccessLogURI)
+			d.FieldIf("Failure Log", logs.JobFailureLogURI)
+		}
+		if m.KmsKeyARN != nil || m.RoleARN != nil {
+			d.Section("Security")
+			d.FieldIf("KMS Key", m.KmsKeyARN)
+			d.FieldIf("IAM Role", m.RoleARN)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary renders summary fields for a job.
+func (r *JobRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	job, ok := resource.(*JobResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Job ID", Value: job.GetID()},
+		{Label: "State", Value: string(job.State), Style: stateStyle(job.State)},
+		{Label: "Type", Value: string(job.JobType) + " " + string(job.SnowballType)},
+	}
+	if fraction, ok := job.Progress(); ok {
+		fields = append(fields, render.SummaryField{Label: "Progress", Value: progressBar(fraction)})
+	}
+	if status, tracking := job.Shipment(); status != "" {
+		if tracking != "" {
+			status += " (" + tracking + ")"
+		}
+		fields = append(fields, render.SummaryField{Label: "Shipment", Value: status})
+	}
+	return fields
+}
+
+// NeedsAutoReload keeps the list refreshing while AWS is transferring a
+// job's data, so its progress stays live
+func (r *JobRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if job, ok := dao.UnwrapResource(res).(*JobResource); ok && job.IsTransferring() {
+			return true
+		}
+	}
+	return false
+}
+
+func formatTransferred(done, total int64) string {
+	if total > 0 {
+		return render.FormatSize(done) + " / " + render.FormatSize(total)
+	}
+	return render.FormatSize(done)
+}
+
+func formatCount(done, total int64) string {
+	if total > 0 {
+		return fmt.Sprintf("%d / %d", done, total)
+	}
+	return fmt.Sprintf("%d", done)
+}
+
+func shipmentLine(s *types.Shipment) string {
+	line := appaws.Str(s.Status)
+	if line == "" {
+		line = "-"
+	}
+	if t := appaws.Str(s.TrackingNumber); t != "" {
+		line += " (" + t + ")"
+	}
+	return line
+}
@@ -10,9 +10,5 @@ import (
 
 // GetClient returns an SNS client configured for the current context
 func GetClient(ctx context.Context) (*sns.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return sns.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, sns.NewFromConfig)
 }
@@ -20,13 +20,13 @@ type SubscriptionDAO struct {
 
 // NewSubscriptionDAO creates a new SubscriptionDAO
 func NewSubscriptionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sns.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SubscriptionDAO{
 		BaseDAO: dao.NewBaseDAO("sns", "subscriptions"),
-		client:  sns.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
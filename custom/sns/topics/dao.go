@@ -21,13 +21,13 @@ type TopicDAO struct {
 
 // NewTopicDAO creates a new TopicDAO
 func NewTopicDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sns.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TopicDAO{
 		BaseDAO: dao.NewBaseDAO("sns", "topics"),
-		client:  sns.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -10,9 +10,5 @@ import (
 
 // GetClient returns an SQS client configured for the current context
 func GetClient(ctx context.Context) (*sqs.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return sqs.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, sqs.NewFromConfig)
 }
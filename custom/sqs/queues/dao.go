@@ -21,13 +21,13 @@ type QueueDAO struct {
 
 // NewQueueDAO creates a new QueueDAO
 func NewQueueDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sqs.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &QueueDAO{
 		BaseDAO: dao.NewBaseDAO("sqs", "queues"),
-		client:  sqs.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
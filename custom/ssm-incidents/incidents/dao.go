@@ -0,0 +1,301 @@
+package incidents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
+	contacttypes "github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// IncidentDAO provides data access for Incident Manager incidents
+type IncidentDAO struct {
+	dao.BaseDAO
+	client   *ssmincidents.Client
+	contacts *ssmcontacts.Client
+}
+
+// NewIncidentDAO creates a new IncidentDAO
+func NewIncidentDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, ssmincidents.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	contacts, err := appaws.Client(ctx, ssmcontacts.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &IncidentDAO{
+		BaseDAO:  dao.NewBaseDAO("ssm-incidents", "incidents"),
+		client:   client,
+		contacts: contacts,
+	}, nil
+}
+
+// List returns open incidents, newest first, with their engagements. The
+// IncludeResolved toggle lists resolved incidents too.
+func (d *IncidentDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &ssmincidents.ListIncidentRecordsInput{}
+	if dao.GetFilterFromContext(ctx, "IncludeResolved") != "true" {
+		input.Filters = []types.Filter{{
+			Key: aws.String("status"),
+			Condition: &types.ConditionMemberEquals{
+				Value: &types.AttributeValueListMemberStringValues{Value: []string{string(types.IncidentRecordStatusOpen)}},
+			},
+		}}
+	}
+	records, err := appaws.Paginate(ctx, func(token *string) ([]types.IncidentRecordSummary, *string, error) {
+		input.NextToken = token
+		output, err := d.client.ListIncidentRecords(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list incident records")
+		}
+		return output.IncidentRecordSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	engagements := d.listEngagements(ctx, records)
+	resources := make([]dao.Resource, 0, len(records))
+	for _, record := range records {
+		r := NewIncidentResource(record)
+		r.Engagements = engagements[r.GetID()]
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// listEngagements returns the engagements started since the oldest of the
+// incidents, keyed by incident ARN. Engagements only add to the list, so a
+// failure, e.g. without ssm-contacts permissions, is logged.
+func (d *IncidentDAO) listEngagements(ctx context.Context, records []types.IncidentRecordSummary) map[string][]contacttypes.Engagement {
+	var since *time.Time
+	for _, record := range records {
+		if record.CreationTime != nil && (since == nil || record.CreationTime.Before(*since)) {
+			since = record.CreationTime
+		}
+	}
+	if since == nil {
+		return nil
+	}
+	engagements, err := appaws.Paginate(ctx, func(token *string) ([]contacttypes.Engagement, *string, error) {
+		output, err := d.contacts.ListEngagements(ctx, &ssmcontacts.ListEngagementsInput{
+			TimeRangeValue: &contacttypes.TimeRange{StartTime: since},
+			NextToken:      token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list engagements")
+		}
+		return output.Engagements, output.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to list engagements", "error", err)
+		return nil
+	}
+	byIncident := make(map[string][]contacttypes.Engagement)
+	for _, e := range engagements {
+		if id := appaws.Str(e.IncidentId); id != "" {
+			byIncident[id] = append(byIncident[id], e)
+		}
+	}
+	return byIncident
+}
+
+// Get returns an incident with its related items, engagements and the
+// pages each engagement sent
+func (d *IncidentDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetIncidentRecord(ctx, &ssmincidents.GetIncidentRecordInput{Arn: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get incident record %s", id)
+	}
+	if output.IncidentRecord == nil {
+		return nil, fmt.Errorf("incident not found: %s", id)
+	}
+	r := NewIncidentResourceFromDetail(*output.IncidentRecord)
+
+	r.RelatedItems, err = appaws.Paginate(ctx, func(token *string) ([]types.RelatedItem, *string, error) {
+		out, err := d.client.ListRelatedItems(ctx, &ssmincidents.ListRelatedItemsInput{IncidentRecordArn: &id, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list related items of %s", id)
+		}
+		return out.RelatedItems, out.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to list incident related items", "arn", id, "error", err)
+	}
+
+	r.Engagements, err = appaws.Paginate(ctx, func(token *string) ([]contacttypes.Engagement, *string, error) {
+		out, err := d.contacts.ListEngagements(ctx, &ssmcontacts.ListEngagementsInput{IncidentId: &id, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list engagements of %s", id)
+		}
+		return out.Engagements, out.NextToken, nil
+	})
+	if err != nil {
+		log.Warn("failed to list incident engagements", "arn", id, "error", err)
+	}
+
+	r.Pages = make(map[string][]contacttypes.Page)
+	for _, e := range r.Engagements {
+		engagementID := appaws.Str(e.EngagementArn)
+		pages, err := appaws.Paginate(ctx, func(token *string) ([]contacttypes.Page, *string, error) {
+			out, err := d.contacts.ListPagesByEngagement(ctx, &ssmcontacts.ListPagesByEngagementInput{EngagementId: &engagementID, NextToken: token})
+			if err != nil {
+				return nil, nil, apperrors.Wrapf(err, "list pages of %s", engagementID)
+			}
+			return out.Pages, out.NextToken, nil
+		})
+		if err != nil {
+			log.Warn("failed to list engagement pages", "engagement", engagementID, "error", err)
+			continue
+		}
+		r.Pages[engagementID] = pages
+	}
+	return r, nil
+}
+
+// Delete is not supported for incidents
+func (d *IncidentDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for incidents")
+}
+
+// IncidentResource represents an Incident Manager incident record
+type IncidentResource struct {
+	dao.BaseResource
+	Item types.IncidentRecordSummary
+
+	// Engagements are the contacts engaged for the incident.
+	Engagements []contacttypes.Engagement
+
+	// Detail, RelatedItems and Pages (keyed by engagement ARN) are
+	// populated by Get.
+	Detail       *types.IncidentRecord
+	RelatedItems []types.RelatedItem
+	Pages        map[string][]contacttypes.Page
+}
+
+// NewIncidentResource creates a new IncidentResource from a list entry
+func NewIncidentResource(item types.IncidentRecordSummary) *IncidentResource {
+	arn := appaws.Str(item.Arn)
+	return &IncidentResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn,
+			Name: appaws.Str(item.Title),
+			ARN:  arn,
+			Data: item,
+		},
+		Item: item,
+	}
+}
+
+// NewIncidentResourceFromDetail creates a new IncidentResource from
+// GetIncidentRecord
+func NewIncidentResourceFromDetail(record types.IncidentRecord) *IncidentResource {
+	r := NewIncidentResource(types.IncidentRecordSummary{
+		Arn:                  record.Arn,
+		CreationTime:         record.CreationTime,
+		Impact:               record.Impact,
+		IncidentRecordSource: record.IncidentRecordSource,
+		Status:               record.Status,
+		Title:                record.Title,
+		ResolvedTime:         record.ResolvedTime,
+	})
+	r.Data = record
+	r.Detail = &record
+	return r
+}
+
+// Status returns the incident status, OPEN or RESOLVED
+func (r *IncidentResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// IsOpen reports whether the incident is still open
+func (r *IncidentResource) IsOpen() bool {
+	return r.Item.Status == types.IncidentRecordStatusOpen
+}
+
+// Impact returns the impact, 1 (critical) to 5 (no impact), or 0 if unset
+func (r *IncidentResource) Impact() int32 {
+	if r.Item.Impact == nil {
+		return 0
+	}
+	return *r.Item.Impact
+}
+
+// CreationTime returns when the incident was created
+func (r *IncidentResource) CreationTime() time.Time {
+	if r.Item.CreationTime == nil {
+		return time.Time{}
+	}
+	return *r.Item.CreationTime
+}
+
+// ActiveEngagements returns how many engagements have not been stopped
+func (r *IncidentResource) ActiveEngagements() int {
+	n := 0
+	for _, e := range r.Engagements {
+		if e.StopTime == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// EngagementStatus summarizes who has been engaged: "none", the number of
+// active engagements, or "stopped" once every engagement has ended
+func (r *IncidentResource) EngagementStatus() string {
+	switch active := r.ActiveEngagements(); {
+	case len(r.Engagements) == 0:
+		return "none"
+	case active == 0:
+		return "stopped"
+	default:
+		return fmt.Sprintf("%d engaged", active)
+	}
+}
+
+// Acknowledged returns the page of an engagement that was read, if any
+func (r *IncidentResource) Acknowledged(engagementArn string) (contacttypes.Page, bool) {
+	for _, p := range r.Pages[engagementArn] {
+		if p.ReadTime != nil {
+			return p, true
+		}
+	}
+	return contacttypes.Page{}, false
+}
+
+// AutomationExecutionIDs returns the IDs of the SSM Automation runbook
+// executions the incident started
+func (r *IncidentResource) AutomationExecutionIDs() []string {
+	if r.Detail == nil {
+		return nil
+	}
+	var ids []string
+	for _, e := range r.Detail.AutomationExecutions {
+		if arn, ok := e.(*types.AutomationExecutionMemberSsmExecutionArn); ok {
+			ids = append(ids, arnResourceID(arn.Value))
+		}
+	}
+	return ids
+}
+
+// arnResourceID returns the part of an ARN after its last slash, e.g. a
+// contact alias or an automation execution ID
+func arnResourceID(arn string) string {
+	if i := strings.LastIndex(arn, "/"); i >= 0 {
+		return arn[i+1:]
+	}
+	return arn
+}
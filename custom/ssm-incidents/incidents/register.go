@@ -0,0 +1,20 @@
+package incidents
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ssm-incidents", "incidents", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewIncidentDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewIncidentRenderer()
+		},
+	})
+}
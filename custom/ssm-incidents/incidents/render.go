@@ -0,0 +1,233 @@
+package incidents
+
+import (
+	"fmt"
+
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var (
+	_ render.Toggler   = (*IncidentRenderer)(nil)
+	_ render.Navigator = (*IncidentRenderer)(nil)
+)
+
+// IncidentRenderer renders Incident Manager incidents
+type IncidentRenderer struct {
+	render.BaseRenderer
+}
+
+// NewIncidentRenderer creates a new IncidentRenderer
+func NewIncidentRenderer() render.Renderer {
+	return &IncidentRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm-incidents",
+			Resource: "incidents",
+			Cols: []render.Column{
+				{Name: "TITLE", Width: 44, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "IMPACT", Width: 7, Getter: getImpact, Priority: 0},
+				{Name: "STATUS", Width: 9, Getter: getStatus, Priority: 0},
+				{Name: "ENGAGEMENT", Width: 11, Getter: getEngagement, Priority: 1},
+				{Name: "SOURCE", Width: 24, Getter: getSource, Priority: 2},
+				{Name: "AGE", Width: 8, Getter: getAge, Priority: 1},
+			},
+		},
+	}
+}
+
+func getImpact(r dao.Resource) string {
+	if i, ok := r.(*IncidentResource); ok && i.Impact() > 0 {
+		return fmt.Sprintf("%d", i.Impact())
+	}
+	return "-"
+}
+
+func getStatus(r dao.Resource) string {
+	if i, ok := r.(*IncidentResource); ok {
+		return i.Status()
+	}
+	return ""
+}
+
+func getEngagement(r dao.Resource) string {
+	if i, ok := r.(*IncidentResource); ok {
+		return i.EngagementStatus()
+	}
+	return ""
+}
+
+func getSource(r dao.Resource) string {
+	if i, ok := r.(*IncidentResource); ok && i.Item.IncidentRecordSource != nil {
+		return appaws.Str(i.Item.IncidentRecordSource.Source)
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if i, ok := r.(*IncidentResource); ok {
+		if t := i.CreationTime(); !t.IsZero() {
+			return render.FormatAge(t)
+		}
+	}
+	return "-"
+}
+
+// impactStyle colors an impact, 1 being critical
+func impactStyle(impact int32) lipgloss.Style {
+	switch impact {
+	case 1, 2:
+		return ui.DangerStyle()
+	case 3:
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// relatedItemValue returns the ARN, URL or metric a related item points at
+func relatedItemValue(item types.RelatedItem) string {
+	if item.Identifier == nil {
+		return ""
+	}
+	switch v := item.Identifier.Value.(type) {
+	case *types.ItemValueMemberArn:
+		return v.Value
+	case *types.ItemValueMemberUrl:
+		return v.Value
+	case *types.ItemValueMemberMetricDefinition:
+		return v.Value
+	case *types.ItemValueMemberPagerDutyIncidentDetail:
+		return appaws.Str(v.Value.Id)
+	}
+	return ""
+}
+
+// RenderDetail renders the incident with its engagements and related items
+func (r *IncidentRenderer) RenderDetail(resource dao.Resource) string {
+	i, ok := resource.(*IncidentResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Incident", i.GetName())
+
+	d.Section("Incident")
+	d.Field("Title", i.GetName())
+	if i.IsOpen() {
+		d.FieldStyled("Status", i.Status(), ui.DangerStyle())
+	} else {
+		d.FieldStyled("Status", i.Status(), ui.SuccessStyle())
+	}
+	if impact := i.Impact(); impact > 0 {
+		d.FieldStyled("Impact", fmt.Sprintf("%d", impact), impactStyle(impact))
+	}
+	if src := i.Item.IncidentRecordSource; src != nil {
+		d.FieldIf("Source", src.Source)
+		d.FieldIf("Created By", src.CreatedBy)
+		d.FieldIf("Invoked By", src.InvokedBy)
+		d.FieldIf("Triggered By", src.ResourceArn)
+	}
+	if t := i.CreationTime(); !t.IsZero() {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if i.Item.ResolvedTime != nil {
+		d.Field("Resolved", i.Item.ResolvedTime.Format("2006-01-02 15:04:05"))
+	}
+	d.Field("ARN", i.GetARN())
+
+	if i.Detail != nil {
+		if summary := appaws.Str(i.Detail.Summary); summary != "" {
+			d.Section("Summary")
+			d.Line(summary)
+		}
+	}
+
+	d.Section("Engagements")
+	if len(i.Engagements) == 0 {
+		d.Dim("No contacts engaged")
+	}
+	for _, e := range i.Engagements {
+		contact := arnResourceID(appaws.Str(e.ContactArn))
+		var value string
+		style := ui.WarningStyle()
+		switch page, acked := i.Acknowledged(appaws.Str(e.EngagementArn)); {
+		case acked:
+			value = "acknowledged " + render.FormatAge(*page.ReadTime) + " ago"
+			style = ui.SuccessStyle()
+		case e.StopTime != nil:
+			value = "stopped"
+			style = ui.DimStyle()
+		case e.StartTime != nil:
+			value = "engaged " + render.FormatAge(*e.StartTime) + " ago"
+		default:
+			value = "engaged"
+		}
+		d.FieldStyled(contact, value, style)
+	}
+
+	if len(i.RelatedItems) > 0 {
+		d.Section("Related Items")
+		for _, item := range i.RelatedItems {
+			label := appaws.Str(item.Title)
+			if label == "" && item.Identifier != nil {
+				label = string(item.Identifier.Type)
+			}
+			d.Field(label, relatedItemValue(item))
+		}
+	}
+
+	if ids := i.AutomationExecutionIDs(); len(ids) > 0 {
+		d.Section("Runbook Executions")
+		for _, id := range ids {
+			d.Line(id)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *IncidentRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	i, ok := resource.(*IncidentResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "Title", Value: i.GetName()},
+		{Label: "Status", Value: i.Status()},
+	}
+	if impact := i.Impact(); impact > 0 {
+		fields = append(fields, render.SummaryField{Label: "Impact", Value: fmt.Sprintf("%d", impact), Style: impactStyle(impact)})
+	}
+	fields = append(fields, render.SummaryField{Label: "Engagement", Value: i.EngagementStatus()})
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *IncidentRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	i, ok := resource.(*IncidentResource)
+	if !ok {
+		return nil
+	}
+	ids := i.AutomationExecutionIDs()
+	if len(ids) == 0 {
+		return nil
+	}
+	return []render.Navigation{{
+		Key: "a", Label: "Runbook", Service: "ssm", Resource: "automation-executions",
+		FilterField: "AutomationExecutionId", FilterValue: ids[0],
+	}}
+}
+
+// ListToggles returns the resolved incidents toggle
+func (r *IncidentRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "r", ContextKey: "IncludeResolved", LabelOn: "all", LabelOff: "open"},
+	}
+}
@@ -0,0 +1,59 @@
+package incidents
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	contacttypes "github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+)
+
+func TestIncidentEngagementStatus(t *testing.T) {
+	r := NewIncidentResource(types.IncidentRecordSummary{
+		Arn:    aws.String("arn:aws:ssm-incidents::111122223333:incident-record/plan/abc"),
+		Title:  aws.String("API errors"),
+		Status: types.IncidentRecordStatusOpen,
+		Impact: aws.Int32(2),
+	})
+	if r.GetID() != r.GetARN() || r.GetName() != "API errors" || !r.IsOpen() || r.Impact() != 2 {
+		t.Errorf("id = %q, name = %q, open = %v", r.GetID(), r.GetName(), r.IsOpen())
+	}
+	if got := r.EngagementStatus(); got != "none" {
+		t.Errorf("EngagementStatus() = %q, want none", got)
+	}
+
+	now := time.Now()
+	r.Engagements = []contacttypes.Engagement{
+		{EngagementArn: aws.String("e-1"), ContactArn: aws.String("arn:aws:ssm-contacts:us-east-1:111122223333:contact/alice"), StartTime: &now},
+		{EngagementArn: aws.String("e-2"), StartTime: &now, StopTime: &now},
+	}
+	if got := r.EngagementStatus(); got != "1 engaged" {
+		t.Errorf("EngagementStatus() = %q, want 1 engaged", got)
+	}
+	if _, ok := r.Acknowledged("e-1"); ok {
+		t.Error("unread engagement reported acknowledged")
+	}
+	r.Pages = map[string][]contacttypes.Page{"e-1": {{SentTime: &now}, {ReadTime: &now}}}
+	if _, ok := r.Acknowledged("e-1"); !ok {
+		t.Error("read page not reported acknowledged")
+	}
+
+	r.Engagements = r.Engagements[1:]
+	if got := r.EngagementStatus(); got != "stopped" {
+		t.Errorf("EngagementStatus() = %q, want stopped", got)
+	}
+}
+
+func TestIncidentAutomationExecutionIDs(t *testing.T) {
+	r := NewIncidentResourceFromDetail(types.IncidentRecord{
+		Arn: aws.String("arn:aws:ssm-incidents::111122223333:incident-record/plan/abc"),
+		AutomationExecutions: []types.AutomationExecution{
+			&types.AutomationExecutionMemberSsmExecutionArn{Value: "arn:aws:ssm:us-east-1:111122223333:automation-execution/0a1b2c3d"},
+		},
+	})
+	if got := r.AutomationExecutionIDs(); !slices.Equal(got, []string{"0a1b2c3d"}) {
+		t.Errorf("AutomationExecutionIDs() = %v", got)
+	}
+}
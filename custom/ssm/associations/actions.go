@@ -0,0 +1,47 @@
+package associations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ssm", "associations", []action.Action{
+		{
+			Name:      "Run Now",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RunNow",
+			Confirm:   action.ConfirmSimple,
+		},
+	})
+
+	action.RegisterExecutor("ssm", "associations", executeAssociationAction)
+}
+
+func executeAssociationAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RunNow":
+		return executeRunNow(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeRunNow(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	id := resource.GetID()
+	if _, err := client.StartAssociationsOnce(ctx, &ssm.StartAssociationsOnceInput{AssociationIds: []string{id}}); err != nil {
+		return action.FailResultf(err, "start association %s", id)
+	}
+	return action.SuccessResult(fmt.Sprintf("Started %s on its targets", resource.GetName()))
+}
@@ -0,0 +1,163 @@
+package associations
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// AssociationDAO provides data access for State Manager associations
+type AssociationDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewAssociationDAO creates a new AssociationDAO
+func NewAssociationDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &AssociationDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "associations"),
+		client:  client,
+	}, nil
+}
+
+// List returns all State Manager associations
+func (d *AssociationDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	associations, err := appaws.Paginate(ctx, func(token *string) ([]types.Association, *string, error) {
+		output, err := d.client.ListAssociations(ctx, &ssm.ListAssociationsInput{NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list associations")
+		}
+		return output.Associations, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(associations))
+	for i, a := range associations {
+		resources[i] = NewAssociationResource(a)
+	}
+	return resources, nil
+}
+
+// Get returns an association with its parameters and recent executions
+func (d *AssociationDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeAssociation(ctx, &ssm.DescribeAssociationInput{AssociationId: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe association %s", id)
+	}
+	if output.AssociationDescription == nil {
+		return nil, fmt.Errorf("association not found: %s", id)
+	}
+	r := NewAssociationResourceFromDetail(*output.AssociationDescription)
+
+	executions, err := d.client.DescribeAssociationExecutions(ctx, &ssm.DescribeAssociationExecutionsInput{
+		AssociationId: &id,
+		MaxResults:    appaws.Int32Ptr(10),
+	})
+	if err != nil {
+		log.Warn("failed to describe association executions", "association", id, "error", err)
+	} else {
+		r.Executions = executions.AssociationExecutions
+	}
+	return r, nil
+}
+
+// Delete is not supported for associations
+func (d *AssociationDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for associations")
+}
+
+// AssociationResource represents a State Manager association
+type AssociationResource struct {
+	dao.BaseResource
+	Item types.Association
+
+	// Detail and Executions (newest first) are populated by Get.
+	Detail     *types.AssociationDescription
+	Executions []types.AssociationExecution
+}
+
+// NewAssociationResource creates a new AssociationResource from a list entry
+func NewAssociationResource(item types.Association) *AssociationResource {
+	return &AssociationResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(item.AssociationId),
+			Name: cmp.Or(appaws.Str(item.AssociationName), appaws.Str(item.Name)),
+			Data: item,
+		},
+		Item: item,
+	}
+}
+
+// NewAssociationResourceFromDetail creates a new AssociationResource from
+// DescribeAssociation
+func NewAssociationResourceFromDetail(desc types.AssociationDescription) *AssociationResource {
+	r := NewAssociationResource(types.Association{
+		AssociationId:      desc.AssociationId,
+		AssociationName:    desc.AssociationName,
+		AssociationVersion: desc.AssociationVersion,
+		DocumentVersion:    desc.DocumentVersion,
+		Duration:           desc.Duration,
+		InstanceId:         desc.InstanceId,
+		LastExecutionDate:  desc.LastExecutionDate,
+		Name:               desc.Name,
+		Overview:           desc.Overview,
+		ScheduleExpression: desc.ScheduleExpression,
+		ScheduleOffset:     desc.ScheduleOffset,
+		TargetMaps:         desc.TargetMaps,
+		Targets:            desc.Targets,
+	})
+	r.Data = desc
+	r.Detail = &desc
+	return r
+}
+
+// Document returns the name of the document the association applies
+func (r *AssociationResource) Document() string {
+	return appaws.Str(r.Item.Name)
+}
+
+// Status returns the overall status, e.g. Success, Failed or Pending
+func (r *AssociationResource) Status() string {
+	if r.Item.Overview == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.Overview.Status)
+}
+
+// Targets describes what the association applies to
+func (r *AssociationResource) Targets() string {
+	if id := appaws.Str(r.Item.InstanceId); id != "" {
+		return id
+	}
+	return appssm.FormatTargets(r.Item.Targets)
+}
+
+// Schedule returns the cron or rate expression, or "" for a one-time
+// association that only runs when created or updated
+func (r *AssociationResource) Schedule() string {
+	return appaws.Str(r.Item.ScheduleExpression)
+}
+
+// LastExecution returns when the association last ran
+func (r *AssociationResource) LastExecution() time.Time {
+	if r.Item.LastExecutionDate == nil {
+		return time.Time{}
+	}
+	return *r.Item.LastExecutionDate
+}
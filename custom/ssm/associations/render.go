@@ -0,0 +1,192 @@
+package associations
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Navigator = (*AssociationRenderer)(nil)
+
+// AssociationRenderer renders State Manager associations
+type AssociationRenderer struct {
+	render.BaseRenderer
+}
+
+// NewAssociationRenderer creates a new AssociationRenderer
+func NewAssociationRenderer() render.Renderer {
+	return &AssociationRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "associations",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "DOCUMENT", Width: 32, Getter: getDocument, Priority: 1},
+				{Name: "STATUS", Width: 10, Getter: getStatus, Priority: 0},
+				{Name: "TARGETS", Width: 30, Getter: getTargets, Priority: 2},
+				{Name: "SCHEDULE", Width: 24, Getter: getSchedule, Priority: 1},
+				{Name: "LAST RUN", Width: 9, Getter: getLastRun, Priority: 0},
+			},
+		},
+	}
+}
+
+func getDocument(r dao.Resource) string {
+	if a, ok := r.(*AssociationResource); ok {
+		return a.Document()
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if a, ok := r.(*AssociationResource); ok && a.Status() != "" {
+		return a.Status()
+	}
+	return "-"
+}
+
+func getTargets(r dao.Resource) string {
+	if a, ok := r.(*AssociationResource); ok {
+		return a.Targets()
+	}
+	return ""
+}
+
+func getSchedule(r dao.Resource) string {
+	if a, ok := r.(*AssociationResource); ok && a.Schedule() != "" {
+		return a.Schedule()
+	}
+	return "-"
+}
+
+func getLastRun(r dao.Resource) string {
+	if a, ok := r.(*AssociationResource); ok {
+		if t := a.LastExecution(); !t.IsZero() {
+			return render.FormatAge(t)
+		}
+	}
+	return "-"
+}
+
+// statusStyle colors an association or execution status
+func statusStyle(status string) lipgloss.Style {
+	switch status {
+	case "Success":
+		return ui.SuccessStyle()
+	case "Failed", "TimedOut", "Cancelled":
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+// RenderDetail renders the association with its last execution results
+func (r *AssociationRenderer) RenderDetail(resource dao.Resource) string {
+	a, ok := resource.(*AssociationResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("State Manager Association", a.GetName())
+
+	d.Section("Association")
+	d.Field("Association ID", a.GetID())
+	d.FieldIf("Name", a.Item.AssociationName)
+	d.Field("Document", a.Document())
+	d.FieldIf("Document Version", a.Item.DocumentVersion)
+	d.FieldIf("Association Version", a.Item.AssociationVersion)
+	d.Field("Targets", a.Targets())
+	if schedule := a.Schedule(); schedule != "" {
+		d.Field("Schedule", schedule)
+	} else {
+		d.Field("Schedule", "none (runs when created or updated)")
+	}
+	if t := a.LastExecution(); !t.IsZero() {
+		d.Field("Last Run", t.Format("2006-01-02 15:04:05"))
+	}
+
+	if o := a.Item.Overview; o != nil {
+		d.Section("Status")
+		if status := appaws.Str(o.Status); status != "" {
+			d.FieldStyled("Status", status, statusStyle(status))
+		}
+		d.FieldIf("Detailed Status", o.DetailedStatus)
+		for _, k := range slices.Sorted(maps.Keys(o.AssociationStatusAggregatedCount)) {
+			d.FieldStyled(k, fmt.Sprintf("%d", o.AssociationStatusAggregatedCount[k]), statusStyle(k))
+		}
+	}
+
+	if a.Detail != nil {
+		if t := a.Detail.LastSuccessfulExecutionDate; t != nil {
+			d.Field("Last Success", t.Format("2006-01-02 15:04:05"))
+		}
+		d.FieldIf("Max Concurrency", a.Detail.MaxConcurrency)
+		d.FieldIf("Max Errors", a.Detail.MaxErrors)
+		if a.Detail.ComplianceSeverity != "" {
+			d.Field("Compliance Severity", string(a.Detail.ComplianceSeverity))
+		}
+		if len(a.Detail.Parameters) > 0 {
+			d.Section("Parameters")
+			for _, k := range slices.Sorted(maps.Keys(a.Detail.Parameters)) {
+				d.Field(k, strings.Join(a.Detail.Parameters[k], ", "))
+			}
+		}
+
+		d.Section("Recent Executions")
+		if len(a.Executions) == 0 {
+			d.Dim("No executions yet")
+		}
+		for _, e := range a.Executions {
+			label := appaws.Str(e.ExecutionId)
+			if e.CreatedTime != nil {
+				label = e.CreatedTime.Local().Format("2006-01-02 15:04")
+			}
+			status := appaws.Str(e.Status)
+			value := status
+			if counts := appaws.Str(e.ResourceCountByStatus); counts != "" {
+				value += "  " + counts
+			}
+			d.FieldStyled(label, value, statusStyle(status))
+			if detailed := appaws.Str(e.DetailedStatus); detailed != "" && detailed != status {
+				d.DimIndent(detailed)
+			}
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *AssociationRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	a, ok := resource.(*AssociationResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Name", Value: a.GetName()},
+		{Label: "Document", Value: a.Document()},
+		{Label: "Status", Value: getStatus(a), Style: statusStyle(a.Status())},
+		{Label: "Schedule", Value: getSchedule(a)},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *AssociationRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	a, ok := resource.(*AssociationResource)
+	if !ok {
+		return nil
+	}
+	return []render.Navigation{{
+		Key: "D", Label: "Document", Service: "ssm", Resource: "documents",
+		FilterField: "Name", FilterValue: a.Document(),
+	}}
+}
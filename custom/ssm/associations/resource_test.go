@@ -0,0 +1,33 @@
+package associations
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+func TestAssociationResource(t *testing.T) {
+	named := NewAssociationResource(types.Association{
+		AssociationId:   aws.String("a-1"),
+		AssociationName: aws.String("inventory"),
+		Name:            aws.String("AWS-GatherSoftwareInventory"),
+		Targets:         []types.Target{{Key: aws.String("InstanceIds"), Values: []string{"*"}}},
+		Overview:        &types.AssociationOverview{Status: aws.String("Success")},
+	})
+	if named.GetName() != "inventory" || named.Document() != "AWS-GatherSoftwareInventory" {
+		t.Errorf("name = %q, document = %q", named.GetName(), named.Document())
+	}
+	if named.Status() != "Success" || named.Targets() != "InstanceIds=*" || getSchedule(named) != "-" {
+		t.Errorf("status = %q, targets = %q, schedule = %q", named.Status(), named.Targets(), getSchedule(named))
+	}
+
+	legacy := NewAssociationResource(types.Association{
+		AssociationId: aws.String("a-2"),
+		Name:          aws.String("AWS-UpdateSSMAgent"),
+		InstanceId:    aws.String("i-1"),
+	})
+	if legacy.GetName() != "AWS-UpdateSSMAgent" || legacy.Targets() != "i-1" || legacy.Status() != "" {
+		t.Errorf("name = %q, targets = %q, status = %q", legacy.GetName(), legacy.Targets(), legacy.Status())
+	}
+}
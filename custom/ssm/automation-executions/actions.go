@@ -0,0 +1,75 @@
+package automationexecutions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	commentInput := &action.InputSpec{Label: "Comment", Placeholder: "optional", Optional: true}
+	awaitingApproval := func(r dao.Resource) bool {
+		e, ok := dao.UnwrapResource(r).(*ExecutionResource)
+		return ok && e.AwaitingApproval()
+	}
+
+	action.Global.Register("ssm", "automation-executions", []action.Action{
+		{
+			Name:      "Approve",
+			Shortcut:  "A",
+			Type:      action.ActionTypeAPI,
+			Operation: "Approve",
+			Confirm:   action.ConfirmSimple,
+			Input:     commentInput,
+			Filter:    awaitingApproval,
+		},
+		{
+			Name:      "Reject",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "Reject",
+			Confirm:   action.ConfirmSimple,
+			Input:     commentInput,
+			Filter:    awaitingApproval,
+		},
+	})
+
+	action.RegisterExecutor("ssm", "automation-executions", executeExecutionAction)
+}
+
+func executeExecutionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "Approve":
+		return executeSignal(ctx, resource, types.SignalTypeApprove)
+	case "Reject":
+		return executeSignal(ctx, resource, types.SignalTypeReject)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// executeSignal answers the aws:approve step an execution is waiting on
+func executeSignal(ctx context.Context, resource dao.Resource, signal types.SignalType) action.ActionResult {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	id := resource.GetID()
+	input := &ssm.SendAutomationSignalInput{
+		AutomationExecutionId: &id,
+		SignalType:            signal,
+	}
+	if comment := action.InputFromContext(ctx); comment != "" {
+		input.Payload = map[string][]string{"Comment": {comment}}
+	}
+	if _, err := client.SendAutomationSignal(ctx, input); err != nil {
+		return action.FailResultf(err, "send %s signal to %s", signal, id)
+	}
+	return action.SuccessResult(fmt.Sprintf("Sent %s to %s", signal, id))
+}
@@ -0,0 +1,195 @@
+package automationexecutions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ApproveAction is the automation action that waits for an approval signal.
+const ApproveAction = "aws:approve"
+
+// ExecutionDAO provides data access for SSM Automation executions
+type ExecutionDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewExecutionDAO creates a new ExecutionDAO
+func NewExecutionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ExecutionDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "automation-executions"),
+		client:  client,
+	}, nil
+}
+
+// List returns automation executions, newest first. An AutomationExecutionId
+// or DocumentName filter in context narrows the list.
+func (d *ExecutionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &ssm.DescribeAutomationExecutionsInput{}
+	docName := dao.GetFilterFromContext(ctx, "DocumentName")
+	if id := dao.GetFilterFromContext(ctx, "AutomationExecutionId"); id != "" {
+		input.Filters = append(input.Filters, types.AutomationExecutionFilter{
+			Key: types.AutomationExecutionFilterKeyExecutionId, Values: []string{id},
+		})
+	}
+	if docName != "" {
+		input.Filters = append(input.Filters, types.AutomationExecutionFilter{
+			Key: types.AutomationExecutionFilterKeyDocumentNamePrefix, Values: []string{docName},
+		})
+	}
+
+	items, err := appaws.Paginate(ctx, func(token *string) ([]types.AutomationExecutionMetadata, *string, error) {
+		input.NextToken = token
+		output, err := d.client.DescribeAutomationExecutions(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe automation executions")
+		}
+		return output.AutomationExecutionMetadataList, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(items))
+	for _, item := range items {
+		// The API filters by prefix; keep only the document asked for
+		if docName != "" && appaws.Str(item.DocumentName) != docName {
+			continue
+		}
+		resources = append(resources, NewExecutionResource(item))
+	}
+	return resources, nil
+}
+
+// Get returns an automation execution with its step executions
+func (d *ExecutionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetAutomationExecution(ctx, &ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: &id,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get automation execution %s", id)
+	}
+	if output.AutomationExecution == nil {
+		return nil, fmt.Errorf("automation execution not found: %s", id)
+	}
+	return NewExecutionResourceFromDetail(*output.AutomationExecution), nil
+}
+
+// Delete is not supported for automation executions
+func (d *ExecutionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for automation executions")
+}
+
+// ExecutionResource represents an SSM Automation execution
+type ExecutionResource struct {
+	dao.BaseResource
+	Item types.AutomationExecutionMetadata
+
+	// Detail carries the step executions, populated by Get.
+	Detail *types.AutomationExecution
+}
+
+// NewExecutionResource creates a new ExecutionResource from a list entry
+func NewExecutionResource(item types.AutomationExecutionMetadata) *ExecutionResource {
+	id := appaws.Str(item.AutomationExecutionId)
+	return &ExecutionResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: appaws.Str(item.DocumentName),
+			Data: item,
+		},
+		Item: item,
+	}
+}
+
+// NewExecutionResourceFromDetail creates a new ExecutionResource from
+// GetAutomationExecution, which also carries the step executions
+func NewExecutionResourceFromDetail(exec types.AutomationExecution) *ExecutionResource {
+	r := NewExecutionResource(types.AutomationExecutionMetadata{
+		AutomationExecutionId:       exec.AutomationExecutionId,
+		AutomationExecutionStatus:   exec.AutomationExecutionStatus,
+		CurrentAction:               exec.CurrentAction,
+		CurrentStepName:             exec.CurrentStepName,
+		DocumentName:                exec.DocumentName,
+		DocumentVersion:             exec.DocumentVersion,
+		ExecutedBy:                  exec.ExecutedBy,
+		ExecutionStartTime:          exec.ExecutionStartTime,
+		ExecutionEndTime:            exec.ExecutionEndTime,
+		FailureMessage:              exec.FailureMessage,
+		Mode:                        exec.Mode,
+		Outputs:                     exec.Outputs,
+		ParentAutomationExecutionId: exec.ParentAutomationExecutionId,
+		Target:                      exec.Target,
+		Targets:                     exec.Targets,
+	})
+	r.Data = exec
+	r.Detail = &exec
+	return r
+}
+
+// Status returns the execution status, e.g. InProgress or Success
+func (r *ExecutionResource) Status() string {
+	return string(r.Item.AutomationExecutionStatus)
+}
+
+// CurrentStep returns the step running now, or "" once finished
+func (r *ExecutionResource) CurrentStep() string {
+	return appaws.Str(r.Item.CurrentStepName)
+}
+
+// AwaitingApproval reports whether the execution is paused on an
+// aws:approve step that Approve and Reject can signal
+func (r *ExecutionResource) AwaitingApproval() bool {
+	return r.Item.AutomationExecutionStatus == types.AutomationExecutionStatusWaiting &&
+		appaws.Str(r.Item.CurrentAction) == ApproveAction
+}
+
+// IsRunning reports whether the execution may still progress on its own.
+// Executions waiting for an approval are not: they can wait for days.
+func (r *ExecutionResource) IsRunning() bool {
+	switch r.Item.AutomationExecutionStatus {
+	case types.AutomationExecutionStatusPending, types.AutomationExecutionStatusInprogress,
+		types.AutomationExecutionStatusCancelling, types.AutomationExecutionStatusRunbookInprogress:
+		return true
+	case types.AutomationExecutionStatusWaiting:
+		return !r.AwaitingApproval()
+	}
+	return false
+}
+
+// StartTime returns when the execution started
+func (r *ExecutionResource) StartTime() time.Time {
+	if r.Item.ExecutionStartTime == nil {
+		return time.Time{}
+	}
+	return *r.Item.ExecutionStartTime
+}
+
+// Duration returns how long the execution ran, or has been running
+func (r *ExecutionResource) Duration() time.Duration {
+	return stepDuration(r.Item.ExecutionStartTime, r.Item.ExecutionEndTime)
+}
+
+// stepDuration returns end-start, measuring to now while end is unset
+func stepDuration(start, end *time.Time) time.Duration {
+	if start == nil || start.IsZero() {
+		return 0
+	}
+	if end == nil || end.Before(*start) {
+		return time.Since(*start).Round(time.Second)
+	}
+	return end.Sub(*start).Round(time.Second)
+}
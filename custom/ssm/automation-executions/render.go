@@ -0,0 +1,229 @@
+package automationexecutions
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var (
+	_ render.Navigator    = (*ExecutionRenderer)(nil)
+	_ render.AutoReloader = (*ExecutionRenderer)(nil)
+)
+
+// ExecutionRenderer renders SSM Automation executions
+type ExecutionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewExecutionRenderer creates a new ExecutionRenderer
+func NewExecutionRenderer() render.Renderer {
+	return &ExecutionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "automation-executions",
+			Cols: []render.Column{
+				{Name: "EXECUTION ID", Width: 38, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "DOCUMENT", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "STATUS", Width: 14, Getter: getStatus, Priority: 0},
+				{Name: "CURRENT STEP", Width: 24, Getter: getCurrentStep, Priority: 1},
+				{Name: "MODE", Width: 11, Getter: getMode, Priority: 3},
+				{Name: "DURATION", Width: 9, Getter: getDuration, Priority: 2},
+				{Name: "STARTED", Width: 8, Getter: getStarted, Priority: 2},
+				{Name: "EXECUTED BY", Width: 30, Getter: getExecutedBy, Priority: 4},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if e, ok := r.(*ExecutionResource); ok {
+		if e.AwaitingApproval() {
+			return "Approval"
+		}
+		return e.Status()
+	}
+	return ""
+}
+
+func getCurrentStep(r dao.Resource) string {
+	if e, ok := r.(*ExecutionResource); ok {
+		if step := e.CurrentStep(); step != "" {
+			return step
+		}
+	}
+	return "-"
+}
+
+func getMode(r dao.Resource) string {
+	if e, ok := r.(*ExecutionResource); ok {
+		return string(e.Item.Mode)
+	}
+	return ""
+}
+
+func getDuration(r dao.Resource) string {
+	if e, ok := r.(*ExecutionResource); ok {
+		if d := e.Duration(); d > 0 {
+			return render.FormatDuration(d)
+		}
+	}
+	return "-"
+}
+
+func getStarted(r dao.Resource) string {
+	if e, ok := r.(*ExecutionResource); ok {
+		if t := e.StartTime(); !t.IsZero() {
+			return render.FormatAge(t)
+		}
+	}
+	return "-"
+}
+
+func getExecutedBy(r dao.Resource) string {
+	if e, ok := r.(*ExecutionResource); ok {
+		return appaws.Str(e.Item.ExecutedBy)
+	}
+	return ""
+}
+
+// statusStyle colors an execution or step status
+func statusStyle(status string) lipgloss.Style {
+	switch status {
+	case "Success", "CompletedWithSuccess", "Approved":
+		return ui.SuccessStyle()
+	case "Failed", "TimedOut", "Cancelled", "Rejected", "CompletedWithFailure":
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+// RenderDetail renders the execution with the status of each step
+func (r *ExecutionRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*ExecutionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Automation Execution", e.GetID())
+
+	d.Section("Execution")
+	d.Field("Execution ID", e.GetID())
+	d.Field("Document", e.GetName())
+	d.FieldIf("Document Version", e.Item.DocumentVersion)
+	d.FieldStyled("Status", e.Status(), statusStyle(e.Status()))
+	if e.AwaitingApproval() {
+		d.FieldStyled("Waiting For", "approval of step "+e.CurrentStep(), ui.WarningStyle())
+	} else if step := e.CurrentStep(); step != "" {
+		d.Field("Current Step", step)
+	}
+	d.Field("Mode", string(e.Item.Mode))
+	d.FieldIf("Executed By", e.Item.ExecutedBy)
+	d.FieldIf("Parent Execution", e.Item.ParentAutomationExecutionId)
+	d.FieldIf("Target", e.Item.Target)
+	if t := e.StartTime(); !t.IsZero() {
+		d.Field("Started", t.Format("2006-01-02 15:04:05"))
+	}
+	if dur := e.Duration(); dur > 0 {
+		d.Field("Duration", render.FormatDuration(dur))
+	}
+	if msg := appaws.Str(e.Item.FailureMessage); msg != "" {
+		d.FieldStyled("Failure", msg, ui.DangerStyle())
+	}
+
+	if e.Detail != nil && len(e.Detail.Parameters) > 0 {
+		d.Section("Parameters")
+		for _, k := range slices.Sorted(maps.Keys(e.Detail.Parameters)) {
+			d.Field(k, strings.Join(e.Detail.Parameters[k], ", "))
+		}
+	}
+
+	d.Section("Steps")
+	if e.Detail == nil {
+		d.Dim("Loading steps...")
+	} else {
+		if len(e.Detail.StepExecutions) == 0 {
+			d.Dim("No steps have run yet")
+		}
+		for i, step := range e.Detail.StepExecutions {
+			status := string(step.StepStatus)
+			label := fmt.Sprintf("%d. %s", i+1, appaws.Str(step.StepName))
+			value := status + "  " + appaws.Str(step.Action)
+			if dur := stepDuration(step.ExecutionStartTime, step.ExecutionEndTime); dur > 0 && step.ExecutionStartTime != nil {
+				value += "  " + render.FormatDuration(dur)
+			}
+			d.FieldStyled(label, value, statusStyle(status))
+			if msg := appaws.Str(step.FailureMessage); msg != "" {
+				d.DimIndent(msg)
+			}
+		}
+		if e.Detail.StepExecutionsTruncated {
+			d.Dim("(more steps not shown)")
+		}
+	}
+
+	if len(e.Item.Outputs) > 0 {
+		d.Section("Outputs")
+		for _, k := range slices.Sorted(maps.Keys(e.Item.Outputs)) {
+			d.Field(k, strings.Join(e.Item.Outputs[k], ", "))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ExecutionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*ExecutionResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "Execution ID", Value: e.GetID()},
+		{Label: "Document", Value: e.GetName()},
+		{Label: "Status", Value: e.Status(), Style: statusStyle(e.Status())},
+	}
+	if step := e.CurrentStep(); step != "" {
+		fields = append(fields, render.SummaryField{Label: "Current Step", Value: step})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *ExecutionRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	e, ok := resource.(*ExecutionResource)
+	if !ok {
+		return nil
+	}
+	navs := []render.Navigation{{
+		Key: "D", Label: "Document", Service: "ssm", Resource: "documents",
+		FilterField: "Name", FilterValue: e.GetName(),
+	}}
+	if parent := appaws.Str(e.Item.ParentAutomationExecutionId); parent != "" {
+		navs = append(navs, render.Navigation{
+			Key: "p", Label: "Parent", Service: "ssm", Resource: "automation-executions",
+			FilterField: "AutomationExecutionId", FilterValue: parent,
+		})
+	}
+	return navs
+}
+
+// NeedsAutoReload keeps the list refreshing while an execution runs
+func (r *ExecutionRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if e, ok := dao.UnwrapResource(res).(*ExecutionResource); ok && e.IsRunning() {
+			return true
+		}
+	}
+	return false
+}
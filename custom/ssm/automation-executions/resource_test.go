@@ -0,0 +1,82 @@
+package automationexecutions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func execution(status types.AutomationExecutionStatus, currentAction string) *ExecutionResource {
+	return NewExecutionResource(types.AutomationExecutionMetadata{
+		AutomationExecutionId:     aws.String("exec-1"),
+		AutomationExecutionStatus: status,
+		CurrentAction:             aws.String(currentAction),
+		DocumentName:              aws.String("Restart"),
+	})
+}
+
+func TestExecutionResourceState(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           types.AutomationExecutionStatus
+		currentAction    string
+		awaitingApproval bool
+		running          bool
+	}{
+		{"in progress", types.AutomationExecutionStatusInprogress, "aws:runCommand", false, true},
+		{"sleeping", types.AutomationExecutionStatusWaiting, "aws:sleep", false, true},
+		{"approval", types.AutomationExecutionStatusWaiting, ApproveAction, true, false},
+		{"success", types.AutomationExecutionStatusSuccess, "", false, false},
+		{"failed", types.AutomationExecutionStatusFailed, "", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := execution(tt.status, tt.currentAction)
+			if got := e.AwaitingApproval(); got != tt.awaitingApproval {
+				t.Errorf("AwaitingApproval() = %v, want %v", got, tt.awaitingApproval)
+			}
+			if got := e.IsRunning(); got != tt.running {
+				t.Errorf("IsRunning() = %v, want %v", got, tt.running)
+			}
+		})
+	}
+}
+
+func TestNewExecutionResourceFromDetail(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	e := NewExecutionResourceFromDetail(types.AutomationExecution{
+		AutomationExecutionId:     aws.String("exec-1"),
+		AutomationExecutionStatus: types.AutomationExecutionStatusSuccess,
+		DocumentName:              aws.String("Restart"),
+		ExecutionStartTime:        aws.Time(start),
+		ExecutionEndTime:          aws.Time(start.Add(90 * time.Second)),
+		StepExecutions:            []types.StepExecution{{StepName: aws.String("stop")}},
+	})
+	if e.GetID() != "exec-1" || e.GetName() != "Restart" || e.Status() != "Success" {
+		t.Errorf("resource = %q %q %q", e.GetID(), e.GetName(), e.Status())
+	}
+	if e.Detail == nil || len(e.Detail.StepExecutions) != 1 {
+		t.Error("Detail should carry the step executions")
+	}
+	if got := e.Duration(); got != 90*time.Second {
+		t.Errorf("Duration() = %v, want 1m30s", got)
+	}
+}
+
+func TestNeedsAutoReload(t *testing.T) {
+	renderer := NewExecutionRenderer().(*ExecutionRenderer)
+	done := execution(types.AutomationExecutionStatusSuccess, "")
+	approval := execution(types.AutomationExecutionStatusWaiting, ApproveAction)
+	running := execution(types.AutomationExecutionStatusInprogress, "aws:runCommand")
+
+	if renderer.NeedsAutoReload([]dao.Resource{done, approval}) {
+		t.Error("NeedsAutoReload() = true with only finished or approval-waiting executions")
+	}
+	if !renderer.NeedsAutoReload([]dao.Resource{done, running}) {
+		t.Error("NeedsAutoReload() = false with an execution in progress")
+	}
+}
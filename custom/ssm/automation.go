@@ -0,0 +1,39 @@
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	navmsg "github.com/clawscli/claws/internal/msg"
+)
+
+// StartAutomation starts a runbook with the given parameters and returns
+// the execution ID.
+func StartAutomation(ctx context.Context, document string, params map[string][]string) (string, error) {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	out, err := client.StartAutomationExecution(ctx, &ssm.StartAutomationExecutionInput{
+		DocumentName: &document,
+		Parameters:   params,
+	})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "start automation %s", document)
+	}
+	return appaws.Str(out.AutomationExecutionId), nil
+}
+
+// ShowAutomationExecution returns the follow-up message opening an
+// automation execution, which refreshes while its steps run.
+func ShowAutomationExecution(executionID string) navmsg.ShowResourcesMsg {
+	return navmsg.ShowResourcesMsg{
+		Service:      "ssm",
+		ResourceType: "automation-executions",
+		FilterField:  "AutomationExecutionId",
+		FilterValue:  executionID,
+	}
+}
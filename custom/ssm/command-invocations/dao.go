@@ -0,0 +1,173 @@
+package commandinvocations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// InvocationDAO provides data access for the per-instance invocations of
+// an SSM Run Command command
+type InvocationDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewInvocationDAO creates a new InvocationDAO
+func NewInvocationDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &InvocationDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "command-invocations"),
+		client:  client,
+	}, nil
+}
+
+// List returns one invocation per instance of the command named by the
+// CommandId filter, with each plugin's output
+func (d *InvocationDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	commandID := dao.GetFilterFromContext(ctx, "CommandId")
+	if commandID == "" {
+		return nil, fmt.Errorf("command ID filter required")
+	}
+	invocations, err := d.list(ctx, commandID, "")
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]dao.Resource, 0, len(invocations))
+	for _, inv := range invocations {
+		resources = append(resources, NewInvocationResource(inv))
+	}
+	return resources, nil
+}
+
+func (d *InvocationDAO) list(ctx context.Context, commandID, instanceID string) ([]types.CommandInvocation, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: &commandID,
+		Details:   true,
+	}
+	if instanceID != "" {
+		input.InstanceId = &instanceID
+	}
+	return appaws.Paginate(ctx, func(token *string) ([]types.CommandInvocation, *string, error) {
+		input.NextToken = token
+		output, err := d.client.ListCommandInvocations(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list command invocations %s", commandID)
+		}
+		return output.CommandInvocations, output.NextToken, nil
+	})
+}
+
+// Get returns the invocation of a command on one instance.
+// IDs are "<command-id>:<instance-id>".
+func (d *InvocationDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	commandID, instanceID, ok := strings.Cut(id, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid command invocation ID format: %s", id)
+	}
+	invocations, err := d.list(ctx, commandID, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(invocations) == 0 {
+		return nil, fmt.Errorf("invocation not found: %s", id)
+	}
+	return NewInvocationResource(invocations[0]), nil
+}
+
+// Delete is not supported for command invocations
+func (d *InvocationDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for command invocations")
+}
+
+// InvocationResource is the run of a command on one managed instance
+type InvocationResource struct {
+	dao.BaseResource
+	Item types.CommandInvocation
+}
+
+// NewInvocationResource creates a new InvocationResource
+func NewInvocationResource(inv types.CommandInvocation) *InvocationResource {
+	instanceID := appaws.Str(inv.InstanceId)
+	name := appaws.Str(inv.InstanceName)
+	if name == "" {
+		name = instanceID
+	}
+	return &InvocationResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(inv.CommandId) + ":" + instanceID,
+			Name: name,
+			Data: inv,
+		},
+		Item: inv,
+	}
+}
+
+// CommandID returns the ID of the command this invocation belongs to
+func (r *InvocationResource) CommandID() string {
+	return appaws.Str(r.Item.CommandId)
+}
+
+// InstanceID returns the managed instance the command ran on
+func (r *InvocationResource) InstanceID() string {
+	return appaws.Str(r.Item.InstanceId)
+}
+
+// Status returns the invocation status, e.g. InProgress or Success
+func (r *InvocationResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// IsRunning reports whether the invocation may still change
+func (r *InvocationResource) IsRunning() bool {
+	switch r.Item.Status {
+	case types.CommandInvocationStatusPending, types.CommandInvocationStatusInProgress,
+		types.CommandInvocationStatusDelayed, types.CommandInvocationStatusCancelling:
+		return true
+	}
+	return false
+}
+
+// ResponseCode returns the exit code of the last plugin that reported one,
+// or -1 while none has
+func (r *InvocationResource) ResponseCode() int32 {
+	code := int32(-1)
+	for _, p := range r.Item.CommandPlugins {
+		if p.ResponseFinishDateTime != nil {
+			code = p.ResponseCode
+		}
+	}
+	return code
+}
+
+// Output returns the plugins' output joined in order. SSM keeps the first
+// 24,000 characters per plugin; the rest only reaches S3 when configured.
+func (r *InvocationResource) Output() string {
+	var parts []string
+	for _, p := range r.Item.CommandPlugins {
+		if out := strings.TrimRight(appaws.Str(p.Output), "\n"); out != "" {
+			parts = append(parts, out)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// LastLine returns the last non-empty line of the output
+func (r *InvocationResource) LastLine() string {
+	out := strings.TrimSpace(r.Output())
+	if i := strings.LastIndexByte(out, '\n'); i >= 0 {
+		out = out[i+1:]
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,20 @@
+package commandinvocations
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ssm", "command-invocations", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewInvocationDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewInvocationRenderer()
+		},
+	})
+}
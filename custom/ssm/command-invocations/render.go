@@ -0,0 +1,179 @@
+package commandinvocations
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var (
+	_ render.Navigator    = (*InvocationRenderer)(nil)
+	_ render.AutoReloader = (*InvocationRenderer)(nil)
+)
+
+// InvocationRenderer renders the per-instance invocations of a command
+type InvocationRenderer struct {
+	render.BaseRenderer
+}
+
+// NewInvocationRenderer creates a new InvocationRenderer
+func NewInvocationRenderer() render.Renderer {
+	return &InvocationRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "command-invocations",
+			Cols: []render.Column{
+				{Name: "INSTANCE ID", Width: 21, Getter: getInstanceID, Priority: 0},
+				{Name: "NAME", Width: 24, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 1},
+				{Name: "STATUS", Width: 12, Getter: getStatus, Priority: 0},
+				{Name: "CODE", Width: 5, Getter: getResponseCode, Priority: 2},
+				{Name: "OUTPUT", Width: 60, Getter: getLastLine, Priority: 0},
+				{Name: "AGE", Width: 8, Getter: getAge, Priority: 3},
+			},
+		},
+	}
+}
+
+func getInstanceID(r dao.Resource) string {
+	if inv, ok := r.(*InvocationResource); ok {
+		return inv.InstanceID()
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if inv, ok := r.(*InvocationResource); ok {
+		return inv.Status()
+	}
+	return ""
+}
+
+func getResponseCode(r dao.Resource) string {
+	if inv, ok := r.(*InvocationResource); ok {
+		if code := inv.ResponseCode(); code >= 0 {
+			return fmt.Sprintf("%d", code)
+		}
+	}
+	return "-"
+}
+
+func getLastLine(r dao.Resource) string {
+	if inv, ok := r.(*InvocationResource); ok {
+		return inv.LastLine()
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if inv, ok := r.(*InvocationResource); ok && inv.Item.RequestedDateTime != nil {
+		return render.FormatAge(*inv.Item.RequestedDateTime)
+	}
+	return "-"
+}
+
+// statusStyle colors an invocation status
+func statusStyle(inv *InvocationResource) lipgloss.Style {
+	switch {
+	case inv.Status() == "Success":
+		return ui.SuccessStyle()
+	case inv.IsRunning():
+		return ui.WarningStyle()
+	default:
+		return ui.DangerStyle()
+	}
+}
+
+// RenderDetail renders the invocation with each plugin's output
+func (r *InvocationRenderer) RenderDetail(resource dao.Resource) string {
+	inv, ok := resource.(*InvocationResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Command Invocation", inv.GetName())
+
+	d.Section("Invocation")
+	d.Field("Command ID", inv.CommandID())
+	d.Field("Instance ID", inv.InstanceID())
+	d.FieldIf("Document", inv.Item.DocumentName)
+	d.FieldStyled("Status", inv.Status(), statusStyle(inv))
+	d.FieldIf("Status Details", inv.Item.StatusDetails)
+	if inv.Item.RequestedDateTime != nil {
+		d.Field("Requested", inv.Item.RequestedDateTime.Format("2006-01-02 15:04:05"))
+	}
+	d.FieldIf("Comment", inv.Item.Comment)
+
+	for _, p := range inv.Item.CommandPlugins {
+		d.Section("Plugin " + appaws.Str(p.Name))
+		d.Field("Status", string(p.Status))
+		if p.ResponseFinishDateTime != nil {
+			d.Field("Exit Code", fmt.Sprintf("%d", p.ResponseCode))
+			d.Field("Finished", p.ResponseFinishDateTime.Format("2006-01-02 15:04:05"))
+		}
+		d.FieldIf("Output S3 URL", p.StandardOutputUrl)
+		out := strings.TrimRight(appaws.Str(p.Output), "\n")
+		if out == "" {
+			d.Dim("(no output yet)")
+			continue
+		}
+		for line := range strings.SplitSeq(out, "\n") {
+			d.Line(line)
+		}
+	}
+	if len(inv.Item.CommandPlugins) == 0 {
+		d.Section("Output")
+		d.Dim("(no output yet)")
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *InvocationRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	inv, ok := resource.(*InvocationResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Instance ID", Value: inv.InstanceID()},
+		{Label: "Name", Value: inv.GetName()},
+		{Label: "Status", Value: inv.Status(), Style: statusStyle(inv)},
+		{Label: "Document", Value: appaws.Str(inv.Item.DocumentName)},
+		{Label: "Command ID", Value: inv.CommandID()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *InvocationRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	inv, ok := resource.(*InvocationResource)
+	if !ok || !strings.HasPrefix(inv.InstanceID(), "i-") {
+		return nil
+	}
+	return []render.Navigation{{
+		Key: "i", Label: "Instance", Service: "ec2", Resource: "instances",
+		FilterField: "InstanceId", FilterValue: inv.InstanceID(),
+	}}
+}
+
+// NeedsAutoReload keeps the list refreshing, streaming each instance's
+// output, until every invocation has finished. An empty list also
+// refreshes: SSM creates the invocations of a new command shortly after
+// SendCommand returns, later still for tag targets.
+func (r *InvocationRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	if len(resources) == 0 {
+		return true
+	}
+	for _, res := range resources {
+		if inv, ok := dao.UnwrapResource(res).(*InvocationResource); ok && inv.IsRunning() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package commandinvocations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func invocation(status types.CommandInvocationStatus, plugins ...types.CommandPlugin) *InvocationResource {
+	return NewInvocationResource(types.CommandInvocation{
+		CommandId:      aws.String("cmd-1"),
+		InstanceId:     aws.String("i-1"),
+		Status:         status,
+		CommandPlugins: plugins,
+	})
+}
+
+func TestInvocationResource(t *testing.T) {
+	inv := invocation(types.CommandInvocationStatusSuccess,
+		types.CommandPlugin{Name: aws.String("a"), Output: aws.String("one\ntwo\n"), ResponseCode: 0, ResponseFinishDateTime: aws.Time(time.Now())},
+		types.CommandPlugin{Name: aws.String("b"), Output: aws.String("three\n\n"), ResponseCode: 2, ResponseFinishDateTime: aws.Time(time.Now())},
+	)
+	if inv.GetID() != "cmd-1:i-1" || inv.InstanceID() != "i-1" || inv.GetName() != "i-1" {
+		t.Errorf("ID = %q, InstanceID = %q, Name = %q", inv.GetID(), inv.InstanceID(), inv.GetName())
+	}
+	if got := inv.Output(); got != "one\ntwo\nthree" {
+		t.Errorf("Output() = %q", got)
+	}
+	if got := inv.LastLine(); got != "three" {
+		t.Errorf("LastLine() = %q", got)
+	}
+	if got := inv.ResponseCode(); got != 2 {
+		t.Errorf("ResponseCode() = %d, want 2", got)
+	}
+	if got := invocation(types.CommandInvocationStatusInProgress).ResponseCode(); got != -1 {
+		t.Errorf("ResponseCode() without finished plugins = %d, want -1", got)
+	}
+}
+
+func TestNeedsAutoReload(t *testing.T) {
+	renderer := NewInvocationRenderer().(*InvocationRenderer)
+	done := invocation(types.CommandInvocationStatusSuccess)
+	failed := invocation(types.CommandInvocationStatusFailed)
+	running := invocation(types.CommandInvocationStatusInProgress)
+
+	if !renderer.NeedsAutoReload(nil) {
+		t.Error("NeedsAutoReload() = false before invocations appear")
+	}
+	if renderer.NeedsAutoReload([]dao.Resource{done, failed}) {
+		t.Error("NeedsAutoReload() = true with only finished invocations")
+	}
+	if !renderer.NeedsAutoReload([]dao.Resource{done, running}) {
+		t.Error("NeedsAutoReload() = false with an invocation in progress")
+	}
+}
@@ -0,0 +1,209 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	navmsg "github.com/clawscli/claws/internal/msg"
+)
+
+// DocumentParameters returns the parameters of a document in declaration
+// order, which is the order the run-command form prompts for them. The
+// document is described once per action run; the parameter prompts and the
+// executor share the result.
+func DocumentParameters(ctx context.Context, name string) ([]types.DocumentParameter, error) {
+	return action.Cached(ctx, "ssm:document-parameters:"+name, func() ([]types.DocumentParameter, error) {
+		client, err := GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out, err := client.DescribeDocument(ctx, &ssm.DescribeDocumentInput{Name: &name})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe document %s", name)
+		}
+		if out.Document == nil {
+			return nil, nil
+		}
+		return out.Document.Parameters, nil
+	})
+}
+
+// CommandDocumentChoices lists the Command documents owned by the account,
+// then the Amazon-owned ones, as action choices. A non-empty platform
+// (Linux, Windows, MacOS) keeps the documents supporting it.
+func CommandDocumentChoices(ctx context.Context, platform string) ([]action.Choice, error) {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var choices []action.Choice
+	for _, owner := range []string{"Self", "Amazon"} {
+		filters := []types.DocumentKeyValuesFilter{
+			{Key: appaws.StringPtr("DocumentType"), Values: []string{string(types.DocumentTypeCommand)}},
+			{Key: appaws.StringPtr("Owner"), Values: []string{owner}},
+		}
+		if platform != "" {
+			filters = append(filters, types.DocumentKeyValuesFilter{Key: appaws.StringPtr("PlatformTypes"), Values: []string{platform}})
+		}
+		docs, err := appaws.Paginate(ctx, func(token *string) ([]types.DocumentIdentifier, *string, error) {
+			out, err := client.ListDocuments(ctx, &ssm.ListDocumentsInput{Filters: filters, NextToken: token})
+			if err != nil {
+				return nil, nil, apperrors.Wrap(err, "list documents")
+			}
+			return out.DocumentIdentifiers, out.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			name := appaws.Str(doc.Name)
+			label := name
+			if owner == "Self" {
+				label += " (own)"
+			}
+			choices = append(choices, action.Choice{Value: name, Label: label})
+		}
+	}
+	return choices, nil
+}
+
+// ParameterInputs returns an InputSpec.Next that prompts for each
+// parameter of a document, one prompt per parameter. document names the
+// document from the resource and the values submitted so far; first is the
+// index of the first parameter value. Parameters with a default may be
+// left empty.
+func ParameterInputs(document func(resource dao.Resource, values []string) string, first int) func(context.Context, dao.Resource, []string) (*action.InputSpec, error) {
+	var next func(context.Context, dao.Resource, []string) (*action.InputSpec, error)
+	next = func(ctx context.Context, resource dao.Resource, values []string) (*action.InputSpec, error) {
+		name := document(resource, values)
+		if name == "" || len(values) < first {
+			return nil, nil
+		}
+		params, err := DocumentParameters(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		i := len(values) - first
+		if i >= len(params) {
+			return nil, nil
+		}
+		return parameterInput(params[i], next), nil
+	}
+	return next
+}
+
+func parameterInput(p types.DocumentParameter, next func(context.Context, dao.Resource, []string) (*action.InputSpec, error)) *action.InputSpec {
+	label := appaws.Str(p.Name)
+	if p.Type != "" && p.Type != types.DocumentParameterTypeString {
+		label += " (" + string(p.Type) + ")"
+	}
+	spec := &action.InputSpec{Label: label, Next: next}
+	if p.DefaultValue != nil {
+		spec.Optional = true
+		spec.Placeholder = "default: " + *p.DefaultValue
+	} else {
+		spec.Placeholder = firstLine(appaws.Str(p.Description))
+	}
+	return spec
+}
+
+func firstLine(s string) string {
+	s, _, _ = strings.Cut(s, "\n")
+	return s
+}
+
+// CommandParameters pairs a document's parameters with the values typed for
+// them, leaving out empty ones so their defaults apply. It serves Command
+// and Automation documents alike.
+func CommandParameters(params []types.DocumentParameter, values []string) map[string][]string {
+	out := make(map[string][]string)
+	for i, p := range params {
+		if i >= len(values) || values[i] == "" {
+			continue
+		}
+		out[appaws.Str(p.Name)] = []string{values[i]}
+	}
+	return out
+}
+
+// ParseCommandTargets parses where to run a command: comma-separated
+// managed instance IDs, or tag:Key=Value for every instance with that tag.
+func ParseCommandTargets(s string) (instanceIDs []string, targets []types.Target, err error) {
+	s = strings.TrimSpace(s)
+	if tag, ok := strings.CutPrefix(s, "tag:"); ok {
+		key, value, found := strings.Cut(tag, "=")
+		if !found || key == "" || value == "" {
+			return nil, nil, fmt.Errorf("tag target must be tag:Key=Value")
+		}
+		return nil, []types.Target{{Key: appaws.StringPtr("tag:" + key), Values: []string{value}}}, nil
+	}
+	for id := range strings.SplitSeq(s, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			instanceIDs = append(instanceIDs, id)
+		}
+	}
+	if len(instanceIDs) == 0 {
+		return nil, nil, fmt.Errorf("no instance IDs given")
+	}
+	return instanceIDs, nil, nil
+}
+
+// FormatTargets renders targets the way ParseCommandTargets reads them,
+// e.g. "tag:Env=prod; InstanceIds=i-1,i-2".
+func FormatTargets(targets []types.Target) string {
+	parts := make([]string, 0, len(targets))
+	for _, t := range targets {
+		parts = append(parts, appaws.Str(t.Key)+"="+strings.Join(t.Values, ","))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// RunCommandInput describes a SendCommand call.
+type RunCommandInput struct {
+	Document    string
+	InstanceIDs []string
+	Targets     []types.Target
+	Parameters  map[string][]string
+}
+
+// RunCommand sends a document to managed instances and returns the
+// command ID, whose invocations ssm/command-invocations lists.
+func RunCommand(ctx context.Context, in RunCommandInput) (string, error) {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	out, err := client.SendCommand(ctx, &ssm.SendCommandInput{
+		DocumentName: &in.Document,
+		InstanceIds:  in.InstanceIDs,
+		Targets:      in.Targets,
+		Parameters:   in.Parameters,
+		Comment:      appaws.StringPtr("Run from claws"),
+	})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "send command %s", in.Document)
+	}
+	if out.Command == nil {
+		return "", nil
+	}
+	return appaws.Str(out.Command.CommandId), nil
+}
+
+// ShowInvocations returns the follow-up message opening the per-instance
+// invocations of a command, which refresh until every instance finishes.
+func ShowInvocations(commandID string) navmsg.ShowResourcesMsg {
+	return navmsg.ShowResourcesMsg{
+		Service:      "ssm",
+		ResourceType: "command-invocations",
+		FilterField:  "CommandId",
+		FilterValue:  commandID,
+	}
+}
@@ -0,0 +1,72 @@
+package ssm
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+func TestParseCommandTargets(t *testing.T) {
+	ids, targets, err := ParseCommandTargets(" i-1, i-2 ,")
+	if err != nil || !slices.Equal(ids, []string{"i-1", "i-2"}) || targets != nil {
+		t.Errorf("ParseCommandTargets(ids) = %v, %v, %v", ids, targets, err)
+	}
+
+	ids, targets, err = ParseCommandTargets("tag:Env=prod")
+	if err != nil || ids != nil || len(targets) != 1 ||
+		aws.ToString(targets[0].Key) != "tag:Env" || !slices.Equal(targets[0].Values, []string{"prod"}) {
+		t.Errorf("ParseCommandTargets(tag) = %v, %v, %v", ids, targets, err)
+	}
+
+	for _, bad := range []string{"", " , ", "tag:Env", "tag:=prod"} {
+		if _, _, err := ParseCommandTargets(bad); err == nil {
+			t.Errorf("ParseCommandTargets(%q) should fail", bad)
+		}
+	}
+}
+
+func TestFormatTargets(t *testing.T) {
+	got := FormatTargets([]types.Target{
+		{Key: aws.String("tag:Env"), Values: []string{"prod"}},
+		{Key: aws.String("InstanceIds"), Values: []string{"i-1", "i-2"}},
+	})
+	if want := "tag:Env=prod; InstanceIds=i-1,i-2"; got != want {
+		t.Errorf("FormatTargets() = %q, want %q", got, want)
+	}
+}
+
+func TestCommandParameters(t *testing.T) {
+	params := []types.DocumentParameter{
+		{Name: aws.String("commands")},
+		{Name: aws.String("workingDirectory"), DefaultValue: aws.String("")},
+		{Name: aws.String("executionTimeout"), DefaultValue: aws.String("3600")},
+	}
+	got := CommandParameters(params, []string{"uptime", "", "60"})
+	want := map[string][]string{"commands": {"uptime"}, "executionTimeout": {"60"}}
+	if !maps.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("CommandParameters() = %v, want %v", got, want)
+	}
+}
+
+func TestParameterInput(t *testing.T) {
+	required := parameterInput(types.DocumentParameter{
+		Name:        aws.String("commands"),
+		Type:        types.DocumentParameterTypeStringList,
+		Description: aws.String("Commands to run.\nOne per line."),
+	}, nil)
+	if required.Label != "commands (StringList)" || required.Optional || required.Placeholder != "Commands to run." {
+		t.Errorf("required input = %+v", required)
+	}
+
+	optional := parameterInput(types.DocumentParameter{
+		Name:         aws.String("executionTimeout"),
+		Type:         types.DocumentParameterTypeString,
+		DefaultValue: aws.String("3600"),
+	}, nil)
+	if optional.Label != "executionTimeout" || !optional.Optional || optional.Placeholder != "default: 3600" {
+		t.Errorf("optional input = %+v", optional)
+	}
+}
@@ -0,0 +1,106 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ssm", "documents", []action.Action{
+		{
+			Name:      "Run Command",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RunCommand",
+			Confirm:   action.ConfirmSimple,
+			Input: &action.InputSpec{
+				Label:       "Targets",
+				Placeholder: "i-0123,i-0456 or tag:Key=Value",
+				Next:        appssm.ParameterInputs(documentName, 1),
+			},
+			Filter: func(r dao.Resource) bool {
+				doc, ok := dao.UnwrapResource(r).(*DocumentResource)
+				return ok && !doc.IsAutomation()
+			},
+		},
+		{
+			Name:      "Start Automation",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartAutomation",
+			Confirm:   action.ConfirmSimple,
+			Input:     &action.InputSpec{Next: appssm.ParameterInputs(documentName, 0)},
+			Filter: func(r dao.Resource) bool {
+				doc, ok := dao.UnwrapResource(r).(*DocumentResource)
+				return ok && doc.IsAutomation()
+			},
+		},
+	})
+
+	action.RegisterExecutor("ssm", "documents", executeDocumentAction)
+}
+
+func executeDocumentAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RunCommand":
+		return executeRunCommand(ctx, resource)
+	case "StartAutomation":
+		return executeStartAutomation(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// documentName names the document whose parameters an action prompts for
+func documentName(resource dao.Resource, _ []string) string {
+	return resource.GetID()
+}
+
+func executeRunCommand(ctx context.Context, resource dao.Resource) action.ActionResult {
+	values := action.InputsFromContext(ctx)
+	if len(values) == 0 {
+		return action.FailResult(fmt.Errorf("no targets given"))
+	}
+	instanceIDs, targets, err := appssm.ParseCommandTargets(values[0])
+	if err != nil {
+		return action.FailResult(err)
+	}
+	document := resource.GetID()
+	params, err := appssm.DocumentParameters(ctx, document)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	commandID, err := appssm.RunCommand(ctx, appssm.RunCommandInput{
+		Document:    document,
+		InstanceIDs: instanceIDs,
+		Targets:     targets,
+		Parameters:  appssm.CommandParameters(params, values[1:]),
+	})
+	if err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResultWithFollowUp(
+		fmt.Sprintf("Sent %s (command %s)", document, commandID),
+		appssm.ShowInvocations(commandID),
+	)
+}
+
+func executeStartAutomation(ctx context.Context, resource dao.Resource) action.ActionResult {
+	document := resource.GetID()
+	params, err := appssm.DocumentParameters(ctx, document)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	executionID, err := appssm.StartAutomation(ctx, document, appssm.CommandParameters(params, action.InputsFromContext(ctx)))
+	if err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResultWithFollowUp(
+		fmt.Sprintf("Started %s (execution %s)", document, executionID),
+		appssm.ShowAutomationExecution(executionID),
+	)
+}
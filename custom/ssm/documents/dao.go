@@ -0,0 +1,177 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DocumentDAO provides data access for SSM Command and Automation documents
+type DocumentDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewDocumentDAO creates a new DocumentDAO
+func NewDocumentDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &DocumentDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "documents"),
+		client:  client,
+	}, nil
+}
+
+// List returns the Command and Automation documents owned by the account,
+// plus the Amazon-owned ones when the IncludeAmazon toggle is on. A Name
+// filter in context returns that document whatever its owner.
+func (d *DocumentDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if name := dao.GetFilterFromContext(ctx, "Name"); name != "" {
+		doc, err := d.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return []dao.Resource{doc}, nil
+	}
+
+	owners := []string{"Self"}
+	if dao.GetFilterFromContext(ctx, "IncludeAmazon") == "true" {
+		owners = append(owners, "Amazon")
+	}
+
+	var resources []dao.Resource
+	for _, owner := range owners {
+		input := &ssm.ListDocumentsInput{
+			Filters: []types.DocumentKeyValuesFilter{
+				{Key: appaws.StringPtr("DocumentType"), Values: []string{string(types.DocumentTypeCommand), string(types.DocumentTypeAutomation)}},
+				{Key: appaws.StringPtr("Owner"), Values: []string{owner}},
+			},
+		}
+		docs, err := appaws.Paginate(ctx, func(token *string) ([]types.DocumentIdentifier, *string, error) {
+			input.NextToken = token
+			output, err := d.client.ListDocuments(ctx, input)
+			if err != nil {
+				return nil, nil, apperrors.Wrap(err, "list documents")
+			}
+			return output.DocumentIdentifiers, output.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, doc := range docs {
+			resources = append(resources, NewDocumentResource(doc))
+		}
+	}
+	return resources, nil
+}
+
+// Get returns a document with its description and parameters
+func (d *DocumentDAO) Get(ctx context.Context, name string) (dao.Resource, error) {
+	output, err := d.client.DescribeDocument(ctx, &ssm.DescribeDocumentInput{Name: &name})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe document %s", name)
+	}
+	if output.Document == nil {
+		return nil, fmt.Errorf("document not found: %s", name)
+	}
+	return NewDocumentResourceFromDescription(*output.Document), nil
+}
+
+// Delete is not supported for documents
+func (d *DocumentDAO) Delete(ctx context.Context, name string) error {
+	return fmt.Errorf("delete not supported for SSM documents")
+}
+
+// DocumentResource represents an SSM Command or Automation document
+type DocumentResource struct {
+	dao.BaseResource
+	Item        types.DocumentIdentifier
+	Description *types.DocumentDescription
+}
+
+// NewDocumentResource creates a new DocumentResource from a list entry
+func NewDocumentResource(doc types.DocumentIdentifier) *DocumentResource {
+	name := appaws.Str(doc.Name)
+	return &DocumentResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Data: doc,
+		},
+		Item: doc,
+	}
+}
+
+// NewDocumentResourceFromDescription creates a new DocumentResource from
+// DescribeDocument, which also carries the document's parameters
+func NewDocumentResourceFromDescription(desc types.DocumentDescription) *DocumentResource {
+	name := appaws.Str(desc.Name)
+	return &DocumentResource{
+		BaseResource: dao.BaseResource{
+			ID:   name,
+			Name: name,
+			Data: desc,
+		},
+		Item: types.DocumentIdentifier{
+			Name:            desc.Name,
+			DisplayName:     desc.DisplayName,
+			Owner:           desc.Owner,
+			DocumentVersion: desc.DocumentVersion,
+			DocumentType:    desc.DocumentType,
+			DocumentFormat:  desc.DocumentFormat,
+			PlatformTypes:   desc.PlatformTypes,
+			SchemaVersion:   desc.SchemaVersion,
+			TargetType:      desc.TargetType,
+			CreatedDate:     desc.CreatedDate,
+		},
+		Description: &desc,
+	}
+}
+
+// Owner returns the document owner: "Amazon" or an account ID
+func (r *DocumentResource) Owner() string {
+	return appaws.Str(r.Item.Owner)
+}
+
+// DocumentType returns the document type, Command or Automation
+func (r *DocumentResource) DocumentType() string {
+	return string(r.Item.DocumentType)
+}
+
+// IsAutomation reports whether the document is an Automation runbook
+func (r *DocumentResource) IsAutomation() bool {
+	return r.Item.DocumentType == types.DocumentTypeAutomation
+}
+
+// Platforms returns the supported platforms, e.g. "Linux, Windows"
+func (r *DocumentResource) Platforms() string {
+	platforms := make([]string, 0, len(r.Item.PlatformTypes))
+	for _, p := range r.Item.PlatformTypes {
+		platforms = append(platforms, string(p))
+	}
+	return strings.Join(platforms, ", ")
+}
+
+// Version returns the default document version
+func (r *DocumentResource) Version() string {
+	return appaws.Str(r.Item.DocumentVersion)
+}
+
+// CreatedDate returns when the document was created
+func (r *DocumentResource) CreatedDate() time.Time {
+	if r.Item.CreatedDate == nil {
+		return time.Time{}
+	}
+	return *r.Item.CreatedDate
+}
@@ -0,0 +1,167 @@
+package documents
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+var (
+	_ render.Toggler   = (*DocumentRenderer)(nil)
+	_ render.Navigator = (*DocumentRenderer)(nil)
+)
+
+// DocumentRenderer renders SSM Command and Automation documents
+type DocumentRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDocumentRenderer creates a new DocumentRenderer
+func NewDocumentRenderer() render.Renderer {
+	return &DocumentRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "documents",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 40, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "TYPE", Width: 11, Getter: getType, Priority: 0},
+				{Name: "OWNER", Width: 14, Getter: getOwner, Priority: 1},
+				{Name: "PLATFORMS", Width: 24, Getter: getPlatforms, Priority: 1},
+				{Name: "VERSION", Width: 8, Getter: getVersion, Priority: 2},
+				{Name: "FORMAT", Width: 7, Getter: getFormat, Priority: 3},
+				{Name: "AGE", Width: 8, Getter: getAge, Priority: 4},
+			},
+		},
+	}
+}
+
+func getType(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return doc.DocumentType()
+	}
+	return ""
+}
+
+func getOwner(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return doc.Owner()
+	}
+	return ""
+}
+
+func getPlatforms(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return doc.Platforms()
+	}
+	return ""
+}
+
+func getVersion(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return doc.Version()
+	}
+	return ""
+}
+
+func getFormat(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		return string(doc.Item.DocumentFormat)
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if doc, ok := r.(*DocumentResource); ok {
+		if t := doc.CreatedDate(); !t.IsZero() {
+			return render.FormatAge(t)
+		}
+	}
+	return "-"
+}
+
+// RenderDetail renders detailed document information
+func (r *DocumentRenderer) RenderDetail(resource dao.Resource) string {
+	doc, ok := resource.(*DocumentResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("SSM Document", doc.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", doc.GetName())
+	d.FieldIf("Display Name", doc.Item.DisplayName)
+	d.Field("Owner", doc.Owner())
+	d.Field("Document Type", doc.DocumentType())
+	d.Field("Format", string(doc.Item.DocumentFormat))
+	d.Field("Default Version", doc.Version())
+	d.FieldIf("Schema Version", doc.Item.SchemaVersion)
+	d.Field("Platforms", doc.Platforms())
+	d.FieldIf("Target Type", doc.Item.TargetType)
+	if t := doc.CreatedDate(); !t.IsZero() {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+
+	desc := doc.Description
+	if desc == nil {
+		return d.String()
+	}
+	if s := appaws.Str(desc.Description); s != "" {
+		d.Section("Description")
+		d.Line(s)
+	}
+
+	d.Section("Parameters")
+	if len(desc.Parameters) == 0 {
+		d.Dim("This document takes no parameters")
+	}
+	for _, p := range desc.Parameters {
+		value := string(p.Type)
+		if p.DefaultValue != nil {
+			value += " (default: " + *p.DefaultValue + ")"
+		} else {
+			value += " (required)"
+		}
+		d.Field(appaws.Str(p.Name), value)
+		if s := appaws.Str(p.Description); s != "" {
+			d.DimIndent(s)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *DocumentRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	doc, ok := resource.(*DocumentResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Name", Value: doc.GetName()},
+		{Label: "Type", Value: doc.DocumentType()},
+		{Label: "Owner", Value: doc.Owner()},
+		{Label: "Platforms", Value: doc.Platforms()},
+		{Label: "Version", Value: doc.Version()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *DocumentRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	doc, ok := resource.(*DocumentResource)
+	if !ok || !doc.IsAutomation() {
+		return nil
+	}
+	return []render.Navigation{{
+		Key: "e", Label: "Executions", Service: "ssm", Resource: "automation-executions",
+		FilterField: "DocumentName", FilterValue: doc.GetName(),
+	}}
+}
+
+// ListToggles returns the Amazon-owned documents toggle
+func (r *DocumentRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "a", ContextKey: "IncludeAmazon", LabelOn: "self+amazon", LabelOff: "self"},
+	}
+}
@@ -0,0 +1,168 @@
+package maintenancewindows
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// windowTargetsKey is the task target key that refers to targets
+// registered with the window.
+const windowTargetsKey = "WindowTargetIds"
+
+func init() {
+	action.Global.Register("ssm", "maintenance-windows", []action.Action{
+		{
+			Name:      "Run Tasks Now",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RunTasksNow",
+			Confirm:   action.ConfirmSimple,
+		},
+		{
+			Name:      "Enable",
+			Shortcut:  "E",
+			Type:      action.ActionTypeAPI,
+			Operation: "Enable",
+			Filter: func(r dao.Resource) bool {
+				w, ok := dao.UnwrapResource(r).(*WindowResource)
+				return ok && !w.Enabled()
+			},
+		},
+		{
+			Name:      "Disable",
+			Shortcut:  "X",
+			Type:      action.ActionTypeAPI,
+			Operation: "Disable",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				w, ok := dao.UnwrapResource(r).(*WindowResource)
+				return ok && w.Enabled()
+			},
+		},
+	})
+
+	action.RegisterExecutor("ssm", "maintenance-windows", executeWindowAction)
+}
+
+func executeWindowAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "RunTasksNow":
+		return executeRunTasksNow(ctx, resource)
+	case "Enable":
+		return executeSetEnabled(ctx, resource, true)
+	case "Disable":
+		return executeSetEnabled(ctx, resource, false)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeSetEnabled(ctx context.Context, resource dao.Resource, enabled bool) action.ActionResult {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	id := resource.GetID()
+	if _, err := client.UpdateMaintenanceWindow(ctx, &ssm.UpdateMaintenanceWindowInput{
+		WindowId: &id,
+		Enabled:  &enabled,
+	}); err != nil {
+		return action.FailResultf(err, "update maintenance window %s", id)
+	}
+	if enabled {
+		return action.SuccessResult(fmt.Sprintf("Enabled %s", resource.GetName()))
+	}
+	return action.SuccessResult(fmt.Sprintf("Disabled %s", resource.GetName()))
+}
+
+// executeRunTasksNow sends the window's Run Command tasks to their targets
+// right away. AWS has no API to start a window outside its schedule, so the
+// run is not recorded as a window execution, and other task types (Automation,
+// Lambda, Step Functions) are skipped.
+func executeRunTasksNow(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	id := resource.GetID()
+	targets, err := ListTargets(ctx, client, id)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	tasks, err := ListTasks(ctx, client, id)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	var commandIDs, skipped []string
+	for _, task := range tasks {
+		name := cmp.Or(appaws.Str(task.Name), appaws.Str(task.WindowTaskId))
+		taskTargets := resolveTaskTargets(task.Targets, targets)
+		if task.Type != types.MaintenanceWindowTaskTypeRunCommand || len(taskTargets) == 0 {
+			skipped = append(skipped, name)
+			continue
+		}
+		out, err := client.GetMaintenanceWindowTask(ctx, &ssm.GetMaintenanceWindowTaskInput{
+			WindowId:     &id,
+			WindowTaskId: task.WindowTaskId,
+		})
+		if err != nil {
+			return action.FailResultf(err, "get task %s", name)
+		}
+		var params map[string][]string
+		if p := out.TaskInvocationParameters; p != nil && p.RunCommand != nil {
+			params = p.RunCommand.Parameters
+		}
+		commandID, err := appssm.RunCommand(ctx, appssm.RunCommandInput{
+			Document:   appaws.Str(task.TaskArn),
+			Targets:    taskTargets,
+			Parameters: params,
+		})
+		if err != nil {
+			return action.FailResultf(err, "run task %s (%d sent before it)", name, len(commandIDs))
+		}
+		commandIDs = append(commandIDs, commandID)
+	}
+
+	if len(commandIDs) == 0 {
+		return action.FailResult(fmt.Errorf("%s has no Run Command tasks with targets", resource.GetName()))
+	}
+	msg := fmt.Sprintf("Sent %d Run Command task(s) of %s", len(commandIDs), resource.GetName())
+	if len(skipped) > 0 {
+		msg += "; skipped " + strings.Join(skipped, ", ")
+	}
+	if len(commandIDs) == 1 {
+		return action.SuccessResultWithFollowUp(msg, appssm.ShowInvocations(commandIDs[0]))
+	}
+	return action.SuccessResult(msg + " (commands " + strings.Join(commandIDs, ", ") + ")")
+}
+
+// resolveTaskTargets replaces a task's WindowTargetIds target with the
+// targets registered under those IDs, keeping any other targets as is
+func resolveTaskTargets(taskTargets []types.Target, registered []types.MaintenanceWindowTarget) []types.Target {
+	var resolved []types.Target
+	for _, t := range taskTargets {
+		if appaws.Str(t.Key) != windowTargetsKey {
+			resolved = append(resolved, t)
+			continue
+		}
+		for _, r := range registered {
+			for _, id := range t.Values {
+				if appaws.Str(r.WindowTargetId) == id {
+					resolved = append(resolved, r.Targets...)
+				}
+			}
+		}
+	}
+	return resolved
+}
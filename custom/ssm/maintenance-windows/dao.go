@@ -0,0 +1,221 @@
+package maintenancewindows
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"golang.org/x/sync/errgroup"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// describeConcurrency bounds the per-window execution lookups in List
+const describeConcurrency = 5
+
+// WindowDAO provides data access for SSM maintenance windows
+type WindowDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewWindowDAO creates a new WindowDAO
+func NewWindowDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &WindowDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "maintenance-windows"),
+		client:  client,
+	}, nil
+}
+
+// List returns all maintenance windows with the result of their last execution
+func (d *WindowDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	windows, err := appaws.Paginate(ctx, func(token *string) ([]types.MaintenanceWindowIdentity, *string, error) {
+		output, err := d.client.DescribeMaintenanceWindows(ctx, &ssm.DescribeMaintenanceWindowsInput{NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe maintenance windows")
+		}
+		return output.WindowIdentities, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The identity has no execution history; look up the latest execution
+	// of each window. Failures leave the last run unknown.
+	resources := make([]dao.Resource, len(windows))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeConcurrency)
+	for i, w := range windows {
+		r := NewWindowResource(w)
+		resources[i] = r
+		g.Go(func() error {
+			executions, err := d.executions(gctx, r.GetID())
+			if err != nil {
+				log.Debug("failed to describe maintenance window executions", "window", r.GetID(), "error", err)
+				return nil
+			}
+			r.Executions = executions
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return resources, nil
+}
+
+// executions returns the most recent executions of a window, newest first
+func (d *WindowDAO) executions(ctx context.Context, id string) ([]types.MaintenanceWindowExecution, error) {
+	output, err := d.client.DescribeMaintenanceWindowExecutions(ctx, &ssm.DescribeMaintenanceWindowExecutionsInput{
+		WindowId:   &id,
+		MaxResults: appaws.Int32Ptr(10),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe executions of %s", id)
+	}
+	executions := output.WindowExecutions
+	slices.SortFunc(executions, func(a, b types.MaintenanceWindowExecution) int {
+		return timeOf(b.StartTime).Compare(timeOf(a.StartTime))
+	})
+	return executions, nil
+}
+
+// Get returns a maintenance window with its targets, tasks and recent executions
+func (d *WindowDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetMaintenanceWindow(ctx, &ssm.GetMaintenanceWindowInput{WindowId: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get maintenance window %s", id)
+	}
+	r := NewWindowResource(types.MaintenanceWindowIdentity{
+		WindowId:          output.WindowId,
+		Name:              output.Name,
+		Description:       output.Description,
+		Enabled:           output.Enabled,
+		Duration:          output.Duration,
+		Cutoff:            output.Cutoff,
+		Schedule:          output.Schedule,
+		ScheduleTimezone:  output.ScheduleTimezone,
+		ScheduleOffset:    output.ScheduleOffset,
+		NextExecutionTime: output.NextExecutionTime,
+		StartDate:         output.StartDate,
+		EndDate:           output.EndDate,
+	})
+	r.Data = output
+	r.Detailed = true
+
+	if r.Targets, err = ListTargets(ctx, d.client, id); err != nil {
+		log.Warn("failed to list maintenance window targets", "window", id, "error", err)
+	}
+	if r.Tasks, err = ListTasks(ctx, d.client, id); err != nil {
+		log.Warn("failed to list maintenance window tasks", "window", id, "error", err)
+	}
+	if r.Executions, err = d.executions(ctx, id); err != nil {
+		log.Warn("failed to describe maintenance window executions", "window", id, "error", err)
+	}
+	return r, nil
+}
+
+// Delete is not supported for maintenance windows
+func (d *WindowDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for maintenance windows")
+}
+
+// ListTargets returns the targets registered with a window
+func ListTargets(ctx context.Context, client *ssm.Client, id string) ([]types.MaintenanceWindowTarget, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.MaintenanceWindowTarget, *string, error) {
+		output, err := client.DescribeMaintenanceWindowTargets(ctx, &ssm.DescribeMaintenanceWindowTargetsInput{WindowId: &id, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe targets of %s", id)
+		}
+		return output.Targets, output.NextToken, nil
+	})
+}
+
+// ListTasks returns the tasks registered with a window in priority order
+func ListTasks(ctx context.Context, client *ssm.Client, id string) ([]types.MaintenanceWindowTask, error) {
+	tasks, err := appaws.Paginate(ctx, func(token *string) ([]types.MaintenanceWindowTask, *string, error) {
+		output, err := client.DescribeMaintenanceWindowTasks(ctx, &ssm.DescribeMaintenanceWindowTasksInput{WindowId: &id, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "describe tasks of %s", id)
+		}
+		return output.Tasks, output.NextToken, nil
+	})
+	slices.SortStableFunc(tasks, func(a, b types.MaintenanceWindowTask) int { return int(a.Priority - b.Priority) })
+	return tasks, err
+}
+
+// WindowResource represents an SSM maintenance window
+type WindowResource struct {
+	dao.BaseResource
+	Item types.MaintenanceWindowIdentity
+
+	// Executions holds the recent executions, newest first. Targets and
+	// Tasks are populated by Get, which sets Detailed.
+	Executions []types.MaintenanceWindowExecution
+	Targets    []types.MaintenanceWindowTarget
+	Tasks      []types.MaintenanceWindowTask
+	Detailed   bool
+}
+
+// NewWindowResource creates a new WindowResource
+func NewWindowResource(item types.MaintenanceWindowIdentity) *WindowResource {
+	return &WindowResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(item.WindowId),
+			Name: appaws.Str(item.Name),
+			Data: item,
+		},
+		Item: item,
+	}
+}
+
+// Enabled reports whether the window runs on its schedule
+func (r *WindowResource) Enabled() bool {
+	return r.Item.Enabled
+}
+
+// Schedule returns the cron or rate expression, with its time zone if set
+func (r *WindowResource) Schedule() string {
+	schedule := appaws.Str(r.Item.Schedule)
+	if tz := appaws.Str(r.Item.ScheduleTimezone); tz != "" {
+		schedule += " " + tz
+	}
+	return schedule
+}
+
+// NextExecution returns when the window runs next, or zero if not
+// scheduled. The API reports it without seconds, e.g. 2024-05-01T22:00Z.
+func (r *WindowResource) NextExecution() time.Time {
+	next := appaws.Str(r.Item.NextExecutionTime)
+	for _, layout := range []string{"2006-01-02T15:04Z07:00", time.RFC3339} {
+		if t, err := time.Parse(layout, next); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// LastExecution returns the most recent execution, if any
+func (r *WindowResource) LastExecution() (types.MaintenanceWindowExecution, bool) {
+	if len(r.Executions) == 0 {
+		return types.MaintenanceWindowExecution{}, false
+	}
+	return r.Executions[0], true
+}
+
+// timeOf dereferences an optional time
+func timeOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
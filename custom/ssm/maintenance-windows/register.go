@@ -0,0 +1,20 @@
+package maintenancewindows
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ssm", "maintenance-windows", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewWindowDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewWindowRenderer()
+		},
+	})
+}
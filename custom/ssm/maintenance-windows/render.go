@@ -0,0 +1,203 @@
+package maintenancewindows
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// WindowRenderer renders SSM maintenance windows
+type WindowRenderer struct {
+	render.BaseRenderer
+}
+
+// NewWindowRenderer creates a new WindowRenderer
+func NewWindowRenderer() render.Renderer {
+	return &WindowRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "maintenance-windows",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 32, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "WINDOW ID", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 2},
+				{Name: "ENABLED", Width: 8, Getter: getEnabled, Priority: 0},
+				{Name: "SCHEDULE", Width: 28, Getter: getSchedule, Priority: 1},
+				{Name: "NEXT RUN", Width: 10, Getter: getNextRun, Priority: 1},
+				{Name: "LAST RUN", Width: 10, Getter: getLastStatus, Priority: 0},
+				{Name: "LAST STARTED", Width: 12, Getter: getLastStarted, Priority: 2},
+				{Name: "DURATION", Width: 9, Getter: getDuration, Priority: 3},
+			},
+		},
+	}
+}
+
+func getEnabled(r dao.Resource) string {
+	if w, ok := r.(*WindowResource); ok {
+		if w.Enabled() {
+			return "Yes"
+		}
+		return "No"
+	}
+	return ""
+}
+
+func getSchedule(r dao.Resource) string {
+	if w, ok := r.(*WindowResource); ok {
+		return w.Schedule()
+	}
+	return ""
+}
+
+func getNextRun(r dao.Resource) string {
+	if w, ok := r.(*WindowResource); ok && w.Enabled() {
+		if t := w.NextExecution(); !t.IsZero() {
+			return formatUntil(t)
+		}
+	}
+	return "-"
+}
+
+func getLastStatus(r dao.Resource) string {
+	if w, ok := r.(*WindowResource); ok {
+		if e, ok := w.LastExecution(); ok {
+			return string(e.Status)
+		}
+	}
+	return "-"
+}
+
+func getLastStarted(r dao.Resource) string {
+	if w, ok := r.(*WindowResource); ok {
+		if e, ok := w.LastExecution(); ok && e.StartTime != nil {
+			return render.FormatAge(*e.StartTime)
+		}
+	}
+	return "-"
+}
+
+func getDuration(r dao.Resource) string {
+	if w, ok := r.(*WindowResource); ok && w.Item.Duration != nil {
+		return fmt.Sprintf("%dh", *w.Item.Duration)
+	}
+	return "-"
+}
+
+// formatUntil formats a future time as "in 3h"
+func formatUntil(t time.Time) string {
+	d := time.Until(t)
+	if d <= 0 {
+		return "now"
+	}
+	return "in " + render.FormatDuration(d.Round(time.Minute))
+}
+
+// executionStyle colors a window execution status
+func executionStyle(status types.MaintenanceWindowExecutionStatus) lipgloss.Style {
+	switch status {
+	case types.MaintenanceWindowExecutionStatusSuccess:
+		return ui.SuccessStyle()
+	case types.MaintenanceWindowExecutionStatusFailed, types.MaintenanceWindowExecutionStatusTimedOut,
+		types.MaintenanceWindowExecutionStatusCancelled, types.MaintenanceWindowExecutionStatusSkippedOverlapping:
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+// RenderDetail renders the window with its targets, tasks and recent executions
+func (r *WindowRenderer) RenderDetail(resource dao.Resource) string {
+	w, ok := resource.(*WindowResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Maintenance Window", w.GetName())
+
+	d.Section("Window")
+	d.Field("Window ID", w.GetID())
+	d.Field("Name", w.GetName())
+	d.FieldIf("Description", w.Item.Description)
+	if w.Enabled() {
+		d.FieldStyled("Enabled", "Yes", ui.SuccessStyle())
+	} else {
+		d.FieldStyled("Enabled", "No", ui.DimStyle())
+	}
+	d.Field("Schedule", w.Schedule())
+	if t := w.NextExecution(); !t.IsZero() && w.Enabled() {
+		d.Field("Next Run", t.Local().Format("2006-01-02 15:04")+" ("+formatUntil(t)+")")
+	}
+	if w.Item.Duration != nil {
+		d.Field("Duration", fmt.Sprintf("%d hours, cutoff %d hours", *w.Item.Duration, w.Item.Cutoff))
+	}
+	d.FieldIf("Start Date", w.Item.StartDate)
+	d.FieldIf("End Date", w.Item.EndDate)
+
+	if w.Detailed {
+		d.Section("Targets")
+		if len(w.Targets) == 0 {
+			d.Dim("No targets registered")
+		}
+		for _, t := range w.Targets {
+			label := cmp.Or(appaws.Str(t.Name), appaws.Str(t.WindowTargetId))
+			d.Field(label, appssm.FormatTargets(t.Targets))
+		}
+
+		d.Section("Tasks")
+		if len(w.Tasks) == 0 {
+			d.Dim("No tasks registered")
+		}
+		for _, t := range w.Tasks {
+			label := fmt.Sprintf("%d. %s", t.Priority, cmp.Or(appaws.Str(t.Name), appaws.Str(t.WindowTaskId)))
+			d.Field(label, string(t.Type)+"  "+appaws.Str(t.TaskArn))
+		}
+	}
+
+	d.Section("Recent Executions")
+	if len(w.Executions) == 0 {
+		d.Dim("The window has not run yet")
+	}
+	for _, e := range w.Executions {
+		label := "-"
+		if e.StartTime != nil {
+			label = e.StartTime.Local().Format("2006-01-02 15:04")
+		}
+		value := string(e.Status)
+		if e.StartTime != nil && e.EndTime != nil {
+			value += "  " + render.FormatDuration(e.EndTime.Sub(*e.StartTime).Round(time.Second))
+		}
+		d.FieldStyled(label, value, executionStyle(e.Status))
+		if details := strings.TrimSpace(appaws.Str(e.StatusDetails)); details != "" {
+			d.DimIndent(details)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *WindowRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	w, ok := resource.(*WindowResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "Name", Value: w.GetName()},
+		{Label: "Enabled", Value: getEnabled(w)},
+		{Label: "Schedule", Value: w.Schedule()},
+	}
+	if e, ok := w.LastExecution(); ok {
+		fields = append(fields, render.SummaryField{Label: "Last Run", Value: string(e.Status), Style: executionStyle(e.Status)})
+	}
+	return fields
+}
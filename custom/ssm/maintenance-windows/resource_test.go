@@ -0,0 +1,50 @@
+package maintenancewindows
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+)
+
+func TestWindowResource(t *testing.T) {
+	r := NewWindowResource(types.MaintenanceWindowIdentity{
+		WindowId:          aws.String("mw-1"),
+		Name:              aws.String("patching"),
+		Enabled:           true,
+		Schedule:          aws.String("cron(0 2 ? * SUN *)"),
+		ScheduleTimezone:  aws.String("Europe/Berlin"),
+		NextExecutionTime: aws.String("2024-05-05T02:00+02:00"),
+	})
+	if r.Schedule() != "cron(0 2 ? * SUN *) Europe/Berlin" {
+		t.Errorf("Schedule() = %q", r.Schedule())
+	}
+	if want := time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC); !r.NextExecution().Equal(want) {
+		t.Errorf("NextExecution() = %v, want %v", r.NextExecution(), want)
+	}
+	if _, ok := r.LastExecution(); ok {
+		t.Error("window without executions reports a last execution")
+	}
+
+	r.Executions = []types.MaintenanceWindowExecution{{Status: types.MaintenanceWindowExecutionStatusFailed}}
+	if e, ok := r.LastExecution(); !ok || e.Status != types.MaintenanceWindowExecutionStatusFailed {
+		t.Errorf("LastExecution() = %v, %v", e.Status, ok)
+	}
+}
+
+func TestResolveTaskTargets(t *testing.T) {
+	registered := []types.MaintenanceWindowTarget{
+		{WindowTargetId: aws.String("t-1"), Targets: []types.Target{{Key: aws.String("tag:Env"), Values: []string{"prod"}}}},
+		{WindowTargetId: aws.String("t-2"), Targets: []types.Target{{Key: aws.String("InstanceIds"), Values: []string{"i-1"}}}},
+	}
+	got := resolveTaskTargets([]types.Target{
+		{Key: aws.String(windowTargetsKey), Values: []string{"t-2", "t-3"}},
+		{Key: aws.String("InstanceIds"), Values: []string{"i-9"}},
+	}, registered)
+	if want := "InstanceIds=i-1; InstanceIds=i-9"; appssm.FormatTargets(got) != want {
+		t.Errorf("resolveTaskTargets() = %q, want %q", appssm.FormatTargets(got), want)
+	}
+}
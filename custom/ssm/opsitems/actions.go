@@ -0,0 +1,65 @@
+package opsitems
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ssm", "opsitems", []action.Action{
+		{
+			Name:      "Set In Progress",
+			Shortcut:  "I",
+			Type:      action.ActionTypeAPI,
+			Operation: "SetInProgress",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				o, ok := dao.UnwrapResource(r).(*OpsItemResource)
+				return ok && o.Item.Status == types.OpsItemStatusOpen
+			},
+		},
+		{
+			Name:      "Resolve",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "Resolve",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				o, ok := dao.UnwrapResource(r).(*OpsItemResource)
+				return ok && !o.IsResolved()
+			},
+		},
+	})
+
+	action.RegisterExecutor("ssm", "opsitems", executeOpsItemAction)
+}
+
+func executeOpsItemAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "SetInProgress":
+		return executeSetStatus(ctx, resource, types.OpsItemStatusInProgress)
+	case "Resolve":
+		return executeSetStatus(ctx, resource, types.OpsItemStatusResolved)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeSetStatus(ctx context.Context, resource dao.Resource, status types.OpsItemStatus) action.ActionResult {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	id := resource.GetID()
+	if _, err := client.UpdateOpsItem(ctx, &ssm.UpdateOpsItemInput{OpsItemId: &id, Status: status}); err != nil {
+		return action.FailResultf(err, "update ops item %s", id)
+	}
+	return action.SuccessResult(fmt.Sprintf("Set %s to %s", id, status))
+}
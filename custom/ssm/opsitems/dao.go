@@ -0,0 +1,212 @@
+package opsitems
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// resourcesKey is the operational data key holding an OpsItem's related
+// resources as a JSON list of {"arn": ...} objects.
+const resourcesKey = "/aws/resources"
+
+// OpsItemDAO provides data access for OpsCenter OpsItems
+type OpsItemDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewOpsItemDAO creates a new OpsItemDAO
+func NewOpsItemDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &OpsItemDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "opsitems"),
+		client:  client,
+	}, nil
+}
+
+// List returns the open and in-progress OpsItems, most severe first. The
+// IncludeResolved toggle lists every OpsItem.
+func (d *OpsItemDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &ssm.DescribeOpsItemsInput{}
+	if dao.GetFilterFromContext(ctx, "IncludeResolved") != "true" {
+		input.OpsItemFilters = []types.OpsItemFilter{{
+			Key:      types.OpsItemFilterKeyStatus,
+			Operator: types.OpsItemFilterOperatorEqual,
+			Values:   []string{string(types.OpsItemStatusOpen), string(types.OpsItemStatusInProgress)},
+		}}
+	}
+	items, err := appaws.Paginate(ctx, func(token *string) ([]types.OpsItemSummary, *string, error) {
+		input.NextToken = token
+		output, err := d.client.DescribeOpsItems(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe ops items")
+		}
+		return output.OpsItemSummaries, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*OpsItemResource, 0, len(items))
+	for _, item := range items {
+		resources = append(resources, NewOpsItemResource(item))
+	}
+	slices.SortFunc(resources, func(a, b *OpsItemResource) int {
+		return cmp.Or(
+			cmp.Compare(a.severityRank(), b.severityRank()),
+			b.CreatedTime().Compare(a.CreatedTime()),
+		)
+	})
+	result := make([]dao.Resource, len(resources))
+	for i, r := range resources {
+		result[i] = r
+	}
+	return result, nil
+}
+
+// Get returns an OpsItem with its description and related items
+func (d *OpsItemDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.GetOpsItem(ctx, &ssm.GetOpsItemInput{OpsItemId: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get ops item %s", id)
+	}
+	if output.OpsItem == nil {
+		return nil, fmt.Errorf("ops item not found: %s", id)
+	}
+	r := NewOpsItemResourceFromDetail(*output.OpsItem)
+
+	related, err := appaws.Paginate(ctx, func(token *string) ([]types.OpsItemRelatedItemSummary, *string, error) {
+		out, err := d.client.ListOpsItemRelatedItems(ctx, &ssm.ListOpsItemRelatedItemsInput{OpsItemId: &id, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrapf(err, "list related items of %s", id)
+		}
+		return out.Summaries, out.NextToken, nil
+	})
+	if err != nil {
+		// Related items only add to the detail view
+		log.Warn("failed to list ops item related items", "id", id, "error", err)
+	}
+	r.RelatedItems = related
+	return r, nil
+}
+
+// Delete is not supported for OpsItems; resolve them instead
+func (d *OpsItemDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for OpsItems")
+}
+
+// OpsItemResource represents an OpsCenter OpsItem
+type OpsItemResource struct {
+	dao.BaseResource
+	Item types.OpsItemSummary
+
+	// Detail and RelatedItems are populated by Get.
+	Detail       *types.OpsItem
+	RelatedItems []types.OpsItemRelatedItemSummary
+}
+
+// NewOpsItemResource creates a new OpsItemResource from a list entry
+func NewOpsItemResource(item types.OpsItemSummary) *OpsItemResource {
+	return &OpsItemResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(item.OpsItemId),
+			Name: appaws.Str(item.Title),
+			Data: item,
+		},
+		Item: item,
+	}
+}
+
+// NewOpsItemResourceFromDetail creates a new OpsItemResource from GetOpsItem
+func NewOpsItemResourceFromDetail(item types.OpsItem) *OpsItemResource {
+	r := NewOpsItemResource(types.OpsItemSummary{
+		OpsItemId:        item.OpsItemId,
+		Title:            item.Title,
+		Status:           item.Status,
+		Severity:         item.Severity,
+		Priority:         item.Priority,
+		Source:           item.Source,
+		Category:         item.Category,
+		OpsItemType:      item.OpsItemType,
+		CreatedBy:        item.CreatedBy,
+		CreatedTime:      item.CreatedTime,
+		LastModifiedBy:   item.LastModifiedBy,
+		LastModifiedTime: item.LastModifiedTime,
+		OperationalData:  item.OperationalData,
+	})
+	r.ARN = appaws.Str(item.OpsItemArn)
+	r.Data = item
+	r.Detail = &item
+	return r
+}
+
+// Status returns the OpsItem status, e.g. Open or Resolved
+func (r *OpsItemResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// Severity returns the severity, "1" (critical) to "4" (low)
+func (r *OpsItemResource) Severity() string {
+	return appaws.Str(r.Item.Severity)
+}
+
+// severityRank orders OpsItems by severity, unset last
+func (r *OpsItemResource) severityRank() string {
+	if s := r.Severity(); s != "" {
+		return s
+	}
+	return "9"
+}
+
+// IsResolved reports whether the OpsItem no longer needs attention
+func (r *OpsItemResource) IsResolved() bool {
+	return r.Item.Status == types.OpsItemStatusResolved || r.Item.Status == types.OpsItemStatusClosed
+}
+
+// CreatedTime returns when the OpsItem was created
+func (r *OpsItemResource) CreatedTime() time.Time {
+	if r.Item.CreatedTime == nil {
+		return time.Time{}
+	}
+	return *r.Item.CreatedTime
+}
+
+// RelatedResources returns the ARNs of the resources the OpsItem is about:
+// those in its operational data, then any related items added since
+func (r *OpsItemResource) RelatedResources() []string {
+	var arns []string
+	if v, ok := r.Item.OperationalData[resourcesKey]; ok && v.Value != nil {
+		var entries []struct {
+			ARN string `json:"arn"`
+		}
+		if err := json.Unmarshal([]byte(*v.Value), &entries); err == nil {
+			for _, e := range entries {
+				if e.ARN != "" {
+					arns = append(arns, e.ARN)
+				}
+			}
+		}
+	}
+	for _, item := range r.RelatedItems {
+		if uri := appaws.Str(item.ResourceUri); uri != "" && !slices.Contains(arns, uri) {
+			arns = append(arns, uri)
+		}
+	}
+	return arns
+}
@@ -0,0 +1,197 @@
+package opsitems
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Toggler = (*OpsItemRenderer)(nil)
+
+// OpsItemRenderer renders OpsCenter OpsItems
+type OpsItemRenderer struct {
+	render.BaseRenderer
+}
+
+// NewOpsItemRenderer creates a new OpsItemRenderer
+func NewOpsItemRenderer() render.Renderer {
+	return &OpsItemRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "opsitems",
+			Cols: []render.Column{
+				{Name: "ID", Width: 22, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 1},
+				{Name: "TITLE", Width: 44, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "SEV", Width: 4, Getter: getSeverity, Priority: 0},
+				{Name: "PRI", Width: 4, Getter: getPriority, Priority: 2},
+				{Name: "STATUS", Width: 11, Getter: getStatus, Priority: 0},
+				{Name: "SOURCE", Width: 16, Getter: getSource, Priority: 2},
+				{Name: "CATEGORY", Width: 14, Getter: getCategory, Priority: 3},
+				{Name: "RESOURCES", Width: 10, Getter: getResourceCount, Priority: 3},
+				{Name: "AGE", Width: 8, Getter: getAge, Priority: 1},
+			},
+		},
+	}
+}
+
+func getSeverity(r dao.Resource) string {
+	if o, ok := r.(*OpsItemResource); ok && o.Severity() != "" {
+		return o.Severity()
+	}
+	return "-"
+}
+
+func getPriority(r dao.Resource) string {
+	if o, ok := r.(*OpsItemResource); ok && o.Item.Priority != nil {
+		return fmt.Sprintf("%d", *o.Item.Priority)
+	}
+	return "-"
+}
+
+func getStatus(r dao.Resource) string {
+	if o, ok := r.(*OpsItemResource); ok {
+		return o.Status()
+	}
+	return ""
+}
+
+func getSource(r dao.Resource) string {
+	if o, ok := r.(*OpsItemResource); ok {
+		return appaws.Str(o.Item.Source)
+	}
+	return ""
+}
+
+func getCategory(r dao.Resource) string {
+	if o, ok := r.(*OpsItemResource); ok {
+		return appaws.Str(o.Item.Category)
+	}
+	return ""
+}
+
+func getResourceCount(r dao.Resource) string {
+	if o, ok := r.(*OpsItemResource); ok {
+		if n := len(o.RelatedResources()); n > 0 {
+			return fmt.Sprintf("%d", n)
+		}
+	}
+	return "-"
+}
+
+func getAge(r dao.Resource) string {
+	if o, ok := r.(*OpsItemResource); ok {
+		if t := o.CreatedTime(); !t.IsZero() {
+			return render.FormatAge(t)
+		}
+	}
+	return "-"
+}
+
+// severityStyle colors a severity, 1 being critical
+func severityStyle(severity string) lipgloss.Style {
+	switch severity {
+	case "1", "2":
+		return ui.DangerStyle()
+	case "3":
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
+
+// RenderDetail renders the OpsItem with its related resources
+func (r *OpsItemRenderer) RenderDetail(resource dao.Resource) string {
+	o, ok := resource.(*OpsItemResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("OpsItem", o.GetName())
+
+	d.Section("OpsItem")
+	d.Field("ID", o.GetID())
+	d.Field("Title", o.GetName())
+	d.Field("Status", o.Status())
+	if sev := o.Severity(); sev != "" {
+		d.FieldStyled("Severity", sev, severityStyle(sev))
+	}
+	if o.Item.Priority != nil {
+		d.Field("Priority", fmt.Sprintf("%d", *o.Item.Priority))
+	}
+	d.FieldIf("Source", o.Item.Source)
+	d.FieldIf("Category", o.Item.Category)
+	d.FieldIf("Type", o.Item.OpsItemType)
+	d.FieldIf("Created By", o.Item.CreatedBy)
+	if t := o.CreatedTime(); !t.IsZero() {
+		d.Field("Created", t.Format("2006-01-02 15:04:05"))
+	}
+	if o.Item.LastModifiedTime != nil {
+		d.Field("Last Modified", o.Item.LastModifiedTime.Format("2006-01-02 15:04:05"))
+	}
+
+	if o.Detail != nil {
+		if desc := appaws.Str(o.Detail.Description); desc != "" {
+			d.Section("Description")
+			for line := range strings.SplitSeq(strings.TrimSpace(desc), "\n") {
+				d.Line(line)
+			}
+		}
+	}
+
+	d.Section("Related Resources")
+	if arns := o.RelatedResources(); len(arns) == 0 {
+		d.Dim("No related resources")
+	} else {
+		for _, arn := range arns {
+			d.Line(arn)
+		}
+	}
+
+	var keys []string
+	for _, k := range slices.Sorted(maps.Keys(o.Item.OperationalData)) {
+		if k != resourcesKey {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) > 0 {
+		d.Section("Operational Data")
+		for _, k := range keys {
+			d.Field(k, appaws.Str(o.Item.OperationalData[k].Value))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *OpsItemRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	o, ok := resource.(*OpsItemResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "ID", Value: o.GetID()},
+		{Label: "Title", Value: o.GetName()},
+		{Label: "Status", Value: o.Status()},
+	}
+	if sev := o.Severity(); sev != "" {
+		fields = append(fields, render.SummaryField{Label: "Severity", Value: sev, Style: severityStyle(sev)})
+	}
+	return fields
+}
+
+// ListToggles returns the resolved OpsItems toggle
+func (r *OpsItemRenderer) ListToggles() []render.Toggle {
+	return []render.Toggle{
+		{Key: "r", ContextKey: "IncludeResolved", LabelOn: "all", LabelOff: "open"},
+	}
+}
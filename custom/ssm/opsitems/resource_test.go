@@ -0,0 +1,41 @@
+package opsitems
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+func TestOpsItemResource(t *testing.T) {
+	r := NewOpsItemResource(types.OpsItemSummary{
+		OpsItemId: aws.String("oi-1"),
+		Title:     aws.String("CPU alarm"),
+		Status:    types.OpsItemStatusInProgress,
+		Severity:  aws.String("2"),
+		OperationalData: map[string]types.OpsItemDataValue{
+			resourcesKey: {Value: aws.String(`[{"arn":"arn:aws:ec2:us-east-1:111122223333:instance/i-1"}]`)},
+		},
+	})
+	r.RelatedItems = []types.OpsItemRelatedItemSummary{
+		{ResourceUri: aws.String("arn:aws:ec2:us-east-1:111122223333:instance/i-1")},
+		{ResourceUri: aws.String("arn:aws:rds:us-east-1:111122223333:db:db-1")},
+	}
+
+	if r.IsResolved() || r.severityRank() != "2" {
+		t.Errorf("resolved = %v, rank = %q", r.IsResolved(), r.severityRank())
+	}
+	want := []string{
+		"arn:aws:ec2:us-east-1:111122223333:instance/i-1",
+		"arn:aws:rds:us-east-1:111122223333:db:db-1",
+	}
+	if got := r.RelatedResources(); !slices.Equal(got, want) {
+		t.Errorf("RelatedResources() = %v, want %v", got, want)
+	}
+
+	resolved := NewOpsItemResource(types.OpsItemSummary{Status: types.OpsItemStatusResolved})
+	if !resolved.IsResolved() || resolved.severityRank() != "9" || len(resolved.RelatedResources()) != 0 {
+		t.Errorf("resolved item: resolved = %v, rank = %q", resolved.IsResolved(), resolved.severityRank())
+	}
+}
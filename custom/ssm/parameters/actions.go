@@ -47,11 +47,10 @@ func executeParameterAction(ctx context.Context, act action.Action, resource dao
 }
 
 func executeDeleteParameter(ctx context.Context, resource dao.Resource) action.ActionResult {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ssm.NewFromConfig)
 	if err != nil {
 		return action.ActionResult{Success: false, Error: err}
 	}
-	client := ssm.NewFromConfig(cfg)
 
 	paramName := resource.GetID()
 	input := &ssm.DeleteParameterInput{
@@ -20,13 +20,13 @@ type ParameterDAO struct {
 
 // NewParameterDAO creates a new ParameterDAO
 func NewParameterDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ssm.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ParameterDAO{
 		BaseDAO: dao.NewBaseDAO("ssm", "parameters"),
-		client:  ssm.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,53 @@
+package patchcompliance
+
+import (
+	"context"
+	"fmt"
+
+	appssm "github.com/clawscli/claws/custom/ssm"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ssm", "patch-compliance", []action.Action{
+		{
+			Name:      "Patch Now",
+			Shortcut:  "P",
+			Type:      action.ActionTypeAPI,
+			Operation: "PatchNow",
+			Confirm:   action.ConfirmSimple,
+			Input:     appssm.PatchNowInput,
+			Filter: func(r dao.Resource) bool {
+				pc, ok := dao.UnwrapResource(r).(*PatchComplianceResource)
+				return ok && pc.PingStatus() == "Online"
+			},
+		},
+		{
+			Name:     "SSM Session",
+			Shortcut: "x",
+			Type:     action.ActionTypeExec,
+			Command:  "aws ssm start-session --target ${ID}",
+		},
+	})
+
+	action.RegisterExecutor("ssm", "patch-compliance", executePatchComplianceAction)
+}
+
+func executePatchComplianceAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "PatchNow":
+		return executePatchNow(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executePatchNow(ctx context.Context, resource dao.Resource) action.ActionResult {
+	instanceID := resource.GetID()
+	commandID, err := appssm.PatchNow(ctx, instanceID, action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+	return action.SuccessResult(fmt.Sprintf("Started patching %s (command %s)", instanceID, commandID))
+}
@@ -0,0 +1,292 @@
+package patchcompliance
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	appssm "github.com/clawscli/claws/custom/ssm"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// Compliance states of a managed instance.
+const (
+	StateCompliant    = "Compliant"
+	StateNonCompliant = "NonCompliant"
+	StateNotScanned   = "NotScanned"
+)
+
+// patchStatesBatch is the most instance IDs DescribeInstancePatchStates accepts.
+const patchStatesBatch = 50
+
+// PatchComplianceDAO provides data access for the patch compliance of SSM
+// managed instances
+type PatchComplianceDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewPatchComplianceDAO creates a new PatchComplianceDAO
+func NewPatchComplianceDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appssm.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &PatchComplianceDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "patch-compliance"),
+		client:  client,
+	}, nil
+}
+
+// List returns the patch compliance of every managed instance, non-compliant
+// instances with the most missing critical patches first. An InstanceId
+// filter in context narrows it to one instance.
+func (d *PatchComplianceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &ssm.DescribeInstanceInformationInput{MaxResults: appaws.Int32Ptr(50)}
+	if id := dao.GetFilterFromContext(ctx, "InstanceId"); id != "" {
+		input.Filters = []types.InstanceInformationStringFilter{
+			{Key: appaws.StringPtr("InstanceIds"), Values: []string{id}},
+		}
+	}
+	infos, err := appaws.Paginate(ctx, func(token *string) ([]types.InstanceInformation, *string, error) {
+		input.NextToken = token
+		output, err := d.client.DescribeInstanceInformation(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe instance information")
+		}
+		return output.InstanceInformationList, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(infos))
+	for _, info := range infos {
+		ids = append(ids, appaws.Str(info.InstanceId))
+	}
+	states, err := d.patchStates(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	names := instanceNames(ctx, ids)
+
+	resources := make([]dao.Resource, 0, len(infos))
+	for _, info := range infos {
+		id := appaws.Str(info.InstanceId)
+		resources = append(resources, NewPatchComplianceResource(info, states[id], names[id]))
+	}
+	slices.SortFunc(resources, func(a, b dao.Resource) int {
+		ra, rb := a.(*PatchComplianceResource), b.(*PatchComplianceResource)
+		return cmp.Or(
+			cmp.Compare(stateRank(ra.Status()), stateRank(rb.Status())),
+			cmp.Compare(rb.CriticalCount(), ra.CriticalCount()),
+			cmp.Compare(rb.MissingCount(), ra.MissingCount()),
+			cmp.Compare(ra.GetID(), rb.GetID()),
+		)
+	})
+	return resources, nil
+}
+
+func stateRank(state string) int {
+	switch state {
+	case StateNonCompliant:
+		return 0
+	case StateNotScanned:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// patchStates returns the patch state of each instance that has been scanned
+func (d *PatchComplianceDAO) patchStates(ctx context.Context, ids []string) (map[string]*types.InstancePatchState, error) {
+	states := make(map[string]*types.InstancePatchState, len(ids))
+	for batch := range slices.Chunk(ids, patchStatesBatch) {
+		items, err := appaws.Paginate(ctx, func(token *string) ([]types.InstancePatchState, *string, error) {
+			output, err := d.client.DescribeInstancePatchStates(ctx, &ssm.DescribeInstancePatchStatesInput{
+				InstanceIds: batch,
+				NextToken:   token,
+			})
+			if err != nil {
+				return nil, nil, apperrors.Wrap(err, "describe instance patch states")
+			}
+			return output.InstancePatchStates, output.NextToken, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range items {
+			states[appaws.Str(items[i].InstanceId)] = &items[i]
+		}
+	}
+	return states, nil
+}
+
+// instanceNames returns the Name tag of the EC2 instances among ids. Hybrid
+// (mi-) instances have no EC2 counterpart; failures only cost the names.
+func instanceNames(ctx context.Context, ids []string) map[string]string {
+	ec2IDs := slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return !strings.HasPrefix(id, "i-") })
+	names := make(map[string]string, len(ec2IDs))
+	if len(ec2IDs) == 0 {
+		return names
+	}
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		log.Warn("failed to create EC2 client for instance names", "error", err)
+		return names
+	}
+	for batch := range slices.Chunk(ec2IDs, 200) {
+		paginator := ec2.NewDescribeInstancesPaginator(client, &ec2.DescribeInstancesInput{
+			Filters: []ec2types.Filter{{Name: appaws.StringPtr("instance-id"), Values: batch}},
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				log.Warn("failed to describe instances for names", "error", err)
+				return names
+			}
+			for _, reservation := range output.Reservations {
+				for _, instance := range reservation.Instances {
+					if name := appaws.EC2NameTag(instance.Tags); name != "" {
+						names[appaws.Str(instance.InstanceId)] = name
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// Get returns the patch compliance of one managed instance
+func (d *PatchComplianceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	resources, err := d.List(dao.WithFilter(ctx, "InstanceId", id))
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("managed instance not found: %s", id)
+	}
+	return resources[0], nil
+}
+
+// Delete is not supported for patch compliance
+func (d *PatchComplianceDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for patch compliance")
+}
+
+// PatchComplianceData is the raw data of a PatchComplianceResource: the
+// managed instance and, once scanned, its patch state.
+type PatchComplianceData struct {
+	types.InstanceInformation
+	PatchState *types.InstancePatchState `json:",omitempty"`
+}
+
+// PatchComplianceResource is the patch compliance of one managed instance
+type PatchComplianceResource struct {
+	dao.BaseResource
+	Info       types.InstanceInformation
+	PatchState *types.InstancePatchState
+}
+
+// NewPatchComplianceResource creates a new PatchComplianceResource. name is
+// the EC2 Name tag, falling back to the instance's computer name.
+func NewPatchComplianceResource(info types.InstanceInformation, state *types.InstancePatchState, name string) *PatchComplianceResource {
+	id := appaws.Str(info.InstanceId)
+	if name == "" {
+		name = appaws.Str(info.ComputerName)
+	}
+	if name == "" {
+		name = id
+	}
+	return &PatchComplianceResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: name,
+			Data: PatchComplianceData{InstanceInformation: info, PatchState: state},
+		},
+		Info:       info,
+		PatchState: state,
+	}
+}
+
+// Status returns the compliance state: NonCompliant when any patch is
+// missing, failed or non-compliant, NotScanned before the first scan
+func (r *PatchComplianceResource) Status() string {
+	s := r.PatchState
+	if s == nil {
+		return StateNotScanned
+	}
+	if r.CriticalCount() > 0 || r.SecurityCount() > 0 || appaws.Int32(s.OtherNonCompliantCount) > 0 ||
+		s.MissingCount > 0 || s.FailedCount > 0 {
+		return StateNonCompliant
+	}
+	return StateCompliant
+}
+
+// CriticalCount returns the number of missing or failed critical patches
+func (r *PatchComplianceResource) CriticalCount() int32 {
+	if r.PatchState == nil {
+		return 0
+	}
+	return appaws.Int32(r.PatchState.CriticalNonCompliantCount)
+}
+
+// SecurityCount returns the number of missing or failed security patches
+func (r *PatchComplianceResource) SecurityCount() int32 {
+	if r.PatchState == nil {
+		return 0
+	}
+	return appaws.Int32(r.PatchState.SecurityNonCompliantCount)
+}
+
+// MissingCount returns the number of approved patches not installed
+func (r *PatchComplianceResource) MissingCount() int32 {
+	if r.PatchState == nil {
+		return 0
+	}
+	return r.PatchState.MissingCount
+}
+
+// FailedCount returns the number of patches that failed to install
+func (r *PatchComplianceResource) FailedCount() int32 {
+	if r.PatchState == nil {
+		return 0
+	}
+	return r.PatchState.FailedCount
+}
+
+// PendingRebootCount returns the number of patches installed but waiting
+// for a reboot
+func (r *PatchComplianceResource) PendingRebootCount() int32 {
+	if r.PatchState == nil {
+		return 0
+	}
+	return appaws.Int32(r.PatchState.InstalledPendingRebootCount)
+}
+
+// Platform returns the platform name and version, e.g. "Amazon Linux 2023"
+func (r *PatchComplianceResource) Platform() string {
+	return strings.TrimSpace(appaws.Str(r.Info.PlatformName) + " " + appaws.Str(r.Info.PlatformVersion))
+}
+
+// PingStatus returns the SSM agent status (Online, ConnectionLost, Inactive)
+func (r *PatchComplianceResource) PingStatus() string {
+	return string(r.Info.PingStatus)
+}
+
+// IsEC2 reports whether the managed instance is an EC2 instance rather than
+// a hybrid node
+func (r *PatchComplianceResource) IsEC2() bool {
+	return strings.HasPrefix(r.GetID(), "i-")
+}
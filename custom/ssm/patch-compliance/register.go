@@ -0,0 +1,20 @@
+package patchcompliance
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ssm", "patch-compliance", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPatchComplianceDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPatchComplianceRenderer()
+		},
+	})
+}
@@ -0,0 +1,190 @@
+package patchcompliance
+
+import (
+	"fmt"
+
+	"charm.land/lipgloss/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var _ render.Navigator = (*PatchComplianceRenderer)(nil)
+
+// PatchComplianceRenderer renders the patch compliance of managed instances
+type PatchComplianceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPatchComplianceRenderer creates a new PatchComplianceRenderer
+func NewPatchComplianceRenderer() render.Renderer {
+	return &PatchComplianceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "patch-compliance",
+			Cols: []render.Column{
+				{Name: "INSTANCE ID", Width: 21, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "NAME", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "COMPLIANCE", Width: 13, Getter: getStatus, Priority: 0},
+				{Name: "CRITICAL", Width: 9, Getter: countGetter((*PatchComplianceResource).CriticalCount), Priority: 0},
+				{Name: "SECURITY", Width: 9, Getter: countGetter((*PatchComplianceResource).SecurityCount), Priority: 1},
+				{Name: "MISSING", Width: 8, Getter: countGetter((*PatchComplianceResource).MissingCount), Priority: 1},
+				{Name: "FAILED", Width: 7, Getter: countGetter((*PatchComplianceResource).FailedCount), Priority: 2},
+				{Name: "REBOOT", Width: 7, Getter: countGetter((*PatchComplianceResource).PendingRebootCount), Priority: 3},
+				{Name: "LAST OP", Width: 14, Getter: getLastOperation, Priority: 2},
+				{Name: "PLATFORM", Width: 22, Getter: getPlatform, Priority: 4},
+				{Name: "AGENT", Width: 14, Getter: getPingStatus, Priority: 3},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if pc, ok := r.(*PatchComplianceResource); ok {
+		return pc.Status()
+	}
+	return ""
+}
+
+// countGetter shows a patch count, or "-" for instances not yet scanned
+func countGetter(count func(*PatchComplianceResource) int32) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		pc, ok := r.(*PatchComplianceResource)
+		if !ok || pc.PatchState == nil {
+			return "-"
+		}
+		return fmt.Sprintf("%d", count(pc))
+	}
+}
+
+func getLastOperation(r dao.Resource) string {
+	pc, ok := r.(*PatchComplianceResource)
+	if !ok || pc.PatchState == nil || pc.PatchState.OperationEndTime == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%s %s", pc.PatchState.Operation, render.FormatAge(*pc.PatchState.OperationEndTime))
+}
+
+func getPlatform(r dao.Resource) string {
+	if pc, ok := r.(*PatchComplianceResource); ok {
+		return pc.Platform()
+	}
+	return ""
+}
+
+func getPingStatus(r dao.Resource) string {
+	if pc, ok := r.(*PatchComplianceResource); ok {
+		return pc.PingStatus()
+	}
+	return ""
+}
+
+// statusStyle colors a compliance state
+func statusStyle(state string) lipgloss.Style {
+	switch state {
+	case StateCompliant:
+		return ui.SuccessStyle()
+	case StateNonCompliant:
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+// RenderDetail renders detailed patch compliance information
+func (r *PatchComplianceRenderer) RenderDetail(resource dao.Resource) string {
+	pc, ok := resource.(*PatchComplianceResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Patch Compliance", pc.GetName())
+
+	d.Section("Managed Instance")
+	d.Field("Instance ID", pc.GetID())
+	d.FieldIf("Computer Name", pc.Info.ComputerName)
+	d.Field("Platform", pc.Platform())
+	d.FieldIf("IP Address", pc.Info.IPAddress)
+	d.FieldIf("Agent Version", pc.Info.AgentVersion)
+	d.Field("Agent Status", pc.PingStatus())
+	if pc.Info.LastPingDateTime != nil {
+		d.Field("Last Ping", pc.Info.LastPingDateTime.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Compliance")
+	d.FieldStyled("State", pc.Status(), statusStyle(pc.Status()))
+	s := pc.PatchState
+	if s == nil {
+		d.Dim("No patch scan has reported for this instance yet")
+		return d.String()
+	}
+	d.Field("Critical Non-Compliant", fmt.Sprintf("%d", pc.CriticalCount()))
+	d.Field("Security Non-Compliant", fmt.Sprintf("%d", pc.SecurityCount()))
+	d.Field("Other Non-Compliant", fmt.Sprintf("%d", appaws.Int32(s.OtherNonCompliantCount)))
+	d.Field("Missing", fmt.Sprintf("%d", s.MissingCount))
+	d.Field("Failed", fmt.Sprintf("%d", s.FailedCount))
+	d.Field("Installed", fmt.Sprintf("%d", s.InstalledCount))
+	d.Field("Installed Pending Reboot", fmt.Sprintf("%d", pc.PendingRebootCount()))
+	d.Field("Installed Other", fmt.Sprintf("%d", s.InstalledOtherCount))
+	d.Field("Installed Rejected", fmt.Sprintf("%d", appaws.Int32(s.InstalledRejectedCount)))
+	d.Field("Not Applicable", fmt.Sprintf("%d", s.NotApplicableCount))
+
+	d.Section("Last Operation")
+	d.Field("Operation", string(s.Operation))
+	if s.OperationStartTime != nil {
+		d.Field("Started", s.OperationStartTime.Format("2006-01-02 15:04:05"))
+	}
+	if s.OperationEndTime != nil {
+		d.Field("Ended", s.OperationEndTime.Format("2006-01-02 15:04:05"))
+	}
+	if s.RebootOption != "" {
+		d.Field("Reboot Option", string(s.RebootOption))
+	}
+
+	d.Section("Baseline")
+	d.FieldIf("Baseline ID", s.BaselineId)
+	d.FieldIf("Patch Group", s.PatchGroup)
+	d.FieldIf("Snapshot ID", s.SnapshotId)
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PatchComplianceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	pc, ok := resource.(*PatchComplianceResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Instance ID", Value: pc.GetID()},
+		{Label: "Name", Value: pc.GetName()},
+		{Label: "Compliance", Value: pc.Status(), Style: statusStyle(pc.Status())},
+	}
+	if pc.PatchState != nil {
+		fields = append(fields,
+			render.SummaryField{Label: "Critical", Value: fmt.Sprintf("%d", pc.CriticalCount())},
+			render.SummaryField{Label: "Missing", Value: fmt.Sprintf("%d", pc.MissingCount())},
+		)
+	}
+	fields = append(fields,
+		render.SummaryField{Label: "Platform", Value: pc.Platform()},
+		render.SummaryField{Label: "Agent", Value: pc.PingStatus()},
+	)
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *PatchComplianceRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	pc, ok := resource.(*PatchComplianceResource)
+	if !ok || !pc.IsEC2() {
+		return nil
+	}
+	return []render.Navigation{{
+		Key: "i", Label: "Instance", Service: "ec2", Resource: "instances",
+		FilterField: "InstanceId", FilterValue: pc.GetID(),
+	}}
+}
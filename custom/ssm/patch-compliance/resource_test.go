@@ -0,0 +1,49 @@
+package patchcompliance
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+func TestPatchComplianceResourceStatus(t *testing.T) {
+	info := types.InstanceInformation{InstanceId: aws.String("i-1"), ComputerName: aws.String("ip-10-0-0-1")}
+
+	tests := []struct {
+		name  string
+		state *types.InstancePatchState
+		want  string
+	}{
+		{"not scanned", nil, StateNotScanned},
+		{"compliant", &types.InstancePatchState{InstalledCount: 40}, StateCompliant},
+		{"missing", &types.InstancePatchState{MissingCount: 2}, StateNonCompliant},
+		{"failed", &types.InstancePatchState{FailedCount: 1}, StateNonCompliant},
+		{"critical", &types.InstancePatchState{CriticalNonCompliantCount: aws.Int32(1)}, StateNonCompliant},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPatchComplianceResource(info, tt.state, "").Status(); got != tt.want {
+				t.Errorf("Status() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPatchComplianceResourceName(t *testing.T) {
+	info := types.InstanceInformation{InstanceId: aws.String("i-1"), ComputerName: aws.String("ip-10-0-0-1")}
+	if got := NewPatchComplianceResource(info, nil, "web").GetName(); got != "web" {
+		t.Errorf("GetName() = %q, want the EC2 Name tag", got)
+	}
+	if got := NewPatchComplianceResource(info, nil, "").GetName(); got != "ip-10-0-0-1" {
+		t.Errorf("GetName() = %q, want the computer name", got)
+	}
+
+	hybrid := NewPatchComplianceResource(types.InstanceInformation{InstanceId: aws.String("mi-1")}, nil, "")
+	if hybrid.GetName() != "mi-1" || hybrid.IsEC2() {
+		t.Errorf("hybrid node: name %q, IsEC2 %v", hybrid.GetName(), hybrid.IsEC2())
+	}
+	if navs := NewPatchComplianceRenderer().(*PatchComplianceRenderer).Navigations(hybrid); len(navs) != 0 {
+		t.Errorf("hybrid node should have no EC2 navigation, got %v", navs)
+	}
+}
@@ -0,0 +1,69 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// GetClient returns an SSM client configured for the current context
+func GetClient(ctx context.Context) (*ssm.Client, error) {
+	return appaws.Client(ctx, ssm.NewFromConfig)
+}
+
+// PatchDocument is the Patch Manager document run by PatchNow.
+const PatchDocument = "AWS-RunPatchBaseline"
+
+// Reboot options of PatchDocument.
+const (
+	RebootIfNeeded = "RebootIfNeeded"
+	NoReboot       = "NoReboot"
+)
+
+// PatchNowInput prompts for the reboot option of a patch-now action.
+var PatchNowInput = &action.InputSpec{
+	Label: "Reboot after installing patches",
+	Choices: func(context.Context, dao.Resource) ([]action.Choice, error) {
+		return []action.Choice{
+			{Value: RebootIfNeeded, Label: "RebootIfNeeded (reboot when an installed patch requires it)"},
+			{Value: NoReboot, Label: "NoReboot (patches needing a reboot stay pending)"},
+		}, nil
+	},
+}
+
+// PatchNow runs PatchDocument with Operation=Install on an SSM managed
+// instance, installing the patches its baseline approves, and returns the
+// command ID.
+func PatchNow(ctx context.Context, instanceID, rebootOption string) (string, error) {
+	if rebootOption == "" {
+		rebootOption = RebootIfNeeded
+	}
+	if rebootOption != RebootIfNeeded && rebootOption != NoReboot {
+		return "", fmt.Errorf("unknown reboot option %q", rebootOption)
+	}
+	client, err := GetClient(ctx)
+	if err != nil {
+		return "", err
+	}
+	out, err := client.SendCommand(ctx, &ssm.SendCommandInput{
+		DocumentName: appaws.StringPtr(PatchDocument),
+		InstanceIds:  []string{instanceID},
+		Parameters: map[string][]string{
+			"Operation":    {"Install"},
+			"RebootOption": {rebootOption},
+		},
+		Comment: appaws.StringPtr("Patch now from claws"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("send %s to %s: %w", PatchDocument, instanceID, err)
+	}
+	if out.Command == nil {
+		return "", nil
+	}
+	return appaws.Str(out.Command.CommandId), nil
+}
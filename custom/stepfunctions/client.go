@@ -10,9 +10,5 @@ import (
 
 // GetClient returns a Step Functions client configured for the current context
 func GetClient(ctx context.Context) (*sfn.Client, error) {
-	cfg, err := appaws.NewConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
-	return sfn.NewFromConfig(cfg), nil
+	return appaws.Client(ctx, sfn.NewFromConfig)
 }
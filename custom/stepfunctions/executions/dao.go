@@ -21,13 +21,13 @@ type ExecutionDAO struct {
 
 // NewExecutionDAO creates a new ExecutionDAO
 func NewExecutionDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sfn.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ExecutionDAO{
 		BaseDAO: dao.NewBaseDAO("stepfunctions", "executions"),
-		client:  sfn.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
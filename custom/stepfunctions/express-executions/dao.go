@@ -0,0 +1,391 @@
+package expressexecutions
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	logtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	cwClient "github.com/clawscli/claws/custom/cloudwatch"
+	sfnClient "github.com/clawscli/claws/custom/stepfunctions"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+const (
+	// lookback is how far back the state machine's logs are scanned.
+	lookback = 24 * time.Hour
+	// scanStep is the slice of the lookback scanned at a time, newest first.
+	scanStep = 6 * time.Hour
+	// maxLogEvents caps the log events read per listing; once reached, older
+	// slices of the lookback are not scanned.
+	maxLogEvents = 10000
+	// executionEventsPattern matches the start and end events of executions.
+	executionEventsPattern = `{ $.type = "Execution*" }`
+)
+
+// Execution statuses, named as for standard workflows
+const (
+	StatusRunning   = "RUNNING"
+	StatusSucceeded = "SUCCEEDED"
+	StatusFailed    = "FAILED"
+	StatusAborted   = "ABORTED"
+	StatusTimedOut  = "TIMED_OUT"
+)
+
+// terminalStatus maps the log event ending an execution to its status
+var terminalStatus = map[string]string{
+	"ExecutionSucceeded": StatusSucceeded,
+	"ExecutionFailed":    StatusFailed,
+	"ExecutionAborted":   StatusAborted,
+	"ExecutionTimedOut":  StatusTimedOut,
+}
+
+// ExpressExecutionDAO reconstructs Express workflow executions, which have
+// no execution history API, from the state machine's CloudWatch logs
+type ExpressExecutionDAO struct {
+	dao.BaseDAO
+	sfn  *sfn.Client
+	logs *cloudwatchlogs.Client
+}
+
+// NewExpressExecutionDAO creates a new ExpressExecutionDAO
+func NewExpressExecutionDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := sfnClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	logs, err := cwClient.GetLogsClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &ExpressExecutionDAO{
+		BaseDAO: dao.NewBaseDAO("stepfunctions", "express-executions"),
+		sfn:     client,
+		logs:    logs,
+	}, nil
+}
+
+// List returns the executions logged over the last day by the state machine
+// given by the StateMachineArn filter, newest first
+func (d *ExpressExecutionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	smArn := dao.GetFilterFromContext(ctx, "StateMachineArn")
+	if smArn == "" {
+		return nil, fmt.Errorf("StateMachineArn filter required - navigate from a state machine")
+	}
+	logging, err := d.logging(ctx, smArn)
+	if err != nil {
+		return nil, err
+	}
+	events, err := d.filterEvents(ctx, logging.logGroup, executionEventsPattern)
+	if err != nil {
+		return nil, err
+	}
+
+	executions := reconstruct(events)
+	resources := make([]dao.Resource, len(executions))
+	for i, e := range executions {
+		e.StateMachineARN = smArn
+		e.LogGroup = logging.logGroup
+		e.LogLevel = logging.level
+		resources[i] = e
+	}
+	return resources, nil
+}
+
+// Get returns an execution with every event it logged
+func (d *ExpressExecutionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	smArn := stateMachineARN(id)
+	if smArn == "" {
+		return nil, fmt.Errorf("invalid express execution ARN %s", id)
+	}
+	logging, err := d.logging(ctx, smArn)
+	if err != nil {
+		return nil, err
+	}
+	events, err := d.filterEvents(ctx, logging.logGroup, fmt.Sprintf(`{ $.execution_arn = %q }`, id))
+	if err != nil {
+		return nil, err
+	}
+
+	executions := reconstruct(events)
+	if len(executions) == 0 {
+		return nil, fmt.Errorf("no log events for execution %s in the last %s", id, lookback)
+	}
+	e := executions[0]
+	e.StateMachineARN = smArn
+	e.LogGroup = logging.logGroup
+	e.LogLevel = logging.level
+	e.History = make([]HistoryEvent, 0, len(events))
+	for _, ev := range events {
+		e.History = append(e.History, ev.history())
+	}
+	slices.SortStableFunc(e.History, func(a, b HistoryEvent) int { return a.Timestamp.Compare(b.Timestamp) })
+	return e, nil
+}
+
+// Delete is not supported; express executions cannot be stopped
+func (d *ExpressExecutionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for express executions")
+}
+
+// Supports returns supported operations
+func (d *ExpressExecutionDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// loggingConfig is where and how much a state machine logs
+type loggingConfig struct {
+	logGroup string
+	level    string
+}
+
+// logging returns the log group a state machine logs its executions to
+func (d *ExpressExecutionDAO) logging(ctx context.Context, smArn string) (loggingConfig, error) {
+	output, err := d.sfn.DescribeStateMachine(ctx, &sfn.DescribeStateMachineInput{StateMachineArn: &smArn})
+	if err != nil {
+		return loggingConfig{}, apperrors.Wrapf(err, "describe state machine %s", smArn)
+	}
+	lc := output.LoggingConfiguration
+	if lc == nil || lc.Level == types.LogLevelOff {
+		return loggingConfig{}, fmt.Errorf("logging is off for %s; enable CloudWatch Logs to see express executions", appaws.Str(output.Name))
+	}
+	for _, dest := range lc.Destinations {
+		if dest.CloudWatchLogsLogGroup == nil {
+			continue
+		}
+		if group := logGroupName(appaws.Str(dest.CloudWatchLogsLogGroup.LogGroupArn)); group != "" {
+			return loggingConfig{logGroup: group, level: string(lc.Level)}, nil
+		}
+	}
+	return loggingConfig{}, fmt.Errorf("no CloudWatch Logs destination for %s", appaws.Str(output.Name))
+}
+
+// filterEvents reads the log events of the lookback window matching
+// pattern, newest slices first so a busy workflow keeps its latest runs
+func (d *ExpressExecutionDAO) filterEvents(ctx context.Context, logGroup, pattern string) ([]logEvent, error) {
+	now := time.Now()
+	var events []logEvent
+	for end := now; now.Sub(end) < lookback && len(events) < maxLogEvents; end = end.Add(-scanStep) {
+		input := &cloudwatchlogs.FilterLogEventsInput{
+			LogGroupName:  &logGroup,
+			FilterPattern: &pattern,
+			StartTime:     appaws.Int64Ptr(end.Add(-scanStep).UnixMilli()),
+			EndTime:       appaws.Int64Ptr(end.UnixMilli() - 1),
+		}
+		for {
+			output, err := d.logs.FilterLogEvents(ctx, input)
+			if err != nil {
+				return nil, apperrors.Wrapf(err, "filter log events of %s", logGroup)
+			}
+			events = append(events, parseEvents(output.Events)...)
+			if output.NextToken == nil || len(events) >= maxLogEvents {
+				break
+			}
+			input.NextToken = output.NextToken
+		}
+	}
+	return events, nil
+}
+
+// logEvent is one event a state machine logs, as documented for Step
+// Functions log levels
+type logEvent struct {
+	Type         string `json:"type"`
+	ExecutionARN string `json:"execution_arn"`
+	Timestamp    string `json:"event_timestamp"`
+	Details      struct {
+		Name   string `json:"name"`
+		Input  string `json:"input"`
+		Output string `json:"output"`
+		Error  string `json:"error"`
+		Cause  string `json:"cause"`
+	} `json:"details"`
+}
+
+// parseEvents decodes log events, skipping any that are not execution events
+func parseEvents(events []logtypes.FilteredLogEvent) []logEvent {
+	parsed := make([]logEvent, 0, len(events))
+	for _, ev := range events {
+		var e logEvent
+		if err := json.Unmarshal([]byte(appaws.Str(ev.Message)), &e); err != nil || e.ExecutionARN == "" {
+			continue
+		}
+		if e.Timestamp == "" {
+			e.Timestamp = strconv.FormatInt(appaws.Int64(ev.Timestamp), 10)
+		}
+		parsed = append(parsed, e)
+	}
+	return parsed
+}
+
+// time returns when the event happened
+func (e logEvent) time() time.Time {
+	ms, err := strconv.ParseInt(e.Timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// history converts the event for the detail view
+func (e logEvent) history() HistoryEvent {
+	return HistoryEvent{
+		Type:      e.Type,
+		Name:      e.Details.Name,
+		Timestamp: e.time(),
+		Error:     e.Details.Error,
+		Cause:     e.Details.Cause,
+	}
+}
+
+// reconstruct groups execution events into executions, newest first. An
+// execution without an end event is still running, one without a start
+// event started before the window or was logged at level ERROR or FATAL.
+func reconstruct(events []logEvent) []*ExpressExecutionResource {
+	byARN := make(map[string]*ExpressExecutionResource)
+	var executions []*ExpressExecutionResource
+	for _, ev := range events {
+		e, ok := byARN[ev.ExecutionARN]
+		if !ok {
+			e = NewExpressExecutionResource(ev.ExecutionARN)
+			byARN[ev.ExecutionARN] = e
+			executions = append(executions, e)
+		}
+		t := ev.time()
+		if t.After(e.LastEvent) {
+			e.LastEvent = t
+		}
+		switch ev.Type {
+		case "ExecutionStarted":
+			e.Started = t
+			e.Input = ev.Details.Input
+		default:
+			status, ok := terminalStatus[ev.Type]
+			if !ok {
+				continue
+			}
+			e.Status = status
+			e.Stopped = t
+			e.Output = ev.Details.Output
+			e.Error = ev.Details.Error
+			e.Cause = ev.Details.Cause
+		}
+	}
+	slices.SortFunc(executions, func(a, b *ExpressExecutionResource) int {
+		return cmp.Or(b.sortTime().Compare(a.sortTime()), strings.Compare(a.ID, b.ID))
+	})
+	return executions
+}
+
+// stateMachineARN returns the state machine of an express execution ARN,
+// arn:aws:states:<region>:<account>:express:<state machine>:<name>:<id>
+func stateMachineARN(executionARN string) string {
+	parts := strings.Split(executionARN, ":")
+	if len(parts) < 8 || parts[5] != "express" {
+		return ""
+	}
+	return strings.Join(append(parts[:5:5], "stateMachine", parts[6]), ":")
+}
+
+// logGroupName returns the name of a log group ARN, which Step Functions
+// records with a trailing ":*"
+func logGroupName(arn string) string {
+	_, rest, ok := strings.Cut(arn, ":log-group:")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSuffix(rest, ":*")
+}
+
+// HistoryEvent is one logged event of an execution
+type HistoryEvent struct {
+	Type      string
+	Name      string
+	Timestamp time.Time
+	Error     string
+	Cause     string
+}
+
+// Execution is what the log events of an express execution tell about it
+type Execution struct {
+	StateMachineARN string
+	LogGroup        string
+	LogLevel        string
+
+	Status    string
+	Started   time.Time
+	Stopped   time.Time
+	LastEvent time.Time
+	Input     string
+	Output    string
+	Error     string
+	Cause     string
+
+	// History is populated by Get.
+	History []HistoryEvent
+}
+
+// ExpressExecutionResource is an Express workflow execution reconstructed
+// from its log events
+type ExpressExecutionResource struct {
+	dao.BaseResource
+	Execution
+}
+
+// NewExpressExecutionResource creates a new, running ExpressExecutionResource
+func NewExpressExecutionResource(arn string) *ExpressExecutionResource {
+	name := arn
+	if parts := strings.Split(arn, ":"); len(parts) >= 8 {
+		name = parts[7]
+	}
+	r := &ExpressExecutionResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn,
+			Name: name,
+			ARN:  arn,
+		},
+		Execution: Execution{Status: StatusRunning},
+	}
+	r.Data = &r.Execution
+	return r
+}
+
+// StateMachineName returns the name of the execution's state machine
+func (r *ExpressExecutionResource) StateMachineName() string {
+	if parts := strings.Split(r.ID, ":"); len(parts) >= 8 {
+		return parts[6]
+	}
+	return ""
+}
+
+// Duration returns how long the execution ran, 0 if its start or end was
+// not logged
+func (r *ExpressExecutionResource) Duration() time.Duration {
+	if r.Started.IsZero() {
+		return 0
+	}
+	if r.Stopped.IsZero() {
+		return time.Since(r.Started)
+	}
+	return r.Stopped.Sub(r.Started)
+}
+
+// sortTime orders executions by start, or by their last event when the
+// start was not logged
+func (r *ExpressExecutionResource) sortTime() time.Time {
+	if !r.Started.IsZero() {
+		return r.Started
+	}
+	return r.LastEvent
+}
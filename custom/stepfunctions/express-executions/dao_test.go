@@ -0,0 +1,86 @@
+package expressexecutions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	logtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+const (
+	execA = "arn:aws:states:us-east-1:123456789012:express:orders:run-a:1111"
+	execB = "arn:aws:states:us-east-1:123456789012:express:orders:run-b:2222"
+)
+
+func message(s string) logtypes.FilteredLogEvent {
+	return logtypes.FilteredLogEvent{Message: aws.String(s), Timestamp: aws.Int64(1700000009000)}
+}
+
+func TestReconstruct(t *testing.T) {
+	events := parseEvents([]logtypes.FilteredLogEvent{
+		message(`{"type":"ExecutionStarted","execution_arn":"` + execA + `","event_timestamp":"1700000000000","details":{"input":"{}"}}`),
+		message(`{"type":"ExecutionStarted","execution_arn":"` + execB + `","event_timestamp":"1700000001000"}`),
+		message(`{"type":"ExecutionFailed","execution_arn":"` + execA + `","event_timestamp":"1700000002500","details":{"error":"States.TaskFailed","cause":"boom"}}`),
+		message(`not json`),
+	})
+	if len(events) != 3 {
+		t.Fatalf("parsed %d events, want 3", len(events))
+	}
+
+	execs := reconstruct(events)
+	if len(execs) != 2 {
+		t.Fatalf("reconstructed %d executions, want 2", len(execs))
+	}
+	b, a := execs[0], execs[1]
+	if b.GetID() != execB || a.GetID() != execA {
+		t.Fatalf("order = %s, %s; want newest first", execs[0].GetName(), execs[1].GetName())
+	}
+	if b.Status != StatusRunning || !b.Stopped.IsZero() {
+		t.Errorf("run-b status = %s, want RUNNING", b.Status)
+	}
+	if a.GetName() != "run-a" || a.StateMachineName() != "orders" {
+		t.Errorf("run-a name = %q / %q", a.GetName(), a.StateMachineName())
+	}
+	if a.Status != StatusFailed || a.Error != "States.TaskFailed" || a.Cause != "boom" || a.Input != "{}" {
+		t.Errorf("run-a = %+v", a.Execution)
+	}
+	if got := a.Duration(); got != 2500*time.Millisecond {
+		t.Errorf("run-a duration = %s, want 2.5s", got)
+	}
+}
+
+func TestReconstructWithoutStart(t *testing.T) {
+	// At level ERROR only the failure is logged
+	execs := reconstruct(parseEvents([]logtypes.FilteredLogEvent{
+		message(`{"type":"ExecutionTimedOut","execution_arn":"` + execA + `"}`),
+	}))
+	if len(execs) != 1 {
+		t.Fatalf("reconstructed %d executions, want 1", len(execs))
+	}
+	e := execs[0]
+	if e.Status != StatusTimedOut || e.Duration() != 0 {
+		t.Errorf("status = %s, duration = %s", e.Status, e.Duration())
+	}
+	if want := time.UnixMilli(1700000009000); !e.Stopped.Equal(want) {
+		t.Errorf("stopped = %s, want the log event time %s", e.Stopped, want)
+	}
+}
+
+func TestStateMachineARN(t *testing.T) {
+	if got, want := stateMachineARN(execA), "arn:aws:states:us-east-1:123456789012:stateMachine:orders"; got != want {
+		t.Errorf("stateMachineARN = %q, want %q", got, want)
+	}
+	if got := stateMachineARN("arn:aws:states:us-east-1:123456789012:execution:orders:run"); got != "" {
+		t.Errorf("stateMachineARN of a standard execution = %q, want empty", got)
+	}
+}
+
+func TestLogGroupName(t *testing.T) {
+	if got := logGroupName("arn:aws:logs:us-east-1:123456789012:log-group:/aws/vendedlogs/states/orders:*"); got != "/aws/vendedlogs/states/orders" {
+		t.Errorf("logGroupName = %q", got)
+	}
+	if got := logGroupName("not-an-arn"); got != "" {
+		t.Errorf("logGroupName = %q, want empty", got)
+	}
+}
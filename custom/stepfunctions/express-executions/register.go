@@ -0,0 +1,20 @@
+package expressexecutions
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("stepfunctions", "express-executions", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewExpressExecutionDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewExpressExecutionRenderer()
+		},
+	})
+}
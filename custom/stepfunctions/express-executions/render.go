@@ -0,0 +1,198 @@
+package expressexecutions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+var (
+	_ render.Navigator    = (*ExpressExecutionRenderer)(nil)
+	_ render.AutoReloader = (*ExpressExecutionRenderer)(nil)
+)
+
+// maxDataLen truncates input, output and cause as the executions view does
+const maxDataLen = 300
+
+// ExpressExecutionRenderer renders Express workflow executions
+type ExpressExecutionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewExpressExecutionRenderer creates a new ExpressExecutionRenderer
+func NewExpressExecutionRenderer() render.Renderer {
+	return &ExpressExecutionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "stepfunctions",
+			Resource: "express-executions",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 38, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "STATUS", Width: 12, Getter: getStatus, Priority: 0},
+				{Name: "STARTED", Width: 20, Getter: getStarted, Priority: 1},
+				{Name: "DURATION", Width: 10, Getter: getDuration, Priority: 2},
+				{Name: "ERROR", Width: 30, Getter: getError, Priority: 3},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if e, ok := r.(*ExpressExecutionResource); ok {
+		return e.Status
+	}
+	return ""
+}
+
+func getStarted(r dao.Resource) string {
+	if e, ok := r.(*ExpressExecutionResource); ok && !e.Started.IsZero() {
+		return e.Started.Format("2006-01-02 15:04:05")
+	}
+	return "-"
+}
+
+func getDuration(r dao.Resource) string {
+	if e, ok := r.(*ExpressExecutionResource); ok {
+		if d := e.Duration(); d > 0 {
+			return render.FormatDuration(d)
+		}
+	}
+	return "-"
+}
+
+func getError(r dao.Resource) string {
+	if e, ok := r.(*ExpressExecutionResource); ok {
+		return e.Error
+	}
+	return ""
+}
+
+// truncate shortens logged payloads for the detail view
+func truncate(s string) string {
+	if len(s) > maxDataLen {
+		return s[:maxDataLen] + "..."
+	}
+	return s
+}
+
+// RenderDetail renders the execution with its logged events
+func (r *ExpressExecutionRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*ExpressExecutionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title("Express Execution", e.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Name", e.GetName())
+	d.Field("ARN", e.GetID())
+	d.FieldStyled("Status", e.Status, render.StateColorer()(e.Status))
+	d.Field("State Machine", e.StateMachineName())
+
+	d.Section("Timing")
+	if e.Started.IsZero() {
+		d.Dim("Start not logged")
+	} else {
+		d.Field("Started", e.Started.Format(time.RFC3339))
+	}
+	if !e.Stopped.IsZero() {
+		d.Field("Stopped", e.Stopped.Format(time.RFC3339))
+	}
+	if dur := e.Duration(); dur > 0 {
+		d.Field("Duration", render.FormatDuration(dur))
+	}
+
+	d.Section("Logging")
+	d.Field("Log Group", e.LogGroup)
+	d.Field("Level", e.LogLevel)
+	if e.LogLevel != "ALL" {
+		d.Dim("Only level ALL logs every execution; at " + e.LogLevel + " successful runs are not listed")
+	}
+
+	if e.Input != "" {
+		d.Section("Input")
+		d.Line(truncate(e.Input))
+	}
+	if e.Output != "" {
+		d.Section("Output")
+		d.Line(truncate(e.Output))
+	}
+	if e.Error != "" || e.Cause != "" {
+		d.Section("Error")
+		if e.Error != "" {
+			d.Field("Error", e.Error)
+		}
+		if e.Cause != "" {
+			d.Field("Cause", truncate(e.Cause))
+		}
+	}
+
+	if len(e.History) > 0 {
+		d.Section("Events")
+		start := e.History[0].Timestamp
+		for _, ev := range e.History {
+			label := fmt.Sprintf("+%s", render.FormatDuration(ev.Timestamp.Sub(start)))
+			value := ev.Type
+			if ev.Name != "" {
+				value += "  " + ev.Name
+			}
+			if ev.Error != "" {
+				d.FieldStyled(label, value+"  "+ev.Error, ui.DangerStyle())
+				continue
+			}
+			d.Field(label, value)
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ExpressExecutionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*ExpressExecutionResource)
+	if !ok {
+		return nil
+	}
+	fields := []render.SummaryField{
+		{Label: "Name", Value: e.GetName()},
+		{Label: "Status", Value: e.Status, Style: render.StateColorer()(e.Status)},
+		{Label: "State Machine", Value: e.StateMachineName()},
+	}
+	if dur := e.Duration(); dur > 0 {
+		fields = append(fields, render.SummaryField{Label: "Duration", Value: render.FormatDuration(dur)})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *ExpressExecutionRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	e, ok := resource.(*ExpressExecutionResource)
+	if !ok {
+		return nil
+	}
+	navs := []render.Navigation{{
+		Key: "s", Label: "State Machine", Service: "stepfunctions", Resource: "state-machines",
+		FilterField: "StateMachineArn", FilterValue: e.StateMachineARN,
+	}}
+	if e.LogGroup != "" {
+		navs = append(navs, render.Navigation{
+			Key: "l", Label: "Logs", Service: "cloudwatch", Resource: "log-streams",
+			FilterField: "LogGroupName", FilterValue: e.LogGroup,
+		})
+	}
+	return navs
+}
+
+// NeedsAutoReload keeps the list refreshing while an execution runs
+func (r *ExpressExecutionRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if e, ok := dao.UnwrapResource(res).(*ExpressExecutionResource); ok && e.Status == StatusRunning {
+			return true
+		}
+	}
+	return false
+}
@@ -20,13 +20,13 @@ type StateMachineDAO struct {
 
 // NewStateMachineDAO creates a new StateMachineDAO
 func NewStateMachineDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, sfn.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &StateMachineDAO{
 		BaseDAO: dao.NewBaseDAO("stepfunctions", "state-machines"),
-		client:  sfn.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
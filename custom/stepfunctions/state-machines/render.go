@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
@@ -186,11 +188,19 @@ func (r *StateMachineRenderer) Navigations(resource dao.Resource) []render.Navig
 
 	var navs []render.Navigation
 
-	// Executions navigation
-	navs = append(navs, render.Navigation{
-		Key: "e", Label: "Executions", Service: "stepfunctions", Resource: "executions",
-		FilterField: "StateMachineName", FilterValue: sr.GetName(),
-	})
+	// Executions navigation; express workflows have no execution history API,
+	// so theirs are read from the logs
+	if sr.Type() == string(types.StateMachineTypeExpress) {
+		navs = append(navs, render.Navigation{
+			Key: "e", Label: "Executions", Service: "stepfunctions", Resource: "express-executions",
+			FilterField: "StateMachineArn", FilterValue: sr.ARN(),
+		})
+	} else {
+		navs = append(navs, render.Navigation{
+			Key: "e", Label: "Executions", Service: "stepfunctions", Resource: "executions",
+			FilterField: "StateMachineName", FilterValue: sr.GetName(),
+		})
+	}
 
 	// IAM Role navigation
 	if sr.RoleName() != "" {
@@ -19,13 +19,13 @@ type ServerDAO struct {
 
 // NewServerDAO creates a new ServerDAO.
 func NewServerDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, transfer.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &ServerDAO{
 		BaseDAO: dao.NewBaseDAO("transfer", "servers"),
-		client:  transfer.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type UserDAO struct {
 
 // NewUserDAO creates a new UserDAO.
 func NewUserDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, transfer.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &UserDAO{
 		BaseDAO: dao.NewBaseDAO("transfer", "users"),
-		client:  transfer.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
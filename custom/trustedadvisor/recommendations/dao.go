@@ -21,13 +21,13 @@ type RecommendationDAO struct {
 
 // NewRecommendationDAO creates a new RecommendationDAO.
 func NewRecommendationDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, trustedadvisor.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RecommendationDAO{
 		BaseDAO: dao.NewBaseDAO("trustedadvisor", "recommendations"),
-		client:  trustedadvisor.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
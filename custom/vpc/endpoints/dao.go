@@ -21,13 +21,13 @@ type VpcEndpointDAO struct {
 
 // NewVpcEndpointDAO creates a new VpcEndpointDAO.
 func NewVpcEndpointDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &VpcEndpointDAO{
 		BaseDAO: dao.NewBaseDAO("vpc", "endpoints"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -0,0 +1,58 @@
+package flowlogs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("vpc", "flow-logs", []action.Action{
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteFlowLogs",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("vpc", "flow-logs", executeFlowLogAction)
+}
+
+func executeFlowLogAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "DeleteFlowLogs":
+		return executeDeleteFlowLog(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeDeleteFlowLog(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	flowLogID := resource.GetID()
+	output, err := client.DeleteFlowLogs(ctx, &ec2.DeleteFlowLogsInput{
+		FlowLogIds: []string{flowLogID},
+	})
+	if err != nil {
+		return action.FailResultf(err, "delete flow log %s", flowLogID)
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error != nil {
+			return action.FailResult(fmt.Errorf("delete flow log %s: %s", flowLogID, appaws.Str(item.Error.Message)))
+		}
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deleted flow log %s", flowLogID))
+}
@@ -0,0 +1,171 @@
+package flowlogs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FlowLogDAO provides data access for VPC flow logs
+type FlowLogDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewFlowLogDAO creates a new FlowLogDAO
+func NewFlowLogDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
+	}
+	return &FlowLogDAO{
+		BaseDAO: dao.NewBaseDAO("vpc", "flow-logs"),
+		client:  client,
+	}, nil
+}
+
+// List returns flow logs, narrowed by any navigation filter in context.
+// Supported filters:
+//   - ResourceId: flow logs for a VPC, subnet or network interface
+func (d *FlowLogDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &ec2.DescribeFlowLogsInput{}
+	if resourceID := dao.GetFilterFromContext(ctx, "ResourceId"); resourceID != "" {
+		input.Filter = append(input.Filter, types.Filter{
+			Name:   appaws.StringPtr("resource-id"),
+			Values: []string{resourceID},
+		})
+	}
+
+	flowLogs, err := appaws.Paginate(ctx, func(token *string) ([]types.FlowLog, *string, error) {
+		input.NextToken = token
+		output, err := d.client.DescribeFlowLogs(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "describe flow logs")
+		}
+		return output.FlowLogs, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, len(flowLogs))
+	for i, fl := range flowLogs {
+		resources[i] = NewFlowLogResource(fl)
+	}
+	return resources, nil
+}
+
+// Get returns a flow log by ID
+func (d *FlowLogDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeFlowLogs(ctx, &ec2.DescribeFlowLogsInput{
+		FlowLogIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe flow log %s", id)
+	}
+	if len(output.FlowLogs) == 0 {
+		return nil, fmt.Errorf("flow log not found: %s", id)
+	}
+	return NewFlowLogResource(output.FlowLogs[0]), nil
+}
+
+// Delete deletes a flow log. Records already delivered are kept.
+func (d *FlowLogDAO) Delete(ctx context.Context, id string) error {
+	output, err := d.client.DeleteFlowLogs(ctx, &ec2.DeleteFlowLogsInput{
+		FlowLogIds: []string{id},
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return apperrors.Wrapf(err, "delete flow log %s", id)
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error != nil {
+			return fmt.Errorf("delete flow log %s: %s", id, appaws.Str(item.Error.Message))
+		}
+	}
+	return nil
+}
+
+// FlowLogResource wraps a VPC flow log
+type FlowLogResource struct {
+	dao.BaseResource
+	Item types.FlowLog
+}
+
+// NewFlowLogResource creates a new FlowLogResource
+func NewFlowLogResource(fl types.FlowLog) *FlowLogResource {
+	return &FlowLogResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(fl.FlowLogId),
+			Name: appaws.EC2NameTag(fl.Tags),
+			Tags: appaws.TagsToMap(fl.Tags),
+			Data: fl,
+		},
+		Item: fl,
+	}
+}
+
+// ResourceID returns the VPC, subnet or network interface the flow log captures
+func (r *FlowLogResource) ResourceID() string {
+	return appaws.Str(r.Item.ResourceId)
+}
+
+// TrafficType returns ACCEPT, REJECT or ALL
+func (r *FlowLogResource) TrafficType() string {
+	return string(r.Item.TrafficType)
+}
+
+// DestinationType returns cloud-watch-logs, s3 or kinesis-data-firehose
+func (r *FlowLogResource) DestinationType() string {
+	return string(r.Item.LogDestinationType)
+}
+
+// Destination returns the log group name for CloudWatch Logs destinations
+// and the destination ARN otherwise.
+func (r *FlowLogResource) Destination() string {
+	if name := r.LogGroupName(); name != "" {
+		return name
+	}
+	return appaws.Str(r.Item.LogDestination)
+}
+
+// Status returns the flow log status, or the delivery error when delivery fails
+func (r *FlowLogResource) Status() string {
+	if status := appaws.Str(r.Item.DeliverLogsStatus); status == "FAILED" {
+		return status
+	}
+	return appaws.Str(r.Item.FlowLogStatus)
+}
+
+// LogGroupName returns the CloudWatch log group records are delivered to.
+// It is empty for other destination types, so the record viewer is only
+// offered when records can be read back from CloudWatch Logs.
+func (r *FlowLogResource) LogGroupName() string {
+	if r.Item.LogDestinationType != types.LogDestinationTypeCloudWatchLogs {
+		return ""
+	}
+	return appaws.Str(r.Item.LogGroupName)
+}
+
+// LogStreamPrefix narrows records to a single network interface, whose
+// records are written to streams named after it.
+func (r *FlowLogResource) LogStreamPrefix() string {
+	if id := r.ResourceID(); strings.HasPrefix(id, "eni-") {
+		return id
+	}
+	return ""
+}
+
+// LogFormat returns the record format, or "" for the default format
+func (r *FlowLogResource) LogFormat() string {
+	return appaws.Str(r.Item.LogFormat)
+}
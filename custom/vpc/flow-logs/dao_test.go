@@ -0,0 +1,71 @@
+package flowlogs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/clawscli/claws/internal/render"
+)
+
+func TestFlowLogResource(t *testing.T) {
+	cw := NewFlowLogResource(types.FlowLog{
+		FlowLogId:          aws.String("fl-1"),
+		ResourceId:         aws.String("eni-0a1b2c3d"),
+		LogDestinationType: types.LogDestinationTypeCloudWatchLogs,
+		LogGroupName:       aws.String("/vpc/flow-logs/eni-0a1b2c3d"),
+		FlowLogStatus:      aws.String("ACTIVE"),
+		DeliverLogsStatus:  aws.String("SUCCESS"),
+	})
+	if cw.LogGroupName() != "/vpc/flow-logs/eni-0a1b2c3d" || cw.Destination() != cw.LogGroupName() {
+		t.Errorf("LogGroupName() = %q, Destination() = %q", cw.LogGroupName(), cw.Destination())
+	}
+	if cw.LogStreamPrefix() != "eni-0a1b2c3d" {
+		t.Errorf("LogStreamPrefix() = %q, want ENI ID", cw.LogStreamPrefix())
+	}
+	if cw.Status() != "ACTIVE" {
+		t.Errorf("Status() = %q, want ACTIVE", cw.Status())
+	}
+
+	s3 := NewFlowLogResource(types.FlowLog{
+		FlowLogId:          aws.String("fl-2"),
+		ResourceId:         aws.String("vpc-1"),
+		LogDestinationType: types.LogDestinationTypeS3,
+		LogDestination:     aws.String("arn:aws:s3:::flow-log-bucket"),
+		FlowLogStatus:      aws.String("ACTIVE"),
+		DeliverLogsStatus:  aws.String("FAILED"),
+	})
+	if s3.LogGroupName() != "" || s3.Destination() != "arn:aws:s3:::flow-log-bucket" {
+		t.Errorf("LogGroupName() = %q, Destination() = %q", s3.LogGroupName(), s3.Destination())
+	}
+	if s3.LogStreamPrefix() != "" {
+		t.Errorf("LogStreamPrefix() = %q, want empty for a VPC", s3.LogStreamPrefix())
+	}
+	if s3.Status() != "FAILED" {
+		t.Errorf("Status() = %q, want FAILED delivery to surface", s3.Status())
+	}
+}
+
+func TestFlowLogNavigations(t *testing.T) {
+	r := NewFlowLogRenderer().(*FlowLogRenderer)
+
+	cw := NewFlowLogResource(types.FlowLog{
+		ResourceId:         aws.String("subnet-1"),
+		LogDestinationType: types.LogDestinationTypeCloudWatchLogs,
+		LogGroupName:       aws.String("/vpc/flow-logs/subnet-1"),
+	})
+	navs := r.Navigations(cw)
+	if len(navs) != 2 || navs[0].ViewType != render.ViewTypeFlowLogView || navs[1].Resource != "subnets" {
+		t.Errorf("Navigations(cloudwatch subnet) = %+v", navs)
+	}
+
+	s3 := NewFlowLogResource(types.FlowLog{
+		ResourceId:         aws.String("vpc-1"),
+		LogDestinationType: types.LogDestinationTypeS3,
+	})
+	navs = r.Navigations(s3)
+	if len(navs) != 1 || navs[0].Resource != "vpcs" {
+		t.Errorf("Navigations(s3 vpc) = %+v, want only the VPC", navs)
+	}
+}
@@ -0,0 +1,20 @@
+package flowlogs
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("vpc", "flow-logs", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewFlowLogDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewFlowLogRenderer()
+		},
+	})
+}
@@ -0,0 +1,198 @@
+package flowlogs
+
+import (
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// FlowLogRenderer renders VPC flow logs
+type FlowLogRenderer struct {
+	render.BaseRenderer
+}
+
+// NewFlowLogRenderer creates a new FlowLogRenderer
+func NewFlowLogRenderer() render.Renderer {
+	return &FlowLogRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "vpc",
+			Resource: "flow-logs",
+			Cols: []render.Column{
+				{
+					Name:  "FLOW LOG ID",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "RESOURCE",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*FlowLogResource); ok {
+							return v.ResourceID()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "TRAFFIC",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*FlowLogResource); ok {
+							return v.TrafficType()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "STATUS",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*FlowLogResource); ok {
+							return v.Status()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "DEST TYPE",
+					Width: 16,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*FlowLogResource); ok {
+							return v.DestinationType()
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "DESTINATION",
+					Width: 40,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*FlowLogResource); ok {
+							return v.Destination()
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+				{
+					Name:  "AGE",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if v, ok := r.(*FlowLogResource); ok && v.Item.CreationTime != nil {
+							return render.FormatAge(*v.Item.CreationTime)
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+				render.TagsColumn(25, 7),
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed flow log information
+func (r *FlowLogRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*FlowLogResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("VPC Flow Log", v.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Flow Log ID", v.GetID())
+	d.Field("Resource", v.ResourceID())
+	d.Field("Traffic Type", v.TrafficType())
+	d.FieldStyled("Status", v.Status(), render.StateColorer()(v.Status()))
+	d.FieldIf("Delivery Error", v.Item.DeliverLogsErrorMessage)
+	if v.Item.MaxAggregationInterval != nil {
+		d.Field("Aggregation Interval", render.FormatDuration(time.Duration(*v.Item.MaxAggregationInterval)*time.Second))
+	}
+
+	d.Section("Destination")
+	d.Field("Type", v.DestinationType())
+	d.Field("Destination", v.Destination())
+	d.FieldIf("Delivery Role", v.Item.DeliverLogsPermissionArn)
+	if v.Item.DestinationOptions != nil && v.Item.DestinationOptions.FileFormat != "" {
+		d.Field("File Format", string(v.Item.DestinationOptions.FileFormat))
+	}
+
+	d.Section("Record Format")
+	if format := v.LogFormat(); format != "" {
+		d.DimIndent(strings.TrimSpace(format))
+	} else {
+		d.DimIndent("(default)")
+	}
+
+	if v.Item.CreationTime != nil {
+		d.Section("Timestamps")
+		d.Field("Created", v.Item.CreationTime.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Tags(v.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *FlowLogRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	v, ok := resource.(*FlowLogResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.SummaryField{
+		{Label: "Flow Log ID", Value: v.GetID()},
+		{Label: "Resource", Value: v.ResourceID()},
+		{Label: "Status", Value: v.Status(), Style: render.StateColorer()(v.Status())},
+		{Label: "Traffic", Value: v.TrafficType()},
+		{Label: "Destination", Value: v.Destination()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *FlowLogRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	v, ok := resource.(*FlowLogResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if v.LogGroupName() != "" {
+		navs = append(navs, render.Navigation{
+			Key: "r", Label: "Records", ViewType: render.ViewTypeFlowLogView,
+		})
+	}
+
+	id := v.ResourceID()
+	switch {
+	case strings.HasPrefix(id, "vpc-"):
+		navs = append(navs, render.Navigation{
+			Key: "v", Label: "VPC", Service: "vpc", Resource: "vpcs",
+			FilterField: "VpcId", FilterValue: id,
+		})
+	case strings.HasPrefix(id, "subnet-"):
+		navs = append(navs, render.Navigation{
+			Key: "s", Label: "Subnet", Service: "vpc", Resource: "subnets",
+			FilterField: "SubnetId", FilterValue: id,
+		})
+	case strings.HasPrefix(id, "eni-"):
+		navs = append(navs, render.Navigation{
+			Key: "e", Label: "ENI", Service: "ec2", Resource: "network-interfaces",
+			FilterField: "NetworkInterfaceIds", FilterValue: id,
+		})
+	}
+	return navs
+}
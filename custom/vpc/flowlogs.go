@@ -0,0 +1,180 @@
+package vpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	appec2 "github.com/clawscli/claws/custom/ec2"
+	appiam "github.com/clawscli/claws/custom/iam"
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// EnableFlowLogsOperation is the operation of the action returned by
+// EnableFlowLogsAction; executors dispatch it to EnableFlowLogs.
+const EnableFlowLogsOperation = "EnableFlowLogs"
+
+// flowLogsPrincipal is the service principal that delivers flow logs to
+// CloudWatch Logs using a role from the account.
+const flowLogsPrincipal = "vpc-flow-logs.amazonaws.com"
+
+// EnableFlowLogsAction returns the action that creates a flow log for a VPC,
+// subnet or network interface, with a picker for the destination.
+func EnableFlowLogsAction() action.Action {
+	return action.Action{
+		Name:      "Enable Flow Logs",
+		Shortcut:  "F",
+		Type:      action.ActionTypeAPI,
+		Operation: EnableFlowLogsOperation,
+		Confirm:   action.ConfirmSimple,
+		Input: &action.InputSpec{
+			Label:   "Flow log destination",
+			Choices: flowLogDestinations,
+		},
+	}
+}
+
+// flowLogDestinations offers each S3 bucket in the current region and, for
+// CloudWatch Logs, each IAM role that flow logs can assume. Either lookup
+// may fail on its own (e.g. no iam:ListRoles) without hiding the other.
+func flowLogDestinations(ctx context.Context, _ dao.Resource) ([]action.Choice, error) {
+	roles, rolesErr := flowLogRoles(ctx)
+	if rolesErr != nil {
+		log.Warn("failed to list flow log delivery roles", "error", rolesErr)
+	}
+	buckets, bucketsErr := apps3.RegionBuckets(ctx)
+	if bucketsErr != nil {
+		log.Warn("failed to list S3 buckets for flow logs", "error", bucketsErr)
+	}
+	if rolesErr != nil && bucketsErr != nil {
+		return nil, errors.Join(rolesErr, bucketsErr)
+	}
+
+	var choices []action.Choice
+	for _, role := range roles {
+		choices = append(choices, action.Choice{
+			Value: string(types.LogDestinationTypeCloudWatchLogs) + ":" + appaws.Str(role.Arn),
+			Label: "CloudWatch Logs via role " + appaws.Str(role.RoleName),
+		})
+	}
+	partition := appaws.PartitionForRegion(appaws.CurrentRegion(ctx))
+	for _, bucket := range buckets {
+		choices = append(choices, action.Choice{
+			Value: string(types.LogDestinationTypeS3) + ":arn:" + partition + ":s3:::" + appaws.Str(bucket.Name),
+			Label: "S3 bucket " + appaws.Str(bucket.Name),
+		})
+	}
+	if len(choices) == 0 {
+		return nil, fmt.Errorf("no destinations: create an S3 bucket in this region or an IAM role trusted by %s", flowLogsPrincipal)
+	}
+	return choices, nil
+}
+
+func flowLogRoles(ctx context.Context) ([]iamtypes.Role, error) {
+	client, err := appiam.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := appaws.PaginateMarker(ctx, func(marker *string) ([]iamtypes.Role, *string, error) {
+		output, err := client.ListRoles(ctx, &iam.ListRolesInput{Marker: marker})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list roles")
+		}
+		return output.Roles, output.Marker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var trusted []iamtypes.Role
+	for _, role := range roles {
+		if trustsFlowLogs(appaws.Str(role.AssumeRolePolicyDocument)) {
+			trusted = append(trusted, role)
+		}
+	}
+	return trusted, nil
+}
+
+// trustsFlowLogs reports whether a URL-encoded trust policy names the flow
+// logs service principal.
+func trustsFlowLogs(policy string) bool {
+	if decoded, err := url.QueryUnescape(policy); err == nil {
+		policy = decoded
+	}
+	return strings.Contains(policy, flowLogsPrincipal)
+}
+
+// parseFlowLogDestination splits a picker value such as
+// "s3:arn:aws:s3:::bucket" into the destination type and target ARN.
+func parseFlowLogDestination(value string) (types.LogDestinationType, string, error) {
+	kind, target, ok := strings.Cut(strings.TrimSpace(value), ":")
+	if !ok || target == "" {
+		return "", "", fmt.Errorf("invalid flow log destination %q", value)
+	}
+	switch destType := types.LogDestinationType(kind); destType {
+	case types.LogDestinationTypeCloudWatchLogs, types.LogDestinationTypeS3:
+		return destType, target, nil
+	default:
+		return "", "", fmt.Errorf("unsupported flow log destination type %q", kind)
+	}
+}
+
+// FlowLogGroupName is the CloudWatch log group flow logs for a resource are
+// delivered to. The group is created on first delivery.
+func FlowLogGroupName(resourceID string) string {
+	return "/vpc/flow-logs/" + resourceID
+}
+
+// EnableFlowLogs creates a flow log capturing all traffic for resource,
+// delivered to the destination picked in EnableFlowLogsAction.
+func EnableFlowLogs(ctx context.Context, resource dao.Resource, resourceType types.FlowLogsResourceType) action.ActionResult {
+	destType, target, err := parseFlowLogDestination(action.InputFromContext(ctx))
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	client, err := appec2.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	resourceID := resource.GetID()
+	input := &ec2.CreateFlowLogsInput{
+		ResourceIds:        []string{resourceID},
+		ResourceType:       resourceType,
+		TrafficType:        types.TrafficTypeAll,
+		LogDestinationType: destType,
+	}
+	destination := target
+	if destType == types.LogDestinationTypeCloudWatchLogs {
+		destination = FlowLogGroupName(resourceID)
+		input.LogGroupName = &destination
+		input.DeliverLogsPermissionArn = &target
+	} else {
+		input.LogDestination = &target
+	}
+
+	output, err := client.CreateFlowLogs(ctx, input)
+	if err != nil {
+		return action.FailResultf(err, "create flow log for %s", resourceID)
+	}
+	for _, item := range output.Unsuccessful {
+		if item.Error != nil {
+			return action.FailResult(fmt.Errorf("create flow log for %s: %s", resourceID, appaws.Str(item.Error.Message)))
+		}
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Created flow log %s for %s to %s", strings.Join(output.FlowLogIds, ", "), resourceID, destination))
+}
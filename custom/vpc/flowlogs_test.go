@@ -0,0 +1,43 @@
+package vpc
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestParseFlowLogDestination(t *testing.T) {
+	tests := []struct {
+		value      string
+		wantType   types.LogDestinationType
+		wantTarget string
+		wantErr    bool
+	}{
+		{value: "s3:arn:aws:s3:::flow-log-bucket", wantType: types.LogDestinationTypeS3, wantTarget: "arn:aws:s3:::flow-log-bucket"},
+		{value: "cloud-watch-logs:arn:aws:iam::123456789012:role/flow-logs", wantType: types.LogDestinationTypeCloudWatchLogs, wantTarget: "arn:aws:iam::123456789012:role/flow-logs"},
+		{value: "kinesis-data-firehose:arn:aws:firehose:us-east-1:123456789012:deliverystream/x", wantErr: true},
+		{value: "s3:", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			gotType, gotTarget, err := parseFlowLogDestination(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotType != tt.wantType || gotTarget != tt.wantTarget {
+				t.Errorf("got (%q, %q), want (%q, %q)", gotType, gotTarget, tt.wantType, tt.wantTarget)
+			}
+		})
+	}
+}
+
+func TestTrustsFlowLogs(t *testing.T) {
+	encoded := "%7B%22Statement%22%3A%5B%7B%22Principal%22%3A%7B%22Service%22%3A%22vpc-flow-logs.amazonaws.com%22%7D%7D%5D%7D"
+	if !trustsFlowLogs(encoded) {
+		t.Error("trustsFlowLogs(encoded flow logs policy) = false")
+	}
+	if trustsFlowLogs(`{"Statement":[{"Principal":{"Service":"ec2.amazonaws.com"}}]}`) {
+		t.Error("trustsFlowLogs(ec2 policy) = true")
+	}
+}
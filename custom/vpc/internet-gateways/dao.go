@@ -20,13 +20,13 @@ type InternetGatewayDAO struct {
 
 // NewInternetGatewayDAO creates a new InternetGatewayDAO
 func NewInternetGatewayDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &InternetGatewayDAO{
 		BaseDAO: dao.NewBaseDAO("vpc", "internet-gateways"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -20,13 +20,13 @@ type NatGatewayDAO struct {
 
 // NewNatGatewayDAO creates a new NatGatewayDAO
 func NewNatGatewayDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &NatGatewayDAO{
 		BaseDAO: dao.NewBaseDAO("vpc", "nat-gateways"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -143,3 +143,14 @@ func (r *NatGatewayResource) PrivateIp() string {
 	}
 	return ""
 }
+
+// NetworkInterfaceIds returns the IDs of the ENIs backing this NAT gateway
+func (r *NatGatewayResource) NetworkInterfaceIds() []string {
+	var ids []string
+	for _, addr := range r.Item.NatGatewayAddresses {
+		if addr.NetworkInterfaceId != nil {
+			ids = append(ids, *addr.NetworkInterfaceId)
+		}
+	}
+	return ids
+}
@@ -1,6 +1,7 @@
 package natgateways
 
 import (
+	"strings"
 	"time"
 
 	appaws "github.com/clawscli/claws/internal/aws"
@@ -198,6 +199,9 @@ func (r *NatGatewayRenderer) Navigations(resource dao.Resource) []render.Navigat
 	if subnetId != "" {
 		navs = append(navs, render.Navigation{Key: "u", Label: "Subnet", Service: "vpc", Resource: "subnets", FilterField: "SubnetId", FilterValue: subnetId})
 	}
+	if eniIds := ngwr.NetworkInterfaceIds(); len(eniIds) > 0 {
+		navs = append(navs, render.Navigation{Key: "e", Label: "ENIs", Service: "ec2", Resource: "network-interfaces", FilterField: "NetworkInterfaceIds", FilterValue: strings.Join(eniIds, ",")})
+	}
 
 	return navs
 }
@@ -20,13 +20,13 @@ type RouteTableDAO struct {
 
 // NewRouteTableDAO creates a new RouteTableDAO
 func NewRouteTableDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &RouteTableDAO{
 		BaseDAO: dao.NewBaseDAO("vpc", "route-tables"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
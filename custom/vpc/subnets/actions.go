@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	appec2 "github.com/clawscli/claws/custom/ec2"
+	appvpc "github.com/clawscli/claws/custom/vpc"
 	"github.com/clawscli/claws/internal/action"
 	"github.com/clawscli/claws/internal/dao"
 )
@@ -20,6 +22,7 @@ func init() {
 			Operation: "DeleteSubnet",
 			Confirm:   action.ConfirmDangerous,
 		},
+		appvpc.EnableFlowLogsAction(),
 	})
 
 	action.RegisterExecutor("vpc", "subnets", executeSubnetAction)
@@ -29,6 +32,8 @@ func executeSubnetAction(ctx context.Context, act action.Action, resource dao.Re
 	switch act.Operation {
 	case "DeleteSubnet":
 		return executeDeleteSubnet(ctx, resource)
+	case appvpc.EnableFlowLogsOperation:
+		return appvpc.EnableFlowLogs(ctx, resource, types.FlowLogsResourceTypeSubnet)
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
+	appec2 "github.com/clawscli/claws/custom/ec2"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
@@ -20,18 +21,20 @@ type SubnetDAO struct {
 
 // NewSubnetDAO creates a new SubnetDAO
 func NewSubnetDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &SubnetDAO{
 		BaseDAO: dao.NewBaseDAO("vpc", "subnets"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
 func (d *SubnetDAO) List(ctx context.Context) ([]dao.Resource, error) {
-	output, err := d.client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{})
+	output, err := d.client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		Filters: appec2.ListFilters(ctx, "state"),
+	})
 	if err != nil {
 		return nil, apperrors.Wrap(err, "describe subnets")
 	}
@@ -204,5 +204,6 @@ func (r *SubnetRenderer) Navigations(resource dao.Resource) []render.Navigation
 	return []render.Navigation{
 		{Key: "v", Label: "VPC", Service: "vpc", Resource: "vpcs", FilterField: "VpcId", FilterValue: vpcId},
 		{Key: "e", Label: "Instances", Service: "ec2", Resource: "instances", FilterField: "SubnetId", FilterValue: subnetId},
+		{Key: "F", Label: "Flow Logs", Service: "vpc", Resource: "flow-logs", FilterField: "ResourceId", FilterValue: subnetId},
 	}
 }
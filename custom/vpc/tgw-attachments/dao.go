@@ -21,13 +21,13 @@ type TGWAttachmentDAO struct {
 
 // NewTGWAttachmentDAO creates a new TGWAttachmentDAO.
 func NewTGWAttachmentDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TGWAttachmentDAO{
 		BaseDAO: dao.NewBaseDAO("vpc", "tgw-attachments"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -21,13 +21,13 @@ type TransitGatewayDAO struct {
 
 // NewTransitGatewayDAO creates a new TransitGatewayDAO.
 func NewTransitGatewayDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, ec2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &TransitGatewayDAO{
 		BaseDAO: dao.NewBaseDAO("vpc", "transit-gateways"),
-		client:  ec2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
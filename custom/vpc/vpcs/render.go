@@ -209,5 +209,7 @@ func (r *VPCRenderer) Navigations(resource dao.Resource) []render.Navigation {
 		{Key: "n", Label: "NAT GWs", Service: "vpc", Resource: "nat-gateways", FilterField: "VpcId", FilterValue: vpcId},
 		{Key: "g", Label: "Security Groups", Service: "ec2", Resource: "security-groups", FilterField: "VpcId", FilterValue: vpcId},
 		{Key: "e", Label: "Instances", Service: "ec2", Resource: "instances", FilterField: "VpcId", FilterValue: vpcId},
+		{Key: "E", Label: "ENIs", Service: "ec2", Resource: "network-interfaces", FilterField: "VpcId", FilterValue: vpcId},
+		{Key: "F", Label: "Flow Logs", Service: "vpc", Resource: "flow-logs", FilterField: "ResourceId", FilterValue: vpcId},
 	}
 }
@@ -20,13 +20,13 @@ type WebACLDAO struct {
 
 // NewWebACLDAO creates a new WebACLDAO
 func NewWebACLDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, wafv2.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &WebACLDAO{
 		BaseDAO: dao.NewBaseDAO("wafv2", "web-acls"),
-		client:  wafv2.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
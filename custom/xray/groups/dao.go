@@ -19,13 +19,13 @@ type GroupDAO struct {
 
 // NewGroupDAO creates a new GroupDAO.
 func NewGroupDAO(ctx context.Context) (dao.DAO, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, xray.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "new "+ServiceResourcePath+" dao")
 	}
 	return &GroupDAO{
 		BaseDAO: dao.NewBaseDAO("xray", "groups"),
-		client:  xray.NewFromConfig(cfg),
+		client:  client,
 	}, nil
 }
 
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 	"sync"
 
@@ -85,6 +87,108 @@ type Action struct {
 	// If nil, defaults to resource.GetID().
 	// Use when the action operates on a different identifier (e.g., Name vs ARN).
 	ConfirmToken func(resource dao.Resource) string
+
+	// Input prompts the user for a value before confirmation. API executors
+	// read the value with InputFromContext; exec commands get it as ${INPUT}.
+	Input *InputSpec
+}
+
+// InputSpec describes a value collected before an action runs.
+type InputSpec struct {
+	Label       string // Prompt shown above the input field
+	Placeholder string
+	Optional    bool // Allow submitting an empty value
+
+	// Choices, when set, loads the values the user picks from instead of
+	// typing one. Typed text filters the list.
+	Choices func(ctx context.Context, resource dao.Resource) ([]Choice, error)
+
+	// Then, when set, prompts for another value once this one is submitted.
+	// API executors read every value with InputsFromContext.
+	Then *InputSpec
+
+	// Next, when set instead of Then, loads the next prompt from the values
+	// submitted so far, e.g. one prompt per parameter of a picked document.
+	// A nil spec ends the chain. A spec with only Next set asks nothing
+	// itself and starts with the prompt Next loads from no values.
+	Next func(ctx context.Context, resource dao.Resource, values []string) (*InputSpec, error)
+}
+
+// Deferred reports whether s only loads the prompts that follow it.
+func (s *InputSpec) Deferred() bool {
+	return s.Label == "" && s.Choices == nil && s.Then == nil && s.Next != nil
+}
+
+// Specs returns the static prompt chain starting at s: s, s.Then,
+// s.Then.Then, ... Prompts loaded by Next are not included.
+func (s *InputSpec) Specs() []*InputSpec {
+	var specs []*InputSpec
+	for spec := s; spec != nil; spec = spec.Then {
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// Choice is one value offered by InputSpec.Choices.
+type Choice struct {
+	Value string // Passed to the executor
+	Label string // Shown in the picker
+}
+
+type inputKey struct{}
+
+// WithInput returns a context carrying the user's input for an action.
+func WithInput(ctx context.Context, value string) context.Context {
+	return WithInputs(ctx, []string{value})
+}
+
+// WithInputs returns a context carrying the user's answers to an action's
+// chained prompts, in prompt order.
+func WithInputs(ctx context.Context, values []string) context.Context {
+	return context.WithValue(ctx, inputKey{}, values)
+}
+
+// InputFromContext returns the user's input for an action, or "" if none.
+// For chained prompts it is the answer to the first one.
+func InputFromContext(ctx context.Context) string {
+	if values := InputsFromContext(ctx); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// InputsFromContext returns the user's answers to an action's chained
+// prompts, in prompt order, or nil if none.
+func InputsFromContext(ctx context.Context) []string {
+	values, _ := ctx.Value(inputKey{}).([]string)
+	return values
+}
+
+type runCacheKey struct{}
+
+// WithRunCache returns a context whose Cached lookups are shared for one run
+// of an action: its prompts, their Next and Choices loaders, and its executor.
+func WithRunCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, runCacheKey{}, &sync.Map{})
+}
+
+// Cached returns the value load produced for key earlier in the same action
+// run, calling load on first use. Errors are not cached. Without a run cache
+// in ctx, load is called every time.
+func Cached[T any](ctx context.Context, key string, load func() (T, error)) (T, error) {
+	cache, ok := ctx.Value(runCacheKey{}).(*sync.Map)
+	if !ok {
+		return load()
+	}
+	if v, ok := cache.Load(key); ok {
+		return v.(T), nil
+	}
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+	cache.Store(key, v)
+	return v, nil
 }
 
 // ActionResult represents the result of an action
@@ -94,6 +198,10 @@ type ActionResult struct {
 	Error       error
 	ErrorKind   apperrors.Kind // Classification of the error (Auth, Throttling, NotFound, etc.)
 	FollowUpMsg any            // Optional tea.Msg to send after action completes
+
+	// Progress, when set, streams the steps of an action still running in
+	// the background. See Track.
+	Progress <-chan ProgressUpdate
 }
 
 // FailResult creates a failed ActionResult with automatic error classification.
@@ -135,18 +243,25 @@ func SuccessResultWithFollowUp(message string, followUp any) ActionResult {
 // ExecutorFunc is a function that executes an action on a resource
 type ExecutorFunc func(ctx context.Context, action Action, resource dao.Resource) ActionResult
 
+// UniversalExecutorFunc executes an action offered for every resource type.
+// Unlike ExecutorFunc it is told which resource type the resource belongs to.
+type UniversalExecutorFunc func(ctx context.Context, action Action, resource dao.Resource, service, resourceType string) ActionResult
+
 // Registry holds actions for resources
 type Registry struct {
-	mu        sync.RWMutex
-	actions   map[string][]Action     // key: service/resource
-	executors map[string]ExecutorFunc // key: service/resource
+	mu                 sync.RWMutex
+	actions            map[string][]Action              // key: service/resource
+	executors          map[string]ExecutorFunc          // key: service/resource
+	universal          []Action                         // offered for every resource type
+	universalExecutors map[string]UniversalExecutorFunc // key: operation
 }
 
 // NewRegistry creates a new action registry
 func NewRegistry() *Registry {
 	return &Registry{
-		actions:   make(map[string][]Action),
-		executors: make(map[string]ExecutorFunc),
+		actions:            make(map[string][]Action),
+		executors:          make(map[string]ExecutorFunc),
+		universalExecutors: make(map[string]UniversalExecutorFunc),
 	}
 }
 
@@ -161,6 +276,9 @@ var ReadOnlyAllowlist = map[string]bool{
 	"DetectStackDrift": true,
 	// InvokeFunctionDryRun: Validation mode, function is not actually invoked
 	"InvokeFunctionDryRun": true,
+	// OpenInConsole, CopyConsoleURL: Build a console link; nothing is modified
+	OperationOpenInConsole:  true,
+	OperationCopyConsoleURL: true,
 }
 
 var ReadOnlyExecAllowlist = map[string]bool{
@@ -209,6 +327,25 @@ func ConfirmSuffix(token string) string {
 	return token[len(token)-MinConfirmChars:]
 }
 
+// ConfirmFull returns the whole token, for confirmations that may not be
+// shortened to a suffix. Empty tokens fall back to "CONFIRM" like ConfirmSuffix.
+func ConfirmFull(token string) string {
+	if token == "" {
+		return "CONFIRM"
+	}
+	return token
+}
+
+// ProductionConfirmPhrase is typed in the second prompt of API actions on
+// production profiles when the production confirm policy is "phrase".
+const ProductionConfirmPhrase = "yes I mean prod"
+
+// NeedsProductionConfirm reports whether act gets the stronger confirmation
+// on production profiles: API actions, except those safe in read-only mode.
+func NeedsProductionConfirm(act Action) bool {
+	return act.Type == ActionTypeAPI && !ReadOnlyAllowlist[act.Operation]
+}
+
 // ConfirmMatches checks if the user input matches the required confirmation.
 // Returns true if input equals the suffix returned by ConfirmSuffix.
 func ConfirmMatches(token, input string) bool {
@@ -231,6 +368,40 @@ func (r *Registry) Get(service, resource string) []Action {
 	return r.actions[key]
 }
 
+// RegisterUniversal registers API actions offered for every resource type,
+// run by executor regardless of the resource type's own executor.
+func (r *Registry) RegisterUniversal(actions []Action, executor UniversalExecutorFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.universal = append(r.universal, actions...)
+	for _, act := range actions {
+		r.universalExecutors[act.Operation] = executor
+	}
+}
+
+// ForResource returns the actions for a resource type followed by the
+// universal actions. A universal action is omitted when a type-specific
+// action already uses its shortcut.
+func (r *Registry) ForResource(service, resource string) []Action {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	own := r.actions[fmt.Sprintf("%s/%s", service, resource)]
+	actions := make([]Action, 0, len(own)+len(r.universal))
+	actions = append(actions, own...)
+	for _, act := range r.universal {
+		if !slices.ContainsFunc(own, func(a Action) bool { return a.Shortcut == act.Shortcut }) {
+			actions = append(actions, act)
+		}
+	}
+	return actions
+}
+
+func (r *Registry) getUniversalExecutor(operation string) UniversalExecutorFunc {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.universalExecutors[operation]
+}
+
 // RegisterExecutor registers an executor for a resource type
 func (r *Registry) RegisterExecutor(service, resource string, executor ExecutorFunc) {
 	r.mu.Lock()
@@ -247,6 +418,20 @@ func (r *Registry) GetExecutor(service, resource string) ExecutorFunc {
 	return r.executors[key]
 }
 
+// Restrict drops the actions and executors of every service not in
+// services, so actions of services left out by --services cannot run.
+// Universal actions are kept.
+func (r *Registry) Restrict(services []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	drop := func(key string) bool {
+		service, _, _ := strings.Cut(key, "/")
+		return !slices.Contains(services, service)
+	}
+	maps.DeleteFunc(r.actions, func(key string, _ []Action) bool { return drop(key) })
+	maps.DeleteFunc(r.executors, func(key string, _ ExecutorFunc) bool { return drop(key) })
+}
+
 // RegisterExecutor is a convenience function to register with the global registry
 func RegisterExecutor(service, resource string, executor ExecutorFunc) {
 	Global.RegisterExecutor(service, resource, executor)
@@ -280,7 +465,9 @@ func ExecuteWithDAO(ctx context.Context, action Action, resource dao.Resource, s
 	case ActionTypeExec:
 		result = executeExec(ctx, action, resource)
 	case ActionTypeAPI:
-		if executor := Global.GetExecutor(service, resourceType); executor != nil {
+		if executor := Global.getUniversalExecutor(action.Operation); executor != nil {
+			result = executor(ctx, action, resource, service, resourceType)
+		} else if executor := Global.GetExecutor(service, resourceType); executor != nil {
 			result = executor(ctx, action, resource)
 		} else {
 			result = ActionResult{Success: false, Error: fmt.Errorf("no executor registered for %s/%s", service, resourceType)}
@@ -299,7 +486,7 @@ func ExecuteWithDAO(ctx context.Context, action Action, resource dao.Resource, s
 }
 
 func executeExec(ctx context.Context, action Action, resource dao.Resource) ActionResult {
-	cmd, err := ExpandVariables(action.Command, resource)
+	cmd, err := ExpandVariablesWithInput(action.Command, resource, InputFromContext(ctx))
 	if err != nil {
 		return ActionResult{Success: false, Error: err}
 	}
@@ -362,12 +549,19 @@ var ErrUnsafeValue = errors.New("variable value contains unsafe characters")
 //
 // Returns an error if any value contains shell metacharacters.
 func ExpandVariables(cmd string, resource dao.Resource) (string, error) {
+	return ExpandVariablesWithInput(cmd, resource, "")
+}
+
+// ExpandVariablesWithInput is ExpandVariables with ${INPUT} set to the value
+// the user entered for the action's InputSpec.
+func ExpandVariablesWithInput(cmd string, resource dao.Resource, input string) (string, error) {
 	replacements := map[string]string{
 		"${ID}":          resource.GetID(),
 		"${NAME}":        resource.GetName(),
 		"${ARN}":         resource.GetARN(),
 		"${INSTANCE_ID}": resource.GetID(),
 		"${BUCKET}":      resource.GetID(),
+		"${INPUT}":       input,
 	}
 
 	// Optional variables from interface implementations
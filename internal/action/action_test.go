@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"os/exec"
+	"slices"
 	"strings"
 	"testing"
 
@@ -329,6 +330,86 @@ func TestRegistry_Executor(t *testing.T) {
 	}
 }
 
+func TestRegistry_Universal(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("ec2", "instances", []Action{
+		{Name: "Start", Shortcut: "S"},
+		{Name: "Own Open", Shortcut: "O"},
+	})
+
+	var gotService, gotType string
+	registry.RegisterUniversal([]Action{
+		{Name: "Open", Shortcut: "O", Type: ActionTypeAPI, Operation: "UniversalOpen"},
+		{Name: "Copy", Shortcut: "L", Type: ActionTypeAPI, Operation: "UniversalCopy"},
+	}, func(ctx context.Context, action Action, resource dao.Resource, service, resourceType string) ActionResult {
+		gotService, gotType = service, resourceType
+		return SuccessResult("ok")
+	})
+
+	// Universal actions follow the type's own; a taken shortcut hides one
+	var names []string
+	for _, act := range registry.ForResource("ec2", "instances") {
+		names = append(names, act.Name)
+	}
+	if want := []string{"Start", "Own Open", "Copy"}; !slices.Equal(names, want) {
+		t.Errorf("ForResource() = %v, want %v", names, want)
+	}
+
+	if got := registry.ForResource("s3", "buckets"); len(got) != 2 {
+		t.Errorf("ForResource() for type without actions = %d actions, want 2", len(got))
+	}
+	if got := registry.Get("s3", "buckets"); len(got) != 0 {
+		t.Errorf("Get() should not include universal actions, got %d", len(got))
+	}
+
+	executor := registry.getUniversalExecutor("UniversalCopy")
+	if executor == nil {
+		t.Fatal("getUniversalExecutor() returned nil")
+	}
+	executor(context.Background(), Action{}, &mockResource{id: "b"}, "s3", "buckets")
+	if gotService != "s3" || gotType != "buckets" {
+		t.Errorf("executor got %s/%s, want s3/buckets", gotService, gotType)
+	}
+}
+
+func TestRegistry_Restrict(t *testing.T) {
+	registry := NewRegistry()
+	executor := func(ctx context.Context, action Action, resource dao.Resource) ActionResult {
+		return SuccessResult("ok")
+	}
+	registry.Register("ec2", "instances", []Action{{Name: "Start", Shortcut: "S"}})
+	registry.RegisterExecutor("ec2", "instances", executor)
+	registry.Register("s3", "buckets", []Action{{Name: "Empty", Shortcut: "E"}})
+	registry.RegisterExecutor("s3", "buckets", executor)
+	registry.RegisterUniversal([]Action{{Name: "Open", Shortcut: "O", Operation: "UniversalOpen"}}, nil)
+
+	registry.Restrict([]string{"ec2"})
+
+	if len(registry.Get("ec2", "instances")) != 1 || registry.GetExecutor("ec2", "instances") == nil {
+		t.Error("Restrict() should keep actions of kept services")
+	}
+	if len(registry.Get("s3", "buckets")) != 0 || registry.GetExecutor("s3", "buckets") != nil {
+		t.Error("Restrict() should drop actions of other services")
+	}
+	if got := registry.ForResource("s3", "buckets"); len(got) != 1 || got[0].Name != "Open" {
+		t.Errorf("ForResource() after Restrict() = %v, want only universal actions", got)
+	}
+}
+
+func TestConsoleActionsRegistered(t *testing.T) {
+	actions := Global.ForResource("nonexistent", "resource")
+	var ops []string
+	for _, act := range actions {
+		ops = append(ops, act.Operation)
+		if !IsAllowedInReadOnly(act) {
+			t.Errorf("%s should be allowed in read-only mode", act.Name)
+		}
+	}
+	if !slices.Contains(ops, OperationOpenInConsole) || !slices.Contains(ops, OperationCopyConsoleURL) {
+		t.Errorf("console actions missing from universal actions: %v", ops)
+	}
+}
+
 func TestActionResult(t *testing.T) {
 	// Success result
 	success := ActionResult{Success: true, Message: "done"}
@@ -1051,6 +1132,34 @@ func TestConfirmSuffix(t *testing.T) {
 	}
 }
 
+func TestConfirmFull(t *testing.T) {
+	if got := ConfirmFull("i-1234567890abcdef0"); got != "i-1234567890abcdef0" {
+		t.Errorf("ConfirmFull() = %q, want the whole token", got)
+	}
+	if got := ConfirmFull(""); got != "CONFIRM" {
+		t.Errorf("ConfirmFull(\"\") = %q, want %q", got, "CONFIRM")
+	}
+}
+
+func TestNeedsProductionConfirm(t *testing.T) {
+	tests := []struct {
+		name string
+		act  Action
+		want bool
+	}{
+		{"api action", Action{Type: ActionTypeAPI, Operation: "StopInstances"}, true},
+		{"read-only safe api action", Action{Type: ActionTypeAPI, Operation: OperationOpenInConsole}, false},
+		{"exec action", Action{Type: ActionTypeExec, Command: "aws ssm start-session"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NeedsProductionConfirm(tt.act); got != tt.want {
+				t.Errorf("NeedsProductionConfirm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConfirmMatches(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1170,3 +1279,83 @@ func TestExecWithHeader_SetIO(t *testing.T) {
 		t.Error("SetStderr did not set stderr")
 	}
 }
+
+func TestInputFromContext(t *testing.T) {
+	if got := InputFromContext(context.Background()); got != "" {
+		t.Errorf("InputFromContext() = %q, want empty", got)
+	}
+
+	ctx := WithInput(context.Background(), "admins")
+	if got := InputFromContext(ctx); got != "admins" {
+		t.Errorf("InputFromContext() = %q, want %q", got, "admins")
+	}
+
+	ctx = WithInputs(context.Background(), []string{"bucket-a", "ALL"})
+	if got := InputFromContext(ctx); got != "bucket-a" {
+		t.Errorf("InputFromContext() = %q, want first value", got)
+	}
+	if got := InputsFromContext(ctx); !slices.Equal(got, []string{"bucket-a", "ALL"}) {
+		t.Errorf("InputsFromContext() = %v", got)
+	}
+}
+
+func TestCached(t *testing.T) {
+	loads := 0
+	load := func() (string, error) {
+		loads++
+		return "value", nil
+	}
+
+	ctx := WithRunCache(context.Background())
+	for range 2 {
+		if got, err := Cached(ctx, "doc", load); err != nil || got != "value" {
+			t.Fatalf("Cached() = %q, %v", got, err)
+		}
+	}
+	if loads != 1 {
+		t.Errorf("loads within a run = %d, want 1", loads)
+	}
+
+	if _, err := Cached(ctx, "bad", func() (string, error) { return "", errors.New("boom") }); err == nil {
+		t.Error("Cached() should return load errors")
+	}
+	if got, _ := Cached(ctx, "bad", load); got != "value" {
+		t.Errorf("errors should not be cached, got %q", got)
+	}
+
+	loads = 0
+	Cached(context.Background(), "doc", load)
+	Cached(context.Background(), "doc", load)
+	if loads != 2 {
+		t.Errorf("loads without a run cache = %d, want 2", loads)
+	}
+	Cached(WithRunCache(context.Background()), "doc", load)
+	if loads != 3 {
+		t.Error("a new run should not reuse an earlier run's values")
+	}
+}
+
+func TestInputSpecSpecs(t *testing.T) {
+	last := &InputSpec{Label: "Schedule"}
+	first := &InputSpec{Label: "Bucket", Then: &InputSpec{Label: "Identifiers", Then: last}}
+	specs := first.Specs()
+	if len(specs) != 3 || specs[0] != first || specs[2] != last {
+		t.Errorf("Specs() = %+v", specs)
+	}
+}
+
+func TestExpandVariablesWithInput(t *testing.T) {
+	resource := &mockResource{id: "task-1", arn: "arn:aws:ecs:us-east-1:123456789012:task/prod/task-1"}
+
+	got, err := ExpandVariablesWithInput(`exec --task "${ARN}" --container "${INPUT}"`, resource, "web")
+	if err != nil {
+		t.Fatalf("ExpandVariablesWithInput() error = %v", err)
+	}
+	if want := `exec --task "arn:aws:ecs:us-east-1:123456789012:task/prod/task-1" --container "web"`; got != want {
+		t.Errorf("ExpandVariablesWithInput() = %q, want %q", got, want)
+	}
+
+	if _, err := ExpandVariablesWithInput("echo ${INPUT}", resource, "web; rm -rf /"); !errors.Is(err, ErrUnsafeValue) {
+		t.Errorf("ExpandVariablesWithInput(unsafe) error = %v, want ErrUnsafeValue", err)
+	}
+}
@@ -0,0 +1,63 @@
+package action
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/console"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// Operations of the universal console actions
+const (
+	OperationOpenInConsole  = "OpenInConsole"
+	OperationCopyConsoleURL = "CopyConsoleURL"
+)
+
+func init() {
+	Global.RegisterUniversal([]Action{
+		{
+			Name:      "Open in Console",
+			Shortcut:  "O",
+			Type:      ActionTypeAPI,
+			Operation: OperationOpenInConsole,
+		},
+		{
+			Name:      "Copy Console URL",
+			Shortcut:  "L",
+			Type:      ActionTypeAPI,
+			Operation: OperationCopyConsoleURL,
+		},
+	}, executeConsoleAction)
+}
+
+func executeConsoleAction(ctx context.Context, act Action, resource dao.Resource, service, resourceType string) ActionResult {
+	region := aws.GetRegionFromContext(ctx)
+	if region == "" {
+		region = config.Global().Region()
+	}
+	link := console.NewLink(service, resourceType, resource, region)
+	u := link.URL()
+
+	if config.File().ConsoleFederatedSignin() {
+		signin, err := console.SigninURL(ctx, u, link.Partition)
+		if err != nil {
+			return FailResultf(err, "federated sign-in")
+		}
+		u = signin
+	}
+
+	switch act.Operation {
+	case OperationOpenInConsole:
+		if err := console.Open(u); err != nil {
+			return FailResult(err)
+		}
+		return SuccessResult("Opened in browser")
+	case OperationCopyConsoleURL:
+		return SuccessResultWithFollowUp("Copied console URL", clipboard.Copy("Console URL", u)())
+	default:
+		return UnknownOperationResult(act.Operation)
+	}
+}
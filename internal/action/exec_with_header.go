@@ -201,12 +201,12 @@ func (e *ExecWithHeader) Run() error {
 }
 
 func (e *ExecWithHeader) buildHeader(_ int) string {
-	profileDisplay := config.Global().Selection().DisplayName()
 	region := e.Region
 	if region == "" {
 		region = config.Global().Region()
 	}
-	accountID := config.Global().AccountID()
+	sel := config.Global().Selection()
+	account := config.Global().AccountLabel(sel.ID())
 
 	titleStyle := ui.TitleStyle()
 	labelStyle := ui.DimStyle()
@@ -225,13 +225,13 @@ func (e *ExecWithHeader) buildHeader(_ int) string {
 	lines = append(lines, resourceLine)
 
 	contextParts := []string{
-		labelStyle.Render("Profile: ") + valueStyle.Render(profileDisplay),
+		labelStyle.Render("Profile: ") + valueStyle.Render(sel.DisplayName()),
 	}
 	if region != "" {
 		contextParts = append(contextParts, regionStyle.Render("["+region+"]"))
 	}
-	if accountID != "" {
-		contextParts = append(contextParts, labelStyle.Render("Account: ")+valueStyle.Render(accountID))
+	if account != "" {
+		contextParts = append(contextParts, labelStyle.Render("Account: ")+valueStyle.Render(account))
 	}
 	lines = append(lines, strings.Join(contextParts, " "))
 
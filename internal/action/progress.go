@@ -0,0 +1,53 @@
+package action
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/log"
+)
+
+// ProgressUpdate reports one step of an action started with Track.
+// The last update on the channel has Done set; Message or Err describe the outcome.
+type ProgressUpdate struct {
+	Step    string
+	Done    bool
+	Message string
+	Err     error
+}
+
+// progressBuffer is how many updates a tracked action can queue without a reader.
+const progressBuffer = 32
+
+// TrackFunc runs a multi-step action, calling report as each step begins,
+// and returns the final success message.
+type TrackFunc func(ctx context.Context, report func(step string)) (string, error)
+
+// Track runs fn in the background and returns immediately with a result whose
+// Progress channel receives each reported step followed by a Done update.
+// Use it for guided flows that would otherwise block the UI for minutes.
+// Updates are dropped rather than blocking fn if nobody is reading.
+func Track(ctx context.Context, message string, fn TrackFunc) ActionResult {
+	ch := make(chan ProgressUpdate, progressBuffer)
+	send := func(u ProgressUpdate) {
+		select {
+		case ch <- u:
+		default:
+		}
+	}
+
+	go func() {
+		defer close(ch)
+		msg, err := fn(ctx, func(step string) {
+			log.Debug("action progress", "step", step)
+			send(ProgressUpdate{Step: step})
+		})
+		if err != nil {
+			log.Error("tracked action failed", "error", err)
+		} else {
+			log.Info("tracked action completed", "message", msg)
+		}
+		send(ProgressUpdate{Done: true, Message: msg, Err: err})
+	}()
+
+	return ActionResult{Success: true, Message: message, Progress: ch}
+}
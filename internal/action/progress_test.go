@@ -0,0 +1,52 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func drainProgress(ch <-chan ProgressUpdate) []ProgressUpdate {
+	var updates []ProgressUpdate
+	for u := range ch {
+		updates = append(updates, u)
+	}
+	return updates
+}
+
+func TestTrack(t *testing.T) {
+	result := Track(context.Background(), "working", func(_ context.Context, report func(string)) (string, error) {
+		report("step one")
+		report("step two")
+		return "all done", nil
+	})
+	if !result.Success || result.Message != "working" || result.Progress == nil {
+		t.Fatalf("Track() = %+v, want immediate success with a progress channel", result)
+	}
+
+	updates := drainProgress(result.Progress)
+	if len(updates) != 3 {
+		t.Fatalf("got %d updates, want 3", len(updates))
+	}
+	if updates[0].Step != "step one" || updates[1].Step != "step two" {
+		t.Errorf("steps = %q, %q", updates[0].Step, updates[1].Step)
+	}
+	final := updates[2]
+	if !final.Done || final.Err != nil || final.Message != "all done" {
+		t.Errorf("final update = %+v", final)
+	}
+}
+
+func TestTrack_Error(t *testing.T) {
+	wantErr := errors.New("boom")
+	result := Track(context.Background(), "working", func(_ context.Context, report func(string)) (string, error) {
+		report("step one")
+		return "", wantErr
+	})
+
+	updates := drainProgress(result.Progress)
+	final := updates[len(updates)-1]
+	if !final.Done || !errors.Is(final.Err, wantErr) {
+		t.Errorf("final update = %+v, want Done with %v", final, wantErr)
+	}
+}
@@ -79,6 +79,7 @@ type StreamEvent struct {
 	Thinking   *ThinkingContent
 	ToolUse    *ToolUseContent
 	StopReason StopReason
+	Usage      *Usage // Tokens billed for the response, set on "done"
 	Error      error
 }
 
@@ -140,13 +141,13 @@ func NewClient(ctx context.Context, opts ...ClientOption) (*Client, error) {
 		ctx = appaws.WithRegionOverride(ctx, region)
 	}
 
-	awsCfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, bedrockruntime.NewFromConfig)
 	if err != nil {
 		return nil, apperrors.Wrap(err, "load aws config")
 	}
 
 	c := &Client{
-		client: bedrockruntime.NewFromConfig(awsCfg),
+		client: client,
 	}
 
 	for _, opt := range opts {
@@ -314,6 +315,11 @@ func (c *Client) processStream(ctx context.Context, output *bedrockruntime.Conve
 	var thinkingSignature string
 	var isThinkingBlock bool
 
+	// Usage arrives in a metadata event after the message stops, so "done"
+	// is sent once the stream ends.
+	var done *StreamEvent
+	var usage *Usage
+
 	for event := range stream.Events() {
 		select {
 		case <-ctx.Done():
@@ -402,16 +408,28 @@ func (c *Client) processStream(ctx context.Context, output *bedrockruntime.Conve
 			}
 
 		case *types.ConverseStreamOutputMemberMessageStop:
-			events <- StreamEvent{
+			done = &StreamEvent{
 				Type:       "done",
 				StopReason: convertStopReason(e.Value.StopReason),
 			}
-			return
+
+		case *types.ConverseStreamOutputMemberMetadata:
+			if u := e.Value.Usage; u != nil {
+				usage = &Usage{
+					InputTokens:  int(aws.ToInt32(u.InputTokens)),
+					OutputTokens: int(aws.ToInt32(u.OutputTokens)),
+				}
+			}
 		}
 	}
 
 	if err := stream.Err(); err != nil {
 		events <- StreamEvent{Type: "error", Error: err}
+		return
+	}
+	if done != nil {
+		done.Usage = usage
+		events <- *done
 	}
 }
 
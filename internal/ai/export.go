@@ -0,0 +1,118 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/clawscli/claws/internal/config"
+)
+
+const exportDir = "chat/exports"
+
+// ExportMarkdown renders a session as Markdown: the conversation, the tool
+// calls with their inputs and results, and thinking in collapsed blocks.
+func ExportMarkdown(s *Session) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# claws AI chat — %s\n\n", s.StartedAt.Format("2006-01-02 15:04"))
+	if line := contextSummary(s.Context); line != "" {
+		fmt.Fprintf(&b, "Context: %s\n\n", line)
+	}
+	if s.Usage != (Usage{}) {
+		fmt.Fprintf(&b, "Usage: %s", s.Usage)
+		if s.Cost > 0 {
+			fmt.Fprintf(&b, " · %s (estimated)", FormatCost(s.Cost))
+		}
+		b.WriteString("\n\n")
+	}
+
+	for _, msg := range s.Messages {
+		for _, block := range msg.Content {
+			switch {
+			case block.Reasoning != "":
+				b.WriteString("<details><summary>Thinking</summary>\n\n")
+				b.WriteString(block.Reasoning)
+				b.WriteString("\n\n</details>\n\n")
+			case block.Text != "":
+				if msg.Role == RoleUser {
+					b.WriteString("## You\n\n")
+				} else {
+					b.WriteString("## Assistant\n\n")
+				}
+				b.WriteString(block.Text)
+				b.WriteString("\n\n")
+			case block.ToolUse != nil:
+				fmt.Fprintf(&b, "**Tool call:** `%s`\n\n", block.ToolUse.Name)
+				input, err := json.MarshalIndent(block.ToolUse.Input, "", "  ")
+				if err != nil {
+					input = []byte(fmt.Sprint(block.ToolUse.Input))
+				}
+				writeFence(&b, "json", string(input))
+			case block.ToolResult != nil:
+				if block.ToolResult.IsError {
+					b.WriteString("**Tool error:**\n\n")
+				} else {
+					b.WriteString("**Tool result:**\n\n")
+				}
+				writeFence(&b, "", block.ToolResult.Content)
+			}
+		}
+	}
+	return b.String()
+}
+
+// writeFence writes body as a fenced code block, using a fence longer than
+// any backtick run in body so the block cannot be closed early.
+func writeFence(b *strings.Builder, lang, body string) {
+	fence := "```"
+	for strings.Contains(body, fence) {
+		fence += "`"
+	}
+	fmt.Fprintf(b, "%s%s\n%s\n%s\n\n", fence, lang, strings.TrimRight(body, "\n"), fence)
+}
+
+func contextSummary(c *Context) string {
+	if c == nil {
+		return ""
+	}
+	var parts []string
+	if c.Service != "" {
+		target := c.Service
+		if c.ResourceType != "" {
+			target += "/" + c.ResourceType
+		}
+		if c.ResourceID != "" {
+			target += " " + c.ResourceID
+		}
+		parts = append(parts, target)
+	}
+	if c.ResourceRegion != "" {
+		parts = append(parts, c.ResourceRegion)
+	} else if len(c.UserRegions) > 0 {
+		parts = append(parts, strings.Join(c.UserRegions, ", "))
+	}
+	for _, p := range c.Pinned {
+		parts = append(parts, "pinned "+p.String())
+	}
+	return strings.Join(parts, " · ")
+}
+
+// SaveExport writes a session's Markdown export under the config directory
+// and returns its path.
+func SaveExport(s *Session) (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, exportDir)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, s.StartedAt.Format("20060102-150405")+"-"+s.ID[:min(8, len(s.ID))]+".md")
+	if err := os.WriteFile(path, []byte(ExportMarkdown(s)), 0600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
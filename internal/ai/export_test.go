@@ -0,0 +1,69 @@
+package ai
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testExportSession() *Session {
+	return &Session{
+		ID:        "0123456789abcdef",
+		StartedAt: time.Date(2026, 10, 3, 9, 30, 0, 0, time.UTC),
+		Context: &Context{
+			Service:        "ec2",
+			ResourceType:   "instances",
+			ResourceID:     "i-1",
+			ResourceRegion: "us-east-1",
+			Pinned:         []PinnedResource{{Service: "rds", ResourceType: "instances", ResourceRef: ResourceRef{ID: "db-1"}}},
+		},
+		Usage: Usage{InputTokens: 1200, OutputTokens: 80},
+		Messages: []Message{
+			{Role: RoleUser, Content: []ContentBlock{{Text: "Why is it slow?"}}},
+			{Role: RoleAssistant, Content: []ContentBlock{
+				{Reasoning: "Check metrics first."},
+				{ToolUse: &ToolUseContent{ID: "t1", Name: "get_resource_detail", Input: map[string]any{"id": "i-1"}}},
+			}},
+			{Role: RoleUser, Content: []ContentBlock{{ToolResult: &ToolResultContent{ToolUseID: "t1", Content: "has ```fences``` inside"}}}},
+			{Role: RoleAssistant, Content: []ContentBlock{{Text: "CPU credits are exhausted."}}},
+		},
+	}
+}
+
+func TestExportMarkdown(t *testing.T) {
+	md := ExportMarkdown(testExportSession())
+
+	for _, want := range []string{
+		"# claws AI chat — 2026-10-03 09:30",
+		"Context: ec2/instances i-1 · us-east-1 · pinned rds/instances db-1",
+		"Usage: 1.2k in · 80 out",
+		"## You\n\nWhy is it slow?",
+		"<details><summary>Thinking</summary>\n\nCheck metrics first.",
+		"**Tool call:** `get_resource_detail`\n\n```json\n{\n  \"id\": \"i-1\"\n}\n```",
+		"**Tool result:**\n\n````\nhas ```fences``` inside\n````",
+		"## Assistant\n\nCPU credits are exhausted.",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("export missing %q\n%s", want, md)
+		}
+	}
+}
+
+func TestSaveExport(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	path, err := SaveExport(testExportSession())
+	if err != nil {
+		t.Fatalf("SaveExport() error = %v", err)
+	}
+	if want := filepath.Join(home, ".config", "claws", "chat", "exports", "20261003-093000-01234567.md"); path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || !strings.HasPrefix(string(data), "# claws AI chat") {
+		t.Errorf("ReadFile() = %q, %v", data, err)
+	}
+}
@@ -0,0 +1,109 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+// scopedTools are the tools ai.tools services, regions and accounts apply
+// to, i.e. the ones that read resources.
+var scopedTools = []string{"list_resources", "query_resources", "get_resource_detail", "tail_logs", ToolProposeAction}
+
+// checkPolicy returns an error message when ai.tools disables the call's
+// tool or keeps it away from the requested service, region or account.
+// Missing parameters pass, so each tool still reports them itself.
+func (e *ToolExecutor) checkPolicy(ctx context.Context, call *ToolUseContent) string {
+	t := e.policy
+	if !t.ToolEnabled(call.Name) {
+		return fmt.Sprintf("Error: %s is disabled by the ai.tools config", call.Name)
+	}
+	if !slices.Contains(scopedTools, call.Name) {
+		return ""
+	}
+
+	str := func(key string) string {
+		s, _ := call.Input[key].(string)
+		return strings.TrimSpace(s)
+	}
+	service, region, profile := str("service"), str("region"), str("profile")
+
+	if service != "" && len(t.Services) > 0 && !slices.Contains(t.Services, service) {
+		return fmt.Sprintf("Error: service %s is not allowed by ai.tools.services (allowed: %s)", service, strings.Join(t.Services, ", "))
+	}
+	if region == "" {
+		return ""
+	}
+	if len(t.Regions) > 0 && !slices.Contains(t.Regions, region) {
+		return fmt.Sprintf("Error: region %s is not allowed by ai.tools.regions (allowed: %s)", region, strings.Join(t.Regions, ", "))
+	}
+	if len(t.Accounts) > 0 {
+		account := toolAccountID(ctx, profile)
+		if account == "" {
+			return "Error: could not determine the AWS account, which ai.tools.accounts requires"
+		}
+		if !slices.Contains(t.Accounts, account) {
+			return fmt.Sprintf("Error: account %s is not allowed by ai.tools.accounts", account)
+		}
+	}
+	return ""
+}
+
+// toolAccountID returns the account ID of profile, or of the current profile
+// when empty, looking it up with STS the first time.
+func toolAccountID(ctx context.Context, profile string) string {
+	sel := appconfig.Global().Selection()
+	if profile != "" {
+		sel = appconfig.ProfileSelectionFromID(profile)
+	}
+	if id := appconfig.Global().GetAccountIDForProfile(sel.ID()); id != "" {
+		return id
+	}
+	id := appaws.FetchAccountIDForContext(appaws.WithSelectionOverride(ctx, sel))
+	if id != "" {
+		appconfig.Global().SetAccountIDForProfile(sel.ID(), id)
+	}
+	return id
+}
+
+// capResults lowers limit to ai.tools.max_results when that is set.
+func (e *ToolExecutor) capResults(limit int) int {
+	if m := e.policy.MaxResults; m > 0 && limit > m {
+		return m
+	}
+	return limit
+}
+
+// ToolPolicyPrompt describes the ai.tools restrictions for the system
+// prompt, or returns "" when there are none.
+func ToolPolicyPrompt() string {
+	t := appconfig.File().GetAITools()
+	var lines []string
+	if len(t.Allow) > 0 {
+		lines = append(lines, "Only these tools are enabled: "+strings.Join(t.Allow, ", "))
+	}
+	if len(t.Deny) > 0 {
+		lines = append(lines, "These tools are disabled: "+strings.Join(t.Deny, ", "))
+	}
+	if len(t.Services) > 0 {
+		lines = append(lines, "Resource tools may only query these services: "+strings.Join(t.Services, ", "))
+	}
+	if len(t.Regions) > 0 {
+		lines = append(lines, "Resource tools may only query these regions: "+strings.Join(t.Regions, ", "))
+	}
+	if len(t.Accounts) > 0 {
+		lines = append(lines, "Resource tools may only query these AWS accounts: "+strings.Join(t.Accounts, ", "))
+	}
+	if t.MaxResults > 0 {
+		lines = append(lines, fmt.Sprintf("Each call returns at most %d resources or log lines", t.MaxResults))
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n\n<tool_restrictions>\n" + strings.Join(lines, "\n") +
+		"\nThese are set by the user's organization. Do not try to work around them; say so when a request falls outside them.\n</tool_restrictions>"
+}
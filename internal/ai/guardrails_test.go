@@ -0,0 +1,78 @@
+package ai
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestToolPolicyHidesDisabledTools(t *testing.T) {
+	e := &ToolExecutor{policy: appconfig.AIToolsConfig{Deny: []string{"search_aws_docs"}}}
+	var names []string
+	for _, tool := range e.Tools() {
+		names = append(names, tool.Name)
+	}
+	if slices.Contains(names, "search_aws_docs") || !slices.Contains(names, "query_resources") {
+		t.Errorf("Tools() with deny = %v", names)
+	}
+
+	e = &ToolExecutor{policy: appconfig.AIToolsConfig{Allow: []string{"list_resources", "query_resources"}}}
+	if got := len(e.Tools()); got != 2 {
+		t.Errorf("Tools() with allow returned %d tools, want 2", got)
+	}
+}
+
+func TestToolPolicyExecute(t *testing.T) {
+	sel := appconfig.Global().Selection().ID()
+	prev := appconfig.Global().GetAccountIDForProfile(sel)
+	appconfig.Global().SetAccountIDForProfile(sel, "111111111111")
+	t.Cleanup(func() { appconfig.Global().SetAccountIDForProfile(sel, prev) })
+
+	query := func(service, region string) map[string]any {
+		return map[string]any{"service": service, "resource_type": "instances", "region": region}
+	}
+
+	tests := []struct {
+		name    string
+		policy  appconfig.AIToolsConfig
+		tool    string
+		input   map[string]any
+		wantErr string
+	}{
+		{"denied tool", appconfig.AIToolsConfig{Deny: []string{"search_aws_docs"}}, "search_aws_docs", map[string]any{"query": "s3"}, "search_aws_docs is disabled"},
+		{"not allowed tool", appconfig.AIToolsConfig{Allow: []string{"list_resources"}}, "tail_logs", query("lambda", "us-east-1"), "tail_logs is disabled"},
+		{"service out of scope", appconfig.AIToolsConfig{Services: []string{"ec2"}}, "query_resources", query("iam", "us-east-1"), "service iam is not allowed"},
+		{"list_resources out of scope", appconfig.AIToolsConfig{Services: []string{"ec2"}}, "list_resources", map[string]any{"service": "iam"}, "service iam is not allowed"},
+		{"region out of scope", appconfig.AIToolsConfig{Regions: []string{"eu-west-1"}}, "get_resource_detail", query("ec2", "us-east-1"), "region us-east-1 is not allowed"},
+		{"account out of scope", appconfig.AIToolsConfig{Accounts: []string{"222222222222"}}, "query_resources", query("ec2", "us-east-1"), "account 111111111111 is not allowed"},
+		{"in scope", appconfig.AIToolsConfig{Services: []string{"ec2"}, Regions: []string{"us-east-1"}, Accounts: []string{"111111111111"}}, "query_resources", query("ec2", "us-east-1"), "ec2/instances not found"},
+		{"missing region still reported by the tool", appconfig.AIToolsConfig{Regions: []string{"eu-west-1"}}, "query_resources", map[string]any{"service": "ec2", "resource_type": "instances"}, "region parameter is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &ToolExecutor{registry: registry.New(), policy: tt.policy}
+			result := e.Execute(context.Background(), &ToolUseContent{ID: "t", Name: tt.tool, Input: tt.input})
+			if !result.IsError || !strings.Contains(result.Content, tt.wantErr) {
+				t.Errorf("Execute() = %+v, want error containing %q", result, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestToolPolicyCapResults(t *testing.T) {
+	e := &ToolExecutor{policy: appconfig.AIToolsConfig{MaxResults: 50}}
+	if got := e.capResults(100); got != 50 {
+		t.Errorf("capResults(100) = %d, want 50", got)
+	}
+	if got := e.capResults(20); got != 20 {
+		t.Errorf("capResults(20) = %d, want 20", got)
+	}
+	if got := (&ToolExecutor{}).capResults(2000); got != 2000 {
+		t.Errorf("capResults without a cap = %d, want 2000", got)
+	}
+}
@@ -0,0 +1,78 @@
+package ai
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// PinnedResource is a resource the user pinned into the AI chat context
+// from a resource list.
+type PinnedResource struct {
+	Service      string `json:"service"`
+	ResourceType string `json:"resource_type"`
+	ResourceRef
+}
+
+// String describes the pin, e.g. "ec2/instances i-0abc (web) us-east-1".
+func (p PinnedResource) String() string {
+	s := fmt.Sprintf("%s/%s %s", p.Service, p.ResourceType, p.ID)
+	if p.Name != "" && p.Name != p.ID {
+		s += " (" + p.Name + ")"
+	}
+	if p.Region != "" {
+		s += " " + p.Region
+	}
+	return s
+}
+
+func (p PinnedResource) same(o PinnedResource) bool {
+	return p.Service == o.Service && p.ResourceType == o.ResourceType &&
+		p.ID == o.ID && p.Region == o.Region && p.Profile == o.Profile
+}
+
+// maxPins bounds the pinned resources, keeping the system prompt small.
+const maxPins = 20
+
+// pins holds the pinned resources until they are unpinned or claws exits.
+var pins struct {
+	sync.Mutex
+	list []PinnedResource
+}
+
+// TogglePin pins p, or unpins it when already pinned. It reports whether p
+// is now pinned; pinning fails once maxPins resources are pinned.
+func TogglePin(p PinnedResource) (pinned bool, err error) {
+	pins.Lock()
+	defer pins.Unlock()
+	if i := slices.IndexFunc(pins.list, p.same); i >= 0 {
+		pins.list = slices.Delete(pins.list, i, i+1)
+		return false, nil
+	}
+	if len(pins.list) >= maxPins {
+		return false, fmt.Errorf("at most %d resources can be pinned", maxPins)
+	}
+	pins.list = append(pins.list, p)
+	return true, nil
+}
+
+// IsPinned reports whether p is pinned.
+func IsPinned(p PinnedResource) bool {
+	pins.Lock()
+	defer pins.Unlock()
+	return slices.ContainsFunc(pins.list, p.same)
+}
+
+// Pinned returns the pinned resources in pin order.
+func Pinned() []PinnedResource {
+	pins.Lock()
+	defer pins.Unlock()
+	return slices.Clone(pins.list)
+}
+
+// ClearPins unpins every resource.
+func ClearPins() {
+	pins.Lock()
+	defer pins.Unlock()
+	pins.list = nil
+}
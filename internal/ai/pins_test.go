@@ -0,0 +1,54 @@
+package ai
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTogglePin(t *testing.T) {
+	ClearPins()
+	t.Cleanup(ClearPins)
+
+	web := PinnedResource{Service: "ec2", ResourceType: "instances", ResourceRef: ResourceRef{ID: "i-1", Name: "web", Region: "us-east-1"}}
+	otherRegion := web
+	otherRegion.Region = "eu-west-1"
+
+	if pinned, err := TogglePin(web); err != nil || !pinned {
+		t.Fatalf("TogglePin(web) = %v, %v, want pinned", pinned, err)
+	}
+	if pinned, err := TogglePin(otherRegion); err != nil || !pinned {
+		t.Fatalf("TogglePin(otherRegion) = %v, %v, want pinned", pinned, err)
+	}
+	if !IsPinned(web) || len(Pinned()) != 2 {
+		t.Fatalf("Pinned() = %+v, want web in both regions", Pinned())
+	}
+	if got := web.String(); got != "ec2/instances i-1 (web) us-east-1" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if pinned, _ := TogglePin(web); pinned {
+		t.Error("second TogglePin(web) should unpin")
+	}
+	if IsPinned(web) || !IsPinned(otherRegion) {
+		t.Errorf("Pinned() = %+v, want only otherRegion", Pinned())
+	}
+
+	ClearPins()
+	if len(Pinned()) != 0 {
+		t.Errorf("Pinned() after ClearPins = %+v", Pinned())
+	}
+}
+
+func TestTogglePinLimit(t *testing.T) {
+	ClearPins()
+	t.Cleanup(ClearPins)
+
+	for i := range maxPins {
+		if _, err := TogglePin(PinnedResource{Service: "s3", ResourceType: "buckets", ResourceRef: ResourceRef{ID: fmt.Sprint(i)}}); err != nil {
+			t.Fatalf("TogglePin(%d) error = %v", i, err)
+		}
+	}
+	if _, err := TogglePin(PinnedResource{Service: "s3", ResourceType: "buckets", ResourceRef: ResourceRef{ID: "one-too-many"}}); err == nil {
+		t.Error("TogglePin beyond maxPins should fail")
+	}
+}
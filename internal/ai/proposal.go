@@ -0,0 +1,121 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	appconfig "github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// ToolProposeAction is the tool the assistant calls to suggest an action.
+const ToolProposeAction = "propose_action"
+
+// ToolExecutorOption configures a ToolExecutor.
+type ToolExecutorOption func(*ToolExecutor)
+
+// WithActionProposals offers the propose_action tool. Only enable it where a
+// user can see and approve the proposals, i.e. the chat overlay.
+func WithActionProposals() ToolExecutorOption {
+	return func(e *ToolExecutor) {
+		e.proposals = true
+	}
+}
+
+// ActionProposal is an action the assistant suggests running on a resource.
+// Proposing runs nothing: the chat shows it as a button and the user runs it
+// through the action menu's usual confirmation and read-only checks.
+type ActionProposal struct {
+	Service      string
+	ResourceType string
+	Region       string
+	Profile      string
+	ID           string
+	Cluster      string
+	Action       string // Action.Name as registered in action.Global
+	Reason       string
+}
+
+// ProposalFromInput reads the input of a propose_action tool call.
+func ProposalFromInput(input map[string]any) ActionProposal {
+	str := func(key string) string {
+		s, _ := input[key].(string)
+		return strings.TrimSpace(s)
+	}
+	return ActionProposal{
+		Service:      str("service"),
+		ResourceType: str("resource_type"),
+		Region:       str("region"),
+		Profile:      str("profile"),
+		ID:           str("id"),
+		Cluster:      str("cluster"),
+		Action:       str("action"),
+		Reason:       str("reason"),
+	}
+}
+
+// Context scopes ctx to the proposal's profile, region and ECS cluster, as
+// the resource tools do.
+func (p ActionProposal) Context(ctx context.Context) context.Context {
+	if p.Profile != "" {
+		ctx = appaws.WithSelectionOverride(ctx, appconfig.ProfileSelectionFromID(p.Profile))
+	}
+	if p.Region != "" {
+		ctx = appaws.WithRegionOverride(ctx, p.Region)
+	}
+	if p.Cluster != "" {
+		ctx = dao.WithFilter(ctx, "ClusterName", p.Cluster)
+	}
+	return ctx
+}
+
+// String describes the proposal for display, e.g. "Force Deploy ecs/services web".
+func (p ActionProposal) String() string {
+	return fmt.Sprintf("%s %s/%s %s", p.Action, p.Service, p.ResourceType, p.ID)
+}
+
+// FindAction returns the registered action the proposal names, matching
+// the name case-insensitively.
+func (p ActionProposal) FindAction() (action.Action, bool) {
+	for _, act := range action.Global.ForResource(p.Service, p.ResourceType) {
+		if strings.EqualFold(act.Name, p.Action) {
+			return act, true
+		}
+	}
+	return action.Action{}, false
+}
+
+// proposeAction validates a proposal without running it.
+func (e *ToolExecutor) proposeAction(p ActionProposal) (string, bool) {
+	switch {
+	case p.Service == "" || p.ResourceType == "" || p.ID == "" || p.Action == "":
+		return "Error: service, resource_type, id and action parameters are required", true
+	case p.Region == "":
+		return "Error: region parameter is required", true
+	case p.Service == "ecs" && (p.ResourceType == "services" || p.ResourceType == "tasks") && p.Cluster == "":
+		return "Error: cluster parameter is required for ecs/services and ecs/tasks", true
+	}
+	if !e.registry.HasResource(p.Service, p.ResourceType) {
+		return fmt.Sprintf("Error: unknown resource type %s/%s", p.Service, p.ResourceType), true
+	}
+
+	act, ok := p.FindAction()
+	if !ok {
+		var names []string
+		for _, a := range action.Global.ForResource(p.Service, p.ResourceType) {
+			names = append(names, a.Name)
+		}
+		if len(names) == 0 {
+			return fmt.Sprintf("Error: %s/%s has no actions", p.Service, p.ResourceType), true
+		}
+		return fmt.Sprintf("Error: unknown action %q for %s/%s. Available: %s", p.Action, p.Service, p.ResourceType, strings.Join(names, ", ")), true
+	}
+	if appconfig.Global().ReadOnly() && !action.IsAllowedInReadOnly(act) {
+		return fmt.Sprintf("Error: claws is in read-only mode, %q cannot be run", act.Name), true
+	}
+
+	return fmt.Sprintf("Proposed %q on %s/%s %s. It has NOT been run: the user sees it as a button and decides whether to run it.", act.Name, p.Service, p.ResourceType, p.ID), false
+}
@@ -0,0 +1,114 @@
+package ai
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestToolExecutorProposalsOptIn(t *testing.T) {
+	hasPropose := func(e *ToolExecutor) bool {
+		return slices.ContainsFunc(e.Tools(), func(t Tool) bool { return t.Name == ToolProposeAction })
+	}
+
+	plain, _ := NewToolExecutor(context.Background(), registry.New())
+	if hasPropose(plain) {
+		t.Error("propose_action should only be offered with WithActionProposals")
+	}
+	result := plain.Execute(context.Background(), &ToolUseContent{Name: ToolProposeAction, Input: map[string]any{}})
+	if !result.IsError || !strings.Contains(result.Content, "Unknown tool") {
+		t.Errorf("propose_action without opt-in = %+v, want unknown tool error", result)
+	}
+
+	chat, _ := NewToolExecutor(context.Background(), registry.New(), WithActionProposals())
+	if !hasPropose(chat) {
+		t.Error("WithActionProposals should offer propose_action")
+	}
+}
+
+func TestProposeAction(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("proposaltest", "widgets", registry.Entry{})
+	action.Global.Register("proposaltest", "widgets", []action.Action{
+		{Name: "Restart", Type: action.ActionTypeAPI, Operation: "RestartWidget"},
+	})
+	executor, _ := NewToolExecutor(context.Background(), reg, WithActionProposals())
+
+	input := func(overrides map[string]any) map[string]any {
+		in := map[string]any{
+			"service":       "proposaltest",
+			"resource_type": "widgets",
+			"region":        "us-east-1",
+			"id":            "w-1",
+			"action":        "restart",
+		}
+		for k, v := range overrides {
+			in[k] = v
+		}
+		return in
+	}
+
+	tests := []struct {
+		name     string
+		input    map[string]any
+		readOnly bool
+		wantErr  bool
+		want     string
+	}{
+		{"valid, case-insensitive", input(nil), false, false, `Proposed "Restart"`},
+		{"missing region", input(map[string]any{"region": ""}), false, true, "region parameter is required"},
+		{"missing action", input(map[string]any{"action": ""}), false, true, "are required"},
+		{"unknown resource type", input(map[string]any{"resource_type": "gadgets"}), false, true, "unknown resource type"},
+		{"unknown action", input(map[string]any{"action": "Explode"}), false, true, "Available: Restart"},
+		{"read-only", input(nil), true, true, "read-only mode"},
+		{"ecs needs cluster", input(map[string]any{"service": "ecs", "resource_type": "services"}), false, true, "cluster parameter is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Global().SetReadOnly(tt.readOnly)
+			defer config.Global().SetReadOnly(false)
+
+			result := executor.Execute(context.Background(), &ToolUseContent{Name: ToolProposeAction, Input: tt.input})
+			if result.IsError != tt.wantErr {
+				t.Errorf("IsError = %v, want %v (%s)", result.IsError, tt.wantErr, result.Content)
+			}
+			if !strings.Contains(result.Content, tt.want) {
+				t.Errorf("Content = %q, want it to contain %q", result.Content, tt.want)
+			}
+		})
+	}
+}
+
+func TestProposalFromInput(t *testing.T) {
+	p := ProposalFromInput(map[string]any{
+		"service":       "ecs",
+		"resource_type": "services",
+		"region":        "us-west-2",
+		"id":            " web ",
+		"cluster":       "prod",
+		"action":        "Force Deploy",
+		"reason":        "Tasks are stuck on an old image",
+		"limit":         5.0,
+	})
+	want := ActionProposal{
+		Service:      "ecs",
+		ResourceType: "services",
+		Region:       "us-west-2",
+		ID:           "web",
+		Cluster:      "prod",
+		Action:       "Force Deploy",
+		Reason:       "Tasks are stuck on an old image",
+	}
+	if p != want {
+		t.Errorf("ProposalFromInput() = %+v, want %+v", p, want)
+	}
+	if got := p.String(); got != "Force Deploy ecs/services web" {
+		t.Errorf("String() = %q", got)
+	}
+}
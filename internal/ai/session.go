@@ -26,6 +26,8 @@ type Session struct {
 	UpdatedAt time.Time `json:"updated_at"`
 	Messages  []Message `json:"messages"`
 	Context   *Context  `json:"context,omitempty"`
+	Usage     Usage     `json:"usage,omitzero"`
+	Cost      float64   `json:"cost,omitempty"` // Estimated USD, priced per response
 }
 
 type ContextMode string
@@ -64,6 +66,9 @@ type Context struct {
 
 	DiffLeft  *ResourceRef `json:"diff_left,omitempty"`
 	DiffRight *ResourceRef `json:"diff_right,omitempty"`
+
+	// Pinned lists resources pinned from resource lists, added to any mode
+	Pinned []PinnedResource `json:"pinned,omitempty"`
 }
 
 type SessionManager struct {
@@ -187,6 +192,13 @@ func (m *SessionManager) AddMessage(session *Session, msg Message) error {
 	return nil
 }
 
+// AddUsage adds the tokens and estimated cost of one response to session.
+func (m *SessionManager) AddUsage(session *Session, usage Usage, cost float64) error {
+	session.Usage.Add(usage)
+	session.Cost += cost
+	return m.saveSession(session)
+}
+
 func (m *SessionManager) shouldPrune() (bool, error) {
 	dir, err := m.sessionsDir()
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	appconfig "github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/policy"
 	"github.com/clawscli/claws/internal/registry"
 
 	apigatewayStages "github.com/clawscli/claws/custom/apigateway/stages"
@@ -30,17 +33,24 @@ import (
 )
 
 type ToolExecutor struct {
-	registry *registry.Registry
+	registry  *registry.Registry
+	proposals bool                    // offer propose_action
+	policy    appconfig.AIToolsConfig // ai.tools restrictions
 }
 
-func NewToolExecutor(_ context.Context, reg *registry.Registry) (*ToolExecutor, error) {
-	return &ToolExecutor{
+func NewToolExecutor(_ context.Context, reg *registry.Registry, opts ...ToolExecutorOption) (*ToolExecutor, error) {
+	e := &ToolExecutor{
 		registry: reg,
-	}, nil
+		policy:   appconfig.File().GetAITools(),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e, nil
 }
 
 func (e *ToolExecutor) Tools() []Tool {
-	return []Tool{
+	tools := []Tool{
 		{
 			Name:        "list_resources",
 			Description: "List resource types available for a specific AWS service",
@@ -81,6 +91,24 @@ func (e *ToolExecutor) Tools() []Tool {
 						"type":        "boolean",
 						"description": "Include resolved/archived items (securityhub/findings only, default: false)",
 					},
+					"filter": map[string]any{
+						"type":        "object",
+						"description": "Return only matching resources. Prefer this over listing everything and filtering yourself; it is applied by the AWS API where supported.",
+						"properties": map[string]any{
+							"tag": map[string]any{
+								"type":        "string",
+								"description": "Tag equals, as key=value (exact, case-sensitive). Example: Environment=prod",
+							},
+							"name_contains": map[string]any{
+								"type":        "string",
+								"description": "Name contains this text (case-insensitive)",
+							},
+							"status": map[string]any{
+								"type":        "string",
+								"description": "Status or state equals (case-insensitive). Examples: running, stopped, available, ALARM",
+							},
+						},
+					},
 					"limit": map[string]any{
 						"type":        "integer",
 						"description": "Maximum resources to return (default: 100, max: 2000)",
@@ -187,7 +215,79 @@ func (e *ToolExecutor) Tools() []Tool {
 				"required": []string{"query"},
 			},
 		},
+		{
+			Name:        "validate_policy",
+			Description: "Validate an IAM policy .json file under the working directory with IAM Access Analyzer and list findings with line:column references",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"path": map[string]any{
+						"type":        "string",
+						"description": "Path to the .json policy document, relative to or inside the working directory",
+					},
+					"policy_type": map[string]any{
+						"type":        "string",
+						"description": "Policy type: identity (default), resource, scp, or rcp",
+					},
+					"region": map[string]any{
+						"type":        "string",
+						"description": "AWS region to call Access Analyzer in (optional, uses current region if not specified)",
+					},
+					"profile": map[string]any{
+						"type":        "string",
+						"description": "AWS profile name (optional, uses current profile if not specified)",
+					},
+				},
+				"required": []string{"path"},
+			},
+		},
 	}
+	if e.proposals {
+		tools = append(tools, Tool{
+			Name:        ToolProposeAction,
+			Description: "Suggest running a claws action on a resource, e.g. Force Deploy on an ECS service. Nothing is run: the user sees a button and approves or ignores it.",
+			InputSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"service": map[string]any{
+						"type":        "string",
+						"description": "AWS service name (e.g., ecs, ec2, lambda)",
+					},
+					"resource_type": map[string]any{
+						"type":        "string",
+						"description": "Resource type (e.g., services, instances, functions)",
+					},
+					"region": map[string]any{
+						"type":        "string",
+						"description": "AWS region of the resource",
+					},
+					"id": map[string]any{
+						"type":        "string",
+						"description": "Resource ID",
+					},
+					"action": map[string]any{
+						"type":        "string",
+						"description": "Action name as shown in the claws action menu (e.g., Force Deploy, Stop, Reboot)",
+					},
+					"reason": map[string]any{
+						"type":        "string",
+						"description": "One sentence on why the action helps, shown to the user",
+					},
+					"cluster": map[string]any{
+						"type":        "string",
+						"description": "ECS cluster name (required for ecs/services and ecs/tasks)",
+					},
+					"profile": map[string]any{
+						"type":        "string",
+						"description": "AWS profile name (optional, uses current profile if not specified)",
+					},
+				},
+				"required": []string{"service", "resource_type", "region", "id", "action"},
+			},
+		})
+	}
+	// Hide the tools ai.tools disables; Execute rejects them too
+	return slices.DeleteFunc(tools, func(t Tool) bool { return !e.policy.ToolEnabled(t.Name) })
 }
 
 func (e *ToolExecutor) Execute(ctx context.Context, call *ToolUseContent) ToolResultContent {
@@ -199,6 +299,10 @@ func (e *ToolExecutor) Execute(ctx context.Context, call *ToolUseContent) ToolRe
 		}
 	}
 
+	if msg := e.checkPolicy(ctx, call); msg != "" {
+		return ToolResultContent{ToolUseID: call.ID, Content: msg, IsError: true}
+	}
+
 	var content string
 	var isError bool
 
@@ -212,9 +316,10 @@ func (e *ToolExecutor) Execute(ctx context.Context, call *ToolUseContent) ToolRe
 		region, _ := call.Input["region"].(string)
 		profile, _ := call.Input["profile"].(string)
 		includeResolved, _ := call.Input["include_resolved"].(bool)
+		filter, _ := call.Input["filter"].(map[string]any)
 		limit, _ := call.Input["limit"].(float64)
 		offset, _ := call.Input["offset"].(float64)
-		content, isError = e.queryResources(ctx, service, resourceType, region, profile, includeResolved, int(limit), int(offset))
+		content, isError = e.queryResources(ctx, service, resourceType, region, profile, includeResolved, listFilterFromInput(filter), int(limit), int(offset))
 	case "get_resource_detail":
 		service, _ := call.Input["service"].(string)
 		resourceType, _ := call.Input["resource_type"].(string)
@@ -237,6 +342,19 @@ func (e *ToolExecutor) Execute(ctx context.Context, call *ToolUseContent) ToolRe
 	case "search_aws_docs":
 		query, _ := call.Input["query"].(string)
 		content = e.searchDocs(ctx, query)
+	case "validate_policy":
+		path, _ := call.Input["path"].(string)
+		policyType, _ := call.Input["policy_type"].(string)
+		region, _ := call.Input["region"].(string)
+		profile, _ := call.Input["profile"].(string)
+		content, isError = e.validatePolicy(ctx, path, policyType, region, profile)
+	case ToolProposeAction:
+		if !e.proposals {
+			content = fmt.Sprintf("Unknown tool: %s", call.Name)
+			isError = true
+			break
+		}
+		content, isError = e.proposeAction(ProposalFromInput(call.Input))
 	default:
 		content = fmt.Sprintf("Unknown tool: %s", call.Name)
 		isError = true
@@ -263,7 +381,55 @@ func (e *ToolExecutor) listResources(service string) string {
 	return result
 }
 
-func (e *ToolExecutor) queryResources(ctx context.Context, service, resourceType, region, profile string, includeResolved bool, limit, offset int) (string, bool) {
+// listFilter is the filter parameter of query_resources.
+type listFilter struct {
+	Tag          string
+	NameContains string
+	Status       string
+}
+
+func listFilterFromInput(input map[string]any) listFilter {
+	str := func(key string) string {
+		s, _ := input[key].(string)
+		return strings.TrimSpace(s)
+	}
+	return listFilter{Tag: str("tag"), NameContains: str("name_contains"), Status: str("status")}
+}
+
+// String describes the filter for the result header, e.g.
+// " matching tag Env=prod, status running".
+func (f listFilter) String() string {
+	var parts []string
+	if f.Tag != "" {
+		parts = append(parts, "tag "+f.Tag)
+	}
+	if f.NameContains != "" {
+		parts = append(parts, fmt.Sprintf("name containing %q", f.NameContains))
+	}
+	if f.Status != "" {
+		parts = append(parts, "status "+f.Status)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " matching " + strings.Join(parts, ", ")
+}
+
+// apply sets the filter on ctx for DAOs that filter server-side.
+func (f listFilter) apply(ctx context.Context) context.Context {
+	if f.Tag != "" {
+		ctx = dao.WithFilter(ctx, dao.FilterTag, f.Tag)
+	}
+	if f.NameContains != "" {
+		ctx = dao.WithFilter(ctx, dao.FilterNameContains, f.NameContains)
+	}
+	if f.Status != "" {
+		ctx = dao.WithFilter(ctx, dao.FilterStatus, f.Status)
+	}
+	return ctx
+}
+
+func (e *ToolExecutor) queryResources(ctx context.Context, service, resourceType, region, profile string, includeResolved bool, filter listFilter, limit, offset int) (string, bool) {
 	if service == "" {
 		return "Error: service parameter is required", true
 	}
@@ -281,6 +447,7 @@ func (e *ToolExecutor) queryResources(ctx context.Context, service, resourceType
 	if limit > 2000 {
 		limit = 2000 // max 2000
 	}
+	limit = e.capResults(limit)
 
 	// Validate offset
 	if offset < 0 {
@@ -294,6 +461,10 @@ func (e *ToolExecutor) queryResources(ctx context.Context, service, resourceType
 	if includeResolved {
 		ctx = dao.WithFilter(ctx, "ShowResolved", "true")
 	}
+	if filter.Tag != "" && !strings.Contains(filter.Tag, "=") {
+		return "Error: filter.tag must be key=value", true
+	}
+	ctx = filter.apply(ctx)
 	d, err := e.registry.GetDAO(ctx, service, resourceType)
 	if err != nil {
 		return fmt.Sprintf("Error: %s/%s not found. Use list_resources(service=\"%s\") to see available types.", service, resourceType, service), true
@@ -303,9 +474,13 @@ func (e *ToolExecutor) queryResources(ctx context.Context, service, resourceType
 	if err != nil {
 		return fmt.Sprintf("Error listing %s/%s: %v", service, resourceType, err), true
 	}
+	// DAOs apply what their API supports; the rest is filtered here.
+	resources = slices.DeleteFunc(resources, func(r dao.Resource) bool {
+		return !dao.MatchesListFilters(ctx, r)
+	})
 
 	if len(resources) == 0 {
-		return fmt.Sprintf("No %s/%s resources found in %s", service, resourceType, region), false
+		return fmt.Sprintf("No %s/%s resources found in %s%s", service, resourceType, region, filter), false
 	}
 
 	filterNote := ""
@@ -330,8 +505,8 @@ func (e *ToolExecutor) queryResources(ctx context.Context, service, resourceType
 
 	viewResources := resources[start:end]
 
-	result := fmt.Sprintf("Found %d %s/%s resources in %s%s (showing %d-%d):\n\n",
-		len(resources), service, resourceType, region, filterNote, start+1, end)
+	result := fmt.Sprintf("Found %d %s/%s resources in %s%s%s (showing %d-%d):\n\n",
+		len(resources), service, resourceType, region, filter, filterNote, start+1, end)
 
 	for _, r := range viewResources {
 		result += formatResourceSummary(r)
@@ -388,6 +563,7 @@ func (e *ToolExecutor) tailLogs(ctx context.Context, service, resourceType, regi
 	if limit > 500 {
 		limit = 500
 	}
+	limit = e.capResults(limit)
 
 	if profile != "" {
 		ctx = appaws.WithSelectionOverride(ctx, appconfig.ProfileSelectionFromID(profile))
@@ -400,11 +576,10 @@ func (e *ToolExecutor) tailLogs(ctx context.Context, service, resourceType, regi
 		return fmt.Sprintf("Error extracting log group for %s/%s/%s: %v", service, resourceType, id, err), true
 	}
 
-	cfg, err := appaws.NewConfigWithRegion(ctx, region)
+	cwClient, err := appaws.ClientWithRegion(ctx, region, cloudwatchlogs.NewFromConfig)
 	if err != nil {
 		return fmt.Sprintf("Error creating config for region %s: %v", region, err), true
 	}
-	cwClient := cloudwatchlogs.NewFromConfig(cfg)
 
 	startTime := time.Now().Add(-15 * time.Minute)
 	if since != "" {
@@ -719,6 +894,40 @@ func (e *ToolExecutor) searchDocs(ctx context.Context, query string) string {
 	return sb.String()
 }
 
+func (e *ToolExecutor) validatePolicy(ctx context.Context, path, policyType, region, profile string) (string, bool) {
+	if path == "" {
+		return "Error: path parameter is required", true
+	}
+	pt, err := policy.ParseType(policyType)
+	if err != nil {
+		return fmt.Sprintf("Error: %v", err), true
+	}
+
+	if profile != "" {
+		ctx = appaws.WithSelectionOverride(ctx, appconfig.ProfileSelectionFromID(profile))
+	}
+	if region != "" {
+		ctx = appaws.WithRegionOverride(ctx, region)
+	}
+
+	// Unlike the :validate-policy command, the model picks this path, so keep
+	// it to policy files in the working directory
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Sprintf("Error: %v", err), true
+	}
+	doc, err := policy.ReadFileUnder(wd, path)
+	if err != nil {
+		return fmt.Sprintf("Error: %v", err), true
+	}
+	findings, err := policy.Validate(ctx, doc, pt)
+	if err != nil {
+		log.Warn("validatePolicy failed", "path", path, "error", err)
+		return fmt.Sprintf("Error validating policy: %v", err), true
+	}
+	return policy.Format(path, findings), false
+}
+
 func (e *ToolExecutor) getResource(ctx context.Context, service, resourceType, id string) (dao.Resource, error) {
 	d, err := e.registry.GetDAO(ctx, service, resourceType)
 	if err != nil {
@@ -2,8 +2,12 @@ package ai
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
 )
 
 func TestToolExecutorTools(t *testing.T) {
@@ -16,6 +20,7 @@ func TestToolExecutorTools(t *testing.T) {
 		"get_resource_detail",
 		"tail_logs",
 		"search_aws_docs",
+		"validate_policy",
 	}
 
 	if len(tools) != len(expectedTools) {
@@ -225,6 +230,31 @@ func TestToolExecuteSearchDocsEmptyQuery(t *testing.T) {
 	}
 }
 
+func TestToolExecuteValidatePolicyBadInput(t *testing.T) {
+	executor := &ToolExecutor{registry: nil}
+
+	tests := []struct {
+		input map[string]any
+		want  string
+	}{
+		{map[string]any{}, "path parameter is required"},
+		{map[string]any{"path": "p.json", "policy_type": "trust"}, "unknown policy type"},
+		{map[string]any{"path": t.TempDir() + "/missing.json"}, "read policy"},
+		{map[string]any{"path": "missing.json"}, "read policy"},
+		{map[string]any{"path": "/etc/passwd"}, "not a .json file"},
+	}
+	for _, tt := range tests {
+		result := executor.Execute(context.TODO(), &ToolUseContent{
+			ID:    "test-123",
+			Name:  "validate_policy",
+			Input: tt.input,
+		})
+		if !result.IsError || !strings.Contains(result.Content, tt.want) {
+			t.Errorf("Execute(%v) = %q (IsError %v), want error containing %q", tt.input, result.Content, result.IsError, tt.want)
+		}
+	}
+}
+
 func TestExtractLogGroupNameFromArn(t *testing.T) {
 	tests := []struct {
 		arn      string
@@ -324,3 +354,87 @@ func (m *mockResource) GetName() string            { return m.name }
 func (m *mockResource) GetARN() string             { return m.arn }
 func (m *mockResource) GetTags() map[string]string { return m.tags }
 func (m *mockResource) Raw() any                   { return m.raw }
+
+type filterTestResource struct {
+	dao.BaseResource
+	state string
+}
+
+func (r *filterTestResource) State() string { return r.state }
+
+// filterTestDAO lists fixed resources and applies only the tag filter, as a
+// DAO whose API filters by tag but not by name or status would.
+type filterTestDAO struct {
+	dao.BaseDAO
+	resources []dao.Resource
+}
+
+func (d *filterTestDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	key, value, ok := dao.TagFilterFromContext(ctx)
+	var out []dao.Resource
+	for _, r := range d.resources {
+		if !ok || r.GetTags()[key] == value {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (d *filterTestDAO) Get(context.Context, string) (dao.Resource, error) { return nil, nil }
+func (d *filterTestDAO) Delete(context.Context, string) error              { return nil }
+
+func TestToolExecuteQueryResourcesFilter(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("filtertest", "widgets", registry.Entry{
+		DAOFactory: func(context.Context) (dao.DAO, error) {
+			return &filterTestDAO{
+				BaseDAO: dao.NewBaseDAO("filtertest", "widgets"),
+				resources: []dao.Resource{
+					&filterTestResource{BaseResource: dao.BaseResource{ID: "w-1", Name: "web-a", Tags: map[string]string{"Env": "prod"}}, state: "running"},
+					&filterTestResource{BaseResource: dao.BaseResource{ID: "w-2", Name: "web-b", Tags: map[string]string{"Env": "prod"}}, state: "stopped"},
+					&filterTestResource{BaseResource: dao.BaseResource{ID: "w-3", Name: "db", Tags: map[string]string{"Env": "dev"}}, state: "running"},
+				},
+			}, nil
+		},
+	})
+	executor := &ToolExecutor{registry: reg}
+
+	tests := []struct {
+		name    string
+		filter  map[string]any
+		want    []string
+		wantErr string
+	}{
+		{"no filter", nil, []string{"w-1", "w-2", "w-3"}, ""},
+		{"tag", map[string]any{"tag": "Env=prod"}, []string{"w-1", "w-2"}, ""},
+		{"tag and status", map[string]any{"tag": "Env=prod", "status": "Running"}, []string{"w-1"}, ""},
+		{"name contains", map[string]any{"name_contains": "WEB"}, []string{"w-1", "w-2"}, ""},
+		{"no match", map[string]any{"status": "terminated"}, nil, ""},
+		{"bad tag", map[string]any{"tag": "Env"}, nil, "filter.tag must be key=value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := map[string]any{"service": "filtertest", "resource_type": "widgets", "region": "us-east-1"}
+			if tt.filter != nil {
+				input["filter"] = tt.filter
+			}
+			result := executor.Execute(context.Background(), &ToolUseContent{ID: "t", Name: "query_resources", Input: input})
+
+			if tt.wantErr != "" {
+				if !result.IsError || !strings.Contains(result.Content, tt.wantErr) {
+					t.Fatalf("result = %+v, want error %q", result, tt.wantErr)
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("unexpected error: %s", result.Content)
+			}
+			for _, id := range []string{"w-1", "w-2", "w-3"} {
+				if got, want := strings.Contains(result.Content, id+", Name"), slices.Contains(tt.want, id); got != want {
+					t.Errorf("%s listed = %v, want %v\n%s", id, got, want, result.Content)
+				}
+			}
+		})
+	}
+}
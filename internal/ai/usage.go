@@ -0,0 +1,158 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/config"
+)
+
+const usageFile = "chat/usage.json"
+
+// Usage counts the tokens billed for Bedrock calls.
+type Usage struct {
+	InputTokens  int `json:"input_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+// Add accumulates o into u.
+func (u *Usage) Add(o Usage) {
+	u.InputTokens += o.InputTokens
+	u.OutputTokens += o.OutputTokens
+}
+
+// String formats the counts compactly, e.g. "12.3k in · 850 out".
+func (u Usage) String() string {
+	return formatTokens(u.InputTokens) + " in · " + formatTokens(u.OutputTokens) + " out"
+}
+
+func formatTokens(n int) string {
+	switch {
+	case n >= 1_000_000:
+		return fmt.Sprintf("%.1fM", float64(n)/1_000_000)
+	case n >= 1_000:
+		return fmt.Sprintf("%.1fk", float64(n)/1_000)
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+// modelPrices are on-demand Bedrock prices in USD per million input and
+// output tokens, matched against the model ID in order (more specific IDs
+// first). Cross-region inference profiles bill the same.
+var modelPrices = []struct {
+	match         string
+	input, output float64
+}{
+	{"claude-opus-4-5", 5, 25},
+	{"claude-opus-4", 15, 75},
+	{"claude-sonnet-4", 3, 15},
+	{"claude-haiku-4-5", 1, 5},
+	{"claude-3-7-sonnet", 3, 15},
+	{"claude-3-5-sonnet", 3, 15},
+	{"claude-3-5-haiku", 0.8, 4},
+	{"claude-3-haiku", 0.25, 1.25},
+}
+
+// ModelPrice returns the USD price per million input and output tokens for
+// model: ai.input_price_per_mtok / ai.output_price_per_mtok from config when
+// set, otherwise the built-in on-demand price. ok is false when neither is
+// known.
+func ModelPrice(model string) (input, output float64, ok bool) {
+	if input, output, ok := config.File().GetAIPricing(); ok {
+		return input, output, true
+	}
+	for _, p := range modelPrices {
+		if strings.Contains(model, p.match) {
+			return p.input, p.output, true
+		}
+	}
+	return 0, 0, false
+}
+
+// Cost estimates the USD cost of u on model; 0 when its price is unknown.
+func (u Usage) Cost(model string) float64 {
+	input, output, ok := ModelPrice(model)
+	if !ok {
+		return 0
+	}
+	return (float64(u.InputTokens)*input + float64(u.OutputTokens)*output) / 1_000_000
+}
+
+// FormatCost formats an estimated USD amount, keeping cents visible for
+// small amounts.
+func FormatCost(usd float64) string {
+	if usd < 1 {
+		return fmt.Sprintf("$%.3f", usd)
+	}
+	return fmt.Sprintf("$%.2f", usd)
+}
+
+// MonthlyUsage is the month-to-date spend checked against ai.monthly_budget.
+type MonthlyUsage struct {
+	Month string  `json:"month"` // YYYY-MM, local time
+	Usage Usage   `json:"usage"`
+	Cost  float64 `json:"cost"`
+}
+
+func usagePath() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, usageFile), nil
+}
+
+// LoadMonthlyUsage returns the spend recorded for the month of now. A
+// missing file or a file from an earlier month is zero usage.
+func LoadMonthlyUsage(now time.Time) (MonthlyUsage, error) {
+	month := now.Format("2006-01")
+	empty := MonthlyUsage{Month: month}
+
+	path, err := usagePath()
+	if err != nil {
+		return empty, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return empty, nil
+		}
+		return empty, err
+	}
+	var m MonthlyUsage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return empty, err
+	}
+	if m.Month != month {
+		return empty, nil
+	}
+	return m, nil
+}
+
+// RecordMonthlyUsage adds u and its cost to the month of now and returns
+// the new month-to-date total.
+func RecordMonthlyUsage(u Usage, cost float64, now time.Time) (MonthlyUsage, error) {
+	m, err := LoadMonthlyUsage(now)
+	if err != nil {
+		return m, err
+	}
+	m.Usage.Add(u)
+	m.Cost += cost
+
+	path, err := usagePath()
+	if err != nil {
+		return m, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return m, err
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return m, err
+	}
+	return m, os.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,79 @@
+package ai
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUsageString(t *testing.T) {
+	tests := []struct {
+		usage Usage
+		want  string
+	}{
+		{Usage{}, "0 in · 0 out"},
+		{Usage{InputTokens: 850, OutputTokens: 12}, "850 in · 12 out"},
+		{Usage{InputTokens: 12_345, OutputTokens: 2_500_000}, "12.3k in · 2.5M out"},
+	}
+	for _, tt := range tests {
+		if got := tt.usage.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.usage, got, tt.want)
+		}
+	}
+}
+
+func TestUsageCost(t *testing.T) {
+	usage := Usage{InputTokens: 1_000_000, OutputTokens: 100_000}
+
+	tests := []struct {
+		model string
+		want  float64
+	}{
+		{"global.anthropic.claude-haiku-4-5-20251001-v1:0", 1 + 0.5},
+		{"us.anthropic.claude-sonnet-4-5-20250929-v1:0", 3 + 1.5},
+		{"anthropic.claude-opus-4-1-20250805-v1:0", 15 + 7.5},
+		{"anthropic.claude-opus-4-5-20251101-v1:0", 5 + 2.5},
+		{"amazon.nova-pro-v1:0", 0},
+	}
+	for _, tt := range tests {
+		if got := usage.Cost(tt.model); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Cost(%q) = %v, want %v", tt.model, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCost(t *testing.T) {
+	if got := FormatCost(0.0123); got != "$0.012" {
+		t.Errorf("FormatCost(0.0123) = %q", got)
+	}
+	if got := FormatCost(12.5); got != "$12.50" {
+		t.Errorf("FormatCost(12.5) = %q", got)
+	}
+}
+
+func TestMonthlyUsage(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	oct := time.Date(2026, 10, 3, 12, 0, 0, 0, time.Local)
+
+	m, err := LoadMonthlyUsage(oct)
+	if err != nil || m.Month != "2026-10" || m.Cost != 0 {
+		t.Fatalf("LoadMonthlyUsage() with no file = %+v, %v", m, err)
+	}
+
+	if _, err := RecordMonthlyUsage(Usage{InputTokens: 100, OutputTokens: 10}, 0.5, oct); err != nil {
+		t.Fatalf("RecordMonthlyUsage() error = %v", err)
+	}
+	m, err = RecordMonthlyUsage(Usage{InputTokens: 50, OutputTokens: 5}, 0.25, oct.AddDate(0, 0, 10))
+	if err != nil {
+		t.Fatalf("RecordMonthlyUsage() error = %v", err)
+	}
+	if m.Usage != (Usage{InputTokens: 150, OutputTokens: 15}) || m.Cost != 0.75 {
+		t.Errorf("month-to-date = %+v, want 150/15 tokens and $0.75", m)
+	}
+
+	// A new month starts from zero
+	m, err = LoadMonthlyUsage(oct.AddDate(0, 1, 0))
+	if err != nil || m.Month != "2026-11" || m.Cost != 0 || m.Usage != (Usage{}) {
+		t.Errorf("LoadMonthlyUsage() next month = %+v, %v", m, err)
+	}
+}
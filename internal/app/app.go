@@ -17,6 +17,7 @@ import (
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/history"
 	"github.com/clawscli/claws/internal/log"
 	navmsg "github.com/clawscli/claws/internal/msg"
 	"github.com/clawscli/claws/internal/registry"
@@ -42,6 +43,12 @@ type awsContextReadyMsg struct {
 	err error
 }
 
+// accountAliasesMsg carries the IAM account aliases of the selected profiles
+type accountAliasesMsg struct {
+	refreshID uint64
+	aliases   map[string]string
+}
+
 // profileRefreshDoneMsg is sent when async profile refresh completes
 type profileRefreshDoneMsg struct {
 	refreshID  uint64
@@ -89,6 +96,8 @@ type App struct {
 
 	currentView view.View
 	viewStack   []view.View
+	// forwardStack holds views left by going back, until a new navigation
+	forwardStack []view.View
 
 	commandInput *view.CommandInput
 	commandMode  bool
@@ -106,6 +115,12 @@ type App struct {
 	profileRefreshing   bool
 	profileRefreshError error
 
+	throttled []aws.ThrottleStatus // services AWS throttled recently
+
+	credCheckID  uint64
+	credExpiry   aws.CredentialExpiry
+	credPrompted time.Time // expiry the SSO login prompt was last shown for
+
 	modal         *view.Modal
 	modalStack    []*view.Modal
 	modalRenderer *view.ModalRenderer
@@ -153,7 +168,7 @@ func (a *App) Init() tea.Cmd {
 		return awsContextReadyMsg{err: err}
 	}
 
-	cmds := []tea.Cmd{a.currentView.Init(), initAWSCmd}
+	cmds := []tea.Cmd{a.currentView.Init(), initAWSCmd, a.checkCredentials(), throttleTick()}
 
 	if a.startupPath != nil && a.startupPath.ResourceID != "" {
 		cmds = append(cmds, a.fetchStartupResource)
@@ -174,6 +189,26 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Credential checks and prompts run regardless of modals or command mode
+	switch msg := msg.(type) {
+	case credentialExpiryMsg:
+		return a.handleCredentialExpiry(msg)
+	case credentialTickMsg:
+		return a.handleCredentialTick(msg)
+	case throttleTickMsg:
+		a.throttled = aws.Throttled()
+		return a, throttleTick()
+	case mfaPromptMsg:
+		return a.handleMFAPrompt(msg)
+	case view.MFAAnsweredMsg:
+		if msg.Cancelled {
+			return a, nil
+		}
+		// Requests that timed out waiting for the code left the account
+		// context incomplete
+		return a, a.refreshProfileContext()
+	}
+
 	if a.modal != nil {
 		return a.handleModalUpdate(msg)
 	}
@@ -186,17 +221,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if !a.commandInput.IsActive() {
 				a.commandMode = false
 			}
-			if nav != nil {
-				a.pushOrClearStack(nav.ClearStack)
-				a.currentView = nav.View
-				cmds := []tea.Cmd{
-					cmd,
-					a.currentView.Init(),
-					a.currentView.SetSize(a.width, a.height-2),
-				}
-				return a, tea.Batch(cmds...)
-			}
-			return a, cmd
+			return a.applyCommandResult(cmd, nav)
 		}
 	}
 
@@ -225,6 +250,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		for _, v := range a.viewStack {
 			v.Update(msg)
 		}
+		for _, v := range a.forwardStack {
+			v.Update(msg)
+		}
 		return a, nil
 
 	case view.CompactHeaderChangedMsg:
@@ -234,6 +262,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		for _, v := range a.viewStack {
 			v.Update(msg)
 		}
+		for _, v := range a.forwardStack {
+			v.Update(msg)
+		}
 		return a, nil
 
 	case view.ThemeChangeMsg:
@@ -257,6 +288,35 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			tea.Tick(flashDuration, func(t time.Time) tea.Msg { return clearFlashMsg{} }),
 		)
 
+	case view.FavoriteToggledMsg:
+		if msg.Pinned {
+			a.clipboardFlash = "Pinned " + msg.Label + " to favorites"
+		} else {
+			a.clipboardFlash = "Unpinned " + msg.Label
+		}
+		a.clipboardWarning = false
+		return a, tea.Tick(flashDuration, func(t time.Time) tea.Msg {
+			return clearFlashMsg{}
+		})
+
+	case view.AIPinToggledMsg:
+		if msg.Pinned {
+			a.clipboardFlash = fmt.Sprintf("Pinned %s to AI context (%d pinned)", msg.Label, msg.Count)
+		} else {
+			a.clipboardFlash = "Unpinned " + msg.Label + " from AI context"
+		}
+		a.clipboardWarning = false
+		return a, tea.Tick(flashDuration, func(t time.Time) tea.Msg {
+			return clearFlashMsg{}
+		})
+
+	case view.HistoryClearedMsg:
+		a.clipboardFlash = "History cleared"
+		a.clipboardWarning = false
+		return a, tea.Tick(flashDuration, func(t time.Time) tea.Msg {
+			return clearFlashMsg{}
+		})
+
 	case view.PersistenceChangeMsg:
 		if err := config.File().SavePersistence(msg.Enabled); err != nil {
 			a.err = fmt.Errorf("failed to save autosave setting: %w", err)
@@ -278,6 +338,11 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return a, cmd
 			}
 		}
+		if msg.Button == tea.MouseForward {
+			if cmd := a.navigateForward(); cmd != nil {
+				return a, cmd
+			}
+		}
 
 	case tea.KeyPressMsg:
 		// Handle back navigation (esc or backspace)
@@ -317,22 +382,29 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return a, tea.Quit
 
 		case key.Matches(msg, a.keys.Help):
-			helpView := view.NewHelpView()
+			helpView := view.NewHelpView(a.currentView)
 			a.modal = &view.Modal{Content: helpView, Width: view.ModalWidthHelp}
 			return a, a.modal.SetSize(a.width, a.height)
 
 		case key.Matches(msg, a.keys.Command):
 			a.commandMode = true
-			// Set completion providers if current view is a ResourceBrowser
-			if rb, ok := a.currentView.(*view.ResourceBrowser); ok {
-				a.commandInput.SetTagProvider(rb)
-				a.commandInput.SetDiffProvider(rb)
-			} else {
-				a.commandInput.SetTagProvider(nil)
-				a.commandInput.SetDiffProvider(nil)
-			}
+			a.setCommandProviders()
 			return a, a.commandInput.Activate()
 
+		case key.Matches(msg, a.keys.Forward):
+			if cmd := a.navigateForward(); cmd != nil {
+				return a, cmd
+			}
+			return a, nil
+
+		case key.Matches(msg, a.keys.Palette):
+			palette := view.NewCommandPalette(a.ctx, a.registry)
+			a.modal = &view.Modal{Content: palette, Width: view.ModalWidthCommandPalette}
+			return a, tea.Batch(
+				palette.Init(),
+				a.modal.SetSize(a.width, a.height),
+			)
+
 		case key.Matches(msg, a.keys.Region):
 			regionSelector := view.NewRegionSelector(a.ctx)
 			a.modal = &view.Modal{Content: regionSelector, Width: view.ModalWidthRegion}
@@ -378,6 +450,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case view.ClearHistoryMsg:
 		log.Debug("clearing navigation history", "stackDepth", len(a.viewStack))
 		a.viewStack = nil
+		a.forwardStack = nil
 		return a, nil
 
 	case view.ErrorMsg:
@@ -422,6 +495,13 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				config.Global().AddWarning("AWS init failed: " + errStr)
 				a.showWarnings = true
 			}
+			return a, nil
+		}
+		return a, a.fetchAccountAliases()
+
+	case accountAliasesMsg:
+		if msg.refreshID == a.profileRefreshID {
+			config.Global().SetAccountAliases(msg.aliases)
 		}
 		return a, nil
 
@@ -449,7 +529,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				config.Global().SetAccountIDForProfile(profileID, accountID)
 			}
 		}
-		return a, nil
+		return a, a.fetchAccountAliases()
 
 	case startupResourceMsg:
 		if a.startupPath == nil {
@@ -479,7 +559,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		detailView := view.NewDetailView(a.ctx, msg.resource, renderer, a.startupPath.Service, a.startupPath.ResourceType, a.registry, d)
 		a.viewStack = append(a.viewStack, a.currentView)
 		a.currentView = detailView
-		return a, tea.Batch(detailView.Init(), detailView.SetSize(a.width, a.height-2))
+		return a, tea.Batch(detailView.Init(), detailView.SetSize(a.width, a.height-2), a.recordHistory())
 
 	case navmsg.RegionChangedMsg:
 		return a.handleRegionChanged(msg)
@@ -487,6 +567,9 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case navmsg.ProfilesChangedMsg:
 		return a.handleProfilesChanged(msg)
 
+	case navmsg.ShowResourcesMsg:
+		return a.handleShowResources(msg)
+
 	case view.SortMsg:
 		// Delegate sort command to current view
 		if a.currentView != nil {
@@ -511,9 +594,14 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return a, nil
 }
 
-// newAltScreenView creates a View with AltScreen and mouse support enabled
+// newAltScreenView creates a View with AltScreen and mouse support enabled.
+// Accessibility mode renders inline without mouse capture instead, so screen
+// readers can follow the output and the terminal keeps native selection.
 func newAltScreenView(content string) tea.View {
 	v := tea.NewView(content)
+	if config.Global().Accessible() {
+		return v
+	}
 	v.AltScreen = true
 	v.MouseMode = tea.MouseModeAllMotion // AllMotion for hover tracking
 	return v
@@ -545,15 +633,31 @@ func (a *App) View() tea.View {
 			statusContent = a.currentView.StatusLine()
 		}
 
-		if config.Global().ReadOnly() {
-			roIndicator := a.styles.readOnly.Render("READ-ONLY")
-			statusContent = roIndicator + " " + statusContent
+		if tmpl := config.File().StatusBarTemplate(); tmpl != "" {
+			statusContent = a.renderStatusTemplate(tmpl, statusContent)
+		} else {
+			if config.Global().ReadOnly() {
+				roIndicator := a.styles.readOnly.Render("READ-ONLY")
+				statusContent = roIndicator + " " + statusContent
+			}
+
+			if cred := a.credentialStatus(); cred != "" {
+				statusContent = cred + " • " + statusContent
+			}
+
+			if badges := profileBadges(); badges != "" {
+				statusContent = badges + " " + statusContent
+			}
 		}
 
 		if a.awsInitializing {
 			statusContent = ui.DimStyle().Render("AWS initializing...") + " • " + statusContent
 		}
 
+		if throttled := a.throttleStatus(); throttled != "" {
+			statusContent = throttled + " • " + statusContent
+		}
+
 		if a.profileRefreshError != nil {
 			statusContent = ui.WarningStyle().Render("⚠ Profile error") + " • " + statusContent
 		} else if a.profileRefreshing {
@@ -563,13 +667,16 @@ func (a *App) View() tea.View {
 
 	status := a.styles.status.Render(statusContent)
 
-	// Fix content height to keep status line at bottom regardless of content size.
-	contentHeight := a.height - 1
-	if contentHeight < 1 {
-		contentHeight = 1
+	mainView := content + "\n" + status
+	if !config.Global().Accessible() {
+		// Fix content height to keep status line at bottom regardless of content size.
+		// Accessibility mode skips the filler lines, which screen readers announce.
+		contentHeight := a.height - 1
+		if contentHeight < 1 {
+			contentHeight = 1
+		}
+		mainView = ui.NoStyle().Height(contentHeight).Render(content) + "\n" + status
 	}
-	paddedContent := ui.NoStyle().Height(contentHeight).Render(content)
-	mainView := paddedContent + "\n" + status
 
 	if a.modal != nil {
 		return newAltScreenView(a.modalRenderer.Render(a.modal, mainView, a.width, a.height))
@@ -617,6 +724,15 @@ func (a *App) handleModalUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.clearModalState()
 		return a.handleNavigate(msg)
 
+	case view.PaletteSelectMsg:
+		a.clearModalState()
+		if msg.Nav != nil {
+			return a.handleNavigate(*msg.Nav)
+		}
+		a.setCommandProviders()
+		cmd, nav := a.commandInput.Run(msg.Command)
+		return a.applyCommandResult(cmd, nav)
+
 	case navmsg.RegionChangedMsg:
 		a.clearModalState()
 		return a.handleRegionChanged(msg)
@@ -625,13 +741,20 @@ func (a *App) handleModalUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.clearModalState()
 		return a.handleProfilesChanged(msg)
 
+	case navmsg.ShowResourcesMsg:
+		a.clearModalState()
+		return a.handleShowResources(msg)
+
 	case tea.KeyPressMsg:
+		if ic, ok := a.modal.Content.(view.InputCapture); ok && ic.HasActiveInput() {
+			break
+		}
 		if view.IsEscKey(msg) || msg.Code == tea.KeyBackspace || msg.String() == "q" || msg.String() == "ctrl+c" {
-			if ic, ok := a.modal.Content.(view.InputCapture); ok && ic.HasActiveInput() {
-				break
-			}
 			return a.popModal()
 		}
+		if _, ok := a.modal.Content.(view.KeyHelper); ok && key.Matches(msg, a.keys.Help) {
+			return a.showModal(&view.Modal{Content: view.NewHelpView(a.modal.Content), Width: view.ModalWidthHelp})
+		}
 
 	case tea.WindowSizeMsg:
 		a.width = msg.Width
@@ -650,6 +773,32 @@ func (a *App) handleModalUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return a, cmd
 }
 
+// setCommandProviders sets completion providers if current view is a ResourceBrowser
+func (a *App) setCommandProviders() {
+	if rb, ok := a.currentView.(*view.ResourceBrowser); ok {
+		a.commandInput.SetTagProvider(rb)
+		a.commandInput.SetDiffProvider(rb)
+	} else {
+		a.commandInput.SetTagProvider(nil)
+		a.commandInput.SetDiffProvider(nil)
+	}
+}
+
+// applyCommandResult navigates to the view produced by a command, if any
+func (a *App) applyCommandResult(cmd tea.Cmd, nav *view.NavigateMsg) (tea.Model, tea.Cmd) {
+	if nav == nil {
+		return a, cmd
+	}
+	a.pushOrClearStack(nav.ClearStack)
+	a.currentView = nav.View
+	return a, tea.Batch(
+		cmd,
+		a.currentView.Init(),
+		a.currentView.SetSize(a.width, a.height-2),
+		a.recordHistory(),
+	)
+}
+
 func (a *App) popModal() (tea.Model, tea.Cmd) {
 	if len(a.modalStack) > 0 {
 		a.modal = a.modalStack[len(a.modalStack)-1]
@@ -680,9 +829,17 @@ func (a *App) handleNavigate(msg view.NavigateMsg) (tea.Model, tea.Cmd) {
 	return a, tea.Batch(
 		a.currentView.Init(),
 		a.currentView.SetSize(a.width, a.height-2),
+		a.recordHistory(),
 	)
 }
 
+// handleShowResources opens the resource list an action asked for as its
+// follow-up.
+func (a *App) handleShowResources(msg navmsg.ShowResourcesMsg) (tea.Model, tea.Cmd) {
+	browser := view.NewResourceBrowserWithFilter(a.ctx, a.registry, msg.Service, msg.ResourceType, msg.FilterField, msg.FilterValue)
+	return a.handleNavigate(view.NavigateMsg{View: browser})
+}
+
 // popView pops the top view from the view stack.
 // Returns nil if the stack is empty.
 func (a *App) popView() view.View {
@@ -702,18 +859,58 @@ func (a *App) navigateBack() tea.Cmd {
 	if v == nil {
 		return nil
 	}
+	if a.currentView != nil {
+		a.forwardStack = append(a.forwardStack, a.currentView)
+	}
 	a.currentView = v
 	log.Debug("navigating back", "view", a.currentView.StatusLine(), "stackDepth", len(a.viewStack))
 	return tea.Batch(
 		a.currentView.Init(),
 		a.currentView.SetSize(a.width, a.height-2),
+		a.recordHistory(),
 	)
 }
 
+// navigateForward re-opens the view most recently left by navigateBack.
+// Returns nil if there is nothing to go forward to (no-op).
+func (a *App) navigateForward() tea.Cmd {
+	if len(a.forwardStack) == 0 {
+		return nil
+	}
+	v := a.forwardStack[len(a.forwardStack)-1]
+	a.forwardStack = a.forwardStack[:len(a.forwardStack)-1]
+	if a.currentView != nil {
+		a.viewStack = append(a.viewStack, a.currentView)
+	}
+	a.currentView = v
+	log.Debug("navigating forward", "view", a.currentView.StatusLine(), "forwardDepth", len(a.forwardStack))
+	return tea.Batch(
+		a.currentView.Init(),
+		a.currentView.SetSize(a.width, a.height-2),
+		a.recordHistory(),
+	)
+}
+
+// recordHistory persists the current view to :history in the background.
+func (a *App) recordHistory() tea.Cmd {
+	entry, ok := view.HistoryEntryFor(a.registry, a.currentView)
+	if !ok {
+		return nil
+	}
+	limit := config.File().HistorySize()
+	return func() tea.Msg {
+		if err := history.Record(entry, limit); err != nil {
+			log.Warn("failed to record history", "error", err)
+		}
+		return nil
+	}
+}
+
 // pushOrClearStack either clears the view stack (for home navigation) or
 // pushes the current view onto the stack (for drill-down navigation).
-// Enforces max stack size from config.
+// Enforces max stack size from config. Any forward history is dropped.
 func (a *App) pushOrClearStack(clearStack bool) {
+	a.forwardStack = nil
 	if clearStack {
 		a.viewStack = nil
 	} else if a.currentView != nil {
@@ -754,6 +951,10 @@ func (a *App) handleRegionChanged(msg navmsg.RegionChangedMsg) (tea.Model, tea.C
 
 func (a *App) handleProfilesChanged(msg navmsg.ProfilesChangedMsg) (tea.Model, tea.Cmd) {
 	log.Info("profiles changed", "count", len(msg.Selections))
+	// Re-resolve credentials, e.g. after an SSO login re-applies the selection,
+	// and ask for a fresh MFA code rather than reusing the old role session
+	aws.ResetClients()
+	aws.ResetMFASessions()
 	if config.File().PersistenceEnabled() {
 		profileIDs := make([]string, len(msg.Selections))
 		for i, sel := range msg.Selections {
@@ -763,11 +964,19 @@ func (a *App) handleProfilesChanged(msg navmsg.ProfilesChangedMsg) (tea.Model, t
 			log.Warn("failed to persist profiles", "error", err)
 		}
 	}
+	_, viewCmd := a.refreshCurrentView()
+	return a, tea.Batch(a.refreshProfileContext(), viewCmd, a.checkCredentials())
+}
+
+// refreshProfileContext re-fetches region and account IDs for the current
+// profile selection, superseding any refresh in flight.
+func (a *App) refreshProfileContext() tea.Cmd {
 	a.profileRefreshID++
 	a.profileRefreshing = true
 	a.profileRefreshError = nil
+	config.Global().SetAccountAliases(nil)
 	refreshID := a.profileRefreshID
-	refreshCmd := func() tea.Msg {
+	return func() tea.Msg {
 		ctx, cancel := context.WithTimeout(a.ctx, config.File().AWSInitTimeout())
 		defer cancel()
 		region, accountIDs, err := aws.RefreshContextData(ctx)
@@ -778,9 +987,16 @@ func (a *App) handleProfilesChanged(msg navmsg.ProfilesChangedMsg) (tea.Model, t
 			err:        err,
 		}
 	}
+}
 
-	_, viewCmd := a.refreshCurrentView()
-	return a, tea.Batch(refreshCmd, viewCmd)
+// fetchAccountAliases looks up the IAM account alias of each selected profile
+func (a *App) fetchAccountAliases() tea.Cmd {
+	refreshID := a.profileRefreshID
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(a.ctx, config.File().AWSInitTimeout())
+		defer cancel()
+		return accountAliasesMsg{refreshID: refreshID, aliases: aws.FetchAccountAliases(ctx)}
+	}
 }
 
 // refreshCurrentView triggers a refresh on the current view if it's refreshable.
@@ -807,6 +1023,8 @@ type keyMap struct {
 	Back          key.Binding
 	Filter        key.Binding
 	Command       key.Binding
+	Palette       key.Binding
+	Forward       key.Binding
 	Region        key.Binding
 	Profile       key.Binding
 	AI            key.Binding
@@ -841,6 +1059,14 @@ func defaultKeyMap() keyMap {
 			key.WithKeys(":"),
 			key.WithHelp(":", "command"),
 		),
+		Forward: key.NewBinding(
+			key.WithKeys("ctrl+f"),
+			key.WithHelp("ctrl+f", "forward"),
+		),
+		Palette: key.NewBinding(
+			key.WithKeys("ctrl+p"),
+			key.WithHelp("ctrl+p", "go to"),
+		),
 		Region: key.NewBinding(
 			key.WithKeys("R"),
 			key.WithHelp("R", "region"),
@@ -902,6 +1128,13 @@ func (a *App) resolveStartupView(viewName string) view.View {
 }
 
 func (a *App) buildAIContext() *ai.Context {
+	ctx := a.viewAIContext()
+	ctx.Pinned = ai.Pinned()
+	return ctx
+}
+
+// viewAIContext describes the current view for the AI chat.
+func (a *App) viewAIContext() *ai.Context {
 	regions := config.Global().Regions()
 	selections := config.Global().Selections()
 	var profiles []string
@@ -3,10 +3,14 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
 	navmsg "github.com/clawscli/claws/internal/msg"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/view"
@@ -331,6 +335,61 @@ func TestProfileRefreshError_ClearedOnNewRefresh(t *testing.T) {
 	}
 }
 
+func TestProfilesChanged_ResetsMFASession(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config")
+	credsPath := filepath.Join(dir, "credentials")
+	awsConfig := `[profile base]
+region = us-east-1
+
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/Admin
+source_profile = base
+mfa_serial = arn:aws:iam::123456789012:mfa/alice
+region = us-east-1
+`
+	creds := `[base]
+aws_access_key_id = AKIAEXAMPLE
+aws_secret_access_key = secret
+`
+	if err := os.WriteFile(configPath, []byte(awsConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(credsPath, []byte(creds), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONFIG_FILE", configPath)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", credsPath)
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	prev := config.Global().Selections()
+	t.Cleanup(func() {
+		config.Global().SetSelections(prev)
+		aws.ResetClients()
+		aws.ResetMFASessions()
+	})
+	config.Global().SetSelection(config.NamedProfile("admin"))
+	aws.ResetClients()
+	aws.ResetMFASessions()
+
+	before, err := aws.NewConfigWithRegion(context.Background(), "us-east-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := newTestApp(t)
+	app.currentView = &MockView{name: "Dashboard"}
+	app.Update(navmsg.ProfilesChangedMsg{Selections: []config.ProfileSelection{config.NamedProfile("admin")}})
+
+	after, err := aws.NewConfigWithRegion(context.Background(), "us-east-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.Credentials == after.Credentials {
+		t.Error("profile change should drop the cached MFA session so the next request prompts again")
+	}
+}
+
 func TestProfileRefresh_RapidChangesOnlyLatestHonored(t *testing.T) {
 	app := newTestApp(t)
 	app.currentView = &MockView{name: "Dashboard"}
@@ -436,6 +495,30 @@ func TestModalNavigateClosesModal(t *testing.T) {
 	}
 }
 
+func TestModalShowResourcesOpensBrowser(t *testing.T) {
+	app := newTestApp(t)
+	app.currentView = &MockView{name: "DetailView"}
+	app.viewStack = nil
+	app.modal = &view.Modal{Content: &MockView{name: "ActionMenu"}}
+
+	app.Update(navmsg.ShowResourcesMsg{
+		Service:      "ssm",
+		ResourceType: "command-invocations",
+		FilterField:  "CommandId",
+		FilterValue:  "cmd-1",
+	})
+
+	if app.modal != nil {
+		t.Error("Expected modal to be closed after ShowResourcesMsg")
+	}
+	if _, ok := app.currentView.(*view.ResourceBrowser); !ok {
+		t.Errorf("Expected currentView to be a ResourceBrowser, got %T", app.currentView)
+	}
+	if len(app.viewStack) != 1 {
+		t.Errorf("Expected viewStack length 1, got %d", len(app.viewStack))
+	}
+}
+
 func TestKeyOpensModal(t *testing.T) {
 	tests := []struct {
 		name string
@@ -742,6 +825,37 @@ func TestNavigateBackWithEmptyStack(t *testing.T) {
 	}
 }
 
+func TestNavigateForwardAfterBack(t *testing.T) {
+	app := newTestApp(t)
+	list := &MockView{name: "List"}
+	detail := &MockView{name: "Detail"}
+	app.currentView = detail
+	app.viewStack = []view.View{list}
+
+	app.navigateForward()
+	if app.currentView != detail {
+		t.Error("Expected currentView unchanged with nothing to go forward to")
+	}
+
+	app.navigateBack()
+	if app.currentView != list || len(app.forwardStack) != 1 {
+		t.Fatalf("after back: current=%s forward=%d", app.currentView.StatusLine(), len(app.forwardStack))
+	}
+
+	app.navigateForward()
+	if app.currentView != detail || len(app.viewStack) != 1 || len(app.forwardStack) != 0 {
+		t.Errorf("after forward: current=%s back=%d forward=%d",
+			app.currentView.StatusLine(), len(app.viewStack), len(app.forwardStack))
+	}
+
+	// A new navigation drops forward history
+	app.navigateBack()
+	app.handleNavigate(view.NavigateMsg{View: &MockView{name: "Other"}})
+	if len(app.forwardStack) != 0 {
+		t.Errorf("forward stack = %d after new navigation, want 0", len(app.forwardStack))
+	}
+}
+
 func TestRefreshCurrentViewWithNilView(t *testing.T) {
 	app := newTestApp(t)
 	app.currentView = nil
@@ -775,3 +889,24 @@ func TestRefreshCurrentViewKeepsNonRefreshableViewUnchanged(t *testing.T) {
 		t.Errorf("Expected currentView unchanged, got %T", app.currentView)
 	}
 }
+
+func TestViewAccessibleMode(t *testing.T) {
+	app := newTestApp(t)
+	app.currentView = &MockView{name: "Dashboard"}
+
+	v := app.View()
+	if !v.AltScreen || v.MouseMode == tea.MouseModeNone {
+		t.Error("default mode should use alt screen and mouse capture")
+	}
+
+	config.Global().SetAccessible(true)
+	t.Cleanup(func() { config.Global().SetAccessible(false) })
+
+	v = app.View()
+	if v.AltScreen {
+		t.Error("accessible mode should render inline, not in the alt screen")
+	}
+	if v.MouseMode != tea.MouseModeNone {
+		t.Errorf("accessible mode MouseMode = %v, want none", v.MouseMode)
+	}
+}
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/ui"
+	"github.com/clawscli/claws/internal/view"
+)
+
+const (
+	// credentialTickInterval is how often the countdown is refreshed
+	credentialTickInterval = time.Minute
+	// credentialWarnThreshold highlights the countdown as expiry approaches
+	credentialWarnThreshold = 15 * time.Minute
+	// credentialPromptThreshold is when SSO re-login is offered
+	credentialPromptThreshold = 5 * time.Minute
+)
+
+// credentialExpiryMsg carries the result of a credential expiry check
+type credentialExpiryMsg struct {
+	checkID uint64
+	expiry  aws.CredentialExpiry
+	err     error
+}
+
+// credentialTickMsg triggers a countdown refresh
+type credentialTickMsg struct {
+	checkID uint64
+}
+
+// checkCredentials starts a new expiry check, superseding any pending one
+func (a *App) checkCredentials() tea.Cmd {
+	a.credCheckID++
+	return a.fetchCredentialExpiry(a.credCheckID)
+}
+
+func (a *App) fetchCredentialExpiry(checkID uint64) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(a.ctx, config.File().AWSInitTimeout())
+		defer cancel()
+		expiry, err := aws.FetchCredentialExpiry(ctx)
+		return credentialExpiryMsg{checkID: checkID, expiry: expiry, err: err}
+	}
+}
+
+func (a *App) credentialTick(checkID uint64) tea.Cmd {
+	return tea.Tick(credentialTickInterval, func(time.Time) tea.Msg {
+		return credentialTickMsg{checkID: checkID}
+	})
+}
+
+func (a *App) handleCredentialExpiry(msg credentialExpiryMsg) (tea.Model, tea.Cmd) {
+	if msg.checkID != a.credCheckID {
+		return a, nil
+	}
+	if msg.err != nil {
+		log.Debug("credential expiry check failed", "error", msg.err)
+	}
+	a.credExpiry = msg.expiry
+
+	cmds := []tea.Cmd{a.credentialTick(msg.checkID)}
+	if cmd := a.maybePromptSSOLogin(); cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+	return a, tea.Batch(cmds...)
+}
+
+// handleCredentialTick re-checks the expiry when it may have changed: SSO
+// tokens can be renewed from another terminal, and expiring credentials may
+// be refreshed by the SDK. Long-lived credentials aren't re-fetched.
+func (a *App) handleCredentialTick(msg credentialTickMsg) (tea.Model, tea.Cmd) {
+	if msg.checkID != a.credCheckID {
+		return a, nil
+	}
+	e := a.credExpiry
+	if e.SSO || (e.Known() && e.Remaining(time.Now()) < credentialWarnThreshold) {
+		return a, a.fetchCredentialExpiry(msg.checkID)
+	}
+	return a, a.credentialTick(msg.checkID)
+}
+
+// maybePromptSSOLogin offers `aws sso login` once per SSO session, when it
+// has expired or is about to.
+func (a *App) maybePromptSSOLogin() tea.Cmd {
+	e := a.credExpiry
+	if !e.SSO || !e.Known() || e.Profile == "" || a.modal != nil {
+		return nil
+	}
+	if e.Remaining(time.Now()) > credentialPromptThreshold || a.credPrompted.Equal(e.Expires) {
+		return nil
+	}
+	a.credPrompted = e.Expires
+	_, cmd := a.showModal(&view.Modal{
+		Content: view.NewSSOLoginPrompt(e.Profile, e.Expires),
+		Width:   view.ModalWidthSSOLogin,
+	})
+	return cmd
+}
+
+// credentialStatus renders the expiry countdown for the status bar
+func (a *App) credentialStatus() string {
+	e := a.credExpiry
+	if !e.Known() {
+		return ""
+	}
+	remaining := e.Remaining(time.Now())
+	switch {
+	case remaining <= 0:
+		if e.SSO {
+			return ui.DangerStyle().Render("⏱ SSO expired")
+		}
+		return ui.DangerStyle().Render("⏱ credentials expired")
+	case remaining < credentialWarnThreshold:
+		return ui.WarningStyle().Render("⏱ " + view.FormatCountdown(remaining))
+	default:
+		return ui.DimStyle().Render("⏱ " + view.FormatCountdown(remaining))
+	}
+}
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/view"
+)
+
+func TestCredentialExpiry_PromptsSSOLoginOnce(t *testing.T) {
+	app := newTestApp(t)
+	app.currentView = &MockView{name: "main"}
+	app.credCheckID = 1
+
+	expiry := aws.CredentialExpiry{Expires: time.Now().Add(2 * time.Minute), SSO: true, Profile: "dev"}
+	app.Update(credentialExpiryMsg{checkID: 1, expiry: expiry})
+
+	if app.modal == nil {
+		t.Fatal("expected SSO login prompt")
+	}
+	if _, ok := app.modal.Content.(*view.SSOLoginPrompt); !ok {
+		t.Fatalf("modal content = %T, want *view.SSOLoginPrompt", app.modal.Content)
+	}
+
+	// Dismissed prompts are not shown again for the same session
+	app.popModal()
+	app.Update(credentialExpiryMsg{checkID: 1, expiry: expiry})
+	if app.modal != nil {
+		t.Error("expected prompt not to reappear for the same expiry")
+	}
+}
+
+func TestCredentialExpiry_NoPromptWhenFarFromExpiry(t *testing.T) {
+	app := newTestApp(t)
+	app.currentView = &MockView{name: "main"}
+	app.credCheckID = 1
+
+	app.Update(credentialExpiryMsg{checkID: 1, expiry: aws.CredentialExpiry{
+		Expires: time.Now().Add(3 * time.Hour), SSO: true, Profile: "dev",
+	}})
+	if app.modal != nil {
+		t.Error("expected no prompt hours before expiry")
+	}
+
+	// Non-SSO credentials never prompt
+	app.Update(credentialExpiryMsg{checkID: 1, expiry: aws.CredentialExpiry{
+		Expires: time.Now().Add(-time.Minute), Profile: "role",
+	}})
+	if app.modal != nil {
+		t.Error("expected no prompt for non-SSO credentials")
+	}
+}
+
+func TestCredentialExpiry_StaleCheckIgnored(t *testing.T) {
+	app := newTestApp(t)
+	app.credCheckID = 2
+
+	app.Update(credentialExpiryMsg{checkID: 1, expiry: aws.CredentialExpiry{Expires: time.Now().Add(time.Hour)}})
+	if app.credExpiry.Known() {
+		t.Error("expected stale expiry result to be ignored")
+	}
+	if _, cmd := app.Update(credentialTickMsg{checkID: 1}); cmd != nil {
+		t.Error("expected stale tick not to reschedule")
+	}
+}
+
+func TestCredentialStatus(t *testing.T) {
+	app := newTestApp(t)
+
+	if got := app.credentialStatus(); got != "" {
+		t.Errorf("credentialStatus() = %q, want empty for unknown expiry", got)
+	}
+
+	tests := []struct {
+		expiry aws.CredentialExpiry
+		want   string
+	}{
+		{aws.CredentialExpiry{Expires: time.Now().Add(2*time.Hour + 5*time.Minute + 30*time.Second)}, "2h05m"},
+		{aws.CredentialExpiry{Expires: time.Now().Add(10*time.Minute + 30*time.Second)}, "10m"},
+		{aws.CredentialExpiry{Expires: time.Now().Add(-time.Minute), SSO: true}, "SSO expired"},
+		{aws.CredentialExpiry{Expires: time.Now().Add(-time.Minute)}, "credentials expired"},
+	}
+	for _, tt := range tests {
+		app.credExpiry = tt.expiry
+		if got := app.credentialStatus(); !strings.Contains(got, tt.want) {
+			t.Errorf("credentialStatus() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/view"
+)
+
+// mfaPromptMsg asks the app to prompt for an MFA code on behalf of a
+// credential request blocked in another goroutine
+type mfaPromptMsg struct {
+	profile string
+	serial  string
+	reply   chan<- mfaReply
+}
+
+type mfaReply struct {
+	code      string
+	cancelled bool
+}
+
+// MFATokenFunc returns an aws.MFATokenFunc that prompts through the running
+// program. send delivers messages to it, typically (*tea.Program).Send.
+func MFATokenFunc(send func(tea.Msg)) aws.MFATokenFunc {
+	return func(profile, serial string) (string, error) {
+		reply := make(chan mfaReply, 1)
+		send(mfaPromptMsg{profile: profile, serial: serial, reply: reply})
+
+		select {
+		case r := <-reply:
+			if r.cancelled {
+				return "", aws.ErrMFACancelled
+			}
+			return r.code, nil
+		case <-time.After(aws.MFAPromptTimeout):
+			return "", fmt.Errorf("MFA prompt for profile %s timed out", profile)
+		}
+	}
+}
+
+func (a *App) handleMFAPrompt(msg mfaPromptMsg) (tea.Model, tea.Cmd) {
+	prompt := view.NewMFAPrompt(msg.profile, msg.serial, func(code string, cancelled bool) {
+		msg.reply <- mfaReply{code: code, cancelled: cancelled}
+	})
+	_, cmd := a.showModal(&view.Modal{Content: prompt, Width: view.ModalWidthMFA})
+	return a, tea.Batch(prompt.Init(), cmd)
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/view"
+)
+
+func TestMFATokenFunc(t *testing.T) {
+	app := newTestApp(t)
+	app.currentView = &MockView{name: "main"}
+
+	msgs := make(chan tea.Msg, 1)
+	prompt := MFATokenFunc(func(msg tea.Msg) { msgs <- msg })
+
+	type result struct {
+		code string
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		code, err := prompt("admin", "serial")
+		done <- result{code, err}
+	}()
+
+	app.Update(<-msgs)
+	p, ok := app.modal.Content.(*view.MFAPrompt)
+	if app.modal == nil || !ok {
+		t.Fatal("expected MFA prompt modal")
+	}
+	p.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+
+	if r := <-done; !errors.Is(r.err, aws.ErrMFACancelled) {
+		t.Errorf("prompt() error = %v, want ErrMFACancelled", r.err)
+	}
+}
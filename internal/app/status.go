@@ -0,0 +1,136 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/ui"
+	"github.com/clawscli/claws/internal/view"
+)
+
+const (
+	// statusSeparator separates status bar segments
+	statusSeparator = " • "
+	// throttleTickInterval is how often the throttled indicator is refreshed
+	throttleTickInterval = 2 * time.Second
+	// maxThrottledShown is how many throttled services the indicator names
+	maxThrottledShown = 2
+)
+
+// throttleTickMsg refreshes the services AWS is throttling
+type throttleTickMsg struct{}
+
+func throttleTick() tea.Cmd {
+	return tea.Tick(throttleTickInterval, func(time.Time) tea.Msg { return throttleTickMsg{} })
+}
+
+// throttleStatus renders e.g. "⚠ throttled: ec2 (us-east-1)", or empty
+// string when nothing was throttled recently
+func (a *App) throttleStatus() string {
+	if len(a.throttled) == 0 {
+		return ""
+	}
+	var names []string
+	for _, st := range a.throttled[:min(len(a.throttled), maxThrottledShown)] {
+		names = append(names, st.String())
+	}
+	if extra := len(a.throttled) - maxThrottledShown; extra > 0 {
+		names = append(names, fmt.Sprintf("+%d", extra))
+	}
+	return ui.WarningStyle().Render("⚠ throttled: " + strings.Join(names, ", "))
+}
+
+// statusPlaceholder matches a {segment} in status_bar.template
+var statusPlaceholder = regexp.MustCompile(`\{([a-z]+)\}`)
+
+// renderStatusTemplate fills status_bar.template. viewStatus is what the
+// status bar shows by default: the view's status line, or an error or flash.
+// Segments that render empty are dropped along with their separator, and
+// unknown placeholders are left as written.
+func (a *App) renderStatusTemplate(tmpl, viewStatus string) string {
+	filled := statusPlaceholder.ReplaceAllStringFunc(tmpl, func(m string) string {
+		if v, ok := a.statusSegment(m[1:len(m)-1], viewStatus); ok {
+			return v
+		}
+		return m
+	})
+
+	var kept []string
+	for part := range strings.SplitSeq(filled, statusSeparator) {
+		if strings.TrimSpace(ansi.Strip(part)) != "" {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, statusSeparator)
+}
+
+func (a *App) statusSegment(name, viewStatus string) (string, bool) {
+	cfg := config.Global()
+	switch name {
+	case "view":
+		return viewStatus, true
+	case "count":
+		c, ok := a.currentView.(view.ItemCounter)
+		if !ok {
+			return "", true
+		}
+		shown, total := c.ItemCount()
+		if shown == total {
+			return fmt.Sprintf("%d items", total), true
+		}
+		return fmt.Sprintf("%d/%d items", shown, total), true
+	case "profile":
+		sels := cfg.Selections()
+		names := make([]string, len(sels))
+		for i, sel := range sels {
+			names[i] = sel.DisplayName()
+			if c := ui.ProfileColor(sel.ID()); c != nil {
+				names[i] = ui.ProfileBadgeStyle(c).Render(names[i])
+			}
+		}
+		return strings.Join(names, ","), true
+	case "account":
+		return a.accountStatus(), true
+	case "regions":
+		return strings.Join(cfg.Regions(), ","), true
+	case "expiry":
+		return a.credentialStatus(), true
+	case "readonly":
+		if cfg.ReadOnly() {
+			return a.styles.readOnly.Render("READ-ONLY"), true
+		}
+		return "", true
+	}
+	return "", false
+}
+
+// profileBadges renders the selected profiles that have a color tag as
+// badges, so the status bar flags e.g. production before anything is run
+func profileBadges() string {
+	var badges []string
+	for _, sel := range config.Global().Selections() {
+		if c := ui.ProfileColor(sel.ID()); c != nil {
+			badges = append(badges, ui.ProfileBadgeStyle(c).Render(sel.DisplayName()))
+		}
+	}
+	return strings.Join(badges, " ")
+}
+
+// accountStatus renders the account of every selected profile
+func (a *App) accountStatus() string {
+	cfg := config.Global()
+	var accounts []string
+	for _, sel := range cfg.Selections() {
+		if label := cfg.AccountLabel(sel.ID()); label != "" {
+			accounts = append(accounts, label)
+		}
+	}
+	return strings.Join(accounts, ",")
+}
@@ -0,0 +1,81 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+)
+
+type countingView struct {
+	MockView
+	shown, total int
+}
+
+func (v *countingView) ItemCount() (int, int) { return v.shown, v.total }
+
+func TestRenderStatusTemplate(t *testing.T) {
+	app := newTestApp(t)
+	app.currentView = &countingView{MockView: MockView{name: "main"}, shown: 3, total: 10}
+
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{"view and count", "{view} • {count}", "status • 3/10 items"},
+		{"empty segments dropped", "{expiry} • {view} • {readonly}", "status"},
+		{"unknown placeholder kept", "{view} • {nope}", "status • {nope}"},
+		{"literal text kept", "claws • {view}", "claws • status"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ansi.Strip(app.renderStatusTemplate(tt.tmpl, "status")); got != tt.want {
+				t.Errorf("renderStatusTemplate(%q) = %q, want %q", tt.tmpl, got, tt.want)
+			}
+		})
+	}
+
+	app.currentView = &countingView{MockView: MockView{name: "main"}, shown: 4, total: 4}
+	if got := app.renderStatusTemplate("{count}", ""); got != "4 items" {
+		t.Errorf("count = %q, want %q", got, "4 items")
+	}
+	app.currentView = &MockView{name: "main"}
+	if got := app.renderStatusTemplate("{view} • {count}", "status"); got != "status" {
+		t.Errorf("count without ItemCounter = %q, want %q", got, "status")
+	}
+}
+
+func TestAccountAliasesMsg_StaleIgnored(t *testing.T) {
+	app := newTestApp(t)
+	app.profileRefreshID = 2
+	t.Cleanup(func() { config.Global().SetAccountAliases(nil) })
+
+	app.Update(accountAliasesMsg{refreshID: 1, aliases: map[string]string{config.ProfileIDSDKDefault: "old"}})
+	if got := config.Global().GetAccountAliasForProfile(config.ProfileIDSDKDefault); got != "" {
+		t.Errorf("alias = %q, want stale aliases ignored", got)
+	}
+	app.Update(accountAliasesMsg{refreshID: 2, aliases: map[string]string{config.ProfileIDSDKDefault: "prod"}})
+	if got := config.Global().GetAccountAliasForProfile(config.ProfileIDSDKDefault); got != "prod" {
+		t.Errorf("alias = %q, want %q", got, "prod")
+	}
+}
+
+func TestThrottleStatus(t *testing.T) {
+	app := newTestApp(t)
+	if got := app.throttleStatus(); got != "" {
+		t.Errorf("throttleStatus() = %q, want empty when nothing is throttled", got)
+	}
+
+	app.throttled = []aws.ThrottleStatus{
+		{Service: "ec2", Region: "us-east-1"},
+		{Service: "lambda", Region: "us-west-2"},
+		{Service: "s3", Region: "us-west-2"},
+	}
+	want := "⚠ throttled: ec2 (us-east-1), lambda (us-west-2), +1"
+	if got := ansi.Strip(app.throttleStatus()); got != want {
+		t.Errorf("throttleStatus() = %q, want %q", got, want)
+	}
+}
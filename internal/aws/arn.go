@@ -18,6 +18,11 @@ type ARN struct {
 	Raw          string // original ARN string
 }
 
+// IsARN reports whether s looks like an ARN in any partition.
+func IsARN(s string) bool {
+	return sdkarn.IsARN(s)
+}
+
 // ParseARN parses an ARN string into its components using the AWS SDK.
 // Returns nil if the string is not a valid ARN.
 func ParseARN(arn string) *ARN {
@@ -5,14 +5,22 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
 
 	appconfig "github.com/clawscli/claws/internal/config"
 )
 
-// CostExplorerRegion is the only region where Cost Explorer API is available.
+// CostExplorerRegion is the Cost Explorer API region in the standard
+// partition. See CostExplorerRegionFor for other partitions.
 const CostExplorerRegion = "us-east-1"
 
+// PricingRegion is the Price List Query API region in the standard
+// partition. See PricingRegionFor for other partitions.
+const PricingRegion = "us-east-1"
+
+// HealthRegion is the AWS Health API region in the standard partition. See
+// HealthRegionFor for other partitions.
+const HealthRegion = "us-east-1"
+
 type regionOverrideKey struct{}
 type selectionOverrideKey struct{}
 
@@ -38,22 +46,19 @@ func GetSelectionFromContext(ctx context.Context) (appconfig.ProfileSelection, b
 	return appconfig.ProfileSelection{}, false
 }
 
-func NewConfig(ctx context.Context) (aws.Config, error) {
-	sel := appconfig.Global().Selection()
-	if ctxSel, ok := GetSelectionFromContext(ctx); ok {
-		sel = ctxSel
-	}
-	opts := SelectionLoadOptions(sel)
-
-	region := GetRegionFromContext(ctx)
-	if region == "" {
-		region = appconfig.Global().Region()
-	}
-	if region != "" {
-		opts = append(opts, config.WithRegion(region))
+// selectionFromContext returns the profile selection override in ctx, or
+// the global selection
+func selectionFromContext(ctx context.Context) appconfig.ProfileSelection {
+	if sel, ok := GetSelectionFromContext(ctx); ok {
+		return sel
 	}
+	return appconfig.Global().Selection()
+}
 
-	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+// NewConfig returns the config for the profile and region ctx resolves to.
+// Configs are memoized; see Client for service clients.
+func NewConfig(ctx context.Context) (aws.Config, error) {
+	cfg, err := clients.config(ctx, selectionFromContext(ctx), CurrentRegion(ctx))
 	if err != nil {
 		return aws.Config{}, fmt.Errorf("load AWS config: %w", err)
 	}
@@ -61,14 +66,7 @@ func NewConfig(ctx context.Context) (aws.Config, error) {
 }
 
 func NewConfigWithRegion(ctx context.Context, region string) (aws.Config, error) {
-	sel := appconfig.Global().Selection()
-	if ctxSel, ok := GetSelectionFromContext(ctx); ok {
-		sel = ctxSel
-	}
-	opts := SelectionLoadOptions(sel)
-	opts = append(opts, config.WithRegion(region))
-
-	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	cfg, err := clients.config(ctx, selectionFromContext(ctx), region)
 	if err != nil {
 		return aws.Config{}, fmt.Errorf("load AWS config for region %s: %w", region, err)
 	}
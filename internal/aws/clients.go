@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+type configKey struct {
+	profile string // ProfileSelection.ID()
+	region  string // requested region; empty resolves the profile's default
+}
+
+type clientKey struct {
+	client reflect.Type
+	config configKey
+}
+
+// clientCache memoizes configs and service clients per profile and region,
+// so switching views reuses resolved credentials and the clients' pooled
+// connections instead of repeating config loading and TLS handshakes.
+type clientCache struct {
+	mu      sync.Mutex
+	configs map[configKey]aws.Config
+	clients map[clientKey]any
+}
+
+var clients = &clientCache{
+	configs: make(map[configKey]aws.Config),
+	clients: make(map[clientKey]any),
+}
+
+// config returns the config for sel in region, loading it on first use.
+// Failed loads are not cached, so the next call retries.
+func (c *clientCache) config(ctx context.Context, sel appconfig.ProfileSelection, region string) (aws.Config, error) {
+	key := configKey{profile: sel.ID(), region: region}
+	c.mu.Lock()
+	cfg, ok := c.configs[key]
+	c.mu.Unlock()
+	if ok {
+		return cfg, nil
+	}
+
+	var opts []func(*config.LoadOptions) error
+	if region != "" {
+		opts = append(opts, config.WithRegion(region))
+	}
+	cfg, err := loadSelectionConfig(ctx, sel, opts...)
+	if err != nil {
+		return aws.Config{}, err
+	}
+	// Callers get a copy of the config; clipping makes their appends
+	// reallocate rather than write into the shared backing array.
+	cfg.APIOptions = slices.Clip(cfg.APIOptions)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.configs[key]; ok {
+		return cached, nil
+	}
+	c.configs[key] = cfg
+	return cfg, nil
+}
+
+func (c *clientCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.configs)
+	clear(c.clients)
+}
+
+// Client returns the service client for the profile and region ctx resolves
+// to, creating it with newFromConfig on first use, e.g.
+//
+//	client, err := appaws.Client(ctx, ec2.NewFromConfig)
+//
+// Clients are safe for concurrent use and shared by every caller. Clients
+// that need per-call options are built from NewConfig instead.
+func Client[C any, O any](ctx context.Context, newFromConfig func(aws.Config, ...func(*O)) *C) (*C, error) {
+	return clientFor(ctx, CurrentRegion(ctx), newFromConfig)
+}
+
+// ClientWithRegion is Client for an explicit region, e.g. a service whose
+// API is only served from one region.
+func ClientWithRegion[C any, O any](ctx context.Context, region string, newFromConfig func(aws.Config, ...func(*O)) *C) (*C, error) {
+	return clientFor(ctx, region, newFromConfig)
+}
+
+func clientFor[C any, O any](ctx context.Context, region string, newFromConfig func(aws.Config, ...func(*O)) *C) (*C, error) {
+	return clientForSelection(ctx, selectionFromContext(ctx), region, newFromConfig)
+}
+
+// clientForSelection is Client for an explicit profile selection, for
+// lookups that run per selected profile. An empty region resolves the
+// profile's default.
+func clientForSelection[C any, O any](ctx context.Context, sel appconfig.ProfileSelection, region string, newFromConfig func(aws.Config, ...func(*O)) *C) (*C, error) {
+	key := clientKey{client: reflect.TypeFor[C](), config: configKey{profile: sel.ID(), region: region}}
+
+	clients.mu.Lock()
+	client, ok := clients.clients[key].(*C)
+	clients.mu.Unlock()
+	if ok {
+		return client, nil
+	}
+
+	cfg, err := clients.config(ctx, sel, region)
+	if err != nil {
+		return nil, fmt.Errorf("load AWS config: %w", err)
+	}
+	client = newFromConfig(cfg)
+
+	clients.mu.Lock()
+	defer clients.mu.Unlock()
+	if cached, ok := clients.clients[key].(*C); ok {
+		return cached, nil
+	}
+	clients.clients[key] = client
+	return client, nil
+}
+
+// ResetClients drops the memoized configs and clients, so the next request
+// resolves credentials again, e.g. after an SSO login or profile change.
+func ResetClients() {
+	clients.reset()
+}
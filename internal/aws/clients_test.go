@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+func TestClient_Memoized(t *testing.T) {
+	writeMFAProfile(t)
+	t.Cleanup(ResetClients)
+	ResetClients()
+
+	ctx := WithSelectionOverride(context.Background(), appconfig.NamedProfile("base"))
+	east := WithRegionOverride(ctx, "us-east-1")
+
+	c1, err := Client(east, ec2.NewFromConfig)
+	if err != nil {
+		t.Fatalf("Client() error = %v", err)
+	}
+	c2, err := Client(east, ec2.NewFromConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c1 != c2 {
+		t.Error("expected the same client for the same service, profile and region")
+	}
+
+	west, err := Client(WithRegionOverride(ctx, "us-west-2"), ec2.NewFromConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if west == c1 || west.Options().Region != "us-west-2" {
+		t.Errorf("expected a separate us-west-2 client, got region %q", west.Options().Region)
+	}
+	if c, _ := ClientWithRegion(ctx, "us-west-2", ec2.NewFromConfig); c != west {
+		t.Error("ClientWithRegion should share the client for the same region")
+	}
+
+	other, err := Client(WithSelectionOverride(east, appconfig.EnvOnly()), ec2.NewFromConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == c1 {
+		t.Error("expected a separate client per profile")
+	}
+
+	stsClient, err := Client(east, sts.NewFromConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stsClient.Options().HTTPClient != c1.Options().HTTPClient {
+		t.Error("clients for one profile and region should share the HTTP client")
+	}
+
+	perSelection, err := clientForSelection(context.Background(), appconfig.NamedProfile("base"), "us-east-1", ec2.NewFromConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perSelection != c1 {
+		t.Error("clientForSelection should share the client for the same profile and region")
+	}
+
+	ResetClients()
+	if c3, _ := Client(east, ec2.NewFromConfig); c3 == c1 {
+		t.Error("ResetClients should drop memoized clients")
+	}
+}
@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+// CredentialExpiry describes when the current credentials stop working.
+type CredentialExpiry struct {
+	Expires time.Time // zero when the credentials don't expire
+	SSO     bool      // Expires is the SSO token's; renewable via `aws sso login`
+	Profile string    // profile the credentials come from, if any
+}
+
+// Known reports whether an expiry time is known.
+func (e CredentialExpiry) Known() bool {
+	return !e.Expires.IsZero()
+}
+
+// Remaining returns the time left before expiry at now (negative once expired).
+func (e CredentialExpiry) Remaining(now time.Time) time.Duration {
+	return e.Expires.Sub(now)
+}
+
+// FetchCredentialExpiry reports when the current selection's credentials
+// expire. SSO profiles use the cached SSO token's expiry, since the role
+// credentials derived from it are refreshed silently until the token lapses;
+// other credentials use the expiry reported by the provider.
+func FetchCredentialExpiry(ctx context.Context) (CredentialExpiry, error) {
+	sel := appconfig.Global().Selection()
+	if ctxSel, ok := GetSelectionFromContext(ctx); ok {
+		sel = ctxSel
+	}
+
+	profile := selectionProfile(sel)
+	if expiry, ok, err := ssoExpiry(profile); ok {
+		return expiry, err
+	}
+
+	cfg, err := NewConfig(ctx)
+	if err != nil {
+		return CredentialExpiry{Profile: profile}, err
+	}
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return CredentialExpiry{Profile: profile}, fmt.Errorf("retrieve credentials: %w", err)
+	}
+	if !creds.CanExpire {
+		return CredentialExpiry{Profile: profile}, nil
+	}
+	return CredentialExpiry{Expires: creds.Expires, Profile: profile}, nil
+}
+
+// ssoExpiry returns the SSO token expiry when profile is an SSO profile;
+// ok is false for any other profile.
+func ssoExpiry(profile string) (expiry CredentialExpiry, ok bool, err error) {
+	if profile == "" {
+		return CredentialExpiry{}, false, nil
+	}
+	info, found := findProfile(profile)
+	if !found || !info.IsSSO {
+		return CredentialExpiry{}, false, nil
+	}
+	expires, err := ssoTokenExpiry(info)
+	if err != nil {
+		return CredentialExpiry{SSO: true, Profile: profile}, true, err
+	}
+	return CredentialExpiry{Expires: expires, SSO: true, Profile: profile}, true, nil
+}
+
+func findProfile(name string) (ProfileInfo, bool) {
+	profiles, err := LoadProfiles()
+	if err != nil {
+		return ProfileInfo{}, false
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return ProfileInfo{}, false
+}
+
+// ssoTokenExpiry reads the expiry of the SSO token cached by `aws sso login`.
+// A missing cache file means the profile has never logged in, which is
+// reported as already expired.
+func ssoTokenExpiry(info ProfileInfo) (time.Time, error) {
+	key := info.SSOSession
+	if key == "" {
+		key = info.SSOStartURL
+	}
+	path, err := ssocreds.StandardCachedTokenFilepath(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return time.Unix(0, 0), nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("read SSO token cache: %w", err)
+	}
+
+	var token struct {
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return time.Time{}, fmt.Errorf("parse SSO token cache: %w", err)
+	}
+	return token.ExpiresAt, nil
+}
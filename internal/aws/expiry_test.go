@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+)
+
+func TestSSOTokenExpiry(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("USERPROFILE", os.Getenv("HOME"))
+
+	info := ProfileInfo{Name: "dev", IsSSO: true, SSOSession: "corp"}
+
+	// Never logged in: treated as expired
+	got, err := ssoTokenExpiry(info)
+	if err != nil {
+		t.Fatalf("ssoTokenExpiry() error = %v", err)
+	}
+	if got.After(time.Now()) {
+		t.Errorf("ssoTokenExpiry() = %v, want a past time without a cached token", got)
+	}
+
+	path, err := ssocreds.StandardCachedTokenFilepath("corp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(`{"accessToken":"x","expiresAt":"2030-01-02T03:04:05Z"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = ssoTokenExpiry(info)
+	if err != nil {
+		t.Fatalf("ssoTokenExpiry() error = %v", err)
+	}
+	want := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("ssoTokenExpiry() = %v, want %v", got, want)
+	}
+
+	// Legacy profiles key the cache by start URL
+	legacy := ProfileInfo{Name: "old", IsSSO: true, SSOStartURL: "https://corp.awsapps.com/start"}
+	if got, _ := ssoTokenExpiry(legacy); got.After(time.Now()) {
+		t.Errorf("ssoTokenExpiry(legacy) = %v, want a past time", got)
+	}
+}
+
+func TestCredentialExpiryRemaining(t *testing.T) {
+	now := time.Now()
+	e := CredentialExpiry{Expires: now.Add(time.Hour)}
+	if !e.Known() || e.Remaining(now) != time.Hour {
+		t.Errorf("Remaining() = %v, want 1h", e.Remaining(now))
+	}
+	if (CredentialExpiry{}).Known() {
+		t.Error("expected zero expiry to be unknown")
+	}
+}
@@ -74,7 +74,7 @@ func ExtractResourceName(arn string) string {
 	}
 
 	// Handle ARN format with ":" separator (e.g., S3 buckets)
-	if strings.HasPrefix(arn, "arn:aws:") {
+	if IsARN(arn) {
 		parts := strings.Split(arn, ":")
 		if len(parts) >= 6 {
 			return parts[len(parts)-1]
@@ -158,6 +158,8 @@ func TestExtractResourceName(t *testing.T) {
 		{"EC2 instance ARN", "arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0", "i-1234567890abcdef0"},
 		{"SNS topic ARN", "arn:aws:sns:us-east-1:123456789012:my-topic", "my-topic"},
 		{"SQS queue ARN", "arn:aws:sqs:us-east-1:123456789012:my-queue", "my-queue"},
+		{"China SNS topic ARN", "arn:aws-cn:sns:cn-north-1:123456789012:my-topic", "my-topic"},
+		{"GovCloud SQS queue ARN", "arn:aws-us-gov:sqs:us-gov-west-1:123456789012:my-queue", "my-queue"},
 		{"not an ARN", "just-a-name", "just-a-name"},
 		{"path with slash", "some/path/resource", "resource"},
 	}
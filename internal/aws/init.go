@@ -4,8 +4,6 @@ import (
 	"context"
 	"sync"
 
-	"github.com/aws/aws-sdk-go-v2/config"
-
 	appconfig "github.com/clawscli/claws/internal/config"
 )
 
@@ -13,14 +11,14 @@ func InitContext(ctx context.Context) error {
 	selections := appconfig.Global().Selections()
 
 	if len(selections) == 1 {
-		cfg, err := config.LoadDefaultConfig(ctx, SelectionLoadOptions(selections[0])...)
+		cfg, err := clients.config(ctx, selections[0], "")
 		if err != nil {
 			return err
 		}
 		if appconfig.Global().Region() == "" {
 			appconfig.Global().SetRegion(cfg.Region)
 		}
-		accountID := FetchAccountID(ctx, cfg)
+		accountID := FetchAccountIDForSelection(ctx, selections[0])
 		appconfig.Global().SetAccountID(accountID)
 		return nil
 	}
@@ -44,7 +42,7 @@ func RefreshContextData(ctx context.Context) (region string, accountIDs map[stri
 
 	if !appconfig.Global().IsMultiRegion() {
 		sel := selections[0]
-		cfg, cfgErr := config.LoadDefaultConfig(ctx, SelectionLoadOptions(sel)...)
+		cfg, cfgErr := clients.config(ctx, sel, "")
 		if cfgErr == nil && cfg.Region != "" {
 			region = cfg.Region
 		}
@@ -62,12 +60,11 @@ func RefreshContextData(ctx context.Context) (region string, accountIDs map[stri
 			defer wg.Done()
 			sem <- struct{}{}
 			defer func() { <-sem }()
-			cfg, cfgErr := config.LoadDefaultConfig(ctx, SelectionLoadOptions(s)...)
-			if cfgErr != nil {
+			if _, cfgErr := clients.config(ctx, s, ""); cfgErr != nil {
 				errChan <- cfgErr
 				return
 			}
-			id := FetchAccountID(ctx, cfg)
+			id := FetchAccountIDForSelection(ctx, s)
 			mu.Lock()
 			accountIDs[s.ID()] = id
 			mu.Unlock()
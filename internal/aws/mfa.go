@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+const (
+	// MFAPromptTimeout bounds how long an MFA prompt waits for a code.
+	MFAPromptTimeout = 5 * time.Minute
+	// mfaCancelCooldown suppresses new MFA prompts for a profile after the
+	// user cancels one, so auto-reloading views don't immediately prompt again.
+	mfaCancelCooldown = time.Minute
+)
+
+var (
+	// ErrMFARequired is returned when a role requires MFA but no prompt is available.
+	ErrMFARequired = errors.New("MFA code required but no prompt is available")
+	// ErrMFACancelled is returned when the user dismisses the MFA prompt.
+	ErrMFACancelled = errors.New("MFA prompt cancelled")
+)
+
+// MFATokenFunc asks the user for a code from the MFA device serial, for
+// assuming profile's role. It blocks until a code is entered or the prompt
+// is cancelled.
+type MFATokenFunc func(profile, serial string) (string, error)
+
+var mfa = struct {
+	sync.Mutex
+	prompt    MFATokenFunc
+	sessions  map[string]aws.CredentialsProvider
+	cancelled map[string]time.Time
+}{
+	sessions:  make(map[string]aws.CredentialsProvider),
+	cancelled: make(map[string]time.Time),
+}
+
+// SetMFATokenFunc registers the prompt used for roles that require MFA.
+func SetMFATokenFunc(fn MFATokenFunc) {
+	mfa.Lock()
+	defer mfa.Unlock()
+	mfa.prompt = fn
+}
+
+// ResetMFASessions drops cached MFA sessions, so the next request prompts again.
+func ResetMFASessions() {
+	mfa.Lock()
+	defer mfa.Unlock()
+	clear(mfa.sessions)
+	clear(mfa.cancelled)
+}
+
+// mfaLoadOption wires the MFA prompt into assume-role credentials for
+// profile and sets *required when the role has an mfa_serial.
+func mfaLoadOption(profile string, required *bool) func(*config.LoadOptions) error {
+	return config.WithAssumeRoleCredentialOptions(func(o *stscreds.AssumeRoleOptions) {
+		if o.SerialNumber == nil {
+			return
+		}
+		*required = true
+		serial := aws.ToString(o.SerialNumber)
+		o.TokenProvider = func() (string, error) {
+			return requestMFAToken(profile, serial)
+		}
+	})
+}
+
+func requestMFAToken(profile, serial string) (string, error) {
+	mfa.Lock()
+	prompt := mfa.prompt
+	cancelledAt, wasCancelled := mfa.cancelled[profile]
+	mfa.Unlock()
+
+	if prompt == nil {
+		return "", ErrMFARequired
+	}
+	if wasCancelled && time.Since(cancelledAt) < mfaCancelCooldown {
+		return "", ErrMFACancelled
+	}
+
+	code, err := prompt(profile, serial)
+	if errors.Is(err, ErrMFACancelled) {
+		mfa.Lock()
+		mfa.cancelled[profile] = time.Now()
+		mfa.Unlock()
+	}
+	return code, err
+}
+
+// mfaSession returns the credentials shared by every config for profile.
+// Each config load builds a new assume-role provider; sharing the first
+// one's cache means the MFA code is asked for once per session rather than
+// once per request, and again only after the session expires. The cache
+// retrieves independently of the caller's context, so a request that times
+// out while the prompt is open doesn't discard the session.
+func mfaSession(profile string, creds aws.CredentialsProvider) aws.CredentialsProvider {
+	mfa.Lock()
+	defer mfa.Unlock()
+	if cached, ok := mfa.sessions[profile]; ok {
+		return cached
+	}
+	mfa.sessions[profile] = creds
+	return creds
+}
+
+// selectionProfile returns the shared-config profile a selection resolves
+// to, or "" when ~/.aws files are ignored.
+func selectionProfile(sel appconfig.ProfileSelection) string {
+	switch {
+	case sel.IsNamedProfile():
+		return sel.ProfileName
+	case sel.IsSDKDefault():
+		if p := os.Getenv("AWS_PROFILE"); p != "" {
+			return p
+		}
+		return "default"
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+func writeMFAProfile(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config")
+	credsPath := filepath.Join(dir, "credentials")
+	config := `[profile base]
+region = us-east-1
+
+[profile admin]
+role_arn = arn:aws:iam::123456789012:role/Admin
+source_profile = base
+mfa_serial = arn:aws:iam::123456789012:mfa/alice
+region = us-east-1
+`
+	creds := `[base]
+aws_access_key_id = AKIAEXAMPLE
+aws_secret_access_key = secret
+`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(credsPath, []byte(creds), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONFIG_FILE", configPath)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", credsPath)
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+}
+
+func TestLoadSelectionConfig_MFAProfile(t *testing.T) {
+	writeMFAProfile(t)
+	t.Cleanup(ResetMFASessions)
+	ResetMFASessions()
+
+	sel := appconfig.NamedProfile("admin")
+	cfg1, err := loadSelectionConfig(context.Background(), sel)
+	if err != nil {
+		t.Fatalf("loadSelectionConfig() error = %v", err)
+	}
+	cfg2, err := loadSelectionConfig(context.Background(), sel)
+	if err != nil {
+		t.Fatalf("loadSelectionConfig() error = %v", err)
+	}
+	if cfg1.Credentials != cfg2.Credentials {
+		t.Error("expected MFA profile configs to share one credentials session")
+	}
+
+	profiles, err := LoadProfiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range profiles {
+		if p.Name == "admin" && p.MFASerial != "arn:aws:iam::123456789012:mfa/alice" {
+			t.Errorf("MFASerial = %q", p.MFASerial)
+		}
+	}
+}
+
+func TestLoadSelectionConfig_NonMFAProfileNotShared(t *testing.T) {
+	writeMFAProfile(t)
+	t.Cleanup(ResetMFASessions)
+	ResetMFASessions()
+
+	sel := appconfig.NamedProfile("base")
+	cfg1, err := loadSelectionConfig(context.Background(), sel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg2, err := loadSelectionConfig(context.Background(), sel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg1.Credentials == cfg2.Credentials {
+		t.Error("expected non-MFA profiles to keep per-config credentials")
+	}
+}
+
+func TestRequestMFAToken(t *testing.T) {
+	t.Cleanup(func() {
+		SetMFATokenFunc(nil)
+		ResetMFASessions()
+	})
+	ResetMFASessions()
+
+	SetMFATokenFunc(nil)
+	if _, err := requestMFAToken("admin", "serial"); !errors.Is(err, ErrMFARequired) {
+		t.Errorf("requestMFAToken() error = %v, want ErrMFARequired", err)
+	}
+
+	SetMFATokenFunc(func(profile, serial string) (string, error) {
+		if profile != "admin" || serial != "serial" {
+			t.Errorf("prompt(%q, %q)", profile, serial)
+		}
+		return "123456", nil
+	})
+	if code, err := requestMFAToken("admin", "serial"); err != nil || code != "123456" {
+		t.Errorf("requestMFAToken() = %q, %v", code, err)
+	}
+
+	// A cancelled prompt isn't shown again during the cooldown
+	prompts := 0
+	SetMFATokenFunc(func(string, string) (string, error) {
+		prompts++
+		return "", ErrMFACancelled
+	})
+	requestMFAToken("admin", "serial")
+	if _, err := requestMFAToken("admin", "serial"); !errors.Is(err, ErrMFACancelled) {
+		t.Errorf("requestMFAToken() error = %v, want ErrMFACancelled", err)
+	}
+	if prompts != 1 {
+		t.Errorf("prompts = %d, want 1 during cooldown", prompts)
+	}
+}
@@ -1,7 +1,11 @@
 package aws
 
 import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/smithy-go/middleware"
 
 	appconfig "github.com/clawscli/claws/internal/config"
 )
@@ -28,3 +32,23 @@ func SelectionLoadOptions(sel appconfig.ProfileSelection) []func(*config.LoadOpt
 	}
 	return opts
 }
+
+// loadSelectionConfig loads the AWS config for sel with extra options
+// applied. Roles that require MFA prompt for a code through the registered
+// MFATokenFunc and share one session per profile.
+func loadSelectionConfig(ctx context.Context, sel appconfig.ProfileSelection, extra ...func(*config.LoadOptions) error) (aws.Config, error) {
+	profile := selectionProfile(sel)
+	var mfaRequired bool
+	opts := append(SelectionLoadOptions(sel), extra...)
+	opts = append(opts, mfaLoadOption(profile, &mfaRequired))
+	opts = append(opts, config.WithAPIOptions([]func(*middleware.Stack) error{addThrottleMiddleware, addTimingMiddleware(sel.DisplayName())}))
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return cfg, err
+	}
+	if mfaRequired {
+		cfg.Credentials = mfaSession(profile, cfg.Credentials)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+// Partition names as they appear in ARNs.
+const (
+	PartitionAWS   = "aws"
+	PartitionChina = "aws-cn"
+	PartitionGov   = "aws-us-gov"
+)
+
+// ChinaRegions is the fallback region list for the aws-cn partition.
+var ChinaRegions = []string{
+	"cn-north-1",
+	"cn-northwest-1",
+}
+
+// GovCloudRegions is the fallback region list for the aws-us-gov partition.
+var GovCloudRegions = []string{
+	"us-gov-west-1",
+	"us-gov-east-1",
+}
+
+// PartitionForRegion returns the partition a region belongs to.
+func PartitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return PartitionChina
+	case strings.HasPrefix(region, "us-gov-"):
+		return PartitionGov
+	default:
+		return PartitionAWS
+	}
+}
+
+// PartitionForARN returns the partition of arn, falling back to the
+// standard partition when arn isn't a valid ARN.
+func PartitionForARN(arn string) string {
+	if parsed := ParseARN(arn); parsed != nil && parsed.Partition != "" {
+		return parsed.Partition
+	}
+	return PartitionAWS
+}
+
+// DNSSuffix returns the service endpoint domain for partition,
+// e.g. "amazonaws.com" or "amazonaws.com.cn".
+func DNSSuffix(partition string) string {
+	if partition == PartitionChina {
+		return "amazonaws.com.cn"
+	}
+	return "amazonaws.com"
+}
+
+// PartitionRegions returns the fallback region list for partition.
+func PartitionRegions(partition string) []string {
+	switch partition {
+	case PartitionChina:
+		return ChinaRegions
+	case PartitionGov:
+		return GovCloudRegions
+	default:
+		return CommonRegions
+	}
+}
+
+// CurrentRegion returns the region override from ctx, or else the
+// globally selected region.
+func CurrentRegion(ctx context.Context) string {
+	if region := GetRegionFromContext(ctx); region != "" {
+		return region
+	}
+	return appconfig.Global().Region()
+}
+
+// CostExplorerRegionFor returns the Cost Explorer endpoint region for the
+// partition region belongs to.
+func CostExplorerRegionFor(region string) string {
+	switch PartitionForRegion(region) {
+	case PartitionChina:
+		return "cn-northwest-1"
+	case PartitionGov:
+		return "us-gov-west-1"
+	default:
+		return CostExplorerRegion
+	}
+}
+
+// HealthRegionFor returns the global AWS Health endpoint region for the
+// partition region belongs to.
+func HealthRegionFor(region string) string {
+	switch PartitionForRegion(region) {
+	case PartitionChina:
+		return "cn-northwest-1"
+	case PartitionGov:
+		return "us-gov-west-1"
+	default:
+		return HealthRegion
+	}
+}
+
+// PricingRegionFor returns the Price List Query API region for the
+// partition region belongs to. GovCloud has no Price List endpoint of its
+// own, so it uses the standard one.
+func PricingRegionFor(region string) string {
+	if PartitionForRegion(region) == PartitionChina {
+		return "cn-northwest-1"
+	}
+	return PricingRegion
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	tests := []struct {
+		region string
+		want   string
+	}{
+		{"us-east-1", PartitionAWS},
+		{"eu-west-1", PartitionAWS},
+		{"", PartitionAWS},
+		{"cn-north-1", PartitionChina},
+		{"cn-northwest-1", PartitionChina},
+		{"us-gov-west-1", PartitionGov},
+		{"us-gov-east-1", PartitionGov},
+	}
+	for _, tt := range tests {
+		if got := PartitionForRegion(tt.region); got != tt.want {
+			t.Errorf("PartitionForRegion(%q) = %q, want %q", tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestPartitionForARN(t *testing.T) {
+	tests := []struct {
+		arn  string
+		want string
+	}{
+		{"arn:aws:iam::123456789012:user/alice", PartitionAWS},
+		{"arn:aws-cn:iam::123456789012:user/alice", PartitionChina},
+		{"arn:aws-us-gov:sts::123456789012:assumed-role/r/s", PartitionGov},
+		{"not-an-arn", PartitionAWS},
+		{"", PartitionAWS},
+	}
+	for _, tt := range tests {
+		if got := PartitionForARN(tt.arn); got != tt.want {
+			t.Errorf("PartitionForARN(%q) = %q, want %q", tt.arn, got, tt.want)
+		}
+	}
+}
+
+func TestDNSSuffix(t *testing.T) {
+	tests := map[string]string{
+		PartitionAWS:   "amazonaws.com",
+		PartitionChina: "amazonaws.com.cn",
+		PartitionGov:   "amazonaws.com",
+	}
+	for partition, want := range tests {
+		if got := DNSSuffix(partition); got != want {
+			t.Errorf("DNSSuffix(%q) = %q, want %q", partition, got, want)
+		}
+	}
+}
+
+func TestPartitionRegions(t *testing.T) {
+	for _, partition := range []string{PartitionAWS, PartitionChina, PartitionGov} {
+		regions := PartitionRegions(partition)
+		if len(regions) == 0 {
+			t.Fatalf("PartitionRegions(%q) is empty", partition)
+		}
+		for _, r := range regions {
+			if got := PartitionForRegion(r); got != partition {
+				t.Errorf("PartitionRegions(%q) contains %q from partition %q", partition, r, got)
+			}
+		}
+	}
+}
+
+func TestServiceRegionsForPartition(t *testing.T) {
+	tests := []struct {
+		region      string
+		costExplore string
+		pricing     string
+		health      string
+	}{
+		{"eu-west-1", "us-east-1", "us-east-1", "us-east-1"},
+		{"cn-north-1", "cn-northwest-1", "cn-northwest-1", "cn-northwest-1"},
+		{"us-gov-east-1", "us-gov-west-1", "us-east-1", "us-gov-west-1"},
+	}
+	for _, tt := range tests {
+		if got := CostExplorerRegionFor(tt.region); got != tt.costExplore {
+			t.Errorf("CostExplorerRegionFor(%q) = %q, want %q", tt.region, got, tt.costExplore)
+		}
+		if got := PricingRegionFor(tt.region); got != tt.pricing {
+			t.Errorf("PricingRegionFor(%q) = %q, want %q", tt.region, got, tt.pricing)
+		}
+		if got := HealthRegionFor(tt.region); got != tt.health {
+			t.Errorf("HealthRegionFor(%q) = %q, want %q", tt.region, got, tt.health)
+		}
+	}
+}
+
+func TestCurrentRegion_ContextOverride(t *testing.T) {
+	ctx := WithRegionOverride(context.Background(), "cn-north-1")
+	if got := CurrentRegion(ctx); got != "cn-north-1" {
+		t.Errorf("CurrentRegion() = %q, want cn-north-1", got)
+	}
+}
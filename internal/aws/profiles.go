@@ -22,6 +22,7 @@ type ProfileInfo struct {
 	ProfileType    string // SSO, AssumeRole, Static, Default
 	RoleArn        string
 	SourceProfile  string
+	MFASerial      string
 	SSOSession     string
 	SSOStartURL    string
 	SSORegion      string
@@ -76,6 +77,7 @@ func LoadProfiles() ([]ProfileInfo, error) {
 				Region:        section.Key("region").String(),
 				RoleArn:       roleArn,
 				SourceProfile: section.Key("source_profile").String(),
+				MFASerial:     section.Key("mfa_serial").String(),
 				SSOSession:    ssoSession,
 				SSOStartURL:   ssoStartURL,
 				SSORegion:     section.Key("sso_region").String(),
@@ -2,8 +2,11 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 
 	appconfig "github.com/clawscli/claws/internal/config"
@@ -26,18 +29,39 @@ var CommonRegions = []string{
 	"sa-east-1",
 }
 
+// Region opt-in statuses reported by DescribeRegions
+const (
+	RegionOptInNotRequired = "opt-in-not-required"
+	RegionOptedIn          = "opted-in"
+	RegionNotOptedIn       = "not-opted-in"
+)
+
+// RegionLatencyTimeout bounds a single region latency probe.
+const RegionLatencyTimeout = 3 * time.Second
+
+// RegionInfo describes a region and whether the account can use it.
+type RegionInfo struct {
+	Name        string
+	OptInStatus string // empty when unknown
+}
+
+// Enabled reports whether the region is usable by the account. Regions with
+// an unknown status are assumed enabled.
+func (r RegionInfo) Enabled() bool {
+	return r.OptInStatus != RegionNotOptedIn
+}
+
 // FetchAvailableRegions fetches available regions from AWS using the current profile.
-// Falls back to CommonRegions on error.
+// Falls back to the current partition's region list on error.
 func FetchAvailableRegions(ctx context.Context) ([]string, error) {
-	cfg, err := config.LoadDefaultConfig(ctx, SelectionLoadOptions(appconfig.Global().Selection())...)
+	client, err := clientForSelection(ctx, appconfig.Global().Selection(), "", ec2.NewFromConfig)
 	if err != nil {
-		return CommonRegions, nil // Fallback to common regions
+		return fallbackRegions(), nil
 	}
 
-	client := ec2.NewFromConfig(cfg)
 	output, err := client.DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
 	if err != nil {
-		return CommonRegions, nil // Fallback to common regions
+		return fallbackRegions(), nil
 	}
 
 	regions := make([]string, 0, len(output.Regions))
@@ -48,3 +72,90 @@ func FetchAvailableRegions(ctx context.Context) ([]string, error) {
 	}
 	return regions, nil
 }
+
+// FetchRegionInfo fetches every region, including those not enabled for the
+// account, with its opt-in status. Falls back to the current partition's
+// region list with an unknown status on error, returning the error for logging.
+func FetchRegionInfo(ctx context.Context) ([]RegionInfo, error) {
+	client, err := clientForSelection(ctx, appconfig.Global().Selection(), "", ec2.NewFromConfig)
+	if err != nil {
+		return fallbackRegionInfo(), err
+	}
+
+	output, err := client.DescribeRegions(ctx, &ec2.DescribeRegionsInput{
+		AllRegions: aws.Bool(true),
+	})
+	if err != nil {
+		return fallbackRegionInfo(), err
+	}
+
+	regions := make([]RegionInfo, 0, len(output.Regions))
+	for _, r := range output.Regions {
+		if r.RegionName != nil {
+			regions = append(regions, RegionInfo{
+				Name:        *r.RegionName,
+				OptInStatus: aws.ToString(r.OptInStatus),
+			})
+		}
+	}
+	return regions, nil
+}
+
+// fallbackRegions returns the region list for the selected region's
+// partition, for when the account's regions can't be listed.
+func fallbackRegions() []string {
+	return PartitionRegions(PartitionForRegion(appconfig.Global().Region()))
+}
+
+func fallbackRegionInfo() []RegionInfo {
+	names := fallbackRegions()
+	regions := make([]RegionInfo, len(names))
+	for i, name := range names {
+		regions[i] = RegionInfo{Name: name}
+	}
+	return regions
+}
+
+// stsEndpoint returns the regional STS endpoint probed for latency.
+var stsEndpoint = func(region string) string {
+	return fmt.Sprintf("https://sts.%s.%s/", region, DNSSuffix(PartitionForRegion(region)))
+}
+
+// MeasureRegionLatency estimates the round-trip time to region by timing an
+// unauthenticated request to its STS endpoint. The connection is warmed up
+// first so DNS, TCP and TLS setup aren't counted.
+func MeasureRegionLatency(ctx context.Context, region string) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, RegionLatencyTimeout)
+	defer cancel()
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	defer transport.CloseIdleConnections()
+	client := &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	url := stsEndpoint(region)
+	if err := headRequest(ctx, client, url); err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	if err := headRequest(ctx, client, url); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+func headRequest(ctx context.Context, client *http.Client, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -1,6 +1,10 @@
 package aws
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 )
 
@@ -24,3 +28,70 @@ func TestCommonRegions(t *testing.T) {
 		}
 	}
 }
+
+func TestRegionInfoEnabled(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{RegionOptInNotRequired, true},
+		{RegionOptedIn, true},
+		{RegionNotOptedIn, false},
+		{"", true},
+	}
+	for _, tt := range tests {
+		if got := (RegionInfo{Name: "x", OptInStatus: tt.status}).Enabled(); got != tt.want {
+			t.Errorf("Enabled() with status %q = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestSTSEndpoint(t *testing.T) {
+	if got := stsEndpoint("us-east-1"); got != "https://sts.us-east-1.amazonaws.com/" {
+		t.Errorf("stsEndpoint(us-east-1) = %q", got)
+	}
+	if got := stsEndpoint("cn-north-1"); got != "https://sts.cn-north-1.amazonaws.com.cn/" {
+		t.Errorf("stsEndpoint(cn-north-1) = %q", got)
+	}
+	if got := stsEndpoint("us-gov-west-1"); got != "https://sts.us-gov-west-1.amazonaws.com/" {
+		t.Errorf("stsEndpoint(us-gov-west-1) = %q", got)
+	}
+}
+
+func TestMeasureRegionLatency(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	orig := stsEndpoint
+	stsEndpoint = func(string) string { return srv.URL }
+	defer func() { stsEndpoint = orig }()
+
+	latency, err := MeasureRegionLatency(context.Background(), "us-east-1")
+	if err != nil {
+		t.Fatalf("MeasureRegionLatency() error = %v", err)
+	}
+	if latency <= 0 {
+		t.Errorf("latency = %v, want > 0", latency)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2 (warm-up and timed)", got)
+	}
+}
+
+func TestMeasureRegionLatency_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	orig := stsEndpoint
+	stsEndpoint = func(string) string { return url }
+	defer func() { stsEndpoint = orig }()
+
+	if _, err := MeasureRegionLatency(context.Background(), "us-east-1"); err == nil {
+		t.Error("expected error for unreachable endpoint")
+	}
+}
@@ -2,15 +2,17 @@ package aws
 
 import (
 	"context"
+	"sync"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	appconfig "github.com/clawscli/claws/internal/config"
 )
 
-// FetchAccountID fetches the AWS account ID using STS GetCallerIdentity.
+// fetchAccountID fetches the AWS account ID using STS GetCallerIdentity.
 // Returns empty string on error.
-func FetchAccountID(ctx context.Context, cfg aws.Config) string {
-	stsClient := sts.NewFromConfig(cfg)
+func fetchAccountID(ctx context.Context, stsClient *sts.Client) string {
 	identity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil || identity.Account == nil {
 		return ""
@@ -18,10 +20,60 @@ func FetchAccountID(ctx context.Context, cfg aws.Config) string {
 	return *identity.Account
 }
 
+// FetchAccountIDForContext fetches the account ID for the profile and
+// region ctx resolves to. Returns empty string on error.
 func FetchAccountIDForContext(ctx context.Context) string {
-	cfg, err := NewConfig(ctx)
+	stsClient, err := Client(ctx, sts.NewFromConfig)
 	if err != nil {
 		return ""
 	}
-	return FetchAccountID(ctx, cfg)
+	return fetchAccountID(ctx, stsClient)
+}
+
+// FetchAccountIDForSelection fetches the account ID for sel in its default
+// region. Returns empty string on error.
+func FetchAccountIDForSelection(ctx context.Context, sel appconfig.ProfileSelection) string {
+	stsClient, err := clientForSelection(ctx, sel, "", sts.NewFromConfig)
+	if err != nil {
+		return ""
+	}
+	return fetchAccountID(ctx, stsClient)
+}
+
+// FetchAccountAlias returns the IAM account alias of sel, or empty string if
+// the account has none or the lookup fails.
+func FetchAccountAlias(ctx context.Context, sel appconfig.ProfileSelection) string {
+	client, err := clientForSelection(ctx, sel, "", iam.NewFromConfig)
+	if err != nil {
+		return ""
+	}
+	out, err := client.ListAccountAliases(ctx, &iam.ListAccountAliasesInput{})
+	if err != nil || len(out.AccountAliases) == 0 {
+		return ""
+	}
+	return out.AccountAliases[0]
+}
+
+// FetchAccountAliases fetches the IAM account alias of each selected profile,
+// keyed by profile ID. Profiles without an alias are left out.
+func FetchAccountAliases(ctx context.Context) map[string]string {
+	selections := appconfig.Global().Selections()
+	aliases := make(map[string]string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, appconfig.File().MaxConcurrentFetches())
+
+	for _, sel := range selections {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if alias := FetchAccountAlias(ctx, sel); alias != "" {
+				mu.Lock()
+				aliases[sel.ID()] = alias
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return aliases
 }
@@ -5,14 +5,18 @@ import (
 	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	computeoptimizertypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	lightsailtypes "github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
 // AWSTag is a constraint for AWS tag types that have Key and Value fields.
 type AWSTag interface {
-	ec2types.Tag | iamtypes.Tag | s3types.Tag | cfntypes.Tag | computeoptimizertypes.Tag | rdstypes.Tag
+	ec2types.Tag | iamtypes.Tag | s3types.Tag | cfntypes.Tag | computeoptimizertypes.Tag | rdstypes.Tag | lightsailtypes.Tag |
+		efstypes.Tag | fsxtypes.Tag
 }
 
 // tagKeyValue extracts key and value from different AWS tag types.
@@ -30,6 +34,12 @@ func tagKeyValue[T AWSTag](tag T) (key, value *string) {
 		return t.Key, t.Value
 	case rdstypes.Tag:
 		return t.Key, t.Value
+	case lightsailtypes.Tag:
+		return t.Key, t.Value
+	case efstypes.Tag:
+		return t.Key, t.Value
+	case fsxtypes.Tag:
+		return t.Key, t.Value
 	}
 	return nil, nil
 }
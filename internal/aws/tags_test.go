@@ -5,7 +5,10 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	efstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+	fsxtypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	lightsailtypes "github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
@@ -72,6 +75,31 @@ func TestTagsToMap_S3(t *testing.T) {
 	}
 }
 
+func TestTagsToMap_Lightsail(t *testing.T) {
+	tags := []lightsailtypes.Tag{
+		{Key: aws.String("Project"), Value: aws.String("blog")},
+	}
+
+	result := TagsToMap(tags)
+
+	if result["Project"] != "blog" {
+		t.Errorf("TagsToMap()[Project] = %q, want %q", result["Project"], "blog")
+	}
+}
+
+func TestTagsToMap_FileSystems(t *testing.T) {
+	efsTags := TagsToMap([]efstypes.Tag{
+		{Key: aws.String("Name"), Value: aws.String("shared-home")},
+	})
+	fsxTags := TagsToMap([]fsxtypes.Tag{
+		{Key: aws.String("Name"), Value: aws.String("scratch")},
+	})
+
+	if efsTags["Name"] != "shared-home" || fsxTags["Name"] != "scratch" {
+		t.Errorf("TagsToMap() efs=%v fsx=%v", efsTags, fsxTags)
+	}
+}
+
 func TestTagsToMap_Empty(t *testing.T) {
 	var tags []ec2types.Tag
 
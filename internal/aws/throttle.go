@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// throttleMinBackoff is the first delay after a throttled request
+	throttleMinBackoff = 200 * time.Millisecond
+	// throttleMaxBackoff caps the delay, which doubles per throttled request
+	throttleMaxBackoff = 5 * time.Second
+	// throttleWindow is how long a service stays reported as throttled
+	throttleWindow = 30 * time.Second
+)
+
+// ThrottleStatus reports a service that AWS recently throttled in one region.
+type ThrottleStatus struct {
+	Service string
+	Region  string
+	Count   int // throttled attempts since the service was last unthrottled
+	Last    time.Time
+}
+
+// String returns e.g. "ec2 (us-east-1)".
+func (s ThrottleStatus) String() string {
+	if s.Region == "" {
+		return s.Service
+	}
+	return s.Service + " (" + s.Region + ")"
+}
+
+type throttleKey struct {
+	service string
+	region  string
+}
+
+type throttleState struct {
+	count   int
+	last    time.Time
+	backoff time.Duration
+	until   time.Time // requests wait until then
+}
+
+// throttleTracker shares backoff across every client calling a service in a
+// region, so concurrent fetches slow down together instead of each retrying
+// into the limit.
+type throttleTracker struct {
+	mu     sync.Mutex
+	states map[throttleKey]*throttleState
+	now    func() time.Time
+}
+
+var throttles = &throttleTracker{states: make(map[throttleKey]*throttleState), now: time.Now}
+
+// wait returns how long a request to key must wait before being sent.
+func (t *throttleTracker) wait(key throttleKey) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.states[key]
+	if !ok {
+		return 0
+	}
+	return max(st.until.Sub(t.now()), 0)
+}
+
+// record updates the backoff for key after an attempt: throttled attempts
+// double it, successful ones halve it until it is dropped.
+func (t *throttleTracker) record(key throttleKey, throttled bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.states[key]
+	if !throttled {
+		if ok && st.backoff > 0 {
+			st.backoff /= 2
+			if st.backoff < throttleMinBackoff {
+				st.backoff = 0
+			}
+		}
+		return
+	}
+	if !ok {
+		st = &throttleState{}
+		t.states[key] = st
+	}
+	now := t.now()
+	st.count++
+	st.last = now
+	st.backoff = min(max(st.backoff*2, throttleMinBackoff), throttleMaxBackoff)
+	st.until = now.Add(st.backoff)
+}
+
+// active returns the services throttled within throttleWindow, most recent first.
+func (t *throttleTracker) active() []ThrottleStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	var out []ThrottleStatus
+	for key, st := range t.states {
+		if now.Sub(st.last) > throttleWindow {
+			delete(t.states, key)
+			continue
+		}
+		out = append(out, ThrottleStatus{Service: key.service, Region: key.region, Count: st.count, Last: st.last})
+	}
+	slices.SortFunc(out, func(a, b ThrottleStatus) int { return b.Last.Compare(a.Last) })
+	return out
+}
+
+// Throttled returns the services AWS throttled in the last 30 seconds, most
+// recent first.
+func Throttled() []ThrottleStatus {
+	return throttles.active()
+}
+
+// throttleMiddleware delays attempts to a throttled service and records the
+// outcome of each attempt. It runs inside the SDK retry loop.
+var throttleMiddleware = middleware.FinalizeMiddlewareFunc("ClawsThrottle",
+	func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		key := throttleKey{service: strings.ToLower(awsmiddleware.GetServiceID(ctx)), region: awsmiddleware.GetRegion(ctx)}
+		if d := throttles.wait(key); d > 0 {
+			timer := time.NewTimer(d)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		out, md, err := next.HandleFinalize(ctx, in)
+		throttled := apperrors.IsThrottling(err)
+		if throttled {
+			log.Debug("request throttled", "service", key.service, "region", key.region, "error", err)
+		}
+		throttles.record(key, throttled)
+		return out, md, err
+	})
+
+// addThrottleMiddleware places throttleMiddleware right after the retry
+// middleware so each attempt is tracked, or at the end of the finalize step
+// for operations without retries.
+func addThrottleMiddleware(stack *middleware.Stack) error {
+	if err := stack.Finalize.Insert(throttleMiddleware, "Retry", middleware.After); err == nil {
+		return nil
+	}
+	return stack.Finalize.Add(throttleMiddleware, middleware.After)
+}
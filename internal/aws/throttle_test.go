@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func newTestThrottleTracker(now *time.Time) *throttleTracker {
+	return &throttleTracker{states: make(map[throttleKey]*throttleState), now: func() time.Time { return *now }}
+}
+
+func TestThrottleTracker_Backoff(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tr := newTestThrottleTracker(&now)
+	key := throttleKey{service: "ec2", region: "us-east-1"}
+
+	if d := tr.wait(key); d != 0 {
+		t.Fatalf("wait() = %v before any throttling, want 0", d)
+	}
+
+	tr.record(key, true)
+	if d := tr.wait(key); d != throttleMinBackoff {
+		t.Errorf("wait() = %v after first throttle, want %v", d, throttleMinBackoff)
+	}
+	tr.record(key, true)
+	if d := tr.wait(key); d != 2*throttleMinBackoff {
+		t.Errorf("wait() = %v after second throttle, want %v", d, 2*throttleMinBackoff)
+	}
+	for range 10 {
+		tr.record(key, true)
+	}
+	if d := tr.wait(key); d != throttleMaxBackoff {
+		t.Errorf("wait() = %v, want capped at %v", d, throttleMaxBackoff)
+	}
+
+	// Other services and regions are unaffected
+	if d := tr.wait(throttleKey{service: "ec2", region: "eu-west-1"}); d != 0 {
+		t.Errorf("wait() for another region = %v, want 0", d)
+	}
+
+	now = now.Add(throttleMaxBackoff)
+	if d := tr.wait(key); d != 0 {
+		t.Errorf("wait() = %v once the backoff elapsed, want 0", d)
+	}
+}
+
+func TestThrottleTracker_Active(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tr := newTestThrottleTracker(&now)
+
+	tr.record(throttleKey{service: "ec2", region: "us-east-1"}, true)
+	now = now.Add(time.Second)
+	tr.record(throttleKey{service: "lambda", region: "us-west-2"}, true)
+	tr.record(throttleKey{service: "s3", region: "us-west-2"}, false)
+
+	got := tr.active()
+	if len(got) != 2 {
+		t.Fatalf("active() = %v, want 2 throttled services", got)
+	}
+	if got[0].String() != "lambda (us-west-2)" || got[1].String() != "ec2 (us-east-1)" {
+		t.Errorf("active() = [%s, %s], want most recent first", got[0], got[1])
+	}
+
+	now = now.Add(throttleWindow)
+	if got := tr.active(); len(got) != 1 || got[0].Service != "lambda" {
+		t.Errorf("active() = %v, want ec2 expired", got)
+	}
+}
+
+func TestThrottleMiddleware_RecordsThrottles(t *testing.T) {
+	orig := throttles
+	now := time.Now()
+	throttles = newTestThrottleTracker(&now)
+	t.Cleanup(func() { throttles = orig })
+
+	// The region is set by the SDK client's own middleware; without it the
+	// status shows the service alone
+	ctx := awsmiddleware.SetServiceID(context.Background(), "EC2")
+
+	throttleErr := &smithy.GenericAPIError{Code: "RequestLimitExceeded"}
+	next := middleware.FinalizeHandlerFunc(func(context.Context, middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, throttleErr
+	})
+	if _, _, err := throttleMiddleware.HandleFinalize(ctx, middleware.FinalizeInput{}, next); err != throttleErr {
+		t.Fatalf("HandleFinalize() error = %v, want the throttle error passed through", err)
+	}
+
+	got := throttles.active()
+	if len(got) != 1 || got[0].String() != "ec2" || got[0].Count != 1 {
+		t.Errorf("active() = %+v, want ec2 throttled once", got)
+	}
+
+	// Other errors are not throttles
+	other := middleware.FinalizeHandlerFunc(func(context.Context, middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, &smithy.GenericAPIError{Code: "AccessDenied"}
+	})
+	ctx = awsmiddleware.SetServiceID(ctx, "Lambda")
+	_, _, _ = throttleMiddleware.HandleFinalize(ctx, middleware.FinalizeInput{}, other)
+	if got := throttles.active(); len(got) != 1 {
+		t.Errorf("active() = %+v, want AccessDenied not counted", got)
+	}
+}
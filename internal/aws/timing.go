@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// maxCallTimings is how many recent calls are kept for :profile
+	maxCallTimings = 500
+	// slowCallThreshold is the duration above which a call is logged
+	slowCallThreshold = 2 * time.Second
+)
+
+// CallTiming is one timed API call, or one DAO list made of several calls.
+type CallTiming struct {
+	Call     string // "ec2:DescribeInstances", or "ec2/instances" for a DAO list
+	Profile  string
+	Region   string
+	Duration time.Duration
+	Retries  int
+	Err      string // AWS error code or message, empty on success
+	At       time.Time
+}
+
+// IsDAO reports whether the timing covers a whole DAO list rather than a
+// single API call.
+func (c CallTiming) IsDAO() bool {
+	return !strings.Contains(c.Call, ":")
+}
+
+// timingRegistry keeps the most recent calls in a ring buffer.
+type timingRegistry struct {
+	mu    sync.Mutex
+	calls []CallTiming
+	next  int
+}
+
+var timings = &timingRegistry{}
+
+func (t *timingRegistry) record(c CallTiming) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.calls) < maxCallTimings {
+		t.calls = append(t.calls, c)
+		return
+	}
+	t.calls[t.next] = c
+	t.next = (t.next + 1) % maxCallTimings
+}
+
+// slowest returns up to n recorded calls, slowest first.
+func (t *timingRegistry) slowest(n int) []CallTiming {
+	t.mu.Lock()
+	out := slices.Clone(t.calls)
+	t.mu.Unlock()
+	slices.SortStableFunc(out, func(a, b CallTiming) int { return cmp.Compare(b.Duration, a.Duration) })
+	return out[:min(n, len(out))]
+}
+
+func (t *timingRegistry) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.calls = nil
+	t.next = 0
+}
+
+// RecordCall adds a timing to the registry shown by :profile. API calls are
+// recorded automatically; callers use this for DAO-level timings.
+func RecordCall(c CallTiming) {
+	if c.At.IsZero() {
+		c.At = time.Now()
+	}
+	if c.Duration >= slowCallThreshold {
+		log.Debug("slow call", "call", c.Call, "profile", c.Profile, "region", c.Region, "duration", c.Duration, "retries", c.Retries)
+	}
+	timings.record(c)
+}
+
+// RecordDAOCall records a DAO call that started at start, in the profile and
+// region ctx resolves to.
+func RecordDAOCall(ctx context.Context, call string, start time.Time, err error) {
+	sel := appconfig.Global().Selection()
+	if ctxSel, ok := GetSelectionFromContext(ctx); ok {
+		sel = ctxSel
+	}
+	RecordCall(CallTiming{
+		Call:     call,
+		Profile:  sel.DisplayName(),
+		Region:   CurrentRegion(ctx),
+		Duration: time.Since(start),
+		Err:      CallError(err),
+		At:       start,
+	})
+}
+
+// SlowestCalls returns up to n of the recent calls, slowest first.
+func SlowestCalls(n int) []CallTiming {
+	return timings.slowest(n)
+}
+
+// ResetCallTimings forgets every recorded call.
+func ResetCallTimings() {
+	timings.reset()
+}
+
+// CallError describes err for a CallTiming: its AWS error code if it has
+// one, otherwise its message.
+func CallError(err error) string {
+	if err == nil {
+		return ""
+	}
+	if code := apperrors.GetErrorCode(err); code != "" {
+		return code
+	}
+	return err.Error()
+}
+
+// timingMiddleware times each operation including its retries. It runs
+// after the SDK registers the service metadata, so the operation and region
+// are known.
+func timingMiddleware(profile string) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("ClawsTiming",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, md, err := next.HandleInitialize(ctx, in)
+			c := CallTiming{
+				Call:     strings.ToLower(awsmiddleware.GetServiceID(ctx)) + ":" + awsmiddleware.GetOperationName(ctx),
+				Profile:  profile,
+				Region:   awsmiddleware.GetRegion(ctx),
+				Duration: time.Since(start),
+				Err:      CallError(err),
+				At:       start,
+			}
+			if attempts, ok := retry.GetAttemptResults(md); ok && len(attempts.Results) > 1 {
+				c.Retries = len(attempts.Results) - 1
+			}
+			RecordCall(c)
+			return out, md, err
+		})
+}
+
+// addTimingMiddleware returns the API option that times every call made
+// with a config loaded for profile.
+func addTimingMiddleware(profile string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(timingMiddleware(profile), middleware.After)
+	}
+}
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestTimingRegistry_Slowest(t *testing.T) {
+	r := &timingRegistry{}
+	for i, d := range []time.Duration{30, 10, 50, 20} {
+		r.record(CallTiming{Call: fmt.Sprintf("s:Op%d", i), Duration: d * time.Millisecond})
+	}
+
+	got := r.slowest(3)
+	var calls []string
+	for _, c := range got {
+		calls = append(calls, c.Call)
+	}
+	if want := "s:Op2,s:Op0,s:Op3"; strings.Join(calls, ",") != want {
+		t.Errorf("slowest(3) = %v, want %s", calls, want)
+	}
+}
+
+func TestTimingRegistry_KeepsRecent(t *testing.T) {
+	r := &timingRegistry{}
+	for i := range maxCallTimings + 10 {
+		r.record(CallTiming{Call: fmt.Sprintf("s:Op%d", i), Duration: time.Duration(i)})
+	}
+
+	got := r.slowest(maxCallTimings * 2)
+	if len(got) != maxCallTimings {
+		t.Fatalf("kept %d calls, want %d", len(got), maxCallTimings)
+	}
+	if got[len(got)-1].Call != "s:Op10" {
+		t.Errorf("oldest kept call = %s, want s:Op10", got[len(got)-1].Call)
+	}
+}
+
+func TestTimingMiddleware(t *testing.T) {
+	orig := timings
+	timings = &timingRegistry{}
+	t.Cleanup(func() { timings = orig })
+
+	ctx := awsmiddleware.SetServiceID(context.Background(), "Lambda")
+	next := middleware.InitializeHandlerFunc(func(context.Context, middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, &smithy.GenericAPIError{Code: "AccessDeniedException"}
+	})
+	if _, _, err := timingMiddleware("prod").HandleInitialize(ctx, middleware.InitializeInput{}, next); err == nil {
+		t.Fatal("HandleInitialize() should pass the error through")
+	}
+
+	got := SlowestCalls(10)
+	if len(got) != 1 {
+		t.Fatalf("recorded %d calls, want 1", len(got))
+	}
+	c := got[0]
+	if !strings.HasPrefix(c.Call, "lambda:") || c.Profile != "prod" || c.Err != "AccessDeniedException" || c.IsDAO() {
+		t.Errorf("recorded %+v", c)
+	}
+}
@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+// Identity describes who a profile selection authenticates as, where its
+// credentials come from and how its region was chosen.
+type Identity struct {
+	Selection appconfig.ProfileSelection
+	Account   string
+	ARN       string
+	UserID    string
+
+	// CredentialSource is the SDK provider that produced the credentials
+	// (e.g. "SSOProvider"); see CredentialSourceLabel.
+	CredentialSource string
+	Expiry           CredentialExpiry
+
+	// Region is the region claws uses; RegionChain is where the SDK alone
+	// would have found one, so a mismatch explains a surprising region.
+	Region      string
+	RegionChain []RegionSource
+
+	Err error // first failure; fields resolved before it are still set
+}
+
+// RegionSource is one step of the region resolution chain.
+type RegionSource struct {
+	Name  string
+	Value string
+	Used  bool // the step that decided the effective region
+}
+
+// FetchIdentities resolves the identity of every active profile selection,
+// in selection order. Concurrency is limited by MaxConcurrentFetches.
+func FetchIdentities(ctx context.Context) []Identity {
+	selections := appconfig.Global().Selections()
+	identities := make([]Identity, len(selections))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, appconfig.File().MaxConcurrentFetches())
+	for i, sel := range selections {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			identities[i] = FetchIdentity(ctx, sel)
+		}()
+	}
+	wg.Wait()
+	return identities
+}
+
+// FetchIdentity calls GetCallerIdentity for sel and records the credential
+// source, expiry and region resolution chain used to get there.
+func FetchIdentity(ctx context.Context, sel appconfig.ProfileSelection) Identity {
+	override := CurrentRegion(ctx)
+	id := Identity{
+		Selection:   sel,
+		RegionChain: regionChain(sel, os.Getenv, profileRegion),
+	}
+
+	cfg, err := clients.config(ctx, sel, override)
+	if err != nil {
+		id.Err = fmt.Errorf("load AWS config: %w", err)
+		return id
+	}
+	id.Region = cfg.Region
+	if override == "" && id.DefaultRegion() == "" && cfg.Region != "" {
+		// Nothing configured the region, so the SDK fell back to IMDS
+		last := &id.RegionChain[len(id.RegionChain)-1]
+		last.Value, last.Used = cfg.Region, true
+	}
+
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	id.CredentialSource = creds.Source
+	if err != nil {
+		// An expired SSO token is the usual cause; still report its expiry
+		id.Expiry, _ = credentialExpiry(sel, creds)
+		id.Err = fmt.Errorf("retrieve credentials: %w", err)
+		return id
+	}
+	id.Expiry, err = credentialExpiry(sel, creds)
+	if err != nil {
+		id.Err = err
+	}
+
+	stsClient, err := clientForSelection(ctx, sel, override, sts.NewFromConfig)
+	if err != nil {
+		id.Err = err
+		return id
+	}
+	output, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		id.Err = fmt.Errorf("get caller identity: %w", err)
+		return id
+	}
+	id.Account = Str(output.Account)
+	id.ARN = Str(output.Arn)
+	id.UserID = Str(output.UserId)
+	return id
+}
+
+// credentialExpiry reports when creds stop working for sel. SSO profiles use
+// the cached SSO token's expiry (see FetchCredentialExpiry).
+func credentialExpiry(sel appconfig.ProfileSelection, creds aws.Credentials) (CredentialExpiry, error) {
+	profile := selectionProfile(sel)
+	if expiry, ok, err := ssoExpiry(profile); ok {
+		return expiry, err
+	}
+	if !creds.CanExpire {
+		return CredentialExpiry{Profile: profile}, nil
+	}
+	return CredentialExpiry{Expires: creds.Expires, Profile: profile}, nil
+}
+
+// CredentialSourceLabel describes an SDK credential provider name in terms
+// of where the credentials were configured.
+func CredentialSourceLabel(source string) string {
+	switch {
+	case source == "":
+		return "unknown"
+	case source == config.CredentialsSourceName:
+		return "environment variables (AWS_ACCESS_KEY_ID)"
+	case strings.HasPrefix(source, "SharedConfigCredentials"):
+		if _, file, ok := strings.Cut(source, ": "); ok && file != "" {
+			return "static keys in " + file
+		}
+		return "static keys in shared credentials file"
+	case source == "SSOProvider":
+		return "IAM Identity Center (SSO)"
+	case source == "AssumeRoleProvider":
+		return "assumed role (role_arn)"
+	case source == "WebIdentityCredentials":
+		return "web identity token (OIDC)"
+	case source == "EC2RoleProvider":
+		return "EC2 instance metadata (IMDS)"
+	case source == "CredentialsEndpointProvider":
+		return "container credentials endpoint (ECS/EKS)"
+	case source == "ProcessProvider":
+		return "credential_process"
+	case source == "LoginProvider":
+		return "console login (aws login)"
+	default:
+		return source
+	}
+}
+
+// DefaultRegion returns the region the SDK resolves without claws' selection
+func (id Identity) DefaultRegion() string {
+	for _, s := range id.RegionChain {
+		if s.Used {
+			return s.Value
+		}
+	}
+	return ""
+}
+
+// regionChain lists the places the SDK looks for a region, in precedence
+// order, marking the first that is set. claws passes its selected region as
+// an explicit option, which beats every step of the chain.
+func regionChain(sel appconfig.ProfileSelection, getenv func(string) string, lookupRegion func(string) string) []RegionSource {
+	chain := []RegionSource{
+		{Name: "AWS_REGION", Value: getenv("AWS_REGION")},
+		{Name: "AWS_DEFAULT_REGION", Value: getenv("AWS_DEFAULT_REGION")},
+	}
+	// Env-only selections skip the shared config files entirely
+	if profile := selectionProfile(sel); profile != "" {
+		chain = append(chain, RegionSource{
+			Name:  fmt.Sprintf("profile %s in ~/.aws/config", profile),
+			Value: lookupRegion(profile),
+		})
+	}
+	chain = append(chain, RegionSource{Name: "EC2 instance metadata (IMDS)"})
+
+	for i := range chain {
+		if chain[i].Value != "" {
+			chain[i].Used = true
+			break
+		}
+	}
+	return chain
+}
+
+// profileRegion returns the region configured for a named profile
+func profileRegion(name string) string {
+	info, ok := findProfile(name)
+	if !ok {
+		return ""
+	}
+	return info.Region
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"testing"
+
+	appconfig "github.com/clawscli/claws/internal/config"
+)
+
+func TestRegionChain(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(k string) string { return env[k] }
+	regions := map[string]string{"dev": "eu-west-1"}
+	lookup := func(p string) string { return regions[p] }
+
+	used := func(chain []RegionSource) string {
+		for _, s := range chain {
+			if s.Used {
+				return s.Name + "=" + s.Value
+			}
+		}
+		return ""
+	}
+
+	chain := regionChain(appconfig.NamedProfile("dev"), getenv, lookup)
+	if got := used(chain); got != "profile dev in ~/.aws/config=eu-west-1" {
+		t.Errorf("profile region: used %q", got)
+	}
+
+	env["AWS_DEFAULT_REGION"] = "us-west-2"
+	chain = regionChain(appconfig.NamedProfile("dev"), getenv, lookup)
+	if got := used(chain); got != "AWS_DEFAULT_REGION=us-west-2" {
+		t.Errorf("AWS_DEFAULT_REGION should beat the profile: used %q", got)
+	}
+
+	env["AWS_REGION"] = "ap-northeast-1"
+	chain = regionChain(appconfig.NamedProfile("dev"), getenv, lookup)
+	if got := used(chain); got != "AWS_REGION=ap-northeast-1" {
+		t.Errorf("AWS_REGION should win: used %q", got)
+	}
+
+	// Env-only selections ignore ~/.aws/config
+	chain = regionChain(appconfig.EnvOnly(), func(string) string { return "" }, lookup)
+	if len(chain) != 3 || used(chain) != "" {
+		t.Errorf("env-only chain = %+v, want AWS_REGION, AWS_DEFAULT_REGION, IMDS with none used", chain)
+	}
+}
+
+func TestIdentity_DefaultRegion(t *testing.T) {
+	id := Identity{RegionChain: []RegionSource{
+		{Name: "AWS_REGION"},
+		{Name: "profile dev", Value: "eu-west-1", Used: true},
+	}}
+	if got := id.DefaultRegion(); got != "eu-west-1" {
+		t.Errorf("DefaultRegion() = %q, want eu-west-1", got)
+	}
+	if got := (Identity{}).DefaultRegion(); got != "" {
+		t.Errorf("DefaultRegion() = %q, want empty", got)
+	}
+}
+
+func TestCredentialSourceLabel(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"", "unknown"},
+		{"EnvConfigCredentials", "environment variables (AWS_ACCESS_KEY_ID)"},
+		{"SharedConfigCredentials: /home/me/.aws/credentials", "static keys in /home/me/.aws/credentials"},
+		{"SSOProvider", "IAM Identity Center (SSO)"},
+		{"AssumeRoleProvider", "assumed role (role_arn)"},
+		{"EC2RoleProvider", "EC2 instance metadata (IMDS)"},
+		{"SomethingNew", "SomethingNew"},
+	}
+	for _, tt := range tests {
+		if got := CredentialSourceLabel(tt.source); got != tt.want {
+			t.Errorf("CredentialSourceLabel(%q) = %q, want %q", tt.source, got, tt.want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"cmp"
 	"maps"
 	"os"
 	"regexp"
@@ -180,9 +181,11 @@ type Config struct {
 	regions       []string
 	selections    []ProfileSelection
 	accountIDs    map[string]string
+	aliases       map[string]string // IAM account aliases by profile ID
 	warnings      []string
 	readOnly      bool
 	compactHeader bool
+	accessible    bool
 }
 
 var (
@@ -335,6 +338,43 @@ func (c *Config) GetAccountIDForProfile(profileID string) string {
 	})
 }
 
+// SetAccountAliases replaces the IAM account aliases, keyed by profile ID
+func (c *Config) SetAccountAliases(aliases map[string]string) {
+	doWithLock(&c.mu, func() {
+		c.aliases = make(map[string]string, len(aliases))
+		maps.Copy(c.aliases, aliases)
+	})
+}
+
+// GetAccountAliasForProfile returns the account alias for a profile: the
+// alias set in config.yaml, or else the IAM account alias.
+func (c *Config) GetAccountAliasForProfile(profileID string) string {
+	if alias := File().ProfileSettings(profileID).Alias; alias != "" {
+		return alias
+	}
+	return withRLock(&c.mu, func() string { return c.aliases[profileID] })
+}
+
+// AccountAlias returns the account alias of the first selected profile
+func (c *Config) AccountAlias() string {
+	key := ProfileIDSDKDefault
+	if sels := c.Selections(); len(sels) > 0 {
+		key = sels[0].ID()
+	}
+	return c.GetAccountAliasForProfile(key)
+}
+
+// AccountLabel names a profile's account as "alias 123456789012", or
+// whichever of the two is known
+func (c *Config) AccountLabel(profileID string) string {
+	id := c.GetAccountIDForProfile(profileID)
+	alias := c.GetAccountAliasForProfile(profileID)
+	if alias != "" && id != "" {
+		return alias + " " + id
+	}
+	return cmp.Or(alias, id)
+}
+
 func (c *Config) Warnings() []string {
 	return withRLock(&c.mu, func() []string { return c.warnings })
 }
@@ -355,6 +395,15 @@ func (c *Config) SetCompactHeader(compact bool) {
 	doWithLock(&c.mu, func() { c.compactHeader = compact })
 }
 
+// Accessible reports whether accessibility mode is active
+func (c *Config) Accessible() bool {
+	return withRLock(&c.mu, func() bool { return c.accessible })
+}
+
+func (c *Config) SetAccessible(accessible bool) {
+	doWithLock(&c.mu, func() { c.accessible = accessible })
+}
+
 func (c *Config) AddWarning(msg string) {
 	doWithLock(&c.mu, func() { c.warnings = append(c.warnings, msg) })
 }
@@ -309,6 +309,24 @@ func TestConfig_AccountIDs(t *testing.T) {
 	}
 }
 
+func TestConfig_AccountLabel(t *testing.T) {
+	cfg := &Config{}
+	cfg.SetAccountIDs(map[string]string{"label-a": "111111111111", "label-b": "222222222222"})
+	cfg.SetAccountAliases(map[string]string{"label-a": "acme-prod", "label-c": "acme-dev"})
+
+	tests := map[string]string{
+		"label-a": "acme-prod 111111111111",
+		"label-b": "222222222222",
+		"label-c": "acme-dev",
+		"label-d": "",
+	}
+	for profileID, want := range tests {
+		if got := cfg.AccountLabel(profileID); got != want {
+			t.Errorf("AccountLabel(%q) = %q, want %q", profileID, got, want)
+		}
+	}
+}
+
 func TestIsValidRegion(t *testing.T) {
 	tests := []struct {
 		region string
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +29,8 @@ const (
 	DefaultMetricsWindow           = 15 * time.Minute
 	DefaultMaxConcurrentFetches    = 50
 	DefaultMaxStackSize            = 100
+	DefaultHistorySize             = 200
+	DefaultDashboardColumns        = 2
 	DefaultAIMaxToolCallsPerQuery  = 50
 )
 
@@ -34,8 +39,8 @@ var (
 	configPathMu     sync.RWMutex
 )
 
-// expandTilde expands ~ to user home directory.
-func expandTilde(path string) (string, error) {
+// ExpandTilde expands ~ to user home directory.
+func ExpandTilde(path string) (string, error) {
 	if strings.HasPrefix(path, "~/") {
 		home, err := os.UserHomeDir()
 		if err != nil {
@@ -49,7 +54,7 @@ func expandTilde(path string) (string, error) {
 // SetConfigPath sets custom config file path. Must be called before File().
 // Returns error if file doesn't exist or isn't readable.
 func SetConfigPath(path string) error {
-	expanded, err := expandTilde(path)
+	expanded, err := ExpandTilde(path)
 	if err != nil {
 		return apperrors.Wrap(err, "config file", "path", path)
 	}
@@ -111,7 +116,54 @@ type TimeoutConfig struct {
 }
 
 type CloudWatchConfig struct {
-	Window Duration `yaml:"window,omitempty"`
+	Window      Duration                          `yaml:"window,omitempty"`
+	Expressions map[string]MetricExpressionConfig `yaml:"expressions,omitempty"` // keyed by "service/resource"
+}
+
+// MetricExpressionConfig defines a metric math expression that replaces the
+// default metrics column of a resource list (e.g. error rate = errors/invocations).
+// Namespace and Dimension default to the resource's built-in metric.
+type MetricExpressionConfig struct {
+	Header     string                       `yaml:"header,omitempty"`
+	Unit       string                       `yaml:"unit,omitempty"`
+	Expression string                       `yaml:"expression"`
+	Namespace  string                       `yaml:"namespace,omitempty"`
+	Dimension  string                       `yaml:"dimension,omitempty"`
+	Metrics    map[string]MetricInputConfig `yaml:"metrics"` // keyed by expression variable
+}
+
+// MetricInputConfig is a metric referenced by a metric math expression.
+type MetricInputConfig struct {
+	Name string `yaml:"name"`
+	Stat string `yaml:"stat,omitempty"` // default: Sum
+}
+
+// Tag search backends
+const (
+	TagSearchBackendAuto             = "auto" // Resource Explorer when an aggregator index exists
+	TagSearchBackendTagging          = "tagging"
+	TagSearchBackendResourceExplorer = "resource-explorer"
+)
+
+// TagSearchConfig holds tag search settings and named tag search expressions,
+// usable as `:tags @name`.
+type TagSearchConfig struct {
+	Backend string            `yaml:"backend,omitempty"` // auto (default), tagging, resource-explorer
+	Queries map[string]string `yaml:"queries,omitempty"`
+}
+
+// ConsoleConfig holds settings for opening resources in the AWS console.
+type ConsoleConfig struct {
+	// FederatedSignin wraps console links in a federated sign-in URL built
+	// from the current credentials, so no separate console login is needed.
+	FederatedSignin bool `yaml:"federated_signin,omitempty"`
+}
+
+// TrustMapConfig holds settings for the cross-account role trust map.
+type TrustMapConfig struct {
+	// AllowedAccounts are account IDs outside the selected profiles that
+	// roles may trust without being flagged, e.g. a security vendor.
+	AllowedAccounts []string `yaml:"allowed_accounts,omitempty"`
 }
 
 type ConcurrencyConfig struct {
@@ -143,6 +195,44 @@ func (s StartupConfig) GetProfiles() []string {
 
 type NavigationConfig struct {
 	MaxStackSize int `yaml:"max_stack_size,omitempty"`
+	// HistorySize is the number of :history entries kept; negative disables it
+	HistorySize int `yaml:"history_size,omitempty"`
+}
+
+// DashboardConfig arranges the dashboard. Widgets are laid out left to right,
+// top to bottom, in the order listed.
+type DashboardConfig struct {
+	Widgets []string `yaml:"widgets,omitempty"` // widget IDs, e.g. "cost", "alarms", "ec2-running"
+	Columns int      `yaml:"columns,omitempty"`
+}
+
+// StatusBarConfig customizes the status bar.
+type StatusBarConfig struct {
+	// Template lays out segments such as "{profile} • {regions} • {view}";
+	// segments that render empty are dropped with their separator.
+	Template string `yaml:"template,omitempty"`
+}
+
+// ProfileConfig holds display settings for one profile.
+type ProfileConfig struct {
+	// Color tags the profile's header border and status bar: red, yellow,
+	// green, blue, a hex color (#RRGGBB) or an ANSI 256 number
+	Color string `yaml:"color,omitempty"`
+	// Alias names the account, overriding its IAM account alias
+	Alias string `yaml:"alias,omitempty"`
+}
+
+// Production confirm policies
+const (
+	ProductionConfirmFull   = "full"   // type the whole resource ID, even for simple confirmations
+	ProductionConfirmPhrase = "phrase" // confirm again by typing "yes I mean prod"
+)
+
+// ProductionConfig escalates confirmation of API actions on production profiles.
+type ProductionConfig struct {
+	// Profiles are glob patterns matched against profile names, e.g. "*-prod"
+	Profiles []string `yaml:"profiles,omitempty"`
+	Confirm  string   `yaml:"confirm,omitempty"` // full (default), phrase
 }
 
 type AIConfig struct {
@@ -155,6 +245,39 @@ type AIConfig struct {
 	MaxToolRounds        int    `yaml:"max_tool_rounds,omitempty"`
 	MaxToolCallsPerQuery int    `yaml:"max_tool_calls_per_query,omitempty"`
 	SaveSessions         *bool  `yaml:"save_sessions,omitempty"`
+
+	// Pricing overrides in USD per million tokens, for models without a
+	// built-in price or with negotiated rates
+	InputPricePerMTok  *float64 `yaml:"input_price_per_mtok,omitempty"`
+	OutputPricePerMTok *float64 `yaml:"output_price_per_mtok,omitempty"`
+	// MonthlyBudget caps the estimated monthly spend in USD; 0 disables it
+	MonthlyBudget float64 `yaml:"monthly_budget,omitempty"`
+
+	Tools AIToolsConfig `yaml:"tools,omitempty"`
+}
+
+// AIToolsConfig limits the AI tools, in the chat and in claws mcp. Empty
+// lists place no restriction.
+type AIToolsConfig struct {
+	Allow []string `yaml:"allow,omitempty"` // only these tools are offered
+	Deny  []string `yaml:"deny,omitempty"`  // these tools are never offered
+
+	// Resource tools only reach these services, regions and account IDs
+	Services []string `yaml:"services,omitempty"`
+	Regions  []string `yaml:"regions,omitempty"`
+	Accounts []string `yaml:"accounts,omitempty"`
+
+	// MaxResults caps resources per query_resources call and log lines per
+	// tail_logs call; 0 keeps the tool defaults
+	MaxResults int `yaml:"max_results,omitempty"`
+}
+
+// ToolEnabled reports whether the allow and deny lists permit the tool.
+func (t AIToolsConfig) ToolEnabled(name string) bool {
+	if len(t.Allow) > 0 && !slices.Contains(t.Allow, name) {
+		return false
+	}
+	return !slices.Contains(t.Deny, name)
 }
 
 // ThemeConfig holds theme configuration.
@@ -199,17 +322,25 @@ func (t *ThemeConfig) UnmarshalYAML(node *yaml.Node) error {
 }
 
 type FileConfig struct {
-	mu                  sync.RWMutex      `yaml:"-"`
-	persistenceOverride *bool             `yaml:"-"`
-	Timeouts            TimeoutConfig     `yaml:"timeouts,omitempty"`
-	Concurrency         ConcurrencyConfig `yaml:"concurrency,omitempty"`
-	CloudWatch          CloudWatchConfig  `yaml:"cloudwatch,omitempty"`
-	Autosave            PersistenceConfig `yaml:"autosave,omitempty"`
-	Startup             StartupConfig     `yaml:"startup,omitempty"`
-	Theme               ThemeConfig       `yaml:"theme,omitempty"`
-	Navigation          NavigationConfig  `yaml:"navigation,omitempty"`
-	AI                  AIConfig          `yaml:"ai,omitempty"`
-	CompactHeader       bool              `yaml:"compact_header,omitempty"`
+	mu                  sync.RWMutex             `yaml:"-"`
+	persistenceOverride *bool                    `yaml:"-"`
+	Timeouts            TimeoutConfig            `yaml:"timeouts,omitempty"`
+	Concurrency         ConcurrencyConfig        `yaml:"concurrency,omitempty"`
+	CloudWatch          CloudWatchConfig         `yaml:"cloudwatch,omitempty"`
+	TagSearch           TagSearchConfig          `yaml:"tag_search,omitempty"`
+	Console             ConsoleConfig            `yaml:"console,omitempty"`
+	TrustMap            TrustMapConfig           `yaml:"trust_map,omitempty"`
+	Autosave            PersistenceConfig        `yaml:"autosave,omitempty"`
+	Startup             StartupConfig            `yaml:"startup,omitempty"`
+	Theme               ThemeConfig              `yaml:"theme,omitempty"`
+	Navigation          NavigationConfig         `yaml:"navigation,omitempty"`
+	Dashboard           DashboardConfig          `yaml:"dashboard,omitempty"`
+	StatusBar           StatusBarConfig          `yaml:"status_bar,omitempty"`
+	Profiles            map[string]ProfileConfig `yaml:"profiles,omitempty"` // keyed by profile name
+	Production          ProductionConfig         `yaml:"production,omitempty"`
+	AI                  AIConfig                 `yaml:"ai,omitempty"`
+	CompactHeader       bool                     `yaml:"compact_header,omitempty"`
+	Accessible          bool                     `yaml:"accessible,omitempty"`
 }
 
 // Duration wraps time.Duration for YAML marshal/unmarshal as string (e.g., "5s", "30s")
@@ -403,7 +534,60 @@ func (c *FileConfig) MetricsWindow() time.Duration {
 	})
 }
 
+// MetricExpression returns the configured metric math expression for a
+// resource type ("service/resource"), if any.
+func (c *FileConfig) MetricExpression(resourcePath string) (MetricExpressionConfig, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	expr, ok := c.CloudWatch.Expressions[resourcePath]
+	return expr, ok
+}
+
+// TagSearchBackend returns the configured tag search backend, defaulting to auto.
+func (c *FileConfig) TagSearchBackend() string {
+	return withRLock(&c.mu, func() string {
+		switch c.TagSearch.Backend {
+		case TagSearchBackendTagging, TagSearchBackendResourceExplorer:
+			return c.TagSearch.Backend
+		default:
+			return TagSearchBackendAuto
+		}
+	})
+}
+
+// TagSearchQuery returns the saved tag search expression with the given name.
+func (c *FileConfig) TagSearchQuery(name string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	q, ok := c.TagSearch.Queries[name]
+	return q, ok
+}
+
+// TagSearchQueryNames returns the names of saved tag search queries, sorted.
+func (c *FileConfig) TagSearchQueryNames() []string {
+	return withRLock(&c.mu, func() []string {
+		names := slices.Collect(maps.Keys(c.TagSearch.Queries))
+		slices.Sort(names)
+		return names
+	})
+}
+
+// TrustMapAllowedAccounts returns the accounts roles may trust without
+// being flagged by the trust map.
+func (c *FileConfig) TrustMapAllowedAccounts() []string {
+	return withRLock(&c.mu, func() []string {
+		return slices.Clone(c.TrustMap.AllowedAccounts)
+	})
+}
+
 // MaxStackSize returns the maximum navigation stack size.
+// ConsoleFederatedSignin reports whether console links use federated sign-in.
+func (c *FileConfig) ConsoleFederatedSignin() bool {
+	return withRLock(&c.mu, func() bool {
+		return c.Console.FederatedSignin
+	})
+}
+
 func (c *FileConfig) MaxStackSize() int {
 	return withRLock(&c.mu, func() int {
 		if c.Navigation.MaxStackSize <= 0 {
@@ -413,6 +597,75 @@ func (c *FileConfig) MaxStackSize() int {
 	})
 }
 
+// HistorySize returns how many visited views :history keeps (0 = disabled).
+func (c *FileConfig) HistorySize() int {
+	return withRLock(&c.mu, func() int {
+		switch {
+		case c.Navigation.HistorySize < 0:
+			return 0
+		case c.Navigation.HistorySize == 0:
+			return DefaultHistorySize
+		}
+		return c.Navigation.HistorySize
+	})
+}
+
+// DashboardWidgets returns the configured dashboard widget IDs, or nil for
+// the default layout.
+func (c *FileConfig) DashboardWidgets() []string {
+	return withRLock(&c.mu, func() []string {
+		return slices.Clone(c.Dashboard.Widgets)
+	})
+}
+
+// DashboardColumns returns how many widgets the dashboard shows per row.
+func (c *FileConfig) DashboardColumns() int {
+	return withRLock(&c.mu, func() int {
+		if c.Dashboard.Columns <= 0 {
+			return DefaultDashboardColumns
+		}
+		return c.Dashboard.Columns
+	})
+}
+
+// StatusBarTemplate returns the status bar template, or "" for the default layout.
+func (c *FileConfig) StatusBarTemplate() string {
+	return withRLock(&c.mu, func() string {
+		return c.StatusBar.Template
+	})
+}
+
+// ProfileSettings returns the display settings for a profile selection ID
+func (c *FileConfig) ProfileSettings(profileID string) ProfileConfig {
+	return withRLock(&c.mu, func() ProfileConfig {
+		return c.Profiles[profileID]
+	})
+}
+
+// IsProductionProfile reports whether a profile selection ID matches one of
+// the production.profiles patterns
+func (c *FileConfig) IsProductionProfile(profileID string) bool {
+	return withRLock(&c.mu, func() bool {
+		for _, pattern := range c.Production.Profiles {
+			if ok, err := path.Match(pattern, profileID); err == nil && ok {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ProductionConfirm returns the confirm policy for production profiles,
+// ProductionConfirmFull unless "phrase" is configured
+func (c *FileConfig) ProductionConfirm() string {
+	return withRLock(&c.mu, func() string {
+		if c.Production.Confirm == ProductionConfirmPhrase {
+			return ProductionConfirmPhrase
+		}
+		return ProductionConfirmFull
+	})
+}
+
 func (c *FileConfig) PersistenceEnabled() bool {
 	return withRLock(&c.mu, func() bool {
 		if c.persistenceOverride != nil {
@@ -537,6 +790,38 @@ func (c *FileConfig) GetAISaveSessions() bool {
 	})
 }
 
+// GetAIPricing returns the configured price per million input and output
+// tokens. ok is false unless both are set.
+func (c *FileConfig) GetAIPricing() (input, output float64, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.AI.InputPricePerMTok == nil || c.AI.OutputPricePerMTok == nil {
+		return 0, 0, false
+	}
+	return *c.AI.InputPricePerMTok, *c.AI.OutputPricePerMTok, true
+}
+
+// GetAIMonthlyBudget returns the monthly AI budget in USD, or 0 for none.
+func (c *FileConfig) GetAIMonthlyBudget() float64 {
+	return withRLock(&c.mu, func() float64 {
+		return max(c.AI.MonthlyBudget, 0)
+	})
+}
+
+// GetAITools returns the ai.tools restrictions.
+func (c *FileConfig) GetAITools() AIToolsConfig {
+	return withRLock(&c.mu, func() AIToolsConfig {
+		t := c.AI.Tools
+		t.Allow = slices.Clone(t.Allow)
+		t.Deny = slices.Clone(t.Deny)
+		t.Services = slices.Clone(t.Services)
+		t.Regions = slices.Clone(t.Regions)
+		t.Accounts = slices.Clone(t.Accounts)
+		t.MaxResults = max(t.MaxResults, 0)
+		return t
+	})
+}
+
 func (c *FileConfig) SaveRegions(regions []string) error {
 	if len(regions) == 0 {
 		return nil
@@ -600,6 +885,14 @@ func (c *FileConfig) GetCompactHeader() bool {
 	})
 }
 
+// GetAccessible reports whether accessibility mode is enabled in the config
+// file: no alt screen, mouse capture, or animations.
+func (c *FileConfig) GetAccessible() bool {
+	return withRLock(&c.mu, func() bool {
+		return c.Accessible
+	})
+}
+
 func (c *FileConfig) SaveCompactHeader(compact bool) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
@@ -250,6 +251,206 @@ func TestLoad_PartialConfig(t *testing.T) {
 	}
 }
 
+func TestFileConfig_MetricExpression(t *testing.T) {
+	yamlData := `
+cloudwatch:
+  expressions:
+    lambda/functions:
+      header: ERR%
+      unit: "%"
+      expression: 100 * errors / invocations
+      metrics:
+        errors: {name: Errors}
+        invocations: {name: Invocations, stat: Sum}
+`
+	cfg := DefaultFileConfig()
+	if err := yaml.Unmarshal([]byte(yamlData), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	expr, ok := cfg.MetricExpression("lambda/functions")
+	if !ok {
+		t.Fatal("MetricExpression(lambda/functions) not found")
+	}
+	if expr.Header != "ERR%" || expr.Unit != "%" || expr.Expression != "100 * errors / invocations" {
+		t.Errorf("unexpected expression config: %+v", expr)
+	}
+	if got := expr.Metrics["invocations"]; got.Name != "Invocations" || got.Stat != "Sum" {
+		t.Errorf("Metrics[invocations] = %+v", got)
+	}
+	if cfg.MetricsWindow() != DefaultMetricsWindow {
+		t.Errorf("MetricsWindow() = %v, want default", cfg.MetricsWindow())
+	}
+
+	if _, ok := cfg.MetricExpression("ec2/instances"); ok {
+		t.Error("MetricExpression(ec2/instances) should not be found")
+	}
+}
+
+func TestFileConfig_TagSearchQueries(t *testing.T) {
+	yamlData := `
+tag_search:
+  queries:
+    untagged-prod: "Environment=prod AND !Team"
+    legacy: "Generation=1 OR Generation=2"
+`
+	cfg := DefaultFileConfig()
+	if err := yaml.Unmarshal([]byte(yamlData), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if got, ok := cfg.TagSearchQuery("untagged-prod"); !ok || got != "Environment=prod AND !Team" {
+		t.Errorf("TagSearchQuery(untagged-prod) = %q, %v", got, ok)
+	}
+	if _, ok := cfg.TagSearchQuery("missing"); ok {
+		t.Error("TagSearchQuery(missing) should not be found")
+	}
+	if got := cfg.TagSearchBackend(); got != TagSearchBackendAuto {
+		t.Errorf("TagSearchBackend() = %q, want %q", got, TagSearchBackendAuto)
+	}
+	cfg.TagSearch.Backend = "resource-explorer"
+	if got := cfg.TagSearchBackend(); got != TagSearchBackendResourceExplorer {
+		t.Errorf("TagSearchBackend() = %q, want %q", got, TagSearchBackendResourceExplorer)
+	}
+	cfg.TagSearch.Backend = "bogus"
+	if got := cfg.TagSearchBackend(); got != TagSearchBackendAuto {
+		t.Errorf("TagSearchBackend() with invalid value = %q, want %q", got, TagSearchBackendAuto)
+	}
+	names := cfg.TagSearchQueryNames()
+	if len(names) != 2 || names[0] != "legacy" || names[1] != "untagged-prod" {
+		t.Errorf("TagSearchQueryNames() = %v, want [legacy untagged-prod]", names)
+	}
+}
+
+func TestFileConfig_ConsoleFederatedSignin(t *testing.T) {
+	cfg := DefaultFileConfig()
+	if cfg.ConsoleFederatedSignin() {
+		t.Error("ConsoleFederatedSignin() should default to false")
+	}
+	if err := yaml.Unmarshal([]byte("console:\n  federated_signin: true\n"), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !cfg.ConsoleFederatedSignin() {
+		t.Error("ConsoleFederatedSignin() = false, want true")
+	}
+}
+
+func TestFileConfig_HistorySize(t *testing.T) {
+	cfg := DefaultFileConfig()
+	if got := cfg.HistorySize(); got != DefaultHistorySize {
+		t.Errorf("HistorySize() = %d, want default %d", got, DefaultHistorySize)
+	}
+	if err := yaml.Unmarshal([]byte("navigation:\n  history_size: 50\n"), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := cfg.HistorySize(); got != 50 {
+		t.Errorf("HistorySize() = %d, want 50", got)
+	}
+	cfg.Navigation.HistorySize = -1
+	if got := cfg.HistorySize(); got != 0 {
+		t.Errorf("HistorySize() with negative value = %d, want 0 (disabled)", got)
+	}
+}
+
+func TestFileConfig_Dashboard(t *testing.T) {
+	cfg := DefaultFileConfig()
+	if got := cfg.DashboardWidgets(); got != nil {
+		t.Errorf("DashboardWidgets() = %v, want nil (default layout)", got)
+	}
+	if got := cfg.DashboardColumns(); got != DefaultDashboardColumns {
+		t.Errorf("DashboardColumns() = %d, want default %d", got, DefaultDashboardColumns)
+	}
+	yamlData := "dashboard:\n  columns: 3\n  widgets: [cost, ec2-running, alarms]\n"
+	if err := yaml.Unmarshal([]byte(yamlData), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := cfg.DashboardWidgets(); !slices.Equal(got, []string{"cost", "ec2-running", "alarms"}) {
+		t.Errorf("DashboardWidgets() = %v", got)
+	}
+	if got := cfg.DashboardColumns(); got != 3 {
+		t.Errorf("DashboardColumns() = %d, want 3", got)
+	}
+}
+
+func TestFileConfig_StatusBarTemplate(t *testing.T) {
+	cfg := DefaultFileConfig()
+	if got := cfg.StatusBarTemplate(); got != "" {
+		t.Errorf("StatusBarTemplate() = %q, want empty", got)
+	}
+	yamlData := "status_bar:\n  template: \"{profile} • {view}\"\n"
+	if err := yaml.Unmarshal([]byte(yamlData), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := cfg.StatusBarTemplate(); got != "{profile} • {view}" {
+		t.Errorf("StatusBarTemplate() = %q", got)
+	}
+}
+
+func TestFileConfig_ProfileSettings(t *testing.T) {
+	cfg := DefaultFileConfig()
+	if got := cfg.ProfileSettings("prod"); got != (ProfileConfig{}) {
+		t.Errorf("ProfileSettings() = %+v, want zero value", got)
+	}
+	yamlData := "profiles:\n  prod:\n    color: red\n    alias: acme-prod\n"
+	if err := yaml.Unmarshal([]byte(yamlData), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := cfg.ProfileSettings("prod"); got.Color != "red" || got.Alias != "acme-prod" {
+		t.Errorf("ProfileSettings(prod) = %+v", got)
+	}
+	if got := cfg.ProfileSettings("dev"); got != (ProfileConfig{}) {
+		t.Errorf("ProfileSettings(dev) = %+v, want zero value", got)
+	}
+}
+
+func TestFileConfig_Production(t *testing.T) {
+	cfg := DefaultFileConfig()
+	if cfg.IsProductionProfile("prod") {
+		t.Error("IsProductionProfile() = true with no patterns configured")
+	}
+	if got := cfg.ProductionConfirm(); got != ProductionConfirmFull {
+		t.Errorf("ProductionConfirm() = %q, want %q", got, ProductionConfirmFull)
+	}
+
+	yamlData := "production:\n  profiles: [prod, \"*-production\"]\n  confirm: phrase\n"
+	if err := yaml.Unmarshal([]byte(yamlData), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	for profile, want := range map[string]bool{
+		"prod":              true,
+		"acme-production":   true,
+		"prod-readonly":     false,
+		"staging":           false,
+		ProfileIDSDKDefault: false,
+	} {
+		if got := cfg.IsProductionProfile(profile); got != want {
+			t.Errorf("IsProductionProfile(%q) = %v, want %v", profile, got, want)
+		}
+	}
+	if got := cfg.ProductionConfirm(); got != ProductionConfirmPhrase {
+		t.Errorf("ProductionConfirm() = %q, want %q", got, ProductionConfirmPhrase)
+	}
+}
+
+func TestFileConfig_TrustMapAllowedAccounts(t *testing.T) {
+	cfg := DefaultFileConfig()
+	if got := cfg.TrustMapAllowedAccounts(); len(got) != 0 {
+		t.Errorf("TrustMapAllowedAccounts() = %v, want none", got)
+	}
+	yamlData := "trust_map:\n  allowed_accounts:\n    - \"111122223333\"\n    - \"444455556666\"\n"
+	if err := yaml.Unmarshal([]byte(yamlData), cfg); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	got := cfg.TrustMapAllowedAccounts()
+	if !slices.Equal(got, []string{"111122223333", "444455556666"}) {
+		t.Errorf("TrustMapAllowedAccounts() = %v", got)
+	}
+	got[0] = "changed"
+	if cfg.TrustMap.AllowedAccounts[0] != "111122223333" {
+		t.Error("TrustMapAllowedAccounts() should return a copy")
+	}
+}
+
 func TestThemeConfig_UnmarshalString(t *testing.T) {
 	var cfg ThemeConfig
 	if err := yaml.Unmarshal([]byte(`"nord"`), &cfg); err != nil {
@@ -688,6 +889,69 @@ func TestGetAIMaxToolCallsPerQuery(t *testing.T) {
 	}
 }
 
+func TestGetAIPricingAndBudget(t *testing.T) {
+	price := func(v float64) *float64 { return &v }
+
+	cfg := &FileConfig{}
+	if _, _, ok := cfg.GetAIPricing(); ok {
+		t.Error("GetAIPricing() should report unset pricing")
+	}
+	if got := cfg.GetAIMonthlyBudget(); got != 0 {
+		t.Errorf("GetAIMonthlyBudget() = %v, want 0", got)
+	}
+
+	cfg = &FileConfig{AI: AIConfig{InputPricePerMTok: price(3)}}
+	if _, _, ok := cfg.GetAIPricing(); ok {
+		t.Error("GetAIPricing() needs both prices")
+	}
+
+	cfg = &FileConfig{AI: AIConfig{InputPricePerMTok: price(3), OutputPricePerMTok: price(15), MonthlyBudget: 20}}
+	if in, out, ok := cfg.GetAIPricing(); !ok || in != 3 || out != 15 {
+		t.Errorf("GetAIPricing() = %v, %v, %v", in, out, ok)
+	}
+	if got := cfg.GetAIMonthlyBudget(); got != 20 {
+		t.Errorf("GetAIMonthlyBudget() = %v, want 20", got)
+	}
+
+	cfg = &FileConfig{AI: AIConfig{MonthlyBudget: -5}}
+	if got := cfg.GetAIMonthlyBudget(); got != 0 {
+		t.Errorf("negative budget = %v, want 0", got)
+	}
+}
+
+func TestGetAITools(t *testing.T) {
+	var cfg FileConfig
+	data := `
+ai:
+  tools:
+    deny: [search_aws_docs]
+    services: [ec2, lambda]
+    regions: [us-east-1]
+    accounts: ["123456789012"]
+    max_results: 200
+`
+	if err := yaml.Unmarshal([]byte(data), &cfg); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	tools := cfg.GetAITools()
+	if tools.MaxResults != 200 || len(tools.Services) != 2 || tools.Accounts[0] != "123456789012" {
+		t.Errorf("GetAITools() = %+v", tools)
+	}
+	if tools.ToolEnabled("search_aws_docs") || !tools.ToolEnabled("query_resources") {
+		t.Error("deny list not applied")
+	}
+
+	tools.Services[0] = "changed"
+	if cfg.GetAITools().Services[0] != "ec2" {
+		t.Error("GetAITools() should return a copy")
+	}
+
+	allow := AIToolsConfig{Allow: []string{"list_resources"}, Deny: []string{"list_resources"}}
+	if allow.ToolEnabled("list_resources") || allow.ToolEnabled("query_resources") {
+		t.Error("allow list should admit only listed tools, and deny should win")
+	}
+}
+
 func TestSetConfigPath(t *testing.T) {
 	// Create temp config file
 	tmpDir := t.TempDir()
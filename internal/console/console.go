@@ -0,0 +1,150 @@
+// Package console builds AWS Management Console deep links for resources.
+package console
+
+import (
+	"fmt"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// Partition names as they appear in ARNs.
+const (
+	PartitionAWS   = aws.PartitionAWS
+	PartitionChina = aws.PartitionChina
+	PartitionGov   = aws.PartitionGov
+)
+
+// Link identifies the resource a console URL points at.
+type Link struct {
+	Service   string // claws service, e.g. "ec2"
+	Resource  string // claws resource type, e.g. "instances"
+	ID        string
+	Name      string
+	ARN       string
+	Region    string
+	Partition string
+
+	cluster string // ECS cluster name, for resources scoped to a cluster
+}
+
+// NewLink builds a Link for resource. region is used when the resource's
+// ARN has none; the partition comes from the ARN, or else from the region.
+func NewLink(service, resType string, resource dao.Resource, region string) Link {
+	l := Link{
+		Service:  service,
+		Resource: resType,
+		ID:       resource.GetID(),
+		Name:     resource.GetName(),
+		ARN:      resource.GetARN(),
+		Region:   region,
+	}
+	if parsed := aws.ParseARN(l.ARN); parsed != nil {
+		l.Partition = parsed.Partition
+		if parsed.Region != "" {
+			l.Region = parsed.Region
+		}
+	}
+	if l.Partition == "" {
+		l.Partition = PartitionForRegion(l.Region)
+	}
+	if p, ok := resource.(clusterArnProvider); ok {
+		l.cluster = lastSegment(p.ClusterArn())
+	}
+	return l
+}
+
+type clusterArnProvider interface {
+	ClusterArn() string
+}
+
+// PartitionForRegion returns the partition a region belongs to.
+func PartitionForRegion(region string) string {
+	return aws.PartitionForRegion(region)
+}
+
+// Domain returns the console host for partition.
+func Domain(partition string) string {
+	switch partition {
+	case PartitionChina:
+		return "console.amazonaws.cn"
+	case PartitionGov:
+		return "console.amazonaws-us-gov.com"
+	default:
+		return "console.aws.amazon.com"
+	}
+}
+
+// SigninHost returns the federation sign-in host for partition.
+func SigninHost(partition string) string {
+	switch partition {
+	case PartitionChina:
+		return "signin.amazonaws.cn"
+	case PartitionGov:
+		return "signin.amazonaws-us-gov.com"
+	default:
+		return "signin.aws.amazon.com"
+	}
+}
+
+// URL returns the console deep link for l. Resource types without a
+// template link to the service's console home page.
+func (l Link) URL() string {
+	region := l.Region
+	base := "https://" + Domain(l.Partition)
+	if region != "" && !globalServices[l.Service] {
+		base = "https://" + region + "." + Domain(l.Partition)
+	}
+
+	if tmpl, ok := templates[l.Service+"/"+l.Resource]; ok {
+		return base + tmpl(l)
+	}
+	path := consolePaths[l.Service]
+	if path == "" {
+		path = l.Service
+	}
+	return fmt.Sprintf("%s/%s/home?region=%s", base, path, region)
+}
+
+// Open opens u in the default browser.
+func Open(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("open browser: %w", err)
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
+
+func q(s string) string {
+	return url.QueryEscape(s)
+}
+
+func p(s string) string {
+	return url.PathEscape(s)
+}
+
+func lastSegment(s string) string {
+	if i := strings.LastIndexAny(s, "/:"); i >= 0 {
+		return s[i+1:]
+	}
+	return s
+}
+
+// cloudWatchEscape encodes a value for the CloudWatch console's hash routes,
+// which double-escape "/" and other reserved characters as "$25xx".
+func cloudWatchEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(url.QueryEscape(s)), "%", "$")
+}
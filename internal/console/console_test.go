@@ -0,0 +1,178 @@
+package console
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	sdkaws "github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+type clusterResource struct {
+	dao.BaseResource
+	cluster string
+}
+
+func (r *clusterResource) ClusterArn() string { return r.cluster }
+
+func TestPartitionForRegion(t *testing.T) {
+	tests := map[string]string{
+		"us-east-1":     PartitionAWS,
+		"cn-north-1":    PartitionChina,
+		"us-gov-west-1": PartitionGov,
+		"":              PartitionAWS,
+	}
+	for region, want := range tests {
+		if got := PartitionForRegion(region); got != want {
+			t.Errorf("PartitionForRegion(%q) = %q, want %q", region, got, want)
+		}
+	}
+}
+
+func TestLinkURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		service  string
+		resType  string
+		resource dao.Resource
+		region   string
+		want     string
+	}{
+		{
+			name:     "ec2 instance",
+			service:  "ec2",
+			resType:  "instances",
+			resource: &dao.BaseResource{ID: "i-123"},
+			region:   "us-west-2",
+			want:     "https://us-west-2.console.aws.amazon.com/ec2/home?region=us-west-2#InstanceDetails:instanceId=i-123",
+		},
+		{
+			name:     "region from ARN wins",
+			service:  "lambda",
+			resType:  "functions",
+			resource: &dao.BaseResource{ID: "fn", ARN: "arn:aws:lambda:eu-west-1:123456789012:function:fn"},
+			region:   "us-east-1",
+			want:     "https://eu-west-1.console.aws.amazon.com/lambda/home?region=eu-west-1#/functions/fn",
+		},
+		{
+			name:     "china partition",
+			service:  "lambda",
+			resType:  "functions",
+			resource: &dao.BaseResource{ID: "fn", ARN: "arn:aws-cn:lambda:cn-north-1:123456789012:function:fn"},
+			want:     "https://cn-north-1.console.amazonaws.cn/lambda/home?region=cn-north-1#/functions/fn",
+		},
+		{
+			name:     "gov partition from region",
+			service:  "dynamodb",
+			resType:  "tables",
+			resource: &dao.BaseResource{ID: "orders"},
+			region:   "us-gov-west-1",
+			want:     "https://us-gov-west-1.console.amazonaws-us-gov.com/dynamodbv2/home?region=us-gov-west-1#table?name=orders",
+		},
+		{
+			name:     "global service",
+			service:  "iam",
+			resType:  "roles",
+			resource: &dao.BaseResource{ID: "admin", ARN: "arn:aws:iam::123456789012:role/admin"},
+			region:   "us-east-1",
+			want:     "https://console.aws.amazon.com/iam/home#/roles/details/admin",
+		},
+		{
+			name:     "log group escaping",
+			service:  "cloudwatch",
+			resType:  "log-groups",
+			resource: &dao.BaseResource{ID: "/aws/lambda/fn"},
+			region:   "us-east-1",
+			want:     "https://us-east-1.console.aws.amazon.com/cloudwatch/home?region=us-east-1#logsV2:log-groups/log-group/$252Faws$252Flambda$252Ffn",
+		},
+		{
+			name:     "ecs service in cluster",
+			service:  "ecs",
+			resType:  "services",
+			resource: &clusterResource{BaseResource: dao.BaseResource{ID: "web"}, cluster: "arn:aws:ecs:us-east-1:123456789012:cluster/prod"},
+			region:   "us-east-1",
+			want:     "https://us-east-1.console.aws.amazon.com/ecs/v2/clusters/prod/services/web/health?region=us-east-1",
+		},
+		{
+			name:     "sqs queue url",
+			service:  "sqs",
+			resType:  "queues",
+			resource: &dao.BaseResource{ID: "jobs", ARN: "arn:aws:sqs:us-east-1:123456789012:jobs"},
+			want:     "https://us-east-1.console.aws.amazon.com/sqs/v3/home?region=us-east-1#/queues/" + url.QueryEscape("https://sqs.us-east-1.amazonaws.com/123456789012/jobs"),
+		},
+		{
+			name:     "fallback to service home",
+			service:  "stepfunctions",
+			resType:  "executions",
+			resource: &dao.BaseResource{ID: "run-1"},
+			region:   "us-east-1",
+			want:     "https://us-east-1.console.aws.amazon.com/states/home?region=us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewLink(tt.service, tt.resType, tt.resource, tt.region).URL()
+			if got != tt.want {
+				t.Errorf("URL() = %q\nwant      %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSigninURLForCredentials(t *testing.T) {
+	var gotSession string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("Action") != "getSigninToken" {
+			http.Error(w, "bad action", http.StatusBadRequest)
+			return
+		}
+		gotSession = r.URL.Query().Get("Session")
+		_, _ = w.Write([]byte(`{"SigninToken":"tok en"}`))
+	}))
+	defer srv.Close()
+
+	orig := signinBaseURL
+	signinBaseURL = func(string) string { return srv.URL }
+	defer func() { signinBaseURL = orig }()
+
+	creds := sdkaws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "token"}
+	dest := "https://us-east-1.console.aws.amazon.com/ec2/home"
+	got, err := signinURLForCredentials(context.Background(), creds, dest, PartitionAWS)
+	if err != nil {
+		t.Fatalf("signinURLForCredentials() error = %v", err)
+	}
+
+	if !strings.Contains(gotSession, `"sessionToken":"token"`) {
+		t.Errorf("session = %q, want credentials JSON", gotSession)
+	}
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("Action") != "login" || q.Get("Destination") != dest || q.Get("SigninToken") != "tok en" || q.Get("Issuer") != "claws" {
+		t.Errorf("login URL query = %v", q)
+	}
+}
+
+func TestSigninURLForCredentials_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "denied", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	orig := signinBaseURL
+	signinBaseURL = func(string) string { return srv.URL }
+	defer func() { signinBaseURL = orig }()
+
+	_, err := signinURLForCredentials(context.Background(), sdkaws.Credentials{}, "https://example.com", PartitionAWS)
+	if err == nil {
+		t.Error("expected error for non-200 response")
+	}
+}
@@ -0,0 +1,105 @@
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	sdkaws "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/clawscli/claws/internal/aws"
+)
+
+const (
+	// signinIssuer is shown by the console as the link's origin.
+	signinIssuer = "claws"
+	// federationName names the session created for long-term credentials.
+	federationName = "claws"
+	// federationPolicy grants everything; the effective permissions are the
+	// intersection with the caller's own policies.
+	federationPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`
+)
+
+var (
+	signinClient = &http.Client{Timeout: 10 * time.Second}
+
+	// signinBaseURL returns the federation endpoint; replaced in tests.
+	signinBaseURL = func(partition string) string {
+		return "https://" + SigninHost(partition) + "/federation"
+	}
+)
+
+// SigninURL wraps destination in a federated sign-in link for the current
+// credentials, so the browser opens the console already signed in.
+// Long-term access keys are first exchanged via sts:GetFederationToken.
+func SigninURL(ctx context.Context, destination, partition string) (string, error) {
+	client, err := aws.Client(ctx, sts.NewFromConfig)
+	if err != nil {
+		return "", err
+	}
+	creds, err := client.Options().Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieve credentials: %w", err)
+	}
+	if creds.SessionToken == "" {
+		out, err := client.GetFederationToken(ctx, &sts.GetFederationTokenInput{
+			Name:   sdkaws.String(federationName),
+			Policy: sdkaws.String(federationPolicy),
+		})
+		if err != nil {
+			return "", fmt.Errorf("get federation token: %w", err)
+		}
+		creds = sdkaws.Credentials{
+			AccessKeyID:     aws.Str(out.Credentials.AccessKeyId),
+			SecretAccessKey: aws.Str(out.Credentials.SecretAccessKey),
+			SessionToken:    aws.Str(out.Credentials.SessionToken),
+		}
+	}
+	return signinURLForCredentials(ctx, creds, destination, partition)
+}
+
+// signinURLForCredentials exchanges temporary credentials for a sign-in
+// token and builds the login link.
+func signinURLForCredentials(ctx context.Context, creds sdkaws.Credentials, destination, partition string) (string, error) {
+	session, err := json.Marshal(map[string]string{
+		"sessionId":    creds.AccessKeyID,
+		"sessionKey":   creds.SecretAccessKey,
+		"sessionToken": creds.SessionToken,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	base := signinBaseURL(partition)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		base+"?Action=getSigninToken&Session="+url.QueryEscape(string(session)), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := signinClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("get signin token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("get signin token: %s", resp.Status)
+	}
+
+	var token struct {
+		SigninToken string `json:"SigninToken"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("decode signin token: %w", err)
+	}
+	if token.SigninToken == "" {
+		return "", fmt.Errorf("get signin token: empty token")
+	}
+
+	return base + "?Action=login&Issuer=" + q(signinIssuer) +
+		"&Destination=" + q(destination) +
+		"&SigninToken=" + q(token.SigninToken), nil
+}
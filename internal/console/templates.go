@@ -0,0 +1,165 @@
+package console
+
+import (
+	"strings"
+
+	"github.com/clawscli/claws/internal/aws"
+)
+
+// templates maps "service/resource" to the console path and fragment for a
+// resource, appended to https://{region}.{domain}.
+var templates = map[string]func(l Link) string{
+	"ec2/instances": func(l Link) string {
+		return "/ec2/home?region=" + l.Region + "#InstanceDetails:instanceId=" + q(l.ID)
+	},
+	"ec2/volumes": func(l Link) string {
+		return "/ec2/home?region=" + l.Region + "#VolumeDetails:volumeId=" + q(l.ID)
+	},
+	"ec2/security-groups": func(l Link) string {
+		return "/ec2/home?region=" + l.Region + "#SecurityGroup:groupId=" + q(l.ID)
+	},
+	"ec2/images": func(l Link) string {
+		return "/ec2/home?region=" + l.Region + "#ImageDetails:imageId=" + q(l.ID)
+	},
+	"ec2/snapshots": func(l Link) string {
+		return "/ec2/home?region=" + l.Region + "#SnapshotDetails:snapshotId=" + q(l.ID)
+	},
+	"ec2/launch-templates": func(l Link) string {
+		return "/ec2/home?region=" + l.Region + "#LaunchTemplateDetails:launchTemplateId=" + q(l.ID)
+	},
+	"vpc/vpcs": func(l Link) string {
+		return "/vpcconsole/home?region=" + l.Region + "#VpcDetails:VpcId=" + q(l.ID)
+	},
+	"vpc/subnets": func(l Link) string {
+		return "/vpcconsole/home?region=" + l.Region + "#SubnetDetails:subnetId=" + q(l.ID)
+	},
+	"s3/buckets": func(l Link) string {
+		return "/s3/buckets/" + p(l.ID) + "?region=" + l.Region
+	},
+	"lambda/functions": func(l Link) string {
+		return "/lambda/home?region=" + l.Region + "#/functions/" + p(l.ID)
+	},
+	"iam/roles": func(l Link) string {
+		return "/iam/home#/roles/details/" + p(l.ID)
+	},
+	"iam/users": func(l Link) string {
+		return "/iam/home#/users/details/" + p(l.ID)
+	},
+	"iam/groups": func(l Link) string {
+		return "/iam/home#/groups/details/" + p(l.ID)
+	},
+	"iam/policies": func(l Link) string {
+		return "/iam/home#/policies/details/" + q(l.ARN)
+	},
+	"rds/instances": func(l Link) string {
+		return "/rds/home?region=" + l.Region + "#database:id=" + q(l.ID) + ";is-cluster=false"
+	},
+	"dynamodb/tables": func(l Link) string {
+		return "/dynamodbv2/home?region=" + l.Region + "#table?name=" + q(l.ID)
+	},
+	"sqs/queues": func(l Link) string {
+		return "/sqs/v3/home?region=" + l.Region + "#/queues/" + q(queueURL(l))
+	},
+	"sns/topics": func(l Link) string {
+		return "/sns/v3/home?region=" + l.Region + "#/topic/" + l.ARN
+	},
+	"cloudformation/stacks": func(l Link) string {
+		return "/cloudformation/home?region=" + l.Region + "#/stacks/stackinfo?stackId=" + q(l.ID)
+	},
+	"cloudwatch/log-groups": func(l Link) string {
+		return "/cloudwatch/home?region=" + l.Region + "#logsV2:log-groups/log-group/" + cloudWatchEscape(l.ID)
+	},
+	"cloudwatch/alarms": func(l Link) string {
+		return "/cloudwatch/home?region=" + l.Region + "#alarmsV2:alarm/" + p(l.ID)
+	},
+	"secretsmanager/secrets": func(l Link) string {
+		return "/secretsmanager/secret?name=" + q(l.ID) + "&region=" + l.Region
+	},
+	"ssm/parameters": func(l Link) string {
+		return "/systems-manager/parameters/" + strings.TrimPrefix(l.ID, "/") + "/description?region=" + l.Region
+	},
+	"kms/keys": func(l Link) string {
+		return "/kms/home?region=" + l.Region + "#/kms/keys/" + p(l.ID)
+	},
+	"ecs/clusters": func(l Link) string {
+		return "/ecs/v2/clusters/" + p(l.ID) + "/services?region=" + l.Region
+	},
+	"ecs/services": func(l Link) string {
+		return "/ecs/v2/clusters/" + p(l.cluster) + "/services/" + p(l.ID) + "/health?region=" + l.Region
+	},
+	"eks/clusters": func(l Link) string {
+		return "/eks/home?region=" + l.Region + "#/clusters/" + p(l.ID)
+	},
+	"ecr/repositories": func(l Link) string {
+		return "/ecr/repositories/private/" + accountID(l) + "/" + l.ID + "?region=" + l.Region
+	},
+	"stepfunctions/state-machines": func(l Link) string {
+		return "/states/home?region=" + l.Region + "#/statemachines/view/" + q(l.ARN)
+	},
+	"route53/hosted-zones": func(l Link) string {
+		return "/route53/v2/hostedzones#ListRecordSets/" + strings.TrimPrefix(l.ID, "/hostedzone/")
+	},
+	"cloudfront/distributions": func(l Link) string {
+		return "/cloudfront/v4/home#/distributions/" + p(l.ID)
+	},
+	"elbv2/load-balancers": func(l Link) string {
+		return "/ec2/home?region=" + l.Region + "#LoadBalancer:loadBalancerArn=" + q(l.ARN)
+	},
+	"codebuild/projects": func(l Link) string {
+		return "/codesuite/codebuild/projects/" + p(l.ID) + "/history?region=" + l.Region
+	},
+	"codepipeline/pipelines": func(l Link) string {
+		return "/codesuite/codepipeline/pipelines/" + p(l.ID) + "/view?region=" + l.Region
+	},
+}
+
+// consolePaths maps claws services whose console path differs from the
+// service name.
+var consolePaths = map[string]string{
+	"dynamodb":       "dynamodbv2",
+	"stepfunctions":  "states",
+	"ssm":            "systems-manager",
+	"ssm-incidents":  "systems-manager/incidents",
+	"elbv2":          "ec2",
+	"vpc":            "vpcconsole",
+	"cognito-idp":    "cognito",
+	"ce":             "cost-management",
+	"budgets":        "billing",
+	"configservice":  "config",
+	"risp":           "cost-management",
+	"bedrock-agent":  "bedrock",
+	"service-quotas": "servicequotas",
+	"codebuild":      "codesuite/codebuild",
+	"codepipeline":   "codesuite/codepipeline",
+	"mq":             "amazon-mq",
+	"appconfig":      "systems-manager/appconfig",
+	"cloudhsmv2":     "cloudhsm",
+	"ds":             "directoryservicev2",
+	"dms":            "dms/v2",
+}
+
+// globalServices are served from the console's global endpoint rather than a
+// regional subdomain.
+var globalServices = map[string]bool{
+	"iam":               true,
+	"cloudfront":        true,
+	"route53":           true,
+	"organizations":     true,
+	"globalaccelerator": true,
+}
+
+// queueURL reconstructs an SQS queue URL from the queue ARN.
+func queueURL(l Link) string {
+	account := accountID(l)
+	host := "sqs." + l.Region + "." + aws.DNSSuffix(l.Partition)
+	return "https://" + host + "/" + account + "/" + l.ID
+}
+
+func accountID(l Link) string {
+	// arn:partition:service:region:account:resource
+	parts := strings.SplitN(l.ARN, ":", 6)
+	if len(parts) < 5 {
+		return ""
+	}
+	return parts[4]
+}
@@ -0,0 +1,194 @@
+// Package copyas generates ready-to-run snippets that reference a resource:
+// an aws CLI describe command, a terraform import line, or a boto3 call.
+package copyas
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// Supported formats
+const (
+	FormatCLI       = "cli"
+	FormatTerraform = "terraform"
+	FormatBoto3     = "boto3"
+)
+
+// Formats lists the supported formats in display order.
+var Formats = []string{FormatCLI, FormatTerraform, FormatBoto3}
+
+// Target identifies the resource a snippet refers to.
+type Target struct {
+	Service  string // claws service, e.g. "ec2"
+	Resource string // claws resource type, e.g. "instances"
+	ID       string
+	Name     string
+	ARN      string
+	Region   string
+	Profile  string // named profile; empty for the SDK default chain
+
+	cluster string // ECS cluster name, for resources scoped to a cluster
+}
+
+// NewTarget builds a Target for resource. region is used when the resource's
+// ARN has none.
+func NewTarget(service, resType string, resource dao.Resource, region, profile string) Target {
+	t := Target{
+		Service:  service,
+		Resource: resType,
+		ID:       resource.GetID(),
+		Name:     resource.GetName(),
+		ARN:      resource.GetARN(),
+		Region:   region,
+		Profile:  profile,
+	}
+	if parsed := aws.ParseARN(t.ARN); parsed != nil && parsed.Region != "" {
+		t.Region = parsed.Region
+	}
+	if p, ok := resource.(clusterArnProvider); ok {
+		if arn := p.ClusterArn(); arn != "" {
+			t.cluster = arn[strings.LastIndexAny(arn, "/:")+1:]
+		}
+	}
+	return t
+}
+
+type clusterArnProvider interface {
+	ClusterArn() string
+}
+
+// Supported reports whether snippets can be generated for service/resource.
+func Supported(service, resType string) bool {
+	_, ok := specs[service+"/"+resType]
+	return ok
+}
+
+// Generate returns the snippet for t in format.
+func Generate(format string, t Target) (string, error) {
+	if !slices.Contains(Formats, format) {
+		return "", fmt.Errorf("unknown format %q (want %s)", format, strings.Join(Formats, ", "))
+	}
+	s, ok := specs[t.Service+"/"+t.Resource]
+	if !ok {
+		return "", fmt.Errorf("copy as %s is not supported for %s/%s", format, t.Service, t.Resource)
+	}
+	switch format {
+	case FormatCLI:
+		return s.cliCommand(t), nil
+	case FormatTerraform:
+		return s.terraformImport(t), nil
+	default:
+		return s.boto3Snippet(t), nil
+	}
+}
+
+// cliCommand renders e.g.
+// aws ec2 describe-instances --instance-ids i-123 --region us-east-1
+func (s spec) cliCommand(t Target) string {
+	args := []string{"aws", s.cli, kebab(s.op)}
+	for _, p := range s.params {
+		args = append(args, "--"+kebab(p.name), shellQuote(p.value(t)))
+	}
+	if t.Region != "" {
+		args = append(args, "--region", t.Region)
+	}
+	if t.Profile != "" {
+		args = append(args, "--profile", shellQuote(t.Profile))
+	}
+	return strings.Join(args, " ")
+}
+
+// terraformImport renders e.g. terraform import aws_instance.web i-123
+func (s spec) terraformImport(t Target) string {
+	id := t.ID
+	if s.tfID != nil {
+		id = s.tfID(t)
+	}
+	name := t.Name
+	if name == "" {
+		name = t.ID
+	}
+	return fmt.Sprintf("terraform import %s.%s %s", s.tf, tfName(name), shellQuote(id))
+}
+
+// boto3Snippet renders a short Python script calling the describe operation
+func (s spec) boto3Snippet(t Target) string {
+	var session []string
+	if t.Profile != "" {
+		session = append(session, "profile_name="+strconv.Quote(t.Profile))
+	}
+	if t.Region != "" {
+		session = append(session, "region_name="+strconv.Quote(t.Region))
+	}
+
+	var args []string
+	for _, p := range s.params {
+		value := strconv.Quote(p.value(t))
+		if p.list {
+			value = "[" + value + "]"
+		}
+		args = append(args, p.name+"="+value)
+	}
+
+	client := s.client
+	if client == "" {
+		client = s.cli
+	}
+	return fmt.Sprintf("import boto3\n\nsession = boto3.Session(%s)\nclient = session.client(%q)\nresponse = client.%s(%s)\nprint(response)\n",
+		strings.Join(session, ", "), client, strings.ReplaceAll(kebab(s.op), "-", "_"), strings.Join(args, ", "))
+}
+
+// kebab converts an API name to the CLI's form: DescribeDBInstances becomes
+// describe-db-instances, stateMachineArn becomes state-machine-arn.
+func kebab(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				b.WriteByte('-')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// tfName turns a resource name into a Terraform resource label
+func tfName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-') {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	label := strings.Trim(b.String(), "_-")
+	if label == "" {
+		return "this"
+	}
+	if !unicode.IsLetter(rune(label[0])) {
+		label = "r_" + label
+	}
+	return label
+}
+
+// shellQuote single-quotes s when it contains characters the shell would
+// interpret.
+func shellQuote(s string) string {
+	if s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("-_./:=@,+", r))
+	}) < 0 {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,164 @@
+package copyas
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+type ecsService struct {
+	dao.BaseResource
+	cluster string
+}
+
+func (s *ecsService) ClusterArn() string { return s.cluster }
+
+func TestGenerate(t *testing.T) {
+	instance := &dao.BaseResource{
+		ID:   "i-0abc",
+		Name: "Web Server",
+		ARN:  "arn:aws:ec2:eu-west-1:123456789012:instance/i-0abc",
+	}
+	db := &dao.BaseResource{ID: "prod-db", Name: "prod-db"}
+	queue := &dao.BaseResource{
+		ID:   "jobs",
+		Name: "jobs",
+		ARN:  "arn:aws:sqs:us-east-1:123456789012:jobs",
+	}
+	cnQueue := &dao.BaseResource{
+		ID:   "jobs",
+		Name: "jobs",
+		ARN:  "arn:aws-cn:sqs:cn-north-1:123456789012:jobs",
+	}
+	svc := &ecsService{
+		BaseResource: dao.BaseResource{ID: "api", Name: "api"},
+		cluster:      "arn:aws:ecs:us-east-1:123456789012:cluster/main",
+	}
+
+	tests := []struct {
+		name    string
+		format  string
+		target  Target
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "cli uses ARN region",
+			format: FormatCLI,
+			target: NewTarget("ec2", "instances", instance, "us-east-1", ""),
+			want:   "aws ec2 describe-instances --instance-ids i-0abc --region eu-west-1",
+		},
+		{
+			name:   "cli with profile and acronym operation",
+			format: FormatCLI,
+			target: NewTarget("rds", "instances", db, "us-east-1", "prod"),
+			want:   "aws rds describe-db-instances --db-instance-identifier prod-db --region us-east-1 --profile prod",
+		},
+		{
+			name:   "cli multiple params",
+			format: FormatCLI,
+			target: NewTarget("ecs", "services", svc, "us-east-1", ""),
+			want:   "aws ecs describe-services --cluster main --services api --region us-east-1",
+		},
+		{
+			name:   "terraform label from name",
+			format: FormatTerraform,
+			target: NewTarget("ec2", "instances", instance, "", ""),
+			want:   "terraform import aws_instance.web_server i-0abc",
+		},
+		{
+			name:   "terraform composite import ID",
+			format: FormatTerraform,
+			target: NewTarget("ecs", "services", svc, "us-east-1", ""),
+			want:   "terraform import aws_ecs_service.api main/api",
+		},
+		{
+			name:   "terraform queue URL",
+			format: FormatTerraform,
+			target: NewTarget("sqs", "queues", queue, "", ""),
+			want:   "terraform import aws_sqs_queue.jobs https://sqs.us-east-1.amazonaws.com/123456789012/jobs",
+		},
+		{
+			name:   "terraform China queue URL",
+			format: FormatTerraform,
+			target: NewTarget("sqs", "queues", cnQueue, "", ""),
+			want:   "terraform import aws_sqs_queue.jobs https://sqs.cn-north-1.amazonaws.com.cn/123456789012/jobs",
+		},
+		{
+			name:   "boto3",
+			format: FormatBoto3,
+			target: NewTarget("ec2", "instances", instance, "", "dev"),
+			want: "import boto3\n\n" +
+				"session = boto3.Session(profile_name=\"dev\", region_name=\"eu-west-1\")\n" +
+				"client = session.client(\"ec2\")\n" +
+				"response = client.describe_instances(InstanceIds=[\"i-0abc\"])\n" +
+				"print(response)\n",
+		},
+		{
+			name:    "unsupported type",
+			format:  FormatCLI,
+			target:  NewTarget("glue", "jobs", db, "us-east-1", ""),
+			wantErr: true,
+		},
+		{
+			name:    "unknown format",
+			format:  "pulumi",
+			target:  NewTarget("ec2", "instances", instance, "", ""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Generate(tt.format, tt.target)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Generate() = %q, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Generate() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKebab(t *testing.T) {
+	tests := map[string]string{
+		"DescribeInstances":    "describe-instances",
+		"DescribeDBInstances":  "describe-db-instances",
+		"DBInstanceIdentifier": "db-instance-identifier",
+		"stateMachineArn":      "state-machine-arn",
+		"Id":                   "id",
+	}
+	for in, want := range tests {
+		if got := kebab(in); got != want {
+			t.Errorf("kebab(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	if got := shellQuote("/aws/lambda/fn"); got != "/aws/lambda/fn" {
+		t.Errorf("shellQuote() = %q, want unquoted", got)
+	}
+	if got := shellQuote("it's here"); got != `'it'\''s here'` {
+		t.Errorf("shellQuote() = %q", got)
+	}
+}
+
+func TestSpecsComplete(t *testing.T) {
+	for key, s := range specs {
+		if s.cli == "" || s.op == "" || s.tf == "" || len(s.params) == 0 {
+			t.Errorf("%s: incomplete spec", key)
+		}
+		if !strings.HasPrefix(s.tf, "aws_") {
+			t.Errorf("%s: terraform type %q", key, s.tf)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package copyas
+
+import "github.com/clawscli/claws/internal/aws"
+
+// spec describes how to reference one claws resource type.
+type spec struct {
+	cli    string  // aws CLI command, e.g. "s3api"
+	client string  // boto3 client name; defaults to cli
+	op     string  // API operation, e.g. "DescribeInstances"
+	params []param // operation parameters identifying the resource
+
+	tf   string                // Terraform resource type, e.g. "aws_instance"
+	tfID func(t Target) string // Terraform import ID; defaults to Target.ID
+}
+
+// param is an API parameter; its CLI flag is derived from name.
+type param struct {
+	name  string // API parameter name, e.g. "InstanceIds"
+	value func(t Target) string
+	list  bool // the API takes a list of values
+}
+
+func id(t Target) string   { return t.ID }
+func name(t Target) string { return t.Name }
+func arn(t Target) string  { return t.ARN }
+
+func ids(n string) []param   { return []param{{name: n, value: id, list: true}} }
+func byID(n string) []param  { return []param{{name: n, value: id}} }
+func byARN(n string) []param { return []param{{name: n, value: arn}} }
+
+// specs maps "service/resource" to how its snippets are built.
+var specs = map[string]spec{
+	"ec2/instances":        {cli: "ec2", op: "DescribeInstances", params: ids("InstanceIds"), tf: "aws_instance"},
+	"ec2/volumes":          {cli: "ec2", op: "DescribeVolumes", params: ids("VolumeIds"), tf: "aws_ebs_volume"},
+	"ec2/security-groups":  {cli: "ec2", op: "DescribeSecurityGroups", params: ids("GroupIds"), tf: "aws_security_group"},
+	"ec2/images":           {cli: "ec2", op: "DescribeImages", params: ids("ImageIds"), tf: "aws_ami"},
+	"ec2/snapshots":        {cli: "ec2", op: "DescribeSnapshots", params: ids("SnapshotIds"), tf: "aws_ebs_snapshot"},
+	"ec2/launch-templates": {cli: "ec2", op: "DescribeLaunchTemplates", params: ids("LaunchTemplateIds"), tf: "aws_launch_template"},
+	"vpc/vpcs":             {cli: "ec2", op: "DescribeVpcs", params: ids("VpcIds"), tf: "aws_vpc"},
+	"vpc/subnets":          {cli: "ec2", op: "DescribeSubnets", params: ids("SubnetIds"), tf: "aws_subnet"},
+	"s3/buckets":           {cli: "s3api", client: "s3", op: "HeadBucket", params: byID("Bucket"), tf: "aws_s3_bucket"},
+	"lambda/functions":     {cli: "lambda", op: "GetFunction", params: byID("FunctionName"), tf: "aws_lambda_function"},
+	"iam/roles":            {cli: "iam", op: "GetRole", params: byID("RoleName"), tf: "aws_iam_role"},
+	"iam/users":            {cli: "iam", op: "GetUser", params: byID("UserName"), tf: "aws_iam_user"},
+	"iam/groups":           {cli: "iam", op: "GetGroup", params: byID("GroupName"), tf: "aws_iam_group"},
+	"iam/policies":         {cli: "iam", op: "GetPolicy", params: byARN("PolicyArn"), tf: "aws_iam_policy", tfID: arn},
+	"rds/instances":        {cli: "rds", op: "DescribeDBInstances", params: byID("DBInstanceIdentifier"), tf: "aws_db_instance"},
+	"dynamodb/tables":      {cli: "dynamodb", op: "DescribeTable", params: byID("TableName"), tf: "aws_dynamodb_table"},
+	"sqs/queues": {
+		cli: "sqs", op: "GetQueueAttributes",
+		params: []param{
+			{name: "QueueUrl", value: queueURL},
+			{name: "AttributeNames", value: func(Target) string { return "All" }, list: true},
+		},
+		tf: "aws_sqs_queue", tfID: queueURL,
+	},
+	"sns/topics":             {cli: "sns", op: "GetTopicAttributes", params: byARN("TopicArn"), tf: "aws_sns_topic", tfID: arn},
+	"cloudformation/stacks":  {cli: "cloudformation", op: "DescribeStacks", params: []param{{name: "StackName", value: name}}, tf: "aws_cloudformation_stack", tfID: name},
+	"cloudwatch/log-groups":  {cli: "logs", op: "DescribeLogGroups", params: byID("LogGroupNamePrefix"), tf: "aws_cloudwatch_log_group"},
+	"cloudwatch/alarms":      {cli: "cloudwatch", op: "DescribeAlarms", params: ids("AlarmNames"), tf: "aws_cloudwatch_metric_alarm"},
+	"secretsmanager/secrets": {cli: "secretsmanager", op: "DescribeSecret", params: byID("SecretId"), tf: "aws_secretsmanager_secret", tfID: arn},
+	"ssm/parameters":         {cli: "ssm", op: "GetParameter", params: byID("Name"), tf: "aws_ssm_parameter"},
+	"kms/keys":               {cli: "kms", op: "DescribeKey", params: byID("KeyId"), tf: "aws_kms_key"},
+	"ecs/clusters":           {cli: "ecs", op: "DescribeClusters", params: ids("clusters"), tf: "aws_ecs_cluster"},
+	"ecs/services": {
+		cli: "ecs", op: "DescribeServices",
+		params: []param{
+			{name: "cluster", value: cluster},
+			{name: "services", value: id, list: true},
+		},
+		tf: "aws_ecs_service", tfID: func(t Target) string { return t.cluster + "/" + t.ID },
+	},
+	"eks/clusters":                 {cli: "eks", op: "DescribeCluster", params: byID("name"), tf: "aws_eks_cluster"},
+	"ecr/repositories":             {cli: "ecr", op: "DescribeRepositories", params: ids("repositoryNames"), tf: "aws_ecr_repository"},
+	"stepfunctions/state-machines": {cli: "stepfunctions", op: "DescribeStateMachine", params: byARN("stateMachineArn"), tf: "aws_sfn_state_machine", tfID: arn},
+	"route53/hosted-zones":         {cli: "route53", op: "GetHostedZone", params: byID("Id"), tf: "aws_route53_zone"},
+	"cloudfront/distributions":     {cli: "cloudfront", op: "GetDistribution", params: byID("Id"), tf: "aws_cloudfront_distribution"},
+	"elbv2/load-balancers":         {cli: "elbv2", op: "DescribeLoadBalancers", params: []param{{name: "LoadBalancerArns", value: arn, list: true}}, tf: "aws_lb", tfID: arn},
+	"codebuild/projects":           {cli: "codebuild", op: "BatchGetProjects", params: ids("names"), tf: "aws_codebuild_project"},
+	"codepipeline/pipelines":       {cli: "codepipeline", op: "GetPipeline", params: byID("name"), tf: "aws_codepipeline"},
+}
+
+func cluster(t Target) string { return t.cluster }
+
+// queueURL reconstructs an SQS queue URL from the queue ARN.
+func queueURL(t Target) string {
+	parsed := aws.ParseARN(t.ARN)
+	if parsed == nil {
+		return t.ID
+	}
+	host := "sqs." + t.Region + "." + aws.DNSSuffix(aws.PartitionForARN(t.ARN))
+	return "https://" + host + "/" + parsed.AccountID + "/" + t.ID
+}
@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"context"
+	"strings"
+)
+
+// Generic List filters, set with WithFilter. DAOs whose API can filter
+// server-side apply the ones they support; callers re-check every result
+// with MatchesListFilters, so applying them is an optimization a DAO may
+// skip.
+const (
+	FilterTag          = "Tag"          // key=value, exact and case-sensitive
+	FilterNameContains = "NameContains" // case-insensitive substring of GetName
+	FilterStatus       = "Status"       // case-insensitive, see GetResourceStatus
+)
+
+// TagFilterFromContext returns the key and value of the FilterTag filter.
+func TagFilterFromContext(ctx context.Context) (key, value string, ok bool) {
+	key, value, ok = strings.Cut(GetFilterFromContext(ctx, FilterTag), "=")
+	return key, value, ok && key != ""
+}
+
+type statusResource interface {
+	Status() string
+}
+
+type stateResource interface {
+	State() string
+}
+
+// GetResourceStatus returns the resource's Status(), or its State() when it
+// has no status, e.g. "running" for an EC2 instance.
+func GetResourceStatus(res Resource) string {
+	unwrapped := UnwrapResource(res)
+	if sr, ok := unwrapped.(statusResource); ok {
+		return sr.Status()
+	}
+	if sr, ok := unwrapped.(stateResource); ok {
+		return sr.State()
+	}
+	return ""
+}
+
+// MatchesListFilters reports whether res passes the generic List filters in
+// ctx. A resource without a status never matches a FilterStatus filter.
+func MatchesListFilters(ctx context.Context, res Resource) bool {
+	if v := GetFilterFromContext(ctx, FilterTag); v != "" {
+		key, value, ok := TagFilterFromContext(ctx)
+		if !ok {
+			return false
+		}
+		if got, found := res.GetTags()[key]; !found || got != value {
+			return false
+		}
+	}
+	if v := GetFilterFromContext(ctx, FilterNameContains); v != "" {
+		if !strings.Contains(strings.ToLower(res.GetName()), strings.ToLower(v)) {
+			return false
+		}
+	}
+	if v := GetFilterFromContext(ctx, FilterStatus); v != "" {
+		if !strings.EqualFold(GetResourceStatus(res), v) {
+			return false
+		}
+	}
+	return true
+}
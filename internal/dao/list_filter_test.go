@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"context"
+	"testing"
+)
+
+type stateTestResource struct {
+	BaseResource
+	state string
+}
+
+func (r *stateTestResource) State() string { return r.state }
+
+func TestMatchesListFilters(t *testing.T) {
+	web := &stateTestResource{
+		BaseResource: BaseResource{ID: "i-1", Name: "Web-Server", Tags: map[string]string{"Env": "prod"}},
+		state:        "running",
+	}
+
+	tests := []struct {
+		name    string
+		filters map[string]string
+		want    bool
+	}{
+		{"no filters", nil, true},
+		{"tag equals", map[string]string{FilterTag: "Env=prod"}, true},
+		{"tag value differs", map[string]string{FilterTag: "Env=Prod"}, false},
+		{"tag missing", map[string]string{FilterTag: "Team=core"}, false},
+		{"tag without value", map[string]string{FilterTag: "Env"}, false},
+		{"name contains", map[string]string{FilterNameContains: "web"}, true},
+		{"name does not contain", map[string]string{FilterNameContains: "db"}, false},
+		{"status equals", map[string]string{FilterStatus: "RUNNING"}, true},
+		{"status differs", map[string]string{FilterStatus: "stopped"}, false},
+		{"all match", map[string]string{FilterTag: "Env=prod", FilterNameContains: "server", FilterStatus: "running"}, true},
+		{"one fails", map[string]string{FilterTag: "Env=prod", FilterStatus: "stopped"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			for k, v := range tt.filters {
+				ctx = WithFilter(ctx, k, v)
+			}
+			if got := MatchesListFilters(ctx, web); got != tt.want {
+				t.Errorf("MatchesListFilters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetResourceStatus(t *testing.T) {
+	if got := GetResourceStatus(&stateTestResource{state: "available"}); got != "available" {
+		t.Errorf("GetResourceStatus() = %q, want available", got)
+	}
+	if got := GetResourceStatus(&BaseResource{}); got != "" {
+		t.Errorf("GetResourceStatus() without status = %q", got)
+	}
+	ctx := WithFilter(context.Background(), FilterStatus, "running")
+	if MatchesListFilters(ctx, &BaseResource{}) {
+		t.Error("resource without status should not match a status filter")
+	}
+}
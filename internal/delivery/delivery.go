@@ -0,0 +1,375 @@
+// Package delivery traces where messages published to an SNS topic or
+// matched by an EventBridge rule go: subscriptions and targets, the queues
+// they feed, the Lambda functions consuming those queues and the dead-letter
+// queues catching what fails, with per-hop CloudWatch failure metrics.
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// maxDepth bounds the trace, e.g. rule → topic → queue → function.
+const maxDepth = 6
+
+// Kind is what a node of the chain is.
+type Kind string
+
+const (
+	KindTopic        Kind = "topic"
+	KindRule         Kind = "rule"
+	KindQueue        Kind = "queue"
+	KindFunction     Kind = "function"
+	KindStateMachine Kind = "state-machine"
+	// KindEndpoint is any other destination, e.g. an email address, an
+	// HTTPS URL or a Kinesis stream. Endpoints are not traced further.
+	KindEndpoint Kind = "endpoint"
+)
+
+// Node is one hop of a delivery chain.
+type Node struct {
+	Kind Kind
+	ARN  string // empty for endpoints that are not AWS resources
+	Name string
+	// Via is how the parent delivers to this node, e.g. "sqs subscription",
+	// "target" or "event source mapping".
+	Via string
+	// Notes qualify the hop, e.g. "filter policy" or "disabled".
+	Notes []string
+	// DeadLetter marks a dead-letter queue: every message in it failed.
+	DeadLetter bool
+	Metrics    []Metric
+	Children   []*Node
+	Err        string
+}
+
+// Failing reports whether any failure metric of the node is non-zero.
+func (n *Node) Failing() bool {
+	for _, m := range n.Metrics {
+		if m.Failing() {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk calls fn for n and every node below it, depth first.
+func (n *Node) Walk(fn func(*Node)) {
+	fn(n)
+	for _, c := range n.Children {
+		c.Walk(fn)
+	}
+}
+
+// Metric is a CloudWatch statistic of a node over the metrics window.
+type Metric struct {
+	Label   string // e.g. "failed"
+	Value   float64
+	HasData bool
+	// Failure marks metrics counting failed deliveries; a non-zero value
+	// flags the node.
+	Failure bool
+	// Seconds marks a duration metric, e.g. the age of the oldest message.
+	Seconds bool
+
+	namespace  string
+	name       string
+	stat       string
+	dimensions map[string]string
+}
+
+// Failing reports whether the metric counts failures and saw any.
+func (m Metric) Failing() bool {
+	return m.Failure && m.Value > 0
+}
+
+// Result is a traced delivery chain.
+type Result struct {
+	Root   *Node
+	Errors []string
+}
+
+// Failing returns the nodes whose failure metrics are non-zero.
+func (r *Result) Failing() []*Node {
+	var failing []*Node
+	r.Root.Walk(func(n *Node) {
+		if n.Failing() {
+			failing = append(failing, n)
+		}
+	})
+	return failing
+}
+
+// Subscription is an SNS topic subscription.
+type Subscription struct {
+	Protocol string
+	Endpoint string
+	Pending  bool
+	Filtered bool
+	Raw      bool
+	DLQ      string // ARN of the subscription's dead-letter queue
+}
+
+// Target is an EventBridge rule target.
+type Target struct {
+	ARN string
+	DLQ string
+}
+
+// Consumer is a Lambda event source mapping reading a queue.
+type Consumer struct {
+	FunctionARN string
+	State       string
+	BatchSize   int32
+}
+
+// source reads the chain from AWS. Tests substitute a fake.
+type source interface {
+	Subscriptions(ctx context.Context, topicARN string) ([]Subscription, error)
+	Targets(ctx context.Context, ruleARN string) ([]Target, error)
+	QueueDLQ(ctx context.Context, queueARN string) (string, error)
+	Consumers(ctx context.Context, queueARN string) ([]Consumer, error)
+	// Metrics fills in the value of each metric of nodes in region.
+	Metrics(ctx context.Context, region string, nodes []*Node) error
+}
+
+// Supported reports whether a resource type can be traced.
+func Supported(service, resourceType string) bool {
+	return (service == "sns" && resourceType == "topics") || (service == "events" && resourceType == "rules")
+}
+
+// Trace follows the delivery chain starting at an SNS topic or EventBridge
+// rule ARN. ctx should carry the profile to read it with.
+func Trace(ctx context.Context, arn string) (*Result, error) {
+	return trace(ctx, newSDKSource(), arn)
+}
+
+func trace(ctx context.Context, src source, arn string) (*Result, error) {
+	root := newNode(arn, "")
+	if root.Kind != KindTopic && root.Kind != KindRule {
+		return nil, fmt.Errorf("cannot trace %s: not an SNS topic or EventBridge rule", arn)
+	}
+	t := &tracer{src: src, seen: make(map[string]bool)}
+	t.follow(ctx, root, 0)
+
+	// Fetch metrics per region, since a chain may cross regions
+	byRegion := make(map[string][]*Node)
+	var regions []string
+	root.Walk(func(n *Node) {
+		setMetrics(n)
+		if len(n.Metrics) == 0 {
+			return
+		}
+		region := regionOf(n.ARN)
+		if _, ok := byRegion[region]; !ok {
+			regions = append(regions, region)
+		}
+		byRegion[region] = append(byRegion[region], n)
+	})
+	for _, region := range regions {
+		if err := src.Metrics(ctx, region, byRegion[region]); err != nil {
+			t.errors = append(t.errors, fmt.Sprintf("metrics: %v", err))
+		}
+	}
+	return &Result{Root: root, Errors: t.errors}, nil
+}
+
+type tracer struct {
+	src    source
+	seen   map[string]bool
+	errors []string
+}
+
+// follow adds the nodes n delivers to as its children
+func (t *tracer) follow(ctx context.Context, n *Node, depth int) {
+	if n.ARN == "" {
+		return
+	}
+	if t.seen[n.ARN] {
+		n.Notes = append(n.Notes, "shown above")
+		return
+	}
+	t.seen[n.ARN] = true
+	if depth >= maxDepth {
+		n.Notes = append(n.Notes, "not traced further")
+		return
+	}
+
+	switch n.Kind {
+	case KindTopic:
+		subs, err := t.src.Subscriptions(ctx, n.ARN)
+		if err != nil {
+			t.fail(n, err)
+			return
+		}
+		for _, s := range subs {
+			child := subscriptionNode(s)
+			n.Children = append(n.Children, child)
+			t.follow(ctx, child, depth+1)
+			if s.DLQ != "" {
+				child.Children = append(child.Children, t.dlq(s.DLQ, "subscription dead-letter queue"))
+			}
+		}
+
+	case KindRule:
+		targets, err := t.src.Targets(ctx, n.ARN)
+		if err != nil {
+			t.fail(n, err)
+			return
+		}
+		for _, target := range targets {
+			child := newNode(target.ARN, "target")
+			n.Children = append(n.Children, child)
+			t.follow(ctx, child, depth+1)
+			if target.DLQ != "" {
+				child.Children = append(child.Children, t.dlq(target.DLQ, "target dead-letter queue"))
+			}
+		}
+
+	case KindQueue:
+		consumers, err := t.src.Consumers(ctx, n.ARN)
+		if err != nil {
+			t.fail(n, err)
+		}
+		for _, c := range consumers {
+			child := newNode(c.FunctionARN, "event source mapping")
+			if c.State != "" && c.State != "Enabled" {
+				child.Notes = append(child.Notes, strings.ToLower(c.State))
+			}
+			if c.BatchSize > 0 {
+				child.Notes = append(child.Notes, fmt.Sprintf("batch %d", c.BatchSize))
+			}
+			n.Children = append(n.Children, child)
+			t.follow(ctx, child, depth+1)
+		}
+		dlq, err := t.src.QueueDLQ(ctx, n.ARN)
+		if err != nil {
+			t.fail(n, err)
+		} else if dlq != "" {
+			n.Children = append(n.Children, t.dlq(dlq, "redrive"))
+		}
+	}
+}
+
+// dlq returns the node of a dead-letter queue. It is not traced further:
+// its consumers replay failures rather than deliver new messages.
+func (t *tracer) dlq(arn, via string) *Node {
+	n := newNode(arn, via)
+	n.DeadLetter = true
+	return n
+}
+
+func (t *tracer) fail(n *Node, err error) {
+	n.Err = err.Error()
+	t.errors = append(t.errors, fmt.Sprintf("%s: %v", n.Name, err))
+}
+
+// subscriptionNode returns the node a subscription delivers to
+func subscriptionNode(s Subscription) *Node {
+	var n *Node
+	if appaws.IsARN(s.Endpoint) {
+		n = newNode(s.Endpoint, s.Protocol+" subscription")
+	} else {
+		n = &Node{Kind: KindEndpoint, Name: s.Endpoint, Via: s.Protocol + " subscription"}
+	}
+	if s.Pending {
+		n.Notes = append(n.Notes, "pending confirmation")
+	}
+	if s.Filtered {
+		n.Notes = append(n.Notes, "filter policy")
+	}
+	if s.Raw {
+		n.Notes = append(n.Notes, "raw")
+	}
+	return n
+}
+
+// newNode returns the node of the resource arn names
+func newNode(arn, via string) *Node {
+	n := &Node{Kind: KindEndpoint, ARN: arn, Name: arn, Via: via}
+	parsed := appaws.ParseARN(arn)
+	if parsed == nil {
+		return n
+	}
+	switch {
+	case parsed.Service == "sns" && parsed.ResourceType == "topic":
+		n.Kind, n.Name = KindTopic, parsed.ResourceID
+	case parsed.Service == "sqs":
+		n.Kind, n.Name = KindQueue, parsed.ResourceID
+	case parsed.Service == "lambda" && parsed.ResourceType == "function":
+		n.Kind, n.Name = KindFunction, parsed.ResourceID
+	case parsed.Service == "states" && parsed.ResourceType == "stateMachine":
+		n.Kind, n.Name = KindStateMachine, parsed.ResourceID
+	case parsed.Service == "events" && parsed.ResourceType == "rule":
+		n.Kind, n.Name = KindRule, parsed.ResourceID
+	}
+	return n
+}
+
+// setMetrics sets the metrics fetched for n
+func setMetrics(n *Node) {
+	switch n.Kind {
+	case KindTopic:
+		dims := map[string]string{"TopicName": n.Name}
+		n.Metrics = []Metric{
+			{Label: "published", namespace: "AWS/SNS", name: "NumberOfMessagesPublished", stat: "Sum", dimensions: dims},
+			{Label: "delivered", namespace: "AWS/SNS", name: "NumberOfNotificationsDelivered", stat: "Sum", dimensions: dims},
+			{Label: "failed", Failure: true, namespace: "AWS/SNS", name: "NumberOfNotificationsFailed", stat: "Sum", dimensions: dims},
+		}
+	case KindRule:
+		// Rules on the default bus are named "rule"; others "bus/rule"
+		dims := map[string]string{"RuleName": n.Name}
+		if bus, rule, ok := cutBus(n.Name); ok {
+			dims = map[string]string{"EventBusName": bus, "RuleName": rule}
+		}
+		n.Metrics = []Metric{
+			{Label: "matched", namespace: "AWS/Events", name: "TriggeredRules", stat: "Sum", dimensions: dims},
+			{Label: "invoked", namespace: "AWS/Events", name: "Invocations", stat: "Sum", dimensions: dims},
+			{Label: "failed", Failure: true, namespace: "AWS/Events", name: "FailedInvocations", stat: "Sum", dimensions: dims},
+		}
+	case KindQueue:
+		dims := map[string]string{"QueueName": n.Name}
+		if n.DeadLetter {
+			n.Metrics = []Metric{
+				{Label: "messages", Failure: true, namespace: "AWS/SQS", name: "ApproximateNumberOfMessagesVisible", stat: "Maximum", dimensions: dims},
+			}
+			return
+		}
+		n.Metrics = []Metric{
+			{Label: "received", namespace: "AWS/SQS", name: "NumberOfMessagesReceived", stat: "Sum", dimensions: dims},
+			{Label: "backlog", namespace: "AWS/SQS", name: "ApproximateNumberOfMessagesVisible", stat: "Maximum", dimensions: dims},
+			{Label: "oldest", Seconds: true, namespace: "AWS/SQS", name: "ApproximateAgeOfOldestMessage", stat: "Maximum", dimensions: dims},
+		}
+	case KindFunction:
+		// Metrics of the function as a whole, whatever alias was targeted
+		name, _, _ := strings.Cut(n.Name, ":")
+		dims := map[string]string{"FunctionName": name}
+		n.Metrics = []Metric{
+			{Label: "invocations", namespace: "AWS/Lambda", name: "Invocations", stat: "Sum", dimensions: dims},
+			{Label: "errors", Failure: true, namespace: "AWS/Lambda", name: "Errors", stat: "Sum", dimensions: dims},
+			{Label: "throttles", Failure: true, namespace: "AWS/Lambda", name: "Throttles", stat: "Sum", dimensions: dims},
+		}
+	case KindStateMachine:
+		dims := map[string]string{"StateMachineArn": n.ARN}
+		n.Metrics = []Metric{
+			{Label: "started", namespace: "AWS/States", name: "ExecutionsStarted", stat: "Sum", dimensions: dims},
+			{Label: "failed", Failure: true, namespace: "AWS/States", name: "ExecutionsFailed", stat: "Sum", dimensions: dims},
+		}
+	}
+}
+
+// cutBus splits the "bus/rule" name of a rule on a custom event bus
+func cutBus(name string) (bus, rule string, ok bool) {
+	return strings.Cut(name, "/")
+}
+
+// regionOf returns the region of an ARN, or "" for the current region
+func regionOf(arn string) string {
+	if parsed := appaws.ParseARN(arn); parsed != nil {
+		return parsed.Region
+	}
+	return ""
+}
@@ -0,0 +1,203 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+const (
+	topicARN   = "arn:aws:sns:us-east-1:111122223333:orders"
+	queueARN   = "arn:aws:sqs:us-east-1:111122223333:orders-worker"
+	dlqARN     = "arn:aws:sqs:us-east-1:111122223333:orders-worker-dlq"
+	subDLQARN  = "arn:aws:sqs:us-east-1:111122223333:orders-sub-dlq"
+	funcARN    = "arn:aws:lambda:us-east-1:111122223333:function:process-order"
+	auditARN   = "arn:aws:lambda:eu-west-1:111122223333:function:audit:live"
+	ruleARN    = "arn:aws:events:us-east-1:111122223333:rule/orders-bus/order-placed"
+	machineARN = "arn:aws:states:us-east-1:111122223333:stateMachine:fulfil"
+)
+
+type fakeSource struct {
+	subs      map[string][]Subscription
+	targets   map[string][]Target
+	dlqs      map[string]string
+	consumers map[string][]Consumer
+	// values are the metric values by node name and label
+	values  map[string]map[string]float64
+	regions []string
+}
+
+func (f *fakeSource) Subscriptions(_ context.Context, arn string) ([]Subscription, error) {
+	return f.subs[arn], nil
+}
+
+func (f *fakeSource) Targets(_ context.Context, arn string) ([]Target, error) {
+	return f.targets[arn], nil
+}
+
+func (f *fakeSource) QueueDLQ(_ context.Context, arn string) (string, error) {
+	return f.dlqs[arn], nil
+}
+
+func (f *fakeSource) Consumers(_ context.Context, arn string) ([]Consumer, error) {
+	return f.consumers[arn], nil
+}
+
+func (f *fakeSource) Metrics(_ context.Context, region string, nodes []*Node) error {
+	f.regions = append(f.regions, region)
+	for _, n := range nodes {
+		for i := range n.Metrics {
+			if v, ok := f.values[n.Name][n.Metrics[i].Label]; ok {
+				n.Metrics[i].record([]float64{v})
+			}
+		}
+	}
+	return nil
+}
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{
+		subs: map[string][]Subscription{
+			topicARN: {
+				{Protocol: "sqs", Endpoint: queueARN, Raw: true, DLQ: subDLQARN},
+				{Protocol: "lambda", Endpoint: auditARN, Filtered: true},
+				{Protocol: "email", Endpoint: "ops@example.com", Pending: true},
+			},
+		},
+		targets: map[string][]Target{
+			ruleARN: {{ARN: topicARN}, {ARN: machineARN, DLQ: dlqARN}},
+		},
+		dlqs: map[string]string{queueARN: dlqARN},
+		consumers: map[string][]Consumer{
+			queueARN: {{FunctionARN: funcARN, State: "Enabled", BatchSize: 10}},
+		},
+		values: map[string]map[string]float64{
+			"process-order":     {"invocations": 40, "errors": 2},
+			"orders-worker-dlq": {"messages": 3},
+			"orders":            {"published": 120},
+		},
+	}
+}
+
+// shape renders the tree as "via>kind:name" lines indented by depth
+func shape(n *Node, depth int, out *[]string) {
+	line := ""
+	for range depth {
+		line += "  "
+	}
+	if n.Via != "" {
+		line += n.Via + ">"
+	}
+	*out = append(*out, line+string(n.Kind)+":"+n.Name)
+	for _, c := range n.Children {
+		shape(c, depth+1, out)
+	}
+}
+
+func TestTraceTopic(t *testing.T) {
+	src := newFakeSource()
+	result, err := trace(context.Background(), src, topicARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	shape(result.Root, 0, &got)
+	want := []string{
+		"topic:orders",
+		"  sqs subscription>queue:orders-worker",
+		"    event source mapping>function:process-order",
+		"    redrive>queue:orders-worker-dlq",
+		"    subscription dead-letter queue>queue:orders-sub-dlq",
+		"  lambda subscription>function:audit:live",
+		"  email subscription>endpoint:ops@example.com",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("tree =\n%v\nwant\n%v", got, want)
+	}
+
+	var failing []string
+	for _, n := range result.Failing() {
+		failing = append(failing, n.Name)
+	}
+	if !slices.Equal(failing, []string{"process-order", "orders-worker-dlq"}) {
+		t.Errorf("failing = %v", failing)
+	}
+	if !slices.Equal(src.regions, []string{"us-east-1", "eu-west-1"}) {
+		t.Errorf("metrics fetched in %v", src.regions)
+	}
+
+	audit := result.Root.Children[1]
+	if dims := audit.Metrics[0].dimensions; dims["FunctionName"] != "audit" {
+		t.Errorf("alias target dimensions = %v, want the function name", dims)
+	}
+	if email := result.Root.Children[2]; !slices.Equal(email.Notes, []string{"pending confirmation"}) || len(email.Metrics) != 0 {
+		t.Errorf("email endpoint = %+v", email)
+	}
+}
+
+func TestTraceRule(t *testing.T) {
+	result, err := trace(context.Background(), newFakeSource(), ruleARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := result.Root
+	if root.Kind != KindRule || root.Metrics[0].dimensions["EventBusName"] != "orders-bus" || root.Metrics[0].dimensions["RuleName"] != "order-placed" {
+		t.Errorf("rule node = %+v", root)
+	}
+	if len(root.Children) != 2 || root.Children[0].Kind != KindTopic || len(root.Children[0].Children) != 3 {
+		t.Fatalf("rule should fan out through the topic: %+v", root.Children)
+	}
+	machine := root.Children[1]
+	if machine.Kind != KindStateMachine || len(machine.Children) != 1 || !machine.Children[0].DeadLetter {
+		t.Errorf("state machine target = %+v", machine)
+	}
+	if len(result.Errors) != 0 {
+		t.Errorf("errors = %v", result.Errors)
+	}
+}
+
+func TestTraceRejectsOtherResources(t *testing.T) {
+	if _, err := trace(context.Background(), newFakeSource(), queueARN); err == nil {
+		t.Error("tracing a queue should fail")
+	}
+	if !Supported("sns", "topics") || !Supported("events", "rules") || Supported("sqs", "queues") {
+		t.Error("Supported() mismatch")
+	}
+}
+
+func TestDeadLetterTarget(t *testing.T) {
+	if got := deadLetterTarget(`{"deadLetterTargetArn":"` + dlqARN + `","maxReceiveCount":5}`); got != dlqARN {
+		t.Errorf("deadLetterTarget() = %q", got)
+	}
+	if deadLetterTarget("") != "" || deadLetterTarget("{") != "" {
+		t.Error("invalid policies should have no target")
+	}
+}
+
+func TestMetricRecord(t *testing.T) {
+	sum := Metric{stat: "Sum"}
+	sum.record([]float64{1, 2, 3})
+	peak := Metric{stat: "Maximum"}
+	peak.record([]float64{4, 9, 2})
+	if sum.Value != 6 || peak.Value != 9 || !sum.HasData {
+		t.Errorf("sum = %v, max = %v", sum.Value, peak.Value)
+	}
+}
+
+type failingSource struct{ fakeSource }
+
+func (failingSource) Subscriptions(context.Context, string) ([]Subscription, error) {
+	return nil, errors.New("AccessDenied")
+}
+
+func TestTraceRecordsErrors(t *testing.T) {
+	result, err := trace(context.Background(), &failingSource{}, topicARN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Root.Err != "AccessDenied" || !slices.Equal(result.Errors, []string{"orders: AccessDenied"}) {
+		t.Errorf("root err = %q, errors = %v", result.Root.Err, result.Errors)
+	}
+}
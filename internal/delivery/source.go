@@ -0,0 +1,262 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// maxMetricQueries is the GetMetricData limit per request.
+const maxMetricQueries = 500
+
+// pendingConfirmation is the subscription ARN of unconfirmed subscriptions.
+const pendingConfirmation = "PendingConfirmation"
+
+// sdkSource reads delivery chains with the AWS SDK, in the region of each
+// ARN.
+type sdkSource struct{}
+
+func newSDKSource() source { return sdkSource{} }
+
+// clientIn returns a client for region, or the current region if empty
+func clientIn[C any, O any](ctx context.Context, region string, newFromConfig func(aws.Config, ...func(*O)) *C) (*C, error) {
+	if region == "" {
+		return appaws.Client(ctx, newFromConfig)
+	}
+	return appaws.ClientWithRegion(ctx, region, newFromConfig)
+}
+
+func (sdkSource) Subscriptions(ctx context.Context, topicARN string) ([]Subscription, error) {
+	client, err := clientIn(ctx, regionOf(topicARN), sns.NewFromConfig)
+	if err != nil {
+		return nil, err
+	}
+	items, err := appaws.Paginate(ctx, func(token *string) ([]snstypes.Subscription, *string, error) {
+		output, err := client.ListSubscriptionsByTopic(ctx, &sns.ListSubscriptionsByTopicInput{TopicArn: &topicARN, NextToken: token})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list subscriptions")
+		}
+		return output.Subscriptions, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]Subscription, 0, len(items))
+	for _, item := range items {
+		s := Subscription{Protocol: appaws.Str(item.Protocol), Endpoint: appaws.Str(item.Endpoint)}
+		arn := appaws.Str(item.SubscriptionArn)
+		if arn == pendingConfirmation {
+			s.Pending = true
+			subs = append(subs, s)
+			continue
+		}
+		// Attributes only qualify the hop; the subscription is traced without them
+		attrs, err := client.GetSubscriptionAttributes(ctx, &sns.GetSubscriptionAttributesInput{SubscriptionArn: &arn})
+		if err != nil {
+			log.Debug("failed to get subscription attributes", "subscription", arn, "error", err)
+		} else {
+			s.Filtered = attrs.Attributes["FilterPolicy"] != ""
+			s.Raw = attrs.Attributes["RawMessageDelivery"] == "true"
+			s.DLQ = deadLetterTarget(attrs.Attributes["RedrivePolicy"])
+		}
+		subs = append(subs, s)
+	}
+	return subs, nil
+}
+
+func (sdkSource) Targets(ctx context.Context, ruleARN string) ([]Target, error) {
+	parsed := appaws.ParseARN(ruleARN)
+	if parsed == nil {
+		return nil, fmt.Errorf("invalid rule ARN %s", ruleARN)
+	}
+	client, err := clientIn(ctx, parsed.Region, eventbridge.NewFromConfig)
+	if err != nil {
+		return nil, err
+	}
+	input := &eventbridge.ListTargetsByRuleInput{Rule: aws.String(parsed.ResourceID)}
+	if bus, rule, ok := cutBus(parsed.ResourceID); ok {
+		input.EventBusName, input.Rule = aws.String(bus), aws.String(rule)
+	}
+	items, err := appaws.Paginate(ctx, func(token *string) ([]ebtypes.Target, *string, error) {
+		input.NextToken = token
+		output, err := client.ListTargetsByRule(ctx, input)
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list targets")
+		}
+		return output.Targets, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]Target, 0, len(items))
+	for _, item := range items {
+		t := Target{ARN: appaws.Str(item.Arn)}
+		if item.DeadLetterConfig != nil {
+			t.DLQ = appaws.Str(item.DeadLetterConfig.Arn)
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+func (sdkSource) QueueDLQ(ctx context.Context, queueARN string) (string, error) {
+	parsed := appaws.ParseARN(queueARN)
+	if parsed == nil {
+		return "", fmt.Errorf("invalid queue ARN %s", queueARN)
+	}
+	client, err := clientIn(ctx, parsed.Region, sqs.NewFromConfig)
+	if err != nil {
+		return "", err
+	}
+	url, err := client.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName:              aws.String(parsed.ResourceID),
+		QueueOwnerAWSAccountId: aws.String(parsed.AccountID),
+	})
+	if err != nil {
+		return "", apperrors.Wrap(err, "get queue url")
+	}
+	attrs, err := client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       url.QueueUrl,
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameRedrivePolicy},
+	})
+	if err != nil {
+		return "", apperrors.Wrap(err, "get queue attributes")
+	}
+	return deadLetterTarget(attrs.Attributes[string(sqstypes.QueueAttributeNameRedrivePolicy)]), nil
+}
+
+func (sdkSource) Consumers(ctx context.Context, queueARN string) ([]Consumer, error) {
+	client, err := clientIn(ctx, regionOf(queueARN), lambda.NewFromConfig)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := appaws.Paginate(ctx, func(marker *string) ([]lambdatypes.EventSourceMappingConfiguration, *string, error) {
+		output, err := client.ListEventSourceMappings(ctx, &lambda.ListEventSourceMappingsInput{EventSourceArn: &queueARN, Marker: marker})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list event source mappings")
+		}
+		return output.EventSourceMappings, output.NextMarker, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	consumers := make([]Consumer, 0, len(mappings))
+	for _, m := range mappings {
+		consumers = append(consumers, Consumer{
+			FunctionARN: appaws.Str(m.FunctionArn),
+			State:       appaws.Str(m.State),
+			BatchSize:   appaws.Int32(m.BatchSize),
+		})
+	}
+	return consumers, nil
+}
+
+// Metrics fetches every metric of nodes as one statistic over the metrics
+// window
+func (sdkSource) Metrics(ctx context.Context, region string, nodes []*Node) error {
+	client, err := clientIn(ctx, region, cloudwatch.NewFromConfig)
+	if err != nil {
+		return err
+	}
+	window := config.File().MetricsWindow()
+	end := time.Now().Truncate(time.Minute)
+	start := end.Add(-window)
+	period := int32(max(window.Round(time.Minute)/time.Second, 60))
+
+	var refs []*Metric
+	var queries []cwtypes.MetricDataQuery
+	for _, n := range nodes {
+		for i := range n.Metrics {
+			m := &n.Metrics[i]
+			dims := make([]cwtypes.Dimension, 0, len(m.dimensions))
+			for _, k := range slices.Sorted(maps.Keys(m.dimensions)) {
+				dims = append(dims, cwtypes.Dimension{Name: aws.String(k), Value: aws.String(m.dimensions[k])})
+			}
+			queries = append(queries, cwtypes.MetricDataQuery{
+				Id: aws.String(fmt.Sprintf("m%d", len(refs))),
+				MetricStat: &cwtypes.MetricStat{
+					Metric: &cwtypes.Metric{Namespace: aws.String(m.namespace), MetricName: aws.String(m.name), Dimensions: dims},
+					Period: aws.Int32(period),
+					Stat:   aws.String(m.stat),
+				},
+			})
+			refs = append(refs, m)
+		}
+	}
+
+	for batch := range slices.Chunk(queries, maxMetricQueries) {
+		// A batch's datapoints may span pages; record folds them together
+		paginator := cloudwatch.NewGetMetricDataPaginator(client, &cloudwatch.GetMetricDataInput{
+			StartTime:         aws.Time(start),
+			EndTime:           aws.Time(end),
+			MetricDataQueries: batch,
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return apperrors.Wrap(err, "get metric data")
+			}
+			for _, result := range output.MetricDataResults {
+				var i int
+				if _, err := fmt.Sscanf(appaws.Str(result.Id), "m%d", &i); err != nil || i >= len(refs) {
+					continue
+				}
+				refs[i].record(result.Values)
+			}
+		}
+	}
+	return nil
+}
+
+// record folds the datapoints of the window into the metric's value
+func (m *Metric) record(values []float64) {
+	for _, v := range values {
+		switch {
+		case !m.HasData:
+			m.Value = v
+		case m.stat == "Maximum":
+			m.Value = max(m.Value, v)
+		default:
+			m.Value += v
+		}
+		m.HasData = true
+	}
+}
+
+// deadLetterTarget returns the dead-letter queue ARN of an SNS or SQS
+// redrive policy
+func deadLetterTarget(policy string) string {
+	if policy == "" {
+		return ""
+	}
+	var p struct {
+		DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+	}
+	if err := json.Unmarshal([]byte(policy), &p); err != nil {
+		return ""
+	}
+	return p.DeadLetterTargetArn
+}
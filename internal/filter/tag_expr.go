@@ -0,0 +1,292 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TagExpr is a boolean tag expression, e.g. `Environment=prod AND !Team`.
+// Grammar (operators are case-insensitive; NOT binds tighter than AND, which
+// binds tighter than OR):
+//
+//	expr := and ("OR" and)*
+//	and  := not ("AND" not)*
+//	not  := ("!" | "NOT") not | "(" expr ")" | term
+//	term := key | key=value | key~partial   (see MatchesTagFilter)
+//
+// Terms containing spaces can be double-quoted: `Name="web server"`.
+type TagExpr interface {
+	// Match reports whether tags satisfy the expression.
+	Match(tags map[string]string) bool
+	String() string
+}
+
+// TagCondition is a condition the Resource Groups Tagging API can evaluate:
+// the key exists and, when Values is set, has one of Values.
+type TagCondition struct {
+	Key    string
+	Values []string
+}
+
+type tagTerm struct{ filter string }
+
+func (t tagTerm) Match(tags map[string]string) bool { return MatchesTagFilter(tags, t.filter) }
+func (t tagTerm) String() string {
+	if strings.ContainsAny(t.filter, " \t()") {
+		return `"` + t.filter + `"`
+	}
+	return t.filter
+}
+
+type tagNot struct{ expr TagExpr }
+
+func (n tagNot) Match(tags map[string]string) bool { return !n.expr.Match(tags) }
+func (n tagNot) String() string                    { return "!" + n.expr.String() }
+
+type tagAnd []TagExpr
+
+func (a tagAnd) Match(tags map[string]string) bool {
+	for _, e := range a {
+		if !e.Match(tags) {
+			return false
+		}
+	}
+	return true
+}
+func (a tagAnd) String() string { return joinExprs(a, " AND ") }
+
+type tagOr []TagExpr
+
+func (o tagOr) Match(tags map[string]string) bool {
+	for _, e := range o {
+		if e.Match(tags) {
+			return true
+		}
+	}
+	return false
+}
+func (o tagOr) String() string { return joinExprs(o, " OR ") }
+
+func joinExprs(exprs []TagExpr, sep string) string {
+	parts := make([]string, len(exprs))
+	for i, e := range exprs {
+		parts[i] = e.String()
+		if _, ok := e.(tagOr); ok {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// ParseTagExpr parses a boolean tag expression. An empty string yields a nil
+// expression that callers should treat as "match everything".
+func ParseTagExpr(s string) (TagExpr, error) {
+	tokens, err := tokenizeTagExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	p := &tagExprParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in tag expression", p.tokens[p.pos])
+	}
+	return expr, nil
+}
+
+// ServerConditions returns the conditions that can be evaluated by the
+// tagging API, which ANDs TagFilters and ORs values within one filter.
+// Conjuncts that cannot be pushed down (negation, partial match, mixed-key
+// OR) are omitted, so results must still be post-filtered with Match.
+func ServerConditions(expr TagExpr) []TagCondition {
+	var conjuncts []TagExpr
+	switch e := expr.(type) {
+	case nil:
+		return nil
+	case tagAnd:
+		conjuncts = e
+	default:
+		conjuncts = []TagExpr{e}
+	}
+
+	var conds []TagCondition
+	for _, c := range conjuncts {
+		if cond, ok := serverCondition(c); ok {
+			conds = append(conds, cond)
+		}
+	}
+	return conds
+}
+
+func serverCondition(expr TagExpr) (TagCondition, bool) {
+	switch e := expr.(type) {
+	case tagTerm:
+		return termCondition(e)
+	case tagOr:
+		// k=a OR k=b -> {k: [a, b]}
+		var merged TagCondition
+		for i, sub := range e {
+			term, ok := sub.(tagTerm)
+			if !ok {
+				return TagCondition{}, false
+			}
+			cond, ok := termCondition(term)
+			if !ok || len(cond.Values) == 0 || (i > 0 && cond.Key != merged.Key) {
+				return TagCondition{}, false
+			}
+			merged.Key = cond.Key
+			merged.Values = append(merged.Values, cond.Values...)
+		}
+		return merged, len(e) > 0
+	}
+	return TagCondition{}, false
+}
+
+func termCondition(t tagTerm) (TagCondition, bool) {
+	if strings.Contains(t.filter, "~") {
+		return TagCondition{}, false
+	}
+	key, value, hasValue := strings.Cut(t.filter, "=")
+	if key == "" {
+		return TagCondition{}, false
+	}
+	cond := TagCondition{Key: key}
+	if hasValue {
+		cond.Values = []string{value}
+	}
+	return cond, true
+}
+
+type tagExprParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *tagExprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *tagExprParser) parseOr() (TagExpr, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	exprs := tagOr{first}
+	for strings.EqualFold(p.peek(), "OR") {
+		p.pos++
+		next, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, next)
+	}
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return exprs, nil
+}
+
+func (p *tagExprParser) parseAnd() (TagExpr, error) {
+	first, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	exprs := tagAnd{first}
+	for strings.EqualFold(p.peek(), "AND") {
+		p.pos++
+		next, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, next)
+	}
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return exprs, nil
+}
+
+func (p *tagExprParser) parseNot() (TagExpr, error) {
+	tok := p.peek()
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("unexpected end of tag expression")
+	case tok == "!" || strings.EqualFold(tok, "NOT"):
+		p.pos++
+		inner, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return tagNot{inner}, nil
+	case tok == "(":
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing ) in tag expression")
+		}
+		p.pos++
+		return inner, nil
+	case tok == ")" || strings.EqualFold(tok, "AND") || strings.EqualFold(tok, "OR"):
+		return nil, fmt.Errorf("unexpected %q in tag expression", tok)
+	default:
+		p.pos++
+		return tagTerm{tok}, nil
+	}
+}
+
+// tokenizeTagExpr splits on whitespace and parentheses, treats a leading "!"
+// as its own token, and keeps double-quoted sections (quotes removed) intact.
+func tokenizeTagExpr(s string) ([]string, error) {
+	var tokens []string
+	var cur strings.Builder
+	inQuote := false
+	hasTerm := false
+
+	flush := func() {
+		if hasTerm {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+			hasTerm = false
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case inQuote:
+			if r == '"' {
+				inQuote = false
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '"':
+			inQuote = true
+			hasTerm = true
+		case r == ' ' || r == '\t':
+			flush()
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case r == '!' && !hasTerm:
+			tokens = append(tokens, "!")
+		default:
+			cur.WriteRune(r)
+			hasTerm = true
+		}
+	}
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quote in tag expression")
+	}
+	flush()
+	return tokens, nil
+}
@@ -0,0 +1,127 @@
+package filter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTagExpr_Match(t *testing.T) {
+	prodWeb := map[string]string{"Environment": "prod", "Team": "web"}
+	prodNoTeam := map[string]string{"Environment": "prod"}
+	devWeb := map[string]string{"Environment": "dev", "Team": "web", "Name": "web server"}
+
+	tests := []struct {
+		expr string
+		want []bool // prodWeb, prodNoTeam, devWeb
+	}{
+		{"Environment=prod", []bool{true, true, false}},
+		{"Environment=prod AND !Team", []bool{false, true, false}},
+		{"Environment=prod and not Team", []bool{false, true, false}},
+		{"Environment=dev OR !Team", []bool{false, true, true}},
+		{"Team=web AND (Environment=prod OR Environment=dev)", []bool{true, false, true}},
+		{"!(Environment=prod AND Team)", []bool{false, true, true}},
+		{"Environment~pro OR Name=\"web server\"", []bool{true, true, true}},
+		{"a OR b AND c", []bool{false, false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := ParseTagExpr(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseTagExpr() error = %v", err)
+			}
+			for i, tags := range []map[string]string{prodWeb, prodNoTeam, devWeb} {
+				if got := expr.Match(tags); got != tt.want[i] {
+					t.Errorf("Match(%v) = %v, want %v", tags, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseTagExpr_Precedence(t *testing.T) {
+	expr, err := ParseTagExpr("a OR b AND !c")
+	if err != nil {
+		t.Fatalf("ParseTagExpr() error = %v", err)
+	}
+	if got := expr.String(); got != "a OR b AND !c" {
+		t.Errorf("String() = %q", got)
+	}
+
+	expr, err = ParseTagExpr("(a OR b) AND c")
+	if err != nil {
+		t.Fatalf("ParseTagExpr() error = %v", err)
+	}
+	if got := expr.String(); got != "(a OR b) AND c" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestParseTagExpr_Empty(t *testing.T) {
+	expr, err := ParseTagExpr("  ")
+	if err != nil || expr != nil {
+		t.Errorf("ParseTagExpr(blank) = %v, %v; want nil, nil", expr, err)
+	}
+}
+
+func TestParseTagExpr_Errors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"Env=prod AND", "unexpected end"},
+		{"AND Env=prod", "unexpected \"AND\""},
+		{"(Env=prod", "missing )"},
+		{"Env=prod)", "unexpected \")\""},
+		{"Name=\"web", "unterminated quote"},
+		{"!", "unexpected end"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseTagExpr(tt.expr)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseTagExpr() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerConditions(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []TagCondition
+	}{
+		{"Environment", []TagCondition{{Key: "Environment"}}},
+		{"Config=key=value", []TagCondition{{Key: "Config", Values: []string{"key=value"}}}},
+		{"Environment=prod AND !Team", []TagCondition{{Key: "Environment", Values: []string{"prod"}}}},
+		{"Env=prod AND Team=web", []TagCondition{
+			{Key: "Env", Values: []string{"prod"}},
+			{Key: "Team", Values: []string{"web"}},
+		}},
+		{"Env=prod OR Env=staging", []TagCondition{{Key: "Env", Values: []string{"prod", "staging"}}}},
+		{"Team AND (Env=prod OR Env=dev)", []TagCondition{
+			{Key: "Team"},
+			{Key: "Env", Values: []string{"prod", "dev"}},
+		}},
+		{"Env=prod OR Team=web", nil},
+		{"Env=prod OR Env", nil},
+		{"Name~web", nil},
+		{"!Team", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := ParseTagExpr(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseTagExpr() error = %v", err)
+			}
+			if got := ServerConditions(expr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ServerConditions() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if got := ServerConditions(nil); got != nil {
+		t.Errorf("ServerConditions(nil) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,31 @@
+package history
+
+import "time"
+
+// Favorites returns pinned entries, most recently pinned first.
+func Favorites() ([]Entry, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	return load(favoritesFile)
+}
+
+// ToggleFavorite pins e, or unpins it if it is already pinned, and reports
+// whether e is pinned afterwards.
+func ToggleFavorite(e Entry) (bool, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	favorites, err := load(favoritesFile)
+	if err != nil {
+		return false, err
+	}
+	key := e.Key()
+	for i, f := range favorites {
+		if f.Key() == key {
+			favorites = append(favorites[:i], favorites[i+1:]...)
+			return false, save(favoritesFile, favorites)
+		}
+	}
+	e.VisitedAt = time.Now()
+	return true, save(favoritesFile, append([]Entry{e}, favorites...))
+}
@@ -0,0 +1,40 @@
+package history
+
+import "testing"
+
+func TestToggleFavorite(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	alb := Entry{Service: "elbv2", Resource: "load-balancers", ID: "arn:alb", Name: "web-alb", Region: "us-east-1"}
+	db := Entry{Service: "rds", Resource: "instances", ID: "db-1", Region: "us-east-1"}
+
+	for _, e := range []Entry{alb, db} {
+		pinned, err := ToggleFavorite(e)
+		if err != nil || !pinned {
+			t.Fatalf("ToggleFavorite(%s) = %v, %v; want pinned", e.ID, pinned, err)
+		}
+	}
+	favorites, err := Favorites()
+	if err != nil {
+		t.Fatalf("Favorites() error = %v", err)
+	}
+	if len(favorites) != 2 || favorites[0].ID != "db-1" || favorites[1].ID != "arn:alb" {
+		t.Errorf("Favorites() = %+v, want db-1 then arn:alb", favorites)
+	}
+
+	// The same resource in another region is a different favorite
+	other := alb
+	other.Region = "eu-west-1"
+	if pinned, _ := ToggleFavorite(other); !pinned {
+		t.Error("ToggleFavorite(other region) should pin")
+	}
+
+	pinned, err := ToggleFavorite(alb)
+	if err != nil || pinned {
+		t.Fatalf("ToggleFavorite(alb) again = %v, %v; want unpinned", pinned, err)
+	}
+	favorites, _ = Favorites()
+	if len(favorites) != 2 {
+		t.Errorf("Favorites() after unpin = %d entries, want 2", len(favorites))
+	}
+}
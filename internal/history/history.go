@@ -0,0 +1,158 @@
+// Package history persists recently visited resource lists and resources so
+// they can be reopened in later sessions.
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/clawscli/claws/internal/config"
+)
+
+// Entry is one visited resource list or resource.
+type Entry struct {
+	Service  string `json:"service"`
+	Resource string `json:"resource"`
+	// ID is set when a single resource was opened; empty for a list.
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	ARN  string `json:"arn,omitempty"`
+	// FilterField and FilterValue scope a sub-resource list to its parent.
+	FilterField string    `json:"filter_field,omitempty"`
+	FilterValue string    `json:"filter_value,omitempty"`
+	Profile     string    `json:"profile,omitempty"` // profile selection ID
+	Region      string    `json:"region,omitempty"`
+	VisitedAt   time.Time `json:"visited_at"`
+}
+
+// Type returns the entry's "service/resource" type.
+func (e Entry) Type() string {
+	return e.Service + "/" + e.Resource
+}
+
+// IsResource reports whether the entry is a single resource rather than a list.
+func (e Entry) IsResource() bool {
+	return e.ID != ""
+}
+
+// Key identifies the entry so that revisits replace the older record.
+func (e Entry) Key() string {
+	return e.Profile + "|" + e.Region + "|" + e.Type() + "|" + e.FilterField + "=" + e.FilterValue + "|" + e.ID
+}
+
+const (
+	historyFile   = "history.json"
+	favoritesFile = "favorites.json"
+)
+
+// mu serializes read-modify-write of the history and favorites files.
+var mu sync.Mutex
+
+// Path returns the file history is stored in.
+func Path() (string, error) {
+	return filePath(historyFile)
+}
+
+func filePath(name string) (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// Load returns saved entries, most recent first.
+func Load() ([]Entry, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	return load(historyFile)
+}
+
+func load(name string) ([]Entry, error) {
+	p, err := filePath(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", name, err)
+	}
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", name, err)
+	}
+	return entries, nil
+}
+
+func save(name string, entries []Entry) error {
+	p, err := filePath(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", name, err)
+	}
+	if err := os.WriteFile(p, data, 0600); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+// Record adds e to the front of the history, dropping an earlier visit of
+// the same entry and anything beyond limit. A limit of 0 records nothing.
+func Record(e Entry, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+	if e.VisitedAt.IsZero() {
+		e.VisitedAt = time.Now()
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	entries, err := load(historyFile)
+	if err != nil {
+		// A corrupt file shouldn't block recording; start over
+		entries = nil
+	}
+	key := e.Key()
+	updated := make([]Entry, 0, min(len(entries)+1, limit))
+	updated = append(updated, e)
+	for _, old := range entries {
+		if len(updated) >= limit {
+			break
+		}
+		if old.Key() != key {
+			updated = append(updated, old)
+		}
+	}
+	return save(historyFile, updated)
+}
+
+// Clear removes all saved history.
+func Clear() error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	p, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("clear history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecordLoad(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	entries, err := Load()
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("Load() on empty dir = %v, %v", entries, err)
+	}
+
+	alb := Entry{Service: "elbv2", Resource: "load-balancers", ID: "arn:alb", Name: "web-alb", Region: "us-east-1"}
+	list := Entry{Service: "ec2", Resource: "instances", Region: "us-east-1"}
+	for _, e := range []Entry{alb, list, alb} {
+		if err := Record(e, 10); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	entries, err = Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Load() = %d entries, want 2 (revisit replaces older)", len(entries))
+	}
+	if entries[0].Name != "web-alb" || !entries[0].IsResource() {
+		t.Errorf("entries[0] = %+v, want the ALB", entries[0])
+	}
+	if entries[1].Type() != "ec2/instances" || entries[1].IsResource() {
+		t.Errorf("entries[1] = %+v, want the ec2/instances list", entries[1])
+	}
+	if entries[0].VisitedAt.IsZero() {
+		t.Error("Record() should stamp VisitedAt")
+	}
+}
+
+func TestRecordLimit(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	base := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	for i := range 5 {
+		e := Entry{Service: "s3", Resource: "buckets", ID: string(rune('a' + i)), VisitedAt: base.Add(time.Duration(i) * time.Minute)}
+		if err := Record(e, 3); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+	entries, _ := Load()
+	if len(entries) != 3 || entries[0].ID != "e" || entries[2].ID != "c" {
+		t.Errorf("Load() = %+v, want the 3 most recent (e, d, c)", entries)
+	}
+
+	// A zero limit disables recording
+	if err := Record(Entry{Service: "s3", Resource: "buckets", ID: "z"}, 0); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if entries, _ := Load(); entries[0].ID == "z" {
+		t.Error("Record() with limit 0 should not record")
+	}
+}
+
+func TestRecordCorruptFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	p, err := Path()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(); err == nil {
+		t.Error("Load() on corrupt file should fail")
+	}
+	if err := Record(Entry{Service: "ec2", Resource: "instances"}, 10); err != nil {
+		t.Fatalf("Record() over corrupt file error = %v", err)
+	}
+	if entries, err := Load(); err != nil || len(entries) != 1 {
+		t.Errorf("Load() after Record = %v, %v", entries, err)
+	}
+}
+
+func TestClear(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := Clear(); err != nil {
+		t.Fatalf("Clear() with no file error = %v", err)
+	}
+	if err := Record(Entry{Service: "ec2", Resource: "instances"}, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := Clear(); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if entries, _ := Load(); len(entries) != 0 {
+		t.Errorf("Load() after Clear = %v, want none", entries)
+	}
+}
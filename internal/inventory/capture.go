@@ -0,0 +1,222 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// DefaultTypes are captured when no resource types are given.
+var DefaultTypes = []string{
+	"ec2/instances",
+	"ec2/security-groups",
+	"vpc/vpcs",
+	"vpc/subnets",
+	"elbv2/load-balancers",
+	"s3/buckets",
+	"lambda/functions",
+	"rds/instances",
+	"dynamodb/tables",
+	"ecs/clusters",
+	"sqs/queues",
+	"sns/topics",
+	"cloudformation/stacks",
+	"iam/roles",
+	"iam/users",
+}
+
+// Scope is what a capture lists: every type in every region of every profile.
+type Scope struct {
+	Profiles []config.ProfileSelection
+	Regions  []string
+	Types    []string
+}
+
+// CurrentScope returns a scope covering the selected profiles and regions.
+func CurrentScope(types []string) Scope {
+	regions := config.Global().Regions()
+	if len(regions) == 0 {
+		regions = []string{config.Global().Region()}
+	}
+	profiles := config.Global().Selections()
+	if len(profiles) == 0 {
+		profiles = []config.ProfileSelection{config.SDKDefault()}
+	}
+	return Scope{
+		Profiles: profiles,
+		Regions:  regions,
+		Types:    types,
+	}
+}
+
+// ScopeOf returns the scope s was captured with, so it can be captured again.
+func ScopeOf(s *Snapshot) Scope {
+	profiles := make([]config.ProfileSelection, len(s.Profiles))
+	for i, id := range s.Profiles {
+		profiles[i] = config.ProfileSelectionFromID(id)
+	}
+	return Scope{Profiles: profiles, Regions: s.Regions, Types: s.Types}
+}
+
+// ResolveTypes resolves service/resource arguments and aliases to
+// registered, directly listable types. With no arguments, the registered
+// DefaultTypes are returned.
+func ResolveTypes(reg *registry.Registry, args []string) ([]string, error) {
+	if len(args) == 0 {
+		var types []string
+		for _, t := range DefaultTypes {
+			service, resource, _ := splitType(t)
+			if reg.HasResource(service, resource) {
+				types = append(types, t)
+			}
+		}
+		return types, nil
+	}
+
+	var types []string
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		service, resource, err := reg.ParseServiceResource(arg)
+		if err != nil {
+			return nil, err
+		}
+		if reg.IsSubResource(service, resource) {
+			return nil, fmt.Errorf("%s/%s can only be listed from its parent resource", service, resource)
+		}
+		t := service + "/" + resource
+		if !seen[t] {
+			seen[t] = true
+			types = append(types, t)
+		}
+	}
+	return types, nil
+}
+
+type captureKey struct {
+	profile config.ProfileSelection
+	region  string
+	typ     string
+}
+
+type captureResult struct {
+	key   captureKey
+	items []Item
+	err   error
+}
+
+// Capture lists every type in scope and returns the snapshot. Listings that
+// fail are recorded in the snapshot's Errors rather than failing the capture.
+func Capture(ctx context.Context, reg *registry.Registry, name string, scope Scope) *Snapshot {
+	var keys []captureKey
+	for _, sel := range scope.Profiles {
+		for _, region := range scope.Regions {
+			for _, typ := range scope.Types {
+				keys = append(keys, captureKey{profile: sel, region: region, typ: typ})
+			}
+		}
+	}
+
+	results := make([]captureResult, len(keys))
+	sem := make(chan struct{}, config.File().MaxConcurrentFetches())
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			items, err := captureOne(ctx, reg, key)
+			results[i] = captureResult{key: key, items: items, err: err}
+		}()
+	}
+	wg.Wait()
+
+	snap := &Snapshot{
+		Name:      name,
+		CreatedAt: time.Now(),
+		Regions:   scope.Regions,
+		Types:     scope.Types,
+	}
+	for _, sel := range scope.Profiles {
+		snap.Profiles = append(snap.Profiles, sel.ID())
+	}
+
+	seen := make(map[string]bool)
+	for _, r := range results {
+		if r.err != nil {
+			log.Debug("inventory listing failed", "profile", r.key.profile.ID(), "region", r.key.region, "type", r.key.typ, "error", r.err)
+			snap.Errors = append(snap.Errors, fmt.Sprintf("%s/%s %s: %v", r.key.profile.ID(), r.key.region, r.key.typ, r.err))
+			snap.Failed = append(snap.Failed, scopeKey(r.key.profile.ID(), r.key.region, r.key.typ))
+			continue
+		}
+		for _, item := range r.items {
+			if k := item.Key(); !seen[k] {
+				seen[k] = true
+				snap.Items = append(snap.Items, item)
+			}
+		}
+	}
+	sort.Slice(snap.Items, func(i, j int) bool {
+		return snap.Items[i].Key() < snap.Items[j].Key()
+	})
+	return snap
+}
+
+func captureOne(ctx context.Context, reg *registry.Registry, key captureKey) ([]Item, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.File().MultiRegionFetchTimeout())
+	defer cancel()
+	ctx = aws.WithSelectionOverride(ctx, key.profile)
+	ctx = aws.WithRegionOverride(ctx, key.region)
+
+	service, resource, _ := splitType(key.typ)
+	d, err := reg.GetDAO(ctx, service, resource)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]Item, 0, len(resources))
+	for _, res := range resources {
+		items = append(items, newItem(key.profile.ID(), key.region, service, resource, dao.UnwrapResource(res)))
+	}
+	return items, nil
+}
+
+func newItem(profile, region, service, resource string, res dao.Resource) Item {
+	item := Item{
+		Service:  service,
+		Resource: resource,
+		Profile:  profile,
+		Region:   region,
+		ID:       res.GetID(),
+		Name:     res.GetName(),
+		ARN:      res.GetARN(),
+	}
+	if raw := res.Raw(); raw != nil {
+		data, err := json.Marshal(raw)
+		if err != nil {
+			log.Debug("inventory: cannot record resource data", "type", item.Type(), "id", item.ID, "error", err)
+		} else {
+			item.Data = data
+		}
+	}
+	return item
+}
+
+func splitType(t string) (service, resource string, ok bool) {
+	service, resource, ok = strings.Cut(t, "/")
+	return service, resource, ok
+}
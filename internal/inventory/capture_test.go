@@ -0,0 +1,101 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+type fakeResource struct {
+	dao.BaseResource
+}
+
+type fakeDAO struct {
+	dao.BaseDAO
+	list func(ctx context.Context) ([]dao.Resource, error)
+}
+
+func (f *fakeDAO) List(ctx context.Context) ([]dao.Resource, error) { return f.list(ctx) }
+func (f *fakeDAO) Get(context.Context, string) (dao.Resource, error) {
+	return nil, errors.New("not implemented")
+}
+func (f *fakeDAO) Delete(context.Context, string) error { return nil }
+
+func fakeEntry(service, resource string, list func(ctx context.Context) ([]dao.Resource, error)) registry.Entry {
+	return registry.Entry{DAOFactory: func(context.Context) (dao.DAO, error) {
+		return &fakeDAO{BaseDAO: dao.NewBaseDAO(service, resource), list: list}, nil
+	}}
+}
+
+func TestCapture(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", fakeEntry("ec2", "instances", func(ctx context.Context) ([]dao.Resource, error) {
+		region := aws.GetRegionFromContext(ctx)
+		if region == "eu-west-1" {
+			return nil, errors.New("throttled")
+		}
+		return []dao.Resource{&fakeResource{dao.BaseResource{ID: "i-" + region, Data: map[string]string{"State": "running"}}}}, nil
+	}))
+	reg.RegisterCustom("iam", "roles", fakeEntry("iam", "roles", func(context.Context) ([]dao.Resource, error) {
+		return []dao.Resource{&fakeResource{dao.BaseResource{ID: "admin", ARN: "arn:aws:iam::1:role/admin"}}}, nil
+	}))
+
+	scope := Scope{
+		Profiles: []config.ProfileSelection{config.NamedProfile("dev")},
+		Regions:  []string{"us-east-1", "eu-west-1", "ap-northeast-1"},
+		Types:    []string{"ec2/instances", "iam/roles"},
+	}
+	snap := Capture(context.Background(), reg, "test", scope)
+
+	counts := snap.TypeCounts()
+	if counts["ec2/instances"] != 2 {
+		t.Errorf("ec2/instances count = %d, want 2 (one per successful region)", counts["ec2/instances"])
+	}
+	if counts["iam/roles"] != 1 {
+		t.Errorf("iam/roles count = %d, want 1 (deduplicated by ARN)", counts["iam/roles"])
+	}
+	if len(snap.Errors) != 1 || !snap.failed("dev", "eu-west-1", "ec2/instances") {
+		t.Errorf("Errors = %v, Failed = %v, want the eu-west-1 failure", snap.Errors, snap.Failed)
+	}
+	for _, item := range snap.Items {
+		if item.Service == "ec2" && string(item.Data) != `{"State":"running"}` {
+			t.Errorf("item data = %s", item.Data)
+		}
+	}
+	if len(snap.Profiles) != 1 || snap.Profiles[0] != "dev" {
+		t.Errorf("Profiles = %v", snap.Profiles)
+	}
+
+	rescope := ScopeOf(snap)
+	if len(rescope.Profiles) != 1 || rescope.Profiles[0].ID() != "dev" || len(rescope.Regions) != 3 {
+		t.Errorf("ScopeOf() = %+v", rescope)
+	}
+}
+
+func TestResolveTypes(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{})
+	reg.RegisterCustom("s3", "buckets", registry.Entry{})
+
+	types, err := ResolveTypes(reg, nil)
+	if err != nil {
+		t.Fatalf("ResolveTypes(nil) error = %v", err)
+	}
+	if len(types) != 2 {
+		t.Errorf("ResolveTypes(nil) = %v, want registered defaults only", types)
+	}
+
+	types, err = ResolveTypes(reg, []string{"s3/buckets", "ec2/instances", "s3/buckets"})
+	if err != nil || len(types) != 2 || types[0] != "s3/buckets" {
+		t.Errorf("ResolveTypes(args) = %v, %v", types, err)
+	}
+
+	if _, err := ResolveTypes(reg, []string{"nope/things"}); err == nil {
+		t.Error("ResolveTypes() with unknown type should fail")
+	}
+}
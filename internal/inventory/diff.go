@@ -0,0 +1,105 @@
+package inventory
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// Change is one resource that differs between two snapshots.
+type Change struct {
+	Item   Item     // the resource as in the newer snapshot, or the older one if removed
+	Fields []string // top-level fields that changed, for changed resources
+}
+
+// Report lists the differences between a base snapshot and a later one.
+type Report struct {
+	Base       string
+	BaseTime   time.Time
+	Target     string // empty when compared against a fresh capture
+	TargetTime time.Time
+
+	Added   []Change
+	Removed []Change
+	Changed []Change
+	// Errors are listings that failed in either snapshot; resources in those
+	// scopes are neither added nor removed.
+	Errors []string
+}
+
+// Empty reports whether the snapshots have no differences.
+func (r Report) Empty() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0
+}
+
+// Diff compares base with target.
+func Diff(base, target *Snapshot) Report {
+	r := Report{
+		Base:       base.Name,
+		BaseTime:   base.CreatedAt,
+		Target:     target.Name,
+		TargetTime: target.CreatedAt,
+	}
+	r.Errors = append(append(r.Errors, base.Errors...), target.Errors...)
+
+	before := make(map[string]Item, len(base.Items))
+	for _, item := range base.Items {
+		before[item.Key()] = item
+	}
+	after := make(map[string]Item, len(target.Items))
+	for _, item := range target.Items {
+		after[item.Key()] = item
+	}
+
+	for key, item := range after {
+		old, ok := before[key]
+		if !ok {
+			if !base.failed(item.Profile, item.Region, item.Type()) {
+				r.Added = append(r.Added, Change{Item: item})
+			}
+			continue
+		}
+		if fields := changedFields(old.Data, item.Data); len(fields) > 0 {
+			r.Changed = append(r.Changed, Change{Item: item, Fields: fields})
+		}
+	}
+	for key, item := range before {
+		if _, ok := after[key]; !ok && !target.failed(item.Profile, item.Region, item.Type()) {
+			r.Removed = append(r.Removed, Change{Item: item})
+		}
+	}
+
+	for _, changes := range [][]Change{r.Added, r.Removed, r.Changed} {
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].Item.Key() < changes[j].Item.Key()
+		})
+	}
+	return r
+}
+
+// changedFields returns the top-level JSON fields that differ between a and
+// b. Data that isn't a JSON object is compared as a whole.
+func changedFields(a, b json.RawMessage) []string {
+	if bytes.Equal(a, b) {
+		return nil
+	}
+	var am, bm map[string]json.RawMessage
+	if json.Unmarshal(a, &am) != nil || json.Unmarshal(b, &bm) != nil {
+		return []string{"(data)"}
+	}
+
+	var fields []string
+	for k, av := range am {
+		if bv, ok := bm[k]; !ok || !bytes.Equal(av, bv) {
+			fields = append(fields, k)
+		}
+	}
+	for k := range bm {
+		if _, ok := am[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,66 @@
+package inventory
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func item(id, data string) Item {
+	return Item{Service: "ec2", Resource: "instances", Profile: "dev", Region: "us-east-1", ID: id, Data: json.RawMessage(data)}
+}
+
+func TestDiff(t *testing.T) {
+	base := &Snapshot{Name: "before", Items: []Item{
+		item("i-kept", `{"State":"running","Type":"t3.micro"}`),
+		item("i-changed", `{"State":"running","Type":"t3.micro"}`),
+		item("i-removed", `{"State":"running"}`),
+	}}
+	target := &Snapshot{Items: []Item{
+		item("i-kept", `{"State":"running","Type":"t3.micro"}`),
+		item("i-changed", `{"State":"stopped","Type":"t3.micro","Tags":[]}`),
+		item("i-added", `{"State":"pending"}`),
+	}}
+
+	r := Diff(base, target)
+	if len(r.Added) != 1 || r.Added[0].Item.ID != "i-added" {
+		t.Errorf("Added = %+v", r.Added)
+	}
+	if len(r.Removed) != 1 || r.Removed[0].Item.ID != "i-removed" {
+		t.Errorf("Removed = %+v", r.Removed)
+	}
+	if len(r.Changed) != 1 || r.Changed[0].Item.ID != "i-changed" {
+		t.Fatalf("Changed = %+v", r.Changed)
+	}
+	if got := strings.Join(r.Changed[0].Fields, ","); got != "State,Tags" {
+		t.Errorf("changed fields = %q, want State,Tags", got)
+	}
+	if r.Empty() {
+		t.Error("Empty() = true, want false")
+	}
+	if !Diff(base, base).Empty() {
+		t.Error("Diff of a snapshot with itself should be empty")
+	}
+}
+
+func TestDiff_FailedListingNotReportedAsRemoved(t *testing.T) {
+	base := &Snapshot{Items: []Item{item("i-1", `{}`)}}
+	target := &Snapshot{
+		Errors: []string{"dev/us-east-1 ec2/instances: throttled"},
+		Failed: []string{scopeKey("dev", "us-east-1", "ec2/instances")},
+	}
+
+	r := Diff(base, target)
+	if len(r.Removed) != 0 {
+		t.Errorf("Removed = %+v, want none for a failed listing", r.Removed)
+	}
+	if len(r.Errors) != 1 {
+		t.Errorf("Errors = %v, want the target's listing error", r.Errors)
+	}
+}
+
+func TestChangedFields_NonObject(t *testing.T) {
+	if got := changedFields(json.RawMessage(`"a"`), json.RawMessage(`"b"`)); len(got) != 1 || got[0] != "(data)" {
+		t.Errorf("changedFields() = %v, want [(data)]", got)
+	}
+}
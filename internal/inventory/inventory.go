@@ -0,0 +1,175 @@
+// Package inventory captures point-in-time snapshots of account resources,
+// stores them locally, and reports what changed between two snapshots.
+package inventory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/config"
+)
+
+// Item is one resource recorded in a snapshot.
+type Item struct {
+	Service  string          `json:"service"`
+	Resource string          `json:"resource"`
+	Profile  string          `json:"profile,omitempty"`
+	Region   string          `json:"region,omitempty"`
+	ID       string          `json:"id"`
+	Name     string          `json:"name,omitempty"`
+	ARN      string          `json:"arn,omitempty"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// Type returns the item's "service/resource" type.
+func (i Item) Type() string {
+	return i.Service + "/" + i.Resource
+}
+
+// Key identifies the item across snapshots. The ARN is preferred so that
+// global resources listed from several regions are recorded once.
+func (i Item) Key() string {
+	id := i.ARN
+	if id == "" {
+		id = i.Region + "/" + i.ID
+	}
+	return i.Profile + "|" + i.Type() + "|" + id
+}
+
+// Snapshot is the inventory of a set of resource types across profiles and
+// regions at one point in time.
+type Snapshot struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	Profiles  []string  `json:"profiles"`
+	Regions   []string  `json:"regions"`
+	Types     []string  `json:"types"`
+	Items     []Item    `json:"items"`
+	// Errors describes listings that failed; Failed holds their scope keys
+	// so a failed listing isn't reported as removed resources.
+	Errors []string `json:"errors,omitempty"`
+	Failed []string `json:"failed,omitempty"`
+}
+
+// TypeCounts returns the number of items per resource type.
+func (s *Snapshot) TypeCounts() map[string]int {
+	counts := make(map[string]int)
+	for _, item := range s.Items {
+		counts[item.Type()]++
+	}
+	return counts
+}
+
+func (s *Snapshot) failed(profile, region, typ string) bool {
+	key := scopeKey(profile, region, typ)
+	for _, f := range s.Failed {
+		if f == key {
+			return true
+		}
+	}
+	return false
+}
+
+func scopeKey(profile, region, typ string) string {
+	return profile + "|" + region + "|" + typ
+}
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// ValidateName checks that name is usable as a snapshot file name.
+func ValidateName(name string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("invalid inventory name %q (use letters, digits, '.', '_' or '-')", name)
+	}
+	return nil
+}
+
+// Dir returns the directory snapshots are stored in.
+func Dir() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "inventory"), nil
+}
+
+func path(name string) (string, error) {
+	if err := ValidateName(name); err != nil {
+		return "", err
+	}
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+// Save writes s to the snapshot directory, replacing any snapshot with the
+// same name, and returns the file path.
+func Save(s *Snapshot) (string, error) {
+	p, err := path(s.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return "", fmt.Errorf("create inventory dir: %w", err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal inventory: %w", err)
+	}
+	if err := os.WriteFile(p, data, 0600); err != nil {
+		return "", fmt.Errorf("write inventory: %w", err)
+	}
+	return p, nil
+}
+
+// Load reads the snapshot called name.
+func Load(name string) (*Snapshot, error) {
+	p, err := path(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("inventory %q not found", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read inventory: %w", err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parse inventory %q: %w", name, err)
+	}
+	return &s, nil
+}
+
+// List returns the names of saved snapshots, sorted.
+func List() ([]string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list inventories: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		if name, ok := strings.CutSuffix(e.Name(), ".json"); ok && !e.IsDir() && validName.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,93 @@
+package inventory
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateName(t *testing.T) {
+	valid := []string{"before-change", "2026.10.17", "prod_1"}
+	for _, name := range valid {
+		if err := ValidateName(name); err != nil {
+			t.Errorf("ValidateName(%q) = %v, want nil", name, err)
+		}
+	}
+	invalid := []string{"", "../etc", "a/b", ".hidden", "with space"}
+	for _, name := range invalid {
+		if err := ValidateName(name); err == nil {
+			t.Errorf("ValidateName(%q) = nil, want error", name)
+		}
+	}
+}
+
+func TestSaveLoadList(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	names, err := List()
+	if err != nil || len(names) != 0 {
+		t.Fatalf("List() on empty dir = %v, %v", names, err)
+	}
+
+	snap := &Snapshot{
+		Name:      "before",
+		CreatedAt: time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+		Profiles:  []string{"dev"},
+		Regions:   []string{"us-east-1"},
+		Types:     []string{"ec2/instances"},
+		Items: []Item{{
+			Service: "ec2", Resource: "instances", Profile: "dev", Region: "us-east-1",
+			ID: "i-1", Name: "web", Data: json.RawMessage(`{"State":"running"}`),
+		}},
+	}
+	path, err := Save(snap)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if !strings.HasSuffix(path, "before.json") {
+		t.Errorf("Save() path = %q", path)
+	}
+	if _, err := Save(&Snapshot{Name: "after"}); err != nil {
+		t.Fatalf("Save(after) error = %v", err)
+	}
+
+	loaded, err := Load("before")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !loaded.CreatedAt.Equal(snap.CreatedAt) || len(loaded.Items) != 1 || loaded.Items[0].Name != "web" {
+		t.Errorf("Load() = %+v, want round trip of %+v", loaded, snap)
+	}
+
+	names, err = List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if strings.Join(names, ",") != "after,before" {
+		t.Errorf("List() = %v, want [after before]", names)
+	}
+
+	if _, err := Load("missing"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Load(missing) error = %v, want not found", err)
+	}
+	if _, err := Save(&Snapshot{Name: "../escape"}); err == nil {
+		t.Error("Save() with path traversal name should fail")
+	}
+}
+
+func TestItemKey(t *testing.T) {
+	withARN := Item{Service: "iam", Resource: "roles", Profile: "dev", Region: "us-east-1", ID: "r", ARN: "arn:aws:iam::1:role/r"}
+	sameARN := withARN
+	sameARN.Region = "eu-west-1"
+	if withARN.Key() != sameARN.Key() {
+		t.Error("items with the same ARN should share a key regardless of region")
+	}
+
+	noARN := Item{Service: "ec2", Resource: "instances", Profile: "dev", Region: "us-east-1", ID: "i-1"}
+	otherRegion := noARN
+	otherRegion.Region = "eu-west-1"
+	if noARN.Key() == otherRegion.Key() {
+		t.Error("items without an ARN should be keyed by region")
+	}
+}
@@ -0,0 +1,273 @@
+// Package mcp serves claws' AI tools over the Model Context Protocol on
+// stdio, so external agents and editors can query AWS through the DAO layer
+// without the TUI.
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/clawscli/claws/internal/ai"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// protocolVersion is the latest MCP revision the server speaks
+const protocolVersion = "2025-06-18"
+
+// supportedVersions are the revisions the server accepts from a client
+var supportedVersions = []string{protocolVersion, "2025-03-26", "2024-11-05"}
+
+// maxMessageSize bounds one JSON-RPC message read from the client
+const maxMessageSize = 16 << 20
+
+// JSON-RPC error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// ToolExecutor runs the tools the server exposes; *ai.ToolExecutor
+// implements it.
+type ToolExecutor interface {
+	Tools() []ai.Tool
+	Execute(ctx context.Context, call *ai.ToolUseContent) ai.ToolResultContent
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type toolInfo struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+}
+
+type textContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type callResult struct {
+	Content []textContent `json:"content"`
+	IsError bool          `json:"isError"`
+}
+
+// Server answers MCP requests. Tool calls run concurrently and can be
+// cancelled by the client.
+type Server struct {
+	tools   ToolExecutor
+	version string
+
+	writeMu sync.Mutex
+	out     *json.Encoder
+
+	mu       sync.Mutex
+	inflight map[string]context.CancelFunc // request ID -> cancel
+}
+
+// NewServer creates a Server exposing tools, reporting version as the
+// server version
+func NewServer(tools ToolExecutor, version string) *Server {
+	return &Server{tools: tools, version: version, inflight: make(map[string]context.CancelFunc)}
+}
+
+// Serve reads newline-delimited JSON-RPC messages from r and writes replies
+// to w until r is exhausted or ctx is done. Pending tool calls finish first.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.out = json.NewEncoder(w)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), maxMessageSize)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	lines := make(chan []byte)
+	go func() {
+		defer close(lines)
+		for scanner.Scan() {
+			select {
+			case lines <- slices.Clone(scanner.Bytes()):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case line, ok := <-lines:
+			if !ok {
+				return scanner.Err()
+			}
+			if len(line) == 0 {
+				continue
+			}
+			s.handle(ctx, line, &wg)
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, line []byte, wg *sync.WaitGroup) {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		s.reply(nil, nil, &rpcError{Code: codeParseError, Message: "parse error: " + err.Error()})
+		return
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		s.reply(req.ID, nil, &rpcError{Code: codeInvalidRequest, Message: "invalid request"})
+		return
+	}
+
+	// Notifications get no reply
+	if len(req.ID) == 0 {
+		if req.Method == "notifications/cancelled" {
+			s.cancel(req.Params)
+		}
+		return
+	}
+
+	switch req.Method {
+	case "initialize":
+		s.reply(req.ID, s.initialize(req.Params), nil)
+	case "ping":
+		s.reply(req.ID, struct{}{}, nil)
+	case "tools/list":
+		s.reply(req.ID, map[string]any{"tools": s.toolList()}, nil)
+	case "tools/call":
+		callCtx, cancel := context.WithCancel(ctx)
+		s.mu.Lock()
+		s.inflight[string(req.ID)] = cancel
+		s.mu.Unlock()
+		wg.Go(func() {
+			defer s.finish(req.ID)
+			result, rpcErr := s.callTool(callCtx, req.Params)
+			if callCtx.Err() != nil && ctx.Err() == nil {
+				// Cancelled by the client, which expects no reply
+				return
+			}
+			if rpcErr != nil {
+				s.reply(req.ID, nil, rpcErr)
+				return
+			}
+			s.reply(req.ID, result, nil)
+		})
+	default:
+		s.reply(req.ID, nil, &rpcError{Code: codeMethodNotFound, Message: "method not found: " + req.Method})
+	}
+}
+
+func (s *Server) initialize(params json.RawMessage) map[string]any {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+		ClientInfo      struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"clientInfo"`
+	}
+	_ = json.Unmarshal(params, &p)
+	log.Info("mcp client connected", "client", p.ClientInfo.Name, "version", p.ClientInfo.Version, "protocol", p.ProtocolVersion)
+
+	version := protocolVersion
+	if slices.Contains(supportedVersions, p.ProtocolVersion) {
+		version = p.ProtocolVersion
+	}
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities":    map[string]any{"tools": map[string]any{}},
+		"serverInfo":      map[string]any{"name": "claws", "version": s.version},
+	}
+}
+
+func (s *Server) toolList() []toolInfo {
+	tools := s.tools.Tools()
+	list := make([]toolInfo, len(tools))
+	for i, t := range tools {
+		list[i] = toolInfo{Name: t.Name, Description: t.Description, InputSchema: t.InputSchema}
+	}
+	return list
+}
+
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (*callResult, *rpcError) {
+	var p struct {
+		Name      string         `json:"name"`
+		Arguments map[string]any `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "invalid params: " + err.Error()}
+	}
+	if !slices.ContainsFunc(s.tools.Tools(), func(t ai.Tool) bool { return t.Name == p.Name }) {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "unknown tool: " + p.Name}
+	}
+	if p.Arguments == nil {
+		p.Arguments = map[string]any{}
+	}
+
+	log.Debug("mcp tool call", "tool", p.Name, "input", p.Arguments)
+	result := s.tools.Execute(ctx, &ai.ToolUseContent{Name: p.Name, Input: p.Arguments})
+	return &callResult{
+		Content: []textContent{{Type: "text", Text: result.Content}},
+		IsError: result.IsError,
+	}, nil
+}
+
+// cancel stops the tool call named by a notifications/cancelled message
+func (s *Server) cancel(params json.RawMessage) {
+	var p struct {
+		RequestID json.RawMessage `json:"requestId"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return
+	}
+	s.mu.Lock()
+	cancel, ok := s.inflight[string(p.RequestID)]
+	s.mu.Unlock()
+	if ok {
+		cancel()
+	}
+}
+
+func (s *Server) finish(id json.RawMessage) {
+	s.mu.Lock()
+	cancel := s.inflight[string(id)]
+	delete(s.inflight, string(id))
+	s.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+func (s *Server) reply(id json.RawMessage, result any, rpcErr *rpcError) {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	resp := response{JSONRPC: "2.0", ID: id, Result: result, Error: rpcErr}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if err := s.out.Encode(resp); err != nil {
+		log.Warn("mcp write failed", "error", err)
+	}
+}
@@ -0,0 +1,230 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clawscli/claws/internal/ai"
+)
+
+type fakeTools struct {
+	block chan struct{} // when set, "slow" waits on it or ctx
+}
+
+func (f *fakeTools) Tools() []ai.Tool {
+	return []ai.Tool{
+		{Name: "echo", Description: "Echo the input", InputSchema: map[string]any{"type": "object"}},
+		{Name: "slow", Description: "Block until cancelled", InputSchema: map[string]any{"type": "object"}},
+	}
+}
+
+func (f *fakeTools) Execute(ctx context.Context, call *ai.ToolUseContent) ai.ToolResultContent {
+	switch call.Name {
+	case "echo":
+		msg, _ := call.Input["msg"].(string)
+		if msg == "" {
+			return ai.ToolResultContent{Content: "Error: msg is required", IsError: true}
+		}
+		return ai.ToolResultContent{Content: msg}
+	case "slow":
+		select {
+		case <-f.block:
+		case <-ctx.Done():
+		}
+		return ai.ToolResultContent{Content: "done"}
+	}
+	return ai.ToolResultContent{Content: "unknown", IsError: true}
+}
+
+type reply struct {
+	ID     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// serve runs the server over the given input lines and returns its replies
+func serve(t *testing.T, tools ToolExecutor, lines ...string) []reply {
+	t.Helper()
+	var out strings.Builder
+	in := strings.NewReader(strings.Join(lines, "\n") + "\n")
+	if err := NewServer(tools, "test").Serve(context.Background(), in, &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	var replies []reply
+	dec := json.NewDecoder(strings.NewReader(out.String()))
+	for dec.More() {
+		var r reply
+		if err := dec.Decode(&r); err != nil {
+			t.Fatalf("decode reply: %v\n%s", err, out.String())
+		}
+		replies = append(replies, r)
+	}
+	return replies
+}
+
+func TestServer_Initialize(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{"supported", "2025-03-26", "2025-03-26"},
+		{"unsupported", "1999-01-01", protocolVersion},
+		{"missing", "", protocolVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"` + tt.version + `","clientInfo":{"name":"test"}}}`
+			replies := serve(t, &fakeTools{}, line)
+			if len(replies) != 1 {
+				t.Fatalf("got %d replies, want 1", len(replies))
+			}
+			var res struct {
+				ProtocolVersion string         `json:"protocolVersion"`
+				Capabilities    map[string]any `json:"capabilities"`
+				ServerInfo      struct {
+					Name    string `json:"name"`
+					Version string `json:"version"`
+				} `json:"serverInfo"`
+			}
+			if err := json.Unmarshal(replies[0].Result, &res); err != nil {
+				t.Fatalf("unmarshal result: %v", err)
+			}
+			if res.ProtocolVersion != tt.want {
+				t.Errorf("protocolVersion = %q, want %q", res.ProtocolVersion, tt.want)
+			}
+			if _, ok := res.Capabilities["tools"]; !ok {
+				t.Error("capabilities missing tools")
+			}
+			if res.ServerInfo.Name != "claws" || res.ServerInfo.Version != "test" {
+				t.Errorf("serverInfo = %+v", res.ServerInfo)
+			}
+		})
+	}
+}
+
+func TestServer_ToolsList(t *testing.T) {
+	replies := serve(t, &fakeTools{}, `{"jsonrpc":"2.0","id":"a","method":"tools/list"}`)
+	if len(replies) != 1 {
+		t.Fatalf("got %d replies, want 1", len(replies))
+	}
+	if string(replies[0].ID) != `"a"` {
+		t.Errorf("id = %s, want \"a\"", replies[0].ID)
+	}
+	var res struct {
+		Tools []toolInfo `json:"tools"`
+	}
+	if err := json.Unmarshal(replies[0].Result, &res); err != nil {
+		t.Fatalf("unmarshal result: %v", err)
+	}
+	if len(res.Tools) != 2 || res.Tools[0].Name != "echo" || res.Tools[0].InputSchema["type"] != "object" {
+		t.Errorf("tools = %+v", res.Tools)
+	}
+}
+
+func TestServer_ToolsCall(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    string
+		wantText  string
+		wantIsErr bool
+		wantCode  int
+	}{
+		{"success", `{"name":"echo","arguments":{"msg":"hi"}}`, "hi", false, 0},
+		{"tool error", `{"name":"echo"}`, "Error: msg is required", true, 0},
+		{"unknown tool", `{"name":"nope"}`, "", false, codeInvalidParams},
+		{"bad params", `[]`, "", false, codeInvalidParams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replies := serve(t, &fakeTools{}, `{"jsonrpc":"2.0","id":7,"method":"tools/call","params":`+tt.params+`}`)
+			if len(replies) != 1 {
+				t.Fatalf("got %d replies, want 1", len(replies))
+			}
+			r := replies[0]
+			if tt.wantCode != 0 {
+				if r.Error == nil || r.Error.Code != tt.wantCode {
+					t.Fatalf("error = %+v, want code %d", r.Error, tt.wantCode)
+				}
+				return
+			}
+			if r.Error != nil {
+				t.Fatalf("unexpected error: %+v", r.Error)
+			}
+			var res callResult
+			if err := json.Unmarshal(r.Result, &res); err != nil {
+				t.Fatalf("unmarshal result: %v", err)
+			}
+			if len(res.Content) != 1 || res.Content[0].Type != "text" || res.Content[0].Text != tt.wantText {
+				t.Errorf("content = %+v, want text %q", res.Content, tt.wantText)
+			}
+			if res.IsError != tt.wantIsErr {
+				t.Errorf("isError = %v, want %v", res.IsError, tt.wantIsErr)
+			}
+		})
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	replies := serve(t, &fakeTools{},
+		`{not json`,
+		`{"jsonrpc":"1.0","id":1,"method":"ping"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"resources/list"}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"ping"}`,
+	)
+
+	want := []struct {
+		id   string
+		code int
+	}{
+		{"null", codeParseError},
+		{"1", codeInvalidRequest},
+		{"2", codeMethodNotFound},
+		{"3", 0},
+	}
+	if len(replies) != len(want) {
+		t.Fatalf("got %d replies, want %d (notifications get none)", len(replies), len(want))
+	}
+	for i, w := range want {
+		r := replies[i]
+		if string(r.ID) != w.id {
+			t.Errorf("reply %d id = %s, want %s", i, r.ID, w.id)
+		}
+		code := 0
+		if r.Error != nil {
+			code = r.Error.Code
+		}
+		if code != w.code {
+			t.Errorf("reply %d code = %d, want %d", i, code, w.code)
+		}
+	}
+}
+
+func TestServer_Cancel(t *testing.T) {
+	tools := &fakeTools{block: make(chan struct{})}
+	defer close(tools.block)
+
+	done := make(chan []reply)
+	go func() {
+		done <- serve(t, tools,
+			`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"slow"}}`,
+			`{"jsonrpc":"2.0","method":"notifications/cancelled","params":{"requestId":1}}`,
+		)
+	}()
+
+	select {
+	case replies := <-done:
+		if len(replies) != 0 {
+			t.Errorf("cancelled call got replies: %+v", replies)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("cancelled tool call did not finish")
+	}
+}
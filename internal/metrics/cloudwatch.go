@@ -25,11 +25,11 @@ type Fetcher struct {
 }
 
 func NewFetcher(ctx context.Context) (*Fetcher, error) {
-	cfg, err := appaws.NewConfig(ctx)
+	client, err := appaws.Client(ctx, cloudwatch.NewFromConfig)
 	if err != nil {
 		return nil, err
 	}
-	return &Fetcher{client: cloudwatch.NewFromConfig(cfg)}, nil
+	return &Fetcher{client: client}, nil
 }
 
 func (f *Fetcher) Fetch(ctx context.Context, resourceIDs []string, spec *render.MetricSpec) (*MetricData, error) {
@@ -43,12 +43,23 @@ func (f *Fetcher) Fetch(ctx context.Context, resourceIDs []string, spec *render.
 
 	data := NewMetricData(spec)
 
-	for i := 0; i < len(queries); i += maxQueriesPerRequest {
+	// Keep each resource's queries in one request: expression inputs must be
+	// sent alongside the expression that references them.
+	batchSize := maxQueriesPerRequest
+	if spec.Expression != "" {
+		groupSize := 1 + len(spec.Inputs)
+		batchSize = (maxQueriesPerRequest / groupSize) * groupSize
+		if batchSize == 0 {
+			return data, fmt.Errorf("metric expression needs %d queries per resource, over the %d request limit", groupSize, maxQueriesPerRequest)
+		}
+	}
+
+	for i := 0; i < len(queries); i += batchSize {
 		if ctx.Err() != nil {
 			return data, ctx.Err()
 		}
 
-		end := i + maxQueriesPerRequest
+		end := i + batchSize
 		if end > len(queries) {
 			end = len(queries)
 		}
@@ -73,29 +84,59 @@ func (f *Fetcher) Fetch(ctx context.Context, resourceIDs []string, spec *render.
 }
 
 func (f *Fetcher) buildQueries(resourceIDs []string, spec *render.MetricSpec) []types.MetricDataQuery {
+	if spec.Expression != "" {
+		return f.buildExpressionQueries(resourceIDs, spec)
+	}
+
 	queries := make([]types.MetricDataQuery, len(resourceIDs))
 	for i, resourceID := range resourceIDs {
 		queries[i] = types.MetricDataQuery{
-			Id: aws.String(fmt.Sprintf("m%d", i)),
-			MetricStat: &types.MetricStat{
-				Metric: &types.Metric{
-					Namespace:  aws.String(spec.Namespace),
-					MetricName: aws.String(spec.MetricName),
-					Dimensions: []types.Dimension{
-						{
-							Name:  aws.String(spec.DimensionName),
-							Value: aws.String(resourceID),
-						},
-					},
-				},
-				Period: aws.Int32(metricPeriod),
-				Stat:   aws.String(spec.Stat),
-			},
+			Id:         aws.String(fmt.Sprintf("m%d", i)),
+			MetricStat: metricStat(spec, spec.MetricName, spec.Stat, resourceID),
+		}
+	}
+	return queries
+}
+
+// buildExpressionQueries returns, per resource, the expression query (m<i>)
+// followed by the hidden input queries it references (m<i>_<input>).
+func (f *Fetcher) buildExpressionQueries(resourceIDs []string, spec *render.MetricSpec) []types.MetricDataQuery {
+	queries := make([]types.MetricDataQuery, 0, len(resourceIDs)*(1+len(spec.Inputs)))
+	for i, resourceID := range resourceIDs {
+		prefix := fmt.Sprintf("m%d_", i)
+		queries = append(queries, types.MetricDataQuery{
+			Id:         aws.String(fmt.Sprintf("m%d", i)),
+			Expression: aws.String(scopeExpression(spec.Expression, prefix, spec.Inputs)),
+			Period:     aws.Int32(metricPeriod),
+		})
+		for _, in := range spec.Inputs {
+			queries = append(queries, types.MetricDataQuery{
+				Id:         aws.String(prefix + in.ID),
+				MetricStat: metricStat(spec, in.MetricName, in.Stat, resourceID),
+				ReturnData: aws.Bool(false),
+			})
 		}
 	}
 	return queries
 }
 
+func metricStat(spec *render.MetricSpec, metricName, stat, resourceID string) *types.MetricStat {
+	return &types.MetricStat{
+		Metric: &types.Metric{
+			Namespace:  aws.String(spec.Namespace),
+			MetricName: aws.String(metricName),
+			Dimensions: []types.Dimension{
+				{
+					Name:  aws.String(spec.DimensionName),
+					Value: aws.String(resourceID),
+				},
+			},
+		},
+		Period: aws.Int32(metricPeriod),
+		Stat:   aws.String(stat),
+	}
+}
+
 func (f *Fetcher) processResults(results []types.MetricDataResult, resourceIDs []string, data *MetricData) {
 	idToResource := make(map[string]string, len(resourceIDs))
 	for i, id := range resourceIDs {
@@ -76,6 +76,43 @@ func TestFetcher_buildQueries_correctStructure(t *testing.T) {
 	}
 }
 
+func TestFetcher_buildQueries_expression(t *testing.T) {
+	f := &Fetcher{}
+	spec := &render.MetricSpec{
+		Namespace:     "AWS/Lambda",
+		DimensionName: "FunctionName",
+		Expression:    "errors / invocations",
+		Inputs: []render.MetricInput{
+			{ID: "errors", MetricName: "Errors", Stat: "Sum"},
+			{ID: "invocations", MetricName: "Invocations", Stat: "Sum"},
+		},
+	}
+
+	queries := f.buildQueries([]string{"fn-a", "fn-b"}, spec)
+	if len(queries) != 6 {
+		t.Fatalf("expected 6 queries, got %d", len(queries))
+	}
+
+	expr := queries[3]
+	if *expr.Id != "m1" || expr.Expression == nil || *expr.Expression != "m1_errors / m1_invocations" {
+		t.Errorf("expression query = %s %v", *expr.Id, aws.ToString(expr.Expression))
+	}
+	if expr.ReturnData != nil && !*expr.ReturnData {
+		t.Error("expression query should return data")
+	}
+
+	input := queries[4]
+	if *input.Id != "m1_errors" {
+		t.Errorf("input Id = %s, want m1_errors", *input.Id)
+	}
+	if input.ReturnData == nil || *input.ReturnData {
+		t.Error("input query should not return data")
+	}
+	if *input.MetricStat.Metric.MetricName != "Errors" || *input.MetricStat.Metric.Dimensions[0].Value != "fn-b" {
+		t.Errorf("input metric = %s/%s", *input.MetricStat.Metric.MetricName, *input.MetricStat.Metric.Dimensions[0].Value)
+	}
+}
+
 func TestBatchSplitting(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,143 @@
+package metrics
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
+)
+
+const defaultExpressionStat = "Sum"
+
+// metricIDPattern matches valid CloudWatch metric query IDs, which are also
+// the variable names usable in an expression.
+var (
+	metricIDPattern   = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`)
+	identifierPattern = regexp.MustCompile(`\b[a-z][a-zA-Z0-9_]*\b`)
+)
+
+// cachedSpec is a resolved spec along with the expression config it was
+// built from, so a changed config is picked up instead of served stale.
+type cachedSpec struct {
+	expr config.MetricExpressionConfig
+	spec *render.MetricSpec
+}
+
+var (
+	specCacheMu sync.Mutex
+	specCache   = make(map[string]cachedSpec)
+)
+
+// SpecFor returns the metric spec for a resource type ("service/resource"):
+// the configured metric math expression if one is defined and valid,
+// otherwise base. Results are cached per expression config so invalid config
+// is reported once; a reloaded config rebuilds the spec.
+func SpecFor(resourcePath string, base *render.MetricSpec) *render.MetricSpec {
+	expr, ok := config.File().MetricExpression(resourcePath)
+
+	specCacheMu.Lock()
+	defer specCacheMu.Unlock()
+	if !ok {
+		delete(specCache, resourcePath)
+		return base
+	}
+	if c, cached := specCache[resourcePath]; cached && reflect.DeepEqual(c.expr, expr) {
+		return c.spec
+	}
+
+	spec, err := ApplyExpression(base, expr)
+	if err != nil {
+		log.Warn("invalid metric expression, using default metric", "resource", resourcePath, "error", err)
+		spec = base
+	}
+	specCache[resourcePath] = cachedSpec{expr: expr, spec: spec}
+	return spec
+}
+
+// ResetSpecCache drops all cached specs. Call it after reloading the config.
+func ResetSpecCache() {
+	specCacheMu.Lock()
+	defer specCacheMu.Unlock()
+	clear(specCache)
+}
+
+// ApplyExpression returns a copy of base that fetches the configured metric
+// math expression instead of base's single metric. base may be nil when the
+// config supplies both namespace and dimension.
+func ApplyExpression(base *render.MetricSpec, expr config.MetricExpressionConfig) (*render.MetricSpec, error) {
+	if expr.Expression == "" {
+		return nil, fmt.Errorf("expression is empty")
+	}
+	if len(expr.Metrics) == 0 {
+		return nil, fmt.Errorf("expression %q defines no metrics", expr.Expression)
+	}
+	// Each resource sends the expression and its inputs in one request
+	if 1+len(expr.Metrics) > maxQueriesPerRequest {
+		return nil, fmt.Errorf("expression %q defines %d metrics, at most %d allowed", expr.Expression, len(expr.Metrics), maxQueriesPerRequest-1)
+	}
+
+	spec := &render.MetricSpec{}
+	if base != nil {
+		*spec = *base
+	}
+	if expr.Namespace != "" {
+		spec.Namespace = expr.Namespace
+	}
+	if expr.Dimension != "" {
+		spec.DimensionName = expr.Dimension
+	}
+	if spec.Namespace == "" || spec.DimensionName == "" {
+		return nil, fmt.Errorf("expression %q needs namespace and dimension", expr.Expression)
+	}
+
+	spec.Expression = expr.Expression
+	spec.MetricName = ""
+	spec.ColumnHeader = expr.Header
+	if spec.ColumnHeader == "" {
+		spec.ColumnHeader = "EXPR"
+	}
+	spec.Unit = expr.Unit
+
+	ids := make([]string, 0, len(expr.Metrics))
+	for id := range expr.Metrics {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	spec.Inputs = make([]render.MetricInput, 0, len(ids))
+	for _, id := range ids {
+		m := expr.Metrics[id]
+		if !metricIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid metric id %q: must start with a lowercase letter and contain only letters, digits, and _", id)
+		}
+		if m.Name == "" {
+			return nil, fmt.Errorf("metric %q has no name", id)
+		}
+		stat := m.Stat
+		if stat == "" {
+			stat = defaultExpressionStat
+		}
+		spec.Inputs = append(spec.Inputs, render.MetricInput{ID: id, MetricName: m.Name, Stat: stat})
+	}
+	return spec, nil
+}
+
+// scopeExpression rewrites references to input IDs in expr so that each
+// resource's copy of the expression refers to its own input queries.
+func scopeExpression(expr, prefix string, inputs []render.MetricInput) string {
+	known := make(map[string]bool, len(inputs))
+	for _, in := range inputs {
+		known[in.ID] = true
+	}
+	return identifierPattern.ReplaceAllStringFunc(expr, func(id string) string {
+		if known[id] {
+			return prefix + id
+		}
+		return id
+	})
+}
@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func lambdaSpec() *render.MetricSpec {
+	return &render.MetricSpec{
+		Namespace:     "AWS/Lambda",
+		MetricName:    "Invocations",
+		DimensionName: "FunctionName",
+		Stat:          "Sum",
+		ColumnHeader:  "INVOCATIONS",
+	}
+}
+
+func TestApplyExpression(t *testing.T) {
+	base := lambdaSpec()
+	spec, err := ApplyExpression(base, config.MetricExpressionConfig{
+		Header:     "ERR%",
+		Unit:       "%",
+		Expression: "100 * errors / invocations",
+		Metrics: map[string]config.MetricInputConfig{
+			"invocations": {Name: "Invocations"},
+			"errors":      {Name: "Errors", Stat: "Maximum"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyExpression() error = %v", err)
+	}
+
+	if spec.Namespace != "AWS/Lambda" || spec.DimensionName != "FunctionName" {
+		t.Errorf("namespace/dimension not inherited: %+v", spec)
+	}
+	if spec.ColumnHeader != "ERR%" || spec.Unit != "%" {
+		t.Errorf("header/unit = %q/%q", spec.ColumnHeader, spec.Unit)
+	}
+	if len(spec.Inputs) != 2 {
+		t.Fatalf("Inputs len = %d, want 2", len(spec.Inputs))
+	}
+	if spec.Inputs[0] != (render.MetricInput{ID: "errors", MetricName: "Errors", Stat: "Maximum"}) {
+		t.Errorf("Inputs[0] = %+v", spec.Inputs[0])
+	}
+	if spec.Inputs[1].Stat != defaultExpressionStat {
+		t.Errorf("Inputs[1].Stat = %q, want default %q", spec.Inputs[1].Stat, defaultExpressionStat)
+	}
+	if base.Expression != "" || base.ColumnHeader != "INVOCATIONS" {
+		t.Error("ApplyExpression() modified base spec")
+	}
+}
+
+func TestApplyExpression_Errors(t *testing.T) {
+	metrics := map[string]config.MetricInputConfig{"errors": {Name: "Errors"}}
+	tests := []struct {
+		name string
+		base *render.MetricSpec
+		expr config.MetricExpressionConfig
+		want string
+	}{
+		{"empty expression", lambdaSpec(), config.MetricExpressionConfig{Metrics: metrics}, "empty"},
+		{"no metrics", lambdaSpec(), config.MetricExpressionConfig{Expression: "errors"}, "no metrics"},
+		{"no namespace", nil, config.MetricExpressionConfig{Expression: "errors", Metrics: metrics}, "namespace"},
+		{"invalid id", lambdaSpec(), config.MetricExpressionConfig{
+			Expression: "Errors", Metrics: map[string]config.MetricInputConfig{"Errors": {Name: "Errors"}},
+		}, "invalid metric id"},
+		{"missing name", lambdaSpec(), config.MetricExpressionConfig{
+			Expression: "errors", Metrics: map[string]config.MetricInputConfig{"errors": {}},
+		}, "no name"},
+		{"too many metrics", lambdaSpec(), config.MetricExpressionConfig{
+			Expression: "SUM(METRICS())", Metrics: manyMetrics(maxQueriesPerRequest),
+		}, "at most"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ApplyExpression(tt.base, tt.expr)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ApplyExpression() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func manyMetrics(n int) map[string]config.MetricInputConfig {
+	metrics := make(map[string]config.MetricInputConfig, n)
+	for i := range n {
+		metrics[fmt.Sprintf("m%d", i)] = config.MetricInputConfig{Name: "Errors"}
+	}
+	return metrics
+}
+
+func TestSpecFor_ConfigChange(t *testing.T) {
+	const path = "lambda/functions"
+	cw := &config.File().CloudWatch
+	saved := cw.Expressions
+	t.Cleanup(func() {
+		cw.Expressions = saved
+		ResetSpecCache()
+	})
+	ResetSpecCache()
+
+	cw.Expressions = map[string]config.MetricExpressionConfig{path: {
+		Expression: "errors", Metrics: map[string]config.MetricInputConfig{"errors": {Name: "Errors"}},
+	}}
+	if got := SpecFor(path, lambdaSpec()); got.Expression != "errors" {
+		t.Fatalf("Expression = %q, want errors", got.Expression)
+	}
+
+	cw.Expressions = map[string]config.MetricExpressionConfig{path: {
+		Expression: "throttles", Metrics: map[string]config.MetricInputConfig{"throttles": {Name: "Throttles"}},
+	}}
+	if got := SpecFor(path, lambdaSpec()); got.Expression != "throttles" {
+		t.Errorf("Expression after config change = %q, want throttles", got.Expression)
+	}
+
+	cw.Expressions = nil
+	if got := SpecFor(path, lambdaSpec()); got.Expression != "" {
+		t.Errorf("Expression after removal = %q, want base spec", got.Expression)
+	}
+}
+
+func TestApplyExpression_ConfigNamespace(t *testing.T) {
+	spec, err := ApplyExpression(nil, config.MetricExpressionConfig{
+		Expression: "hits / (hits + misses)",
+		Namespace:  "AWS/ElastiCache",
+		Dimension:  "CacheClusterId",
+		Metrics: map[string]config.MetricInputConfig{
+			"hits":   {Name: "CacheHits"},
+			"misses": {Name: "CacheMisses"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyExpression() error = %v", err)
+	}
+	if spec.Namespace != "AWS/ElastiCache" || spec.DimensionName != "CacheClusterId" {
+		t.Errorf("namespace/dimension = %q/%q", spec.Namespace, spec.DimensionName)
+	}
+	if spec.ColumnHeader != "EXPR" {
+		t.Errorf("ColumnHeader = %q, want EXPR", spec.ColumnHeader)
+	}
+}
+
+func TestScopeExpression(t *testing.T) {
+	inputs := []render.MetricInput{{ID: "errors"}, {ID: "invocations"}}
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"100 * errors / invocations", "100 * m3_errors / m3_invocations"},
+		{"IF(invocations > 0, errors / invocations, 0)", "IF(m3_invocations > 0, m3_errors / m3_invocations, 0)"},
+		{"FILL(errors, 0) + other", "FILL(m3_errors, 0) + other"},
+		{"errors_total", "errors_total"},
+	}
+	for _, tt := range tests {
+		if got := scopeExpression(tt.expr, "m3_", inputs); got != tt.want {
+			t.Errorf("scopeExpression(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
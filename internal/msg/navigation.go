@@ -11,3 +11,13 @@ type ProfilesChangedMsg struct {
 type RegionChangedMsg struct {
 	Regions []string
 }
+
+// ShowResourcesMsg opens a resource list, narrowed to the resources whose
+// FilterField equals FilterValue when set. Actions send it as a follow-up,
+// e.g. to show the invocations of a command they started.
+type ShowResourcesMsg struct {
+	Service      string
+	ResourceType string
+	FilterField  string
+	FilterValue  string
+}
@@ -0,0 +1,256 @@
+// Package policy lints IAM policy documents with IAM Access Analyzer's
+// ValidatePolicy, so local policy files can be checked before they are
+// committed.
+package policy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Policy type names accepted by ParseType
+const (
+	TypeIdentity = "identity"
+	TypeResource = "resource"
+	TypeSCP      = "scp"
+	TypeRCP      = "rcp"
+)
+
+// Types lists the accepted policy type names; the first is the default.
+var Types = []string{TypeIdentity, TypeResource, TypeSCP, TypeRCP}
+
+// maxDocumentSize is the largest document read from disk. Access Analyzer
+// rejects anything bigger, and it keeps a stray path from loading a huge file.
+const maxDocumentSize = 1 << 20
+
+// Finding is one issue reported for a policy document.
+type Finding struct {
+	Type          types.ValidatePolicyFindingType
+	IssueCode     string
+	Message       string
+	LearnMoreLink string
+	Line          int    // 1-based; 0 when the finding has no location
+	Column        int    // 1-based
+	Path          string // e.g. "Statement[0].Action[1]"
+}
+
+// Location formats the finding's position as "line:column", or "-".
+func (f Finding) Location() string {
+	if f.Line == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d:%d", f.Line, f.Column)
+}
+
+// ParseType converts a policy type name to its Access Analyzer value. An
+// empty name is an identity policy.
+func ParseType(name string) (types.PolicyType, error) {
+	switch strings.ToLower(name) {
+	case "", TypeIdentity:
+		return types.PolicyTypeIdentityPolicy, nil
+	case TypeResource:
+		return types.PolicyTypeResourcePolicy, nil
+	case TypeSCP:
+		return types.PolicyTypeServiceControlPolicy, nil
+	case TypeRCP:
+		return types.PolicyTypeResourceControlPolicy, nil
+	}
+	return "", fmt.Errorf("unknown policy type %q (want %s)", name, strings.Join(Types, ", "))
+}
+
+// ReadFile reads a policy document from path, expanding a leading ~/.
+func ReadFile(path string) (string, error) {
+	expanded, err := config.ExpandTilde(path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(expanded)
+	if err != nil {
+		return "", apperrors.Wrap(err, "read policy", "path", expanded)
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("read policy: %s is a directory", expanded)
+	}
+	if info.Size() > maxDocumentSize {
+		return "", fmt.Errorf("read policy: %s is larger than %d bytes", expanded, maxDocumentSize)
+	}
+	data, err := os.ReadFile(expanded)
+	if err != nil {
+		return "", apperrors.Wrap(err, "read policy", "path", expanded)
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return "", fmt.Errorf("read policy: %s is empty", expanded)
+	}
+	return string(data), nil
+}
+
+// ReadFileUnder reads a .json policy document from path, which must resolve
+// (after symlinks) to a file inside dir. Relative paths are taken from dir.
+// It is for callers that don't choose the path themselves, such as AI tools.
+func ReadFileUnder(dir, path string) (string, error) {
+	if !strings.EqualFold(filepath.Ext(path), ".json") {
+		return "", fmt.Errorf("read policy: %s is not a .json file", path)
+	}
+	expanded, err := config.ExpandTilde(path)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(expanded) {
+		expanded = filepath.Join(dir, expanded)
+	}
+	resolved, err := filepath.EvalSymlinks(expanded)
+	if err != nil {
+		return "", apperrors.Wrap(err, "read policy", "path", expanded)
+	}
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", apperrors.Wrap(err, "read policy", "dir", dir)
+	}
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("read policy: %s is outside %s", path, root)
+	}
+	return ReadFile(resolved)
+}
+
+// Validate runs ValidatePolicy on document in the current profile and
+// region, returning findings sorted by severity and then position.
+func Validate(ctx context.Context, document string, policyType types.PolicyType) ([]Finding, error) {
+	client, err := appaws.Client(ctx, accessanalyzer.NewFromConfig)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "validate policy")
+	}
+
+	raw, err := appaws.Paginate(ctx, func(token *string) ([]types.ValidatePolicyFinding, *string, error) {
+		output, err := client.ValidatePolicy(ctx, &accessanalyzer.ValidatePolicyInput{
+			PolicyDocument: &document,
+			PolicyType:     policyType,
+			NextToken:      token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "validate policy")
+		}
+		return output.Findings, output.NextToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	findings := make([]Finding, len(raw))
+	for i, f := range raw {
+		findings[i] = newFinding(f)
+	}
+	Sort(findings)
+	return findings, nil
+}
+
+func newFinding(f types.ValidatePolicyFinding) Finding {
+	finding := Finding{
+		Type:          f.FindingType,
+		IssueCode:     appaws.Str(f.IssueCode),
+		Message:       appaws.Str(f.FindingDetails),
+		LearnMoreLink: appaws.Str(f.LearnMoreLink),
+	}
+	// The first location is the one the finding is about
+	if len(f.Locations) > 0 {
+		loc := f.Locations[0]
+		finding.Path = formatPath(loc.Path)
+		if loc.Span != nil && loc.Span.Start != nil {
+			finding.Line = int(appaws.Int32(loc.Span.Start.Line))
+			// Access Analyzer columns start at 0; editors count from 1
+			finding.Column = int(appaws.Int32(loc.Span.Start.Column)) + 1
+		}
+	}
+	return finding
+}
+
+// formatPath renders a JSON path such as Statement[0].Action[1]
+func formatPath(path []types.PathElement) string {
+	var b strings.Builder
+	for _, el := range path {
+		switch v := el.(type) {
+		case *types.PathElementMemberIndex:
+			fmt.Fprintf(&b, "[%d]", v.Value)
+		case *types.PathElementMemberKey:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(v.Value)
+		case *types.PathElementMemberValue:
+			fmt.Fprintf(&b, "=%q", v.Value)
+		case *types.PathElementMemberSubstring:
+			fmt.Fprintf(&b, "[%d:%d]", appaws.Int32(v.Value.Start), appaws.Int32(v.Value.Start)+appaws.Int32(v.Value.Length))
+		}
+	}
+	return b.String()
+}
+
+// severity orders finding types from most to least serious
+var severity = map[types.ValidatePolicyFindingType]int{
+	types.ValidatePolicyFindingTypeError:           0,
+	types.ValidatePolicyFindingTypeSecurityWarning: 1,
+	types.ValidatePolicyFindingTypeWarning:         2,
+	types.ValidatePolicyFindingTypeSuggestion:      3,
+}
+
+func rank(t types.ValidatePolicyFindingType) int {
+	if r, ok := severity[t]; ok {
+		return r
+	}
+	return len(severity)
+}
+
+// Sort orders findings by severity, then line and column.
+func Sort(findings []Finding) {
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		if d := rank(a.Type) - rank(b.Type); d != 0 {
+			return d
+		}
+		if a.Line != b.Line {
+			return a.Line - b.Line
+		}
+		return a.Column - b.Column
+	})
+}
+
+// HasErrors reports whether any finding makes the policy non-functional.
+func HasErrors(findings []Finding) bool {
+	return slices.ContainsFunc(findings, func(f Finding) bool {
+		return f.Type == types.ValidatePolicyFindingTypeError
+	})
+}
+
+// Format renders findings compiler-style, one per line as
+// "path:line:col: TYPE ISSUE_CODE: message", for pasting into reviews.
+func Format(path string, findings []Finding) string {
+	if len(findings) == 0 {
+		return fmt.Sprintf("%s: no findings", path)
+	}
+	var b strings.Builder
+	for _, f := range findings {
+		pos := path
+		if f.Line > 0 {
+			pos = fmt.Sprintf("%s:%d:%d", path, f.Line, f.Column)
+		}
+		fmt.Fprintf(&b, "%s: %s %s: %s", pos, f.Type, f.IssueCode, f.Message)
+		if f.Path != "" {
+			fmt.Fprintf(&b, " (%s)", f.Path)
+		}
+		if f.LearnMoreLink != "" {
+			fmt.Fprintf(&b, "\n  see %s", f.LearnMoreLink)
+		}
+		b.WriteByte('\n')
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,148 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+)
+
+func TestParseType(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    types.PolicyType
+		wantErr bool
+	}{
+		{"", types.PolicyTypeIdentityPolicy, false},
+		{"identity", types.PolicyTypeIdentityPolicy, false},
+		{"Resource", types.PolicyTypeResourcePolicy, false},
+		{"scp", types.PolicyTypeServiceControlPolicy, false},
+		{"rcp", types.PolicyTypeResourceControlPolicy, false},
+		{"trust", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseType(tt.name)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseType(%q) = %q, %v, want %q (err %v)", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "policy.json")
+	if err := os.WriteFile(path, []byte(`{"Version":"2012-10-17"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if doc, err := ReadFile(path); err != nil || !strings.Contains(doc, "2012-10-17") {
+		t.Errorf("ReadFile() = %q, %v", doc, err)
+	}
+
+	empty := filepath.Join(dir, "empty.json")
+	if err := os.WriteFile(empty, []byte("  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{empty, dir, filepath.Join(dir, "missing.json")} {
+		if _, err := ReadFile(p); err == nil {
+			t.Errorf("ReadFile(%q) should fail", p)
+		}
+	}
+}
+
+func TestReadFileUnder(t *testing.T) {
+	dir := t.TempDir()
+	work := filepath.Join(dir, "work")
+	if err := os.MkdirAll(filepath.Join(work, "iam"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	doc := []byte(`{"Version":"2012-10-17"}`)
+	for _, p := range []string{
+		filepath.Join(work, "iam", "policy.json"),
+		filepath.Join(work, "notes.txt"),
+		filepath.Join(dir, "outside.json"),
+	} {
+		if err := os.WriteFile(p, doc, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(filepath.Join(dir, "outside.json"), filepath.Join(work, "link.json")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []string{"iam/policy.json", filepath.Join(work, "iam", "policy.json")} {
+		if got, err := ReadFileUnder(work, p); err != nil || !strings.Contains(got, "2012-10-17") {
+			t.Errorf("ReadFileUnder(%q) = %q, %v", p, got, err)
+		}
+	}
+	for _, p := range []string{"notes.txt", "../outside.json", filepath.Join(dir, "outside.json"), "link.json", "missing.json"} {
+		if _, err := ReadFileUnder(work, p); err == nil {
+			t.Errorf("ReadFileUnder(%q) should fail", p)
+		}
+	}
+}
+
+func TestNewFinding(t *testing.T) {
+	f := newFinding(types.ValidatePolicyFinding{
+		FindingType:    types.ValidatePolicyFindingTypeSecurityWarning,
+		IssueCode:      aws.String("PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+		FindingDetails: aws.String("Using iam:PassRole with * is overly permissive."),
+		LearnMoreLink:  aws.String("https://docs.aws.amazon.com/x"),
+		Locations: []types.Location{{
+			Path: []types.PathElement{
+				&types.PathElementMemberKey{Value: "Statement"},
+				&types.PathElementMemberIndex{Value: 0},
+				&types.PathElementMemberKey{Value: "Resource"},
+			},
+			Span: &types.Span{Start: &types.Position{Line: aws.Int32(7), Column: aws.Int32(18)}},
+		}},
+	})
+	if f.Location() != "7:19" || f.Path != "Statement[0].Resource" || f.IssueCode != "PASS_ROLE_WITH_STAR_IN_RESOURCE" {
+		t.Errorf("newFinding() = %+v", f)
+	}
+
+	if f := newFinding(types.ValidatePolicyFinding{}); f.Location() != "-" || f.Path != "" {
+		t.Errorf("finding without location = %+v", f)
+	}
+}
+
+func TestSort(t *testing.T) {
+	findings := []Finding{
+		{Type: types.ValidatePolicyFindingTypeSuggestion, Line: 1},
+		{Type: types.ValidatePolicyFindingTypeError, Line: 9},
+		{Type: types.ValidatePolicyFindingTypeWarning, Line: 2},
+		{Type: types.ValidatePolicyFindingTypeError, Line: 3},
+		{Type: types.ValidatePolicyFindingTypeSecurityWarning, Line: 5},
+	}
+	Sort(findings)
+
+	var got []string
+	for _, f := range findings {
+		got = append(got, string(f.Type)+"@"+f.Location())
+	}
+	want := "ERROR@3:0 ERROR@9:0 SECURITY_WARNING@5:0 WARNING@2:0 SUGGESTION@1:0"
+	if strings.Join(got, " ") != want {
+		t.Errorf("Sort() = %v, want %s", got, want)
+	}
+	if !HasErrors(findings) || HasErrors(findings[2:]) {
+		t.Error("HasErrors() should only be true when an ERROR finding is present")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if got := Format("p.json", nil); got != "p.json: no findings" {
+		t.Errorf("Format(nil) = %q", got)
+	}
+
+	got := Format("p.json", []Finding{
+		{Type: types.ValidatePolicyFindingTypeError, IssueCode: "MISSING_VERSION", Message: "Add a Version.", Line: 1, Column: 1},
+		{Type: types.ValidatePolicyFindingTypeWarning, IssueCode: "X", Message: "m", Path: "Statement[0]", LearnMoreLink: "https://l"},
+	})
+	want := "p.json:1:1: ERROR MISSING_VERSION: Add a Version.\n" +
+		"p.json: WARNING X: m (Statement[0])\n  see https://l"
+	if got != want {
+		t.Errorf("Format() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -50,11 +50,13 @@ type Registry struct {
 	mu           sync.RWMutex
 	custom       map[ServiceResource]Entry // High-priority custom implementations
 	generated    map[ServiceResource]Entry // Low-priority generated implementations
+	pending      []registration            // registrations not yet indexed into custom/generated
 	services     map[string][]string       // service -> resource types
 	aliases      map[string]string         // alias -> service name or service/resource
 	displayNames map[string]string         // service -> display name for UI
 	categories   []ServiceCategory         // ordered list of service categories
 	userDefaults map[string]string         // user-configured default resources per service
+	dropped      map[string]bool           // services dropped by Restrict
 
 	// Cached computed values (aliases are immutable after init, safe to cache)
 	aliasListOnce       sync.Once           // guards aliasListCache initialization
@@ -63,6 +65,15 @@ type Registry struct {
 	serviceAliasesCache map[string][]string // cached result of GetAliasesForService() by service
 }
 
+// registration is an entry recorded by RegisterCustom or RegisterGenerated.
+// Entries are indexed on the first lookup rather than at init, so Restrict
+// can drop services before anything can reach their factories.
+type registration struct {
+	sr     ServiceResource
+	entry  Entry
+	custom bool
+}
+
 // New creates a new Registry
 func New() *Registry {
 	return &Registry{
@@ -84,6 +95,7 @@ func defaultAliases() map[string]string {
 		"asg":              "autoscaling",
 		"cw":               "cloudwatch",
 		"logs":             "cloudwatch/log-groups",
+		"insights":         "cloudwatch/insights-queries",
 		"r53":              "route53",
 		"ssm":              "ssm",
 		"sm":               "secretsmanager",
@@ -98,6 +110,7 @@ func defaultAliases() map[string]string {
 		"eb":               "events",
 		"eventbridge":      "events",
 		"sfn":              "stepfunctions",
+		"kafka":            "msk",
 		"sq":               "service-quotas",
 		"quotas":           "service-quotas",
 		"apigw":            "apigateway",
@@ -128,11 +141,28 @@ func defaultAliases() map[string]string {
 		"ri":               "risp/reserved-instances",
 		"sp":               "risp/savings-plans",
 		"odcr":             "ec2/capacity-reservations",
+		"eni":              "ec2/network-interfaces",
+		"spot":             "ec2/spot-requests",
 		"tgw":              "vpc/transit-gateways",
 		"cognito":          "cognito-idp",
 		"config":           "configservice",
 		"macie":            "macie2",
 		"gl":               "gamelift",
+		"beanstalk":        "elasticbeanstalk",
+		"ga":               "globalaccelerator",
+		"fh":               "firehose",
+		"flags":            "appconfig",
+		"cloudhsm":         "cloudhsmv2",
+		"directory":        "ds",
+		"email":            "ses",
+		"iotcore":          "iot",
+		"migration":        "dms",
+		"opsitems":         "ssm/opsitems",
+		"incidents":        "ssm-incidents",
+		"incident-manager": "ssm-incidents",
+		"control-tower":    "controltower",
+		"ct":               "controltower",
+		"guardrails":       "controltower/controls",
 	}
 }
 
@@ -141,7 +171,9 @@ func defaultDisplayNames() map[string]string {
 	return map[string]string{
 		"accessanalyzer":    "IAM Access Analyzer",
 		"acm":               "ACM",
+		"amplify":           "Amplify",
 		"apigateway":        "API Gateway",
+		"appconfig":         "AppConfig",
 		"apprunner":         "App Runner",
 		"appsync":           "AppSync",
 		"athena":            "Athena",
@@ -154,37 +186,51 @@ func defaultDisplayNames() map[string]string {
 		"budgets":           "Budgets",
 		"cloudformation":    "CloudFormation",
 		"cloudfront":        "CloudFront",
+		"cloudhsmv2":        "CloudHSM",
 		"cloudtrail":        "CloudTrail",
 		"cloudwatch":        "CloudWatch",
 		"codebuild":         "CodeBuild",
 		"codepipeline":      "CodePipeline",
 		"cognito-idp":       "Cognito",
 		"configservice":     "Config",
+		"controltower":      "Control Tower",
 		"ce":                "Cost Explorer",
 		"datasync":          "DataSync",
 		"detective":         "Detective",
+		"dlm":               "Data Lifecycle Manager",
+		"dms":               "Database Migration Service",
+		"ds":                "Directory Service",
 		"directconnect":     "Direct Connect",
 		"dynamodb":          "DynamoDB",
 		"fms":               "Firewall Manager",
 		"gamelift":          "GameLift",
+		"globalaccelerator": "Global Accelerator",
 		"glue":              "Glue",
 		"guardduty":         "GuardDuty",
 		"health":            "Health",
 		"inspector2":        "Inspector",
+		"iot":               "IoT Core",
 		"ec2":               "EC2",
 		"ecr":               "ECR",
 		"elasticache":       "ElastiCache",
+		"efs":               "EFS",
+		"elasticbeanstalk":  "Elastic Beanstalk",
 		"ecs":               "ECS",
 		"eks":               "EKS",
 		"elbv2":             "Elastic Load Balancing",
 		"emr":               "EMR",
 		"events":            "EventBridge",
+		"firehose":          "Data Firehose",
+		"fsx":               "FSx",
 		"iam":               "IAM",
 		"kinesis":           "Kinesis",
 		"kms":               "KMS",
 		"lambda":            "Lambda",
 		"license-manager":   "License Manager",
+		"lightsail":         "Lightsail",
 		"macie2":            "Macie",
+		"mq":                "Amazon MQ",
+		"msk":               "MSK",
 		"network-firewall":  "Network Firewall",
 		"opensearch":        "OpenSearch",
 		"organizations":     "Organizations",
@@ -192,16 +238,20 @@ func defaultDisplayNames() map[string]string {
 		"redshift":          "Redshift",
 		"risp":              "RI/SP",
 		"route53":           "Route 53",
+		"rum":               "CloudWatch RUM",
 		"s3":                "S3",
 		"sagemaker":         "SageMaker",
 		"s3vectors":         "S3 Vectors",
 		"secretsmanager":    "Secrets Manager",
 		"securityhub":       "Security Hub",
 		"service-quotas":    "Service Quotas",
+		"ses":               "SES",
 		"stepfunctions":     "Step Functions",
+		"snowball":          "Snow Family",
 		"sns":               "SNS",
 		"sqs":               "SQS",
 		"ssm":               "Systems Manager",
+		"ssm-incidents":     "Incident Manager",
 		"transcribe":        "Transcribe",
 		"transfer":          "Transfer Family",
 		"vpc":               "VPC",
@@ -223,11 +273,11 @@ func defaultCategories() []ServiceCategory {
 	return []ServiceCategory{
 		{
 			Name:     "Compute",
-			Services: []string{"ec2", "lambda", "ecs", "eks", "autoscaling", "apprunner", "batch", "emr"},
+			Services: []string{"ec2", "lambda", "ecs", "eks", "autoscaling", "apprunner", "amplify", "elasticbeanstalk", "lightsail", "batch", "emr"},
 		},
 		{
 			Name:     "Storage & Database",
-			Services: []string{"s3", "s3vectors", "dynamodb", "rds", "redshift", "elasticache", "opensearch"},
+			Services: []string{"s3", "s3vectors", "efs", "fsx", "dynamodb", "rds", "redshift", "elasticache", "opensearch"},
 		},
 		{
 			Name:     "Containers & ML",
@@ -235,36 +285,40 @@ func defaultCategories() []ServiceCategory {
 		},
 		{
 			Name:     "Data & Analytics",
-			Services: []string{"glue", "athena"},
+			Services: []string{"glue", "athena", "dms"},
 		},
 		{
 			Name:     "Networking",
-			Services: []string{"vpc", "route53", "apigateway", "appsync", "elbv2", "cloudfront", "directconnect", "network-firewall"},
+			Services: []string{"vpc", "route53", "apigateway", "appsync", "elbv2", "cloudfront", "globalaccelerator", "directconnect", "network-firewall"},
 		},
 		{
 			Name:     "Security & Identity",
-			Services: []string{"iam", "kms", "acm", "secretsmanager", "ssm", "cognito-idp", "guardduty", "wafv2", "inspector2", "securityhub", "fms", "accessanalyzer", "detective", "macie2"},
+			Services: []string{"iam", "kms", "acm", "secretsmanager", "ssm", "cognito-idp", "guardduty", "wafv2", "inspector2", "securityhub", "fms", "accessanalyzer", "detective", "macie2", "cloudhsmv2", "ds"},
 		},
 		{
 			Name:     "Integration",
-			Services: []string{"sqs", "sns", "events", "stepfunctions", "kinesis", "transfer", "datasync"},
+			Services: []string{"sqs", "sns", "ses", "mq", "events", "stepfunctions", "kinesis", "firehose", "msk", "transfer", "datasync", "snowball"},
 		},
 		{
 			Name:     "DevOps",
-			Services: []string{"codebuild", "codepipeline", "cloudformation"},
+			Services: []string{"codebuild", "codepipeline", "cloudformation", "appconfig"},
 		},
 		{
 			Name:     "Monitoring",
-			Services: []string{"cloudwatch", "cloudtrail", "xray", "health"},
+			Services: []string{"cloudwatch", "cloudtrail", "xray", "rum", "health", "ssm-incidents"},
 		},
 		{
 			Name:     "Governance",
-			Services: []string{"configservice", "organizations", "service-quotas", "license-manager", "backup", "trustedadvisor", "compute-optimizer"},
+			Services: []string{"configservice", "organizations", "controltower", "service-quotas", "license-manager", "backup", "dlm", "trustedadvisor", "compute-optimizer"},
 		},
 		{
 			Name:     "Cost Management",
 			Services: []string{"risp", "ce", "budgets"},
 		},
+		{
+			Name:     "IoT",
+			Services: []string{"iot"},
+		},
 		{
 			Name:     "Game Development",
 			Services: []string{"gamelift"},
@@ -382,22 +436,36 @@ func (r *Registry) GetAliases() []string {
 // RegisterCustom registers a custom (hand-written) implementation
 // Custom implementations take priority over generated ones
 func (r *Registry) RegisterCustom(service, resource string, entry Entry) {
+	r.register(registration{sr: ServiceResource{Service: service, Resource: resource}, entry: entry, custom: true})
+}
+
+// RegisterGenerated registers a generated implementation
+func (r *Registry) RegisterGenerated(service, resource string, entry Entry) {
+	r.register(registration{sr: ServiceResource{Service: service, Resource: resource}, entry: entry})
+}
+
+func (r *Registry) register(reg registration) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	sr := ServiceResource{Service: service, Resource: resource}
-	r.custom[sr] = entry
-	r.addService(service, resource)
+	r.pending = append(r.pending, reg)
+	r.addService(reg.sr.Service, reg.sr.Resource)
 }
 
-// RegisterGenerated registers a generated implementation
-func (r *Registry) RegisterGenerated(service, resource string, entry Entry) {
+// index moves pending registrations into the entry maps. Later
+// registrations of the same service/resource replace earlier ones.
+func (r *Registry) index() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	sr := ServiceResource{Service: service, Resource: resource}
-	r.generated[sr] = entry
-	r.addService(service, resource)
+	for _, reg := range r.pending {
+		if reg.custom {
+			r.custom[reg.sr] = reg.entry
+		} else {
+			r.generated[reg.sr] = reg.entry
+		}
+	}
+	r.pending = nil
 }
 
 func (r *Registry) addService(service, resource string) {
@@ -408,9 +476,69 @@ func (r *Registry) addService(service, resource string) {
 	r.services[service] = append(resources, resource)
 }
 
+// Restrict drops every service not named in services, which may be aliases,
+// so only that subset is listed and navigable. Called at startup, before any
+// lookup, it drops the other services' registrations before they are
+// indexed, so their DAO factories, and the clients those create, are never
+// reachable. It returns the names that match no registered service.
+func (r *Registry) Restrict(services []string) (unknown []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keep := make(map[string]bool, len(services))
+	for _, name := range services {
+		svc := name
+		if target, ok := r.aliases[name]; ok {
+			svc, _, _ = strings.Cut(target, "/")
+		}
+		if _, ok := r.services[svc]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		keep[svc] = true
+	}
+	if len(unknown) > 0 {
+		return unknown
+	}
+
+	if r.dropped == nil {
+		r.dropped = make(map[string]bool)
+	}
+	for svc := range r.services {
+		if !keep[svc] {
+			r.dropped[svc] = true
+		}
+	}
+	maps.DeleteFunc(r.services, func(svc string, _ []string) bool { return !keep[svc] })
+	r.pending = slices.DeleteFunc(r.pending, func(reg registration) bool { return !keep[reg.sr.Service] })
+	maps.DeleteFunc(r.custom, func(sr ServiceResource, _ Entry) bool { return !keep[sr.Service] })
+	maps.DeleteFunc(r.generated, func(sr ServiceResource, _ Entry) bool { return !keep[sr.Service] })
+	maps.DeleteFunc(r.aliases, func(_, target string) bool {
+		svc, _, _ := strings.Cut(target, "/")
+		return !keep[svc]
+	})
+	return nil
+}
+
+// Dropped reports whether Restrict dropped service. Features that reach a
+// service without going through the registry, like dashboard widgets,
+// check it so no client is created for a dropped service.
+func (r *Registry) Dropped(service string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.dropped[service]
+}
+
 // Get retrieves the entry for a service/resource, respecting priority:
 // custom > generated
 func (r *Registry) Get(service, resource string) (Entry, bool) {
+	r.mu.RLock()
+	indexed := r.pending == nil
+	r.mu.RUnlock()
+	if !indexed {
+		r.index()
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -533,6 +661,8 @@ func (r *Registry) ListResources(service string) []string {
 // When a service is accessed without specifying a resource type (e.g., `:ec2`),
 // this resource is used instead of alphabetically first.
 var defaultResources = map[string]string{
+	"amplify":           "apps",
+	"appconfig":         "applications",
 	"apprunner":         "services",
 	"appsync":           "graphql-apis",
 	"athena":            "workgroups",
@@ -543,26 +673,39 @@ var defaultResources = map[string]string{
 	"bedrock-agentcore": "runtimes",
 	"ce":                "costs",
 	"cloudformation":    "stacks",
+	"cloudhsmv2":        "clusters",
 	"cloudtrail":        "trails",
 	"cloudwatch":        "alarms",
 	"codebuild":         "projects",
 	"codepipeline":      "pipelines",
 	"cognito-idp":       "user-pools",
+	"controltower":      "landing-zones",
 	"datasync":          "tasks",
 	"directconnect":     "connections",
+	"dms":               "replication-tasks",
+	"ds":                "directories",
 	"ec2":               "instances",
 	"ecr":               "repositories",
 	"ecs":               "clusters",
+	"firehose":          "delivery-streams",
+	"fsx":               "file-systems",
 	"gamelift":          "fleets",
 	"eks":               "clusters",
+	"efs":               "file-systems",
+	"elasticbeanstalk":  "environments",
 	"elbv2":             "load-balancers",
 	"emr":               "clusters",
 	"events":            "rules",
+	"globalaccelerator": "accelerators",
 	"glue":              "jobs",
 	"guardduty":         "detectors",
 	"iam":               "roles",
+	"iot":               "things",
 	"license-manager":   "licenses",
+	"lightsail":         "instances",
 	"macie2":            "findings",
+	"mq":                "brokers",
+	"msk":               "clusters",
 	"network-firewall":  "firewalls",
 	"organizations":     "accounts",
 	"rds":               "instances",
@@ -571,6 +714,7 @@ var defaultResources = map[string]string{
 	"route53":           "hosted-zones",
 	"sagemaker":         "endpoints",
 	"service-quotas":    "services",
+	"ses":               "identities",
 	"sns":               "topics",
 	"stepfunctions":     "state-machines",
 	"transfer":          "servers",
@@ -617,50 +761,85 @@ func (r *Registry) SetDefaultResource(service, resource string) {
 // and should only be accessed via navigation from their parent resource.
 // Format: "service/resource"
 var subResourceSet = map[string]struct{}{
-	"cloudformation/events":            {},
-	"cloudformation/outputs":           {},
-	"cloudformation/resources":         {},
-	"cloudwatch/log-streams":           {},
-	"service-quotas/quotas":            {},
-	"route53/record-sets":              {},
-	"apigateway/stages":                {},
-	"apigateway/stages-v2":             {},
-	"elbv2/targets":                    {},
-	"s3vectors/indexes":                {},
-	"guardduty/findings":               {},
-	"cognito-idp/users":                {},
-	"codepipeline/executions":          {},
-	"stepfunctions/executions":         {},
-	"codebuild/builds":                 {},
-	"backup/recovery-points":           {},
-	"backup/selections":                {},
-	"ecr/images":                       {},
-	"autoscaling/activities":           {},
-	"bedrock-agent/data-sources":       {},
-	"bedrock-agentcore/endpoints":      {},
-	"bedrock-agentcore/versions":       {},
-	"glue/tables":                      {},
-	"glue/job-runs":                    {},
-	"athena/query-executions":          {},
-	"apprunner/operations":             {},
-	"budgets/notifications":            {},
-	"vpc/tgw-attachments":              {},
-	"directconnect/virtual-interfaces": {},
-	"transfer/users":                   {},
-	"accessanalyzer/findings":          {},
-	"detective/investigations":         {},
-	"datasync/task-executions":         {},
-	"batch/jobs":                       {},
-	"emr/steps":                        {},
-	"gamelift/game-sessions":           {},
-	"organizations/ous":                {},
-	"license-manager/grants":           {},
-	"appsync/data-sources":             {},
-	"eks/node-groups":                  {},
-	"eks/fargate-profiles":             {},
-	"eks/addons":                       {},
-	"eks/access-entries":               {},
-	"redshift/snapshots":               {},
+	"cloudformation/events":             {},
+	"cloudformation/outputs":            {},
+	"cloudformation/resources":          {},
+	"cloudwatch/canary-runs":            {},
+	"cloudwatch/log-streams":            {},
+	"cloudwatch/insights-results":       {},
+	"ec2/launch-template-versions":      {},
+	"service-quotas/quotas":             {},
+	"route53/record-sets":               {},
+	"apigateway/stages":                 {},
+	"apigateway/stages-v2":              {},
+	"elbv2/targets":                     {},
+	"elbv2/listeners":                   {},
+	"elbv2/rules":                       {},
+	"s3vectors/indexes":                 {},
+	"guardduty/findings":                {},
+	"cognito-idp/groups":                {},
+	"cognito-idp/users":                 {},
+	"codepipeline/executions":           {},
+	"stepfunctions/executions":          {},
+	"stepfunctions/express-executions":  {},
+	"codebuild/builds":                  {},
+	"backup/recovery-points":            {},
+	"backup/selections":                 {},
+	"ecr/images":                        {},
+	"autoscaling/activities":            {},
+	"bedrock-agent/data-sources":        {},
+	"bedrock-agent/ingestion-jobs":      {},
+	"bedrock-agentcore/endpoints":       {},
+	"bedrock-agentcore/versions":        {},
+	"glue/tables":                       {},
+	"glue/job-runs":                     {},
+	"athena/query-executions":           {},
+	"apprunner/operations":              {},
+	"budgets/notifications":             {},
+	"vpc/tgw-attachments":               {},
+	"directconnect/virtual-interfaces":  {},
+	"transfer/users":                    {},
+	"mq/users":                          {},
+	"msk/topics":                        {},
+	"efs/mount-targets":                 {},
+	"globalaccelerator/listeners":       {},
+	"globalaccelerator/endpoint-groups": {},
+	"appconfig/environments":            {},
+	"appconfig/configuration-profiles":  {},
+	"appconfig/deployments":             {},
+	"appconfig/hosted-versions":         {},
+	"cloudhsmv2/hsms":                   {},
+	"ds/trusts":                         {},
+	"ds/domain-controllers":             {},
+	"amplify/branches":                  {},
+	"accessanalyzer/findings":           {},
+	"detective/investigations":          {},
+	"datasync/task-executions":          {},
+	"batch/jobs":                        {},
+	"emr/steps":                         {},
+	"gamelift/game-sessions":            {},
+	"organizations/ous":                 {},
+	"organizations/scp-denies":          {},
+	"license-manager/grants":            {},
+	"appsync/data-sources":              {},
+	"eks/node-groups":                   {},
+	"eks/fargate-profiles":              {},
+	"eks/addons":                        {},
+	"eks/access-entries":                {},
+	"redshift/snapshots":                {},
+	"redshift/queries":                  {},
+	"elasticache/nodes":                 {},
+	"elasticache/shards":                {},
+	"elasticache/parameters":            {},
+	"dynamodb/backups":                  {},
+	"dynamodb/exports":                  {},
+	"s3/archived-objects":               {},
+	"macie2/finding-samples":            {},
+	"fms/policy-compliance":             {},
+	"glue/table-preview":                {},
+	"dms/table-statistics":              {},
+	"dms/connections":                   {},
+	"ssm/command-invocations":           {},
 }
 
 // isSubResource returns true if the resource is only accessible via navigation
@@ -2,6 +2,8 @@ package registry
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"sync"
 	"testing"
 
@@ -635,3 +637,83 @@ func TestRegistry_ParseServiceResource(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistry_Restrict(t *testing.T) {
+	reg := New()
+	reg.RegisterCustom("ec2", "instances", Entry{})
+	reg.RegisterCustom("ec2", "security-groups", Entry{})
+	reg.RegisterCustom("cloudformation", "stacks", Entry{})
+	reg.RegisterGenerated("iam", "roles", Entry{})
+
+	if unknown := reg.Restrict([]string{"ec2", "nope"}); len(unknown) != 1 || unknown[0] != "nope" {
+		t.Fatalf("Restrict() unknown = %v, want [nope]", unknown)
+	}
+	if len(reg.ListServices()) != 3 {
+		t.Fatal("a failed Restrict() should not drop services")
+	}
+
+	if unknown := reg.Restrict([]string{"ec2", "cfn"}); unknown != nil {
+		t.Fatalf("Restrict() unknown = %v", unknown)
+	}
+	if got := reg.ListServices(); len(got) != 2 || got[0] != "cloudformation" || got[1] != "ec2" {
+		t.Errorf("ListServices() = %v, want [cloudformation ec2]", got)
+	}
+	if !reg.HasResource("ec2", "security-groups") || reg.HasResource("iam", "roles") {
+		t.Error("Restrict() should keep every resource of kept services only")
+	}
+	if _, _, err := reg.ParseServiceResource("sg"); err != nil {
+		t.Errorf("alias of a kept service should resolve: %v", err)
+	}
+	for _, alias := range reg.GetAliases() {
+		if svc, _, _ := reg.ResolveAlias(alias); svc != "ec2" && svc != "cloudformation" {
+			t.Errorf("alias %q for dropped service %q is still listed", alias, svc)
+		}
+	}
+}
+
+func TestRegistry_RestrictBeforeLookup(t *testing.T) {
+	reg := New()
+	var built []string
+	entry := func(service string) Entry {
+		return Entry{DAOFactory: func(context.Context) (dao.DAO, error) {
+			built = append(built, service)
+			return nil, errors.New("no client in tests")
+		}}
+	}
+	reg.RegisterCustom("ec2", "instances", entry("ec2"))
+	reg.RegisterCustom("s3", "buckets", entry("s3"))
+	reg.RegisterGenerated("iam", "roles", entry("iam"))
+
+	if unknown := reg.Restrict([]string{"ec2"}); unknown != nil {
+		t.Fatalf("Restrict() unknown = %v", unknown)
+	}
+	if len(reg.custom) != 0 || len(reg.generated) != 0 {
+		t.Error("registrations should not be indexed before the first lookup")
+	}
+	if len(reg.pending) != 1 || reg.pending[0].sr.Service != "ec2" {
+		t.Errorf("pending = %v, want only ec2", reg.pending)
+	}
+
+	for _, svc := range []string{"s3", "iam"} {
+		if !reg.Dropped(svc) {
+			t.Errorf("Dropped(%q) = false, want true", svc)
+		}
+	}
+	if reg.Dropped("ec2") {
+		t.Error("Dropped(ec2) = true for a kept service")
+	}
+
+	ctx := context.Background()
+	if _, err := reg.GetDAO(ctx, "s3", "buckets"); err == nil {
+		t.Error("GetDAO() should fail for a dropped service")
+	}
+	if _, err := reg.GetDAO(ctx, "ec2", "instances"); err == nil {
+		t.Error("GetDAO() should return the factory's error")
+	}
+	if !slices.Equal(built, []string{"ec2"}) {
+		t.Errorf("factories called for %v, want only [ec2]", built)
+	}
+	if _, ok := reg.custom[ServiceResource{Service: "ec2", Resource: "instances"}]; !ok || reg.pending != nil {
+		t.Error("the first lookup should index pending registrations")
+	}
+}
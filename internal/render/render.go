@@ -29,6 +29,20 @@ type SummaryField struct {
 // ViewTypeLogView indicates navigation should open a LogView instead of ResourceBrowser
 const ViewTypeLogView = "log-view"
 
+// ViewTypeFlowLogView opens a LogView that parses VPC flow log records
+const ViewTypeFlowLogView = "flow-log-view"
+
+// ViewTypeContainerLogView opens a LogView that tails several containers'
+// log streams at once
+const ViewTypeContainerLogView = "container-log-view"
+
+// ContainerLog identifies the CloudWatch log stream of one container
+type ContainerLog struct {
+	Container string
+	LogGroup  string
+	LogStream string
+}
+
 // Navigation defines a navigation shortcut to related resources or custom views
 type Navigation struct {
 	Key            string
@@ -89,6 +103,12 @@ type MetricSpecProvider interface {
 	MetricSpec() *MetricSpec
 }
 
+// AutoReloader is an optional interface for renderers whose lists should
+// refresh on their own while any resource is in a transitional state.
+type AutoReloader interface {
+	NeedsAutoReload(resources []dao.Resource) bool
+}
+
 // MetricSpec defines which CloudWatch metric to fetch for inline display.
 type MetricSpec struct {
 	Namespace     string
@@ -97,6 +117,18 @@ type MetricSpec struct {
 	Stat          string
 	ColumnHeader  string
 	Unit          string // Display unit (e.g., "%", "", "ms"). Empty for count-based metrics.
+
+	// Expression, when set, is a metric math expression over Inputs whose
+	// result is shown instead of MetricName.
+	Expression string
+	Inputs     []MetricInput
+}
+
+// MetricInput is a metric referenced by ID from a MetricSpec expression.
+type MetricInput struct {
+	ID         string
+	MetricName string
+	Stat       string
 }
 
 // BaseRenderer provides a default implementation
@@ -0,0 +1,363 @@
+// Package trustmap scans IAM role trust policies across profiles and maps
+// which accounts can assume which roles, flagging roles trusted by accounts
+// that are neither scanned nor allowlisted.
+package trustmap
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+)
+
+// Trust classifies who a cross-account trust is granted to.
+type Trust string
+
+const (
+	// TrustKnown is an account that was scanned, i.e. one of your own.
+	TrustKnown Trust = "known"
+	// TrustAllowed is an account on trust_map.allowed_accounts.
+	TrustAllowed Trust = "allowed"
+	// TrustExternal is any other account; these are flagged.
+	TrustExternal Trust = "external"
+	// TrustAnyone is a "*" principal; flagged regardless of conditions.
+	TrustAnyone Trust = "anyone"
+	// TrustUnknown is a principal whose account cannot be determined, such as
+	// the unique ID left behind when a trusted role is deleted.
+	TrustUnknown Trust = "unknown"
+)
+
+// Edge is one principal allowed to assume one role in another account.
+type Edge struct {
+	Principal        string // as written in the trust policy
+	PrincipalAccount string // empty for "*" and unresolvable principals
+
+	Profile     string // display name of the profile the role was found in
+	RoleAccount string
+	RoleName    string
+	RoleARN     string
+
+	Actions    []string // e.g. sts:AssumeRole
+	Conditions []string // condition keys guarding the trust, e.g. sts:ExternalId
+	Trust      Trust
+}
+
+// Flagged reports whether the trust should be reviewed.
+func (e Edge) Flagged() bool {
+	return e.Trust == TrustExternal || e.Trust == TrustAnyone || e.Trust == TrustUnknown
+}
+
+// Report is the trust map of every scanned profile.
+type Report struct {
+	// Accounts maps each scanned account ID to the profiles it was scanned as.
+	Accounts map[string][]string
+	Roles    int
+	// SameAccount counts trusts of principals in the role's own account,
+	// which are not part of the map.
+	SameAccount int
+	Edges       []Edge
+	Errors      []string
+}
+
+// Flagged returns the number of flagged edges.
+func (r *Report) Flagged() int {
+	n := 0
+	for _, e := range r.Edges {
+		if e.Flagged() {
+			n++
+		}
+	}
+	return n
+}
+
+// ByPrincipalAccount groups edges by the account they trust, flagged groups
+// first. Edges with no account ("*" or unresolvable) are keyed by principal.
+func (r *Report) ByPrincipalAccount() []Group {
+	index := make(map[string]int)
+	var groups []Group
+	for _, e := range r.Edges {
+		key := e.PrincipalAccount
+		if key == "" {
+			key = e.Principal
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, Group{Account: key, Trust: e.Trust})
+		}
+		groups[i].Edges = append(groups[i].Edges, e)
+	}
+	slices.SortFunc(groups, func(a, b Group) int {
+		if a.Flagged() != b.Flagged() {
+			if a.Flagged() {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.Account, b.Account)
+	})
+	return groups
+}
+
+// Group is every role one account can assume.
+type Group struct {
+	Account string
+	Trust   Trust
+	Edges   []Edge
+}
+
+// Flagged reports whether the group's account is flagged.
+func (g Group) Flagged() bool {
+	return len(g.Edges) > 0 && g.Edges[0].Flagged()
+}
+
+// CurrentProfiles returns the selected profiles, or the SDK default.
+func CurrentProfiles() []config.ProfileSelection {
+	profiles := config.Global().Selections()
+	if len(profiles) == 0 {
+		profiles = []config.ProfileSelection{config.SDKDefault()}
+	}
+	return profiles
+}
+
+type profileScan struct {
+	profile string
+	account string
+	roles   []types.Role
+	err     error
+}
+
+// Scan lists the roles of every profile and builds the trust map. Principals
+// are classified against the scanned accounts and the allowed accounts.
+// Profiles that fail are recorded in the report's Errors.
+func Scan(ctx context.Context, profiles []config.ProfileSelection, allowed []string) *Report {
+	scans := make([]profileScan, len(profiles))
+	sem := make(chan struct{}, config.File().MaxConcurrentFetches())
+	var wg sync.WaitGroup
+	for i, sel := range profiles {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			scans[i] = scanProfile(ctx, sel)
+		}()
+	}
+	wg.Wait()
+
+	report := &Report{Accounts: make(map[string][]string)}
+	for _, s := range scans {
+		if s.err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: %v", s.profile, s.err))
+			continue
+		}
+		if s.account != "" && !slices.Contains(report.Accounts[s.account], s.profile) {
+			report.Accounts[s.account] = append(report.Accounts[s.account], s.profile)
+		}
+	}
+
+	// The same account may be scanned through several profiles
+	seen := make(map[string]bool)
+	for _, s := range scans {
+		for _, role := range s.roles {
+			arn := aws.Str(role.Arn)
+			if seen[arn] {
+				continue
+			}
+			seen[arn] = true
+			report.Roles++
+			for _, e := range roleEdges(role, s.profile) {
+				if e.PrincipalAccount != "" && e.PrincipalAccount == e.RoleAccount {
+					report.SameAccount++
+					continue
+				}
+				e.Trust = classify(e, report.Accounts, allowed)
+				report.Edges = append(report.Edges, e)
+			}
+		}
+	}
+	return report
+}
+
+func scanProfile(ctx context.Context, sel config.ProfileSelection) profileScan {
+	scan := profileScan{profile: sel.DisplayName()}
+	client, err := aws.Client(aws.WithSelectionOverride(ctx, sel), iam.NewFromConfig)
+	if err != nil {
+		scan.err = err
+		return scan
+	}
+	scan.roles, scan.err = aws.PaginateMarker(ctx, func(marker *string) ([]types.Role, *string, error) {
+		output, err := client.ListRoles(ctx, &iam.ListRolesInput{Marker: marker})
+		if err != nil {
+			return nil, nil, fmt.Errorf("list roles: %w", err)
+		}
+		return output.Roles, output.Marker, nil
+	})
+	// Roles carry their account in the ARN, which saves a GetCallerIdentity
+	if len(scan.roles) > 0 {
+		if parsed := aws.ParseARN(aws.Str(scan.roles[0].Arn)); parsed != nil {
+			scan.account = parsed.AccountID
+		}
+	}
+	return scan
+}
+
+func classify(e Edge, scanned map[string][]string, allowed []string) Trust {
+	switch {
+	case e.Principal == "*":
+		return TrustAnyone
+	case e.PrincipalAccount == "":
+		return TrustUnknown
+	case len(scanned[e.PrincipalAccount]) > 0:
+		return TrustKnown
+	case slices.Contains(allowed, e.PrincipalAccount):
+		return TrustAllowed
+	default:
+		return TrustExternal
+	}
+}
+
+// roleEdges returns an edge for every AWS principal the role's trust policy
+// allows. Service and federated principals are not accounts and are skipped.
+func roleEdges(role types.Role, profile string) []Edge {
+	doc := aws.Str(role.AssumeRolePolicyDocument)
+	if decoded, err := url.QueryUnescape(doc); err == nil {
+		doc = decoded
+	}
+	statements, err := parseTrustPolicy(doc)
+	if err != nil {
+		return nil
+	}
+
+	roleARN := aws.Str(role.Arn)
+	roleAccount := ""
+	if parsed := aws.ParseARN(roleARN); parsed != nil {
+		roleAccount = parsed.AccountID
+	}
+
+	var edges []Edge
+	for _, st := range statements {
+		if st.Effect != "Allow" {
+			continue
+		}
+		for _, principal := range st.awsPrincipals() {
+			edges = append(edges, Edge{
+				Principal:        principal,
+				PrincipalAccount: PrincipalAccount(principal),
+				Profile:          profile,
+				RoleAccount:      roleAccount,
+				RoleName:         aws.Str(role.RoleName),
+				RoleARN:          roleARN,
+				Actions:          st.Action,
+				Conditions:       st.conditionKeys(),
+			})
+		}
+	}
+	return edges
+}
+
+var accountIDPattern = regexp.MustCompile(`^\d{12}$`)
+
+// PrincipalAccount returns the account of an AWS principal given as an
+// account ID or an IAM ARN, or "" for "*" and unique IDs.
+func PrincipalAccount(principal string) string {
+	if accountIDPattern.MatchString(principal) {
+		return principal
+	}
+	if parsed := aws.ParseARN(principal); parsed != nil && accountIDPattern.MatchString(parsed.AccountID) {
+		return parsed.AccountID
+	}
+	return ""
+}
+
+type trustStatement struct {
+	Effect    string                     `json:"Effect"`
+	Principal json.RawMessage            `json:"Principal"`
+	Action    stringList                 `json:"Action"`
+	Condition map[string]json.RawMessage `json:"Condition"`
+}
+
+// awsPrincipals returns the AWS principals of the statement; a bare "*"
+// principal means any AWS account.
+func (s trustStatement) awsPrincipals() []string {
+	var wildcard string
+	if err := json.Unmarshal(s.Principal, &wildcard); err == nil {
+		if wildcard == "*" {
+			return []string{"*"}
+		}
+		return nil
+	}
+	var principal struct {
+		AWS stringList `json:"AWS"`
+	}
+	if err := json.Unmarshal(s.Principal, &principal); err != nil {
+		return nil
+	}
+	return principal.AWS
+}
+
+// conditionKeys returns the sorted keys checked by the statement's
+// conditions, e.g. sts:ExternalId, without their operators and values.
+func (s trustStatement) conditionKeys() []string {
+	var keys []string
+	for _, raw := range s.Condition {
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &values); err != nil {
+			continue
+		}
+		for key := range values {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	return keys
+}
+
+func parseTrustPolicy(doc string) ([]trustStatement, error) {
+	var policy struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(doc), &policy); err != nil {
+		return nil, err
+	}
+	var statements []trustStatement
+	if err := json.Unmarshal(policy.Statement, &statements); err == nil {
+		return statements, nil
+	}
+	var single trustStatement
+	if err := json.Unmarshal(policy.Statement, &single); err != nil {
+		return nil, err
+	}
+	return []trustStatement{single}, nil
+}
+
+// stringList accepts a single string or an array of strings
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*l = stringList{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(l))
+}
@@ -0,0 +1,130 @@
+package trustmap
+
+import (
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+func role(name, account, trust string) types.Role {
+	return types.Role{
+		RoleName:                 aws.String(name),
+		Arn:                      aws.String("arn:aws:iam::" + account + ":role/" + name),
+		AssumeRolePolicyDocument: aws.String(url.QueryEscape(trust)),
+	}
+}
+
+func TestRoleEdges(t *testing.T) {
+	r := role("deploy", "111111111111", `{
+	  "Version": "2012-10-17",
+	  "Statement": [
+	    {
+	      "Effect": "Allow",
+	      "Principal": {"AWS": ["arn:aws:iam::222222222222:root", "333333333333", "AROAEXAMPLEDELETED"]},
+	      "Action": "sts:AssumeRole",
+	      "Condition": {"StringEquals": {"sts:ExternalId": "x"}, "Bool": {"aws:MultiFactorAuthPresent": "true"}}
+	    },
+	    {"Effect": "Allow", "Principal": {"Service": "ec2.amazonaws.com"}, "Action": "sts:AssumeRole"},
+	    {"Effect": "Deny", "Principal": {"AWS": "444444444444"}, "Action": "sts:AssumeRole"}
+	  ]
+	}`)
+
+	edges := roleEdges(r, "prod")
+	if len(edges) != 3 {
+		t.Fatalf("got %d edges, want 3 (service and deny statements skipped): %+v", len(edges), edges)
+	}
+	var accounts []string
+	for _, e := range edges {
+		accounts = append(accounts, e.PrincipalAccount)
+	}
+	if !slices.Equal(accounts, []string{"222222222222", "333333333333", ""}) {
+		t.Errorf("principal accounts = %v", accounts)
+	}
+	e := edges[0]
+	if e.RoleAccount != "111111111111" || e.RoleName != "deploy" || e.Profile != "prod" {
+		t.Errorf("edge = %+v", e)
+	}
+	if !slices.Equal(e.Conditions, []string{"aws:MultiFactorAuthPresent", "sts:ExternalId"}) {
+		t.Errorf("conditions = %v", e.Conditions)
+	}
+	if !slices.Equal(e.Actions, []string{"sts:AssumeRole"}) {
+		t.Errorf("actions = %v", e.Actions)
+	}
+
+	// A single statement object and a bare "*" principal
+	anyone := roleEdges(role("open", "111111111111",
+		`{"Statement": {"Effect": "Allow", "Principal": "*", "Action": "sts:AssumeRole"}}`), "prod")
+	if len(anyone) != 1 || anyone[0].Principal != "*" {
+		t.Errorf("wildcard edges = %+v", anyone)
+	}
+
+	if edges := roleEdges(role("bad", "111111111111", "not json"), "prod"); edges != nil {
+		t.Errorf("invalid trust policy should yield no edges, got %+v", edges)
+	}
+}
+
+func TestPrincipalAccount(t *testing.T) {
+	tests := map[string]string{
+		"123456789012":                               "123456789012",
+		"arn:aws:iam::123456789012:root":             "123456789012",
+		"arn:aws:sts::123456789012:assumed-role/a/b": "123456789012",
+		"*":                  "",
+		"AROAEXAMPLEDELETED": "",
+	}
+	for principal, want := range tests {
+		if got := PrincipalAccount(principal); got != want {
+			t.Errorf("PrincipalAccount(%q) = %q, want %q", principal, got, want)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	scanned := map[string][]string{"222222222222": {"dev"}}
+	allowed := []string{"333333333333"}
+	tests := []struct {
+		edge Edge
+		want Trust
+	}{
+		{Edge{Principal: "*"}, TrustAnyone},
+		{Edge{Principal: "AROAEXAMPLEDELETED"}, TrustUnknown},
+		{Edge{Principal: "222222222222", PrincipalAccount: "222222222222"}, TrustKnown},
+		{Edge{Principal: "333333333333", PrincipalAccount: "333333333333"}, TrustAllowed},
+		{Edge{Principal: "999999999999", PrincipalAccount: "999999999999"}, TrustExternal},
+	}
+	for _, tt := range tests {
+		got := classify(tt.edge, scanned, allowed)
+		if got != tt.want {
+			t.Errorf("classify(%q) = %q, want %q", tt.edge.Principal, got, tt.want)
+		}
+		if flagged := (Edge{Trust: got}).Flagged(); flagged != (got != TrustKnown && got != TrustAllowed) {
+			t.Errorf("Flagged() for %q = %v", got, flagged)
+		}
+	}
+}
+
+func TestReport_ByPrincipalAccount(t *testing.T) {
+	r := &Report{Edges: []Edge{
+		{Principal: "222222222222", PrincipalAccount: "222222222222", RoleName: "a", Trust: TrustKnown},
+		{Principal: "arn:aws:iam::999999999999:root", PrincipalAccount: "999999999999", RoleName: "b", Trust: TrustExternal},
+		{Principal: "222222222222", PrincipalAccount: "222222222222", RoleName: "c", Trust: TrustKnown},
+		{Principal: "*", RoleName: "d", Trust: TrustAnyone},
+	}}
+
+	groups := r.ByPrincipalAccount()
+	var keys []string
+	for _, g := range groups {
+		keys = append(keys, g.Account)
+	}
+	if !slices.Equal(keys, []string{"*", "999999999999", "222222222222"}) {
+		t.Errorf("groups = %v, want flagged first", keys)
+	}
+	if len(groups[2].Edges) != 2 || groups[2].Flagged() {
+		t.Errorf("known group = %+v", groups[2])
+	}
+	if r.Flagged() != 2 {
+		t.Errorf("Flagged() = %d, want 2", r.Flagged())
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"charm.land/bubbles/v2/spinner"
 	"charm.land/bubbles/v2/textinput"
@@ -459,6 +460,50 @@ func ReadOnlyBadgeStyle() lipgloss.Style {
 		Padding(0, 1)
 }
 
+// ProfileColor returns the color tag configured for a profile, or nil.
+// Named colors follow the theme: red, yellow, green and blue.
+func ProfileColor(profileID string) color.Color {
+	value := config.File().ProfileSettings(profileID).Color
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		return nil
+	case "red":
+		return Current().Danger
+	case "yellow", "orange":
+		return Current().Warning
+	case "green":
+		return Current().Success
+	case "blue":
+		return Current().Info
+	}
+	c, err := ParseColor(value)
+	if err != nil {
+		slog.Warn("invalid profile color, ignoring", "profile", profileID, "value", value, "error", err)
+		return nil
+	}
+	return c
+}
+
+// SelectionColor returns the color tag of the first selected profile that
+// has one, or nil
+func SelectionColor() color.Color {
+	for _, sel := range config.Global().Selections() {
+		if c := ProfileColor(sel.ID()); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+// ProfileBadgeStyle returns a style for a profile's color tag in the status bar
+func ProfileBadgeStyle(c color.Color) lipgloss.Style {
+	return lipgloss.NewStyle().
+		Background(c).
+		Foreground(Current().BadgeForeground).
+		Bold(true).
+		Padding(0, 1)
+}
+
 func CellStyle(width, height int) lipgloss.Style {
 	return lipgloss.NewStyle().
 		Foreground(Current().Text).
@@ -470,6 +515,10 @@ func CellStyle(width, height int) lipgloss.Style {
 func NewSpinner() spinner.Model {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
+	if config.Global().Accessible() {
+		// A single static frame: screen readers re-announce every redraw
+		s.Spinner = spinner.Spinner{Frames: []string{"…"}, FPS: time.Hour}
+	}
 	s.Style = lipgloss.NewStyle().Foreground(Current().Accent)
 	return s
 }
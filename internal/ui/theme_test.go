@@ -665,3 +665,13 @@ func TestThemeConcurrentAccess(t *testing.T) {
 		t.Error("Current() should not return nil")
 	}
 }
+
+func TestNewSpinner_Accessible(t *testing.T) {
+	config.Global().SetAccessible(true)
+	t.Cleanup(func() { config.Global().SetAccessible(false) })
+
+	s := NewSpinner()
+	if len(s.Spinner.Frames) != 1 {
+		t.Errorf("accessible spinner has %d frames, want a single static frame", len(s.Spinner.Frames))
+	}
+}
@@ -0,0 +1,322 @@
+// Package usedby finds the resources that reference a resource, such as the
+// instances, Lambda functions and ECS services using a security group. It
+// lists the resource types known to hold such references and searches their
+// API data for the target's ID or ARN.
+package usedby
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// Relation is a resource type that can reference a target.
+type Relation struct {
+	Service      string
+	ResourceType string
+	// Through matches resources referencing the hits of another relation
+	// (service/type) rather than the target itself, e.g. auto scaling groups
+	// whose instances use a security group.
+	Through string
+	// ByName also matches the target's name, for references such as alarm
+	// dimensions (TopicName, QueueName) that name rather than identify it.
+	ByName bool
+}
+
+// Type returns the relation's service/type.
+func (r Relation) Type() string { return r.Service + "/" + r.ResourceType }
+
+// relations maps a target service/type to the types that reference it.
+// Through relations must follow the relation they go through.
+var relations = map[string][]Relation{
+	"ec2/security-groups": {
+		{Service: "ec2", ResourceType: "instances"},
+		{Service: "ecs", ResourceType: "services"},
+		{Service: "lambda", ResourceType: "functions"},
+		{Service: "rds", ResourceType: "instances"},
+		{Service: "elbv2", ResourceType: "load-balancers"},
+		{Service: "elasticache", ResourceType: "clusters"},
+		{Service: "autoscaling", ResourceType: "groups", Through: "ec2/instances"},
+	},
+	"vpc/vpcs": {
+		{Service: "vpc", ResourceType: "subnets"},
+		{Service: "ec2", ResourceType: "security-groups"},
+		{Service: "ec2", ResourceType: "instances"},
+		{Service: "lambda", ResourceType: "functions"},
+		{Service: "rds", ResourceType: "instances"},
+		{Service: "elbv2", ResourceType: "load-balancers"},
+	},
+	"vpc/subnets": {
+		{Service: "ec2", ResourceType: "instances"},
+		{Service: "ecs", ResourceType: "services"},
+		{Service: "lambda", ResourceType: "functions"},
+		{Service: "elbv2", ResourceType: "load-balancers"},
+		{Service: "autoscaling", ResourceType: "groups"},
+	},
+	"iam/roles": {
+		{Service: "lambda", ResourceType: "functions"},
+		{Service: "ecs", ResourceType: "task-definitions"},
+		{Service: "iam", ResourceType: "instance-profiles"},
+		{Service: "ec2", ResourceType: "instances", Through: "iam/instance-profiles"},
+	},
+	"iam/instance-profiles": {
+		{Service: "ec2", ResourceType: "instances"},
+	},
+	"lambda/layers": {
+		{Service: "lambda", ResourceType: "functions"},
+	},
+	"acm/certificates": {
+		{Service: "cloudfront", ResourceType: "distributions"},
+		{Service: "apigateway", ResourceType: "domain-names"},
+	},
+	"kms/keys": {
+		{Service: "lambda", ResourceType: "functions"},
+		{Service: "rds", ResourceType: "instances"},
+		{Service: "ec2", ResourceType: "volumes"},
+	},
+	"ecs/task-definitions": {
+		{Service: "ecs", ResourceType: "services"},
+	},
+	"elbv2/target-groups": {
+		{Service: "ecs", ResourceType: "services"},
+		{Service: "autoscaling", ResourceType: "groups"},
+	},
+	// Alarms reference a resource through their metric dimensions
+	"ec2/instances": {
+		{Service: "autoscaling", ResourceType: "groups"},
+		alarms,
+	},
+	"lambda/functions": {alarms},
+	"rds/instances":    {alarms},
+	"sqs/queues":       {alarms},
+	"dynamodb/tables":  {alarms},
+	"sns/topics":       {alarms},
+}
+
+var alarms = Relation{Service: "cloudwatch", ResourceType: "alarms", ByName: true}
+
+// Relations returns the types that can reference service/resourceType.
+func Relations(service, resourceType string) []Relation {
+	return slices.Clone(relations[service+"/"+resourceType])
+}
+
+// Target is the resource to find references to.
+type Target struct {
+	Service      string
+	ResourceType string
+	ID           string
+	Name         string
+	ARN          string
+}
+
+// Hit is a resource referencing the target.
+type Hit struct {
+	Service      string
+	ResourceType string
+	Resource     dao.Resource
+	Path         string // where the reference was found, e.g. VpcConfig.SecurityGroupIds[0]
+	Via          string // for Through relations, the resource it goes through
+}
+
+// Type returns the hit's service/type.
+func (h Hit) Type() string { return h.Service + "/" + h.ResourceType }
+
+// Result holds the hits in relation order, and the types that failed to list.
+type Result struct {
+	Hits   []Hit
+	Errors []string
+}
+
+// Find lists every type that can reference t and returns the resources
+// that do. Types of services dropped by --services are not scanned. ctx
+// should carry the target's profile and region.
+func Find(ctx context.Context, reg *registry.Registry, t Target) (*Result, error) {
+	rels := slices.DeleteFunc(Relations(t.Service, t.ResourceType), func(rel Relation) bool {
+		return reg.Dropped(rel.Service)
+	})
+	if len(rels) == 0 {
+		return nil, fmt.Errorf("no known references to %s/%s", t.Service, t.ResourceType)
+	}
+
+	hits := make([][]Hit, len(rels))
+	errs := make([]error, len(rels))
+	scan := func(i int, ids []string, via func(value string) (string, string)) {
+		rel := rels[i]
+		hits[i], errs[i] = scanType(ctx, reg, rel, ids, via)
+	}
+
+	// Direct relations first, then those going through their hits
+	var wg sync.WaitGroup
+	for i, rel := range rels {
+		if rel.Through == "" {
+			refs := ids(t.ID, t.ARN)
+			if rel.ByName {
+				refs = ids(t.ID, t.ARN, t.Name)
+			}
+			wg.Go(func() { scan(i, refs, nil) })
+		}
+	}
+	wg.Wait()
+	for i, rel := range rels {
+		if rel.Through == "" {
+			continue
+		}
+		src := slices.IndexFunc(rels, func(r Relation) bool { return r.Through == "" && r.Type() == rel.Through })
+		if src < 0 || len(hits[src]) == 0 {
+			continue
+		}
+		through := hits[src]
+		var refs []string
+		for _, h := range through {
+			inner := dao.UnwrapResource(h.Resource)
+			refs = append(refs, ids(inner.GetID(), inner.GetARN())...)
+		}
+		wg.Go(func() {
+			scan(i, refs, func(value string) (string, string) {
+				for _, h := range through {
+					inner := dao.UnwrapResource(h.Resource)
+					if slices.ContainsFunc(ids(inner.GetID(), inner.GetARN()), func(id string) bool { return matches(value, id) }) {
+						return h.Type(), inner.GetID()
+					}
+				}
+				return "", ""
+			})
+		})
+	}
+	wg.Wait()
+
+	result := &Result{}
+	for i, rel := range rels {
+		if errs[i] != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", rel.Type(), errs[i]))
+			continue
+		}
+		result.Hits = append(result.Hits, hits[i]...)
+	}
+	return result, nil
+}
+
+func ids(values ...string) []string {
+	var out []string
+	for _, s := range values {
+		if s != "" && !slices.Contains(out, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// scanType lists rel and returns the resources whose data references one of
+// refs. via names the resource a matched value goes through, if any.
+func scanType(ctx context.Context, reg *registry.Registry, rel Relation, refs []string, via func(value string) (string, string)) ([]Hit, error) {
+	d, err := reg.GetDAO(ctx, rel.Service, rel.ResourceType)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var hits []Hit
+	for _, res := range resources {
+		path, value, ok := findReference(dao.UnwrapResource(res).Raw(), refs)
+		if !ok {
+			continue
+		}
+		hit := Hit{Service: rel.Service, ResourceType: rel.ResourceType, Resource: res, Path: path}
+		if via != nil {
+			if typ, id := via(value); id != "" {
+				hit.Via = typ + " " + id
+			}
+		}
+		hits = append(hits, hit)
+	}
+	slices.SortFunc(hits, func(a, b Hit) int {
+		return cmp.Compare(dao.UnwrapResource(a.Resource).GetID(), dao.UnwrapResource(b.Resource).GetID())
+	})
+	return hits, nil
+}
+
+// skipKeys hold free-form user data, where a match is only a coincidence.
+var skipKeys = []string{"Tags", "TagList", "TagSet", "Description"}
+
+// findReference searches raw's JSON form for a string value referencing one
+// of refs and returns its path and value.
+func findReference(raw any, refs []string) (path, value string, ok bool) {
+	if raw == nil || len(refs) == 0 {
+		return "", "", false
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return "", "", false
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", "", false
+	}
+	return walk(v, "", refs)
+}
+
+func walk(v any, path string, refs []string) (string, string, bool) {
+	switch v := v.(type) {
+	case string:
+		for _, ref := range refs {
+			if matches(v, ref) {
+				return path, v, true
+			}
+		}
+	case []any:
+		for i, item := range v {
+			if p, value, ok := walk(item, path+"["+strconv.Itoa(i)+"]", refs); ok {
+				return p, value, true
+			}
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			if !slices.Contains(skipKeys, k) {
+				keys = append(keys, k)
+			}
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			if p, value, ok := walk(v[k], child, refs); ok {
+				return p, value, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// matches reports whether value references ref: equal to it, a versioned
+// form of an ARN (ARN:3), or one entry of a comma-separated list such as an
+// auto scaling group's VPCZoneIdentifier.
+func matches(value, ref string) bool {
+	if value == ref {
+		return true
+	}
+	if strings.HasPrefix(ref, "arn:") && strings.HasPrefix(value, ref+":") {
+		return true
+	}
+	if strings.Contains(value, ",") {
+		for part := range strings.SplitSeq(value, ",") {
+			if strings.TrimSpace(part) == ref {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package usedby
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		value, ref string
+		want       bool
+	}{
+		{"sg-1", "sg-1", true},
+		{"sg-10", "sg-1", false},
+		{"subnet-a,subnet-b", "subnet-b", true},
+		{"subnet-a, subnet-b", "subnet-b", true},
+		{"subnet-ab", "subnet-a", false},
+		{"arn:aws:lambda:us-east-1:1:layer:deps:3", "arn:aws:lambda:us-east-1:1:layer:deps", true},
+		{"arn:aws:lambda:us-east-1:1:layer:deps-extra:3", "arn:aws:lambda:us-east-1:1:layer:deps", false},
+		{"my-fn:3", "my-fn", false},
+	}
+	for _, tt := range tests {
+		if got := matches(tt.value, tt.ref); got != tt.want {
+			t.Errorf("matches(%q, %q) = %v, want %v", tt.value, tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestFindReference(t *testing.T) {
+	raw := map[string]any{
+		"Description": "uses sg-1",
+		"Tags":        []map[string]string{{"Key": "sg", "Value": "sg-1"}},
+		"VpcConfig":   map[string]any{"SecurityGroupIds": []string{"sg-0", "sg-1"}},
+	}
+	path, value, ok := findReference(raw, []string{"sg-1"})
+	if !ok || path != "VpcConfig.SecurityGroupIds[1]" || value != "sg-1" {
+		t.Errorf("findReference() = %q, %q, %v", path, value, ok)
+	}
+
+	if _, _, ok := findReference(map[string]any{"Description": "sg-1"}, []string{"sg-1"}); ok {
+		t.Error("a match in Description should be ignored")
+	}
+	if _, _, ok := findReference(nil, []string{"sg-1"}); ok {
+		t.Error("nil raw should not match")
+	}
+}
+
+type fakeDAO struct {
+	dao.BaseDAO
+	resources []dao.Resource
+	err       error
+}
+
+func (d *fakeDAO) List(context.Context) ([]dao.Resource, error)      { return d.resources, d.err }
+func (d *fakeDAO) Get(context.Context, string) (dao.Resource, error) { return nil, nil }
+func (d *fakeDAO) Delete(context.Context, string) error              { return nil }
+
+func register(reg *registry.Registry, service, resType string, err error, resources ...dao.Resource) {
+	reg.RegisterCustom(service, resType, registry.Entry{
+		DAOFactory: func(context.Context) (dao.DAO, error) {
+			return &fakeDAO{BaseDAO: dao.NewBaseDAO(service, resType), resources: resources, err: err}, nil
+		},
+	})
+}
+
+func res(id string, raw any) dao.Resource {
+	return &dao.BaseResource{ID: id, Name: id, Data: raw}
+}
+
+func TestFind(t *testing.T) {
+	reg := registry.New()
+	register(reg, "ec2", "instances", nil,
+		res("i-1", map[string]any{"SecurityGroups": []map[string]string{{"GroupId": "sg-1"}}}),
+		res("i-2", map[string]any{"SecurityGroups": []map[string]string{{"GroupId": "sg-2"}}}),
+	)
+	register(reg, "lambda", "functions", nil,
+		res("fn", map[string]any{"VpcConfig": map[string]any{"SecurityGroupIds": []string{"sg-1"}}}),
+	)
+	register(reg, "autoscaling", "groups", nil,
+		res("asg-web", map[string]any{"Instances": []map[string]string{{"InstanceId": "i-1"}}}),
+		res("asg-db", map[string]any{"Instances": []map[string]string{{"InstanceId": "i-2"}}}),
+	)
+	register(reg, "ecs", "services", errors.New("AccessDenied"))
+
+	result, err := Find(context.Background(), reg, Target{Service: "ec2", ResourceType: "security-groups", ID: "sg-1"})
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+
+	var got []string
+	for _, h := range result.Hits {
+		got = append(got, h.Type()+" "+dao.UnwrapResource(h.Resource).GetID())
+	}
+	want := []string{"ec2/instances i-1", "lambda/functions fn", "autoscaling/groups asg-web"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("hits = %v, want %v", got, want)
+	}
+	if asg := result.Hits[2]; asg.Via != "ec2/instances i-1" || asg.Path != "Instances[0].InstanceId" {
+		t.Errorf("asg hit = via %q path %q", asg.Via, asg.Path)
+	}
+	if !slices.ContainsFunc(result.Errors, func(e string) bool { return strings.HasPrefix(e, "ecs/services: ") }) {
+		t.Errorf("errors = %v, want an ecs/services error", result.Errors)
+	}
+}
+
+func TestFindByName(t *testing.T) {
+	reg := registry.New()
+	register(reg, "cloudwatch", "alarms", nil,
+		res("queue-depth", map[string]any{"Dimensions": []map[string]string{{"Name": "QueueName", "Value": "jobs"}}}),
+		res("other", map[string]any{"Dimensions": []map[string]string{{"Name": "QueueName", "Value": "mail"}}}),
+	)
+
+	result, err := Find(context.Background(), reg, Target{Service: "sqs", ResourceType: "queues", ID: "https://sqs/1/jobs", Name: "jobs"})
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if len(result.Hits) != 1 || dao.UnwrapResource(result.Hits[0].Resource).GetID() != "queue-depth" {
+		t.Errorf("hits = %v, want queue-depth", result.Hits)
+	}
+}
+
+func TestFindUnknownType(t *testing.T) {
+	if _, err := Find(context.Background(), registry.New(), Target{Service: "s3", ResourceType: "buckets", ID: "b"}); err == nil {
+		t.Error("Find() should fail for a type without known references")
+	}
+}
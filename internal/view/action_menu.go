@@ -3,8 +3,10 @@ package view
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
@@ -53,10 +55,82 @@ type dangerousState struct {
 	active bool
 	input  string
 	token  string
+	// full requires the whole token rather than its suffix
+	full bool
+	// production names the production profile that escalated the prompt
+	production string
+	// phrase marks the second, production phrase prompt
+	phrase bool
+}
+
+// required returns what must be typed to confirm
+func (d dangerousState) required() string {
+	if d.full {
+		return action.ConfirmFull(d.token)
+	}
+	return action.ConfirmSuffix(d.token)
+}
+
+// inputState tracks the prompts for actions with an InputSpec.
+type inputState struct {
+	active bool
+	spec   *action.InputSpec // Prompt being answered
+	field  textinput.Model
+	values []string // Submitted values in prompt order, passed to the executor
+	labels []string // Labels of the submitted prompts, parallel to values
+
+	// Picker state, used when the InputSpec has Choices
+	picking      bool
+	loading      bool
+	choices      []action.Choice
+	choicesErr   error
+	choiceCursor int
+}
+
+// choicesLoadedMsg delivers the options for an action's input picker.
+type choicesLoadedMsg struct {
+	choices []action.Choice
+	err     error
+}
+
+// nextPromptLoadedMsg delivers the prompt an InputSpec's Next loaded after
+// from was answered; a nil spec ends the chain.
+type nextPromptLoadedMsg struct {
+	from   *action.InputSpec
+	spec   *action.InputSpec
+	values []string
+	labels []string
+	err    error
+}
+
+// maxVisibleChoices bounds how many picker rows are shown at once.
+const maxVisibleChoices = 8
+
+// progressState tracks an action running in the background via action.Track.
+type progressState struct {
+	name    string
+	ch      <-chan action.ProgressUpdate
+	steps   []string
+	running bool
+}
+
+// actionProgressMsg delivers the next update from a tracked action.
+type actionProgressMsg struct {
+	ch     <-chan action.ProgressUpdate
+	update action.ProgressUpdate
+	ok     bool
+}
+
+func waitForProgress(ch <-chan action.ProgressUpdate) tea.Cmd {
+	return func() tea.Msg {
+		update, ok := <-ch
+		return actionProgressMsg{ch: ch, update: update, ok: ok}
+	}
 }
 
 type ActionMenu struct {
 	ctx            context.Context
+	runCtx         context.Context // ctx with the run cache of the action being started
 	resource       dao.Resource
 	service        string
 	resType        string
@@ -68,11 +142,13 @@ type ActionMenu struct {
 	lastExecAction *action.Action
 	styles         actionMenuStyles
 	dangerous      dangerousState
+	input          inputState
+	progress       progressState
 }
 
 // NewActionMenu creates a new ActionMenu
 func NewActionMenu(ctx context.Context, resource dao.Resource, service, resType string) *ActionMenu {
-	actions := action.Global.Get(service, resType)
+	actions := action.Global.ForResource(service, resType)
 
 	filtered := make([]action.Action, 0, len(actions))
 	readOnly := config.Global().ReadOnly()
@@ -125,12 +201,38 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 		return m, nil
+
+	case actionProgressMsg:
+		return m.handleProgress(msg)
+
+	case choicesLoadedMsg:
+		if m.input.picking {
+			m.input.loading = false
+			m.input.choices = msg.choices
+			m.input.choicesErr = msg.err
+		}
+		return m, nil
+
+	case nextPromptLoadedMsg:
+		if !m.input.active || m.input.spec != msg.from {
+			return m, nil
+		}
+		m.input.loading = false
+		if msg.err != nil {
+			m.input.choicesErr = msg.err
+			return m, nil
+		}
+		if msg.spec != nil {
+			return m, m.prompt(msg.spec, msg.values, msg.labels)
+		}
+		return m.finishInput(msg.values, msg.labels)
+
 	case ThemeChangedMsg:
 		m.styles = newActionMenuStyles()
 		return m, nil
 
 	case tea.MouseMotionMsg:
-		if !m.confirming && !m.dangerous.active {
+		if !m.confirming && !m.dangerous.active && !m.input.active {
 			if idx := m.getActionAtPosition(msg.Y); idx >= 0 && idx != m.cursor {
 				m.cursor = idx
 			}
@@ -138,7 +240,7 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.MouseClickMsg:
-		if msg.Button == tea.MouseLeft && !m.confirming && !m.dangerous.active {
+		if msg.Button == tea.MouseLeft && !m.confirming && !m.dangerous.active && !m.input.active && !m.progress.running {
 			if idx := m.getActionAtPosition(msg.Y); idx >= 0 {
 				m.cursor = idx
 				return m.handleActionConfirm(m.actions[idx], idx)
@@ -147,22 +249,26 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyPressMsg:
+		if m.input.active {
+			return m.handleInputKey(msg)
+		}
+
 		if m.dangerous.active {
 			switch msg.String() {
 			case "enter":
-				if action.ConfirmMatches(m.dangerous.token, m.dangerous.input) {
-					m.dangerous.active = false
-					m.dangerous.input = ""
-					m.dangerous.token = ""
+				if m.dangerous.input == m.dangerous.required() {
+					phrase := m.dangerous.phrase
+					m.dangerous = dangerousState{}
 					if m.confirmIdx < len(m.actions) {
-						return m.executeAction(m.actions[m.confirmIdx])
+						if phrase {
+							return m.executeAction(m.actions[m.confirmIdx])
+						}
+						return m.confirmed(m.actions[m.confirmIdx])
 					}
 				}
 				return m, nil
 			case "esc":
-				m.dangerous.active = false
-				m.dangerous.input = ""
-				m.dangerous.token = ""
+				m.dangerous = dangerousState{}
 				return m, nil
 			default:
 				if msg.Code == tea.KeyBackspace || msg.String() == "backspace" {
@@ -171,7 +277,9 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 					return m, nil
 				}
-				if len(msg.String()) == 1 {
+				if msg.String() == "space" {
+					m.dangerous.input += " "
+				} else if len(msg.String()) == 1 {
 					m.dangerous.input += msg.String()
 				}
 				return m, nil
@@ -184,7 +292,7 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.confirming = false
 				if m.confirmIdx < len(m.actions) {
 					act := m.actions[m.confirmIdx]
-					return m.executeAction(act)
+					return m.confirmed(act)
 				}
 				return m, nil
 			case "n", "N", "esc":
@@ -194,6 +302,11 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// One tracked action at a time; esc still closes the menu.
+		if m.progress.running {
+			return m, nil
+		}
+
 		switch msg.String() {
 		// Don't intercept esc/q - let the app handle back navigation
 		case "up", "k":
@@ -224,20 +337,235 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *ActionMenu) handleActionConfirm(act action.Action, idx int) (tea.Model, tea.Cmd) {
-	switch act.Confirm {
+	m.runCtx = action.WithRunCache(m.ctx)
+	if act.Input != nil {
+		m.confirmIdx = idx
+		return m, m.prompt(act.Input, nil, nil)
+	}
+	return m.confirmAction(act, idx)
+}
+
+// Start begins the named action as if it had been picked from the menu,
+// with its input prompts and confirmation. It reports false when the action
+// is not offered for the resource, e.g. in read-only mode.
+func (m *ActionMenu) Start(name string) (tea.Cmd, bool) {
+	for i, act := range m.actions {
+		if strings.EqualFold(act.Name, name) {
+			m.cursor = i
+			_, cmd := m.handleActionConfirm(act, i)
+			return cmd, true
+		}
+	}
+	return nil, false
+}
+
+// StartWithInputs begins the named action with its prompts already answered,
+// e.g. a destination picked in another view, and goes straight to its
+// confirmation. labels name the values in the confirmation.
+func (m *ActionMenu) StartWithInputs(name string, values, labels []string) (tea.Cmd, bool) {
+	for i, act := range m.actions {
+		if strings.EqualFold(act.Name, name) {
+			m.cursor = i
+			m.runCtx = action.WithRunCache(m.ctx)
+			m.input.values, m.input.labels = values, labels
+			_, cmd := m.confirmAction(act, i)
+			return cmd, true
+		}
+	}
+	return nil, false
+}
+
+// actionCtx returns the context for the action being run, carrying its run
+// cache once the action was started.
+func (m *ActionMenu) actionCtx() context.Context {
+	if m.runCtx != nil {
+		return m.runCtx
+	}
+	return m.ctx
+}
+
+// prompt opens the input for spec, keeping the values and labels already
+// submitted for earlier prompts of the chain.
+func (m *ActionMenu) prompt(spec *action.InputSpec, values, labels []string) tea.Cmd {
+	ti := textinput.New()
+	ti.Placeholder = spec.Placeholder
+	ti.Prompt = "> "
+	ti.CharLimit = 500
+	ti.SetWidth(40)
+	ti.SetStyles(ui.TextInputStyles())
+	ti.Focus()
+	m.input = inputState{active: true, spec: spec, field: ti, values: values, labels: labels}
+	if spec.Deferred() {
+		m.input.loading = true
+		return m.loadNext(spec, values, labels)
+	}
+	if spec.Choices != nil {
+		m.input.picking = true
+		m.input.loading = true
+		return tea.Batch(textinput.Blink, m.loadChoices(spec))
+	}
+	return textinput.Blink
+}
+
+func (m *ActionMenu) loadChoices(spec *action.InputSpec) tea.Cmd {
+	ctx, resource, load := m.actionCtx(), m.resource, spec.Choices
+	return func() tea.Msg {
+		choices, err := load(ctx, resource)
+		return choicesLoadedMsg{choices: choices, err: err}
+	}
+}
+
+// filteredChoices returns the picker options matching the typed text.
+func (m *ActionMenu) filteredChoices() []action.Choice {
+	query := strings.ToLower(strings.TrimSpace(m.input.field.Value()))
+	if query == "" {
+		return m.input.choices
+	}
+	var out []action.Choice
+	for _, c := range m.input.choices {
+		if strings.Contains(strings.ToLower(c.Label), query) || strings.Contains(strings.ToLower(c.Value), query) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// handleInputKey handles key presses while an action's input prompt is active.
+func (m *ActionMenu) handleInputKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.input = inputState{}
+		return m, nil
+	case "up", "ctrl+p":
+		if m.input.picking {
+			m.input.choiceCursor = max(0, m.input.choiceCursor-1)
+			return m, nil
+		}
+	case "down", "ctrl+n":
+		if m.input.picking {
+			m.input.choiceCursor = max(0, min(m.input.choiceCursor+1, len(m.filteredChoices())-1))
+			return m, nil
+		}
+	case "enter":
+		if m.confirmIdx >= len(m.actions) {
+			m.input = inputState{}
+			return m, nil
+		}
+		if m.input.loading {
+			return m, nil
+		}
+		value := strings.TrimSpace(m.input.field.Value())
+		if m.input.picking {
+			choices := m.filteredChoices()
+			if m.input.choiceCursor >= len(choices) {
+				return m, nil
+			}
+			value = choices[m.input.choiceCursor].Value
+		}
+		if value == "" && !m.input.spec.Optional {
+			return m, nil
+		}
+		values := append(slices.Clone(m.input.values), value)
+		labels := append(slices.Clone(m.input.labels), m.input.spec.Label)
+		if next := m.input.spec.Then; next != nil {
+			return m, m.prompt(next, values, labels)
+		}
+		if m.input.spec.Next != nil {
+			m.input.loading = true
+			m.input.choicesErr = nil
+			return m, m.loadNext(m.input.spec, values, labels)
+		}
+		return m.finishInput(values, labels)
+	}
+
+	var cmd tea.Cmd
+	m.input.field, cmd = m.input.field.Update(msg)
+	m.input.choiceCursor = 0
+	return m, cmd
+}
+
+// loadNext runs from.Next in the background once from was answered.
+func (m *ActionMenu) loadNext(from *action.InputSpec, values, labels []string) tea.Cmd {
+	ctx, resource := m.actionCtx(), m.resource
+	return func() tea.Msg {
+		spec, err := from.Next(ctx, resource, values)
+		return nextPromptLoadedMsg{from: from, spec: spec, values: values, labels: labels, err: err}
+	}
+}
+
+// finishInput closes the prompt chain and moves on to confirmation.
+func (m *ActionMenu) finishInput(values, labels []string) (tea.Model, tea.Cmd) {
+	m.input.active = false
+	m.input.field.Blur()
+	m.input.values = values
+	m.input.labels = labels
+	return m.confirmAction(m.actions[m.confirmIdx], m.confirmIdx)
+}
+
+// confirmAction applies the action's confirmation level, then executes it.
+// On production profiles the "full" policy turns every confirmation into
+// typing the whole resource ID.
+func (m *ActionMenu) confirmAction(act action.Action, idx int) (tea.Model, tea.Cmd) {
+	level := act.Confirm
+	profile, policy := m.productionPolicy(act)
+	if policy == config.ProductionConfirmFull {
+		level = action.ConfirmDangerous
+	}
+	switch level {
 	case action.ConfirmDangerous:
-		m.dangerous.active = true
-		m.dangerous.input = ""
+		m.dangerous = dangerousState{
+			active:     true,
+			token:      m.getConfirmToken(act),
+			full:       policy == config.ProductionConfirmFull,
+			production: profile,
+		}
 		m.confirmIdx = idx
-		m.dangerous.token = m.getConfirmToken(act)
 		return m, nil
 	case action.ConfirmSimple:
 		m.confirming = true
 		m.confirmIdx = idx
 		return m, nil
 	default:
-		return m.executeAction(act)
+		m.confirmIdx = idx
+		return m.confirmed(act)
+	}
+}
+
+// confirmed runs an action whose confirmation passed, first asking for the
+// production phrase when the "phrase" policy applies.
+func (m *ActionMenu) confirmed(act action.Action) (tea.Model, tea.Cmd) {
+	if profile, policy := m.productionPolicy(act); policy == config.ProductionConfirmPhrase {
+		m.dangerous = dangerousState{
+			active:     true,
+			token:      action.ProductionConfirmPhrase,
+			full:       true,
+			production: profile,
+			phrase:     true,
+		}
+		return m, nil
+	}
+	return m.executeAction(act)
+}
+
+// productionPolicy returns the production profile act runs in and its
+// confirm policy, or empty strings outside production profiles.
+func (m *ActionMenu) productionPolicy(act action.Action) (profile, policy string) {
+	if !action.NeedsProductionConfirm(act) {
+		return "", ""
+	}
+	profiles := []string{dao.GetResourceProfile(m.resource)}
+	if profiles[0] == "" {
+		profiles = profiles[:0]
+		for _, sel := range config.Global().Selections() {
+			profiles = append(profiles, sel.ID())
+		}
+	}
+	for _, p := range profiles {
+		if config.File().IsProductionProfile(p) {
+			return p, config.File().ProductionConfirm()
+		}
 	}
+	return "", ""
 }
 
 func (m *ActionMenu) getConfirmToken(act action.Action) string {
@@ -250,7 +578,11 @@ func (m *ActionMenu) getConfirmToken(act action.Action) string {
 func (m *ActionMenu) executeAction(act action.Action) (tea.Model, tea.Cmd) {
 	if act.Type == action.ActionTypeExec {
 		m.lastExecAction = &act
-		execCmd, err := action.ExpandVariables(act.Command, m.resource)
+		input := ""
+		if act.Input != nil && len(m.input.values) > 0 {
+			input = m.input.values[0]
+		}
+		execCmd, err := action.ExpandVariablesWithInput(act.Command, m.resource, input)
 		if err != nil {
 			return m, func() tea.Msg {
 				return execResultMsg{success: false, err: err}
@@ -273,8 +605,16 @@ func (m *ActionMenu) executeAction(act action.Action) (tea.Model, tea.Cmd) {
 		})
 	}
 
-	result := action.ExecuteWithDAO(m.ctx, act, m.resource, m.service, m.resType)
+	ctx := m.actionCtx()
+	if act.Input != nil {
+		ctx = action.WithInputs(ctx, m.input.values)
+	}
+	result := action.ExecuteWithDAO(ctx, act, m.resource, m.service, m.resType)
 	m.result = &result
+	if result.Progress != nil {
+		m.progress = progressState{name: act.Name, ch: result.Progress, running: true}
+		return m, waitForProgress(result.Progress)
+	}
 	if result.FollowUpMsg != nil {
 		log.Debug("action has follow-up message", "action", act.Name, "msgType", fmt.Sprintf("%T", result.FollowUpMsg))
 		return m, func() tea.Msg { return result.FollowUpMsg }
@@ -282,6 +622,28 @@ func (m *ActionMenu) executeAction(act action.Action) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleProgress records a step of a tracked action, or its final result.
+func (m *ActionMenu) handleProgress(msg actionProgressMsg) (tea.Model, tea.Cmd) {
+	if msg.ch != m.progress.ch {
+		return m, nil
+	}
+	if !msg.ok {
+		m.progress.running = false
+		return m, nil
+	}
+	if msg.update.Done {
+		m.progress.running = false
+		result := action.SuccessResult(msg.update.Message)
+		if msg.update.Err != nil {
+			result = action.FailResult(msg.update.Err)
+		}
+		m.result = &result
+		return m, nil
+	}
+	m.progress.steps = append(m.progress.steps, msg.update.Step)
+	return m, waitForProgress(m.progress.ch)
+}
+
 // execResultMsg is sent when an exec action completes
 type execResultMsg struct {
 	success bool
@@ -310,7 +672,11 @@ func (m *ActionMenu) ViewString() string {
 		}
 	}
 
-	if m.dangerous.active && m.confirmIdx < len(m.actions) {
+	if m.input.active && m.confirmIdx < len(m.actions) {
+		act := m.actions[m.confirmIdx]
+		out += "\n"
+		out += m.renderInputPrompt(act, m.input.spec)
+	} else if m.dangerous.active && m.confirmIdx < len(m.actions) {
 		act := m.actions[m.confirmIdx]
 		out += "\n"
 		out += m.renderDangerousConfirm(act)
@@ -319,13 +685,22 @@ func (m *ActionMenu) ViewString() string {
 		out += "\n"
 
 		confirmContent := s.bold.Render("Confirm Action") + "\n"
-		confirmContent += fmt.Sprintf("Execute '%s' on %s?\n\n", act.Name, m.resource.GetID())
+		confirmContent += fmt.Sprintf("Execute '%s' on %s?\n", act.Name, m.resource.GetID())
+		for i, label := range m.input.labels {
+			if i < len(m.input.values) && m.input.values[i] != "" {
+				confirmContent += fmt.Sprintf("%s: %s\n", label, m.input.values[i])
+			}
+		}
+		confirmContent += "\n"
 		confirmContent += "Press " + s.yes.Render("[Y]") + " to confirm or " + s.no.Render("[N]") + " to cancel"
 
 		out += s.box.Render(confirmContent)
 	} else if m.result != nil {
 		out += "\n"
-		if m.result.Success {
+		out += m.renderProgress()
+		if m.progress.running {
+			out += s.item.Render(m.result.Message)
+		} else if m.result.Success {
 			out += ui.SuccessStyle().Render(m.result.Message)
 		} else if m.result.ErrorKind != apperrors.Unknown {
 			out += ui.DangerStyle().Render(fmt.Sprintf("[%s] %v", m.result.ErrorKind, m.result.Error))
@@ -334,31 +709,61 @@ func (m *ActionMenu) ViewString() string {
 		}
 	}
 
-	if !m.confirming && !m.dangerous.active {
+	if m.progress.running {
+		out += "\n\n" + ui.DimStyle().Render("Running… Esc closes the menu; the action continues in the background")
+	} else if !m.confirming && !m.dangerous.active && !m.input.active {
 		out += "\n\n" + ui.DimStyle().Render("Press shortcut key or Enter to execute, Esc to cancel")
 	}
 
 	return out
 }
 
+// renderProgress lists the steps reported by a tracked action. The latest
+// step is marked in progress while running, or failed if the action failed.
+func (m *ActionMenu) renderProgress() string {
+	var out string
+	last := len(m.progress.steps) - 1
+	for i, step := range m.progress.steps {
+		switch {
+		case i == last && m.progress.running:
+			out += ui.WarningStyle().Render("… "+step) + "\n"
+		case i == last && m.result != nil && !m.result.Success:
+			out += ui.DangerStyle().Render("✗ "+step) + "\n"
+		default:
+			out += ui.DimStyle().Render("✓ "+step) + "\n"
+		}
+	}
+	return out
+}
+
 func (m *ActionMenu) renderDangerousConfirm(act action.Action) string {
 	s := m.styles
 	t := ui.Current()
 
 	dangerTitle := ui.BoldDangerStyle().Render("⚠ DANGER")
+	if m.dangerous.production != "" {
+		dangerTitle = ui.BoldDangerStyle().Render("⚠ PRODUCTION: " + config.ProfileSelectionFromID(m.dangerous.production).DisplayName())
+	}
 	content := dangerTitle + "\n\n"
-	content += fmt.Sprintf("You are about to %s:\n", s.no.Render(act.Name))
-	content += s.bold.Render(m.dangerous.token) + "\n\n"
+	if m.dangerous.phrase {
+		content += fmt.Sprintf("You are about to %s on %s in production.\n\n", s.no.Render(act.Name), s.bold.Render(m.resource.GetID()))
+	} else {
+		content += fmt.Sprintf("You are about to %s:\n", s.no.Render(act.Name))
+		content += s.bold.Render(m.dangerous.token) + "\n\n"
+	}
 
-	suffix := action.ConfirmSuffix(m.dangerous.token)
-	if len(suffix) < len(m.dangerous.token) {
+	suffix := m.dangerous.required()
+	switch {
+	case m.dangerous.phrase:
+		content += fmt.Sprintf("Type %q to confirm:\n", suffix)
+	case len(suffix) < len(m.dangerous.token):
 		content += fmt.Sprintf("Type last %d chars: ...%s\n", len(suffix), suffix)
-	} else {
+	default:
 		content += "Type to confirm:\n"
 	}
 
 	inputStyle := s.input
-	matched := action.ConfirmMatches(m.dangerous.token, m.dangerous.input)
+	matched := m.dangerous.input == suffix
 	if matched {
 		inputStyle = inputStyle.BorderForeground(t.Success)
 	} else if len(m.dangerous.input) > 0 && strings.HasPrefix(suffix, m.dangerous.input) {
@@ -370,6 +775,70 @@ func (m *ActionMenu) renderDangerousConfirm(act action.Action) string {
 	return s.dangerBox.Render(content)
 }
 
+func (m *ActionMenu) renderInputPrompt(act action.Action, spec *action.InputSpec) string {
+	s := m.styles
+
+	content := s.bold.Render(act.Name) + "\n"
+	for i, value := range m.input.values {
+		if i < len(m.input.labels) {
+			content += ui.DimStyle().Render(fmt.Sprintf("%s: %s", m.input.labels[i], value)) + "\n"
+		}
+	}
+	content += spec.Label + ":\n"
+	content += m.input.field.View() + "\n\n"
+	if !m.input.picking {
+		switch {
+		case m.input.loading:
+			content += ui.DimStyle().Render("Loading…") + "\n"
+		case m.input.choicesErr != nil:
+			content += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", m.input.choicesErr)) + "\n"
+		}
+	}
+	if m.input.picking {
+		content += m.renderChoices() + "\n"
+		content += ui.DimStyle().Render("Type to filter, ↑/↓ to choose, Enter to select, Esc to cancel")
+	} else if spec.Optional {
+		content += ui.DimStyle().Render("Press Enter to continue (empty allowed), Esc to cancel")
+	} else {
+		content += ui.DimStyle().Render("Press Enter to continue, Esc to cancel")
+	}
+
+	return s.box.Render(content)
+}
+
+// renderChoices lists a window of picker options around the cursor.
+func (m *ActionMenu) renderChoices() string {
+	switch {
+	case m.input.loading:
+		return ui.DimStyle().Render("Loading…") + "\n"
+	case m.input.choicesErr != nil:
+		return ui.DangerStyle().Render(fmt.Sprintf("Error: %v", m.input.choicesErr)) + "\n"
+	}
+	choices := m.filteredChoices()
+	if len(choices) == 0 {
+		return ui.DimStyle().Render("No matches") + "\n"
+	}
+
+	start := max(0, min(m.input.choiceCursor-maxVisibleChoices/2, len(choices)-maxVisibleChoices))
+	end := min(start+maxVisibleChoices, len(choices))
+	var out string
+	for i := start; i < end; i++ {
+		label := choices[i].Label
+		if label == "" {
+			label = choices[i].Value
+		}
+		if i == m.input.choiceCursor {
+			out += m.styles.selected.Render(label) + "\n"
+		} else {
+			out += "  " + m.styles.item.Render(label) + "\n"
+		}
+	}
+	if len(choices) > maxVisibleChoices {
+		out += ui.DimStyle().Render(fmt.Sprintf("  %d of %d", m.input.choiceCursor+1, len(choices))) + "\n"
+	}
+	return out
+}
+
 func (m *ActionMenu) View() tea.View {
 	return tea.NewView(m.ViewString())
 }
@@ -389,11 +858,20 @@ func (m *ActionMenu) SetSize(_, _ int) tea.Cmd {
 }
 
 func (m *ActionMenu) StatusLine() string {
+	if m.input.active && m.input.picking {
+		return "Choose a value • ↑/↓ to move • Enter to select • Esc to cancel"
+	}
+	if m.input.active {
+		return "Enter value • Enter to continue • Esc to cancel"
+	}
 	if m.dangerous.active {
-		suffix := action.ConfirmSuffix(m.dangerous.token)
+		suffix := m.dangerous.required()
 		if m.dangerous.input != "" && !strings.HasPrefix(suffix, m.dangerous.input) {
 			return "Token does not match"
 		}
+		if m.dangerous.phrase {
+			return fmt.Sprintf("Production profile • Type %q to confirm", suffix)
+		}
 		if len(suffix) < len(m.dangerous.token) {
 			return fmt.Sprintf("Type last %d chars to confirm", len(suffix))
 		}
@@ -402,9 +880,12 @@ func (m *ActionMenu) StatusLine() string {
 	if m.confirming {
 		return "Confirm: Y/N"
 	}
+	if m.progress.running {
+		return fmt.Sprintf("Running %s • Esc to close", m.progress.name)
+	}
 	return fmt.Sprintf("Actions for %s • Enter to execute • Esc to cancel", m.resource.GetID())
 }
 
 func (m *ActionMenu) HasActiveInput() bool {
-	return m.dangerous.active
+	return m.dangerous.active || m.input.active
 }
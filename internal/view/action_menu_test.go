@@ -2,11 +2,15 @@ package view
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
 )
 
 func TestActionMenuMouseHover(t *testing.T) {
@@ -98,6 +102,47 @@ func TestActionMenuConfirmDangerousWrongToken(t *testing.T) {
 	}
 }
 
+func TestActionMenuConfirmDangerousFullToken(t *testing.T) {
+	resource := &mockResource{id: "i-1234567890abcdef0", name: "test-instance"}
+	menu := NewActionMenu(context.Background(), resource, "test", "items")
+
+	// Production "full" policy: the suffix alone is not enough
+	menu.dangerous = dangerousState{active: true, token: "i-1234567890abcdef0", full: true, production: "prod"}
+	for _, r := range action.ConfirmSuffix("i-1234567890abcdef0") {
+		menu.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !menu.dangerous.active {
+		t.Fatal("expected suffix to be rejected when the full token is required")
+	}
+	if !strings.Contains(menu.ViewString(), "PRODUCTION") {
+		t.Error("expected the prompt to name the production profile")
+	}
+}
+
+func TestActionMenuConfirmProductionPhrase(t *testing.T) {
+	resource := &mockResource{id: "i-12345", name: "test-instance"}
+	menu := NewActionMenu(context.Background(), resource, "test", "items")
+
+	menu.dangerous = dangerousState{
+		active: true, token: action.ProductionConfirmPhrase, full: true, production: "prod", phrase: true,
+	}
+	for _, r := range action.ProductionConfirmPhrase {
+		if r == ' ' {
+			menu.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+			continue
+		}
+		menu.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+	if menu.dangerous.input != action.ProductionConfirmPhrase {
+		t.Fatalf("input = %q, want %q", menu.dangerous.input, action.ProductionConfirmPhrase)
+	}
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if menu.dangerous.active {
+		t.Error("expected the phrase prompt to close after typing the phrase")
+	}
+}
+
 func TestActionMenuConfirmDangerousEscCancels(t *testing.T) {
 	ctx := context.Background()
 	resource := &mockResource{id: "i-12345", name: "test-instance"}
@@ -218,3 +263,453 @@ func TestActionMenuConfirmDangerousHasActiveInput(t *testing.T) {
 		t.Error("Expected HasActiveInput() to be true when dangerousConfirm is active")
 	}
 }
+
+func TestActionMenuInputPrompt(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "user-1", name: "user-1"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:      "Add to Group",
+		Shortcut:  "G",
+		Type:      action.ActionTypeAPI,
+		Operation: "AddToGroup",
+		Confirm:   action.ConfirmSimple,
+		Input:     &action.InputSpec{Label: "Group name"},
+	}}
+
+	menu.Update(tea.KeyPressMsg{Text: "G", Code: 'G'})
+	if !menu.input.active {
+		t.Fatal("Expected input prompt to be active after selecting action")
+	}
+	if !menu.HasActiveInput() {
+		t.Error("Expected HasActiveInput() to be true while prompting")
+	}
+
+	// Empty submission is rejected for required input
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !menu.input.active {
+		t.Fatal("Expected input prompt to stay active on empty submit")
+	}
+
+	for _, r := range "admins" {
+		menu.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	if menu.input.active {
+		t.Error("Expected input prompt to close after submit")
+	}
+	if !slices.Equal(menu.input.values, []string{"admins"}) {
+		t.Errorf("input values = %q, want %q", menu.input.values, "admins")
+	}
+	if !menu.confirming {
+		t.Error("Expected simple confirmation after input")
+	}
+}
+
+func TestActionMenuInputPromptEsc(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "user-1", name: "user-1"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:      "Add to Group",
+		Shortcut:  "G",
+		Type:      action.ActionTypeAPI,
+		Operation: "AddToGroup",
+		Input:     &action.InputSpec{Label: "Group name"},
+	}}
+
+	menu.Update(tea.KeyPressMsg{Text: "G", Code: 'G'})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+
+	if menu.input.active || menu.confirming {
+		t.Error("Expected Esc to cancel the input prompt")
+	}
+}
+
+func TestActionMenuTrackedProgress(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "i-123", name: "test"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{Name: "Resize", Shortcut: "T"}}
+
+	ch := make(chan action.ProgressUpdate, 3)
+	result := action.ActionResult{Success: true, Message: "Resizing i-123", Progress: ch}
+	menu.result = &result
+	menu.progress = progressState{name: "Resize", ch: ch, running: true}
+
+	ch <- action.ProgressUpdate{Step: "Stopping instance"}
+	ch <- action.ProgressUpdate{Done: true, Message: "Resized i-123"}
+	close(ch)
+
+	_, cmd := menu.Update(waitForProgress(ch)())
+	if cmd == nil {
+		t.Fatal("Expected another progress wait after a step")
+	}
+	if len(menu.progress.steps) != 1 || menu.progress.steps[0] != "Stopping instance" {
+		t.Errorf("steps = %v", menu.progress.steps)
+	}
+	if got := menu.StatusLine(); got != "Running Resize • Esc to close" {
+		t.Errorf("StatusLine() = %q", got)
+	}
+
+	// Action keys are ignored while the tracked action runs
+	menu.Update(tea.KeyPressMsg{Text: "T", Code: 'T'})
+	if menu.confirming {
+		t.Error("Expected shortcut to be ignored while running")
+	}
+
+	menu.Update(cmd())
+	if menu.progress.running {
+		t.Error("Expected progress to stop after the Done update")
+	}
+	if menu.result == nil || !menu.result.Success || menu.result.Message != "Resized i-123" {
+		t.Errorf("result = %+v, want final success", menu.result)
+	}
+}
+
+func TestActionMenuTrackedProgressIgnoresStaleChannel(t *testing.T) {
+	menu := NewActionMenu(context.Background(), &mockResource{id: "i-123", name: "test"}, "test", "items")
+	current := make(chan action.ProgressUpdate)
+	menu.progress = progressState{ch: current, running: true}
+
+	stale := make(chan action.ProgressUpdate)
+	menu.Update(actionProgressMsg{ch: stale, update: action.ProgressUpdate{Step: "old"}, ok: true})
+	if len(menu.progress.steps) != 0 || !menu.progress.running {
+		t.Errorf("stale update changed progress: %+v", menu.progress)
+	}
+}
+
+func TestActionMenuInputChoices(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "vol-1", name: "data"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:      "Attach",
+		Shortcut:  "A",
+		Type:      action.ActionTypeAPI,
+		Operation: "Attach",
+		Confirm:   action.ConfirmSimple,
+		Input: &action.InputSpec{
+			Label: "Instance",
+			Choices: func(context.Context, dao.Resource) ([]action.Choice, error) {
+				return []action.Choice{
+					{Value: "i-111", Label: "i-111 web"},
+					{Value: "i-222", Label: "i-222 db"},
+					{Value: "i-333", Label: "i-333 worker"},
+				}, nil
+			},
+		},
+	}}
+
+	_, cmd := menu.Update(tea.KeyPressMsg{Text: "A", Code: 'A'})
+	if !menu.input.picking || !menu.input.loading {
+		t.Fatal("Expected picker to start loading choices")
+	}
+	if cmd == nil {
+		t.Fatal("Expected a command to load choices")
+	}
+	menu.Update(choicesLoadedMsg{choices: []action.Choice{
+		{Value: "i-111", Label: "i-111 web"},
+		{Value: "i-222", Label: "i-222 db"},
+		{Value: "i-333", Label: "i-333 worker"},
+	}})
+	if menu.input.loading || len(menu.input.choices) != 3 {
+		t.Fatalf("choices not loaded: %+v", menu.input)
+	}
+
+	// Typing filters, then down/up move within the filtered list
+	for _, r := range "w" {
+		menu.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+	if got := len(menu.filteredChoices()); got != 2 {
+		t.Fatalf("filtered choices = %d, want 2", got)
+	}
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	if menu.input.choiceCursor != 1 {
+		t.Errorf("choiceCursor = %d, want 1 (clamped)", menu.input.choiceCursor)
+	}
+
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if menu.input.active {
+		t.Error("Expected picker to close after selection")
+	}
+	if !slices.Equal(menu.input.values, []string{"i-333"}) {
+		t.Errorf("input values = %q, want i-333", menu.input.values)
+	}
+	if !menu.confirming {
+		t.Error("Expected simple confirmation after picking")
+	}
+}
+
+func TestActionMenuChainedInput(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "job-1", name: "nightly"}
+
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:      "Create Job",
+		Shortcut:  "n",
+		Type:      action.ActionTypeAPI,
+		Operation: "CreateJob",
+		Confirm:   action.ConfirmSimple,
+		Input: &action.InputSpec{
+			Label: "Bucket",
+			Then: &action.InputSpec{
+				Label:   "Schedule",
+				Choices: func(context.Context, dao.Resource) ([]action.Choice, error) { return nil, nil },
+			},
+		},
+	}}
+
+	menu.Update(tea.KeyPressMsg{Text: "n", Code: 'n'})
+	for _, r := range "logs" {
+		menu.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+	_, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !menu.input.active || menu.input.spec.Label != "Schedule" || !menu.input.picking {
+		t.Fatalf("Expected the chained Schedule picker, got %+v", menu.input)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a command to load the chained prompt's choices")
+	}
+	if !strings.Contains(menu.ViewString(), "Bucket: logs") {
+		t.Error("Expected earlier answers to be shown above the chained prompt")
+	}
+
+	menu.Update(choicesLoadedMsg{choices: []action.Choice{{Value: "DAILY", Label: "Daily"}}})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if menu.input.active || !menu.confirming {
+		t.Fatal("Expected confirmation after the last prompt")
+	}
+	if !slices.Equal(menu.input.values, []string{"logs", "DAILY"}) {
+		t.Errorf("input values = %q", menu.input.values)
+	}
+	if view := menu.ViewString(); !strings.Contains(view, "Bucket: logs") || !strings.Contains(view, "Schedule: DAILY") {
+		t.Errorf("Expected every answer in the confirmation, got %q", view)
+	}
+}
+
+func TestActionMenuNextInput(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "i-1", name: "web"}
+
+	// One prompt per parameter of the picked document, then the end
+	params := map[string][]string{"Restart": {"service"}}
+	var next func(context.Context, dao.Resource, []string) (*action.InputSpec, error)
+	next = func(_ context.Context, _ dao.Resource, values []string) (*action.InputSpec, error) {
+		names := params[values[0]]
+		if i := len(values) - 1; i < len(names) {
+			return &action.InputSpec{Label: names[i], Next: next}, nil
+		}
+		return nil, nil
+	}
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:      "Run Command",
+		Shortcut:  "c",
+		Type:      action.ActionTypeAPI,
+		Operation: "RunCommand",
+		Confirm:   action.ConfirmSimple,
+		Input:     &action.InputSpec{Label: "Document", Next: next},
+	}}
+
+	menu.Update(tea.KeyPressMsg{Text: "c", Code: 'c'})
+	for _, r := range "Restart" {
+		menu.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+	_, cmd := menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil || !menu.input.loading {
+		t.Fatal("Expected Next to load the following prompt")
+	}
+	if !strings.Contains(menu.ViewString(), "Loading") {
+		t.Error("Expected a loading hint while the next prompt loads")
+	}
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter}) // ignored while loading
+
+	menu.Update(cmd())
+	if !menu.input.active || menu.input.spec.Label != "service" {
+		t.Fatalf("Expected the service prompt, got %+v", menu.input)
+	}
+	for _, r := range "nginx" {
+		menu.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+	_, cmd = menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	menu.Update(cmd())
+	if menu.input.active || !menu.confirming {
+		t.Fatal("Expected confirmation once Next returns no prompt")
+	}
+	if !slices.Equal(menu.input.values, []string{"Restart", "nginx"}) {
+		t.Errorf("input values = %q", menu.input.values)
+	}
+	if view := menu.ViewString(); !strings.Contains(view, "Document: Restart") || !strings.Contains(view, "service: nginx") {
+		t.Errorf("Expected every answer in the confirmation, got %q", view)
+	}
+}
+
+func TestActionMenuRunCache(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "doc-1", name: "doc-1"}
+
+	// The prompts and the executor look up the same parameters; one load serves the run
+	loads := 0
+	params := func(ctx context.Context) ([]string, error) {
+		return action.Cached(ctx, "params", func() ([]string, error) {
+			loads++
+			return []string{"Service", "Mode"}, nil
+		})
+	}
+	next := func(ctx context.Context, _ dao.Resource, values []string) (*action.InputSpec, error) {
+		names, err := params(ctx)
+		if err != nil || len(values) >= len(names) {
+			return nil, err
+		}
+		return &action.InputSpec{Label: names[len(values)], Optional: true}, nil
+	}
+	action.RegisterExecutor("runcache", "docs", func(ctx context.Context, _ action.Action, _ dao.Resource) action.ActionResult {
+		if _, err := params(ctx); err != nil {
+			return action.FailResult(err)
+		}
+		return action.SuccessResult("ran")
+	})
+	menu := NewActionMenu(ctx, resource, "runcache", "docs")
+	menu.actions = []action.Action{{
+		Name:      "Run",
+		Shortcut:  "r",
+		Type:      action.ActionTypeAPI,
+		Operation: "RunDocument",
+		Confirm:   action.ConfirmSimple,
+		Input:     &action.InputSpec{Next: next},
+	}}
+
+	run := func() {
+		_, cmd := menu.Update(tea.KeyPressMsg{Text: "r", Code: 'r'})
+		for menu.input.active {
+			if menu.input.loading {
+				menu.Update(cmd())
+				continue
+			}
+			_, cmd = menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			if cmd == nil || !menu.input.loading {
+				break
+			}
+		}
+		if !menu.confirming {
+			t.Fatal("Expected confirmation after the last prompt")
+		}
+		menu.Update(tea.KeyPressMsg{Text: "y", Code: 'y'})
+		if menu.result == nil || !menu.result.Success {
+			t.Fatalf("result = %+v", menu.result)
+		}
+	}
+
+	run()
+	if loads != 1 {
+		t.Errorf("loads in one run = %d, want 1", loads)
+	}
+	menu.result = nil
+	run()
+	if loads != 2 {
+		t.Errorf("loads after a second run = %d, want 2", loads)
+	}
+}
+
+func TestActionMenuDeferredInput(t *testing.T) {
+	ctx := context.Background()
+	resource := &mockResource{id: "AWS-RestartEC2Instance", name: "AWS-RestartEC2Instance"}
+
+	// The form is only the document's parameters; the action asks nothing itself
+	next := func(_ context.Context, _ dao.Resource, values []string) (*action.InputSpec, error) {
+		if len(values) == 0 {
+			return &action.InputSpec{Label: "InstanceId"}, nil
+		}
+		return nil, nil
+	}
+	menu := NewActionMenu(ctx, resource, "test", "items")
+	menu.actions = []action.Action{{
+		Name:      "Start Automation",
+		Shortcut:  "s",
+		Type:      action.ActionTypeAPI,
+		Operation: "StartAutomation",
+		Confirm:   action.ConfirmSimple,
+		Input:     &action.InputSpec{Next: next},
+	}}
+
+	_, cmd := menu.Update(tea.KeyPressMsg{Text: "s", Code: 's'})
+	if cmd == nil || !menu.input.loading {
+		t.Fatal("Expected a deferred input to load its first prompt")
+	}
+	menu.Update(cmd())
+	if !menu.input.active || menu.input.spec.Label != "InstanceId" {
+		t.Fatalf("Expected the InstanceId prompt, got %+v", menu.input)
+	}
+	for _, r := range "i-1" {
+		menu.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if menu.input.active || !menu.confirming {
+		t.Fatal("Expected confirmation after the last prompt")
+	}
+	if !slices.Equal(menu.input.values, []string{"i-1"}) {
+		t.Errorf("input values = %q", menu.input.values)
+	}
+}
+
+func TestActionMenuStart(t *testing.T) {
+	action.Global.Register("starttest", "widgets", []action.Action{
+		{Name: "Stop", Type: action.ActionTypeAPI, Operation: "StopWidget", Confirm: action.ConfirmSimple},
+		{Name: "Delete", Type: action.ActionTypeAPI, Operation: "DeleteWidget", Confirm: action.ConfirmDangerous},
+	})
+	resource := &mockResource{id: "w-12345", name: "widget"}
+
+	menu := NewActionMenu(context.Background(), resource, "starttest", "widgets")
+	if _, ok := menu.Start("delete"); !ok {
+		t.Fatal("Start() should find the action case-insensitively")
+	}
+	if menu.cursor != 1 || !menu.dangerous.active {
+		t.Errorf("Start() should select the action and ask for the dangerous confirmation, cursor=%d dangerous=%v", menu.cursor, menu.dangerous.active)
+	}
+
+	menu = NewActionMenu(context.Background(), resource, "starttest", "widgets")
+	if _, ok := menu.Start("Stop"); !ok || !menu.confirming {
+		t.Errorf("Start() should ask for the simple confirmation, confirming=%v", menu.confirming)
+	}
+
+	if _, ok := menu.Start("Explode"); ok {
+		t.Error("Start() should report unknown actions")
+	}
+
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+	menu = NewActionMenu(context.Background(), resource, "starttest", "widgets")
+	if _, ok := menu.Start("Stop"); ok {
+		t.Error("Start() should not offer actions blocked in read-only mode")
+	}
+}
+
+func TestActionMenuStartWithInputs(t *testing.T) {
+	action.Global.Register("startinputtest", "widgets", []action.Action{{
+		Name:      "Move",
+		Type:      action.ActionTypeAPI,
+		Operation: "MoveWidget",
+		Confirm:   action.ConfirmSimple,
+		Input:     &action.InputSpec{Label: "Destination"},
+	}})
+	resource := &mockResource{id: "w-12345", name: "widget"}
+
+	menu := NewActionMenu(context.Background(), resource, "startinputtest", "widgets")
+	if _, ok := menu.StartWithInputs("Move", []string{"shelf-2"}, []string{"Destination (Shelf 2)"}); !ok {
+		t.Fatal("StartWithInputs() should find the action")
+	}
+	if menu.input.active || !menu.confirming {
+		t.Fatalf("StartWithInputs() should skip the prompt and confirm, input=%v confirming=%v", menu.input.active, menu.confirming)
+	}
+	if view := menu.ViewString(); !strings.Contains(view, "Destination (Shelf 2): shelf-2") {
+		t.Errorf("confirmation should show the preset input, got:\n%s", view)
+	}
+}
@@ -0,0 +1,217 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// maxProfiledCalls is how many of the slowest calls :profile lists
+const maxProfiledCalls = 100
+
+type callProfileStyles struct {
+	header lipgloss.Style
+	status lipgloss.Style
+	dim    lipgloss.Style
+}
+
+func newCallProfileStyles() callProfileStyles {
+	return callProfileStyles{
+		header: ui.TableHeaderStyle().Padding(0, 1),
+		status: ui.DimStyle().Padding(0, 1),
+		dim:    ui.DimStyle(),
+	}
+}
+
+// CallProfileView lists the slowest recent AWS API calls and DAO lists, to
+// help report performance issues.
+type CallProfileView struct {
+	ctx   context.Context
+	calls []aws.CallTiming
+
+	tc           TableCursor
+	tableContent string
+	width        int
+	height       int
+	styles       callProfileStyles
+}
+
+// NewCallProfileView creates a CallProfileView
+func NewCallProfileView(ctx context.Context) *CallProfileView {
+	v := &CallProfileView{ctx: ctx, styles: newCallProfileStyles()}
+	v.load()
+	return v
+}
+
+func (v *CallProfileView) Init() tea.Cmd {
+	return nil
+}
+
+func (v *CallProfileView) load() {
+	v.calls = aws.SlowestCalls(maxProfiledCalls)
+	v.buildTable()
+}
+
+func (v *CallProfileView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ThemeChangedMsg:
+		v.styles = newCallProfileStyles()
+		v.buildTable()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		n := len(v.calls)
+		switch msg.String() {
+		case "ctrl+r":
+			v.load()
+			return v, nil
+		case "c":
+			aws.ResetCallTimings()
+			v.load()
+			return v, nil
+		case "y":
+			if n == 0 {
+				return v, nil
+			}
+			return v, clipboard.Copy("slow calls", callProfileReport(v.calls))
+		case "j", "down":
+			v.tc.SetCursor(v.tc.Cursor()+1, n)
+		case "k", "up":
+			v.tc.SetCursor(v.tc.Cursor()-1, n)
+		case "ctrl+d", "pgdown":
+			v.tc.SetCursor(v.tc.Cursor()+v.tc.TableHeight()/2, n)
+		case "ctrl+u", "pgup":
+			v.tc.SetCursor(v.tc.Cursor()-v.tc.TableHeight()/2, n)
+		case "g", "home":
+			v.tc.SetCursor(0, n)
+		case "G", "end":
+			v.tc.SetCursor(n-1, n)
+		default:
+			return v, nil
+		}
+		v.tc.UpdateScrollOffset(n)
+		v.buildTable()
+	}
+	return v, nil
+}
+
+// formatCallDuration renders e.g. "1.234s" or "87ms"
+func formatCallDuration(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return d.Round(time.Millisecond).String()
+}
+
+// callProfileReport renders calls as plain text for pasting into an issue
+func callProfileReport(calls []aws.CallTiming) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-10s %-44s %-16s %-8s %s\n", "DURATION", "CALL", "REGION", "RETRIES", "ERROR")
+	for _, c := range calls {
+		fmt.Fprintf(&b, "%-10s %-44s %-16s %-8d %s\n", formatCallDuration(c.Duration), c.Call, c.Region, c.Retries, c.Err)
+	}
+	return b.String()
+}
+
+func (v *CallProfileView) buildTable() {
+	v.tc.SetCursor(v.tc.Cursor(), len(v.calls))
+	if len(v.calls) == 0 {
+		v.tableContent = ""
+		return
+	}
+
+	headers := []string{"DURATION", "CALL", "PROFILE", "REGION", "RETRIES", "WHEN", "ERROR"}
+	tableHeight := max(v.height-1, 1)
+	v.tc.SetTableHeight(tableHeight)
+	tableWidth := v.width
+	if tableWidth < 80 {
+		tableWidth = 120
+	}
+	fixed := 10 + 40 + 18 + 16 + 8 + 8
+	widths := []int{10, 40, 18, 16, 8, 8, max(tableWidth-fixed, 20)}
+
+	t := table.New().
+		Headers(headers...).
+		Width(tableWidth).
+		Height(tableHeight).
+		Wrap(false).
+		BorderTop(false).
+		BorderBottom(false).
+		BorderLeft(false).
+		BorderRight(false).
+		BorderColumn(false).
+		BorderHeader(true).
+		BorderStyle(TableBorderStyle()).
+		StyleFunc(NewTableStyleFunc(widths, v.tc.Cursor()))
+
+	for _, c := range v.calls {
+		retries := ""
+		if c.Retries > 0 {
+			retries = fmt.Sprintf("%d", c.Retries)
+		}
+		t = t.Row(
+			formatCallDuration(c.Duration),
+			c.Call,
+			c.Profile,
+			c.Region,
+			retries,
+			render.FormatAge(c.At)+" ago",
+			c.Err,
+		)
+	}
+	if v.tc.ScrollOffset() > 0 {
+		t = t.YOffset(v.tc.ScrollOffset())
+	}
+	v.tableContent = t.String()
+}
+
+func (v *CallProfileView) ViewString() string {
+	header := v.styles.header.Width(v.width).Render("Slowest Calls")
+	if len(v.calls) == 0 {
+		return header + "\n" + v.styles.dim.Render("No AWS calls recorded yet")
+	}
+	status := v.styles.status.Render(fmt.Sprintf("%d slowest of recent calls (API calls as service:Operation, resource lists as service/type)", len(v.calls)))
+	return header + "\n" + status + "\n" + v.tableContent
+}
+
+func (v *CallProfileView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *CallProfileView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height - 2 // header and status lines
+	v.buildTable()
+	return nil
+}
+
+func (v *CallProfileView) StatusLine() string {
+	return fmt.Sprintf("profile • %d calls • y:copy report • c:clear • ctrl+r:reload • q/esc:back", len(v.calls))
+}
+
+// ItemCount implements ItemCounter
+func (v *CallProfileView) ItemCount() (shown, total int) {
+	return len(v.calls), len(v.calls)
+}
+
+// KeyHelp implements KeyHelper
+func (v *CallProfileView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{Title: "Profile", Bindings: []KeyBinding{
+		{"↑/k, ↓/j", "Move cursor up/down"},
+		{"g, G", "Go to top / bottom"},
+		{"y", "Copy the list as a plain-text report"},
+		{"c", "Forget recorded calls"},
+		{"Ctrl+r", "Reload"},
+		{"Esc", "Back"},
+	}}}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/clawscli/claws/internal/ai"
 	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
 	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/registry"
@@ -27,9 +28,11 @@ type chatStyles struct {
 	assistantMsg lipgloss.Style
 	toolCall     lipgloss.Style
 	toolError    lipgloss.Style
+	proposal     lipgloss.Style
 	thinking     lipgloss.Style
 	input        lipgloss.Style
 	errorMsg     lipgloss.Style
+	warning      lipgloss.Style
 	mdBold       lipgloss.Style
 	mdCode       lipgloss.Style
 	mdItalic     lipgloss.Style
@@ -43,9 +46,11 @@ func newChatStyles() chatStyles {
 		assistantMsg: ui.SecondaryStyle(),
 		toolCall:     ui.DimStyle(),
 		toolError:    ui.DangerStyle(),
+		proposal:     ui.SelectedStyle().Padding(0, 1),
 		thinking:     ui.DimItalicStyle(),
 		input:        ui.ChatInputStyle(),
 		errorMsg:     ui.DangerStyle(),
+		warning:      ui.WarningStyle(),
 		mdBold:       ui.TitleStyle(),
 		mdCode:       ui.SuccessStyle(),
 		mdItalic:     ui.ItalicStyle(),
@@ -62,6 +67,8 @@ type ChatOverlay struct {
 	executor *ai.ToolExecutor
 	session  *ai.Session
 	sessMgr  *ai.SessionManager
+	model    string
+	month    ai.MonthlyUsage // Month-to-date spend, tracked when ai.monthly_budget is set
 
 	input textinput.Model
 	vp    ViewportState
@@ -73,6 +80,7 @@ type ChatOverlay struct {
 	collapsedToolCalls map[int]bool
 	thinkingLineRanges map[int][2]int
 	toolCallLineRanges map[int][2]int
+	proposalLineRanges map[int][2]int
 	isStreaming        bool
 	err                error
 
@@ -109,6 +117,7 @@ type chatMessage struct {
 	toolUse         *ai.ToolUseContent
 	toolResult      *ai.ToolResultContent
 	toolError       bool
+	proposal        *ai.ActionProposal // Action suggested via propose_action, run on click
 }
 
 type chatStreamMsg struct {
@@ -124,10 +133,20 @@ type chatToolExecuteMsg struct {
 	toolRound       int
 }
 
+// chatProposalMsg carries the resource a proposed action runs on, fetched
+// when the user picks the proposal.
+type chatProposalMsg struct {
+	proposal ai.ActionProposal
+	ctx      context.Context
+	resource dao.Resource
+	err      error
+}
+
 type chatInitMsg struct {
 	client   *ai.Client
 	executor *ai.ToolExecutor
 	session  *ai.Session
+	month    ai.MonthlyUsage
 	err      error
 }
 
@@ -146,6 +165,7 @@ func NewChatOverlay(ctx context.Context, reg *registry.Registry, aiCtx *ai.Conte
 		styles:             newChatStyles(),
 		input:              ti,
 		sessMgr:            ai.NewSessionManager(cfg.GetAIMaxSessions(), cfg.GetAISaveSessions()),
+		model:              cfg.GetAIModel(),
 		messages:           []chatMessage{},
 		collapsedThinking:  make(map[int]bool),
 		collapsedToolCalls: make(map[int]bool),
@@ -160,14 +180,14 @@ func (c *ChatOverlay) Init() tea.Cmd {
 }
 
 func (c *ChatOverlay) initClient() tea.Msg {
-	executor, err := ai.NewToolExecutor(c.ctx, c.registry)
+	executor, err := ai.NewToolExecutor(c.ctx, c.registry, ai.WithActionProposals())
 	if err != nil {
 		return chatInitMsg{err: apperrors.Wrap(err, "init tool executor")}
 	}
 
 	client, err := ai.NewClient(
 		c.ctx,
-		ai.WithModel(config.File().GetAIModel()),
+		ai.WithModel(c.model),
 		ai.WithTools(executor.Tools()),
 		ai.WithMaxTokens(config.File().GetAIMaxTokens()),
 		ai.WithThinkingBudget(config.File().GetAIThinkingBudget()),
@@ -181,7 +201,14 @@ func (c *ChatOverlay) initClient() tea.Msg {
 		return chatInitMsg{err: apperrors.Wrap(err, "create session")}
 	}
 
-	return chatInitMsg{client: client, executor: executor, session: session}
+	var month ai.MonthlyUsage
+	if config.File().GetAIMonthlyBudget() > 0 {
+		if month, err = ai.LoadMonthlyUsage(time.Now()); err != nil {
+			log.Warn("failed to load monthly AI usage", "error", err)
+		}
+	}
+
+	return chatInitMsg{client: client, executor: executor, session: session, month: month}
 }
 
 func (c *ChatOverlay) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -197,6 +224,7 @@ func (c *ChatOverlay) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			c.client = msg.client
 			c.executor = msg.executor
 			c.session = msg.session
+			c.month = msg.month
 		}
 		return c, nil
 
@@ -209,6 +237,9 @@ func (c *ChatOverlay) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case chatToolExecuteMsg:
 		return c.handleToolExecute(msg)
 
+	case chatProposalMsg:
+		return c.handleProposal(msg)
+
 	case tea.MouseClickMsg:
 		return c.handleMouseClick(msg)
 	}
@@ -249,6 +280,38 @@ func (c *ChatOverlay) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		return c, func() tea.Msg { return HideModalMsg{} }
 	case "ctrl+h":
 		return c.showHistory()
+	case "ctrl+t":
+		toggleAllCollapsed(c.collapsedThinking, c.thinkingLineRanges)
+		c.updateViewport()
+		return c, nil
+	case "ctrl+o":
+		toggleAllCollapsed(c.collapsedToolCalls, c.toolCallLineRanges)
+		c.updateViewport()
+		return c, nil
+	case "ctrl+s":
+		c.exportSession()
+		return c, nil
+	case "ctrl+y":
+		for i := len(c.messages) - 1; i >= 0; i-- {
+			if p := c.messages[i].proposal; p != nil {
+				return c, c.runProposal(*p)
+			}
+		}
+		return c, nil
+	case "ctrl+g":
+		if c.contextLine() != "" {
+			c.contextExpanded = !c.contextExpanded
+			c.updateViewport()
+		}
+		return c, nil
+	case "?":
+		// The prompt always has focus, so "?" only opens help when it is empty
+		if c.input.Value() == "" {
+			help := NewHelpView(c)
+			return c, func() tea.Msg {
+				return ShowModalMsg{Modal: &Modal{Content: help, Width: ModalWidthHelp}}
+			}
+		}
 	case "enter":
 		if c.isStreaming {
 			return c, nil
@@ -258,6 +321,11 @@ func (c *ChatOverlay) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		if text == "" {
 			return c, nil
 		}
+		if err := c.budgetErr(); err != nil {
+			c.err = err
+			c.updateViewport()
+			return c, nil
+		}
 
 		c.input.SetValue("")
 		c.messages = append(c.messages, chatMessage{role: ai.RoleUser, content: text})
@@ -289,8 +357,23 @@ func (c *ChatOverlay) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	return c, kpCmd
 }
 
+// toggleAllCollapsed is the keyboard equivalent of clicking each rendered
+// block: it expands all blocks if any is collapsed, otherwise collapses all.
+func toggleAllCollapsed(collapsed map[int]bool, rendered map[int][2]int) {
+	expand := false
+	for idx := range rendered {
+		if collapsed[idx] {
+			expand = true
+			break
+		}
+	}
+	for idx := range rendered {
+		collapsed[idx] = !expand
+	}
+}
+
 func (c *ChatOverlay) handleMouseClick(msg tea.MouseClickMsg) (tea.Model, tea.Cmd) {
-	if c.aiCtx != nil && c.aiCtx.Service != "" && msg.Y == 1 {
+	if c.contextLine() != "" && msg.Y == 1 {
 		c.contextExpanded = !c.contextExpanded
 		c.updateViewport()
 		return c, nil
@@ -316,6 +399,12 @@ func (c *ChatOverlay) handleMouseClick(msg tea.MouseClickMsg) (tea.Model, tea.Cm
 		}
 	}
 
+	for msgIdx, lineRange := range c.proposalLineRanges {
+		if contentLine >= lineRange[0] && contentLine < lineRange[1] {
+			return c, c.runProposal(*c.messages[msgIdx].proposal)
+		}
+	}
+
 	for msgIdx, lineRange := range c.toolCallLineRanges {
 		if contentLine >= lineRange[0] && contentLine < lineRange[1] {
 			wasCollapsed := c.collapsedToolCalls[msgIdx]
@@ -397,6 +486,9 @@ func (c *ChatOverlay) handleStreamEvent(msg chatStreamMsg) (tea.Model, tea.Cmd)
 		return c, c.waitForStream(msg.eventCh)
 
 	case "done":
+		if event.Usage != nil {
+			c.recordUsage(*event.Usage)
+		}
 		return c.handleStreamDone(msg.eventCh)
 
 	case "error":
@@ -512,6 +604,14 @@ func (c *ChatOverlay) handleStreamDone(_ <-chan ai.StreamEvent) (tea.Model, tea.
 }
 
 func (c *ChatOverlay) handleToolExecute(msg chatToolExecuteMsg) (tea.Model, tea.Cmd) {
+	// The next round is another Bedrock call, so stop once the budget is spent
+	if err := c.budgetErr(); err != nil {
+		c.err = err
+		c.isStreaming = false
+		c.updateViewport()
+		return c, nil
+	}
+
 	maxCalls := config.File().GetAIMaxToolCallsPerQuery()
 
 	// Execute each tool and collect results
@@ -536,6 +636,11 @@ func (c *ChatOverlay) handleToolExecute(msg chatToolExecuteMsg) (tea.Model, tea.
 			toolError:  result.IsError,
 		})
 		c.collapsedToolCalls[len(c.messages)-1] = true
+
+		if tu.Name == ai.ToolProposeAction && !result.IsError {
+			proposal := ai.ProposalFromInput(tu.Input)
+			c.messages = append(c.messages, chatMessage{role: ai.RoleAssistant, proposal: &proposal})
+		}
 	}
 	c.updateViewport()
 
@@ -570,6 +675,118 @@ func (c *ChatOverlay) handleToolExecute(msg chatToolExecuteMsg) (tea.Model, tea.
 	return c, c.startStream(messages)
 }
 
+// exportSession writes the session, tool calls included, as Markdown under
+// the config directory and reports the path in the status line.
+func (c *ChatOverlay) exportSession() {
+	c.statusMsgTime = time.Now()
+	if c.session == nil || len(c.session.Messages) == 0 {
+		c.statusMsg = "Nothing to export yet"
+		return
+	}
+	path, err := ai.SaveExport(c.session)
+	if err != nil {
+		log.Warn("failed to export chat session", "error", err)
+		c.statusMsg = "Export failed: " + err.Error()
+		return
+	}
+	c.statusMsg = "Exported to " + path
+}
+
+// recordUsage adds a response's tokens and estimated cost to the session and,
+// when a monthly budget is set, to the month-to-date total.
+func (c *ChatOverlay) recordUsage(usage ai.Usage) {
+	cost := usage.Cost(c.model)
+	if c.session != nil {
+		if err := c.sessMgr.AddUsage(c.session, usage, cost); err != nil {
+			log.Warn("failed to save session usage", "error", err)
+		}
+	}
+	if config.File().GetAIMonthlyBudget() > 0 {
+		month, err := ai.RecordMonthlyUsage(usage, cost, time.Now())
+		if err != nil {
+			log.Warn("failed to record monthly AI usage", "error", err)
+		}
+		c.month = month
+	}
+}
+
+// budgetErr returns an error once the month's estimated spend reaches
+// ai.monthly_budget, disabling further requests.
+func (c *ChatOverlay) budgetErr() error {
+	budget := config.File().GetAIMonthlyBudget()
+	if budget <= 0 || c.month.Cost < budget {
+		return nil
+	}
+	return fmt.Errorf("monthly AI budget of %s reached (%s estimated for %s); raise ai.monthly_budget to continue",
+		ai.FormatCost(budget), ai.FormatCost(c.month.Cost), c.month.Month)
+}
+
+// budgetWarnRatio is the share of the monthly budget after which the header
+// warns.
+const budgetWarnRatio = 0.8
+
+// usageHint renders the session's token usage and cost for the header, plus
+// the month-to-date spend when a budget is set.
+func (c *ChatOverlay) usageHint() string {
+	var parts []string
+	if c.session != nil && c.session.Usage != (ai.Usage{}) {
+		hint := c.session.Usage.String()
+		if _, _, ok := ai.ModelPrice(c.model); ok {
+			hint += " · " + ai.FormatCost(c.session.Cost)
+		}
+		parts = append(parts, c.styles.context.Render(hint))
+	}
+	if budget := config.File().GetAIMonthlyBudget(); budget > 0 {
+		month := fmt.Sprintf("%s/%s this month", ai.FormatCost(c.month.Cost), ai.FormatCost(budget))
+		if c.month.Cost >= budget*budgetWarnRatio {
+			parts = append(parts, c.styles.warning.Render("⚠ "+month))
+		} else {
+			parts = append(parts, c.styles.context.Render(month))
+		}
+	}
+	return strings.Join(parts, c.styles.context.Render(" · "))
+}
+
+// runProposal fetches the resource a proposed action targets. The action
+// itself only runs once the user confirms it in the action menu.
+func (c *ChatOverlay) runProposal(p ai.ActionProposal) tea.Cmd {
+	ctx, reg := p.Context(c.ctx), c.registry
+	return func() tea.Msg {
+		d, err := reg.GetDAO(ctx, p.Service, p.ResourceType)
+		if err != nil {
+			return chatProposalMsg{proposal: p, err: err}
+		}
+		resource, err := d.Get(ctx, p.ID)
+		if err != nil {
+			return chatProposalMsg{proposal: p, err: err}
+		}
+		return chatProposalMsg{proposal: p, ctx: ctx, resource: dao.UnwrapResource(resource)}
+	}
+}
+
+// handleProposal opens the action menu on the proposed action, so it goes
+// through the same input prompts, confirmation and read-only checks as
+// picking it by hand.
+func (c *ChatOverlay) handleProposal(msg chatProposalMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		c.statusMsg = fmt.Sprintf("Cannot load %s/%s %s: %v", msg.proposal.Service, msg.proposal.ResourceType, msg.proposal.ID, msg.err)
+		c.statusMsgTime = time.Now()
+		return c, nil
+	}
+
+	menu := NewActionMenu(msg.ctx, msg.resource, msg.proposal.Service, msg.proposal.ResourceType)
+	cmd, ok := menu.Start(msg.proposal.Action)
+	if !ok {
+		c.statusMsg = fmt.Sprintf("%s is not available for this resource", msg.proposal.Action)
+		c.statusMsgTime = time.Now()
+		return c, nil
+	}
+	show := func() tea.Msg {
+		return ShowModalMsg{Modal: &Modal{Content: menu, Width: ModalWidthActionMenu}}
+	}
+	return c, tea.Sequence(show, cmd)
+}
+
 func (c *ChatOverlay) View() tea.View {
 	return tea.NewView(c.ViewString())
 }
@@ -583,6 +800,9 @@ func (c *ChatOverlay) ViewString() string {
 
 	title := c.styles.title.Render("AI Chat")
 	hint := c.styles.context.Render("Ctrl+h: history")
+	if usage := c.usageHint(); usage != "" {
+		hint = usage + c.styles.context.Render("  ") + hint
+	}
 	titleWidth := lipgloss.Width(title)
 	hintWidth := lipgloss.Width(hint)
 	padding := c.width - titleWidth - hintWidth
@@ -592,18 +812,11 @@ func (c *ChatOverlay) ViewString() string {
 	sb.WriteString(title + strings.Repeat(" ", padding) + hint)
 	sb.WriteString("\n")
 
-	if c.aiCtx != nil && c.aiCtx.Service != "" {
+	if ctx := c.contextLine(); ctx != "" {
 		indicator := "▶"
 		if c.contextExpanded {
 			indicator = "▼"
 		}
-		ctx := fmt.Sprintf("Context: %s", c.aiCtx.Service)
-		if c.aiCtx.ResourceType != "" {
-			ctx += "/" + c.aiCtx.ResourceType
-		}
-		if c.aiCtx.ResourceName != "" {
-			ctx += " - " + c.aiCtx.ResourceName
-		}
 		ctx += " [" + indicator + "]"
 		sb.WriteString(c.styles.context.Render(ctx))
 		sb.WriteString("\n")
@@ -646,18 +859,36 @@ func (c *ChatOverlay) StatusLine() string {
 
 func (c *ChatOverlay) headerHeight() int {
 	lines := 2
-	if c.aiCtx != nil && c.aiCtx.Service != "" {
-		ctx := fmt.Sprintf("Context: %s", c.aiCtx.Service)
+	if ctx := c.contextLine(); ctx != "" {
+		rendered := c.styles.context.Render(ctx)
+		lines += strings.Count(rendered, "\n") + 1
+	}
+	return lines
+}
+
+// contextLine names the chat's resource context for the header, or is empty
+// when the chat has none.
+func (c *ChatOverlay) contextLine() string {
+	if c.aiCtx == nil {
+		return ""
+	}
+	var ctx string
+	if c.aiCtx.Service != "" {
+		ctx = fmt.Sprintf("Context: %s", c.aiCtx.Service)
 		if c.aiCtx.ResourceType != "" {
 			ctx += "/" + c.aiCtx.ResourceType
 		}
 		if c.aiCtx.ResourceName != "" {
 			ctx += " - " + c.aiCtx.ResourceName
 		}
-		rendered := c.styles.context.Render(ctx)
-		lines += strings.Count(rendered, "\n") + 1
 	}
-	return lines
+	if n := len(c.aiCtx.Pinned); n > 0 {
+		if ctx == "" {
+			ctx = "Context:"
+		}
+		ctx += fmt.Sprintf(" +%d pinned", n)
+	}
+	return ctx
 }
 
 func (c *ChatOverlay) HasActiveInput() bool {
@@ -740,8 +971,20 @@ func (c *ChatOverlay) loadSession(sess *ai.Session) (tea.Model, tea.Cmd) {
 	c.collapsedToolCalls = make(map[int]bool)
 	c.toolCallCount = 0 // Reset per-query counter
 
+	// Proposals are rebuilt from the saved propose_action calls, skipping
+	// those the tool rejected
+	rejected := make(map[string]bool)
+	for _, msg := range sess.Messages {
+		for _, block := range msg.Content {
+			if block.ToolResult != nil && block.ToolResult.IsError {
+				rejected[block.ToolResult.ToolUseID] = true
+			}
+		}
+	}
+
 	for _, msg := range sess.Messages {
 		cm := chatMessage{role: msg.Role}
+		var proposals []chatMessage
 		for _, block := range msg.Content {
 			if block.Text != "" {
 				cm.content = block.Text
@@ -749,8 +992,13 @@ func (c *ChatOverlay) loadSession(sess *ai.Session) (tea.Model, tea.Cmd) {
 			if block.Reasoning != "" {
 				cm.thinkingContent = block.Reasoning
 			}
+			if tu := block.ToolUse; tu != nil && tu.Name == ai.ToolProposeAction && !rejected[tu.ID] {
+				proposal := ai.ProposalFromInput(tu.Input)
+				proposals = append(proposals, chatMessage{role: ai.RoleAssistant, proposal: &proposal})
+			}
 		}
 		c.messages = append(c.messages, cm)
+		c.messages = append(c.messages, proposals...)
 		c.streamMessages = append(c.streamMessages, msg)
 	}
 
@@ -773,3 +1021,22 @@ func (c *ChatOverlay) newSession() (tea.Model, tea.Cmd) {
 	c.updateViewport()
 	return c, nil
 }
+
+// KeyHelp implements KeyHelper
+func (c *ChatOverlay) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{
+		Title: "AI Chat",
+		Bindings: []KeyBinding{
+			{"Enter", "Send message"},
+			{"Ctrl+h", "Session history"},
+			{"Ctrl+s", "Export session to Markdown"},
+			{"Ctrl+t", "Expand / collapse thinking"},
+			{"Ctrl+o", "Expand / collapse tool calls"},
+			{"Ctrl+g", "Show / hide resource context"},
+			{"Ctrl+y", "Run the latest proposed action (asks to confirm)"},
+			{"Click", "Expand / collapse a block, or run a proposed action"},
+			{"?", "Show this help (when the prompt is empty)"},
+			{"Esc, Ctrl+c", "Close chat (cancels streaming)"},
+		},
+	}}
+}
@@ -2,6 +2,7 @@ package view
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/clawscli/claws/internal/ai"
@@ -19,6 +20,9 @@ func formatProfileName(profileID string) string {
 
 func (c *ChatOverlay) buildSystemPrompt() string {
 	services := c.registry.ListServices()
+	if allowed := config.File().GetAITools().Services; len(allowed) > 0 {
+		services = slices.DeleteFunc(services, func(s string) bool { return !slices.Contains(allowed, s) })
+	}
 	serviceList := strings.Join(services, ", ")
 
 	prompt := fmt.Sprintf(`You are an AWS resource assistant in claws TUI.
@@ -34,17 +38,22 @@ All resource tools require a region parameter. Use profile parameter when queryi
 
 Available tools:
 - list_resources(service): Lists resource types for a service
-- query_resources(service, resource_type, region, profile?, limit?, offset?): Lists resources (default: 100, max: 2000, supports pagination)
+- query_resources(service, resource_type, region, profile?, filter?, limit?, offset?): Lists resources (default: 100, max: 2000, supports pagination). filter narrows by tag (key=value), name_contains and status; use it instead of listing everything and filtering yourself
 - get_resource_detail(service, resource_type, region, id, cluster?, profile?): Gets resource details
 - tail_logs(service, resource_type, region, id, cluster?, profile?): Fetches CloudWatch logs for a resource
   - Supported: lambda/functions, ecs/services, ecs/tasks, ecs/task-definitions, codebuild/projects, codebuild/builds, cloudtrail/trails, apigateway/stages, apigateway/stages-v2, stepfunctions/state-machines
   - cluster parameter required for ecs/services and ecs/tasks
 - search_aws_docs(query): Search AWS documentation
+- validate_policy(path, policy_type?, region?, profile?): Lints a local IAM policy file with Access Analyzer (policy_type: identity, resource, scp, rcp)
+- propose_action(service, resource_type, region, id, action, reason?, cluster?, profile?): Suggests a claws action (e.g., Force Deploy, Stop) shown to the user as a button
+  - Propose only when an action would address the user's request; never claim it has run
+  - The user reviews and confirms it; read-only mode rejects actions that change resources
 </tool_usage>
 
 <response_format>
 Be concise. Use markdown for formatting.
 </response_format>`, serviceList)
+	prompt += ai.ToolPolicyPrompt()
 
 	if c.aiCtx != nil {
 		if len(c.aiCtx.UserRegions) > 0 {
@@ -73,6 +82,7 @@ Be concise. Use markdown for formatting.
 		default:
 			prompt += c.buildSingleContextPrompt()
 		}
+		prompt += c.buildPinnedContextPrompt()
 	}
 
 	return prompt
@@ -160,6 +170,32 @@ func (c *ChatOverlay) buildSingleContextPrompt() string {
 	return prompt
 }
 
+func (c *ChatOverlay) buildPinnedContextPrompt() string {
+	if len(c.aiCtx.Pinned) == 0 {
+		return ""
+	}
+
+	prompt := "\n<pinned_resources>"
+	for _, p := range c.aiCtx.Pinned {
+		prompt += fmt.Sprintf("\nservice=%s, resource_type=%s, id=%s", p.Service, p.ResourceType, p.ID)
+		if p.Name != "" {
+			prompt += ", name=" + p.Name
+		}
+		if p.Region != "" {
+			prompt += ", region=" + p.Region
+		}
+		if p.Profile != "" {
+			prompt += ", profile=" + p.Profile
+		}
+		if p.Cluster != "" {
+			prompt += ", cluster=" + p.Cluster
+		}
+	}
+	prompt += "\n</pinned_resources>"
+	prompt += "\nThe user pinned these resources for this conversation. Consider them together with current_context, and call get_resource_detail with each resource's own region and profile when their details matter."
+	return prompt
+}
+
 func (c *ChatOverlay) renderContextParams() string {
 	ctx := c.aiCtx
 	if ctx == nil {
@@ -225,6 +261,9 @@ func (c *ChatOverlay) renderContextParams() string {
 		}
 		lines = append(lines, fmt.Sprintf("  show_resolved: %s", showResolved))
 	}
+	for _, p := range ctx.Pinned {
+		lines = append(lines, fmt.Sprintf("  pinned: %s", p))
+	}
 
 	return strings.Join(lines, "\n") + "\n"
 }
@@ -26,6 +26,7 @@ func (c *ChatOverlay) renderMessages() string {
 	lineNum := 0
 	c.thinkingLineRanges = make(map[int][2]int)
 	c.toolCallLineRanges = make(map[int][2]int)
+	c.proposalLineRanges = make(map[int][2]int)
 
 	if c.contextExpanded && c.aiCtx != nil {
 		params := c.renderContextParams()
@@ -39,7 +40,13 @@ func (c *ChatOverlay) renderMessages() string {
 	}
 
 	for i, msg := range c.messages {
-		if msg.toolUse != nil {
+		if msg.proposal != nil {
+			startLine := lineNum
+			proposalStr := c.renderProposal(msg.proposal, w)
+			sb.WriteString(proposalStr)
+			lineNum += strings.Count(proposalStr, "\n")
+			c.proposalLineRanges[i] = [2]int{startLine, lineNum}
+		} else if msg.toolUse != nil {
 			startLine := lineNum
 			toolStr := c.renderToolCall(i, msg.toolUse, msg.toolError, w)
 			sb.WriteString(toolStr)
@@ -107,7 +114,7 @@ func (c *ChatOverlay) renderThinking(idx int, content string, width int) string
 	var sb strings.Builder
 
 	if collapsed {
-		sb.WriteString(c.styles.thinking.Render("💭 ▶ [click to expand]"))
+		sb.WriteString(c.styles.thinking.Render("💭 ▶ [click or Ctrl+T to expand]"))
 		sb.WriteString("\n")
 	} else {
 		sb.WriteString(c.styles.thinking.Render("💭 ▼ Thinking:"))
@@ -156,6 +163,21 @@ func (c *ChatOverlay) renderToolCall(idx int, tu *ai.ToolUseContent, isError boo
 	return sb.String()
 }
 
+// renderProposal draws a proposed action as a button, with the assistant's
+// reason below it.
+func (c *ChatOverlay) renderProposal(p *ai.ActionProposal, width int) string {
+	var sb strings.Builder
+	sb.WriteString(c.styles.proposal.Render(wrapText("▶ "+p.String(), width-2)))
+	sb.WriteString("\n")
+	hint := "Click or Ctrl+Y to review and run"
+	if p.Reason != "" {
+		hint = p.Reason + " · " + hint
+	}
+	sb.WriteString(c.styles.context.Render(wrapText("  "+hint, width)))
+	sb.WriteString("\n")
+	return sb.String()
+}
+
 func (c *ChatOverlay) wrapWidth() int {
 	if c.width > 4 {
 		return c.width - 4
@@ -0,0 +1,90 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/ai"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestToggleAllCollapsed(t *testing.T) {
+	rendered := map[int][2]int{1: {0, 2}, 3: {4, 6}}
+	collapsed := map[int]bool{1: true, 3: false, 5: true}
+
+	// Any collapsed block expands all rendered blocks
+	toggleAllCollapsed(collapsed, rendered)
+	if collapsed[1] || collapsed[3] {
+		t.Errorf("expected all rendered blocks expanded, got %v", collapsed)
+	}
+	if !collapsed[5] {
+		t.Error("blocks that are not rendered should be left untouched")
+	}
+
+	// All expanded collapses them again
+	toggleAllCollapsed(collapsed, rendered)
+	if !collapsed[1] || !collapsed[3] {
+		t.Errorf("expected all rendered blocks collapsed, got %v", collapsed)
+	}
+}
+
+func TestChatOverlayHandleProposal(t *testing.T) {
+	action.Global.Register("chatproposal", "widgets", []action.Action{
+		{Name: "Restart", Type: action.ActionTypeAPI, Operation: "RestartWidget", Confirm: action.ConfirmSimple},
+	})
+	c := NewChatOverlay(context.Background(), registry.New(), nil)
+	proposal := ai.ActionProposal{Service: "chatproposal", ResourceType: "widgets", ID: "w-1", Action: "Restart"}
+	resource := &mockResource{id: "w-1"}
+
+	_, cmd := c.handleProposal(chatProposalMsg{proposal: proposal, ctx: context.Background(), resource: resource})
+	if cmd == nil {
+		t.Fatal("expected a command opening the action menu")
+	}
+
+	proposal.Action = "Explode"
+	_, cmd = c.handleProposal(chatProposalMsg{proposal: proposal, ctx: context.Background(), resource: resource})
+	if cmd != nil || !strings.Contains(c.statusMsg, "not available") {
+		t.Errorf("unknown action should only set a status, got cmd=%v status=%q", cmd != nil, c.statusMsg)
+	}
+
+	_, cmd = c.handleProposal(chatProposalMsg{proposal: proposal, err: errors.New("boom")})
+	if cmd != nil || !strings.Contains(c.statusMsg, "boom") {
+		t.Errorf("load errors should be reported in the status, got %q", c.statusMsg)
+	}
+}
+
+func TestChatOverlayLoadSessionRestoresProposals(t *testing.T) {
+	input := func(id string) map[string]any {
+		return map[string]any{"service": "ec2", "resource_type": "instances", "id": id, "action": "Reboot"}
+	}
+	sess := &ai.Session{ID: "s-1", Messages: []ai.Message{
+		{Role: ai.RoleUser, Content: []ai.ContentBlock{{Text: "reboot my web servers"}}},
+		{Role: ai.RoleAssistant, Content: []ai.ContentBlock{
+			{Text: "Rebooting i-1 should clear it."},
+			{ToolUse: &ai.ToolUseContent{ID: "tu-1", Name: ai.ToolProposeAction, Input: input("i-1")}},
+			{ToolUse: &ai.ToolUseContent{ID: "tu-2", Name: ai.ToolProposeAction, Input: input("i-2")}},
+		}},
+		{Role: ai.RoleUser, Content: []ai.ContentBlock{
+			{ToolResult: &ai.ToolResultContent{ToolUseID: "tu-1", Content: "proposed"}},
+			{ToolResult: &ai.ToolResultContent{ToolUseID: "tu-2", Content: "no such action", IsError: true}},
+		}},
+	}}
+	c := NewChatOverlay(context.Background(), registry.New(), nil)
+	c.loadSession(sess)
+
+	var proposals []*ai.ActionProposal
+	for _, m := range c.messages {
+		if m.proposal != nil {
+			proposals = append(proposals, m.proposal)
+		}
+	}
+	if len(proposals) != 1 || proposals[0].ID != "i-1" || proposals[0].Action != "Reboot" {
+		t.Errorf("restored proposals = %+v, want only the accepted i-1 Reboot", proposals)
+	}
+	if len(c.streamMessages) != len(sess.Messages) {
+		t.Errorf("streamMessages = %d, want %d", len(c.streamMessages), len(sess.Messages))
+	}
+}
@@ -9,10 +9,16 @@ import (
 	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 
 	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/copyas"
+	"github.com/clawscli/claws/internal/history"
+	"github.com/clawscli/claws/internal/inventory"
 	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/policy"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/ui"
 )
@@ -332,9 +338,11 @@ func (c *CommandInput) resolveDestination(input string) string {
 
 	// Skip non-navigation commands
 	if strings.HasPrefix(input, "tag ") || strings.HasPrefix(input, "tags ") ||
-		strings.HasPrefix(input, "diff ") || strings.HasPrefix(input, "sort ") ||
+		strings.HasPrefix(input, "search ") || strings.HasPrefix(input, "diff ") || strings.HasPrefix(input, "sort ") ||
 		strings.HasPrefix(input, "theme ") || strings.HasPrefix(input, "autosave ") ||
-		strings.HasPrefix(input, "login ") {
+		strings.HasPrefix(input, "login ") || strings.HasPrefix(input, "inventory ") ||
+		strings.HasPrefix(input, "compare-regions ") || strings.HasPrefix(input, "validate-policy ") ||
+		strings.HasPrefix(input, "history ") {
 		return ""
 	}
 
@@ -437,6 +445,14 @@ func (c *CommandInput) SetDiffProvider(provider DiffCompletionProvider) {
 	c.diffProvider = provider
 }
 
+// Run executes input as if it had been typed in command mode
+func (c *CommandInput) Run(input string) (tea.Cmd, *NavigateMsg) {
+	c.textInput.SetValue(input)
+	cmd, nav := c.executeCommand()
+	c.textInput.Reset()
+	return cmd, nav
+}
+
 func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 	input := strings.TrimSpace(c.textInput.Value())
 
@@ -464,6 +480,19 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 		}, nil
 	}
 
+	// Handle history command - persisted list of visited views
+	if input == "history" {
+		return nil, &NavigateMsg{View: NewHistoryView(c.ctx, c.registry)}
+	}
+	if input == "history clear" {
+		return func() tea.Msg {
+			if err := history.Clear(); err != nil {
+				return ErrorMsg{Err: err}
+			}
+			return HistoryClearedMsg{}
+		}, nil
+	}
+
 	// Handle dashboard command - explicitly open dashboard
 	if input == "dashboard" {
 		dashboard := NewDashboardView(c.ctx, c.registry)
@@ -488,6 +517,34 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 		}, nil
 	}
 
+	// Handle trust-map command - map cross-account role trusts across the
+	// selected profiles
+	if input == "trust-map" {
+		return nil, &NavigateMsg{View: NewTrustMapView(c.ctx)}
+	}
+
+	// Handle org-tree command - organization OUs and accounts with SCPs
+	if input == "org-tree" {
+		return nil, &NavigateMsg{View: NewOrgTreeView(c.ctx)}
+	}
+
+	// Handle profile command - slowest recent API calls and DAO lists
+	if input == "profile" {
+		return nil, &NavigateMsg{View: NewCallProfileView(c.ctx)}
+	}
+
+	// Handle whoami command - show caller identity of each selected profile
+	if input == "whoami" {
+		return func() tea.Msg {
+			return ShowModalMsg{
+				Modal: &Modal{
+					Content: NewWhoamiView(c.ctx),
+					Width:   ModalWidthWhoami,
+				},
+			}
+		}, nil
+	}
+
 	// Handle sort command: :sort (clear) or :sort <column> (sort by column)
 	if input == "sort" {
 		return func() tea.Msg {
@@ -537,6 +594,12 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 		return nil, &NavigateMsg{View: browser}
 	}
 
+	// Handle search command: :search <query> (free-text Resource Explorer search)
+	if query, ok := strings.CutPrefix(input, "search "); ok && strings.TrimSpace(query) != "" {
+		browser := NewResourceSearchView(c.ctx, c.registry, query)
+		return nil, &NavigateMsg{View: browser}
+	}
+
 	// Handle diff command: :diff <name> or :diff <name1> <name2>
 	if suffix, ok := strings.CutPrefix(input, "diff "); ok {
 		parts := strings.Fields(suffix)
@@ -551,6 +614,85 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 		}
 	}
 
+	// Handle copyas command: :copyas <format> (copy a snippet for the selected resource)
+	if input == "copyas" {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("usage: copyas <%s>", strings.Join(copyas.Formats, "|"))}
+		}, nil
+	}
+	if suffix, ok := strings.CutPrefix(input, "copyas "); ok {
+		format := strings.ToLower(strings.TrimSpace(suffix))
+		if !slices.Contains(copyas.Formats, format) {
+			return func() tea.Msg {
+				return ErrorMsg{Err: fmt.Errorf("unknown copyas format %q (want %s)", format, strings.Join(copyas.Formats, ", "))}
+			}, nil
+		}
+		return func() tea.Msg {
+			return CopyAsMsg{Format: format}
+		}, nil
+	}
+
+	// Handle usedby command: :usedby (selected resource) or :usedby <arn>
+	if input == "usedby" {
+		return func() tea.Msg {
+			return UsedByMsg{}
+		}, nil
+	}
+	if suffix, ok := strings.CutPrefix(input, "usedby "); ok {
+		return usedByARNCmd(c.ctx, c.registry, strings.TrimSpace(suffix)), nil
+	}
+
+	// Handle trace command: :trace (selected topic or rule) or :trace <arn>
+	if input == "trace" {
+		return func() tea.Msg {
+			return TraceMsg{}
+		}, nil
+	}
+	if suffix, ok := strings.CutPrefix(input, "trace "); ok {
+		v := NewDeliveryTraceView(c.ctx, strings.TrimSpace(suffix))
+		return nil, &NavigateMsg{View: v}
+	}
+
+	// Handle compare-regions command: :compare-regions <region-a> <region-b>
+	if input == "compare-regions" || strings.HasPrefix(input, "compare-regions ") {
+		regions := strings.Fields(strings.TrimPrefix(input, "compare-regions"))
+		if len(regions) != 2 || regions[0] == regions[1] {
+			return func() tea.Msg {
+				return ErrorMsg{Err: fmt.Errorf("usage: compare-regions <region-a> <region-b>")}
+			}, nil
+		}
+		return func() tea.Msg {
+			return CompareRegionsMsg{RegionA: regions[0], RegionB: regions[1]}
+		}, nil
+	}
+
+	// Handle inventory command: :inventory save <name> [types...] or
+	// :inventory diff <name> [name2]
+	if input == "inventory" {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("usage: inventory save <name> [service/resource...] | inventory diff <name> [name2]")}
+		}, nil
+	}
+	if suffix, ok := strings.CutPrefix(input, "inventory "); ok {
+		return c.parseInventoryArgs(strings.Fields(suffix))
+	}
+
+	// Handle validate-policy command: :validate-policy <file> [type]
+	if input == "validate-policy" || strings.HasPrefix(input, "validate-policy ") {
+		path, policyType, err := parseValidatePolicyArgs(strings.TrimPrefix(input, "validate-policy"))
+		if err != nil {
+			return func() tea.Msg { return ErrorMsg{Err: err} }, nil
+		}
+		return func() tea.Msg {
+			return ShowModalMsg{
+				Modal: &Modal{
+					Content: NewPolicyValidationView(c.ctx, path, policyType),
+					Width:   ModalWidthPolicy,
+				},
+			}
+		}, nil
+	}
+
 	if suffix, ok := strings.CutPrefix(input, "theme "); ok {
 		themeName := strings.TrimSpace(suffix)
 		if themeName != "" {
@@ -610,6 +752,59 @@ func (c *CommandInput) parseSortArgs(args string) tea.Cmd {
 	}
 }
 
+func (c *CommandInput) parseInventoryArgs(args []string) (tea.Cmd, *NavigateMsg) {
+	fail := func(err error) (tea.Cmd, *NavigateMsg) {
+		return func() tea.Msg { return ErrorMsg{Err: err} }, nil
+	}
+	if len(args) < 2 {
+		return fail(fmt.Errorf("usage: inventory save <name> [service/resource...] | inventory diff <name> [name2]"))
+	}
+	if err := inventory.ValidateName(args[1]); err != nil {
+		return fail(err)
+	}
+
+	switch args[0] {
+	case "save":
+		types, err := inventory.ResolveTypes(c.registry, args[2:])
+		if err != nil {
+			return fail(err)
+		}
+		return nil, &NavigateMsg{View: NewInventorySaveView(c.ctx, c.registry, args[1], types)}
+	case "diff":
+		if len(args) > 3 {
+			return fail(fmt.Errorf("usage: inventory diff <name> [name2]"))
+		}
+		compare := ""
+		if len(args) == 3 {
+			compare = args[2]
+			if err := inventory.ValidateName(compare); err != nil {
+				return fail(err)
+			}
+		}
+		return nil, &NavigateMsg{View: NewInventoryDiffView(c.ctx, c.registry, args[1], compare)}
+	}
+	return fail(fmt.Errorf("unknown inventory command %q (want save or diff)", args[0]))
+}
+
+// parseValidatePolicyArgs splits "<file> [type]". The type is only taken
+// from the last word, so paths containing spaces still work.
+func parseValidatePolicyArgs(args string) (string, accessanalyzertypes.PolicyType, error) {
+	usage := fmt.Errorf("usage: validate-policy <file> [%s]", strings.Join(policy.Types, "|"))
+	path := strings.TrimSpace(args)
+	if path == "" {
+		return "", "", usage
+	}
+	typeName := ""
+	if i := strings.LastIndexByte(path, ' '); i >= 0 && slices.Contains(policy.Types, strings.ToLower(path[i+1:])) {
+		path, typeName = strings.TrimSpace(path[:i]), path[i+1:]
+	}
+	policyType, err := policy.ParseType(typeName)
+	if err != nil {
+		return "", "", err
+	}
+	return path, policyType, nil
+}
+
 func (c *CommandInput) executeLogin(profileName string) tea.Cmd {
 	exec := &action.SimpleExec{
 		Command:    fmt.Sprintf("aws login --remote --profile %s", profileName),
@@ -636,9 +831,9 @@ func (c *CommandInput) GetSuggestions() []string {
 		return c.getTagSuggestions("tag ", suffix)
 	}
 
-	// Handle :tags command completion (same as :tag)
+	// Handle :tags command completion (saved queries and boolean expressions)
 	if suffix, ok := strings.CutPrefix(input, "tags "); ok {
-		return c.getTagSuggestions("tags ", suffix)
+		return c.getTagSearchSuggestions(suffix)
 	}
 
 	// Handle :diff command completion
@@ -646,6 +841,22 @@ func (c *CommandInput) GetSuggestions() []string {
 		return c.getDiffSuggestions(suffix)
 	}
 
+	if suffix, ok := strings.CutPrefix(input, "copyas "); ok {
+		return c.getCopyAsSuggestions(suffix)
+	}
+
+	if suffix, ok := strings.CutPrefix(input, "inventory "); ok {
+		return c.getInventorySuggestions(suffix)
+	}
+
+	if suffix, ok := strings.CutPrefix(input, "compare-regions "); ok {
+		return c.getCompareRegionsSuggestions(suffix)
+	}
+
+	if suffix, ok := strings.CutPrefix(input, "validate-policy "); ok {
+		return c.getValidatePolicySuggestions(suffix)
+	}
+
 	if suffix, ok := strings.CutPrefix(input, "theme "); ok {
 		return c.getThemeSuggestions(suffix)
 	}
@@ -689,6 +900,9 @@ func (c *CommandInput) GetSuggestions() []string {
 		if strings.HasPrefix("clear-history", input) {
 			suggestions = append(suggestions, "clear-history")
 		}
+		if strings.HasPrefix("history", input) {
+			suggestions = append(suggestions, "history")
+		}
 
 		// Add "tag" command (current view filter)
 		if strings.HasPrefix("tag", input) && !strings.HasPrefix("tags", input) {
@@ -700,6 +914,11 @@ func (c *CommandInput) GetSuggestions() []string {
 			suggestions = append(suggestions, "tags")
 		}
 
+		// Add "search" command (Resource Explorer free-text search)
+		if strings.HasPrefix("search", input) {
+			suggestions = append(suggestions, "search")
+		}
+
 		// Add "sort" command
 		if strings.HasPrefix("sort", input) {
 			suggestions = append(suggestions, "sort")
@@ -710,6 +929,26 @@ func (c *CommandInput) GetSuggestions() []string {
 			suggestions = append(suggestions, "diff")
 		}
 
+		if strings.HasPrefix("copyas", input) {
+			suggestions = append(suggestions, "copyas")
+		}
+
+		if strings.HasPrefix("inventory", input) {
+			suggestions = append(suggestions, "inventory")
+		}
+
+		if strings.HasPrefix("usedby", input) {
+			suggestions = append(suggestions, "usedby")
+		}
+
+		if strings.HasPrefix("trace", input) {
+			suggestions = append(suggestions, "trace")
+		}
+
+		if strings.HasPrefix("compare-regions", input) {
+			suggestions = append(suggestions, "compare-regions")
+		}
+
 		if strings.HasPrefix("theme", input) {
 			suggestions = append(suggestions, "theme")
 		}
@@ -722,6 +961,26 @@ func (c *CommandInput) GetSuggestions() []string {
 			suggestions = append(suggestions, "settings")
 		}
 
+		if strings.HasPrefix("whoami", input) {
+			suggestions = append(suggestions, "whoami")
+		}
+
+		if strings.HasPrefix("profile", input) {
+			suggestions = append(suggestions, "profile")
+		}
+
+		if strings.HasPrefix("trust-map", input) {
+			suggestions = append(suggestions, "trust-map")
+		}
+
+		if strings.HasPrefix("org-tree", input) {
+			suggestions = append(suggestions, "org-tree")
+		}
+
+		if strings.HasPrefix("validate-policy", input) {
+			suggestions = append(suggestions, "validate-policy")
+		}
+
 		for _, svc := range c.registry.ListServices() {
 			// Skip if input exactly matches service (already fully typed)
 			if svc != input && strings.HasPrefix(svc, input) {
@@ -755,6 +1014,104 @@ func (c *CommandInput) getThemeSuggestions(prefix string) []string {
 	return suggestions
 }
 
+func (c *CommandInput) getCopyAsSuggestions(prefix string) []string {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+
+	var suggestions []string
+	for _, f := range copyas.Formats {
+		if prefix == "" || strings.HasPrefix(f, prefix) {
+			suggestions = append(suggestions, "copyas "+f)
+		}
+	}
+	return suggestions
+}
+
+// getInventorySuggestions completes the subcommand, then saved snapshot
+// names for diff.
+func (c *CommandInput) getInventorySuggestions(args string) []string {
+	sub, rest, hasRest := strings.Cut(args, " ")
+	if !hasRest {
+		var suggestions []string
+		for _, s := range []string{"save", "diff"} {
+			if strings.HasPrefix(s, sub) {
+				suggestions = append(suggestions, "inventory "+s)
+			}
+		}
+		return suggestions
+	}
+	if sub != "diff" {
+		return nil
+	}
+
+	names, err := inventory.List()
+	if err != nil {
+		return nil
+	}
+	fields := strings.Fields(rest)
+	prefix := ""
+	if len(fields) > 0 && !strings.HasSuffix(rest, " ") {
+		prefix = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) >= 2 {
+		return nil
+	}
+	base := "inventory diff " + strings.Join(append(fields, ""), " ")
+	var suggestions []string
+	for _, name := range names {
+		if strings.HasPrefix(name, prefix) && !slices.Contains(fields, name) {
+			suggestions = append(suggestions, base+name)
+		}
+	}
+	return suggestions
+}
+
+// getCompareRegionsSuggestions completes region names, offering the selected
+// regions first
+func (c *CommandInput) getCompareRegionsSuggestions(args string) []string {
+	fields := strings.Fields(args)
+	prefix := ""
+	if len(fields) > 0 && !strings.HasSuffix(args, " ") {
+		prefix = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) >= 2 {
+		return nil
+	}
+
+	candidates := slices.Clone(config.Global().Regions())
+	for _, r := range aws.PartitionRegions(aws.PartitionForRegion(config.Global().Region())) {
+		if !slices.Contains(candidates, r) {
+			candidates = append(candidates, r)
+		}
+	}
+
+	base := "compare-regions " + strings.Join(append(fields, ""), " ")
+	var suggestions []string
+	for _, r := range candidates {
+		if strings.HasPrefix(r, prefix) && !slices.Contains(fields, r) {
+			suggestions = append(suggestions, base+r)
+		}
+	}
+	return suggestions
+}
+
+// getValidatePolicySuggestions completes the policy type once a file has
+// been typed
+func (c *CommandInput) getValidatePolicySuggestions(args string) []string {
+	path, prefix, ok := strings.Cut(args, " ")
+	if !ok || path == "" || strings.Contains(prefix, " ") {
+		return nil
+	}
+	var suggestions []string
+	for _, t := range policy.Types {
+		if strings.HasPrefix(t, strings.ToLower(prefix)) {
+			suggestions = append(suggestions, "validate-policy "+path+" "+t)
+		}
+	}
+	return suggestions
+}
+
 func (c *CommandInput) getAutosaveSuggestions(prefix string) []string {
 	prefix = strings.ToLower(strings.TrimSpace(prefix))
 	options := []string{"on", "off"}
@@ -846,6 +1203,29 @@ func (c *CommandInput) getTagSuggestions(cmdPrefix, tagPart string) []string {
 	return suggestions
 }
 
+// getTagSearchSuggestions completes saved query names (@name) and the last
+// term of a boolean tag expression.
+func (c *CommandInput) getTagSearchSuggestions(expr string) []string {
+	if namePrefix, ok := strings.CutPrefix(expr, "@"); ok {
+		var suggestions []string
+		for _, name := range config.File().TagSearchQueryNames() {
+			if strings.HasPrefix(name, namePrefix) {
+				suggestions = append(suggestions, "tags @"+name)
+			}
+		}
+		return suggestions
+	}
+
+	head, term := "", expr
+	if i := strings.LastIndexAny(expr, " ("); i >= 0 {
+		head, term = expr[:i+1], expr[i+1:]
+	}
+	if rest, ok := strings.CutPrefix(term, "!"); ok {
+		head, term = head+"!", rest
+	}
+	return c.getTagSuggestions("tags "+head, term)
+}
+
 // commonPrefix returns the longest common prefix of all suggestions.
 // Returns empty string if suggestions is empty.
 func commonPrefix(suggestions []string) string {
@@ -2,10 +2,14 @@ package view
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
+	accessanalyzertypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 
+	"github.com/clawscli/claws/internal/inventory"
 	"github.com/clawscli/claws/internal/registry"
 )
 
@@ -492,6 +496,51 @@ func TestCommandInput_ClearHistoryCommand(t *testing.T) {
 	}
 }
 
+func TestCommandInput_CopyAsCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"copyas cli", "cli", false},
+		{"copyas Terraform", "terraform", false},
+		{"copyas boto3", "boto3", false},
+		{"copyas pulumi", "", true},
+		{"copyas", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ci.Activate()
+			ci.textInput.SetValue(tt.input)
+			cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			if nav != nil || cmd == nil {
+				t.Fatalf("Update(%q) = %v, %v; want cmd only", tt.input, cmd, nav)
+			}
+			switch msg := cmd().(type) {
+			case CopyAsMsg:
+				if tt.wantErr || msg.Format != tt.want {
+					t.Errorf("Format = %q, want %q (wantErr %v)", msg.Format, tt.want, tt.wantErr)
+				}
+			case ErrorMsg:
+				if !tt.wantErr {
+					t.Errorf("unexpected error: %v", msg.Err)
+				}
+			default:
+				t.Errorf("unexpected msg %T", msg)
+			}
+		})
+	}
+
+	ci.Activate()
+	ci.textInput.SetValue("copyas t")
+	if got := ci.GetSuggestions(); len(got) != 1 || got[0] != "copyas terraform" {
+		t.Errorf("GetSuggestions() = %v, want [copyas terraform]", got)
+	}
+}
+
 func TestCommandInput_DashboardCommand(t *testing.T) {
 	ctx := context.Background()
 	reg := registry.New()
@@ -835,3 +884,287 @@ func TestCommandInput_SuggestionTruncation(t *testing.T) {
 	ci.updateSuggestions()
 	// No assertion needed - just ensure no panic
 }
+
+type stubTagProvider struct {
+	tags map[string][]string
+}
+
+func (p stubTagProvider) GetTagKeys() []string {
+	keys := make([]string, 0, len(p.tags))
+	for k := range p.tags {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (p stubTagProvider) GetTagValues(key string) []string { return p.tags[key] }
+
+func TestCommandInput_TagSearchExpressionSuggestions(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+	ci.SetTagProvider(stubTagProvider{tags: map[string][]string{
+		"Environment": {"dev", "prod"},
+		"Team":        {"web"},
+	}})
+
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"tags Env", []string{"tags Environment"}},
+		{"tags Environment=p", []string{"tags Environment=prod"}},
+		{"tags Environment=prod AND !Te", []string{"tags Environment=prod AND !Team"}},
+		{"tags (Team=web OR Environment=d", []string{"tags (Team=web OR Environment=dev"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ci.textInput.SetValue(tt.input)
+			if got := ci.GetSuggestions(); !slices.Equal(got, tt.want) {
+				t.Errorf("GetSuggestions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandInput_InventoryCommand(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{})
+	ci := NewCommandInput(context.Background(), reg)
+
+	tests := []struct {
+		input    string
+		wantDiff bool
+		wantErr  bool
+	}{
+		{"inventory save before", false, false},
+		{"inventory save before ec2/instances", false, false},
+		{"inventory save before nope/things", false, true},
+		{"inventory save ../x", false, true},
+		{"inventory diff before", true, false},
+		{"inventory diff before after", true, false},
+		{"inventory diff a b c", false, true},
+		{"inventory list", false, true},
+		{"inventory", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ci.Activate()
+			ci.textInput.SetValue(tt.input)
+			cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			if tt.wantErr {
+				if nav != nil || cmd == nil {
+					t.Fatalf("Update(%q) = %v, %v; want error cmd", tt.input, cmd, nav)
+				}
+				if _, ok := cmd().(ErrorMsg); !ok {
+					t.Errorf("Update(%q) msg = %T, want ErrorMsg", tt.input, cmd())
+				}
+				return
+			}
+			if nav == nil {
+				t.Fatalf("Update(%q) returned no navigation", tt.input)
+			}
+			v, ok := nav.View.(*InventoryView)
+			if !ok {
+				t.Fatalf("Update(%q) view = %T, want *InventoryView", tt.input, nav.View)
+			}
+			if v.diff != tt.wantDiff {
+				t.Errorf("diff = %v, want %v", v.diff, tt.wantDiff)
+			}
+		})
+	}
+
+	if _, err := inventory.Save(&inventory.Snapshot{Name: "before"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := inventory.Save(&inventory.Snapshot{Name: "after"}); err != nil {
+		t.Fatal(err)
+	}
+	ci.Activate()
+	ci.textInput.SetValue("inventory d")
+	if got := ci.GetSuggestions(); len(got) != 1 || got[0] != "inventory diff" {
+		t.Errorf("suggestions = %v, want [inventory diff]", got)
+	}
+	ci.textInput.SetValue("inventory diff b")
+	if got := ci.GetSuggestions(); len(got) != 1 || got[0] != "inventory diff before" {
+		t.Errorf("suggestions = %v, want [inventory diff before]", got)
+	}
+	ci.textInput.SetValue("inventory diff before ")
+	if got := ci.GetSuggestions(); len(got) != 1 || got[0] != "inventory diff before after" {
+		t.Errorf("suggestions = %v, want [inventory diff before after]", got)
+	}
+}
+
+func TestCommandInput_CompareRegionsCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+
+	tests := []struct {
+		input   string
+		wantErr bool
+	}{
+		{"compare-regions us-east-1 us-west-2", false},
+		{"compare-regions us-east-1", true},
+		{"compare-regions us-east-1 us-east-1", true},
+		{"compare-regions", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ci.Activate()
+			ci.textInput.SetValue(tt.input)
+			cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			if nav != nil || cmd == nil {
+				t.Fatalf("Update(%q) = %v, %v; want cmd only", tt.input, cmd, nav)
+			}
+			switch msg := cmd().(type) {
+			case CompareRegionsMsg:
+				if tt.wantErr || msg.RegionA != "us-east-1" || msg.RegionB != "us-west-2" {
+					t.Errorf("msg = %+v (wantErr %v)", msg, tt.wantErr)
+				}
+			case ErrorMsg:
+				if !tt.wantErr {
+					t.Errorf("unexpected error: %v", msg.Err)
+				}
+			default:
+				t.Errorf("unexpected msg %T", msg)
+			}
+		})
+	}
+
+	ci.Activate()
+	ci.textInput.SetValue("compare-regions us-east-1 us-west-")
+	got := ci.GetSuggestions()
+	if !slices.Contains(got, "compare-regions us-east-1 us-west-2") || slices.Contains(got, "compare-regions us-east-1 us-east-1") {
+		t.Errorf("suggestions = %v", got)
+	}
+}
+
+func TestCommandInput_WhoamiCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+	ci.Activate()
+	ci.textInput.SetValue("whoami")
+
+	cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nav != nil || cmd == nil {
+		t.Fatalf("whoami: nav = %v, cmd nil = %v; want a modal command", nav, cmd == nil)
+	}
+	msg, ok := cmd().(ShowModalMsg)
+	if !ok {
+		t.Fatalf("whoami returned %T, want ShowModalMsg", cmd())
+	}
+	if _, ok := msg.Modal.Content.(*WhoamiView); !ok {
+		t.Errorf("modal content = %T, want *WhoamiView", msg.Modal.Content)
+	}
+}
+
+func TestCommandInput_ValidatePolicyCommand(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantPath string
+		wantType accessanalyzertypes.PolicyType
+		wantErr  string
+	}{
+		{"validate-policy", "", "", "usage: validate-policy"},
+		{"validate-policy policies/admin.json", "policies/admin.json", accessanalyzertypes.PolicyTypeIdentityPolicy, ""},
+		{"validate-policy bucket policy.json resource", "bucket policy.json", accessanalyzertypes.PolicyTypeResourcePolicy, ""},
+		{"validate-policy guardrails.json SCP", "guardrails.json", accessanalyzertypes.PolicyTypeServiceControlPolicy, ""},
+	}
+	for _, tt := range tests {
+		ci := NewCommandInput(context.Background(), registry.New())
+		ci.Activate()
+		ci.textInput.SetValue(tt.input)
+
+		cmd, _ := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+		if cmd == nil {
+			t.Fatalf("%q: expected a command", tt.input)
+		}
+		switch msg := cmd().(type) {
+		case ErrorMsg:
+			if tt.wantErr == "" || !strings.Contains(msg.Err.Error(), tt.wantErr) {
+				t.Errorf("%q: error %v, want %q", tt.input, msg.Err, tt.wantErr)
+			}
+		case ShowModalMsg:
+			v, ok := msg.Modal.Content.(*PolicyValidationView)
+			if !ok {
+				t.Fatalf("%q: modal content = %T", tt.input, msg.Modal.Content)
+			}
+			if v.path != tt.wantPath || v.policyType != tt.wantType {
+				t.Errorf("%q: path %q type %q, want %q %q", tt.input, v.path, v.policyType, tt.wantPath, tt.wantType)
+			}
+		default:
+			t.Errorf("%q returned %T", tt.input, msg)
+		}
+	}
+
+	ci := NewCommandInput(context.Background(), registry.New())
+	got := ci.getValidatePolicySuggestions("admin.json s")
+	if !slices.Equal(got, []string{"validate-policy admin.json scp"}) {
+		t.Errorf("suggestions = %v", got)
+	}
+}
+
+func TestCommandInput_TrustMapCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+	ci.Activate()
+	ci.textInput.SetValue("trust-map")
+
+	_, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nav == nil {
+		t.Fatal("trust-map should navigate")
+	}
+	if _, ok := nav.View.(*TrustMapView); !ok {
+		t.Errorf("view = %T, want *TrustMapView", nav.View)
+	}
+}
+
+func TestCommandInput_OrgTreeCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+	ci.Activate()
+	ci.textInput.SetValue("org-tree")
+
+	_, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nav == nil {
+		t.Fatal("org-tree should navigate")
+	}
+	if _, ok := nav.View.(*OrgTreeView); !ok {
+		t.Errorf("view = %T, want *OrgTreeView", nav.View)
+	}
+}
+
+func TestCommandInput_TraceCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+	ci.Activate()
+	ci.textInput.SetValue("trace")
+	cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nav != nil || cmd == nil {
+		t.Fatal("trace should ask the current view to trace its selection")
+	}
+	if _, ok := cmd().(TraceMsg); !ok {
+		t.Errorf("trace msg = %T, want TraceMsg", cmd())
+	}
+
+	ci.Activate()
+	ci.textInput.SetValue("trace arn:aws:sns:us-east-1:111122223333:orders")
+	_, nav = ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nav == nil {
+		t.Fatal("trace <arn> should navigate")
+	}
+	if _, ok := nav.View.(*DeliveryTraceView); !ok {
+		t.Errorf("view = %T, want *DeliveryTraceView", nav.View)
+	}
+}
+
+func TestCommandInput_ProfileCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+	ci.Activate()
+	ci.textInput.SetValue("profile")
+
+	_, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nav == nil {
+		t.Fatal("profile should navigate")
+	}
+	if _, ok := nav.View.(*CallProfileView); !ok {
+		t.Errorf("view = %T, want *CallProfileView", nav.View)
+	}
+}
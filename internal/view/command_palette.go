@@ -0,0 +1,408 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	ModalWidthCommandPalette = 72
+
+	// paletteMaxRows is the number of entries shown at once
+	paletteMaxRows = 12
+	// maxRecentResources bounds the in-memory recent resource list
+	maxRecentResources = 10
+)
+
+// PaletteEntryKind identifies what a palette entry navigates to
+type PaletteEntryKind string
+
+const (
+	PaletteRecent   PaletteEntryKind = "recent"
+	PaletteCommand  PaletteEntryKind = "command"
+	PaletteService  PaletteEntryKind = "service"
+	PaletteResource PaletteEntryKind = "resource"
+	PaletteAlias    PaletteEntryKind = "alias"
+)
+
+// paletteCommands are the argument-less commands offered by the palette
+var paletteCommands = []struct{ name, desc string }{
+	{"home", "Service browser"},
+	{"pulse", "Dashboard"},
+	{"services", "Service browser"},
+	{"tags", "Cross-service tag browser"},
+	{"trust-map", "IAM trust map"},
+	{"whoami", "Current identity"},
+	{"profile", "Slowest recent AWS calls"},
+	{"settings", "Settings"},
+	{"login", "AWS Console login"},
+	{"history", "Recently visited lists and resources"},
+	{"clear-history", "Clear navigation history"},
+	{"quit", "Quit claws"},
+}
+
+// PaletteEntry is a single selectable item in the command palette
+type PaletteEntry struct {
+	Kind    PaletteEntryKind
+	Label   string
+	Detail  string
+	Command string // command-mode input executed on selection
+
+	recent *RecentResource
+}
+
+// PaletteSelectMsg is sent when a palette entry is chosen. Exactly one of
+// Command and Nav is set; Command is executed as if typed in command mode.
+type PaletteSelectMsg struct {
+	Command string
+	Nav     *NavigateMsg
+}
+
+// RecentResource is a resource recently opened in a detail view
+type RecentResource struct {
+	Service      string
+	ResourceType string
+	Resource     dao.Resource
+}
+
+var (
+	recentMu        sync.Mutex
+	recentResources []RecentResource
+)
+
+// recordRecentResource moves the resource to the front of the recent list
+func recordRecentResource(service, resType string, resource dao.Resource) {
+	if resource == nil || service == "" || resType == "" {
+		return
+	}
+	recentMu.Lock()
+	defer recentMu.Unlock()
+
+	for i, r := range recentResources {
+		if r.Service == service && r.ResourceType == resType && r.Resource.GetID() == resource.GetID() {
+			recentResources = append(recentResources[:i], recentResources[i+1:]...)
+			break
+		}
+	}
+	recentResources = append([]RecentResource{{Service: service, ResourceType: resType, Resource: resource}}, recentResources...)
+	if len(recentResources) > maxRecentResources {
+		recentResources = recentResources[:maxRecentResources]
+	}
+}
+
+// RecentResources returns recently opened resources, most recent first
+func RecentResources() []RecentResource {
+	recentMu.Lock()
+	defer recentMu.Unlock()
+	return append([]RecentResource(nil), recentResources...)
+}
+
+type commandPaletteStyles struct {
+	title    lipgloss.Style
+	item     lipgloss.Style
+	selected lipgloss.Style
+	detail   lipgloss.Style
+	kind     lipgloss.Style
+	hint     lipgloss.Style
+}
+
+func newCommandPaletteStyles() commandPaletteStyles {
+	return commandPaletteStyles{
+		title:    ui.TableHeaderStyle().Padding(0, 1),
+		item:     ui.TextStyle(),
+		selected: ui.SelectedStyle(),
+		detail:   ui.DimStyle(),
+		kind:     ui.MutedStyle(),
+		hint:     ui.DimStyle(),
+	}
+}
+
+// CommandPalette is a fuzzy finder over services, resource types, aliases,
+// commands and recently opened resources
+type CommandPalette struct {
+	ctx      context.Context
+	registry *registry.Registry
+	input    textinput.Model
+	entries  []PaletteEntry
+	matches  []PaletteEntry
+	cursor   int
+	offset   int
+	styles   commandPaletteStyles
+	width    int
+	height   int
+}
+
+// NewCommandPalette creates a palette populated from the registry
+func NewCommandPalette(ctx context.Context, reg *registry.Registry) *CommandPalette {
+	ti := textinput.New()
+	ti.Placeholder = "service, resource, alias or command"
+	ti.Prompt = "> "
+	ti.CharLimit = 100
+	ti.SetWidth(ModalWidthCommandPalette - 8)
+	ti.SetStyles(ui.TextInputStyles())
+	ti.Focus()
+
+	p := &CommandPalette{
+		ctx:      ctx,
+		registry: reg,
+		input:    ti,
+		styles:   newCommandPaletteStyles(),
+	}
+	p.entries = p.buildEntries()
+	p.applyFilter()
+	return p
+}
+
+func (p *CommandPalette) buildEntries() []PaletteEntry {
+	var entries []PaletteEntry
+
+	for _, r := range RecentResources() {
+		res := r
+		name := res.Resource.GetName()
+		if name == "" {
+			name = res.Resource.GetID()
+		}
+		entries = append(entries, PaletteEntry{
+			Kind:   PaletteRecent,
+			Label:  name,
+			Detail: res.Service + "/" + res.ResourceType,
+			recent: &res,
+		})
+	}
+
+	for _, c := range paletteCommands {
+		entries = append(entries, PaletteEntry{Kind: PaletteCommand, Label: c.name, Detail: c.desc, Command: c.name})
+	}
+
+	if p.registry == nil {
+		return entries
+	}
+
+	services := p.registry.ListServices()
+	for _, svc := range services {
+		entries = append(entries, PaletteEntry{
+			Kind:    PaletteService,
+			Label:   svc,
+			Detail:  p.registry.GetDisplayName(svc),
+			Command: svc,
+		})
+	}
+	for _, svc := range services {
+		display := p.registry.GetDisplayName(svc)
+		for _, res := range p.registry.ListResources(svc) {
+			entries = append(entries, PaletteEntry{
+				Kind:    PaletteResource,
+				Label:   svc + "/" + res,
+				Detail:  display,
+				Command: svc + "/" + res,
+			})
+		}
+	}
+	for _, alias := range p.registry.GetAliases() {
+		svc, res, _ := p.registry.ResolveAlias(alias)
+		target := svc
+		if res != "" {
+			target += "/" + res
+		}
+		entries = append(entries, PaletteEntry{
+			Kind:    PaletteAlias,
+			Label:   alias,
+			Detail:  "→ " + target,
+			Command: alias,
+		})
+	}
+	return entries
+}
+
+// paletteScore ranks how well an entry matches the query; -1 means no match.
+// Label prefix beats label substring, which beats fuzzy and detail matches.
+func paletteScore(e PaletteEntry, query string) int {
+	if query == "" {
+		return 0
+	}
+	label := strings.ToLower(e.Label)
+	switch {
+	case label == query:
+		return 5
+	case strings.HasPrefix(label, query):
+		return 4
+	case strings.Contains(label, query):
+		return 3
+	case fuzzyMatch(label, query):
+		return 2
+	case strings.Contains(strings.ToLower(e.Detail), query):
+		return 1
+	}
+	return -1
+}
+
+func (p *CommandPalette) applyFilter() {
+	query := strings.ToLower(strings.TrimSpace(p.input.Value()))
+
+	// Bucket by score to keep the stable kind ordering within each rank
+	var buckets [6][]PaletteEntry
+	for _, e := range p.entries {
+		if s := paletteScore(e, query); s >= 0 {
+			buckets[s] = append(buckets[s], e)
+		}
+	}
+	p.matches = p.matches[:0]
+	for s := len(buckets) - 1; s >= 0; s-- {
+		p.matches = append(p.matches, buckets[s]...)
+	}
+	p.cursor = 0
+	p.offset = 0
+}
+
+func (p *CommandPalette) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (p *CommandPalette) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ThemeChangedMsg:
+		p.styles = newCommandPaletteStyles()
+		p.input.SetStyles(ui.TextInputStyles())
+		return p, nil
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "esc", "ctrl+c":
+			return p, func() tea.Msg { return HideModalMsg{} }
+		case "up", "ctrl+p", "ctrl+k":
+			p.moveCursor(-1)
+			return p, nil
+		case "down", "ctrl+n", "ctrl+j", "tab":
+			p.moveCursor(1)
+			return p, nil
+		case "pgup":
+			p.moveCursor(-paletteMaxRows)
+			return p, nil
+		case "pgdown":
+			p.moveCursor(paletteMaxRows)
+			return p, nil
+		case "enter":
+			return p, p.selectCurrent()
+		}
+	}
+
+	prev := p.input.Value()
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	if p.input.Value() != prev {
+		p.applyFilter()
+	}
+	return p, cmd
+}
+
+func (p *CommandPalette) moveCursor(delta int) {
+	if len(p.matches) == 0 {
+		return
+	}
+	p.cursor = max(0, min(len(p.matches)-1, p.cursor+delta))
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	} else if p.cursor >= p.offset+paletteMaxRows {
+		p.offset = p.cursor - paletteMaxRows + 1
+	}
+}
+
+// selectCurrent returns the command that navigates to the highlighted entry
+func (p *CommandPalette) selectCurrent() tea.Cmd {
+	if p.cursor < 0 || p.cursor >= len(p.matches) {
+		return nil
+	}
+	e := p.matches[p.cursor]
+	if e.recent != nil {
+		nav := p.recentNavigation(*e.recent)
+		return func() tea.Msg { return PaletteSelectMsg{Nav: &nav} }
+	}
+	return func() tea.Msg { return PaletteSelectMsg{Command: e.Command} }
+}
+
+func (p *CommandPalette) recentNavigation(r RecentResource) NavigateMsg {
+	renderer, err := p.registry.GetRenderer(r.Service, r.ResourceType)
+	if err != nil {
+		browser := NewResourceBrowserWithType(p.ctx, p.registry, r.Service, r.ResourceType)
+		return NavigateMsg{View: browser}
+	}
+	daoInst, err := p.registry.GetDAO(p.ctx, r.Service, r.ResourceType)
+	if err != nil {
+		daoInst = nil
+	}
+	return NavigateMsg{View: NewDetailView(p.ctx, r.Resource, renderer, r.Service, r.ResourceType, p.registry, daoInst)}
+}
+
+func (p *CommandPalette) View() tea.View {
+	return tea.NewView(p.ViewString())
+}
+
+func (p *CommandPalette) ViewString() string {
+	var b strings.Builder
+
+	b.WriteString(p.styles.title.Render("Go to"))
+	b.WriteString("\n\n")
+	b.WriteString(p.input.View())
+	b.WriteString("\n\n")
+
+	if len(p.matches) == 0 {
+		b.WriteString(p.styles.hint.Render("  No matches"))
+		b.WriteString("\n")
+	}
+
+	end := min(len(p.matches), p.offset+paletteMaxRows)
+	for i := p.offset; i < end; i++ {
+		b.WriteString(p.renderEntry(p.matches[i], i == p.cursor))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	hint := "↑/↓:select  enter:go  esc:close"
+	if len(p.matches) > paletteMaxRows {
+		hint = fmt.Sprintf("%d/%d  %s", p.cursor+1, len(p.matches), hint)
+	}
+	b.WriteString(p.styles.hint.Render(hint))
+
+	return b.String()
+}
+
+func (p *CommandPalette) renderEntry(e PaletteEntry, selected bool) string {
+	const kindWidth = 9
+	labelWidth := ModalWidthCommandPalette - 8 - kindWidth
+
+	prefix := "  "
+	labelStyle := p.styles.item
+	if selected {
+		prefix = "> "
+		labelStyle = p.styles.selected
+	}
+
+	label := TruncateOrPadString(e.Label, labelWidth/2)
+	detail := TruncateOrPadString(e.Detail, labelWidth-labelWidth/2)
+	return prefix + labelStyle.Render(label) + " " + p.styles.detail.Render(detail) + " " + p.styles.kind.Render(string(e.Kind))
+}
+
+func (p *CommandPalette) SetSize(width, height int) tea.Cmd {
+	p.width = width
+	p.height = height
+	return nil
+}
+
+func (p *CommandPalette) StatusLine() string {
+	return "Type to search • ↑/↓ select • Enter go • Esc close"
+}
+
+// HasActiveInput keeps keys like q and backspace in the search field
+func (p *CommandPalette) HasActiveInput() bool {
+	return true
+}
@@ -0,0 +1,141 @@
+package view
+
+import (
+	"context"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func newTestPaletteRegistry() *registry.Registry {
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{})
+	reg.RegisterCustom("ec2", "volumes", registry.Entry{})
+	reg.RegisterCustom("cloudformation", "stacks", registry.Entry{})
+	reg.RegisterCustom("lambda", "functions", registry.Entry{})
+	return reg
+}
+
+func typePalette(p *CommandPalette, s string) {
+	for _, r := range s {
+		p.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+}
+
+func TestCommandPalette_Filter(t *testing.T) {
+	p := NewCommandPalette(context.Background(), newTestPaletteRegistry())
+
+	typePalette(p, "ec2/vol")
+	if len(p.matches) == 0 || p.matches[0].Label != "ec2/volumes" {
+		t.Fatalf("first match = %v, want ec2/volumes", p.matches)
+	}
+
+	// Fuzzy: "cfst" matches cloudformation/stacks
+	p = NewCommandPalette(context.Background(), newTestPaletteRegistry())
+	typePalette(p, "cfst")
+	found := false
+	for _, m := range p.matches {
+		if m.Label == "cloudformation/stacks" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("fuzzy query did not match cloudformation/stacks: %v", p.matches)
+	}
+}
+
+func TestCommandPalette_PrefixRanksFirst(t *testing.T) {
+	p := NewCommandPalette(context.Background(), newTestPaletteRegistry())
+	typePalette(p, "la")
+	if len(p.matches) == 0 || p.matches[0].Label != "lambda" {
+		t.Fatalf("first match = %v, want lambda", p.matches)
+	}
+}
+
+func TestCommandPalette_SelectCommand(t *testing.T) {
+	p := NewCommandPalette(context.Background(), newTestPaletteRegistry())
+	typePalette(p, "pulse")
+
+	_, cmd := p.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter returned nil cmd")
+	}
+	msg, ok := cmd().(PaletteSelectMsg)
+	if !ok {
+		t.Fatalf("enter returned %T, want PaletteSelectMsg", cmd())
+	}
+	if msg.Command != "pulse" || msg.Nav != nil {
+		t.Errorf("got %+v, want Command=pulse", msg)
+	}
+}
+
+func TestCommandPalette_CursorMovement(t *testing.T) {
+	p := NewCommandPalette(context.Background(), newTestPaletteRegistry())
+	typePalette(p, "ec2/")
+
+	p.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	if p.cursor != 1 {
+		t.Errorf("cursor = %d, want 1", p.cursor)
+	}
+	for range len(p.matches) {
+		p.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	}
+	if p.cursor != len(p.matches)-1 {
+		t.Errorf("cursor = %d, want last match %d", p.cursor, len(p.matches)-1)
+	}
+	p.Update(tea.KeyPressMsg{Code: tea.KeyUp})
+	if p.cursor != len(p.matches)-2 {
+		t.Errorf("cursor = %d, want %d", p.cursor, len(p.matches)-2)
+	}
+}
+
+func TestCommandPalette_Esc(t *testing.T) {
+	p := NewCommandPalette(context.Background(), newTestPaletteRegistry())
+	_, cmd := p.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if cmd == nil {
+		t.Fatal("esc returned nil cmd")
+	}
+	if _, ok := cmd().(HideModalMsg); !ok {
+		t.Errorf("esc returned %T, want HideModalMsg", cmd())
+	}
+}
+
+func TestRecordRecentResource(t *testing.T) {
+	recentMu.Lock()
+	saved := recentResources
+	recentResources = nil
+	recentMu.Unlock()
+	t.Cleanup(func() {
+		recentMu.Lock()
+		recentResources = saved
+		recentMu.Unlock()
+	})
+
+	a := &mockResource{id: "i-1", name: "web"}
+	b := &mockResource{id: "i-2", name: "db"}
+	recordRecentResource("ec2", "instances", a)
+	recordRecentResource("ec2", "instances", b)
+	recordRecentResource("ec2", "instances", a)
+
+	recent := RecentResources()
+	if len(recent) != 2 {
+		t.Fatalf("len = %d, want 2 (duplicates collapsed)", len(recent))
+	}
+	if recent[0].Resource.GetID() != "i-1" || recent[1].Resource.GetID() != "i-2" {
+		t.Errorf("order = %s, %s; want i-1, i-2", recent[0].Resource.GetID(), recent[1].Resource.GetID())
+	}
+
+	for i := range maxRecentResources + 5 {
+		recordRecentResource("s3", "buckets", &mockResource{id: string(rune('a' + i))})
+	}
+	if got := len(RecentResources()); got != maxRecentResources {
+		t.Errorf("len = %d, want %d", got, maxRecentResources)
+	}
+
+	p := NewCommandPalette(context.Background(), newTestPaletteRegistry())
+	if p.matches[0].Kind != PaletteRecent {
+		t.Errorf("first entry kind = %s, want recent", p.matches[0].Kind)
+	}
+}
@@ -0,0 +1,148 @@
+package view
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// NewContainerLogView creates a LogView that tails the log streams of all
+// containers of a task together, prefixing each line with its container.
+// title names the task in the header.
+func NewContainerLogView(ctx context.Context, title string, containers []render.ContainerLog) *LogView {
+	v := NewLogView(ctx, containers[0].LogGroup)
+	v.title = title
+	v.containers = containers
+	v.streamContainers = make(map[string]string, len(containers))
+	for _, c := range containers {
+		v.streamContainers[c.LogStream] = c.Container
+	}
+	return v
+}
+
+// containerStyles returns the prefix colors assigned to containers in order.
+func containerStyles() []lipgloss.Style {
+	return []lipgloss.Style{
+		ui.AccentStyle(),
+		ui.SuccessStyle(),
+		ui.WarningStyle(),
+		ui.HighlightStyle(),
+		ui.SecondaryStyle(),
+		ui.DangerStyle(),
+	}
+}
+
+// filterContainerLogEvents runs the base query once per log group for the
+// containers' streams and merges the results by time. When a group hits the
+// fetch limit, newer events of other groups are held back for the next poll
+// so that group's remaining events are not skipped.
+func (v *LogView) filterContainerLogEvents(ctx context.Context, base *cloudwatchlogs.FilterLogEventsInput, older bool) ([]types.FilteredLogEvent, error) {
+	var groups []string
+	streams := make(map[string][]string)
+	for _, c := range v.containers {
+		if _, ok := streams[c.LogGroup]; !ok {
+			groups = append(groups, c.LogGroup)
+		}
+		streams[c.LogGroup] = append(streams[c.LogGroup], c.LogStream)
+	}
+
+	var events []types.FilteredLogEvent
+	var cutoff int64
+	for _, group := range groups {
+		input := *base
+		input.LogGroupName = appaws.StringPtr(group)
+		input.LogStreamNames = streams[group]
+		input.LogStreamNamePrefix = nil
+
+		output, err := v.client.FilterLogEvents(ctx, &input)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(output.Events); n == logFetchLimit {
+			last := appaws.Int64(output.Events[n-1].Timestamp)
+			if cutoff == 0 || last < cutoff {
+				cutoff = last
+			}
+		}
+		events = append(events, output.Events...)
+	}
+
+	return mergeContainerEvents(events, cutoff, older), nil
+}
+
+// mergeContainerEvents orders events by time and, for forward tailing, drops
+// events newer than cutoff (0 means no cutoff).
+func mergeContainerEvents(events []types.FilteredLogEvent, cutoff int64, older bool) []types.FilteredLogEvent {
+	slices.SortStableFunc(events, func(a, b types.FilteredLogEvent) int {
+		return cmp.Compare(appaws.Int64(a.Timestamp), appaws.Int64(b.Timestamp))
+	})
+	if older || cutoff == 0 {
+		return events
+	}
+	n := len(events)
+	for n > 0 && appaws.Int64(events[n-1].Timestamp) > cutoff {
+		n--
+	}
+	return events[:n]
+}
+
+// cycleContainerFilter steps through all containers, then back to showing all.
+func (v *LogView) cycleContainerFilter() {
+	i := slices.IndexFunc(v.containers, func(c render.ContainerLog) bool {
+		return c.Container == v.containerFilter
+	})
+	if i+1 < len(v.containers) {
+		v.containerFilter = v.containers[i+1].Container
+	} else {
+		v.containerFilter = ""
+	}
+}
+
+// containerStyle returns the prefix color of a container.
+func (v *LogView) containerStyle(name string) lipgloss.Style {
+	i := slices.IndexFunc(v.containers, func(c render.ContainerLog) bool {
+		return c.Container == name
+	})
+	if i < 0 || len(v.styles.containers) == 0 {
+		return v.styles.dim
+	}
+	return v.styles.containers[i%len(v.styles.containers)]
+}
+
+// containerPrefix renders "[name]" padded to the longest container name.
+func (v *LogView) containerPrefix(name string) string {
+	width := 0
+	for _, c := range v.containers {
+		width = max(width, len(c.Container))
+	}
+	return v.containerStyle(name).Render(fmt.Sprintf("%-*s", width+2, "["+name+"]"))
+}
+
+// containerLegend lists the containers in their colors, marking the filter.
+func (v *LogView) containerLegend() string {
+	parts := make([]string, 0, len(v.containers))
+	for _, c := range v.containers {
+		label := c.Container
+		if v.containerFilter != "" && c.Container != v.containerFilter {
+			parts = append(parts, v.styles.dim.Render(label))
+			continue
+		}
+		parts = append(parts, v.containerStyle(c.Container).Render(label))
+	}
+	legend := strings.Join(parts, "  ")
+	if v.containerFilter != "" {
+		legend += v.styles.dim.Render("  (showing " + v.containerFilter + " only)")
+	}
+	return legend
+}
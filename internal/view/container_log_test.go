@@ -0,0 +1,98 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/clawscli/claws/internal/render"
+)
+
+func testContainerLogView() *LogView {
+	return NewContainerLogView(context.Background(), "abc123", []render.ContainerLog{
+		{Container: "web", LogGroup: "/ecs/app", LogStream: "ecs/web/abc123"},
+		{Container: "sidecar", LogGroup: "/ecs/app", LogStream: "ecs/sidecar/abc123"},
+	})
+}
+
+func TestMergeContainerEvents(t *testing.T) {
+	event := func(ts int64) types.FilteredLogEvent {
+		return types.FilteredLogEvent{Timestamp: aws.Int64(ts)}
+	}
+	events := []types.FilteredLogEvent{event(30), event(10), event(50), event(20)}
+
+	got := mergeContainerEvents(events, 30, false)
+	if len(got) != 3 || *got[0].Timestamp != 10 || *got[2].Timestamp != 30 {
+		t.Errorf("mergeContainerEvents(cutoff 30) = %v events, first %d", len(got), *got[0].Timestamp)
+	}
+	if got := mergeContainerEvents(events, 0, false); len(got) != 4 {
+		t.Errorf("mergeContainerEvents(no cutoff) = %d events, want 4", len(got))
+	}
+	if got := mergeContainerEvents(events, 20, true); len(got) != 4 {
+		t.Errorf("mergeContainerEvents(older) = %d events, want 4", len(got))
+	}
+}
+
+func TestContainerLogViewProcessesStreams(t *testing.T) {
+	v := testContainerLogView()
+	msg := v.processLogEvents([]types.FilteredLogEvent{
+		{Timestamp: aws.Int64(1000), Message: aws.String("GET /\n"), LogStreamName: aws.String("ecs/web/abc123")},
+		{Timestamp: aws.Int64(2000), Message: aws.String("synced"), LogStreamName: aws.String("ecs/sidecar/abc123")},
+	}, false)
+
+	if len(msg.entries) != 2 || msg.entries[0].container != "web" || msg.entries[1].container != "sidecar" {
+		t.Errorf("processLogEvents() entries = %+v", msg.entries)
+	}
+	if msg.entries[0].message != "GET /" {
+		t.Errorf("message = %q, want trailing newline trimmed", msg.entries[0].message)
+	}
+}
+
+func TestContainerLogViewFilterCycle(t *testing.T) {
+	v := testContainerLogView()
+	v.SetSize(120, 24)
+	v.Update(logsLoadedMsg{entries: []logEntry{
+		{timestamp: time.Now(), message: "request served", container: "web"},
+		{timestamp: time.Now(), message: "cache synced", container: "sidecar"},
+	}})
+
+	out := v.ViewString()
+	for _, want := range []string{"abc123", "[web]", "[sidecar]", "request served", "cache synced"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("ViewString() missing %q:\n%s", want, out)
+		}
+	}
+
+	press := func() { v.Update(tea.KeyPressMsg{Code: 't', Text: "t"}) }
+
+	press()
+	if v.containerFilter != "web" || v.getDisplayedCount() != 1 {
+		t.Errorf("after 1 press: filter=%q displayed=%d", v.containerFilter, v.getDisplayedCount())
+	}
+	if out := v.ViewString(); strings.Contains(out, "cache synced") || !strings.Contains(out, "showing web only") {
+		t.Errorf("ViewString() with web filter:\n%s", out)
+	}
+
+	press()
+	if v.containerFilter != "sidecar" {
+		t.Errorf("after 2 presses: filter=%q, want sidecar", v.containerFilter)
+	}
+	press()
+	if v.containerFilter != "" || v.getDisplayedCount() != 2 {
+		t.Errorf("after 3 presses: filter=%q displayed=%d, want all", v.containerFilter, v.getDisplayedCount())
+	}
+}
+
+func TestContainerLogViewStatusLine(t *testing.T) {
+	if !strings.Contains(testContainerLogView().StatusLine(), "t:container") {
+		t.Error("StatusLine() missing container toggle")
+	}
+	if strings.Contains(NewLogView(context.Background(), "/aws/lambda/fn").StatusLine(), "t:container") {
+		t.Error("StatusLine() of a plain log view shows container toggle")
+	}
+}
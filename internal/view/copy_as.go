@@ -0,0 +1,44 @@
+package view
+
+import (
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/copyas"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// copyAsCmd generates a snippet for res in format and copies it to the
+// clipboard, using the resource's own region and profile when it has them.
+func copyAsCmd(format, service, resType string, res dao.Resource) tea.Cmd {
+	if res == nil {
+		return nil
+	}
+
+	region := dao.GetResourceRegion(res)
+	if region == "" {
+		region = config.Global().Region()
+	}
+	sel := config.Global().Selection()
+	if id := dao.GetResourceProfile(res); id != "" {
+		sel = config.ProfileSelectionFromID(id)
+	}
+	profile := ""
+	if sel.IsNamedProfile() {
+		profile = sel.ProfileName
+	}
+
+	target := copyas.NewTarget(service, resType, dao.UnwrapResource(res), region, profile)
+	snippet, err := copyas.Generate(format, target)
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	return clipboard.Copy(copyAsLabels[format], snippet)
+}
+
+var copyAsLabels = map[string]string{
+	copyas.FormatCLI:       "aws CLI command",
+	copyas.FormatTerraform: "terraform import",
+	copyas.FormatBoto3:     "boto3 snippet",
+}
@@ -8,7 +8,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
-	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/config"
 	navmsg "github.com/clawscli/claws/internal/msg"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/ui"
@@ -49,6 +49,10 @@ type DashboardView struct {
 	spinner     spinner.Model
 	styles      dashboardStyles
 
+	// widgets are the panels, in the order configured by dashboard.widgets
+	widgets []dashboardWidget
+	columns int
+
 	hitAreas         []hitArea
 	hoverIdx         int
 	focusedPanel     int
@@ -56,124 +60,55 @@ type DashboardView struct {
 	lastPanelWidth   int
 	lastPanelHeight  int
 	lastHeaderHeight int
-
-	alarms       []alarmItem
-	alarmLoading bool
-	alarmErr     error
-
-	costMTD     float64
-	costTop     []costItem
-	costLoading bool
-	costErr     error
-
-	anomalyCount   int
-	anomalyLoading bool
-	anomalyErr     error
-
-	healthItems   []healthItem
-	healthLoading bool
-	healthErr     error
-
-	secItems   []securityItem
-	secLoading bool
-	secErr     error
-
-	taItems   []taItem
-	taSavings float64
-	taLoading bool
-	taErr     error
 }
 
 func NewDashboardView(ctx context.Context, reg *registry.Registry) *DashboardView {
 	hp := NewHeaderPanel()
 	hp.SetWidth(120)
 
+	widgets := newDashboardWidgets(config.File().DashboardWidgets(), reg)
 	return &DashboardView{
-		ctx:            ctx,
-		registry:       reg,
-		headerPanel:    hp,
-		spinner:        ui.NewSpinner(),
-		styles:         newDashboardStyles(),
-		alarmLoading:   true,
-		costLoading:    true,
-		anomalyLoading: true,
-		healthLoading:  true,
-		secLoading:     true,
-		taLoading:      true,
-		hoverIdx:       -1,
-		focusedPanel:   panelCost,
-		focusedRow:     -1,
+		ctx:          ctx,
+		registry:     reg,
+		headerPanel:  hp,
+		spinner:      ui.NewSpinner(),
+		styles:       newDashboardStyles(),
+		widgets:      widgets,
+		columns:      dashboardColumns(len(widgets)),
+		hoverIdx:     -1,
+		focusedPanel: 0,
+		focusedRow:   -1,
 	}
 }
 
 func (d *DashboardView) Init() tea.Cmd {
-	return tea.Batch(
-		d.spinner.Tick,
-		d.loadAlarms,
-		d.loadCosts,
-		d.loadAnomalies,
-		d.loadHealth,
-		d.loadSecurity,
-		d.loadTrustedAdvisor,
-	)
+	cmds := []tea.Cmd{d.spinner.Tick}
+	for i := range d.widgets {
+		cmds = append(cmds, d.loadWidget(i))
+	}
+	return tea.Batch(cmds...)
+}
+
+// loadWidget starts the widget's fetches, routing each result back to it
+func (d *DashboardView) loadWidget(i int) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, load := range d.widgets[i].Load() {
+		cmds = append(cmds, func() tea.Msg {
+			return widgetMsg{index: i, msg: load(d.ctx)}
+		})
+	}
+	return tea.Batch(cmds...)
 }
 
 func (d *DashboardView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
-	case alarmLoadedMsg:
-		d.alarmLoading = false
-		d.alarms = msg.items
-		return d, nil
-	case alarmErrorMsg:
-		d.alarmLoading = false
-		d.alarmErr = msg.err
-		return d, nil
-
-	case costLoadedMsg:
-		d.costLoading = false
-		d.costMTD = msg.mtd
-		d.costTop = msg.topCosts
-		return d, nil
-	case costErrorMsg:
-		d.costLoading = false
-		d.costErr = msg.err
-		return d, nil
-
-	case anomalyLoadedMsg:
-		d.anomalyLoading = false
-		d.anomalyCount = msg.count
-		return d, nil
-	case anomalyErrorMsg:
-		d.anomalyLoading = false
-		d.anomalyErr = msg.err
-		return d, nil
-
-	case healthLoadedMsg:
-		d.healthLoading = false
-		d.healthItems = msg.items
-		return d, nil
-	case healthErrorMsg:
-		d.healthLoading = false
-		d.healthErr = msg.err
-		return d, nil
-
-	case securityLoadedMsg:
-		d.secLoading = false
-		d.secItems = msg.items
-		return d, nil
-	case securityErrorMsg:
-		d.secLoading = false
-		d.secErr = msg.err
-		return d, nil
-
-	case taLoadedMsg:
-		d.taLoading = false
-		d.taItems = msg.items
-		d.taSavings = msg.savings
-		return d, nil
-	case taErrorMsg:
-		d.taLoading = false
-		d.taErr = msg.err
+	case widgetMsg:
+		if msg.index >= 0 && msg.index < len(d.widgets) {
+			d.widgets[msg.index].Update(msg.msg)
+			if msg.index == d.focusedPanel && d.focusedRow >= 0 {
+				d.clampFocusedRow()
+			}
+		}
 		return d, nil
 
 	case spinner.TickMsg:
@@ -235,91 +170,7 @@ func (d *DashboardView) hitTestRow(x, y int) (panelIdx, rowIdx int) {
 		return panelIdx, -1
 	}
 
-	rowIdx = d.computeRowFromContentLine(panelIdx, rowY)
-	return panelIdx, rowIdx
-}
-
-func (d *DashboardView) computeRowFromContentLine(panelIdx, lineY int) int {
-	switch panelIdx {
-	case panelCost:
-		if lineY == 0 {
-			return -1
-		}
-		rowIdx := lineY - 1
-		if rowIdx >= 0 && rowIdx < len(d.costTop) {
-			return rowIdx
-		}
-
-	case panelOperations:
-		line := 0
-		if len(d.alarms) > 0 {
-			line++
-			for i := range d.alarms {
-				if lineY == line {
-					return i
-				}
-				line++
-			}
-		} else {
-			line++
-		}
-		if len(d.healthItems) > 0 {
-			line++
-			alarmCount := len(d.alarms)
-			for i := range d.healthItems {
-				if lineY == line {
-					return alarmCount + i
-				}
-				line++
-			}
-		}
-
-	case panelSecurity:
-		headerLines := 0
-		for _, item := range d.secItems {
-			if item.severity == "CRITICAL" {
-				headerLines = 1
-				break
-			}
-		}
-		for _, item := range d.secItems {
-			if item.severity == "HIGH" {
-				if headerLines == 0 {
-					headerLines = 1
-				} else {
-					headerLines = 2
-				}
-				break
-			}
-		}
-		rowIdx := lineY - headerLines
-		if rowIdx >= 0 && rowIdx < len(d.secItems) {
-			return rowIdx
-		}
-
-	case panelOptimization:
-		headerLines := 0
-		for _, item := range d.taItems {
-			if item.status == "error" {
-				headerLines++
-				break
-			}
-		}
-		for _, item := range d.taItems {
-			if item.status == "warning" {
-				headerLines++
-				break
-			}
-		}
-		if d.taSavings > 0 {
-			headerLines++
-		}
-		rowIdx := lineY - headerLines
-		if rowIdx >= 0 && rowIdx < len(d.taItems) {
-			return rowIdx
-		}
-	}
-	return -1
+	return panelIdx, d.widgets[panelIdx].RowAt(rowY)
 }
 
 func (d *DashboardView) navigateTo(target string) (tea.Model, tea.Cmd) {
@@ -334,25 +185,11 @@ func (d *DashboardView) navigateTo(target string) (tea.Model, tea.Cmd) {
 	}
 }
 
-func (d *DashboardView) navigateToFiltered(service, resType, filterKey, filterVal string) (tea.Model, tea.Cmd) {
-	browser := NewResourceBrowserWithFilter(d.ctx, d.registry, service, resType, filterKey, filterVal)
-	return d, func() tea.Msg {
-		return NavigateMsg{View: browser}
-	}
-}
-
 func (d *DashboardView) getRowCount(panelIdx int) int {
-	switch panelIdx {
-	case panelCost:
-		return len(d.costTop)
-	case panelOperations:
-		return len(d.alarms) + len(d.healthItems)
-	case panelSecurity:
-		return len(d.secItems)
-	case panelOptimization:
-		return len(d.taItems)
+	if panelIdx < 0 || panelIdx >= len(d.widgets) {
+		return 0
 	}
-	return 0
+	return d.widgets[panelIdx].Rows()
 }
 
 func (d *DashboardView) clampFocusedRow() {
@@ -388,133 +225,101 @@ func (d *DashboardView) moveRowFocus(delta int) {
 }
 
 func (d *DashboardView) cyclePanelFocus(delta int) {
-	d.focusedPanel = (d.focusedPanel + delta + 4) % 4
+	n := len(d.widgets)
+	d.focusedPanel = (d.focusedPanel + delta + n) % n
 	d.hoverIdx = d.focusedPanel
 	d.clampFocusedRow()
 }
 
 func (d *DashboardView) panelTarget(panelIdx int) string {
-	switch panelIdx {
-	case panelCost:
-		return targetCost
-	case panelOperations:
-		return targetOperations
-	case panelSecurity:
-		return targetSecurity
-	case panelOptimization:
-		return targetOptimization
+	if panelIdx < 0 || panelIdx >= len(d.widgets) {
+		return ""
+	}
+	return d.widgets[panelIdx].Target()
+}
+
+func (d *DashboardView) widgetEnv() *widgetEnv {
+	return &widgetEnv{
+		ctx:      d.ctx,
+		registry: d.registry,
+		styles:   d.styles,
+		theme:    ui.Current(),
+		spinner:  d.spinner.View(),
 	}
-	return ""
 }
 
-func (d *DashboardView) openDetailViewForResource(resource dao.Resource, service, resType string) (tea.Model, tea.Cmd) {
-	renderer, err := d.registry.GetRenderer(service, resType)
-	if err != nil {
-		return d.navigateTo(service + "/" + resType)
+// togglePinned pins the focused recent resource, or unpins the focused
+// favorite, then reloads every history panel
+func (d *DashboardView) togglePinned() (tea.Model, tea.Cmd) {
+	if d.focusedPanel < 0 || d.focusedPanel >= len(d.widgets) {
+		return d, nil
+	}
+	hw, ok := d.widgets[d.focusedPanel].(historyWidget)
+	if !ok {
+		return d, nil
 	}
-	daoInst, err := d.registry.GetDAO(d.ctx, service, resType)
-	if err != nil {
-		daoInst = nil
+	e, ok := hw.entryAt(d.focusedRow)
+	if !ok {
+		return d, nil
 	}
-	detailView := NewDetailView(d.ctx, resource, renderer, service, resType, d.registry, daoInst)
-	return d, func() tea.Msg {
-		return NavigateMsg{View: detailView}
+	var reloads []tea.Cmd
+	for i, w := range d.widgets {
+		if _, ok := w.(historyWidget); ok {
+			reloads = append(reloads, d.loadWidget(i))
+		}
 	}
+	return d, tea.Sequence(toggleFavorite(e), tea.Batch(reloads...))
 }
 
 func (d *DashboardView) activateCurrentRow() (tea.Model, tea.Cmd) {
-	if d.focusedRow < 0 {
-		return d.navigateTo(d.panelTarget(d.focusedPanel))
+	if d.focusedPanel < 0 || d.focusedPanel >= len(d.widgets) {
+		return d, nil
 	}
-
-	switch d.focusedPanel {
-	case panelCost:
-		if d.focusedRow < len(d.costTop) {
-			item := d.costTop[d.focusedRow]
-			return d.navigateToFiltered("ce", "costs", "ServiceName", item.service)
-		}
-
-	case panelOperations:
-		alarmCount := len(d.alarms)
-		if d.focusedRow < alarmCount {
-			item := d.alarms[d.focusedRow]
-			if item.resource != nil {
-				return d.openDetailViewForResource(item.resource, "cloudwatch", "alarms")
-			}
-		} else {
-			healthIdx := d.focusedRow - alarmCount
-			if healthIdx < len(d.healthItems) {
-				item := d.healthItems[healthIdx]
-				if item.resource != nil {
-					return d.openDetailViewForResource(item.resource, "health", "events")
-				}
-			}
-		}
-
-	case panelSecurity:
-		if d.focusedRow < len(d.secItems) {
-			item := d.secItems[d.focusedRow]
-			if item.resource != nil {
-				return d.openDetailViewForResource(item.resource, "securityhub", "findings")
-			}
-		}
-
-	case panelOptimization:
-		if d.focusedRow < len(d.taItems) {
-			item := d.taItems[d.focusedRow]
-			if item.resource != nil {
-				return d.openDetailViewForResource(item.resource, "trustedadvisor", "recommendations")
-			}
-		}
+	if cmd := d.widgets[d.focusedPanel].Activate(d.widgetEnv(), d.focusedRow); cmd != nil {
+		return d, cmd
 	}
-
 	return d.navigateTo(d.panelTarget(d.focusedPanel))
 }
 
 func (d *DashboardView) isLoading() bool {
-	return d.alarmLoading || d.costLoading || d.anomalyLoading ||
-		d.healthLoading || d.secLoading || d.taLoading
+	for _, w := range d.widgets {
+		if w.Loading() {
+			return true
+		}
+	}
+	return false
 }
 
 func (d *DashboardView) ViewString() string {
 	header := d.headerPanel.RenderHome()
 	headerHeight := d.headerPanel.Height(header)
 	t := ui.Current()
+	env := d.widgetEnv()
 
 	panelWidth := d.calcPanelWidth()
 	panelHeight := d.calcPanelHeight(headerHeight)
 	contentWidth := panelWidth - 4
 	contentHeight := panelHeight - 3
 
-	costFocusRow := -1
-	opsFocusRow := -1
-	secFocusRow := -1
-	optFocusRow := -1
-	switch d.focusedPanel {
-	case panelCost:
-		costFocusRow = d.focusedRow
-	case panelOperations:
-		opsFocusRow = d.focusedRow
-	case panelSecurity:
-		secFocusRow = d.focusedRow
-	case panelOptimization:
-		optFocusRow = d.focusedRow
-	}
-
-	costContent := d.renderCostContent(contentWidth, contentHeight, t, costFocusRow)
-	opsContent := d.renderOpsContent(contentWidth, contentHeight, opsFocusRow)
-	secContent := d.renderSecurityContent(contentWidth, contentHeight, secFocusRow)
-	optContent := d.renderOptimizationContent(contentWidth, contentHeight, optFocusRow)
-
-	costPanel := renderPanel("Cost", costContent, panelWidth, panelHeight, t, d.hoverIdx == panelCost)
-	opsPanel := renderPanel("Operations", opsContent, panelWidth, panelHeight, t, d.hoverIdx == panelOperations)
-	secPanel := renderPanel("Security", secContent, panelWidth, panelHeight, t, d.hoverIdx == panelSecurity)
-	optPanel := renderPanel("Optimization", optContent, panelWidth, panelHeight, t, d.hoverIdx == panelOptimization)
-
 	gap := strings.Repeat(" ", panelGap)
-	topRow := lipgloss.JoinHorizontal(lipgloss.Top, costPanel, gap, opsPanel)
-	bottomRow := lipgloss.JoinHorizontal(lipgloss.Top, secPanel, gap, optPanel)
-	grid := lipgloss.JoinVertical(lipgloss.Left, topRow, bottomRow)
+	var rows []string
+	for start := 0; start < len(d.widgets); start += d.columns {
+		var panels []string
+		for i := start; i < min(start+d.columns, len(d.widgets)); i++ {
+			focusRow := -1
+			if i == d.focusedPanel {
+				focusRow = d.focusedRow
+			}
+			w := d.widgets[i]
+			content := w.Render(env, contentWidth, contentHeight, focusRow)
+			if len(panels) > 0 {
+				panels = append(panels, gap)
+			}
+			panels = append(panels, renderPanel(w.Title(), content, panelWidth, panelHeight, t, d.hoverIdx == i))
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, panels...))
+	}
+	grid := lipgloss.JoinVertical(lipgloss.Left, rows...)
 
 	if panelWidth != d.lastPanelWidth || panelHeight != d.lastPanelHeight || headerHeight != d.lastHeaderHeight {
 		d.buildHitAreas(panelWidth, panelHeight, headerHeight)
@@ -530,26 +335,25 @@ func (d *DashboardView) buildHitAreas(panelWidth, panelHeight, headerHeight int)
 	d.hitAreas = d.hitAreas[:0]
 
 	topRowY := headerHeight + 1
-	bottomRowY := topRowY + panelHeight
-
-	leftX1, leftX2 := 0, panelWidth
-	rightX1, rightX2 := panelWidth+panelGap, panelWidth+panelGap+panelWidth
-
-	d.hitAreas = append(d.hitAreas,
-		hitArea{y1: topRowY, y2: topRowY + panelHeight - 1, x1: leftX1, x2: leftX2, target: targetCost},
-		hitArea{y1: topRowY, y2: topRowY + panelHeight - 1, x1: rightX1, x2: rightX2, target: targetOperations},
-		hitArea{y1: bottomRowY, y2: bottomRowY + panelHeight - 1, x1: leftX1, x2: leftX2, target: targetSecurity},
-		hitArea{y1: bottomRowY, y2: bottomRowY + panelHeight - 1, x1: rightX1, x2: rightX2, target: targetOptimization},
-	)
+	for i, w := range d.widgets {
+		y1 := topRowY + (i/d.columns)*panelHeight
+		x1 := (i % d.columns) * (panelWidth + panelGap)
+		d.hitAreas = append(d.hitAreas, hitArea{
+			y1: y1, y2: y1 + panelHeight - 1,
+			x1: x1, x2: x1 + panelWidth,
+			target: w.Target(),
+		})
+	}
 }
 
 func (d *DashboardView) calcPanelWidth() int {
-	return max((d.width-panelGap)/2, minPanelWidth)
+	return max((d.width-panelGap*(d.columns-1))/d.columns, minPanelWidth)
 }
 
 func (d *DashboardView) calcPanelHeight(headerHeight int) int {
 	available := d.height - headerHeight + 1
-	return max(available/2, minPanelHeight)
+	rows := (len(d.widgets) + d.columns - 1) / d.columns
+	return max(available/max(rows, 1), minPanelHeight)
 }
 
 func (d *DashboardView) View() tea.View {
@@ -564,7 +368,7 @@ func (d *DashboardView) SetSize(width, height int) tea.Cmd {
 }
 
 func (d *DashboardView) StatusLine() string {
-	return "h/l:panel • j/k:row • enter:select • s:services • R:region • P:profile • Ctrl+r:refresh • ?:help"
+	return "h/l:panel • j/k:row • enter:select • *:pin • s:services • R:region • P:profile • Ctrl+r:refresh • ?:help"
 }
 
 func (d *DashboardView) CanRefresh() bool {
@@ -1,18 +1,27 @@
 package view
 
 import (
+	"context"
+	"errors"
 	"sort"
 	"strconv"
+	"sync"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/custom/ce/anomalies"
 	"github.com/clawscli/claws/custom/ce/costs"
+	ctevents "github.com/clawscli/claws/custom/cloudtrail/events"
 	"github.com/clawscli/claws/custom/cloudwatch/alarms"
+	"github.com/clawscli/claws/custom/ec2/instances"
 	"github.com/clawscli/claws/custom/health/events"
 	"github.com/clawscli/claws/custom/securityhub/findings"
 	"github.com/clawscli/claws/custom/trustedadvisor/recommendations"
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/history"
 )
 
 type alarmItem struct {
@@ -45,6 +54,26 @@ type taItem struct {
 	resource *recommendations.RecommendationResource
 }
 
+type ec2CountItem struct {
+	region string
+	count  int
+}
+
+type trailItem struct {
+	event    string
+	user     string
+	at       *time.Time
+	resource *ctevents.EventResource
+}
+
+// dashboardMaxRecent bounds how many history entries the dashboard reads
+const dashboardMaxRecent = 20
+
+type historyEntriesMsg struct {
+	entries []history.Entry
+	err     error
+}
+
 type alarmLoadedMsg struct{ items []alarmItem }
 type alarmErrorMsg struct{ err error }
 
@@ -69,18 +98,23 @@ type taLoadedMsg struct {
 }
 type taErrorMsg struct{ err error }
 
-func (d *DashboardView) loadAlarms() tea.Msg {
-	if d.ctx.Err() != nil {
-		return alarmErrorMsg{err: d.ctx.Err()}
+type ec2CountLoadedMsg struct{ items []ec2CountItem }
+type ec2CountErrorMsg struct{ err error }
+
+type trailLoadedMsg struct{ items []trailItem }
+type trailErrorMsg struct{ err error }
+
+func loadAlarms(ctx context.Context) tea.Msg {
+	if ctx.Err() != nil {
+		return alarmErrorMsg{err: ctx.Err()}
 	}
 
-	alarmDAO, err := alarms.NewAlarmDAO(d.ctx)
+	alarmDAO, err := alarms.NewAlarmDAO(ctx)
 	if err != nil {
 		return alarmErrorMsg{err: err}
 	}
 
-	ctx := dao.WithFilter(d.ctx, "StateValue", "ALARM")
-	resources, err := alarmDAO.List(ctx)
+	resources, err := alarmDAO.List(dao.WithFilter(ctx, "StateValue", "ALARM"))
 	if err != nil {
 		return alarmErrorMsg{err: err}
 	}
@@ -98,17 +132,17 @@ func (d *DashboardView) loadAlarms() tea.Msg {
 	return alarmLoadedMsg{items: items}
 }
 
-func (d *DashboardView) loadCosts() tea.Msg {
-	if d.ctx.Err() != nil {
-		return costErrorMsg{err: d.ctx.Err()}
+func loadCosts(ctx context.Context) tea.Msg {
+	if ctx.Err() != nil {
+		return costErrorMsg{err: ctx.Err()}
 	}
 
-	costDAO, err := costs.NewCostDAO(d.ctx)
+	costDAO, err := costs.NewCostDAO(ctx)
 	if err != nil {
 		return costErrorMsg{err: err}
 	}
 
-	resources, err := costDAO.List(d.ctx)
+	resources, err := costDAO.List(ctx)
 	if err != nil {
 		return costErrorMsg{err: err}
 	}
@@ -135,17 +169,17 @@ func (d *DashboardView) loadCosts() tea.Msg {
 	return costLoadedMsg{mtd: total, topCosts: items}
 }
 
-func (d *DashboardView) loadAnomalies() tea.Msg {
-	if d.ctx.Err() != nil {
-		return anomalyErrorMsg{err: d.ctx.Err()}
+func loadAnomalies(ctx context.Context) tea.Msg {
+	if ctx.Err() != nil {
+		return anomalyErrorMsg{err: ctx.Err()}
 	}
 
-	anomalyDAO, err := anomalies.NewAnomalyDAO(d.ctx)
+	anomalyDAO, err := anomalies.NewAnomalyDAO(ctx)
 	if err != nil {
 		return anomalyErrorMsg{err: err}
 	}
 
-	resources, err := anomalyDAO.List(d.ctx)
+	resources, err := anomalyDAO.List(ctx)
 	if err != nil {
 		return anomalyErrorMsg{err: err}
 	}
@@ -153,17 +187,17 @@ func (d *DashboardView) loadAnomalies() tea.Msg {
 	return anomalyLoadedMsg{count: len(resources)}
 }
 
-func (d *DashboardView) loadHealth() tea.Msg {
-	if d.ctx.Err() != nil {
-		return healthErrorMsg{err: d.ctx.Err()}
+func loadHealth(ctx context.Context) tea.Msg {
+	if ctx.Err() != nil {
+		return healthErrorMsg{err: ctx.Err()}
 	}
 
-	eventDAO, err := events.NewEventDAO(d.ctx)
+	eventDAO, err := events.NewEventDAO(ctx)
 	if err != nil {
 		return healthErrorMsg{err: err}
 	}
 
-	resources, err := eventDAO.List(d.ctx)
+	resources, err := eventDAO.List(ctx)
 	if err != nil {
 		return healthErrorMsg{err: err}
 	}
@@ -179,17 +213,17 @@ func (d *DashboardView) loadHealth() tea.Msg {
 	return healthLoadedMsg{items: items}
 }
 
-func (d *DashboardView) loadSecurity() tea.Msg {
-	if d.ctx.Err() != nil {
-		return securityErrorMsg{err: d.ctx.Err()}
+func loadSecurity(ctx context.Context) tea.Msg {
+	if ctx.Err() != nil {
+		return securityErrorMsg{err: ctx.Err()}
 	}
 
-	findingDAO, err := findings.NewFindingDAO(d.ctx)
+	findingDAO, err := findings.NewFindingDAO(ctx)
 	if err != nil {
 		return securityErrorMsg{err: err}
 	}
 
-	resources, err := findingDAO.List(d.ctx)
+	resources, err := findingDAO.List(ctx)
 	if err != nil {
 		return securityErrorMsg{err: err}
 	}
@@ -206,17 +240,17 @@ func (d *DashboardView) loadSecurity() tea.Msg {
 	return securityLoadedMsg{items: items}
 }
 
-func (d *DashboardView) loadTrustedAdvisor() tea.Msg {
-	if d.ctx.Err() != nil {
-		return taErrorMsg{err: d.ctx.Err()}
+func loadTrustedAdvisor(ctx context.Context) tea.Msg {
+	if ctx.Err() != nil {
+		return taErrorMsg{err: ctx.Err()}
 	}
 
-	taDAO, err := recommendations.NewRecommendationDAO(d.ctx)
+	taDAO, err := recommendations.NewRecommendationDAO(ctx)
 	if err != nil {
 		return taErrorMsg{err: err}
 	}
 
-	resources, err := taDAO.List(d.ctx)
+	resources, err := taDAO.List(ctx)
 	if err != nil {
 		return taErrorMsg{err: err}
 	}
@@ -234,3 +268,93 @@ func (d *DashboardView) loadTrustedAdvisor() tea.Msg {
 	}
 	return taLoadedMsg{items: items, savings: totalSavings}
 }
+
+// loadRecent reads recently viewed resources from :history
+func loadRecent(context.Context) tea.Msg {
+	entries, err := history.Load()
+	if err != nil {
+		return historyEntriesMsg{err: err}
+	}
+	var recent []history.Entry
+	for _, e := range entries {
+		if e.IsResource() {
+			recent = append(recent, e)
+			if len(recent) == dashboardMaxRecent {
+				break
+			}
+		}
+	}
+	return historyEntriesMsg{entries: recent}
+}
+
+// loadFavorites reads resources pinned with *
+func loadFavorites(context.Context) tea.Msg {
+	favorites, err := history.Favorites()
+	return historyEntriesMsg{entries: favorites, err: err}
+}
+
+// loadRunningInstances counts running EC2 instances in each selected region
+func loadRunningInstances(ctx context.Context) tea.Msg {
+	if ctx.Err() != nil {
+		return ec2CountErrorMsg{err: ctx.Err()}
+	}
+
+	regions := config.Global().Regions()
+	if len(regions) == 0 {
+		regions = []string{""} // the profile's default region
+	}
+	items := make([]ec2CountItem, len(regions))
+	errs := make([]error, len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		wg.Go(func() {
+			regionCtx := ctx
+			if region != "" {
+				regionCtx = aws.WithRegionOverride(ctx, region)
+			}
+			count, err := instances.CountRunning(regionCtx)
+			items[i] = ec2CountItem{region: region, count: count}
+			errs[i] = err
+		})
+	}
+	wg.Wait()
+
+	var ok []ec2CountItem
+	for i, item := range items {
+		if errs[i] == nil {
+			ok = append(ok, item)
+		}
+	}
+	if len(ok) == 0 {
+		return ec2CountErrorMsg{err: errors.Join(errs...)}
+	}
+	sort.SliceStable(ok, func(i, j int) bool {
+		return ok[i].count > ok[j].count
+	})
+	return ec2CountLoadedMsg{items: ok}
+}
+
+// loadTrailWrites fetches write events from the last 24 hours
+func loadTrailWrites(ctx context.Context) tea.Msg {
+	if ctx.Err() != nil {
+		return trailErrorMsg{err: ctx.Err()}
+	}
+
+	eventDAO, err := ctevents.NewEventDAO(ctx)
+	if err != nil {
+		return trailErrorMsg{err: err}
+	}
+
+	resources, err := eventDAO.List(dao.WithFilter(ctx, "ReadOnly", "false"))
+	if err != nil {
+		return trailErrorMsg{err: err}
+	}
+
+	items := make([]trailItem, 0, len(resources))
+	for _, r := range resources {
+		if er, ok := r.(*ctevents.EventResource); ok {
+			items = append(items, trailItem{event: er.EventName(), user: er.Username(), at: er.EventTime(), resource: er})
+		}
+	}
+	return trailLoadedMsg{items: items}
+}
@@ -33,6 +33,8 @@ func (d *DashboardView) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd)
 		d.cyclePanelFocus(-1)
 	case "enter":
 		return d.activateCurrentRow()
+	case "*":
+		return d.togglePinned()
 	}
 	return d, nil
 }
@@ -59,17 +61,21 @@ func (d *DashboardView) handleMouseMotion(msg tea.MouseMotionMsg) {
 }
 
 func (d *DashboardView) handleRefresh() (tea.Model, tea.Cmd) {
-	d.alarmLoading = true
-	d.costLoading = true
-	d.anomalyLoading = true
-	d.healthLoading = true
-	d.secLoading = true
-	d.taLoading = true
-	d.alarmErr = nil
-	d.costErr = nil
-	d.anomalyErr = nil
-	d.healthErr = nil
-	d.secErr = nil
-	d.taErr = nil
 	return d, d.Init()
 }
+
+// KeyHelp implements KeyHelper
+func (d *DashboardView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{
+		Title: "Dashboard",
+		Bindings: []KeyBinding{
+			{"←/h, →/l", "Previous / next panel"},
+			{"Tab, Shift+Tab", "Next / previous panel"},
+			{"↑/k, ↓/j", "Move within panel"},
+			{"Enter", "Open selected item"},
+			{"*", "Pin recent resource / unpin favorite"},
+			{"Ctrl+r", "Refresh"},
+			{"s, ~", "Go to services"},
+		},
+	}}
+}
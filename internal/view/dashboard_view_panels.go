@@ -1,22 +1,13 @@
 package view
 
 import (
-	"fmt"
 	"strings"
 
 	"charm.land/lipgloss/v2"
 
-	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/ui"
 )
 
-const (
-	panelCost = iota
-	panelOperations
-	panelSecurity
-	panelOptimization
-)
-
 const (
 	minPanelWidth  = 30
 	minPanelHeight = 6
@@ -25,9 +16,15 @@ const (
 	dashboardMaxRecords = 100
 
 	targetCost         = "ce/costs"
-	targetOperations   = "health/events"
+	targetHealth       = "health/events"
+	targetOperations   = targetHealth
+	targetAlarms       = "cloudwatch/alarms"
 	targetSecurity     = "securityhub/findings"
 	targetOptimization = "trustedadvisor/recommendations"
+	targetEC2          = "ec2/instances"
+	targetTrail        = "cloudtrail/events"
+	targetRecent       = "history"
+	targetFavorites    = "favorites"
 
 	costValueWidth     = 9
 	costPadding        = 2
@@ -36,6 +33,9 @@ const (
 	costNameWidthRatio = 60
 
 	bulletIndentWidth = 4
+
+	minPinnedNameWidth = 12
+	ec2CountWidth      = 6
 )
 
 func renderPanel(title, content string, width, height int, t *ui.Theme, hovered bool) string {
@@ -70,191 +70,3 @@ func renderBar(value, maxVal float64, width int, t *ui.Theme) string {
 	return barStyle.Render(strings.Repeat("█", filled)) +
 		emptyStyle.Render(strings.Repeat("░", width-filled))
 }
-
-func (d *DashboardView) renderCostContent(contentWidth, contentHeight int, t *ui.Theme, focusRow int) string {
-	s := d.styles
-	var lines []string
-
-	if d.costLoading {
-		lines = append(lines, s.text.Render(d.spinner.View()+" loading..."))
-	} else if d.costErr != nil {
-		lines = append(lines, s.dim.Render("Cost: N/A"))
-	} else {
-		lines = append(lines, s.text.Render("MTD: "+appaws.FormatMoney(d.costMTD, "")))
-
-		if len(d.costTop) > 0 {
-			maxCost := d.costTop[0].cost
-			available := contentWidth - costValueWidth - costPadding
-			nameWidth := available * costNameWidthRatio / 100
-			barWidth := available - nameWidth
-			nameWidth = max(nameWidth, minCostNameWidth)
-			barWidth = max(barWidth, minCostBarWidth)
-			maxServices := max(contentHeight-2, 3)
-			showCount := min(len(d.costTop), maxServices)
-
-			for i := range showCount {
-				c := d.costTop[i]
-				bar := renderBar(c.cost, maxCost, barWidth, t)
-				name := TruncateString(c.service, nameWidth)
-				line := fmt.Sprintf("%-*s %s %8.0f", nameWidth, name, bar, c.cost)
-				if i == focusRow {
-					line = s.highlight.Render(line)
-				} else {
-					line = s.text.Render(line)
-				}
-				lines = append(lines, line)
-			}
-		}
-
-		if d.anomalyLoading {
-			lines = append(lines, s.text.Render("Anomalies: "+d.spinner.View()))
-		} else if d.anomalyErr != nil {
-			lines = append(lines, s.text.Render("Anomalies: ")+s.dim.Render("N/A"))
-		} else if d.anomalyCount > 0 {
-			lines = append(lines, s.text.Render("Anomalies: ")+s.warning.Render(fmt.Sprintf("%d", d.anomalyCount)))
-		} else {
-			lines = append(lines, s.text.Render("Anomalies: ")+s.success.Render("0"))
-		}
-	}
-
-	return strings.Join(lines, "\n")
-}
-
-func (d *DashboardView) renderOpsContent(contentWidth, contentHeight int, focusRow int) string {
-	s := d.styles
-	var lines []string
-	alarmCount := len(d.alarms)
-
-	if d.alarmLoading {
-		lines = append(lines, s.text.Render("Alarms: "+d.spinner.View()))
-	} else if d.alarmErr != nil {
-		lines = append(lines, s.dim.Render("Alarms: N/A"))
-	} else if alarmCount > 0 {
-		lines = append(lines, s.danger.Render(fmt.Sprintf("Alarms: %d in ALARM", alarmCount)))
-		maxShow := min(alarmCount, contentHeight-3)
-		for i := range maxShow {
-			line := "  " + s.danger.Render("• ") + s.text.Render(TruncateString(d.alarms[i].name, contentWidth-bulletIndentWidth))
-			if i == focusRow {
-				line = s.highlight.Render(line)
-			}
-			lines = append(lines, line)
-		}
-	} else {
-		lines = append(lines, s.text.Render("Alarms: ")+s.success.Render("0 ✓"))
-	}
-
-	if d.healthLoading {
-		lines = append(lines, s.text.Render("Health: "+d.spinner.View()))
-	} else if d.healthErr != nil {
-		lines = append(lines, s.dim.Render("Health: N/A"))
-	} else if len(d.healthItems) > 0 {
-		lines = append(lines, s.warning.Render(fmt.Sprintf("Health: %d open", len(d.healthItems))))
-		remaining := contentHeight - len(lines) - 1
-		maxShow := min(len(d.healthItems), remaining)
-		for i := range maxShow {
-			h := d.healthItems[i]
-			line := "  " + s.warning.Render("• ") + s.text.Render(TruncateString(h.service+": "+h.eventType, contentWidth-bulletIndentWidth))
-			if alarmCount+i == focusRow {
-				line = s.highlight.Render(line)
-			}
-			lines = append(lines, line)
-		}
-	} else {
-		lines = append(lines, s.text.Render("Health: ")+s.success.Render("0 open ✓"))
-	}
-
-	return strings.Join(lines, "\n")
-}
-
-func (d *DashboardView) renderSecurityContent(contentWidth, contentHeight int, focusRow int) string {
-	s := d.styles
-	var lines []string
-
-	if d.secLoading {
-		lines = append(lines, s.text.Render(d.spinner.View()+" loading..."))
-	} else if d.secErr != nil {
-		lines = append(lines, s.dim.Render("Security: N/A"))
-	} else if len(d.secItems) > 0 {
-		var critical, high int
-		for _, item := range d.secItems {
-			switch item.severity {
-			case "CRITICAL":
-				critical++
-			case "HIGH":
-				high++
-			}
-		}
-		if critical > 0 {
-			lines = append(lines, s.danger.Render(fmt.Sprintf("Critical: %d 🔴", critical)))
-		}
-		if high > 0 {
-			lines = append(lines, s.warning.Render(fmt.Sprintf("High: %d 🟠", high)))
-		}
-		maxShow := min(len(d.secItems), contentHeight-len(lines)-1)
-		for i := range maxShow {
-			item := d.secItems[i]
-			style := s.warning
-			if item.severity == "CRITICAL" {
-				style = s.danger
-			}
-			line := "  " + style.Render("• ") + s.text.Render(TruncateString(item.title, contentWidth-bulletIndentWidth))
-			if i == focusRow {
-				line = s.highlight.Render(line)
-			}
-			lines = append(lines, line)
-		}
-	} else {
-		lines = append(lines, s.success.Render("No critical/high ✓"))
-	}
-
-	return strings.Join(lines, "\n")
-}
-
-func (d *DashboardView) renderOptimizationContent(contentWidth, contentHeight int, focusRow int) string {
-	s := d.styles
-	var lines []string
-
-	if d.taLoading {
-		lines = append(lines, s.text.Render(d.spinner.View()+" loading..."))
-	} else if d.taErr != nil {
-		lines = append(lines, s.dim.Render("Optimization: N/A"))
-	} else {
-		var errors, warnings int
-		for _, item := range d.taItems {
-			if item.status == "error" {
-				errors++
-			} else {
-				warnings++
-			}
-		}
-		if errors > 0 {
-			lines = append(lines, s.danger.Render(fmt.Sprintf("Errors: %d", errors)))
-		}
-		if warnings > 0 {
-			lines = append(lines, s.warning.Render(fmt.Sprintf("Warnings: %d", warnings)))
-		}
-		if d.taSavings > 0 {
-			lines = append(lines, s.success.Render("Savings: "+appaws.FormatMoney(d.taSavings, "")+"/mo 💰"))
-		}
-		if len(d.taItems) > 0 {
-			maxShow := min(len(d.taItems), contentHeight-len(lines)-1)
-			for i := range maxShow {
-				item := d.taItems[i]
-				style := s.warning
-				if item.status == "error" {
-					style = s.danger
-				}
-				line := "  " + style.Render("• ") + s.text.Render(TruncateString(item.name, contentWidth-bulletIndentWidth))
-				if i == focusRow {
-					line = s.highlight.Render(line)
-				}
-				lines = append(lines, line)
-			}
-		}
-		if len(lines) == 0 {
-			lines = append(lines, s.success.Render("All good ✓"))
-		}
-	}
-
-	return strings.Join(lines, "\n")
-}
@@ -2,9 +2,12 @@ package view
 
 import (
 	"context"
+	"slices"
 	"testing"
 
+	"github.com/clawscli/claws/internal/history"
 	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
 )
 
@@ -62,12 +65,13 @@ func TestDashboardView_HitTest(t *testing.T) {
 	dv.buildHitAreas(panelWidth, panelHeight, headerHeight)
 
 	topRowY1 := headerHeight + 1
-	bottomRowY1 := topRowY1 + panelHeight
+	middleRowY1 := topRowY1 + panelHeight
+	bottomRowY1 := middleRowY1 + panelHeight
 	bottomRowY2 := bottomRowY1 + panelHeight - 1
 	leftX2 := panelWidth
 	rightX1 := panelWidth + panelGap
 
-	// Panel indices: 0=cost, 1=operations, 2=security, 3=optimization
+	// Panel indices: 0=cost, 1=operations, 2=security, 3=optimization, 4=recent, 5=favorites
 	tests := []struct {
 		name string
 		x, y int
@@ -75,8 +79,10 @@ func TestDashboardView_HitTest(t *testing.T) {
 	}{
 		{"top-left panel (cost)", 10, topRowY1 + 2, 0},
 		{"top-right panel (operations)", rightX1 + 5, topRowY1 + 2, 1},
-		{"bottom-left panel (security)", 10, bottomRowY1 + 2, 2},
-		{"bottom-right panel (optimization)", rightX1 + 5, bottomRowY1 + 2, 3},
+		{"middle-left panel (security)", 10, middleRowY1 + 2, 2},
+		{"middle-right panel (optimization)", rightX1 + 5, middleRowY1 + 2, 3},
+		{"bottom-left panel (recent)", 10, bottomRowY1 + 2, 4},
+		{"bottom-right panel (favorites)", rightX1 + 5, bottomRowY1 + 2, 5},
 		{"header area (no hit)", 50, headerHeight - 1, -1},
 		{"below all panels (no hit)", 50, bottomRowY2 + 5, -1},
 		{"left edge of cost panel", 0, topRowY1, 0},
@@ -238,23 +244,16 @@ func TestDashboardView_IsLoading(t *testing.T) {
 		t.Error("expected isLoading() to be true initially")
 	}
 
-	// Set all loading to false
-	dv.alarmLoading = false
-	dv.costLoading = false
-	dv.anomalyLoading = false
-	dv.healthLoading = false
-	dv.secLoading = false
-	dv.taLoading = false
+	dv.widgets = []dashboardWidget{&alarmsWidget{loading: true}, &healthWidget{loading: true}}
+	dv.Update(widgetMsg{index: 0, msg: alarmLoadedMsg{}})
+	if !dv.isLoading() {
+		t.Error("expected isLoading() to be true when any loading")
+	}
 
+	dv.Update(widgetMsg{index: 1, msg: healthErrorMsg{err: context.Canceled}})
 	if dv.isLoading() {
 		t.Error("expected isLoading() to be false when all loading complete")
 	}
-
-	// Set one back to loading
-	dv.costLoading = true
-	if !dv.isLoading() {
-		t.Error("expected isLoading() to be true when any loading")
-	}
 }
 
 func TestDashboardView_CanRefresh(t *testing.T) {
@@ -277,8 +276,8 @@ func TestDashboardView_BuildHitAreas(t *testing.T) {
 	// First call - hitAreas is nil
 	dv.buildHitAreas(50, 15, 5)
 
-	if len(dv.hitAreas) != 4 {
-		t.Errorf("expected 4 hit areas, got %d", len(dv.hitAreas))
+	if len(dv.hitAreas) != len(dv.widgets) {
+		t.Errorf("expected %d hit areas, got %d", len(dv.widgets), len(dv.hitAreas))
 	}
 
 	// Verify targets
@@ -287,7 +286,7 @@ func TestDashboardView_BuildHitAreas(t *testing.T) {
 		targets[h.target] = true
 	}
 
-	expectedTargets := []string{targetCost, targetOperations, targetSecurity, targetOptimization}
+	expectedTargets := []string{targetCost, targetOperations, targetSecurity, targetOptimization, targetRecent, targetFavorites}
 	for _, target := range expectedTargets {
 		if !targets[target] {
 			t.Errorf("missing hit area for target %q", target)
@@ -297,7 +296,142 @@ func TestDashboardView_BuildHitAreas(t *testing.T) {
 	// Second call - should reset and rebuild
 	dv.buildHitAreas(60, 20, 6)
 
+	if len(dv.hitAreas) != len(dv.widgets) {
+		t.Errorf("expected %d hit areas after rebuild, got %d", len(dv.widgets), len(dv.hitAreas))
+	}
+}
+
+func TestDashboardView_PinnedPanels(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{
+		RendererFactory: func() render.Renderer { return &mockRenderer{} },
+	})
+
+	dv := NewDashboardView(ctx, reg)
+	dv.SetSize(120, 50)
+	const recentIdx, favoritesIdx = 4, 5
+	dv.Update(widgetMsg{index: recentIdx, msg: historyEntriesMsg{
+		entries: []history.Entry{{Service: "ec2", Resource: "instances", ID: "i-1", Name: "web", Region: "eu-west-1"}},
+	}})
+	dv.Update(widgetMsg{index: favoritesIdx, msg: historyEntriesMsg{
+		entries: []history.Entry{{Service: "gone", Resource: "things", ID: "x"}},
+	}})
+
+	dv.focusedPanel = recentIdx
+	dv.focusedRow = 0
+	_, cmd := dv.activateCurrentRow()
+	if cmd == nil {
+		t.Fatal("activating a recent resource returned nil cmd")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatalf("got %T, want NavigateMsg", cmd())
+	}
+	if dv, ok := nav.View.(*DetailView); !ok || dv.Resource().GetID() != "i-1" {
+		t.Errorf("navigated to %T, want DetailView for i-1", nav.View)
+	}
+
+	// The panel title opens the full history
+	dv.focusedRow = -1
+	_, cmd = dv.activateCurrentRow()
+	if nav, ok := cmd().(NavigateMsg); !ok {
+		t.Errorf("got %T, want NavigateMsg", cmd())
+	} else if _, ok := nav.View.(*HistoryView); !ok {
+		t.Errorf("navigated to %T, want HistoryView", nav.View)
+	}
+
+	// Favorites whose resource type is gone report an error
+	dv.focusedPanel = favoritesIdx
+	dv.focusedRow = 0
+	_, cmd = dv.activateCurrentRow()
+	if _, ok := cmd().(ErrorMsg); !ok {
+		t.Errorf("got %T, want ErrorMsg", cmd())
+	}
+
+	if got := dv.getRowCount(recentIdx); got != 1 {
+		t.Errorf("getRowCount(recent) = %d, want 1", got)
+	}
+}
+
+func TestNewDashboardWidgets(t *testing.T) {
+	widgets := newDashboardWidgets(nil, nil)
+	if len(widgets) != len(defaultDashboardWidgets) {
+		t.Fatalf("default layout has %d widgets, want %d", len(widgets), len(defaultDashboardWidgets))
+	}
+	if widgets[0].Title() != "Cost" {
+		t.Errorf("first default widget = %q, want Cost", widgets[0].Title())
+	}
+
+	widgets = newDashboardWidgets([]string{"ec2-running", "bogus", "cloudtrail-writes", "alarms"}, nil)
+	var titles []string
+	for _, w := range widgets {
+		titles = append(titles, w.Title())
+	}
+	if want := []string{"EC2 Running", "CloudTrail Writes", "Alarms"}; !slices.Equal(titles, want) {
+		t.Errorf("titles = %v, want %v (unknown IDs skipped)", titles, want)
+	}
+
+	if widgets := newDashboardWidgets([]string{"bogus"}, nil); len(widgets) != len(defaultDashboardWidgets) {
+		t.Errorf("all-unknown list gave %d widgets, want the default layout", len(widgets))
+	}
+}
+
+func TestDashboardView_ColumnsLayout(t *testing.T) {
+	dv := NewDashboardView(context.Background(), registry.New())
+	dv.widgets = newDashboardWidgets([]string{"cost", "alarms", "health", "ec2-running"}, nil)
+	dv.columns = 3
+	dv.buildHitAreas(30, 10, 5)
+
 	if len(dv.hitAreas) != 4 {
-		t.Errorf("expected 4 hit areas after rebuild, got %d", len(dv.hitAreas))
+		t.Fatalf("expected 4 hit areas, got %d", len(dv.hitAreas))
+	}
+	// The fourth widget wraps to the start of the second row
+	if h := dv.hitAreas[3]; h.x1 != 0 || h.y1 != 6+10 || h.target != targetEC2 {
+		t.Errorf("hitAreas[3] = %+v, want second row, first column, %s", h, targetEC2)
+	}
+	if h := dv.hitAreas[2]; h.x1 != 2*(30+panelGap) {
+		t.Errorf("hitAreas[2].x1 = %d, want third column", h.x1)
+	}
+}
+
+func TestOperationsWidget_RowAt(t *testing.T) {
+	w := &operationsWidget{
+		alarms: alarmsWidget{items: []alarmItem{{name: "a"}, {name: "b"}}},
+		health: healthWidget{items: []healthItem{{service: "EC2"}}},
+	}
+	// 0: alarm summary, 1-2: alarms, 3: health summary, 4: health event
+	for line, want := range []int{-1, 0, 1, -1, 2, -1} {
+		if got := w.RowAt(line); got != want {
+			t.Errorf("RowAt(%d) = %d, want %d", line, got, want)
+		}
+	}
+}
+
+func TestNewDashboardWidgets_SkipsDroppedServices(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("ce", "costs", registry.Entry{})
+	reg.RegisterCustom("cloudwatch", "alarms", registry.Entry{})
+	reg.RegisterCustom("health", "events", registry.Entry{})
+	reg.RegisterCustom("securityhub", "findings", registry.Entry{})
+	reg.RegisterCustom("trustedadvisor", "recommendations", registry.Entry{})
+	if unknown := reg.Restrict([]string{"ce", "cloudwatch"}); unknown != nil {
+		t.Fatalf("Restrict() unknown = %v", unknown)
+	}
+
+	var targets []string
+	for _, w := range newDashboardWidgets([]string{widgetCost, widgetOperations, widgetAlarms, widgetSecurity, widgetRecent}, reg) {
+		targets = append(targets, w.Target())
+	}
+	if want := []string{targetCost, targetAlarms, targetRecent}; !slices.Equal(targets, want) {
+		t.Errorf("widgets = %v, want %v", targets, want)
+	}
+
+	targets = nil
+	for _, w := range newDashboardWidgets(nil, reg) {
+		targets = append(targets, w.Target())
+	}
+	if want := []string{targetCost, targetRecent, targetFavorites}; !slices.Equal(targets, want) {
+		t.Errorf("default widgets = %v, want %v", targets, want)
 	}
 }
@@ -0,0 +1,181 @@
+package view
+
+import (
+	"context"
+	"slices"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/history"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// dashboardWidget is one panel of the dashboard. Widgets fetch their own
+// data and are arranged by the dashboard.widgets list in config.yaml.
+type dashboardWidget interface {
+	Title() string
+	// Target is the list opened from the panel title, "service/resource"
+	Target() string
+	// Load resets the widget and returns the fetches to run; each result is
+	// passed back to Update
+	Load() []func(context.Context) tea.Msg
+	Update(msg tea.Msg)
+	Loading() bool
+	// Render draws the panel content; focusRow is -1 when no row is focused
+	Render(env *widgetEnv, width, height, focusRow int) string
+	// Rows is the number of focusable rows
+	Rows() int
+	// RowAt maps a content line to a row, or -1
+	RowAt(line int) int
+	// Activate opens row. A nil result falls back to Target.
+	Activate(env *widgetEnv, row int) tea.Cmd
+}
+
+// historyWidget is implemented by widgets listing :history entries, which
+// * pins to or unpins from the favorites
+type historyWidget interface {
+	entryAt(row int) (history.Entry, bool)
+}
+
+// widgetEnv is what widgets need from the dashboard to render and navigate
+type widgetEnv struct {
+	ctx      context.Context
+	registry *registry.Registry
+	styles   dashboardStyles
+	theme    *ui.Theme
+	spinner  string
+}
+
+// widgetMsg routes a fetch result to the widget at index
+type widgetMsg struct {
+	index int
+	msg   tea.Msg
+}
+
+// Dashboard widget IDs, as used in config.yaml
+const (
+	widgetCost         = "cost"
+	widgetOperations   = "operations"
+	widgetAlarms       = "alarms"
+	widgetHealth       = "health"
+	widgetSecurity     = "security"
+	widgetOptimization = "optimization"
+	widgetEC2Running   = "ec2-running"
+	widgetTrailWrites  = "cloudtrail-writes"
+	widgetRecent       = "recent"
+	widgetFavorites    = "favorites"
+)
+
+var dashboardWidgetFactories = map[string]func() dashboardWidget{
+	widgetCost: func() dashboardWidget { return &costWidget{loading: true, anomalyLoading: true} },
+	widgetOperations: func() dashboardWidget {
+		return &operationsWidget{alarms: alarmsWidget{loading: true}, health: healthWidget{loading: true}}
+	},
+	widgetAlarms:       func() dashboardWidget { return &alarmsWidget{loading: true} },
+	widgetHealth:       func() dashboardWidget { return &healthWidget{loading: true} },
+	widgetSecurity:     func() dashboardWidget { return &securityWidget{loading: true} },
+	widgetOptimization: func() dashboardWidget { return &optimizationWidget{loading: true} },
+	widgetEC2Running:   func() dashboardWidget { return &ec2RunningWidget{loading: true} },
+	widgetTrailWrites:  func() dashboardWidget { return &trailWritesWidget{loading: true} },
+	widgetRecent:       func() dashboardWidget { return &historyListWidget{recent: true} },
+	widgetFavorites:    func() dashboardWidget { return &historyListWidget{} },
+}
+
+// dashboardWidgetServices lists the services each widget fetches from.
+// Widgets of services left out by --services are not shown, so their
+// clients are never created.
+var dashboardWidgetServices = map[string][]string{
+	widgetCost:         {"ce"},
+	widgetOperations:   {"cloudwatch", "health"},
+	widgetAlarms:       {"cloudwatch"},
+	widgetHealth:       {"health"},
+	widgetSecurity:     {"securityhub"},
+	widgetOptimization: {"trustedadvisor"},
+	widgetEC2Running:   {"ec2"},
+	widgetTrailWrites:  {"cloudtrail"},
+}
+
+// defaultDashboardWidgets is the layout used when config.yaml lists none
+var defaultDashboardWidgets = []string{
+	widgetCost, widgetOperations,
+	widgetSecurity, widgetOptimization,
+	widgetRecent, widgetFavorites,
+}
+
+// DashboardWidgetIDs returns the widget IDs usable in dashboard.widgets
+func DashboardWidgetIDs() []string {
+	ids := make([]string, 0, len(dashboardWidgetFactories))
+	for id := range dashboardWidgetFactories {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// newDashboardWidgets builds the widgets listed in ids, skipping unknown
+// ones and those of services dropped from reg. An empty or entirely unknown
+// list gives the default layout.
+func newDashboardWidgets(ids []string, reg *registry.Registry) []dashboardWidget {
+	var widgets []dashboardWidget
+	for _, id := range ids {
+		factory, ok := dashboardWidgetFactories[id]
+		if !ok {
+			log.Warn("unknown dashboard widget", "widget", id, "available", DashboardWidgetIDs())
+			continue
+		}
+		if widgetDropped(id, reg) {
+			continue
+		}
+		widgets = append(widgets, factory())
+	}
+	if len(widgets) == 0 && len(ids) > 0 {
+		log.Warn("no valid dashboard widgets configured, using the default layout")
+	}
+	if len(widgets) == 0 {
+		for _, id := range defaultDashboardWidgets {
+			if !widgetDropped(id, reg) {
+				widgets = append(widgets, dashboardWidgetFactories[id]())
+			}
+		}
+	}
+	return widgets
+}
+
+// widgetDropped reports whether widget id fetches from a service dropped
+// from reg
+func widgetDropped(id string, reg *registry.Registry) bool {
+	return reg != nil && slices.ContainsFunc(dashboardWidgetServices[id], reg.Dropped)
+}
+
+// dashboardColumns returns how many widgets share a row, at most one per widget
+func dashboardColumns(widgetCount int) int {
+	return max(min(config.File().DashboardColumns(), widgetCount), 1)
+}
+
+func (e *widgetEnv) navigate(v View) tea.Cmd {
+	return func() tea.Msg { return NavigateMsg{View: v} }
+}
+
+func (e *widgetEnv) openList(service, resType string) tea.Cmd {
+	return e.navigate(NewResourceBrowserWithType(e.ctx, e.registry, service, resType))
+}
+
+func (e *widgetEnv) openFiltered(service, resType, filterKey, filterVal string) tea.Cmd {
+	return e.navigate(NewResourceBrowserWithFilter(e.ctx, e.registry, service, resType, filterKey, filterVal))
+}
+
+func (e *widgetEnv) openDetail(resource dao.Resource, service, resType string) tea.Cmd {
+	renderer, err := e.registry.GetRenderer(service, resType)
+	if err != nil {
+		return e.openList(service, resType)
+	}
+	daoInst, err := e.registry.GetDAO(e.ctx, service, resType)
+	if err != nil {
+		daoInst = nil
+	}
+	return e.navigate(NewDetailView(e.ctx, resource, renderer, service, resType, e.registry, daoInst))
+}
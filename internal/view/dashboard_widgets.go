@@ -0,0 +1,763 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/history"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// costWidget shows month-to-date cost by service and the anomaly count
+type costWidget struct {
+	mtd     float64
+	top     []costItem
+	loading bool
+	err     error
+
+	anomalyCount   int
+	anomalyLoading bool
+	anomalyErr     error
+}
+
+func (w *costWidget) Title() string  { return "Cost" }
+func (w *costWidget) Target() string { return targetCost }
+func (w *costWidget) Loading() bool  { return w.loading || w.anomalyLoading }
+func (w *costWidget) Rows() int      { return len(w.top) }
+
+func (w *costWidget) Load() []func(context.Context) tea.Msg {
+	w.loading, w.err = true, nil
+	w.anomalyLoading, w.anomalyErr = true, nil
+	return []func(context.Context) tea.Msg{loadCosts, loadAnomalies}
+}
+
+func (w *costWidget) Update(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case costLoadedMsg:
+		w.loading = false
+		w.mtd = msg.mtd
+		w.top = msg.topCosts
+	case costErrorMsg:
+		w.loading = false
+		w.err = msg.err
+	case anomalyLoadedMsg:
+		w.anomalyLoading = false
+		w.anomalyCount = msg.count
+	case anomalyErrorMsg:
+		w.anomalyLoading = false
+		w.anomalyErr = msg.err
+	}
+}
+
+func (w *costWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	s := env.styles
+	var lines []string
+
+	if w.loading {
+		lines = append(lines, s.text.Render(env.spinner+" loading..."))
+	} else if w.err != nil {
+		lines = append(lines, s.dim.Render("Cost: N/A"))
+	} else {
+		lines = append(lines, s.text.Render("MTD: "+appaws.FormatMoney(w.mtd, "")))
+
+		if len(w.top) > 0 {
+			maxCost := w.top[0].cost
+			available := contentWidth - costValueWidth - costPadding
+			nameWidth := available * costNameWidthRatio / 100
+			barWidth := available - nameWidth
+			nameWidth = max(nameWidth, minCostNameWidth)
+			barWidth = max(barWidth, minCostBarWidth)
+			maxServices := max(contentHeight-2, 3)
+			showCount := min(len(w.top), maxServices)
+
+			for i := range showCount {
+				c := w.top[i]
+				bar := renderBar(c.cost, maxCost, barWidth, env.theme)
+				name := TruncateString(c.service, nameWidth)
+				line := fmt.Sprintf("%-*s %s %8.0f", nameWidth, name, bar, c.cost)
+				if i == focusRow {
+					line = s.highlight.Render(line)
+				} else {
+					line = s.text.Render(line)
+				}
+				lines = append(lines, line)
+			}
+		}
+
+		if w.anomalyLoading {
+			lines = append(lines, s.text.Render("Anomalies: "+env.spinner))
+		} else if w.anomalyErr != nil {
+			lines = append(lines, s.text.Render("Anomalies: ")+s.dim.Render("N/A"))
+		} else if w.anomalyCount > 0 {
+			lines = append(lines, s.text.Render("Anomalies: ")+s.warning.Render(fmt.Sprintf("%d", w.anomalyCount)))
+		} else {
+			lines = append(lines, s.text.Render("Anomalies: ")+s.success.Render("0"))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (w *costWidget) RowAt(line int) int {
+	if line >= 1 && line-1 < len(w.top) {
+		return line - 1
+	}
+	return -1
+}
+
+func (w *costWidget) Activate(env *widgetEnv, row int) tea.Cmd {
+	if row < 0 || row >= len(w.top) {
+		return nil
+	}
+	return env.openFiltered("ce", "costs", "ServiceName", w.top[row].service)
+}
+
+// alarmsWidget lists CloudWatch alarms in the ALARM state
+type alarmsWidget struct {
+	items   []alarmItem
+	loading bool
+	err     error
+}
+
+func (w *alarmsWidget) Title() string  { return "Alarms" }
+func (w *alarmsWidget) Target() string { return targetAlarms }
+func (w *alarmsWidget) Loading() bool  { return w.loading }
+func (w *alarmsWidget) Rows() int      { return len(w.items) }
+
+func (w *alarmsWidget) Load() []func(context.Context) tea.Msg {
+	w.loading, w.err = true, nil
+	return []func(context.Context) tea.Msg{loadAlarms}
+}
+
+func (w *alarmsWidget) Update(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case alarmLoadedMsg:
+		w.loading = false
+		w.items = msg.items
+	case alarmErrorMsg:
+		w.loading = false
+		w.err = msg.err
+	}
+}
+
+// lines renders the summary line and up to maxShow alarms
+func (w *alarmsWidget) lines(env *widgetEnv, contentWidth, maxShow, focusRow int) []string {
+	s := env.styles
+	switch {
+	case w.loading:
+		return []string{s.text.Render("Alarms: " + env.spinner)}
+	case w.err != nil:
+		return []string{s.dim.Render("Alarms: N/A")}
+	case len(w.items) == 0:
+		return []string{s.text.Render("Alarms: ") + s.success.Render("0 ✓")}
+	}
+	lines := []string{s.danger.Render(fmt.Sprintf("Alarms: %d in ALARM", len(w.items)))}
+	for i := range min(len(w.items), maxShow) {
+		line := "  " + s.danger.Render("• ") + s.text.Render(TruncateString(w.items[i].name, contentWidth-bulletIndentWidth))
+		if i == focusRow {
+			line = s.highlight.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (w *alarmsWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	return strings.Join(w.lines(env, contentWidth, contentHeight-2, focusRow), "\n")
+}
+
+func (w *alarmsWidget) RowAt(line int) int {
+	if line >= 1 && line-1 < len(w.items) {
+		return line - 1
+	}
+	return -1
+}
+
+func (w *alarmsWidget) Activate(env *widgetEnv, row int) tea.Cmd {
+	if row < 0 || row >= len(w.items) || w.items[row].resource == nil {
+		return nil
+	}
+	return env.openDetail(w.items[row].resource, "cloudwatch", "alarms")
+}
+
+// healthWidget lists open AWS Health events
+type healthWidget struct {
+	items   []healthItem
+	loading bool
+	err     error
+}
+
+func (w *healthWidget) Title() string  { return "Health" }
+func (w *healthWidget) Target() string { return targetHealth }
+func (w *healthWidget) Loading() bool  { return w.loading }
+func (w *healthWidget) Rows() int      { return len(w.items) }
+
+func (w *healthWidget) Load() []func(context.Context) tea.Msg {
+	w.loading, w.err = true, nil
+	return []func(context.Context) tea.Msg{loadHealth}
+}
+
+func (w *healthWidget) Update(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case healthLoadedMsg:
+		w.loading = false
+		w.items = msg.items
+	case healthErrorMsg:
+		w.loading = false
+		w.err = msg.err
+	}
+}
+
+// lines renders the summary line and up to maxShow events; focusRow is
+// relative to the first event
+func (w *healthWidget) lines(env *widgetEnv, contentWidth, maxShow, focusRow int) []string {
+	s := env.styles
+	switch {
+	case w.loading:
+		return []string{s.text.Render("Health: " + env.spinner)}
+	case w.err != nil:
+		return []string{s.dim.Render("Health: N/A")}
+	case len(w.items) == 0:
+		return []string{s.text.Render("Health: ") + s.success.Render("0 open ✓")}
+	}
+	lines := []string{s.warning.Render(fmt.Sprintf("Health: %d open", len(w.items)))}
+	for i := range min(len(w.items), maxShow) {
+		h := w.items[i]
+		line := "  " + s.warning.Render("• ") + s.text.Render(TruncateString(h.service+": "+h.eventType, contentWidth-bulletIndentWidth))
+		if i == focusRow {
+			line = s.highlight.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (w *healthWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	return strings.Join(w.lines(env, contentWidth, contentHeight-2, focusRow), "\n")
+}
+
+func (w *healthWidget) RowAt(line int) int {
+	if line >= 1 && line-1 < len(w.items) {
+		return line - 1
+	}
+	return -1
+}
+
+func (w *healthWidget) Activate(env *widgetEnv, row int) tea.Cmd {
+	if row < 0 || row >= len(w.items) || w.items[row].resource == nil {
+		return nil
+	}
+	return env.openDetail(w.items[row].resource, "health", "events")
+}
+
+// operationsWidget combines failing alarms and open Health events
+type operationsWidget struct {
+	alarms alarmsWidget
+	health healthWidget
+}
+
+func (w *operationsWidget) Title() string  { return "Operations" }
+func (w *operationsWidget) Target() string { return targetOperations }
+func (w *operationsWidget) Loading() bool  { return w.alarms.Loading() || w.health.Loading() }
+func (w *operationsWidget) Rows() int      { return w.alarms.Rows() + w.health.Rows() }
+
+func (w *operationsWidget) Load() []func(context.Context) tea.Msg {
+	return append(w.alarms.Load(), w.health.Load()...)
+}
+
+func (w *operationsWidget) Update(msg tea.Msg) {
+	w.alarms.Update(msg)
+	w.health.Update(msg)
+}
+
+func (w *operationsWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	lines := w.alarms.lines(env, contentWidth, contentHeight-3, focusRow)
+	remaining := contentHeight - len(lines) - 1
+	lines = append(lines, w.health.lines(env, contentWidth, remaining, focusRow-w.alarms.Rows())...)
+	return strings.Join(lines, "\n")
+}
+
+func (w *operationsWidget) RowAt(line int) int {
+	alarmCount := w.alarms.Rows()
+	// Alarm rows follow their summary line, Health rows follow theirs
+	if line >= 1 && line <= alarmCount {
+		return line - 1
+	}
+	healthRow := line - alarmCount - 2
+	if w.health.Rows() > 0 && healthRow >= 0 && healthRow < w.health.Rows() {
+		return alarmCount + healthRow
+	}
+	return -1
+}
+
+func (w *operationsWidget) Activate(env *widgetEnv, row int) tea.Cmd {
+	if row < w.alarms.Rows() {
+		return w.alarms.Activate(env, row)
+	}
+	return w.health.Activate(env, row-w.alarms.Rows())
+}
+
+// securityWidget lists critical and high Security Hub findings
+type securityWidget struct {
+	items   []securityItem
+	loading bool
+	err     error
+}
+
+func (w *securityWidget) Title() string  { return "Security" }
+func (w *securityWidget) Target() string { return targetSecurity }
+func (w *securityWidget) Loading() bool  { return w.loading }
+func (w *securityWidget) Rows() int      { return len(w.items) }
+
+func (w *securityWidget) Load() []func(context.Context) tea.Msg {
+	w.loading, w.err = true, nil
+	return []func(context.Context) tea.Msg{loadSecurity}
+}
+
+func (w *securityWidget) Update(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case securityLoadedMsg:
+		w.loading = false
+		w.items = msg.items
+	case securityErrorMsg:
+		w.loading = false
+		w.err = msg.err
+	}
+}
+
+func (w *securityWidget) severityCounts() (critical, high int) {
+	for _, item := range w.items {
+		switch item.severity {
+		case "CRITICAL":
+			critical++
+		case "HIGH":
+			high++
+		}
+	}
+	return critical, high
+}
+
+func (w *securityWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	s := env.styles
+	var lines []string
+
+	if w.loading {
+		lines = append(lines, s.text.Render(env.spinner+" loading..."))
+	} else if w.err != nil {
+		lines = append(lines, s.dim.Render("Security: N/A"))
+	} else if len(w.items) > 0 {
+		critical, high := w.severityCounts()
+		if critical > 0 {
+			lines = append(lines, s.danger.Render(fmt.Sprintf("Critical: %d 🔴", critical)))
+		}
+		if high > 0 {
+			lines = append(lines, s.warning.Render(fmt.Sprintf("High: %d 🟠", high)))
+		}
+		maxShow := min(len(w.items), contentHeight-len(lines)-1)
+		for i := range maxShow {
+			item := w.items[i]
+			style := s.warning
+			if item.severity == "CRITICAL" {
+				style = s.danger
+			}
+			line := "  " + style.Render("• ") + s.text.Render(TruncateString(item.title, contentWidth-bulletIndentWidth))
+			if i == focusRow {
+				line = s.highlight.Render(line)
+			}
+			lines = append(lines, line)
+		}
+	} else {
+		lines = append(lines, s.success.Render("No critical/high ✓"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (w *securityWidget) RowAt(line int) int {
+	headerLines := 0
+	critical, high := w.severityCounts()
+	if critical > 0 {
+		headerLines++
+	}
+	if high > 0 {
+		headerLines++
+	}
+	if row := line - headerLines; row >= 0 && row < len(w.items) {
+		return row
+	}
+	return -1
+}
+
+func (w *securityWidget) Activate(env *widgetEnv, row int) tea.Cmd {
+	if row < 0 || row >= len(w.items) || w.items[row].resource == nil {
+		return nil
+	}
+	return env.openDetail(w.items[row].resource, "securityhub", "findings")
+}
+
+// optimizationWidget lists Trusted Advisor checks needing attention
+type optimizationWidget struct {
+	items   []taItem
+	savings float64
+	loading bool
+	err     error
+}
+
+func (w *optimizationWidget) Title() string  { return "Optimization" }
+func (w *optimizationWidget) Target() string { return targetOptimization }
+func (w *optimizationWidget) Loading() bool  { return w.loading }
+func (w *optimizationWidget) Rows() int      { return len(w.items) }
+
+func (w *optimizationWidget) Load() []func(context.Context) tea.Msg {
+	w.loading, w.err = true, nil
+	return []func(context.Context) tea.Msg{loadTrustedAdvisor}
+}
+
+func (w *optimizationWidget) Update(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case taLoadedMsg:
+		w.loading = false
+		w.items = msg.items
+		w.savings = msg.savings
+	case taErrorMsg:
+		w.loading = false
+		w.err = msg.err
+	}
+}
+
+func (w *optimizationWidget) statusCounts() (errors, warnings int) {
+	for _, item := range w.items {
+		if item.status == "error" {
+			errors++
+		} else {
+			warnings++
+		}
+	}
+	return errors, warnings
+}
+
+func (w *optimizationWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	s := env.styles
+	var lines []string
+
+	if w.loading {
+		lines = append(lines, s.text.Render(env.spinner+" loading..."))
+	} else if w.err != nil {
+		lines = append(lines, s.dim.Render("Optimization: N/A"))
+	} else {
+		errors, warnings := w.statusCounts()
+		if errors > 0 {
+			lines = append(lines, s.danger.Render(fmt.Sprintf("Errors: %d", errors)))
+		}
+		if warnings > 0 {
+			lines = append(lines, s.warning.Render(fmt.Sprintf("Warnings: %d", warnings)))
+		}
+		if w.savings > 0 {
+			lines = append(lines, s.success.Render("Savings: "+appaws.FormatMoney(w.savings, "")+"/mo 💰"))
+		}
+		if len(w.items) > 0 {
+			maxShow := min(len(w.items), contentHeight-len(lines)-1)
+			for i := range maxShow {
+				item := w.items[i]
+				style := s.warning
+				if item.status == "error" {
+					style = s.danger
+				}
+				line := "  " + style.Render("• ") + s.text.Render(TruncateString(item.name, contentWidth-bulletIndentWidth))
+				if i == focusRow {
+					line = s.highlight.Render(line)
+				}
+				lines = append(lines, line)
+			}
+		}
+		if len(lines) == 0 {
+			lines = append(lines, s.success.Render("All good ✓"))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (w *optimizationWidget) RowAt(line int) int {
+	headerLines := 0
+	errors, warnings := w.statusCounts()
+	if errors > 0 {
+		headerLines++
+	}
+	if warnings > 0 {
+		headerLines++
+	}
+	if w.savings > 0 {
+		headerLines++
+	}
+	if row := line - headerLines; row >= 0 && row < len(w.items) {
+		return row
+	}
+	return -1
+}
+
+func (w *optimizationWidget) Activate(env *widgetEnv, row int) tea.Cmd {
+	if row < 0 || row >= len(w.items) || w.items[row].resource == nil {
+		return nil
+	}
+	return env.openDetail(w.items[row].resource, "trustedadvisor", "recommendations")
+}
+
+// ec2RunningWidget counts running EC2 instances per selected region
+type ec2RunningWidget struct {
+	items   []ec2CountItem
+	loading bool
+	err     error
+}
+
+func (w *ec2RunningWidget) Title() string  { return "EC2 Running" }
+func (w *ec2RunningWidget) Target() string { return targetEC2 }
+func (w *ec2RunningWidget) Loading() bool  { return w.loading }
+func (w *ec2RunningWidget) Rows() int      { return len(w.items) }
+
+func (w *ec2RunningWidget) Load() []func(context.Context) tea.Msg {
+	w.loading, w.err = true, nil
+	return []func(context.Context) tea.Msg{loadRunningInstances}
+}
+
+func (w *ec2RunningWidget) Update(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case ec2CountLoadedMsg:
+		w.loading = false
+		w.items = msg.items
+	case ec2CountErrorMsg:
+		w.loading = false
+		w.err = msg.err
+	}
+}
+
+func (w *ec2RunningWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	s := env.styles
+	switch {
+	case w.loading:
+		return s.text.Render(env.spinner + " loading...")
+	case w.err != nil:
+		return s.dim.Render("EC2: N/A")
+	}
+
+	total := 0
+	for _, item := range w.items {
+		total += item.count
+	}
+	lines := []string{s.text.Render(fmt.Sprintf("Running: %d", total))}
+	regionWidth := max(contentWidth-bulletIndentWidth-ec2CountWidth, minPinnedNameWidth)
+	for i := range min(len(w.items), max(contentHeight-2, 1)) {
+		item := w.items[i]
+		region := item.region
+		if region == "" {
+			region = "default region"
+		}
+		style := s.text
+		if item.count == 0 {
+			style = s.dim
+		}
+		line := "  " + style.Render(fmt.Sprintf("• %-*s %*d", regionWidth, TruncateString(region, regionWidth), ec2CountWidth, item.count))
+		if i == focusRow {
+			line = s.highlight.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (w *ec2RunningWidget) RowAt(line int) int {
+	if line >= 1 && line-1 < len(w.items) {
+		return line - 1
+	}
+	return -1
+}
+
+// Activate opens the instance list, which covers every selected region
+func (w *ec2RunningWidget) Activate(*widgetEnv, int) tea.Cmd {
+	return nil
+}
+
+// trailWritesWidget lists CloudTrail write events from the last 24 hours
+type trailWritesWidget struct {
+	items   []trailItem
+	loading bool
+	err     error
+}
+
+func (w *trailWritesWidget) Title() string  { return "CloudTrail Writes" }
+func (w *trailWritesWidget) Target() string { return targetTrail }
+func (w *trailWritesWidget) Loading() bool  { return w.loading }
+func (w *trailWritesWidget) Rows() int      { return len(w.items) }
+
+func (w *trailWritesWidget) Load() []func(context.Context) tea.Msg {
+	w.loading, w.err = true, nil
+	return []func(context.Context) tea.Msg{loadTrailWrites}
+}
+
+func (w *trailWritesWidget) Update(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case trailLoadedMsg:
+		w.loading = false
+		w.items = msg.items
+	case trailErrorMsg:
+		w.loading = false
+		w.err = msg.err
+	}
+}
+
+func (w *trailWritesWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	s := env.styles
+	switch {
+	case w.loading:
+		return s.text.Render(env.spinner + " loading...")
+	case w.err != nil:
+		return s.dim.Render("CloudTrail: N/A")
+	case len(w.items) == 0:
+		return s.success.Render("No writes in 24h ✓")
+	}
+
+	lines := []string{s.text.Render(fmt.Sprintf("Last 24h: %d writes", len(w.items)))}
+	for i := range min(len(w.items), max(contentHeight-2, 1)) {
+		item := w.items[i]
+		age := ""
+		if item.at != nil {
+			age = render.FormatAge(*item.at)
+		}
+		who := item.user
+		if age != "" {
+			who += " " + age
+		}
+		nameWidth := max(contentWidth-bulletIndentWidth-len(who)-2, minPinnedNameWidth)
+		line := "  " + s.text.Render("• "+TruncateString(item.event, nameWidth)) + "  " + s.dim.Render(TruncateString(who, max(contentWidth-bulletIndentWidth-nameWidth-2, 0)))
+		if i == focusRow {
+			line = s.highlight.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (w *trailWritesWidget) RowAt(line int) int {
+	if line >= 1 && line-1 < len(w.items) {
+		return line - 1
+	}
+	return -1
+}
+
+func (w *trailWritesWidget) Activate(env *widgetEnv, row int) tea.Cmd {
+	if row < 0 || row >= len(w.items) || w.items[row].resource == nil {
+		return nil
+	}
+	return env.openDetail(w.items[row].resource, "cloudtrail", "events")
+}
+
+// historyListWidget lists recently viewed resources, or the favorites
+type historyListWidget struct {
+	recent  bool
+	entries []history.Entry
+	loaded  bool
+}
+
+func (w *historyListWidget) Title() string {
+	if w.recent {
+		return "Recently Viewed"
+	}
+	return "Favorites"
+}
+
+func (w *historyListWidget) Target() string {
+	if w.recent {
+		return targetRecent
+	}
+	return targetFavorites
+}
+
+func (w *historyListWidget) Loading() bool { return false }
+func (w *historyListWidget) Rows() int     { return len(w.entries) }
+
+func (w *historyListWidget) Load() []func(context.Context) tea.Msg {
+	if w.recent {
+		return []func(context.Context) tea.Msg{loadRecent}
+	}
+	return []func(context.Context) tea.Msg{loadFavorites}
+}
+
+func (w *historyListWidget) Update(msg tea.Msg) {
+	if msg, ok := msg.(historyEntriesMsg); ok {
+		if msg.err != nil {
+			log.Warn("failed to load history for dashboard", "error", msg.err)
+		}
+		w.loaded = true
+		w.entries = msg.entries
+	}
+}
+
+func (w *historyListWidget) Render(env *widgetEnv, contentWidth, contentHeight, focusRow int) string {
+	s := env.styles
+	if !w.loaded {
+		return ""
+	}
+	if len(w.entries) == 0 {
+		if w.recent {
+			return s.dim.Render("Resources you open appear here")
+		}
+		return s.dim.Render("Press * on a resource to pin it here")
+	}
+
+	var lines []string
+	maxShow := min(len(w.entries), max(contentHeight-1, 1))
+	for i := range maxShow {
+		e := w.entries[i]
+		name := e.Name
+		if name == "" {
+			name = e.ID
+		}
+		where := e.Type()
+		if e.Region != "" {
+			where += " " + e.Region
+		}
+		nameWidth := max(contentWidth-bulletIndentWidth-len(where)-2, minPinnedNameWidth)
+		line := "  " + s.text.Render("• "+TruncateString(name, nameWidth)) + "  " + s.dim.Render(TruncateString(where, max(contentWidth-bulletIndentWidth-nameWidth-2, 0)))
+		if i == focusRow {
+			line = s.highlight.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (w *historyListWidget) RowAt(line int) int {
+	if line >= 0 && line < len(w.entries) {
+		return line
+	}
+	return -1
+}
+
+// Activate reopens the entry in its stored profile and region. The title of
+// the recent panel opens the full :history.
+func (w *historyListWidget) Activate(env *widgetEnv, row int) tea.Cmd {
+	e, ok := w.entryAt(row)
+	if !ok {
+		if w.recent {
+			return env.navigate(NewHistoryView(env.ctx, env.registry))
+		}
+		return nil
+	}
+	target, err := historyView(env.ctx, env.registry, e)
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	return env.navigate(target)
+}
+
+func (w *historyListWidget) entryAt(row int) (history.Entry, bool) {
+	if row < 0 || row >= len(w.entries) {
+		return history.Entry{}, false
+	}
+	return w.entries[row], true
+}
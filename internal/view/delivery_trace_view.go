@@ -0,0 +1,281 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/delivery"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// traceCmd opens a DeliveryTraceView starting at res, an SNS topic or
+// EventBridge rule
+func traceCmd(ctx context.Context, service, resType string, res dao.Resource) tea.Cmd {
+	if res == nil {
+		return nil
+	}
+	if !delivery.Supported(service, resType) {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("cannot trace delivery from %s/%s: select an SNS topic or EventBridge rule", service, resType)}
+		}
+	}
+	if region := dao.GetResourceRegion(res); region != "" {
+		ctx = aws.WithRegionOverride(ctx, region)
+	}
+	if profile := dao.GetResourceProfile(res); profile != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(profile))
+	}
+	arn := dao.UnwrapResource(res).GetARN()
+	if arn == "" {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("cannot trace delivery: %s has no ARN", res.GetName())}
+		}
+	}
+	v := NewDeliveryTraceView(ctx, arn)
+	return func() tea.Msg { return NavigateMsg{View: v} }
+}
+
+type deliveryTracedMsg struct {
+	result *delivery.Result
+	err    error
+}
+
+type deliveryTraceViewStyles struct {
+	title   lipgloss.Style
+	dim     lipgloss.Style
+	failing lipgloss.Style
+	ok      lipgloss.Style
+	warning lipgloss.Style
+}
+
+func newDeliveryTraceViewStyles() deliveryTraceViewStyles {
+	return deliveryTraceViewStyles{
+		title:   ui.TitleStyle(),
+		dim:     ui.DimStyle(),
+		failing: ui.DangerStyle(),
+		ok:      ui.SuccessStyle(),
+		warning: ui.WarningStyle(),
+	}
+}
+
+// DeliveryTraceView shows the fan-out of an SNS topic or EventBridge rule as
+// a tree, with failure metrics for every hop.
+type DeliveryTraceView struct {
+	ctx context.Context
+	arn string
+
+	loading bool
+	spinner spinner.Model
+	result  *delivery.Result
+	err     error
+
+	vp     ViewportState
+	styles deliveryTraceViewStyles
+}
+
+// NewDeliveryTraceView creates a DeliveryTraceView starting at arn. ctx
+// should carry the resource's profile.
+func NewDeliveryTraceView(ctx context.Context, arn string) *DeliveryTraceView {
+	return &DeliveryTraceView{
+		ctx:     ctx,
+		arn:     arn,
+		loading: true,
+		spinner: ui.NewSpinner(),
+		styles:  newDeliveryTraceViewStyles(),
+	}
+}
+
+func (v *DeliveryTraceView) Init() tea.Cmd {
+	return tea.Batch(v.trace, v.spinner.Tick)
+}
+
+func (v *DeliveryTraceView) trace() tea.Msg {
+	result, err := delivery.Trace(v.ctx, v.arn)
+	return deliveryTracedMsg{result: result, err: err}
+}
+
+func (v *DeliveryTraceView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case deliveryTracedMsg:
+		v.loading = false
+		v.result = msg.result
+		v.err = msg.err
+		v.refreshContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newDeliveryTraceViewStyles()
+		v.refreshContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		// Let app handle back navigation
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		if msg.String() == "ctrl+r" && !v.loading {
+			v.loading = true
+			return v, tea.Batch(v.trace, v.spinner.Tick)
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *DeliveryTraceView) refreshContent() {
+	if v.vp.Ready && (v.result != nil || v.err != nil) {
+		v.vp.Model.SetContent(v.renderContent())
+	}
+}
+
+func (v *DeliveryTraceView) renderContent() string {
+	s := v.styles
+	if v.err != nil {
+		return s.failing.Render(fmt.Sprintf("Error: %v", v.err))
+	}
+	r := v.result
+	var b strings.Builder
+
+	b.WriteString(s.title.Render("Delivery Trace") + "\n")
+	hops := -1
+	r.Root.Walk(func(*delivery.Node) { hops++ })
+	window := config.File().MetricsWindow()
+	b.WriteString(s.dim.Render(fmt.Sprintf("%d downstream hops • metrics over the last %s", hops, render.FormatDuration(window))) + "\n")
+	if failing := r.Failing(); len(failing) > 0 {
+		b.WriteString(s.failing.Render(fmt.Sprintf("⚠ %d failing hops", len(failing))) + "\n")
+	} else {
+		b.WriteString(s.ok.Render("✓ no failures") + "\n")
+	}
+	b.WriteString("\n")
+
+	b.WriteString(v.nodeLine(r.Root) + "\n")
+	v.writeChildren(&b, r.Root, "")
+
+	if len(r.Errors) > 0 {
+		b.WriteString("\n" + s.warning.Render(fmt.Sprintf("⚠ %d lookups failed:", len(r.Errors))) + "\n")
+		for _, e := range r.Errors {
+			b.WriteString("  " + s.dim.Render(e) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// writeChildren draws the subtree below n, prefix being the guide lines of
+// n's ancestors
+func (v *DeliveryTraceView) writeChildren(b *strings.Builder, n *delivery.Node, prefix string) {
+	for i, c := range n.Children {
+		branch, guide := "├─ ", "│  "
+		if i == len(n.Children)-1 {
+			branch, guide = "└─ ", "   "
+		}
+		b.WriteString(v.styles.dim.Render(prefix+branch) + v.nodeLine(c) + "\n")
+		v.writeChildren(b, c, prefix+guide)
+	}
+}
+
+// nodeLine shows a hop, how it is reached, and its metrics
+func (v *DeliveryTraceView) nodeLine(n *delivery.Node) string {
+	s := v.styles
+	kind := string(n.Kind)
+	if n.DeadLetter {
+		kind = "dlq"
+	}
+	line := kind + " " + n.Name
+	if n.Failing() {
+		line = s.failing.Render("⚠ " + line)
+	}
+	if n.Via != "" {
+		line = s.dim.Render(n.Via+" →") + " " + line
+	}
+
+	var metrics []string
+	for _, m := range n.Metrics {
+		text := m.Label + " " + formatMetric(m)
+		if m.Failing() {
+			text = s.failing.Render(text)
+		}
+		metrics = append(metrics, text)
+	}
+	if len(metrics) > 0 {
+		line += "  " + strings.Join(metrics, "  ")
+	}
+	if len(n.Notes) > 0 {
+		line += "  " + s.dim.Render("("+strings.Join(n.Notes, ", ")+")")
+	}
+	if n.Err != "" {
+		line += "  " + s.warning.Render("⚠ "+n.Err)
+	}
+	return line
+}
+
+// formatMetric formats a metric value; counts without datapoints are 0, as
+// CloudWatch omits them when nothing happened
+func formatMetric(m delivery.Metric) string {
+	switch {
+	case m.Seconds && !m.HasData:
+		return "-"
+	case m.Seconds:
+		return render.FormatDuration(time.Duration(m.Value) * time.Second)
+	default:
+		return fmt.Sprintf("%.0f", m.Value)
+	}
+}
+
+func (v *DeliveryTraceView) ViewString() string {
+	if v.loading {
+		return v.spinner.View() + " Tracing delivery from " + v.arn + "..."
+	}
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.vp.Model.View()
+}
+
+func (v *DeliveryTraceView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *DeliveryTraceView) SetSize(width, height int) tea.Cmd {
+	v.vp.SetSize(width, max(height, 5))
+	if !v.loading {
+		v.refreshContent()
+	}
+	return nil
+}
+
+func (v *DeliveryTraceView) StatusLine() string {
+	if v.loading {
+		return "trace • q/esc:back"
+	}
+	return "trace • ↑/↓:scroll • ctrl+r:retrace • q/esc:back"
+}
+
+// KeyHelp implements KeyHelper
+func (v *DeliveryTraceView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{Title: "Delivery Trace", Bindings: []KeyBinding{
+		{"↑/k, ↓/j", "Scroll"},
+		{"PgUp, PgDn", "Page up / down"},
+		{"Ctrl+r", "Trace again"},
+		{"Esc", "Back"},
+	}}}
+}
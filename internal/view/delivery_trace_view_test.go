@@ -0,0 +1,58 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/delivery"
+)
+
+func TestDeliveryTraceView_Content(t *testing.T) {
+	v := NewDeliveryTraceView(context.Background(), "arn:aws:sns:us-east-1:111122223333:orders")
+	v.SetSize(160, 30)
+	v.Update(deliveryTracedMsg{result: &delivery.Result{
+		Root: &delivery.Node{Kind: delivery.KindTopic, Name: "orders", Children: []*delivery.Node{
+			{Kind: delivery.KindQueue, Name: "orders-worker", Via: "sqs subscription", Children: []*delivery.Node{
+				{Kind: delivery.KindFunction, Name: "process-order", Via: "event source mapping",
+					Metrics: []delivery.Metric{{Label: "errors", Value: 2, HasData: true, Failure: true}}},
+				{Kind: delivery.KindQueue, Name: "orders-dlq", Via: "redrive", DeadLetter: true},
+			}},
+			{Kind: delivery.KindEndpoint, Name: "ops@example.com", Via: "email subscription", Notes: []string{"pending confirmation"}},
+		}},
+		Errors: []string{"orders-worker: AccessDenied"},
+	}})
+
+	content := ansi.Strip(v.renderContent())
+	for _, want := range []string{
+		"4 downstream hops",
+		"1 failing hops",
+		"├─ sqs subscription → queue orders-worker",
+		"│  ├─ event source mapping → ⚠ function process-order  errors 2",
+		"│  └─ redrive → dlq orders-dlq",
+		"└─ email subscription → endpoint ops@example.com  (pending confirmation)",
+		"orders-worker: AccessDenied",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("content missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestTraceCmdUnsupportedType(t *testing.T) {
+	cmd := traceCmd(context.Background(), "sqs", "queues", &dao.BaseResource{ID: "q"})
+	msg, ok := cmd().(ErrorMsg)
+	if !ok || !strings.Contains(msg.Err.Error(), "cannot trace delivery from sqs/queues") {
+		t.Errorf("traceCmd() msg = %v, want an ErrorMsg", msg)
+	}
+
+	cmd = traceCmd(context.Background(), "sns", "topics", &dao.BaseResource{ID: "t", ARN: "arn:aws:sns:us-east-1:111122223333:t"})
+	if nav, ok := cmd().(NavigateMsg); !ok {
+		t.Errorf("traceCmd() msg = %T, want NavigateMsg", nav)
+	} else if _, ok := nav.View.(*DeliveryTraceView); !ok {
+		t.Errorf("navigated to %T, want *DeliveryTraceView", nav.View)
+	}
+}
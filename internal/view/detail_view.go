@@ -1,12 +1,15 @@
 package view
 
 import (
+	"cmp"
 	"context"
 	"strings"
 
 	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/itchyny/gojq"
 
 	"github.com/clawscli/claws/internal/action"
 	"github.com/clawscli/claws/internal/clipboard"
@@ -51,12 +54,38 @@ type DetailView struct {
 	styles      detailViewStyles
 	width       int
 	height      int
+
+	// Field search
+	searchInput  textinput.Model
+	searchActive bool
+	searchText   string
+	matches      []int // content line indexes matching searchText
+	matchIdx     int   // index into matches of the highlighted match
+
+	// Rendered detail and the window of it on screen
+	content      *lineStore
+	contentValid bool
+	yOffset      int
+	xOffset      int
+
+	// Raw JSON view with optional jq projection
+	rawMode  bool
+	jqInput  textinput.Model
+	jqActive bool
+	jqExpr   string
+	jqCode   *gojq.Code
+	jqErr    error
+	rawJSON  *lineStore // indented Raw() JSON, cached until the resource changes
 }
 
 // NewDetailView creates a new DetailView
 func NewDetailView(ctx context.Context, resource dao.Resource, renderer render.Renderer, service, resType string, reg *registry.Registry, d dao.DAO) *DetailView {
 	hp := NewHeaderPanel()
 	hp.SetWidth(120) // Default width until SetSize is called
+	if resource != nil {
+		hp.SetDetail(cmp.Or(resource.GetName(), resource.GetID()))
+	}
+	recordRecentResource(service, resType, resource)
 
 	return &DetailView{
 		ctx:         ctx,
@@ -69,6 +98,8 @@ func NewDetailView(ctx context.Context, resource dao.Resource, renderer render.R
 		headerPanel: hp,
 		spinner:     ui.NewSpinner(),
 		styles:      newDetailViewStyles(),
+		searchInput: newDetailSearchInput(),
+		jqInput:     newDetailJQInput(),
 	}
 }
 
@@ -111,10 +142,9 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else {
 			d.refreshErr = nil
 			d.resource = mergeResources(d.resource, msg.resource)
-			if d.vp.Ready {
-				content := d.renderContent()
-				d.vp.Model.SetContent(content)
-			}
+			d.rawJSON = nil
+			d.invalidateContent()
+			d.setContent()
 		}
 		return d, nil
 
@@ -128,33 +158,62 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ThemeChangedMsg:
 		d.styles = newDetailViewStyles()
 		d.headerPanel.ReloadStyles()
-		if d.vp.Ready {
-			d.vp.Model.SetContent(d.renderContent())
-		}
+		d.invalidateContent()
+		d.setContent()
 		return d, nil
 	case CompactHeaderChangedMsg:
 		d.recalcViewport()
 		return d, nil
+	case CopyAsMsg:
+		return d, copyAsCmd(msg.Format, d.service, d.resType, d.resource)
+	case UsedByMsg:
+		return d, usedByCmd(d.ctx, d.registry, d.service, d.resType, d.resource)
+	case TraceMsg:
+		return d, traceCmd(d.ctx, d.service, d.resType, d.resource)
 
 	case tea.KeyPressMsg:
+		if d.jqActive {
+			return d.handleJQInput(msg)
+		}
+		if d.searchActive {
+			return d.handleSearchInput(msg)
+		}
+
 		// Let app handle back navigation (esc/backspace/q handled by app.go)
 		if IsEscKey(msg) {
 			return d, nil
 		}
 
+		if model, cmd := d.handleSearchKey(msg.String()); model != nil {
+			return model, cmd
+		}
+
 		// Check navigation shortcuts
 		if model, cmd := d.handleNavigation(msg.String()); model != nil {
 			return model, cmd
 		}
 
 		switch msg.String() {
+		case "/":
+			d.searchActive = true
+			d.searchInput.Focus()
+			d.recalcViewport()
+			return d, textinput.Blink
+		case "J":
+			return d.toggleRaw()
+		case "|":
+			return d.openJQInput()
 		case "a":
-			if actions := action.Global.Get(d.service, d.resType); len(actions) > 0 {
+			if actions := action.Global.ForResource(d.service, d.resType); len(actions) > 0 {
 				actionMenu := NewActionMenu(d.ctx, dao.UnwrapResource(d.resource), d.service, d.resType)
 				return d, func() tea.Msg {
 					return ShowModalMsg{Modal: &Modal{Content: actionMenu, Width: ModalWidthActionMenu}}
 				}
 			}
+		case "*":
+			if e, ok := HistoryEntryFor(d.registry, d); ok {
+				return d, toggleFavorite(e)
+			}
 		case "y":
 			return d, clipboard.CopyID(dao.UnwrapResource(d.resource).GetID())
 		case "Y":
@@ -166,9 +225,8 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
-	var cmd tea.Cmd
-	d.vp.Model, cmd = d.vp.Model.Update(msg)
-	return d, cmd
+	d.scroll(msg)
+	return d, nil
 }
 
 // handleNavigation checks if a key matches a navigation shortcut
@@ -201,8 +259,11 @@ func (d *DetailView) ViewString() string {
 	}
 
 	header := d.headerPanel.Render(d.service, d.resType, summaryFields)
+	for _, line := range d.inputLines() {
+		header += "\n" + line
+	}
 
-	return header + "\n" + d.vp.Model.View()
+	return header + "\n" + d.viewportView()
 }
 
 // View implements tea.Model
@@ -231,18 +292,23 @@ func (d *DetailView) recalcViewport() {
 	headerHeight := d.headerPanel.Height(headerStr)
 
 	// +1 compensates for border overlap
-	viewportHeight := max(d.height-headerHeight+1, minViewportHeight)
+	viewportHeight := d.height - headerHeight + 1
+	viewportHeight -= len(d.inputLines())
+	viewportHeight = max(viewportHeight, minViewportHeight)
 
 	d.vp.SetSize(d.width, viewportHeight)
 
-	if !d.vp.Ready {
-		return
-	}
-	content := d.renderContent()
-	d.vp.Model.SetContent(content)
+	d.setContent()
 }
 
 func (d *DetailView) StatusLine() string {
+	if d.searchActive {
+		return "Esc:cancel Enter:search"
+	}
+	if d.jqActive {
+		return "Esc:cancel Enter:apply (empty shows full JSON)"
+	}
+
 	parts := []string{d.resource.GetID()}
 
 	if d.refreshing {
@@ -253,11 +319,21 @@ func (d *DetailView) StatusLine() string {
 
 	parts = append(parts, "↑/↓:scroll")
 
-	if actions := action.Global.Get(d.service, d.resType); len(actions) > 0 {
+	if actions := action.Global.ForResource(d.service, d.resType); len(actions) > 0 {
 		parts = append(parts, "a:actions")
 	}
 
-	parts = append(parts, "y:copy")
+	if d.searchText != "" {
+		parts = append(parts, "n/N:next/prev", "y:copy value")
+	} else {
+		parts = append(parts, "/:search", "y:copy")
+	}
+
+	if d.rawMode {
+		parts = append(parts, "J:formatted", "|:jq")
+	} else {
+		parts = append(parts, "J:raw")
+	}
 
 	if navInfo := d.getNavigationShortcuts(); navInfo != "" {
 		parts = append(parts, navInfo)
@@ -267,6 +343,11 @@ func (d *DetailView) StatusLine() string {
 	return strings.Join(parts, " • ")
 }
 
+// HasActiveInput implements InputCapture
+func (d *DetailView) HasActiveInput() bool {
+	return d.searchActive || d.jqActive
+}
+
 func (d *DetailView) Resource() dao.Resource {
 	return d.resource
 }
@@ -290,6 +371,10 @@ func (d *DetailView) getNavigationShortcuts() string {
 }
 
 func (d *DetailView) renderContent() string {
+	if d.rawMode {
+		return d.renderRaw()
+	}
+
 	var detail string
 
 	// Try to use renderer's RenderDetail if available
@@ -302,22 +387,6 @@ func (d *DetailView) renderContent() string {
 		detail = d.renderGenericDetail()
 	}
 
-	// Replace placeholder values with "Loading..." during async refresh.
-	// Match placeholders only at line endings to avoid replacing substrings
-	// (e.g., "Not configured server" should not be replaced).
-	if d.refreshing && detail != "" {
-		loading := ui.DimStyle().Render(LoadingMessage)
-
-		// Replace placeholders at end of line or end of content
-		for _, placeholder := range []string{render.NotConfigured, render.Empty, render.NoValue} {
-			detail = strings.ReplaceAll(detail, placeholder+"\n", loading+"\n")
-			if strings.HasSuffix(detail, placeholder) {
-				detail = detail[:len(detail)-len(placeholder)] + loading
-				break // Only one placeholder can be at EOF
-			}
-		}
-	}
-
 	return detail
 }
 
@@ -337,3 +406,25 @@ func (d *DetailView) renderGenericDetail() string {
 
 	return out
 }
+
+// KeyHelp implements KeyHelper
+func (d *DetailView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{
+		{
+			Title: "Detail View",
+			Bindings: []KeyBinding{
+				{"↑/k, ↓/j", "Scroll"},
+				{"/", "Search fields (Enter with empty text clears)"},
+				{"n, N", "Next / previous match"},
+				{"J", "Toggle raw JSON"},
+				{"|", "Filter raw JSON with a jq expression"},
+				{"a", "Show actions menu"},
+				{"y", "Copy highlighted field value (resource ID without a search)"},
+				{"Y", "Copy resource ARN to clipboard"},
+				{"*", "Pin / unpin in dashboard favorites"},
+			},
+		},
+		navigationKeyHelp(d.renderer, d.resource),
+		actionKeyHelp(d.service, d.resType, d.resource),
+	}
+}
@@ -0,0 +1,354 @@
+package view
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	// compressThreshold is the size above which detail content is kept
+	// gzip-compressed
+	compressThreshold = 256 << 10
+	// linesPerChunk is how many lines of compressed content are
+	// decompressed together
+	linesPerChunk = 256
+	// horizontalStep and mouseWheelDelta match the bubbles viewport defaults
+	horizontalStep  = 6
+	mouseWheelDelta = 3
+)
+
+// lineStore holds detail content as lines. Past compressThreshold the
+// text is kept as gzip-compressed chunks of linesPerChunk lines, located
+// through an index of their offsets, so a multi-megabyte document costs a
+// fraction of its size and drawing decompresses only the chunks on screen.
+type lineStore struct {
+	lines   []string // uncompressed lines, below the threshold
+	gz      []byte   // compressed chunks, back to back
+	offsets []int    // start of each chunk in gz, followed by len(gz)
+	count   int      // number of lines
+	width   int      // widest line, for horizontal scrolling
+
+	chunk  int      // index of the chunk held in cached
+	cached []string // lines of the last decompressed chunk
+}
+
+func newLineStore(s string) *lineStore {
+	if len(s) < compressThreshold {
+		return newUncompressedStore(s)
+	}
+
+	ls := &lineStore{chunk: -1}
+	var buf bytes.Buffer
+	zw, _ := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+	for rest := s; ; {
+		part := rest
+		end := nthIndexByte(rest, '\n', linesPerChunk)
+		if end >= 0 {
+			part, rest = rest[:end], rest[end+1:]
+		}
+
+		lines := strings.Split(part, "\n")
+		ls.count += len(lines)
+		ls.width = max(ls.width, maxLineWidth(lines))
+
+		ls.offsets = append(ls.offsets, buf.Len())
+		zw.Reset(&buf)
+		if _, err := io.WriteString(zw, part); err != nil {
+			return newUncompressedStore(s)
+		}
+		if err := zw.Close(); err != nil {
+			return newUncompressedStore(s)
+		}
+		if end < 0 {
+			break
+		}
+	}
+	ls.offsets = append(ls.offsets, buf.Len())
+	ls.gz = bytes.Clone(buf.Bytes())
+	return ls
+}
+
+func newUncompressedStore(s string) *lineStore {
+	lines := strings.Split(s, "\n")
+	return &lineStore{lines: lines, count: len(lines), width: maxLineWidth(lines), chunk: -1}
+}
+
+// nthIndexByte returns the index of the nth occurrence of c in s, or -1
+func nthIndexByte(s string, c byte, n int) int {
+	pos := -1
+	for range n {
+		i := strings.IndexByte(s[pos+1:], c)
+		if i < 0 {
+			return -1
+		}
+		pos += i + 1
+	}
+	return pos
+}
+
+func maxLineWidth(lines []string) int {
+	width := 0
+	for _, line := range lines {
+		width = max(width, ansi.StringWidth(line))
+	}
+	return width
+}
+
+// Len returns the number of lines
+func (ls *lineStore) Len() int {
+	return ls.count
+}
+
+// Size returns the bytes retained for the content
+func (ls *lineStore) Size() int {
+	if ls.gz == nil {
+		n := 0
+		for _, line := range ls.lines {
+			n += len(line)
+		}
+		return n
+	}
+	return len(ls.gz) + 8*len(ls.offsets)
+}
+
+// Lines returns lines [start, end), decompressing only the chunks they
+// fall in
+func (ls *lineStore) Lines(start, end int) []string {
+	if ls.gz == nil {
+		return ls.lines[start:end]
+	}
+	out := make([]string, 0, end-start)
+	for i := start; i < end; {
+		chunk := ls.load(i / linesPerChunk)
+		j := i % linesPerChunk
+		n := min(len(chunk)-j, end-i)
+		out = append(out, chunk[j:j+n]...)
+		i += n
+	}
+	return out
+}
+
+// Line returns line i
+func (ls *lineStore) Line(i int) string {
+	if ls.gz == nil {
+		return ls.lines[i]
+	}
+	return ls.load(i / linesPerChunk)[i%linesPerChunk]
+}
+
+// load returns the lines of chunk k, keeping the last one decompressed
+func (ls *lineStore) load(k int) []string {
+	if k == ls.chunk {
+		return ls.cached
+	}
+	n := min(linesPerChunk, ls.count-k*linesPerChunk)
+	lines := make([]string, n)
+	zr, err := gzip.NewReader(bytes.NewReader(ls.gz[ls.offsets[k]:ls.offsets[k+1]]))
+	if err == nil {
+		var data []byte
+		if data, err = io.ReadAll(zr); err == nil {
+			copy(lines, strings.Split(string(data), "\n"))
+		}
+	}
+	if err != nil {
+		log.Warn("failed to read detail content", "error", err)
+	}
+	ls.chunk, ls.cached = k, lines
+	return lines
+}
+
+// invalidateContent makes the next setContent render the detail again
+func (d *DetailView) invalidateContent() {
+	d.contentValid = false
+}
+
+// setContent renders the detail into a line store for the viewport, unless
+// the current content is still valid. Lines are styled for search and
+// refresh placeholders only when they are drawn, by viewportView.
+func (d *DetailView) setContent() {
+	if !d.vp.Ready || d.contentValid {
+		return
+	}
+	d.content = d.renderLines()
+	d.contentValid = true
+	d.setYOffset(d.yOffset)
+	d.setXOffset(d.xOffset)
+	d.findMatches()
+}
+
+// renderLines renders the detail into a line store. The unfiltered raw JSON
+// is kept until the resource changes, so toggling back to it or clearing a
+// jq filter doesn't encode it again.
+func (d *DetailView) renderLines() *lineStore {
+	if d.rawMode && d.jqExpr == "" {
+		if d.rawJSON == nil {
+			d.rawJSON = newLineStore(d.renderRaw())
+		}
+		return d.rawJSON
+	}
+	return newLineStore(d.renderContent())
+}
+
+// findMatches records the lines matching the search (case-insensitive)
+func (d *DetailView) findMatches() {
+	d.matches = d.matches[:0]
+	if d.searchText == "" || d.content == nil {
+		return
+	}
+
+	query := strings.ToLower(d.searchText)
+	for start := 0; start < d.content.Len(); start += linesPerChunk {
+		for i, line := range d.content.Lines(start, min(start+linesPerChunk, d.content.Len())) {
+			if strings.Contains(strings.ToLower(ansi.Strip(line)), query) {
+				d.matches = append(d.matches, start+i)
+			}
+		}
+	}
+	if d.matchIdx >= len(d.matches) {
+		d.matchIdx = 0
+	}
+}
+
+// plainLine returns content line i without styling, for search and yanking
+func (d *DetailView) plainLine(i int) string {
+	return ansi.Strip(d.content.Line(i))
+}
+
+// styledLine returns content line i as drawn: placeholders show "Loading..."
+// while the detail refreshes, the current match is shown as selected and
+// other matches have the term highlighted.
+func (d *DetailView) styledLine(i int, line string) string {
+	n, ok := slices.BinarySearch(d.matches, i)
+	if !ok {
+		if d.refreshing {
+			return loadingPlaceholder(line)
+		}
+		return line
+	}
+	plain := ansi.Strip(line)
+	if n == d.matchIdx {
+		return ui.SelectedStyle().Render(plain)
+	}
+	return highlightTerm(plain, strings.ToLower(d.searchText))
+}
+
+// loadingPlaceholder replaces a placeholder value at the end of line with
+// "Loading...". Only line endings match, so e.g. "Not configured server"
+// is left alone.
+func loadingPlaceholder(line string) string {
+	for _, placeholder := range []string{render.NotConfigured, render.Empty, render.NoValue} {
+		if strings.HasSuffix(line, placeholder) {
+			return line[:len(line)-len(placeholder)] + ui.DimStyle().Render(LoadingMessage)
+		}
+	}
+	return line
+}
+
+// maxYOffset returns the last line the viewport can start at
+func (d *DetailView) maxYOffset() int {
+	if d.content == nil {
+		return 0
+	}
+	return max(0, d.content.Len()-d.vp.Model.Height())
+}
+
+func (d *DetailView) setYOffset(n int) {
+	d.yOffset = max(0, min(n, d.maxYOffset()))
+}
+
+func (d *DetailView) setXOffset(n int) {
+	width := 0
+	if d.content != nil {
+		width = d.content.width
+	}
+	d.xOffset = max(0, min(n, width-d.vp.Model.Width()))
+}
+
+// ensureVisible scrolls so content line i is on screen
+func (d *DetailView) ensureVisible(i int) {
+	d.setXOffset(0)
+	if h := d.vp.Model.Height(); i < d.yOffset {
+		d.setYOffset(i)
+	} else if i >= d.yOffset+h {
+		d.setYOffset(i - h + 1)
+	}
+}
+
+// scroll moves the viewport for the bubbles viewport's scroll keys and the
+// mouse wheel. The detail view keeps its own offsets since the viewport
+// would need every line of the content.
+func (d *DetailView) scroll(msg tea.Msg) {
+	keys := viewport.DefaultKeyMap()
+	h := d.vp.Model.Height()
+	switch msg := msg.(type) {
+	case tea.KeyPressMsg:
+		switch {
+		case key.Matches(msg, keys.PageDown):
+			d.setYOffset(d.yOffset + h)
+		case key.Matches(msg, keys.PageUp):
+			d.setYOffset(d.yOffset - h)
+		case key.Matches(msg, keys.HalfPageDown):
+			d.setYOffset(d.yOffset + h/2)
+		case key.Matches(msg, keys.HalfPageUp):
+			d.setYOffset(d.yOffset - h/2)
+		case key.Matches(msg, keys.Down):
+			d.setYOffset(d.yOffset + 1)
+		case key.Matches(msg, keys.Up):
+			d.setYOffset(d.yOffset - 1)
+		case key.Matches(msg, keys.Left):
+			d.setXOffset(d.xOffset - horizontalStep)
+		case key.Matches(msg, keys.Right):
+			d.setXOffset(d.xOffset + horizontalStep)
+		}
+	case tea.MouseWheelMsg:
+		switch {
+		case msg.Button == tea.MouseWheelDown && msg.Mod.Contains(tea.ModShift), msg.Button == tea.MouseWheelRight:
+			d.setXOffset(d.xOffset + horizontalStep)
+		case msg.Button == tea.MouseWheelUp && msg.Mod.Contains(tea.ModShift), msg.Button == tea.MouseWheelLeft:
+			d.setXOffset(d.xOffset - horizontalStep)
+		case msg.Button == tea.MouseWheelDown:
+			d.setYOffset(d.yOffset + mouseWheelDelta)
+		case msg.Button == tea.MouseWheelUp:
+			d.setYOffset(d.yOffset - mouseWheelDelta)
+		}
+	}
+}
+
+// viewportView draws the visible window of the content. Only those lines
+// are decompressed, styled and cut to width, so scrolling a multi-megabyte
+// document costs the same as a short one.
+func (d *DetailView) viewportView() string {
+	m := &d.vp.Model
+	w, h := m.Width(), m.Height()
+	if w <= 0 || h <= 0 || d.content == nil {
+		return ""
+	}
+
+	start := min(d.yOffset, d.content.Len())
+	end := min(start+h, d.content.Len())
+	lines := d.content.Lines(start, end)
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		line = d.styledLine(start+i, line)
+		if x := d.xOffset; x > 0 {
+			line = ansi.Cut(line, x, x+w)
+		} else {
+			line = ansi.Truncate(line, w, "")
+		}
+		out[i] = line
+	}
+	return lipgloss.NewStyle().Width(w).Height(h).Render(strings.Join(out, "\n"))
+}
@@ -0,0 +1,184 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"github.com/itchyny/gojq"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const (
+	// jqTimeout bounds a jq evaluation so expressions like repeat(.) can't hang the UI.
+	jqTimeout = 2 * time.Second
+	// jqMaxResults caps the number of values a jq expression may emit.
+	jqMaxResults = 1000
+)
+
+func newDetailJQInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = ".State.Name"
+	ti.Prompt = "jq "
+	ti.CharLimit = 500
+	return ti
+}
+
+// toggleRaw switches between the renderer's output and raw JSON
+func (d *DetailView) toggleRaw() (tea.Model, tea.Cmd) {
+	d.rawMode = !d.rawMode
+	d.matchIdx = 0
+	d.invalidateContent()
+	d.recalcViewport()
+	d.setYOffset(0)
+	return d, nil
+}
+
+// openJQInput focuses the jq filter, switching to raw JSON if needed
+func (d *DetailView) openJQInput() (tea.Model, tea.Cmd) {
+	if !d.rawMode {
+		d.rawMode = true
+		d.invalidateContent()
+	}
+	d.jqActive = true
+	d.jqInput.Focus()
+	d.recalcViewport()
+	return d, textinput.Blink
+}
+
+// handleJQInput handles keys while the jq filter is focused
+func (d *DetailView) handleJQInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		d.jqActive = false
+		d.jqInput.Blur()
+		d.jqInput.SetValue(d.jqExpr)
+		d.recalcViewport()
+		return d, nil
+	case "enter":
+		d.jqActive = false
+		d.jqInput.Blur()
+		d.setJQExpr(strings.TrimSpace(d.jqInput.Value()))
+		d.matchIdx = 0
+		d.invalidateContent()
+		d.recalcViewport()
+		d.setYOffset(0)
+		return d, nil
+	}
+	var cmd tea.Cmd
+	d.jqInput, cmd = d.jqInput.Update(msg)
+	return d, cmd
+}
+
+// setJQExpr compiles expr; an empty expression shows the whole document
+func (d *DetailView) setJQExpr(expr string) {
+	d.jqExpr = expr
+	d.jqCode = nil
+	d.jqErr = nil
+	if expr == "" {
+		return
+	}
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		d.jqErr = err
+		return
+	}
+	d.jqCode, d.jqErr = gojq.Compile(query)
+}
+
+// renderRaw renders Resource.Raw() as indented JSON, projected through the
+// jq expression when one is set.
+func (d *DetailView) renderRaw() string {
+	raw := dao.UnwrapResource(d.resource).Raw()
+	if raw == nil {
+		return ui.DimStyle().Render("(No raw data for this resource)")
+	}
+
+	doc, err := normalizeJSON(raw)
+	if err != nil {
+		return ui.DangerStyle().Render(fmt.Sprintf("Failed to encode raw data: %v", err))
+	}
+	if d.jqErr != nil {
+		return ui.DangerStyle().Render(fmt.Sprintf("jq: %v", d.jqErr))
+	}
+	if d.jqCode == nil {
+		return indentJSON(doc)
+	}
+
+	results, err := runJQ(d.ctx, d.jqCode, doc)
+	out := make([]string, 0, len(results)+1)
+	for _, v := range results {
+		out = append(out, indentJSON(v))
+	}
+	if err != nil {
+		out = append(out, ui.DangerStyle().Render(fmt.Sprintf("jq: %v", err)))
+	}
+	if len(out) == 0 {
+		return ui.DimStyle().Render("(No results)")
+	}
+	return strings.Join(out, "\n")
+}
+
+// runJQ evaluates code against doc, returning the values emitted before any
+// error, timeout, or the result cap.
+func runJQ(ctx context.Context, code *gojq.Code, doc any) ([]any, error) {
+	ctx, cancel := context.WithTimeout(ctx, jqTimeout)
+	defer cancel()
+
+	var results []any
+	iter := code.RunWithContext(ctx, doc)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return results, nil
+		}
+		if err, isErr := v.(error); isErr {
+			var halt *gojq.HaltError
+			if errors.As(err, &halt) && halt.Value() == nil {
+				return results, nil
+			}
+			return results, err
+		}
+		if len(results) == jqMaxResults {
+			return results, fmt.Errorf("more than %d results", jqMaxResults)
+		}
+		results = append(results, v)
+	}
+}
+
+// normalizeJSON round-trips v through encoding/json so gojq sees only maps,
+// slices, and scalars.
+func normalizeJSON(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func indentJSON(v any) string {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// jqLine renders the jq prompt or the applied expression
+func (d *DetailView) jqLine() string {
+	if d.jqActive {
+		return ui.InputFieldStyle().Render(d.jqInput.View())
+	}
+	return ui.AccentStyle().Render("jq " + d.jqExpr)
+}
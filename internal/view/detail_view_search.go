@@ -0,0 +1,148 @@
+package view
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+func newDetailSearchInput() textinput.Model {
+	ti := textinput.New()
+	ti.Placeholder = "search..."
+	ti.Prompt = "/"
+	ti.CharLimit = 200
+	return ti
+}
+
+// inputLines returns the search and jq lines shown above the viewport
+func (d *DetailView) inputLines() []string {
+	var lines []string
+	if d.rawMode && (d.jqActive || d.jqExpr != "") {
+		lines = append(lines, d.jqLine())
+	}
+	if d.searchActive || d.searchText != "" {
+		lines = append(lines, d.searchLine())
+	}
+	return lines
+}
+
+// handleSearchInput handles keys while the search prompt is focused
+func (d *DetailView) handleSearchInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		d.searchActive = false
+		d.searchInput.Blur()
+		d.searchInput.SetValue(d.searchText)
+		d.recalcViewport()
+		return d, nil
+	case "enter":
+		d.searchActive = false
+		d.searchInput.Blur()
+		d.searchText = d.searchInput.Value()
+		d.matchIdx = 0
+		d.findMatches()
+		d.recalcViewport()
+		d.scrollToMatch()
+		return d, nil
+	}
+	var cmd tea.Cmd
+	d.searchInput, cmd = d.searchInput.Update(msg)
+	return d, cmd
+}
+
+// handleSearchKey handles n/N and y once a search has been applied. It runs
+// before navigation shortcuts so n/N always step through matches.
+func (d *DetailView) handleSearchKey(key string) (tea.Model, tea.Cmd) {
+	if d.searchText == "" {
+		return nil, nil
+	}
+	switch key {
+	case "n", "N":
+		if len(d.matches) == 0 {
+			return d, nil
+		}
+		step := 1
+		if key == "N" {
+			step = -1
+		}
+		d.matchIdx = (d.matchIdx + step + len(d.matches)) % len(d.matches)
+		d.scrollToMatch()
+		return d, nil
+	case "y":
+		if value := d.matchedFieldValue(); value != "" {
+			return d, clipboard.Copy("Value", value)
+		}
+	}
+	return nil, nil
+}
+
+// highlightTerm highlights each occurrence of the lowercase query in line
+func highlightTerm(line, query string) string {
+	lower := strings.ToLower(line)
+	if len(lower) != len(line) {
+		// Case folding changed byte offsets; highlight the whole line
+		return ui.HighlightStyle().Render(line)
+	}
+
+	style := ui.HighlightStyle()
+	var out strings.Builder
+	for {
+		i := strings.Index(lower, query)
+		if i < 0 {
+			out.WriteString(line)
+			return out.String()
+		}
+		out.WriteString(line[:i])
+		out.WriteString(style.Render(line[i : i+len(query)]))
+		line, lower = line[i+len(query):], lower[i+len(query):]
+	}
+}
+
+// scrollToMatch scrolls the viewport so the current match is visible
+func (d *DetailView) scrollToMatch() {
+	if !d.vp.Ready || len(d.matches) == 0 {
+		return
+	}
+	d.ensureVisible(d.matches[d.matchIdx])
+}
+
+// matchedFieldValue returns the value of the field on the current match line
+func (d *DetailView) matchedFieldValue() string {
+	if len(d.matches) == 0 {
+		return ""
+	}
+	return fieldValue(d.plainLine(d.matches[d.matchIdx]))
+}
+
+// fieldValue extracts the value from a detail line: the text after a
+// "Label:" prefix, unquoted for JSON-style lines. Lines without a label
+// (e.g. an ARN or a list item) are returned whole.
+func fieldValue(line string) string {
+	line = strings.TrimSpace(line)
+	label, value, ok := strings.Cut(line, ": ")
+	if !ok || strings.TrimSpace(label) == "" || strings.TrimSpace(value) == "" {
+		return strings.TrimPrefix(line, "- ")
+	}
+	value = strings.TrimSuffix(strings.TrimSpace(value), ",")
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		return unquoted
+	}
+	return value
+}
+
+// searchLine renders the search prompt or the applied search
+func (d *DetailView) searchLine() string {
+	if d.searchActive {
+		return ui.InputFieldStyle().Render(d.searchInput.View())
+	}
+	if len(d.matches) == 0 {
+		return ui.AccentStyle().Render(fmt.Sprintf("🔍 %s (no matches)", d.searchText))
+	}
+	return ui.AccentStyle().Render(fmt.Sprintf("🔍 %s (%d/%d)", d.searchText, d.matchIdx+1, len(d.matches)))
+}
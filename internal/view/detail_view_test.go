@@ -3,10 +3,13 @@ package view
 import (
 	"context"
 	"fmt"
+	"runtime"
+	"slices"
 	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/itchyny/gojq"
 
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -230,7 +233,7 @@ func TestDetailViewLoadingPlaceholderReplacement(t *testing.T) {
 			dv.refreshing = tt.refreshing
 			dv.SetSize(100, 50)
 
-			content := dv.vp.Model.View()
+			content := dv.viewportView()
 
 			for _, want := range tt.wantContains {
 				if !strings.Contains(content, want) {
@@ -319,3 +322,307 @@ func TestDetailViewCopyARNNoARN(t *testing.T) {
 		t.Fatal("Expected cmd from 'Y' key press for NoARN")
 	}
 }
+
+func newSearchTestDetailView() *DetailView {
+	resource := &mockResource{id: "i-123", name: "web"}
+	detail := strings.Join([]string{
+		"Instance Details",
+		"Instance Type: t3.micro",
+		"Subnet ID:     subnet-aaa",
+		`  "VpcId": "vpc-123",`,
+		"arn:aws:ec2:us-east-1:123456789012:subnet/subnet-bbb",
+	}, "\n")
+	dv := NewDetailView(context.Background(), resource, &mockRenderer{detail: detail}, "ec2", "instances", nil, nil)
+	dv.SetSize(100, 50)
+	return dv
+}
+
+func typeDetailSearch(dv *DetailView, query string) {
+	dv.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	for _, r := range query {
+		dv.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	dv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+}
+
+func TestDetailViewSearch(t *testing.T) {
+	dv := newSearchTestDetailView()
+
+	dv.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	if !dv.HasActiveInput() {
+		t.Fatal("Expected search input to capture keys after '/'")
+	}
+	dv.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if dv.HasActiveInput() || dv.searchText != "" {
+		t.Fatal("Expected esc to cancel the search prompt")
+	}
+
+	typeDetailSearch(dv, "SUBNET")
+	if len(dv.matches) != 2 {
+		t.Fatalf("matches = %v, want 2 lines", dv.matches)
+	}
+	if !strings.Contains(dv.ViewString(), "(1/2)") {
+		t.Error("Expected match position in search line")
+	}
+
+	dv.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if dv.matchIdx != 1 {
+		t.Errorf("matchIdx after n = %d, want 1", dv.matchIdx)
+	}
+	dv.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if dv.matchIdx != 0 {
+		t.Errorf("matchIdx after wrapping n = %d, want 0", dv.matchIdx)
+	}
+	dv.Update(tea.KeyPressMsg{Code: 'N', Text: "N"})
+	if dv.matchIdx != 1 {
+		t.Errorf("matchIdx after N = %d, want 1", dv.matchIdx)
+	}
+
+	// Submitting an empty search clears it
+	dv.searchInput.SetValue("")
+	typeDetailSearch(dv, "")
+	if dv.searchText != "" || len(dv.matches) != 0 {
+		t.Error("Expected empty search to clear matches")
+	}
+}
+
+func TestDetailViewSearchYankValue(t *testing.T) {
+	dv := newSearchTestDetailView()
+	typeDetailSearch(dv, "vpc")
+
+	_, cmd := dv.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if cmd == nil {
+		t.Fatal("Expected cmd from 'y' with a match")
+	}
+	if got := dv.matchedFieldValue(); got != "vpc-123" {
+		t.Errorf("matchedFieldValue() = %q, want %q", got, "vpc-123")
+	}
+}
+
+func TestFieldValue(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"Instance Type: t3.micro", "t3.micro"},
+		{"ID:            i-123", "i-123"},
+		{`  "VpcId": "vpc-123",`, "vpc-123"},
+		{`"Count": 3`, "3"},
+		{"arn:aws:s3:::bucket", "arn:aws:s3:::bucket"},
+		{"  - sg-123", "sg-123"},
+		{"Tags:", "Tags:"},
+		{"Launched: 2024-01-01T10:00:00Z", "2024-01-01T10:00:00Z"},
+	}
+	for _, tt := range tests {
+		if got := fieldValue(tt.line); got != tt.want {
+			t.Errorf("fieldValue(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+func newRawTestDetailView() *DetailView {
+	resource := &dao.BaseResource{
+		ID: "i-123",
+		Data: map[string]any{
+			"InstanceId": "i-123",
+			"State":      map[string]any{"Name": "running"},
+			"Tags":       []map[string]string{{"Key": "Env", "Value": "prod"}, {"Key": "Team", "Value": "web"}},
+		},
+	}
+	dv := NewDetailView(context.Background(), resource, &mockRenderer{detail: "formatted"}, "ec2", "instances", nil, nil)
+	dv.SetSize(100, 50)
+	return dv
+}
+
+func applyJQ(dv *DetailView, expr string) {
+	dv.Update(tea.KeyPressMsg{Code: '|', Text: "|"})
+	dv.jqInput.SetValue(expr)
+	dv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+}
+
+func TestDetailViewRawToggle(t *testing.T) {
+	dv := newRawTestDetailView()
+
+	dv.Update(tea.KeyPressMsg{Code: 'J', Text: "J"})
+	content := dv.renderContent()
+	if !strings.Contains(content, `"Name": "running"`) {
+		t.Errorf("Expected raw JSON, got %q", content)
+	}
+
+	dv.Update(tea.KeyPressMsg{Code: 'J', Text: "J"})
+	if got := dv.renderContent(); got != "formatted" {
+		t.Errorf("Expected renderer output after toggling back, got %q", got)
+	}
+}
+
+func TestDetailViewRawNoData(t *testing.T) {
+	dv := NewDetailView(context.Background(), &mockResource{id: "x"}, nil, "test", "items", nil, nil)
+	dv.SetSize(100, 50)
+	dv.Update(tea.KeyPressMsg{Code: 'J', Text: "J"})
+	if !strings.Contains(dv.renderContent(), "No raw data") {
+		t.Error("Expected placeholder for resource without raw data")
+	}
+}
+
+func TestDetailViewJQ(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{"field", ".State.Name", `"running"`},
+		{"stream", ".Tags[].Key", "\"Env\"\n\"Team\""},
+		{"object", `.Tags | from_entries | {Env}`, "{\n  \"Env\": \"prod\"\n}"},
+		{"empty", "empty", "No results"},
+		{"parse error", ".State.", "jq:"},
+		{"runtime error", ".State.Name | keys", "jq:"},
+		{"clear", "", `"InstanceId": "i-123"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dv := newRawTestDetailView()
+			dv.Update(tea.KeyPressMsg{Code: '|', Text: "|"})
+			if !dv.rawMode || !dv.HasActiveInput() {
+				t.Fatal("Expected '|' to open jq input in raw mode")
+			}
+			dv.jqInput.SetValue(tt.expr)
+			dv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+			if got := dv.renderContent(); !strings.Contains(got, tt.want) {
+				t.Errorf("renderContent() = %q, want containing %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetailViewJQEscKeepsExpression(t *testing.T) {
+	dv := newRawTestDetailView()
+	applyJQ(dv, ".State")
+
+	dv.Update(tea.KeyPressMsg{Code: '|', Text: "|"})
+	dv.jqInput.SetValue(".Tags")
+	dv.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+
+	if dv.jqExpr != ".State" || dv.jqInput.Value() != ".State" {
+		t.Errorf("jqExpr = %q, input = %q; want .State kept after esc", dv.jqExpr, dv.jqInput.Value())
+	}
+}
+
+func TestRunJQResultCap(t *testing.T) {
+	query, err := gojq.Parse("range(2000)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := runJQ(context.Background(), code, nil)
+	if err == nil || len(results) != jqMaxResults {
+		t.Errorf("runJQ() = %d results, err %v; want %d and an error", len(results), err, jqMaxResults)
+	}
+}
+
+func TestLineStore(t *testing.T) {
+	small := "short\ntext"
+	if ls := newLineStore(small); ls.gz != nil || ls.Len() != 2 || ls.Line(1) != "text" {
+		t.Errorf("small store = %+v, want 2 uncompressed lines", ls)
+	}
+
+	lines := make([]string, compressThreshold/16)
+	for i := range lines {
+		lines[i] = fmt.Sprintf(`{"Key": "Env", "Value": "prod-%d"}`, i)
+	}
+	large := strings.Join(lines, "\n") + "\n"
+	ls := newLineStore(large)
+	if ls.gz == nil || ls.lines != nil {
+		t.Fatal("large store should be kept compressed")
+	}
+	if ls.Size() >= len(large)/4 {
+		t.Errorf("compressed %d bytes to %d, want far less", len(large), ls.Size())
+	}
+	if ls.Len() != len(lines)+1 {
+		t.Fatalf("Len() = %d, want %d", ls.Len(), len(lines)+1)
+	}
+	if got := strings.Join(ls.Lines(0, ls.Len()), "\n"); got != large {
+		t.Error("large store did not round-trip")
+	}
+	for _, i := range []int{0, linesPerChunk - 1, linesPerChunk, len(lines) - 1} {
+		if ls.Line(i) != lines[i] {
+			t.Errorf("Line(%d) = %q, want %q", i, ls.Line(i), lines[i])
+		}
+	}
+	if got := ls.Lines(linesPerChunk-2, linesPerChunk+2); !slices.Equal(got, lines[linesPerChunk-2:linesPerChunk+2]) {
+		t.Errorf("Lines across a chunk boundary = %q", got)
+	}
+}
+
+func TestDetailViewRawRetainedSize(t *testing.T) {
+	items := make([]any, 80000)
+	for i := range items {
+		items[i] = map[string]any{"Key": fmt.Sprintf("tag-%d", i), "Value": fmt.Sprintf("value-%d", i)}
+	}
+	resource := &dao.BaseResource{ID: "big", Data: map[string]any{"Tags": items}}
+	dv := NewDetailView(context.Background(), resource, &mockRenderer{detail: "formatted"}, "test", "items", nil, nil)
+	dv.SetSize(80, 20)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	dv.toggleRaw()
+	dv.setYOffset(dv.content.Len() / 2)
+	typeDetailSearch(dv, "tag-40000\"")
+	out := dv.ViewString()
+
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+
+	payload := len(dv.renderRaw())
+	if payload < 4<<20 {
+		t.Fatalf("raw payload is %d bytes, want a multi-MB document", payload)
+	}
+	if !strings.Contains(out, "tag-40000") {
+		t.Errorf("search should scroll to the match, got:\n%s", out)
+	}
+	if size := dv.content.Size(); size > payload/4 {
+		t.Errorf("content retains %d bytes of a %d byte payload", size, payload)
+	}
+	if grown := int64(after.HeapAlloc) - int64(before.HeapAlloc); grown > int64(payload/3) {
+		t.Errorf("heap grew by %d bytes for a %d byte payload", grown, payload)
+	}
+	runtime.KeepAlive(dv)
+}
+
+func TestDetailViewViewportWindow(t *testing.T) {
+	lines := make([]string, 10000)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("Line %05d: value", i)
+	}
+	dv := NewDetailView(context.Background(), &mockResource{id: "x"}, &mockRenderer{detail: strings.Join(lines, "\n")}, "test", "items", nil, nil)
+	dv.SetSize(80, 20)
+
+	dv.setYOffset(5000)
+	out := dv.viewportView()
+	if !strings.Contains(out, "Line 05000") || strings.Contains(out, "Line 04999") {
+		t.Errorf("viewport should start at line 5000, got:\n%s", out)
+	}
+	if got := strings.Count(out, "\n") + 1; got != dv.vp.Model.Height() {
+		t.Errorf("viewport rendered %d lines, want %d", got, dv.vp.Model.Height())
+	}
+}
+
+func TestDetailViewSearchKeepsContent(t *testing.T) {
+	dv := newSearchTestDetailView()
+	typeDetailSearch(dv, "subnet")
+	content := dv.content
+
+	dv.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if dv.content != content {
+		t.Error("moving between matches should not render the detail again")
+	}
+	if !strings.Contains(dv.ViewString(), "(2/2)") {
+		t.Error("Expected second match to be current after n")
+	}
+}
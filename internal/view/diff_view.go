@@ -179,3 +179,15 @@ func (d *DiffView) Left() dao.Resource   { return d.left }
 func (d *DiffView) Right() dao.Resource  { return d.right }
 func (d *DiffView) Service() string      { return d.service }
 func (d *DiffView) ResourceType() string { return d.resourceType }
+
+// KeyHelp implements KeyHelper
+func (d *DiffView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{
+		Title: "Compare View",
+		Bindings: []KeyBinding{
+			{"↑/k, ↓/j", "Scroll"},
+			{"PgUp, PgDn", "Page up / down"},
+			{"Esc", "Back to resource list"},
+		},
+	}}
+}
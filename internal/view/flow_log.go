@@ -0,0 +1,143 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clawscli/claws/internal/render"
+)
+
+// defaultFlowLogFormat is the record layout of flow logs created without a
+// custom LogFormat.
+const defaultFlowLogFormat = "${version} ${account-id} ${interface-id} ${srcaddr} ${dstaddr} ${srcport} ${dstport} ${protocol} ${packets} ${bytes} ${start} ${end} ${action} ${log-status}"
+
+// NewFlowLogView creates a LogView that tails a flow log's CloudWatch log
+// group and renders each record as a one-line connection summary. When
+// streamPrefix is set (a network interface ID) only that interface's streams
+// are read. format is the flow log's LogFormat, or "" for the default.
+func NewFlowLogView(ctx context.Context, logGroupName, streamPrefix, format string) *LogView {
+	v := NewLogView(ctx, logGroupName)
+	v.streamPrefix = streamPrefix
+	v.flowLogFields = flowLogFields(format)
+	v.filterInput.Placeholder = "Filter records (IP, port, ACCEPT/REJECT)..."
+	return v
+}
+
+// flowLogFields returns the field names of a flow log format string such as
+// "${srcaddr} ${dstaddr} ${action}".
+func flowLogFields(format string) []string {
+	if strings.TrimSpace(format) == "" {
+		format = defaultFlowLogFormat
+	}
+	var fields []string
+	for _, f := range strings.Fields(format) {
+		fields = append(fields, strings.TrimSuffix(strings.TrimPrefix(f, "${"), "}"))
+	}
+	return fields
+}
+
+// flowLogRecord maps field names to values for a single record.
+type flowLogRecord map[string]string
+
+// parseFlowLogRecord splits a space-separated record according to fields.
+// Records with a different number of values (e.g. a CSV header or a record
+// written before the format changed) are rejected.
+func parseFlowLogRecord(fields []string, line string) (flowLogRecord, bool) {
+	values := strings.Fields(line)
+	if len(values) != len(fields) {
+		return nil, false
+	}
+	rec := make(flowLogRecord, len(fields))
+	for i, f := range fields {
+		rec[f] = values[i]
+	}
+	return rec, true
+}
+
+// hasData reports whether the record describes traffic rather than an
+// aggregation interval with no data (NODATA) or dropped records (SKIPDATA).
+func (r flowLogRecord) hasData() bool {
+	status, ok := r["log-status"]
+	return !ok || status == "OK"
+}
+
+// summary renders the record as "eni-1 10.0.0.1:443 → 10.0.0.2:5000 TCP 3 pkts 1.2 KB".
+func (r flowLogRecord) summary() string {
+	var parts []string
+	if eni := r.value("interface-id"); eni != "" {
+		parts = append(parts, eni)
+	}
+	if src, dst := r.value("srcaddr"), r.value("dstaddr"); src != "" || dst != "" {
+		parts = append(parts, endpoint(src, r.value("srcport"))+" → "+endpoint(dst, r.value("dstport")))
+	}
+	if proto := r.value("protocol"); proto != "" {
+		parts = append(parts, protocolName(proto))
+	}
+	if packets := r.value("packets"); packets != "" {
+		parts = append(parts, packets+" pkts")
+	}
+	if n, err := strconv.ParseInt(r.value("bytes"), 10, 64); err == nil {
+		parts = append(parts, render.FormatSize(n))
+	}
+	return strings.Join(parts, " ")
+}
+
+// value returns a field, treating the "-" placeholder as empty.
+func (r flowLogRecord) value(field string) string {
+	if v := r[field]; v != "-" {
+		return v
+	}
+	return ""
+}
+
+func endpoint(addr, port string) string {
+	if addr == "" {
+		addr = "*"
+	}
+	if port == "" || port == "0" {
+		return addr
+	}
+	if strings.Contains(addr, ":") {
+		return "[" + addr + "]:" + port
+	}
+	return addr + ":" + port
+}
+
+// protocolName maps common IANA protocol numbers to names.
+func protocolName(proto string) string {
+	switch proto {
+	case "1":
+		return "ICMP"
+	case "6":
+		return "TCP"
+	case "17":
+		return "UDP"
+	case "58":
+		return "ICMPv6"
+	default:
+		return "proto " + proto
+	}
+}
+
+// renderFlowLogRecord formats a record for the viewport with the action
+// colored; lines that are not records of the expected format are shown as-is.
+func (v *LogView) renderFlowLogRecord(message string) string {
+	rec, ok := parseFlowLogRecord(v.flowLogFields, message)
+	if !ok {
+		return v.styles.message.Render(message)
+	}
+	if !rec.hasData() {
+		return v.styles.dim.Render(fmt.Sprintf("%s %s", rec.value("interface-id"), rec["log-status"]))
+	}
+
+	line := v.styles.message.Render(rec.summary())
+	switch rec["action"] {
+	case "ACCEPT":
+		line += " " + v.styles.accept.Render("ACCEPT")
+	case "REJECT":
+		line += " " + v.styles.reject.Render("REJECT")
+	}
+	return line
+}
@@ -0,0 +1,100 @@
+package view
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFlowLogFields(t *testing.T) {
+	if got := flowLogFields(""); len(got) != 14 || got[2] != "interface-id" || got[12] != "action" {
+		t.Errorf("flowLogFields(\"\") = %v, want default format", got)
+	}
+	got := flowLogFields("${srcaddr} ${dstaddr} ${action}")
+	if want := []string{"srcaddr", "dstaddr", "action"}; !slices.Equal(got, want) {
+		t.Errorf("flowLogFields(custom) = %v, want %v", got, want)
+	}
+}
+
+func TestFlowLogRecordSummary(t *testing.T) {
+	fields := flowLogFields("")
+	tests := []struct {
+		name   string
+		line   string
+		want   string
+		ok     bool
+		noData bool
+	}{
+		{
+			name: "tcp accept",
+			line: "2 123456789012 eni-0a1b2c3d 10.0.1.5 10.0.2.7 443 51234 6 10 2048 1700000000 1700000060 ACCEPT OK",
+			want: "eni-0a1b2c3d 10.0.1.5:443 → 10.0.2.7:51234 TCP 10 pkts 2.0 KiB",
+			ok:   true,
+		},
+		{
+			name: "icmp without ports",
+			line: "2 123456789012 eni-0a1b2c3d 10.0.1.5 10.0.2.7 0 0 1 1 84 1700000000 1700000060 REJECT OK",
+			want: "eni-0a1b2c3d 10.0.1.5 → 10.0.2.7 ICMP 1 pkts 84 B",
+			ok:   true,
+		},
+		{
+			name:   "no data",
+			line:   "2 123456789012 eni-0a1b2c3d - - - - - - - 1700000000 1700000060 - NODATA",
+			ok:     true,
+			noData: true,
+		},
+		{
+			name: "wrong field count",
+			line: "version account-id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, ok := parseFlowLogRecord(fields, tt.line)
+			if ok != tt.ok {
+				t.Fatalf("parseFlowLogRecord() ok = %v, want %v", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if rec.hasData() == tt.noData {
+				t.Errorf("hasData() = %v, want %v", rec.hasData(), !tt.noData)
+			}
+			if !tt.noData {
+				if got := rec.summary(); got != tt.want {
+					t.Errorf("summary() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	if got := endpoint("2001:db8::1", "443"); got != "[2001:db8::1]:443" {
+		t.Errorf("endpoint(ipv6) = %q", got)
+	}
+	if got := endpoint("", "80"); got != "*:80" {
+		t.Errorf("endpoint(empty) = %q", got)
+	}
+}
+
+func TestFlowLogViewRendersRecords(t *testing.T) {
+	v := NewFlowLogView(context.Background(), "/vpc/flow-logs/eni-0a1b2c3d", "eni-0a1b2c3d", "")
+	if v.streamPrefix != "eni-0a1b2c3d" || v.flowLogFields == nil {
+		t.Fatalf("NewFlowLogView() streamPrefix=%q fields=%v", v.streamPrefix, v.flowLogFields)
+	}
+	v.SetSize(120, 24)
+	v.Update(logsLoadedMsg{entries: []logEntry{
+		{timestamp: time.Now(), message: "2 123456789012 eni-0a1b2c3d 10.0.1.5 10.0.2.7 443 51234 6 10 2048 1700000000 1700000060 REJECT OK"},
+		{timestamp: time.Now(), message: "not a record"},
+	}})
+
+	out := v.ViewString()
+	for _, want := range []string{"eni-0a1b2c3d*", "10.0.1.5:443 → 10.0.2.7:51234", "REJECT", "not a record"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("ViewString() missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -23,6 +23,8 @@ const (
 	// profileWidthRatio: profile gets 2/3 of remaining width, region gets 1/3 (compact mode)
 	profileWidthRatio = 2
 	regionWidthRatio  = 3
+
+	breadcrumbSeparator = " ▸ "
 )
 
 // HeaderPanel renders the fixed header panel at the top of resource views
@@ -50,6 +52,8 @@ func newHeaderPanelStyles() headerPanelStyles {
 type HeaderPanel struct {
 	width  int
 	styles headerPanelStyles
+	// detail is the last breadcrumb, e.g. the resource a detail view shows
+	detail string
 }
 
 // NewHeaderPanel creates a new HeaderPanel
@@ -70,10 +74,11 @@ func (h *HeaderPanel) renderProfileAccountLine() string {
 	var profileWithAccount string
 	if cfg.IsMultiProfile() {
 		selections := cfg.Selections()
-		profileWithAccount = formatProfilesWithAccounts(selections, cfg.AccountIDs(), s.value, ui.DangerStyle(), availableWidth)
+		profileWithAccount = formatProfilesWithAccounts(selections, accountLabels(selections), s.value, ui.DangerStyle(), availableWidth)
 	} else {
-		name := cfg.Selection().DisplayName()
-		accID := cmp.Or(cfg.AccountID(), "-")
+		sel := cfg.Selection()
+		name := sel.DisplayName()
+		accID := cmp.Or(cfg.AccountLabel(sel.ID()), "-")
 		profileWithAccount = formatSingleProfile(name, accID, s.value, 0)
 	}
 
@@ -93,10 +98,7 @@ func (h *HeaderPanel) renderRegionServiceLine(service, resourceType string) stri
 	var rightPart string
 	rightWidth := 0
 	if service != "" {
-		displayName := registry.Global.GetDisplayName(service)
-		rightPart = s.accent.Render(displayName) +
-			s.dim.Render(" › ") +
-			s.accent.Render(resourceType)
+		rightPart = h.renderBreadcrumb(service, resourceType)
 		rightWidth = lipgloss.Width(rightPart)
 	}
 
@@ -115,6 +117,24 @@ func (h *HeaderPanel) renderRegionServiceLine(service, resourceType string) stri
 	return leftPart + strings.Repeat(" ", padding) + rightPart
 }
 
+// accountLabels returns each selected profile's account label by profile ID
+func accountLabels(selections []config.ProfileSelection) map[string]string {
+	labels := make(map[string]string, len(selections))
+	for _, sel := range selections {
+		labels[sel.ID()] = config.Global().AccountLabel(sel.ID())
+	}
+	return labels
+}
+
+// panelStyle returns the panel style, its border tinted with the selected
+// profile's color tag
+func (h *HeaderPanel) panelStyle() lipgloss.Style {
+	if c := ui.SelectionColor(); c != nil {
+		return h.styles.panel.BorderForeground(c)
+	}
+	return h.styles.panel
+}
+
 // formatProfilesWithAccounts formats profiles with account IDs, truncating with (+N) suffix when they don't all fit.
 // Note: The first profile is always shown regardless of maxWidth to ensure at least one item is visible.
 func formatProfilesWithAccounts(selections []config.ProfileSelection, accountIDs map[string]string, valueStyle, dangerStyle lipgloss.Style, maxWidth int) string {
@@ -258,6 +278,24 @@ func formatSingleProfile(name, accID string, valueStyle lipgloss.Style, truncate
 	return valueStyle.Render(name + " (" + accID + ")")
 }
 
+// renderBreadcrumb renders the trail "Service ▸ resource ▸ detail"
+func (h *HeaderPanel) renderBreadcrumb(service, resourceType string) string {
+	s := h.styles
+	crumbs := []string{
+		s.accent.Render(registry.Global.GetDisplayName(service)),
+		s.accent.Render(resourceType),
+	}
+	if h.detail != "" {
+		crumbs = append(crumbs, s.value.Render(TruncateString(h.detail, maxFieldValueWidth)))
+	}
+	return strings.Join(crumbs, s.dim.Render(breadcrumbSeparator))
+}
+
+// SetDetail sets the breadcrumb shown after the resource type
+func (h *HeaderPanel) SetDetail(detail string) {
+	h.detail = detail
+}
+
 // SetWidth sets the panel width
 func (h *HeaderPanel) SetWidth(width int) {
 	h.width = width
@@ -285,7 +323,7 @@ func (h *HeaderPanel) RenderHome() string {
 
 	content := strings.Join(lines, "\n")
 
-	panelStyle := h.styles.panel
+	panelStyle := h.panelStyle()
 	if h.width > 4 {
 		panelStyle = panelStyle.Width(h.width - 2)
 	}
@@ -305,10 +343,7 @@ func (h *HeaderPanel) RenderCompact(service, resourceType string) string {
 	var servicePart string
 	serviceWidth := 0
 	if service != "" {
-		displayName := registry.Global.GetDisplayName(service)
-		servicePart = s.accent.Render(displayName) +
-			s.dim.Render(" › ") +
-			s.accent.Render(resourceType)
+		servicePart = h.renderBreadcrumb(service, resourceType)
 		serviceWidth = lipgloss.Width(servicePart)
 	}
 
@@ -323,10 +358,11 @@ func (h *HeaderPanel) RenderCompact(service, resourceType string) string {
 	var profilePart string
 	if cfg.IsMultiProfile() {
 		selections := cfg.Selections()
-		profilePart = formatProfilesWithAccounts(selections, cfg.AccountIDs(), s.value, ui.DangerStyle(), profileMaxWidth)
+		profilePart = formatProfilesWithAccounts(selections, accountLabels(selections), s.value, ui.DangerStyle(), profileMaxWidth)
 	} else {
-		name := cfg.Selection().DisplayName()
-		accID := cmp.Or(cfg.AccountID(), "-")
+		sel := cfg.Selection()
+		name := sel.DisplayName()
+		accID := cmp.Or(cfg.AccountLabel(sel.ID()), "-")
 		profilePart = formatSingleProfile(name, accID, s.value, profileTruncateWidth)
 	}
 
@@ -342,7 +378,7 @@ func (h *HeaderPanel) RenderCompact(service, resourceType string) string {
 	content := strings.Join(parts, separator)
 	content = TruncateString(content, availableWidth)
 
-	panelStyle := s.panel
+	panelStyle := h.panelStyle()
 	if h.width > 4 {
 		panelStyle = panelStyle.Width(h.width - 2)
 	}
@@ -430,7 +466,7 @@ func (h *HeaderPanel) Render(service, resourceType string, summaryFields []rende
 
 	content := strings.Join(lines, "\n")
 
-	panelStyle := s.panel
+	panelStyle := h.panelStyle()
 	if h.width > 4 {
 		panelStyle = panelStyle.Width(h.width - 2)
 	}
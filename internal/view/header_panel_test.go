@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/render"
@@ -61,6 +62,20 @@ func TestHeaderPanel_RenderCompactMode(t *testing.T) {
 	}
 }
 
+func TestHeaderPanel_Breadcrumb(t *testing.T) {
+	hp := NewHeaderPanel()
+	hp.SetWidth(120)
+
+	if got := ansi.Strip(hp.Render("ec2", "instances", nil)); !strings.Contains(got, "instances") || strings.Contains(got, "instances ▸") {
+		t.Errorf("list header should end the trail at the resource type:\n%s", got)
+	}
+
+	hp.SetDetail("web-server")
+	if got := ansi.Strip(hp.Render("ec2", "instances", nil)); !strings.Contains(got, "instances ▸ web-server") {
+		t.Errorf("detail header missing breadcrumb:\n%s", got)
+	}
+}
+
 func TestHeaderPanel_RenderModeSwitching(t *testing.T) {
 	cfg := config.Global()
 	t.Cleanup(func() { cfg.SetCompactHeader(false) })
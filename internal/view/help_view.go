@@ -1,13 +1,29 @@
 package view
 
 import (
+	"strings"
+
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
 )
 
-// HelpView shows keybindings and help information
+// KeyBinding is a key and what it does, as shown in the help overlay
+type KeyBinding struct {
+	Key  string
+	Desc string
+}
+
+// KeyHelpSection is a titled group of keybindings
+type KeyHelpSection struct {
+	Title    string
+	Bindings []KeyBinding
+}
+
 // helpViewStyles holds cached lipgloss styles for performance
 type helpViewStyles struct {
 	title   lipgloss.Style
@@ -25,16 +41,34 @@ func newHelpViewStyles() helpViewStyles {
 	}
 }
 
+// HelpView shows the keybindings of the view it was opened from, followed
+// by the global keys and commands.
 type HelpView struct {
-	styles helpViewStyles
-	vp     ViewportState
+	title    string
+	sections []KeyHelpSection
+	styles   helpViewStyles
+	vp       ViewportState
 }
 
-// NewHelpView creates a new HelpView
-func NewHelpView() *HelpView {
-	return &HelpView{
+// NewHelpView creates a HelpView for v. Views implementing KeyHelper
+// contribute their own sections; v may be nil.
+func NewHelpView(v tea.Model) *HelpView {
+	h := &HelpView{
+		title:  "claws - AWS TUI",
 		styles: newHelpViewStyles(),
 	}
+	if kh, ok := v.(KeyHelper); ok {
+		h.sections = append(h.sections, kh.KeyHelp()...)
+	}
+	if _, isChat := v.(*ChatOverlay); !isChat {
+		h.sections = append(h.sections, globalKeyHelp()...)
+	}
+	return h
+}
+
+// Sections returns the help sections shown by the view
+func (h *HelpView) Sections() []KeyHelpSection {
+	return h.sections
 }
 
 // Init implements tea.Model
@@ -60,114 +94,20 @@ func (h *HelpView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (h *HelpView) renderContent() string {
 	s := h.styles
 
-	var out string
-	out += s.title.Render("claws - AWS TUI") + "\n\n"
-
-	// Navigation
-	out += s.section.Render("Navigation") + "\n"
-	out += s.key.Render("↑/k, ↓/j") + s.desc.Render("Move cursor up/down") + "\n"
-	out += s.key.Render("Enter/d") + s.desc.Render("View details / select") + "\n"
-	out += s.key.Render("Esc") + s.desc.Render("Go back / cancel") + "\n"
-	out += s.key.Render("q") + s.desc.Render("Quit") + "\n"
-
-	// Service Browser
-	out += "\n" + s.section.Render("Service Browser") + "\n"
-	out += s.key.Render("←/h, →/l") + s.desc.Render("Move within category") + "\n"
-	out += s.key.Render("↑/k, ↓/j") + s.desc.Render("Move between categories") + "\n"
-	out += s.key.Render("~") + s.desc.Render("Toggle Dashboard ↔ Services") + "\n"
-	out += s.key.Render("/") + s.desc.Render("Filter services") + "\n"
-
-	// Resource Browser
-	out += "\n" + s.section.Render("Resource Browser") + "\n"
-	out += s.key.Render("Tab") + s.desc.Render("Next resource type") + "\n"
-	out += s.key.Render("Shift+Tab") + s.desc.Render("Previous resource type") + "\n"
-	out += s.key.Render("1-9") + s.desc.Render("Switch to resource type") + "\n"
-	out += s.key.Render("/") + s.desc.Render("Filter resources") + "\n"
-	out += s.key.Render("c") + s.desc.Render("Clear filter") + "\n"
-	out += s.key.Render("Ctrl+r") + s.desc.Render("Refresh resources") + "\n"
-	out += s.key.Render("a") + s.desc.Render("Show actions menu") + "\n"
-	out += s.key.Render("y") + s.desc.Render("Copy resource ID to clipboard") + "\n"
-	out += s.key.Render("Y") + s.desc.Render("Copy resource ARN to clipboard") + "\n"
-
-	// Filter Syntax
-	out += "\n" + s.section.Render("Filter Syntax") + "\n"
-	out += s.key.Render("/text") + s.desc.Render("Fuzzy search in all columns") + "\n"
-
-	// Command Mode
-	out += "\n" + s.section.Render("Command Mode") + "\n"
-	out += s.key.Render(":") + s.desc.Render("Enter command mode") + "\n"
-	out += s.key.Render(": + Enter") + s.desc.Render("Go to services") + "\n"
-	out += s.key.Render(":home") + s.desc.Render("Go to services") + "\n"
-	out += s.key.Render(":pulse") + s.desc.Render("Go to dashboard") + "\n"
-	out += s.key.Render(":dashboard") + s.desc.Render("Go to dashboard") + "\n"
-	out += s.key.Render(":services") + s.desc.Render("Go to services") + "\n"
-	out += s.key.Render(":clear-history") + s.desc.Render("Clear navigation history") + "\n"
-	out += s.key.Render("Tab") + s.desc.Render("Cycle through suggestions") + "\n"
-	out += s.key.Render("Shift+Tab") + s.desc.Render("Cycle backward") + "\n"
-	out += s.key.Render("Enter") + s.desc.Render("Execute command") + "\n"
-	out += s.key.Render(":q") + s.desc.Render("Quit") + "\n"
-	out += s.key.Render(":login") + s.desc.Render("AWS Console login (claws-login profile)") + "\n"
-	out += s.key.Render(":login <name>") + s.desc.Render("AWS Console login with profile") + "\n"
-	out += s.key.Render(":theme <name>") + s.desc.Render("Change theme (dark/light/nord/dracula/...)") + "\n"
-	out += s.key.Render(":autosave") + s.desc.Render("Toggle config persistence (on/off)") + "\n"
-	out += s.key.Render(":settings") + s.desc.Render("Show current settings") + "\n"
-
-	// Tag Commands
-	out += "\n" + s.section.Render("Tag Commands") + "\n"
-	out += s.key.Render(":tag key=val") + s.desc.Render("Filter current view by tag (exact)") + "\n"
-	out += s.key.Render(":tag key") + s.desc.Render("Filter by tag key exists") + "\n"
-	out += s.key.Render(":tag key~val") + s.desc.Render("Filter by tag (partial match)") + "\n"
-	out += s.key.Render(":tag") + s.desc.Render("Clear tag filter") + "\n"
-	out += s.key.Render(":tags") + s.desc.Render("Browse all tagged resources") + "\n"
-	out += s.key.Render(":tags Env=prod") + s.desc.Render("Browse with tag filter") + "\n"
-
-	// Diff Commands
-	out += "\n" + s.section.Render("Compare Resources") + "\n"
-	out += s.key.Render("m") + s.desc.Render("Mark resource for comparison") + "\n"
-	out += s.key.Render("d") + s.desc.Render("Compare with marked resource (or view detail)") + "\n"
-	out += s.key.Render(":diff name") + s.desc.Render("Compare current row with named resource") + "\n"
-	out += s.key.Render(":diff a b") + s.desc.Render("Compare two named resources") + "\n"
-
-	// Actions
-	out += "\n" + s.section.Render("Actions (EC2 Instances)") + "\n"
-	out += s.key.Render("x") + s.desc.Render("SSM Session") + "\n"
-	out += s.key.Render("s") + s.desc.Render("SSH") + "\n"
-	out += s.key.Render("S") + s.desc.Render("Stop instance") + "\n"
-	out += s.key.Render("R") + s.desc.Render("Start instance") + "\n"
-	out += s.key.Render("T") + s.desc.Render("Terminate instance (dangerous)") + "\n"
-
-	// Navigation shortcuts
-	out += "\n" + s.section.Render("Resource Navigation") + "\n"
-	out += ui.DimStyle().Italic(true).
-		Render("  Navigation shortcuts are shown in the status line.\n  They change based on the current resource type.\n") + "\n"
-	out += s.key.Render("VPC") + s.desc.Render("s:Subnets t:RouteTables i:IGWs n:NATGWs g:SGs e:Instances") + "\n"
-	out += s.key.Render("Subnet") + s.desc.Render("v:VPC e:Instances") + "\n"
-	out += s.key.Render("Instance") + s.desc.Render("v:VPC u:Subnet g:SecurityGroups") + "\n"
-	out += s.key.Render("SecurityGroup") + s.desc.Render("v:VPC e:Instances") + "\n"
-
-	// Global
-	out += "\n" + s.section.Render("Global") + "\n"
-	out += s.key.Render("R") + s.desc.Render("Switch AWS region") + "\n"
-	out += s.key.Render("P") + s.desc.Render("Switch AWS profile") + "\n"
-	out += s.key.Render("Ctrl+E") + s.desc.Render("Toggle compact header") + "\n"
-	out += s.key.Render("?") + s.desc.Render("Show this help") + "\n"
-
-	// Command examples
-	out += "\n" + s.section.Render("Command Examples") + "\n"
-	out += ui.DimStyle().Render(
-		"  :ec2             → EC2 instances\n" +
-			"  :ec2/volumes     → EC2 volumes\n" +
-			"  :s3              → S3 buckets\n" +
-			"  :ec2/sec         → Auto-completes to ec2/security-groups\n" +
-			"  :sort Name       → Sort by Name column\n" +
-			"  :tag Env=prod    → Filter current view by tag\n" +
-			"  :tags Env=prod   → Browse all resources with tag\n" +
-			"  :diff my-func    → Compare current row with my-func\n" +
-			"  :login           → AWS Console login\n" +
-			"  :theme nord      → Switch to Nord theme",
-	)
-
-	return out
+	var out strings.Builder
+	out.WriteString(s.title.Render(h.title) + "\n")
+
+	for _, sec := range h.sections {
+		if len(sec.Bindings) == 0 {
+			continue
+		}
+		out.WriteString("\n" + s.section.Render(sec.Title) + "\n")
+		for _, b := range sec.Bindings {
+			out.WriteString(s.key.Render(b.Key) + s.desc.Render(b.Desc) + "\n")
+		}
+	}
+
+	return out.String()
 }
 
 func (h *HelpView) ViewString() string {
@@ -192,3 +132,82 @@ func (h *HelpView) SetSize(width, height int) tea.Cmd {
 func (h *HelpView) StatusLine() string {
 	return "Help • Press Esc to go back"
 }
+
+// globalKeyHelp returns the keys and commands available from every view
+func globalKeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{
+		{
+			Title: "Global",
+			Bindings: []KeyBinding{
+				{"Esc", "Go back / cancel"},
+				{"q", "Quit"},
+				{"R", "Switch AWS region"},
+				{"P", "Switch AWS profile"},
+				{"A", "AI chat"},
+				{"Ctrl+F", "Go forward (after going back)"},
+				{"Ctrl+E", "Toggle compact header"},
+				{"?", "Show help for this view"},
+			},
+		},
+		{
+			Title: "Command Mode",
+			Bindings: []KeyBinding{
+				{":", "Enter command mode"},
+				{"Ctrl+P", "Fuzzy go-to: services, resources, commands, recent"},
+				{":<service>", "Go to service (e.g. :ec2/volumes)"},
+				{":home", "Go to services"},
+				{":pulse", "Go to dashboard"},
+				{":tags Env=prod", "Browse all resources with tag"},
+				{":search <text>", "Search resources (Resource Explorer)"},
+				{":theme <name>", "Change theme (dark/light/nord/dracula/...)"},
+				{":autosave", "Toggle config persistence (on/off)"},
+				{":settings", "Show current settings"},
+				{":whoami", "Show caller identity and credential source"},
+				{":validate-policy <file>", "Lint an IAM policy file (Access Analyzer)"},
+				{":trust-map", "Map cross-account role trusts"},
+				{":org-tree", "Organization OU tree with SCPs"},
+				{":usedby [arn]", "List resources referencing the selected resource"},
+				{":trace [arn]", "Trace SNS topic / EventBridge rule delivery"},
+				{":login", "AWS Console login"},
+				{":history", "Recently visited lists and resources"},
+				{":history clear", "Forget visited history"},
+				{":clear-history", "Clear navigation history"},
+				{":q", "Quit"},
+			},
+		},
+	}
+}
+
+// navigationKeyHelp lists the renderer's navigation shortcuts for resource
+func navigationKeyHelp(renderer render.Renderer, resource dao.Resource) KeyHelpSection {
+	sec := KeyHelpSection{Title: "Resource Navigation"}
+	navigator, ok := renderer.(render.Navigator)
+	if !ok || resource == nil {
+		return sec
+	}
+	for _, nav := range navigator.Navigations(dao.UnwrapResource(resource)) {
+		sec.Bindings = append(sec.Bindings, KeyBinding{nav.Key, nav.Label})
+	}
+	return sec
+}
+
+// actionKeyHelp lists the registered actions for a resource type, as
+// available from the action menu. Actions filtered out for resource are
+// omitted.
+func actionKeyHelp(service, resType string, resource dao.Resource) KeyHelpSection {
+	sec := KeyHelpSection{Title: "Actions (a, then key)"}
+	for _, act := range action.Global.ForResource(service, resType) {
+		if act.Shortcut == "" {
+			continue
+		}
+		if act.Filter != nil && resource != nil && !act.Filter(dao.UnwrapResource(resource)) {
+			continue
+		}
+		desc := act.Name
+		if act.Confirm == action.ConfirmDangerous {
+			desc += " (dangerous)"
+		}
+		sec.Bindings = append(sec.Bindings, KeyBinding{act.Shortcut, desc})
+	}
+	return sec
+}
@@ -1,22 +1,95 @@
 package view
 
 import (
+	"context"
+	"slices"
 	"testing"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
 )
 
 func TestHelpView_New(t *testing.T) {
-	hv := NewHelpView()
+	hv := NewHelpView(nil)
 
 	if hv == nil {
 		t.Fatal("NewHelpView() returned nil")
 	}
+	if !hasHelpSection(hv, "Global") {
+		t.Error("help without a view should still show global keys")
+	}
 }
 
 func TestHelpView_StatusLine(t *testing.T) {
-	hv := NewHelpView()
+	hv := NewHelpView(nil)
 
 	status := hv.StatusLine()
 	if status == "" {
 		t.Error("StatusLine() should not be empty")
 	}
 }
+
+func TestHelpView_Contextual(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+
+	tests := []struct {
+		name     string
+		view     View
+		want     string
+		unwanted string
+	}{
+		{"resource browser", NewResourceBrowser(ctx, reg, "ec2"), "Resource Browser", "Compare View"},
+		{"diff view", NewDiffView(ctx, nil, nil, nil, "ec2", "instances"), "Compare View", "Resource Browser"},
+		{"service browser", NewServiceBrowser(ctx, reg), "Service Browser", "Resource Browser"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hv := NewHelpView(tt.view)
+			if !hasHelpSection(hv, tt.want) {
+				t.Errorf("missing %q section", tt.want)
+			}
+			if hasHelpSection(hv, tt.unwanted) {
+				t.Errorf("unexpected %q section", tt.unwanted)
+			}
+		})
+	}
+}
+
+func TestHelpView_ChatOverlayOmitsGlobal(t *testing.T) {
+	hv := NewHelpView(&ChatOverlay{})
+	if !hasHelpSection(hv, "AI Chat") {
+		t.Error("missing AI Chat section")
+	}
+	if hasHelpSection(hv, "Global") {
+		t.Error("chat help should not list global keys that the prompt captures")
+	}
+}
+
+func TestActionKeyHelp(t *testing.T) {
+	action.Global.Register("helptest", "widgets", []action.Action{
+		{Name: "Start", Shortcut: "S"},
+		{Name: "Delete", Shortcut: "D", Confirm: action.ConfirmDangerous},
+		{Name: "Hidden", Shortcut: "H", Filter: func(dao.Resource) bool { return false }},
+		{Name: "No shortcut"},
+	})
+
+	// Universal actions (console links) follow the type's own actions
+	universal := []KeyBinding{{"O", "Open in Console"}, {"L", "Copy Console URL"}}
+
+	sec := actionKeyHelp("helptest", "widgets", &dao.BaseResource{ID: "w-1"})
+	want := append([]KeyBinding{{"S", "Start"}, {"D", "Delete (dangerous)"}}, universal...)
+	if !slices.Equal(sec.Bindings, want) {
+		t.Errorf("actionKeyHelp() = %v, want %v", sec.Bindings, want)
+	}
+
+	if sec := actionKeyHelp("helptest", "none", nil); !slices.Equal(sec.Bindings, universal) {
+		t.Errorf("actionKeyHelp() for unregistered type = %v, want %v", sec.Bindings, universal)
+	}
+}
+
+func hasHelpSection(h *HelpView, title string) bool {
+	return slices.ContainsFunc(h.Sections(), func(s KeyHelpSection) bool { return s.Title == title })
+}
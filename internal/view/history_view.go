@@ -0,0 +1,344 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/history"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// HistoryEntryFor describes v as a :history entry. Only resource lists and
+// resource details are recorded.
+func HistoryEntryFor(reg *registry.Registry, v View) (history.Entry, bool) {
+	switch v := v.(type) {
+	case *ResourceBrowser:
+		field, value := v.FieldFilter()
+		return history.Entry{
+			Service:     v.Service(),
+			Resource:    v.ResourceType(),
+			FilterField: field,
+			FilterValue: value,
+		}, true
+
+	case *DetailView:
+		res := v.Resource()
+		if res == nil || v.Service() == "" || v.ResourceType() == "" {
+			return history.Entry{}, false
+		}
+		// Sub-resources can't be fetched again without their parent's filter
+		if reg != nil && reg.IsSubResource(v.Service(), v.ResourceType()) {
+			return history.Entry{}, false
+		}
+		inner := dao.UnwrapResource(res)
+		e := history.Entry{
+			Service:  v.Service(),
+			Resource: v.ResourceType(),
+			ID:       inner.GetID(),
+			Name:     inner.GetName(),
+			ARN:      inner.GetARN(),
+			Profile:  dao.GetResourceProfile(res),
+			Region:   dao.GetResourceRegion(res),
+		}
+		if e.ID == "" {
+			return history.Entry{}, false
+		}
+		if e.Region == "" {
+			if regions := config.Global().Regions(); len(regions) == 1 {
+				e.Region = regions[0]
+			}
+		}
+		if e.Profile == "" {
+			if sels := config.Global().Selections(); len(sels) == 1 {
+				e.Profile = sels[0].ID()
+			}
+		}
+		return e, true
+	}
+	return history.Entry{}, false
+}
+
+// historyView opens e. Resources reopen in the profile and region they were
+// visited in; lists open in the current selection.
+func historyView(ctx context.Context, reg *registry.Registry, e history.Entry) (View, error) {
+	if _, ok := reg.Get(e.Service, e.Resource); !ok {
+		return nil, fmt.Errorf("unknown resource type %s", e.Type())
+	}
+	if !e.IsResource() {
+		if e.FilterField != "" {
+			return NewResourceBrowserWithFilter(ctx, reg, e.Service, e.Resource, e.FilterField, e.FilterValue), nil
+		}
+		return NewResourceBrowserWithType(ctx, reg, e.Service, e.Resource), nil
+	}
+
+	if e.Region != "" {
+		ctx = aws.WithRegionOverride(ctx, e.Region)
+	}
+	if e.Profile != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(e.Profile))
+	}
+	renderer, err := reg.GetRenderer(e.Service, e.Resource)
+	if err != nil {
+		return nil, err
+	}
+	daoInst, err := reg.GetDAO(ctx, e.Service, e.Resource)
+	if err != nil {
+		daoInst = nil
+	}
+	resource := &dao.BaseResource{ID: e.ID, Name: e.Name, ARN: e.ARN}
+	return NewDetailView(ctx, resource, renderer, e.Service, e.Resource, reg, daoInst), nil
+}
+
+// FavoriteToggledMsg reports that a resource was pinned to or unpinned
+// from the dashboard favorites
+type FavoriteToggledMsg struct {
+	Label  string
+	Pinned bool
+}
+
+// toggleFavorite pins e to the dashboard favorites, or unpins it
+func toggleFavorite(e history.Entry) tea.Cmd {
+	return func() tea.Msg {
+		pinned, err := history.ToggleFavorite(e)
+		if err != nil {
+			return ErrorMsg{Err: err}
+		}
+		label := e.Name
+		if label == "" {
+			label = e.ID
+		}
+		return FavoriteToggledMsg{Label: label, Pinned: pinned}
+	}
+}
+
+type historyLoadedMsg struct {
+	entries []history.Entry
+	err     error
+}
+
+type historyViewStyles struct {
+	header lipgloss.Style
+	status lipgloss.Style
+	dim    lipgloss.Style
+}
+
+func newHistoryViewStyles() historyViewStyles {
+	return historyViewStyles{
+		header: ui.TableHeaderStyle().Padding(0, 1),
+		status: ui.DimStyle().Padding(0, 1),
+		dim:    ui.DimStyle(),
+	}
+}
+
+// HistoryView lists resource lists and resources visited in this and
+// earlier sessions, most recent first.
+type HistoryView struct {
+	ctx      context.Context
+	registry *registry.Registry
+	entries  []history.Entry
+	err      error
+	loaded   bool
+
+	tc           TableCursor
+	tableContent string
+	width        int
+	height       int
+	styles       historyViewStyles
+}
+
+// NewHistoryView creates a HistoryView
+func NewHistoryView(ctx context.Context, reg *registry.Registry) *HistoryView {
+	return &HistoryView{
+		ctx:      ctx,
+		registry: reg,
+		styles:   newHistoryViewStyles(),
+	}
+}
+
+func (v *HistoryView) Init() tea.Cmd {
+	return v.load
+}
+
+func (v *HistoryView) load() tea.Msg {
+	entries, err := history.Load()
+	return historyLoadedMsg{entries: entries, err: err}
+}
+
+func (v *HistoryView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case historyLoadedMsg:
+		v.loaded = true
+		v.entries = msg.entries
+		v.err = msg.err
+		v.buildTable()
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newHistoryViewStyles()
+		v.buildTable()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		n := len(v.entries)
+		switch msg.String() {
+		case "enter", "d":
+			return v, v.open()
+		case "ctrl+r":
+			return v, v.load
+		case "j", "down":
+			v.tc.SetCursor(v.tc.Cursor()+1, n)
+		case "k", "up":
+			v.tc.SetCursor(v.tc.Cursor()-1, n)
+		case "ctrl+d", "pgdown":
+			v.tc.SetCursor(v.tc.Cursor()+v.tc.TableHeight()/2, n)
+		case "ctrl+u", "pgup":
+			v.tc.SetCursor(v.tc.Cursor()-v.tc.TableHeight()/2, n)
+		case "g", "home":
+			v.tc.SetCursor(0, n)
+		case "G", "end":
+			v.tc.SetCursor(n-1, n)
+		default:
+			return v, nil
+		}
+		v.tc.UpdateScrollOffset(n)
+		v.buildTable()
+	}
+	return v, nil
+}
+
+// open navigates to the entry under the cursor
+func (v *HistoryView) open() tea.Cmd {
+	cursor := v.tc.Cursor()
+	if cursor < 0 || cursor >= len(v.entries) {
+		return nil
+	}
+	target, err := historyView(v.ctx, v.registry, v.entries[cursor])
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	return func() tea.Msg { return NavigateMsg{View: target} }
+}
+
+// historyLabel names what an entry opens
+func historyLabel(e history.Entry) string {
+	switch {
+	case e.IsResource() && e.Name != "" && e.Name != e.ID:
+		return e.Name + " (" + e.ID + ")"
+	case e.IsResource():
+		return e.ID
+	case e.FilterField != "":
+		return "list: " + e.FilterField + "=" + e.FilterValue
+	default:
+		return "list"
+	}
+}
+
+func (v *HistoryView) buildTable() {
+	v.tc.SetCursor(v.tc.Cursor(), len(v.entries))
+	if len(v.entries) == 0 {
+		v.tableContent = ""
+		return
+	}
+
+	headers := []string{"VISITED", "TYPE", "RESOURCE", "PROFILE", "REGION"}
+	tableHeight := max(v.height-1, 1)
+	v.tc.SetTableHeight(tableHeight)
+	tableWidth := v.width
+	if tableWidth < 80 {
+		tableWidth = 120
+	}
+	fixed := 10 + 30 + 18 + 16
+	widths := []int{10, 30, max(tableWidth-fixed, 20), 18, 16}
+
+	t := table.New().
+		Headers(headers...).
+		Width(tableWidth).
+		Height(tableHeight).
+		Wrap(false).
+		BorderTop(false).
+		BorderBottom(false).
+		BorderLeft(false).
+		BorderRight(false).
+		BorderColumn(false).
+		BorderHeader(true).
+		BorderStyle(TableBorderStyle()).
+		StyleFunc(NewTableStyleFunc(widths, v.tc.Cursor()))
+
+	for _, e := range v.entries {
+		profile := e.Profile
+		if profile != "" {
+			profile = config.ProfileSelectionFromID(profile).DisplayName()
+		}
+		t = t.Row(
+			render.FormatAge(e.VisitedAt)+" ago",
+			e.Type(),
+			historyLabel(e),
+			profile,
+			e.Region,
+		)
+	}
+	if v.tc.ScrollOffset() > 0 {
+		t = t.YOffset(v.tc.ScrollOffset())
+	}
+	v.tableContent = t.String()
+}
+
+func (v *HistoryView) ViewString() string {
+	header := v.styles.header.Width(v.width).Render("History")
+	switch {
+	case !v.loaded:
+		return header + "\n" + LoadingMessage
+	case v.err != nil:
+		return header + "\n" + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	case len(v.entries) == 0:
+		hint := "No history yet - resource lists and resources you open are recorded here"
+		if config.File().HistorySize() == 0 {
+			hint = "History is disabled (navigation.history_size < 0)"
+		}
+		return header + "\n" + v.styles.dim.Render(hint)
+	}
+	status := v.styles.status.Render(fmt.Sprintf("%d entries", len(v.entries)))
+	return header + "\n" + status + "\n" + v.tableContent
+}
+
+func (v *HistoryView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *HistoryView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height - 2 // header and status lines
+	v.buildTable()
+	return nil
+}
+
+func (v *HistoryView) StatusLine() string {
+	return fmt.Sprintf("history • %d entries • enter:open • ctrl+r:reload • q/esc:back", len(v.entries))
+}
+
+// ItemCount implements ItemCounter
+func (v *HistoryView) ItemCount() (shown, total int) {
+	return len(v.entries), len(v.entries)
+}
+
+// KeyHelp implements KeyHelper
+func (v *HistoryView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{Title: "History", Bindings: []KeyBinding{
+		{"↑/k, ↓/j", "Move cursor up/down"},
+		{"g, G", "Go to top / bottom"},
+		{"Enter", "Reopen list or resource"},
+		{"Ctrl+r", "Reload"},
+		{"Ctrl+f", "Go forward (after going back)"},
+		{":history clear", "Forget all history"},
+		{"Esc", "Back"},
+	}}}
+}
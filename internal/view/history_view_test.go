@@ -0,0 +1,84 @@
+package view
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/history"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestHistoryEntryFor(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+
+	rb := NewResourceBrowserWithFilter(ctx, reg, "dms", "table-statistics", "ReplicationTaskArn", "arn:task")
+	e, ok := HistoryEntryFor(reg, rb)
+	if !ok || e.IsResource() || e.Type() != "dms/table-statistics" || e.FilterValue != "arn:task" {
+		t.Errorf("browser entry = %+v, %v", e, ok)
+	}
+
+	res := dao.WrapWithRegion(&mockResource{id: "i-1", name: "web"}, "eu-west-1")
+	dv := NewDetailView(ctx, res, nil, "ec2", "instances", reg, nil)
+	e, ok = HistoryEntryFor(reg, dv)
+	if !ok || e.ID != "i-1" || e.Name != "web" || e.Region != "eu-west-1" {
+		t.Errorf("detail entry = %+v, %v; want unwrapped ID and region", e, ok)
+	}
+
+	if _, ok := HistoryEntryFor(reg, NewHistoryView(ctx, reg)); ok {
+		t.Error("history view itself should not be recorded")
+	}
+}
+
+func TestHistoryLabel(t *testing.T) {
+	tests := []struct {
+		entry history.Entry
+		want  string
+	}{
+		{history.Entry{ID: "i-1", Name: "web"}, "web (i-1)"},
+		{history.Entry{ID: "bucket", Name: "bucket"}, "bucket"},
+		{history.Entry{FilterField: "VpcId", FilterValue: "vpc-1"}, "list: VpcId=vpc-1"},
+		{history.Entry{}, "list"},
+	}
+	for _, tt := range tests {
+		if got := historyLabel(tt.entry); got != tt.want {
+			t.Errorf("historyLabel(%+v) = %q, want %q", tt.entry, got, tt.want)
+		}
+	}
+}
+
+func TestHistoryViewOpen(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{})
+
+	v := NewHistoryView(ctx, reg)
+	v.SetSize(120, 30)
+	v.Update(historyLoadedMsg{entries: []history.Entry{
+		{Service: "ec2", Resource: "instances", VisitedAt: time.Now()},
+		{Service: "gone", Resource: "things", ID: "x", VisitedAt: time.Now()},
+	}})
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter returned nil cmd")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatalf("enter returned %T, want NavigateMsg", cmd())
+	}
+	if rb, ok := nav.View.(*ResourceBrowser); !ok || rb.ResourceType() != "instances" {
+		t.Errorf("navigated to %T, want ec2/instances browser", nav.View)
+	}
+
+	// Entries for resource types no longer registered report an error
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if _, ok := cmd().(ErrorMsg); !ok {
+		t.Errorf("enter on unknown type returned %T, want ErrorMsg", cmd())
+	}
+}
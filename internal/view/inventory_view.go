@@ -0,0 +1,344 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/inventory"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// inventoryTimeFormat is how snapshot times are shown
+const inventoryTimeFormat = "2006-01-02 15:04"
+
+type inventoryViewStyles struct {
+	title   lipgloss.Style
+	section lipgloss.Style
+	dim     lipgloss.Style
+	added   lipgloss.Style
+	removed lipgloss.Style
+	changed lipgloss.Style
+	warning lipgloss.Style
+}
+
+func newInventoryViewStyles() inventoryViewStyles {
+	return inventoryViewStyles{
+		title:   ui.TitleStyle(),
+		section: ui.SectionStyle(),
+		dim:     ui.DimStyle(),
+		added:   ui.SuccessStyle(),
+		removed: ui.DangerStyle(),
+		changed: ui.WarningStyle(),
+		warning: ui.WarningStyle(),
+	}
+}
+
+// InventoryView saves an inventory snapshot or reports the differences
+// between a snapshot and the account now (or another snapshot).
+type InventoryView struct {
+	ctx      context.Context
+	registry *registry.Registry
+
+	diff    bool
+	name    string   // snapshot to save, or the base snapshot for a diff
+	compare string   // second snapshot for a diff; empty compares with a fresh capture
+	types   []string // types to save
+
+	loading bool
+	spinner spinner.Model
+	err     error
+	saved   *inventory.Snapshot
+	path    string
+	report  *inventory.Report
+
+	vp     ViewportState
+	width  int
+	styles inventoryViewStyles
+}
+
+// NewInventorySaveView captures types in the selected profiles and regions
+// and saves them as the snapshot name.
+func NewInventorySaveView(ctx context.Context, reg *registry.Registry, name string, types []string) *InventoryView {
+	return &InventoryView{
+		ctx:      ctx,
+		registry: reg,
+		name:     name,
+		types:    types,
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		styles:   newInventoryViewStyles(),
+	}
+}
+
+// NewInventoryDiffView compares the snapshot name with compare, or with a
+// fresh capture of the same scope when compare is empty.
+func NewInventoryDiffView(ctx context.Context, reg *registry.Registry, name, compare string) *InventoryView {
+	v := NewInventorySaveView(ctx, reg, name, nil)
+	v.diff = true
+	v.compare = compare
+	return v
+}
+
+type inventorySavedMsg struct {
+	snapshot *inventory.Snapshot
+	path     string
+	err      error
+}
+
+type inventoryDiffMsg struct {
+	report inventory.Report
+	err    error
+}
+
+func (v *InventoryView) Init() tea.Cmd {
+	return tea.Batch(v.run, v.spinner.Tick)
+}
+
+func (v *InventoryView) run() tea.Msg {
+	if v.diff {
+		return v.runDiff()
+	}
+	snap := inventory.Capture(v.ctx, v.registry, v.name, inventory.CurrentScope(v.types))
+	path, err := inventory.Save(snap)
+	return inventorySavedMsg{snapshot: snap, path: path, err: err}
+}
+
+func (v *InventoryView) runDiff() tea.Msg {
+	base, err := inventory.Load(v.name)
+	if err != nil {
+		return inventoryDiffMsg{err: err}
+	}
+	var target *inventory.Snapshot
+	if v.compare != "" {
+		if target, err = inventory.Load(v.compare); err != nil {
+			return inventoryDiffMsg{err: err}
+		}
+	} else {
+		target = inventory.Capture(v.ctx, v.registry, "", inventory.ScopeOf(base))
+	}
+	return inventoryDiffMsg{report: inventory.Diff(base, target)}
+}
+
+func (v *InventoryView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case inventorySavedMsg:
+		v.loading = false
+		v.err = msg.err
+		v.saved = msg.snapshot
+		v.path = msg.path
+		v.refreshContent()
+		return v, nil
+
+	case inventoryDiffMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.report = &msg.report
+		}
+		v.refreshContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newInventoryViewStyles()
+		v.refreshContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		// Let app handle back navigation
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		if msg.String() == "ctrl+r" && v.diff && !v.loading {
+			v.loading = true
+			v.err = nil
+			return v, tea.Batch(v.run, v.spinner.Tick)
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *InventoryView) refreshContent() {
+	if v.vp.Ready {
+		v.vp.Model.SetContent(v.renderContent())
+	}
+}
+
+func (v *InventoryView) renderContent() string {
+	if v.diff {
+		return v.renderReport()
+	}
+	return v.renderSaved()
+}
+
+func (v *InventoryView) renderSaved() string {
+	s := v.styles
+	var b strings.Builder
+	b.WriteString(s.title.Render("Inventory: "+v.name) + "\n\n")
+	if v.err != nil {
+		b.WriteString(s.removed.Render("✗ "+v.err.Error()) + "\n")
+		return b.String()
+	}
+
+	snap := v.saved
+	fmt.Fprintf(&b, "Saved %d resources to %s\n", len(snap.Items), v.path)
+	b.WriteString(s.dim.Render(fmt.Sprintf("Profiles: %s • Regions: %s",
+		strings.Join(snap.Profiles, ", "), strings.Join(snap.Regions, ", "))) + "\n\n")
+
+	counts := snap.TypeCounts()
+	b.WriteString(s.section.Render("Resources") + "\n")
+	for _, t := range snap.Types {
+		fmt.Fprintf(&b, "  %-28s %d\n", t, counts[t])
+	}
+	v.writeErrors(&b, snap.Errors)
+	b.WriteString("\n" + s.dim.Render(":inventory diff "+v.name+" to compare later") + "\n")
+	return b.String()
+}
+
+func (v *InventoryView) renderReport() string {
+	s := v.styles
+	var b strings.Builder
+
+	target := "now"
+	if v.compare != "" {
+		target = v.compare
+	}
+	b.WriteString(s.title.Render("Inventory diff: "+v.name+" → "+target) + "\n")
+	if v.err != nil {
+		b.WriteString("\n" + s.removed.Render("✗ "+v.err.Error()) + "\n")
+		return b.String()
+	}
+
+	r := v.report
+	b.WriteString(s.dim.Render(fmt.Sprintf("%s → %s",
+		r.BaseTime.Local().Format(inventoryTimeFormat), r.TargetTime.Local().Format(inventoryTimeFormat))) + "\n")
+	b.WriteString(fmt.Sprintf("%s  %s  %s\n",
+		s.added.Render(fmt.Sprintf("+%d added", len(r.Added))),
+		s.removed.Render(fmt.Sprintf("-%d removed", len(r.Removed))),
+		s.changed.Render(fmt.Sprintf("~%d changed", len(r.Changed)))))
+
+	if r.Empty() {
+		b.WriteString("\n" + s.dim.Render("No differences") + "\n")
+	}
+	v.writeChanges(&b, "Added", "+", s.added, r.Added)
+	v.writeChanges(&b, "Removed", "-", s.removed, r.Removed)
+	v.writeChanges(&b, "Changed", "~", s.changed, r.Changed)
+	v.writeErrors(&b, r.Errors)
+	return b.String()
+}
+
+func (v *InventoryView) writeChanges(b *strings.Builder, title, marker string, style lipgloss.Style, changes []inventory.Change) {
+	if len(changes) == 0 {
+		return
+	}
+	b.WriteString("\n" + v.styles.section.Render(fmt.Sprintf("%s (%d)", title, len(changes))) + "\n")
+
+	// Group by type so related resources read together
+	byType := make(map[string][]inventory.Change)
+	for _, c := range changes {
+		byType[c.Item.Type()] = append(byType[c.Item.Type()], c)
+	}
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	for _, t := range types {
+		b.WriteString("  " + v.styles.dim.Render(t) + "\n")
+		for _, c := range byType[t] {
+			line := "    " + style.Render(marker+" "+inventoryLabel(c.Item))
+			if c.Item.Region != "" {
+				line += "  " + v.styles.dim.Render(c.Item.Region)
+			}
+			if len(c.Fields) > 0 {
+				line += "  " + v.styles.dim.Render(strings.Join(c.Fields, ", "))
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+}
+
+func (v *InventoryView) writeErrors(b *strings.Builder, errs []string) {
+	if len(errs) == 0 {
+		return
+	}
+	b.WriteString("\n" + v.styles.warning.Render(fmt.Sprintf("⚠ %d listings failed (not compared):", len(errs))) + "\n")
+	for _, e := range errs {
+		b.WriteString("  " + v.styles.dim.Render(e) + "\n")
+	}
+}
+
+func inventoryLabel(item inventory.Item) string {
+	if item.Name != "" && item.Name != item.ID {
+		return item.Name + " (" + item.ID + ")"
+	}
+	return item.ID
+}
+
+func (v *InventoryView) ViewString() string {
+	if v.loading {
+		what := "Capturing inventory"
+		if v.diff {
+			what = "Comparing inventory " + v.name
+		}
+		return v.spinner.View() + " " + what + "..."
+	}
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.vp.Model.View()
+}
+
+func (v *InventoryView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *InventoryView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.vp.SetSize(width, max(height, 5))
+	if !v.loading {
+		v.refreshContent()
+	}
+	return nil
+}
+
+func (v *InventoryView) StatusLine() string {
+	if v.loading {
+		return "inventory " + v.name + " • q/esc:back"
+	}
+	if v.diff {
+		return "inventory diff " + v.name + " • ↑/↓:scroll • ctrl+r:compare again • q/esc:back"
+	}
+	return "inventory " + v.name + " • ↑/↓:scroll • q/esc:back"
+}
+
+// KeyHelp implements KeyHelper
+func (v *InventoryView) KeyHelp() []KeyHelpSection {
+	bindings := []KeyBinding{
+		{"↑/k, ↓/j", "Scroll"},
+		{"PgUp, PgDn", "Page up / down"},
+	}
+	if v.diff {
+		bindings = append(bindings, KeyBinding{"Ctrl+r", "Compare again"})
+	}
+	bindings = append(bindings, KeyBinding{"Esc", "Back"})
+	return []KeyHelpSection{{Title: "Inventory", Bindings: bindings}}
+}
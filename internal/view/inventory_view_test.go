@@ -0,0 +1,91 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/inventory"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestInventoryViewDiffReport(t *testing.T) {
+	v := NewInventoryDiffView(context.Background(), registry.New(), "before", "")
+	v.SetSize(120, 40)
+
+	if !strings.Contains(v.ViewString(), "Comparing inventory before") {
+		t.Errorf("loading view = %q", v.ViewString())
+	}
+
+	report := inventory.Report{
+		Base:     "before",
+		BaseTime: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		Added: []inventory.Change{{Item: inventory.Item{
+			Service: "ec2", Resource: "instances", Region: "us-east-1", ID: "i-new", Name: "web-2",
+		}}},
+		Changed: []inventory.Change{{
+			Item:   inventory.Item{Service: "ec2", Resource: "instances", Region: "us-east-1", ID: "i-old"},
+			Fields: []string{"State", "Tags"},
+		}},
+		Errors: []string{"dev/eu-west-1 ec2/instances: throttled"},
+	}
+	v.Update(inventoryDiffMsg{report: report})
+
+	out := v.ViewString()
+	for _, want := range []string{"before → now", "+1 added", "-0 removed", "~1 changed", "web-2 (i-new)", "State, Tags", "1 listings failed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("report missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Removed (") {
+		t.Error("report should omit empty sections")
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	if cmd == nil || !v.loading {
+		t.Error("ctrl+r should compare again")
+	}
+}
+
+func TestInventoryViewDiffError(t *testing.T) {
+	v := NewInventoryDiffView(context.Background(), registry.New(), "missing", "")
+	v.SetSize(120, 40)
+	v.Update(inventoryDiffMsg{err: errors.New(`inventory "missing" not found`)})
+
+	if out := v.ViewString(); !strings.Contains(out, "not found") {
+		t.Errorf("view = %q, want error", out)
+	}
+}
+
+func TestInventoryViewSaved(t *testing.T) {
+	v := NewInventorySaveView(context.Background(), registry.New(), "before", []string{"ec2/instances", "s3/buckets"})
+	v.SetSize(120, 40)
+
+	snap := &inventory.Snapshot{
+		Name:     "before",
+		Profiles: []string{"dev"},
+		Regions:  []string{"us-east-1"},
+		Types:    []string{"ec2/instances", "s3/buckets"},
+		Items: []inventory.Item{
+			{Service: "ec2", Resource: "instances", ID: "i-1"},
+			{Service: "ec2", Resource: "instances", ID: "i-2"},
+		},
+	}
+	v.Update(inventorySavedMsg{snapshot: snap, path: "/tmp/before.json"})
+
+	out := v.ViewString()
+	for _, want := range []string{"Saved 2 resources to /tmp/before.json", "ec2/instances", "s3/buckets", ":inventory diff before"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("summary missing %q:\n%s", want, out)
+		}
+	}
+
+	// Saving isn't repeated on ctrl+r
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl}); cmd != nil && v.loading {
+		t.Error("ctrl+r should not save again")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/clawscli/claws/internal/config"
 	apperrors "github.com/clawscli/claws/internal/errors"
 	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
 )
 
@@ -40,6 +41,16 @@ type LogView struct {
 	client        *cloudwatchlogs.Client
 	logGroupName  string
 	logStreamName string
+	streamPrefix  string // Reads only streams with this prefix (flow log ENI)
+
+	// flowLogFields, when set, renders messages as VPC flow log records
+	flowLogFields []string
+
+	// containers, when set, are tailed together with per-container prefixes
+	title            string
+	containers       []render.ContainerLog
+	streamContainers map[string]string // log stream → container name
+	containerFilter  string            // Shows only this container when set
 
 	vp      ViewportState
 	spinner spinner.Model
@@ -67,6 +78,7 @@ type LogView struct {
 type logEntry struct {
 	timestamp time.Time
 	message   string
+	container string
 }
 
 type logViewStyles struct {
@@ -76,6 +88,10 @@ type logViewStyles struct {
 	paused    lipgloss.Style
 	error     lipgloss.Style
 	dim       lipgloss.Style
+	accept    lipgloss.Style
+	reject    lipgloss.Style
+
+	containers []lipgloss.Style
 }
 
 func newLogViewStyles() logViewStyles {
@@ -86,6 +102,10 @@ func newLogViewStyles() logViewStyles {
 		paused:    ui.BoldWarningStyle(),
 		error:     ui.DangerStyle(),
 		dim:       ui.DimStyle(),
+		accept:    ui.SuccessStyle(),
+		reject:    ui.DangerStyle(),
+
+		containers: containerStyles(),
 	}
 }
 
@@ -137,11 +157,11 @@ func (v *LogView) initClient() tea.Msg {
 	if err := v.ctx.Err(); err != nil {
 		return logsLoadedMsg{err: err}
 	}
-	cfg, err := appaws.NewConfig(v.ctx)
+	client, err := appaws.Client(v.ctx, cloudwatchlogs.NewFromConfig)
 	if err != nil {
 		return logsLoadedMsg{err: apperrors.Wrap(err, "init AWS config")}
 	}
-	v.client = cloudwatchlogs.NewFromConfig(cfg)
+	v.client = client
 	return v.doFetchLogs(v.lastEventTime, 0, false)
 }
 
@@ -183,6 +203,8 @@ func (v *LogView) doFetchLogs(startTime, endTime int64, older bool) tea.Msg {
 
 	if v.logStreamName != "" {
 		input.LogStreamNames = []string{v.logStreamName}
+	} else if v.streamPrefix != "" {
+		input.LogStreamNamePrefix = appaws.StringPtr(v.streamPrefix)
 	}
 
 	if older {
@@ -194,6 +216,14 @@ func (v *LogView) doFetchLogs(startTime, endTime int64, older bool) tea.Msg {
 		input.StartTime = appaws.Int64Ptr(time.Now().Add(-1 * time.Hour).UnixMilli())
 	}
 
+	if len(v.containers) > 0 {
+		events, err := v.filterContainerLogEvents(ctx, input, older)
+		if err != nil {
+			return v.handleFetchError(err, older)
+		}
+		return v.processLogEvents(events, older)
+	}
+
 	output, err := v.client.FilterLogEvents(ctx, input)
 	if err != nil {
 		return v.handleFetchError(err, older)
@@ -232,6 +262,7 @@ func (v *LogView) processLogEvents(events []types.FilteredLogEvent, older bool)
 		entries = append(entries, logEntry{
 			timestamp: ts,
 			message:   strings.TrimSuffix(msg, "\n"),
+			container: v.streamContainers[appaws.Str(event.LogStreamName)],
 		})
 
 		eventTs := appaws.Int64(event.Timestamp)
@@ -359,6 +390,14 @@ func (v *LogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				v.updateViewportContent()
 			}
 			return v, nil
+		case "t":
+			if len(v.containers) > 1 {
+				v.cycleContainerFilter()
+				if v.vp.Ready {
+					v.updateViewportContent()
+				}
+			}
+			return v, nil
 		case "p":
 			if v.oldestEventTime > 0 && !v.loading {
 				v.loading = true
@@ -390,6 +429,9 @@ func (v *LogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (v *LogView) matchesFilter(entry logEntry) bool {
+	if v.containerFilter != "" && entry.container != v.containerFilter {
+		return false
+	}
 	if v.filterText == "" {
 		return true
 	}
@@ -407,7 +449,15 @@ func (v *LogView) updateViewportContent() {
 		}
 
 		ts := v.styles.timestamp.Render(entry.timestamp.Format("15:04:05.000"))
-		msg := v.styles.message.Render(entry.message)
+		var msg string
+		if v.flowLogFields != nil {
+			msg = v.renderFlowLogRecord(entry.message)
+		} else {
+			msg = v.styles.message.Render(entry.message)
+		}
+		if v.containers != nil {
+			msg = v.containerPrefix(entry.container) + " " + msg
+		}
 		sb.WriteString(fmt.Sprintf("%s %s\n", ts, msg))
 	}
 	v.vp.Model.SetContent(sb.String())
@@ -449,11 +499,19 @@ func (v *LogView) ViewString() string {
 	var sb strings.Builder
 
 	title := v.logGroupName
-	if v.logStreamName != "" {
+	if v.title != "" {
+		title = v.title
+	} else if v.logStreamName != "" {
 		title = fmt.Sprintf("%s / %s", v.logGroupName, v.logStreamName)
+	} else if v.streamPrefix != "" {
+		title = fmt.Sprintf("%s / %s*", v.logGroupName, v.streamPrefix)
 	}
 	sb.WriteString(v.styles.header.Render("📜 " + title))
 	sb.WriteString("\n")
+	if v.containers != nil {
+		sb.WriteString(v.containerLegend())
+		sb.WriteString("\n")
+	}
 
 	// Filter UI
 	if v.filterActive {
@@ -472,7 +530,7 @@ func (v *LogView) ViewString() string {
 	// Show filtered/total count
 	totalCount := len(v.logs)
 	displayedCount := v.getDisplayedCount()
-	if v.filterText != "" && displayedCount < totalCount {
+	if (v.filterText != "" || v.containerFilter != "") && displayedCount < totalCount {
 		sb.WriteString(v.styles.dim.Render(fmt.Sprintf("(%d/%d lines)", displayedCount, totalCount)))
 	} else {
 		sb.WriteString(v.styles.dim.Render(fmt.Sprintf("(%d lines)", totalCount)))
@@ -500,7 +558,7 @@ func (v *LogView) ViewString() string {
 }
 
 func (v *LogView) getDisplayedCount() int {
-	if v.filterText == "" {
+	if v.filterText == "" && v.containerFilter == "" {
 		return len(v.logs)
 	}
 	count := 0
@@ -524,6 +582,9 @@ func (v *LogView) SetSize(width, height int) tea.Cmd {
 	if v.filterActive || v.filterText != "" {
 		headerOffset++ // Extra line for filter UI
 	}
+	if v.containers != nil {
+		headerOffset++ // Container legend
+	}
 	viewportHeight := height - headerOffset
 	v.vp.SetSize(width, viewportHeight)
 
@@ -544,6 +605,9 @@ func (v *LogView) StatusLine() string {
 	}
 
 	status := "Space:pause/resume p:older g/G:top/bottom c:clear /:filter Esc:back"
+	if len(v.containers) > 1 {
+		status = "t:container " + status
+	}
 
 	if v.filterText != "" {
 		filterDisplay := v.filterText
@@ -570,3 +634,22 @@ func (v *LogView) HasActiveInput() bool {
 func (v *LogView) LogGroupName() string {
 	return v.logGroupName
 }
+
+// KeyHelp implements KeyHelper
+func (v *LogView) KeyHelp() []KeyHelpSection {
+	bindings := []KeyBinding{
+		{"↑/k, ↓/j", "Scroll"},
+		{"g, G", "Go to oldest / newest"},
+		{"Space", "Pause / resume tailing"},
+		{"p", "Load older events"},
+		{"/", "Filter log events"},
+		{"c", "Clear filter (or buffer)"},
+	}
+	if len(v.containers) > 1 {
+		bindings = append(bindings, KeyBinding{"t", "Cycle container filter"})
+	}
+	return []KeyHelpSection{{
+		Title:    "Log View",
+		Bindings: bindings,
+	}}
+}
@@ -7,8 +7,11 @@ import (
 
 // fuzzyMatch checks if pattern characters appear in order in str (case insensitive)
 func fuzzyMatch(str, pattern string) bool {
-	str = strings.ToLower(str)
-	pattern = strings.ToLower(pattern)
+	return fuzzyMatchLower(strings.ToLower(str), strings.ToLower(pattern))
+}
+
+// fuzzyMatchLower is fuzzyMatch for a str and pattern already lowercased
+func fuzzyMatchLower(str, pattern string) bool {
 	pi := 0
 	for i := 0; i < len(str) && pi < len(pattern); i++ {
 		if str[i] == pattern[pi] {
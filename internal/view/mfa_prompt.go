@@ -0,0 +1,117 @@
+package view
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ModalWidthMFA is the width of the MFA code prompt
+const ModalWidthMFA = 60
+
+// MFAAnsweredMsg is sent after the MFA prompt is submitted or cancelled
+type MFAAnsweredMsg struct {
+	Cancelled bool
+}
+
+// MFAPrompt asks for an MFA code while a role is being assumed. Reply is
+// called exactly once, with the code or with cancelled set.
+type MFAPrompt struct {
+	profile  string
+	serial   string
+	input    textinput.Model
+	reply    func(code string, cancelled bool)
+	answered bool
+	err      string
+}
+
+// NewMFAPrompt creates a prompt for assuming profile's role with the MFA
+// device serial.
+func NewMFAPrompt(profile, serial string, reply func(code string, cancelled bool)) *MFAPrompt {
+	ti := textinput.New()
+	ti.Placeholder = "123456"
+	ti.Prompt = "Code: "
+	ti.CharLimit = 6
+	ti.SetStyles(ui.TextInputStyles())
+	ti.Focus()
+	return &MFAPrompt{profile: profile, serial: serial, input: ti, reply: reply}
+}
+
+func (p *MFAPrompt) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// HasActiveInput keeps esc and q inside the prompt, so dismissing it always
+// answers the waiting request.
+func (p *MFAPrompt) HasActiveInput() bool {
+	return !p.answered
+}
+
+func (p *MFAPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyPressMsg); ok && !p.answered {
+		switch {
+		case IsEscKey(keyMsg) || keyMsg.String() == "ctrl+c":
+			return p.answer("", true)
+		case keyMsg.String() == "enter":
+			code := strings.TrimSpace(p.input.Value())
+			if !isMFACode(code) {
+				p.err = "Enter the 6-digit code from your MFA device"
+				return p, nil
+			}
+			return p.answer(code, false)
+		}
+	}
+
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return p, cmd
+}
+
+func (p *MFAPrompt) answer(code string, cancelled bool) (tea.Model, tea.Cmd) {
+	p.answered = true
+	p.input.Blur()
+	p.reply(code, cancelled)
+	return p, tea.Sequence(
+		func() tea.Msg { return HideModalMsg{} },
+		func() tea.Msg { return MFAAnsweredMsg{Cancelled: cancelled} },
+	)
+}
+
+func isMFACode(s string) bool {
+	if len(s) != 6 {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *MFAPrompt) View() tea.View {
+	return tea.NewView(p.ViewString())
+}
+
+func (p *MFAPrompt) ViewString() string {
+	s := ui.BoldPendingStyle().Render("MFA code required") + "\n\n"
+	s += "Profile: " + p.profile + "\n"
+	s += ui.DimStyle().Render("Device:  "+p.serial) + "\n\n"
+	s += ui.InputFieldStyle().Render(p.input.View()) + "\n"
+	if p.err != "" {
+		s += ui.DangerStyle().Render(p.err) + "\n"
+	}
+	s += "\n" + ui.DimStyle().Render("Enter: submit • Esc: cancel")
+	return s
+}
+
+func (p *MFAPrompt) SetSize(_, _ int) tea.Cmd {
+	return nil
+}
+
+func (p *MFAPrompt) StatusLine() string {
+	return "Enter:submit Esc:cancel"
+}
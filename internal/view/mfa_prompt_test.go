@@ -0,0 +1,51 @@
+package view
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+func TestMFAPrompt(t *testing.T) {
+	var gotCode string
+	var gotCancelled, replied bool
+	p := NewMFAPrompt("admin", "arn:aws:iam::123456789012:mfa/alice", func(code string, cancelled bool) {
+		gotCode, gotCancelled, replied = code, cancelled, true
+	})
+
+	if !p.HasActiveInput() {
+		t.Error("expected prompt to capture input")
+	}
+
+	// Invalid codes are rejected without replying
+	sendKeys(p, "12a")
+	p.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if replied || p.err == "" {
+		t.Fatal("expected invalid code to be rejected")
+	}
+
+	p.input.SetValue("")
+	sendKeys(p, "123456")
+	_, cmd := p.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !replied || gotCancelled || gotCode != "123456" {
+		t.Errorf("reply = %q, cancelled %v, replied %v", gotCode, gotCancelled, replied)
+	}
+	if cmd == nil {
+		t.Error("expected command to close the prompt")
+	}
+	if p.HasActiveInput() {
+		t.Error("expected answered prompt to release input")
+	}
+}
+
+func TestMFAPromptCancel(t *testing.T) {
+	var gotCancelled bool
+	p := NewMFAPrompt("admin", "serial", func(_ string, cancelled bool) {
+		gotCancelled = cancelled
+	})
+
+	p.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if !gotCancelled {
+		t.Error("expected esc to cancel the prompt")
+	}
+}
@@ -25,6 +25,9 @@ const (
 	ModalWidthActionMenu    = 60
 	ModalWidthSettings      = 75
 	ModalWidthChat          = 80
+	ModalWidthWhoami        = 80
+	ModalWidthPolicy        = 90
+	ModalWidthScopeErrors   = 80
 )
 
 type Modal struct {
@@ -0,0 +1,450 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	orgs "github.com/clawscli/claws/custom/organizations"
+	"github.com/clawscli/claws/custom/organizations/accounts"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// orgTreeHeaderLines is the number of lines above the first tree row
+const orgTreeHeaderLines = 3
+
+type orgTreeViewStyles struct {
+	title    lipgloss.Style
+	dim      lipgloss.Style
+	selected lipgloss.Style
+	ou       lipgloss.Style
+	warning  lipgloss.Style
+	holding  lipgloss.Style
+	failing  lipgloss.Style
+}
+
+func newOrgTreeViewStyles() orgTreeViewStyles {
+	return orgTreeViewStyles{
+		title:    ui.TitleStyle(),
+		dim:      ui.DimStyle(),
+		selected: ui.SelectedStyle(),
+		ou:       ui.SectionStyle(),
+		warning:  ui.WarningStyle(),
+		holding:  ui.AccentStyle(),
+		failing:  ui.DangerStyle(),
+	}
+}
+
+// orgTreeRow is a visible node with the guide drawn before it
+type orgTreeRow struct {
+	node   *orgs.TreeNode
+	prefix string
+}
+
+// OrgTreeView shows the organization's root, OUs and accounts as an
+// expandable tree with the SCPs attached to each node. An account can be
+// picked up and dropped on another OU to move it.
+type OrgTreeView struct {
+	ctx context.Context
+
+	loading bool
+	spinner spinner.Model
+	root    *orgs.TreeNode
+	err     error
+
+	expanded map[string]bool
+	rows     []orgTreeRow
+	cursor   int
+
+	// holding is the account picked up to be moved
+	holding *orgs.TreeNode
+	// reloadPending reloads the tree once the move's action menu is closed
+	reloadPending bool
+
+	vp     ViewportState
+	styles orgTreeViewStyles
+}
+
+// NewOrgTreeView creates an OrgTreeView for the current profile
+func NewOrgTreeView(ctx context.Context) *OrgTreeView {
+	return &OrgTreeView{
+		ctx:      ctx,
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		expanded: make(map[string]bool),
+		styles:   newOrgTreeViewStyles(),
+	}
+}
+
+type orgTreeLoadedMsg struct {
+	root *orgs.TreeNode
+	err  error
+}
+
+func (v *OrgTreeView) Init() tea.Cmd {
+	return tea.Batch(v.load, v.spinner.Tick)
+}
+
+func (v *OrgTreeView) load() tea.Msg {
+	client, err := appaws.Client(v.ctx, organizations.NewFromConfig)
+	if err != nil {
+		return orgTreeLoadedMsg{err: err}
+	}
+	root, err := orgs.LoadTree(v.ctx, client)
+	if err != nil {
+		return orgTreeLoadedMsg{err: err}
+	}
+	orgs.LoadSCPs(v.ctx, client, root)
+	return orgTreeLoadedMsg{root: root}
+}
+
+func (v *OrgTreeView) reload() tea.Cmd {
+	v.loading = true
+	return tea.Batch(v.load, v.spinner.Tick)
+}
+
+func (v *OrgTreeView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case orgTreeLoadedMsg:
+		v.setTree(msg.root, msg.err)
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newOrgTreeViewStyles()
+		v.refreshContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		return v.handleKey(msg)
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+// setTree replaces the tree, keeping the cursor on the same node where it
+// still exists. The root and its children start expanded.
+func (v *OrgTreeView) setTree(root *orgs.TreeNode, err error) {
+	v.loading = false
+	v.err = err
+	if err != nil {
+		return
+	}
+	var current string
+	if v.cursor < len(v.rows) {
+		current = v.rows[v.cursor].node.ID
+	}
+	if v.root == nil {
+		v.expanded[root.ID] = true
+		for _, c := range root.Children {
+			if !c.IsAccount() {
+				v.expanded[c.ID] = true
+			}
+		}
+	}
+	v.root = root
+	if v.holding != nil {
+		v.holding = root.Find(v.holding.ID)
+	}
+	v.rebuildRows()
+	for i, row := range v.rows {
+		if row.node.ID == current {
+			v.cursor = i
+		}
+	}
+	v.refreshContent()
+}
+
+func (v *OrgTreeView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if v.reloadPending {
+		v.reloadPending = false
+		if !v.loading {
+			return v, v.reload()
+		}
+	}
+	if IsEscKey(msg) {
+		// Esc drops a held account; otherwise let app handle back navigation
+		v.holding = nil
+		v.refreshContent()
+		return v, nil
+	}
+	if msg.String() == "ctrl+r" {
+		if v.loading {
+			return v, nil
+		}
+		return v, v.reload()
+	}
+	if v.loading || v.root == nil {
+		return v, nil
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		v.moveCursor(v.cursor - 1)
+	case "down", "j":
+		v.moveCursor(v.cursor + 1)
+	case "home", "g":
+		v.moveCursor(0)
+	case "end", "G":
+		v.moveCursor(len(v.rows) - 1)
+	case "enter", "space":
+		if n := v.current(); n != nil && len(n.Children) > 0 {
+			v.expanded[n.ID] = !v.expanded[n.ID]
+			v.rebuildRows()
+		}
+	case "right", "l":
+		if n := v.current(); n != nil && len(n.Children) > 0 {
+			v.expanded[n.ID] = true
+			v.rebuildRows()
+		}
+	case "left", "h":
+		v.collapseOrParent()
+	case "m":
+		return v, v.pickOrDrop()
+	default:
+		var cmd tea.Cmd
+		v.vp.Model, cmd = v.vp.Model.Update(msg)
+		return v, cmd
+	}
+	v.refreshContent()
+	return v, nil
+}
+
+func (v *OrgTreeView) current() *orgs.TreeNode {
+	if v.cursor < 0 || v.cursor >= len(v.rows) {
+		return nil
+	}
+	return v.rows[v.cursor].node
+}
+
+func (v *OrgTreeView) moveCursor(i int) {
+	v.cursor = max(0, min(i, len(v.rows)-1))
+}
+
+// collapseOrParent collapses the current node, or moves to its parent if it
+// is already collapsed
+func (v *OrgTreeView) collapseOrParent() {
+	n := v.current()
+	if n == nil {
+		return
+	}
+	if v.expanded[n.ID] && len(n.Children) > 0 {
+		v.expanded[n.ID] = false
+		v.rebuildRows()
+		return
+	}
+	if n.Parent == nil {
+		return
+	}
+	for i, row := range v.rows {
+		if row.node == n.Parent {
+			v.cursor = i
+			return
+		}
+	}
+}
+
+// pickOrDrop picks up the account under the cursor, or drops the held
+// account on the OU under the cursor by opening the Move action's
+// confirmation
+func (v *OrgTreeView) pickOrDrop() tea.Cmd {
+	n := v.current()
+	if n == nil {
+		return nil
+	}
+	if v.holding == nil {
+		if n.IsAccount() {
+			v.holding = n
+		}
+		return nil
+	}
+	if n.IsAccount() {
+		n = n.Parent
+	}
+	held := v.holding
+	v.holding = nil
+	if n == held.Parent {
+		return nil
+	}
+
+	menu := NewActionMenu(v.ctx, accounts.NewAccountResource(*held.Account), "organizations", "accounts")
+	cmd, ok := menu.StartWithInputs("Move", []string{n.ID}, []string{"Destination (" + n.Path() + ")"})
+	if !ok {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("cannot move %s: Move is not available", held.Name)}
+		}
+	}
+	v.reloadPending = true
+	show := func() tea.Msg {
+		return ShowModalMsg{Modal: &Modal{Content: menu, Width: ModalWidthActionMenu}}
+	}
+	return tea.Sequence(show, cmd)
+}
+
+// rebuildRows flattens the expanded part of the tree into rows
+func (v *OrgTreeView) rebuildRows() {
+	v.rows = v.rows[:0]
+	if v.root != nil {
+		v.rows = append(v.rows, orgTreeRow{node: v.root})
+		v.appendRows(v.root, "")
+	}
+	v.moveCursor(v.cursor)
+}
+
+func (v *OrgTreeView) appendRows(parent *orgs.TreeNode, indent string) {
+	if !v.expanded[parent.ID] {
+		return
+	}
+	for i, c := range parent.Children {
+		branch, next := "├─ ", "│  "
+		if i == len(parent.Children)-1 {
+			branch, next = "└─ ", "   "
+		}
+		v.rows = append(v.rows, orgTreeRow{node: c, prefix: indent + branch})
+		v.appendRows(c, indent+next)
+	}
+}
+
+func (v *OrgTreeView) refreshContent() {
+	if !v.vp.Ready || (v.root == nil && v.err == nil) {
+		return
+	}
+	v.vp.Model.SetContent(v.renderContent())
+	if v.root != nil {
+		v.vp.Model.EnsureVisible(orgTreeHeaderLines+v.cursor, 0, 0)
+	}
+}
+
+func (v *OrgTreeView) renderContent() string {
+	s := v.styles
+	if v.err != nil {
+		return s.failing.Render(fmt.Sprintf("Error: %v", v.err))
+	}
+
+	var b strings.Builder
+	b.WriteString(s.title.Render("Organization Tree") + "\n")
+	var ous, accts int
+	v.root.Walk(func(n *orgs.TreeNode) {
+		switch n.Type {
+		case types.TargetTypeOrganizationalUnit:
+			ous++
+		case types.TargetTypeAccount:
+			accts++
+		}
+	})
+	summary := fmt.Sprintf("%d OUs • %d accounts", ous, accts)
+	if v.holding != nil {
+		summary += "  " + s.holding.Render(fmt.Sprintf("moving %s: m on an OU to drop, esc to cancel", v.holding.Name))
+	}
+	b.WriteString(s.dim.Render(summary) + "\n\n")
+
+	for i, row := range v.rows {
+		b.WriteString(s.dim.Render(row.prefix) + v.rowLabel(row.node, i == v.cursor) + "\n")
+	}
+	return b.String()
+}
+
+// rowLabel names a node with its ID and attached SCPs
+func (v *OrgTreeView) rowLabel(n *orgs.TreeNode, selected bool) string {
+	s := v.styles
+	var marker string
+	switch {
+	case n.IsAccount():
+		marker = "  "
+	case len(n.Children) == 0:
+		marker = "· "
+	case v.expanded[n.ID]:
+		marker = "▾ "
+	default:
+		marker = "▸ "
+	}
+
+	name := n.Name
+	if n == v.holding {
+		name = "⇢ " + name
+	}
+	switch {
+	case selected:
+		name = s.selected.Render(name)
+	case n == v.holding:
+		name = s.holding.Render(name)
+	case n.IsAccount() && n.Account.Status != types.AccountStatusActive:
+		name = s.warning.Render(name + " (" + strings.ToLower(string(n.Account.Status)) + ")")
+	case !n.IsAccount():
+		name = s.ou.Render(name)
+	}
+
+	line := marker + name + " " + s.dim.Render(n.ID)
+	if len(n.SCPs) > 0 {
+		names := make([]string, len(n.SCPs))
+		for i, p := range n.SCPs {
+			names[i] = appaws.Str(p.Name)
+		}
+		line += "  " + s.dim.Render("SCP: "+strings.Join(names, ", "))
+	}
+	return line
+}
+
+func (v *OrgTreeView) ViewString() string {
+	if v.loading && v.root == nil {
+		return v.spinner.View() + " Loading organization tree..."
+	}
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.vp.Model.View()
+}
+
+func (v *OrgTreeView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *OrgTreeView) SetSize(width, height int) tea.Cmd {
+	v.vp.SetSize(width, max(height, 5))
+	v.refreshContent()
+	return nil
+}
+
+// HasActiveInput implements InputCapture so esc cancels a pending move
+func (v *OrgTreeView) HasActiveInput() bool {
+	return v.holding != nil
+}
+
+func (v *OrgTreeView) StatusLine() string {
+	if v.loading {
+		return "org-tree • loading... • q/esc:back"
+	}
+	if v.holding != nil {
+		return "org-tree • moving " + v.holding.Name + " • m:drop • esc:cancel"
+	}
+	return "org-tree • ↑/↓:select • enter:toggle • m:move account • ctrl+r:reload • q/esc:back"
+}
+
+// KeyHelp implements KeyHelper
+func (v *OrgTreeView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{Title: "Organization Tree", Bindings: []KeyBinding{
+		{"↑/k, ↓/j", "Select"},
+		{"Enter, Space", "Expand / collapse"},
+		{"→/l", "Expand"},
+		{"←/h", "Collapse / go to parent"},
+		{"m", "Pick up account / drop on OU"},
+		{"Ctrl+r", "Reload"},
+		{"Esc", "Cancel move / back"},
+	}}}
+}
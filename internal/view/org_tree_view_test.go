@@ -0,0 +1,146 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/charmbracelet/x/ansi"
+
+	orgs "github.com/clawscli/claws/custom/organizations"
+	"github.com/clawscli/claws/internal/config"
+)
+
+// testOrgTree builds Root → {Workloads → {Prod → shop}, Sandbox, mgmt}
+func testOrgTree() *orgs.TreeNode {
+	root := &orgs.TreeNode{ID: "r-1", Name: "Root", Type: types.TargetTypeRoot,
+		SCPs: []types.PolicySummary{{Name: aws.String("FullAWSAccess")}}}
+	workloads := &orgs.TreeNode{ID: "ou-1", Name: "Workloads", Type: types.TargetTypeOrganizationalUnit, Parent: root,
+		SCPs: []types.PolicySummary{{Name: aws.String("DenyLeaveOrg")}, {Name: aws.String("RegionGuard")}}}
+	prod := &orgs.TreeNode{ID: "ou-2", Name: "Prod", Type: types.TargetTypeOrganizationalUnit, Parent: workloads}
+	sandbox := &orgs.TreeNode{ID: "ou-3", Name: "Sandbox", Type: types.TargetTypeOrganizationalUnit, Parent: root}
+	shop := &orgs.TreeNode{ID: "111111111111", Name: "shop", Type: types.TargetTypeAccount, Parent: prod,
+		Account: &types.Account{Id: aws.String("111111111111"), Name: aws.String("shop"), Status: types.AccountStatusActive}}
+	mgmt := &orgs.TreeNode{ID: "222222222222", Name: "mgmt", Type: types.TargetTypeAccount, Parent: root,
+		Account: &types.Account{Id: aws.String("222222222222"), Name: aws.String("mgmt"), Status: types.AccountStatusSuspended}}
+	root.Children = []*orgs.TreeNode{workloads, sandbox, mgmt}
+	workloads.Children = []*orgs.TreeNode{prod}
+	prod.Children = []*orgs.TreeNode{shop}
+	return root
+}
+
+func loadedOrgTreeView(t *testing.T) *OrgTreeView {
+	t.Helper()
+	v := NewOrgTreeView(context.Background())
+	v.SetSize(120, 40)
+	v.Update(orgTreeLoadedMsg{root: testOrgTree()})
+	return v
+}
+
+func pressKey(v *OrgTreeView, key string) tea.Cmd {
+	var msg tea.KeyPressMsg
+	switch key {
+	case "enter":
+		msg = tea.KeyPressMsg{Code: tea.KeyEnter}
+	case "esc":
+		msg = tea.KeyPressMsg{Code: tea.KeyEscape}
+	default:
+		msg = tea.KeyPressMsg{Code: rune(key[0]), Text: key}
+	}
+	_, cmd := v.Update(msg)
+	return cmd
+}
+
+func TestOrgTreeView_Content(t *testing.T) {
+	v := loadedOrgTreeView(t)
+
+	// Root and first-level OUs start expanded, so Prod shows but shop doesn't
+	var names []string
+	for _, row := range v.rows {
+		names = append(names, row.node.Name)
+	}
+	if got := strings.Join(names, ","); got != "Root,Workloads,Prod,Sandbox,mgmt" {
+		t.Errorf("rows = %s", got)
+	}
+
+	content := ansi.Strip(v.renderContent())
+	for _, want := range []string{
+		"3 OUs • 2 accounts",
+		"├─ ▾ Workloads ou-1  SCP: DenyLeaveOrg, RegionGuard",
+		"│  └─ ▸ Prod ou-2",
+		"├─ · Sandbox ou-3",
+		"└─   mgmt (suspended) 222222222222",
+		"SCP: FullAWSAccess",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("content missing %q:\n%s", want, content)
+		}
+	}
+}
+
+func TestOrgTreeView_Navigation(t *testing.T) {
+	v := loadedOrgTreeView(t)
+
+	pressKey(v, "j")
+	pressKey(v, "j")
+	if v.current().Name != "Prod" {
+		t.Fatalf("cursor on %s, want Prod", v.current().Name)
+	}
+	pressKey(v, "l")
+	if len(v.rows) != 6 || v.rows[3].node.Name != "shop" {
+		t.Errorf("expanding Prod should show shop, rows = %d", len(v.rows))
+	}
+	pressKey(v, "h")
+	if len(v.rows) != 5 || v.current().Name != "Prod" {
+		t.Errorf("h should collapse Prod, rows = %d", len(v.rows))
+	}
+	pressKey(v, "h")
+	if v.current().Name != "Workloads" {
+		t.Errorf("h on a collapsed node should go to its parent, cursor on %s", v.current().Name)
+	}
+	pressKey(v, "enter")
+	if len(v.rows) != 4 {
+		t.Errorf("enter should collapse Workloads, rows = %d", len(v.rows))
+	}
+}
+
+func TestOrgTreeView_Move(t *testing.T) {
+	v := loadedOrgTreeView(t)
+
+	pressKey(v, "G")
+	if cmd := pressKey(v, "m"); cmd != nil || v.holding == nil || v.holding.Name != "mgmt" {
+		t.Fatal("m on an account should pick it up")
+	}
+	if !v.HasActiveInput() || !strings.Contains(v.StatusLine(), "moving mgmt") {
+		t.Error("holding an account should capture esc and show in the status line")
+	}
+	pressKey(v, "esc")
+	if v.holding != nil {
+		t.Error("esc should cancel the move")
+	}
+
+	pressKey(v, "m")
+	pressKey(v, "k")
+	if cmd := pressKey(v, "m"); cmd == nil || v.holding != nil || !v.reloadPending {
+		t.Fatal("m on an OU should drop the account and open the action menu")
+	}
+
+	// Read-only mode hides Move, so dropping reports an error instead
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+	pressKey(v, "m") // reload pending: reloads, the key is not handled
+	v.Update(orgTreeLoadedMsg{root: testOrgTree()})
+	pressKey(v, "j")
+	pressKey(v, "m")
+	pressKey(v, "k")
+	cmd := pressKey(v, "m")
+	if cmd == nil {
+		t.Fatal("dropping in read-only mode should report an error")
+	}
+	if _, ok := cmd().(ErrorMsg); !ok {
+		t.Errorf("msg = %T, want ErrorMsg", cmd())
+	}
+}
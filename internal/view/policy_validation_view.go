@@ -0,0 +1,193 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+
+	"github.com/clawscli/claws/internal/clipboard"
+	"github.com/clawscli/claws/internal/policy"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// policyValidatedMsg carries the result of validating a policy file
+type policyValidatedMsg struct {
+	findings []policy.Finding
+	err      error
+}
+
+// PolicyValidationView lints a local policy file with Access Analyzer and
+// lists the findings with their line and column.
+type PolicyValidationView struct {
+	ctx        context.Context
+	path       string
+	policyType types.PolicyType
+
+	vp       ViewportState
+	findings []policy.Finding
+	err      error
+	loading  bool
+	loaded   bool
+}
+
+// NewPolicyValidationView validates the policy file at path as policyType
+func NewPolicyValidationView(ctx context.Context, path string, policyType types.PolicyType) *PolicyValidationView {
+	return &PolicyValidationView{ctx: ctx, path: path, policyType: policyType}
+}
+
+func (v *PolicyValidationView) Init() tea.Cmd {
+	return v.load()
+}
+
+// load re-reads the file each time, so r picks up edits made since
+func (v *PolicyValidationView) load() tea.Cmd {
+	if v.loading {
+		return nil
+	}
+	v.loading = true
+	ctx, path, policyType := v.ctx, v.path, v.policyType
+	return func() tea.Msg {
+		doc, err := policy.ReadFile(path)
+		if err != nil {
+			return policyValidatedMsg{err: err}
+		}
+		findings, err := policy.Validate(ctx, doc, policyType)
+		return policyValidatedMsg{findings: findings, err: err}
+	}
+}
+
+func (v *PolicyValidationView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case policyValidatedMsg:
+		v.findings = msg.findings
+		v.err = msg.err
+		v.loading = false
+		v.loaded = true
+		v.refreshContent()
+		return v, nil
+	case ThemeChangedMsg:
+		v.refreshContent()
+		return v, nil
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "r":
+			cmd := v.load()
+			v.refreshContent()
+			return v, cmd
+		case "y":
+			if v.loaded && v.err == nil {
+				return v, clipboard.Copy("Findings", policy.Format(v.path, v.findings))
+			}
+			return v, nil
+		}
+	}
+
+	if !v.vp.Ready {
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *PolicyValidationView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *PolicyValidationView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.vp.Model.View()
+}
+
+// SetSize sizes the viewport. Modals opened from command mode are not
+// Init'ed, so the first SetSize starts the validation.
+func (v *PolicyValidationView) SetSize(w, h int) tea.Cmd {
+	v.vp.SetSize(w, h)
+	var cmd tea.Cmd
+	if !v.loaded {
+		cmd = v.load()
+	}
+	v.refreshContent()
+	return cmd
+}
+
+func (v *PolicyValidationView) StatusLine() string {
+	return "r:revalidate • y:copy findings • Esc/q:close"
+}
+
+func (v *PolicyValidationView) refreshContent() {
+	if v.vp.Ready {
+		v.vp.Model.SetContent(v.buildContent())
+	}
+}
+
+func (v *PolicyValidationView) buildContent() string {
+	d := render.NewDetailBuilder()
+	d.Title("Validate Policy", v.path)
+	d.Field("Policy Type", string(v.policyType))
+
+	if v.loading && !v.loaded {
+		d.Dim("Calling access-analyzer:ValidatePolicy...")
+		return d.String()
+	}
+	if v.loading {
+		d.Dim("Revalidating...")
+	}
+	if v.err != nil {
+		d.FieldStyled("Error", v.err.Error(), ui.DangerStyle())
+		return d.String()
+	}
+	if len(v.findings) == 0 {
+		d.FieldStyled("Result", "No findings", ui.SuccessStyle())
+		return d.String()
+	}
+
+	counts := make(map[types.ValidatePolicyFindingType]int)
+	for _, f := range v.findings {
+		counts[f.Type]++
+	}
+	summary := fmt.Sprintf("%d error(s), %d security warning(s), %d warning(s), %d suggestion(s)",
+		counts[types.ValidatePolicyFindingTypeError],
+		counts[types.ValidatePolicyFindingTypeSecurityWarning],
+		counts[types.ValidatePolicyFindingTypeWarning],
+		counts[types.ValidatePolicyFindingTypeSuggestion])
+	if policy.HasErrors(v.findings) {
+		d.FieldStyled("Result", summary, ui.DangerStyle())
+	} else {
+		d.Field("Result", summary)
+	}
+
+	for _, f := range v.findings {
+		title := f.IssueCode
+		if f.Line > 0 {
+			title = fmt.Sprintf("Line %s  %s", f.Location(), f.IssueCode)
+		}
+		d.Section(title)
+		d.FieldStyled("Type", string(f.Type), findingTypeStyle(f.Type))
+		if f.Path != "" {
+			d.Field("Path", f.Path)
+		}
+		d.Field("Message", f.Message)
+		if f.LearnMoreLink != "" {
+			d.DimIndent("  " + f.LearnMoreLink)
+		}
+	}
+	return d.String()
+}
+
+// findingTypeStyle colors a finding type by severity
+func findingTypeStyle(t types.ValidatePolicyFindingType) render.Style {
+	switch t {
+	case types.ValidatePolicyFindingTypeError, types.ValidatePolicyFindingTypeSecurityWarning:
+		return ui.DangerStyle()
+	case types.ValidatePolicyFindingTypeWarning:
+		return ui.WarningStyle()
+	default:
+		return ui.DimStyle()
+	}
+}
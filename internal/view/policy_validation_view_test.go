@@ -0,0 +1,46 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+
+	"github.com/clawscli/claws/internal/policy"
+)
+
+func TestPolicyValidationView_Content(t *testing.T) {
+	v := NewPolicyValidationView(context.Background(), "admin.json", types.PolicyTypeIdentityPolicy)
+	v.vp.SetSize(90, 40)
+	v.Update(policyValidatedMsg{findings: []policy.Finding{
+		{Type: types.ValidatePolicyFindingTypeError, IssueCode: "MISSING_VERSION", Message: "Add a Version element.", Line: 1, Column: 1},
+		{Type: types.ValidatePolicyFindingTypeSecurityWarning, IssueCode: "PASS_ROLE_WITH_STAR_IN_RESOURCE", Message: "Overly permissive.", Line: 6, Column: 19, Path: "Statement[0].Resource"},
+	}})
+
+	content := v.buildContent()
+	for _, want := range []string{
+		"1 error(s), 1 security warning(s), 0 warning(s), 0 suggestion(s)",
+		"Line 1:1  MISSING_VERSION",
+		"Line 6:19  PASS_ROLE_WITH_STAR_IN_RESOURCE",
+		"Statement[0].Resource",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("content missing %q", want)
+		}
+	}
+	if v.loading || !v.loaded {
+		t.Errorf("loading = %v, loaded = %v after policyValidatedMsg", v.loading, v.loaded)
+	}
+
+	v.Update(policyValidatedMsg{})
+	if content := v.buildContent(); !strings.Contains(content, "No findings") {
+		t.Error("a clean policy should report no findings")
+	}
+
+	v.Update(policyValidatedMsg{err: errors.New("read policy: no such file")})
+	if content := v.buildContent(); !strings.Contains(content, "no such file") {
+		t.Error("content should show the error")
+	}
+}
@@ -75,6 +75,9 @@ func (v *ProfileDetailView) buildContent() string {
 		if v.info.SourceProfile != "" {
 			d.Field("Source Profile", v.info.SourceProfile)
 		}
+		if v.info.MFASerial != "" {
+			d.Field("MFA Device", v.info.MFASerial)
+		}
 	}
 
 	if v.info.IsSSO {
@@ -195,33 +195,30 @@ func (p *ProfileSelector) ssoLoginCurrentProfile() (tea.Model, tea.Cmd) {
 		return p, nil
 	}
 
-	if config.Global().ReadOnly() && !action.IsExecAllowedInReadOnly(action.ActionNameSSOLogin) {
-		p.loginResult = &loginResultMsg{
-			profileID: profile.id,
-			success:   false,
-			err:       fmt.Errorf("SSO login denied: read-only mode"),
-		}
+	cmd, err := ssoLogin(profile.id)
+	if err != nil {
+		p.loginResult = &loginResultMsg{profileID: profile.id, success: false, err: err}
 		p.updateExtraHeight()
 		return p, nil
 	}
+	return p, cmd
+}
 
+// ssoLogin returns a command running `aws sso login` for profileID, which
+// reports a loginResultMsg when it finishes.
+func ssoLogin(profileID string) (tea.Cmd, error) {
+	if config.Global().ReadOnly() && !action.IsExecAllowedInReadOnly(action.ActionNameSSOLogin) {
+		return nil, fmt.Errorf("SSO login denied: read-only mode")
+	}
 	if _, err := exec.LookPath("aws"); err != nil {
-		p.loginResult = &loginResultMsg{
-			profileID: profile.id,
-			success:   false,
-			err:       fmt.Errorf("aws CLI not found in PATH"),
-		}
-		p.updateExtraHeight()
-		return p, nil
+		return nil, fmt.Errorf("aws CLI not found in PATH")
 	}
-
-	profileID := profile.id
-	return p, tea.Exec(&ssoLoginCmd{profileName: profileID}, func(err error) tea.Msg {
+	return tea.Exec(&ssoLoginCmd{profileName: profileID}, func(err error) tea.Msg {
 		if err != nil {
 			return loginResultMsg{profileID: profileID, success: false, err: err}
 		}
 		return loginResultMsg{profileID: profileID, success: true}
-	})
+	}), nil
 }
 
 type ssoLoginCmd struct {
@@ -0,0 +1,404 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// regionVolatileWords are column name words whose values are expected to
+// differ between regions (timestamps, addresses, identifiers)
+var regionVolatileWords = map[string]bool{
+	"AGE": true, "CREATED": true, "LAST": true, "UPDATED": true, "MODIFIED": true,
+	"LAUNCHED": true, "LAUNCH": true, "TIME": true, "DATE": true, "ARN": true,
+	"REGION": true, "ACCOUNT": true, "IP": true, "DNS": true,
+}
+
+// awsIDPattern matches region-scoped resource IDs such as vpc-0abc1234,
+// which never match across regions
+var awsIDPattern = regexp.MustCompile(`^[a-z]+(-[a-z]+)*-[0-9a-f]{8,17}$`)
+
+// regionFieldDiff is a key field that differs between the two regions
+type regionFieldDiff struct {
+	column string
+	a, b   string
+}
+
+// regionCompareRow pairs the resources with the same name in two regions
+type regionCompareRow struct {
+	name  string
+	a, b  dao.Resource // nil when missing from that region
+	diffs []regionFieldDiff
+}
+
+func (row regionCompareRow) differs() bool {
+	return row.a == nil || row.b == nil || len(row.diffs) > 0
+}
+
+type regionCompareStyles struct {
+	title   lipgloss.Style
+	dim     lipgloss.Style
+	same    lipgloss.Style
+	onlyA   lipgloss.Style
+	onlyB   lipgloss.Style
+	changed lipgloss.Style
+	err     lipgloss.Style
+}
+
+func newRegionCompareStyles() regionCompareStyles {
+	return regionCompareStyles{
+		title:   ui.TitleStyle(),
+		dim:     ui.DimStyle(),
+		same:    ui.TextStyle(),
+		onlyA:   ui.DangerStyle(),
+		onlyB:   ui.SuccessStyle(),
+		changed: ui.WarningStyle(),
+		err:     ui.DangerStyle(),
+	}
+}
+
+// RegionCompareView lists a resource type side by side in two regions,
+// highlighting resources present in only one region or differing in key
+// fields, e.g. to check a DR region matches production.
+type RegionCompareView struct {
+	ctx          context.Context
+	registry     *registry.Registry
+	renderer     render.Renderer
+	service      string
+	resourceType string
+	regionA      string
+	regionB      string
+
+	loading  bool
+	spinner  spinner.Model
+	errs     []ScopeError
+	rows     []regionCompareRow
+	diffOnly bool
+
+	vp     ViewportState
+	width  int
+	styles regionCompareStyles
+}
+
+// NewRegionCompareView compares service/resourceType between regionA and regionB
+func NewRegionCompareView(ctx context.Context, reg *registry.Registry, renderer render.Renderer, service, resourceType, regionA, regionB string) *RegionCompareView {
+	return &RegionCompareView{
+		ctx:          ctx,
+		registry:     reg,
+		renderer:     renderer,
+		service:      service,
+		resourceType: resourceType,
+		regionA:      regionA,
+		regionB:      regionB,
+		loading:      true,
+		spinner:      ui.NewSpinner(),
+		styles:       newRegionCompareStyles(),
+	}
+}
+
+type regionCompareLoadedMsg struct {
+	a, b []dao.Resource
+	errs []ScopeError
+}
+
+func (v *RegionCompareView) Init() tea.Cmd {
+	return tea.Batch(v.load, v.spinner.Tick)
+}
+
+func (v *RegionCompareView) load() tea.Msg {
+	fetch := func(ctx context.Context, region string) ([]dao.Resource, string, error) {
+		regionCtx := aws.WithRegionOverride(ctx, region)
+		d, err := v.registry.GetDAO(regionCtx, v.service, v.resourceType)
+		if err != nil {
+			return nil, "", err
+		}
+		resources, err := d.List(regionCtx)
+		if err != nil {
+			return nil, "", err
+		}
+		wrapped := make([]dao.Resource, len(resources))
+		for i, res := range resources {
+			wrapped[i] = dao.WrapWithRegion(dao.UnwrapResource(res), region)
+		}
+		return wrapped, "", nil
+	}
+	scopeError := func(region string, err error) ScopeError {
+		return ScopeError{Region: region, Err: err}
+	}
+
+	result := fetchParallel(v.ctx, []string{v.regionA, v.regionB}, fetch, scopeError)
+	msg := regionCompareLoadedMsg{errs: result.errors}
+	for _, res := range result.resources {
+		if dao.GetResourceRegion(res) == v.regionA {
+			msg.a = append(msg.a, res)
+		} else {
+			msg.b = append(msg.b, res)
+		}
+	}
+	return msg
+}
+
+func (v *RegionCompareView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case regionCompareLoadedMsg:
+		v.loading = false
+		v.errs = msg.errs
+		v.rows = compareRegionResources(v.renderer, msg.a, msg.b, v.regionA, v.regionB)
+		v.refreshContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newRegionCompareStyles()
+		v.refreshContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		// Let app handle back navigation
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		switch msg.String() {
+		case "d":
+			v.diffOnly = !v.diffOnly
+			v.refreshContent()
+			return v, nil
+		case "ctrl+r":
+			if v.loading {
+				return v, nil
+			}
+			v.loading = true
+			return v, tea.Batch(v.load, v.spinner.Tick)
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+// compareRegionResources pairs resources by name (or ID when unnamed) and
+// compares the renderer's columns, ignoring those expected to differ
+// between regions.
+func compareRegionResources(renderer render.Renderer, a, b []dao.Resource, regionA, regionB string) []regionCompareRow {
+	byName := make(map[string]*regionCompareRow)
+	var order []string
+	add := func(res dao.Resource, inA bool) {
+		name := res.GetName()
+		if name == "" {
+			name = dao.UnwrapResource(res).GetID()
+		}
+		row, ok := byName[name]
+		if !ok {
+			row = &regionCompareRow{name: name}
+			byName[name] = row
+			order = append(order, name)
+		}
+		if inA {
+			row.a = res
+		} else {
+			row.b = res
+		}
+	}
+	for _, res := range a {
+		add(res, true)
+	}
+	for _, res := range b {
+		add(res, false)
+	}
+
+	rows := make([]regionCompareRow, 0, len(order))
+	for _, name := range order {
+		row := *byName[name]
+		if row.a != nil && row.b != nil && renderer != nil {
+			row.diffs = compareRegionFields(renderer, row.a, row.b, regionA, regionB)
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].name < rows[j].name })
+	return rows
+}
+
+func compareRegionFields(renderer render.Renderer, a, b dao.Resource, regionA, regionB string) []regionFieldDiff {
+	ua, ub := dao.UnwrapResource(a), dao.UnwrapResource(b)
+	cols := renderer.Columns()
+	rowA := renderer.RenderRow(ua, cols)
+	rowB := renderer.RenderRow(ub, cols)
+
+	var diffs []regionFieldDiff
+	for i, col := range cols {
+		if i >= len(rowA) || i >= len(rowB) || isRegionVolatileColumn(col.Name) {
+			continue
+		}
+		va := strings.TrimSpace(ansi.Strip(rowA[i]))
+		vb := strings.TrimSpace(ansi.Strip(rowB[i]))
+		// Identifiers are region-scoped, so they're expected to differ
+		if va == ua.GetID() || vb == ub.GetID() || (awsIDPattern.MatchString(va) && awsIDPattern.MatchString(vb)) {
+			continue
+		}
+		// Values embedding the region (AZs, endpoints) are compared without it
+		if strings.ReplaceAll(va, regionA, "") == strings.ReplaceAll(vb, regionB, "") {
+			continue
+		}
+		diffs = append(diffs, regionFieldDiff{column: col.Name, a: va, b: vb})
+	}
+	return diffs
+}
+
+func isRegionVolatileColumn(name string) bool {
+	words := strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return r < 'A' || r > 'Z'
+	})
+	for _, w := range words {
+		if regionVolatileWords[w] {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *RegionCompareView) counts() (onlyA, onlyB, changed, same int) {
+	for _, row := range v.rows {
+		switch {
+		case row.b == nil:
+			onlyA++
+		case row.a == nil:
+			onlyB++
+		case len(row.diffs) > 0:
+			changed++
+		default:
+			same++
+		}
+	}
+	return onlyA, onlyB, changed, same
+}
+
+func (v *RegionCompareView) refreshContent() {
+	if v.vp.Ready {
+		v.vp.Model.SetContent(v.renderContent())
+	}
+}
+
+func (v *RegionCompareView) renderContent() string {
+	s := v.styles
+	var b strings.Builder
+
+	b.WriteString(s.title.Render(fmt.Sprintf("Compare regions: %s/%s  %s ↔ %s", v.service, v.resourceType, v.regionA, v.regionB)) + "\n")
+	onlyA, onlyB, changed, same := v.counts()
+	b.WriteString(fmt.Sprintf("%s  %s  %s  %s\n",
+		s.onlyA.Render(fmt.Sprintf("%d only in %s", onlyA, v.regionA)),
+		s.onlyB.Render(fmt.Sprintf("%d only in %s", onlyB, v.regionB)),
+		s.changed.Render(fmt.Sprintf("%d differ", changed)),
+		s.dim.Render(fmt.Sprintf("%d match", same))))
+	for _, e := range v.errs {
+		b.WriteString(s.err.Render("✗ "+e.Error()) + "\n")
+	}
+	b.WriteString("\n")
+
+	nameWidth := 20
+	for _, row := range v.rows {
+		nameWidth = max(nameWidth, min(ansi.StringWidth(row.name), 40))
+	}
+
+	shown := 0
+	for _, row := range v.rows {
+		if v.diffOnly && !row.differs() {
+			continue
+		}
+		shown++
+		name := TruncateOrPadString(row.name, nameWidth)
+		switch {
+		case row.b == nil:
+			b.WriteString(s.onlyA.Render("◀ "+name+"  only in "+v.regionA) + "\n")
+		case row.a == nil:
+			b.WriteString(s.onlyB.Render("▶ "+name+"  only in "+v.regionB) + "\n")
+		case len(row.diffs) > 0:
+			b.WriteString(s.changed.Render("≠ "+name) + "\n")
+			for _, d := range row.diffs {
+				b.WriteString(fmt.Sprintf("    %s %s %s %s\n",
+					s.dim.Render(d.column+":"), valueOrDash(d.a), s.dim.Render("↔"), valueOrDash(d.b)))
+			}
+		default:
+			b.WriteString(s.same.Render("= "+name) + "\n")
+		}
+	}
+	if shown == 0 {
+		if len(v.rows) == 0 {
+			b.WriteString(s.dim.Render("No resources in either region") + "\n")
+		} else {
+			b.WriteString(s.dim.Render("Regions match") + "\n")
+		}
+	}
+	return b.String()
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func (v *RegionCompareView) ViewString() string {
+	if v.loading {
+		return v.spinner.View() + fmt.Sprintf(" Comparing %s and %s...", v.regionA, v.regionB)
+	}
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.vp.Model.View()
+}
+
+func (v *RegionCompareView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *RegionCompareView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.vp.SetSize(width, max(height, 5))
+	if !v.loading {
+		v.refreshContent()
+	}
+	return nil
+}
+
+func (v *RegionCompareView) StatusLine() string {
+	filter := "d:differences only"
+	if v.diffOnly {
+		filter = "d:show all"
+	}
+	return v.regionA + " ↔ " + v.regionB + " • " + filter + " • ctrl+r:refresh • q/esc:back"
+}
+
+// KeyHelp implements KeyHelper
+func (v *RegionCompareView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{
+		Title: "Region Compare",
+		Bindings: []KeyBinding{
+			{"↑/k, ↓/j", "Scroll"},
+			{"d", "Toggle differences only"},
+			{"Ctrl+r", "Compare again"},
+			{"Esc", "Back to resource list"},
+		},
+	}}
+}
@@ -0,0 +1,147 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// compareRenderer renders fields from a mockResource's tags map
+type compareRenderer struct {
+	render.BaseRenderer
+}
+
+func newCompareRenderer(columns ...string) *compareRenderer {
+	r := &compareRenderer{}
+	for _, name := range columns {
+		r.Cols = append(r.Cols, render.Column{Name: name, Getter: func(res dao.Resource) string {
+			if name == "ID" {
+				return res.GetID()
+			}
+			return res.GetTags()[name]
+		}})
+	}
+	return r
+}
+
+func regionRes(region, id, name string, fields map[string]string) dao.Resource {
+	return dao.WrapWithRegion(&mockResource{id: id, name: name, tags: fields}, region)
+}
+
+func TestCompareRegionResources(t *testing.T) {
+	renderer := newCompareRenderer("ID", "STATE", "TYPE", "AZ", "VPC", "CREATED", "PRIVATE IP")
+	a := []dao.Resource{
+		regionRes("us-east-1", "i-aaa", "web", map[string]string{
+			"STATE": "running", "TYPE": "t3.large", "AZ": "us-east-1a", "VPC": "vpc-0aaaaaaaa",
+			"CREATED": "2026-01-01", "PRIVATE IP": "10.0.0.1",
+		}),
+		regionRes("us-east-1", "i-bbb", "worker", map[string]string{"STATE": "running", "TYPE": "t3.small"}),
+		regionRes("us-east-1", "i-ccc", "batch", map[string]string{"STATE": "running"}),
+	}
+	b := []dao.Resource{
+		regionRes("us-west-2", "i-ddd", "web", map[string]string{
+			"STATE": "running", "TYPE": "t3.large", "AZ": "us-west-2a", "VPC": "vpc-0bbbbbbbb",
+			"CREATED": "2026-02-01", "PRIVATE IP": "10.1.0.1",
+		}),
+		regionRes("us-west-2", "i-eee", "worker", map[string]string{"STATE": "stopped", "TYPE": "t3.small"}),
+		regionRes("us-west-2", "i-fff", "dr-only", nil),
+	}
+
+	rows := compareRegionResources(renderer, a, b, "us-east-1", "us-west-2")
+	byName := make(map[string]regionCompareRow)
+	for _, row := range rows {
+		byName[row.name] = row
+	}
+	if len(rows) != 4 {
+		t.Fatalf("rows = %d, want 4", len(rows))
+	}
+
+	if web := byName["web"]; web.differs() {
+		t.Errorf("web should match: IDs, AZs, VPC IDs, timestamps and IPs are region-specific; diffs = %+v", web.diffs)
+	}
+	worker := byName["worker"]
+	if len(worker.diffs) != 1 || worker.diffs[0].column != "STATE" || worker.diffs[0].a != "running" || worker.diffs[0].b != "stopped" {
+		t.Errorf("worker diffs = %+v, want STATE running ↔ stopped", worker.diffs)
+	}
+	if batch := byName["batch"]; batch.a == nil || batch.b != nil {
+		t.Error("batch should be only in us-east-1")
+	}
+	if dr := byName["dr-only"]; dr.a != nil || dr.b == nil {
+		t.Error("dr-only should be only in us-west-2")
+	}
+}
+
+func TestIsRegionVolatileColumn(t *testing.T) {
+	for _, name := range []string{"AGE", "CREATED", "LAST RUN", "PUBLIC IP", "ARN", "LAUNCH TIME"} {
+		if !isRegionVolatileColumn(name) {
+			t.Errorf("%q should be volatile", name)
+		}
+	}
+	for _, name := range []string{"STATE", "DESCRIPTION", "PIPELINE", "TYPE"} {
+		if isRegionVolatileColumn(name) {
+			t.Errorf("%q should not be volatile", name)
+		}
+	}
+}
+
+func TestRegionCompareView(t *testing.T) {
+	renderer := newCompareRenderer("STATE")
+	v := NewRegionCompareView(context.Background(), registry.New(), renderer, "ec2", "instances", "us-east-1", "us-west-2")
+	v.SetSize(120, 40)
+
+	if !strings.Contains(v.ViewString(), "Comparing us-east-1 and us-west-2") {
+		t.Errorf("loading view = %q", v.ViewString())
+	}
+
+	v.Update(regionCompareLoadedMsg{
+		a: []dao.Resource{
+			regionRes("us-east-1", "i-1", "web", map[string]string{"STATE": "running"}),
+			regionRes("us-east-1", "i-2", "same", map[string]string{"STATE": "running"}),
+		},
+		b: []dao.Resource{
+			regionRes("us-west-2", "i-3", "web", map[string]string{"STATE": "stopped"}),
+			regionRes("us-west-2", "i-4", "same", map[string]string{"STATE": "running"}),
+			regionRes("us-west-2", "i-5", "extra", nil),
+		},
+		errs: []ScopeError{},
+	})
+
+	out := v.ViewString()
+	for _, want := range []string{"0 only in us-east-1", "1 only in us-west-2", "1 differ", "1 match", "≠ web", "STATE:", "▶ extra", "= same"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	if out := v.ViewString(); strings.Contains(out, "= same") {
+		t.Errorf("differences-only view should hide matching rows:\n%s", out)
+	}
+	if !strings.Contains(v.StatusLine(), "d:show all") {
+		t.Errorf("StatusLine() = %q", v.StatusLine())
+	}
+}
+
+func TestResourceBrowserCompareRegionsMsg(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{})
+	browser := NewResourceBrowserWithType(context.Background(), reg, "ec2", "instances")
+
+	_, cmd := browser.Update(CompareRegionsMsg{RegionA: "us-east-1", RegionB: "eu-west-1"})
+	if cmd == nil {
+		t.Fatal("expected navigation command")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatalf("msg = %T, want NavigateMsg", cmd())
+	}
+	if v, ok := nav.View.(*RegionCompareView); !ok || v.regionA != "us-east-1" || v.regionB != "eu-west-1" {
+		t.Errorf("view = %#v", nav.View)
+	}
+}
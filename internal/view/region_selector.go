@@ -2,8 +2,10 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/log"
 	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/ui"
 )
 
 var regionOrder = map[string]int{
@@ -26,22 +29,39 @@ var regionOrder = map[string]int{
 	"default": 9,
 }
 
-type regionItem string
+// regionSlowLatency is the latency above which a region is highlighted
+const regionSlowLatency = 250 * time.Millisecond
 
-func (r regionItem) GetID() string    { return string(r) }
-func (r regionItem) GetLabel() string { return string(r) }
+type regionItem struct {
+	name    string
+	enabled bool
+}
+
+func (r regionItem) GetID() string    { return r.name }
+func (r regionItem) GetLabel() string { return r.name }
+
+// regionLatency is the result of probing a region's endpoint
+type regionLatency struct {
+	latency time.Duration
+	err     error
+}
 
 type RegionSelector struct {
 	ctx      context.Context
 	selector *MultiSelector[regionItem]
 	regions  []regionItem
+	latency  map[string]regionLatency
+	pinging  bool
 }
 
 func NewRegionSelector(ctx context.Context) *RegionSelector {
-	return &RegionSelector{
+	r := &RegionSelector{
 		ctx:      ctx,
 		selector: NewMultiSelector[regionItem]("Select Regions", config.Global().Regions()),
+		latency:  make(map[string]regionLatency),
 	}
+	r.selector.SetRenderExtra(r.renderRegionExtra)
+	return r
 }
 
 func (r *RegionSelector) Init() tea.Cmd {
@@ -49,15 +69,73 @@ func (r *RegionSelector) Init() tea.Cmd {
 }
 
 func (r *RegionSelector) loadRegions() tea.Msg {
-	regions, err := aws.FetchAvailableRegions(r.ctx)
+	infos, err := aws.FetchRegionInfo(r.ctx)
 	if err != nil {
 		log.Error("failed to fetch regions", "error", err)
 	}
-	return regionsLoadedMsg{regions: regions}
+	regions := make([]string, len(infos))
+	disabled := make(map[string]bool)
+	for i, info := range infos {
+		regions[i] = info.Name
+		if !info.Enabled() {
+			disabled[info.Name] = true
+		}
+	}
+	return regionsLoadedMsg{regions: regions, disabled: disabled}
 }
 
 type regionsLoadedMsg struct {
-	regions []string
+	regions  []string
+	disabled map[string]bool // regions not enabled for the account
+}
+
+type regionLatencyMsg struct {
+	region  string
+	latency time.Duration
+	err     error
+}
+
+// pingRegions probes every enabled region's endpoint in parallel
+func (r *RegionSelector) pingRegions() tea.Cmd {
+	var cmds []tea.Cmd
+	for _, item := range r.regions {
+		if !item.enabled {
+			continue
+		}
+		region := item.name
+		cmds = append(cmds, func() tea.Msg {
+			latency, err := aws.MeasureRegionLatency(r.ctx, region)
+			return regionLatencyMsg{region: region, latency: latency, err: err}
+		})
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	r.pinging = true
+	clear(r.latency)
+	r.selector.ClearResult()
+	return tea.Batch(cmds...)
+}
+
+func (r *RegionSelector) renderRegionExtra(item regionItem) string {
+	if !item.enabled {
+		return ui.WarningStyle().Render("(not enabled)")
+	}
+	result, ok := r.latency[item.name]
+	switch {
+	case !ok:
+		return ""
+	case result.err != nil:
+		return ui.DangerStyle().Render("unreachable")
+	case result.latency > regionSlowLatency:
+		return ui.WarningStyle().Render(formatLatency(result.latency))
+	default:
+		return ui.DimStyle().Render(formatLatency(result.latency))
+	}
+}
+
+func formatLatency(d time.Duration) string {
+	return fmt.Sprintf("~%dms", d.Milliseconds())
 }
 
 func sortRegions(regions []string) {
@@ -85,12 +163,25 @@ func (r *RegionSelector) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case regionsLoadedMsg:
 		sortRegions(msg.regions)
+		// Regions the account can't use go last, so they're not mistaken for empty ones
+		sort.SliceStable(msg.regions, func(i, j int) bool {
+			return !msg.disabled[msg.regions[i]] && msg.disabled[msg.regions[j]]
+		})
 		r.regions = make([]regionItem, len(msg.regions))
 		for i, region := range msg.regions {
-			r.regions[i] = regionItem(region)
+			r.regions[i] = regionItem{name: region, enabled: !msg.disabled[region]}
 		}
 		r.selector.SetItems(r.regions)
 		return r, nil
+	case regionLatencyMsg:
+		r.latency[msg.region] = regionLatency{latency: msg.latency, err: msg.err}
+		r.pinging = r.pendingPings() > 0
+		r.selector.ClearResult()
+		return r, nil
+	case tea.KeyPressMsg:
+		if !r.selector.FilterActive() && msg.String() == "p" {
+			return r, r.pingRegions()
+		}
 	case ThemeChangedMsg:
 		r.selector.ReloadStyles()
 		return r, nil
@@ -111,7 +202,7 @@ func (r *RegionSelector) applySelection() (tea.Model, tea.Cmd) {
 
 	regions := make([]string, len(selected))
 	for i, item := range selected {
-		regions[i] = string(item)
+		regions[i] = item.name
 	}
 
 	config.Global().SetRegions(regions)
@@ -120,6 +211,16 @@ func (r *RegionSelector) applySelection() (tea.Model, tea.Cmd) {
 	}
 }
 
+func (r *RegionSelector) pendingPings() int {
+	pending := 0
+	for _, item := range r.regions {
+		if _, ok := r.latency[item.name]; item.enabled && !ok {
+			pending++
+		}
+	}
+	return pending
+}
+
 func (r *RegionSelector) ViewString() string {
 	return r.selector.ViewString()
 }
@@ -138,7 +239,11 @@ func (r *RegionSelector) StatusLine() string {
 	if r.selector.FilterActive() {
 		return "Type to filter • Enter confirm • Esc cancel"
 	}
-	return "Space:toggle • a:all • n:none • Enter:apply • " + strings.Repeat("●", count) + " selected"
+	ping := "p:ping"
+	if r.pinging {
+		ping = "pinging…"
+	}
+	return "Space:toggle • a:all • n:none • " + ping + " • Enter:apply • " + strings.Repeat("●", count) + " selected"
 }
 
 func (r *RegionSelector) HasActiveInput() bool {
@@ -2,7 +2,10 @@ package view
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 )
@@ -67,3 +70,60 @@ func TestRegionSelectorEmptyFilter(t *testing.T) {
 		t.Errorf("Expected cursor >= 0 after clear, got %d", selector.selector.Cursor())
 	}
 }
+
+func TestRegionSelectorDisabledRegionsLast(t *testing.T) {
+	selector := NewRegionSelector(context.Background())
+	selector.SetSize(100, 50)
+
+	selector.Update(regionsLoadedMsg{
+		regions:  []string{"ap-east-1", "us-west-2", "me-south-1", "us-east-1"},
+		disabled: map[string]bool{"ap-east-1": true, "me-south-1": true},
+	})
+
+	var got []string
+	for _, item := range selector.regions {
+		got = append(got, item.name)
+	}
+	want := []string{"us-east-1", "us-west-2", "me-south-1", "ap-east-1"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+
+	if extra := selector.renderRegionExtra(selector.regions[2]); !strings.Contains(extra, "not enabled") {
+		t.Errorf("disabled region extra = %q, want 'not enabled'", extra)
+	}
+	if extra := selector.renderRegionExtra(selector.regions[0]); extra != "" {
+		t.Errorf("enabled region extra = %q, want empty before ping", extra)
+	}
+}
+
+func TestRegionSelectorLatency(t *testing.T) {
+	selector := NewRegionSelector(context.Background())
+	selector.SetSize(100, 50)
+
+	selector.Update(regionsLoadedMsg{
+		regions:  []string{"us-east-1", "eu-west-1", "ap-east-1"},
+		disabled: map[string]bool{"ap-east-1": true},
+	})
+	selector.pinging = true
+
+	selector.Update(regionLatencyMsg{region: "us-east-1", latency: 42 * time.Millisecond})
+	if !selector.pinging {
+		t.Error("expected pinging while eu-west-1 is pending")
+	}
+	if !strings.Contains(selector.StatusLine(), "pinging") {
+		t.Errorf("StatusLine() = %q, want pinging", selector.StatusLine())
+	}
+
+	selector.Update(regionLatencyMsg{region: "eu-west-1", err: errors.New("timeout")})
+	if selector.pinging {
+		t.Error("expected pinging to finish once enabled regions reported")
+	}
+
+	if extra := selector.renderRegionExtra(regionItem{name: "us-east-1", enabled: true}); !strings.Contains(extra, "~42ms") {
+		t.Errorf("us-east-1 extra = %q, want ~42ms", extra)
+	}
+	if extra := selector.renderRegionExtra(regionItem{name: "eu-west-1", enabled: true}); !strings.Contains(extra, "unreachable") {
+		t.Errorf("eu-west-1 extra = %q, want unreachable", extra)
+	}
+}
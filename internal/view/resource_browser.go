@@ -82,6 +82,7 @@ type ResourceBrowser struct {
 	filterInput  textinput.Model
 	filterActive bool
 	filterText   string
+	filterSeq    int // bumped on each filter change; stale results are dropped
 
 	// Tag filter (from :tag command)
 	tagFilterText string // tag filter (e.g., "Env=prod")
@@ -93,6 +94,11 @@ type ResourceBrowser struct {
 	// Auto-reload
 	autoReload         bool
 	autoReloadInterval time.Duration
+	// transientReload is set while the renderer reports resources in a
+	// transitional state (render.AutoReloader); reloadTickPending prevents
+	// manual refreshes from starting a second tick chain.
+	transientReload   bool
+	reloadTickPending bool
 
 	// Pagination (for PaginatedDAO)
 	nextPageToken       string
@@ -115,13 +121,23 @@ type ResourceBrowser struct {
 	// Diff mark (for comparing two resources)
 	markedResource dao.Resource
 
+	// Vim-style count prefixes and jump marks. "m" marks for comparison
+	// at once; if a mark name follows on the same row, the compare mark is
+	// restored to markPrev and a jump mark is set instead.
+	vim        vimKeys
+	markPrev   dao.Resource
+	markCursor int
+
+	// Rendered cells of the loaded resources
+	rows rowCache
+
 	// Inline metrics
 	metricsEnabled bool
 	metricsLoading bool
 	metricsData    *metrics.MetricData
 
-	// Partial region errors (for multi-region queries)
-	partialErrors []string
+	// Profiles/regions that failed in a multi-scope query (shown with "!")
+	partialErrors []ScopeError
 
 	// List-level toggles (e.g., show resolved findings)
 	toggleStates map[string]bool
@@ -200,6 +216,25 @@ func (r *ResourceBrowser) tickCmd() tea.Cmd {
 	})
 }
 
+// transientTickCmd schedules a reload while resources are transitioning,
+// unless one is already pending.
+func (r *ResourceBrowser) transientTickCmd() tea.Cmd {
+	if r.reloadTickPending {
+		return nil
+	}
+	r.reloadTickPending = true
+	return tea.Tick(DefaultAutoReloadInterval, func(t time.Time) tea.Msg {
+		return autoReloadTickMsg{time: t}
+	})
+}
+
+// needsTransientReload reports whether the renderer wants the list
+// refreshed because some resources are still changing state.
+func (r *ResourceBrowser) needsTransientReload() bool {
+	reloader, ok := r.renderer.(render.AutoReloader)
+	return ok && reloader.NeedsAutoReload(r.resources)
+}
+
 // autoReloadTickMsg is sent when auto-reload timer fires
 type autoReloadTickMsg struct {
 	time time.Time
@@ -222,6 +257,7 @@ func (r *ResourceBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ThemeChangedMsg:
 		r.styles = newResourceBrowserStyles()
 		r.headerPanel.ReloadStyles()
+		r.rows.reset(r.renderer, nil)
 		r.buildTable()
 		return r, nil
 	case CompactHeaderChangedMsg:
@@ -233,6 +269,23 @@ func (r *ResourceBrowser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return r.handleTagFilterMsg(msg)
 	case DiffMsg:
 		return r.handleDiffMsg(msg)
+	case CopyAsMsg:
+		return r.handleCopyAsMsg(msg)
+	case UsedByMsg:
+		return r.handleUsedByMsg()
+	case TraceMsg:
+		return r.handleTraceMsg()
+	case CompareRegionsMsg:
+		return r.handleCompareRegionsMsg(msg)
+	case filterDebounceMsg:
+		return r.handleFilterDebounce(msg)
+	case filterResultMsg:
+		return r.handleFilterResult(msg)
+	case vimKeyTimeoutMsg:
+		if key, ok := r.vim.expire(msg, r.vimAmbiguous); ok {
+			return r.handleNumberKey(key)
+		}
+		return r, nil
 	case tea.KeyPressMsg:
 		if model, cmd := r.handleKeyPress(msg); model != nil || cmd != nil {
 			if model == nil {
@@ -303,7 +356,7 @@ func (r *ResourceBrowser) ViewString() string {
 		countText += " (more available)"
 	}
 
-	tabsView := r.renderTabs() + r.styles.count.Render(countText)
+	tabsView := r.renderTabs() + r.styles.count.Render(countText) + partialErrorsIndicator(r.partialErrors)
 
 	// Filter view (use cached styles)
 	var filterView string
@@ -319,6 +372,11 @@ func (r *ResourceBrowser) ViewString() string {
 			ui.DimStyle().Render("No matching resources (press 'c' to clear filter)")
 	}
 
+	if len(r.resources) == 0 && len(r.partialErrors) > 0 {
+		return headerPanel + "\n" + tabsView + "\n" +
+			ui.DangerStyle().Render(fmt.Sprintf("All %d scope(s) failed - press ! for details", len(r.partialErrors)))
+	}
+
 	if len(r.resources) == 0 {
 		return headerPanel + "\n" + tabsView + "\n" +
 			ui.DimStyle().Render("No resources found")
@@ -345,7 +403,7 @@ func (r *ResourceBrowser) SetSize(width, height int) tea.Cmd {
 }
 
 func (r *ResourceBrowser) HasActiveInput() bool {
-	return r.filterActive
+	return r.filterActive || r.vim.capturing()
 }
 
 func (r *ResourceBrowser) contextForResource(res dao.Resource) (context.Context, dao.Resource) {
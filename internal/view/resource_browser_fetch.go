@@ -2,8 +2,6 @@ package view
 
 import (
 	"context"
-	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -36,6 +34,8 @@ func (r *ResourceBrowser) listResourcesWithContext(ctx context.Context, d dao.DA
 	var resources []dao.Resource
 	var nextToken string
 	var err error
+	start := time.Now()
+	defer func() { aws.RecordDAOCall(ctx, r.service+"/"+r.resourceType, start, err) }()
 	if pagDAO, ok := d.(dao.PaginatedDAO); ok {
 		resources, nextToken, err = pagDAO.ListPage(listCtx, r.pageSize, "")
 	} else {
@@ -62,7 +62,7 @@ type parallelFetchItem[K comparable] struct {
 
 type parallelFetchResult[K comparable] struct {
 	resources  []dao.Resource
-	errors     []string
+	errors     []ScopeError
 	pageTokens map[K]string
 }
 
@@ -70,7 +70,7 @@ func fetchParallel[K comparable](
 	ctx context.Context,
 	keys []K,
 	fetch func(context.Context, K) ([]dao.Resource, string, error),
-	formatError func(K, error) string,
+	scopeError func(K, error) ScopeError,
 ) parallelFetchResult[K] {
 	ctx, cancel := context.WithTimeout(ctx, config.File().MultiRegionFetchTimeout())
 	defer cancel()
@@ -101,7 +101,7 @@ func fetchParallel[K comparable](
 	}
 
 	var allResources []dao.Resource
-	var errors []string
+	var errors []ScopeError
 	pageTokens := make(map[K]string)
 	for _, key := range keys {
 		result, ok := resultsByKey[key]
@@ -109,7 +109,7 @@ func fetchParallel[K comparable](
 			continue
 		}
 		if result.err != nil {
-			errors = append(errors, formatError(key, result.err))
+			errors = append(errors, scopeError(key, result.err))
 		} else {
 			allResources = append(allResources, result.resources...)
 			if result.nextToken != "" {
@@ -164,12 +164,12 @@ func (r *ResourceBrowser) fetchMultiProfileResources(profiles []config.ProfileSe
 		return wrapped, listResult.nextToken, nil
 	}
 
-	formatError := func(key profileRegionKey, err error) string {
+	scopeError := func(key profileRegionKey, err error) ScopeError {
 		log.Debug("failed to fetch", "profile", key.Profile, "region", key.Region, "error", err)
-		return fmt.Sprintf("%s/%s: %v", key.Profile, key.Region, err)
+		return ScopeError{Profile: key.Profile, Region: key.Region, Err: err}
 	}
 
-	return fetchParallel(r.ctx, keys, fetch, formatError)
+	return fetchParallel(r.ctx, keys, fetch, scopeError)
 }
 
 func (r *ResourceBrowser) fetchMultiRegionResources(regions []string, existingTokens map[string]string) parallelFetchResult[string] {
@@ -196,12 +196,12 @@ func (r *ResourceBrowser) fetchMultiRegionResources(regions []string, existingTo
 		return wrapped, listResult.nextToken, nil
 	}
 
-	formatError := func(region string, err error) string {
+	scopeError := func(region string, err error) ScopeError {
 		log.Debug("failed to fetch from region", "region", region, "error", err)
-		return fmt.Sprintf("%s: %v", region, err)
+		return ScopeError{Region: region, Err: err}
 	}
 
-	return fetchParallel(r.ctx, regions, fetch, formatError)
+	return fetchParallel(r.ctx, regions, fetch, scopeError)
 }
 
 func (r *ResourceBrowser) fetchWithDAO(ctx context.Context, d dao.DAO, token string) listResourcesResult {
@@ -215,7 +215,9 @@ func (r *ResourceBrowser) fetchWithDAO(ctx context.Context, d dao.DAO, token str
 				listCtx = dao.WithFilter(listCtx, key, "true")
 			}
 		}
+		start := time.Now()
 		resources, nextToken, err := pagDAO.ListPage(listCtx, r.pageSize, token)
+		aws.RecordDAOCall(ctx, r.service+"/"+r.resourceType, start, err)
 		return listResourcesResult{resources: resources, nextToken: nextToken, err: err}
 	}
 	return r.listResourcesWithContext(ctx, d)
@@ -239,9 +241,6 @@ func (r *ResourceBrowser) loadResources() tea.Msg {
 
 	if isMultiProfile {
 		fetchResult := r.fetchMultiProfileResources(profiles, regions, nil)
-		if len(fetchResult.resources) == 0 && len(fetchResult.errors) > 0 {
-			return resourcesErrorMsg{err: fmt.Errorf("all profile/region pairs failed: %s", strings.Join(fetchResult.errors, "; "))}
-		}
 
 		log.Debug("multi-profile resources loaded", "count", len(fetchResult.resources),
 			"profiles", len(profiles), "regions", len(regions), "errors", len(fetchResult.errors), "duration", time.Since(start))
@@ -253,6 +252,7 @@ func (r *ResourceBrowser) loadResources() tea.Msg {
 			nextMultiPageTokens: fetchResult.pageTokens,
 			hasMorePages:        len(fetchResult.pageTokens) > 0,
 			partialErrors:       fetchResult.errors,
+			rows:                renderRows(renderer, fetchResult.resources),
 		}
 	}
 
@@ -276,13 +276,11 @@ func (r *ResourceBrowser) loadResources() tea.Msg {
 			resources:    result.resources,
 			nextToken:    result.nextToken,
 			hasMorePages: result.nextToken != "",
+			rows:         renderRows(renderer, result.resources),
 		}
 	}
 
 	fetchResult := r.fetchMultiRegionResources(regions, nil)
-	if len(fetchResult.resources) == 0 && len(fetchResult.errors) > 0 {
-		return resourcesErrorMsg{err: fmt.Errorf("all regions failed: %s", strings.Join(fetchResult.errors, "; "))}
-	}
 
 	log.Debug("multi-region resources loaded", "count", len(fetchResult.resources),
 		"regions", len(regions), "errors", len(fetchResult.errors), "duration", time.Since(start))
@@ -294,6 +292,7 @@ func (r *ResourceBrowser) loadResources() tea.Msg {
 		nextPageTokens: fetchResult.pageTokens,
 		hasMorePages:   len(fetchResult.pageTokens) > 0,
 		partialErrors:  fetchResult.errors,
+		rows:           renderRows(renderer, fetchResult.resources),
 	}
 }
 
@@ -305,9 +304,6 @@ func (r *ResourceBrowser) reloadResources() tea.Msg {
 
 	if isMultiProfile {
 		fetchResult := r.fetchMultiProfileResources(profiles, regions, nil)
-		if len(fetchResult.resources) == 0 && len(fetchResult.errors) > 0 {
-			return resourcesErrorMsg{err: fmt.Errorf("all profile/region pairs failed: %s", strings.Join(fetchResult.errors, "; "))}
-		}
 
 		return resourcesLoadedMsg{
 			dao:                 nil,
@@ -316,6 +312,7 @@ func (r *ResourceBrowser) reloadResources() tea.Msg {
 			nextMultiPageTokens: fetchResult.pageTokens,
 			hasMorePages:        len(fetchResult.pageTokens) > 0,
 			partialErrors:       fetchResult.errors,
+			rows:                renderRows(r.renderer, fetchResult.resources),
 		}
 	}
 
@@ -340,13 +337,11 @@ func (r *ResourceBrowser) reloadResources() tea.Msg {
 			resources:    result.resources,
 			nextToken:    result.nextToken,
 			hasMorePages: result.nextToken != "",
+			rows:         renderRows(r.renderer, result.resources),
 		}
 	}
 
 	fetchResult := r.fetchMultiRegionResources(regions, nil)
-	if len(fetchResult.resources) == 0 && len(fetchResult.errors) > 0 {
-		return resourcesErrorMsg{err: fmt.Errorf("all regions failed: %s", strings.Join(fetchResult.errors, "; "))}
-	}
 
 	return resourcesLoadedMsg{
 		dao:            nil,
@@ -355,6 +350,7 @@ func (r *ResourceBrowser) reloadResources() tea.Msg {
 		nextPageTokens: fetchResult.pageTokens,
 		hasMorePages:   len(fetchResult.pageTokens) > 0,
 		partialErrors:  fetchResult.errors,
+		rows:           renderRows(r.renderer, fetchResult.resources),
 	}
 }
 
@@ -366,7 +362,10 @@ type resourcesLoadedMsg struct {
 	nextPageTokens      map[string]string
 	nextMultiPageTokens map[profileRegionKey]string
 	hasMorePages        bool
-	partialErrors       []string
+	// partialErrors lists the scopes that failed; when every scope failed
+	// resources is empty and the browser shows the errors instead
+	partialErrors []ScopeError
+	rows          rowCells
 }
 
 type nextPageLoadedMsg struct {
@@ -375,6 +374,8 @@ type nextPageLoadedMsg struct {
 	nextPageTokens      map[string]string
 	nextMultiPageTokens map[profileRegionKey]string
 	hasMorePages        bool
+	partialErrors       []ScopeError
+	rows                rowCells
 }
 
 type resourcesErrorMsg struct {
@@ -430,6 +431,7 @@ func (r *ResourceBrowser) loadNextPage() tea.Msg {
 	}
 
 	resources, nextToken, err := pagDAO.ListPage(listCtx, r.pageSize, r.nextPageToken)
+	aws.RecordDAOCall(r.ctx, r.service+"/"+r.resourceType, start, err)
 	if err != nil {
 		log.Error("failed to load next page", "error", err, "duration", time.Since(start))
 		return resourcesErrorMsg{err: err}
@@ -441,6 +443,7 @@ func (r *ResourceBrowser) loadNextPage() tea.Msg {
 		resources:    resources,
 		nextToken:    nextToken,
 		hasMorePages: nextToken != "",
+		rows:         renderRows(r.renderer, resources),
 	}
 }
 
@@ -464,6 +467,8 @@ func (r *ResourceBrowser) loadNextPageMultiRegion() tea.Msg {
 		resources:      fetchResult.resources,
 		nextPageTokens: fetchResult.pageTokens,
 		hasMorePages:   len(fetchResult.pageTokens) > 0,
+		partialErrors:  fetchResult.errors,
+		rows:           renderRows(r.renderer, fetchResult.resources),
 	}
 }
 
@@ -487,5 +492,7 @@ func (r *ResourceBrowser) loadNextPageMultiProfile() tea.Msg {
 		resources:           fetchResult.resources,
 		nextMultiPageTokens: fetchResult.pageTokens,
 		hasMorePages:        len(fetchResult.pageTokens) > 0,
+		partialErrors:       fetchResult.errors,
+		rows:                renderRows(r.renderer, fetchResult.resources),
 	}
 }
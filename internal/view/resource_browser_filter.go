@@ -3,17 +3,54 @@ package view
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/filter"
-	"github.com/clawscli/claws/internal/render"
 )
 
+// filterDebounce is how long typing in the filter must pause before the
+// list is filtered again
+const filterDebounce = 80 * time.Millisecond
+
+// filterDebounceMsg fires when typing in the filter has paused
+type filterDebounceMsg struct {
+	owner *ResourceBrowser
+	seq   int
+}
+
+// filterResultMsg carries the resources matched off the UI goroutine
+type filterResultMsg struct {
+	owner    *ResourceBrowser
+	seq      int
+	filtered []dao.Resource
+}
+
 // applyFilter filters resources based on current filter settings
 func (r *ResourceBrowser) applyFilter() {
-	// Start with all resources
+	// Any filtering still pending is for older input or data
+	r.filterSeq++
+
+	working := r.scopedResources()
+
+	// Then apply text filter
+	if r.filterText == "" {
+		r.filtered = working
+		r.applySorting()
+		return
+	}
+
+	r.setFiltered(matchSearchKeys(working, r.searchKeys(working), strings.ToLower(r.filterText)))
+}
+
+// scopedResources returns the resources that pass the navigation and tag
+// filters, before the text filter
+func (r *ResourceBrowser) scopedResources() []dao.Resource {
 	working := r.resources
 
 	// Apply field-based filter first (from navigation)
@@ -37,32 +74,12 @@ func (r *ResourceBrowser) applyFilter() {
 		}
 		working = tagFiltered
 	}
+	return working
+}
 
-	// Then apply text filter
-	if r.filterText == "" {
-		r.filtered = working
-		r.applySorting()
-		return
-	}
-
-	r.filtered = nil
-
-	// Regular text filter (fuzzy match across all columns)
-	filterLower := strings.ToLower(r.filterText)
-
-	// Get columns from renderer
-	var cols []render.Column
-	if r.renderer != nil {
-		cols = r.renderer.Columns()
-	}
-
-	for _, res := range working {
-		// Match against all visible columns
-		if r.matchesFilter(res, cols, filterLower) {
-			r.filtered = append(r.filtered, res)
-		}
-	}
-
+// setFiltered shows the text-filtered resources, sorted
+func (r *ResourceBrowser) setFiltered(filtered []dao.Resource) {
+	r.filtered = filtered
 	r.applySorting()
 
 	// Clear mark if marked resource is no longer in filtered list
@@ -80,6 +97,83 @@ func (r *ResourceBrowser) applyFilter() {
 	}
 }
 
+// searchKeys returns the cached search keys of resources, in order
+func (r *ResourceBrowser) searchKeys(resources []dao.Resource) [][]string {
+	if r.renderer == nil {
+		return nil
+	}
+	cols := r.renderer.Columns()
+	keys := make([][]string, len(resources))
+	for i, res := range resources {
+		keys[i] = r.rows.searchKeys(r.renderer, cols, res)
+	}
+	return keys
+}
+
+// matchSearchKeys returns the resources with a search key fuzzy-matching
+// filter, which must be lowercase. keys[i] belongs to resources[i]; it only
+// reads its arguments, so it can run off the UI goroutine.
+func matchSearchKeys(resources []dao.Resource, keys [][]string, filter string) []dao.Resource {
+	var matched []dao.Resource
+	for i, res := range resources {
+		if i >= len(keys) {
+			// No renderer: match ID and name only
+			if fuzzyMatch(res.GetID(), filter) || fuzzyMatch(res.GetName(), filter) {
+				matched = append(matched, res)
+			}
+			continue
+		}
+		for _, key := range keys[i] {
+			if fuzzyMatchLower(key, filter) {
+				matched = append(matched, res)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// scheduleFilter filters again once typing in the filter pauses for
+// filterDebounce, so a burst of keys over a large list matches once.
+func (r *ResourceBrowser) scheduleFilter() tea.Cmd {
+	r.filterSeq++
+	owner, seq := r, r.filterSeq
+	return tea.Tick(filterDebounce, func(time.Time) tea.Msg {
+		return filterDebounceMsg{owner: owner, seq: seq}
+	})
+}
+
+// handleFilterDebounce starts matching the current filter text in a command,
+// keeping the UI responsive on very large lists.
+func (r *ResourceBrowser) handleFilterDebounce(msg filterDebounceMsg) (tea.Model, tea.Cmd) {
+	if msg.owner != r || msg.seq != r.filterSeq {
+		return r, nil
+	}
+	if r.filterText == "" {
+		r.applyFilter()
+		r.buildTable()
+		return r, nil
+	}
+
+	// Sorting reorders r.resources in place, so match against a copy
+	working := slices.Clone(r.scopedResources())
+	keys := r.searchKeys(working)
+	filter := strings.ToLower(r.filterText)
+	owner, seq := r, r.filterSeq
+	return r, func() tea.Msg {
+		return filterResultMsg{owner: owner, seq: seq, filtered: matchSearchKeys(working, keys, filter)}
+	}
+}
+
+func (r *ResourceBrowser) handleFilterResult(msg filterResultMsg) (tea.Model, tea.Cmd) {
+	if msg.owner != r || msg.seq != r.filterSeq {
+		return r, nil
+	}
+	r.setFiltered(msg.filtered)
+	r.buildTable()
+	return r, nil
+}
+
 // matchesTagFilter checks if a resource matches the tag filter.
 func (r *ResourceBrowser) matchesTagFilter(res dao.Resource, tagFilter string) bool {
 	return filter.MatchesTagFilter(res.GetTags(), tagFilter)
@@ -98,7 +192,7 @@ func (r *ResourceBrowser) matchesFieldFilter(res dao.Resource) bool {
 	// Extract resource name from ARN if the filter value is an ARN
 	// e.g., "arn:aws:iam::123456789012:role/MyRole" -> "MyRole"
 	// This handles cases where ID is the resource name (e.g., IAM Role)
-	if strings.HasPrefix(filterValue, "arn:aws:") {
+	if appaws.IsARN(filterValue) {
 		extractedName := appaws.ExtractResourceName(filterValue)
 		if res.GetID() == extractedName || res.GetName() == extractedName {
 			return true
@@ -125,27 +219,6 @@ func (r *ResourceBrowser) matchesFieldFilter(res dao.Resource) bool {
 	return fieldValue == filterValue
 }
 
-// matchesFilter checks if a resource matches the text filter
-func (r *ResourceBrowser) matchesFilter(res dao.Resource, cols []render.Column, filter string) bool {
-	// Always check ID and Name as fallback (fuzzy match)
-	if fuzzyMatch(res.GetID(), filter) || fuzzyMatch(res.GetName(), filter) {
-		return true
-	}
-
-	unwrapped := dao.UnwrapResource(res)
-
-	// Check all column values (fuzzy match)
-	for _, col := range cols {
-		if col.Getter != nil {
-			if fuzzyMatch(col.Getter(unwrapped), filter) {
-				return true
-			}
-		}
-	}
-
-	return false
-}
-
 // getFieldValue extracts a field value from an AWS resource using reflection
 func getFieldValue(data any, fieldName string) string {
 	if data == nil {
@@ -1,10 +1,14 @@
 package view
 
 import (
+	"slices"
+
 	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/ai"
+	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/clipboard"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -15,6 +19,13 @@ func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return r.handleFilterInput(msg)
 	}
 
+	// A pending prefix takes the next key before navigation shortcuts do
+	if r.vim.active() {
+		if model, cmd, ok := r.handleVimKey(msg); ok {
+			return model, cmd
+		}
+	}
+
 	if len(r.filtered) > 0 && r.tc.Cursor() < len(r.filtered) {
 		if nav, cmd := r.handleNavigation(msg.String()); cmd != nil {
 			return nav, cmd
@@ -25,6 +36,10 @@ func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return model, cmd
 	}
 
+	if model, cmd, ok := r.handleVimKey(msg); ok {
+		return model, cmd
+	}
+
 	switch msg.String() {
 	case "/":
 		r.filterActive = true
@@ -36,28 +51,32 @@ func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return r.handleClearFilter()
 	case "esc":
 		return r.handleEsc()
-	case "m":
-		return r.handleMark()
 	case "M":
 		return r.handleMetricsToggle()
 	case "d", "enter":
 		return r.handleEnter()
 	case "a":
 		return r.handleAction()
+	case "m":
+		r.markPrev, r.markCursor = r.markedResource, r.tc.Cursor()
+		_, cmd := r.handleMark()
+		return r, tea.Batch(cmd, r.vim.beginMark())
 	case "tab":
 		r.cycleResourceType(1)
 		return r, tea.Batch(r.loadResources, r.spinner.Tick)
 	case "shift+tab":
 		r.cycleResourceType(-1)
 		return r, tea.Batch(r.loadResources, r.spinner.Tick)
-	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
-		return r.handleNumberKey(msg.String())
 	case "N":
 		return r.handleLoadNextPage()
 	case "y":
 		return r.handleCopyID()
 	case "Y":
 		return r.handleCopyARN()
+	case "+":
+		return r.handleAIPin()
+	case "!":
+		return r, showScopeErrors(r.service+"/"+r.resourceType, r.partialErrors)
 	case "j", "down":
 		r.tc.SetCursor(r.tc.Cursor()+1, len(r.filtered))
 		r.tc.UpdateScrollOffset(len(r.filtered))
@@ -110,9 +129,10 @@ func (r *ResourceBrowser) handleFilterInput(msg tea.KeyPressMsg) (tea.Model, tea
 	default:
 		var cmd tea.Cmd
 		r.filterInput, cmd = r.filterInput.Update(msg)
-		r.filterText = r.filterInput.Value()
-		r.applyFilter()
-		r.buildTable()
+		if value := r.filterInput.Value(); value != r.filterText {
+			r.filterText = value
+			return r, tea.Batch(cmd, r.scheduleFilter())
+		}
 		return r, cmd
 	}
 }
@@ -194,7 +214,7 @@ func (r *ResourceBrowser) handleEnter() (tea.Model, tea.Cmd) {
 func (r *ResourceBrowser) handleAction() (tea.Model, tea.Cmd) {
 	cursor := r.tc.Cursor()
 	if len(r.filtered) > 0 && cursor >= 0 && cursor < len(r.filtered) {
-		if actions := action.Global.Get(r.service, r.resourceType); len(actions) > 0 {
+		if actions := action.Global.ForResource(r.service, r.resourceType); len(actions) > 0 {
 			ctx, resource := r.contextForResource(r.filtered[cursor])
 			actionMenu := NewActionMenu(ctx, dao.UnwrapResource(resource), r.service, r.resourceType)
 			return r, func() tea.Msg {
@@ -213,6 +233,7 @@ func (r *ResourceBrowser) handleNumberKey(key string) (tea.Model, tea.Cmd) {
 		r.filterText = ""
 		r.filterInput.SetValue("")
 		r.markedResource = nil
+		r.vim.reset()
 		r.metricsEnabled = false
 		r.metricsData = nil
 		return r, tea.Batch(r.loadResources, r.spinner.Tick)
@@ -311,6 +332,7 @@ func (r *ResourceBrowser) switchToTab(idx int) (tea.Model, tea.Cmd) {
 	}
 	r.resourceType = r.resourceTypes[idx]
 	r.markedResource = nil
+	r.vim.reset()
 	r.metricsEnabled = false
 	r.metricsData = nil
 	return r, r.loadResources
@@ -337,6 +359,53 @@ func (r *ResourceBrowser) handleCopyID() (tea.Model, tea.Cmd) {
 	return r, nil
 }
 
+// AIPinToggledMsg reports a resource pinned into or unpinned from the AI
+// chat context; Count is the number now pinned.
+type AIPinToggledMsg struct {
+	Label  string
+	Pinned bool
+	Count  int
+}
+
+// handleAIPin pins the row under the cursor into the AI chat context, or
+// unpins it.
+func (r *ResourceBrowser) handleAIPin() (tea.Model, tea.Cmd) {
+	cursor := r.tc.Cursor()
+	if len(r.filtered) == 0 || cursor < 0 || cursor >= len(r.filtered) {
+		return r, nil
+	}
+	p := r.aiPin(r.filtered[cursor])
+	pinned, err := ai.TogglePin(p)
+	if err != nil {
+		return r, func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	r.buildTable()
+	label := p.Name
+	if label == "" {
+		label = p.ID
+	}
+	return r, func() tea.Msg { return AIPinToggledMsg{Label: label, Pinned: pinned, Count: len(ai.Pinned())} }
+}
+
+// aiPin describes res as a pin in the AI chat context.
+func (r *ResourceBrowser) aiPin(res dao.Resource) ai.PinnedResource {
+	unwrapped := dao.UnwrapResource(res)
+	p := ai.PinnedResource{
+		Service:      r.service,
+		ResourceType: r.resourceType,
+		ResourceRef: ai.ResourceRef{
+			ID:      unwrapped.GetID(),
+			Name:    unwrapped.GetName(),
+			Region:  dao.GetResourceRegion(res),
+			Profile: dao.GetResourceProfile(res),
+		},
+	}
+	if clusterArn := dao.GetResourceClusterArn(res); clusterArn != "" {
+		p.Cluster = appaws.ExtractResourceName(clusterArn)
+	}
+	return p
+}
+
 func (r *ResourceBrowser) handleCopyARN() (tea.Model, tea.Cmd) {
 	cursor := r.tc.Cursor()
 	if len(r.filtered) > 0 && cursor >= 0 && cursor < len(r.filtered) {
@@ -366,3 +435,62 @@ func (r *ResourceBrowser) handleToggleKey(key string) (tea.Model, tea.Cmd) {
 	}
 	return nil, nil
 }
+
+// handleVimKey applies count prefixes and jump marks. A tab digit followed
+// by an unrelated key switches resource types before the key runs.
+func (r *ResourceBrowser) handleVimKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd, bool) {
+	res, cmd := r.vim.handle(msg.String(), r.vimAmbiguous)
+	switch res.action {
+	case vimConsumed:
+		return r, cmd, true
+	case vimMove:
+		r.tc.SetCursor(r.tc.Cursor()+res.count, len(r.filtered))
+	case vimGoto:
+		r.tc.SetCursor(res.count-1, len(r.filtered))
+	case vimSetMark:
+		if r.tc.Cursor() != r.markCursor {
+			// The cursor moved since "m": the key is not a mark name
+			model, keyCmd := r.handleKeyPress(msg)
+			return model, keyCmd, true
+		}
+		r.markedResource = r.markPrev
+		if sel := r.SelectedResource(); sel != nil {
+			setSessionMark(r.markScope(), res.mark, sel.GetID())
+		}
+	case vimJump:
+		id, ok := sessionMark(r.markScope(), res.mark)
+		if !ok {
+			return r, nil, true
+		}
+		idx := slices.IndexFunc(r.filtered, func(res dao.Resource) bool { return res.GetID() == id })
+		if idx < 0 {
+			return r, nil, true
+		}
+		r.tc.SetCursor(idx, len(r.filtered))
+	default:
+		if res.flush == "" {
+			return nil, nil, false
+		}
+		_, flushCmd := r.handleNumberKey(res.flush)
+		_, keyCmd := r.handleKeyPress(msg)
+		return r, tea.Batch(flushCmd, keyCmd), true
+	}
+	r.tc.UpdateScrollOffset(len(r.filtered))
+	r.buildTable()
+	return r, nil, true
+}
+
+// vimAmbiguous reports whether a prefix key also acts on its own: "m" marks
+// for comparison and 1-9 switch resource types.
+func (r *ResourceBrowser) vimAmbiguous(key string) bool {
+	if key == "m" {
+		return true
+	}
+	idx := int(key[0] - '1')
+	return len(r.resourceTypes) > 1 && idx >= 0 && idx < len(r.resourceTypes)
+}
+
+// markScope keys jump marks per resource type for the session
+func (r *ResourceBrowser) markScope() string {
+	return r.service + "/" + r.resourceType
+}
@@ -92,8 +92,9 @@ func (r *ResourceBrowser) getMetricSpec() *render.MetricSpec {
 	if r.renderer == nil {
 		return nil
 	}
+	var base *render.MetricSpec
 	if provider, ok := r.renderer.(render.MetricSpecProvider); ok {
-		return provider.MetricSpec()
+		base = provider.MetricSpec()
 	}
-	return nil
+	return metrics.SpecFor(r.service+"/"+r.resourceType, base)
 }
@@ -51,24 +51,36 @@ func (r *ResourceBrowser) cycleResourceType(delta int) {
 	r.filterText = ""
 	r.filterInput.SetValue("")
 	r.markedResource = nil
+	r.vim.reset()
 	r.metricsEnabled = false
 	r.metricsData = nil
 }
 
 // StatusLine implements View interface
+// ItemCount implements ItemCounter
+func (r *ResourceBrowser) ItemCount() (shown, total int) {
+	return len(r.filtered), len(r.resources)
+}
+
 func (r *ResourceBrowser) StatusLine() string {
 	if r.filterActive {
 		return fmt.Sprintf("/%s • %d/%d items • Esc:done Enter:apply", r.filterInput.Value(), len(r.filtered), len(r.resources))
 	}
 
+	if ind := r.vim.indicator(); ind != "" {
+		return fmt.Sprintf("%s/%s • %s… (Esc:cancel)", r.service, r.resourceType, ind)
+	}
+
 	total := len(r.resources)
 	shown := len(r.filtered)
-	hasActions := len(action.Global.Get(r.service, r.resourceType)) > 0
+	hasActions := len(action.Global.ForResource(r.service, r.resourceType)) > 0
 
 	// Build auto-reload info
 	autoReloadInfo := ""
 	if r.autoReload {
 		autoReloadInfo = fmt.Sprintf(" (auto-refresh: %s)", r.autoReloadInterval)
+	} else if r.transientReload {
+		autoReloadInfo = fmt.Sprintf(" (auto-refresh: %s while changing)", DefaultAutoReloadInterval)
 	}
 
 	// Build filter info
@@ -111,10 +123,7 @@ func (r *ResourceBrowser) StatusLine() string {
 		}
 	}
 
-	partialWarn := ""
-	if len(r.partialErrors) > 0 {
-		partialWarn = fmt.Sprintf(" ⚠%d region(s) failed", len(r.partialErrors))
-	}
+	partialWarn := partialErrorsHint(r.partialErrors)
 
 	if r.filterText != "" || filterInfo != "" {
 		base := fmt.Sprintf("%s/%s%s%s%s%s%s%s • %d/%d items • c:clear", r.service, r.resourceType, filterInfo, sortInfo, markInfo, toggleInfo, autoReloadInfo, partialWarn, shown, total)
@@ -172,6 +181,11 @@ func (r *ResourceBrowser) ResourceType() string {
 	return r.resourceType
 }
 
+// FieldFilter returns the parent field filter and value, if any
+func (r *ResourceBrowser) FieldFilter() (string, string) {
+	return r.fieldFilter, r.fieldFilterValue
+}
+
 func (r *ResourceBrowser) SelectedResource() dao.Resource {
 	if len(r.filtered) == 0 {
 		return nil
@@ -219,3 +233,68 @@ func (r *ResourceBrowser) getToggleInfo() string {
 	}
 	return " [" + strings.Join(parts, " ") + "]"
 }
+
+// KeyHelp implements KeyHelper
+func (r *ResourceBrowser) KeyHelp() []KeyHelpSection {
+	sections := []KeyHelpSection{
+		{
+			Title: "Resource Browser",
+			Bindings: []KeyBinding{
+				{"↑/k, ↓/j", "Move cursor up/down"},
+				{"g, G", "Go to top / bottom"},
+				{"Ctrl+d, Ctrl+u", "Page down / up"},
+				{"{n}j, {n}k", "Move n rows (e.g. 10j)"},
+				{"{n}G", "Go to row n"},
+				{"m{a-z}", "Set a jump mark (kept per resource type)"},
+				{"`{a-z}", "Jump to mark"},
+				{"Enter/d", "View details"},
+				{"Tab", "Next resource type"},
+				{"Shift+Tab", "Previous resource type"},
+				{"1-9", "Switch to resource type"},
+				{"/", "Filter resources"},
+				{"c", "Clear filter"},
+				{"Ctrl+r", "Refresh resources"},
+				{"N", "Load next page"},
+				{"M", "Toggle metrics"},
+				{"a", "Show actions menu"},
+				{"y", "Copy resource ID to clipboard"},
+				{"Y", "Copy resource ARN to clipboard"},
+				{"+", "Pin / unpin in the AI chat context"},
+				{"!", "Show profiles/regions that failed to load"},
+			},
+		},
+		{
+			Title: "Compare Resources",
+			Bindings: []KeyBinding{
+				{"m", "Mark resource for comparison"},
+				{"d", "Compare with marked resource"},
+				{":diff name", "Compare current row with named resource"},
+				{":diff a b", "Compare two named resources"},
+			},
+		},
+		{
+			Title: "Tag Commands",
+			Bindings: []KeyBinding{
+				{":tag key=val", "Filter by tag (exact)"},
+				{":tag key", "Filter by tag key exists"},
+				{":tag key~val", "Filter by tag (partial match)"},
+				{":tag", "Clear tag filter"},
+				{":sort Name", "Sort by column"},
+			},
+		},
+	}
+
+	if toggler, ok := r.renderer.(render.Toggler); ok {
+		sec := KeyHelpSection{Title: "Toggles"}
+		for _, t := range toggler.ListToggles() {
+			sec.Bindings = append(sec.Bindings, KeyBinding{t.Key, t.LabelOff + " / " + t.LabelOn})
+		}
+		sections = append(sections, sec)
+	}
+
+	selected := r.SelectedResource()
+	return append(sections,
+		navigationKeyHelp(r.renderer, selected),
+		actionKeyHelp(r.service, r.resourceType, selected),
+	)
+}
@@ -1,8 +1,14 @@
 package view
 
 import (
+	"maps"
+	"runtime"
+	"strings"
+	"sync"
+
 	"charm.land/lipgloss/v2/table"
 
+	"github.com/clawscli/claws/internal/ai"
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/metrics"
@@ -14,8 +20,114 @@ const (
 	profileColWidth = 16
 	accountColWidth = 14
 	regionColWidth  = 14
+
+	// parallelRowThreshold is the number of rows above which renderRows
+	// spreads rendering across goroutines
+	parallelRowThreshold = 1000
 )
 
+// renderedRow is a resource's rendered cells and its lowercase search keys
+type renderedRow struct {
+	cells []string
+	keys  []string
+}
+
+// rowCells maps each resource to the row its renderer produced. Resources
+// are pointers, so a refreshed resource is a new key.
+type rowCells map[dao.Resource]renderedRow
+
+// renderResourceRow renders res and the keys the "/" filter matches against:
+// its ID, name and every column value, lowercased once here rather than on
+// every keystroke.
+func renderResourceRow(renderer render.Renderer, cols []render.Column, res dao.Resource) renderedRow {
+	unwrapped := dao.UnwrapResource(res)
+	keys := make([]string, 0, len(cols)+2)
+	keys = append(keys, strings.ToLower(res.GetID()), strings.ToLower(res.GetName()))
+	for _, col := range cols {
+		if col.Getter != nil {
+			keys = append(keys, strings.ToLower(col.Getter(unwrapped)))
+		}
+	}
+	return renderedRow{cells: renderer.RenderRow(unwrapped, cols), keys: keys}
+}
+
+// renderRows renders every resource once. Large lists are rendered in
+// parallel chunks; it runs in the load command, off the UI goroutine.
+func renderRows(renderer render.Renderer, resources []dao.Resource) rowCells {
+	if renderer == nil || len(resources) == 0 {
+		return nil
+	}
+	cols := renderer.Columns()
+	rendered := make([]renderedRow, len(resources))
+	renderChunk := func(lo, hi int) {
+		for i := lo; i < hi; i++ {
+			rendered[i] = renderResourceRow(renderer, cols, resources[i])
+		}
+	}
+
+	if len(resources) < parallelRowThreshold {
+		renderChunk(0, len(resources))
+	} else {
+		chunk := (len(resources) + runtime.GOMAXPROCS(0) - 1) / runtime.GOMAXPROCS(0)
+		var wg sync.WaitGroup
+		for lo := 0; lo < len(resources); lo += chunk {
+			wg.Go(func() { renderChunk(lo, min(lo+chunk, len(resources))) })
+		}
+		wg.Wait()
+	}
+
+	rows := make(rowCells, len(resources))
+	for i, res := range resources {
+		rows[res] = rendered[i]
+	}
+	return rows
+}
+
+// rowCache holds the rendered rows of the loaded resources, so a cursor
+// move only restyles the visible rows and filtering does not call getters.
+// It is replaced on every data refresh.
+type rowCache struct {
+	renderer render.Renderer
+	rows     rowCells
+}
+
+// reset starts a cache for renderer seeded with rows
+func (c *rowCache) reset(renderer render.Renderer, rows rowCells) {
+	c.renderer = renderer
+	c.rows = rows
+}
+
+// add merges rows rendered for a further page
+func (c *rowCache) add(rows rowCells) {
+	if c.rows == nil {
+		c.rows = make(rowCells, len(rows))
+	}
+	maps.Copy(c.rows, rows)
+}
+
+// row returns the rendered row of res, rendering it if it is not cached
+func (c *rowCache) row(renderer render.Renderer, cols []render.Column, res dao.Resource) renderedRow {
+	if c.renderer != renderer || c.rows == nil {
+		c.reset(renderer, make(rowCells))
+	}
+	if row, ok := c.rows[res]; ok {
+		return row
+	}
+	row := renderResourceRow(renderer, cols, res)
+	c.rows[res] = row
+	return row
+}
+
+// cells returns the cells of res
+func (c *rowCache) cells(renderer render.Renderer, cols []render.Column, res dao.Resource) []string {
+	return c.row(renderer, cols, res).cells
+}
+
+// searchKeys returns the search keys of res
+func (c *rowCache) searchKeys(renderer render.Renderer, cols []render.Column, res dao.Resource) []string {
+	return c.row(renderer, cols, res).keys
+}
+
 func (r *ResourceBrowser) Cursor() int {
 	return r.tc.Cursor()
 }
@@ -97,6 +209,11 @@ func (r *ResourceBrowser) buildTable() {
 
 	widths := r.calculateColumnWidths(cols, isMultiProfile, isMultiRegion, effectiveMetricsEnabled, numCols)
 
+	// Only the visible rows are handed to the table, so long lists cost no
+	// more per keypress than short ones
+	offset := min(r.tc.ScrollOffset(), len(r.filtered))
+	visible := r.filtered[offset:min(offset+tableHeight, len(r.filtered))]
+
 	t := table.New().
 		Headers(headers...).
 		Width(r.width).
@@ -109,13 +226,15 @@ func (r *ResourceBrowser) buildTable() {
 		BorderColumn(false).
 		BorderHeader(true).
 		BorderStyle(TableBorderStyle()).
-		StyleFunc(NewTableStyleFunc(widths, cursor))
+		StyleFunc(NewTableStyleFunc(widths, cursor-offset))
 
-	for _, res := range r.filtered {
-		row := r.renderer.RenderRow(dao.UnwrapResource(res), cols)
+	for _, res := range visible {
+		row := r.rows.cells(r.renderer, cols, res)
 		mark := " "
 		if r.markedResource != nil && r.markedResource.GetID() == res.GetID() {
 			mark = "◆"
+		} else if ai.IsPinned(r.aiPin(res)) {
+			mark = "+"
 		}
 
 		fullRow := make([]string, numCols)
@@ -148,10 +267,6 @@ func (r *ResourceBrowser) buildTable() {
 		t = t.Row(fullRow...)
 	}
 
-	if r.tc.ScrollOffset() > 0 {
-		t = t.YOffset(r.tc.ScrollOffset())
-	}
-
 	r.tableContent = t.String()
 }
 
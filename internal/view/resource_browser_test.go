@@ -2,13 +2,17 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/clawscli/claws/internal/ai"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
 )
 
 func TestResourceBrowserFilterEsc(t *testing.T) {
@@ -290,8 +294,10 @@ func TestResourceBrowserMarkClearedOnResourceTypeSwitch(t *testing.T) {
 
 	// Switch with number key (simulated via direct resourceType change + clear)
 	// The actual key handling clears markedResource, so we test that path
+	// A lone tab digit may start a count, so it switches after the prefix timeout
 	numMsg := tea.KeyPressMsg{Code: '2'}
 	browser.Update(numMsg)
+	browser.Update(vimKeyTimeoutMsg{owner: &browser.vim, seq: browser.vim.seq})
 
 	if browser.markedResource != nil {
 		t.Error("Expected mark to be cleared after number key switch")
@@ -482,11 +488,9 @@ func TestFetchParallelBasic(t *testing.T) {
 	fetch := func(_ context.Context, k string) ([]dao.Resource, string, error) {
 		return []dao.Resource{&mockResource{id: k + "-1"}}, "", nil
 	}
-	formatError := func(k string, err error) string {
-		return k + ": " + err.Error()
-	}
+	scopeError := func(k string, err error) ScopeError { return ScopeError{Region: k, Err: err} }
 
-	result := fetchParallel(ctx, keys, fetch, formatError)
+	result := fetchParallel(ctx, keys, fetch, scopeError)
 
 	if len(result.resources) != 3 {
 		t.Errorf("got %d resources, want 3", len(result.resources))
@@ -506,9 +510,9 @@ func TestFetchParallelWithPageTokens(t *testing.T) {
 		}
 		return []dao.Resource{&mockResource{id: "r2-item"}}, "", nil
 	}
-	formatError := func(k string, err error) string { return k + ": " + err.Error() }
+	scopeError := func(k string, err error) ScopeError { return ScopeError{Region: k, Err: err} }
 
-	result := fetchParallel(ctx, keys, fetch, formatError)
+	result := fetchParallel(ctx, keys, fetch, scopeError)
 
 	if len(result.resources) != 2 {
 		t.Errorf("got %d resources, want 2", len(result.resources))
@@ -531,9 +535,9 @@ func TestFetchParallelPartialErrors(t *testing.T) {
 		}
 		return []dao.Resource{&mockResource{id: k}}, "", nil
 	}
-	formatError := func(k string, err error) string { return k + ": " + err.Error() }
+	scopeError := func(k string, err error) ScopeError { return ScopeError{Region: k, Err: err} }
 
-	result := fetchParallel(ctx, keys, fetch, formatError)
+	result := fetchParallel(ctx, keys, fetch, scopeError)
 
 	if len(result.resources) != 2 {
 		t.Errorf("got %d resources, want 2", len(result.resources))
@@ -541,8 +545,8 @@ func TestFetchParallelPartialErrors(t *testing.T) {
 	if len(result.errors) != 1 {
 		t.Errorf("got %d errors, want 1", len(result.errors))
 	}
-	if !strings.Contains(result.errors[0], "fail") {
-		t.Errorf("error should mention 'fail', got: %s", result.errors[0])
+	if result.errors[0].Scope() != "fail" || result.errors[0].Code() != "timeout" {
+		t.Errorf("errors[0] = %v (code %s), want fail timeout", result.errors[0], result.errors[0].Code())
 	}
 }
 
@@ -554,9 +558,9 @@ func TestFetchParallelEmptyKeys(t *testing.T) {
 		t.Error("fetch should not be called for empty keys")
 		return nil, "", nil
 	}
-	formatError := func(k string, err error) string { return "" }
+	scopeError := func(k string, err error) ScopeError { return ScopeError{} }
 
-	result := fetchParallel(ctx, keys, fetch, formatError)
+	result := fetchParallel(ctx, keys, fetch, scopeError)
 
 	if len(result.resources) != 0 {
 		t.Errorf("got %d resources, want 0", len(result.resources))
@@ -573,9 +577,9 @@ func TestFetchParallelPreservesKeyOrder(t *testing.T) {
 	fetch := func(_ context.Context, k string) ([]dao.Resource, string, error) {
 		return []dao.Resource{&mockResource{id: k}}, k + "-token", nil
 	}
-	formatError := func(k string, err error) string { return "" }
+	scopeError := func(k string, err error) ScopeError { return ScopeError{} }
 
-	result := fetchParallel(ctx, keys, fetch, formatError)
+	result := fetchParallel(ctx, keys, fetch, scopeError)
 
 	if len(result.resources) != 3 {
 		t.Fatalf("got %d resources, want 3", len(result.resources))
@@ -594,9 +598,9 @@ func TestFetchParallelAllErrors(t *testing.T) {
 	fetch := func(_ context.Context, k string) ([]dao.Resource, string, error) {
 		return nil, "", context.DeadlineExceeded
 	}
-	formatError := func(k string, err error) string { return k + ": timeout" }
+	scopeError := func(k string, err error) ScopeError { return ScopeError{Region: k, Err: err} }
 
-	result := fetchParallel(ctx, keys, fetch, formatError)
+	result := fetchParallel(ctx, keys, fetch, scopeError)
 
 	if len(result.resources) != 0 {
 		t.Errorf("got %d resources, want 0", len(result.resources))
@@ -632,6 +636,45 @@ func TestResourceBrowserCopyID(t *testing.T) {
 	}
 }
 
+func TestResourceBrowserAIPin(t *testing.T) {
+	ai.ClearPins()
+	t.Cleanup(ai.ClearPins)
+
+	browser := NewResourceBrowser(context.Background(), registry.New(), "ec2")
+	browser.SetSize(100, 50)
+	browser.renderer = &mockRenderer{detail: "test"}
+	browser.loading = false
+
+	browser.resources = []dao.Resource{
+		&mockResource{id: "i-1", name: "instance-1"},
+		&mockResource{id: "i-2", name: "instance-2"},
+	}
+	browser.applyFilter()
+	browser.buildTable()
+	browser.SetCursor(1)
+
+	_, cmd := browser.Update(tea.KeyPressMsg{Code: '+', Text: "+"})
+	if cmd == nil {
+		t.Fatal("Expected cmd from '+' key press")
+	}
+	msg, ok := cmd().(AIPinToggledMsg)
+	if !ok || !msg.Pinned || msg.Label != "instance-2" || msg.Count != 1 {
+		t.Fatalf("msg = %+v, want instance-2 pinned", msg)
+	}
+	pinned := ai.Pinned()
+	if len(pinned) != 1 || pinned[0].ID != "i-2" || pinned[0].Service != "ec2" {
+		t.Fatalf("Pinned() = %+v", pinned)
+	}
+	if !strings.Contains(browser.ViewString(), "+") {
+		t.Error("Expected pin indicator '+' in view")
+	}
+
+	_, cmd = browser.Update(tea.KeyPressMsg{Code: '+', Text: "+"})
+	if msg, _ := cmd().(AIPinToggledMsg); msg.Pinned || len(ai.Pinned()) != 0 {
+		t.Errorf("second '+' should unpin, got %+v with %d pinned", msg, len(ai.Pinned()))
+	}
+}
+
 func TestResourceBrowserCopyARN(t *testing.T) {
 	ctx := context.Background()
 	reg := registry.New()
@@ -694,3 +737,162 @@ func TestResourceBrowserCopyEmptyList(t *testing.T) {
 		t.Error("Expected nil cmd for 'Y' on empty list")
 	}
 }
+
+// transitioningRenderer asks for auto-reload while any resource is named "busy".
+type transitioningRenderer struct {
+	mockRenderer
+}
+
+func (r *transitioningRenderer) NeedsAutoReload(resources []dao.Resource) bool {
+	for _, res := range resources {
+		if res.GetName() == "busy" {
+			return true
+		}
+	}
+	return false
+}
+
+func TestResourceBrowserTransientAutoReload(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+
+	browser := NewResourceBrowser(ctx, reg, "elbv2")
+	browser.SetSize(100, 50)
+	renderer := &transitioningRenderer{}
+
+	_, cmd := browser.Update(resourcesLoadedMsg{
+		renderer:  renderer,
+		resources: []dao.Resource{&mockResource{id: "t-1", name: "busy"}},
+	})
+	if !browser.transientReload || cmd == nil {
+		t.Fatal("Expected a reload tick while a resource is transitioning")
+	}
+	if !strings.Contains(browser.StatusLine(), "auto-refresh") {
+		t.Errorf("Expected status line to mention auto-refresh, got %q", browser.StatusLine())
+	}
+
+	// A manual refresh while a tick is pending must not start a second chain
+	browser.Update(resourcesLoadedMsg{
+		renderer:  renderer,
+		resources: []dao.Resource{&mockResource{id: "t-1", name: "busy"}},
+	})
+	if cmd := browser.transientTickCmd(); cmd != nil {
+		t.Error("Expected no second tick while one is pending")
+	}
+
+	browser.Update(autoReloadTickMsg{})
+	_, cmd = browser.Update(resourcesLoadedMsg{
+		renderer:  renderer,
+		resources: []dao.Resource{&mockResource{id: "t-1", name: "idle"}},
+	})
+	if browser.transientReload || cmd != nil {
+		t.Error("Expected auto-reload to stop once nothing is transitioning")
+	}
+}
+
+// countingRenderer counts RenderRow calls
+type countingRenderer struct {
+	mockRenderer
+	calls atomic.Int64
+}
+
+func (c *countingRenderer) RenderRow(r dao.Resource, cols []render.Column) []string {
+	c.calls.Add(1)
+	return c.mockRenderer.RenderRow(r, cols)
+}
+
+func TestRenderRowsParallel(t *testing.T) {
+	renderer := &countingRenderer{}
+	resources := make([]dao.Resource, parallelRowThreshold*3+7)
+	for i := range resources {
+		resources[i] = &mockResource{id: fmt.Sprint(i), name: fmt.Sprintf("res-%d", i)}
+	}
+
+	rows := renderRows(renderer, resources)
+	if got := renderer.calls.Load(); got != int64(len(resources)) {
+		t.Errorf("RenderRow called %d times, want %d", got, len(resources))
+	}
+	for i, res := range resources {
+		if cells := rows[res].cells; len(cells) != 1 || cells[0] != fmt.Sprintf("res-%d", i) {
+			t.Fatalf("rows[%d] = %v", i, cells)
+		}
+	}
+}
+
+func TestResourceBrowserCursorMoveUsesCachedRows(t *testing.T) {
+	browser := NewResourceBrowser(context.Background(), registry.New(), "ec2")
+	browser.SetSize(100, 30)
+	renderer := &countingRenderer{}
+	resources := make([]dao.Resource, 5000)
+	for i := range resources {
+		resources[i] = &mockResource{id: fmt.Sprint(i), name: fmt.Sprintf("res-%d", i)}
+	}
+	browser.Update(resourcesLoadedMsg{renderer: renderer, resources: resources, rows: renderRows(renderer, resources)})
+	loaded := renderer.calls.Load()
+
+	for range 100 {
+		browser.Update(tea.KeyPressMsg{Code: 'j'})
+	}
+	if got := renderer.calls.Load(); got != loaded {
+		t.Errorf("cursor moves rendered %d rows again, want 0", got-loaded)
+	}
+	if !strings.Contains(browser.tableContent, "res-95") {
+		t.Errorf("table should show the rows around the cursor:\n%s", browser.tableContent)
+	}
+	if strings.Contains(browser.tableContent, "res-0 ") {
+		t.Errorf("table should only hold the visible rows:\n%s", browser.tableContent)
+	}
+}
+
+func TestResourceBrowserFilterDebounce(t *testing.T) {
+	browser := NewResourceBrowser(context.Background(), registry.New(), "ec2")
+	browser.SetSize(100, 50)
+	renderer := &mockRenderer{}
+	resources := []dao.Resource{
+		&mockResource{id: "i-1", name: "Web-Prod"},
+		&mockResource{id: "i-2", name: "api-prod"},
+		&mockResource{id: "i-3", name: "web-dev"},
+	}
+	browser.Update(resourcesLoadedMsg{renderer: renderer, resources: resources, rows: renderRows(renderer, resources)})
+	browser.filterActive = true
+	browser.filterInput.Focus()
+
+	var cmds []tea.Cmd
+	for _, r := range "web" {
+		_, cmd := browser.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+		cmds = append(cmds, cmd)
+	}
+	if len(browser.filtered) != 3 {
+		t.Fatalf("typing should not filter before the debounce, got %d rows", len(browser.filtered))
+	}
+
+	// Only the last keystroke's timer matches
+	stale := filterDebounceMsg{owner: browser, seq: browser.filterSeq - 1}
+	if _, cmd := browser.Update(stale); cmd != nil {
+		t.Error("a stale debounce should be ignored")
+	}
+	_, cmd := browser.Update(filterDebounceMsg{owner: browser, seq: browser.filterSeq})
+	if cmd == nil {
+		t.Fatal("the debounce should start matching")
+	}
+	result, ok := cmd().(filterResultMsg)
+	if !ok {
+		t.Fatalf("expected filterResultMsg, got %T", cmd())
+	}
+	browser.Update(result)
+
+	var names []string
+	for _, res := range browser.filtered {
+		names = append(names, res.GetName())
+	}
+	if got := strings.Join(names, ","); got != "Web-Prod,web-dev" {
+		t.Errorf("filtered = %s, want Web-Prod,web-dev", got)
+	}
+
+	// A reload while matching supersedes the result
+	browser.Update(resourcesLoadedMsg{renderer: renderer, resources: resources[:1], rows: renderRows(renderer, resources[:1])})
+	browser.Update(result)
+	if len(browser.filtered) != 1 {
+		t.Errorf("a result for old data should be dropped, got %d rows", len(browser.filtered))
+	}
+}
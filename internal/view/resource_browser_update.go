@@ -1,6 +1,8 @@
 package view
 
 import (
+	"fmt"
+
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/dao"
@@ -17,12 +19,17 @@ func (r *ResourceBrowser) handleResourcesLoaded(msg resourcesLoadedMsg) (tea.Mod
 	r.nextMultiPageTokens = msg.nextMultiPageTokens
 	r.hasMorePages = msg.hasMorePages
 	r.partialErrors = msg.partialErrors
+	r.rows.reset(msg.renderer, msg.rows)
 	r.applyFilter()
 	r.buildTable()
 
 	var cmds []tea.Cmd
 	if r.autoReload {
 		cmds = append(cmds, r.tickCmd())
+	} else if r.transientReload = r.needsTransientReload(); r.transientReload {
+		if cmd := r.transientTickCmd(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 	}
 	if r.metricsEnabled && r.metricsLoading {
 		cmds = append(cmds, r.loadMetricsCmd())
@@ -40,6 +47,8 @@ func (r *ResourceBrowser) handleNextPageLoaded(msg nextPageLoadedMsg) (tea.Model
 	r.nextPageTokens = msg.nextPageTokens
 	r.nextMultiPageTokens = msg.nextMultiPageTokens
 	r.hasMorePages = msg.hasMorePages
+	r.partialErrors = append(r.partialErrors, msg.partialErrors...)
+	r.rows.add(msg.rows)
 	r.applyFilter()
 	r.buildTable()
 	return r, nil
@@ -60,6 +69,9 @@ func (r *ResourceBrowser) handleResourcesError(msg resourcesErrorMsg) (tea.Model
 	if r.autoReload {
 		return r, r.tickCmd()
 	}
+	if r.transientReload {
+		return r, r.transientTickCmd()
+	}
 	return r, nil
 }
 
@@ -78,6 +90,10 @@ func (r *ResourceBrowser) handleMetricsLoaded(msg metricsLoadedMsg) (tea.Model,
 }
 
 func (r *ResourceBrowser) handleAutoReloadTick() (tea.Model, tea.Cmd) {
+	r.reloadTickPending = false
+	if !r.autoReload && !r.transientReload {
+		return r, nil
+	}
 	if r.metricsEnabled && r.getMetricSpec() != nil {
 		return r, tea.Batch(r.reloadResources, r.loadMetricsCmd())
 	}
@@ -115,6 +131,39 @@ func (r *ResourceBrowser) handleTagFilterMsg(msg TagFilterMsg) (tea.Model, tea.C
 	return r, nil
 }
 
+func (r *ResourceBrowser) handleCopyAsMsg(msg CopyAsMsg) (tea.Model, tea.Cmd) {
+	if len(r.filtered) == 0 || r.tc.Cursor() >= len(r.filtered) {
+		return r, nil
+	}
+	return r, copyAsCmd(msg.Format, r.service, r.resourceType, r.filtered[r.tc.Cursor()])
+}
+
+func (r *ResourceBrowser) handleUsedByMsg() (tea.Model, tea.Cmd) {
+	if len(r.filtered) == 0 || r.tc.Cursor() >= len(r.filtered) {
+		return r, nil
+	}
+	return r, usedByCmd(r.ctx, r.registry, r.service, r.resourceType, r.filtered[r.tc.Cursor()])
+}
+
+func (r *ResourceBrowser) handleTraceMsg() (tea.Model, tea.Cmd) {
+	if len(r.filtered) == 0 || r.tc.Cursor() >= len(r.filtered) {
+		return r, nil
+	}
+	return r, traceCmd(r.ctx, r.service, r.resourceType, r.filtered[r.tc.Cursor()])
+}
+
+func (r *ResourceBrowser) handleCompareRegionsMsg(msg CompareRegionsMsg) (tea.Model, tea.Cmd) {
+	if r.fieldFilter != "" {
+		return r, func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("compare-regions is not available for %s/%s filtered by %s", r.service, r.resourceType, r.fieldFilter)}
+		}
+	}
+	compareView := NewRegionCompareView(r.ctx, r.registry, r.renderer, r.service, r.resourceType, msg.RegionA, msg.RegionB)
+	return r, func() tea.Msg {
+		return NavigateMsg{View: compareView}
+	}
+}
+
 func (r *ResourceBrowser) handleDiffMsg(msg DiffMsg) (tea.Model, tea.Cmd) {
 	var leftRes, rightRes dao.Resource
 
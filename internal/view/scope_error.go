@@ -0,0 +1,143 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ScopeError is a fetch that failed for one profile/region while the rest of
+// a multi-scope query succeeded.
+type ScopeError struct {
+	Profile string // empty for single-profile queries
+	Region  string
+	Err     error
+}
+
+// Scope returns "profile/region", or just the region
+func (e ScopeError) Scope() string {
+	if e.Profile == "" {
+		return e.Region
+	}
+	return e.Profile + "/" + e.Region
+}
+
+// Code returns the AWS error code, e.g. "AccessDeniedException", or
+// "timeout" / "error" for failures that never reached AWS
+func (e ScopeError) Code() string {
+	if code := apperrors.GetErrorCode(e.Err); code != "" {
+		return code
+	}
+	if errors.Is(e.Err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+	return "error"
+}
+
+func (e ScopeError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Scope(), e.Err)
+}
+
+// partialErrorsIndicator renders e.g. " !2" for the count line, or empty
+// string when every scope succeeded
+func partialErrorsIndicator(errs []ScopeError) string {
+	if len(errs) == 0 {
+		return ""
+	}
+	return ui.DangerStyle().Render(fmt.Sprintf(" !%d", len(errs)))
+}
+
+// partialErrorsHint is the status line note for failed scopes
+func partialErrorsHint(errs []ScopeError) string {
+	if len(errs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" ⚠%d scope(s) failed (!:errors)", len(errs))
+}
+
+// showScopeErrors opens the partial results panel, or does nothing when
+// every scope succeeded
+func showScopeErrors(title string, errs []ScopeError) tea.Cmd {
+	if len(errs) == 0 {
+		return nil
+	}
+	v := NewScopeErrorsView(title, errs)
+	return func() tea.Msg {
+		return ShowModalMsg{Modal: &Modal{Content: v, Width: ModalWidthScopeErrors}}
+	}
+}
+
+// ScopeErrorsView lists the profiles and regions that failed in a partial
+// result, with the AWS error code of each.
+type ScopeErrorsView struct {
+	title  string
+	errors []ScopeError
+	vp     ViewportState
+}
+
+// NewScopeErrorsView creates a ScopeErrorsView for the result titled title
+func NewScopeErrorsView(title string, errs []ScopeError) *ScopeErrorsView {
+	return &ScopeErrorsView{title: title, errors: errs}
+}
+
+func (v *ScopeErrorsView) Init() tea.Cmd {
+	return nil
+}
+
+func (v *ScopeErrorsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(ThemeChangedMsg); ok {
+		v.refreshContent()
+		return v, nil
+	}
+	if !v.vp.Ready {
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *ScopeErrorsView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *ScopeErrorsView) ViewString() string {
+	if !v.vp.Ready {
+		return v.buildContent()
+	}
+	return v.vp.Model.View()
+}
+
+func (v *ScopeErrorsView) SetSize(w, h int) tea.Cmd {
+	v.vp.SetSize(w, h)
+	v.refreshContent()
+	return nil
+}
+
+func (v *ScopeErrorsView) StatusLine() string {
+	return fmt.Sprintf("%d failed • Esc/q:close", len(v.errors))
+}
+
+func (v *ScopeErrorsView) refreshContent() {
+	if v.vp.Ready {
+		v.vp.Model.SetContent(v.buildContent())
+	}
+}
+
+func (v *ScopeErrorsView) buildContent() string {
+	d := render.NewDetailBuilder()
+	d.Title("Partial Results", v.title)
+	d.Dim(fmt.Sprintf("%d scope(s) failed; results from the others are shown.", len(v.errors)))
+	for _, e := range v.errors {
+		d.Section(e.Scope())
+		d.FieldStyled("Code", e.Code(), ui.DangerStyle())
+		d.Field("Error", apperrors.GetErrorMessage(e.Err))
+	}
+	return d.String()
+}
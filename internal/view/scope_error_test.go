@@ -0,0 +1,87 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/smithy-go"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestScopeError(t *testing.T) {
+	denied := &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "not authorized"}
+	tests := []struct {
+		err       ScopeError
+		wantScope string
+		wantCode  string
+	}{
+		{ScopeError{Profile: "prod", Region: "us-east-1", Err: denied}, "prod/us-east-1", "AccessDeniedException"},
+		{ScopeError{Region: "eu-west-1", Err: context.DeadlineExceeded}, "eu-west-1", "timeout"},
+		{ScopeError{Region: "ap-south-1", Err: errors.New("dial tcp: no route")}, "ap-south-1", "error"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Scope(); got != tt.wantScope {
+			t.Errorf("Scope() = %q, want %q", got, tt.wantScope)
+		}
+		if got := tt.err.Code(); got != tt.wantCode {
+			t.Errorf("Code() = %q, want %q", got, tt.wantCode)
+		}
+	}
+}
+
+func TestScopeErrorsView(t *testing.T) {
+	v := NewScopeErrorsView("ec2/instances", []ScopeError{
+		{Profile: "prod", Region: "us-east-1", Err: &smithy.GenericAPIError{Code: "UnauthorizedOperation", Message: "denied"}},
+		{Profile: "dev", Region: "eu-west-1", Err: context.DeadlineExceeded},
+	})
+	v.SetSize(80, 30)
+
+	out := v.ViewString()
+	for _, want := range []string{"prod/us-east-1", "UnauthorizedOperation", "denied", "dev/eu-west-1", "timeout"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestResourceBrowserPartialErrors(t *testing.T) {
+	browser := NewResourceBrowser(context.Background(), registry.New(), "ec2")
+	browser.SetSize(100, 50)
+	browser.renderer = &mockRenderer{detail: "test"}
+
+	if _, cmd := browser.Update(tea.KeyPressMsg{Code: '!'}); cmd != nil {
+		t.Error("'!' without failed scopes should do nothing")
+	}
+
+	errs := []ScopeError{{Region: "us-west-2", Err: errors.New("boom")}}
+	browser.Update(resourcesLoadedMsg{renderer: browser.renderer, partialErrors: errs})
+	if out := browser.ViewString(); !strings.Contains(out, "All 1 scope(s) failed") {
+		t.Errorf("empty result with errors should say so, got:\n%s", out)
+	}
+
+	browser.Update(resourcesLoadedMsg{
+		renderer:      browser.renderer,
+		resources:     []dao.Resource{&mockResource{id: "i-1", name: "web"}},
+		partialErrors: errs,
+	})
+	if out := browser.ViewString(); !strings.Contains(out, "!1") {
+		t.Errorf("view should flag the failed scope, got:\n%s", out)
+	}
+
+	_, cmd := browser.Update(tea.KeyPressMsg{Code: '!'})
+	if cmd == nil {
+		t.Fatal("'!' should open the partial results panel")
+	}
+	msg, ok := cmd().(ShowModalMsg)
+	if !ok {
+		t.Fatalf("expected ShowModalMsg, got %T", cmd())
+	}
+	if _, ok := msg.Modal.Content.(*ScopeErrorsView); !ok {
+		t.Errorf("modal content = %T, want *ScopeErrorsView", msg.Modal.Content)
+	}
+}
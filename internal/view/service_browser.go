@@ -623,3 +623,18 @@ func (s *ServiceBrowser) HasActiveInput() bool {
 func (s *ServiceBrowser) CanRefresh() bool {
 	return true
 }
+
+// KeyHelp implements KeyHelper
+func (s *ServiceBrowser) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{
+		Title: "Service Browser",
+		Bindings: []KeyBinding{
+			{"←/h, →/l", "Move within category"},
+			{"↑/k, ↓/j", "Move between categories"},
+			{"Enter", "Open service"},
+			{"/", "Filter services"},
+			{"c", "Clear filter"},
+			{"~", "Toggle Dashboard ↔ Services"},
+		},
+	}}
+}
@@ -120,6 +120,12 @@ func (s *SessionHistory) ViewString() string {
 			dateStr := sess.UpdatedAt.Format("2006-01-02 15:04")
 			msgCount := len(sess.Messages)
 			line := fmt.Sprintf("%s%s  (%d msgs)", prefix, dateStr, msgCount)
+			if sess.Usage != (ai.Usage{}) {
+				line += "  " + sess.Usage.String()
+				if sess.Cost > 0 {
+					line += " · " + ai.FormatCost(sess.Cost)
+				}
+			}
 
 			if sess.ID == s.currentID {
 				line += " " + s.styles.current.Render("*")
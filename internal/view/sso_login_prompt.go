@@ -0,0 +1,101 @@
+package view
+
+import (
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/config"
+	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// ModalWidthSSOLogin is the width of the SSO re-login prompt
+const ModalWidthSSOLogin = 55
+
+// SSOLoginPrompt offers to renew an SSO session that has expired or is about
+// to, before API calls start failing.
+type SSOLoginPrompt struct {
+	profile string
+	expires time.Time
+	now     func() time.Time
+	err     error
+}
+
+// NewSSOLoginPrompt creates a prompt for profile's SSO session expiring at expires
+func NewSSOLoginPrompt(profile string, expires time.Time) *SSOLoginPrompt {
+	return &SSOLoginPrompt{profile: profile, expires: expires, now: time.Now}
+}
+
+func (p *SSOLoginPrompt) Init() tea.Cmd {
+	return nil
+}
+
+func (p *SSOLoginPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case loginResultMsg:
+		if !msg.success {
+			p.err = msg.err
+			return p, nil
+		}
+		// Re-apply the current selection so views reload with fresh credentials
+		selections := config.Global().Selections()
+		return p, func() tea.Msg {
+			return navmsg.ProfilesChangedMsg{Selections: selections}
+		}
+
+	case tea.KeyPressMsg:
+		switch msg.String() {
+		case "enter", "l":
+			cmd, err := ssoLogin(p.profile)
+			if err != nil {
+				p.err = err
+				return p, nil
+			}
+			p.err = nil
+			return p, cmd
+		}
+	}
+	return p, nil
+}
+
+func (p *SSOLoginPrompt) View() tea.View {
+	return tea.NewView(p.ViewString())
+}
+
+func (p *SSOLoginPrompt) ViewString() string {
+	title := "SSO session expired"
+	if remaining := p.expires.Sub(p.now()); remaining > 0 {
+		title = "SSO session expires in " + FormatCountdown(remaining)
+	}
+
+	s := ui.BoldPendingStyle().Render("⚠ "+title) + "\n\n"
+	s += fmt.Sprintf("Profile: %s\n\n", p.profile)
+	s += "Log in again now to avoid failed requests.\n"
+	if p.err != nil {
+		s += "\n" + ui.DangerStyle().Render("✗ "+p.err.Error()) + "\n"
+	}
+	s += "\n" + ui.DimStyle().Render("Enter: aws sso login • Esc: later")
+	return s
+}
+
+func (p *SSOLoginPrompt) SetSize(_, _ int) tea.Cmd {
+	return nil
+}
+
+func (p *SSOLoginPrompt) StatusLine() string {
+	return "Enter:login Esc:later"
+}
+
+// FormatCountdown formats a remaining duration compactly, e.g. "2h05m", "12m", "<1m".
+func FormatCountdown(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "<1m"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	default:
+		return fmt.Sprintf("%dh%02dm", int(d/time.Hour), int(d%time.Hour/time.Minute))
+	}
+}
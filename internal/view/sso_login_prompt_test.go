@@ -0,0 +1,61 @@
+package view
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	navmsg "github.com/clawscli/claws/internal/msg"
+)
+
+func TestFormatCountdown(t *testing.T) {
+	tests := map[time.Duration]string{
+		30 * time.Second:              "<1m",
+		12*time.Minute + time.Second:  "12m",
+		2*time.Hour + 5*time.Minute:   "2h05m",
+		26*time.Hour + 59*time.Minute: "26h59m",
+	}
+	for d, want := range tests {
+		if got := FormatCountdown(d); got != want {
+			t.Errorf("FormatCountdown(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestSSOLoginPrompt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := NewSSOLoginPrompt("dev", now.Add(4*time.Minute))
+	p.now = func() time.Time { return now }
+
+	if s := p.ViewString(); !strings.Contains(s, "expires in 4m") || !strings.Contains(s, "dev") {
+		t.Errorf("ViewString() = %q, want countdown and profile", s)
+	}
+
+	p.now = func() time.Time { return now.Add(5 * time.Minute) }
+	if s := p.ViewString(); !strings.Contains(s, "SSO session expired") {
+		t.Errorf("ViewString() = %q, want expired title", s)
+	}
+
+	// A failed login keeps the prompt open with the error
+	p.Update(loginResultMsg{profileID: "dev", err: errors.New("boom")})
+	if s := p.ViewString(); !strings.Contains(s, "boom") {
+		t.Errorf("ViewString() = %q, want login error", s)
+	}
+
+	// A successful login reloads the current profile selection
+	_, cmd := p.Update(loginResultMsg{profileID: "dev", success: true})
+	if cmd == nil {
+		t.Fatal("expected command after successful login")
+	}
+	if _, ok := cmd().(navmsg.ProfilesChangedMsg); !ok {
+		t.Error("expected ProfilesChangedMsg after successful login")
+	}
+
+	// Other keys are ignored
+	if _, cmd := p.Update(tea.KeyPressMsg{Code: 'x', Text: "x"}); cmd != nil {
+		t.Error("expected no command for unrelated key")
+	}
+}
@@ -0,0 +1,188 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	retypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/filter"
+	"github.com/clawscli/claws/internal/log"
+)
+
+const (
+	// explorerPageSize is the Resource Explorer Search page size (API max: 1000).
+	explorerPageSize = 1000
+	// explorerGlobalRegion is how Resource Explorer reports global resources.
+	explorerGlobalRegion = "global"
+)
+
+// resolveExplorerRegion returns the region hosting the Resource Explorer
+// aggregator index, or "" when the tagging API should be used instead. With
+// the resource-explorer backend forced, a missing index is an error.
+func (v *TagSearchView) resolveExplorerRegion(ctx context.Context) (string, error) {
+	backend := config.File().TagSearchBackend()
+	if backend == config.TagSearchBackendTagging {
+		return "", nil
+	}
+
+	region, err := findAggregatorRegion(ctx)
+	if err == nil && region == "" {
+		err = fmt.Errorf("no Resource Explorer aggregator index")
+	}
+	if err != nil {
+		if backend == config.TagSearchBackendResourceExplorer {
+			return "", err
+		}
+		log.Debug("resource explorer unavailable, using tagging API", "error", err)
+		return "", nil
+	}
+	return region, nil
+}
+
+// findAggregatorRegion looks up the aggregator index, which can search
+// resources from every region with a local index.
+func findAggregatorRegion(ctx context.Context) (string, error) {
+	client, err := aws.Client(ctx, resourceexplorer2.NewFromConfig)
+	if err != nil {
+		return "", err
+	}
+	output, err := client.ListIndexes(ctx, &resourceexplorer2.ListIndexesInput{
+		Type: retypes.IndexTypeAggregator,
+	})
+	if err != nil {
+		return "", fmt.Errorf("list resource explorer indexes: %w", err)
+	}
+	for _, idx := range output.Indexes {
+		if r := aws.Str(idx.Region); r != "" {
+			return r, nil
+		}
+	}
+	return "", nil
+}
+
+// fetchExplorerResources runs a Resource Explorer search in the aggregator
+// region. Results outside the selected regions are dropped (global resources
+// are kept) and tag expressions are post-filtered like the tagging backend.
+func (v *TagSearchView) fetchExplorerResources(region, token string) fetchResult {
+	ctx, cancel := context.WithTimeout(v.ctx, config.File().TagSearchTimeout())
+	defer cancel()
+
+	regionCtx := aws.WithRegionOverride(ctx, region)
+	client, err := aws.Client(regionCtx, resourceexplorer2.NewFromConfig)
+	if err != nil {
+		return fetchResult{errors: []ScopeError{{Region: region, Err: err}}}
+	}
+
+	input := &resourceexplorer2.SearchInput{
+		QueryString: aws.StringPtr(v.explorerQuery()),
+		MaxResults:  aws.Int32Ptr(explorerPageSize),
+	}
+	if token != "" {
+		input.NextToken = aws.StringPtr(token)
+	}
+
+	output, err := client.Search(regionCtx, input)
+	if err != nil {
+		return fetchResult{errors: []ScopeError{{Region: region, Err: fmt.Errorf("resource explorer: %w", err)}}}
+	}
+
+	selected := config.Global().Regions()
+	if len(selected) == 0 {
+		selected = []string{config.Global().Region()}
+	}
+
+	resources := make([]taggedARN, 0, len(output.Resources))
+	for _, res := range output.Resources {
+		resRegion := aws.Str(res.Region)
+		if resRegion != "" && resRegion != explorerGlobalRegion && !slices.Contains(selected, resRegion) {
+			continue
+		}
+
+		tags := explorerTags(res.Properties)
+		if v.tagExpr != nil && !v.tagExpr.Match(tags) {
+			continue
+		}
+
+		rawARN := aws.Str(res.Arn)
+		if resRegion == explorerGlobalRegion {
+			resRegion = ""
+		}
+		resources = append(resources, taggedARN{
+			ARN:    aws.ParseARN(rawARN),
+			Region: resRegion,
+			Tags:   tags,
+			RawARN: rawARN,
+		})
+	}
+
+	pageTokens := make(map[string]string)
+	if output.NextToken != nil {
+		pageTokens[region] = *output.NextToken
+	}
+	return fetchResult{resources: resources, pageTokens: pageTokens}
+}
+
+// explorerQuery builds the Search query string. Free-text searches are passed
+// through; tag expressions push down a single condition, since Resource
+// Explorer ORs repeated tag: filters and AND semantics must be preserved.
+func (v *TagSearchView) explorerQuery() string {
+	if v.searchText != "" {
+		return v.searchText
+	}
+	conds := filter.ServerConditions(v.tagExpr)
+	if len(conds) == 0 {
+		return ""
+	}
+	return explorerCondition(conds[0])
+}
+
+func explorerCondition(c filter.TagCondition) string {
+	if len(c.Values) == 0 {
+		return "tag.key:" + quoteExplorerTerm(c.Key)
+	}
+	parts := make([]string, len(c.Values))
+	for i, val := range c.Values {
+		parts[i] = "tag:" + quoteExplorerTerm(c.Key+"="+val)
+	}
+	return strings.Join(parts, " ")
+}
+
+func quoteExplorerTerm(s string) string {
+	if strings.ContainsAny(s, " \t\"") {
+		return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+	}
+	return s
+}
+
+// explorerTags extracts tags from the "tags" resource property.
+func explorerTags(props []retypes.ResourceProperty) map[string]string {
+	tags := make(map[string]string)
+	for _, p := range props {
+		if aws.Str(p.Name) != "tags" || p.Data == nil {
+			continue
+		}
+		var kvs []struct {
+			Key   string `json:"Key"`
+			Value string `json:"Value"`
+		}
+		data, err := p.Data.MarshalSmithyDocument()
+		if err == nil {
+			err = json.Unmarshal(data, &kvs)
+		}
+		if err != nil {
+			log.Debug("failed to decode resource explorer tags", "error", err)
+			continue
+		}
+		for _, kv := range kvs {
+			tags[kv.Key] = kv.Value
+		}
+	}
+	return tags
+}
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/document"
+	retypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestTagSearchView_explorerQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		tagFilter string
+		search    string
+		want      string
+	}{
+		{"empty", "", "", ""},
+		{"free text", "", "bucket", "bucket"},
+		{"key only", "Team", "", "tag.key:Team"},
+		{"key value", "Environment=prod", "", "tag:Environment=prod"},
+		{"first condition only", "Environment=prod AND Team", "", "tag:Environment=prod"},
+		{"same-key or", "Env=dev OR Env=prod", "", "tag:Env=dev tag:Env=prod"},
+		{"quoted", `Name="web server"`, "", `tag:"Name=web server"`},
+		{"negation only", "!Deprecated", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v *TagSearchView
+			if tt.search != "" {
+				v = NewResourceSearchView(context.Background(), registry.New(), tt.search)
+			} else {
+				v = NewTagSearchView(context.Background(), registry.New(), tt.tagFilter)
+			}
+			if got := v.explorerQuery(); got != tt.want {
+				t.Errorf("explorerQuery() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExplorerTags(t *testing.T) {
+	props := []retypes.ResourceProperty{
+		{Name: aws.StringPtr("other"), Data: document.NewLazyDocument(map[string]any{"x": "y"})},
+		{Name: aws.StringPtr("tags"), Data: document.NewLazyDocument([]map[string]any{
+			{"Key": "Environment", "Value": "prod"},
+			{"Key": "Team", "Value": ""},
+		})},
+	}
+
+	tags := explorerTags(props)
+	if len(tags) != 2 || tags["Environment"] != "prod" {
+		t.Errorf("explorerTags() = %v", tags)
+	}
+	if v, ok := tags["Team"]; !ok || v != "" {
+		t.Errorf("explorerTags() missing empty-valued Team tag: %v", tags)
+	}
+
+	if tags := explorerTags(nil); len(tags) != 0 {
+		t.Errorf("explorerTags(nil) = %v, want empty", tags)
+	}
+}
+
+func TestTagSearchView_searchFallbackFilter(t *testing.T) {
+	v := NewResourceSearchView(context.Background(), registry.New(), "  web  ")
+	if v.searchText != "web" {
+		t.Fatalf("searchText = %q, want %q", v.searchText, "web")
+	}
+
+	v.Update(tagSearchLoadedMsg{resources: []taggedARN{
+		{ARN: aws.ParseARN("arn:aws:s3:::web-assets"), RawARN: "arn:aws:s3:::web-assets"},
+		{ARN: aws.ParseARN("arn:aws:s3:::logs"), RawARN: "arn:aws:s3:::logs"},
+	}})
+	if v.filterText != "web" {
+		t.Errorf("filterText = %q, want search text on tagging fallback", v.filterText)
+	}
+	if len(v.filtered) != 1 {
+		t.Errorf("filtered = %d resources, want 1", len(v.filtered))
+	}
+
+	v = NewResourceSearchView(context.Background(), registry.New(), "web")
+	v.Update(tagSearchLoadedMsg{explorerRegion: "us-east-1", resources: []taggedARN{
+		{ARN: aws.ParseARN("arn:aws:s3:::logs"), RawARN: "arn:aws:s3:::logs"},
+	}})
+	if v.filterText != "" || len(v.filtered) != 1 {
+		t.Errorf("explorer results should not be filtered locally: filter=%q filtered=%d", v.filterText, len(v.filtered))
+	}
+}
@@ -19,12 +19,21 @@ import (
 	"github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/filter"
 	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/ui"
 )
 
-const tagSearchLimit = 100
+const (
+	tagSearchLimit = 100
+	// maxTagFilters is the GetResources limit on TagFilters per request.
+	maxTagFilters = 50
+	// savedQueryPrefix marks a saved query name in :tags (e.g. ":tags @prod-web").
+	savedQueryPrefix = "@"
+	// tagSearchMarkScope keys tag search jump marks, which hold ARNs
+	tagSearchMarkScope = "tags"
+)
 
 type taggedARN struct {
 	ARN    *aws.ARN
@@ -53,7 +62,16 @@ type TagSearchView struct {
 	ctx       context.Context
 	registry  *registry.Registry
 	tagFilter string
-	styles    tagSearchViewStyles
+	queryName string         // saved query name when opened via @name
+	tagExpr   filter.TagExpr // parsed tagFilter; nil matches everything
+	exprErr   error
+	// searchText is a free-text Resource Explorer query (:search); with the
+	// tagging backend it falls back to the local filter.
+	searchText string
+	// explorerRegion is the aggregator index region when results come from
+	// Resource Explorer, "" for the tagging API.
+	explorerRegion string
+	styles         tagSearchViewStyles
 
 	tc           TableCursor
 	tableContent string
@@ -70,10 +88,12 @@ type TagSearchView struct {
 	filterText   string
 	filterInput  textinput.Model
 
+	vim vimKeys
+
 	hasMorePages  bool
 	isLoadingMore bool
 	pageTokens    map[string]string
-	partialErrors []string
+	partialErrors []ScopeError
 }
 
 func NewTagSearchView(ctx context.Context, reg *registry.Registry, tagFilter string) *TagSearchView {
@@ -82,16 +102,47 @@ func NewTagSearchView(ctx context.Context, reg *registry.Registry, tagFilter str
 	ti.Prompt = "/"
 	ti.CharLimit = 100
 
-	return &TagSearchView{
+	v := &TagSearchView{
 		ctx:         ctx,
 		registry:    reg,
-		tagFilter:   tagFilter,
+		tagFilter:   strings.TrimSpace(tagFilter),
 		styles:      newTagSearchViewStyles(),
 		loading:     true,
 		filterInput: ti,
 		spinner:     ui.NewSpinner(),
 		pageTokens:  make(map[string]string),
 	}
+	v.resolveTagFilter()
+	return v
+}
+
+// NewResourceSearchView creates a TagSearchView for a free-text Resource
+// Explorer query, e.g. ":search bucket".
+func NewResourceSearchView(ctx context.Context, reg *registry.Registry, query string) *TagSearchView {
+	v := NewTagSearchView(ctx, reg, "")
+	v.searchText = strings.TrimSpace(query)
+	return v
+}
+
+// resolveTagFilter expands a saved query reference and parses the boolean
+// tag expression. Errors are reported when the view loads.
+func (v *TagSearchView) resolveTagFilter() {
+	if name, ok := strings.CutPrefix(v.tagFilter, savedQueryPrefix); ok {
+		query, found := config.File().TagSearchQuery(name)
+		if !found {
+			v.exprErr = fmt.Errorf("saved tag query %q not found in config (tag_search.queries)", name)
+			return
+		}
+		v.queryName = name
+		v.tagFilter = strings.TrimSpace(query)
+	}
+
+	expr, err := filter.ParseTagExpr(v.tagFilter)
+	if err != nil {
+		v.exprErr = err
+		return
+	}
+	v.tagExpr = expr
 }
 
 func (v *TagSearchView) Init() tea.Cmd {
@@ -99,10 +150,11 @@ func (v *TagSearchView) Init() tea.Cmd {
 }
 
 type tagSearchLoadedMsg struct {
-	resources     []taggedARN
-	pageTokens    map[string]string
-	hasMore       bool
-	partialErrors []string
+	resources      []taggedARN
+	pageTokens     map[string]string
+	hasMore        bool
+	partialErrors  []ScopeError
+	explorerRegion string
 }
 
 type tagSearchErrorMsg struct {
@@ -110,21 +162,40 @@ type tagSearchErrorMsg struct {
 }
 
 type tagSearchNextPageMsg struct {
-	resources  []taggedARN
-	pageTokens map[string]string
-	hasMore    bool
+	resources     []taggedARN
+	pageTokens    map[string]string
+	hasMore       bool
+	partialErrors []ScopeError
 }
 
 func (v *TagSearchView) loadResources() tea.Msg {
+	if v.exprErr != nil {
+		return tagSearchErrorMsg{err: v.exprErr}
+	}
+
+	explorerRegion, err := v.resolveExplorerRegion(v.ctx)
+	if err != nil {
+		return tagSearchErrorMsg{err: fmt.Errorf("resource explorer: %w", err)}
+	}
+	if explorerRegion != "" {
+		result := v.fetchExplorerResources(explorerRegion, "")
+		if len(result.errors) > 0 {
+			return tagSearchErrorMsg{err: result.errors[0]}
+		}
+		return tagSearchLoadedMsg{
+			resources:      result.resources,
+			pageTokens:     result.pageTokens,
+			hasMore:        len(result.pageTokens) > 0,
+			explorerRegion: explorerRegion,
+		}
+	}
+
 	regions := config.Global().Regions()
 	if len(regions) == 0 {
 		regions = []string{config.Global().Region()}
 	}
 
 	result := v.fetchTaggedResources(regions, nil)
-	if len(result.resources) == 0 && len(result.errors) > 0 {
-		return tagSearchErrorMsg{err: fmt.Errorf("all regions failed: %s", strings.Join(result.errors, "; "))}
-	}
 
 	return tagSearchLoadedMsg{
 		resources:     result.resources,
@@ -137,7 +208,7 @@ func (v *TagSearchView) loadResources() tea.Msg {
 type fetchResult struct {
 	resources  []taggedARN
 	pageTokens map[string]string
-	errors     []string
+	errors     []ScopeError
 }
 
 func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens map[string]string) fetchResult {
@@ -162,13 +233,12 @@ func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens ma
 			defer wg.Done()
 
 			regionCtx := aws.WithRegionOverride(ctx, region)
-			cfg, err := aws.NewConfig(regionCtx)
+			client, err := aws.Client(regionCtx, resourcegroupstaggingapi.NewFromConfig)
 			if err != nil {
 				results <- regionResult{region: region, err: err}
 				return
 			}
 
-			client := resourcegroupstaggingapi.NewFromConfig(cfg)
 			input := &resourcegroupstaggingapi.GetResourcesInput{
 				TagFilters:       tagFilters,
 				ResourcesPerPage: aws.Int32Ptr(int32(tagSearchLimit)),
@@ -188,13 +258,17 @@ func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens ma
 
 			resources := make([]taggedARN, 0, len(output.ResourceTagMappingList))
 			for _, mapping := range output.ResourceTagMappingList {
-				rawARN := aws.Str(mapping.ResourceARN)
-				parsed := aws.ParseARN(rawARN)
-
 				tags := make(map[string]string)
 				for _, tag := range mapping.Tags {
 					tags[aws.Str(tag.Key)] = aws.Str(tag.Value)
 				}
+				// TagFilters only cover the pushed-down part of the expression
+				if v.tagExpr != nil && !v.tagExpr.Match(tags) {
+					continue
+				}
+
+				rawARN := aws.Str(mapping.ResourceARN)
+				parsed := aws.ParseARN(rawARN)
 
 				resources = append(resources, taggedARN{
 					ARN:    parsed,
@@ -224,7 +298,7 @@ func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens ma
 	}
 
 	var allResources []taggedARN
-	var errors []string
+	var errors []ScopeError
 	pageTokens := make(map[string]string)
 
 	for _, region := range regions {
@@ -233,7 +307,7 @@ func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens ma
 			continue
 		}
 		if result.err != nil {
-			errors = append(errors, fmt.Sprintf("%s: %v", result.region, result.err))
+			errors = append(errors, ScopeError{Region: result.region, Err: result.err})
 			log.Warn("failed to fetch tags from region", "region", result.region, "error", result.err)
 		} else {
 			allResources = append(allResources, result.resources...)
@@ -246,20 +320,22 @@ func (v *TagSearchView) fetchTaggedResources(regions []string, existingTokens ma
 	return fetchResult{resources: allResources, pageTokens: pageTokens, errors: errors}
 }
 
+// parseTagFilters returns the API TagFilters for the parts of the tag
+// expression the tagging API can evaluate; the rest is post-filtered.
 func (v *TagSearchView) parseTagFilters() []tagtypes.TagFilter {
-	if v.tagFilter == "" {
+	conds := filter.ServerConditions(v.tagExpr)
+	if len(conds) == 0 {
 		return nil
 	}
-
-	parts := strings.SplitN(v.tagFilter, "=", 2)
-	key := parts[0]
-	filter := tagtypes.TagFilter{Key: aws.StringPtr(key)}
-
-	if len(parts) == 2 {
-		filter.Values = []string{parts[1]}
+	if len(conds) > maxTagFilters {
+		conds = conds[:maxTagFilters]
 	}
 
-	return []tagtypes.TagFilter{filter}
+	filters := make([]tagtypes.TagFilter, len(conds))
+	for i, c := range conds {
+		filters[i] = tagtypes.TagFilter{Key: aws.StringPtr(c.Key), Values: c.Values}
+	}
+	return filters
 }
 
 func (v *TagSearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -270,6 +346,12 @@ func (v *TagSearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		v.pageTokens = msg.pageTokens
 		v.hasMorePages = msg.hasMore
 		v.partialErrors = msg.partialErrors
+		v.explorerRegion = msg.explorerRegion
+		if v.searchText != "" && v.explorerRegion == "" && v.filterText == "" {
+			// The tagging API has no free-text search; match locally instead
+			v.filterText = v.searchText
+			v.filterInput.SetValue(v.searchText)
+		}
 		v.applyFilter()
 		v.buildTable()
 		return v, nil
@@ -279,6 +361,7 @@ func (v *TagSearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		v.resources = append(v.resources, msg.resources...)
 		v.pageTokens = msg.pageTokens
 		v.hasMorePages = msg.hasMore
+		v.partialErrors = append(v.partialErrors, msg.partialErrors...)
 		v.applyFilter()
 		v.buildTable()
 		return v, nil
@@ -353,6 +436,10 @@ func (v *TagSearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		if model, cmd, ok := v.handleVimKey(msg); ok {
+			return model, cmd
+		}
+
 		switch msg.String() {
 		case "/":
 			v.filterActive = true
@@ -373,6 +460,9 @@ func (v *TagSearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			v.pageTokens = make(map[string]string)
 			return v, tea.Batch(v.loadResources, v.spinner.Tick)
 
+		case "!":
+			return v, showScopeErrors("Tag Search", v.partialErrors)
+
 		case "N":
 			if v.hasMorePages && !v.isLoadingMore && len(v.pageTokens) > 0 {
 				v.isLoadingMore = true
@@ -426,6 +516,16 @@ func (v *TagSearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (v *TagSearchView) loadNextPage() tea.Msg {
+	if v.explorerRegion != "" {
+		result := v.fetchExplorerResources(v.explorerRegion, v.pageTokens[v.explorerRegion])
+		return tagSearchNextPageMsg{
+			resources:     result.resources,
+			pageTokens:    result.pageTokens,
+			hasMore:       len(result.pageTokens) > 0,
+			partialErrors: result.errors,
+		}
+	}
+
 	regions := make([]string, 0, len(v.pageTokens))
 	for region := range v.pageTokens {
 		regions = append(regions, region)
@@ -433,9 +533,10 @@ func (v *TagSearchView) loadNextPage() tea.Msg {
 
 	result := v.fetchTaggedResources(regions, v.pageTokens)
 	return tagSearchNextPageMsg{
-		resources:  result.resources,
-		pageTokens: result.pageTokens,
-		hasMore:    len(result.pageTokens) > 0,
+		resources:     result.resources,
+		pageTokens:    result.pageTokens,
+		hasMore:       len(result.pageTokens) > 0,
+		partialErrors: result.errors,
 	}
 }
 
@@ -633,7 +734,11 @@ func (v *TagSearchView) ViewString() string {
 	s := v.styles
 
 	title := "Tag Search"
-	if v.tagFilter != "" {
+	if v.searchText != "" {
+		title = fmt.Sprintf("Search: %s", v.searchText)
+	} else if v.queryName != "" {
+		title = fmt.Sprintf("Tag Search: %s%s (%s)", savedQueryPrefix, v.queryName, v.tagFilter)
+	} else if v.tagFilter != "" {
 		title = fmt.Sprintf("Tag Search: %s", v.tagFilter)
 	}
 	header := s.header.Width(v.width).Render(title)
@@ -657,11 +762,11 @@ func (v *TagSearchView) ViewString() string {
 	} else if v.hasMorePages {
 		statusLine += " (N for more)"
 	}
-	if len(v.partialErrors) > 0 {
-		statusLine += fmt.Sprintf(" [%d region errors]", len(v.partialErrors))
+	if v.explorerRegion != "" {
+		statusLine += " via Resource Explorer"
 	}
 
-	status := s.status.Render(statusLine)
+	status := s.status.Render(statusLine) + partialErrorsIndicator(v.partialErrors)
 
 	filterView := ""
 	if v.filterActive {
@@ -675,10 +780,17 @@ func (v *TagSearchView) ViewString() string {
 			ui.DimStyle().Render("No matching resources (press 'c' to clear filter)")
 	}
 
+	if len(v.resources) == 0 && len(v.partialErrors) > 0 {
+		return header + "\n" + status + "\n" +
+			ui.DangerStyle().Render(fmt.Sprintf("All %d region(s) failed - press ! for details", len(v.partialErrors)))
+	}
+
 	if len(v.resources) == 0 {
 		msg := "No tagged resources found"
-		if v.tagFilter != "" {
-			msg = fmt.Sprintf("No resources with tag '%s' found", v.tagFilter)
+		if v.searchText != "" {
+			msg = fmt.Sprintf("No resources matching '%s' found", v.searchText)
+		} else if v.tagFilter != "" {
+			msg = fmt.Sprintf("No resources matching '%s' found", v.tagFilter)
 		}
 		return header + "\n" + status + "\n" + ui.DimStyle().Render(msg)
 	}
@@ -704,6 +816,9 @@ func (v *TagSearchView) StatusLine() string {
 	if v.filterActive {
 		return fmt.Sprintf("/%s • %d/%d items • Esc:done Enter:apply", v.filterInput.Value(), len(v.filtered), len(v.resources))
 	}
+	if ind := v.vim.indicator(); ind != "" {
+		return fmt.Sprintf("Tag Search • %s… (Esc:cancel)", ind)
+	}
 
 	count := len(v.filtered)
 	regions := config.Global().Regions()
@@ -711,7 +826,11 @@ func (v *TagSearchView) StatusLine() string {
 	if len(regions) > 1 {
 		regionInfo = fmt.Sprintf(" (%d regions)", len(regions))
 	}
+	regionInfo += partialErrorsHint(v.partialErrors)
 
+	if v.searchText != "" {
+		return fmt.Sprintf("Search: %s • %d/%d%s", v.searchText, count, len(v.resources), regionInfo)
+	}
 	if v.tagFilter != "" {
 		if v.filterText != "" {
 			return fmt.Sprintf("Tag Search: %s • %d/%d%s (/%s)", v.tagFilter, count, len(v.resources), regionInfo, v.filterText)
@@ -725,7 +844,7 @@ func (v *TagSearchView) StatusLine() string {
 }
 
 func (v *TagSearchView) HasActiveInput() bool {
-	return v.filterActive
+	return v.filterActive || v.vim.capturing()
 }
 
 func (v *TagSearchView) getRowAtPosition(y int) int {
@@ -803,3 +922,55 @@ func sanitizeTagValue(s string) string {
 	}
 	return b.String()
 }
+
+// KeyHelp implements KeyHelper
+func (v *TagSearchView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{
+		Title: "Tag Search",
+		Bindings: []KeyBinding{
+			{"↑/k, ↓/j", "Move cursor up/down"},
+			{"g, G", "Go to top / bottom"},
+			{"{n}j, {n}k", "Move n rows (e.g. 10j)"},
+			{"{n}G", "Go to row n"},
+			{"m{a-z}, `{a-z}", "Set / jump to a mark"},
+			{"Enter", "Open resource"},
+			{"/", "Filter results"},
+			{"c", "Clear filter"},
+			{"Ctrl+r", "Refresh"},
+			{"N", "Load next page"},
+			{"!", "Show regions that failed to load"},
+			{":tags @<name>", "Run a saved tag query"},
+		},
+	}}
+}
+
+// handleVimKey applies count prefixes and jump marks. Tag search has no
+// single-key meaning for "m" or digits, so prefixes never time out.
+func (v *TagSearchView) handleVimKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd, bool) {
+	res, cmd := v.vim.handle(msg.String(), func(string) bool { return false })
+	switch res.action {
+	case vimConsumed:
+		return v, cmd, true
+	case vimMove:
+		v.tc.SetCursor(v.tc.Cursor()+res.count, len(v.filtered))
+	case vimGoto:
+		v.tc.SetCursor(res.count-1, len(v.filtered))
+	case vimSetMark:
+		if cursor := v.tc.Cursor(); cursor < len(v.filtered) {
+			setSessionMark(tagSearchMarkScope, res.mark, v.filtered[cursor].RawARN)
+		}
+		return v, nil, true
+	case vimJump:
+		arn, ok := sessionMark(tagSearchMarkScope, res.mark)
+		idx := slices.IndexFunc(v.filtered, func(r taggedARN) bool { return r.RawARN == arn })
+		if !ok || idx < 0 {
+			return v, nil, true
+		}
+		v.tc.SetCursor(idx, len(v.filtered))
+	default:
+		return nil, nil, false
+	}
+	v.tc.UpdateScrollOffset(len(v.filtered))
+	v.buildTable()
+	return v, nil, true
+}
@@ -2,6 +2,7 @@ package view
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/clawscli/claws/internal/aws"
@@ -155,6 +156,55 @@ func TestTagSearchView_parseTagFilters(t *testing.T) {
 	}
 }
 
+func TestTagSearchView_parseTagFilters_expression(t *testing.T) {
+	v := NewTagSearchView(context.Background(), registry.New(), "Environment=prod AND Team AND !Deprecated")
+	if v.exprErr != nil {
+		t.Fatalf("unexpected expression error: %v", v.exprErr)
+	}
+
+	filters := v.parseTagFilters()
+	if len(filters) != 2 {
+		t.Fatalf("parseTagFilters() returned %d filters, want 2", len(filters))
+	}
+	if *filters[0].Key != "Environment" || len(filters[0].Values) != 1 || filters[0].Values[0] != "prod" {
+		t.Errorf("filters[0] = %s %v", *filters[0].Key, filters[0].Values)
+	}
+	if *filters[1].Key != "Team" || filters[1].Values != nil {
+		t.Errorf("filters[1] = %s %v", *filters[1].Key, filters[1].Values)
+	}
+
+	if !v.tagExpr.Match(map[string]string{"Environment": "prod", "Team": "web"}) {
+		t.Error("expected match without Deprecated tag")
+	}
+	if v.tagExpr.Match(map[string]string{"Environment": "prod", "Team": "web", "Deprecated": "true"}) {
+		t.Error("expected no match with Deprecated tag")
+	}
+}
+
+func TestTagSearchView_invalidExpression(t *testing.T) {
+	tests := []struct {
+		name      string
+		tagFilter string
+		want      string
+	}{
+		{"syntax error", "Environment=prod AND", "unexpected end"},
+		{"unknown saved query", "@no-such-saved-query", "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewTagSearchView(context.Background(), registry.New(), tt.tagFilter)
+			msg, ok := v.loadResources().(tagSearchErrorMsg)
+			if !ok {
+				t.Fatalf("loadResources() = %T, want tagSearchErrorMsg", v.loadResources())
+			}
+			if !strings.Contains(msg.err.Error(), tt.want) {
+				t.Errorf("error = %v, want containing %q", msg.err, tt.want)
+			}
+		})
+	}
+}
+
 func TestTagSearchView_applyFilter(t *testing.T) {
 	ctx := context.Background()
 	reg := registry.New()
@@ -0,0 +1,235 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/trustmap"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+type trustMapViewStyles struct {
+	title   lipgloss.Style
+	section lipgloss.Style
+	dim     lipgloss.Style
+	flagged lipgloss.Style
+	known   lipgloss.Style
+	warning lipgloss.Style
+}
+
+func newTrustMapViewStyles() trustMapViewStyles {
+	return trustMapViewStyles{
+		title:   ui.TitleStyle(),
+		section: ui.SectionStyle(),
+		dim:     ui.DimStyle(),
+		flagged: ui.DangerStyle(),
+		known:   ui.SuccessStyle(),
+		warning: ui.WarningStyle(),
+	}
+}
+
+// TrustMapView scans IAM role trust policies in the selected profiles and
+// shows which accounts can assume which roles.
+type TrustMapView struct {
+	ctx      context.Context
+	profiles []config.ProfileSelection
+
+	loading bool
+	spinner spinner.Model
+	report  *trustmap.Report
+
+	vp     ViewportState
+	styles trustMapViewStyles
+}
+
+// NewTrustMapView creates a TrustMapView for the selected profiles
+func NewTrustMapView(ctx context.Context) *TrustMapView {
+	return &TrustMapView{
+		ctx:      ctx,
+		profiles: trustmap.CurrentProfiles(),
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		styles:   newTrustMapViewStyles(),
+	}
+}
+
+type trustMapScannedMsg struct {
+	report *trustmap.Report
+}
+
+func (v *TrustMapView) Init() tea.Cmd {
+	return tea.Batch(v.scan, v.spinner.Tick)
+}
+
+func (v *TrustMapView) scan() tea.Msg {
+	allowed := config.File().TrustMapAllowedAccounts()
+	return trustMapScannedMsg{report: trustmap.Scan(v.ctx, v.profiles, allowed)}
+}
+
+func (v *TrustMapView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case trustMapScannedMsg:
+		v.loading = false
+		v.report = msg.report
+		v.refreshContent()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newTrustMapViewStyles()
+		v.refreshContent()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		// Let app handle back navigation
+		if IsEscKey(msg) {
+			return v, nil
+		}
+		if msg.String() == "ctrl+r" && !v.loading {
+			v.loading = true
+			return v, tea.Batch(v.scan, v.spinner.Tick)
+		}
+	}
+
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *TrustMapView) refreshContent() {
+	if v.vp.Ready && v.report != nil {
+		v.vp.Model.SetContent(v.renderContent())
+	}
+}
+
+func (v *TrustMapView) renderContent() string {
+	s := v.styles
+	r := v.report
+	var b strings.Builder
+
+	b.WriteString(s.title.Render("Cross-Account Trust Map") + "\n")
+	b.WriteString(s.dim.Render(fmt.Sprintf("%d roles in %d account(s) • %d same-account trusts not shown",
+		r.Roles, len(r.Accounts), r.SameAccount)) + "\n")
+	flagged := r.Flagged()
+	summary := fmt.Sprintf("%d cross-account trusts", len(r.Edges))
+	if flagged > 0 {
+		b.WriteString(summary + "  " + s.flagged.Render(fmt.Sprintf("⚠ %d flagged", flagged)) + "\n")
+	} else {
+		b.WriteString(summary + "  " + s.known.Render("✓ none flagged") + "\n")
+	}
+
+	b.WriteString("\n" + s.section.Render("Scanned Accounts") + "\n")
+	accounts := make([]string, 0, len(r.Accounts))
+	for account := range r.Accounts {
+		accounts = append(accounts, account)
+	}
+	slices.Sort(accounts)
+	for _, account := range accounts {
+		fmt.Fprintf(&b, "  %s  %s\n", account, s.dim.Render(strings.Join(r.Accounts[account], ", ")))
+	}
+
+	for _, g := range r.ByPrincipalAccount() {
+		b.WriteString("\n" + v.groupHeader(g, r) + "\n")
+		for _, e := range g.Edges {
+			b.WriteString(v.edgeLine(e) + "\n")
+		}
+	}
+
+	if len(r.Errors) > 0 {
+		b.WriteString("\n" + s.warning.Render(fmt.Sprintf("⚠ %d profiles could not be scanned:", len(r.Errors))) + "\n")
+		for _, e := range r.Errors {
+			b.WriteString("  " + s.dim.Render(e) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// groupHeader names the trusted account and why it is or isn't flagged
+func (v *TrustMapView) groupHeader(g trustmap.Group, r *trustmap.Report) string {
+	s := v.styles
+	switch g.Trust {
+	case trustmap.TrustAnyone:
+		return s.flagged.Render("⚠ * (any AWS account)")
+	case trustmap.TrustUnknown:
+		return s.flagged.Render("⚠ " + g.Account + " (unresolvable principal, possibly deleted)")
+	case trustmap.TrustExternal:
+		return s.flagged.Render("⚠ " + g.Account + " (external, not in trust_map.allowed_accounts)")
+	case trustmap.TrustAllowed:
+		return s.section.Render(g.Account) + " " + s.dim.Render("(allowlisted)")
+	default:
+		return s.section.Render(g.Account) + " " + s.dim.Render("("+strings.Join(r.Accounts[g.Account], ", ")+")")
+	}
+}
+
+// edgeLine shows a role the group's account can assume and how the trust
+// is guarded
+func (v *TrustMapView) edgeLine(e trustmap.Edge) string {
+	s := v.styles
+	line := fmt.Sprintf("  → %s %s", e.RoleAccount, e.RoleName)
+	var notes []string
+	if e.Principal != e.PrincipalAccount && e.Principal != "*" {
+		notes = append(notes, "as "+e.Principal)
+	}
+	if len(e.Conditions) > 0 {
+		notes = append(notes, "if "+strings.Join(e.Conditions, ", "))
+	} else if e.Flagged() {
+		notes = append(notes, "no conditions")
+	}
+	if len(notes) > 0 {
+		line += "  " + s.dim.Render(strings.Join(notes, " • "))
+	}
+	return line
+}
+
+func (v *TrustMapView) ViewString() string {
+	if v.loading {
+		return v.spinner.View() + fmt.Sprintf(" Scanning role trust policies in %d profile(s)...", len(v.profiles))
+	}
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.vp.Model.View()
+}
+
+func (v *TrustMapView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *TrustMapView) SetSize(width, height int) tea.Cmd {
+	v.vp.SetSize(width, max(height, 5))
+	if !v.loading {
+		v.refreshContent()
+	}
+	return nil
+}
+
+func (v *TrustMapView) StatusLine() string {
+	if v.loading {
+		return "trust-map • q/esc:back"
+	}
+	return "trust-map • ↑/↓:scroll • ctrl+r:rescan • q/esc:back"
+}
+
+// KeyHelp implements KeyHelper
+func (v *TrustMapView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{Title: "Trust Map", Bindings: []KeyBinding{
+		{"↑/k, ↓/j", "Scroll"},
+		{"PgUp, PgDn", "Page up / down"},
+		{"Ctrl+r", "Rescan"},
+		{"Esc", "Back"},
+	}}}
+}
@@ -0,0 +1,48 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/trustmap"
+)
+
+func TestTrustMapView_Content(t *testing.T) {
+	v := NewTrustMapView(context.Background())
+	v.vp.SetSize(100, 40)
+	v.Update(trustMapScannedMsg{report: &trustmap.Report{
+		Accounts:    map[string][]string{"111111111111": {"prod"}, "222222222222": {"dev"}},
+		Roles:       12,
+		SameAccount: 3,
+		Edges: []trustmap.Edge{
+			{Principal: "arn:aws:iam::222222222222:role/ci", PrincipalAccount: "222222222222",
+				RoleAccount: "111111111111", RoleName: "deploy", Trust: trustmap.TrustKnown},
+			{Principal: "999999999999", PrincipalAccount: "999999999999",
+				RoleAccount: "111111111111", RoleName: "vendor-audit", Conditions: []string{"sts:ExternalId"}, Trust: trustmap.TrustExternal},
+		},
+		Errors: []string{"staging: list roles: AccessDenied"},
+	}})
+
+	content := v.renderContent()
+	for _, want := range []string{
+		"2 cross-account trusts",
+		"1 flagged",
+		"999999999999 (external, not in trust_map.allowed_accounts)",
+		"→ 111111111111 vendor-audit",
+		"if sts:ExternalId",
+		"as arn:aws:iam::222222222222:role/ci",
+		"staging: list roles: AccessDenied",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("content missing %q", want)
+		}
+	}
+	// Flagged accounts are listed before known ones
+	if strings.Index(content, "999999999999 (external") > strings.Index(content, "→ 111111111111 deploy") {
+		t.Error("flagged account should be listed first")
+	}
+	if v.loading {
+		t.Error("loading should be false after the scan completes")
+	}
+}
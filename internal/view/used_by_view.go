@@ -0,0 +1,335 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+	"github.com/clawscli/claws/internal/usedby"
+)
+
+// usedByCmd opens a UsedByView for res, or reports that nothing is known
+// to reference its type
+func usedByCmd(ctx context.Context, reg *registry.Registry, service, resType string, res dao.Resource) tea.Cmd {
+	if res == nil {
+		return nil
+	}
+	if len(usedby.Relations(service, resType)) == 0 {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("no known references to %s/%s", service, resType)}
+		}
+	}
+	if region := dao.GetResourceRegion(res); region != "" {
+		ctx = aws.WithRegionOverride(ctx, region)
+	}
+	if profile := dao.GetResourceProfile(res); profile != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(profile))
+	}
+	inner := dao.UnwrapResource(res)
+	target := usedby.Target{
+		Service:      service,
+		ResourceType: resType,
+		ID:           inner.GetID(),
+		Name:         inner.GetName(),
+		ARN:          inner.GetARN(),
+	}
+	v := NewUsedByView(ctx, reg, target)
+	return func() tea.Msg { return NavigateMsg{View: v} }
+}
+
+// usedByARNCmd opens a UsedByView for the resource arn names, which need
+// not be listable itself, e.g. a Lambda layer version
+func usedByARNCmd(ctx context.Context, reg *registry.Registry, arn string) tea.Cmd {
+	parsed := aws.ParseARN(arn)
+	service, resType := parsed.ServiceResourceType()
+	if len(usedby.Relations(service, resType)) == 0 {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("no known references to %s", arn)}
+		}
+	}
+	if parsed.Region != "" {
+		ctx = aws.WithRegionOverride(ctx, parsed.Region)
+	}
+	target := usedby.Target{
+		Service:      service,
+		ResourceType: resType,
+		ID:           parsed.ShortID(),
+		ARN:          arn,
+	}
+	v := NewUsedByView(ctx, reg, target)
+	return func() tea.Msg { return NavigateMsg{View: v} }
+}
+
+type usedByScannedMsg struct {
+	result *usedby.Result
+	err    error
+}
+
+type usedByViewStyles struct {
+	header  lipgloss.Style
+	status  lipgloss.Style
+	dim     lipgloss.Style
+	warning lipgloss.Style
+}
+
+func newUsedByViewStyles() usedByViewStyles {
+	return usedByViewStyles{
+		header:  ui.TableHeaderStyle().Padding(0, 1),
+		status:  ui.DimStyle().Padding(0, 1),
+		dim:     ui.DimStyle(),
+		warning: ui.WarningStyle(),
+	}
+}
+
+// UsedByView lists the resources that reference a resource, such as the
+// instances and Lambda functions using a security group.
+type UsedByView struct {
+	ctx      context.Context
+	registry *registry.Registry
+	target   usedby.Target
+
+	loading bool
+	spinner spinner.Model
+	result  *usedby.Result
+	err     error
+
+	tc           TableCursor
+	tableContent string
+	width        int
+	height       int
+	styles       usedByViewStyles
+}
+
+// NewUsedByView creates a UsedByView for target. ctx should carry the
+// target's profile and region.
+func NewUsedByView(ctx context.Context, reg *registry.Registry, target usedby.Target) *UsedByView {
+	return &UsedByView{
+		ctx:      ctx,
+		registry: reg,
+		target:   target,
+		loading:  true,
+		spinner:  ui.NewSpinner(),
+		styles:   newUsedByViewStyles(),
+	}
+}
+
+func (v *UsedByView) Init() tea.Cmd {
+	return tea.Batch(v.scan, v.spinner.Tick)
+}
+
+func (v *UsedByView) scan() tea.Msg {
+	result, err := usedby.Find(v.ctx, v.registry, v.target)
+	return usedByScannedMsg{result: result, err: err}
+}
+
+func (v *UsedByView) hits() []usedby.Hit {
+	if v.result == nil {
+		return nil
+	}
+	return v.result.Hits
+}
+
+func (v *UsedByView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case usedByScannedMsg:
+		v.loading = false
+		v.result = msg.result
+		v.err = msg.err
+		v.buildTable()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case ThemeChangedMsg:
+		v.styles = newUsedByViewStyles()
+		v.buildTable()
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.loading {
+			return v, nil
+		}
+		n := len(v.hits())
+		switch msg.String() {
+		case "enter", "d":
+			return v, v.open()
+		case "ctrl+r":
+			v.loading = true
+			return v, tea.Batch(v.scan, v.spinner.Tick)
+		case "j", "down":
+			v.tc.SetCursor(v.tc.Cursor()+1, n)
+		case "k", "up":
+			v.tc.SetCursor(v.tc.Cursor()-1, n)
+		case "ctrl+d", "pgdown":
+			v.tc.SetCursor(v.tc.Cursor()+v.tc.TableHeight()/2, n)
+		case "ctrl+u", "pgup":
+			v.tc.SetCursor(v.tc.Cursor()-v.tc.TableHeight()/2, n)
+		case "g", "home":
+			v.tc.SetCursor(0, n)
+		case "G", "end":
+			v.tc.SetCursor(n-1, n)
+		default:
+			return v, nil
+		}
+		v.tc.UpdateScrollOffset(n)
+		v.buildTable()
+	}
+	return v, nil
+}
+
+// open navigates to the detail view of the hit under the cursor
+func (v *UsedByView) open() tea.Cmd {
+	hits := v.hits()
+	cursor := v.tc.Cursor()
+	if cursor < 0 || cursor >= len(hits) {
+		return nil
+	}
+	h := hits[cursor]
+	renderer, err := v.registry.GetRenderer(h.Service, h.ResourceType)
+	if err != nil {
+		return func() tea.Msg { return ErrorMsg{Err: err} }
+	}
+	daoInst, err := v.registry.GetDAO(v.ctx, h.Service, h.ResourceType)
+	if err != nil {
+		daoInst = nil
+	}
+	detail := NewDetailView(v.ctx, h.Resource, renderer, h.Service, h.ResourceType, v.registry, daoInst)
+	return func() tea.Msg { return NavigateMsg{View: detail} }
+}
+
+// targetLabel names the resource whose references are listed
+func (v *UsedByView) targetLabel() string {
+	t := v.target
+	label := t.ID
+	if t.Name != "" && t.Name != t.ID {
+		label = t.Name + " (" + t.ID + ")"
+	}
+	return t.Service + "/" + t.ResourceType + " " + label
+}
+
+func (v *UsedByView) buildTable() {
+	hits := v.hits()
+	v.tc.SetCursor(v.tc.Cursor(), len(hits))
+	if len(hits) == 0 {
+		v.tableContent = ""
+		return
+	}
+
+	headers := []string{"TYPE", "RESOURCE", "REFERENCE"}
+	tableHeight := max(v.height-1-len(v.errors()), 1)
+	v.tc.SetTableHeight(tableHeight)
+	tableWidth := v.width
+	if tableWidth < 80 {
+		tableWidth = 120
+	}
+	fixed := 28 + 40
+	widths := []int{28, 40, max(tableWidth-fixed, 20)}
+
+	t := table.New().
+		Headers(headers...).
+		Width(tableWidth).
+		Height(tableHeight).
+		Wrap(false).
+		BorderTop(false).
+		BorderBottom(false).
+		BorderLeft(false).
+		BorderRight(false).
+		BorderColumn(false).
+		BorderHeader(true).
+		BorderStyle(TableBorderStyle()).
+		StyleFunc(NewTableStyleFunc(widths, v.tc.Cursor()))
+
+	for _, h := range hits {
+		inner := dao.UnwrapResource(h.Resource)
+		label := inner.GetID()
+		if name := inner.GetName(); name != "" && name != label {
+			label = name + " (" + label + ")"
+		}
+		ref := h.Path
+		if h.Via != "" {
+			ref = "via " + h.Via
+		}
+		t = t.Row(h.Type(), label, ref)
+	}
+	if v.tc.ScrollOffset() > 0 {
+		t = t.YOffset(v.tc.ScrollOffset())
+	}
+	v.tableContent = t.String()
+}
+
+func (v *UsedByView) errors() []string {
+	if v.result == nil {
+		return nil
+	}
+	return v.result.Errors
+}
+
+func (v *UsedByView) ViewString() string {
+	header := v.styles.header.Width(v.width).Render("Used by " + v.targetLabel())
+	switch {
+	case v.loading:
+		return header + "\n" + v.spinner.View() + " Searching for references..."
+	case v.err != nil:
+		return header + "\n" + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	}
+
+	out := header + "\n"
+	if len(v.hits()) == 0 {
+		out += v.styles.dim.Render("No references found")
+	} else {
+		out += v.styles.status.Render(fmt.Sprintf("%d resources", len(v.hits()))) + "\n" + v.tableContent
+	}
+	for _, e := range v.errors() {
+		out += "\n" + v.styles.warning.Render("⚠ "+e)
+	}
+	return out
+}
+
+func (v *UsedByView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *UsedByView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height - 2 // header and status lines
+	v.buildTable()
+	return nil
+}
+
+func (v *UsedByView) StatusLine() string {
+	if v.loading {
+		return "usedby • q/esc:back"
+	}
+	return fmt.Sprintf("usedby • %d resources • enter:open • ctrl+r:rescan • q/esc:back", len(v.hits()))
+}
+
+// ItemCount implements ItemCounter
+func (v *UsedByView) ItemCount() (shown, total int) {
+	return len(v.hits()), len(v.hits())
+}
+
+// KeyHelp implements KeyHelper
+func (v *UsedByView) KeyHelp() []KeyHelpSection {
+	return []KeyHelpSection{{Title: "Used By", Bindings: []KeyBinding{
+		{"↑/k, ↓/j", "Move cursor up/down"},
+		{"g, G", "Go to top / bottom"},
+		{"Enter, d", "Open resource details"},
+		{"Ctrl+r", "Rescan"},
+		{"Esc", "Back"},
+	}}}
+}
@@ -0,0 +1,51 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/usedby"
+)
+
+func TestUsedByView_Content(t *testing.T) {
+	v := NewUsedByView(context.Background(), registry.New(), usedby.Target{Service: "ec2", ResourceType: "security-groups", ID: "sg-1", Name: "web"})
+	v.SetSize(120, 20)
+	v.Update(usedByScannedMsg{result: &usedby.Result{
+		Hits: []usedby.Hit{
+			{Service: "lambda", ResourceType: "functions", Resource: &dao.BaseResource{ID: "fn"}, Path: "VpcConfig.SecurityGroupIds[0]"},
+			{Service: "autoscaling", ResourceType: "groups", Resource: &dao.BaseResource{ID: "asg"}, Via: "ec2/instances i-1"},
+		},
+		Errors: []string{"ecs/services: AccessDenied"},
+	}})
+
+	content := v.ViewString()
+	for _, want := range []string{
+		"Used by ec2/security-groups web (sg-1)",
+		"2 resources",
+		"VpcConfig.SecurityGroupIds[0]",
+		"via ec2/instances i-1",
+		"ecs/services: AccessDenied",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("content missing %q", want)
+		}
+	}
+}
+
+func TestUsedByCmdUnknownType(t *testing.T) {
+	cmd := usedByCmd(context.Background(), registry.New(), "s3", "buckets", &dao.BaseResource{ID: "b"})
+	msg, ok := cmd().(ErrorMsg)
+	if !ok || !strings.Contains(msg.Err.Error(), "no known references to s3/buckets") {
+		t.Errorf("usedByCmd() msg = %v, want an ErrorMsg", msg)
+	}
+
+	cmd = usedByCmd(context.Background(), registry.New(), "ec2", "security-groups", &dao.BaseResource{ID: "sg-1"})
+	if nav, ok := cmd().(NavigateMsg); !ok {
+		t.Errorf("usedByCmd() msg = %T, want NavigateMsg", nav)
+	} else if _, ok := nav.View.(*UsedByView); !ok {
+		t.Errorf("navigated to %T, want *UsedByView", nav.View)
+	}
+}
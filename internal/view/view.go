@@ -42,6 +42,20 @@ type InputCapture interface {
 	HasActiveInput() bool
 }
 
+// KeyHelper is an optional interface for views that describe their own
+// keybindings in the help overlay
+type KeyHelper interface {
+	// KeyHelp returns the view's keybindings, grouped into sections
+	KeyHelp() []KeyHelpSection
+}
+
+// ItemCounter is an optional interface for views listing items, shown by the
+// status bar's {count} segment
+type ItemCounter interface {
+	// ItemCount returns the items shown after filtering and the total loaded
+	ItemCount() (shown, total int)
+}
+
 // NavigateMsg is sent when navigating to a new view
 type NavigateMsg struct {
 	View       View
@@ -96,9 +110,33 @@ type DiffMsg struct {
 	RightID string // ID of right resource
 }
 
+// CopyAsMsg tells the current view to copy a snippet for the selected
+// resource in one of the copyas formats
+type CopyAsMsg struct {
+	Format string // "cli", "terraform", or "boto3"
+}
+
+// UsedByMsg tells the current view to list the resources referencing the
+// selected resource
+type UsedByMsg struct{}
+
+// TraceMsg tells the current view to trace the delivery chain of the
+// selected SNS topic or EventBridge rule
+type TraceMsg struct{}
+
+// CompareRegionsMsg tells the current view to compare its resource type
+// between two regions
+type CompareRegionsMsg struct {
+	RegionA string
+	RegionB string
+}
+
 // ClearHistoryMsg tells the app to clear the navigation stack
 type ClearHistoryMsg struct{}
 
+// HistoryClearedMsg reports that the persisted :history was cleared
+type HistoryClearedMsg struct{}
+
 // Refreshable is an interface for views that can refresh their data
 // Views like ResourceBrowser implement this, while DetailView does not
 type Refreshable interface {
@@ -200,6 +238,10 @@ func (h *NavigationHelper) createCustomView(nav render.Navigation, resource dao.
 	switch nav.ViewType {
 	case render.ViewTypeLogView:
 		return h.createLogView(resource)
+	case render.ViewTypeFlowLogView:
+		return h.createFlowLogView(resource)
+	case render.ViewTypeContainerLogView:
+		return h.createContainerLogView(resource)
 	default:
 		return nil
 	}
@@ -234,6 +276,45 @@ func (h *NavigationHelper) createLogView(resource dao.Resource) tea.Cmd {
 	}
 }
 
+func (h *NavigationHelper) createFlowLogView(resource dao.Resource) tea.Cmd {
+	type flowLogProvider interface {
+		LogGroupName() string
+		LogStreamPrefix() string
+		LogFormat() string
+	}
+
+	p, ok := dao.UnwrapResource(resource).(flowLogProvider)
+	if !ok || p.LogGroupName() == "" {
+		return nil
+	}
+	flowLogView := NewFlowLogView(h.Ctx, p.LogGroupName(), p.LogStreamPrefix(), p.LogFormat())
+
+	return func() tea.Msg {
+		return NavigateMsg{View: flowLogView}
+	}
+}
+
+func (h *NavigationHelper) createContainerLogView(resource dao.Resource) tea.Cmd {
+	type containerLogProvider interface {
+		ContainerLogs() []render.ContainerLog
+	}
+
+	unwrapped := dao.UnwrapResource(resource)
+	p, ok := unwrapped.(containerLogProvider)
+	if !ok {
+		return nil
+	}
+	containers := p.ContainerLogs()
+	if len(containers) == 0 {
+		return nil
+	}
+	containerLogView := NewContainerLogView(h.Ctx, unwrapped.GetName(), containers)
+
+	return func() tea.Msg {
+		return NavigateMsg{View: containerLogView}
+	}
+}
+
 // mergeResources merges the refreshed resource with the original to preserve
 // fields that are only available from List() but not from Get().
 func mergeResources(original, refreshed dao.Resource) dao.Resource {
@@ -0,0 +1,197 @@
+package view
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// vimKeyTimeout is how long an ambiguous prefix waits for its next key:
+// a digit that also switches resource types runs its single-key meaning after
+// the timeout, and "m" stops waiting for a mark name.
+const vimKeyTimeout = 600 * time.Millisecond
+
+type vimKeyAction int
+
+const (
+	vimNone     vimKeyAction = iota // key not consumed; process it normally
+	vimConsumed                     // key consumed as part of a prefix
+	vimMove                         // move the cursor by count rows (negative = up)
+	vimGoto                         // go to row count (1-based)
+	vimSetMark                      // set mark on the current row
+	vimJump                         // jump to the row holding mark
+)
+
+type vimKeyResult struct {
+	action vimKeyAction
+	count  int
+	mark   string
+	// flush is a deferred digit whose single-key meaning must run before
+	// the current key is processed.
+	flush string
+}
+
+// vimKeyTimeoutMsg fires when an ambiguous prefix has waited vimKeyTimeout
+type vimKeyTimeoutMsg struct {
+	owner *vimKeys
+	seq   int
+}
+
+// vimKeys tracks vim-style prefixes in list views: count prefixes ("10j",
+// "5G"), m{a-z} to set a mark, and `{a-z} to jump back to it.
+type vimKeys struct {
+	count   int
+	pending string // "m" or "`" waiting for a mark name
+	eager   bool   // the view already handled the pending "m" itself
+	seq     int
+}
+
+func (v *vimKeys) active() bool {
+	return v.count > 0 || v.pending != ""
+}
+
+// capturing reports whether the next key must reach the view, e.g. so "mq"
+// sets mark q instead of quitting.
+func (v *vimKeys) capturing() bool {
+	return v.pending != ""
+}
+
+// indicator returns the pending count or jump prefix for the status line
+func (v *vimKeys) indicator() string {
+	if v.count > 0 {
+		return strconv.Itoa(v.count)
+	}
+	if v.eager {
+		return ""
+	}
+	return v.pending
+}
+
+func (v *vimKeys) reset() {
+	v.count = 0
+	v.pending = ""
+	v.eager = false
+}
+
+func (v *vimKeys) timeout() tea.Cmd {
+	v.seq++
+	owner, seq := v, v.seq
+	return tea.Tick(vimKeyTimeout, func(time.Time) tea.Msg {
+		return vimKeyTimeoutMsg{owner: owner, seq: seq}
+	})
+}
+
+// beginMark starts waiting for a mark name after a view handled "m" itself
+func (v *vimKeys) beginMark() tea.Cmd {
+	v.reset()
+	v.pending = "m"
+	v.eager = true
+	return v.timeout()
+}
+
+// handle processes key. ambiguous reports whether a prefix key also has a
+// single-key meaning in the view: for "m" the view handles the key itself
+// and calls beginMark; for digits the meaning is deferred until the next
+// key or the timeout.
+func (v *vimKeys) handle(key string, ambiguous func(string) bool) (vimKeyResult, tea.Cmd) {
+	if prefix := v.pending; prefix != "" {
+		v.reset()
+		switch {
+		case isMarkName(key) && prefix == "m":
+			return vimKeyResult{action: vimSetMark, mark: key}, nil
+		case isMarkName(key):
+			return vimKeyResult{action: vimJump, mark: key}, nil
+		case key == "esc" && prefix == "`":
+			return vimKeyResult{action: vimConsumed}, nil
+		}
+	}
+
+	if len(key) == 1 && key[0] >= '0' && key[0] <= '9' && (v.count > 0 || key != "0") {
+		v.count = v.count*10 + int(key[0]-'0')
+		if v.count < 10 && ambiguous(key) {
+			return vimKeyResult{action: vimConsumed}, v.timeout()
+		}
+		return vimKeyResult{action: vimConsumed}, nil
+	}
+
+	if v.count > 0 {
+		count := v.count
+		deferred := v.deferred(ambiguous)
+		v.reset()
+		switch key {
+		case "j", "down":
+			return vimKeyResult{action: vimMove, count: count}, nil
+		case "k", "up":
+			return vimKeyResult{action: vimMove, count: -count}, nil
+		case "G":
+			return vimKeyResult{action: vimGoto, count: count}, nil
+		case "esc":
+			return vimKeyResult{action: vimConsumed}, nil
+		}
+		return vimKeyResult{flush: deferred}, nil
+	}
+
+	switch {
+	case key == "m" && !ambiguous(key):
+		v.pending = key
+		return vimKeyResult{action: vimConsumed}, nil
+	case key == "`":
+		v.pending = key
+		return vimKeyResult{action: vimConsumed}, nil
+	}
+	return vimKeyResult{}, nil
+}
+
+// expire handles a timeout, returning a deferred digit to run
+func (v *vimKeys) expire(msg vimKeyTimeoutMsg, ambiguous func(string) bool) (string, bool) {
+	if msg.owner != v || msg.seq != v.seq {
+		return "", false
+	}
+	if v.pending == "m" {
+		v.reset()
+		return "", false
+	}
+	deferred := v.deferred(ambiguous)
+	if deferred == "" {
+		return "", false
+	}
+	v.reset()
+	return deferred, true
+}
+
+// deferred returns a single pending digit that also acts on its own
+func (v *vimKeys) deferred(ambiguous func(string) bool) string {
+	if v.count > 0 && v.count < 10 && ambiguous(strconv.Itoa(v.count)) {
+		return strconv.Itoa(v.count)
+	}
+	return ""
+}
+
+func isMarkName(key string) bool {
+	return len(key) == 1 && key[0] >= 'a' && key[0] <= 'z'
+}
+
+// sessionMarks holds jump marks for the session, keyed by scope (a resource
+// type such as "ec2/instances") and mark name, and valued by row ID.
+var sessionMarks = struct {
+	sync.Mutex
+	scopes map[string]map[string]string
+}{scopes: make(map[string]map[string]string)}
+
+func setSessionMark(scope, name, id string) {
+	sessionMarks.Lock()
+	defer sessionMarks.Unlock()
+	if sessionMarks.scopes[scope] == nil {
+		sessionMarks.scopes[scope] = make(map[string]string)
+	}
+	sessionMarks.scopes[scope][name] = id
+}
+
+func sessionMark(scope, name string) (string, bool) {
+	sessionMarks.Lock()
+	defer sessionMarks.Unlock()
+	id, ok := sessionMarks.scopes[scope][name]
+	return id, ok
+}
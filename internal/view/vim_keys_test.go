@@ -0,0 +1,218 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func newVimTestBrowser(service string, n int) *ResourceBrowser {
+	browser := NewResourceBrowser(context.Background(), registry.New(), service)
+	browser.SetSize(100, 50)
+	browser.renderer = &mockRenderer{detail: "test"}
+	browser.resourceTypes = []string{"instances"}
+	browser.resourceType = "instances"
+	for i := range n {
+		id := fmt.Sprintf("i-%d", i)
+		browser.resources = append(browser.resources, &mockResource{id: id, name: id})
+	}
+	browser.applyFilter()
+	browser.buildTable()
+	return browser
+}
+
+func sendKeys(m tea.Model, keys string) {
+	for _, k := range keys {
+		m.Update(tea.KeyPressMsg{Code: k, Text: string(k)})
+	}
+}
+
+func TestVimKeys_Count(t *testing.T) {
+	never := func(string) bool { return false }
+	var v vimKeys
+
+	v.handle("1", never)
+	v.handle("0", never)
+	if got := v.indicator(); got != "10" {
+		t.Errorf("indicator() = %q, want %q", got, "10")
+	}
+
+	res, _ := v.handle("k", never)
+	if res.action != vimMove || res.count != -10 {
+		t.Errorf("handle(k) = %+v, want move -10", res)
+	}
+	if v.active() {
+		t.Error("expected count to be cleared after motion")
+	}
+
+	// A leading 0 is not a count
+	if res, _ := v.handle("0", never); res.action != vimNone {
+		t.Errorf("handle(0) = %+v, want vimNone", res)
+	}
+}
+
+func TestVimKeys_DeferredDigit(t *testing.T) {
+	tab := func(key string) bool { return key == "2" }
+	var v vimKeys
+
+	_, cmd := v.handle("2", tab)
+	if cmd == nil {
+		t.Fatal("expected timeout cmd for ambiguous digit")
+	}
+
+	// A stale timeout is ignored
+	if _, ok := v.expire(vimKeyTimeoutMsg{owner: &v, seq: v.seq - 1}, tab); ok {
+		t.Error("expected stale timeout to be ignored")
+	}
+
+	key, ok := v.expire(vimKeyTimeoutMsg{owner: &v, seq: v.seq}, tab)
+	if !ok || key != "2" {
+		t.Errorf("expire() = %q, %v, want %q, true", key, ok, "2")
+	}
+
+	// An unrelated key flushes the digit first
+	v.handle("2", tab)
+	if res, _ := v.handle("y", tab); res.flush != "2" {
+		t.Errorf("handle(y).flush = %q, want %q", res.flush, "2")
+	}
+}
+
+func TestResourceBrowserVimCountMove(t *testing.T) {
+	browser := newVimTestBrowser("vimcount", 20)
+
+	sendKeys(browser, "3j")
+	if got := browser.tc.Cursor(); got != 3 {
+		t.Errorf("cursor after 3j = %d, want 3", got)
+	}
+
+	sendKeys(browser, "12G")
+	if got := browser.tc.Cursor(); got != 11 {
+		t.Errorf("cursor after 12G = %d, want 11", got)
+	}
+
+	sendKeys(browser, "2k")
+	if got := browser.tc.Cursor(); got != 9 {
+		t.Errorf("cursor after 2k = %d, want 9", got)
+	}
+
+	// Counts are clamped to the list
+	sendKeys(browser, "99j")
+	if got := browser.tc.Cursor(); got != 19 {
+		t.Errorf("cursor after 99j = %d, want 19", got)
+	}
+}
+
+func TestResourceBrowserVimCountStatusLine(t *testing.T) {
+	browser := newVimTestBrowser("vimstatus", 5)
+
+	sendKeys(browser, "4")
+	if status := browser.StatusLine(); !strings.Contains(status, "4…") {
+		t.Errorf("StatusLine() = %q, want pending count", status)
+	}
+
+	browser.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if browser.vim.active() {
+		t.Error("expected esc to cancel the count")
+	}
+}
+
+func TestResourceBrowserVimMarks(t *testing.T) {
+	browser := newVimTestBrowser("vimmarks", 10)
+
+	browser.SetCursor(6)
+	sendKeys(browser, "ma")
+	if browser.markedResource != nil {
+		t.Error("expected m{a-z} not to leave a compare mark")
+	}
+
+	browser.SetCursor(0)
+	sendKeys(browser, "`a")
+	if got := browser.tc.Cursor(); got != 6 {
+		t.Errorf("cursor after `a = %d, want 6", got)
+	}
+
+	// Marks persist for the session across views of the same type
+	other := newVimTestBrowser("vimmarks", 10)
+	sendKeys(other, "`a")
+	if got := other.tc.Cursor(); got != 6 {
+		t.Errorf("cursor in new view after `a = %d, want 6", got)
+	}
+
+	// Unknown marks leave the cursor alone
+	sendKeys(other, "`z")
+	if got := other.tc.Cursor(); got != 6 {
+		t.Errorf("cursor after unset `z = %d, want 6", got)
+	}
+}
+
+func TestResourceBrowserVimMarkKeepsCompareMark(t *testing.T) {
+	browser := newVimTestBrowser("vimcompare", 5)
+
+	// "m" alone still marks for comparison
+	browser.SetCursor(1)
+	sendKeys(browser, "m")
+	if browser.markedResource == nil || browser.markedResource.GetID() != "i-1" {
+		t.Fatal("expected i-1 to be marked for comparison")
+	}
+
+	// Once the timeout passes, the next letter is a normal key
+	browser.Update(vimKeyTimeoutMsg{owner: &browser.vim, seq: browser.vim.seq})
+	sendKeys(browser, "j")
+	if got := browser.tc.Cursor(); got != 2 {
+		t.Errorf("cursor after j = %d, want 2", got)
+	}
+	if browser.markedResource == nil {
+		t.Error("expected compare mark to remain")
+	}
+}
+
+func TestResourceBrowserVimTabDigitSwitches(t *testing.T) {
+	browser := newVimTestBrowser("vimtabs", 5)
+	browser.resourceTypes = []string{"instances", "volumes"}
+
+	sendKeys(browser, "2")
+	if browser.resourceType != "instances" {
+		t.Fatal("expected tab digit to wait for the prefix timeout")
+	}
+	browser.Update(vimKeyTimeoutMsg{owner: &browser.vim, seq: browser.vim.seq})
+	if browser.resourceType != "volumes" {
+		t.Errorf("resourceType = %q, want volumes", browser.resourceType)
+	}
+}
+
+func TestTagSearchViewVimKeys(t *testing.T) {
+	v := NewTagSearchView(context.Background(), registry.New(), "")
+	for i := range 10 {
+		id := fmt.Sprintf("i-%d", i)
+		v.resources = append(v.resources, taggedARN{
+			RawARN: "arn:aws:ec2:us-east-1:123456789012:instance/" + id,
+			Region: "us-east-1",
+			ARN:    &aws.ARN{Service: "ec2", ResourceType: "instance", ResourceID: id},
+			Tags:   map[string]string{"Name": id},
+		})
+	}
+	v.applyFilter()
+	v.SetSize(100, 50)
+
+	sendKeys(v, "4j")
+	if got := v.tc.Cursor(); got != 4 {
+		t.Errorf("cursor after 4j = %d, want 4", got)
+	}
+
+	sendKeys(v, "m")
+	if !v.HasActiveInput() {
+		t.Error("expected pending mark to capture input (so mq does not quit)")
+	}
+	sendKeys(v, "q")
+	v.tc.SetCursor(9, len(v.filtered))
+	sendKeys(v, "`q")
+	if got := v.tc.Cursor(); got != 4 {
+		t.Errorf("cursor after `q = %d, want 4", got)
+	}
+}
@@ -0,0 +1,186 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// whoamiLoadedMsg carries the identities resolved for the active selections
+type whoamiLoadedMsg struct {
+	identities []aws.Identity
+}
+
+// WhoamiView shows the caller identity, credential source, expiry and region
+// resolution of every active profile selection.
+type WhoamiView struct {
+	ctx        context.Context
+	vp         ViewportState
+	identities []aws.Identity
+	loading    bool
+	loaded     bool
+}
+
+// NewWhoamiView creates a new WhoamiView
+func NewWhoamiView(ctx context.Context) *WhoamiView {
+	return &WhoamiView{ctx: ctx}
+}
+
+func (v *WhoamiView) Init() tea.Cmd {
+	return v.load()
+}
+
+func (v *WhoamiView) load() tea.Cmd {
+	if v.loading {
+		return nil
+	}
+	v.loading = true
+	ctx := v.ctx
+	return func() tea.Msg {
+		return whoamiLoadedMsg{identities: aws.FetchIdentities(ctx)}
+	}
+}
+
+func (v *WhoamiView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case whoamiLoadedMsg:
+		v.identities = msg.identities
+		v.loading = false
+		v.loaded = true
+		v.refreshContent()
+		return v, nil
+	case ThemeChangedMsg:
+		v.refreshContent()
+		return v, nil
+	case tea.KeyPressMsg:
+		if msg.String() == "r" {
+			cmd := v.load()
+			v.refreshContent()
+			return v, cmd
+		}
+	}
+
+	if !v.vp.Ready {
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.vp.Model, cmd = v.vp.Model.Update(msg)
+	return v, cmd
+}
+
+func (v *WhoamiView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *WhoamiView) ViewString() string {
+	if !v.vp.Ready {
+		return LoadingMessage
+	}
+	return v.vp.Model.View()
+}
+
+// SetSize sizes the viewport. Modals opened from command mode are not
+// Init'ed, so the first SetSize starts the lookup.
+func (v *WhoamiView) SetSize(w, h int) tea.Cmd {
+	v.vp.SetSize(w, h)
+	var cmd tea.Cmd
+	if !v.loaded {
+		cmd = v.load()
+	}
+	v.refreshContent()
+	return cmd
+}
+
+func (v *WhoamiView) StatusLine() string {
+	return "r:refresh • Esc/q:close"
+}
+
+func (v *WhoamiView) refreshContent() {
+	if v.vp.Ready {
+		v.vp.Model.SetContent(v.buildContent())
+	}
+}
+
+func (v *WhoamiView) buildContent() string {
+	d := render.NewDetailBuilder()
+	d.Title("Who Am I", fmt.Sprintf("%d profile selection(s)", len(config.Global().Selections())))
+
+	if v.loading && len(v.identities) == 0 {
+		d.Dim("Calling sts:GetCallerIdentity...")
+		return d.String()
+	}
+	if v.loading {
+		d.Dim("Refreshing...")
+	}
+
+	now := time.Now()
+	for _, id := range v.identities {
+		d.Section(id.Selection.DisplayName())
+
+		if id.Account != "" {
+			d.Field("Account", id.Account)
+			d.Field("ARN", id.ARN)
+			d.Field("User ID", id.UserID)
+		}
+		if id.Err != nil {
+			d.FieldStyled("Error", id.Err.Error(), ui.DangerStyle())
+		}
+
+		if id.CredentialSource != "" {
+			d.Field("Credentials", aws.CredentialSourceLabel(id.CredentialSource))
+		}
+		// A failed lookup leaves a zero expiry that would read as "never"
+		if id.Account != "" || id.Expiry.SSO {
+			d.FieldStyled(expiryField(id.Expiry, now))
+		}
+
+		if id.Region != "" {
+			d.Field("Region", id.Region)
+		}
+		if def := id.DefaultRegion(); def != "" && def != id.Region {
+			d.FieldStyled("SDK Default", def+" (overridden by claws region)", ui.WarningStyle())
+		}
+		d.Dim("  Region resolution (first set wins):")
+		for _, step := range id.RegionChain {
+			value := step.Value
+			if value == "" {
+				value = "-"
+			}
+			line := fmt.Sprintf("%-36s %s", step.Name, value)
+			if step.Used {
+				d.Line("  " + ui.SuccessStyle().Render("✓ "+line))
+			} else {
+				d.DimIndent("  " + line)
+			}
+		}
+	}
+
+	return d.String()
+}
+
+// expiryField describes when credentials expire, as a styled detail field
+func expiryField(e aws.CredentialExpiry, now time.Time) (label, value string, style render.Style) {
+	label = "Expires"
+	if e.SSO {
+		label = "SSO Token Expires"
+	}
+	if !e.Known() {
+		if e.SSO {
+			return label, "unknown", ui.DimStyle()
+		}
+		return label, "never (long-lived credentials)", ui.DimStyle()
+	}
+	remaining := e.Remaining(now)
+	stamp := e.Expires.Local().Format("2006-01-02 15:04:05")
+	if remaining <= 0 {
+		return label, stamp + " (expired)", ui.DangerStyle()
+	}
+	return label, stamp + " (in " + FormatCountdown(remaining) + ")", ui.TextStyle()
+}
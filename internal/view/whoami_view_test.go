@@ -0,0 +1,64 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+)
+
+func TestWhoamiView_Content(t *testing.T) {
+	v := NewWhoamiView(context.Background())
+	v.vp.SetSize(80, 40)
+	v.Update(whoamiLoadedMsg{identities: []aws.Identity{
+		{
+			Selection:        config.NamedProfile("dev"),
+			Account:          "123456789012",
+			ARN:              "arn:aws:sts::123456789012:assumed-role/Dev/me",
+			CredentialSource: "SSOProvider",
+			Expiry:           aws.CredentialExpiry{Expires: time.Now().Add(time.Hour), SSO: true, Profile: "dev"},
+			Region:           "us-east-1",
+			RegionChain: []aws.RegionSource{
+				{Name: "AWS_REGION"},
+				{Name: "profile dev in ~/.aws/config", Value: "eu-west-1", Used: true},
+			},
+		},
+		{
+			Selection: config.NamedProfile("prod"),
+			Err:       errors.New("retrieve credentials: token expired"),
+		},
+	}})
+
+	content := v.buildContent()
+	for _, want := range []string{
+		"123456789012",
+		"IAM Identity Center (SSO)",
+		"SSO Token Expires",
+		"eu-west-1 (overridden by claws region)",
+		"token expired",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("content missing %q", want)
+		}
+	}
+	if v.loading || !v.loaded {
+		t.Errorf("loading = %v, loaded = %v after whoamiLoadedMsg", v.loading, v.loaded)
+	}
+}
+
+func TestExpiryField(t *testing.T) {
+	now := time.Now()
+	if _, value, _ := expiryField(aws.CredentialExpiry{}, now); !strings.HasPrefix(value, "never") {
+		t.Errorf("static credentials expiry = %q", value)
+	}
+	if _, value, _ := expiryField(aws.CredentialExpiry{Expires: now.Add(-time.Minute)}, now); !strings.HasSuffix(value, "(expired)") {
+		t.Errorf("expired credentials expiry = %q", value)
+	}
+	if label, _, _ := expiryField(aws.CredentialExpiry{Expires: now.Add(time.Hour), SSO: true}, now); label != "SSO Token Expires" {
+		t.Errorf("SSO label = %q", label)
+	}
+}